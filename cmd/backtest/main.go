@@ -0,0 +1,277 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/backtest"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/exchange/paper"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/fachebot/omni-grid-bot/internal/util"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	dataFile        = flag.String("data", "", "行情数据文件(CSV/Parquet, K线或逐笔成交)")
+	fundingFile     = flag.String("funding", "", "资金费率文件(CSV/Parquet, 可选)")
+	symbol          = flag.String("symbol", "BTC", "交易对")
+	mode            = flag.String("mode", "long", "网格模式: long/short/neutral, 参数扫描时以逗号分隔多个候选值")
+	quantityMode    = flag.String("quantity-mode", "arithmetic", "网格间距模式: arithmetic/geometric/adaptive, 参数扫描时以逗号分隔多个候选值")
+	priceLower      = flag.String("lower", "", "网格价格下限, 参数扫描时以逗号分隔多个候选值")
+	priceUpper      = flag.String("upper", "", "网格价格上限, 参数扫描时以逗号分隔多个候选值")
+	gridNum         = flag.String("grids", "10", "网格数量, 参数扫描时以逗号分隔多个候选值")
+	orderSize       = flag.String("size", "", "每格下单数量")
+	sizingMode      = flag.String("sizing", "fixed", "仓位曲线: fixed/pyramid/geometric/equal_notional/custom")
+	sizingFactor    = flag.String("sizing-factor", "0", "金字塔/等比仓位曲线的加仓系数, 0 表示使用默认值")
+	sizingWeights   = flag.String("sizing-weights", "", "自定义仓位曲线的权重列表, 以逗号分隔")
+	leverage        = flag.String("leverage", "1", "杠杆倍数, 参数扫描时以逗号分隔多个候选值")
+	slippageBps     = flag.Int("slippage", 50, "市价单滑点容忍度(基点)")
+	entryPrice      = flag.String("entry", "0", "入场价格, 0 表示不限制")
+	stopLossPrice   = flag.String("sl", "0", "止损触发价格, 0 表示不启用")
+	takeProfitPrice = flag.String("tp", "0", "止盈触发价格, 0 表示不启用")
+	trailingLevels  = flag.Int("trailing", 0, "移动网格触发档位数, 价格超出区间达到该档位数时平移网格, 0 表示不启用")
+	trailingUpper   = flag.String("trailing-upper", "0", "移动网格价格上限, 0 表示不限制")
+	trailingLower   = flag.String("trailing-lower", "0", "移动网格价格下限, 0 表示不限制")
+	atrPeriod       = flag.Int("atr-period", 0, "自适应网格ATR计算周期(K线数量), 0 表示使用默认值")
+	atrMultiplier   = flag.String("atr-multiplier", "0", "自适应网格间距与ATR的倍数, 0 表示使用默认值")
+	adaptiveThresh  = flag.String("adaptive-threshold", "0", "自适应网格重新生成的波动率变化比例, 0 表示使用默认值")
+	initialBalance  = flag.String("balance", "10000", "初始资金")
+	makerFeeRate    = flag.String("maker-fee", "0.0002", "挂单手续费率")
+	takerFeeRate    = flag.String("taker-fee", "0.0005", "吃单手续费率")
+	takerSlippage   = flag.Int("taker-slippage", 5, "吃单成交滑点(基点)")
+	fundingRate     = flag.String("funding-rate", "0", "固定资金费率, 未指定资金费率文件时使用")
+	fundingInterval = flag.Duration("funding-interval", backtest.DefaultFundingInterval, "固定资金费率结算间隔")
+	priceDecimals   = flag.Uint("price-decimals", 2, "价格小数位数")
+	sizeDecimals    = flag.Uint("size-decimals", 4, "数量小数位数")
+	equityOutput    = flag.String("equity-out", "", "权益曲线输出文件(CSV, 可选)")
+	tradesOutput    = flag.String("trades-out", "", "配对记录输出文件(CSV, 可选)")
+	logLevel        = flag.String("log-level", "warn", "日志级别")
+	sweep           = flag.Bool("sweep", false, "参数扫描模式, 回测所有参数组合并按寻优目标排序")
+	objective       = flag.String("objective", string(backtest.ObjectiveNetProfit), "参数扫描寻优目标: net_profit/sharpe/profit_per_margin")
+	workers         = flag.Int("workers", 0, "参数扫描并发数量, 0 表示使用CPU核心数")
+	top             = flag.Int("top", 10, "参数扫描输出结果数量")
+)
+
+func mustDecimal(name, value string) decimal.Decimal {
+	d, err := decimal.NewFromString(value)
+	if err != nil {
+		logger.Fatalf("参数 -%s 格式错误, %s", name, value)
+	}
+	return d
+}
+
+func mustInt(name, value string) int {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		logger.Fatalf("参数 -%s 格式错误, %s", name, value)
+	}
+	return n
+}
+
+// splitList 解析逗号分隔的参数候选值
+func splitList[T any](name, value string, parse func(name, value string) T) []T {
+	list := make([]T, 0)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, parse(name, item))
+		}
+	}
+	return list
+}
+
+func writeFile(path string, write func(w io.Writer) error) {
+	f, err := os.Create(path)
+	if err != nil {
+		logger.Fatalf("创建输出文件失败, %s, %v", path, err)
+	}
+	defer f.Close()
+
+	if err = write(f); err != nil {
+		logger.Fatalf("写入输出文件失败, %s, %v", path, err)
+	}
+}
+
+func main() {
+	flag.Parse()
+
+	if lvl, err := logrus.ParseLevel(*logLevel); err == nil {
+		logger.SetLogLevel(lvl)
+	}
+	if *dataFile == "" || *priceLower == "" || *priceUpper == "" || *orderSize == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// 加载历史数据
+	candles, err := backtest.LoadCandles(*dataFile)
+	if err != nil {
+		logger.Fatalf("加载行情数据失败, %v", err)
+	}
+	var fundingRates []backtest.FundingRate
+	if *fundingFile != "" {
+		fundingRates, err = backtest.LoadFundingRates(*fundingFile)
+		if err != nil {
+			logger.Fatalf("加载资金费率失败, %v", err)
+		}
+	}
+
+	// 解析参数候选值
+	identity := func(name, value string) string { return value }
+	modes := splitList("mode", *mode, identity)
+	quantityModes := splitList("quantity-mode", *quantityMode, identity)
+	lowers := splitList("lower", *priceLower, mustDecimal)
+	uppers := splitList("upper", *priceUpper, mustDecimal)
+	gridNums := splitList("grids", *gridNum, mustInt)
+	leverages := splitList("leverage", *leverage, mustInt)
+	if len(modes) == 0 || len(quantityModes) == 0 || len(lowers) == 0 || len(uppers) == 0 || len(gridNums) == 0 || len(leverages) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if !*sweep && (len(modes) > 1 || len(quantityModes) > 1 || len(lowers) > 1 || len(uppers) > 1 || len(gridNums) > 1 || len(leverages) > 1) {
+		logger.Fatalf("多个参数候选值需要使用 -sweep 参数扫描模式")
+	}
+
+	c := backtest.Config{
+		Symbol:           *symbol,
+		Mode:             strategy.Mode(modes[0]),
+		QuantityMode:     strategy.QuantityMode(quantityModes[0]),
+		PriceLower:       lowers[0],
+		PriceUpper:       uppers[0],
+		GridNum:          gridNums[0],
+		InitialOrderSize: mustDecimal("size", *orderSize),
+		SizingMode:       strategy.SizingMode(*sizingMode),
+		SizingFactor:     mustDecimal("sizing-factor", *sizingFactor),
+		SizingWeights:    *sizingWeights,
+		Leverage:         leverages[0],
+		SlippageBps:      *slippageBps,
+		EntryPrice:       mustDecimal("entry", *entryPrice),
+		StopLossPrice:    mustDecimal("sl", *stopLossPrice),
+		TakeProfitPrice:  mustDecimal("tp", *takeProfitPrice),
+		InitialBalance:   mustDecimal("balance", *initialBalance),
+
+		TrailingLevels:          *trailingLevels,
+		TrailingPriceUpperLimit: mustDecimal("trailing-upper", *trailingUpper),
+		TrailingPriceLowerLimit: mustDecimal("trailing-lower", *trailingLower),
+
+		AtrPeriod:         *atrPeriod,
+		AtrMultiplier:     mustDecimal("atr-multiplier", *atrMultiplier),
+		AdaptiveThreshold: mustDecimal("adaptive-threshold", *adaptiveThresh),
+
+		Fee: paper.FeeModel{
+			MakerFeeRate: mustDecimal("maker-fee", *makerFeeRate),
+			TakerFeeRate: mustDecimal("taker-fee", *takerFeeRate),
+			SlippageBps:  *takerSlippage,
+		},
+		Metadata: exchange.MarketMetadata{
+			SupportedPriceDecimals: uint8(*priceDecimals),
+			SupportedSizeDecimals:  uint8(*sizeDecimals),
+		},
+		FundingRates:    fundingRates,
+		FundingRate:     mustDecimal("funding-rate", *fundingRate),
+		FundingInterval: *fundingInterval,
+	}
+
+	if *sweep {
+		space := backtest.SweepSpace{
+			PriceLowers:   lowers,
+			PriceUppers:   uppers,
+			GridNums:      gridNums,
+			Leverages:     leverages,
+			Modes:         lo.Map(modes, func(item string, _ int) strategy.Mode { return strategy.Mode(item) }),
+			QuantityModes: lo.Map(quantityModes, func(item string, _ int) strategy.QuantityMode { return strategy.QuantityMode(item) }),
+		}
+		runSweep(c, space, candles)
+		return
+	}
+
+	// 执行回测
+	startTime := time.Now()
+	result, err := backtest.Run(context.Background(), c, candles)
+	if err != nil {
+		logger.Fatalf("回测失败, %v", err)
+	}
+
+	// 输出回测报告
+	hundred := decimal.NewFromInt(100)
+	prices := make([]string, 0, len(result.GridPrices))
+	for _, item := range result.GridPrices {
+		prices = append(prices, item.String())
+	}
+
+	fmt.Printf("回测区间: %s ~ %s (%d 根K线, 耗时 %s)\n",
+		util.FormaTime(result.Start), util.FormaTime(result.End), len(candles), time.Since(startTime).Round(time.Millisecond))
+	fmt.Printf("网格价格: %s\n", strings.Join(prices, ", "))
+	if !result.PriceLower.Equal(result.GridPrices[0]) || !result.PriceUpper.Equal(result.GridPrices[len(result.GridPrices)-1]) {
+		fmt.Printf("移动后区间: %s ~ %s\n", result.PriceLower, result.PriceUpper)
+	}
+	if result.GridNum != len(result.GridPrices)-1 {
+		fmt.Printf("自适应网格数量: %d -> %d\n", len(result.GridPrices)-1, result.GridNum)
+	}
+	fmt.Printf("初始资金: %s\n", result.InitialBalance.StringFixed(2))
+	fmt.Printf("最终权益: %s\n", result.FinalEquity.StringFixed(2))
+	fmt.Printf("总收益率: %s%%\n", result.TotalReturn.Mul(hundred).StringFixed(2))
+	fmt.Printf("年化收益: %s%%\n", result.APR.Mul(hundred).StringFixed(2))
+	fmt.Printf("最大回撤: %s%%\n", result.MaxDrawdown.Mul(hundred).StringFixed(2))
+	fmt.Printf("网格利润: %s (%d 次配对)\n", result.GridProfit.StringFixed(4), len(result.MatchedTrades))
+	fmt.Printf("已实现盈亏: %s\n", result.RealizedPnl.StringFixed(4))
+	fmt.Printf("未实现盈亏: %s\n", result.UnrealizedPnl.StringFixed(4))
+	fmt.Printf("手续费: %s\n", result.Fees.StringFixed(4))
+	fmt.Printf("资金费用: %s\n", result.Funding.StringFixed(4))
+	fmt.Printf("成交订单: %d\n", result.FilledOrders)
+	fmt.Printf("结束持仓: %s\n", result.Position.String())
+	if result.StopReason != backtest.StopReasonNone {
+		fmt.Printf("提前停止: %s\n", result.StopReason)
+	}
+
+	if *equityOutput != "" {
+		writeFile(*equityOutput, func(w io.Writer) error {
+			return backtest.WriteEquityCurve(w, result.EquityCurve)
+		})
+	}
+	if *tradesOutput != "" {
+		writeFile(*tradesOutput, func(w io.Writer) error {
+			return backtest.WriteMatchedTrades(w, result.MatchedTrades)
+		})
+	}
+}
+
+// runSweep 执行参数扫描并输出排名
+func runSweep(c backtest.Config, space backtest.SweepSpace, candles []backtest.Candle) {
+	startTime := time.Now()
+	results, err := backtest.Sweep(context.Background(), c, space, candles, *workers, backtest.Objective(*objective))
+	if err != nil {
+		logger.Fatalf("参数扫描失败, %v", err)
+	}
+
+	hundred := decimal.NewFromInt(100)
+	fmt.Printf("参数扫描: %d 组有效参数, %d 根K线, 寻优目标 %s, 耗时 %s\n",
+		len(results), len(candles), *objective, time.Since(startTime).Round(time.Millisecond))
+	fmt.Printf("%-4s %-6s %-11s %-12s %-12s %-6s %-6s %-12s %-10s %-10s %-8s %s\n",
+		"排名", "模式", "间距", "下限", "上限", "网格", "杠杆", "净利润", "收益率", "最大回撤", "夏普", "得分")
+	for idx, item := range results[:min(*top, len(results))] {
+		fmt.Printf("%-6d %-8s %-13s %-14s %-14s %-8d %-8d %-15s %-13s %-14s %-10.2f %.4f\n",
+			idx+1,
+			item.Config.Mode,
+			item.Config.QuantityMode,
+			item.Config.PriceLower.String(),
+			item.Config.PriceUpper.String(),
+			item.Config.GridNum,
+			item.Config.Leverage,
+			item.NetProfit.StringFixed(2),
+			item.Result.TotalReturn.Mul(hundred).StringFixed(2)+"%",
+			item.Result.MaxDrawdown.Mul(hundred).StringFixed(2)+"%",
+			item.Sharpe,
+			item.Score,
+		)
+	}
+}
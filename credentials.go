@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"os"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/migrate"
+	"github.com/fachebot/omni-grid-bot/internal/model"
+	"github.com/fachebot/omni-grid-bot/internal/svc"
+	"github.com/fachebot/omni-grid-bot/internal/util"
+	"github.com/fachebot/omni-grid-bot/internal/util/secret"
+)
+
+// generateKeyFile 生成交易所凭证主密钥文件，文件已存在时返回错误
+func generateKeyFile(path string) error {
+	key, err := secret.GenerateKey()
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+
+	if _, err = f.WriteString(hex.EncodeToString(key) + "\n"); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// reencryptCredentials 使用主密钥重新加密数据库中所有策略和交易所账户的交易所凭证
+// newKeyFile 为空时使用当前主密钥加密升级前保存的明文凭证；
+// 不为空时使用新主密钥重新加密，当前主密钥只用于解密，完成后需要把配置中的主密钥替换为新主密钥
+func reencryptCredentials(masterKey []byte, newKeyFile string) (int, error) {
+	if masterKey == nil {
+		return 0, secret.ErrKeyNotConfigured
+	}
+
+	keys := [][]byte{masterKey}
+	if newKeyFile != "" {
+		newKey, err := secret.LoadKey(newKeyFile)
+		if err != nil {
+			return 0, err
+		}
+		keys = [][]byte{newKey, masterKey}
+	}
+
+	keyring, err := secret.NewKeyring(keys[0], keys[1:]...)
+	if err != nil {
+		return 0, err
+	}
+	secret.SetDefault(keyring)
+
+	ctx := context.Background()
+	client, err := ent.Open("sqlite3", svc.DataSourceName)
+	if err != nil {
+		return 0, err
+	}
+	defer client.Close()
+
+	if err = client.Schema.Create(ctx, migrate.WithDropIndex(true), migrate.WithDropColumn(true)); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	err = util.Tx(ctx, client, func(tx *ent.Tx) error {
+		m := model.NewStrategyModel(tx.Strategy)
+		records, err := m.FindAll(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, record := range records {
+			err = m.UpdateCredentials(ctx, record.ID, record.ExchangeApiKey, record.ExchangeSecretKey, record.ExchangePassphrase)
+			if err != nil {
+				return err
+			}
+		}
+		count = len(records)
+
+		accountModel := model.NewExchangeAccountModel(tx.ExchangeAccount)
+		accounts, err := accountModel.FindAll(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, account := range accounts {
+			err = accountModel.UpdateCredentials(ctx, account.ID, account.SecretKey, account.Passphrase)
+			if err != nil {
+				return err
+			}
+		}
+		count += len(accounts)
+		return nil
+	})
+	return count, err
+}
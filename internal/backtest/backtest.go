@@ -0,0 +1,600 @@
+// Package backtest 提供网格策略历史回测
+// 使用生产环境的网格生成和再平衡代码，在独立的内存数据库和撮合模拟器中回放历史行情
+package backtest
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/cache"
+	"github.com/fachebot/omni-grid-bot/internal/config"
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	entstrategy "github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/exchange/paper"
+	"github.com/fachebot/omni-grid-bot/internal/helper"
+	"github.com/fachebot/omni-grid-bot/internal/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/svc"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// DefaultFundingInterval 固定资金费率的默认结算间隔
+const DefaultFundingInterval = 8 * time.Hour
+
+// backtestAccount 回测使用的模拟账户名称
+const backtestAccount = "backtest"
+
+// maxRebalanceRounds 单个价格点最多连续再平衡的次数
+// 再平衡下出的订单可能立即成交并触发下一轮再平衡
+const maxRebalanceRounds = 100
+
+var (
+	ErrInvalidConfig  = errors.New("invalid backtest config")
+	ErrRebalanceLoop  = errors.New("rebalance did not converge")
+	daysPerYear       = decimal.NewFromInt(365)
+	databaseSequence  atomic.Int64
+	nanosecondsPerDay = decimal.NewFromInt(int64(24 * time.Hour))
+)
+
+// StopReason 策略提前停止原因
+type StopReason string
+
+const (
+	StopReasonNone       StopReason = ""            // 回放结束
+	StopReasonStopLoss   StopReason = "stop_loss"   // 触发止损价格
+	StopReasonTakeProfit StopReason = "take_profit" // 触发止盈价格
+)
+
+// Config 回测参数
+type Config struct {
+	Symbol           string                   // 交易对
+	Mode             entstrategy.Mode         // 网格模式
+	QuantityMode     entstrategy.QuantityMode // 网格间距模式
+	PriceLower       decimal.Decimal          // 网格价格下限
+	PriceUpper       decimal.Decimal          // 网格价格上限
+	GridNum          int                      // 网格数量
+	InitialOrderSize decimal.Decimal          // 每格下单数量
+	SizingMode       entstrategy.SizingMode   // 仓位曲线，为空时每格数量相同
+	SizingFactor     decimal.Decimal          // 金字塔/等比仓位曲线的加仓系数，为零时使用默认值
+	SizingWeights    string                   // 自定义仓位曲线的权重列表，以逗号分隔
+	Leverage         int                      // 杠杆倍数
+	SlippageBps      int                      // 市价单滑点容忍度(基点)
+	EntryPrice       decimal.Decimal          // 入场价格，为零时不限制
+	StopLossPrice    decimal.Decimal          // 止损触发价格，为零时不启用
+	TakeProfitPrice  decimal.Decimal          // 止盈触发价格，为零时不启用
+
+	TrailingLevels          int             // 移动网格触发档位数，为零时不启用
+	TrailingPriceUpperLimit decimal.Decimal // 移动网格价格上限，为零时不限制
+	TrailingPriceLowerLimit decimal.Decimal // 移动网格价格下限，为零时不限制
+
+	AtrPeriod         int             // 自适应网格ATR计算周期，为零时使用默认值
+	AtrMultiplier     decimal.Decimal // 自适应网格间距与ATR的倍数，为零时使用默认值
+	AdaptiveThreshold decimal.Decimal // 自适应网格重新生成的波动率变化比例，为零时使用默认值
+
+	InitialBalance  decimal.Decimal         // 初始资金
+	Fee             paper.FeeModel          // 手续费和滑点模型
+	Metadata        exchange.MarketMetadata // 市场元数据(价格和数量精度)
+	FundingRates    []FundingRate           // 历史资金费率，不为空时忽略固定资金费率
+	FundingRate     decimal.Decimal         // 固定资金费率
+	FundingInterval time.Duration           // 固定资金费率结算间隔
+}
+
+// EquityPoint 权益曲线数据点
+type EquityPoint struct {
+	Time   time.Time       // K线时间
+	Price  decimal.Decimal // 收盘价
+	Equity decimal.Decimal // 账户权益
+}
+
+// Result 回测结果
+type Result struct {
+	Start          time.Time           // 回测开始时间
+	End            time.Time           // 回测结束时间
+	GridPrices     []decimal.Decimal   // 网格价格
+	GridQuantities []decimal.Decimal   // 网格每格下单数量
+	PriceLower     decimal.Decimal     // 结束时网格价格下限(移动网格后可能变化)
+	PriceUpper     decimal.Decimal     // 结束时网格价格上限(移动网格后可能变化)
+	GridNum        int                 // 结束时网格数量(自适应网格后可能变化)
+	InitialBalance decimal.Decimal     // 初始资金
+	FinalEquity    decimal.Decimal     // 最终权益
+	TotalReturn    decimal.Decimal     // 总收益率
+	APR            decimal.Decimal     // 年化收益率(单利)
+	MaxDrawdown    decimal.Decimal     // 最大回撤比例
+	GridProfit     decimal.Decimal     // 网格配对利润(不含手续费)
+	RealizedPnl    decimal.Decimal     // 已实现盈亏(不含手续费和资金费用)
+	UnrealizedPnl  decimal.Decimal     // 未实现盈亏
+	Fees           decimal.Decimal     // 累计手续费
+	Funding        decimal.Decimal     // 累计资金费用(收取时为负数)
+	Position       decimal.Decimal     // 结束时持仓数量(空头为负数)
+	FilledOrders   int                 // 成交订单数量
+	StopReason     StopReason          // 提前停止原因
+	MatchedTrades  []*ent.MatchedTrade // 网格配对记录
+	EquityCurve    []EquityPoint       // 权益曲线
+}
+
+// runner 单次回测的运行状态
+type runner struct {
+	ctx       context.Context
+	config    Config
+	svcCtx    *svc.ServiceContext
+	simulator *paper.Simulator
+	record    *ent.Strategy
+	grid      *strategy.GridStrategy
+
+	now          time.Time
+	changed      bool
+	filledOrders int
+	orderErr     error
+
+	fundingIdx  int
+	nextFunding time.Time
+
+	peak        decimal.Decimal
+	maxDrawdown decimal.Decimal
+	curve       []EquityPoint
+	stopReason  StopReason
+}
+
+// Run 执行回测
+// 首根K线开盘价作为策略启动价格，之后每根K线按 开盘->最低/最高->收盘 的路径驱动撮合，
+// 每次成交后调用生产环境的网格再平衡逻辑
+func Run(ctx context.Context, c Config, candles []Candle) (*Result, error) {
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+	if len(candles) == 0 {
+		return nil, ErrEmptyData
+	}
+
+	// 创建独立的内存数据库
+	client, err := openDatabase(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	// 初始化网格策略
+	r := newRunner(ctx, c, client, candles)
+	prices, quantities, err := r.start(candles[0])
+	if err != nil {
+		return nil, err
+	}
+
+	// 回放历史行情
+	r.initFunding(candles[0].Time)
+	for _, candle := range candles {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		r.now = candle.Time
+		r.settleFunding(candle.Time)
+		for _, price := range pricePath(candle) {
+			r.simulator.OnPrice(c.Symbol, price)
+			if err = r.rebalance(); err != nil {
+				return nil, err
+			}
+			if err = r.checkTriggers(price); err != nil {
+				return nil, err
+			}
+			if r.stopReason != StopReasonNone {
+				break
+			}
+			if err = r.trail(price); err != nil {
+				return nil, err
+			}
+		}
+
+		if r.stopReason == StopReasonNone {
+			if err = r.adapt(candle); err != nil {
+				return nil, err
+			}
+		}
+
+		r.recordEquity(candle.Time, candle.Close)
+		if r.stopReason != StopReasonNone {
+			break
+		}
+	}
+
+	result, err := r.result(candles[0].Time)
+	if err != nil {
+		return nil, err
+	}
+	result.GridPrices = prices
+	result.GridQuantities = quantities
+	return result, nil
+}
+
+// openDatabase 创建独立的内存数据库
+func openDatabase(ctx context.Context) (*ent.Client, error) {
+	dsn := fmt.Sprintf("file:backtest-%d?mode=memory&cache=shared&_fk=1", databaseSequence.Add(1))
+	client, err := ent.Open("sqlite3", dsn)
+	if err != nil {
+		return nil, err
+	}
+	if err = client.Schema.Create(ctx); err != nil {
+		client.Close()
+		return nil, err
+	}
+	return client, nil
+}
+
+// newRunner 创建撮合模拟器和服务上下文
+func newRunner(ctx context.Context, c Config, client *ent.Client, candles []Candle) *runner {
+	r := &runner{ctx: ctx, config: c, now: candles[0].Time}
+	r.simulator = paper.NewSimulator(c.Fee, c.InitialBalance)
+	r.simulator.SetClock(func() time.Time { return r.now })
+	r.simulator.AddOrderHandler(r.onOrders)
+
+	driver := NewDriver(r.simulator, c.Metadata)
+	r.svcCtx = svc.NewIsolatedServiceContext(&config.Config{}, client, driver)
+	driver.svcCtx = r.svcCtx
+
+	// 自适应网格按行情数据的K线周期聚合K线计算波动率
+	if len(candles) > 1 && candles[1].Time.After(candles[0].Time) {
+		r.svcCtx.CandleCache = cache.NewCandleCache(candles[1].Time.Sub(candles[0].Time), svc.CandleCacheLimit)
+	}
+	return r
+}
+
+// start 创建策略记录并调用生产环境的网格初始化逻辑
+func (r *runner) start(first Candle) ([]decimal.Decimal, []decimal.Decimal, error) {
+	r.simulator.OnPrice(r.config.Symbol, first.Open)
+
+	guid, err := uuid.NewRandom()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	args := ent.Strategy{
+		GUID:               guid.String(),
+		Exchange:           ExchangeName,
+		Symbol:             r.config.Symbol,
+		Account:            backtestAccount,
+		Mode:               r.config.Mode,
+		MarginMode:         entstrategy.MarginModeCross,
+		QuantityMode:       r.config.QuantityMode,
+		PriceUpper:         r.config.PriceUpper,
+		PriceLower:         r.config.PriceLower,
+		GridNum:            r.config.GridNum,
+		Leverage:           r.config.Leverage,
+		InitialOrderSize:   r.config.InitialOrderSize,
+		SizingMode:         lo.If(r.config.SizingMode == "", entstrategy.SizingModeFixed).Else(r.config.SizingMode),
+		SlippageBps:        &r.config.SlippageBps,
+		CancelRepairPolicy: entstrategy.CancelRepairPolicyRepair,
+		ReconcilePolicy:    entstrategy.ReconcilePolicyAlert,
+		Status:             entstrategy.StatusInactive,
+		ExchangeApiKey:     backtestAccount,
+	}
+	if r.config.EntryPrice.IsPositive() {
+		args.EntryPrice = &r.config.EntryPrice
+	}
+	if r.config.StopLossPrice.IsPositive() {
+		args.TriggerStopLossPrice = &r.config.StopLossPrice
+	}
+	if r.config.TakeProfitPrice.IsPositive() {
+		args.TriggerTakeProfitPrice = &r.config.TakeProfitPrice
+	}
+	if r.config.TrailingLevels > 0 {
+		args.TrailingLevels = &r.config.TrailingLevels
+	}
+	if r.config.TrailingPriceUpperLimit.IsPositive() {
+		args.TrailingPriceUpperLimit = &r.config.TrailingPriceUpperLimit
+	}
+	if r.config.TrailingPriceLowerLimit.IsPositive() {
+		args.TrailingPriceLowerLimit = &r.config.TrailingPriceLowerLimit
+	}
+	if r.config.SizingFactor.IsPositive() {
+		args.SizingFactor = &r.config.SizingFactor
+	}
+	if r.config.SizingWeights != "" {
+		args.SizingWeights = &r.config.SizingWeights
+	}
+	if r.config.AtrPeriod > 0 {
+		args.AtrPeriod = &r.config.AtrPeriod
+	}
+	if r.config.AtrMultiplier.IsPositive() {
+		args.AtrMultiplier = &r.config.AtrMultiplier
+	}
+	if r.config.AdaptiveThreshold.IsPositive() {
+		args.AdaptiveThreshold = &r.config.AdaptiveThreshold
+	}
+
+	record, err := r.svcCtx.StrategyModel.Save(r.ctx, args)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prices, err := strategy.GenerateGridPrices(record, r.config.Metadata.SupportedPriceDecimals)
+	if err != nil {
+		return nil, nil, err
+	}
+	quantities, err := strategy.GenerateGridQuantities(record, prices, r.config.Metadata.SupportedSizeDecimals)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = strategy.ValidateGridQuantities(prices, quantities, r.config.Metadata); err != nil {
+		return nil, nil, err
+	}
+	if err = strategy.InitGridStrategy(r.ctx, r.svcCtx, record, prices, quantities); err != nil {
+		return nil, nil, err
+	}
+
+	r.record, err = r.svcCtx.StrategyModel.FindOneByGUID(r.ctx, record.GUID)
+	if err != nil {
+		return nil, nil, err
+	}
+	r.grid = strategy.NewGridStrategy(r.svcCtx, nil, r.record)
+
+	return prices, quantities, r.rebalance()
+}
+
+// onOrders 模拟器订单更新回调
+// 与实盘订阅器一致，先将订单写入数据库，再由再平衡逻辑读取
+func (r *runner) onOrders(account string, orders []*exchange.Order) {
+	for _, item := range orders {
+		err := r.svcCtx.OrderModel.Upsert(r.ctx, helper.ToPaperEntOrder(ExchangeName, account, item))
+		if err != nil && r.orderErr == nil {
+			r.orderErr = err
+		}
+
+		switch item.Status {
+		case order.StatusFilled:
+			r.filledOrders++
+			r.changed = true
+		case order.StatusCanceled:
+			r.changed = true
+		case order.StatusOpen:
+			r.changed = r.changed || item.FilledBaseAmount.IsPositive()
+		}
+	}
+}
+
+// rebalance 存在订单变化时执行网格再平衡，直到没有新的成交
+func (r *runner) rebalance() error {
+	for round := 0; r.changed; round++ {
+		if r.orderErr != nil {
+			return r.orderErr
+		}
+		if r.grid == nil || r.stopReason != StopReasonNone {
+			return nil
+		}
+		if round >= maxRebalanceRounds {
+			return ErrRebalanceLoop
+		}
+
+		r.changed = false
+		if err := r.grid.OnOrdersChanged(r.ctx); err != nil {
+			return err
+		}
+	}
+	return r.orderErr
+}
+
+// trail 调用生产环境的移动网格逻辑，远端档位存在平仓挂单时跳过
+func (r *runner) trail(price decimal.Decimal) error {
+	_, err := r.grid.Trail(r.ctx, price)
+	if err != nil && err != strategy.ErrTrailingBlocked {
+		return err
+	}
+	return r.rebalance()
+}
+
+// adapt 将K线写入行情聚合缓存，并调用生产环境的自适应网格逻辑，存在未处理的订单时跳过
+func (r *runner) adapt(candle Candle) error {
+	if r.record.QuantityMode != entstrategy.QuantityModeAdaptive {
+		return nil
+	}
+
+	for _, price := range pricePath(candle) {
+		r.svcCtx.CandleCache.Add(ExchangeName, r.config.Symbol, price, candle.Time)
+	}
+
+	_, err := r.grid.Adapt(r.ctx, candle.Close, candle.Time)
+	if err != nil && err != strategy.ErrRegridBlocked && err != strategy.ErrInsufficientCandles {
+		return err
+	}
+	return r.rebalance()
+}
+
+// checkTriggers 检查止损止盈价格，触发时撤销挂单并平仓
+// 触发条件与 GridStrategy.OnTicker 一致，回测保留成交记录用于统计
+func (r *runner) checkTriggers(price decimal.Decimal) error {
+	stopLoss := lo.FromPtrOr(r.record.TriggerStopLossPrice, decimal.Zero)
+	takeProfit := lo.FromPtrOr(r.record.TriggerTakeProfitPrice, decimal.Zero)
+
+	switch r.record.Mode {
+	case entstrategy.ModeLong:
+		if stopLoss.IsPositive() && price.LessThanOrEqual(stopLoss) {
+			r.stopReason = StopReasonStopLoss
+		} else if takeProfit.IsPositive() && price.GreaterThanOrEqual(takeProfit) {
+			r.stopReason = StopReasonTakeProfit
+		}
+	case entstrategy.ModeShort:
+		if stopLoss.IsPositive() && price.GreaterThanOrEqual(stopLoss) {
+			r.stopReason = StopReasonStopLoss
+		} else if takeProfit.IsPositive() && price.LessThanOrEqual(takeProfit) {
+			r.stopReason = StopReasonTakeProfit
+		}
+	case entstrategy.ModeNeutral:
+		if stopLoss.IsPositive() && strategy.NeutralTriggerReached(r.record, stopLoss, price) {
+			r.stopReason = StopReasonStopLoss
+		} else if takeProfit.IsPositive() && strategy.NeutralTriggerReached(r.record, takeProfit, price) {
+			r.stopReason = StopReasonTakeProfit
+		}
+	}
+	if r.stopReason == StopReasonNone {
+		return nil
+	}
+
+	adapter, err := helper.NewExchangeAdapterFromStrategy(r.svcCtx, r.record)
+	if err != nil {
+		return err
+	}
+	if err = adapter.CancalAllOrders(r.ctx, r.record.Symbol); err != nil {
+		return err
+	}
+
+	for _, side := range helper.PositionSides(r.record) {
+		if err = adapter.ClosePosition(r.ctx, r.record.Symbol, side, r.config.SlippageBps); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// initFunding 初始化资金费用结算进度
+func (r *runner) initFunding(start time.Time) {
+	for r.fundingIdx < len(r.config.FundingRates) && !r.config.FundingRates[r.fundingIdx].Time.After(start) {
+		r.fundingIdx++
+	}
+
+	interval := cmp.Or(r.config.FundingInterval, DefaultFundingInterval)
+	r.nextFunding = start.Truncate(interval).Add(interval)
+}
+
+// settleFunding 结算截止到指定时间的资金费用
+func (r *runner) settleFunding(t time.Time) {
+	if len(r.config.FundingRates) > 0 {
+		for r.fundingIdx < len(r.config.FundingRates) && !r.config.FundingRates[r.fundingIdx].Time.After(t) {
+			r.simulator.ApplyFunding(backtestAccount, r.config.Symbol, r.config.FundingRates[r.fundingIdx].Rate)
+			r.fundingIdx++
+		}
+		return
+	}
+
+	if r.config.FundingRate.IsZero() {
+		return
+	}
+	interval := cmp.Or(r.config.FundingInterval, DefaultFundingInterval)
+	for !t.Before(r.nextFunding) {
+		r.simulator.ApplyFunding(backtestAccount, r.config.Symbol, r.config.FundingRate)
+		r.nextFunding = r.nextFunding.Add(interval)
+	}
+}
+
+// recordEquity 记录权益曲线并更新最大回撤
+func (r *runner) recordEquity(t time.Time, price decimal.Decimal) {
+	equity := r.simulator.Account(backtestAccount).TotalAssetValue
+	r.curve = append(r.curve, EquityPoint{Time: t, Price: price, Equity: equity})
+
+	if equity.GreaterThan(r.peak) {
+		r.peak = equity
+	}
+	if r.peak.IsPositive() {
+		drawdown := r.peak.Sub(equity).Div(r.peak)
+		if drawdown.GreaterThan(r.maxDrawdown) {
+			r.maxDrawdown = drawdown
+		}
+	}
+}
+
+// result 汇总回测结果
+func (r *runner) result(start time.Time) (*Result, error) {
+	gridProfit, err := r.svcCtx.MatchedTradeModel.QueryTotalProfit(r.ctx, r.record.GUID)
+	if err != nil {
+		return nil, err
+	}
+	trades, _, err := r.svcCtx.MatchedTradeModel.FinAllMatchedTrades(r.ctx, r.record.GUID, 0, math.MaxInt32)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(trades, func(a, b *ent.MatchedTrade) int {
+		return cmp.Compare(matchedTime(a), matchedTime(b))
+	})
+
+	account := r.simulator.Account(backtestAccount)
+	summary := r.simulator.Summary(backtestAccount, r.config.Symbol)
+	unrealizedPnl := decimal.Zero
+	for _, item := range account.Positions {
+		unrealizedPnl = unrealizedPnl.Add(item.UnrealizedPnl)
+	}
+
+	end := start
+	if len(r.curve) > 0 {
+		end = r.curve[len(r.curve)-1].Time
+	}
+
+	totalReturn := account.TotalAssetValue.Sub(r.config.InitialBalance).Div(r.config.InitialBalance)
+	apr := decimal.Zero
+	if duration := end.Sub(start); duration > 0 {
+		days := decimal.NewFromInt(int64(duration)).Div(nanosecondsPerDay)
+		apr = totalReturn.Mul(daysPerYear).Div(days)
+	}
+
+	return &Result{
+		Start:          start,
+		End:            end,
+		PriceLower:     r.record.PriceLower,
+		PriceUpper:     r.record.PriceUpper,
+		GridNum:        r.record.GridNum,
+		InitialBalance: r.config.InitialBalance,
+		FinalEquity:    account.TotalAssetValue,
+		TotalReturn:    totalReturn,
+		APR:            apr,
+		MaxDrawdown:    r.maxDrawdown,
+		GridProfit:     gridProfit,
+		RealizedPnl:    summary.RealizedPnl,
+		UnrealizedPnl:  unrealizedPnl,
+		Fees:           summary.FeePaid,
+		Funding:        summary.FundingPaid,
+		Position:       summary.Size,
+		FilledOrders:   r.filledOrders,
+		StopReason:     r.stopReason,
+		MatchedTrades:  trades,
+		EquityCurve:    r.curve,
+	}, nil
+}
+
+// validate 校验回测参数
+func (c *Config) validate() error {
+	switch {
+	case c.Symbol == "":
+		return fmt.Errorf("%w: symbol is required", ErrInvalidConfig)
+	case entstrategy.ModeValidator(c.Mode) != nil:
+		return fmt.Errorf("%w: invalid mode %q", ErrInvalidConfig, c.Mode)
+	case entstrategy.QuantityModeValidator(c.QuantityMode) != nil:
+		return fmt.Errorf("%w: invalid quantity mode %q", ErrInvalidConfig, c.QuantityMode)
+	case c.SizingMode != "" && entstrategy.SizingModeValidator(c.SizingMode) != nil:
+		return fmt.Errorf("%w: invalid sizing mode %q", ErrInvalidConfig, c.SizingMode)
+	case !c.PriceLower.IsPositive() || c.PriceLower.GreaterThanOrEqual(c.PriceUpper):
+		return fmt.Errorf("%w: invalid price range", ErrInvalidConfig)
+	case c.GridNum <= 0:
+		return fmt.Errorf("%w: gridNum must be positive", ErrInvalidConfig)
+	case !c.InitialOrderSize.IsPositive():
+		return fmt.Errorf("%w: order size must be positive", ErrInvalidConfig)
+	case c.Leverage < 1:
+		return fmt.Errorf("%w: leverage must be at least 1", ErrInvalidConfig)
+	case !c.InitialBalance.IsPositive():
+		return fmt.Errorf("%w: initial balance must be positive", ErrInvalidConfig)
+	}
+	return nil
+}
+
+// pricePath K线内部的价格路径
+// 阳线按 开盘->最低->最高->收盘，阴线按 开盘->最高->最低->收盘
+func pricePath(c Candle) []decimal.Decimal {
+	path := []decimal.Decimal{c.Open, c.High, c.Low, c.Close}
+	if c.Close.GreaterThanOrEqual(c.Open) {
+		path = []decimal.Decimal{c.Open, c.Low, c.High, c.Close}
+	}
+
+	return slices.CompactFunc(path, func(a, b decimal.Decimal) bool {
+		return a.Equal(b)
+	})
+}
+
+// matchedTime 配对完成时间
+func matchedTime(item *ent.MatchedTrade) int64 {
+	return max(lo.FromPtr(item.BuyOrderTimestamp), lo.FromPtr(item.SellOrderTimestamp))
+}
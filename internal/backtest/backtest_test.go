@@ -0,0 +1,630 @@
+package backtest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/ent/orderrepair"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/exchange/paper"
+	gridstrategy "github.com/fachebot/omni-grid-bot/internal/strategy"
+	"github.com/parquet-go/parquet-go"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+func d(s string) decimal.Decimal {
+	return decimal.RequireFromString(s)
+}
+
+func testConfig() Config {
+	return Config{
+		Symbol:           "BTC",
+		Mode:             strategy.ModeLong,
+		QuantityMode:     strategy.QuantityModeArithmetic,
+		PriceLower:       d("90"),
+		PriceUpper:       d("110"),
+		GridNum:          10,
+		InitialOrderSize: d("1"),
+		Leverage:         1,
+		SlippageBps:      50,
+		InitialBalance:   d("10000"),
+		Fee: paper.FeeModel{
+			MakerFeeRate: d("0.0002"),
+			TakerFeeRate: d("0.0005"),
+		},
+		Metadata: exchange.MarketMetadata{SupportedPriceDecimals: 2, SupportedSizeDecimals: 4},
+	}
+}
+
+// oscillatingCandles 生成在 low 和 high 之间往复的K线
+func oscillatingCandles(n int, low, high string) []Candle {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	candles := make([]Candle, 0, n)
+	for i := 0; i < n; i++ {
+		open, close := d(high), d(low)
+		if i%2 == 1 {
+			open, close = d(low), d(high)
+		}
+		candles = append(candles, Candle{
+			Time:  start.Add(time.Duration(i) * time.Hour),
+			Open:  open,
+			High:  d(high),
+			Low:   d(low),
+			Close: close,
+		})
+	}
+	return candles
+}
+
+func TestRunOscillatingMarket(t *testing.T) {
+	candles := append([]Candle{{
+		Time: time.Date(2024, 12, 31, 23, 0, 0, 0, time.UTC),
+		Open: d("100"), High: d("100"), Low: d("100"), Close: d("100"),
+	}}, oscillatingCandles(48, "95", "105")...)
+
+	result, err := Run(context.Background(), testConfig(), candles)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if len(result.GridPrices) != 11 || !result.GridPrices[0].Equal(d("90")) || !result.GridPrices[10].Equal(d("110")) {
+		t.Errorf("GridPrices = %v", result.GridPrices)
+	}
+	if len(result.EquityCurve) != len(candles) {
+		t.Errorf("EquityCurve = %d, expected %d", len(result.EquityCurve), len(candles))
+	}
+
+	// 每次 95 -> 105 的往复完成 95~105 之间的网格配对
+	if len(result.MatchedTrades) < 40 {
+		t.Errorf("MatchedTrades = %d, expected at least 40", len(result.MatchedTrades))
+	}
+	if !result.GridProfit.IsPositive() || !result.Fees.IsPositive() {
+		t.Errorf("GridProfit = %s, Fees = %s", result.GridProfit, result.Fees)
+	}
+	if !result.FinalEquity.Equal(result.EquityCurve[len(result.EquityCurve)-1].Equity) {
+		t.Errorf("FinalEquity = %s, expected last equity point", result.FinalEquity)
+	}
+	if result.MaxDrawdown.IsNegative() || result.MaxDrawdown.GreaterThan(d("1")) {
+		t.Errorf("MaxDrawdown = %s", result.MaxDrawdown)
+	}
+	if result.StopReason != StopReasonNone {
+		t.Errorf("StopReason = %s", result.StopReason)
+	}
+}
+
+func TestRunNeutralMarket(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	candles := []Candle{{Time: start, Open: d("100"), High: d("100"), Low: d("100"), Close: d("100")}}
+	for i, price := range []string{"106", "100", "94", "100", "106", "100"} {
+		prev := candles[len(candles)-1].Close
+		candles = append(candles, Candle{
+			Time:  start.Add(time.Duration(i+1) * time.Hour),
+			Open:  prev,
+			High:  decimal.Max(prev, d(price)),
+			Low:   decimal.Min(prev, d(price)),
+			Close: d(price),
+		})
+	}
+
+	c := testConfig()
+	c.Mode = strategy.ModeNeutral
+	result, err := Run(context.Background(), c, candles)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	// 价格上涨时卖出开空、下跌时买入开多，两个方向都应完成配对
+	longs, shorts := 0, 0
+	for _, trade := range result.MatchedTrades {
+		if trade.BuyOrderTimestamp == nil || trade.SellOrderTimestamp == nil {
+			continue
+		}
+		if *trade.BuyOrderTimestamp <= *trade.SellOrderTimestamp {
+			longs++
+		} else {
+			shorts++
+		}
+	}
+	if longs == 0 || shorts == 0 {
+		t.Errorf("MatchedTrades long = %d, short = %d, expected both directions", longs, shorts)
+	}
+	if !result.GridProfit.IsPositive() {
+		t.Errorf("GridProfit = %s", result.GridProfit)
+	}
+	if !result.Position.IsZero() {
+		t.Errorf("Position = %s, expected flat after returning to the initial price", result.Position)
+	}
+}
+
+func TestRunTrailingGrid(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	candles := []Candle{{Time: start, Open: d("100"), High: d("100"), Low: d("100"), Close: d("100")}}
+	for i := 1; i <= 13; i++ {
+		price := decimal.NewFromInt(int64(100 + 2*i))
+		candles = append(candles, Candle{
+			Time:  start.Add(time.Duration(i) * time.Hour),
+			Open:  price,
+			High:  price,
+			Low:   price,
+			Close: price,
+		})
+	}
+
+	testCases := []struct {
+		name       string
+		upperLimit string
+		lower      string
+		upper      string
+	}{
+		{name: "不限制", upperLimit: "0", lower: "106", upper: "126"},
+		{name: "移动上限", upperLimit: "120", lower: "100", upper: "120"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := testConfig()
+			c.TrailingLevels = 2
+			c.TrailingPriceUpperLimit = d(tc.upperLimit)
+			result, err := Run(context.Background(), c, candles)
+			if err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+
+			if !result.PriceLower.Equal(d(tc.lower)) || !result.PriceUpper.Equal(d(tc.upper)) {
+				t.Errorf("PriceRange = %s~%s, expected %s~%s", result.PriceLower, result.PriceUpper, tc.lower, tc.upper)
+			}
+			if !result.Position.IsZero() {
+				t.Errorf("Position = %s, expected flat after price exits the upper bound", result.Position)
+			}
+			if !result.GridProfit.IsPositive() {
+				t.Errorf("GridProfit = %s", result.GridProfit)
+			}
+		})
+	}
+}
+
+func TestRunStopLossAndFunding(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	candles := []Candle{
+		{Time: start, Open: d("100"), High: d("100"), Low: d("100"), Close: d("100")},
+		{Time: start.Add(8 * time.Hour), Open: d("100"), High: d("100"), Low: d("96"), Close: d("96")},
+		{Time: start.Add(16 * time.Hour), Open: d("96"), High: d("96"), Low: d("96"), Close: d("96")},
+		{Time: start.Add(24 * time.Hour), Open: d("96"), High: d("96"), Low: d("85"), Close: d("85")},
+		{Time: start.Add(32 * time.Hour), Open: d("85"), High: d("120"), Low: d("85"), Close: d("120")},
+	}
+
+	c := testConfig()
+	c.StopLossPrice = d("88")
+	c.FundingRate = d("0.0001")
+
+	result, err := Run(context.Background(), c, candles)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if result.StopReason != StopReasonStopLoss {
+		t.Errorf("StopReason = %q, expected stop_loss", result.StopReason)
+	}
+	if !result.Position.IsZero() {
+		t.Errorf("Position = %s, expected closed", result.Position)
+	}
+	if len(result.EquityCurve) != 4 {
+		t.Errorf("EquityCurve = %d, expected to stop at the 4th candle", len(result.EquityCurve))
+	}
+	if !result.Funding.IsPositive() {
+		t.Errorf("Funding = %s, expected long position to pay funding", result.Funding)
+	}
+	if !result.FinalEquity.LessThan(c.InitialBalance) {
+		t.Errorf("FinalEquity = %s, expected loss after stop loss", result.FinalEquity)
+	}
+}
+
+func TestRunInvalidConfig(t *testing.T) {
+	c := testConfig()
+	c.PriceUpper = d("80")
+	if _, err := Run(context.Background(), c, oscillatingCandles(2, "95", "105")); err == nil {
+		t.Error("Run() expected error for invalid price range")
+	}
+}
+
+func TestSweep(t *testing.T) {
+	candles := oscillatingCandles(48, "95", "105")
+	space := SweepSpace{
+		PriceLowers: []decimal.Decimal{d("90"), d("96"), d("104")},
+		PriceUppers: []decimal.Decimal{d("104"), d("110")},
+		GridNums:    []int{5, 10},
+	}
+
+	testCases := []Objective{ObjectiveNetProfit, ObjectiveSharpe, ObjectiveProfitPerMargin}
+	for _, objective := range testCases {
+		t.Run(string(objective), func(t *testing.T) {
+			results, err := Sweep(context.Background(), testConfig(), space, candles, 4, objective)
+			if err != nil {
+				t.Fatalf("Sweep() error = %v", err)
+			}
+
+			// 下限 104 只能与上限 110 组合
+			if len(results) != 10 {
+				t.Fatalf("Sweep() = %d results, expected 10", len(results))
+			}
+			for idx := 1; idx < len(results); idx++ {
+				if results[idx].Score > results[idx-1].Score {
+					t.Errorf("results[%d].Score = %v > results[%d].Score = %v", idx, results[idx].Score, idx-1, results[idx-1].Score)
+				}
+			}
+			if !results[0].Margin.IsPositive() || results[0].Result == nil {
+				t.Errorf("results[0] = %+v", results[0])
+			}
+		})
+	}
+
+	if _, err := Sweep(context.Background(), testConfig(), space, candles, 1, "apr"); err != ErrInvalidObjective {
+		t.Errorf("Sweep() error = %v, expected ErrInvalidObjective", err)
+	}
+}
+
+func TestSuggestPriceRanges(t *testing.T) {
+	lowers, uppers := SuggestPriceRanges(oscillatingCandles(4, "95", "105"), 0)
+	if len(lowers) != 3 || !lowers[0].Equal(d("94")) || !lowers[2].Equal(d("97")) {
+		t.Errorf("lowers = %v", lowers)
+	}
+	if len(uppers) != 3 || !uppers[0].Equal(d("103")) || !uppers[2].Equal(d("106")) {
+		t.Errorf("uppers = %v", uppers)
+	}
+}
+
+func TestLoadCandles(t *testing.T) {
+	dir := t.TempDir()
+
+	testCases := []struct {
+		name     string
+		file     string
+		content  string
+		expected []string // 每根K线的 开盘,最高,最低,收盘
+	}{
+		{
+			name:     "K线CSV",
+			file:     "ohlcv.csv",
+			content:  "timestamp,open,high,low,close,volume\n1735693260000,101,103,100,102,5\n1735693200000,100,102,99,101,3\n",
+			expected: []string{"100,102,99,101", "101,103,100,102"},
+		},
+		{
+			name:     "逐笔成交CSV",
+			file:     "trades.csv",
+			content:  "time,price,size\n2025-01-01T00:00:00Z,100.5,0.1\n2025-01-01T00:00:01Z,100.7,0.2\n",
+			expected: []string{"100.5,100.5,100.5,100.5", "100.7,100.7,100.7,100.7"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(dir, tc.file)
+			if err := os.WriteFile(path, []byte(tc.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			candles, err := LoadCandles(path)
+			if err != nil {
+				t.Fatalf("LoadCandles() error = %v", err)
+			}
+			if len(candles) != len(tc.expected) {
+				t.Fatalf("LoadCandles() = %d candles, expected %d", len(candles), len(tc.expected))
+			}
+			for idx, c := range candles {
+				got := strings.Join([]string{c.Open.String(), c.High.String(), c.Low.String(), c.Close.String()}, ",")
+				if got != tc.expected[idx] {
+					t.Errorf("candle %d = %s, expected %s", idx, got, tc.expected[idx])
+				}
+			}
+		})
+	}
+
+	if _, err := LoadCandles(filepath.Join(dir, "data.json")); err != ErrUnsupportedFormat {
+		t.Errorf("LoadCandles() error = %v, expected ErrUnsupportedFormat", err)
+	}
+}
+
+func TestLoadParquet(t *testing.T) {
+	type row struct {
+		Timestamp int64   `parquet:"timestamp"`
+		Open      float64 `parquet:"open"`
+		High      float64 `parquet:"high"`
+		Low       float64 `parquet:"low"`
+		Close     float64 `parquet:"close"`
+	}
+
+	var buf bytes.Buffer
+	rows := []row{
+		{Timestamp: 1735693200000, Open: 100.25, High: 102.5, Low: 99.125, Close: 101.75},
+		{Timestamp: 1735693260000, Open: 101.75, High: 103, Low: 100, Close: 102},
+	}
+	if err := parquet.Write(&buf, rows); err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "ohlcv.parquet")
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	candles, err := LoadCandles(path)
+	if err != nil {
+		t.Fatalf("LoadCandles() error = %v", err)
+	}
+	if len(candles) != 2 || !candles[0].Low.Equal(d("99.125")) || candles[1].Time.UnixMilli() != 1735693260000 {
+		t.Errorf("LoadCandles() = %+v", candles)
+	}
+}
+
+func TestRunAdaptiveGrid(t *testing.T) {
+	// 低波动行情下ATR为1，网格间距收窄后网格数量增加，已有持仓的平仓订单重新挂到新网格
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	candles := make([]Candle, 0)
+	for i := 0; i < 8; i++ {
+		candles = append(candles, Candle{
+			Time: start.Add(time.Duration(i) * time.Hour),
+			Open: d("100"), High: d("100.5"), Low: d("99.5"), Close: d("100"),
+		})
+	}
+	candles = append(candles, Candle{
+		Time: start.Add(8 * time.Hour),
+		Open: d("100"), High: d("111"), Low: d("100"), Close: d("111"),
+	})
+
+	c := testConfig()
+	c.QuantityMode = strategy.QuantityModeAdaptive
+	c.AtrPeriod = 3
+	result, err := Run(context.Background(), c, candles)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	if len(result.GridPrices) != 11 {
+		t.Errorf("GridPrices = %v, expected to start with configured gridNum", result.GridPrices)
+	}
+	if result.GridNum != 20 {
+		t.Errorf("GridNum = %d, expected 20", result.GridNum)
+	}
+	if !result.Position.IsZero() {
+		t.Errorf("Position = %s, expected closing orders to be re-placed and filled", result.Position)
+	}
+	if len(result.MatchedTrades) != 5 {
+		t.Errorf("MatchedTrades = %d, expected 5", len(result.MatchedTrades))
+	}
+}
+
+func TestRunSizingModes(t *testing.T) {
+	candles := []Candle{{
+		Time: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		Open: d("110"), High: d("110"), Low: d("110"), Close: d("110"),
+	}}
+
+	testCases := []struct {
+		name    string
+		mode    strategy.SizingMode
+		factor  string
+		weights string
+		lowest  string // 最低档位(距离锚定价格最远)的下单数量
+	}{
+		{name: "固定", mode: strategy.SizingModeFixed, factor: "0", lowest: "1"},
+		{name: "金字塔", mode: strategy.SizingModePyramid, factor: "0.5", lowest: "5.5"},
+		{name: "等比", mode: strategy.SizingModeGeometric, factor: "2", lowest: "512"},
+		{name: "等额", mode: strategy.SizingModeEqualNotional, factor: "0", lowest: "1.2"},
+		{name: "自定义", mode: strategy.SizingModeCustom, factor: "0", weights: "1,2,3", lowest: "3"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := testConfig()
+			c.InitialBalance = d("1000000")
+			c.SizingMode = tc.mode
+			c.SizingFactor = d(tc.factor)
+			c.SizingWeights = tc.weights
+			result, err := Run(context.Background(), c, candles)
+			if err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+
+			if len(result.GridQuantities) != len(result.GridPrices) {
+				t.Fatalf("GridQuantities = %v", result.GridQuantities)
+			}
+			if !result.GridQuantities[0].Equal(d(tc.lowest)) {
+				t.Errorf("GridQuantities[0] = %s, expected %s", result.GridQuantities[0], tc.lowest)
+			}
+			if !result.GridQuantities[9].Equal(d("1")) {
+				t.Errorf("GridQuantities[9] = %s, expected the level nearest to the anchor to keep the base size", result.GridQuantities[9])
+			}
+		})
+	}
+}
+
+func TestRunSizingBelowMinimum(t *testing.T) {
+	c := testConfig()
+	c.SizingMode = strategy.SizingModeCustom
+	c.SizingWeights = "1,0.5"
+	c.Metadata.MinBaseAmount = d("0.8")
+
+	candles := oscillatingCandles(2, "95", "105")
+	if _, err := Run(context.Background(), c, candles); err == nil {
+		t.Fatal("Run() expected error for sizes below the minimum base amount")
+	}
+}
+
+func TestRepairCanceledOrders(t *testing.T) {
+	ctx := context.Background()
+	client, err := openDatabase(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	candles := oscillatingCandles(2, "99", "101")
+	r := newRunner(ctx, testConfig(), client, candles)
+	if _, _, err = r.start(candles[0]); err != nil {
+		t.Fatal(err)
+	}
+	maxAttempts := 2
+	r.record.CancelRepairMaxAttempts = &maxAttempts
+
+	// cancelBuy 在模拟器中撤销最低档位的买单并执行再平衡，买单已被取消时直接再平衡
+	cancelBuy := func() (*ent.Grid, error) {
+		grids, err := r.svcCtx.GridModel.FindAllByStrategyIdOrderAsc(ctx, r.record.GUID)
+		if err != nil || grids[0].BuyClientOrderId == nil {
+			t.Fatalf("最低档位没有买单, %v", err)
+		}
+		for _, item := range r.simulator.Orders(backtestAccount) {
+			if item.ClientOrderID == *grids[0].BuyClientOrderId && item.Status == order.StatusOpen {
+				r.simulator.CancelOrders(backtestAccount, r.config.Symbol, []string{item.OrderID})
+			}
+		}
+
+		r.changed = true
+		rebalanceErr := r.rebalance()
+		grids, err = r.svcCtx.GridModel.FindAllByStrategyIdOrderAsc(ctx, r.record.GUID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return grids[0], rebalanceErr
+	}
+
+	// 重新挂单直到超过最大修复次数
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		level, err := cancelBuy()
+		if err != nil {
+			t.Fatalf("第%d次修复失败, %v", attempt, err)
+		}
+		if level.BuyClientOrderId == nil {
+			t.Fatalf("第%d次修复没有重新挂单", attempt)
+		}
+	}
+	if _, err = cancelBuy(); !errors.Is(err, gridstrategy.ErrOrderCanceled) {
+		t.Fatalf("超过最大修复次数后应停止策略, got %v", err)
+	}
+
+	records, err := client.OrderRepair.Query().Order(ent.Asc(orderrepair.FieldID)).All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != maxAttempts+1 {
+		t.Fatalf("修复记录数量 = %d, want %d", len(records), maxAttempts+1)
+	}
+	for idx, item := range records[:maxAttempts] {
+		if item.Action != orderrepair.ActionRepair || item.Attempt != idx+1 || item.NewClientOrderId == nil || !item.Price.Equal(d("90")) {
+			t.Fatalf("第%d条修复记录错误: %+v", idx+1, item)
+		}
+	}
+	if last := records[maxAttempts]; last.Action != orderrepair.ActionStop || last.Attempt != maxAttempts {
+		t.Fatalf("停止记录错误: %+v", last)
+	}
+
+	// 跳过档位时清空挂单，策略继续运行
+	r.record.CancelRepairPolicy = strategy.CancelRepairPolicySkip
+	level, err := cancelBuy()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if level.BuyClientOrderId != nil {
+		t.Fatalf("跳过档位后不应存在挂单: %s", *level.BuyClientOrderId)
+	}
+}
+
+func TestPartialFillRebalance(t *testing.T) {
+	ctx := context.Background()
+	client, err := openDatabase(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	candles := oscillatingCandles(2, "99", "101")
+	r := newRunner(ctx, testConfig(), client, candles)
+	if _, _, err = r.start(candles[0]); err != nil {
+		t.Fatal(err)
+	}
+	threshold := d("0.5")
+	r.record.PartialFillThreshold = &threshold
+
+	// partialFill 在模拟器中部分成交指定档位的挂单并执行再平衡，返回再平衡后的网格
+	partialFill := func(clientOrderId string, size decimal.Decimal) []*ent.Grid {
+		for _, item := range r.simulator.OpenOrders(backtestAccount, r.config.Symbol) {
+			if item.ClientOrderID == clientOrderId {
+				if _, err := r.simulator.PartialFill(backtestAccount, item.OrderID, size); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+		if err := r.rebalance(); err != nil {
+			t.Fatal(err)
+		}
+		grids, err := r.svcCtx.GridModel.FindAllByStrategyIdOrderAsc(ctx, r.record.GUID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return grids
+	}
+
+	// 成交比例低于阈值时保持挂单
+	grids, err := r.svcCtx.GridModel.FindAllByStrategyIdOrderAsc(ctx, r.record.GUID)
+	if err != nil || grids[5].BuyClientOrderId == nil || grids[6].SellClientOrderId != nil {
+		t.Fatalf("初始网格状态错误, %v", err)
+	}
+	buyOrderId := *grids[5].BuyClientOrderId
+	grids = partialFill(buyOrderId, d("0.3"))
+	if grids[5].BuyClientOrderId == nil || *grids[5].BuyClientOrderId != buyOrderId || grids[6].SellClientOrderId != nil {
+		t.Fatal("成交比例低于阈值时不应处理部分成交")
+	}
+
+	// 达到阈值后撤销剩余数量，并按已成交数量挂出平仓卖单
+	grids = partialFill(buyOrderId, d("0.3"))
+	if grids[5].BuyClientOrderId != nil || grids[6].SellClientOrderId == nil {
+		t.Fatal("达到阈值后应撤销买单并挂出卖单")
+	}
+	sellOrderId := *grids[6].SellClientOrderId
+	sellOrders := lo.Filter(r.simulator.OpenOrders(backtestAccount, r.config.Symbol), func(item *exchange.Order, _ int) bool {
+		return item.ClientOrderID == sellOrderId
+	})
+	if len(sellOrders) != 1 || !sellOrders[0].BaseAmount.Equal(d("0.6")) {
+		t.Fatalf("平仓卖单数量错误: %+v", sellOrders)
+	}
+
+	// 部分成交的平仓卖单被取消后按剩余数量重新挂单，已成交部分计入匹配交易
+	partialFill(sellOrderId, d("0.2"))
+	r.simulator.CancelOrders(backtestAccount, r.config.Symbol, []string{sellOrders[0].OrderID})
+	if err = r.rebalance(); err != nil {
+		t.Fatal(err)
+	}
+	grids, err = r.svcCtx.GridModel.FindAllByStrategyIdOrderAsc(ctx, r.record.GUID)
+	if err != nil || grids[6].SellClientOrderId == nil || *grids[6].SellClientOrderId == sellOrderId {
+		t.Fatalf("平仓卖单没有重新挂单, %v", err)
+	}
+	trade, err := r.svcCtx.MatchedTradeModel.FindByBuyClientOrderId(ctx, r.record.GUID, buyOrderId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !trade.BuyBaseAmount.Equal(d("0.6")) || trade.SellPartialBaseAmount == nil || !trade.SellPartialBaseAmount.Equal(d("0.2")) {
+		t.Fatalf("匹配交易部分成交数量错误: %+v", trade)
+	}
+
+	// 剩余数量成交后完成配对，利润按全部卖出金额计算
+	grids = partialFill(*grids[6].SellClientOrderId, d("0.4"))
+	if grids[5].BuyClientOrderId == nil {
+		t.Fatal("平仓卖单成交后应重新挂出买单")
+	}
+	trade, err = r.svcCtx.MatchedTradeModel.FindByBuyClientOrderId(ctx, r.record.GUID, buyOrderId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !trade.SellBaseAmount.Equal(d("0.6")) || trade.Profit == nil || !decimal.NewFromFloat(*trade.Profit).Equal(d("1.2")) {
+		t.Fatalf("匹配交易配对错误: %+v", trade)
+	}
+}
@@ -0,0 +1,277 @@
+package backtest
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/parquet-go/parquet-go"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported data file format")
+	ErrMissingColumn     = errors.New("missing required column")
+	ErrEmptyData         = errors.New("empty data file")
+)
+
+// 列名别名，匹配时忽略大小写
+var (
+	timeColumns  = []string{"timestamp", "time", "ts", "date", "datetime", "open_time"}
+	openColumns  = []string{"open", "o"}
+	highColumns  = []string{"high", "h"}
+	lowColumns   = []string{"low", "l"}
+	closeColumns = []string{"close", "c"}
+	volColumns   = []string{"volume", "vol", "v"}
+	priceColumns = []string{"price", "px"}
+	sizeColumns  = []string{"size", "qty", "amount", "sz"}
+	rateColumns  = []string{"rate", "funding_rate", "fundingrate"}
+)
+
+// Candle K线数据
+// 与交易所驱动返回的K线格式一致，逐笔成交数据按每笔成交一根K线加载，开高低收均为成交价格
+type Candle = exchange.Candle
+
+// FundingRate 资金费率
+type FundingRate struct {
+	Time time.Time       // 结算时间
+	Rate decimal.Decimal // 资金费率
+}
+
+// LoadCandles 从 CSV 或 Parquet 文件加载行情数据
+// 包含 open/high/low/close 列时按K线数据加载，包含 price 列时按逐笔成交数据加载，结果按时间升序排列
+func LoadCandles(path string) ([]Candle, error) {
+	columns, rows, err := readTable(path)
+	if err != nil {
+		return nil, err
+	}
+
+	timeIdx := findColumn(columns, timeColumns)
+	if timeIdx < 0 {
+		return nil, fmt.Errorf("%w: timestamp", ErrMissingColumn)
+	}
+
+	openIdx, highIdx := findColumn(columns, openColumns), findColumn(columns, highColumns)
+	lowIdx, closeIdx := findColumn(columns, lowColumns), findColumn(columns, closeColumns)
+	priceIdx := findColumn(columns, priceColumns)
+
+	candles := make([]Candle, 0, len(rows))
+	switch {
+	case openIdx >= 0 && highIdx >= 0 && lowIdx >= 0 && closeIdx >= 0:
+		volIdx := findColumn(columns, volColumns)
+		for n, row := range rows {
+			var c Candle
+			if c.Time, err = parseTime(row[timeIdx]); err != nil {
+				return nil, fmt.Errorf("row %d: %w", n+1, err)
+			}
+			values := make([]decimal.Decimal, 4)
+			for i, idx := range []int{openIdx, highIdx, lowIdx, closeIdx} {
+				if values[i], err = decimal.NewFromString(row[idx]); err != nil {
+					return nil, fmt.Errorf("row %d: %w", n+1, err)
+				}
+			}
+			c.Open, c.High, c.Low, c.Close = values[0], values[1], values[2], values[3]
+			if volIdx >= 0 {
+				c.Volume, _ = decimal.NewFromString(row[volIdx])
+			}
+			candles = append(candles, c)
+		}
+	case priceIdx >= 0:
+		sizeIdx := findColumn(columns, sizeColumns)
+		for n, row := range rows {
+			var c Candle
+			if c.Time, err = parseTime(row[timeIdx]); err != nil {
+				return nil, fmt.Errorf("row %d: %w", n+1, err)
+			}
+			price, err := decimal.NewFromString(row[priceIdx])
+			if err != nil {
+				return nil, fmt.Errorf("row %d: %w", n+1, err)
+			}
+			c.Open, c.High, c.Low, c.Close = price, price, price, price
+			if sizeIdx >= 0 {
+				c.Volume, _ = decimal.NewFromString(row[sizeIdx])
+			}
+			candles = append(candles, c)
+		}
+	default:
+		return nil, fmt.Errorf("%w: open/high/low/close or price", ErrMissingColumn)
+	}
+
+	if len(candles) == 0 {
+		return nil, ErrEmptyData
+	}
+	slices.SortStableFunc(candles, func(a, b Candle) int {
+		return a.Time.Compare(b.Time)
+	})
+	return candles, nil
+}
+
+// LoadFundingRates 从 CSV 或 Parquet 文件加载资金费率，结果按时间升序排列
+func LoadFundingRates(path string) ([]FundingRate, error) {
+	columns, rows, err := readTable(path)
+	if err != nil {
+		return nil, err
+	}
+
+	timeIdx, rateIdx := findColumn(columns, timeColumns), findColumn(columns, rateColumns)
+	if timeIdx < 0 || rateIdx < 0 {
+		return nil, fmt.Errorf("%w: timestamp and rate", ErrMissingColumn)
+	}
+
+	rates := make([]FundingRate, 0, len(rows))
+	for n, row := range rows {
+		var item FundingRate
+		if item.Time, err = parseTime(row[timeIdx]); err != nil {
+			return nil, fmt.Errorf("row %d: %w", n+1, err)
+		}
+		if item.Rate, err = decimal.NewFromString(row[rateIdx]); err != nil {
+			return nil, fmt.Errorf("row %d: %w", n+1, err)
+		}
+		rates = append(rates, item)
+	}
+
+	slices.SortStableFunc(rates, func(a, b FundingRate) int {
+		return a.Time.Compare(b.Time)
+	})
+	return rates, nil
+}
+
+// readTable 读取表格数据，返回列名和字符串形式的行数据
+func readTable(path string) ([]string, [][]string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return readCSV(path)
+	case ".parquet":
+		return readParquet(path)
+	default:
+		return nil, nil, ErrUnsupportedFormat
+	}
+}
+
+func readCSV(path string) ([]string, [][]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(f)
+	reader.TrimLeadingSpace = true
+
+	columns, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil, ErrEmptyData
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, nil, err
+	}
+	return columns, rows, nil
+}
+
+func readParquet(path string) ([]string, [][]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	reader := parquet.NewReader(f)
+	defer reader.Close()
+
+	schemaColumns := reader.Schema().Columns()
+	columns := make([]string, len(schemaColumns))
+	for idx, item := range schemaColumns {
+		columns[idx] = strings.Join(item, ".")
+	}
+
+	rows := make([][]string, 0, reader.NumRows())
+	buf := make([]parquet.Row, 1024)
+	for {
+		n, err := reader.ReadRows(buf)
+		for _, row := range buf[:n] {
+			values := make([]string, len(columns))
+			for _, v := range row {
+				if col := v.Column(); col >= 0 && col < len(values) {
+					values[col] = parquetValueString(v)
+				}
+			}
+			rows = append(rows, values)
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return columns, rows, nil
+}
+
+// parquetValueString 转换 Parquet 值为字符串
+// 浮点数按 64 位精度格式化，避免价格精度丢失
+func parquetValueString(v parquet.Value) string {
+	switch v.Kind() {
+	case parquet.Double:
+		return strconv.FormatFloat(v.Double(), 'f', -1, 64)
+	case parquet.Float:
+		return strconv.FormatFloat(float64(v.Float()), 'f', -1, 32)
+	default:
+		if v.IsNull() {
+			return ""
+		}
+		return v.String()
+	}
+}
+
+// findColumn 按别名查找列索引，未找到返回 -1
+func findColumn(columns []string, names []string) int {
+	for _, name := range names {
+		for idx, column := range columns {
+			if strings.EqualFold(strings.TrimSpace(column), name) {
+				return idx
+			}
+		}
+	}
+	return -1
+}
+
+// parseTime 解析时间
+// 支持 RFC3339、日期时间字符串和 Unix 时间戳(按数值大小识别秒、毫秒、微秒、纳秒)
+func parseTime(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+		switch {
+		case n < 1e11:
+			return time.Unix(n, 0), nil
+		case n < 1e14:
+			return time.UnixMilli(n), nil
+		case n < 1e17:
+			return time.UnixMicro(n), nil
+		default:
+			return time.Unix(0, n), nil
+		}
+	}
+	if f, err := strconv.ParseFloat(value, 64); err == nil {
+		return time.UnixMilli(int64(f * 1000)), nil
+	}
+
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02 15:04:05", "2006-01-02T15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time: %s", value)
+}
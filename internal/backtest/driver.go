@@ -0,0 +1,77 @@
+package backtest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/exchange/paper"
+	"github.com/fachebot/omni-grid-bot/internal/helper"
+	"github.com/fachebot/omni-grid-bot/internal/svc"
+	"github.com/shopspring/decimal"
+)
+
+// ExchangeName 回测交易所名称
+const ExchangeName = "backtest"
+
+// Driver 回测交易所驱动
+// 订单在撮合模拟器中按历史行情成交，只绑定到回测使用的服务上下文，不注册到全局驱动列表
+type Driver struct {
+	svcCtx    *svc.ServiceContext
+	simulator *paper.Simulator
+	metadata  exchange.MarketMetadata
+}
+
+// NewDriver 创建回测交易所驱动
+func NewDriver(simulator *paper.Simulator, metadata exchange.MarketMetadata) *Driver {
+	return &Driver{simulator: simulator, metadata: metadata}
+}
+
+// Name 交易所名称
+func (d *Driver) Name() string {
+	return ExchangeName
+}
+
+// NewOrderHelper 创建订单操作客户端
+func (d *Driver) NewOrderHelper(record *ent.Strategy) (exchange.OrderHelper, error) {
+	return helper.NewPaperOrderHelper(d.svcCtx, ExchangeName, d.simulator, nil, record.ExchangeApiKey), nil
+}
+
+// Subscriber 回测由回放循环驱动，没有订阅器
+func (d *Driver) Subscriber() exchange.Subscriber {
+	return nil
+}
+
+// GetMarketMetadata 获取市场元数据
+func (d *Driver) GetMarketMetadata(ctx context.Context, symbol string) (exchange.MarketMetadata, error) {
+	return d.metadata, nil
+}
+
+// GetLastTradePrice 获取回放到当前时间的最新价格
+func (d *Driver) GetLastTradePrice(ctx context.Context, symbol string) (decimal.Decimal, error) {
+	price, ok := d.simulator.LastPrice(symbol)
+	if !ok {
+		return decimal.Zero, paper.ErrNoMarketPrice
+	}
+	return price, nil
+}
+
+// GetAccountInfo 获取账户信息
+func (d *Driver) GetAccountInfo(ctx context.Context, record *ent.Strategy) (*exchange.Account, error) {
+	account := d.simulator.Account(record.ExchangeApiKey)
+	return &account, nil
+}
+
+// TestConnectivity 测试账户连通性
+func (d *Driver) TestConnectivity(ctx context.Context, record *ent.Strategy) error {
+	if record.ExchangeApiKey == "" {
+		return errors.New("backtest account is not configured")
+	}
+	return nil
+}
+
+// MarketURL 交易对页面链接
+func (d *Driver) MarketURL(symbol string) string {
+	return ""
+}
@@ -0,0 +1,268 @@
+package backtest
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"math"
+	"runtime"
+	"slices"
+	"sync"
+	"time"
+
+	entstrategy "github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/fachebot/omni-grid-bot/internal/strategy"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrEmptySweep       = errors.New("no valid parameter combination")
+	ErrInvalidObjective = errors.New("invalid sweep objective")
+)
+
+// Objective 参数寻优目标
+type Objective string
+
+const (
+	ObjectiveNetProfit       Objective = "net_profit"        // 净利润
+	ObjectiveSharpe          Objective = "sharpe"            // 年化夏普比率
+	ObjectiveProfitPerMargin Objective = "profit_per_margin" // 单位保证金净利润
+)
+
+// SweepSpace 参数搜索空间
+// 为空的维度使用基础参数中的值，价格下限不小于价格上限的组合会被跳过
+type SweepSpace struct {
+	PriceLowers   []decimal.Decimal          // 网格价格下限候选值
+	PriceUppers   []decimal.Decimal          // 网格价格上限候选值
+	GridNums      []int                      // 网格数量候选值
+	Leverages     []int                      // 杠杆倍数候选值
+	Modes         []entstrategy.Mode         // 网格模式候选值
+	QuantityModes []entstrategy.QuantityMode // 网格间距模式候选值
+}
+
+// SweepResult 单组参数的回测结果
+type SweepResult struct {
+	Config    Config          // 回测参数
+	Result    *Result         // 回测结果
+	NetProfit decimal.Decimal // 净利润(最终权益 - 初始资金)
+	Margin    decimal.Decimal // 全部网格挂单占用的保证金
+	Sharpe    float64         // 年化夏普比率
+	Score     float64         // 寻优目标得分
+}
+
+// Configs 展开搜索空间，返回所有有效的参数组合
+func (s SweepSpace) Configs(base Config) []Config {
+	lowers := orDefault(s.PriceLowers, base.PriceLower)
+	uppers := orDefault(s.PriceUppers, base.PriceUpper)
+	gridNums := orDefault(s.GridNums, base.GridNum)
+	leverages := orDefault(s.Leverages, base.Leverage)
+	modes := orDefault(s.Modes, base.Mode)
+	quantityModes := orDefault(s.QuantityModes, base.QuantityMode)
+
+	configs := make([]Config, 0)
+	for _, mode := range modes {
+		for _, quantityMode := range quantityModes {
+			for _, lower := range lowers {
+				for _, upper := range uppers {
+					if lower.GreaterThanOrEqual(upper) {
+						continue
+					}
+					for _, gridNum := range gridNums {
+						for _, leverage := range leverages {
+							c := base
+							c.Mode = mode
+							c.QuantityMode = quantityMode
+							c.PriceLower = lower
+							c.PriceUpper = upper
+							c.GridNum = gridNum
+							c.Leverage = leverage
+							configs = append(configs, c)
+						}
+					}
+				}
+			}
+		}
+	}
+	return configs
+}
+
+// Sweep 参数扫描
+// 在 workers 个协程中使用同一份历史行情回测搜索空间内的所有参数组合，按寻优目标得分降序返回。
+// 回测失败的组合(例如网格间距小于价格精度)会被忽略，全部失败时返回第一个错误
+func Sweep(ctx context.Context, base Config, space SweepSpace, candles []Candle, workers int, objective Objective) ([]*SweepResult, error) {
+	switch objective {
+	case ObjectiveNetProfit, ObjectiveSharpe, ObjectiveProfitPerMargin:
+	default:
+		return nil, ErrInvalidObjective
+	}
+
+	configs := space.Configs(base)
+	if len(configs) == 0 {
+		return nil, ErrEmptySweep
+	}
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	var wg sync.WaitGroup
+	jobs := make(chan int)
+	results := make([]*SweepResult, len(configs))
+	errs := make([]error, len(configs))
+	for i := 0; i < min(workers, len(configs)); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				results[idx], errs[idx] = sweepOne(ctx, configs[idx], candles, objective)
+			}
+		}()
+	}
+
+	for idx := range configs {
+		if ctx.Err() != nil {
+			break
+		}
+		jobs <- idx
+	}
+	close(jobs)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	list := make([]*SweepResult, 0, len(results))
+	for idx, item := range results {
+		if errs[idx] != nil {
+			logger.Debugf("[Sweep] 回测参数组合失败, lower: %s, upper: %s, gridNum: %d, leverage: %d, %v",
+				configs[idx].PriceLower, configs[idx].PriceUpper, configs[idx].GridNum, configs[idx].Leverage, errs[idx])
+			continue
+		}
+		list = append(list, item)
+	}
+	if len(list) == 0 {
+		for _, err := range errs {
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, ErrEmptySweep
+	}
+
+	slices.SortStableFunc(list, func(a, b *SweepResult) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return b.NetProfit.Cmp(a.NetProfit)
+	})
+	return list, nil
+}
+
+// sweepOne 回测单组参数并计算寻优目标得分
+func sweepOne(ctx context.Context, c Config, candles []Candle, objective Objective) (*SweepResult, error) {
+	result, err := Run(ctx, c, candles)
+	if err != nil {
+		return nil, err
+	}
+
+	margin := strategy.GridInvestment(result.GridPrices, result.GridQuantities).Div(decimal.NewFromInt(int64(c.Leverage)))
+
+	item := &SweepResult{
+		Config:    c,
+		Result:    result,
+		NetProfit: result.FinalEquity.Sub(result.InitialBalance),
+		Margin:    margin,
+		Sharpe:    result.Sharpe(),
+	}
+
+	switch objective {
+	case ObjectiveNetProfit:
+		item.Score = item.NetProfit.InexactFloat64()
+	case ObjectiveSharpe:
+		item.Score = item.Sharpe
+	case ObjectiveProfitPerMargin:
+		if margin.IsPositive() {
+			item.Score = item.NetProfit.Div(margin).InexactFloat64()
+		}
+	}
+	return item, nil
+}
+
+// Sharpe 年化夏普比率
+// 使用权益曲线相邻数据点的收益率计算，无风险利率按零处理，按平均数据点间隔年化
+func (r *Result) Sharpe() float64 {
+	if len(r.EquityCurve) < 3 {
+		return 0
+	}
+
+	returns := make([]float64, 0, len(r.EquityCurve)-1)
+	for i := 1; i < len(r.EquityCurve); i++ {
+		prev := r.EquityCurve[i-1].Equity
+		if !prev.IsPositive() {
+			continue
+		}
+		returns = append(returns, r.EquityCurve[i].Equity.Div(prev).InexactFloat64()-1)
+	}
+	if len(returns) < 2 {
+		return 0
+	}
+
+	var mean, variance float64
+	for _, item := range returns {
+		mean += item
+	}
+	mean /= float64(len(returns))
+	for _, item := range returns {
+		variance += (item - mean) * (item - mean)
+	}
+	std := math.Sqrt(variance / float64(len(returns)-1))
+	if std == 0 {
+		return 0
+	}
+
+	interval := r.EquityCurve[len(r.EquityCurve)-1].Time.Sub(r.EquityCurve[0].Time) / time.Duration(len(r.EquityCurve)-1)
+	if interval <= 0 {
+		return 0
+	}
+	periodsPerYear := float64(365*24*time.Hour) / float64(interval)
+	return mean / std * math.Sqrt(periodsPerYear)
+}
+
+// SuggestPriceRanges 根据历史行情生成价格区间候选值
+// 以区间内最低价和最高价为基准，分别向内收缩和向外扩展，按价格精度取整
+func SuggestPriceRanges(candles []Candle, priceDecimals uint8) (lowers, uppers []decimal.Decimal) {
+	if len(candles) == 0 {
+		return nil, nil
+	}
+
+	low, high := candles[0].Low, candles[0].High
+	for _, item := range candles[1:] {
+		low = decimal.Min(low, item.Low)
+		high = decimal.Max(high, item.High)
+	}
+
+	span := high.Sub(low)
+	places := int32(priceDecimals)
+	for _, ratio := range []string{"-0.1", "0", "0.2"} {
+		price := low.Add(span.Mul(decimal.RequireFromString(ratio))).Round(places)
+		if price.IsPositive() && !slices.ContainsFunc(lowers, price.Equal) {
+			lowers = append(lowers, price)
+		}
+	}
+	for _, ratio := range []string{"-0.2", "0", "0.1"} {
+		price := high.Add(span.Mul(decimal.RequireFromString(ratio))).Round(places)
+		if price.IsPositive() && !slices.ContainsFunc(uppers, price.Equal) {
+			uppers = append(uppers, price)
+		}
+	}
+	return lowers, uppers
+}
+
+// orDefault 候选值为空时使用默认值
+func orDefault[T any](values []T, value T) []T {
+	if len(values) == 0 {
+		return []T{value}
+	}
+	return values
+}
@@ -0,0 +1,69 @@
+package backtest
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// WriteEquityCurve 以 CSV 格式输出权益曲线
+func WriteEquityCurve(w io.Writer, curve []EquityPoint) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"timestamp", "time", "price", "equity"}); err != nil {
+		return err
+	}
+
+	for _, item := range curve {
+		record := []string{
+			strconv.FormatInt(item.Time.UnixMilli(), 10),
+			item.Time.UTC().Format(time.RFC3339),
+			item.Price.String(),
+			item.Equity.String(),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteMatchedTrades 以 CSV 格式输出网格配对记录
+func WriteMatchedTrades(w io.Writer, trades []*ent.MatchedTrade) error {
+	writer := csv.NewWriter(w)
+	header := []string{"buy_time", "buy_size", "buy_quote", "sell_time", "sell_size", "sell_quote", "profit"}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, item := range trades {
+		record := []string{
+			formatTimestamp(item.BuyOrderTimestamp),
+			lo.FromPtr(item.BuyBaseAmount).String(),
+			lo.FromPtr(item.BuyQuoteAmount).String(),
+			formatTimestamp(item.SellOrderTimestamp),
+			lo.FromPtr(item.SellBaseAmount).String(),
+			lo.FromPtr(item.SellQuoteAmount).String(),
+			decimal.NewFromFloat(lo.FromPtr(item.Profit)).String(),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func formatTimestamp(ts *int64) string {
+	if ts == nil {
+		return ""
+	}
+	return time.UnixMilli(*ts).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,75 @@
+package cache
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/shopspring/decimal"
+)
+
+// CandleCache 根据行情推送聚合K线
+// 交易所不提供历史K线时用于计算近期波动率，每个交易对只保留最近 limit 根K线
+type CandleCache struct {
+	rb       sync.RWMutex
+	interval time.Duration
+	limit    int
+	candles  map[string][]exchange.Candle
+}
+
+func NewCandleCache(interval time.Duration, limit int) *CandleCache {
+	return &CandleCache{interval: interval, limit: limit, candles: make(map[string][]exchange.Candle)}
+}
+
+// Interval K线周期
+func (c *CandleCache) Interval() time.Duration {
+	return c.interval
+}
+
+// Add 将最新价格合并到所在周期的K线
+func (c *CandleCache) Add(exchangeName, symbol string, price decimal.Decimal, t time.Time) {
+	if !price.IsPositive() {
+		return
+	}
+
+	key := fmt.Sprintf("%s:%s", exchangeName, symbol)
+	start := t.Truncate(c.interval)
+
+	c.rb.Lock()
+	defer c.rb.Unlock()
+
+	list := c.candles[key]
+	if n := len(list); n > 0 && list[n-1].Time.Equal(start) {
+		last := &list[n-1]
+		last.High = decimal.Max(last.High, price)
+		last.Low = decimal.Min(last.Low, price)
+		last.Close = price
+		return
+	} else if n > 0 && list[n-1].Time.After(start) {
+		return
+	}
+
+	list = append(list, exchange.Candle{Time: start, Open: price, High: price, Low: price, Close: price})
+	if len(list) > c.limit {
+		list = list[len(list)-c.limit:]
+	}
+	c.candles[key] = list
+}
+
+// List 获取 [start, end] 区间内已经收盘的K线，按时间升序返回
+func (c *CandleCache) List(exchangeName, symbol string, start, end time.Time) []exchange.Candle {
+	key := fmt.Sprintf("%s:%s", exchangeName, symbol)
+
+	c.rb.RLock()
+	defer c.rb.RUnlock()
+
+	list := make([]exchange.Candle, 0)
+	for _, item := range c.candles[key] {
+		if item.Time.Before(start) || item.Time.Add(c.interval).After(end) {
+			continue
+		}
+		list = append(list, item)
+	}
+	return list
+}
@@ -26,6 +26,77 @@ type VariationalRateLimit struct {
 	Burst             int     `yaml:"Burst"`             // 默认1
 }
 
+type Dydx struct {
+	ValidatorURL string `yaml:"ValidatorURL"` // 验证节点REST地址
+}
+
+type Paper struct {
+	PriceSource    string  `yaml:"PriceSource"`    // 行情来源交易所，默认hyperliquid
+	InitialBalance float64 `yaml:"InitialBalance"` // 模拟账户初始余额，默认10000
+	MakerFeeRate   float64 `yaml:"MakerFeeRate"`   // 挂单手续费率
+	TakerFeeRate   float64 `yaml:"TakerFeeRate"`   // 吃单手续费率
+	SlippageBps    int     `yaml:"SlippageBps"`    // 吃单滑点(基点)
+	PollInterval   int     `yaml:"PollInterval"`   // 行情查询间隔(秒)，默认2
+}
+
+type WsRecorder struct {
+	Enable bool   `yaml:"Enable"` // 是否录制交易所WebSocket原始消息
+	Dir    string `yaml:"Dir"`    // 录制文件目录，默认data/records
+}
+
+type Reconcile struct {
+	Interval int `yaml:"Interval"` // 对账间隔(秒)，默认60，小于0时关闭对账
+}
+
+type FillSync struct {
+	Interval int `yaml:"Interval"` // 成交记录同步间隔(秒)，默认300，小于0时关闭同步
+}
+
+type FundingSync struct {
+	Interval int `yaml:"Interval"` // 资金费用同步间隔(秒)，默认3600，小于0时关闭同步
+}
+
+// DefaultRangeAlertBuffer 未设置时的网格区间提醒缓冲区(%)
+const DefaultRangeAlertBuffer = 1.0
+
+type RangeAlert struct {
+	Buffer   *float64 `yaml:"Buffer"`   // 价格距离网格区间边界小于此比例(%)时提醒，未设置时默认1，设置为0时只在价格穿越边界时提醒，小于0时关闭提醒
+	Interval int      `yaml:"Interval"` // 同一边界重复提醒的最小间隔(秒)，默认3600
+}
+
+// 自动降低风险的方式
+const (
+	RiskActionNone     = "none"     // 只发送提醒
+	RiskActionCancel   = "cancel"   // 撤销距离当前价格最远的开仓挂单
+	RiskActionLeverage = "leverage" // 杠杆倍数减半
+	RiskActionClose    = "close"    // 停止交易对的所有策略并平仓
+)
+
+type RiskMonitor struct {
+	Interval       int     `yaml:"Interval"`       // 检查间隔(秒)，默认60，小于0时关闭监控
+	AlertDistance  float64 `yaml:"AlertDistance"`  // 价格距离强平价格小于此比例(%)时提醒，默认20，小于0时不提醒
+	MinMarginRatio float64 `yaml:"MinMarginRatio"` // 账户权益占持仓价值的比例(%)小于此值时提醒，默认10，小于0时不检查
+	AlertInterval  int     `yaml:"AlertInterval"`  // 风险等级没有变化时重复提醒的最小间隔(秒)，默认3600
+	DeriskDistance float64 `yaml:"DeriskDistance"` // 价格距离强平价格小于此比例(%)时自动降低风险，默认10，小于0时关闭
+	DeriskAction   string  `yaml:"DeriskAction"`   // 自动降低风险的方式: none、cancel、leverage、close，默认none
+	CancelLevels   int     `yaml:"CancelLevels"`   // cancel 方式每次撤销的最远开仓挂单数量，默认2
+}
+
+type RiskLimitRule struct {
+	MaxNotional         float64 `yaml:"MaxNotional"`         // 运行中策略的最大持仓总价值(USD)，0表示不限制
+	MaxSymbolPosition   float64 `yaml:"MaxSymbolPosition"`   // 单个交易对的最大持仓价值(USD)，0表示不限制
+	MaxActiveStrategies int     `yaml:"MaxActiveStrategies"` // 最多同时运行的策略数量，0表示不限制
+}
+
+type RiskLimit struct {
+	Account RiskLimitRule `yaml:"Account"` // 同一交易账户的限额
+	Owner   RiskLimitRule `yaml:"Owner"`   // 同一用户所有交易账户的限额
+}
+
+type Credentials struct {
+	KeyFile string `yaml:"KeyFile"` // 交易所凭证主密钥文件，未配置时读取环境变量 OMNI_GRID_MASTER_KEY
+}
+
 type TelegramBot struct {
 	Debug        bool    `yaml:"Debug"`
 	ApiToken     string  `yaml:"ApiToken"`
@@ -47,6 +118,16 @@ type Config struct {
 	TelegramBot          TelegramBot          `yaml:"TelegramBot"`
 	LighterRateLimit     LighterRateLimit     `yaml:"LighterRateLimit"`
 	VariationalRateLimit VariationalRateLimit `yaml:"VariationalRateLimit"`
+	Dydx                 Dydx                 `yaml:"Dydx"`
+	Paper                Paper                `yaml:"Paper"`
+	WsRecorder           WsRecorder           `yaml:"WsRecorder"`
+	Reconcile            Reconcile            `yaml:"Reconcile"`
+	FillSync             FillSync             `yaml:"FillSync"`
+	FundingSync          FundingSync          `yaml:"FundingSync"`
+	RangeAlert           RangeAlert           `yaml:"RangeAlert"`
+	RiskMonitor          RiskMonitor          `yaml:"RiskMonitor"`
+	RiskLimit            RiskLimit            `yaml:"RiskLimit"`
+	Credentials          Credentials          `yaml:"Credentials"`
 }
 
 func LoadFromFile(filename string) (*Config, error) {
@@ -81,5 +162,70 @@ func LoadFromFile(filename string) (*Config, error) {
 		c.VariationalRateLimit.Burst = 1
 	}
 
+	if c.Paper.PriceSource == "" {
+		c.Paper.PriceSource = "hyperliquid"
+	}
+
+	if c.Paper.InitialBalance == 0 {
+		c.Paper.InitialBalance = 10000
+	}
+
+	if c.Paper.PollInterval == 0 {
+		c.Paper.PollInterval = 2
+	}
+
+	if c.Reconcile.Interval == 0 {
+		c.Reconcile.Interval = 60
+	}
+
+	if c.FillSync.Interval == 0 {
+		c.FillSync.Interval = 300
+	}
+
+	if c.FundingSync.Interval == 0 {
+		c.FundingSync.Interval = 3600
+	}
+
+	if c.RangeAlert.Buffer == nil {
+		buffer := DefaultRangeAlertBuffer
+		c.RangeAlert.Buffer = &buffer
+	}
+
+	if c.RangeAlert.Interval == 0 {
+		c.RangeAlert.Interval = 3600
+	}
+
+	if c.RiskMonitor.Interval == 0 {
+		c.RiskMonitor.Interval = 60
+	}
+
+	if c.RiskMonitor.AlertDistance == 0 {
+		c.RiskMonitor.AlertDistance = 20
+	}
+
+	if c.RiskMonitor.MinMarginRatio == 0 {
+		c.RiskMonitor.MinMarginRatio = 10
+	}
+
+	if c.RiskMonitor.AlertInterval == 0 {
+		c.RiskMonitor.AlertInterval = 3600
+	}
+
+	if c.RiskMonitor.DeriskDistance == 0 {
+		c.RiskMonitor.DeriskDistance = 10
+	}
+
+	if c.RiskMonitor.DeriskAction == "" {
+		c.RiskMonitor.DeriskAction = RiskActionNone
+	}
+
+	if c.RiskMonitor.CancelLevels == 0 {
+		c.RiskMonitor.CancelLevels = 2
+	}
+
+	if c.WsRecorder.Dir == "" {
+		c.WsRecorder.Dir = "data/records"
+	}
+
 	return &c, nil
 }
@@ -0,0 +1,37 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadRangeAlertBuffer(t *testing.T) {
+	tests := []struct {
+		name string
+		yaml string
+		want float64
+	}{
+		{name: "未设置时使用默认值", yaml: "AppName: test\n", want: DefaultRangeAlertBuffer},
+		{name: "设置为零时只在穿越边界时提醒", yaml: "RangeAlert:\n  Buffer: 0\n", want: 0},
+		{name: "设置为负数时关闭提醒", yaml: "RangeAlert:\n  Buffer: -1\n", want: -1},
+		{name: "使用设置的缓冲区", yaml: "RangeAlert:\n  Buffer: 2.5\n", want: 2.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filename := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(filename, []byte(tt.yaml), 0o600); err != nil {
+				t.Fatalf("写入配置文件失败: %v", err)
+			}
+
+			c, err := LoadFromFile(filename)
+			if err != nil {
+				t.Fatalf("加载配置失败: %v", err)
+			}
+			if c.RangeAlert.Buffer == nil || *c.RangeAlert.Buffer != tt.want {
+				t.Fatalf("Buffer = %v, want %v", c.RangeAlert.Buffer, tt.want)
+			}
+		})
+	}
+}
@@ -3,14 +3,12 @@ package engine
 import (
 	"container/heap"
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
 	"github.com/fachebot/omni-grid-bot/internal/ent"
 	"github.com/fachebot/omni-grid-bot/internal/exchange"
-	"github.com/fachebot/omni-grid-bot/internal/exchange/lighter"
-	"github.com/fachebot/omni-grid-bot/internal/exchange/paradex"
-	"github.com/fachebot/omni-grid-bot/internal/exchange/variational"
 	"github.com/fachebot/omni-grid-bot/internal/logger"
 	"github.com/fachebot/omni-grid-bot/internal/svc"
 	"github.com/samber/lo"
@@ -26,6 +24,7 @@ type Strategy interface {
 	Update(s *ent.Strategy)
 	OnTicker(ctx context.Context, price decimal.Decimal)
 	OnOrdersChanged(ctx context.Context) error
+	Flush(ctx context.Context)
 }
 
 // StrategyEngine 策略引擎
@@ -36,42 +35,59 @@ type StrategyEngine struct {
 
 	svcCtx *svc.ServiceContext
 
-	lighterSubscriber     *lighter.LighterSubscriber
-	paradexSubscriber     *paradex.ParadexSubscriber
-	variationalSubscriber *variational.VariationalSubscriber
+	subscribers map[string]exchange.Subscriber // 交易所名称 -> 订阅器
+	eventChan   chan exchange.SubMessage       // 所有订阅器的消息汇总
 
 	// 策略管理
 	mutex           sync.RWMutex
 	strategyMap     map[string]Strategy // 策略ID -> 策略实例
-	userStrategyMap map[string][]string // 用户账户 -> 策略ID列表
+	userStrategyMap map[string][]string // 交易所:账户 (accountKey) -> 策略ID列表
 
 	// 重试管理
 	retryHeap *retryHeap            // 最小堆
 	retrySet  map[string]*retryItem // 快速查找是否在重试队列
+
+	// 对账管理
+	reconcileInterval time.Duration // 对账间隔
+	reconciledChan    chan string   // 对账修复后需要重新执行的策略ID
+	reconcileAlerts   sync.Map      // 策略ID -> 最近一次发送的差异通知，避免重复通知
+
+	// 成交记录同步
+	fillSyncInterval time.Duration // 成交记录同步间隔
+
+	// 资金费用同步
+	fundingSyncInterval time.Duration // 资金费用同步间隔
+
+	// 强平风险监控
+	riskMonitorInterval time.Duration      // 风险检查间隔
+	deriskChan          chan deriskRequest // 需要在主运行循环中撤销开仓挂单的请求
+	riskAlerts          sync.Map           // 持仓或账户 -> 最近一次发送的风险提醒，避免重复提醒
 }
 
 // NewStrategyEngine 创建策略引擎实例
-func NewStrategyEngine(
-	svcCtx *svc.ServiceContext,
-	lighterSubscriber *lighter.LighterSubscriber,
-	paradexSubscriber *paradex.ParadexSubscriber,
-	variationalSubscriber *variational.VariationalSubscriber,
-) *StrategyEngine {
+// subscribers 为引擎需要处理的交易所订阅器，可以是任意数量的交易所
+func NewStrategyEngine(svcCtx *svc.ServiceContext, subscribers ...exchange.Subscriber) *StrategyEngine {
 	h := make(retryHeap, 0)
 	heap.Init(&h)
 
+	subscriberMap := make(map[string]exchange.Subscriber)
+	for _, subscriber := range subscribers {
+		subscriberMap[subscriber.Exchange()] = subscriber
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	return &StrategyEngine{
-		ctx:                   ctx,
-		cancel:                cancel,
-		svcCtx:                svcCtx,
-		lighterSubscriber:     lighterSubscriber,
-		paradexSubscriber:     paradexSubscriber,
-		variationalSubscriber: variationalSubscriber,
-		strategyMap:           make(map[string]Strategy),
-		userStrategyMap:       make(map[string][]string),
-		retryHeap:             &h,
-		retrySet:              make(map[string]*retryItem),
+		ctx:             ctx,
+		cancel:          cancel,
+		svcCtx:          svcCtx,
+		subscribers:     subscriberMap,
+		eventChan:       make(chan exchange.SubMessage, 1024),
+		strategyMap:     make(map[string]Strategy),
+		userStrategyMap: make(map[string][]string),
+		retryHeap:       &h,
+		retrySet:        make(map[string]*retryItem),
+		reconciledChan:  make(chan string, 64),
+		deriskChan:      make(chan deriskRequest, 64),
 	}
 }
 
@@ -83,7 +99,24 @@ func (engine *StrategyEngine) Start() {
 
 	engine.stopChan = make(chan struct{})
 	logger.Infof("[StrategyEngine] 开始运行服务")
+
+	for _, subscriber := range engine.subscribers {
+		go engine.forward(subscriber.SubscriptionChan())
+	}
 	go engine.run()
+
+	if engine.reconcileInterval > 0 {
+		go engine.reconcileLoop()
+	}
+	if engine.fillSyncInterval > 0 {
+		go engine.fillSyncLoop()
+	}
+	if engine.fundingSyncInterval > 0 {
+		go engine.fundingSyncLoop()
+	}
+	if engine.riskMonitorInterval > 0 {
+		go engine.riskMonitorLoop()
+	}
 }
 
 // Stop 停止策略引擎
@@ -118,7 +151,7 @@ func (engine *StrategyEngine) StartStrategy(s Strategy) error {
 	}
 
 	// 添加策略到引擎
-	engine.addStrategyToEngine(record.GUID, record.Account, s)
+	engine.addStrategyToEngine(s)
 
 	return nil
 }
@@ -208,10 +241,6 @@ func (engine *StrategyEngine) run() {
 	timer := time.NewTimer(0)
 	defer timer.Stop()
 
-	lighterChan := engine.lighterSubscriber.SubscriptionChan()
-	paradexChan := engine.paradexSubscriber.SubscriptionChan()
-	variationalChan := engine.variationalSubscriber.SubscriptionChan()
-
 	for {
 		var msg *exchange.SubMessage
 		select {
@@ -220,15 +249,24 @@ func (engine *StrategyEngine) run() {
 			timer.Reset(time.Second * 1)
 
 		case <-engine.ctx.Done():
+			engine.flushStrategies()
 			engine.stopChan <- struct{}{}
 			return
 
-		case data := <-lighterChan:
-			msg = &data
-		case data := <-paradexChan:
-			msg = &data
-		case data := <-variationalChan:
+		case data := <-engine.eventChan:
 			msg = &data
+
+		case id := <-engine.reconciledChan:
+			engine.mutex.RLock()
+			strategy, exists := engine.strategyMap[id]
+			engine.mutex.RUnlock()
+
+			if exists {
+				engine.executeStrategy(strategy)
+			}
+
+		case req := <-engine.deriskChan:
+			engine.cancelFarthestOpenOrders(req)
 		}
 
 		if msg == nil {
@@ -247,14 +285,57 @@ func (engine *StrategyEngine) run() {
 	}
 }
 
+// flushStrategies 引擎停止前保存所有策略尚未写入数据库的状态，引擎上下文已经取消，使用新的上下文
+func (engine *StrategyEngine) flushStrategies() {
+	engine.mutex.RLock()
+	strategies := make([]Strategy, 0, len(engine.strategyMap))
+	for _, s := range engine.strategyMap {
+		strategies = append(strategies, s)
+	}
+	engine.mutex.RUnlock()
+
+	for _, s := range strategies {
+		s.Flush(context.Background())
+	}
+}
+
+// forward 将单个订阅器的消息转发到引擎的汇总通道
+func (engine *StrategyEngine) forward(ch <-chan exchange.SubMessage) {
+	for {
+		select {
+		case <-engine.ctx.Done():
+			return
+		case data, ok := <-ch:
+			if !ok {
+				return
+			}
+
+			select {
+			case engine.eventChan <- data:
+			case <-engine.ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// accountKey 账户订阅的唯一标识，不同交易所可能使用相同的账户地址，需要同时按交易所区分
+func accountKey(exchangeName, account string) string {
+	return fmt.Sprintf("%s:%s", exchangeName, account)
+}
+
 // addStrategyToEngine 添加策略到引擎
-func (engine *StrategyEngine) addStrategyToEngine(strategyID, account string, s Strategy) {
+func (engine *StrategyEngine) addStrategyToEngine(s Strategy) {
 	engine.mutex.Lock()
 	defer engine.mutex.Unlock()
 
+	record := s.Get()
+	strategyID := record.GUID
+
 	// 如果策略不存在，更新用户策略列表
 	if _, exists := engine.strategyMap[strategyID]; !exists {
-		userStrategyList := engine.userStrategyMap[account]
+		key := accountKey(record.Exchange, record.Account)
+		userStrategyList := engine.userStrategyMap[key]
 		if userStrategyList == nil {
 			userStrategyList = make([]string, 0)
 		}
@@ -265,7 +346,7 @@ func (engine *StrategyEngine) addStrategyToEngine(strategyID, account string, s
 		}); !found {
 			userStrategyList = append(userStrategyList, strategyID)
 		}
-		engine.userStrategyMap[account] = userStrategyList
+		engine.userStrategyMap[key] = userStrategyList
 	}
 
 	engine.strategyMap[strategyID] = s
@@ -285,7 +366,8 @@ func (engine *StrategyEngine) removeStrategyFromEngine(strategyID string) (*ent.
 	userStrategyCount := 0
 
 	// 更新用户策略列表
-	if userStrategyList, ok := engine.userStrategyMap[record.Account]; ok {
+	key := accountKey(record.Exchange, record.Account)
+	if userStrategyList, ok := engine.userStrategyMap[key]; ok {
 		newList := make([]string, 0, len(userStrategyList))
 		for _, guid := range userStrategyList {
 			if guid != strategyID {
@@ -293,7 +375,7 @@ func (engine *StrategyEngine) removeStrategyFromEngine(strategyID string) (*ent.
 			}
 		}
 		userStrategyCount = len(newList)
-		engine.userStrategyMap[record.Account] = newList
+		engine.userStrategyMap[key] = newList
 	}
 
 	delete(engine.strategyMap, strategyID)
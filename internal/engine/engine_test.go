@@ -0,0 +1,151 @@
+package engine
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/shopspring/decimal"
+)
+
+type fakeSubscriber struct {
+	name string
+	ch   chan exchange.SubMessage
+}
+
+func newFakeSubscriber(name string) *fakeSubscriber {
+	return &fakeSubscriber{name: name, ch: make(chan exchange.SubMessage, 8)}
+}
+
+func (s *fakeSubscriber) Exchange() string                                    { return s.name }
+func (s *fakeSubscriber) Start()                                              {}
+func (s *fakeSubscriber) Stop()                                               {}
+func (s *fakeSubscriber) SubscriptionChan() <-chan exchange.SubMessage        { return s.ch }
+func (s *fakeSubscriber) SubscribeMarketStats(symbol string) error            { return nil }
+func (s *fakeSubscriber) SubscribeAccountOrders(record *ent.Strategy) error   { return nil }
+func (s *fakeSubscriber) UnsubscribeAccountOrders(record *ent.Strategy) error { return nil }
+
+type fakeStrategy struct {
+	record  *ent.Strategy
+	mutex   sync.Mutex
+	prices  []decimal.Decimal
+	changes int
+}
+
+func (s *fakeStrategy) Get() *ent.Strategy          { return s.record }
+func (s *fakeStrategy) Update(record *ent.Strategy) { s.record = record }
+func (s *fakeStrategy) Flush(ctx context.Context)   {}
+
+func (s *fakeStrategy) OnOrdersChanged(ctx context.Context) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.changes++
+	return nil
+}
+
+func (s *fakeStrategy) OnTicker(ctx context.Context, price decimal.Decimal) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.prices = append(s.prices, price)
+}
+
+func (s *fakeStrategy) tickers() []decimal.Decimal {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]decimal.Decimal(nil), s.prices...)
+}
+
+func (s *fakeStrategy) orderChanges() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.changes
+}
+
+func TestStrategyEngineFanIn(t *testing.T) {
+	subA := newFakeSubscriber("A")
+	subB := newFakeSubscriber("B")
+
+	engine := NewStrategyEngine(nil, subA, subB)
+	engine.Start()
+	defer engine.Stop()
+
+	sa := &fakeStrategy{record: &ent.Strategy{GUID: "1", Exchange: "A", Symbol: "BTC", Account: "a"}}
+	sb := &fakeStrategy{record: &ent.Strategy{GUID: "2", Exchange: "B", Symbol: "BTC", Account: "b"}}
+	if err := engine.StartStrategy(sa); err != nil {
+		t.Fatalf("启动策略失败: %v", err)
+	}
+	if err := engine.StartStrategy(sb); err != nil {
+		t.Fatalf("启动策略失败: %v", err)
+	}
+
+	subA.ch <- exchange.SubMessage{Exchange: "A", MarketStats: &exchange.MarketStats{Symbol: "BTC", MarkPrice: decimal.NewFromInt(100)}}
+	subB.ch <- exchange.SubMessage{Exchange: "B", MarketStats: &exchange.MarketStats{Symbol: "BTC", MarkPrice: decimal.NewFromInt(200)}}
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) && (len(sa.tickers()) == 0 || len(sb.tickers()) == 0) {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if got := sa.tickers(); len(got) != 1 || !got[0].Equal(decimal.NewFromInt(100)) {
+		t.Errorf("策略A收到的价格 = %v, expected [100]", got)
+	}
+	if got := sb.tickers(); len(got) != 1 || !got[0].Equal(decimal.NewFromInt(200)) {
+		t.Errorf("策略B收到的价格 = %v, expected [200]", got)
+	}
+}
+
+func TestStrategyEngineUnknownSubscriber(t *testing.T) {
+	engine := NewStrategyEngine(nil, newFakeSubscriber("A"))
+
+	s := &fakeStrategy{record: &ent.Strategy{GUID: "1", Exchange: "C", Symbol: "BTC", Account: "c"}}
+	if err := engine.StartStrategy(s); err == nil {
+		t.Error("未注册订阅器的交易所应该启动失败")
+	}
+}
+
+func TestStrategyEngineSeparatesAccountsByExchange(t *testing.T) {
+	svcCtx, driver := newTestSvcCtx(t, nil)
+	driver.name = exchange.Hyperliquid
+	subA := newFakeSubscriber(exchange.Hyperliquid)
+	subB := newFakeSubscriber(exchange.Variational)
+
+	engine := NewStrategyEngine(svcCtx, subA, subB)
+	engine.Start()
+	defer engine.Stop()
+
+	// Hyperliquid 和 Variational 使用相同的 EOA 地址
+	sa := &fakeStrategy{record: &ent.Strategy{GUID: "1", Exchange: exchange.Hyperliquid, Symbol: "BTC", Account: "0xabc"}}
+	sb := &fakeStrategy{record: &ent.Strategy{GUID: "2", Exchange: exchange.Variational, Symbol: "BTC", Account: "0xabc"}}
+	for _, s := range []*fakeStrategy{sa, sb} {
+		if err := engine.StartStrategy(s); err != nil {
+			t.Fatalf("启动策略失败: %v", err)
+		}
+	}
+
+	// 快照只触发同一交易所策略的订单同步和执行
+	subA.ch <- exchange.SubMessage{Exchange: exchange.Hyperliquid, UserOrders: &exchange.UserOrders{Exchange: exchange.Hyperliquid, Account: "0xabc", IsSnapshot: true}}
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) && sa.orderChanges() == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if got := sa.orderChanges(); got != 1 {
+		t.Errorf("Hyperliquid 策略订单变化次数 = %d, expected 1", got)
+	}
+	if got := sb.orderChanges(); got != 0 {
+		t.Errorf("Variational 策略不应收到 Hyperliquid 的订单, got %d", got)
+	}
+	if got := driver.syncCount(); got != 1 {
+		t.Errorf("订单同步次数 = %d, expected 1", got)
+	}
+
+	// 移除 Hyperliquid 的策略后，该交易所账户没有其他策略，Variational 的策略不受影响
+	if _, count := engine.removeStrategyFromEngine(sa.record.GUID); count != 0 {
+		t.Errorf("Hyperliquid 账户剩余策略数量 = %d, expected 0", count)
+	}
+	if got := engine.getUserStrategyList(exchange.Variational, "0xabc"); len(got) != 1 || got[0] != sb {
+		t.Errorf("Variational 账户策略 = %v, expected [%s]", got, sb.record.GUID)
+	}
+}
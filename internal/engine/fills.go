@@ -0,0 +1,199 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/fill"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/helper"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/fachebot/omni-grid-bot/internal/model"
+	"github.com/fachebot/omni-grid-bot/internal/util"
+	"github.com/samber/lo"
+)
+
+const (
+	// fillSyncLookback 没有本地记录且策略没有启动时间时，首次拉取成交记录和资金费用的时间范围
+	fillSyncLookback = 24 * time.Hour
+
+	// fillSyncTimeout 单个账户同步成交记录、单个交易对同步资金费用的超时时间
+	fillSyncTimeout = time.Minute
+)
+
+// SetFillSyncInterval 设置成交记录同步间隔，需要在 Start 之前调用
+// interval 小于等于0时不同步成交记录，匹配交易只记录不含手续费的利润
+func (engine *StrategyEngine) SetFillSyncInterval(interval time.Duration) {
+	engine.fillSyncInterval = interval
+}
+
+// fillSyncLoop 定期拉取成交记录并结算匹配交易的手续费
+func (engine *StrategyEngine) fillSyncLoop() {
+	ticker := time.NewTicker(engine.fillSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-engine.ctx.Done():
+			return
+		case <-ticker.C:
+			engine.syncFills()
+		}
+	}
+}
+
+// syncFills 按账户逐个同步成交记录
+func (engine *StrategyEngine) syncFills() {
+	for _, strategies := range engine.accountGroups() {
+		if err := engine.syncAccountFills(strategies); err != nil {
+			record := strategies[0].Get()
+			logger.Errorf("[StrategyEngine] 同步成交记录失败, exchange: %s, account: %s, %v", record.Exchange, record.Account, err)
+		}
+	}
+}
+
+// accountGroups 按交易所和账户分组运行中的策略
+func (engine *StrategyEngine) accountGroups() [][]Strategy {
+	engine.mutex.RLock()
+	defer engine.mutex.RUnlock()
+
+	groups := make(map[string][]Strategy)
+	for _, s := range engine.strategyMap {
+		record := s.Get()
+		key := accountKey(record.Exchange, record.Account)
+		groups[key] = append(groups[key], s)
+	}
+
+	keys := lo.Keys(groups)
+	slices.Sort(keys)
+	return lo.Map(keys, func(key string, _ int) []Strategy {
+		items := groups[key]
+		slices.SortFunc(items, func(a, b Strategy) int { return strings.Compare(a.Get().GUID, b.Get().GUID) })
+		return items
+	})
+}
+
+// syncAccountFills 同步单个账户的成交记录，并结算账户下各个策略的匹配交易手续费，包括已停止策略归档的匹配交易
+// 从最近一笔成交的时间开始拉取，账户没有成交记录时从策略的最早启动时间开始；交易所不支持查询成交记录时跳过
+func (engine *StrategyEngine) syncAccountFills(strategies []Strategy) error {
+	ctx, cancel := context.WithTimeout(engine.ctx, fillSyncTimeout)
+	defer cancel()
+
+	first := strategies[0].Get()
+	adapter, err := helper.NewExchangeAdapterFromStrategy(engine.svcCtx, first)
+	if err != nil {
+		return err
+	}
+
+	since, err := engine.fillSyncStartTime(ctx, strategies)
+	if err != nil {
+		return err
+	}
+
+	fills, err := adapter.GetFills(ctx, since)
+	if errors.Is(err, exchange.ErrExchangeUnsupported) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	err = util.Tx(ctx, engine.svcCtx.DbClient, func(tx *ent.Tx) error {
+		m := model.NewFillModel(tx.Fill)
+		for _, item := range fills {
+			args := ent.Fill{
+				Exchange:      first.Exchange,
+				Account:       first.Account,
+				Symbol:        item.Symbol,
+				FillId:        item.FillID,
+				OrderId:       item.OrderID,
+				ClientOrderId: lo.EmptyableToPtr(item.ClientOrderID),
+				Side:          fill.Side(item.Side),
+				Price:         item.Price,
+				BaseAmount:    item.BaseAmount,
+				QuoteAmount:   item.QuoteAmount,
+				Fee:           item.Fee,
+				FeeAsset:      item.FeeAsset,
+				IsMaker:       item.IsMaker,
+				Timestamp:     item.Timestamp,
+			}
+			if err := m.Upsert(ctx, args); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	running := make(map[string]struct{}, len(strategies))
+	for _, s := range strategies {
+		record := s.Get()
+		running[record.GUID] = struct{}{}
+		engine.settleFees(ctx, record)
+	}
+
+	// 已停止的策略在归档后才拉取到的成交记录，同样结算到所属的运行记录
+	strategyIds, err := engine.svcCtx.MatchedTradeModel.FindAllArchivedUnsettledStrategyIds(ctx, first.Account)
+	if err != nil {
+		return err
+	}
+	for _, strategyId := range strategyIds {
+		if _, ok := running[strategyId]; ok {
+			continue
+		}
+		record, err := engine.svcCtx.StrategyModel.FindOneByGUID(ctx, strategyId)
+		if ent.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			logger.Errorf("[StrategyEngine] 查询策略失败, strategy: %s, %v", strategyId, err)
+			continue
+		}
+		if record.Exchange == first.Exchange {
+			engine.settleFees(ctx, record)
+		}
+	}
+
+	return nil
+}
+
+// settleFees 结算策略的匹配交易手续费
+func (engine *StrategyEngine) settleFees(ctx context.Context, record *ent.Strategy) {
+	settled, err := engine.svcCtx.MatchedTradeService.SettleFees(ctx, record)
+	if err != nil {
+		logger.Errorf("[StrategyEngine] 结算匹配交易手续费失败, strategy: %s, %v", record.GUID, err)
+		return
+	}
+	if settled > 0 {
+		logger.Debugf("[StrategyEngine] 结算匹配交易手续费, strategy: %s, count: %d", record.GUID, settled)
+	}
+}
+
+// fillSyncStartTime 计算账户成交记录的拉取起始时间
+func (engine *StrategyEngine) fillSyncStartTime(ctx context.Context, strategies []Strategy) (time.Time, error) {
+	first := strategies[0].Get()
+	last, err := engine.svcCtx.FillModel.QueryLastTimestamp(ctx, first.Exchange, first.Account)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if last > 0 {
+		return time.UnixMilli(last), nil
+	}
+
+	var since *time.Time
+	for _, s := range strategies {
+		if startTime := s.Get().StartTime; startTime != nil && (since == nil || startTime.Before(*since)) {
+			since = startTime
+		}
+	}
+	if since == nil {
+		return time.Now().Add(-fillSyncLookback), nil
+	}
+	return *since, nil
+}
@@ -0,0 +1,201 @@
+package engine
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/ent/orderrepair"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/helper"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+func TestStrategyEngineSyncFills(t *testing.T) {
+	ctx := context.Background()
+	svcCtx, driver := newTestSvcCtx(t, nil)
+	client := svcCtx.DbClient
+
+	startTime := time.Now().Add(-time.Hour)
+	ts := startTime.UnixMilli()
+	driver.fills = []*exchange.Fill{
+		{Symbol: "ETH", FillID: "f1", OrderID: "1", Side: order.SideBuy, BaseAmount: decimal.RequireFromString("0.5"), Fee: decimal.RequireFromString("0.1"), Timestamp: ts + 1},
+		{Symbol: "ETH", FillID: "f2", OrderID: "1", Side: order.SideBuy, BaseAmount: decimal.RequireFromString("0.5"), Fee: decimal.RequireFromString("0.05"), Timestamp: ts + 2},
+		{Symbol: "ETH", FillID: "f3", OrderID: "2", Side: order.SideSell, BaseAmount: decimal.NewFromInt(1), Fee: decimal.RequireFromString("-0.02"), Timestamp: ts + 3},
+		{Symbol: "ETH", FillID: "f4", OrderID: "3", Side: order.SideBuy, BaseAmount: decimal.NewFromInt(1), Fee: decimal.RequireFromString("0.1"), Timestamp: ts + 4},
+	}
+
+	record := &ent.Strategy{GUID: "1", Exchange: driver.Name(), Symbol: "ETH", Account: "1", StartTime: &startTime}
+	orders := []struct {
+		orderId       string
+		clientOrderId string
+		side          order.Side
+	}{
+		{"1", "b1", order.SideBuy},
+		{"2", "s1", order.SideSell},
+		{"3", "b2", order.SideBuy},
+		{"4", "s2", order.SideSell},
+	}
+	for _, item := range orders {
+		err := svcCtx.OrderModel.Upsert(ctx, ent.Order{
+			Exchange: record.Exchange, Account: record.Account, Symbol: record.Symbol, OrderId: item.orderId, ClientOrderId: item.clientOrderId,
+			Side: item.side, Price: decimal.NewFromInt(100), BaseAmount: decimal.NewFromInt(1), FilledBaseAmount: decimal.NewFromInt(1),
+			FilledQuoteAmount: decimal.NewFromInt(100), Status: order.StatusFilled, Timestamp: ts,
+		})
+		if err != nil {
+			t.Fatalf("创建订单失败: %v", err)
+		}
+	}
+	for _, pair := range [][]string{{"b1", "s1"}, {"b2", "s2"}} {
+		err := client.MatchedTrade.Create().SetStrategyId(record.GUID).SetAccount(record.Account).SetSymbol(record.Symbol).
+			SetBuyClientOrderId(pair[0]).SetSellClientOrderId(pair[1]).SetProfit(10).Exec(ctx)
+		if err != nil {
+			t.Fatalf("创建匹配交易失败: %v", err)
+		}
+	}
+
+	engine := NewStrategyEngine(svcCtx)
+	engine.addStrategyToEngine(&fakeStrategy{record: record})
+
+	// 卖单 s2 没有成交记录时不结算
+	engine.syncFills()
+	if len(driver.fillSinces) != 1 || !driver.fillSinces[0].Equal(startTime) {
+		t.Errorf("首次拉取起始时间 = %v, expected %v", driver.fillSinces, startTime)
+	}
+	trades, err := client.MatchedTrade.Query().Order(ent.Asc("id")).All(ctx)
+	if err != nil {
+		t.Fatalf("查询匹配交易失败: %v", err)
+	}
+	if trades[0].Fee == nil || math.Abs(*trades[0].Fee-0.13) > 1e-9 || math.Abs(*trades[0].NetProfit-9.87) > 1e-9 {
+		t.Errorf("匹配交易手续费 = %v, 净利润 = %v, expected 0.13, 9.87", trades[0].Fee, trades[0].NetProfit)
+	}
+	if trades[1].Fee != nil {
+		t.Errorf("成交记录不完整的匹配交易不应结算, fee: %v", *trades[1].Fee)
+	}
+
+	// 补齐成交记录后从最近一笔成交开始拉取，重复的成交记录直接忽略
+	driver.fills = append(driver.fills, &exchange.Fill{
+		Symbol: "ETH", FillID: "f5", OrderID: "4", Side: order.SideSell, BaseAmount: decimal.NewFromInt(1), Fee: decimal.RequireFromString("0.1"), Timestamp: ts + 5,
+	})
+	engine.syncFills()
+	if len(driver.fillSinces) != 2 || driver.fillSinces[1].UnixMilli() != ts+4 {
+		t.Errorf("再次拉取起始时间 = %v, expected %d", driver.fillSinces, ts+4)
+	}
+	if count := client.Fill.Query().CountX(ctx); count != 5 {
+		t.Errorf("成交记录数量 = %d, expected 5", count)
+	}
+	fee, err := svcCtx.MatchedTradeModel.QueryTotalFee(ctx, record.GUID)
+	if err != nil {
+		t.Fatalf("查询手续费失败: %v", err)
+	}
+	if !fee.Equal(decimal.RequireFromString("0.33")) {
+		t.Errorf("手续费合计 = %s, expected 0.33", fee)
+	}
+}
+
+func TestStrategyEngineSettleReplacedAndArchivedFees(t *testing.T) {
+	ctx := context.Background()
+	svcCtx, driver := newTestSvcCtx(t, nil)
+	client := svcCtx.DbClient
+
+	startTime := time.Now().Add(-time.Hour)
+	ts := startTime.UnixMilli()
+	driver.fills = []*exchange.Fill{
+		{Symbol: "ETH", FillID: "f1", OrderID: "1", Side: order.SideBuy, BaseAmount: decimal.RequireFromString("0.4"), Fee: decimal.RequireFromString("0.04"), Timestamp: ts + 1},
+		{Symbol: "ETH", FillID: "f2", OrderID: "2", Side: order.SideBuy, BaseAmount: decimal.RequireFromString("0.6"), Fee: decimal.RequireFromString("0.06"), Timestamp: ts + 2},
+		{Symbol: "ETH", FillID: "f3", OrderID: "3", Side: order.SideSell, BaseAmount: decimal.NewFromInt(1), Fee: decimal.RequireFromString("0.1"), Timestamp: ts + 3},
+		{Symbol: "ETH", FillID: "f4", OrderID: "4", Side: order.SideBuy, BaseAmount: decimal.NewFromInt(1), Fee: decimal.RequireFromString("0.1"), Timestamp: ts + 4},
+		{Symbol: "ETH", FillID: "f5", OrderID: "5", Side: order.SideSell, BaseAmount: decimal.NewFromInt(1), Fee: decimal.RequireFromString("0.1"), Timestamp: ts + 5},
+	}
+
+	running := &ent.Strategy{GUID: "1", Exchange: driver.Name(), Symbol: "ETH", Account: "1", StartTime: &startTime}
+	stopped, err := svcCtx.StrategyModel.Save(ctx, ent.Strategy{
+		GUID: "2", Owner: 1, Exchange: driver.Name(), Symbol: "ETH", Account: "1", Mode: strategy.ModeLong,
+		MarginMode: strategy.MarginModeCross, QuantityMode: strategy.QuantityModeArithmetic, PriceUpper: decimal.NewFromInt(110),
+		PriceLower: decimal.NewFromInt(90), GridNum: 10, Leverage: 1, InitialOrderSize: decimal.NewFromInt(1),
+		SizingMode: strategy.SizingModeFixed, CancelRepairPolicy: strategy.CancelRepairPolicyRepair,
+		ReconcilePolicy: strategy.ReconcilePolicyAlert, Status: strategy.StatusInactive,
+	})
+	if err != nil {
+		t.Fatalf("创建策略失败: %v", err)
+	}
+
+	// 买单 b1 部分成交后被取消，重新挂出 b2
+	orders := []struct {
+		orderId       string
+		clientOrderId string
+		side          order.Side
+		filled        string
+	}{
+		{"1", "b1", order.SideBuy, "0.4"},
+		{"2", "b2", order.SideBuy, "0.6"},
+		{"3", "s1", order.SideSell, "1"},
+		{"4", "b3", order.SideBuy, "1"},
+		{"5", "s3", order.SideSell, "1"},
+	}
+	for _, item := range orders {
+		err = svcCtx.OrderModel.Upsert(ctx, ent.Order{
+			Exchange: running.Exchange, Account: running.Account, Symbol: running.Symbol, OrderId: item.orderId, ClientOrderId: item.clientOrderId,
+			Side: item.side, Price: decimal.NewFromInt(100), BaseAmount: decimal.NewFromInt(1), FilledBaseAmount: decimal.RequireFromString(item.filled),
+			FilledQuoteAmount: decimal.RequireFromString(item.filled).Mul(decimal.NewFromInt(100)), Status: order.StatusFilled, Timestamp: ts,
+		})
+		if err != nil {
+			t.Fatalf("创建订单失败: %v", err)
+		}
+	}
+	_, err = svcCtx.OrderRepairModel.Create(ctx, ent.OrderRepair{
+		StrategyId: running.GUID, Exchange: running.Exchange, Symbol: running.Symbol, Account: running.Account, Level: 1,
+		Side: orderrepair.SideBuy, Price: decimal.NewFromInt(100), Quantity: decimal.RequireFromString("0.6"),
+		CanceledClientOrderId: "b1", NewClientOrderId: lo.ToPtr("b2"), Action: orderrepair.ActionRepair, Attempt: 1,
+	})
+	if err != nil {
+		t.Fatalf("创建修复记录失败: %v", err)
+	}
+	err = client.MatchedTrade.Create().SetStrategyId(running.GUID).SetAccount(running.Account).SetSymbol(running.Symbol).
+		SetBuyClientOrderId("b2").SetSellClientOrderId("s1").SetProfit(10).Exec(ctx)
+	if err != nil {
+		t.Fatalf("创建匹配交易失败: %v", err)
+	}
+
+	// 已停止策略的匹配交易在手续费结算前归档
+	run, err := svcCtx.StrategyRunModel.Create(ctx, helper.NewStrategyRun(stopped, startTime))
+	if err != nil {
+		t.Fatalf("创建运行记录失败: %v", err)
+	}
+	err = svcCtx.StrategyRunModel.Close(ctx, run.ID, ent.StrategyRun{Fee: lo.ToPtr(decimal.Zero), TotalProfit: lo.ToPtr(decimal.NewFromInt(10))})
+	if err != nil {
+		t.Fatalf("结束运行记录失败: %v", err)
+	}
+	err = client.MatchedTrade.Create().SetStrategyId(stopped.GUID).SetAccount(stopped.Account).SetSymbol(stopped.Symbol).
+		SetBuyClientOrderId("b3").SetSellClientOrderId("s3").SetProfit(10).SetRunId(run.ID).Exec(ctx)
+	if err != nil {
+		t.Fatalf("创建匹配交易失败: %v", err)
+	}
+
+	engine := NewStrategyEngine(svcCtx)
+	engine.addStrategyToEngine(&fakeStrategy{record: running})
+	engine.syncFills()
+
+	trades, err := client.MatchedTrade.Query().Order(ent.Asc("id")).All(ctx)
+	if err != nil {
+		t.Fatalf("查询匹配交易失败: %v", err)
+	}
+	for i, want := range []float64{0.2, 0.2} {
+		if trades[i].Fee == nil || math.Abs(*trades[i].Fee-want) > 1e-9 {
+			t.Errorf("匹配交易 %d 手续费 = %v, expected %v", trades[i].ID, trades[i].Fee, want)
+		}
+	}
+
+	run, err = svcCtx.StrategyRunModel.FindOne(ctx, run.ID)
+	if err != nil {
+		t.Fatalf("查询运行记录失败: %v", err)
+	}
+	if !lo.FromPtr(run.Fee).Equal(decimal.RequireFromString("0.2")) || !lo.FromPtr(run.TotalProfit).Equal(decimal.RequireFromString("9.8")) {
+		t.Errorf("运行记录手续费 = %v, 总利润 = %v, expected 0.2, 9.8", run.Fee, run.TotalProfit)
+	}
+}
@@ -0,0 +1,190 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/helper"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/fachebot/omni-grid-bot/internal/model"
+	"github.com/fachebot/omni-grid-bot/internal/util"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// SetFundingSyncInterval 设置资金费用同步间隔，需要在 Start 之前调用
+// interval 小于等于0时不同步资金费用，策略收益使用交易所持仓的累计资金费用
+func (engine *StrategyEngine) SetFundingSyncInterval(interval time.Duration) {
+	engine.fundingSyncInterval = interval
+}
+
+// fundingSyncLoop 定期拉取资金费用记录并归属到策略
+func (engine *StrategyEngine) fundingSyncLoop() {
+	ticker := time.NewTicker(engine.fundingSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-engine.ctx.Done():
+			return
+		case <-ticker.C:
+			engine.syncFunding()
+		}
+	}
+}
+
+// syncFunding 按账户和交易对逐个同步资金费用记录
+func (engine *StrategyEngine) syncFunding() {
+	for _, strategies := range engine.accountGroups() {
+		symbols := lo.Uniq(lo.Map(strategies, func(s Strategy, _ int) string { return s.Get().Symbol }))
+		slices.Sort(symbols)
+
+		for _, symbol := range symbols {
+			items := lo.Filter(strategies, func(s Strategy, _ int) bool { return s.Get().Symbol == symbol })
+			if err := engine.syncSymbolFunding(items); err != nil {
+				record := items[0].Get()
+				logger.Errorf("[StrategyEngine] 同步资金费用失败, exchange: %s, account: %s, symbol: %s, %v",
+					record.Exchange, record.Account, record.Symbol, err)
+			}
+		}
+	}
+}
+
+// syncSymbolFunding 同步账户在单个交易对的资金费用记录，并按持仓分摊到结算时运行中的策略
+// 从最近一笔资金费用的时间开始拉取，没有资金费用记录时从策略的最早启动时间开始；交易所不支持查询资金费用时跳过
+func (engine *StrategyEngine) syncSymbolFunding(strategies []Strategy) error {
+	ctx, cancel := context.WithTimeout(engine.ctx, fillSyncTimeout)
+	defer cancel()
+
+	first := strategies[0].Get()
+	adapter, err := helper.NewExchangeAdapterFromStrategy(engine.svcCtx, first)
+	if err != nil {
+		return err
+	}
+
+	since, err := engine.fundingSyncStartTime(ctx, strategies)
+	if err != nil {
+		return err
+	}
+
+	payments, err := adapter.GetFundingPayments(ctx, first.Symbol, since)
+	if errors.Is(err, exchange.ErrExchangeUnsupported) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(payments) == 0 {
+		return nil
+	}
+
+	positions, err := engine.strategyPositions(ctx, strategies)
+	if err != nil {
+		return err
+	}
+
+	return util.Tx(ctx, engine.svcCtx.DbClient, func(tx *ent.Tx) error {
+		m := model.NewFundingPaymentModel(tx.FundingPayment)
+		for _, item := range payments {
+			for _, share := range splitFunding(strategies, positions, item) {
+				args := ent.FundingPayment{
+					Exchange:     first.Exchange,
+					Account:      first.Account,
+					Symbol:       item.Symbol,
+					PaymentId:    item.PaymentID,
+					StrategyId:   share.strategyId,
+					Amount:       share.amount,
+					Rate:         item.Rate,
+					PositionSize: item.PositionSize,
+					Timestamp:    item.Timestamp,
+				}
+				if err := m.Upsert(ctx, args); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// fundingShare 资金费用归属到单个策略的部分
+type fundingShare struct {
+	strategyId *string
+	amount     decimal.Decimal
+}
+
+// strategyPositions 查询每个策略未平仓的持仓数量，多空持仓相抵后取绝对值
+func (engine *StrategyEngine) strategyPositions(ctx context.Context, strategies []Strategy) (map[string]decimal.Decimal, error) {
+	positions := make(map[string]decimal.Decimal, len(strategies))
+	for _, s := range strategies {
+		record := s.Get()
+		longPosition, _, err := engine.svcCtx.MatchedTradeModel.QueryOpeLongPositionAndCost(ctx, record.GUID)
+		if err != nil {
+			return nil, err
+		}
+		shortPosition, _, err := engine.svcCtx.MatchedTradeModel.QueryOpenShortPositionAndCost(ctx, record.GUID)
+		if err != nil {
+			return nil, err
+		}
+		positions[record.GUID] = longPosition.Sub(shortPosition).Abs()
+	}
+	return positions, nil
+}
+
+// splitFunding 将资金费用拆分到结算时已经启动的策略
+// 按策略未平仓的持仓数量分摊，策略都没有持仓时平均分摊，最后一个策略承担舍入误差；结算时没有运行中的策略时不归属到策略
+func splitFunding(strategies []Strategy, positions map[string]decimal.Decimal, payment *exchange.FundingPayment) []fundingShare {
+	active := lo.Filter(strategies, func(s Strategy, _ int) bool {
+		startTime := s.Get().StartTime
+		return startTime != nil && startTime.UnixMilli() <= payment.Timestamp
+	})
+	if len(active) == 0 {
+		return []fundingShare{{amount: payment.Amount}}
+	}
+
+	weights := lo.Map(active, func(s Strategy, _ int) decimal.Decimal { return positions[s.Get().GUID] })
+	total := decimal.Sum(decimal.Zero, weights...)
+	if !total.IsPositive() {
+		weights = lo.Map(active, func(_ Strategy, _ int) decimal.Decimal { return decimal.NewFromInt(1) })
+		total = decimal.NewFromInt(int64(len(active)))
+	}
+
+	shares := make([]fundingShare, 0, len(active))
+	remaining := payment.Amount
+	for idx, s := range active {
+		amount := remaining
+		if idx < len(active)-1 {
+			amount = payment.Amount.Mul(weights[idx]).Div(total)
+			remaining = remaining.Sub(amount)
+		}
+		shares = append(shares, fundingShare{strategyId: &s.Get().GUID, amount: amount})
+	}
+	return shares
+}
+
+// fundingSyncStartTime 计算账户在交易对的资金费用拉取起始时间
+func (engine *StrategyEngine) fundingSyncStartTime(ctx context.Context, strategies []Strategy) (time.Time, error) {
+	first := strategies[0].Get()
+	last, err := engine.svcCtx.FundingPaymentModel.QueryLastTimestamp(ctx, first.Exchange, first.Account, first.Symbol)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if last > 0 {
+		return time.UnixMilli(last), nil
+	}
+
+	var since *time.Time
+	for _, s := range strategies {
+		if startTime := s.Get().StartTime; startTime != nil && (since == nil || startTime.Before(*since)) {
+			since = startTime
+		}
+	}
+	if since == nil {
+		return time.Now().Add(-fillSyncLookback), nil
+	}
+	return *since, nil
+}
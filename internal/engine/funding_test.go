@@ -0,0 +1,140 @@
+package engine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/fundingpayment"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+func TestStrategyEngineSyncFunding(t *testing.T) {
+	ctx := context.Background()
+	svcCtx, driver := newTestSvcCtx(t, nil)
+	client := svcCtx.DbClient
+
+	now := time.Now()
+	firstStart := now.Add(-3 * time.Hour)
+	secondStart := now.Add(-time.Hour)
+	btcStart := now.Add(-2 * time.Hour)
+	driver.payments = []*exchange.FundingPayment{
+		{Symbol: "ETH", PaymentID: "e1", Amount: decimal.RequireFromString("0.5"), Timestamp: now.Add(-150 * time.Minute).UnixMilli()},
+		{Symbol: "ETH", PaymentID: "e2", Amount: decimal.RequireFromString("-0.2"), Timestamp: now.Add(-30 * time.Minute).UnixMilli()},
+		{Symbol: "BTC", PaymentID: "b1", Amount: decimal.RequireFromString("1.5"), Timestamp: now.Add(-90 * time.Minute).UnixMilli()},
+	}
+
+	engine := NewStrategyEngine(svcCtx)
+	for _, record := range []*ent.Strategy{
+		{GUID: "1", Exchange: driver.Name(), Symbol: "ETH", Account: "1", StartTime: &firstStart},
+		{GUID: "2", Exchange: driver.Name(), Symbol: "ETH", Account: "1", StartTime: &secondStart},
+		{GUID: "3", Exchange: driver.Name(), Symbol: "BTC", Account: "1", StartTime: &btcStart},
+	} {
+		engine.addStrategyToEngine(&fakeStrategy{record: record})
+	}
+
+	// 策略 1、2 分别持有 1 和 3 个 ETH 多头
+	for guid, amount := range map[string]string{"1": "1", "2": "3"} {
+		err := client.MatchedTrade.Create().SetStrategyId(guid).SetAccount("1").SetSymbol("ETH").
+			SetBuyClientOrderId("b" + guid).SetBuyBaseAmount(decimal.RequireFromString(amount)).
+			SetBuyQuoteAmount(decimal.RequireFromString(amount).Mul(decimal.NewFromInt(100))).
+			SetBuyOrderTimestamp(firstStart.UnixMilli()).Exec(ctx)
+		if err != nil {
+			t.Fatalf("创建匹配交易失败: %v", err)
+		}
+	}
+
+	// 首次从同一交易对策略的最早启动时间开始拉取，资金费用按持仓分摊到结算时已经启动的策略
+	engine.syncFunding()
+	if since := driver.fundingRequests["ETH"]; len(since) != 1 || !since[0].Equal(firstStart) {
+		t.Errorf("ETH 首次拉取起始时间 = %v, expected %v", since, firstStart)
+	}
+	if since := driver.fundingRequests["BTC"]; len(since) != 1 || !since[0].Equal(btcStart) {
+		t.Errorf("BTC 首次拉取起始时间 = %v, expected %v", since, btcStart)
+	}
+	for guid, expected := range map[string]string{"1": "0.45", "2": "-0.15", "3": "1.5"} {
+		total, ok, err := svcCtx.FundingPaymentModel.QueryTotalAmount(ctx, guid)
+		if err != nil {
+			t.Fatalf("查询资金费用失败: %v", err)
+		}
+		if !ok || !total.Equal(decimal.RequireFromString(expected)) {
+			t.Errorf("策略 %s 资金费用 = %s, ok = %v, expected %s", guid, total, ok, expected)
+		}
+	}
+
+	// 再次同步从最近一笔资金费用开始拉取，重复的资金费用记录直接忽略
+	engine.syncFunding()
+	if since := driver.fundingRequests["ETH"]; len(since) != 2 || since[1].UnixMilli() != driver.payments[1].Timestamp {
+		t.Errorf("ETH 再次拉取起始时间 = %v, expected %d", since, driver.payments[1].Timestamp)
+	}
+	if count := client.FundingPayment.Query().Where(fundingpayment.SymbolEQ("ETH")).CountX(ctx); count != 3 {
+		t.Errorf("ETH 资金费用记录数量 = %d, expected 3", count)
+	}
+	if _, ok, _ := svcCtx.FundingPaymentModel.QueryTotalAmount(ctx, "4"); ok {
+		t.Errorf("没有资金费用记录的策略 ok 应为 false")
+	}
+}
+
+func TestSplitFunding(t *testing.T) {
+	now := time.Now()
+	firstStart := now.Add(-2 * time.Hour)
+	secondStart := now.Add(-time.Hour)
+	strategies := []Strategy{
+		&fakeStrategy{record: &ent.Strategy{GUID: "1", StartTime: &firstStart}},
+		&fakeStrategy{record: &ent.Strategy{GUID: "2", StartTime: &secondStart}},
+		&fakeStrategy{record: &ent.Strategy{GUID: "3", StartTime: &secondStart}},
+	}
+
+	tests := []struct {
+		name      string
+		positions map[string]string
+		amount    string
+		timestamp time.Time
+		expected  map[string]string // 策略ID -> 分摊金额，空字符串表示未归属到策略
+	}{
+		{
+			name: "按持仓分摊", positions: map[string]string{"1": "1", "2": "2", "3": "1"},
+			amount: "1", timestamp: now, expected: map[string]string{"1": "0.25", "2": "0.5", "3": "0.25"},
+		},
+		{
+			name: "没有持仓的策略不分摊", positions: map[string]string{"1": "2", "3": "1"},
+			amount: "-0.3", timestamp: now, expected: map[string]string{"1": "-0.2", "2": "0", "3": "-0.1"},
+		},
+		{
+			name: "都没有持仓时平均分摊", amount: "1", timestamp: now,
+			expected: map[string]string{"1": "0.3333333333333333", "2": "0.3333333333333333", "3": "0.3333333333333334"},
+		},
+		{
+			name: "只分摊到结算时已经启动的策略", positions: map[string]string{"1": "1", "2": "2", "3": "1"},
+			amount: "0.6", timestamp: now.Add(-90 * time.Minute), expected: map[string]string{"1": "0.6"},
+		},
+		{
+			name: "结算时没有运行中的策略", amount: "0.6", timestamp: now.Add(-3 * time.Hour),
+			expected: map[string]string{"": "0.6"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			positions := make(map[string]decimal.Decimal)
+			for guid, position := range tt.positions {
+				positions[guid] = decimal.RequireFromString(position)
+			}
+			payment := &exchange.FundingPayment{Amount: decimal.RequireFromString(tt.amount), Timestamp: tt.timestamp.UnixMilli()}
+
+			shares := splitFunding(strategies, positions, payment)
+			if len(shares) != len(tt.expected) {
+				t.Fatalf("分摊数量 = %d, expected %d", len(shares), len(tt.expected))
+			}
+			for _, share := range shares {
+				guid := lo.FromPtr(share.strategyId)
+				if expected, ok := tt.expected[guid]; !ok || !share.amount.Equal(decimal.RequireFromString(expected)) {
+					t.Errorf("策略 %q 分摊金额 = %s, expected %s", guid, share.amount, expected)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/config"
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/svc"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+const testExchange = "engine-test"
+
+var testDatabaseSequence atomic.Int64
+
+// testDriver 可配置的测试交易所驱动，返回预设的账户、价格、挂单、成交和资金费用，并记录引擎发起的请求
+type testDriver struct {
+	exchange.Driver
+	name  string
+	mutex sync.Mutex
+
+	account    *exchange.Account // 不为空时直接返回，否则按 position 生成多头持仓
+	position   decimal.Decimal
+	prices     map[string]decimal.Decimal
+	openOrders []*exchange.Order
+	fills      []*exchange.Fill
+	payments   []*exchange.FundingPayment
+
+	canceled        []string               // 撤销的订单ID
+	syncs           int                    // 订单同步次数
+	fillSinces      []time.Time            // 每次拉取成交记录的起始时间
+	fundingRequests map[string][]time.Time // 交易对 -> 每次拉取资金费用的起始时间
+}
+
+func (d *testDriver) Name() string { return d.name }
+
+func (d *testDriver) NewOrderHelper(record *ent.Strategy) (exchange.OrderHelper, error) {
+	return &testOrderHelper{driver: d}, nil
+}
+
+func (d *testDriver) GetAccountInfo(ctx context.Context, record *ent.Strategy) (*exchange.Account, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if d.account != nil {
+		return d.account, nil
+	}
+	position := &exchange.Position{Symbol: record.Symbol, Side: exchange.PositionSideLong, Position: d.position}
+	return &exchange.Account{Positions: []*exchange.Position{position}}, nil
+}
+
+func (d *testDriver) GetLastTradePrice(ctx context.Context, symbol string) (decimal.Decimal, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return d.prices[symbol], nil
+}
+
+func (d *testDriver) syncCount() int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return d.syncs
+}
+
+// testOrderHelper 读取和记录 testDriver 数据的订单操作客户端
+type testOrderHelper struct {
+	exchange.OrderHelper
+	driver *testDriver
+}
+
+func (h *testOrderHelper) GetOpenOrders(ctx context.Context, symbol string) ([]*exchange.Order, error) {
+	h.driver.mutex.Lock()
+	defer h.driver.mutex.Unlock()
+	return slices.Clone(h.driver.openOrders), nil
+}
+
+func (h *testOrderHelper) CancelOrders(ctx context.Context, symbol string, orderIds []string) error {
+	h.driver.mutex.Lock()
+	defer h.driver.mutex.Unlock()
+	h.driver.canceled = append(h.driver.canceled, orderIds...)
+	h.driver.openOrders = lo.Filter(h.driver.openOrders, func(item *exchange.Order, _ int) bool {
+		return !slices.Contains(orderIds, item.OrderID)
+	})
+	return nil
+}
+
+func (h *testOrderHelper) SyncUserOrders(ctx context.Context) error {
+	h.driver.mutex.Lock()
+	defer h.driver.mutex.Unlock()
+	h.driver.syncs++
+	return nil
+}
+
+func (h *testOrderHelper) GetFills(ctx context.Context, since time.Time) ([]*exchange.Fill, error) {
+	h.driver.mutex.Lock()
+	defer h.driver.mutex.Unlock()
+	h.driver.fillSinces = append(h.driver.fillSinces, since)
+	return lo.Filter(h.driver.fills, func(item *exchange.Fill, _ int) bool { return item.Timestamp >= since.UnixMilli() }), nil
+}
+
+func (h *testOrderHelper) GetFundingPayments(ctx context.Context, symbol string, since time.Time) ([]*exchange.FundingPayment, error) {
+	h.driver.mutex.Lock()
+	defer h.driver.mutex.Unlock()
+	h.driver.fundingRequests[symbol] = append(h.driver.fundingRequests[symbol], since)
+	return lo.Filter(h.driver.payments, func(item *exchange.FundingPayment, _ int) bool {
+		return item.Symbol == symbol && item.Timestamp >= since.UnixMilli()
+	}), nil
+}
+
+// newTestSvcCtx 创建使用内存数据库和测试驱动的服务上下文
+func newTestSvcCtx(t *testing.T, c *config.Config) (*svc.ServiceContext, *testDriver) {
+	t.Helper()
+
+	ctx := context.Background()
+	dsn := fmt.Sprintf("file:engine-%d?mode=memory&cache=shared&_fk=1", testDatabaseSequence.Add(1))
+	client, err := ent.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatalf("打开数据库失败: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+	if err = client.Schema.Create(ctx); err != nil {
+		t.Fatalf("创建数据表失败: %v", err)
+	}
+
+	if c == nil {
+		c = &config.Config{}
+	}
+	driver := &testDriver{name: testExchange, prices: make(map[string]decimal.Decimal), fundingRequests: make(map[string][]time.Time)}
+	return svc.NewIsolatedServiceContext(c, client, driver), driver
+}
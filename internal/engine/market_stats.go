@@ -1,6 +1,10 @@
 package engine
 
-import "github.com/fachebot/omni-grid-bot/internal/exchange"
+import (
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+)
 
 // getMarketStrategyList 获取市场策略列表
 func (engine *StrategyEngine) getMarketStrategyList(exchange, symbol string) []Strategy {
@@ -21,6 +25,11 @@ func (engine *StrategyEngine) getMarketStrategyList(exchange, symbol string) []S
 
 // processMarketStats 处理市场状态
 func (engine *StrategyEngine) processMarketStats(exchange string, marketStats exchange.MarketStats) {
+	// 聚合K线，供不提供历史K线的交易所计算自适应网格的波动率
+	if engine.svcCtx != nil {
+		engine.svcCtx.CandleCache.Add(exchange, marketStats.Symbol, marketStats.MarkPrice, time.Now())
+	}
+
 	strategyList := engine.getMarketStrategyList(exchange, marketStats.Symbol)
 	for _, s := range strategyList {
 		s.OnTicker(engine.ctx, marketStats.MarkPrice)
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategyrun"
 	"github.com/fachebot/omni-grid-bot/internal/exchange"
 	"github.com/fachebot/omni-grid-bot/internal/helper"
 	"github.com/fachebot/omni-grid-bot/internal/logger"
@@ -22,16 +23,16 @@ func (engine *StrategyEngine) processOrders(userOrders exchange.UserOrders) {
 	}
 
 	if err = engine.handleUserOrders(userOrders, orders); err != nil {
-		engine.resubscribe(userOrders.Account)
+		engine.resubscribe(userOrders.Exchange, userOrders.Account)
 	}
 }
 
-// getUserStrategyList 获取用户策略列表
-func (engine *StrategyEngine) getUserStrategyList(account string) []Strategy {
+// getUserStrategyList 获取交易所账户的策略列表
+func (engine *StrategyEngine) getUserStrategyList(exchangeName, account string) []Strategy {
 	engine.mutex.Lock()
 	defer engine.mutex.Unlock()
 
-	userStrategyIds, ok := engine.userStrategyMap[account]
+	userStrategyIds, ok := engine.userStrategyMap[accountKey(exchangeName, account)]
 	if !ok {
 		return nil
 	}
@@ -51,7 +52,7 @@ func (engine *StrategyEngine) syncUserOrders(userOrders exchange.UserOrders, new
 	logger.Debugf("[StrategyEngine] 同步用户订单开始, account: %s", userOrders.Account)
 
 	// 查询账户策略
-	userStrategyList := engine.getUserStrategyList(userOrders.Account)
+	userStrategyList := engine.getUserStrategyList(userOrders.Exchange, userOrders.Account)
 	if len(userStrategyList) == 0 {
 		logger.Debugf("[StrategyEngine] 账户没有正在运行的策略, account: %s", userOrders.Account)
 		return nil
@@ -141,7 +142,7 @@ func (engine *StrategyEngine) handleUserOrders(userOrders exchange.UserOrders, n
 	}
 
 	// 执行用户策略
-	userStrategyList := engine.getUserStrategyList(userOrders.Account)
+	userStrategyList := engine.getUserStrategyList(userOrders.Exchange, userOrders.Account)
 	for _, strategy := range userStrategyList {
 		engine.executeStrategy(strategy)
 	}
@@ -152,7 +153,7 @@ func (engine *StrategyEngine) handleUserOrders(userOrders exchange.UserOrders, n
 // handleOrderCancelled 处理订单被取消的情况
 func (engine *StrategyEngine) handleOrderCancelled(record *ent.Strategy) {
 	// 停止网格策略
-	err := helper.StopStrategyAndCancelOrders(engine.ctx, engine.svcCtx, engine, record)
+	err := helper.StopStrategyAndCancelOrders(engine.ctx, engine.svcCtx, engine, record, strategyrun.StopReasonOrderCanceled)
 	if err != nil {
 		logger.Warnf("[StrategyEngine] 停止策略并取消订单失败, exchange: %s, account: %s, symbol: %s, side: %s, %v",
 			record.Exchange, record.Account, record.Symbol, record.Mode, err)
@@ -0,0 +1,412 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/helper"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/fachebot/omni-grid-bot/internal/model"
+	"github.com/fachebot/omni-grid-bot/internal/util"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// reconcileGracePeriod 新挂出的订单在宽限期内不参与对账，避免和正在执行的下单流程冲突
+	reconcileGracePeriod = time.Minute
+
+	// reconcileTimeout 单个交易对对账的超时时间
+	reconcileTimeout = 30 * time.Second
+)
+
+// MissingOrder 网格引用但交易所没有对应挂单的订单
+type MissingOrder struct {
+	StrategyId    string          // 策略ID
+	Level         int             // 网格档位
+	Side          order.Side      // 订单方向
+	Price         decimal.Decimal // 档位价格
+	Quantity      decimal.Decimal // 档位数量
+	ClientOrderId string          // 客户端订单ID
+	Resolved      bool            // 同步订单记录后已确认成交或取消
+	Fixed         bool            // 已在本地标记为取消，由策略按撤单处理方式修复
+}
+
+// ReconcileReport 对账结果
+// 同一账户同一交易对的策略共享交易所的挂单和持仓，按交易对汇总对账
+type ReconcileReport struct {
+	Exchange string
+	Account  string
+	Symbol   string
+
+	OrphanOrders   []*exchange.Order // 交易所存在但没有网格引用的挂单
+	OrphanCanceled bool              // 孤立挂单已撤销
+	MissingOrders  []*MissingOrder   // 网格引用但交易所没有对应挂单的订单
+
+	LocalPosition    decimal.Decimal // 按匹配交易和挂单成交推算的净持仓，多头为正、空头为负
+	ExchangePosition decimal.Decimal // 交易所的净持仓，多头为正、空头为负
+	PositionDrift    bool            // 持仓偏差达到最小档位数量
+}
+
+// HasDrift 是否存在差异
+func (r *ReconcileReport) HasDrift() bool {
+	unresolved := lo.ContainsBy(r.MissingOrders, func(item *MissingOrder) bool { return !item.Resolved })
+	return len(r.OrphanOrders) > 0 || unresolved || r.PositionDrift
+}
+
+// SetReconcileInterval 设置对账间隔，需要在 Start 之前调用
+// interval 小于等于0时不启动对账
+func (engine *StrategyEngine) SetReconcileInterval(interval time.Duration) {
+	engine.reconcileInterval = interval
+}
+
+// reconcileLoop 定期核对本地网格和交易所的挂单和持仓
+func (engine *StrategyEngine) reconcileLoop() {
+	ticker := time.NewTicker(engine.reconcileInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-engine.ctx.Done():
+			return
+		case <-ticker.C:
+			engine.reconcile()
+		}
+	}
+}
+
+// reconcile 按交易对逐个对账
+func (engine *StrategyEngine) reconcile() []*ReconcileReport {
+	reports := make([]*ReconcileReport, 0)
+	for _, strategies := range engine.reconcileGroups() {
+		report, err := engine.reconcileSymbol(strategies)
+		if err != nil {
+			record := strategies[0].Get()
+			logger.Errorf("[StrategyEngine] 对账失败, exchange: %s, account: %s, symbol: %s, %v",
+				record.Exchange, record.Account, record.Symbol, err)
+			continue
+		}
+		if report != nil {
+			reports = append(reports, report)
+		}
+	}
+	return reports
+}
+
+// reconcileGroups 按交易所、账户和交易对分组运行中的策略
+func (engine *StrategyEngine) reconcileGroups() [][]Strategy {
+	engine.mutex.RLock()
+	defer engine.mutex.RUnlock()
+
+	groups := make(map[string][]Strategy)
+	for _, s := range engine.strategyMap {
+		record := s.Get()
+		key := fmt.Sprintf("%s:%s:%s", record.Exchange, record.Account, record.Symbol)
+		groups[key] = append(groups[key], s)
+	}
+
+	keys := lo.Keys(groups)
+	slices.Sort(keys)
+	return lo.Map(keys, func(key string, _ int) []Strategy {
+		items := groups[key]
+		slices.SortFunc(items, func(a, b Strategy) int { return strings.Compare(a.Get().GUID, b.Get().GUID) })
+		return items
+	})
+}
+
+// reconcileSymbol 核对单个交易对的挂单和持仓
+// 1. 交易所存在但没有网格引用的挂单为孤立挂单，修复模式下撤销
+// 2. 网格引用但交易所不存在的订单先同步订单记录，仍无法确认成交或取消时修复模式下标记为取消，由策略按撤单处理方式修复
+// 3. 交易所净持仓和本地推算的净持仓偏差达到最小档位数量时告警，持仓偏差不会自动修复
+// 交易所不支持查询活跃订单时跳过对账
+func (engine *StrategyEngine) reconcileSymbol(strategies []Strategy) (*ReconcileReport, error) {
+	ctx, cancel := context.WithTimeout(engine.ctx, reconcileTimeout)
+	defer cancel()
+
+	first := strategies[0].Get()
+	adapter, err := helper.NewExchangeAdapterFromStrategy(engine.svcCtx, first)
+	if err != nil {
+		return nil, err
+	}
+
+	openOrders, err := adapter.GetOpenOrders(ctx, first.Symbol)
+	if errors.Is(err, exchange.ErrExchangeUnsupported) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ReconcileReport{Exchange: first.Exchange, Account: first.Account, Symbol: first.Symbol}
+	openOrderMap := lo.SliceToMap(openOrders, func(item *exchange.Order) (string, *exchange.Order) {
+		return item.ClientOrderID, item
+	})
+
+	// 对比网格挂单和交易所挂单
+	deadline := time.Now().Add(-reconcileGracePeriod).UnixMilli()
+	referenced := make(map[string]struct{})
+	minQuantity := decimal.Zero
+	for _, s := range strategies {
+		record := s.Get()
+		grids, err := engine.svcCtx.GridModel.FindAllByStrategyIdOrderAsc(ctx, record.GUID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, grid := range grids {
+			if minQuantity.IsZero() || grid.Quantity.LessThan(minQuantity) {
+				minQuantity = grid.Quantity
+			}
+
+			refs := []struct {
+				side          order.Side
+				clientOrderId *string
+				timestamp     *int64
+			}{
+				{order.SideBuy, grid.BuyClientOrderId, grid.BuyClientOrderTime},
+				{order.SideSell, grid.SellClientOrderId, grid.SellClientOrderTime},
+			}
+			for _, ref := range refs {
+				if ref.clientOrderId == nil {
+					continue
+				}
+				referenced[*ref.clientOrderId] = struct{}{}
+
+				if ord, ok := openOrderMap[*ref.clientOrderId]; ok {
+					filled := lo.If(ord.Side == order.SideBuy, ord.FilledBaseAmount).Else(ord.FilledBaseAmount.Neg())
+					report.LocalPosition = report.LocalPosition.Add(filled)
+					continue
+				}
+				if lo.FromPtr(ref.timestamp) > deadline {
+					continue
+				}
+				report.MissingOrders = append(report.MissingOrders, &MissingOrder{
+					StrategyId:    record.GUID,
+					Level:         grid.Level,
+					Side:          ref.side,
+					Price:         grid.Price,
+					Quantity:      grid.Quantity,
+					ClientOrderId: *ref.clientOrderId,
+				})
+			}
+		}
+
+		longPosition, _, err := engine.svcCtx.MatchedTradeModel.QueryOpeLongPositionAndCost(ctx, record.GUID)
+		if err != nil {
+			return nil, err
+		}
+		shortPosition, _, err := engine.svcCtx.MatchedTradeModel.QueryOpenShortPositionAndCost(ctx, record.GUID)
+		if err != nil {
+			return nil, err
+		}
+		report.LocalPosition = report.LocalPosition.Add(longPosition).Sub(shortPosition)
+	}
+
+	for _, item := range openOrders {
+		if _, ok := referenced[item.ClientOrderID]; ok {
+			continue
+		}
+		if engine.svcCtx.PendingOrdersCache.Exist(first.Exchange, first.Account, item.ClientOrderID) || item.Timestamp > deadline {
+			continue
+		}
+		report.OrphanOrders = append(report.OrphanOrders, item)
+	}
+
+	// 对比交易所持仓
+	account, err := helper.GetAccountInfo(ctx, engine.svcCtx, first)
+	if err != nil {
+		return nil, err
+	}
+	for _, position := range account.Positions {
+		if position.Symbol == first.Symbol {
+			size := position.Position.Abs().Mul(decimal.NewFromInt(int64(position.Side)))
+			report.ExchangePosition = report.ExchangePosition.Add(size)
+		}
+	}
+	drift := report.ExchangePosition.Sub(report.LocalPosition).Abs()
+	report.PositionDrift = lo.If(minQuantity.IsPositive(), drift.GreaterThanOrEqual(minQuantity)).Else(!drift.IsZero())
+
+	// 处理差异
+	policies := lo.SliceToMap(strategies, func(s Strategy) (string, strategy.ReconcilePolicy) {
+		return s.Get().GUID, s.Get().ReconcilePolicy
+	})
+	if err = engine.fixOrphanOrders(ctx, adapter, report, policies); err != nil {
+		logger.Errorf("[StrategyEngine] 撤销孤立挂单失败, exchange: %s, account: %s, symbol: %s, %v",
+			report.Exchange, report.Account, report.Symbol, err)
+	}
+	if err = engine.fixMissingOrders(ctx, adapter, report, policies); err != nil {
+		logger.Errorf("[StrategyEngine] 处理缺失挂单失败, exchange: %s, account: %s, symbol: %s, %v",
+			report.Exchange, report.Account, report.Symbol, err)
+	}
+
+	if report.HasDrift() {
+		logger.Warnf("[StrategyEngine] 对账发现差异, exchange: %s, account: %s, symbol: %s, orphan: %d, missing: %d, localPosition: %s, exchangePosition: %s",
+			report.Exchange, report.Account, report.Symbol, len(report.OrphanOrders), len(report.MissingOrders),
+			report.LocalPosition, report.ExchangePosition)
+	}
+	for _, s := range strategies {
+		engine.sendReconcileNotification(s.Get(), report)
+	}
+
+	return report, nil
+}
+
+// fixOrphanOrders 撤销孤立挂单
+// 孤立挂单无法确定所属策略，只有同一交易对的全部策略都为修复模式时才撤销
+func (engine *StrategyEngine) fixOrphanOrders(ctx context.Context, adapter *helper.ExchangeAdapter, report *ReconcileReport, policies map[string]strategy.ReconcilePolicy) error {
+	if len(report.OrphanOrders) == 0 {
+		return nil
+	}
+	if lo.ContainsBy(lo.Values(policies), func(item strategy.ReconcilePolicy) bool { return item != strategy.ReconcilePolicyFix }) {
+		return nil
+	}
+
+	orderIds := lo.Map(report.OrphanOrders, func(item *exchange.Order, _ int) string { return item.OrderID })
+	if err := adapter.CancelOrders(ctx, report.Symbol, orderIds); err != nil {
+		return err
+	}
+
+	report.OrphanCanceled = true
+	logger.Infof("[StrategyEngine] 已撤销孤立挂单, exchange: %s, account: %s, symbol: %s, orders: %v",
+		report.Exchange, report.Account, report.Symbol, orderIds)
+	return nil
+}
+
+// fixMissingOrders 处理缺失的网格挂单
+// 先同步交易所订单记录，已成交或取消的订单交给策略正常处理；
+// 仍无法确认状态的订单在修复模式下标记为取消，策略再平衡时按撤单处理方式重新挂单、跳过档位或停止策略
+func (engine *StrategyEngine) fixMissingOrders(ctx context.Context, adapter *helper.ExchangeAdapter, report *ReconcileReport, policies map[string]strategy.ReconcilePolicy) error {
+	if len(report.MissingOrders) == 0 {
+		return nil
+	}
+
+	if err := adapter.SyncUserOrders(ctx); err != nil {
+		return err
+	}
+
+	clientOrderIds := lo.Map(report.MissingOrders, func(item *MissingOrder, _ int) string { return item.ClientOrderId })
+	orders, err := engine.svcCtx.OrderModel.FindAllByAccountClientOrderIds(ctx, report.Exchange, report.Account, clientOrderIds)
+	if err != nil {
+		return err
+	}
+	localOrders := lo.SliceToMap(orders, func(item *ent.Order) (string, *ent.Order) { return item.ClientOrderId, item })
+
+	changed := make(map[string]struct{})
+	for _, item := range report.MissingOrders {
+		ord, ok := localOrders[item.ClientOrderId]
+		if ok && model.IsOrderFinal(ord.Status) {
+			item.Resolved = true
+			changed[item.StrategyId] = struct{}{}
+			continue
+		}
+		if policies[item.StrategyId] != strategy.ReconcilePolicyFix {
+			continue
+		}
+
+		if err = engine.markOrderCanceled(ctx, report, item, ord); err != nil {
+			return err
+		}
+		item.Fixed = true
+		changed[item.StrategyId] = struct{}{}
+	}
+
+	for id := range changed {
+		select {
+		case engine.reconciledChan <- id:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// markOrderCanceled 将缺失的网格挂单在本地标记为取消
+// 本地没有订单记录时按网格档位补充一条取消记录，订单ID使用客户端订单ID
+func (engine *StrategyEngine) markOrderCanceled(ctx context.Context, report *ReconcileReport, item *MissingOrder, ord *ent.Order) error {
+	args := ent.Order{
+		Exchange:      report.Exchange,
+		Account:       report.Account,
+		Symbol:        report.Symbol,
+		OrderId:       item.ClientOrderId,
+		ClientOrderId: item.ClientOrderId,
+		Side:          item.Side,
+		Price:         item.Price,
+		BaseAmount:    item.Quantity,
+		Status:        order.StatusCanceled,
+		Timestamp:     time.Now().UnixMilli(),
+	}
+	if ord != nil {
+		args = *ord
+		args.Status = order.StatusCanceled
+		args.Timestamp = max(ord.Timestamp+1, args.Timestamp)
+	}
+
+	if err := engine.svcCtx.OrderModel.Upsert(ctx, args); err != nil {
+		return err
+	}
+	engine.svcCtx.PendingOrdersCache.Del(report.Exchange, report.Account, item.ClientOrderId)
+
+	logger.Warnf("[StrategyEngine] 网格挂单在交易所不存在，已标记为取消, strategy: %s, level: %d, clientOrderId: %s",
+		item.StrategyId, item.Level, item.ClientOrderId)
+	return nil
+}
+
+// sendReconcileNotification 发送对账差异通知
+// 同一策略的差异没有变化时不重复通知
+func (engine *StrategyEngine) sendReconcileNotification(record *ent.Strategy, report *ReconcileReport) {
+	missingOrders := lo.Filter(report.MissingOrders, func(item *MissingOrder, _ int) bool {
+		return item.StrategyId == record.GUID && !item.Resolved
+	})
+	if len(report.OrphanOrders) == 0 && len(missingOrders) == 0 && !report.PositionDrift {
+		engine.reconcileAlerts.Delete(record.GUID)
+		return
+	}
+
+	body := fmt.Sprintf("🏦 交易平台: %s\n", record.Exchange)
+	if len(report.OrphanOrders) > 0 {
+		ids := lo.Map(report.OrphanOrders, func(item *exchange.Order, _ int) string { return item.ClientOrderID })
+		body += fmt.Sprintf("👻 孤立挂单: %d 个%s\n", len(ids), lo.If(report.OrphanCanceled, "，已撤销").Else(""))
+		body += fmt.Sprintf("🆔 订单ID: `%s`\n", strings.Join(ids, ", "))
+	}
+	if len(missingOrders) > 0 {
+		levels := lo.Map(missingOrders, func(item *MissingOrder, _ int) string {
+			return fmt.Sprintf("#%d %s", item.Level, lo.If(item.Side == order.SideBuy, "买单").Else("卖单"))
+		})
+		fixed := lo.EveryBy(missingOrders, func(item *MissingOrder) bool { return item.Fixed })
+		body += fmt.Sprintf("🕳️ 缺失挂单: %s%s\n", strings.Join(levels, ", "), lo.If(fixed, "，已按撤单处理方式修复").Else(""))
+	}
+	if report.PositionDrift {
+		body += fmt.Sprintf("⚖️ 持仓偏差: 本地 %s / 交易所 %s %s\n", report.LocalPosition, report.ExchangePosition, record.Symbol)
+	}
+	if record.ReconcilePolicy != strategy.ReconcilePolicyFix {
+		body += "\n**注意**：`当前对账方式为仅告警，请检查交易所挂单和持仓后手动处理。`"
+	} else if report.PositionDrift {
+		body += "\n**注意**：`持仓偏差不会自动修复，请检查交易所持仓后手动处理。`"
+	}
+
+	if last, ok := engine.reconcileAlerts.Load(record.GUID); ok && last == body {
+		return
+	}
+	engine.reconcileAlerts.Store(record.GUID, body)
+
+	if !record.EnablePushNotification {
+		return
+	}
+
+	link := fmt.Sprintf("[%s](https://t.me/%s?start=%s)",
+		util.StrategyName(record), engine.svcCtx.Bot.Me.Username, record.GUID)
+	text := fmt.Sprintf("🧾 %s %s 对账发现差异 %s\n\n", record.Symbol, strings.ToUpper(string(record.Mode)), link)
+	chatId := util.ChatId(record.Owner)
+	_, err := util.SendMarkdownMessage(engine.svcCtx.Bot, chatId, text+body, nil)
+	if err != nil {
+		logger.Debugf("[StrategyEngine] 发送对账差异通知失败, chat: %d, %v", chatId, err)
+	}
+}
@@ -0,0 +1,112 @@
+package engine
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+func TestStrategyEngineReconcile(t *testing.T) {
+	ctx := context.Background()
+	svcCtx, driver := newTestSvcCtx(t, nil)
+	client := svcCtx.DbClient
+
+	old := time.Now().Add(-time.Hour).UnixMilli()
+	recent := time.Now().UnixMilli()
+	driver.position = decimal.NewFromInt(1)
+	driver.openOrders = []*exchange.Order{
+		{OrderID: "1", ClientOrderID: "b1", Side: order.SideBuy, FilledBaseAmount: decimal.Zero, Timestamp: old},
+		{OrderID: "2", ClientOrderID: "x1", Side: order.SideBuy, Timestamp: old},
+		{OrderID: "3", ClientOrderID: "x2", Side: order.SideBuy, Timestamp: recent},
+		{OrderID: "4", ClientOrderID: "p1", Side: order.SideSell, Timestamp: old},
+	}
+	svcCtx.PendingOrdersCache.Add(driver.Name(), "1", "p1")
+
+	record := &ent.Strategy{GUID: "1", Exchange: driver.Name(), Symbol: "ETH", Account: "1", ReconcilePolicy: strategy.ReconcilePolicyAlert}
+	grids := []ent.Grid{
+		{Level: 0, Price: decimal.NewFromInt(90), BuyClientOrderId: lo.ToPtr("b0"), BuyClientOrderTime: &old},
+		{Level: 1, Price: decimal.NewFromInt(100), BuyClientOrderId: lo.ToPtr("b1"), BuyClientOrderTime: &old},
+		{Level: 2, Price: decimal.NewFromInt(110), SellClientOrderId: lo.ToPtr("s2"), SellClientOrderTime: &old},
+		{Level: 3, Price: decimal.NewFromInt(120), SellClientOrderId: lo.ToPtr("s3"), SellClientOrderTime: &recent},
+	}
+	for idx := range grids {
+		grids[idx].StrategyId = record.GUID
+		grids[idx].Exchange = record.Exchange
+		grids[idx].Symbol = record.Symbol
+		grids[idx].Account = record.Account
+		grids[idx].Quantity = decimal.NewFromInt(1)
+	}
+	if err := svcCtx.GridModel.CreateBulk(ctx, grids); err != nil {
+		t.Fatalf("创建网格失败: %v", err)
+	}
+	err := svcCtx.OrderModel.Upsert(ctx, ent.Order{
+		Exchange: record.Exchange, Account: record.Account, Symbol: record.Symbol, OrderId: "5", ClientOrderId: "s2",
+		Side: order.SideSell, Price: decimal.NewFromInt(110), BaseAmount: decimal.NewFromInt(1), Status: order.StatusOpen, Timestamp: old,
+	})
+	if err != nil {
+		t.Fatalf("创建订单失败: %v", err)
+	}
+	err = client.MatchedTrade.Create().SetStrategyId(record.GUID).SetAccount(record.Account).SetSymbol(record.Symbol).
+		SetBuyClientOrderId("b9").SetBuyBaseAmount(decimal.NewFromInt(1)).SetBuyQuoteAmount(decimal.NewFromInt(80)).SetBuyOrderTimestamp(old).
+		Exec(ctx)
+	if err != nil {
+		t.Fatalf("创建匹配交易失败: %v", err)
+	}
+
+	engine := NewStrategyEngine(svcCtx)
+	engine.addStrategyToEngine(&fakeStrategy{record: record})
+
+	// 仅告警时只同步订单记录，不修改挂单
+	reports := engine.reconcile()
+	if len(reports) != 1 {
+		t.Fatalf("对账结果数量 = %d, expected 1", len(reports))
+	}
+	report := reports[0]
+	if ids := lo.Map(report.OrphanOrders, func(item *exchange.Order, _ int) string { return item.ClientOrderID }); !slices.Equal(ids, []string{"x1"}) {
+		t.Errorf("孤立挂单 = %v, expected [x1]", ids)
+	}
+	if ids := lo.Map(report.MissingOrders, func(item *MissingOrder, _ int) string { return item.ClientOrderId }); !slices.Equal(ids, []string{"b0", "s2"}) {
+		t.Errorf("缺失挂单 = %v, expected [b0 s2]", ids)
+	}
+	if report.PositionDrift || !report.LocalPosition.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("持仓偏差错误, local: %s, exchange: %s", report.LocalPosition, report.ExchangePosition)
+	}
+	if len(driver.canceled) != 0 || driver.syncs != 1 || len(engine.reconciledChan) != 0 {
+		t.Errorf("仅告警时不应修复, canceled: %v, syncs: %d", driver.canceled, driver.syncs)
+	}
+
+	// 修复挂单时撤销孤立挂单并将缺失挂单标记为取消
+	record.ReconcilePolicy = strategy.ReconcilePolicyFix
+	driver.position = decimal.NewFromInt(3)
+	reports = engine.reconcile()
+	if len(reports) != 1 || !reports[0].OrphanCanceled || !reports[0].PositionDrift {
+		t.Fatalf("对账结果错误: %+v", reports)
+	}
+	if !slices.Equal(driver.canceled, []string{"2"}) {
+		t.Errorf("撤销订单 = %v, expected [2]", driver.canceled)
+	}
+	if id := <-engine.reconciledChan; id != record.GUID {
+		t.Errorf("重新执行的策略 = %s, expected %s", id, record.GUID)
+	}
+
+	orders, err := svcCtx.OrderModel.FindAllByAccountClientOrderIds(ctx, record.Exchange, record.Account, []string{"b0", "s2"})
+	if err != nil {
+		t.Fatalf("查询订单失败: %v", err)
+	}
+	if len(orders) != 2 {
+		t.Fatalf("订单数量 = %d, expected 2", len(orders))
+	}
+	for _, item := range orders {
+		if item.Status != order.StatusCanceled {
+			t.Errorf("订单 %s 状态 = %s, expected canceled", item.ClientOrderId, item.Status)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/exchange/lighter"
+	"github.com/fachebot/omni-grid-bot/internal/exchange/paradex"
+	"github.com/fachebot/omni-grid-bot/internal/exchange/variational"
+	"github.com/shopspring/decimal"
+)
+
+type replayMarketResolver map[int16]string
+
+func (r replayMarketResolver) GetMarketIdBySymbol(ctx context.Context, symbol string) (int16, error) {
+	for id, item := range r {
+		if item == symbol {
+			return id, nil
+		}
+	}
+	return 0, errors.New("market not found")
+}
+
+func (r replayMarketResolver) GetSymbolByMarketId(ctx context.Context, marketIndex int16) (string, error) {
+	if symbol, ok := r[marketIndex]; ok {
+		return symbol, nil
+	}
+	return "", errors.New("market not found")
+}
+
+func TestStrategyEngineReplay(t *testing.T) {
+	testCases := []struct {
+		file     string
+		exchange string
+		account  string
+		parser   exchange.FrameParser
+		changes  int                     // 策略收到的订单变化次数
+		syncs    int                     // 快照触发的订单同步次数
+		price    string                  // 策略收到的标记价格
+		orders   map[string]order.Status // 客户端订单ID -> 最终状态
+	}{
+		{
+			file:     "testdata/lighter.jsonl",
+			exchange: exchange.Lighter,
+			account:  "12345",
+			parser:   lighter.NewReplayParser(replayMarketResolver{0: "ETH"}),
+			changes:  2,
+			syncs:    1,
+			price:    "3989.50",
+			orders:   map[string]order.Status{"1": order.StatusFilled, "2": order.StatusOpen},
+		},
+		{
+			file:     "testdata/paradex.jsonl",
+			exchange: exchange.Paradex,
+			account:  "0x1",
+			parser:   paradex.NewReplayParser(),
+			changes:  3,
+			syncs:    1,
+			price:    "3989.4",
+			orders:   map[string]order.Status{"101": order.StatusFilled},
+		},
+		{
+			file:     "testdata/variational.jsonl",
+			exchange: exchange.Variational,
+			account:  "0x2",
+			parser:   variational.NewReplayParser(),
+			changes:  2,
+			syncs:    2,
+			price:    "3989.7",
+			orders:   map[string]order.Status{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.exchange, func(t *testing.T) {
+			ctx := context.Background()
+			svcCtx, driver := newTestSvcCtx(t, nil)
+			driver.name = tc.exchange
+
+			frames, err := exchange.ReadFrames(tc.file)
+			if err != nil {
+				t.Fatalf("读取录制文件失败: %v", err)
+			}
+
+			subscriber := exchange.NewReplaySubscriber(tc.exchange, frames, tc.parser, 0)
+
+			engine := NewStrategyEngine(svcCtx, subscriber)
+			engine.Start()
+			defer engine.Stop()
+
+			s := &fakeStrategy{record: &ent.Strategy{GUID: "1", Exchange: tc.exchange, Symbol: "ETH", Account: tc.account}}
+			if err = engine.StartStrategy(s); err != nil {
+				t.Fatalf("启动策略失败: %v", err)
+			}
+
+			subscriber.Start()
+			defer subscriber.Stop()
+			<-subscriber.Done()
+
+			deadline := time.Now().Add(2 * time.Second)
+			for time.Now().Before(deadline) && (s.orderChanges() < tc.changes || len(s.tickers()) == 0) {
+				time.Sleep(10 * time.Millisecond)
+			}
+
+			if got := s.orderChanges(); got != tc.changes {
+				t.Errorf("订单变化次数 = %d, expected %d", got, tc.changes)
+			}
+			if got := driver.syncCount(); got != tc.syncs {
+				t.Errorf("订单同步次数 = %d, expected %d", got, tc.syncs)
+			}
+			if got := s.tickers(); len(got) != 1 || !got[0].Equal(decimal.RequireFromString(tc.price)) {
+				t.Errorf("策略收到的价格 = %v, expected [%s]", got, tc.price)
+			}
+
+			orders, err := svcCtx.OrderModel.FindAllByAccount(ctx, tc.exchange, tc.account)
+			if err != nil {
+				t.Fatalf("查询订单失败: %v", err)
+			}
+			if len(orders) != len(tc.orders) {
+				t.Fatalf("订单数量 = %d, expected %d", len(orders), len(tc.orders))
+			}
+			for _, item := range orders {
+				if status, ok := tc.orders[item.ClientOrderId]; !ok || item.Status != status {
+					t.Errorf("订单 %s 状态 = %s, expected %s", item.ClientOrderId, item.Status, status)
+				}
+			}
+		})
+	}
+}
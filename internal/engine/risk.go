@@ -0,0 +1,460 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/config"
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategyrun"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/helper"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/fachebot/omni-grid-bot/internal/util"
+	"github.com/fachebot/omni-grid-bot/internal/util/format"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// riskMonitorTimeout 单个账户风险检查的超时时间，包括自动降低风险的操作
+	riskMonitorTimeout = time.Minute
+)
+
+// OrderReducer 可以撤销开仓挂单降低风险的策略
+type OrderReducer interface {
+	CancelFarthestOpenOrders(ctx context.Context, side helper.Side, n int) (int, error)
+}
+
+// deriskRequest 撤销开仓挂单的请求
+// 由主运行循环执行，避免和策略处理订单变化同时修改网格
+type deriskRequest struct {
+	strategyId string
+	side       helper.Side
+	count      int
+	result     chan deriskResult
+}
+
+// deriskResult 撤销开仓挂单的结果
+type deriskResult struct {
+	canceled int
+	err      error
+}
+
+// riskLevel 风险等级
+type riskLevel int
+
+const (
+	riskLevelNone   riskLevel = iota // 正常
+	riskLevelAlert                   // 发送提醒
+	riskLevelDerisk                  // 自动降低风险
+)
+
+// riskAlert 最近一次发送的风险提醒
+type riskAlert struct {
+	level riskLevel
+	time  time.Time
+}
+
+// PositionRisk 持仓的强平风险
+type PositionRisk struct {
+	Symbol           string
+	Side             exchange.PositionSide
+	Position         decimal.Decimal // 持仓数量
+	Price            decimal.Decimal // 最新成交价格
+	LiquidationPrice decimal.Decimal // 强平价格
+	Distance         decimal.Decimal // 价格距离强平价格的比例(%)，已越过强平价格时为负数
+}
+
+// AccountRisk 账户的强平风险
+// 全仓账户的强平价格受同一账户所有持仓影响，按账户汇总检查
+type AccountRisk struct {
+	Exchange string
+	Account  string
+
+	TotalAssetValue decimal.Decimal // 账户总资产
+	Notional        decimal.Decimal // 持仓总价值
+	MarginRatio     decimal.Decimal // 账户总资产占持仓总价值的比例(%)，没有持仓时为0
+	Positions       []*PositionRisk // 运行中策略交易对的持仓
+}
+
+// SetRiskMonitorInterval 设置强平风险检查间隔，需要在 Start 之前调用
+// interval 小于等于0时不启动风险监控
+func (engine *StrategyEngine) SetRiskMonitorInterval(interval time.Duration) {
+	engine.riskMonitorInterval = interval
+}
+
+// riskMonitorLoop 定期检查账户的强平风险
+func (engine *StrategyEngine) riskMonitorLoop() {
+	ticker := time.NewTicker(engine.riskMonitorInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-engine.ctx.Done():
+			return
+		case <-ticker.C:
+			engine.monitorRisk()
+		}
+	}
+}
+
+// monitorRisk 按账户逐个检查强平风险
+func (engine *StrategyEngine) monitorRisk() {
+	for _, strategies := range engine.accountGroups() {
+		if err := engine.checkAccountRisk(strategies); err != nil {
+			record := strategies[0].Get()
+			logger.Errorf("[StrategyEngine] 检查强平风险失败, exchange: %s, account: %s, %v", record.Exchange, record.Account, err)
+		}
+	}
+}
+
+// liquidationDistance 计算价格距离强平价格的比例(%)
+// 交易所没有返回强平价格时返回 false
+func liquidationDistance(side exchange.PositionSide, price, liquidationPrice decimal.Decimal) (decimal.Decimal, bool) {
+	if !price.IsPositive() || !liquidationPrice.IsPositive() {
+		return decimal.Zero, false
+	}
+
+	diff := price.Sub(liquidationPrice)
+	if side == exchange.PositionSideShort {
+		diff = diff.Neg()
+	}
+	return diff.Div(price).Mul(decimal.NewFromInt(100)), true
+}
+
+// positionValue 按入场价格和未实现盈亏推算持仓的当前价值
+func positionValue(position *exchange.Position) decimal.Decimal {
+	pnl := position.UnrealizedPnl
+	if position.Side == exchange.PositionSideShort {
+		pnl = pnl.Neg()
+	}
+	return position.Position.Abs().Mul(position.AvgEntryPrice).Add(pnl).Abs()
+}
+
+// evaluateAccountRisk 汇总账户的持仓价值和保证金比例，并计算 prices 中交易对的强平距离
+func evaluateAccountRisk(account *exchange.Account, prices map[string]decimal.Decimal) *AccountRisk {
+	risk := &AccountRisk{TotalAssetValue: account.TotalAssetValue}
+	for _, position := range account.Positions {
+		if position.Position.IsZero() {
+			continue
+		}
+		risk.Notional = risk.Notional.Add(positionValue(position))
+
+		price, ok := prices[position.Symbol]
+		if !ok {
+			continue
+		}
+		distance, ok := liquidationDistance(position.Side, price, position.LiquidationPrice)
+		if !ok {
+			continue
+		}
+		risk.Positions = append(risk.Positions, &PositionRisk{
+			Symbol:           position.Symbol,
+			Side:             position.Side,
+			Position:         position.Position.Abs(),
+			Price:            price,
+			LiquidationPrice: position.LiquidationPrice,
+			Distance:         distance,
+		})
+	}
+
+	if risk.Notional.IsPositive() {
+		risk.MarginRatio = risk.TotalAssetValue.Div(risk.Notional).Mul(decimal.NewFromInt(100))
+	}
+	return risk
+}
+
+// positionRiskLevel 按强平距离判断持仓的风险等级，阈值小于0时不检查
+func positionRiskLevel(distance decimal.Decimal, c config.RiskMonitor) riskLevel {
+	switch {
+	case c.DeriskDistance >= 0 && distance.LessThanOrEqual(decimal.NewFromFloat(c.DeriskDistance)):
+		return riskLevelDerisk
+	case c.AlertDistance >= 0 && distance.LessThanOrEqual(decimal.NewFromFloat(c.AlertDistance)):
+		return riskLevelAlert
+	default:
+		return riskLevelNone
+	}
+}
+
+// shouldAlert 判断是否需要发送风险提醒
+// 风险等级升高时立即提醒，等级没有升高时按 interval 限制重复提醒，恢复正常后清除记录
+func (engine *StrategyEngine) shouldAlert(key string, level riskLevel, interval time.Duration) bool {
+	if level == riskLevelNone {
+		engine.riskAlerts.Delete(key)
+		return false
+	}
+
+	now := time.Now()
+	if value, ok := engine.riskAlerts.Load(key); ok {
+		last := value.(riskAlert)
+		if level <= last.level && now.Sub(last.time) < interval {
+			return false
+		}
+	}
+	engine.riskAlerts.Store(key, riskAlert{level: level, time: now})
+	return true
+}
+
+// checkAccountRisk 检查单个账户的保证金比例和运行中策略交易对的强平距离
+// 强平距离达到自动降低风险的阈值时，每次检查都会按配置的方式执行一次降低风险的操作
+func (engine *StrategyEngine) checkAccountRisk(strategies []Strategy) error {
+	ctx, cancel := context.WithTimeout(engine.ctx, riskMonitorTimeout)
+	defer cancel()
+
+	first := strategies[0].Get()
+	account, err := helper.GetAccountInfo(ctx, engine.svcCtx, first)
+	if err != nil {
+		return err
+	}
+
+	// 查询交易对的最新价格
+	prices := make(map[string]decimal.Decimal)
+	for _, s := range strategies {
+		symbol := s.Get().Symbol
+		if _, ok := prices[symbol]; ok {
+			continue
+		}
+		price, err := helper.GetLastTradePrice(ctx, engine.svcCtx, first.Exchange, symbol)
+		if err != nil {
+			return err
+		}
+		prices[symbol] = price
+	}
+
+	risk := evaluateAccountRisk(account, prices)
+	risk.Exchange = first.Exchange
+	risk.Account = first.Account
+
+	c := engine.svcCtx.Config.RiskMonitor
+	interval := time.Duration(c.AlertInterval) * time.Second
+
+	// 检查保证金比例
+	level := riskLevelNone
+	if c.MinMarginRatio >= 0 && risk.Notional.IsPositive() && risk.MarginRatio.LessThan(decimal.NewFromFloat(c.MinMarginRatio)) {
+		level = riskLevelAlert
+	}
+	key := fmt.Sprintf("%s:%s", risk.Exchange, risk.Account)
+	if engine.shouldAlert(key, level, interval) {
+		logger.Warnf("[StrategyEngine] 账户保证金比例过低, exchange: %s, account: %s, ratio: %s%%",
+			risk.Exchange, risk.Account, risk.MarginRatio.StringFixed(2))
+		engine.sendMarginRatioAlert(strategies, risk)
+	}
+
+	// 检查强平距离
+	for _, position := range risk.Positions {
+		level := positionRiskLevel(position.Distance, c)
+		related := lo.Filter(strategies, func(s Strategy, _ int) bool { return s.Get().Symbol == position.Symbol })
+
+		var result string
+		if level == riskLevelDerisk {
+			result = engine.derisk(ctx, related, position, c)
+		}
+
+		key := fmt.Sprintf("%s:%s:%s:%d", risk.Exchange, risk.Account, position.Symbol, position.Side)
+		if !engine.shouldAlert(key, level, interval) && result == "" {
+			continue
+		}
+
+		logger.Warnf("[StrategyEngine] 持仓接近强平价格, exchange: %s, account: %s, symbol: %s, price: %s, liquidation: %s, distance: %s%%",
+			risk.Exchange, risk.Account, position.Symbol, position.Price, position.LiquidationPrice, position.Distance.StringFixed(2))
+		engine.sendLiquidationRiskAlert(related, risk, position, level, result)
+	}
+
+	return nil
+}
+
+// derisk 按配置的方式降低持仓风险
+// 返回值: 执行结果的描述，没有执行任何操作时为空
+func (engine *StrategyEngine) derisk(ctx context.Context, strategies []Strategy, position *PositionRisk, c config.RiskMonitor) string {
+	switch c.DeriskAction {
+	case config.RiskActionCancel:
+		return engine.deriskCancelOrders(ctx, strategies, position.Side, c.CancelLevels)
+	case config.RiskActionLeverage:
+		return engine.deriskLeverage(ctx, strategies)
+	case config.RiskActionClose:
+		return engine.deriskClosePosition(ctx, strategies, position.Side)
+	default:
+		return ""
+	}
+}
+
+// deriskCancelOrders 撤销持仓方向上距离当前价格最远的开仓挂单，每个策略最多撤销 n 个
+func (engine *StrategyEngine) deriskCancelOrders(ctx context.Context, strategies []Strategy, side helper.Side, n int) string {
+	canceled := 0
+loop:
+	for _, s := range strategies {
+		record := s.Get()
+		if _, ok := s.(OrderReducer); !ok || !slices.Contains(helper.PositionSides(record), side) {
+			continue
+		}
+
+		req := deriskRequest{strategyId: record.GUID, side: side, count: n, result: make(chan deriskResult, 1)}
+		select {
+		case engine.deriskChan <- req:
+		case <-ctx.Done():
+			break loop
+		}
+
+		select {
+		case result := <-req.result:
+			if result.err != nil {
+				logger.Errorf("[StrategyEngine] 撤销开仓挂单失败, id: %s, symbol: %s, %v", record.GUID, record.Symbol, result.err)
+			}
+			canceled += result.canceled
+		case <-ctx.Done():
+			break loop
+		}
+	}
+
+	if canceled == 0 {
+		return ""
+	}
+	return fmt.Sprintf("已撤销 %d 个最远的开仓挂单", canceled)
+}
+
+// cancelFarthestOpenOrders 在主运行循环中执行撤销开仓挂单的请求
+func (engine *StrategyEngine) cancelFarthestOpenOrders(req deriskRequest) {
+	engine.mutex.RLock()
+	s, exists := engine.strategyMap[req.strategyId]
+	engine.mutex.RUnlock()
+
+	var result deriskResult
+	if reducer, ok := s.(OrderReducer); exists && ok {
+		result.canceled, result.err = reducer.CancelFarthestOpenOrders(engine.ctx, req.side, req.count)
+	}
+	req.result <- result
+}
+
+// deriskLeverage 将交易对的杠杆倍数减半，最低为1倍
+// 同一账户同一交易对的策略共享交易所的杠杆设置，同步更新所有策略的杠杆倍数
+func (engine *StrategyEngine) deriskLeverage(ctx context.Context, strategies []Strategy) string {
+	records := lo.Map(strategies, func(s Strategy, _ int) *ent.Strategy { return s.Get() })
+	current := lo.MaxBy(records, func(a, b *ent.Strategy) bool { return a.Leverage > b.Leverage })
+	leverage := max(current.Leverage/2, 1)
+	if leverage >= current.Leverage {
+		return ""
+	}
+
+	adapter, err := helper.NewExchangeAdapterFromStrategy(engine.svcCtx, current)
+	if err != nil {
+		logger.Errorf("[StrategyEngine] 降低杠杆倍数失败, id: %s, symbol: %s, %v", current.GUID, current.Symbol, err)
+		return "降低杠杆倍数失败，请手动处理"
+	}
+
+	marginMode := lo.If(current.MarginMode == strategy.MarginModeIsolated, exchange.MarginModeIsolated).Else(exchange.MarginModeCross)
+	if err = adapter.UpdateLeverage(ctx, current.Symbol, uint(leverage), marginMode); err != nil {
+		logger.Errorf("[StrategyEngine] 降低杠杆倍数失败, id: %s, symbol: %s, %v", current.GUID, current.Symbol, err)
+		return "降低杠杆倍数失败，请手动处理"
+	}
+
+	for _, record := range records {
+		if record.Leverage <= leverage {
+			continue
+		}
+		if err = engine.svcCtx.StrategyModel.UpdateLeverage(ctx, record.ID, leverage); err != nil {
+			logger.Errorf("[StrategyEngine] 更新杠杆倍数失败, id: %s, %v", record.GUID, err)
+			continue
+		}
+		if updated, err := engine.svcCtx.StrategyModel.FindOneByGUID(ctx, record.GUID); err == nil {
+			engine.UpdateStrategy(updated)
+		}
+	}
+
+	logger.Infof("[StrategyEngine] 降低杠杆倍数, exchange: %s, account: %s, symbol: %s, leverage: %d -> %d",
+		current.Exchange, current.Account, current.Symbol, current.Leverage, leverage)
+	return fmt.Sprintf("杠杆倍数已从 %dX 调整为 %dX", current.Leverage, leverage)
+}
+
+// deriskClosePosition 停止交易对的所有策略并平仓，账户中其他交易对的持仓不受影响
+func (engine *StrategyEngine) deriskClosePosition(ctx context.Context, strategies []Strategy, side helper.Side) string {
+	first := strategies[0].Get()
+	for _, s := range strategies {
+		record := s.Get()
+		err := helper.StopStrategyAndCancelOrders(ctx, engine.svcCtx, engine, record, strategyrun.StopReasonLiquidationRisk)
+		if err != nil {
+			logger.Errorf("[StrategyEngine] 停止策略并取消订单失败, id: %s, symbol: %s, %v", record.GUID, record.Symbol, err)
+			return "停止策略失败，请手动平仓"
+		}
+	}
+
+	adapter, err := helper.NewExchangeAdapterFromStrategy(engine.svcCtx, first)
+	if err == nil {
+		slippageBps := lo.FromPtrOr(first.SlippageBps, helper.DefaultSlippageBps)
+		err = adapter.ClosePosition(ctx, first.Symbol, side, slippageBps)
+	}
+	if err != nil {
+		logger.Errorf("[StrategyEngine] 平仓失败, exchange: %s, account: %s, symbol: %s, %v",
+			first.Exchange, first.Account, first.Symbol, err)
+		return fmt.Sprintf("已停止 %d 个策略，平仓失败，请手动平仓", len(strategies))
+	}
+
+	logger.Infof("[StrategyEngine] 停止策略并平仓, exchange: %s, account: %s, symbol: %s, strategies: %d",
+		first.Exchange, first.Account, first.Symbol, len(strategies))
+	return fmt.Sprintf("已停止 %d 个策略并平仓", len(strategies))
+}
+
+// sendLiquidationRiskAlert 发送持仓接近强平价格的提醒
+func (engine *StrategyEngine) sendLiquidationRiskAlert(strategies []Strategy, risk *AccountRisk, position *PositionRisk, level riskLevel, result string) {
+	icon := lo.If(level == riskLevelDerisk, "🚨").Else("⚠️")
+	side := lo.If(position.Side == exchange.PositionSideLong, "LONG").Else("SHORT")
+	title := fmt.Sprintf("%s **%s %s** 持仓接近强平价格", icon, position.Symbol, side)
+
+	body := fmt.Sprintf("🏦 交易平台: %s\n", risk.Exchange)
+	body += fmt.Sprintf("📦 持仓数量: %s %s\n", position.Position, position.Symbol)
+	body += fmt.Sprintf("💵 当前价格: %s\n", format.Price(position.Price, 5))
+	body += fmt.Sprintf("💀 强平价格: %s\n", format.Price(position.LiquidationPrice, 5))
+	body += fmt.Sprintf("📐 强平距离: %s%%\n", position.Distance.StringFixed(2))
+	if risk.Notional.IsPositive() {
+		body += fmt.Sprintf("🧮 保证金比例: %s%%\n", risk.MarginRatio.StringFixed(2))
+	}
+	if result != "" {
+		body += fmt.Sprintf("🛡️ 自动降低风险: %s\n", result)
+	}
+	body += "\n**注意**：`全仓账户的强平价格受同一账户所有持仓影响，请及时补充保证金或降低持仓。`"
+
+	engine.sendRiskNotification(strategies, title, body)
+}
+
+// sendMarginRatioAlert 发送账户保证金比例过低的提醒
+func (engine *StrategyEngine) sendMarginRatioAlert(strategies []Strategy, risk *AccountRisk) {
+	title := "⚠️ 账户保证金比例过低"
+
+	body := fmt.Sprintf("🏦 交易平台: %s\n", risk.Exchange)
+	body += fmt.Sprintf("💰 账户总资产: %s USD\n", risk.TotalAssetValue.StringFixed(2))
+	body += fmt.Sprintf("📦 持仓总价值: %s USD\n", risk.Notional.StringFixed(2))
+	body += fmt.Sprintf("🧮 保证金比例: %s%%\n", risk.MarginRatio.StringFixed(2))
+	symbols := lo.Uniq(lo.Map(strategies, func(s Strategy, _ int) string { return s.Get().Symbol }))
+	body += fmt.Sprintf("📈 运行中的交易对: %s\n", strings.Join(symbols, ", "))
+	body += "\n**注意**：`账户中的策略共享保证金，请及时补充保证金或降低持仓。`"
+
+	engine.sendRiskNotification(strategies, title, body)
+}
+
+// sendRiskNotification 向策略的所有者发送风险提醒，同一用户只发送一次
+func (engine *StrategyEngine) sendRiskNotification(strategies []Strategy, title, body string) {
+	sent := make(map[int64]struct{})
+	for _, s := range strategies {
+		record := s.Get()
+		if !record.EnablePushNotification {
+			continue
+		}
+		if _, ok := sent[record.Owner]; ok {
+			continue
+		}
+		sent[record.Owner] = struct{}{}
+
+		link := fmt.Sprintf("[%s](https://t.me/%s?start=%s)",
+			util.StrategyName(record), engine.svcCtx.Bot.Me.Username, record.GUID)
+		text := fmt.Sprintf("%s %s\n\n%s", title, link, body)
+		chatId := util.ChatId(record.Owner)
+		_, err := util.SendMarkdownMessage(engine.svcCtx.Bot, chatId, text, nil)
+		if err != nil {
+			logger.Debugf("[StrategyEngine] 发送风险提醒失败, chat: %d, %v", chatId, err)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package engine
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/config"
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/helper"
+	"github.com/shopspring/decimal"
+)
+
+// reducerStrategy 记录撤销开仓挂单请求的测试策略
+type reducerStrategy struct {
+	fakeStrategy
+	mutex    sync.Mutex
+	requests []helper.Side
+}
+
+func (s *reducerStrategy) CancelFarthestOpenOrders(ctx context.Context, side helper.Side, n int) (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.requests = append(s.requests, side)
+	return n, nil
+}
+
+func (s *reducerStrategy) cancelRequests() []helper.Side {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]helper.Side(nil), s.requests...)
+}
+
+func TestEvaluateAccountRisk(t *testing.T) {
+	account := &exchange.Account{
+		TotalAssetValue: decimal.NewFromInt(115),
+		Positions: []*exchange.Position{
+			{Symbol: "ETH", Side: exchange.PositionSideLong, Position: decimal.NewFromInt(10), AvgEntryPrice: decimal.NewFromInt(100),
+				UnrealizedPnl: decimal.NewFromInt(-50), LiquidationPrice: decimal.NewFromInt(92)},
+			{Symbol: "BTC", Side: exchange.PositionSideShort, Position: decimal.RequireFromString("0.01"), AvgEntryPrice: decimal.NewFromInt(20000),
+				UnrealizedPnl: decimal.Zero, LiquidationPrice: decimal.NewFromInt(30000)},
+			{Symbol: "SOL", Side: exchange.PositionSideLong, Position: decimal.NewFromInt(1), AvgEntryPrice: decimal.NewFromInt(100)},
+		},
+	}
+	prices := map[string]decimal.Decimal{"ETH": decimal.NewFromInt(95), "BTC": decimal.NewFromInt(20000), "SOL": decimal.NewFromInt(100)}
+
+	// 没有强平价格的持仓只计入持仓总价值
+	risk := evaluateAccountRisk(account, prices)
+	if !risk.Notional.Equal(decimal.NewFromInt(1250)) {
+		t.Errorf("持仓总价值 = %s, expected 1250", risk.Notional)
+	}
+	if !risk.MarginRatio.Equal(decimal.NewFromFloat(9.2)) {
+		t.Errorf("保证金比例 = %s, expected 9.2", risk.MarginRatio)
+	}
+	if len(risk.Positions) != 2 {
+		t.Fatalf("强平风险持仓数量 = %d, expected 2", len(risk.Positions))
+	}
+	if got := risk.Positions[0].Distance.StringFixed(2); got != "3.16" {
+		t.Errorf("ETH 多头强平距离 = %s, expected 3.16", got)
+	}
+	if got := risk.Positions[1].Distance.StringFixed(2); got != "50.00" {
+		t.Errorf("BTC 空头强平距离 = %s, expected 50.00", got)
+	}
+
+	c := config.RiskMonitor{AlertDistance: 20, DeriskDistance: 5}
+	for distance, expected := range map[string]riskLevel{"-1": riskLevelDerisk, "5": riskLevelDerisk, "10": riskLevelAlert, "50": riskLevelNone} {
+		if level := positionRiskLevel(decimal.RequireFromString(distance), c); level != expected {
+			t.Errorf("强平距离 %s%% 的风险等级 = %d, expected %d", distance, level, expected)
+		}
+	}
+	c.DeriskDistance = -1
+	if level := positionRiskLevel(decimal.NewFromInt(1), c); level != riskLevelAlert {
+		t.Errorf("关闭自动降低风险后的风险等级 = %d, expected %d", level, riskLevelAlert)
+	}
+}
+
+func TestStrategyEngineShouldAlert(t *testing.T) {
+	engine := NewStrategyEngine(nil)
+
+	// 风险等级升高时立即提醒，没有升高时按间隔限制，恢复正常后重新提醒
+	steps := []struct {
+		level    riskLevel
+		expected bool
+	}{
+		{riskLevelAlert, true},
+		{riskLevelAlert, false},
+		{riskLevelDerisk, true},
+		{riskLevelAlert, false},
+		{riskLevelNone, false},
+		{riskLevelAlert, true},
+	}
+	for idx, step := range steps {
+		if got := engine.shouldAlert("risk:1:ETH", step.level, time.Hour); got != step.expected {
+			t.Errorf("第 %d 次检查 shouldAlert = %v, expected %v", idx+1, got, step.expected)
+		}
+	}
+	if !engine.shouldAlert("risk:1:ETH", riskLevelAlert, 0) {
+		t.Error("超过提醒间隔后应该重复提醒")
+	}
+}
+
+func TestStrategyEngineDeriskCancelOrders(t *testing.T) {
+	c := &config.Config{RiskMonitor: config.RiskMonitor{
+		AlertDistance:  20,
+		MinMarginRatio: 10,
+		AlertInterval:  3600,
+		DeriskDistance: 5,
+		DeriskAction:   config.RiskActionCancel,
+		CancelLevels:   2,
+	}}
+	svcCtx, driver := newTestSvcCtx(t, c)
+	driver.account = &exchange.Account{
+		TotalAssetValue: decimal.NewFromInt(500),
+		Positions: []*exchange.Position{
+			{Symbol: "ETH", Side: exchange.PositionSideLong, Position: decimal.NewFromInt(10), AvgEntryPrice: decimal.NewFromInt(100),
+				UnrealizedPnl: decimal.NewFromInt(-50), LiquidationPrice: decimal.NewFromInt(92)},
+		},
+	}
+	driver.prices = map[string]decimal.Decimal{"ETH": decimal.NewFromInt(95), "BTC": decimal.NewFromInt(20000)}
+
+	engine := NewStrategyEngine(svcCtx)
+	engine.Start()
+	defer engine.Stop()
+
+	long := &reducerStrategy{fakeStrategy: fakeStrategy{record: &ent.Strategy{GUID: "1", Exchange: driver.Name(), Symbol: "ETH", Account: "1", Mode: strategy.ModeLong}}}
+	short := &reducerStrategy{fakeStrategy: fakeStrategy{record: &ent.Strategy{GUID: "2", Exchange: driver.Name(), Symbol: "ETH", Account: "1", Mode: strategy.ModeShort}}}
+	other := &reducerStrategy{fakeStrategy: fakeStrategy{record: &ent.Strategy{GUID: "3", Exchange: driver.Name(), Symbol: "BTC", Account: "1", Mode: strategy.ModeLong}}}
+	for _, s := range []*reducerStrategy{long, short, other} {
+		engine.addStrategyToEngine(s)
+	}
+
+	// 强平距离低于阈值时每次检查都撤销持仓方向上的开仓挂单
+	engine.monitorRisk()
+	engine.monitorRisk()
+	if got := long.cancelRequests(); len(got) != 2 || got[0] != helper.LONG {
+		t.Errorf("多头策略撤单请求 = %v, expected 2 次 LONG", got)
+	}
+	if got := short.cancelRequests(); len(got) != 0 {
+		t.Errorf("空头策略不应撤单, got %v", got)
+	}
+	if got := other.cancelRequests(); len(got) != 0 {
+		t.Errorf("其他交易对的策略不应撤单, got %v", got)
+	}
+}
@@ -14,10 +14,16 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/fachebot/omni-grid-bot/internal/ent/exchangeaccount"
+	"github.com/fachebot/omni-grid-bot/internal/ent/fill"
+	"github.com/fachebot/omni-grid-bot/internal/ent/fundingpayment"
 	"github.com/fachebot/omni-grid-bot/internal/ent/grid"
 	"github.com/fachebot/omni-grid-bot/internal/ent/matchedtrade"
 	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/ent/orderrepair"
 	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategyrun"
 	"github.com/fachebot/omni-grid-bot/internal/ent/syncprogress"
 )
 
@@ -26,14 +32,24 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// ExchangeAccount is the client for interacting with the ExchangeAccount builders.
+	ExchangeAccount *ExchangeAccountClient
+	// Fill is the client for interacting with the Fill builders.
+	Fill *FillClient
+	// FundingPayment is the client for interacting with the FundingPayment builders.
+	FundingPayment *FundingPaymentClient
 	// Grid is the client for interacting with the Grid builders.
 	Grid *GridClient
 	// MatchedTrade is the client for interacting with the MatchedTrade builders.
 	MatchedTrade *MatchedTradeClient
 	// Order is the client for interacting with the Order builders.
 	Order *OrderClient
+	// OrderRepair is the client for interacting with the OrderRepair builders.
+	OrderRepair *OrderRepairClient
 	// Strategy is the client for interacting with the Strategy builders.
 	Strategy *StrategyClient
+	// StrategyRun is the client for interacting with the StrategyRun builders.
+	StrategyRun *StrategyRunClient
 	// SyncProgress is the client for interacting with the SyncProgress builders.
 	SyncProgress *SyncProgressClient
 }
@@ -47,10 +63,15 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.ExchangeAccount = NewExchangeAccountClient(c.config)
+	c.Fill = NewFillClient(c.config)
+	c.FundingPayment = NewFundingPaymentClient(c.config)
 	c.Grid = NewGridClient(c.config)
 	c.MatchedTrade = NewMatchedTradeClient(c.config)
 	c.Order = NewOrderClient(c.config)
+	c.OrderRepair = NewOrderRepairClient(c.config)
 	c.Strategy = NewStrategyClient(c.config)
+	c.StrategyRun = NewStrategyRunClient(c.config)
 	c.SyncProgress = NewSyncProgressClient(c.config)
 }
 
@@ -142,13 +163,18 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:          ctx,
-		config:       cfg,
-		Grid:         NewGridClient(cfg),
-		MatchedTrade: NewMatchedTradeClient(cfg),
-		Order:        NewOrderClient(cfg),
-		Strategy:     NewStrategyClient(cfg),
-		SyncProgress: NewSyncProgressClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		ExchangeAccount: NewExchangeAccountClient(cfg),
+		Fill:            NewFillClient(cfg),
+		FundingPayment:  NewFundingPaymentClient(cfg),
+		Grid:            NewGridClient(cfg),
+		MatchedTrade:    NewMatchedTradeClient(cfg),
+		Order:           NewOrderClient(cfg),
+		OrderRepair:     NewOrderRepairClient(cfg),
+		Strategy:        NewStrategyClient(cfg),
+		StrategyRun:     NewStrategyRunClient(cfg),
+		SyncProgress:    NewSyncProgressClient(cfg),
 	}, nil
 }
 
@@ -166,20 +192,25 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:          ctx,
-		config:       cfg,
-		Grid:         NewGridClient(cfg),
-		MatchedTrade: NewMatchedTradeClient(cfg),
-		Order:        NewOrderClient(cfg),
-		Strategy:     NewStrategyClient(cfg),
-		SyncProgress: NewSyncProgressClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		ExchangeAccount: NewExchangeAccountClient(cfg),
+		Fill:            NewFillClient(cfg),
+		FundingPayment:  NewFundingPaymentClient(cfg),
+		Grid:            NewGridClient(cfg),
+		MatchedTrade:    NewMatchedTradeClient(cfg),
+		Order:           NewOrderClient(cfg),
+		OrderRepair:     NewOrderRepairClient(cfg),
+		Strategy:        NewStrategyClient(cfg),
+		StrategyRun:     NewStrategyRunClient(cfg),
+		SyncProgress:    NewSyncProgressClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Grid.
+//		ExchangeAccount.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -201,34 +232,46 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Grid.Use(hooks...)
-	c.MatchedTrade.Use(hooks...)
-	c.Order.Use(hooks...)
-	c.Strategy.Use(hooks...)
-	c.SyncProgress.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.ExchangeAccount, c.Fill, c.FundingPayment, c.Grid, c.MatchedTrade, c.Order,
+		c.OrderRepair, c.Strategy, c.StrategyRun, c.SyncProgress,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Grid.Intercept(interceptors...)
-	c.MatchedTrade.Intercept(interceptors...)
-	c.Order.Intercept(interceptors...)
-	c.Strategy.Intercept(interceptors...)
-	c.SyncProgress.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.ExchangeAccount, c.Fill, c.FundingPayment, c.Grid, c.MatchedTrade, c.Order,
+		c.OrderRepair, c.Strategy, c.StrategyRun, c.SyncProgress,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *ExchangeAccountMutation:
+		return c.ExchangeAccount.mutate(ctx, m)
+	case *FillMutation:
+		return c.Fill.mutate(ctx, m)
+	case *FundingPaymentMutation:
+		return c.FundingPayment.mutate(ctx, m)
 	case *GridMutation:
 		return c.Grid.mutate(ctx, m)
 	case *MatchedTradeMutation:
 		return c.MatchedTrade.mutate(ctx, m)
 	case *OrderMutation:
 		return c.Order.mutate(ctx, m)
+	case *OrderRepairMutation:
+		return c.OrderRepair.mutate(ctx, m)
 	case *StrategyMutation:
 		return c.Strategy.mutate(ctx, m)
+	case *StrategyRunMutation:
+		return c.StrategyRun.mutate(ctx, m)
 	case *SyncProgressMutation:
 		return c.SyncProgress.mutate(ctx, m)
 	default:
@@ -236,6 +279,421 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// ExchangeAccountClient is a client for the ExchangeAccount schema.
+type ExchangeAccountClient struct {
+	config
+}
+
+// NewExchangeAccountClient returns a client for the ExchangeAccount from the given config.
+func NewExchangeAccountClient(c config) *ExchangeAccountClient {
+	return &ExchangeAccountClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `exchangeaccount.Hooks(f(g(h())))`.
+func (c *ExchangeAccountClient) Use(hooks ...Hook) {
+	c.hooks.ExchangeAccount = append(c.hooks.ExchangeAccount, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `exchangeaccount.Intercept(f(g(h())))`.
+func (c *ExchangeAccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExchangeAccount = append(c.inters.ExchangeAccount, interceptors...)
+}
+
+// Create returns a builder for creating a ExchangeAccount entity.
+func (c *ExchangeAccountClient) Create() *ExchangeAccountCreate {
+	mutation := newExchangeAccountMutation(c.config, OpCreate)
+	return &ExchangeAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ExchangeAccount entities.
+func (c *ExchangeAccountClient) CreateBulk(builders ...*ExchangeAccountCreate) *ExchangeAccountCreateBulk {
+	return &ExchangeAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExchangeAccountClient) MapCreateBulk(slice any, setFunc func(*ExchangeAccountCreate, int)) *ExchangeAccountCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExchangeAccountCreateBulk{err: fmt.Errorf("calling to ExchangeAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExchangeAccountCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExchangeAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ExchangeAccount.
+func (c *ExchangeAccountClient) Update() *ExchangeAccountUpdate {
+	mutation := newExchangeAccountMutation(c.config, OpUpdate)
+	return &ExchangeAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExchangeAccountClient) UpdateOne(_m *ExchangeAccount) *ExchangeAccountUpdateOne {
+	mutation := newExchangeAccountMutation(c.config, OpUpdateOne, withExchangeAccount(_m))
+	return &ExchangeAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExchangeAccountClient) UpdateOneID(id int) *ExchangeAccountUpdateOne {
+	mutation := newExchangeAccountMutation(c.config, OpUpdateOne, withExchangeAccountID(id))
+	return &ExchangeAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExchangeAccount.
+func (c *ExchangeAccountClient) Delete() *ExchangeAccountDelete {
+	mutation := newExchangeAccountMutation(c.config, OpDelete)
+	return &ExchangeAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExchangeAccountClient) DeleteOne(_m *ExchangeAccount) *ExchangeAccountDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExchangeAccountClient) DeleteOneID(id int) *ExchangeAccountDeleteOne {
+	builder := c.Delete().Where(exchangeaccount.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExchangeAccountDeleteOne{builder}
+}
+
+// Query returns a query builder for ExchangeAccount.
+func (c *ExchangeAccountClient) Query() *ExchangeAccountQuery {
+	return &ExchangeAccountQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExchangeAccount},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ExchangeAccount entity by its id.
+func (c *ExchangeAccountClient) Get(ctx context.Context, id int) (*ExchangeAccount, error) {
+	return c.Query().Where(exchangeaccount.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExchangeAccountClient) GetX(ctx context.Context, id int) *ExchangeAccount {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryStrategies queries the strategies edge of a ExchangeAccount.
+func (c *ExchangeAccountClient) QueryStrategies(_m *ExchangeAccount) *StrategyQuery {
+	query := (&StrategyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(exchangeaccount.Table, exchangeaccount.FieldID, id),
+			sqlgraph.To(strategy.Table, strategy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, exchangeaccount.StrategiesTable, exchangeaccount.StrategiesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ExchangeAccountClient) Hooks() []Hook {
+	return c.hooks.ExchangeAccount
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExchangeAccountClient) Interceptors() []Interceptor {
+	return c.inters.ExchangeAccount
+}
+
+func (c *ExchangeAccountClient) mutate(ctx context.Context, m *ExchangeAccountMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExchangeAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExchangeAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExchangeAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExchangeAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ExchangeAccount mutation op: %q", m.Op())
+	}
+}
+
+// FillClient is a client for the Fill schema.
+type FillClient struct {
+	config
+}
+
+// NewFillClient returns a client for the Fill from the given config.
+func NewFillClient(c config) *FillClient {
+	return &FillClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `fill.Hooks(f(g(h())))`.
+func (c *FillClient) Use(hooks ...Hook) {
+	c.hooks.Fill = append(c.hooks.Fill, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `fill.Intercept(f(g(h())))`.
+func (c *FillClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Fill = append(c.inters.Fill, interceptors...)
+}
+
+// Create returns a builder for creating a Fill entity.
+func (c *FillClient) Create() *FillCreate {
+	mutation := newFillMutation(c.config, OpCreate)
+	return &FillCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Fill entities.
+func (c *FillClient) CreateBulk(builders ...*FillCreate) *FillCreateBulk {
+	return &FillCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FillClient) MapCreateBulk(slice any, setFunc func(*FillCreate, int)) *FillCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FillCreateBulk{err: fmt.Errorf("calling to FillClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FillCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FillCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Fill.
+func (c *FillClient) Update() *FillUpdate {
+	mutation := newFillMutation(c.config, OpUpdate)
+	return &FillUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FillClient) UpdateOne(_m *Fill) *FillUpdateOne {
+	mutation := newFillMutation(c.config, OpUpdateOne, withFill(_m))
+	return &FillUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FillClient) UpdateOneID(id int) *FillUpdateOne {
+	mutation := newFillMutation(c.config, OpUpdateOne, withFillID(id))
+	return &FillUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Fill.
+func (c *FillClient) Delete() *FillDelete {
+	mutation := newFillMutation(c.config, OpDelete)
+	return &FillDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FillClient) DeleteOne(_m *Fill) *FillDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FillClient) DeleteOneID(id int) *FillDeleteOne {
+	builder := c.Delete().Where(fill.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FillDeleteOne{builder}
+}
+
+// Query returns a query builder for Fill.
+func (c *FillClient) Query() *FillQuery {
+	return &FillQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFill},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Fill entity by its id.
+func (c *FillClient) Get(ctx context.Context, id int) (*Fill, error) {
+	return c.Query().Where(fill.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FillClient) GetX(ctx context.Context, id int) *Fill {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *FillClient) Hooks() []Hook {
+	return c.hooks.Fill
+}
+
+// Interceptors returns the client interceptors.
+func (c *FillClient) Interceptors() []Interceptor {
+	return c.inters.Fill
+}
+
+func (c *FillClient) mutate(ctx context.Context, m *FillMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FillCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FillUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FillUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FillDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Fill mutation op: %q", m.Op())
+	}
+}
+
+// FundingPaymentClient is a client for the FundingPayment schema.
+type FundingPaymentClient struct {
+	config
+}
+
+// NewFundingPaymentClient returns a client for the FundingPayment from the given config.
+func NewFundingPaymentClient(c config) *FundingPaymentClient {
+	return &FundingPaymentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `fundingpayment.Hooks(f(g(h())))`.
+func (c *FundingPaymentClient) Use(hooks ...Hook) {
+	c.hooks.FundingPayment = append(c.hooks.FundingPayment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `fundingpayment.Intercept(f(g(h())))`.
+func (c *FundingPaymentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FundingPayment = append(c.inters.FundingPayment, interceptors...)
+}
+
+// Create returns a builder for creating a FundingPayment entity.
+func (c *FundingPaymentClient) Create() *FundingPaymentCreate {
+	mutation := newFundingPaymentMutation(c.config, OpCreate)
+	return &FundingPaymentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FundingPayment entities.
+func (c *FundingPaymentClient) CreateBulk(builders ...*FundingPaymentCreate) *FundingPaymentCreateBulk {
+	return &FundingPaymentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FundingPaymentClient) MapCreateBulk(slice any, setFunc func(*FundingPaymentCreate, int)) *FundingPaymentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FundingPaymentCreateBulk{err: fmt.Errorf("calling to FundingPaymentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FundingPaymentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FundingPaymentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FundingPayment.
+func (c *FundingPaymentClient) Update() *FundingPaymentUpdate {
+	mutation := newFundingPaymentMutation(c.config, OpUpdate)
+	return &FundingPaymentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FundingPaymentClient) UpdateOne(_m *FundingPayment) *FundingPaymentUpdateOne {
+	mutation := newFundingPaymentMutation(c.config, OpUpdateOne, withFundingPayment(_m))
+	return &FundingPaymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FundingPaymentClient) UpdateOneID(id int) *FundingPaymentUpdateOne {
+	mutation := newFundingPaymentMutation(c.config, OpUpdateOne, withFundingPaymentID(id))
+	return &FundingPaymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FundingPayment.
+func (c *FundingPaymentClient) Delete() *FundingPaymentDelete {
+	mutation := newFundingPaymentMutation(c.config, OpDelete)
+	return &FundingPaymentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FundingPaymentClient) DeleteOne(_m *FundingPayment) *FundingPaymentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FundingPaymentClient) DeleteOneID(id int) *FundingPaymentDeleteOne {
+	builder := c.Delete().Where(fundingpayment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FundingPaymentDeleteOne{builder}
+}
+
+// Query returns a query builder for FundingPayment.
+func (c *FundingPaymentClient) Query() *FundingPaymentQuery {
+	return &FundingPaymentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFundingPayment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FundingPayment entity by its id.
+func (c *FundingPaymentClient) Get(ctx context.Context, id int) (*FundingPayment, error) {
+	return c.Query().Where(fundingpayment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FundingPaymentClient) GetX(ctx context.Context, id int) *FundingPayment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *FundingPaymentClient) Hooks() []Hook {
+	return c.hooks.FundingPayment
+}
+
+// Interceptors returns the client interceptors.
+func (c *FundingPaymentClient) Interceptors() []Interceptor {
+	return c.inters.FundingPayment
+}
+
+func (c *FundingPaymentClient) mutate(ctx context.Context, m *FundingPaymentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FundingPaymentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FundingPaymentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FundingPaymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FundingPaymentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FundingPayment mutation op: %q", m.Op())
+	}
+}
+
 // GridClient is a client for the Grid schema.
 type GridClient struct {
 	config
@@ -635,6 +1093,139 @@ func (c *OrderClient) mutate(ctx context.Context, m *OrderMutation) (Value, erro
 	}
 }
 
+// OrderRepairClient is a client for the OrderRepair schema.
+type OrderRepairClient struct {
+	config
+}
+
+// NewOrderRepairClient returns a client for the OrderRepair from the given config.
+func NewOrderRepairClient(c config) *OrderRepairClient {
+	return &OrderRepairClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `orderrepair.Hooks(f(g(h())))`.
+func (c *OrderRepairClient) Use(hooks ...Hook) {
+	c.hooks.OrderRepair = append(c.hooks.OrderRepair, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `orderrepair.Intercept(f(g(h())))`.
+func (c *OrderRepairClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OrderRepair = append(c.inters.OrderRepair, interceptors...)
+}
+
+// Create returns a builder for creating a OrderRepair entity.
+func (c *OrderRepairClient) Create() *OrderRepairCreate {
+	mutation := newOrderRepairMutation(c.config, OpCreate)
+	return &OrderRepairCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OrderRepair entities.
+func (c *OrderRepairClient) CreateBulk(builders ...*OrderRepairCreate) *OrderRepairCreateBulk {
+	return &OrderRepairCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrderRepairClient) MapCreateBulk(slice any, setFunc func(*OrderRepairCreate, int)) *OrderRepairCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrderRepairCreateBulk{err: fmt.Errorf("calling to OrderRepairClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrderRepairCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrderRepairCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OrderRepair.
+func (c *OrderRepairClient) Update() *OrderRepairUpdate {
+	mutation := newOrderRepairMutation(c.config, OpUpdate)
+	return &OrderRepairUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrderRepairClient) UpdateOne(_m *OrderRepair) *OrderRepairUpdateOne {
+	mutation := newOrderRepairMutation(c.config, OpUpdateOne, withOrderRepair(_m))
+	return &OrderRepairUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrderRepairClient) UpdateOneID(id int) *OrderRepairUpdateOne {
+	mutation := newOrderRepairMutation(c.config, OpUpdateOne, withOrderRepairID(id))
+	return &OrderRepairUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OrderRepair.
+func (c *OrderRepairClient) Delete() *OrderRepairDelete {
+	mutation := newOrderRepairMutation(c.config, OpDelete)
+	return &OrderRepairDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrderRepairClient) DeleteOne(_m *OrderRepair) *OrderRepairDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrderRepairClient) DeleteOneID(id int) *OrderRepairDeleteOne {
+	builder := c.Delete().Where(orderrepair.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrderRepairDeleteOne{builder}
+}
+
+// Query returns a query builder for OrderRepair.
+func (c *OrderRepairClient) Query() *OrderRepairQuery {
+	return &OrderRepairQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrderRepair},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OrderRepair entity by its id.
+func (c *OrderRepairClient) Get(ctx context.Context, id int) (*OrderRepair, error) {
+	return c.Query().Where(orderrepair.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrderRepairClient) GetX(ctx context.Context, id int) *OrderRepair {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OrderRepairClient) Hooks() []Hook {
+	return c.hooks.OrderRepair
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrderRepairClient) Interceptors() []Interceptor {
+	return c.inters.OrderRepair
+}
+
+func (c *OrderRepairClient) mutate(ctx context.Context, m *OrderRepairMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrderRepairCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrderRepairUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrderRepairUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrderRepairDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OrderRepair mutation op: %q", m.Op())
+	}
+}
+
 // StrategyClient is a client for the Strategy schema.
 type StrategyClient struct {
 	config
@@ -743,6 +1334,22 @@ func (c *StrategyClient) GetX(ctx context.Context, id int) *Strategy {
 	return obj
 }
 
+// QueryExchangeAccount queries the exchangeAccount edge of a Strategy.
+func (c *StrategyClient) QueryExchangeAccount(_m *Strategy) *ExchangeAccountQuery {
+	query := (&ExchangeAccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(strategy.Table, strategy.FieldID, id),
+			sqlgraph.To(exchangeaccount.Table, exchangeaccount.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, strategy.ExchangeAccountTable, strategy.ExchangeAccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *StrategyClient) Hooks() []Hook {
 	return c.hooks.Strategy
@@ -768,6 +1375,139 @@ func (c *StrategyClient) mutate(ctx context.Context, m *StrategyMutation) (Value
 	}
 }
 
+// StrategyRunClient is a client for the StrategyRun schema.
+type StrategyRunClient struct {
+	config
+}
+
+// NewStrategyRunClient returns a client for the StrategyRun from the given config.
+func NewStrategyRunClient(c config) *StrategyRunClient {
+	return &StrategyRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `strategyrun.Hooks(f(g(h())))`.
+func (c *StrategyRunClient) Use(hooks ...Hook) {
+	c.hooks.StrategyRun = append(c.hooks.StrategyRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `strategyrun.Intercept(f(g(h())))`.
+func (c *StrategyRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StrategyRun = append(c.inters.StrategyRun, interceptors...)
+}
+
+// Create returns a builder for creating a StrategyRun entity.
+func (c *StrategyRunClient) Create() *StrategyRunCreate {
+	mutation := newStrategyRunMutation(c.config, OpCreate)
+	return &StrategyRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StrategyRun entities.
+func (c *StrategyRunClient) CreateBulk(builders ...*StrategyRunCreate) *StrategyRunCreateBulk {
+	return &StrategyRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StrategyRunClient) MapCreateBulk(slice any, setFunc func(*StrategyRunCreate, int)) *StrategyRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StrategyRunCreateBulk{err: fmt.Errorf("calling to StrategyRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StrategyRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StrategyRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for StrategyRun.
+func (c *StrategyRunClient) Update() *StrategyRunUpdate {
+	mutation := newStrategyRunMutation(c.config, OpUpdate)
+	return &StrategyRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StrategyRunClient) UpdateOne(_m *StrategyRun) *StrategyRunUpdateOne {
+	mutation := newStrategyRunMutation(c.config, OpUpdateOne, withStrategyRun(_m))
+	return &StrategyRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StrategyRunClient) UpdateOneID(id int) *StrategyRunUpdateOne {
+	mutation := newStrategyRunMutation(c.config, OpUpdateOne, withStrategyRunID(id))
+	return &StrategyRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for StrategyRun.
+func (c *StrategyRunClient) Delete() *StrategyRunDelete {
+	mutation := newStrategyRunMutation(c.config, OpDelete)
+	return &StrategyRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StrategyRunClient) DeleteOne(_m *StrategyRun) *StrategyRunDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StrategyRunClient) DeleteOneID(id int) *StrategyRunDeleteOne {
+	builder := c.Delete().Where(strategyrun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StrategyRunDeleteOne{builder}
+}
+
+// Query returns a query builder for StrategyRun.
+func (c *StrategyRunClient) Query() *StrategyRunQuery {
+	return &StrategyRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStrategyRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a StrategyRun entity by its id.
+func (c *StrategyRunClient) Get(ctx context.Context, id int) (*StrategyRun, error) {
+	return c.Query().Where(strategyrun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StrategyRunClient) GetX(ctx context.Context, id int) *StrategyRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *StrategyRunClient) Hooks() []Hook {
+	return c.hooks.StrategyRun
+}
+
+// Interceptors returns the client interceptors.
+func (c *StrategyRunClient) Interceptors() []Interceptor {
+	return c.inters.StrategyRun
+}
+
+func (c *StrategyRunClient) mutate(ctx context.Context, m *StrategyRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StrategyRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StrategyRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StrategyRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StrategyRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown StrategyRun mutation op: %q", m.Op())
+	}
+}
+
 // SyncProgressClient is a client for the SyncProgress schema.
 type SyncProgressClient struct {
 	config
@@ -904,9 +1644,11 @@ func (c *SyncProgressClient) mutate(ctx context.Context, m *SyncProgressMutation
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Grid, MatchedTrade, Order, Strategy, SyncProgress []ent.Hook
+		ExchangeAccount, Fill, FundingPayment, Grid, MatchedTrade, Order, OrderRepair,
+		Strategy, StrategyRun, SyncProgress []ent.Hook
 	}
 	inters struct {
-		Grid, MatchedTrade, Order, Strategy, SyncProgress []ent.Interceptor
+		ExchangeAccount, Fill, FundingPayment, Grid, MatchedTrade, Order, OrderRepair,
+		Strategy, StrategyRun, SyncProgress []ent.Interceptor
 	}
 )
@@ -12,10 +12,15 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/fachebot/omni-grid-bot/internal/ent/exchangeaccount"
+	"github.com/fachebot/omni-grid-bot/internal/ent/fill"
+	"github.com/fachebot/omni-grid-bot/internal/ent/fundingpayment"
 	"github.com/fachebot/omni-grid-bot/internal/ent/grid"
 	"github.com/fachebot/omni-grid-bot/internal/ent/matchedtrade"
 	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/ent/orderrepair"
 	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategyrun"
 	"github.com/fachebot/omni-grid-bot/internal/ent/syncprogress"
 )
 
@@ -77,11 +82,16 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			grid.Table:         grid.ValidColumn,
-			matchedtrade.Table: matchedtrade.ValidColumn,
-			order.Table:        order.ValidColumn,
-			strategy.Table:     strategy.ValidColumn,
-			syncprogress.Table: syncprogress.ValidColumn,
+			exchangeaccount.Table: exchangeaccount.ValidColumn,
+			fill.Table:            fill.ValidColumn,
+			fundingpayment.Table:  fundingpayment.ValidColumn,
+			grid.Table:            grid.ValidColumn,
+			matchedtrade.Table:    matchedtrade.ValidColumn,
+			order.Table:           order.ValidColumn,
+			orderrepair.Table:     orderrepair.ValidColumn,
+			strategy.Table:        strategy.ValidColumn,
+			strategyrun.Table:     strategyrun.ValidColumn,
+			syncprogress.Table:    syncprogress.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
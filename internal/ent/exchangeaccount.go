@@ -0,0 +1,226 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/fachebot/omni-grid-bot/internal/ent/exchangeaccount"
+)
+
+// ExchangeAccount is the model entity for the ExchangeAccount schema.
+type ExchangeAccount struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Owner holds the value of the "owner" field.
+	Owner int64 `json:"owner,omitempty"`
+	// Exchange holds the value of the "exchange" field.
+	Exchange string `json:"exchange,omitempty"`
+	// Label holds the value of the "label" field.
+	Label string `json:"label,omitempty"`
+	// Account holds the value of the "account" field.
+	Account string `json:"account,omitempty"`
+	// SecretKey holds the value of the "secretKey" field.
+	SecretKey string `json:"secretKey,omitempty"`
+	// Passphrase holds the value of the "passphrase" field.
+	Passphrase string `json:"passphrase,omitempty"`
+	// Testnet holds the value of the "testnet" field.
+	Testnet bool `json:"testnet,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ExchangeAccountQuery when eager-loading is set.
+	Edges        ExchangeAccountEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ExchangeAccountEdges holds the relations/edges for other nodes in the graph.
+type ExchangeAccountEdges struct {
+	// Strategies holds the value of the strategies edge.
+	Strategies []*Strategy `json:"strategies,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// StrategiesOrErr returns the Strategies value or an error if the edge
+// was not loaded in eager-loading.
+func (e ExchangeAccountEdges) StrategiesOrErr() ([]*Strategy, error) {
+	if e.loadedTypes[0] {
+		return e.Strategies, nil
+	}
+	return nil, &NotLoadedError{edge: "strategies"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExchangeAccount) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case exchangeaccount.FieldTestnet:
+			values[i] = new(sql.NullBool)
+		case exchangeaccount.FieldID, exchangeaccount.FieldOwner:
+			values[i] = new(sql.NullInt64)
+		case exchangeaccount.FieldExchange, exchangeaccount.FieldLabel, exchangeaccount.FieldAccount:
+			values[i] = new(sql.NullString)
+		case exchangeaccount.FieldCreateTime, exchangeaccount.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case exchangeaccount.FieldSecretKey:
+			values[i] = exchangeaccount.ValueScanner.SecretKey.ScanValue()
+		case exchangeaccount.FieldPassphrase:
+			values[i] = exchangeaccount.ValueScanner.Passphrase.ScanValue()
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExchangeAccount fields.
+func (_m *ExchangeAccount) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case exchangeaccount.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case exchangeaccount.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case exchangeaccount.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case exchangeaccount.FieldOwner:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field owner", values[i])
+			} else if value.Valid {
+				_m.Owner = value.Int64
+			}
+		case exchangeaccount.FieldExchange:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange", values[i])
+			} else if value.Valid {
+				_m.Exchange = value.String
+			}
+		case exchangeaccount.FieldLabel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field label", values[i])
+			} else if value.Valid {
+				_m.Label = value.String
+			}
+		case exchangeaccount.FieldAccount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account", values[i])
+			} else if value.Valid {
+				_m.Account = value.String
+			}
+		case exchangeaccount.FieldSecretKey:
+			if value, err := exchangeaccount.ValueScanner.SecretKey.FromValue(values[i]); err != nil {
+				return err
+			} else {
+				_m.SecretKey = value
+			}
+		case exchangeaccount.FieldPassphrase:
+			if value, err := exchangeaccount.ValueScanner.Passphrase.FromValue(values[i]); err != nil {
+				return err
+			} else {
+				_m.Passphrase = value
+			}
+		case exchangeaccount.FieldTestnet:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field testnet", values[i])
+			} else if value.Valid {
+				_m.Testnet = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ExchangeAccount.
+// This includes values selected through modifiers, order, etc.
+func (_m *ExchangeAccount) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryStrategies queries the "strategies" edge of the ExchangeAccount entity.
+func (_m *ExchangeAccount) QueryStrategies() *StrategyQuery {
+	return NewExchangeAccountClient(_m.config).QueryStrategies(_m)
+}
+
+// Update returns a builder for updating this ExchangeAccount.
+// Note that you need to call ExchangeAccount.Unwrap() before calling this method if this ExchangeAccount
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ExchangeAccount) Update() *ExchangeAccountUpdateOne {
+	return NewExchangeAccountClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ExchangeAccount entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ExchangeAccount) Unwrap() *ExchangeAccount {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExchangeAccount is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ExchangeAccount) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExchangeAccount(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("owner=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Owner))
+	builder.WriteString(", ")
+	builder.WriteString("exchange=")
+	builder.WriteString(_m.Exchange)
+	builder.WriteString(", ")
+	builder.WriteString("label=")
+	builder.WriteString(_m.Label)
+	builder.WriteString(", ")
+	builder.WriteString("account=")
+	builder.WriteString(_m.Account)
+	builder.WriteString(", ")
+	builder.WriteString("secretKey=")
+	builder.WriteString(_m.SecretKey)
+	builder.WriteString(", ")
+	builder.WriteString("passphrase=")
+	builder.WriteString(_m.Passphrase)
+	builder.WriteString(", ")
+	builder.WriteString("testnet=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Testnet))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExchangeAccounts is a parsable slice of ExchangeAccount.
+type ExchangeAccounts []*ExchangeAccount
@@ -0,0 +1,165 @@
+// Code generated by ent, DO NOT EDIT.
+
+package exchangeaccount
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+const (
+	// Label holds the string label denoting the exchangeaccount type in the database.
+	Label = "exchange_account"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldOwner holds the string denoting the owner field in the database.
+	FieldOwner = "owner"
+	// FieldExchange holds the string denoting the exchange field in the database.
+	FieldExchange = "exchange"
+	// FieldLabel holds the string denoting the label field in the database.
+	FieldLabel = "label"
+	// FieldAccount holds the string denoting the account field in the database.
+	FieldAccount = "account"
+	// FieldSecretKey holds the string denoting the secretkey field in the database.
+	FieldSecretKey = "secret_key"
+	// FieldPassphrase holds the string denoting the passphrase field in the database.
+	FieldPassphrase = "passphrase"
+	// FieldTestnet holds the string denoting the testnet field in the database.
+	FieldTestnet = "testnet"
+	// EdgeStrategies holds the string denoting the strategies edge name in mutations.
+	EdgeStrategies = "strategies"
+	// Table holds the table name of the exchangeaccount in the database.
+	Table = "exchange_accounts"
+	// StrategiesTable is the table that holds the strategies relation/edge.
+	StrategiesTable = "strategies"
+	// StrategiesInverseTable is the table name for the Strategy entity.
+	// It exists in this package in order to avoid circular dependency with the "strategy" package.
+	StrategiesInverseTable = "strategies"
+	// StrategiesColumn is the table column denoting the strategies relation/edge.
+	StrategiesColumn = "account_id"
+)
+
+// Columns holds all SQL columns for exchangeaccount fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldOwner,
+	FieldExchange,
+	FieldLabel,
+	FieldAccount,
+	FieldSecretKey,
+	FieldPassphrase,
+	FieldTestnet,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// ExchangeValidator is a validator for the "exchange" field. It is called by the builders before save.
+	ExchangeValidator func(string) error
+	// LabelValidator is a validator for the "label" field. It is called by the builders before save.
+	LabelValidator func(string) error
+	// DefaultTestnet holds the default value on creation for the "testnet" field.
+	DefaultTestnet bool
+	// ValueScanner of all ExchangeAccount fields.
+	ValueScanner struct {
+		SecretKey  field.TypeValueScanner[string]
+		Passphrase field.TypeValueScanner[string]
+	}
+)
+
+// OrderOption defines the ordering options for the ExchangeAccount queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByOwner orders the results by the owner field.
+func ByOwner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwner, opts...).ToFunc()
+}
+
+// ByExchange orders the results by the exchange field.
+func ByExchange(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExchange, opts...).ToFunc()
+}
+
+// ByLabel orders the results by the label field.
+func ByLabel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLabel, opts...).ToFunc()
+}
+
+// ByAccount orders the results by the account field.
+func ByAccount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccount, opts...).ToFunc()
+}
+
+// BySecretKey orders the results by the secretKey field.
+func BySecretKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSecretKey, opts...).ToFunc()
+}
+
+// ByPassphrase orders the results by the passphrase field.
+func ByPassphrase(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPassphrase, opts...).ToFunc()
+}
+
+// ByTestnet orders the results by the testnet field.
+func ByTestnet(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTestnet, opts...).ToFunc()
+}
+
+// ByStrategiesCount orders the results by strategies count.
+func ByStrategiesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStrategiesStep(), opts...)
+	}
+}
+
+// ByStrategies orders the results by strategies terms.
+func ByStrategies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStrategiesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newStrategiesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StrategiesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StrategiesTable, StrategiesColumn),
+	)
+}
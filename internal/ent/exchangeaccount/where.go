@@ -0,0 +1,690 @@
+// Code generated by ent, DO NOT EDIT.
+
+package exchangeaccount
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/fachebot/omni-grid-bot/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// Owner applies equality check predicate on the "owner" field. It's identical to OwnerEQ.
+func Owner(v int64) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldEQ(FieldOwner, v))
+}
+
+// Exchange applies equality check predicate on the "exchange" field. It's identical to ExchangeEQ.
+func Exchange(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldEQ(FieldExchange, v))
+}
+
+// Account applies equality check predicate on the "account" field. It's identical to AccountEQ.
+func Account(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldEQ(FieldAccount, v))
+}
+
+// SecretKey applies equality check predicate on the "secretKey" field. It's identical to SecretKeyEQ.
+func SecretKey(v string) predicate.ExchangeAccount {
+	vc, err := ValueScanner.SecretKey.Value(v)
+	return predicate.ExchangeAccountOrErr(sql.FieldEQ(FieldSecretKey, vc), err)
+}
+
+// Passphrase applies equality check predicate on the "passphrase" field. It's identical to PassphraseEQ.
+func Passphrase(v string) predicate.ExchangeAccount {
+	vc, err := ValueScanner.Passphrase.Value(v)
+	return predicate.ExchangeAccountOrErr(sql.FieldEQ(FieldPassphrase, vc), err)
+}
+
+// Testnet applies equality check predicate on the "testnet" field. It's identical to TestnetEQ.
+func Testnet(v bool) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldEQ(FieldTestnet, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// OwnerEQ applies the EQ predicate on the "owner" field.
+func OwnerEQ(v int64) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldEQ(FieldOwner, v))
+}
+
+// OwnerNEQ applies the NEQ predicate on the "owner" field.
+func OwnerNEQ(v int64) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldNEQ(FieldOwner, v))
+}
+
+// OwnerIn applies the In predicate on the "owner" field.
+func OwnerIn(vs ...int64) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldIn(FieldOwner, vs...))
+}
+
+// OwnerNotIn applies the NotIn predicate on the "owner" field.
+func OwnerNotIn(vs ...int64) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldNotIn(FieldOwner, vs...))
+}
+
+// OwnerGT applies the GT predicate on the "owner" field.
+func OwnerGT(v int64) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldGT(FieldOwner, v))
+}
+
+// OwnerGTE applies the GTE predicate on the "owner" field.
+func OwnerGTE(v int64) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldGTE(FieldOwner, v))
+}
+
+// OwnerLT applies the LT predicate on the "owner" field.
+func OwnerLT(v int64) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldLT(FieldOwner, v))
+}
+
+// OwnerLTE applies the LTE predicate on the "owner" field.
+func OwnerLTE(v int64) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldLTE(FieldOwner, v))
+}
+
+// ExchangeEQ applies the EQ predicate on the "exchange" field.
+func ExchangeEQ(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldEQ(FieldExchange, v))
+}
+
+// ExchangeNEQ applies the NEQ predicate on the "exchange" field.
+func ExchangeNEQ(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldNEQ(FieldExchange, v))
+}
+
+// ExchangeIn applies the In predicate on the "exchange" field.
+func ExchangeIn(vs ...string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldIn(FieldExchange, vs...))
+}
+
+// ExchangeNotIn applies the NotIn predicate on the "exchange" field.
+func ExchangeNotIn(vs ...string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldNotIn(FieldExchange, vs...))
+}
+
+// ExchangeGT applies the GT predicate on the "exchange" field.
+func ExchangeGT(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldGT(FieldExchange, v))
+}
+
+// ExchangeGTE applies the GTE predicate on the "exchange" field.
+func ExchangeGTE(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldGTE(FieldExchange, v))
+}
+
+// ExchangeLT applies the LT predicate on the "exchange" field.
+func ExchangeLT(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldLT(FieldExchange, v))
+}
+
+// ExchangeLTE applies the LTE predicate on the "exchange" field.
+func ExchangeLTE(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldLTE(FieldExchange, v))
+}
+
+// ExchangeContains applies the Contains predicate on the "exchange" field.
+func ExchangeContains(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldContains(FieldExchange, v))
+}
+
+// ExchangeHasPrefix applies the HasPrefix predicate on the "exchange" field.
+func ExchangeHasPrefix(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldHasPrefix(FieldExchange, v))
+}
+
+// ExchangeHasSuffix applies the HasSuffix predicate on the "exchange" field.
+func ExchangeHasSuffix(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldHasSuffix(FieldExchange, v))
+}
+
+// ExchangeEqualFold applies the EqualFold predicate on the "exchange" field.
+func ExchangeEqualFold(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldEqualFold(FieldExchange, v))
+}
+
+// ExchangeContainsFold applies the ContainsFold predicate on the "exchange" field.
+func ExchangeContainsFold(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldContainsFold(FieldExchange, v))
+}
+
+// LabelEQ applies the EQ predicate on the "label" field.
+func LabelEQ(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldEQ(FieldLabel, v))
+}
+
+// LabelNEQ applies the NEQ predicate on the "label" field.
+func LabelNEQ(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldNEQ(FieldLabel, v))
+}
+
+// LabelIn applies the In predicate on the "label" field.
+func LabelIn(vs ...string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldIn(FieldLabel, vs...))
+}
+
+// LabelNotIn applies the NotIn predicate on the "label" field.
+func LabelNotIn(vs ...string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldNotIn(FieldLabel, vs...))
+}
+
+// LabelGT applies the GT predicate on the "label" field.
+func LabelGT(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldGT(FieldLabel, v))
+}
+
+// LabelGTE applies the GTE predicate on the "label" field.
+func LabelGTE(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldGTE(FieldLabel, v))
+}
+
+// LabelLT applies the LT predicate on the "label" field.
+func LabelLT(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldLT(FieldLabel, v))
+}
+
+// LabelLTE applies the LTE predicate on the "label" field.
+func LabelLTE(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldLTE(FieldLabel, v))
+}
+
+// LabelContains applies the Contains predicate on the "label" field.
+func LabelContains(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldContains(FieldLabel, v))
+}
+
+// LabelHasPrefix applies the HasPrefix predicate on the "label" field.
+func LabelHasPrefix(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldHasPrefix(FieldLabel, v))
+}
+
+// LabelHasSuffix applies the HasSuffix predicate on the "label" field.
+func LabelHasSuffix(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldHasSuffix(FieldLabel, v))
+}
+
+// LabelEqualFold applies the EqualFold predicate on the "label" field.
+func LabelEqualFold(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldEqualFold(FieldLabel, v))
+}
+
+// LabelContainsFold applies the ContainsFold predicate on the "label" field.
+func LabelContainsFold(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldContainsFold(FieldLabel, v))
+}
+
+// AccountEQ applies the EQ predicate on the "account" field.
+func AccountEQ(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldEQ(FieldAccount, v))
+}
+
+// AccountNEQ applies the NEQ predicate on the "account" field.
+func AccountNEQ(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldNEQ(FieldAccount, v))
+}
+
+// AccountIn applies the In predicate on the "account" field.
+func AccountIn(vs ...string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldIn(FieldAccount, vs...))
+}
+
+// AccountNotIn applies the NotIn predicate on the "account" field.
+func AccountNotIn(vs ...string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldNotIn(FieldAccount, vs...))
+}
+
+// AccountGT applies the GT predicate on the "account" field.
+func AccountGT(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldGT(FieldAccount, v))
+}
+
+// AccountGTE applies the GTE predicate on the "account" field.
+func AccountGTE(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldGTE(FieldAccount, v))
+}
+
+// AccountLT applies the LT predicate on the "account" field.
+func AccountLT(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldLT(FieldAccount, v))
+}
+
+// AccountLTE applies the LTE predicate on the "account" field.
+func AccountLTE(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldLTE(FieldAccount, v))
+}
+
+// AccountContains applies the Contains predicate on the "account" field.
+func AccountContains(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldContains(FieldAccount, v))
+}
+
+// AccountHasPrefix applies the HasPrefix predicate on the "account" field.
+func AccountHasPrefix(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldHasPrefix(FieldAccount, v))
+}
+
+// AccountHasSuffix applies the HasSuffix predicate on the "account" field.
+func AccountHasSuffix(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldHasSuffix(FieldAccount, v))
+}
+
+// AccountEqualFold applies the EqualFold predicate on the "account" field.
+func AccountEqualFold(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldEqualFold(FieldAccount, v))
+}
+
+// AccountContainsFold applies the ContainsFold predicate on the "account" field.
+func AccountContainsFold(v string) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldContainsFold(FieldAccount, v))
+}
+
+// SecretKeyEQ applies the EQ predicate on the "secretKey" field.
+func SecretKeyEQ(v string) predicate.ExchangeAccount {
+	vc, err := ValueScanner.SecretKey.Value(v)
+	return predicate.ExchangeAccountOrErr(sql.FieldEQ(FieldSecretKey, vc), err)
+}
+
+// SecretKeyNEQ applies the NEQ predicate on the "secretKey" field.
+func SecretKeyNEQ(v string) predicate.ExchangeAccount {
+	vc, err := ValueScanner.SecretKey.Value(v)
+	return predicate.ExchangeAccountOrErr(sql.FieldNEQ(FieldSecretKey, vc), err)
+}
+
+// SecretKeyIn applies the In predicate on the "secretKey" field.
+func SecretKeyIn(vs ...string) predicate.ExchangeAccount {
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.SecretKey.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.ExchangeAccountOrErr(sql.FieldIn(FieldSecretKey, v...), err)
+}
+
+// SecretKeyNotIn applies the NotIn predicate on the "secretKey" field.
+func SecretKeyNotIn(vs ...string) predicate.ExchangeAccount {
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.SecretKey.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.ExchangeAccountOrErr(sql.FieldNotIn(FieldSecretKey, v...), err)
+}
+
+// SecretKeyGT applies the GT predicate on the "secretKey" field.
+func SecretKeyGT(v string) predicate.ExchangeAccount {
+	vc, err := ValueScanner.SecretKey.Value(v)
+	return predicate.ExchangeAccountOrErr(sql.FieldGT(FieldSecretKey, vc), err)
+}
+
+// SecretKeyGTE applies the GTE predicate on the "secretKey" field.
+func SecretKeyGTE(v string) predicate.ExchangeAccount {
+	vc, err := ValueScanner.SecretKey.Value(v)
+	return predicate.ExchangeAccountOrErr(sql.FieldGTE(FieldSecretKey, vc), err)
+}
+
+// SecretKeyLT applies the LT predicate on the "secretKey" field.
+func SecretKeyLT(v string) predicate.ExchangeAccount {
+	vc, err := ValueScanner.SecretKey.Value(v)
+	return predicate.ExchangeAccountOrErr(sql.FieldLT(FieldSecretKey, vc), err)
+}
+
+// SecretKeyLTE applies the LTE predicate on the "secretKey" field.
+func SecretKeyLTE(v string) predicate.ExchangeAccount {
+	vc, err := ValueScanner.SecretKey.Value(v)
+	return predicate.ExchangeAccountOrErr(sql.FieldLTE(FieldSecretKey, vc), err)
+}
+
+// SecretKeyContains applies the Contains predicate on the "secretKey" field.
+func SecretKeyContains(v string) predicate.ExchangeAccount {
+	vc, err := ValueScanner.SecretKey.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("secretKey value is not a string: %T", vc)
+	}
+	return predicate.ExchangeAccountOrErr(sql.FieldContains(FieldSecretKey, vcs), err)
+}
+
+// SecretKeyHasPrefix applies the HasPrefix predicate on the "secretKey" field.
+func SecretKeyHasPrefix(v string) predicate.ExchangeAccount {
+	vc, err := ValueScanner.SecretKey.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("secretKey value is not a string: %T", vc)
+	}
+	return predicate.ExchangeAccountOrErr(sql.FieldHasPrefix(FieldSecretKey, vcs), err)
+}
+
+// SecretKeyHasSuffix applies the HasSuffix predicate on the "secretKey" field.
+func SecretKeyHasSuffix(v string) predicate.ExchangeAccount {
+	vc, err := ValueScanner.SecretKey.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("secretKey value is not a string: %T", vc)
+	}
+	return predicate.ExchangeAccountOrErr(sql.FieldHasSuffix(FieldSecretKey, vcs), err)
+}
+
+// SecretKeyEqualFold applies the EqualFold predicate on the "secretKey" field.
+func SecretKeyEqualFold(v string) predicate.ExchangeAccount {
+	vc, err := ValueScanner.SecretKey.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("secretKey value is not a string: %T", vc)
+	}
+	return predicate.ExchangeAccountOrErr(sql.FieldEqualFold(FieldSecretKey, vcs), err)
+}
+
+// SecretKeyContainsFold applies the ContainsFold predicate on the "secretKey" field.
+func SecretKeyContainsFold(v string) predicate.ExchangeAccount {
+	vc, err := ValueScanner.SecretKey.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("secretKey value is not a string: %T", vc)
+	}
+	return predicate.ExchangeAccountOrErr(sql.FieldContainsFold(FieldSecretKey, vcs), err)
+}
+
+// PassphraseEQ applies the EQ predicate on the "passphrase" field.
+func PassphraseEQ(v string) predicate.ExchangeAccount {
+	vc, err := ValueScanner.Passphrase.Value(v)
+	return predicate.ExchangeAccountOrErr(sql.FieldEQ(FieldPassphrase, vc), err)
+}
+
+// PassphraseNEQ applies the NEQ predicate on the "passphrase" field.
+func PassphraseNEQ(v string) predicate.ExchangeAccount {
+	vc, err := ValueScanner.Passphrase.Value(v)
+	return predicate.ExchangeAccountOrErr(sql.FieldNEQ(FieldPassphrase, vc), err)
+}
+
+// PassphraseIn applies the In predicate on the "passphrase" field.
+func PassphraseIn(vs ...string) predicate.ExchangeAccount {
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.Passphrase.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.ExchangeAccountOrErr(sql.FieldIn(FieldPassphrase, v...), err)
+}
+
+// PassphraseNotIn applies the NotIn predicate on the "passphrase" field.
+func PassphraseNotIn(vs ...string) predicate.ExchangeAccount {
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.Passphrase.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.ExchangeAccountOrErr(sql.FieldNotIn(FieldPassphrase, v...), err)
+}
+
+// PassphraseGT applies the GT predicate on the "passphrase" field.
+func PassphraseGT(v string) predicate.ExchangeAccount {
+	vc, err := ValueScanner.Passphrase.Value(v)
+	return predicate.ExchangeAccountOrErr(sql.FieldGT(FieldPassphrase, vc), err)
+}
+
+// PassphraseGTE applies the GTE predicate on the "passphrase" field.
+func PassphraseGTE(v string) predicate.ExchangeAccount {
+	vc, err := ValueScanner.Passphrase.Value(v)
+	return predicate.ExchangeAccountOrErr(sql.FieldGTE(FieldPassphrase, vc), err)
+}
+
+// PassphraseLT applies the LT predicate on the "passphrase" field.
+func PassphraseLT(v string) predicate.ExchangeAccount {
+	vc, err := ValueScanner.Passphrase.Value(v)
+	return predicate.ExchangeAccountOrErr(sql.FieldLT(FieldPassphrase, vc), err)
+}
+
+// PassphraseLTE applies the LTE predicate on the "passphrase" field.
+func PassphraseLTE(v string) predicate.ExchangeAccount {
+	vc, err := ValueScanner.Passphrase.Value(v)
+	return predicate.ExchangeAccountOrErr(sql.FieldLTE(FieldPassphrase, vc), err)
+}
+
+// PassphraseContains applies the Contains predicate on the "passphrase" field.
+func PassphraseContains(v string) predicate.ExchangeAccount {
+	vc, err := ValueScanner.Passphrase.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("passphrase value is not a string: %T", vc)
+	}
+	return predicate.ExchangeAccountOrErr(sql.FieldContains(FieldPassphrase, vcs), err)
+}
+
+// PassphraseHasPrefix applies the HasPrefix predicate on the "passphrase" field.
+func PassphraseHasPrefix(v string) predicate.ExchangeAccount {
+	vc, err := ValueScanner.Passphrase.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("passphrase value is not a string: %T", vc)
+	}
+	return predicate.ExchangeAccountOrErr(sql.FieldHasPrefix(FieldPassphrase, vcs), err)
+}
+
+// PassphraseHasSuffix applies the HasSuffix predicate on the "passphrase" field.
+func PassphraseHasSuffix(v string) predicate.ExchangeAccount {
+	vc, err := ValueScanner.Passphrase.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("passphrase value is not a string: %T", vc)
+	}
+	return predicate.ExchangeAccountOrErr(sql.FieldHasSuffix(FieldPassphrase, vcs), err)
+}
+
+// PassphraseEqualFold applies the EqualFold predicate on the "passphrase" field.
+func PassphraseEqualFold(v string) predicate.ExchangeAccount {
+	vc, err := ValueScanner.Passphrase.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("passphrase value is not a string: %T", vc)
+	}
+	return predicate.ExchangeAccountOrErr(sql.FieldEqualFold(FieldPassphrase, vcs), err)
+}
+
+// PassphraseContainsFold applies the ContainsFold predicate on the "passphrase" field.
+func PassphraseContainsFold(v string) predicate.ExchangeAccount {
+	vc, err := ValueScanner.Passphrase.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("passphrase value is not a string: %T", vc)
+	}
+	return predicate.ExchangeAccountOrErr(sql.FieldContainsFold(FieldPassphrase, vcs), err)
+}
+
+// TestnetEQ applies the EQ predicate on the "testnet" field.
+func TestnetEQ(v bool) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldEQ(FieldTestnet, v))
+}
+
+// TestnetNEQ applies the NEQ predicate on the "testnet" field.
+func TestnetNEQ(v bool) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.FieldNEQ(FieldTestnet, v))
+}
+
+// HasStrategies applies the HasEdge predicate on the "strategies" edge.
+func HasStrategies() predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StrategiesTable, StrategiesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStrategiesWith applies the HasEdge predicate on the "strategies" edge with a given conditions (other predicates).
+func HasStrategiesWith(preds ...predicate.Strategy) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(func(s *sql.Selector) {
+		step := newStrategiesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ExchangeAccount) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ExchangeAccount) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ExchangeAccount) predicate.ExchangeAccount {
+	return predicate.ExchangeAccount(sql.NotPredicates(p))
+}
@@ -0,0 +1,969 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/fachebot/omni-grid-bot/internal/ent/exchangeaccount"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+)
+
+// ExchangeAccountCreate is the builder for creating a ExchangeAccount entity.
+type ExchangeAccountCreate struct {
+	config
+	mutation *ExchangeAccountMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ExchangeAccountCreate) SetCreateTime(v time.Time) *ExchangeAccountCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ExchangeAccountCreate) SetNillableCreateTime(v *time.Time) *ExchangeAccountCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ExchangeAccountCreate) SetUpdateTime(v time.Time) *ExchangeAccountCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ExchangeAccountCreate) SetNillableUpdateTime(v *time.Time) *ExchangeAccountCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetOwner sets the "owner" field.
+func (_c *ExchangeAccountCreate) SetOwner(v int64) *ExchangeAccountCreate {
+	_c.mutation.SetOwner(v)
+	return _c
+}
+
+// SetExchange sets the "exchange" field.
+func (_c *ExchangeAccountCreate) SetExchange(v string) *ExchangeAccountCreate {
+	_c.mutation.SetExchange(v)
+	return _c
+}
+
+// SetLabel sets the "label" field.
+func (_c *ExchangeAccountCreate) SetLabel(v string) *ExchangeAccountCreate {
+	_c.mutation.SetLabel(v)
+	return _c
+}
+
+// SetAccount sets the "account" field.
+func (_c *ExchangeAccountCreate) SetAccount(v string) *ExchangeAccountCreate {
+	_c.mutation.SetAccount(v)
+	return _c
+}
+
+// SetSecretKey sets the "secretKey" field.
+func (_c *ExchangeAccountCreate) SetSecretKey(v string) *ExchangeAccountCreate {
+	_c.mutation.SetSecretKey(v)
+	return _c
+}
+
+// SetPassphrase sets the "passphrase" field.
+func (_c *ExchangeAccountCreate) SetPassphrase(v string) *ExchangeAccountCreate {
+	_c.mutation.SetPassphrase(v)
+	return _c
+}
+
+// SetTestnet sets the "testnet" field.
+func (_c *ExchangeAccountCreate) SetTestnet(v bool) *ExchangeAccountCreate {
+	_c.mutation.SetTestnet(v)
+	return _c
+}
+
+// SetNillableTestnet sets the "testnet" field if the given value is not nil.
+func (_c *ExchangeAccountCreate) SetNillableTestnet(v *bool) *ExchangeAccountCreate {
+	if v != nil {
+		_c.SetTestnet(*v)
+	}
+	return _c
+}
+
+// AddStrategyIDs adds the "strategies" edge to the Strategy entity by IDs.
+func (_c *ExchangeAccountCreate) AddStrategyIDs(ids ...int) *ExchangeAccountCreate {
+	_c.mutation.AddStrategyIDs(ids...)
+	return _c
+}
+
+// AddStrategies adds the "strategies" edges to the Strategy entity.
+func (_c *ExchangeAccountCreate) AddStrategies(v ...*Strategy) *ExchangeAccountCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddStrategyIDs(ids...)
+}
+
+// Mutation returns the ExchangeAccountMutation object of the builder.
+func (_c *ExchangeAccountCreate) Mutation() *ExchangeAccountMutation {
+	return _c.mutation
+}
+
+// Save creates the ExchangeAccount in the database.
+func (_c *ExchangeAccountCreate) Save(ctx context.Context) (*ExchangeAccount, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ExchangeAccountCreate) SaveX(ctx context.Context) *ExchangeAccount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExchangeAccountCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExchangeAccountCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ExchangeAccountCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := exchangeaccount.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := exchangeaccount.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Testnet(); !ok {
+		v := exchangeaccount.DefaultTestnet
+		_c.mutation.SetTestnet(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ExchangeAccountCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "ExchangeAccount.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "ExchangeAccount.update_time"`)}
+	}
+	if _, ok := _c.mutation.Owner(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required field "ExchangeAccount.owner"`)}
+	}
+	if _, ok := _c.mutation.Exchange(); !ok {
+		return &ValidationError{Name: "exchange", err: errors.New(`ent: missing required field "ExchangeAccount.exchange"`)}
+	}
+	if v, ok := _c.mutation.Exchange(); ok {
+		if err := exchangeaccount.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "ExchangeAccount.exchange": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Label(); !ok {
+		return &ValidationError{Name: "label", err: errors.New(`ent: missing required field "ExchangeAccount.label"`)}
+	}
+	if v, ok := _c.mutation.Label(); ok {
+		if err := exchangeaccount.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`ent: validator failed for field "ExchangeAccount.label": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Account(); !ok {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required field "ExchangeAccount.account"`)}
+	}
+	if _, ok := _c.mutation.SecretKey(); !ok {
+		return &ValidationError{Name: "secretKey", err: errors.New(`ent: missing required field "ExchangeAccount.secretKey"`)}
+	}
+	if _, ok := _c.mutation.Passphrase(); !ok {
+		return &ValidationError{Name: "passphrase", err: errors.New(`ent: missing required field "ExchangeAccount.passphrase"`)}
+	}
+	if _, ok := _c.mutation.Testnet(); !ok {
+		return &ValidationError{Name: "testnet", err: errors.New(`ent: missing required field "ExchangeAccount.testnet"`)}
+	}
+	return nil
+}
+
+func (_c *ExchangeAccountCreate) sqlSave(ctx context.Context) (*ExchangeAccount, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec, err := _c.createSpec()
+	if err != nil {
+		return nil, err
+	}
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ExchangeAccountCreate) createSpec() (*ExchangeAccount, *sqlgraph.CreateSpec, error) {
+	var (
+		_node = &ExchangeAccount{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(exchangeaccount.Table, sqlgraph.NewFieldSpec(exchangeaccount.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(exchangeaccount.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(exchangeaccount.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Owner(); ok {
+		_spec.SetField(exchangeaccount.FieldOwner, field.TypeInt64, value)
+		_node.Owner = value
+	}
+	if value, ok := _c.mutation.Exchange(); ok {
+		_spec.SetField(exchangeaccount.FieldExchange, field.TypeString, value)
+		_node.Exchange = value
+	}
+	if value, ok := _c.mutation.Label(); ok {
+		_spec.SetField(exchangeaccount.FieldLabel, field.TypeString, value)
+		_node.Label = value
+	}
+	if value, ok := _c.mutation.Account(); ok {
+		_spec.SetField(exchangeaccount.FieldAccount, field.TypeString, value)
+		_node.Account = value
+	}
+	if value, ok := _c.mutation.SecretKey(); ok {
+		vv, err := exchangeaccount.ValueScanner.SecretKey.Value(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		_spec.SetField(exchangeaccount.FieldSecretKey, field.TypeString, vv)
+		_node.SecretKey = value
+	}
+	if value, ok := _c.mutation.Passphrase(); ok {
+		vv, err := exchangeaccount.ValueScanner.Passphrase.Value(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		_spec.SetField(exchangeaccount.FieldPassphrase, field.TypeString, vv)
+		_node.Passphrase = value
+	}
+	if value, ok := _c.mutation.Testnet(); ok {
+		_spec.SetField(exchangeaccount.FieldTestnet, field.TypeBool, value)
+		_node.Testnet = value
+	}
+	if nodes := _c.mutation.StrategiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   exchangeaccount.StrategiesTable,
+			Columns: []string{exchangeaccount.StrategiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(strategy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec, nil
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExchangeAccount.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExchangeAccountUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ExchangeAccountCreate) OnConflict(opts ...sql.ConflictOption) *ExchangeAccountUpsertOne {
+	_c.conflict = opts
+	return &ExchangeAccountUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExchangeAccount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ExchangeAccountCreate) OnConflictColumns(columns ...string) *ExchangeAccountUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ExchangeAccountUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ExchangeAccountUpsertOne is the builder for "upsert"-ing
+	//  one ExchangeAccount node.
+	ExchangeAccountUpsertOne struct {
+		create *ExchangeAccountCreate
+	}
+
+	// ExchangeAccountUpsert is the "OnConflict" setter.
+	ExchangeAccountUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ExchangeAccountUpsert) SetUpdateTime(v time.Time) *ExchangeAccountUpsert {
+	u.Set(exchangeaccount.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ExchangeAccountUpsert) UpdateUpdateTime() *ExchangeAccountUpsert {
+	u.SetExcluded(exchangeaccount.FieldUpdateTime)
+	return u
+}
+
+// SetOwner sets the "owner" field.
+func (u *ExchangeAccountUpsert) SetOwner(v int64) *ExchangeAccountUpsert {
+	u.Set(exchangeaccount.FieldOwner, v)
+	return u
+}
+
+// UpdateOwner sets the "owner" field to the value that was provided on create.
+func (u *ExchangeAccountUpsert) UpdateOwner() *ExchangeAccountUpsert {
+	u.SetExcluded(exchangeaccount.FieldOwner)
+	return u
+}
+
+// AddOwner adds v to the "owner" field.
+func (u *ExchangeAccountUpsert) AddOwner(v int64) *ExchangeAccountUpsert {
+	u.Add(exchangeaccount.FieldOwner, v)
+	return u
+}
+
+// SetExchange sets the "exchange" field.
+func (u *ExchangeAccountUpsert) SetExchange(v string) *ExchangeAccountUpsert {
+	u.Set(exchangeaccount.FieldExchange, v)
+	return u
+}
+
+// UpdateExchange sets the "exchange" field to the value that was provided on create.
+func (u *ExchangeAccountUpsert) UpdateExchange() *ExchangeAccountUpsert {
+	u.SetExcluded(exchangeaccount.FieldExchange)
+	return u
+}
+
+// SetLabel sets the "label" field.
+func (u *ExchangeAccountUpsert) SetLabel(v string) *ExchangeAccountUpsert {
+	u.Set(exchangeaccount.FieldLabel, v)
+	return u
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *ExchangeAccountUpsert) UpdateLabel() *ExchangeAccountUpsert {
+	u.SetExcluded(exchangeaccount.FieldLabel)
+	return u
+}
+
+// SetAccount sets the "account" field.
+func (u *ExchangeAccountUpsert) SetAccount(v string) *ExchangeAccountUpsert {
+	u.Set(exchangeaccount.FieldAccount, v)
+	return u
+}
+
+// UpdateAccount sets the "account" field to the value that was provided on create.
+func (u *ExchangeAccountUpsert) UpdateAccount() *ExchangeAccountUpsert {
+	u.SetExcluded(exchangeaccount.FieldAccount)
+	return u
+}
+
+// SetSecretKey sets the "secretKey" field.
+func (u *ExchangeAccountUpsert) SetSecretKey(v string) *ExchangeAccountUpsert {
+	u.Set(exchangeaccount.FieldSecretKey, v)
+	return u
+}
+
+// UpdateSecretKey sets the "secretKey" field to the value that was provided on create.
+func (u *ExchangeAccountUpsert) UpdateSecretKey() *ExchangeAccountUpsert {
+	u.SetExcluded(exchangeaccount.FieldSecretKey)
+	return u
+}
+
+// SetPassphrase sets the "passphrase" field.
+func (u *ExchangeAccountUpsert) SetPassphrase(v string) *ExchangeAccountUpsert {
+	u.Set(exchangeaccount.FieldPassphrase, v)
+	return u
+}
+
+// UpdatePassphrase sets the "passphrase" field to the value that was provided on create.
+func (u *ExchangeAccountUpsert) UpdatePassphrase() *ExchangeAccountUpsert {
+	u.SetExcluded(exchangeaccount.FieldPassphrase)
+	return u
+}
+
+// SetTestnet sets the "testnet" field.
+func (u *ExchangeAccountUpsert) SetTestnet(v bool) *ExchangeAccountUpsert {
+	u.Set(exchangeaccount.FieldTestnet, v)
+	return u
+}
+
+// UpdateTestnet sets the "testnet" field to the value that was provided on create.
+func (u *ExchangeAccountUpsert) UpdateTestnet() *ExchangeAccountUpsert {
+	u.SetExcluded(exchangeaccount.FieldTestnet)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ExchangeAccount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ExchangeAccountUpsertOne) UpdateNewValues() *ExchangeAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(exchangeaccount.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExchangeAccount.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ExchangeAccountUpsertOne) Ignore() *ExchangeAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExchangeAccountUpsertOne) DoNothing() *ExchangeAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExchangeAccountCreate.OnConflict
+// documentation for more info.
+func (u *ExchangeAccountUpsertOne) Update(set func(*ExchangeAccountUpsert)) *ExchangeAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExchangeAccountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ExchangeAccountUpsertOne) SetUpdateTime(v time.Time) *ExchangeAccountUpsertOne {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ExchangeAccountUpsertOne) UpdateUpdateTime() *ExchangeAccountUpsertOne {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetOwner sets the "owner" field.
+func (u *ExchangeAccountUpsertOne) SetOwner(v int64) *ExchangeAccountUpsertOne {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.SetOwner(v)
+	})
+}
+
+// AddOwner adds v to the "owner" field.
+func (u *ExchangeAccountUpsertOne) AddOwner(v int64) *ExchangeAccountUpsertOne {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.AddOwner(v)
+	})
+}
+
+// UpdateOwner sets the "owner" field to the value that was provided on create.
+func (u *ExchangeAccountUpsertOne) UpdateOwner() *ExchangeAccountUpsertOne {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.UpdateOwner()
+	})
+}
+
+// SetExchange sets the "exchange" field.
+func (u *ExchangeAccountUpsertOne) SetExchange(v string) *ExchangeAccountUpsertOne {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.SetExchange(v)
+	})
+}
+
+// UpdateExchange sets the "exchange" field to the value that was provided on create.
+func (u *ExchangeAccountUpsertOne) UpdateExchange() *ExchangeAccountUpsertOne {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.UpdateExchange()
+	})
+}
+
+// SetLabel sets the "label" field.
+func (u *ExchangeAccountUpsertOne) SetLabel(v string) *ExchangeAccountUpsertOne {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.SetLabel(v)
+	})
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *ExchangeAccountUpsertOne) UpdateLabel() *ExchangeAccountUpsertOne {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.UpdateLabel()
+	})
+}
+
+// SetAccount sets the "account" field.
+func (u *ExchangeAccountUpsertOne) SetAccount(v string) *ExchangeAccountUpsertOne {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.SetAccount(v)
+	})
+}
+
+// UpdateAccount sets the "account" field to the value that was provided on create.
+func (u *ExchangeAccountUpsertOne) UpdateAccount() *ExchangeAccountUpsertOne {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.UpdateAccount()
+	})
+}
+
+// SetSecretKey sets the "secretKey" field.
+func (u *ExchangeAccountUpsertOne) SetSecretKey(v string) *ExchangeAccountUpsertOne {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.SetSecretKey(v)
+	})
+}
+
+// UpdateSecretKey sets the "secretKey" field to the value that was provided on create.
+func (u *ExchangeAccountUpsertOne) UpdateSecretKey() *ExchangeAccountUpsertOne {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.UpdateSecretKey()
+	})
+}
+
+// SetPassphrase sets the "passphrase" field.
+func (u *ExchangeAccountUpsertOne) SetPassphrase(v string) *ExchangeAccountUpsertOne {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.SetPassphrase(v)
+	})
+}
+
+// UpdatePassphrase sets the "passphrase" field to the value that was provided on create.
+func (u *ExchangeAccountUpsertOne) UpdatePassphrase() *ExchangeAccountUpsertOne {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.UpdatePassphrase()
+	})
+}
+
+// SetTestnet sets the "testnet" field.
+func (u *ExchangeAccountUpsertOne) SetTestnet(v bool) *ExchangeAccountUpsertOne {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.SetTestnet(v)
+	})
+}
+
+// UpdateTestnet sets the "testnet" field to the value that was provided on create.
+func (u *ExchangeAccountUpsertOne) UpdateTestnet() *ExchangeAccountUpsertOne {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.UpdateTestnet()
+	})
+}
+
+// Exec executes the query.
+func (u *ExchangeAccountUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExchangeAccountCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExchangeAccountUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ExchangeAccountUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ExchangeAccountUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ExchangeAccountCreateBulk is the builder for creating many ExchangeAccount entities in bulk.
+type ExchangeAccountCreateBulk struct {
+	config
+	err      error
+	builders []*ExchangeAccountCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ExchangeAccount entities in the database.
+func (_c *ExchangeAccountCreateBulk) Save(ctx context.Context) ([]*ExchangeAccount, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ExchangeAccount, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExchangeAccountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i], err = builder.createSpec()
+				if err != nil {
+					return nil, err
+				}
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ExchangeAccountCreateBulk) SaveX(ctx context.Context) []*ExchangeAccount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExchangeAccountCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExchangeAccountCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExchangeAccount.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExchangeAccountUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ExchangeAccountCreateBulk) OnConflict(opts ...sql.ConflictOption) *ExchangeAccountUpsertBulk {
+	_c.conflict = opts
+	return &ExchangeAccountUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExchangeAccount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ExchangeAccountCreateBulk) OnConflictColumns(columns ...string) *ExchangeAccountUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ExchangeAccountUpsertBulk{
+		create: _c,
+	}
+}
+
+// ExchangeAccountUpsertBulk is the builder for "upsert"-ing
+// a bulk of ExchangeAccount nodes.
+type ExchangeAccountUpsertBulk struct {
+	create *ExchangeAccountCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ExchangeAccount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ExchangeAccountUpsertBulk) UpdateNewValues() *ExchangeAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(exchangeaccount.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExchangeAccount.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ExchangeAccountUpsertBulk) Ignore() *ExchangeAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExchangeAccountUpsertBulk) DoNothing() *ExchangeAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExchangeAccountCreateBulk.OnConflict
+// documentation for more info.
+func (u *ExchangeAccountUpsertBulk) Update(set func(*ExchangeAccountUpsert)) *ExchangeAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExchangeAccountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ExchangeAccountUpsertBulk) SetUpdateTime(v time.Time) *ExchangeAccountUpsertBulk {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ExchangeAccountUpsertBulk) UpdateUpdateTime() *ExchangeAccountUpsertBulk {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetOwner sets the "owner" field.
+func (u *ExchangeAccountUpsertBulk) SetOwner(v int64) *ExchangeAccountUpsertBulk {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.SetOwner(v)
+	})
+}
+
+// AddOwner adds v to the "owner" field.
+func (u *ExchangeAccountUpsertBulk) AddOwner(v int64) *ExchangeAccountUpsertBulk {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.AddOwner(v)
+	})
+}
+
+// UpdateOwner sets the "owner" field to the value that was provided on create.
+func (u *ExchangeAccountUpsertBulk) UpdateOwner() *ExchangeAccountUpsertBulk {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.UpdateOwner()
+	})
+}
+
+// SetExchange sets the "exchange" field.
+func (u *ExchangeAccountUpsertBulk) SetExchange(v string) *ExchangeAccountUpsertBulk {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.SetExchange(v)
+	})
+}
+
+// UpdateExchange sets the "exchange" field to the value that was provided on create.
+func (u *ExchangeAccountUpsertBulk) UpdateExchange() *ExchangeAccountUpsertBulk {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.UpdateExchange()
+	})
+}
+
+// SetLabel sets the "label" field.
+func (u *ExchangeAccountUpsertBulk) SetLabel(v string) *ExchangeAccountUpsertBulk {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.SetLabel(v)
+	})
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *ExchangeAccountUpsertBulk) UpdateLabel() *ExchangeAccountUpsertBulk {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.UpdateLabel()
+	})
+}
+
+// SetAccount sets the "account" field.
+func (u *ExchangeAccountUpsertBulk) SetAccount(v string) *ExchangeAccountUpsertBulk {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.SetAccount(v)
+	})
+}
+
+// UpdateAccount sets the "account" field to the value that was provided on create.
+func (u *ExchangeAccountUpsertBulk) UpdateAccount() *ExchangeAccountUpsertBulk {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.UpdateAccount()
+	})
+}
+
+// SetSecretKey sets the "secretKey" field.
+func (u *ExchangeAccountUpsertBulk) SetSecretKey(v string) *ExchangeAccountUpsertBulk {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.SetSecretKey(v)
+	})
+}
+
+// UpdateSecretKey sets the "secretKey" field to the value that was provided on create.
+func (u *ExchangeAccountUpsertBulk) UpdateSecretKey() *ExchangeAccountUpsertBulk {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.UpdateSecretKey()
+	})
+}
+
+// SetPassphrase sets the "passphrase" field.
+func (u *ExchangeAccountUpsertBulk) SetPassphrase(v string) *ExchangeAccountUpsertBulk {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.SetPassphrase(v)
+	})
+}
+
+// UpdatePassphrase sets the "passphrase" field to the value that was provided on create.
+func (u *ExchangeAccountUpsertBulk) UpdatePassphrase() *ExchangeAccountUpsertBulk {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.UpdatePassphrase()
+	})
+}
+
+// SetTestnet sets the "testnet" field.
+func (u *ExchangeAccountUpsertBulk) SetTestnet(v bool) *ExchangeAccountUpsertBulk {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.SetTestnet(v)
+	})
+}
+
+// UpdateTestnet sets the "testnet" field to the value that was provided on create.
+func (u *ExchangeAccountUpsertBulk) UpdateTestnet() *ExchangeAccountUpsertBulk {
+	return u.Update(func(s *ExchangeAccountUpsert) {
+		s.UpdateTestnet()
+	})
+}
+
+// Exec executes the query.
+func (u *ExchangeAccountUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ExchangeAccountCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExchangeAccountCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExchangeAccountUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,685 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/fachebot/omni-grid-bot/internal/ent/exchangeaccount"
+	"github.com/fachebot/omni-grid-bot/internal/ent/predicate"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+)
+
+// ExchangeAccountUpdate is the builder for updating ExchangeAccount entities.
+type ExchangeAccountUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExchangeAccountMutation
+}
+
+// Where appends a list predicates to the ExchangeAccountUpdate builder.
+func (_u *ExchangeAccountUpdate) Where(ps ...predicate.ExchangeAccount) *ExchangeAccountUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ExchangeAccountUpdate) SetUpdateTime(v time.Time) *ExchangeAccountUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetOwner sets the "owner" field.
+func (_u *ExchangeAccountUpdate) SetOwner(v int64) *ExchangeAccountUpdate {
+	_u.mutation.ResetOwner()
+	_u.mutation.SetOwner(v)
+	return _u
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_u *ExchangeAccountUpdate) SetNillableOwner(v *int64) *ExchangeAccountUpdate {
+	if v != nil {
+		_u.SetOwner(*v)
+	}
+	return _u
+}
+
+// AddOwner adds value to the "owner" field.
+func (_u *ExchangeAccountUpdate) AddOwner(v int64) *ExchangeAccountUpdate {
+	_u.mutation.AddOwner(v)
+	return _u
+}
+
+// SetExchange sets the "exchange" field.
+func (_u *ExchangeAccountUpdate) SetExchange(v string) *ExchangeAccountUpdate {
+	_u.mutation.SetExchange(v)
+	return _u
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_u *ExchangeAccountUpdate) SetNillableExchange(v *string) *ExchangeAccountUpdate {
+	if v != nil {
+		_u.SetExchange(*v)
+	}
+	return _u
+}
+
+// SetLabel sets the "label" field.
+func (_u *ExchangeAccountUpdate) SetLabel(v string) *ExchangeAccountUpdate {
+	_u.mutation.SetLabel(v)
+	return _u
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_u *ExchangeAccountUpdate) SetNillableLabel(v *string) *ExchangeAccountUpdate {
+	if v != nil {
+		_u.SetLabel(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" field.
+func (_u *ExchangeAccountUpdate) SetAccount(v string) *ExchangeAccountUpdate {
+	_u.mutation.SetAccount(v)
+	return _u
+}
+
+// SetNillableAccount sets the "account" field if the given value is not nil.
+func (_u *ExchangeAccountUpdate) SetNillableAccount(v *string) *ExchangeAccountUpdate {
+	if v != nil {
+		_u.SetAccount(*v)
+	}
+	return _u
+}
+
+// SetSecretKey sets the "secretKey" field.
+func (_u *ExchangeAccountUpdate) SetSecretKey(v string) *ExchangeAccountUpdate {
+	_u.mutation.SetSecretKey(v)
+	return _u
+}
+
+// SetNillableSecretKey sets the "secretKey" field if the given value is not nil.
+func (_u *ExchangeAccountUpdate) SetNillableSecretKey(v *string) *ExchangeAccountUpdate {
+	if v != nil {
+		_u.SetSecretKey(*v)
+	}
+	return _u
+}
+
+// SetPassphrase sets the "passphrase" field.
+func (_u *ExchangeAccountUpdate) SetPassphrase(v string) *ExchangeAccountUpdate {
+	_u.mutation.SetPassphrase(v)
+	return _u
+}
+
+// SetNillablePassphrase sets the "passphrase" field if the given value is not nil.
+func (_u *ExchangeAccountUpdate) SetNillablePassphrase(v *string) *ExchangeAccountUpdate {
+	if v != nil {
+		_u.SetPassphrase(*v)
+	}
+	return _u
+}
+
+// SetTestnet sets the "testnet" field.
+func (_u *ExchangeAccountUpdate) SetTestnet(v bool) *ExchangeAccountUpdate {
+	_u.mutation.SetTestnet(v)
+	return _u
+}
+
+// SetNillableTestnet sets the "testnet" field if the given value is not nil.
+func (_u *ExchangeAccountUpdate) SetNillableTestnet(v *bool) *ExchangeAccountUpdate {
+	if v != nil {
+		_u.SetTestnet(*v)
+	}
+	return _u
+}
+
+// AddStrategyIDs adds the "strategies" edge to the Strategy entity by IDs.
+func (_u *ExchangeAccountUpdate) AddStrategyIDs(ids ...int) *ExchangeAccountUpdate {
+	_u.mutation.AddStrategyIDs(ids...)
+	return _u
+}
+
+// AddStrategies adds the "strategies" edges to the Strategy entity.
+func (_u *ExchangeAccountUpdate) AddStrategies(v ...*Strategy) *ExchangeAccountUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStrategyIDs(ids...)
+}
+
+// Mutation returns the ExchangeAccountMutation object of the builder.
+func (_u *ExchangeAccountUpdate) Mutation() *ExchangeAccountMutation {
+	return _u.mutation
+}
+
+// ClearStrategies clears all "strategies" edges to the Strategy entity.
+func (_u *ExchangeAccountUpdate) ClearStrategies() *ExchangeAccountUpdate {
+	_u.mutation.ClearStrategies()
+	return _u
+}
+
+// RemoveStrategyIDs removes the "strategies" edge to Strategy entities by IDs.
+func (_u *ExchangeAccountUpdate) RemoveStrategyIDs(ids ...int) *ExchangeAccountUpdate {
+	_u.mutation.RemoveStrategyIDs(ids...)
+	return _u
+}
+
+// RemoveStrategies removes "strategies" edges to Strategy entities.
+func (_u *ExchangeAccountUpdate) RemoveStrategies(v ...*Strategy) *ExchangeAccountUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStrategyIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ExchangeAccountUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExchangeAccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ExchangeAccountUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExchangeAccountUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ExchangeAccountUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := exchangeaccount.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExchangeAccountUpdate) check() error {
+	if v, ok := _u.mutation.Exchange(); ok {
+		if err := exchangeaccount.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "ExchangeAccount.exchange": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Label(); ok {
+		if err := exchangeaccount.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`ent: validator failed for field "ExchangeAccount.label": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ExchangeAccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(exchangeaccount.Table, exchangeaccount.Columns, sqlgraph.NewFieldSpec(exchangeaccount.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(exchangeaccount.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Owner(); ok {
+		_spec.SetField(exchangeaccount.FieldOwner, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOwner(); ok {
+		_spec.AddField(exchangeaccount.FieldOwner, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Exchange(); ok {
+		_spec.SetField(exchangeaccount.FieldExchange, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Label(); ok {
+		_spec.SetField(exchangeaccount.FieldLabel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Account(); ok {
+		_spec.SetField(exchangeaccount.FieldAccount, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SecretKey(); ok {
+		vv, err := exchangeaccount.ValueScanner.SecretKey.Value(value)
+		if err != nil {
+			return 0, err
+		}
+		_spec.SetField(exchangeaccount.FieldSecretKey, field.TypeString, vv)
+	}
+	if value, ok := _u.mutation.Passphrase(); ok {
+		vv, err := exchangeaccount.ValueScanner.Passphrase.Value(value)
+		if err != nil {
+			return 0, err
+		}
+		_spec.SetField(exchangeaccount.FieldPassphrase, field.TypeString, vv)
+	}
+	if value, ok := _u.mutation.Testnet(); ok {
+		_spec.SetField(exchangeaccount.FieldTestnet, field.TypeBool, value)
+	}
+	if _u.mutation.StrategiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   exchangeaccount.StrategiesTable,
+			Columns: []string{exchangeaccount.StrategiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(strategy.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStrategiesIDs(); len(nodes) > 0 && !_u.mutation.StrategiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   exchangeaccount.StrategiesTable,
+			Columns: []string{exchangeaccount.StrategiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(strategy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StrategiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   exchangeaccount.StrategiesTable,
+			Columns: []string{exchangeaccount.StrategiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(strategy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exchangeaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ExchangeAccountUpdateOne is the builder for updating a single ExchangeAccount entity.
+type ExchangeAccountUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExchangeAccountMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ExchangeAccountUpdateOne) SetUpdateTime(v time.Time) *ExchangeAccountUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetOwner sets the "owner" field.
+func (_u *ExchangeAccountUpdateOne) SetOwner(v int64) *ExchangeAccountUpdateOne {
+	_u.mutation.ResetOwner()
+	_u.mutation.SetOwner(v)
+	return _u
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_u *ExchangeAccountUpdateOne) SetNillableOwner(v *int64) *ExchangeAccountUpdateOne {
+	if v != nil {
+		_u.SetOwner(*v)
+	}
+	return _u
+}
+
+// AddOwner adds value to the "owner" field.
+func (_u *ExchangeAccountUpdateOne) AddOwner(v int64) *ExchangeAccountUpdateOne {
+	_u.mutation.AddOwner(v)
+	return _u
+}
+
+// SetExchange sets the "exchange" field.
+func (_u *ExchangeAccountUpdateOne) SetExchange(v string) *ExchangeAccountUpdateOne {
+	_u.mutation.SetExchange(v)
+	return _u
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_u *ExchangeAccountUpdateOne) SetNillableExchange(v *string) *ExchangeAccountUpdateOne {
+	if v != nil {
+		_u.SetExchange(*v)
+	}
+	return _u
+}
+
+// SetLabel sets the "label" field.
+func (_u *ExchangeAccountUpdateOne) SetLabel(v string) *ExchangeAccountUpdateOne {
+	_u.mutation.SetLabel(v)
+	return _u
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_u *ExchangeAccountUpdateOne) SetNillableLabel(v *string) *ExchangeAccountUpdateOne {
+	if v != nil {
+		_u.SetLabel(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" field.
+func (_u *ExchangeAccountUpdateOne) SetAccount(v string) *ExchangeAccountUpdateOne {
+	_u.mutation.SetAccount(v)
+	return _u
+}
+
+// SetNillableAccount sets the "account" field if the given value is not nil.
+func (_u *ExchangeAccountUpdateOne) SetNillableAccount(v *string) *ExchangeAccountUpdateOne {
+	if v != nil {
+		_u.SetAccount(*v)
+	}
+	return _u
+}
+
+// SetSecretKey sets the "secretKey" field.
+func (_u *ExchangeAccountUpdateOne) SetSecretKey(v string) *ExchangeAccountUpdateOne {
+	_u.mutation.SetSecretKey(v)
+	return _u
+}
+
+// SetNillableSecretKey sets the "secretKey" field if the given value is not nil.
+func (_u *ExchangeAccountUpdateOne) SetNillableSecretKey(v *string) *ExchangeAccountUpdateOne {
+	if v != nil {
+		_u.SetSecretKey(*v)
+	}
+	return _u
+}
+
+// SetPassphrase sets the "passphrase" field.
+func (_u *ExchangeAccountUpdateOne) SetPassphrase(v string) *ExchangeAccountUpdateOne {
+	_u.mutation.SetPassphrase(v)
+	return _u
+}
+
+// SetNillablePassphrase sets the "passphrase" field if the given value is not nil.
+func (_u *ExchangeAccountUpdateOne) SetNillablePassphrase(v *string) *ExchangeAccountUpdateOne {
+	if v != nil {
+		_u.SetPassphrase(*v)
+	}
+	return _u
+}
+
+// SetTestnet sets the "testnet" field.
+func (_u *ExchangeAccountUpdateOne) SetTestnet(v bool) *ExchangeAccountUpdateOne {
+	_u.mutation.SetTestnet(v)
+	return _u
+}
+
+// SetNillableTestnet sets the "testnet" field if the given value is not nil.
+func (_u *ExchangeAccountUpdateOne) SetNillableTestnet(v *bool) *ExchangeAccountUpdateOne {
+	if v != nil {
+		_u.SetTestnet(*v)
+	}
+	return _u
+}
+
+// AddStrategyIDs adds the "strategies" edge to the Strategy entity by IDs.
+func (_u *ExchangeAccountUpdateOne) AddStrategyIDs(ids ...int) *ExchangeAccountUpdateOne {
+	_u.mutation.AddStrategyIDs(ids...)
+	return _u
+}
+
+// AddStrategies adds the "strategies" edges to the Strategy entity.
+func (_u *ExchangeAccountUpdateOne) AddStrategies(v ...*Strategy) *ExchangeAccountUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStrategyIDs(ids...)
+}
+
+// Mutation returns the ExchangeAccountMutation object of the builder.
+func (_u *ExchangeAccountUpdateOne) Mutation() *ExchangeAccountMutation {
+	return _u.mutation
+}
+
+// ClearStrategies clears all "strategies" edges to the Strategy entity.
+func (_u *ExchangeAccountUpdateOne) ClearStrategies() *ExchangeAccountUpdateOne {
+	_u.mutation.ClearStrategies()
+	return _u
+}
+
+// RemoveStrategyIDs removes the "strategies" edge to Strategy entities by IDs.
+func (_u *ExchangeAccountUpdateOne) RemoveStrategyIDs(ids ...int) *ExchangeAccountUpdateOne {
+	_u.mutation.RemoveStrategyIDs(ids...)
+	return _u
+}
+
+// RemoveStrategies removes "strategies" edges to Strategy entities.
+func (_u *ExchangeAccountUpdateOne) RemoveStrategies(v ...*Strategy) *ExchangeAccountUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStrategyIDs(ids...)
+}
+
+// Where appends a list predicates to the ExchangeAccountUpdate builder.
+func (_u *ExchangeAccountUpdateOne) Where(ps ...predicate.ExchangeAccount) *ExchangeAccountUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ExchangeAccountUpdateOne) Select(field string, fields ...string) *ExchangeAccountUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ExchangeAccount entity.
+func (_u *ExchangeAccountUpdateOne) Save(ctx context.Context) (*ExchangeAccount, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExchangeAccountUpdateOne) SaveX(ctx context.Context) *ExchangeAccount {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ExchangeAccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExchangeAccountUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ExchangeAccountUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := exchangeaccount.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExchangeAccountUpdateOne) check() error {
+	if v, ok := _u.mutation.Exchange(); ok {
+		if err := exchangeaccount.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "ExchangeAccount.exchange": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Label(); ok {
+		if err := exchangeaccount.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`ent: validator failed for field "ExchangeAccount.label": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ExchangeAccountUpdateOne) sqlSave(ctx context.Context) (_node *ExchangeAccount, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(exchangeaccount.Table, exchangeaccount.Columns, sqlgraph.NewFieldSpec(exchangeaccount.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExchangeAccount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, exchangeaccount.FieldID)
+		for _, f := range fields {
+			if !exchangeaccount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != exchangeaccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(exchangeaccount.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Owner(); ok {
+		_spec.SetField(exchangeaccount.FieldOwner, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOwner(); ok {
+		_spec.AddField(exchangeaccount.FieldOwner, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Exchange(); ok {
+		_spec.SetField(exchangeaccount.FieldExchange, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Label(); ok {
+		_spec.SetField(exchangeaccount.FieldLabel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Account(); ok {
+		_spec.SetField(exchangeaccount.FieldAccount, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SecretKey(); ok {
+		vv, err := exchangeaccount.ValueScanner.SecretKey.Value(value)
+		if err != nil {
+			return nil, err
+		}
+		_spec.SetField(exchangeaccount.FieldSecretKey, field.TypeString, vv)
+	}
+	if value, ok := _u.mutation.Passphrase(); ok {
+		vv, err := exchangeaccount.ValueScanner.Passphrase.Value(value)
+		if err != nil {
+			return nil, err
+		}
+		_spec.SetField(exchangeaccount.FieldPassphrase, field.TypeString, vv)
+	}
+	if value, ok := _u.mutation.Testnet(); ok {
+		_spec.SetField(exchangeaccount.FieldTestnet, field.TypeBool, value)
+	}
+	if _u.mutation.StrategiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   exchangeaccount.StrategiesTable,
+			Columns: []string{exchangeaccount.StrategiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(strategy.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStrategiesIDs(); len(nodes) > 0 && !_u.mutation.StrategiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   exchangeaccount.StrategiesTable,
+			Columns: []string{exchangeaccount.StrategiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(strategy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StrategiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   exchangeaccount.StrategiesTable,
+			Columns: []string{exchangeaccount.StrategiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(strategy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ExchangeAccount{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exchangeaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/fachebot/omni-grid-bot/internal/ent/fill"
+	"github.com/shopspring/decimal"
+)
+
+// Fill is the model entity for the Fill schema.
+type Fill struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Exchange holds the value of the "exchange" field.
+	Exchange string `json:"exchange,omitempty"`
+	// Account holds the value of the "account" field.
+	Account string `json:"account,omitempty"`
+	// Symbol holds the value of the "symbol" field.
+	Symbol string `json:"symbol,omitempty"`
+	// FillId holds the value of the "fillId" field.
+	FillId string `json:"fillId,omitempty"`
+	// OrderId holds the value of the "orderId" field.
+	OrderId string `json:"orderId,omitempty"`
+	// ClientOrderId holds the value of the "clientOrderId" field.
+	ClientOrderId *string `json:"clientOrderId,omitempty"`
+	// Side holds the value of the "side" field.
+	Side fill.Side `json:"side,omitempty"`
+	// Price holds the value of the "price" field.
+	Price decimal.Decimal `json:"price,omitempty"`
+	// BaseAmount holds the value of the "baseAmount" field.
+	BaseAmount decimal.Decimal `json:"baseAmount,omitempty"`
+	// QuoteAmount holds the value of the "quoteAmount" field.
+	QuoteAmount decimal.Decimal `json:"quoteAmount,omitempty"`
+	// Fee holds the value of the "fee" field.
+	Fee decimal.Decimal `json:"fee,omitempty"`
+	// FeeAsset holds the value of the "feeAsset" field.
+	FeeAsset string `json:"feeAsset,omitempty"`
+	// IsMaker holds the value of the "isMaker" field.
+	IsMaker bool `json:"isMaker,omitempty"`
+	// Timestamp holds the value of the "timestamp" field.
+	Timestamp    int64 `json:"timestamp,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Fill) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case fill.FieldPrice, fill.FieldBaseAmount, fill.FieldQuoteAmount, fill.FieldFee:
+			values[i] = new(decimal.Decimal)
+		case fill.FieldIsMaker:
+			values[i] = new(sql.NullBool)
+		case fill.FieldID, fill.FieldTimestamp:
+			values[i] = new(sql.NullInt64)
+		case fill.FieldExchange, fill.FieldAccount, fill.FieldSymbol, fill.FieldFillId, fill.FieldOrderId, fill.FieldClientOrderId, fill.FieldSide, fill.FieldFeeAsset:
+			values[i] = new(sql.NullString)
+		case fill.FieldCreateTime, fill.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Fill fields.
+func (_m *Fill) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case fill.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case fill.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case fill.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case fill.FieldExchange:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange", values[i])
+			} else if value.Valid {
+				_m.Exchange = value.String
+			}
+		case fill.FieldAccount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account", values[i])
+			} else if value.Valid {
+				_m.Account = value.String
+			}
+		case fill.FieldSymbol:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field symbol", values[i])
+			} else if value.Valid {
+				_m.Symbol = value.String
+			}
+		case fill.FieldFillId:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fillId", values[i])
+			} else if value.Valid {
+				_m.FillId = value.String
+			}
+		case fill.FieldOrderId:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field orderId", values[i])
+			} else if value.Valid {
+				_m.OrderId = value.String
+			}
+		case fill.FieldClientOrderId:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field clientOrderId", values[i])
+			} else if value.Valid {
+				_m.ClientOrderId = new(string)
+				*_m.ClientOrderId = value.String
+			}
+		case fill.FieldSide:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field side", values[i])
+			} else if value.Valid {
+				_m.Side = fill.Side(value.String)
+			}
+		case fill.FieldPrice:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value != nil {
+				_m.Price = *value
+			}
+		case fill.FieldBaseAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field baseAmount", values[i])
+			} else if value != nil {
+				_m.BaseAmount = *value
+			}
+		case fill.FieldQuoteAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field quoteAmount", values[i])
+			} else if value != nil {
+				_m.QuoteAmount = *value
+			}
+		case fill.FieldFee:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field fee", values[i])
+			} else if value != nil {
+				_m.Fee = *value
+			}
+		case fill.FieldFeeAsset:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field feeAsset", values[i])
+			} else if value.Valid {
+				_m.FeeAsset = value.String
+			}
+		case fill.FieldIsMaker:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field isMaker", values[i])
+			} else if value.Valid {
+				_m.IsMaker = value.Bool
+			}
+		case fill.FieldTimestamp:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field timestamp", values[i])
+			} else if value.Valid {
+				_m.Timestamp = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Fill.
+// This includes values selected through modifiers, order, etc.
+func (_m *Fill) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Fill.
+// Note that you need to call Fill.Unwrap() before calling this method if this Fill
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Fill) Update() *FillUpdateOne {
+	return NewFillClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Fill entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Fill) Unwrap() *Fill {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Fill is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Fill) String() string {
+	var builder strings.Builder
+	builder.WriteString("Fill(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("exchange=")
+	builder.WriteString(_m.Exchange)
+	builder.WriteString(", ")
+	builder.WriteString("account=")
+	builder.WriteString(_m.Account)
+	builder.WriteString(", ")
+	builder.WriteString("symbol=")
+	builder.WriteString(_m.Symbol)
+	builder.WriteString(", ")
+	builder.WriteString("fillId=")
+	builder.WriteString(_m.FillId)
+	builder.WriteString(", ")
+	builder.WriteString("orderId=")
+	builder.WriteString(_m.OrderId)
+	builder.WriteString(", ")
+	if v := _m.ClientOrderId; v != nil {
+		builder.WriteString("clientOrderId=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("side=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Side))
+	builder.WriteString(", ")
+	builder.WriteString("price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Price))
+	builder.WriteString(", ")
+	builder.WriteString("baseAmount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BaseAmount))
+	builder.WriteString(", ")
+	builder.WriteString("quoteAmount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.QuoteAmount))
+	builder.WriteString(", ")
+	builder.WriteString("fee=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Fee))
+	builder.WriteString(", ")
+	builder.WriteString("feeAsset=")
+	builder.WriteString(_m.FeeAsset)
+	builder.WriteString(", ")
+	builder.WriteString("isMaker=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsMaker))
+	builder.WriteString(", ")
+	builder.WriteString("timestamp=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Timestamp))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Fills is a parsable slice of Fill.
+type Fills []*Fill
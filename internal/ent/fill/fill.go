@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package fill
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the fill type in the database.
+	Label = "fill"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldExchange holds the string denoting the exchange field in the database.
+	FieldExchange = "exchange"
+	// FieldAccount holds the string denoting the account field in the database.
+	FieldAccount = "account"
+	// FieldSymbol holds the string denoting the symbol field in the database.
+	FieldSymbol = "symbol"
+	// FieldFillId holds the string denoting the fillid field in the database.
+	FieldFillId = "fill_id"
+	// FieldOrderId holds the string denoting the orderid field in the database.
+	FieldOrderId = "order_id"
+	// FieldClientOrderId holds the string denoting the clientorderid field in the database.
+	FieldClientOrderId = "client_order_id"
+	// FieldSide holds the string denoting the side field in the database.
+	FieldSide = "side"
+	// FieldPrice holds the string denoting the price field in the database.
+	FieldPrice = "price"
+	// FieldBaseAmount holds the string denoting the baseamount field in the database.
+	FieldBaseAmount = "base_amount"
+	// FieldQuoteAmount holds the string denoting the quoteamount field in the database.
+	FieldQuoteAmount = "quote_amount"
+	// FieldFee holds the string denoting the fee field in the database.
+	FieldFee = "fee"
+	// FieldFeeAsset holds the string denoting the feeasset field in the database.
+	FieldFeeAsset = "fee_asset"
+	// FieldIsMaker holds the string denoting the ismaker field in the database.
+	FieldIsMaker = "is_maker"
+	// FieldTimestamp holds the string denoting the timestamp field in the database.
+	FieldTimestamp = "timestamp"
+	// Table holds the table name of the fill in the database.
+	Table = "fills"
+)
+
+// Columns holds all SQL columns for fill fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldExchange,
+	FieldAccount,
+	FieldSymbol,
+	FieldFillId,
+	FieldOrderId,
+	FieldClientOrderId,
+	FieldSide,
+	FieldPrice,
+	FieldBaseAmount,
+	FieldQuoteAmount,
+	FieldFee,
+	FieldFeeAsset,
+	FieldIsMaker,
+	FieldTimestamp,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// ExchangeValidator is a validator for the "exchange" field. It is called by the builders before save.
+	ExchangeValidator func(string) error
+	// SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
+	SymbolValidator func(string) error
+	// FeeAssetValidator is a validator for the "feeAsset" field. It is called by the builders before save.
+	FeeAssetValidator func(string) error
+	// DefaultIsMaker holds the default value on creation for the "isMaker" field.
+	DefaultIsMaker bool
+)
+
+// Side defines the type for the "side" enum field.
+type Side string
+
+// Side values.
+const (
+	SideBuy  Side = "buy"
+	SideSell Side = "sell"
+)
+
+func (s Side) String() string {
+	return string(s)
+}
+
+// SideValidator is a validator for the "side" field enum values. It is called by the builders before save.
+func SideValidator(s Side) error {
+	switch s {
+	case SideBuy, SideSell:
+		return nil
+	default:
+		return fmt.Errorf("fill: invalid enum value for side field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Fill queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByExchange orders the results by the exchange field.
+func ByExchange(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExchange, opts...).ToFunc()
+}
+
+// ByAccount orders the results by the account field.
+func ByAccount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccount, opts...).ToFunc()
+}
+
+// BySymbol orders the results by the symbol field.
+func BySymbol(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSymbol, opts...).ToFunc()
+}
+
+// ByFillId orders the results by the fillId field.
+func ByFillId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFillId, opts...).ToFunc()
+}
+
+// ByOrderId orders the results by the orderId field.
+func ByOrderId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderId, opts...).ToFunc()
+}
+
+// ByClientOrderId orders the results by the clientOrderId field.
+func ByClientOrderId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientOrderId, opts...).ToFunc()
+}
+
+// BySide orders the results by the side field.
+func BySide(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSide, opts...).ToFunc()
+}
+
+// ByPrice orders the results by the price field.
+func ByPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrice, opts...).ToFunc()
+}
+
+// ByBaseAmount orders the results by the baseAmount field.
+func ByBaseAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBaseAmount, opts...).ToFunc()
+}
+
+// ByQuoteAmount orders the results by the quoteAmount field.
+func ByQuoteAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuoteAmount, opts...).ToFunc()
+}
+
+// ByFee orders the results by the fee field.
+func ByFee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFee, opts...).ToFunc()
+}
+
+// ByFeeAsset orders the results by the feeAsset field.
+func ByFeeAsset(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFeeAsset, opts...).ToFunc()
+}
+
+// ByIsMaker orders the results by the isMaker field.
+func ByIsMaker(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsMaker, opts...).ToFunc()
+}
+
+// ByTimestamp orders the results by the timestamp field.
+func ByTimestamp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimestamp, opts...).ToFunc()
+}
@@ -0,0 +1,1041 @@
+// Code generated by ent, DO NOT EDIT.
+
+package fill
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/fachebot/omni-grid-bot/internal/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Fill {
+	return predicate.Fill(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Fill {
+	return predicate.Fill(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Fill {
+	return predicate.Fill(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Fill {
+	return predicate.Fill(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Fill {
+	return predicate.Fill(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Fill {
+	return predicate.Fill(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Fill {
+	return predicate.Fill(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// Exchange applies equality check predicate on the "exchange" field. It's identical to ExchangeEQ.
+func Exchange(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldExchange, v))
+}
+
+// Account applies equality check predicate on the "account" field. It's identical to AccountEQ.
+func Account(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldAccount, v))
+}
+
+// Symbol applies equality check predicate on the "symbol" field. It's identical to SymbolEQ.
+func Symbol(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldSymbol, v))
+}
+
+// FillId applies equality check predicate on the "fillId" field. It's identical to FillIdEQ.
+func FillId(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldFillId, v))
+}
+
+// OrderId applies equality check predicate on the "orderId" field. It's identical to OrderIdEQ.
+func OrderId(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldOrderId, v))
+}
+
+// ClientOrderId applies equality check predicate on the "clientOrderId" field. It's identical to ClientOrderIdEQ.
+func ClientOrderId(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldClientOrderId, v))
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldPrice, v))
+}
+
+// BaseAmount applies equality check predicate on the "baseAmount" field. It's identical to BaseAmountEQ.
+func BaseAmount(v decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldBaseAmount, v))
+}
+
+// QuoteAmount applies equality check predicate on the "quoteAmount" field. It's identical to QuoteAmountEQ.
+func QuoteAmount(v decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldQuoteAmount, v))
+}
+
+// Fee applies equality check predicate on the "fee" field. It's identical to FeeEQ.
+func Fee(v decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldFee, v))
+}
+
+// FeeAsset applies equality check predicate on the "feeAsset" field. It's identical to FeeAssetEQ.
+func FeeAsset(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldFeeAsset, v))
+}
+
+// IsMaker applies equality check predicate on the "isMaker" field. It's identical to IsMakerEQ.
+func IsMaker(v bool) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldIsMaker, v))
+}
+
+// Timestamp applies equality check predicate on the "timestamp" field. It's identical to TimestampEQ.
+func Timestamp(v int64) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.Fill {
+	return predicate.Fill(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.Fill {
+	return predicate.Fill(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.Fill {
+	return predicate.Fill(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.Fill {
+	return predicate.Fill(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.Fill {
+	return predicate.Fill(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.Fill {
+	return predicate.Fill(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.Fill {
+	return predicate.Fill(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.Fill {
+	return predicate.Fill(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.Fill {
+	return predicate.Fill(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.Fill {
+	return predicate.Fill(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.Fill {
+	return predicate.Fill(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.Fill {
+	return predicate.Fill(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.Fill {
+	return predicate.Fill(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.Fill {
+	return predicate.Fill(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// ExchangeEQ applies the EQ predicate on the "exchange" field.
+func ExchangeEQ(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldExchange, v))
+}
+
+// ExchangeNEQ applies the NEQ predicate on the "exchange" field.
+func ExchangeNEQ(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldNEQ(FieldExchange, v))
+}
+
+// ExchangeIn applies the In predicate on the "exchange" field.
+func ExchangeIn(vs ...string) predicate.Fill {
+	return predicate.Fill(sql.FieldIn(FieldExchange, vs...))
+}
+
+// ExchangeNotIn applies the NotIn predicate on the "exchange" field.
+func ExchangeNotIn(vs ...string) predicate.Fill {
+	return predicate.Fill(sql.FieldNotIn(FieldExchange, vs...))
+}
+
+// ExchangeGT applies the GT predicate on the "exchange" field.
+func ExchangeGT(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldGT(FieldExchange, v))
+}
+
+// ExchangeGTE applies the GTE predicate on the "exchange" field.
+func ExchangeGTE(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldGTE(FieldExchange, v))
+}
+
+// ExchangeLT applies the LT predicate on the "exchange" field.
+func ExchangeLT(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldLT(FieldExchange, v))
+}
+
+// ExchangeLTE applies the LTE predicate on the "exchange" field.
+func ExchangeLTE(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldLTE(FieldExchange, v))
+}
+
+// ExchangeContains applies the Contains predicate on the "exchange" field.
+func ExchangeContains(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldContains(FieldExchange, v))
+}
+
+// ExchangeHasPrefix applies the HasPrefix predicate on the "exchange" field.
+func ExchangeHasPrefix(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldHasPrefix(FieldExchange, v))
+}
+
+// ExchangeHasSuffix applies the HasSuffix predicate on the "exchange" field.
+func ExchangeHasSuffix(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldHasSuffix(FieldExchange, v))
+}
+
+// ExchangeEqualFold applies the EqualFold predicate on the "exchange" field.
+func ExchangeEqualFold(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldEqualFold(FieldExchange, v))
+}
+
+// ExchangeContainsFold applies the ContainsFold predicate on the "exchange" field.
+func ExchangeContainsFold(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldContainsFold(FieldExchange, v))
+}
+
+// AccountEQ applies the EQ predicate on the "account" field.
+func AccountEQ(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldAccount, v))
+}
+
+// AccountNEQ applies the NEQ predicate on the "account" field.
+func AccountNEQ(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldNEQ(FieldAccount, v))
+}
+
+// AccountIn applies the In predicate on the "account" field.
+func AccountIn(vs ...string) predicate.Fill {
+	return predicate.Fill(sql.FieldIn(FieldAccount, vs...))
+}
+
+// AccountNotIn applies the NotIn predicate on the "account" field.
+func AccountNotIn(vs ...string) predicate.Fill {
+	return predicate.Fill(sql.FieldNotIn(FieldAccount, vs...))
+}
+
+// AccountGT applies the GT predicate on the "account" field.
+func AccountGT(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldGT(FieldAccount, v))
+}
+
+// AccountGTE applies the GTE predicate on the "account" field.
+func AccountGTE(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldGTE(FieldAccount, v))
+}
+
+// AccountLT applies the LT predicate on the "account" field.
+func AccountLT(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldLT(FieldAccount, v))
+}
+
+// AccountLTE applies the LTE predicate on the "account" field.
+func AccountLTE(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldLTE(FieldAccount, v))
+}
+
+// AccountContains applies the Contains predicate on the "account" field.
+func AccountContains(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldContains(FieldAccount, v))
+}
+
+// AccountHasPrefix applies the HasPrefix predicate on the "account" field.
+func AccountHasPrefix(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldHasPrefix(FieldAccount, v))
+}
+
+// AccountHasSuffix applies the HasSuffix predicate on the "account" field.
+func AccountHasSuffix(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldHasSuffix(FieldAccount, v))
+}
+
+// AccountEqualFold applies the EqualFold predicate on the "account" field.
+func AccountEqualFold(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldEqualFold(FieldAccount, v))
+}
+
+// AccountContainsFold applies the ContainsFold predicate on the "account" field.
+func AccountContainsFold(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldContainsFold(FieldAccount, v))
+}
+
+// SymbolEQ applies the EQ predicate on the "symbol" field.
+func SymbolEQ(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldSymbol, v))
+}
+
+// SymbolNEQ applies the NEQ predicate on the "symbol" field.
+func SymbolNEQ(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldNEQ(FieldSymbol, v))
+}
+
+// SymbolIn applies the In predicate on the "symbol" field.
+func SymbolIn(vs ...string) predicate.Fill {
+	return predicate.Fill(sql.FieldIn(FieldSymbol, vs...))
+}
+
+// SymbolNotIn applies the NotIn predicate on the "symbol" field.
+func SymbolNotIn(vs ...string) predicate.Fill {
+	return predicate.Fill(sql.FieldNotIn(FieldSymbol, vs...))
+}
+
+// SymbolGT applies the GT predicate on the "symbol" field.
+func SymbolGT(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldGT(FieldSymbol, v))
+}
+
+// SymbolGTE applies the GTE predicate on the "symbol" field.
+func SymbolGTE(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldGTE(FieldSymbol, v))
+}
+
+// SymbolLT applies the LT predicate on the "symbol" field.
+func SymbolLT(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldLT(FieldSymbol, v))
+}
+
+// SymbolLTE applies the LTE predicate on the "symbol" field.
+func SymbolLTE(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldLTE(FieldSymbol, v))
+}
+
+// SymbolContains applies the Contains predicate on the "symbol" field.
+func SymbolContains(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldContains(FieldSymbol, v))
+}
+
+// SymbolHasPrefix applies the HasPrefix predicate on the "symbol" field.
+func SymbolHasPrefix(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldHasPrefix(FieldSymbol, v))
+}
+
+// SymbolHasSuffix applies the HasSuffix predicate on the "symbol" field.
+func SymbolHasSuffix(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldHasSuffix(FieldSymbol, v))
+}
+
+// SymbolEqualFold applies the EqualFold predicate on the "symbol" field.
+func SymbolEqualFold(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldEqualFold(FieldSymbol, v))
+}
+
+// SymbolContainsFold applies the ContainsFold predicate on the "symbol" field.
+func SymbolContainsFold(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldContainsFold(FieldSymbol, v))
+}
+
+// FillIdEQ applies the EQ predicate on the "fillId" field.
+func FillIdEQ(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldFillId, v))
+}
+
+// FillIdNEQ applies the NEQ predicate on the "fillId" field.
+func FillIdNEQ(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldNEQ(FieldFillId, v))
+}
+
+// FillIdIn applies the In predicate on the "fillId" field.
+func FillIdIn(vs ...string) predicate.Fill {
+	return predicate.Fill(sql.FieldIn(FieldFillId, vs...))
+}
+
+// FillIdNotIn applies the NotIn predicate on the "fillId" field.
+func FillIdNotIn(vs ...string) predicate.Fill {
+	return predicate.Fill(sql.FieldNotIn(FieldFillId, vs...))
+}
+
+// FillIdGT applies the GT predicate on the "fillId" field.
+func FillIdGT(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldGT(FieldFillId, v))
+}
+
+// FillIdGTE applies the GTE predicate on the "fillId" field.
+func FillIdGTE(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldGTE(FieldFillId, v))
+}
+
+// FillIdLT applies the LT predicate on the "fillId" field.
+func FillIdLT(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldLT(FieldFillId, v))
+}
+
+// FillIdLTE applies the LTE predicate on the "fillId" field.
+func FillIdLTE(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldLTE(FieldFillId, v))
+}
+
+// FillIdContains applies the Contains predicate on the "fillId" field.
+func FillIdContains(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldContains(FieldFillId, v))
+}
+
+// FillIdHasPrefix applies the HasPrefix predicate on the "fillId" field.
+func FillIdHasPrefix(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldHasPrefix(FieldFillId, v))
+}
+
+// FillIdHasSuffix applies the HasSuffix predicate on the "fillId" field.
+func FillIdHasSuffix(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldHasSuffix(FieldFillId, v))
+}
+
+// FillIdEqualFold applies the EqualFold predicate on the "fillId" field.
+func FillIdEqualFold(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldEqualFold(FieldFillId, v))
+}
+
+// FillIdContainsFold applies the ContainsFold predicate on the "fillId" field.
+func FillIdContainsFold(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldContainsFold(FieldFillId, v))
+}
+
+// OrderIdEQ applies the EQ predicate on the "orderId" field.
+func OrderIdEQ(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldOrderId, v))
+}
+
+// OrderIdNEQ applies the NEQ predicate on the "orderId" field.
+func OrderIdNEQ(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldNEQ(FieldOrderId, v))
+}
+
+// OrderIdIn applies the In predicate on the "orderId" field.
+func OrderIdIn(vs ...string) predicate.Fill {
+	return predicate.Fill(sql.FieldIn(FieldOrderId, vs...))
+}
+
+// OrderIdNotIn applies the NotIn predicate on the "orderId" field.
+func OrderIdNotIn(vs ...string) predicate.Fill {
+	return predicate.Fill(sql.FieldNotIn(FieldOrderId, vs...))
+}
+
+// OrderIdGT applies the GT predicate on the "orderId" field.
+func OrderIdGT(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldGT(FieldOrderId, v))
+}
+
+// OrderIdGTE applies the GTE predicate on the "orderId" field.
+func OrderIdGTE(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldGTE(FieldOrderId, v))
+}
+
+// OrderIdLT applies the LT predicate on the "orderId" field.
+func OrderIdLT(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldLT(FieldOrderId, v))
+}
+
+// OrderIdLTE applies the LTE predicate on the "orderId" field.
+func OrderIdLTE(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldLTE(FieldOrderId, v))
+}
+
+// OrderIdContains applies the Contains predicate on the "orderId" field.
+func OrderIdContains(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldContains(FieldOrderId, v))
+}
+
+// OrderIdHasPrefix applies the HasPrefix predicate on the "orderId" field.
+func OrderIdHasPrefix(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldHasPrefix(FieldOrderId, v))
+}
+
+// OrderIdHasSuffix applies the HasSuffix predicate on the "orderId" field.
+func OrderIdHasSuffix(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldHasSuffix(FieldOrderId, v))
+}
+
+// OrderIdEqualFold applies the EqualFold predicate on the "orderId" field.
+func OrderIdEqualFold(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldEqualFold(FieldOrderId, v))
+}
+
+// OrderIdContainsFold applies the ContainsFold predicate on the "orderId" field.
+func OrderIdContainsFold(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldContainsFold(FieldOrderId, v))
+}
+
+// ClientOrderIdEQ applies the EQ predicate on the "clientOrderId" field.
+func ClientOrderIdEQ(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldClientOrderId, v))
+}
+
+// ClientOrderIdNEQ applies the NEQ predicate on the "clientOrderId" field.
+func ClientOrderIdNEQ(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldNEQ(FieldClientOrderId, v))
+}
+
+// ClientOrderIdIn applies the In predicate on the "clientOrderId" field.
+func ClientOrderIdIn(vs ...string) predicate.Fill {
+	return predicate.Fill(sql.FieldIn(FieldClientOrderId, vs...))
+}
+
+// ClientOrderIdNotIn applies the NotIn predicate on the "clientOrderId" field.
+func ClientOrderIdNotIn(vs ...string) predicate.Fill {
+	return predicate.Fill(sql.FieldNotIn(FieldClientOrderId, vs...))
+}
+
+// ClientOrderIdGT applies the GT predicate on the "clientOrderId" field.
+func ClientOrderIdGT(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldGT(FieldClientOrderId, v))
+}
+
+// ClientOrderIdGTE applies the GTE predicate on the "clientOrderId" field.
+func ClientOrderIdGTE(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldGTE(FieldClientOrderId, v))
+}
+
+// ClientOrderIdLT applies the LT predicate on the "clientOrderId" field.
+func ClientOrderIdLT(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldLT(FieldClientOrderId, v))
+}
+
+// ClientOrderIdLTE applies the LTE predicate on the "clientOrderId" field.
+func ClientOrderIdLTE(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldLTE(FieldClientOrderId, v))
+}
+
+// ClientOrderIdContains applies the Contains predicate on the "clientOrderId" field.
+func ClientOrderIdContains(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldContains(FieldClientOrderId, v))
+}
+
+// ClientOrderIdHasPrefix applies the HasPrefix predicate on the "clientOrderId" field.
+func ClientOrderIdHasPrefix(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldHasPrefix(FieldClientOrderId, v))
+}
+
+// ClientOrderIdHasSuffix applies the HasSuffix predicate on the "clientOrderId" field.
+func ClientOrderIdHasSuffix(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldHasSuffix(FieldClientOrderId, v))
+}
+
+// ClientOrderIdIsNil applies the IsNil predicate on the "clientOrderId" field.
+func ClientOrderIdIsNil() predicate.Fill {
+	return predicate.Fill(sql.FieldIsNull(FieldClientOrderId))
+}
+
+// ClientOrderIdNotNil applies the NotNil predicate on the "clientOrderId" field.
+func ClientOrderIdNotNil() predicate.Fill {
+	return predicate.Fill(sql.FieldNotNull(FieldClientOrderId))
+}
+
+// ClientOrderIdEqualFold applies the EqualFold predicate on the "clientOrderId" field.
+func ClientOrderIdEqualFold(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldEqualFold(FieldClientOrderId, v))
+}
+
+// ClientOrderIdContainsFold applies the ContainsFold predicate on the "clientOrderId" field.
+func ClientOrderIdContainsFold(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldContainsFold(FieldClientOrderId, v))
+}
+
+// SideEQ applies the EQ predicate on the "side" field.
+func SideEQ(v Side) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldSide, v))
+}
+
+// SideNEQ applies the NEQ predicate on the "side" field.
+func SideNEQ(v Side) predicate.Fill {
+	return predicate.Fill(sql.FieldNEQ(FieldSide, v))
+}
+
+// SideIn applies the In predicate on the "side" field.
+func SideIn(vs ...Side) predicate.Fill {
+	return predicate.Fill(sql.FieldIn(FieldSide, vs...))
+}
+
+// SideNotIn applies the NotIn predicate on the "side" field.
+func SideNotIn(vs ...Side) predicate.Fill {
+	return predicate.Fill(sql.FieldNotIn(FieldSide, vs...))
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldPrice, v))
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldNEQ(FieldPrice, v))
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldIn(FieldPrice, vs...))
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldNotIn(FieldPrice, vs...))
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldGT(FieldPrice, v))
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldGTE(FieldPrice, v))
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldLT(FieldPrice, v))
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldLTE(FieldPrice, v))
+}
+
+// PriceContains applies the Contains predicate on the "price" field.
+func PriceContains(v decimal.Decimal) predicate.Fill {
+	vc := v.String()
+	return predicate.Fill(sql.FieldContains(FieldPrice, vc))
+}
+
+// PriceHasPrefix applies the HasPrefix predicate on the "price" field.
+func PriceHasPrefix(v decimal.Decimal) predicate.Fill {
+	vc := v.String()
+	return predicate.Fill(sql.FieldHasPrefix(FieldPrice, vc))
+}
+
+// PriceHasSuffix applies the HasSuffix predicate on the "price" field.
+func PriceHasSuffix(v decimal.Decimal) predicate.Fill {
+	vc := v.String()
+	return predicate.Fill(sql.FieldHasSuffix(FieldPrice, vc))
+}
+
+// PriceEqualFold applies the EqualFold predicate on the "price" field.
+func PriceEqualFold(v decimal.Decimal) predicate.Fill {
+	vc := v.String()
+	return predicate.Fill(sql.FieldEqualFold(FieldPrice, vc))
+}
+
+// PriceContainsFold applies the ContainsFold predicate on the "price" field.
+func PriceContainsFold(v decimal.Decimal) predicate.Fill {
+	vc := v.String()
+	return predicate.Fill(sql.FieldContainsFold(FieldPrice, vc))
+}
+
+// BaseAmountEQ applies the EQ predicate on the "baseAmount" field.
+func BaseAmountEQ(v decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldBaseAmount, v))
+}
+
+// BaseAmountNEQ applies the NEQ predicate on the "baseAmount" field.
+func BaseAmountNEQ(v decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldNEQ(FieldBaseAmount, v))
+}
+
+// BaseAmountIn applies the In predicate on the "baseAmount" field.
+func BaseAmountIn(vs ...decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldIn(FieldBaseAmount, vs...))
+}
+
+// BaseAmountNotIn applies the NotIn predicate on the "baseAmount" field.
+func BaseAmountNotIn(vs ...decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldNotIn(FieldBaseAmount, vs...))
+}
+
+// BaseAmountGT applies the GT predicate on the "baseAmount" field.
+func BaseAmountGT(v decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldGT(FieldBaseAmount, v))
+}
+
+// BaseAmountGTE applies the GTE predicate on the "baseAmount" field.
+func BaseAmountGTE(v decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldGTE(FieldBaseAmount, v))
+}
+
+// BaseAmountLT applies the LT predicate on the "baseAmount" field.
+func BaseAmountLT(v decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldLT(FieldBaseAmount, v))
+}
+
+// BaseAmountLTE applies the LTE predicate on the "baseAmount" field.
+func BaseAmountLTE(v decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldLTE(FieldBaseAmount, v))
+}
+
+// BaseAmountContains applies the Contains predicate on the "baseAmount" field.
+func BaseAmountContains(v decimal.Decimal) predicate.Fill {
+	vc := v.String()
+	return predicate.Fill(sql.FieldContains(FieldBaseAmount, vc))
+}
+
+// BaseAmountHasPrefix applies the HasPrefix predicate on the "baseAmount" field.
+func BaseAmountHasPrefix(v decimal.Decimal) predicate.Fill {
+	vc := v.String()
+	return predicate.Fill(sql.FieldHasPrefix(FieldBaseAmount, vc))
+}
+
+// BaseAmountHasSuffix applies the HasSuffix predicate on the "baseAmount" field.
+func BaseAmountHasSuffix(v decimal.Decimal) predicate.Fill {
+	vc := v.String()
+	return predicate.Fill(sql.FieldHasSuffix(FieldBaseAmount, vc))
+}
+
+// BaseAmountEqualFold applies the EqualFold predicate on the "baseAmount" field.
+func BaseAmountEqualFold(v decimal.Decimal) predicate.Fill {
+	vc := v.String()
+	return predicate.Fill(sql.FieldEqualFold(FieldBaseAmount, vc))
+}
+
+// BaseAmountContainsFold applies the ContainsFold predicate on the "baseAmount" field.
+func BaseAmountContainsFold(v decimal.Decimal) predicate.Fill {
+	vc := v.String()
+	return predicate.Fill(sql.FieldContainsFold(FieldBaseAmount, vc))
+}
+
+// QuoteAmountEQ applies the EQ predicate on the "quoteAmount" field.
+func QuoteAmountEQ(v decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldQuoteAmount, v))
+}
+
+// QuoteAmountNEQ applies the NEQ predicate on the "quoteAmount" field.
+func QuoteAmountNEQ(v decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldNEQ(FieldQuoteAmount, v))
+}
+
+// QuoteAmountIn applies the In predicate on the "quoteAmount" field.
+func QuoteAmountIn(vs ...decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldIn(FieldQuoteAmount, vs...))
+}
+
+// QuoteAmountNotIn applies the NotIn predicate on the "quoteAmount" field.
+func QuoteAmountNotIn(vs ...decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldNotIn(FieldQuoteAmount, vs...))
+}
+
+// QuoteAmountGT applies the GT predicate on the "quoteAmount" field.
+func QuoteAmountGT(v decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldGT(FieldQuoteAmount, v))
+}
+
+// QuoteAmountGTE applies the GTE predicate on the "quoteAmount" field.
+func QuoteAmountGTE(v decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldGTE(FieldQuoteAmount, v))
+}
+
+// QuoteAmountLT applies the LT predicate on the "quoteAmount" field.
+func QuoteAmountLT(v decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldLT(FieldQuoteAmount, v))
+}
+
+// QuoteAmountLTE applies the LTE predicate on the "quoteAmount" field.
+func QuoteAmountLTE(v decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldLTE(FieldQuoteAmount, v))
+}
+
+// QuoteAmountContains applies the Contains predicate on the "quoteAmount" field.
+func QuoteAmountContains(v decimal.Decimal) predicate.Fill {
+	vc := v.String()
+	return predicate.Fill(sql.FieldContains(FieldQuoteAmount, vc))
+}
+
+// QuoteAmountHasPrefix applies the HasPrefix predicate on the "quoteAmount" field.
+func QuoteAmountHasPrefix(v decimal.Decimal) predicate.Fill {
+	vc := v.String()
+	return predicate.Fill(sql.FieldHasPrefix(FieldQuoteAmount, vc))
+}
+
+// QuoteAmountHasSuffix applies the HasSuffix predicate on the "quoteAmount" field.
+func QuoteAmountHasSuffix(v decimal.Decimal) predicate.Fill {
+	vc := v.String()
+	return predicate.Fill(sql.FieldHasSuffix(FieldQuoteAmount, vc))
+}
+
+// QuoteAmountEqualFold applies the EqualFold predicate on the "quoteAmount" field.
+func QuoteAmountEqualFold(v decimal.Decimal) predicate.Fill {
+	vc := v.String()
+	return predicate.Fill(sql.FieldEqualFold(FieldQuoteAmount, vc))
+}
+
+// QuoteAmountContainsFold applies the ContainsFold predicate on the "quoteAmount" field.
+func QuoteAmountContainsFold(v decimal.Decimal) predicate.Fill {
+	vc := v.String()
+	return predicate.Fill(sql.FieldContainsFold(FieldQuoteAmount, vc))
+}
+
+// FeeEQ applies the EQ predicate on the "fee" field.
+func FeeEQ(v decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldFee, v))
+}
+
+// FeeNEQ applies the NEQ predicate on the "fee" field.
+func FeeNEQ(v decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldNEQ(FieldFee, v))
+}
+
+// FeeIn applies the In predicate on the "fee" field.
+func FeeIn(vs ...decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldIn(FieldFee, vs...))
+}
+
+// FeeNotIn applies the NotIn predicate on the "fee" field.
+func FeeNotIn(vs ...decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldNotIn(FieldFee, vs...))
+}
+
+// FeeGT applies the GT predicate on the "fee" field.
+func FeeGT(v decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldGT(FieldFee, v))
+}
+
+// FeeGTE applies the GTE predicate on the "fee" field.
+func FeeGTE(v decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldGTE(FieldFee, v))
+}
+
+// FeeLT applies the LT predicate on the "fee" field.
+func FeeLT(v decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldLT(FieldFee, v))
+}
+
+// FeeLTE applies the LTE predicate on the "fee" field.
+func FeeLTE(v decimal.Decimal) predicate.Fill {
+	return predicate.Fill(sql.FieldLTE(FieldFee, v))
+}
+
+// FeeContains applies the Contains predicate on the "fee" field.
+func FeeContains(v decimal.Decimal) predicate.Fill {
+	vc := v.String()
+	return predicate.Fill(sql.FieldContains(FieldFee, vc))
+}
+
+// FeeHasPrefix applies the HasPrefix predicate on the "fee" field.
+func FeeHasPrefix(v decimal.Decimal) predicate.Fill {
+	vc := v.String()
+	return predicate.Fill(sql.FieldHasPrefix(FieldFee, vc))
+}
+
+// FeeHasSuffix applies the HasSuffix predicate on the "fee" field.
+func FeeHasSuffix(v decimal.Decimal) predicate.Fill {
+	vc := v.String()
+	return predicate.Fill(sql.FieldHasSuffix(FieldFee, vc))
+}
+
+// FeeEqualFold applies the EqualFold predicate on the "fee" field.
+func FeeEqualFold(v decimal.Decimal) predicate.Fill {
+	vc := v.String()
+	return predicate.Fill(sql.FieldEqualFold(FieldFee, vc))
+}
+
+// FeeContainsFold applies the ContainsFold predicate on the "fee" field.
+func FeeContainsFold(v decimal.Decimal) predicate.Fill {
+	vc := v.String()
+	return predicate.Fill(sql.FieldContainsFold(FieldFee, vc))
+}
+
+// FeeAssetEQ applies the EQ predicate on the "feeAsset" field.
+func FeeAssetEQ(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldFeeAsset, v))
+}
+
+// FeeAssetNEQ applies the NEQ predicate on the "feeAsset" field.
+func FeeAssetNEQ(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldNEQ(FieldFeeAsset, v))
+}
+
+// FeeAssetIn applies the In predicate on the "feeAsset" field.
+func FeeAssetIn(vs ...string) predicate.Fill {
+	return predicate.Fill(sql.FieldIn(FieldFeeAsset, vs...))
+}
+
+// FeeAssetNotIn applies the NotIn predicate on the "feeAsset" field.
+func FeeAssetNotIn(vs ...string) predicate.Fill {
+	return predicate.Fill(sql.FieldNotIn(FieldFeeAsset, vs...))
+}
+
+// FeeAssetGT applies the GT predicate on the "feeAsset" field.
+func FeeAssetGT(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldGT(FieldFeeAsset, v))
+}
+
+// FeeAssetGTE applies the GTE predicate on the "feeAsset" field.
+func FeeAssetGTE(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldGTE(FieldFeeAsset, v))
+}
+
+// FeeAssetLT applies the LT predicate on the "feeAsset" field.
+func FeeAssetLT(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldLT(FieldFeeAsset, v))
+}
+
+// FeeAssetLTE applies the LTE predicate on the "feeAsset" field.
+func FeeAssetLTE(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldLTE(FieldFeeAsset, v))
+}
+
+// FeeAssetContains applies the Contains predicate on the "feeAsset" field.
+func FeeAssetContains(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldContains(FieldFeeAsset, v))
+}
+
+// FeeAssetHasPrefix applies the HasPrefix predicate on the "feeAsset" field.
+func FeeAssetHasPrefix(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldHasPrefix(FieldFeeAsset, v))
+}
+
+// FeeAssetHasSuffix applies the HasSuffix predicate on the "feeAsset" field.
+func FeeAssetHasSuffix(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldHasSuffix(FieldFeeAsset, v))
+}
+
+// FeeAssetEqualFold applies the EqualFold predicate on the "feeAsset" field.
+func FeeAssetEqualFold(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldEqualFold(FieldFeeAsset, v))
+}
+
+// FeeAssetContainsFold applies the ContainsFold predicate on the "feeAsset" field.
+func FeeAssetContainsFold(v string) predicate.Fill {
+	return predicate.Fill(sql.FieldContainsFold(FieldFeeAsset, v))
+}
+
+// IsMakerEQ applies the EQ predicate on the "isMaker" field.
+func IsMakerEQ(v bool) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldIsMaker, v))
+}
+
+// IsMakerNEQ applies the NEQ predicate on the "isMaker" field.
+func IsMakerNEQ(v bool) predicate.Fill {
+	return predicate.Fill(sql.FieldNEQ(FieldIsMaker, v))
+}
+
+// TimestampEQ applies the EQ predicate on the "timestamp" field.
+func TimestampEQ(v int64) predicate.Fill {
+	return predicate.Fill(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// TimestampNEQ applies the NEQ predicate on the "timestamp" field.
+func TimestampNEQ(v int64) predicate.Fill {
+	return predicate.Fill(sql.FieldNEQ(FieldTimestamp, v))
+}
+
+// TimestampIn applies the In predicate on the "timestamp" field.
+func TimestampIn(vs ...int64) predicate.Fill {
+	return predicate.Fill(sql.FieldIn(FieldTimestamp, vs...))
+}
+
+// TimestampNotIn applies the NotIn predicate on the "timestamp" field.
+func TimestampNotIn(vs ...int64) predicate.Fill {
+	return predicate.Fill(sql.FieldNotIn(FieldTimestamp, vs...))
+}
+
+// TimestampGT applies the GT predicate on the "timestamp" field.
+func TimestampGT(v int64) predicate.Fill {
+	return predicate.Fill(sql.FieldGT(FieldTimestamp, v))
+}
+
+// TimestampGTE applies the GTE predicate on the "timestamp" field.
+func TimestampGTE(v int64) predicate.Fill {
+	return predicate.Fill(sql.FieldGTE(FieldTimestamp, v))
+}
+
+// TimestampLT applies the LT predicate on the "timestamp" field.
+func TimestampLT(v int64) predicate.Fill {
+	return predicate.Fill(sql.FieldLT(FieldTimestamp, v))
+}
+
+// TimestampLTE applies the LTE predicate on the "timestamp" field.
+func TimestampLTE(v int64) predicate.Fill {
+	return predicate.Fill(sql.FieldLTE(FieldTimestamp, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Fill) predicate.Fill {
+	return predicate.Fill(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Fill) predicate.Fill {
+	return predicate.Fill(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Fill) predicate.Fill {
+	return predicate.Fill(sql.NotPredicates(p))
+}
@@ -0,0 +1,1330 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/fachebot/omni-grid-bot/internal/ent/fill"
+	"github.com/shopspring/decimal"
+)
+
+// FillCreate is the builder for creating a Fill entity.
+type FillCreate struct {
+	config
+	mutation *FillMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *FillCreate) SetCreateTime(v time.Time) *FillCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *FillCreate) SetNillableCreateTime(v *time.Time) *FillCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *FillCreate) SetUpdateTime(v time.Time) *FillCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *FillCreate) SetNillableUpdateTime(v *time.Time) *FillCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetExchange sets the "exchange" field.
+func (_c *FillCreate) SetExchange(v string) *FillCreate {
+	_c.mutation.SetExchange(v)
+	return _c
+}
+
+// SetAccount sets the "account" field.
+func (_c *FillCreate) SetAccount(v string) *FillCreate {
+	_c.mutation.SetAccount(v)
+	return _c
+}
+
+// SetSymbol sets the "symbol" field.
+func (_c *FillCreate) SetSymbol(v string) *FillCreate {
+	_c.mutation.SetSymbol(v)
+	return _c
+}
+
+// SetFillId sets the "fillId" field.
+func (_c *FillCreate) SetFillId(v string) *FillCreate {
+	_c.mutation.SetFillId(v)
+	return _c
+}
+
+// SetOrderId sets the "orderId" field.
+func (_c *FillCreate) SetOrderId(v string) *FillCreate {
+	_c.mutation.SetOrderId(v)
+	return _c
+}
+
+// SetClientOrderId sets the "clientOrderId" field.
+func (_c *FillCreate) SetClientOrderId(v string) *FillCreate {
+	_c.mutation.SetClientOrderId(v)
+	return _c
+}
+
+// SetNillableClientOrderId sets the "clientOrderId" field if the given value is not nil.
+func (_c *FillCreate) SetNillableClientOrderId(v *string) *FillCreate {
+	if v != nil {
+		_c.SetClientOrderId(*v)
+	}
+	return _c
+}
+
+// SetSide sets the "side" field.
+func (_c *FillCreate) SetSide(v fill.Side) *FillCreate {
+	_c.mutation.SetSide(v)
+	return _c
+}
+
+// SetPrice sets the "price" field.
+func (_c *FillCreate) SetPrice(v decimal.Decimal) *FillCreate {
+	_c.mutation.SetPrice(v)
+	return _c
+}
+
+// SetBaseAmount sets the "baseAmount" field.
+func (_c *FillCreate) SetBaseAmount(v decimal.Decimal) *FillCreate {
+	_c.mutation.SetBaseAmount(v)
+	return _c
+}
+
+// SetQuoteAmount sets the "quoteAmount" field.
+func (_c *FillCreate) SetQuoteAmount(v decimal.Decimal) *FillCreate {
+	_c.mutation.SetQuoteAmount(v)
+	return _c
+}
+
+// SetFee sets the "fee" field.
+func (_c *FillCreate) SetFee(v decimal.Decimal) *FillCreate {
+	_c.mutation.SetFee(v)
+	return _c
+}
+
+// SetFeeAsset sets the "feeAsset" field.
+func (_c *FillCreate) SetFeeAsset(v string) *FillCreate {
+	_c.mutation.SetFeeAsset(v)
+	return _c
+}
+
+// SetIsMaker sets the "isMaker" field.
+func (_c *FillCreate) SetIsMaker(v bool) *FillCreate {
+	_c.mutation.SetIsMaker(v)
+	return _c
+}
+
+// SetNillableIsMaker sets the "isMaker" field if the given value is not nil.
+func (_c *FillCreate) SetNillableIsMaker(v *bool) *FillCreate {
+	if v != nil {
+		_c.SetIsMaker(*v)
+	}
+	return _c
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (_c *FillCreate) SetTimestamp(v int64) *FillCreate {
+	_c.mutation.SetTimestamp(v)
+	return _c
+}
+
+// Mutation returns the FillMutation object of the builder.
+func (_c *FillCreate) Mutation() *FillMutation {
+	return _c.mutation
+}
+
+// Save creates the Fill in the database.
+func (_c *FillCreate) Save(ctx context.Context) (*Fill, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FillCreate) SaveX(ctx context.Context) *Fill {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FillCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FillCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *FillCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := fill.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := fill.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.IsMaker(); !ok {
+		v := fill.DefaultIsMaker
+		_c.mutation.SetIsMaker(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FillCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Fill.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Fill.update_time"`)}
+	}
+	if _, ok := _c.mutation.Exchange(); !ok {
+		return &ValidationError{Name: "exchange", err: errors.New(`ent: missing required field "Fill.exchange"`)}
+	}
+	if v, ok := _c.mutation.Exchange(); ok {
+		if err := fill.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "Fill.exchange": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Account(); !ok {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required field "Fill.account"`)}
+	}
+	if _, ok := _c.mutation.Symbol(); !ok {
+		return &ValidationError{Name: "symbol", err: errors.New(`ent: missing required field "Fill.symbol"`)}
+	}
+	if v, ok := _c.mutation.Symbol(); ok {
+		if err := fill.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "Fill.symbol": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FillId(); !ok {
+		return &ValidationError{Name: "fillId", err: errors.New(`ent: missing required field "Fill.fillId"`)}
+	}
+	if _, ok := _c.mutation.OrderId(); !ok {
+		return &ValidationError{Name: "orderId", err: errors.New(`ent: missing required field "Fill.orderId"`)}
+	}
+	if _, ok := _c.mutation.Side(); !ok {
+		return &ValidationError{Name: "side", err: errors.New(`ent: missing required field "Fill.side"`)}
+	}
+	if v, ok := _c.mutation.Side(); ok {
+		if err := fill.SideValidator(v); err != nil {
+			return &ValidationError{Name: "side", err: fmt.Errorf(`ent: validator failed for field "Fill.side": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Price(); !ok {
+		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "Fill.price"`)}
+	}
+	if _, ok := _c.mutation.BaseAmount(); !ok {
+		return &ValidationError{Name: "baseAmount", err: errors.New(`ent: missing required field "Fill.baseAmount"`)}
+	}
+	if _, ok := _c.mutation.QuoteAmount(); !ok {
+		return &ValidationError{Name: "quoteAmount", err: errors.New(`ent: missing required field "Fill.quoteAmount"`)}
+	}
+	if _, ok := _c.mutation.Fee(); !ok {
+		return &ValidationError{Name: "fee", err: errors.New(`ent: missing required field "Fill.fee"`)}
+	}
+	if _, ok := _c.mutation.FeeAsset(); !ok {
+		return &ValidationError{Name: "feeAsset", err: errors.New(`ent: missing required field "Fill.feeAsset"`)}
+	}
+	if v, ok := _c.mutation.FeeAsset(); ok {
+		if err := fill.FeeAssetValidator(v); err != nil {
+			return &ValidationError{Name: "feeAsset", err: fmt.Errorf(`ent: validator failed for field "Fill.feeAsset": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsMaker(); !ok {
+		return &ValidationError{Name: "isMaker", err: errors.New(`ent: missing required field "Fill.isMaker"`)}
+	}
+	if _, ok := _c.mutation.Timestamp(); !ok {
+		return &ValidationError{Name: "timestamp", err: errors.New(`ent: missing required field "Fill.timestamp"`)}
+	}
+	return nil
+}
+
+func (_c *FillCreate) sqlSave(ctx context.Context) (*Fill, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FillCreate) createSpec() (*Fill, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Fill{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(fill.Table, sqlgraph.NewFieldSpec(fill.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(fill.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(fill.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Exchange(); ok {
+		_spec.SetField(fill.FieldExchange, field.TypeString, value)
+		_node.Exchange = value
+	}
+	if value, ok := _c.mutation.Account(); ok {
+		_spec.SetField(fill.FieldAccount, field.TypeString, value)
+		_node.Account = value
+	}
+	if value, ok := _c.mutation.Symbol(); ok {
+		_spec.SetField(fill.FieldSymbol, field.TypeString, value)
+		_node.Symbol = value
+	}
+	if value, ok := _c.mutation.FillId(); ok {
+		_spec.SetField(fill.FieldFillId, field.TypeString, value)
+		_node.FillId = value
+	}
+	if value, ok := _c.mutation.OrderId(); ok {
+		_spec.SetField(fill.FieldOrderId, field.TypeString, value)
+		_node.OrderId = value
+	}
+	if value, ok := _c.mutation.ClientOrderId(); ok {
+		_spec.SetField(fill.FieldClientOrderId, field.TypeString, value)
+		_node.ClientOrderId = &value
+	}
+	if value, ok := _c.mutation.Side(); ok {
+		_spec.SetField(fill.FieldSide, field.TypeEnum, value)
+		_node.Side = value
+	}
+	if value, ok := _c.mutation.Price(); ok {
+		_spec.SetField(fill.FieldPrice, field.TypeString, value)
+		_node.Price = value
+	}
+	if value, ok := _c.mutation.BaseAmount(); ok {
+		_spec.SetField(fill.FieldBaseAmount, field.TypeString, value)
+		_node.BaseAmount = value
+	}
+	if value, ok := _c.mutation.QuoteAmount(); ok {
+		_spec.SetField(fill.FieldQuoteAmount, field.TypeString, value)
+		_node.QuoteAmount = value
+	}
+	if value, ok := _c.mutation.Fee(); ok {
+		_spec.SetField(fill.FieldFee, field.TypeString, value)
+		_node.Fee = value
+	}
+	if value, ok := _c.mutation.FeeAsset(); ok {
+		_spec.SetField(fill.FieldFeeAsset, field.TypeString, value)
+		_node.FeeAsset = value
+	}
+	if value, ok := _c.mutation.IsMaker(); ok {
+		_spec.SetField(fill.FieldIsMaker, field.TypeBool, value)
+		_node.IsMaker = value
+	}
+	if value, ok := _c.mutation.Timestamp(); ok {
+		_spec.SetField(fill.FieldTimestamp, field.TypeInt64, value)
+		_node.Timestamp = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Fill.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FillUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *FillCreate) OnConflict(opts ...sql.ConflictOption) *FillUpsertOne {
+	_c.conflict = opts
+	return &FillUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Fill.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *FillCreate) OnConflictColumns(columns ...string) *FillUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &FillUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// FillUpsertOne is the builder for "upsert"-ing
+	//  one Fill node.
+	FillUpsertOne struct {
+		create *FillCreate
+	}
+
+	// FillUpsert is the "OnConflict" setter.
+	FillUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *FillUpsert) SetUpdateTime(v time.Time) *FillUpsert {
+	u.Set(fill.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *FillUpsert) UpdateUpdateTime() *FillUpsert {
+	u.SetExcluded(fill.FieldUpdateTime)
+	return u
+}
+
+// SetExchange sets the "exchange" field.
+func (u *FillUpsert) SetExchange(v string) *FillUpsert {
+	u.Set(fill.FieldExchange, v)
+	return u
+}
+
+// UpdateExchange sets the "exchange" field to the value that was provided on create.
+func (u *FillUpsert) UpdateExchange() *FillUpsert {
+	u.SetExcluded(fill.FieldExchange)
+	return u
+}
+
+// SetAccount sets the "account" field.
+func (u *FillUpsert) SetAccount(v string) *FillUpsert {
+	u.Set(fill.FieldAccount, v)
+	return u
+}
+
+// UpdateAccount sets the "account" field to the value that was provided on create.
+func (u *FillUpsert) UpdateAccount() *FillUpsert {
+	u.SetExcluded(fill.FieldAccount)
+	return u
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *FillUpsert) SetSymbol(v string) *FillUpsert {
+	u.Set(fill.FieldSymbol, v)
+	return u
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *FillUpsert) UpdateSymbol() *FillUpsert {
+	u.SetExcluded(fill.FieldSymbol)
+	return u
+}
+
+// SetFillId sets the "fillId" field.
+func (u *FillUpsert) SetFillId(v string) *FillUpsert {
+	u.Set(fill.FieldFillId, v)
+	return u
+}
+
+// UpdateFillId sets the "fillId" field to the value that was provided on create.
+func (u *FillUpsert) UpdateFillId() *FillUpsert {
+	u.SetExcluded(fill.FieldFillId)
+	return u
+}
+
+// SetOrderId sets the "orderId" field.
+func (u *FillUpsert) SetOrderId(v string) *FillUpsert {
+	u.Set(fill.FieldOrderId, v)
+	return u
+}
+
+// UpdateOrderId sets the "orderId" field to the value that was provided on create.
+func (u *FillUpsert) UpdateOrderId() *FillUpsert {
+	u.SetExcluded(fill.FieldOrderId)
+	return u
+}
+
+// SetClientOrderId sets the "clientOrderId" field.
+func (u *FillUpsert) SetClientOrderId(v string) *FillUpsert {
+	u.Set(fill.FieldClientOrderId, v)
+	return u
+}
+
+// UpdateClientOrderId sets the "clientOrderId" field to the value that was provided on create.
+func (u *FillUpsert) UpdateClientOrderId() *FillUpsert {
+	u.SetExcluded(fill.FieldClientOrderId)
+	return u
+}
+
+// ClearClientOrderId clears the value of the "clientOrderId" field.
+func (u *FillUpsert) ClearClientOrderId() *FillUpsert {
+	u.SetNull(fill.FieldClientOrderId)
+	return u
+}
+
+// SetSide sets the "side" field.
+func (u *FillUpsert) SetSide(v fill.Side) *FillUpsert {
+	u.Set(fill.FieldSide, v)
+	return u
+}
+
+// UpdateSide sets the "side" field to the value that was provided on create.
+func (u *FillUpsert) UpdateSide() *FillUpsert {
+	u.SetExcluded(fill.FieldSide)
+	return u
+}
+
+// SetPrice sets the "price" field.
+func (u *FillUpsert) SetPrice(v decimal.Decimal) *FillUpsert {
+	u.Set(fill.FieldPrice, v)
+	return u
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *FillUpsert) UpdatePrice() *FillUpsert {
+	u.SetExcluded(fill.FieldPrice)
+	return u
+}
+
+// SetBaseAmount sets the "baseAmount" field.
+func (u *FillUpsert) SetBaseAmount(v decimal.Decimal) *FillUpsert {
+	u.Set(fill.FieldBaseAmount, v)
+	return u
+}
+
+// UpdateBaseAmount sets the "baseAmount" field to the value that was provided on create.
+func (u *FillUpsert) UpdateBaseAmount() *FillUpsert {
+	u.SetExcluded(fill.FieldBaseAmount)
+	return u
+}
+
+// SetQuoteAmount sets the "quoteAmount" field.
+func (u *FillUpsert) SetQuoteAmount(v decimal.Decimal) *FillUpsert {
+	u.Set(fill.FieldQuoteAmount, v)
+	return u
+}
+
+// UpdateQuoteAmount sets the "quoteAmount" field to the value that was provided on create.
+func (u *FillUpsert) UpdateQuoteAmount() *FillUpsert {
+	u.SetExcluded(fill.FieldQuoteAmount)
+	return u
+}
+
+// SetFee sets the "fee" field.
+func (u *FillUpsert) SetFee(v decimal.Decimal) *FillUpsert {
+	u.Set(fill.FieldFee, v)
+	return u
+}
+
+// UpdateFee sets the "fee" field to the value that was provided on create.
+func (u *FillUpsert) UpdateFee() *FillUpsert {
+	u.SetExcluded(fill.FieldFee)
+	return u
+}
+
+// SetFeeAsset sets the "feeAsset" field.
+func (u *FillUpsert) SetFeeAsset(v string) *FillUpsert {
+	u.Set(fill.FieldFeeAsset, v)
+	return u
+}
+
+// UpdateFeeAsset sets the "feeAsset" field to the value that was provided on create.
+func (u *FillUpsert) UpdateFeeAsset() *FillUpsert {
+	u.SetExcluded(fill.FieldFeeAsset)
+	return u
+}
+
+// SetIsMaker sets the "isMaker" field.
+func (u *FillUpsert) SetIsMaker(v bool) *FillUpsert {
+	u.Set(fill.FieldIsMaker, v)
+	return u
+}
+
+// UpdateIsMaker sets the "isMaker" field to the value that was provided on create.
+func (u *FillUpsert) UpdateIsMaker() *FillUpsert {
+	u.SetExcluded(fill.FieldIsMaker)
+	return u
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (u *FillUpsert) SetTimestamp(v int64) *FillUpsert {
+	u.Set(fill.FieldTimestamp, v)
+	return u
+}
+
+// UpdateTimestamp sets the "timestamp" field to the value that was provided on create.
+func (u *FillUpsert) UpdateTimestamp() *FillUpsert {
+	u.SetExcluded(fill.FieldTimestamp)
+	return u
+}
+
+// AddTimestamp adds v to the "timestamp" field.
+func (u *FillUpsert) AddTimestamp(v int64) *FillUpsert {
+	u.Add(fill.FieldTimestamp, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Fill.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *FillUpsertOne) UpdateNewValues() *FillUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(fill.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Fill.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *FillUpsertOne) Ignore() *FillUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FillUpsertOne) DoNothing() *FillUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FillCreate.OnConflict
+// documentation for more info.
+func (u *FillUpsertOne) Update(set func(*FillUpsert)) *FillUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FillUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *FillUpsertOne) SetUpdateTime(v time.Time) *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *FillUpsertOne) UpdateUpdateTime() *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetExchange sets the "exchange" field.
+func (u *FillUpsertOne) SetExchange(v string) *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.SetExchange(v)
+	})
+}
+
+// UpdateExchange sets the "exchange" field to the value that was provided on create.
+func (u *FillUpsertOne) UpdateExchange() *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdateExchange()
+	})
+}
+
+// SetAccount sets the "account" field.
+func (u *FillUpsertOne) SetAccount(v string) *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.SetAccount(v)
+	})
+}
+
+// UpdateAccount sets the "account" field to the value that was provided on create.
+func (u *FillUpsertOne) UpdateAccount() *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdateAccount()
+	})
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *FillUpsertOne) SetSymbol(v string) *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.SetSymbol(v)
+	})
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *FillUpsertOne) UpdateSymbol() *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdateSymbol()
+	})
+}
+
+// SetFillId sets the "fillId" field.
+func (u *FillUpsertOne) SetFillId(v string) *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.SetFillId(v)
+	})
+}
+
+// UpdateFillId sets the "fillId" field to the value that was provided on create.
+func (u *FillUpsertOne) UpdateFillId() *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdateFillId()
+	})
+}
+
+// SetOrderId sets the "orderId" field.
+func (u *FillUpsertOne) SetOrderId(v string) *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.SetOrderId(v)
+	})
+}
+
+// UpdateOrderId sets the "orderId" field to the value that was provided on create.
+func (u *FillUpsertOne) UpdateOrderId() *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdateOrderId()
+	})
+}
+
+// SetClientOrderId sets the "clientOrderId" field.
+func (u *FillUpsertOne) SetClientOrderId(v string) *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.SetClientOrderId(v)
+	})
+}
+
+// UpdateClientOrderId sets the "clientOrderId" field to the value that was provided on create.
+func (u *FillUpsertOne) UpdateClientOrderId() *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdateClientOrderId()
+	})
+}
+
+// ClearClientOrderId clears the value of the "clientOrderId" field.
+func (u *FillUpsertOne) ClearClientOrderId() *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.ClearClientOrderId()
+	})
+}
+
+// SetSide sets the "side" field.
+func (u *FillUpsertOne) SetSide(v fill.Side) *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.SetSide(v)
+	})
+}
+
+// UpdateSide sets the "side" field to the value that was provided on create.
+func (u *FillUpsertOne) UpdateSide() *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdateSide()
+	})
+}
+
+// SetPrice sets the "price" field.
+func (u *FillUpsertOne) SetPrice(v decimal.Decimal) *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *FillUpsertOne) UpdatePrice() *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// SetBaseAmount sets the "baseAmount" field.
+func (u *FillUpsertOne) SetBaseAmount(v decimal.Decimal) *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.SetBaseAmount(v)
+	})
+}
+
+// UpdateBaseAmount sets the "baseAmount" field to the value that was provided on create.
+func (u *FillUpsertOne) UpdateBaseAmount() *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdateBaseAmount()
+	})
+}
+
+// SetQuoteAmount sets the "quoteAmount" field.
+func (u *FillUpsertOne) SetQuoteAmount(v decimal.Decimal) *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.SetQuoteAmount(v)
+	})
+}
+
+// UpdateQuoteAmount sets the "quoteAmount" field to the value that was provided on create.
+func (u *FillUpsertOne) UpdateQuoteAmount() *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdateQuoteAmount()
+	})
+}
+
+// SetFee sets the "fee" field.
+func (u *FillUpsertOne) SetFee(v decimal.Decimal) *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.SetFee(v)
+	})
+}
+
+// UpdateFee sets the "fee" field to the value that was provided on create.
+func (u *FillUpsertOne) UpdateFee() *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdateFee()
+	})
+}
+
+// SetFeeAsset sets the "feeAsset" field.
+func (u *FillUpsertOne) SetFeeAsset(v string) *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.SetFeeAsset(v)
+	})
+}
+
+// UpdateFeeAsset sets the "feeAsset" field to the value that was provided on create.
+func (u *FillUpsertOne) UpdateFeeAsset() *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdateFeeAsset()
+	})
+}
+
+// SetIsMaker sets the "isMaker" field.
+func (u *FillUpsertOne) SetIsMaker(v bool) *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.SetIsMaker(v)
+	})
+}
+
+// UpdateIsMaker sets the "isMaker" field to the value that was provided on create.
+func (u *FillUpsertOne) UpdateIsMaker() *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdateIsMaker()
+	})
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (u *FillUpsertOne) SetTimestamp(v int64) *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.SetTimestamp(v)
+	})
+}
+
+// AddTimestamp adds v to the "timestamp" field.
+func (u *FillUpsertOne) AddTimestamp(v int64) *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.AddTimestamp(v)
+	})
+}
+
+// UpdateTimestamp sets the "timestamp" field to the value that was provided on create.
+func (u *FillUpsertOne) UpdateTimestamp() *FillUpsertOne {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdateTimestamp()
+	})
+}
+
+// Exec executes the query.
+func (u *FillUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FillCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FillUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *FillUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *FillUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// FillCreateBulk is the builder for creating many Fill entities in bulk.
+type FillCreateBulk struct {
+	config
+	err      error
+	builders []*FillCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Fill entities in the database.
+func (_c *FillCreateBulk) Save(ctx context.Context) ([]*Fill, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Fill, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FillMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FillCreateBulk) SaveX(ctx context.Context) []*Fill {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FillCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FillCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Fill.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FillUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *FillCreateBulk) OnConflict(opts ...sql.ConflictOption) *FillUpsertBulk {
+	_c.conflict = opts
+	return &FillUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Fill.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *FillCreateBulk) OnConflictColumns(columns ...string) *FillUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &FillUpsertBulk{
+		create: _c,
+	}
+}
+
+// FillUpsertBulk is the builder for "upsert"-ing
+// a bulk of Fill nodes.
+type FillUpsertBulk struct {
+	create *FillCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Fill.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *FillUpsertBulk) UpdateNewValues() *FillUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(fill.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Fill.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *FillUpsertBulk) Ignore() *FillUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FillUpsertBulk) DoNothing() *FillUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FillCreateBulk.OnConflict
+// documentation for more info.
+func (u *FillUpsertBulk) Update(set func(*FillUpsert)) *FillUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FillUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *FillUpsertBulk) SetUpdateTime(v time.Time) *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *FillUpsertBulk) UpdateUpdateTime() *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetExchange sets the "exchange" field.
+func (u *FillUpsertBulk) SetExchange(v string) *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.SetExchange(v)
+	})
+}
+
+// UpdateExchange sets the "exchange" field to the value that was provided on create.
+func (u *FillUpsertBulk) UpdateExchange() *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdateExchange()
+	})
+}
+
+// SetAccount sets the "account" field.
+func (u *FillUpsertBulk) SetAccount(v string) *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.SetAccount(v)
+	})
+}
+
+// UpdateAccount sets the "account" field to the value that was provided on create.
+func (u *FillUpsertBulk) UpdateAccount() *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdateAccount()
+	})
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *FillUpsertBulk) SetSymbol(v string) *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.SetSymbol(v)
+	})
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *FillUpsertBulk) UpdateSymbol() *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdateSymbol()
+	})
+}
+
+// SetFillId sets the "fillId" field.
+func (u *FillUpsertBulk) SetFillId(v string) *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.SetFillId(v)
+	})
+}
+
+// UpdateFillId sets the "fillId" field to the value that was provided on create.
+func (u *FillUpsertBulk) UpdateFillId() *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdateFillId()
+	})
+}
+
+// SetOrderId sets the "orderId" field.
+func (u *FillUpsertBulk) SetOrderId(v string) *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.SetOrderId(v)
+	})
+}
+
+// UpdateOrderId sets the "orderId" field to the value that was provided on create.
+func (u *FillUpsertBulk) UpdateOrderId() *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdateOrderId()
+	})
+}
+
+// SetClientOrderId sets the "clientOrderId" field.
+func (u *FillUpsertBulk) SetClientOrderId(v string) *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.SetClientOrderId(v)
+	})
+}
+
+// UpdateClientOrderId sets the "clientOrderId" field to the value that was provided on create.
+func (u *FillUpsertBulk) UpdateClientOrderId() *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdateClientOrderId()
+	})
+}
+
+// ClearClientOrderId clears the value of the "clientOrderId" field.
+func (u *FillUpsertBulk) ClearClientOrderId() *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.ClearClientOrderId()
+	})
+}
+
+// SetSide sets the "side" field.
+func (u *FillUpsertBulk) SetSide(v fill.Side) *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.SetSide(v)
+	})
+}
+
+// UpdateSide sets the "side" field to the value that was provided on create.
+func (u *FillUpsertBulk) UpdateSide() *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdateSide()
+	})
+}
+
+// SetPrice sets the "price" field.
+func (u *FillUpsertBulk) SetPrice(v decimal.Decimal) *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *FillUpsertBulk) UpdatePrice() *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// SetBaseAmount sets the "baseAmount" field.
+func (u *FillUpsertBulk) SetBaseAmount(v decimal.Decimal) *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.SetBaseAmount(v)
+	})
+}
+
+// UpdateBaseAmount sets the "baseAmount" field to the value that was provided on create.
+func (u *FillUpsertBulk) UpdateBaseAmount() *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdateBaseAmount()
+	})
+}
+
+// SetQuoteAmount sets the "quoteAmount" field.
+func (u *FillUpsertBulk) SetQuoteAmount(v decimal.Decimal) *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.SetQuoteAmount(v)
+	})
+}
+
+// UpdateQuoteAmount sets the "quoteAmount" field to the value that was provided on create.
+func (u *FillUpsertBulk) UpdateQuoteAmount() *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdateQuoteAmount()
+	})
+}
+
+// SetFee sets the "fee" field.
+func (u *FillUpsertBulk) SetFee(v decimal.Decimal) *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.SetFee(v)
+	})
+}
+
+// UpdateFee sets the "fee" field to the value that was provided on create.
+func (u *FillUpsertBulk) UpdateFee() *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdateFee()
+	})
+}
+
+// SetFeeAsset sets the "feeAsset" field.
+func (u *FillUpsertBulk) SetFeeAsset(v string) *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.SetFeeAsset(v)
+	})
+}
+
+// UpdateFeeAsset sets the "feeAsset" field to the value that was provided on create.
+func (u *FillUpsertBulk) UpdateFeeAsset() *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdateFeeAsset()
+	})
+}
+
+// SetIsMaker sets the "isMaker" field.
+func (u *FillUpsertBulk) SetIsMaker(v bool) *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.SetIsMaker(v)
+	})
+}
+
+// UpdateIsMaker sets the "isMaker" field to the value that was provided on create.
+func (u *FillUpsertBulk) UpdateIsMaker() *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdateIsMaker()
+	})
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (u *FillUpsertBulk) SetTimestamp(v int64) *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.SetTimestamp(v)
+	})
+}
+
+// AddTimestamp adds v to the "timestamp" field.
+func (u *FillUpsertBulk) AddTimestamp(v int64) *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.AddTimestamp(v)
+	})
+}
+
+// UpdateTimestamp sets the "timestamp" field to the value that was provided on create.
+func (u *FillUpsertBulk) UpdateTimestamp() *FillUpsertBulk {
+	return u.Update(func(s *FillUpsert) {
+		s.UpdateTimestamp()
+	})
+}
+
+// Exec executes the query.
+func (u *FillUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the FillCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FillCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FillUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
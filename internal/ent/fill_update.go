@@ -0,0 +1,783 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/fachebot/omni-grid-bot/internal/ent/fill"
+	"github.com/fachebot/omni-grid-bot/internal/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// FillUpdate is the builder for updating Fill entities.
+type FillUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FillMutation
+}
+
+// Where appends a list predicates to the FillUpdate builder.
+func (_u *FillUpdate) Where(ps ...predicate.Fill) *FillUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *FillUpdate) SetUpdateTime(v time.Time) *FillUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetExchange sets the "exchange" field.
+func (_u *FillUpdate) SetExchange(v string) *FillUpdate {
+	_u.mutation.SetExchange(v)
+	return _u
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_u *FillUpdate) SetNillableExchange(v *string) *FillUpdate {
+	if v != nil {
+		_u.SetExchange(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" field.
+func (_u *FillUpdate) SetAccount(v string) *FillUpdate {
+	_u.mutation.SetAccount(v)
+	return _u
+}
+
+// SetNillableAccount sets the "account" field if the given value is not nil.
+func (_u *FillUpdate) SetNillableAccount(v *string) *FillUpdate {
+	if v != nil {
+		_u.SetAccount(*v)
+	}
+	return _u
+}
+
+// SetSymbol sets the "symbol" field.
+func (_u *FillUpdate) SetSymbol(v string) *FillUpdate {
+	_u.mutation.SetSymbol(v)
+	return _u
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_u *FillUpdate) SetNillableSymbol(v *string) *FillUpdate {
+	if v != nil {
+		_u.SetSymbol(*v)
+	}
+	return _u
+}
+
+// SetFillId sets the "fillId" field.
+func (_u *FillUpdate) SetFillId(v string) *FillUpdate {
+	_u.mutation.SetFillId(v)
+	return _u
+}
+
+// SetNillableFillId sets the "fillId" field if the given value is not nil.
+func (_u *FillUpdate) SetNillableFillId(v *string) *FillUpdate {
+	if v != nil {
+		_u.SetFillId(*v)
+	}
+	return _u
+}
+
+// SetOrderId sets the "orderId" field.
+func (_u *FillUpdate) SetOrderId(v string) *FillUpdate {
+	_u.mutation.SetOrderId(v)
+	return _u
+}
+
+// SetNillableOrderId sets the "orderId" field if the given value is not nil.
+func (_u *FillUpdate) SetNillableOrderId(v *string) *FillUpdate {
+	if v != nil {
+		_u.SetOrderId(*v)
+	}
+	return _u
+}
+
+// SetClientOrderId sets the "clientOrderId" field.
+func (_u *FillUpdate) SetClientOrderId(v string) *FillUpdate {
+	_u.mutation.SetClientOrderId(v)
+	return _u
+}
+
+// SetNillableClientOrderId sets the "clientOrderId" field if the given value is not nil.
+func (_u *FillUpdate) SetNillableClientOrderId(v *string) *FillUpdate {
+	if v != nil {
+		_u.SetClientOrderId(*v)
+	}
+	return _u
+}
+
+// ClearClientOrderId clears the value of the "clientOrderId" field.
+func (_u *FillUpdate) ClearClientOrderId() *FillUpdate {
+	_u.mutation.ClearClientOrderId()
+	return _u
+}
+
+// SetSide sets the "side" field.
+func (_u *FillUpdate) SetSide(v fill.Side) *FillUpdate {
+	_u.mutation.SetSide(v)
+	return _u
+}
+
+// SetNillableSide sets the "side" field if the given value is not nil.
+func (_u *FillUpdate) SetNillableSide(v *fill.Side) *FillUpdate {
+	if v != nil {
+		_u.SetSide(*v)
+	}
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *FillUpdate) SetPrice(v decimal.Decimal) *FillUpdate {
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *FillUpdate) SetNillablePrice(v *decimal.Decimal) *FillUpdate {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// SetBaseAmount sets the "baseAmount" field.
+func (_u *FillUpdate) SetBaseAmount(v decimal.Decimal) *FillUpdate {
+	_u.mutation.SetBaseAmount(v)
+	return _u
+}
+
+// SetNillableBaseAmount sets the "baseAmount" field if the given value is not nil.
+func (_u *FillUpdate) SetNillableBaseAmount(v *decimal.Decimal) *FillUpdate {
+	if v != nil {
+		_u.SetBaseAmount(*v)
+	}
+	return _u
+}
+
+// SetQuoteAmount sets the "quoteAmount" field.
+func (_u *FillUpdate) SetQuoteAmount(v decimal.Decimal) *FillUpdate {
+	_u.mutation.SetQuoteAmount(v)
+	return _u
+}
+
+// SetNillableQuoteAmount sets the "quoteAmount" field if the given value is not nil.
+func (_u *FillUpdate) SetNillableQuoteAmount(v *decimal.Decimal) *FillUpdate {
+	if v != nil {
+		_u.SetQuoteAmount(*v)
+	}
+	return _u
+}
+
+// SetFee sets the "fee" field.
+func (_u *FillUpdate) SetFee(v decimal.Decimal) *FillUpdate {
+	_u.mutation.SetFee(v)
+	return _u
+}
+
+// SetNillableFee sets the "fee" field if the given value is not nil.
+func (_u *FillUpdate) SetNillableFee(v *decimal.Decimal) *FillUpdate {
+	if v != nil {
+		_u.SetFee(*v)
+	}
+	return _u
+}
+
+// SetFeeAsset sets the "feeAsset" field.
+func (_u *FillUpdate) SetFeeAsset(v string) *FillUpdate {
+	_u.mutation.SetFeeAsset(v)
+	return _u
+}
+
+// SetNillableFeeAsset sets the "feeAsset" field if the given value is not nil.
+func (_u *FillUpdate) SetNillableFeeAsset(v *string) *FillUpdate {
+	if v != nil {
+		_u.SetFeeAsset(*v)
+	}
+	return _u
+}
+
+// SetIsMaker sets the "isMaker" field.
+func (_u *FillUpdate) SetIsMaker(v bool) *FillUpdate {
+	_u.mutation.SetIsMaker(v)
+	return _u
+}
+
+// SetNillableIsMaker sets the "isMaker" field if the given value is not nil.
+func (_u *FillUpdate) SetNillableIsMaker(v *bool) *FillUpdate {
+	if v != nil {
+		_u.SetIsMaker(*v)
+	}
+	return _u
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (_u *FillUpdate) SetTimestamp(v int64) *FillUpdate {
+	_u.mutation.ResetTimestamp()
+	_u.mutation.SetTimestamp(v)
+	return _u
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (_u *FillUpdate) SetNillableTimestamp(v *int64) *FillUpdate {
+	if v != nil {
+		_u.SetTimestamp(*v)
+	}
+	return _u
+}
+
+// AddTimestamp adds value to the "timestamp" field.
+func (_u *FillUpdate) AddTimestamp(v int64) *FillUpdate {
+	_u.mutation.AddTimestamp(v)
+	return _u
+}
+
+// Mutation returns the FillMutation object of the builder.
+func (_u *FillUpdate) Mutation() *FillMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FillUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FillUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FillUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FillUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *FillUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := fill.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FillUpdate) check() error {
+	if v, ok := _u.mutation.Exchange(); ok {
+		if err := fill.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "Fill.exchange": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Symbol(); ok {
+		if err := fill.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "Fill.symbol": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Side(); ok {
+		if err := fill.SideValidator(v); err != nil {
+			return &ValidationError{Name: "side", err: fmt.Errorf(`ent: validator failed for field "Fill.side": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FeeAsset(); ok {
+		if err := fill.FeeAssetValidator(v); err != nil {
+			return &ValidationError{Name: "feeAsset", err: fmt.Errorf(`ent: validator failed for field "Fill.feeAsset": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *FillUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(fill.Table, fill.Columns, sqlgraph.NewFieldSpec(fill.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(fill.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Exchange(); ok {
+		_spec.SetField(fill.FieldExchange, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Account(); ok {
+		_spec.SetField(fill.FieldAccount, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Symbol(); ok {
+		_spec.SetField(fill.FieldSymbol, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FillId(); ok {
+		_spec.SetField(fill.FieldFillId, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OrderId(); ok {
+		_spec.SetField(fill.FieldOrderId, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientOrderId(); ok {
+		_spec.SetField(fill.FieldClientOrderId, field.TypeString, value)
+	}
+	if _u.mutation.ClientOrderIdCleared() {
+		_spec.ClearField(fill.FieldClientOrderId, field.TypeString)
+	}
+	if value, ok := _u.mutation.Side(); ok {
+		_spec.SetField(fill.FieldSide, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(fill.FieldPrice, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BaseAmount(); ok {
+		_spec.SetField(fill.FieldBaseAmount, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.QuoteAmount(); ok {
+		_spec.SetField(fill.FieldQuoteAmount, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Fee(); ok {
+		_spec.SetField(fill.FieldFee, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FeeAsset(); ok {
+		_spec.SetField(fill.FieldFeeAsset, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IsMaker(); ok {
+		_spec.SetField(fill.FieldIsMaker, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Timestamp(); ok {
+		_spec.SetField(fill.FieldTimestamp, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTimestamp(); ok {
+		_spec.AddField(fill.FieldTimestamp, field.TypeInt64, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fill.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FillUpdateOne is the builder for updating a single Fill entity.
+type FillUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FillMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *FillUpdateOne) SetUpdateTime(v time.Time) *FillUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetExchange sets the "exchange" field.
+func (_u *FillUpdateOne) SetExchange(v string) *FillUpdateOne {
+	_u.mutation.SetExchange(v)
+	return _u
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_u *FillUpdateOne) SetNillableExchange(v *string) *FillUpdateOne {
+	if v != nil {
+		_u.SetExchange(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" field.
+func (_u *FillUpdateOne) SetAccount(v string) *FillUpdateOne {
+	_u.mutation.SetAccount(v)
+	return _u
+}
+
+// SetNillableAccount sets the "account" field if the given value is not nil.
+func (_u *FillUpdateOne) SetNillableAccount(v *string) *FillUpdateOne {
+	if v != nil {
+		_u.SetAccount(*v)
+	}
+	return _u
+}
+
+// SetSymbol sets the "symbol" field.
+func (_u *FillUpdateOne) SetSymbol(v string) *FillUpdateOne {
+	_u.mutation.SetSymbol(v)
+	return _u
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_u *FillUpdateOne) SetNillableSymbol(v *string) *FillUpdateOne {
+	if v != nil {
+		_u.SetSymbol(*v)
+	}
+	return _u
+}
+
+// SetFillId sets the "fillId" field.
+func (_u *FillUpdateOne) SetFillId(v string) *FillUpdateOne {
+	_u.mutation.SetFillId(v)
+	return _u
+}
+
+// SetNillableFillId sets the "fillId" field if the given value is not nil.
+func (_u *FillUpdateOne) SetNillableFillId(v *string) *FillUpdateOne {
+	if v != nil {
+		_u.SetFillId(*v)
+	}
+	return _u
+}
+
+// SetOrderId sets the "orderId" field.
+func (_u *FillUpdateOne) SetOrderId(v string) *FillUpdateOne {
+	_u.mutation.SetOrderId(v)
+	return _u
+}
+
+// SetNillableOrderId sets the "orderId" field if the given value is not nil.
+func (_u *FillUpdateOne) SetNillableOrderId(v *string) *FillUpdateOne {
+	if v != nil {
+		_u.SetOrderId(*v)
+	}
+	return _u
+}
+
+// SetClientOrderId sets the "clientOrderId" field.
+func (_u *FillUpdateOne) SetClientOrderId(v string) *FillUpdateOne {
+	_u.mutation.SetClientOrderId(v)
+	return _u
+}
+
+// SetNillableClientOrderId sets the "clientOrderId" field if the given value is not nil.
+func (_u *FillUpdateOne) SetNillableClientOrderId(v *string) *FillUpdateOne {
+	if v != nil {
+		_u.SetClientOrderId(*v)
+	}
+	return _u
+}
+
+// ClearClientOrderId clears the value of the "clientOrderId" field.
+func (_u *FillUpdateOne) ClearClientOrderId() *FillUpdateOne {
+	_u.mutation.ClearClientOrderId()
+	return _u
+}
+
+// SetSide sets the "side" field.
+func (_u *FillUpdateOne) SetSide(v fill.Side) *FillUpdateOne {
+	_u.mutation.SetSide(v)
+	return _u
+}
+
+// SetNillableSide sets the "side" field if the given value is not nil.
+func (_u *FillUpdateOne) SetNillableSide(v *fill.Side) *FillUpdateOne {
+	if v != nil {
+		_u.SetSide(*v)
+	}
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *FillUpdateOne) SetPrice(v decimal.Decimal) *FillUpdateOne {
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *FillUpdateOne) SetNillablePrice(v *decimal.Decimal) *FillUpdateOne {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// SetBaseAmount sets the "baseAmount" field.
+func (_u *FillUpdateOne) SetBaseAmount(v decimal.Decimal) *FillUpdateOne {
+	_u.mutation.SetBaseAmount(v)
+	return _u
+}
+
+// SetNillableBaseAmount sets the "baseAmount" field if the given value is not nil.
+func (_u *FillUpdateOne) SetNillableBaseAmount(v *decimal.Decimal) *FillUpdateOne {
+	if v != nil {
+		_u.SetBaseAmount(*v)
+	}
+	return _u
+}
+
+// SetQuoteAmount sets the "quoteAmount" field.
+func (_u *FillUpdateOne) SetQuoteAmount(v decimal.Decimal) *FillUpdateOne {
+	_u.mutation.SetQuoteAmount(v)
+	return _u
+}
+
+// SetNillableQuoteAmount sets the "quoteAmount" field if the given value is not nil.
+func (_u *FillUpdateOne) SetNillableQuoteAmount(v *decimal.Decimal) *FillUpdateOne {
+	if v != nil {
+		_u.SetQuoteAmount(*v)
+	}
+	return _u
+}
+
+// SetFee sets the "fee" field.
+func (_u *FillUpdateOne) SetFee(v decimal.Decimal) *FillUpdateOne {
+	_u.mutation.SetFee(v)
+	return _u
+}
+
+// SetNillableFee sets the "fee" field if the given value is not nil.
+func (_u *FillUpdateOne) SetNillableFee(v *decimal.Decimal) *FillUpdateOne {
+	if v != nil {
+		_u.SetFee(*v)
+	}
+	return _u
+}
+
+// SetFeeAsset sets the "feeAsset" field.
+func (_u *FillUpdateOne) SetFeeAsset(v string) *FillUpdateOne {
+	_u.mutation.SetFeeAsset(v)
+	return _u
+}
+
+// SetNillableFeeAsset sets the "feeAsset" field if the given value is not nil.
+func (_u *FillUpdateOne) SetNillableFeeAsset(v *string) *FillUpdateOne {
+	if v != nil {
+		_u.SetFeeAsset(*v)
+	}
+	return _u
+}
+
+// SetIsMaker sets the "isMaker" field.
+func (_u *FillUpdateOne) SetIsMaker(v bool) *FillUpdateOne {
+	_u.mutation.SetIsMaker(v)
+	return _u
+}
+
+// SetNillableIsMaker sets the "isMaker" field if the given value is not nil.
+func (_u *FillUpdateOne) SetNillableIsMaker(v *bool) *FillUpdateOne {
+	if v != nil {
+		_u.SetIsMaker(*v)
+	}
+	return _u
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (_u *FillUpdateOne) SetTimestamp(v int64) *FillUpdateOne {
+	_u.mutation.ResetTimestamp()
+	_u.mutation.SetTimestamp(v)
+	return _u
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (_u *FillUpdateOne) SetNillableTimestamp(v *int64) *FillUpdateOne {
+	if v != nil {
+		_u.SetTimestamp(*v)
+	}
+	return _u
+}
+
+// AddTimestamp adds value to the "timestamp" field.
+func (_u *FillUpdateOne) AddTimestamp(v int64) *FillUpdateOne {
+	_u.mutation.AddTimestamp(v)
+	return _u
+}
+
+// Mutation returns the FillMutation object of the builder.
+func (_u *FillUpdateOne) Mutation() *FillMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the FillUpdate builder.
+func (_u *FillUpdateOne) Where(ps ...predicate.Fill) *FillUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FillUpdateOne) Select(field string, fields ...string) *FillUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Fill entity.
+func (_u *FillUpdateOne) Save(ctx context.Context) (*Fill, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FillUpdateOne) SaveX(ctx context.Context) *Fill {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FillUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FillUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *FillUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := fill.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FillUpdateOne) check() error {
+	if v, ok := _u.mutation.Exchange(); ok {
+		if err := fill.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "Fill.exchange": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Symbol(); ok {
+		if err := fill.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "Fill.symbol": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Side(); ok {
+		if err := fill.SideValidator(v); err != nil {
+			return &ValidationError{Name: "side", err: fmt.Errorf(`ent: validator failed for field "Fill.side": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FeeAsset(); ok {
+		if err := fill.FeeAssetValidator(v); err != nil {
+			return &ValidationError{Name: "feeAsset", err: fmt.Errorf(`ent: validator failed for field "Fill.feeAsset": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *FillUpdateOne) sqlSave(ctx context.Context) (_node *Fill, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(fill.Table, fill.Columns, sqlgraph.NewFieldSpec(fill.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Fill.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, fill.FieldID)
+		for _, f := range fields {
+			if !fill.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != fill.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(fill.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Exchange(); ok {
+		_spec.SetField(fill.FieldExchange, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Account(); ok {
+		_spec.SetField(fill.FieldAccount, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Symbol(); ok {
+		_spec.SetField(fill.FieldSymbol, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FillId(); ok {
+		_spec.SetField(fill.FieldFillId, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OrderId(); ok {
+		_spec.SetField(fill.FieldOrderId, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientOrderId(); ok {
+		_spec.SetField(fill.FieldClientOrderId, field.TypeString, value)
+	}
+	if _u.mutation.ClientOrderIdCleared() {
+		_spec.ClearField(fill.FieldClientOrderId, field.TypeString)
+	}
+	if value, ok := _u.mutation.Side(); ok {
+		_spec.SetField(fill.FieldSide, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(fill.FieldPrice, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BaseAmount(); ok {
+		_spec.SetField(fill.FieldBaseAmount, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.QuoteAmount(); ok {
+		_spec.SetField(fill.FieldQuoteAmount, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Fee(); ok {
+		_spec.SetField(fill.FieldFee, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FeeAsset(); ok {
+		_spec.SetField(fill.FieldFeeAsset, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IsMaker(); ok {
+		_spec.SetField(fill.FieldIsMaker, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Timestamp(); ok {
+		_spec.SetField(fill.FieldTimestamp, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTimestamp(); ok {
+		_spec.AddField(fill.FieldTimestamp, field.TypeInt64, value)
+	}
+	_node = &Fill{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fill.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,236 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/fachebot/omni-grid-bot/internal/ent/fundingpayment"
+	"github.com/shopspring/decimal"
+)
+
+// FundingPayment is the model entity for the FundingPayment schema.
+type FundingPayment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Exchange holds the value of the "exchange" field.
+	Exchange string `json:"exchange,omitempty"`
+	// Account holds the value of the "account" field.
+	Account string `json:"account,omitempty"`
+	// Symbol holds the value of the "symbol" field.
+	Symbol string `json:"symbol,omitempty"`
+	// PaymentId holds the value of the "paymentId" field.
+	PaymentId string `json:"paymentId,omitempty"`
+	// StrategyId holds the value of the "strategyId" field.
+	StrategyId *string `json:"strategyId,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// Rate holds the value of the "rate" field.
+	Rate decimal.Decimal `json:"rate,omitempty"`
+	// PositionSize holds the value of the "positionSize" field.
+	PositionSize decimal.Decimal `json:"positionSize,omitempty"`
+	// Timestamp holds the value of the "timestamp" field.
+	Timestamp int64 `json:"timestamp,omitempty"`
+	// RunId holds the value of the "runId" field.
+	RunId        *int `json:"runId,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FundingPayment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case fundingpayment.FieldAmount, fundingpayment.FieldRate, fundingpayment.FieldPositionSize:
+			values[i] = new(decimal.Decimal)
+		case fundingpayment.FieldID, fundingpayment.FieldTimestamp, fundingpayment.FieldRunId:
+			values[i] = new(sql.NullInt64)
+		case fundingpayment.FieldExchange, fundingpayment.FieldAccount, fundingpayment.FieldSymbol, fundingpayment.FieldPaymentId, fundingpayment.FieldStrategyId:
+			values[i] = new(sql.NullString)
+		case fundingpayment.FieldCreateTime, fundingpayment.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FundingPayment fields.
+func (_m *FundingPayment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case fundingpayment.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case fundingpayment.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case fundingpayment.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case fundingpayment.FieldExchange:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange", values[i])
+			} else if value.Valid {
+				_m.Exchange = value.String
+			}
+		case fundingpayment.FieldAccount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account", values[i])
+			} else if value.Valid {
+				_m.Account = value.String
+			}
+		case fundingpayment.FieldSymbol:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field symbol", values[i])
+			} else if value.Valid {
+				_m.Symbol = value.String
+			}
+		case fundingpayment.FieldPaymentId:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field paymentId", values[i])
+			} else if value.Valid {
+				_m.PaymentId = value.String
+			}
+		case fundingpayment.FieldStrategyId:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field strategyId", values[i])
+			} else if value.Valid {
+				_m.StrategyId = new(string)
+				*_m.StrategyId = value.String
+			}
+		case fundingpayment.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				_m.Amount = *value
+			}
+		case fundingpayment.FieldRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field rate", values[i])
+			} else if value != nil {
+				_m.Rate = *value
+			}
+		case fundingpayment.FieldPositionSize:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field positionSize", values[i])
+			} else if value != nil {
+				_m.PositionSize = *value
+			}
+		case fundingpayment.FieldTimestamp:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field timestamp", values[i])
+			} else if value.Valid {
+				_m.Timestamp = value.Int64
+			}
+		case fundingpayment.FieldRunId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field runId", values[i])
+			} else if value.Valid {
+				_m.RunId = new(int)
+				*_m.RunId = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FundingPayment.
+// This includes values selected through modifiers, order, etc.
+func (_m *FundingPayment) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this FundingPayment.
+// Note that you need to call FundingPayment.Unwrap() before calling this method if this FundingPayment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *FundingPayment) Update() *FundingPaymentUpdateOne {
+	return NewFundingPaymentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the FundingPayment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *FundingPayment) Unwrap() *FundingPayment {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FundingPayment is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *FundingPayment) String() string {
+	var builder strings.Builder
+	builder.WriteString("FundingPayment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("exchange=")
+	builder.WriteString(_m.Exchange)
+	builder.WriteString(", ")
+	builder.WriteString("account=")
+	builder.WriteString(_m.Account)
+	builder.WriteString(", ")
+	builder.WriteString("symbol=")
+	builder.WriteString(_m.Symbol)
+	builder.WriteString(", ")
+	builder.WriteString("paymentId=")
+	builder.WriteString(_m.PaymentId)
+	builder.WriteString(", ")
+	if v := _m.StrategyId; v != nil {
+		builder.WriteString("strategyId=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Rate))
+	builder.WriteString(", ")
+	builder.WriteString("positionSize=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PositionSize))
+	builder.WriteString(", ")
+	builder.WriteString("timestamp=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Timestamp))
+	builder.WriteString(", ")
+	if v := _m.RunId; v != nil {
+		builder.WriteString("runId=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FundingPayments is a parsable slice of FundingPayment.
+type FundingPayments []*FundingPayment
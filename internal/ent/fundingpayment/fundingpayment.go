@@ -0,0 +1,152 @@
+// Code generated by ent, DO NOT EDIT.
+
+package fundingpayment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the fundingpayment type in the database.
+	Label = "funding_payment"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldExchange holds the string denoting the exchange field in the database.
+	FieldExchange = "exchange"
+	// FieldAccount holds the string denoting the account field in the database.
+	FieldAccount = "account"
+	// FieldSymbol holds the string denoting the symbol field in the database.
+	FieldSymbol = "symbol"
+	// FieldPaymentId holds the string denoting the paymentid field in the database.
+	FieldPaymentId = "payment_id"
+	// FieldStrategyId holds the string denoting the strategyid field in the database.
+	FieldStrategyId = "strategy_id"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldRate holds the string denoting the rate field in the database.
+	FieldRate = "rate"
+	// FieldPositionSize holds the string denoting the positionsize field in the database.
+	FieldPositionSize = "position_size"
+	// FieldTimestamp holds the string denoting the timestamp field in the database.
+	FieldTimestamp = "timestamp"
+	// FieldRunId holds the string denoting the runid field in the database.
+	FieldRunId = "run_id"
+	// Table holds the table name of the fundingpayment in the database.
+	Table = "funding_payments"
+)
+
+// Columns holds all SQL columns for fundingpayment fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldExchange,
+	FieldAccount,
+	FieldSymbol,
+	FieldPaymentId,
+	FieldStrategyId,
+	FieldAmount,
+	FieldRate,
+	FieldPositionSize,
+	FieldTimestamp,
+	FieldRunId,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// ExchangeValidator is a validator for the "exchange" field. It is called by the builders before save.
+	ExchangeValidator func(string) error
+	// SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
+	SymbolValidator func(string) error
+	// StrategyIdValidator is a validator for the "strategyId" field. It is called by the builders before save.
+	StrategyIdValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the FundingPayment queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByExchange orders the results by the exchange field.
+func ByExchange(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExchange, opts...).ToFunc()
+}
+
+// ByAccount orders the results by the account field.
+func ByAccount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccount, opts...).ToFunc()
+}
+
+// BySymbol orders the results by the symbol field.
+func BySymbol(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSymbol, opts...).ToFunc()
+}
+
+// ByPaymentId orders the results by the paymentId field.
+func ByPaymentId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaymentId, opts...).ToFunc()
+}
+
+// ByStrategyId orders the results by the strategyId field.
+func ByStrategyId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStrategyId, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByRate orders the results by the rate field.
+func ByRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRate, opts...).ToFunc()
+}
+
+// ByPositionSize orders the results by the positionSize field.
+func ByPositionSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPositionSize, opts...).ToFunc()
+}
+
+// ByTimestamp orders the results by the timestamp field.
+func ByTimestamp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimestamp, opts...).ToFunc()
+}
+
+// ByRunId orders the results by the runId field.
+func ByRunId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunId, opts...).ToFunc()
+}
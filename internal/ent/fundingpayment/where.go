@@ -0,0 +1,846 @@
+// Code generated by ent, DO NOT EDIT.
+
+package fundingpayment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/fachebot/omni-grid-bot/internal/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// Exchange applies equality check predicate on the "exchange" field. It's identical to ExchangeEQ.
+func Exchange(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEQ(FieldExchange, v))
+}
+
+// Account applies equality check predicate on the "account" field. It's identical to AccountEQ.
+func Account(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEQ(FieldAccount, v))
+}
+
+// Symbol applies equality check predicate on the "symbol" field. It's identical to SymbolEQ.
+func Symbol(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEQ(FieldSymbol, v))
+}
+
+// PaymentId applies equality check predicate on the "paymentId" field. It's identical to PaymentIdEQ.
+func PaymentId(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEQ(FieldPaymentId, v))
+}
+
+// StrategyId applies equality check predicate on the "strategyId" field. It's identical to StrategyIdEQ.
+func StrategyId(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEQ(FieldStrategyId, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v decimal.Decimal) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEQ(FieldAmount, v))
+}
+
+// Rate applies equality check predicate on the "rate" field. It's identical to RateEQ.
+func Rate(v decimal.Decimal) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEQ(FieldRate, v))
+}
+
+// PositionSize applies equality check predicate on the "positionSize" field. It's identical to PositionSizeEQ.
+func PositionSize(v decimal.Decimal) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEQ(FieldPositionSize, v))
+}
+
+// Timestamp applies equality check predicate on the "timestamp" field. It's identical to TimestampEQ.
+func Timestamp(v int64) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// RunId applies equality check predicate on the "runId" field. It's identical to RunIdEQ.
+func RunId(v int) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEQ(FieldRunId, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// ExchangeEQ applies the EQ predicate on the "exchange" field.
+func ExchangeEQ(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEQ(FieldExchange, v))
+}
+
+// ExchangeNEQ applies the NEQ predicate on the "exchange" field.
+func ExchangeNEQ(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldNEQ(FieldExchange, v))
+}
+
+// ExchangeIn applies the In predicate on the "exchange" field.
+func ExchangeIn(vs ...string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldIn(FieldExchange, vs...))
+}
+
+// ExchangeNotIn applies the NotIn predicate on the "exchange" field.
+func ExchangeNotIn(vs ...string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldNotIn(FieldExchange, vs...))
+}
+
+// ExchangeGT applies the GT predicate on the "exchange" field.
+func ExchangeGT(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldGT(FieldExchange, v))
+}
+
+// ExchangeGTE applies the GTE predicate on the "exchange" field.
+func ExchangeGTE(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldGTE(FieldExchange, v))
+}
+
+// ExchangeLT applies the LT predicate on the "exchange" field.
+func ExchangeLT(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldLT(FieldExchange, v))
+}
+
+// ExchangeLTE applies the LTE predicate on the "exchange" field.
+func ExchangeLTE(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldLTE(FieldExchange, v))
+}
+
+// ExchangeContains applies the Contains predicate on the "exchange" field.
+func ExchangeContains(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldContains(FieldExchange, v))
+}
+
+// ExchangeHasPrefix applies the HasPrefix predicate on the "exchange" field.
+func ExchangeHasPrefix(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldHasPrefix(FieldExchange, v))
+}
+
+// ExchangeHasSuffix applies the HasSuffix predicate on the "exchange" field.
+func ExchangeHasSuffix(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldHasSuffix(FieldExchange, v))
+}
+
+// ExchangeEqualFold applies the EqualFold predicate on the "exchange" field.
+func ExchangeEqualFold(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEqualFold(FieldExchange, v))
+}
+
+// ExchangeContainsFold applies the ContainsFold predicate on the "exchange" field.
+func ExchangeContainsFold(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldContainsFold(FieldExchange, v))
+}
+
+// AccountEQ applies the EQ predicate on the "account" field.
+func AccountEQ(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEQ(FieldAccount, v))
+}
+
+// AccountNEQ applies the NEQ predicate on the "account" field.
+func AccountNEQ(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldNEQ(FieldAccount, v))
+}
+
+// AccountIn applies the In predicate on the "account" field.
+func AccountIn(vs ...string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldIn(FieldAccount, vs...))
+}
+
+// AccountNotIn applies the NotIn predicate on the "account" field.
+func AccountNotIn(vs ...string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldNotIn(FieldAccount, vs...))
+}
+
+// AccountGT applies the GT predicate on the "account" field.
+func AccountGT(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldGT(FieldAccount, v))
+}
+
+// AccountGTE applies the GTE predicate on the "account" field.
+func AccountGTE(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldGTE(FieldAccount, v))
+}
+
+// AccountLT applies the LT predicate on the "account" field.
+func AccountLT(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldLT(FieldAccount, v))
+}
+
+// AccountLTE applies the LTE predicate on the "account" field.
+func AccountLTE(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldLTE(FieldAccount, v))
+}
+
+// AccountContains applies the Contains predicate on the "account" field.
+func AccountContains(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldContains(FieldAccount, v))
+}
+
+// AccountHasPrefix applies the HasPrefix predicate on the "account" field.
+func AccountHasPrefix(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldHasPrefix(FieldAccount, v))
+}
+
+// AccountHasSuffix applies the HasSuffix predicate on the "account" field.
+func AccountHasSuffix(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldHasSuffix(FieldAccount, v))
+}
+
+// AccountEqualFold applies the EqualFold predicate on the "account" field.
+func AccountEqualFold(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEqualFold(FieldAccount, v))
+}
+
+// AccountContainsFold applies the ContainsFold predicate on the "account" field.
+func AccountContainsFold(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldContainsFold(FieldAccount, v))
+}
+
+// SymbolEQ applies the EQ predicate on the "symbol" field.
+func SymbolEQ(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEQ(FieldSymbol, v))
+}
+
+// SymbolNEQ applies the NEQ predicate on the "symbol" field.
+func SymbolNEQ(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldNEQ(FieldSymbol, v))
+}
+
+// SymbolIn applies the In predicate on the "symbol" field.
+func SymbolIn(vs ...string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldIn(FieldSymbol, vs...))
+}
+
+// SymbolNotIn applies the NotIn predicate on the "symbol" field.
+func SymbolNotIn(vs ...string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldNotIn(FieldSymbol, vs...))
+}
+
+// SymbolGT applies the GT predicate on the "symbol" field.
+func SymbolGT(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldGT(FieldSymbol, v))
+}
+
+// SymbolGTE applies the GTE predicate on the "symbol" field.
+func SymbolGTE(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldGTE(FieldSymbol, v))
+}
+
+// SymbolLT applies the LT predicate on the "symbol" field.
+func SymbolLT(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldLT(FieldSymbol, v))
+}
+
+// SymbolLTE applies the LTE predicate on the "symbol" field.
+func SymbolLTE(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldLTE(FieldSymbol, v))
+}
+
+// SymbolContains applies the Contains predicate on the "symbol" field.
+func SymbolContains(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldContains(FieldSymbol, v))
+}
+
+// SymbolHasPrefix applies the HasPrefix predicate on the "symbol" field.
+func SymbolHasPrefix(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldHasPrefix(FieldSymbol, v))
+}
+
+// SymbolHasSuffix applies the HasSuffix predicate on the "symbol" field.
+func SymbolHasSuffix(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldHasSuffix(FieldSymbol, v))
+}
+
+// SymbolEqualFold applies the EqualFold predicate on the "symbol" field.
+func SymbolEqualFold(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEqualFold(FieldSymbol, v))
+}
+
+// SymbolContainsFold applies the ContainsFold predicate on the "symbol" field.
+func SymbolContainsFold(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldContainsFold(FieldSymbol, v))
+}
+
+// PaymentIdEQ applies the EQ predicate on the "paymentId" field.
+func PaymentIdEQ(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEQ(FieldPaymentId, v))
+}
+
+// PaymentIdNEQ applies the NEQ predicate on the "paymentId" field.
+func PaymentIdNEQ(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldNEQ(FieldPaymentId, v))
+}
+
+// PaymentIdIn applies the In predicate on the "paymentId" field.
+func PaymentIdIn(vs ...string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldIn(FieldPaymentId, vs...))
+}
+
+// PaymentIdNotIn applies the NotIn predicate on the "paymentId" field.
+func PaymentIdNotIn(vs ...string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldNotIn(FieldPaymentId, vs...))
+}
+
+// PaymentIdGT applies the GT predicate on the "paymentId" field.
+func PaymentIdGT(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldGT(FieldPaymentId, v))
+}
+
+// PaymentIdGTE applies the GTE predicate on the "paymentId" field.
+func PaymentIdGTE(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldGTE(FieldPaymentId, v))
+}
+
+// PaymentIdLT applies the LT predicate on the "paymentId" field.
+func PaymentIdLT(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldLT(FieldPaymentId, v))
+}
+
+// PaymentIdLTE applies the LTE predicate on the "paymentId" field.
+func PaymentIdLTE(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldLTE(FieldPaymentId, v))
+}
+
+// PaymentIdContains applies the Contains predicate on the "paymentId" field.
+func PaymentIdContains(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldContains(FieldPaymentId, v))
+}
+
+// PaymentIdHasPrefix applies the HasPrefix predicate on the "paymentId" field.
+func PaymentIdHasPrefix(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldHasPrefix(FieldPaymentId, v))
+}
+
+// PaymentIdHasSuffix applies the HasSuffix predicate on the "paymentId" field.
+func PaymentIdHasSuffix(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldHasSuffix(FieldPaymentId, v))
+}
+
+// PaymentIdEqualFold applies the EqualFold predicate on the "paymentId" field.
+func PaymentIdEqualFold(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEqualFold(FieldPaymentId, v))
+}
+
+// PaymentIdContainsFold applies the ContainsFold predicate on the "paymentId" field.
+func PaymentIdContainsFold(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldContainsFold(FieldPaymentId, v))
+}
+
+// StrategyIdEQ applies the EQ predicate on the "strategyId" field.
+func StrategyIdEQ(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEQ(FieldStrategyId, v))
+}
+
+// StrategyIdNEQ applies the NEQ predicate on the "strategyId" field.
+func StrategyIdNEQ(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldNEQ(FieldStrategyId, v))
+}
+
+// StrategyIdIn applies the In predicate on the "strategyId" field.
+func StrategyIdIn(vs ...string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldIn(FieldStrategyId, vs...))
+}
+
+// StrategyIdNotIn applies the NotIn predicate on the "strategyId" field.
+func StrategyIdNotIn(vs ...string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldNotIn(FieldStrategyId, vs...))
+}
+
+// StrategyIdGT applies the GT predicate on the "strategyId" field.
+func StrategyIdGT(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldGT(FieldStrategyId, v))
+}
+
+// StrategyIdGTE applies the GTE predicate on the "strategyId" field.
+func StrategyIdGTE(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldGTE(FieldStrategyId, v))
+}
+
+// StrategyIdLT applies the LT predicate on the "strategyId" field.
+func StrategyIdLT(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldLT(FieldStrategyId, v))
+}
+
+// StrategyIdLTE applies the LTE predicate on the "strategyId" field.
+func StrategyIdLTE(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldLTE(FieldStrategyId, v))
+}
+
+// StrategyIdContains applies the Contains predicate on the "strategyId" field.
+func StrategyIdContains(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldContains(FieldStrategyId, v))
+}
+
+// StrategyIdHasPrefix applies the HasPrefix predicate on the "strategyId" field.
+func StrategyIdHasPrefix(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldHasPrefix(FieldStrategyId, v))
+}
+
+// StrategyIdHasSuffix applies the HasSuffix predicate on the "strategyId" field.
+func StrategyIdHasSuffix(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldHasSuffix(FieldStrategyId, v))
+}
+
+// StrategyIdIsNil applies the IsNil predicate on the "strategyId" field.
+func StrategyIdIsNil() predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldIsNull(FieldStrategyId))
+}
+
+// StrategyIdNotNil applies the NotNil predicate on the "strategyId" field.
+func StrategyIdNotNil() predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldNotNull(FieldStrategyId))
+}
+
+// StrategyIdEqualFold applies the EqualFold predicate on the "strategyId" field.
+func StrategyIdEqualFold(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEqualFold(FieldStrategyId, v))
+}
+
+// StrategyIdContainsFold applies the ContainsFold predicate on the "strategyId" field.
+func StrategyIdContainsFold(v string) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldContainsFold(FieldStrategyId, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v decimal.Decimal) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v decimal.Decimal) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...decimal.Decimal) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...decimal.Decimal) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v decimal.Decimal) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v decimal.Decimal) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v decimal.Decimal) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v decimal.Decimal) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldLTE(FieldAmount, v))
+}
+
+// AmountContains applies the Contains predicate on the "amount" field.
+func AmountContains(v decimal.Decimal) predicate.FundingPayment {
+	vc := v.String()
+	return predicate.FundingPayment(sql.FieldContains(FieldAmount, vc))
+}
+
+// AmountHasPrefix applies the HasPrefix predicate on the "amount" field.
+func AmountHasPrefix(v decimal.Decimal) predicate.FundingPayment {
+	vc := v.String()
+	return predicate.FundingPayment(sql.FieldHasPrefix(FieldAmount, vc))
+}
+
+// AmountHasSuffix applies the HasSuffix predicate on the "amount" field.
+func AmountHasSuffix(v decimal.Decimal) predicate.FundingPayment {
+	vc := v.String()
+	return predicate.FundingPayment(sql.FieldHasSuffix(FieldAmount, vc))
+}
+
+// AmountEqualFold applies the EqualFold predicate on the "amount" field.
+func AmountEqualFold(v decimal.Decimal) predicate.FundingPayment {
+	vc := v.String()
+	return predicate.FundingPayment(sql.FieldEqualFold(FieldAmount, vc))
+}
+
+// AmountContainsFold applies the ContainsFold predicate on the "amount" field.
+func AmountContainsFold(v decimal.Decimal) predicate.FundingPayment {
+	vc := v.String()
+	return predicate.FundingPayment(sql.FieldContainsFold(FieldAmount, vc))
+}
+
+// RateEQ applies the EQ predicate on the "rate" field.
+func RateEQ(v decimal.Decimal) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEQ(FieldRate, v))
+}
+
+// RateNEQ applies the NEQ predicate on the "rate" field.
+func RateNEQ(v decimal.Decimal) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldNEQ(FieldRate, v))
+}
+
+// RateIn applies the In predicate on the "rate" field.
+func RateIn(vs ...decimal.Decimal) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldIn(FieldRate, vs...))
+}
+
+// RateNotIn applies the NotIn predicate on the "rate" field.
+func RateNotIn(vs ...decimal.Decimal) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldNotIn(FieldRate, vs...))
+}
+
+// RateGT applies the GT predicate on the "rate" field.
+func RateGT(v decimal.Decimal) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldGT(FieldRate, v))
+}
+
+// RateGTE applies the GTE predicate on the "rate" field.
+func RateGTE(v decimal.Decimal) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldGTE(FieldRate, v))
+}
+
+// RateLT applies the LT predicate on the "rate" field.
+func RateLT(v decimal.Decimal) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldLT(FieldRate, v))
+}
+
+// RateLTE applies the LTE predicate on the "rate" field.
+func RateLTE(v decimal.Decimal) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldLTE(FieldRate, v))
+}
+
+// RateContains applies the Contains predicate on the "rate" field.
+func RateContains(v decimal.Decimal) predicate.FundingPayment {
+	vc := v.String()
+	return predicate.FundingPayment(sql.FieldContains(FieldRate, vc))
+}
+
+// RateHasPrefix applies the HasPrefix predicate on the "rate" field.
+func RateHasPrefix(v decimal.Decimal) predicate.FundingPayment {
+	vc := v.String()
+	return predicate.FundingPayment(sql.FieldHasPrefix(FieldRate, vc))
+}
+
+// RateHasSuffix applies the HasSuffix predicate on the "rate" field.
+func RateHasSuffix(v decimal.Decimal) predicate.FundingPayment {
+	vc := v.String()
+	return predicate.FundingPayment(sql.FieldHasSuffix(FieldRate, vc))
+}
+
+// RateEqualFold applies the EqualFold predicate on the "rate" field.
+func RateEqualFold(v decimal.Decimal) predicate.FundingPayment {
+	vc := v.String()
+	return predicate.FundingPayment(sql.FieldEqualFold(FieldRate, vc))
+}
+
+// RateContainsFold applies the ContainsFold predicate on the "rate" field.
+func RateContainsFold(v decimal.Decimal) predicate.FundingPayment {
+	vc := v.String()
+	return predicate.FundingPayment(sql.FieldContainsFold(FieldRate, vc))
+}
+
+// PositionSizeEQ applies the EQ predicate on the "positionSize" field.
+func PositionSizeEQ(v decimal.Decimal) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEQ(FieldPositionSize, v))
+}
+
+// PositionSizeNEQ applies the NEQ predicate on the "positionSize" field.
+func PositionSizeNEQ(v decimal.Decimal) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldNEQ(FieldPositionSize, v))
+}
+
+// PositionSizeIn applies the In predicate on the "positionSize" field.
+func PositionSizeIn(vs ...decimal.Decimal) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldIn(FieldPositionSize, vs...))
+}
+
+// PositionSizeNotIn applies the NotIn predicate on the "positionSize" field.
+func PositionSizeNotIn(vs ...decimal.Decimal) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldNotIn(FieldPositionSize, vs...))
+}
+
+// PositionSizeGT applies the GT predicate on the "positionSize" field.
+func PositionSizeGT(v decimal.Decimal) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldGT(FieldPositionSize, v))
+}
+
+// PositionSizeGTE applies the GTE predicate on the "positionSize" field.
+func PositionSizeGTE(v decimal.Decimal) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldGTE(FieldPositionSize, v))
+}
+
+// PositionSizeLT applies the LT predicate on the "positionSize" field.
+func PositionSizeLT(v decimal.Decimal) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldLT(FieldPositionSize, v))
+}
+
+// PositionSizeLTE applies the LTE predicate on the "positionSize" field.
+func PositionSizeLTE(v decimal.Decimal) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldLTE(FieldPositionSize, v))
+}
+
+// PositionSizeContains applies the Contains predicate on the "positionSize" field.
+func PositionSizeContains(v decimal.Decimal) predicate.FundingPayment {
+	vc := v.String()
+	return predicate.FundingPayment(sql.FieldContains(FieldPositionSize, vc))
+}
+
+// PositionSizeHasPrefix applies the HasPrefix predicate on the "positionSize" field.
+func PositionSizeHasPrefix(v decimal.Decimal) predicate.FundingPayment {
+	vc := v.String()
+	return predicate.FundingPayment(sql.FieldHasPrefix(FieldPositionSize, vc))
+}
+
+// PositionSizeHasSuffix applies the HasSuffix predicate on the "positionSize" field.
+func PositionSizeHasSuffix(v decimal.Decimal) predicate.FundingPayment {
+	vc := v.String()
+	return predicate.FundingPayment(sql.FieldHasSuffix(FieldPositionSize, vc))
+}
+
+// PositionSizeEqualFold applies the EqualFold predicate on the "positionSize" field.
+func PositionSizeEqualFold(v decimal.Decimal) predicate.FundingPayment {
+	vc := v.String()
+	return predicate.FundingPayment(sql.FieldEqualFold(FieldPositionSize, vc))
+}
+
+// PositionSizeContainsFold applies the ContainsFold predicate on the "positionSize" field.
+func PositionSizeContainsFold(v decimal.Decimal) predicate.FundingPayment {
+	vc := v.String()
+	return predicate.FundingPayment(sql.FieldContainsFold(FieldPositionSize, vc))
+}
+
+// TimestampEQ applies the EQ predicate on the "timestamp" field.
+func TimestampEQ(v int64) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// TimestampNEQ applies the NEQ predicate on the "timestamp" field.
+func TimestampNEQ(v int64) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldNEQ(FieldTimestamp, v))
+}
+
+// TimestampIn applies the In predicate on the "timestamp" field.
+func TimestampIn(vs ...int64) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldIn(FieldTimestamp, vs...))
+}
+
+// TimestampNotIn applies the NotIn predicate on the "timestamp" field.
+func TimestampNotIn(vs ...int64) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldNotIn(FieldTimestamp, vs...))
+}
+
+// TimestampGT applies the GT predicate on the "timestamp" field.
+func TimestampGT(v int64) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldGT(FieldTimestamp, v))
+}
+
+// TimestampGTE applies the GTE predicate on the "timestamp" field.
+func TimestampGTE(v int64) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldGTE(FieldTimestamp, v))
+}
+
+// TimestampLT applies the LT predicate on the "timestamp" field.
+func TimestampLT(v int64) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldLT(FieldTimestamp, v))
+}
+
+// TimestampLTE applies the LTE predicate on the "timestamp" field.
+func TimestampLTE(v int64) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldLTE(FieldTimestamp, v))
+}
+
+// RunIdEQ applies the EQ predicate on the "runId" field.
+func RunIdEQ(v int) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldEQ(FieldRunId, v))
+}
+
+// RunIdNEQ applies the NEQ predicate on the "runId" field.
+func RunIdNEQ(v int) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldNEQ(FieldRunId, v))
+}
+
+// RunIdIn applies the In predicate on the "runId" field.
+func RunIdIn(vs ...int) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldIn(FieldRunId, vs...))
+}
+
+// RunIdNotIn applies the NotIn predicate on the "runId" field.
+func RunIdNotIn(vs ...int) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldNotIn(FieldRunId, vs...))
+}
+
+// RunIdGT applies the GT predicate on the "runId" field.
+func RunIdGT(v int) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldGT(FieldRunId, v))
+}
+
+// RunIdGTE applies the GTE predicate on the "runId" field.
+func RunIdGTE(v int) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldGTE(FieldRunId, v))
+}
+
+// RunIdLT applies the LT predicate on the "runId" field.
+func RunIdLT(v int) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldLT(FieldRunId, v))
+}
+
+// RunIdLTE applies the LTE predicate on the "runId" field.
+func RunIdLTE(v int) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldLTE(FieldRunId, v))
+}
+
+// RunIdIsNil applies the IsNil predicate on the "runId" field.
+func RunIdIsNil() predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldIsNull(FieldRunId))
+}
+
+// RunIdNotNil applies the NotNil predicate on the "runId" field.
+func RunIdNotNil() predicate.FundingPayment {
+	return predicate.FundingPayment(sql.FieldNotNull(FieldRunId))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FundingPayment) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FundingPayment) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FundingPayment) predicate.FundingPayment {
+	return predicate.FundingPayment(sql.NotPredicates(p))
+}
@@ -0,0 +1,1146 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/fachebot/omni-grid-bot/internal/ent/fundingpayment"
+	"github.com/shopspring/decimal"
+)
+
+// FundingPaymentCreate is the builder for creating a FundingPayment entity.
+type FundingPaymentCreate struct {
+	config
+	mutation *FundingPaymentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *FundingPaymentCreate) SetCreateTime(v time.Time) *FundingPaymentCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *FundingPaymentCreate) SetNillableCreateTime(v *time.Time) *FundingPaymentCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *FundingPaymentCreate) SetUpdateTime(v time.Time) *FundingPaymentCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *FundingPaymentCreate) SetNillableUpdateTime(v *time.Time) *FundingPaymentCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetExchange sets the "exchange" field.
+func (_c *FundingPaymentCreate) SetExchange(v string) *FundingPaymentCreate {
+	_c.mutation.SetExchange(v)
+	return _c
+}
+
+// SetAccount sets the "account" field.
+func (_c *FundingPaymentCreate) SetAccount(v string) *FundingPaymentCreate {
+	_c.mutation.SetAccount(v)
+	return _c
+}
+
+// SetSymbol sets the "symbol" field.
+func (_c *FundingPaymentCreate) SetSymbol(v string) *FundingPaymentCreate {
+	_c.mutation.SetSymbol(v)
+	return _c
+}
+
+// SetPaymentId sets the "paymentId" field.
+func (_c *FundingPaymentCreate) SetPaymentId(v string) *FundingPaymentCreate {
+	_c.mutation.SetPaymentId(v)
+	return _c
+}
+
+// SetStrategyId sets the "strategyId" field.
+func (_c *FundingPaymentCreate) SetStrategyId(v string) *FundingPaymentCreate {
+	_c.mutation.SetStrategyId(v)
+	return _c
+}
+
+// SetNillableStrategyId sets the "strategyId" field if the given value is not nil.
+func (_c *FundingPaymentCreate) SetNillableStrategyId(v *string) *FundingPaymentCreate {
+	if v != nil {
+		_c.SetStrategyId(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *FundingPaymentCreate) SetAmount(v decimal.Decimal) *FundingPaymentCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetRate sets the "rate" field.
+func (_c *FundingPaymentCreate) SetRate(v decimal.Decimal) *FundingPaymentCreate {
+	_c.mutation.SetRate(v)
+	return _c
+}
+
+// SetPositionSize sets the "positionSize" field.
+func (_c *FundingPaymentCreate) SetPositionSize(v decimal.Decimal) *FundingPaymentCreate {
+	_c.mutation.SetPositionSize(v)
+	return _c
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (_c *FundingPaymentCreate) SetTimestamp(v int64) *FundingPaymentCreate {
+	_c.mutation.SetTimestamp(v)
+	return _c
+}
+
+// SetRunId sets the "runId" field.
+func (_c *FundingPaymentCreate) SetRunId(v int) *FundingPaymentCreate {
+	_c.mutation.SetRunId(v)
+	return _c
+}
+
+// SetNillableRunId sets the "runId" field if the given value is not nil.
+func (_c *FundingPaymentCreate) SetNillableRunId(v *int) *FundingPaymentCreate {
+	if v != nil {
+		_c.SetRunId(*v)
+	}
+	return _c
+}
+
+// Mutation returns the FundingPaymentMutation object of the builder.
+func (_c *FundingPaymentCreate) Mutation() *FundingPaymentMutation {
+	return _c.mutation
+}
+
+// Save creates the FundingPayment in the database.
+func (_c *FundingPaymentCreate) Save(ctx context.Context) (*FundingPayment, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FundingPaymentCreate) SaveX(ctx context.Context) *FundingPayment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FundingPaymentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FundingPaymentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *FundingPaymentCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := fundingpayment.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := fundingpayment.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FundingPaymentCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "FundingPayment.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "FundingPayment.update_time"`)}
+	}
+	if _, ok := _c.mutation.Exchange(); !ok {
+		return &ValidationError{Name: "exchange", err: errors.New(`ent: missing required field "FundingPayment.exchange"`)}
+	}
+	if v, ok := _c.mutation.Exchange(); ok {
+		if err := fundingpayment.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "FundingPayment.exchange": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Account(); !ok {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required field "FundingPayment.account"`)}
+	}
+	if _, ok := _c.mutation.Symbol(); !ok {
+		return &ValidationError{Name: "symbol", err: errors.New(`ent: missing required field "FundingPayment.symbol"`)}
+	}
+	if v, ok := _c.mutation.Symbol(); ok {
+		if err := fundingpayment.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "FundingPayment.symbol": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PaymentId(); !ok {
+		return &ValidationError{Name: "paymentId", err: errors.New(`ent: missing required field "FundingPayment.paymentId"`)}
+	}
+	if v, ok := _c.mutation.StrategyId(); ok {
+		if err := fundingpayment.StrategyIdValidator(v); err != nil {
+			return &ValidationError{Name: "strategyId", err: fmt.Errorf(`ent: validator failed for field "FundingPayment.strategyId": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "FundingPayment.amount"`)}
+	}
+	if _, ok := _c.mutation.Rate(); !ok {
+		return &ValidationError{Name: "rate", err: errors.New(`ent: missing required field "FundingPayment.rate"`)}
+	}
+	if _, ok := _c.mutation.PositionSize(); !ok {
+		return &ValidationError{Name: "positionSize", err: errors.New(`ent: missing required field "FundingPayment.positionSize"`)}
+	}
+	if _, ok := _c.mutation.Timestamp(); !ok {
+		return &ValidationError{Name: "timestamp", err: errors.New(`ent: missing required field "FundingPayment.timestamp"`)}
+	}
+	return nil
+}
+
+func (_c *FundingPaymentCreate) sqlSave(ctx context.Context) (*FundingPayment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FundingPaymentCreate) createSpec() (*FundingPayment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FundingPayment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(fundingpayment.Table, sqlgraph.NewFieldSpec(fundingpayment.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(fundingpayment.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(fundingpayment.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Exchange(); ok {
+		_spec.SetField(fundingpayment.FieldExchange, field.TypeString, value)
+		_node.Exchange = value
+	}
+	if value, ok := _c.mutation.Account(); ok {
+		_spec.SetField(fundingpayment.FieldAccount, field.TypeString, value)
+		_node.Account = value
+	}
+	if value, ok := _c.mutation.Symbol(); ok {
+		_spec.SetField(fundingpayment.FieldSymbol, field.TypeString, value)
+		_node.Symbol = value
+	}
+	if value, ok := _c.mutation.PaymentId(); ok {
+		_spec.SetField(fundingpayment.FieldPaymentId, field.TypeString, value)
+		_node.PaymentId = value
+	}
+	if value, ok := _c.mutation.StrategyId(); ok {
+		_spec.SetField(fundingpayment.FieldStrategyId, field.TypeString, value)
+		_node.StrategyId = &value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(fundingpayment.FieldAmount, field.TypeString, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Rate(); ok {
+		_spec.SetField(fundingpayment.FieldRate, field.TypeString, value)
+		_node.Rate = value
+	}
+	if value, ok := _c.mutation.PositionSize(); ok {
+		_spec.SetField(fundingpayment.FieldPositionSize, field.TypeString, value)
+		_node.PositionSize = value
+	}
+	if value, ok := _c.mutation.Timestamp(); ok {
+		_spec.SetField(fundingpayment.FieldTimestamp, field.TypeInt64, value)
+		_node.Timestamp = value
+	}
+	if value, ok := _c.mutation.RunId(); ok {
+		_spec.SetField(fundingpayment.FieldRunId, field.TypeInt, value)
+		_node.RunId = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FundingPayment.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FundingPaymentUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *FundingPaymentCreate) OnConflict(opts ...sql.ConflictOption) *FundingPaymentUpsertOne {
+	_c.conflict = opts
+	return &FundingPaymentUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FundingPayment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *FundingPaymentCreate) OnConflictColumns(columns ...string) *FundingPaymentUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &FundingPaymentUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// FundingPaymentUpsertOne is the builder for "upsert"-ing
+	//  one FundingPayment node.
+	FundingPaymentUpsertOne struct {
+		create *FundingPaymentCreate
+	}
+
+	// FundingPaymentUpsert is the "OnConflict" setter.
+	FundingPaymentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *FundingPaymentUpsert) SetUpdateTime(v time.Time) *FundingPaymentUpsert {
+	u.Set(fundingpayment.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *FundingPaymentUpsert) UpdateUpdateTime() *FundingPaymentUpsert {
+	u.SetExcluded(fundingpayment.FieldUpdateTime)
+	return u
+}
+
+// SetExchange sets the "exchange" field.
+func (u *FundingPaymentUpsert) SetExchange(v string) *FundingPaymentUpsert {
+	u.Set(fundingpayment.FieldExchange, v)
+	return u
+}
+
+// UpdateExchange sets the "exchange" field to the value that was provided on create.
+func (u *FundingPaymentUpsert) UpdateExchange() *FundingPaymentUpsert {
+	u.SetExcluded(fundingpayment.FieldExchange)
+	return u
+}
+
+// SetAccount sets the "account" field.
+func (u *FundingPaymentUpsert) SetAccount(v string) *FundingPaymentUpsert {
+	u.Set(fundingpayment.FieldAccount, v)
+	return u
+}
+
+// UpdateAccount sets the "account" field to the value that was provided on create.
+func (u *FundingPaymentUpsert) UpdateAccount() *FundingPaymentUpsert {
+	u.SetExcluded(fundingpayment.FieldAccount)
+	return u
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *FundingPaymentUpsert) SetSymbol(v string) *FundingPaymentUpsert {
+	u.Set(fundingpayment.FieldSymbol, v)
+	return u
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *FundingPaymentUpsert) UpdateSymbol() *FundingPaymentUpsert {
+	u.SetExcluded(fundingpayment.FieldSymbol)
+	return u
+}
+
+// SetPaymentId sets the "paymentId" field.
+func (u *FundingPaymentUpsert) SetPaymentId(v string) *FundingPaymentUpsert {
+	u.Set(fundingpayment.FieldPaymentId, v)
+	return u
+}
+
+// UpdatePaymentId sets the "paymentId" field to the value that was provided on create.
+func (u *FundingPaymentUpsert) UpdatePaymentId() *FundingPaymentUpsert {
+	u.SetExcluded(fundingpayment.FieldPaymentId)
+	return u
+}
+
+// SetStrategyId sets the "strategyId" field.
+func (u *FundingPaymentUpsert) SetStrategyId(v string) *FundingPaymentUpsert {
+	u.Set(fundingpayment.FieldStrategyId, v)
+	return u
+}
+
+// UpdateStrategyId sets the "strategyId" field to the value that was provided on create.
+func (u *FundingPaymentUpsert) UpdateStrategyId() *FundingPaymentUpsert {
+	u.SetExcluded(fundingpayment.FieldStrategyId)
+	return u
+}
+
+// ClearStrategyId clears the value of the "strategyId" field.
+func (u *FundingPaymentUpsert) ClearStrategyId() *FundingPaymentUpsert {
+	u.SetNull(fundingpayment.FieldStrategyId)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *FundingPaymentUpsert) SetAmount(v decimal.Decimal) *FundingPaymentUpsert {
+	u.Set(fundingpayment.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *FundingPaymentUpsert) UpdateAmount() *FundingPaymentUpsert {
+	u.SetExcluded(fundingpayment.FieldAmount)
+	return u
+}
+
+// SetRate sets the "rate" field.
+func (u *FundingPaymentUpsert) SetRate(v decimal.Decimal) *FundingPaymentUpsert {
+	u.Set(fundingpayment.FieldRate, v)
+	return u
+}
+
+// UpdateRate sets the "rate" field to the value that was provided on create.
+func (u *FundingPaymentUpsert) UpdateRate() *FundingPaymentUpsert {
+	u.SetExcluded(fundingpayment.FieldRate)
+	return u
+}
+
+// SetPositionSize sets the "positionSize" field.
+func (u *FundingPaymentUpsert) SetPositionSize(v decimal.Decimal) *FundingPaymentUpsert {
+	u.Set(fundingpayment.FieldPositionSize, v)
+	return u
+}
+
+// UpdatePositionSize sets the "positionSize" field to the value that was provided on create.
+func (u *FundingPaymentUpsert) UpdatePositionSize() *FundingPaymentUpsert {
+	u.SetExcluded(fundingpayment.FieldPositionSize)
+	return u
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (u *FundingPaymentUpsert) SetTimestamp(v int64) *FundingPaymentUpsert {
+	u.Set(fundingpayment.FieldTimestamp, v)
+	return u
+}
+
+// UpdateTimestamp sets the "timestamp" field to the value that was provided on create.
+func (u *FundingPaymentUpsert) UpdateTimestamp() *FundingPaymentUpsert {
+	u.SetExcluded(fundingpayment.FieldTimestamp)
+	return u
+}
+
+// AddTimestamp adds v to the "timestamp" field.
+func (u *FundingPaymentUpsert) AddTimestamp(v int64) *FundingPaymentUpsert {
+	u.Add(fundingpayment.FieldTimestamp, v)
+	return u
+}
+
+// SetRunId sets the "runId" field.
+func (u *FundingPaymentUpsert) SetRunId(v int) *FundingPaymentUpsert {
+	u.Set(fundingpayment.FieldRunId, v)
+	return u
+}
+
+// UpdateRunId sets the "runId" field to the value that was provided on create.
+func (u *FundingPaymentUpsert) UpdateRunId() *FundingPaymentUpsert {
+	u.SetExcluded(fundingpayment.FieldRunId)
+	return u
+}
+
+// AddRunId adds v to the "runId" field.
+func (u *FundingPaymentUpsert) AddRunId(v int) *FundingPaymentUpsert {
+	u.Add(fundingpayment.FieldRunId, v)
+	return u
+}
+
+// ClearRunId clears the value of the "runId" field.
+func (u *FundingPaymentUpsert) ClearRunId() *FundingPaymentUpsert {
+	u.SetNull(fundingpayment.FieldRunId)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.FundingPayment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *FundingPaymentUpsertOne) UpdateNewValues() *FundingPaymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(fundingpayment.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FundingPayment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *FundingPaymentUpsertOne) Ignore() *FundingPaymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FundingPaymentUpsertOne) DoNothing() *FundingPaymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FundingPaymentCreate.OnConflict
+// documentation for more info.
+func (u *FundingPaymentUpsertOne) Update(set func(*FundingPaymentUpsert)) *FundingPaymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FundingPaymentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *FundingPaymentUpsertOne) SetUpdateTime(v time.Time) *FundingPaymentUpsertOne {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *FundingPaymentUpsertOne) UpdateUpdateTime() *FundingPaymentUpsertOne {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetExchange sets the "exchange" field.
+func (u *FundingPaymentUpsertOne) SetExchange(v string) *FundingPaymentUpsertOne {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.SetExchange(v)
+	})
+}
+
+// UpdateExchange sets the "exchange" field to the value that was provided on create.
+func (u *FundingPaymentUpsertOne) UpdateExchange() *FundingPaymentUpsertOne {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.UpdateExchange()
+	})
+}
+
+// SetAccount sets the "account" field.
+func (u *FundingPaymentUpsertOne) SetAccount(v string) *FundingPaymentUpsertOne {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.SetAccount(v)
+	})
+}
+
+// UpdateAccount sets the "account" field to the value that was provided on create.
+func (u *FundingPaymentUpsertOne) UpdateAccount() *FundingPaymentUpsertOne {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.UpdateAccount()
+	})
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *FundingPaymentUpsertOne) SetSymbol(v string) *FundingPaymentUpsertOne {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.SetSymbol(v)
+	})
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *FundingPaymentUpsertOne) UpdateSymbol() *FundingPaymentUpsertOne {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.UpdateSymbol()
+	})
+}
+
+// SetPaymentId sets the "paymentId" field.
+func (u *FundingPaymentUpsertOne) SetPaymentId(v string) *FundingPaymentUpsertOne {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.SetPaymentId(v)
+	})
+}
+
+// UpdatePaymentId sets the "paymentId" field to the value that was provided on create.
+func (u *FundingPaymentUpsertOne) UpdatePaymentId() *FundingPaymentUpsertOne {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.UpdatePaymentId()
+	})
+}
+
+// SetStrategyId sets the "strategyId" field.
+func (u *FundingPaymentUpsertOne) SetStrategyId(v string) *FundingPaymentUpsertOne {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.SetStrategyId(v)
+	})
+}
+
+// UpdateStrategyId sets the "strategyId" field to the value that was provided on create.
+func (u *FundingPaymentUpsertOne) UpdateStrategyId() *FundingPaymentUpsertOne {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.UpdateStrategyId()
+	})
+}
+
+// ClearStrategyId clears the value of the "strategyId" field.
+func (u *FundingPaymentUpsertOne) ClearStrategyId() *FundingPaymentUpsertOne {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.ClearStrategyId()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *FundingPaymentUpsertOne) SetAmount(v decimal.Decimal) *FundingPaymentUpsertOne {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *FundingPaymentUpsertOne) UpdateAmount() *FundingPaymentUpsertOne {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetRate sets the "rate" field.
+func (u *FundingPaymentUpsertOne) SetRate(v decimal.Decimal) *FundingPaymentUpsertOne {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.SetRate(v)
+	})
+}
+
+// UpdateRate sets the "rate" field to the value that was provided on create.
+func (u *FundingPaymentUpsertOne) UpdateRate() *FundingPaymentUpsertOne {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.UpdateRate()
+	})
+}
+
+// SetPositionSize sets the "positionSize" field.
+func (u *FundingPaymentUpsertOne) SetPositionSize(v decimal.Decimal) *FundingPaymentUpsertOne {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.SetPositionSize(v)
+	})
+}
+
+// UpdatePositionSize sets the "positionSize" field to the value that was provided on create.
+func (u *FundingPaymentUpsertOne) UpdatePositionSize() *FundingPaymentUpsertOne {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.UpdatePositionSize()
+	})
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (u *FundingPaymentUpsertOne) SetTimestamp(v int64) *FundingPaymentUpsertOne {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.SetTimestamp(v)
+	})
+}
+
+// AddTimestamp adds v to the "timestamp" field.
+func (u *FundingPaymentUpsertOne) AddTimestamp(v int64) *FundingPaymentUpsertOne {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.AddTimestamp(v)
+	})
+}
+
+// UpdateTimestamp sets the "timestamp" field to the value that was provided on create.
+func (u *FundingPaymentUpsertOne) UpdateTimestamp() *FundingPaymentUpsertOne {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.UpdateTimestamp()
+	})
+}
+
+// SetRunId sets the "runId" field.
+func (u *FundingPaymentUpsertOne) SetRunId(v int) *FundingPaymentUpsertOne {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.SetRunId(v)
+	})
+}
+
+// AddRunId adds v to the "runId" field.
+func (u *FundingPaymentUpsertOne) AddRunId(v int) *FundingPaymentUpsertOne {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.AddRunId(v)
+	})
+}
+
+// UpdateRunId sets the "runId" field to the value that was provided on create.
+func (u *FundingPaymentUpsertOne) UpdateRunId() *FundingPaymentUpsertOne {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.UpdateRunId()
+	})
+}
+
+// ClearRunId clears the value of the "runId" field.
+func (u *FundingPaymentUpsertOne) ClearRunId() *FundingPaymentUpsertOne {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.ClearRunId()
+	})
+}
+
+// Exec executes the query.
+func (u *FundingPaymentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FundingPaymentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FundingPaymentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *FundingPaymentUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *FundingPaymentUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// FundingPaymentCreateBulk is the builder for creating many FundingPayment entities in bulk.
+type FundingPaymentCreateBulk struct {
+	config
+	err      error
+	builders []*FundingPaymentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the FundingPayment entities in the database.
+func (_c *FundingPaymentCreateBulk) Save(ctx context.Context) ([]*FundingPayment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*FundingPayment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FundingPaymentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FundingPaymentCreateBulk) SaveX(ctx context.Context) []*FundingPayment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FundingPaymentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FundingPaymentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.FundingPayment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.FundingPaymentUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *FundingPaymentCreateBulk) OnConflict(opts ...sql.ConflictOption) *FundingPaymentUpsertBulk {
+	_c.conflict = opts
+	return &FundingPaymentUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.FundingPayment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *FundingPaymentCreateBulk) OnConflictColumns(columns ...string) *FundingPaymentUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &FundingPaymentUpsertBulk{
+		create: _c,
+	}
+}
+
+// FundingPaymentUpsertBulk is the builder for "upsert"-ing
+// a bulk of FundingPayment nodes.
+type FundingPaymentUpsertBulk struct {
+	create *FundingPaymentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.FundingPayment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *FundingPaymentUpsertBulk) UpdateNewValues() *FundingPaymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(fundingpayment.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.FundingPayment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *FundingPaymentUpsertBulk) Ignore() *FundingPaymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *FundingPaymentUpsertBulk) DoNothing() *FundingPaymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the FundingPaymentCreateBulk.OnConflict
+// documentation for more info.
+func (u *FundingPaymentUpsertBulk) Update(set func(*FundingPaymentUpsert)) *FundingPaymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&FundingPaymentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *FundingPaymentUpsertBulk) SetUpdateTime(v time.Time) *FundingPaymentUpsertBulk {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *FundingPaymentUpsertBulk) UpdateUpdateTime() *FundingPaymentUpsertBulk {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetExchange sets the "exchange" field.
+func (u *FundingPaymentUpsertBulk) SetExchange(v string) *FundingPaymentUpsertBulk {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.SetExchange(v)
+	})
+}
+
+// UpdateExchange sets the "exchange" field to the value that was provided on create.
+func (u *FundingPaymentUpsertBulk) UpdateExchange() *FundingPaymentUpsertBulk {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.UpdateExchange()
+	})
+}
+
+// SetAccount sets the "account" field.
+func (u *FundingPaymentUpsertBulk) SetAccount(v string) *FundingPaymentUpsertBulk {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.SetAccount(v)
+	})
+}
+
+// UpdateAccount sets the "account" field to the value that was provided on create.
+func (u *FundingPaymentUpsertBulk) UpdateAccount() *FundingPaymentUpsertBulk {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.UpdateAccount()
+	})
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *FundingPaymentUpsertBulk) SetSymbol(v string) *FundingPaymentUpsertBulk {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.SetSymbol(v)
+	})
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *FundingPaymentUpsertBulk) UpdateSymbol() *FundingPaymentUpsertBulk {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.UpdateSymbol()
+	})
+}
+
+// SetPaymentId sets the "paymentId" field.
+func (u *FundingPaymentUpsertBulk) SetPaymentId(v string) *FundingPaymentUpsertBulk {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.SetPaymentId(v)
+	})
+}
+
+// UpdatePaymentId sets the "paymentId" field to the value that was provided on create.
+func (u *FundingPaymentUpsertBulk) UpdatePaymentId() *FundingPaymentUpsertBulk {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.UpdatePaymentId()
+	})
+}
+
+// SetStrategyId sets the "strategyId" field.
+func (u *FundingPaymentUpsertBulk) SetStrategyId(v string) *FundingPaymentUpsertBulk {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.SetStrategyId(v)
+	})
+}
+
+// UpdateStrategyId sets the "strategyId" field to the value that was provided on create.
+func (u *FundingPaymentUpsertBulk) UpdateStrategyId() *FundingPaymentUpsertBulk {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.UpdateStrategyId()
+	})
+}
+
+// ClearStrategyId clears the value of the "strategyId" field.
+func (u *FundingPaymentUpsertBulk) ClearStrategyId() *FundingPaymentUpsertBulk {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.ClearStrategyId()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *FundingPaymentUpsertBulk) SetAmount(v decimal.Decimal) *FundingPaymentUpsertBulk {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *FundingPaymentUpsertBulk) UpdateAmount() *FundingPaymentUpsertBulk {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetRate sets the "rate" field.
+func (u *FundingPaymentUpsertBulk) SetRate(v decimal.Decimal) *FundingPaymentUpsertBulk {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.SetRate(v)
+	})
+}
+
+// UpdateRate sets the "rate" field to the value that was provided on create.
+func (u *FundingPaymentUpsertBulk) UpdateRate() *FundingPaymentUpsertBulk {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.UpdateRate()
+	})
+}
+
+// SetPositionSize sets the "positionSize" field.
+func (u *FundingPaymentUpsertBulk) SetPositionSize(v decimal.Decimal) *FundingPaymentUpsertBulk {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.SetPositionSize(v)
+	})
+}
+
+// UpdatePositionSize sets the "positionSize" field to the value that was provided on create.
+func (u *FundingPaymentUpsertBulk) UpdatePositionSize() *FundingPaymentUpsertBulk {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.UpdatePositionSize()
+	})
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (u *FundingPaymentUpsertBulk) SetTimestamp(v int64) *FundingPaymentUpsertBulk {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.SetTimestamp(v)
+	})
+}
+
+// AddTimestamp adds v to the "timestamp" field.
+func (u *FundingPaymentUpsertBulk) AddTimestamp(v int64) *FundingPaymentUpsertBulk {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.AddTimestamp(v)
+	})
+}
+
+// UpdateTimestamp sets the "timestamp" field to the value that was provided on create.
+func (u *FundingPaymentUpsertBulk) UpdateTimestamp() *FundingPaymentUpsertBulk {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.UpdateTimestamp()
+	})
+}
+
+// SetRunId sets the "runId" field.
+func (u *FundingPaymentUpsertBulk) SetRunId(v int) *FundingPaymentUpsertBulk {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.SetRunId(v)
+	})
+}
+
+// AddRunId adds v to the "runId" field.
+func (u *FundingPaymentUpsertBulk) AddRunId(v int) *FundingPaymentUpsertBulk {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.AddRunId(v)
+	})
+}
+
+// UpdateRunId sets the "runId" field to the value that was provided on create.
+func (u *FundingPaymentUpsertBulk) UpdateRunId() *FundingPaymentUpsertBulk {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.UpdateRunId()
+	})
+}
+
+// ClearRunId clears the value of the "runId" field.
+func (u *FundingPaymentUpsertBulk) ClearRunId() *FundingPaymentUpsertBulk {
+	return u.Update(func(s *FundingPaymentUpsert) {
+		s.ClearRunId()
+	})
+}
+
+// Exec executes the query.
+func (u *FundingPaymentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the FundingPaymentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for FundingPaymentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *FundingPaymentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
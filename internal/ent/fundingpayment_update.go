@@ -0,0 +1,675 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/fachebot/omni-grid-bot/internal/ent/fundingpayment"
+	"github.com/fachebot/omni-grid-bot/internal/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// FundingPaymentUpdate is the builder for updating FundingPayment entities.
+type FundingPaymentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FundingPaymentMutation
+}
+
+// Where appends a list predicates to the FundingPaymentUpdate builder.
+func (_u *FundingPaymentUpdate) Where(ps ...predicate.FundingPayment) *FundingPaymentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *FundingPaymentUpdate) SetUpdateTime(v time.Time) *FundingPaymentUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetExchange sets the "exchange" field.
+func (_u *FundingPaymentUpdate) SetExchange(v string) *FundingPaymentUpdate {
+	_u.mutation.SetExchange(v)
+	return _u
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_u *FundingPaymentUpdate) SetNillableExchange(v *string) *FundingPaymentUpdate {
+	if v != nil {
+		_u.SetExchange(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" field.
+func (_u *FundingPaymentUpdate) SetAccount(v string) *FundingPaymentUpdate {
+	_u.mutation.SetAccount(v)
+	return _u
+}
+
+// SetNillableAccount sets the "account" field if the given value is not nil.
+func (_u *FundingPaymentUpdate) SetNillableAccount(v *string) *FundingPaymentUpdate {
+	if v != nil {
+		_u.SetAccount(*v)
+	}
+	return _u
+}
+
+// SetSymbol sets the "symbol" field.
+func (_u *FundingPaymentUpdate) SetSymbol(v string) *FundingPaymentUpdate {
+	_u.mutation.SetSymbol(v)
+	return _u
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_u *FundingPaymentUpdate) SetNillableSymbol(v *string) *FundingPaymentUpdate {
+	if v != nil {
+		_u.SetSymbol(*v)
+	}
+	return _u
+}
+
+// SetPaymentId sets the "paymentId" field.
+func (_u *FundingPaymentUpdate) SetPaymentId(v string) *FundingPaymentUpdate {
+	_u.mutation.SetPaymentId(v)
+	return _u
+}
+
+// SetNillablePaymentId sets the "paymentId" field if the given value is not nil.
+func (_u *FundingPaymentUpdate) SetNillablePaymentId(v *string) *FundingPaymentUpdate {
+	if v != nil {
+		_u.SetPaymentId(*v)
+	}
+	return _u
+}
+
+// SetStrategyId sets the "strategyId" field.
+func (_u *FundingPaymentUpdate) SetStrategyId(v string) *FundingPaymentUpdate {
+	_u.mutation.SetStrategyId(v)
+	return _u
+}
+
+// SetNillableStrategyId sets the "strategyId" field if the given value is not nil.
+func (_u *FundingPaymentUpdate) SetNillableStrategyId(v *string) *FundingPaymentUpdate {
+	if v != nil {
+		_u.SetStrategyId(*v)
+	}
+	return _u
+}
+
+// ClearStrategyId clears the value of the "strategyId" field.
+func (_u *FundingPaymentUpdate) ClearStrategyId() *FundingPaymentUpdate {
+	_u.mutation.ClearStrategyId()
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *FundingPaymentUpdate) SetAmount(v decimal.Decimal) *FundingPaymentUpdate {
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *FundingPaymentUpdate) SetNillableAmount(v *decimal.Decimal) *FundingPaymentUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// SetRate sets the "rate" field.
+func (_u *FundingPaymentUpdate) SetRate(v decimal.Decimal) *FundingPaymentUpdate {
+	_u.mutation.SetRate(v)
+	return _u
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (_u *FundingPaymentUpdate) SetNillableRate(v *decimal.Decimal) *FundingPaymentUpdate {
+	if v != nil {
+		_u.SetRate(*v)
+	}
+	return _u
+}
+
+// SetPositionSize sets the "positionSize" field.
+func (_u *FundingPaymentUpdate) SetPositionSize(v decimal.Decimal) *FundingPaymentUpdate {
+	_u.mutation.SetPositionSize(v)
+	return _u
+}
+
+// SetNillablePositionSize sets the "positionSize" field if the given value is not nil.
+func (_u *FundingPaymentUpdate) SetNillablePositionSize(v *decimal.Decimal) *FundingPaymentUpdate {
+	if v != nil {
+		_u.SetPositionSize(*v)
+	}
+	return _u
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (_u *FundingPaymentUpdate) SetTimestamp(v int64) *FundingPaymentUpdate {
+	_u.mutation.ResetTimestamp()
+	_u.mutation.SetTimestamp(v)
+	return _u
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (_u *FundingPaymentUpdate) SetNillableTimestamp(v *int64) *FundingPaymentUpdate {
+	if v != nil {
+		_u.SetTimestamp(*v)
+	}
+	return _u
+}
+
+// AddTimestamp adds value to the "timestamp" field.
+func (_u *FundingPaymentUpdate) AddTimestamp(v int64) *FundingPaymentUpdate {
+	_u.mutation.AddTimestamp(v)
+	return _u
+}
+
+// SetRunId sets the "runId" field.
+func (_u *FundingPaymentUpdate) SetRunId(v int) *FundingPaymentUpdate {
+	_u.mutation.ResetRunId()
+	_u.mutation.SetRunId(v)
+	return _u
+}
+
+// SetNillableRunId sets the "runId" field if the given value is not nil.
+func (_u *FundingPaymentUpdate) SetNillableRunId(v *int) *FundingPaymentUpdate {
+	if v != nil {
+		_u.SetRunId(*v)
+	}
+	return _u
+}
+
+// AddRunId adds value to the "runId" field.
+func (_u *FundingPaymentUpdate) AddRunId(v int) *FundingPaymentUpdate {
+	_u.mutation.AddRunId(v)
+	return _u
+}
+
+// ClearRunId clears the value of the "runId" field.
+func (_u *FundingPaymentUpdate) ClearRunId() *FundingPaymentUpdate {
+	_u.mutation.ClearRunId()
+	return _u
+}
+
+// Mutation returns the FundingPaymentMutation object of the builder.
+func (_u *FundingPaymentUpdate) Mutation() *FundingPaymentMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FundingPaymentUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FundingPaymentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FundingPaymentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FundingPaymentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *FundingPaymentUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := fundingpayment.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FundingPaymentUpdate) check() error {
+	if v, ok := _u.mutation.Exchange(); ok {
+		if err := fundingpayment.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "FundingPayment.exchange": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Symbol(); ok {
+		if err := fundingpayment.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "FundingPayment.symbol": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StrategyId(); ok {
+		if err := fundingpayment.StrategyIdValidator(v); err != nil {
+			return &ValidationError{Name: "strategyId", err: fmt.Errorf(`ent: validator failed for field "FundingPayment.strategyId": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *FundingPaymentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(fundingpayment.Table, fundingpayment.Columns, sqlgraph.NewFieldSpec(fundingpayment.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(fundingpayment.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Exchange(); ok {
+		_spec.SetField(fundingpayment.FieldExchange, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Account(); ok {
+		_spec.SetField(fundingpayment.FieldAccount, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Symbol(); ok {
+		_spec.SetField(fundingpayment.FieldSymbol, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PaymentId(); ok {
+		_spec.SetField(fundingpayment.FieldPaymentId, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StrategyId(); ok {
+		_spec.SetField(fundingpayment.FieldStrategyId, field.TypeString, value)
+	}
+	if _u.mutation.StrategyIdCleared() {
+		_spec.ClearField(fundingpayment.FieldStrategyId, field.TypeString)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(fundingpayment.FieldAmount, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Rate(); ok {
+		_spec.SetField(fundingpayment.FieldRate, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PositionSize(); ok {
+		_spec.SetField(fundingpayment.FieldPositionSize, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Timestamp(); ok {
+		_spec.SetField(fundingpayment.FieldTimestamp, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTimestamp(); ok {
+		_spec.AddField(fundingpayment.FieldTimestamp, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.RunId(); ok {
+		_spec.SetField(fundingpayment.FieldRunId, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRunId(); ok {
+		_spec.AddField(fundingpayment.FieldRunId, field.TypeInt, value)
+	}
+	if _u.mutation.RunIdCleared() {
+		_spec.ClearField(fundingpayment.FieldRunId, field.TypeInt)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fundingpayment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FundingPaymentUpdateOne is the builder for updating a single FundingPayment entity.
+type FundingPaymentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FundingPaymentMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *FundingPaymentUpdateOne) SetUpdateTime(v time.Time) *FundingPaymentUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetExchange sets the "exchange" field.
+func (_u *FundingPaymentUpdateOne) SetExchange(v string) *FundingPaymentUpdateOne {
+	_u.mutation.SetExchange(v)
+	return _u
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_u *FundingPaymentUpdateOne) SetNillableExchange(v *string) *FundingPaymentUpdateOne {
+	if v != nil {
+		_u.SetExchange(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" field.
+func (_u *FundingPaymentUpdateOne) SetAccount(v string) *FundingPaymentUpdateOne {
+	_u.mutation.SetAccount(v)
+	return _u
+}
+
+// SetNillableAccount sets the "account" field if the given value is not nil.
+func (_u *FundingPaymentUpdateOne) SetNillableAccount(v *string) *FundingPaymentUpdateOne {
+	if v != nil {
+		_u.SetAccount(*v)
+	}
+	return _u
+}
+
+// SetSymbol sets the "symbol" field.
+func (_u *FundingPaymentUpdateOne) SetSymbol(v string) *FundingPaymentUpdateOne {
+	_u.mutation.SetSymbol(v)
+	return _u
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_u *FundingPaymentUpdateOne) SetNillableSymbol(v *string) *FundingPaymentUpdateOne {
+	if v != nil {
+		_u.SetSymbol(*v)
+	}
+	return _u
+}
+
+// SetPaymentId sets the "paymentId" field.
+func (_u *FundingPaymentUpdateOne) SetPaymentId(v string) *FundingPaymentUpdateOne {
+	_u.mutation.SetPaymentId(v)
+	return _u
+}
+
+// SetNillablePaymentId sets the "paymentId" field if the given value is not nil.
+func (_u *FundingPaymentUpdateOne) SetNillablePaymentId(v *string) *FundingPaymentUpdateOne {
+	if v != nil {
+		_u.SetPaymentId(*v)
+	}
+	return _u
+}
+
+// SetStrategyId sets the "strategyId" field.
+func (_u *FundingPaymentUpdateOne) SetStrategyId(v string) *FundingPaymentUpdateOne {
+	_u.mutation.SetStrategyId(v)
+	return _u
+}
+
+// SetNillableStrategyId sets the "strategyId" field if the given value is not nil.
+func (_u *FundingPaymentUpdateOne) SetNillableStrategyId(v *string) *FundingPaymentUpdateOne {
+	if v != nil {
+		_u.SetStrategyId(*v)
+	}
+	return _u
+}
+
+// ClearStrategyId clears the value of the "strategyId" field.
+func (_u *FundingPaymentUpdateOne) ClearStrategyId() *FundingPaymentUpdateOne {
+	_u.mutation.ClearStrategyId()
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *FundingPaymentUpdateOne) SetAmount(v decimal.Decimal) *FundingPaymentUpdateOne {
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *FundingPaymentUpdateOne) SetNillableAmount(v *decimal.Decimal) *FundingPaymentUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// SetRate sets the "rate" field.
+func (_u *FundingPaymentUpdateOne) SetRate(v decimal.Decimal) *FundingPaymentUpdateOne {
+	_u.mutation.SetRate(v)
+	return _u
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (_u *FundingPaymentUpdateOne) SetNillableRate(v *decimal.Decimal) *FundingPaymentUpdateOne {
+	if v != nil {
+		_u.SetRate(*v)
+	}
+	return _u
+}
+
+// SetPositionSize sets the "positionSize" field.
+func (_u *FundingPaymentUpdateOne) SetPositionSize(v decimal.Decimal) *FundingPaymentUpdateOne {
+	_u.mutation.SetPositionSize(v)
+	return _u
+}
+
+// SetNillablePositionSize sets the "positionSize" field if the given value is not nil.
+func (_u *FundingPaymentUpdateOne) SetNillablePositionSize(v *decimal.Decimal) *FundingPaymentUpdateOne {
+	if v != nil {
+		_u.SetPositionSize(*v)
+	}
+	return _u
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (_u *FundingPaymentUpdateOne) SetTimestamp(v int64) *FundingPaymentUpdateOne {
+	_u.mutation.ResetTimestamp()
+	_u.mutation.SetTimestamp(v)
+	return _u
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (_u *FundingPaymentUpdateOne) SetNillableTimestamp(v *int64) *FundingPaymentUpdateOne {
+	if v != nil {
+		_u.SetTimestamp(*v)
+	}
+	return _u
+}
+
+// AddTimestamp adds value to the "timestamp" field.
+func (_u *FundingPaymentUpdateOne) AddTimestamp(v int64) *FundingPaymentUpdateOne {
+	_u.mutation.AddTimestamp(v)
+	return _u
+}
+
+// SetRunId sets the "runId" field.
+func (_u *FundingPaymentUpdateOne) SetRunId(v int) *FundingPaymentUpdateOne {
+	_u.mutation.ResetRunId()
+	_u.mutation.SetRunId(v)
+	return _u
+}
+
+// SetNillableRunId sets the "runId" field if the given value is not nil.
+func (_u *FundingPaymentUpdateOne) SetNillableRunId(v *int) *FundingPaymentUpdateOne {
+	if v != nil {
+		_u.SetRunId(*v)
+	}
+	return _u
+}
+
+// AddRunId adds value to the "runId" field.
+func (_u *FundingPaymentUpdateOne) AddRunId(v int) *FundingPaymentUpdateOne {
+	_u.mutation.AddRunId(v)
+	return _u
+}
+
+// ClearRunId clears the value of the "runId" field.
+func (_u *FundingPaymentUpdateOne) ClearRunId() *FundingPaymentUpdateOne {
+	_u.mutation.ClearRunId()
+	return _u
+}
+
+// Mutation returns the FundingPaymentMutation object of the builder.
+func (_u *FundingPaymentUpdateOne) Mutation() *FundingPaymentMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the FundingPaymentUpdate builder.
+func (_u *FundingPaymentUpdateOne) Where(ps ...predicate.FundingPayment) *FundingPaymentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FundingPaymentUpdateOne) Select(field string, fields ...string) *FundingPaymentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated FundingPayment entity.
+func (_u *FundingPaymentUpdateOne) Save(ctx context.Context) (*FundingPayment, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FundingPaymentUpdateOne) SaveX(ctx context.Context) *FundingPayment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FundingPaymentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FundingPaymentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *FundingPaymentUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := fundingpayment.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FundingPaymentUpdateOne) check() error {
+	if v, ok := _u.mutation.Exchange(); ok {
+		if err := fundingpayment.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "FundingPayment.exchange": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Symbol(); ok {
+		if err := fundingpayment.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "FundingPayment.symbol": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StrategyId(); ok {
+		if err := fundingpayment.StrategyIdValidator(v); err != nil {
+			return &ValidationError{Name: "strategyId", err: fmt.Errorf(`ent: validator failed for field "FundingPayment.strategyId": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *FundingPaymentUpdateOne) sqlSave(ctx context.Context) (_node *FundingPayment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(fundingpayment.Table, fundingpayment.Columns, sqlgraph.NewFieldSpec(fundingpayment.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FundingPayment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, fundingpayment.FieldID)
+		for _, f := range fields {
+			if !fundingpayment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != fundingpayment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(fundingpayment.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Exchange(); ok {
+		_spec.SetField(fundingpayment.FieldExchange, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Account(); ok {
+		_spec.SetField(fundingpayment.FieldAccount, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Symbol(); ok {
+		_spec.SetField(fundingpayment.FieldSymbol, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PaymentId(); ok {
+		_spec.SetField(fundingpayment.FieldPaymentId, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StrategyId(); ok {
+		_spec.SetField(fundingpayment.FieldStrategyId, field.TypeString, value)
+	}
+	if _u.mutation.StrategyIdCleared() {
+		_spec.ClearField(fundingpayment.FieldStrategyId, field.TypeString)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(fundingpayment.FieldAmount, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Rate(); ok {
+		_spec.SetField(fundingpayment.FieldRate, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PositionSize(); ok {
+		_spec.SetField(fundingpayment.FieldPositionSize, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Timestamp(); ok {
+		_spec.SetField(fundingpayment.FieldTimestamp, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTimestamp(); ok {
+		_spec.AddField(fundingpayment.FieldTimestamp, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.RunId(); ok {
+		_spec.SetField(fundingpayment.FieldRunId, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRunId(); ok {
+		_spec.AddField(fundingpayment.FieldRunId, field.TypeInt, value)
+	}
+	if _u.mutation.RunIdCleared() {
+		_spec.ClearField(fundingpayment.FieldRunId, field.TypeInt)
+	}
+	_node = &FundingPayment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fundingpayment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
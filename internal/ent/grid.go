@@ -44,7 +44,9 @@ type Grid struct {
 	SellClientOrderId *string `json:"sellClientOrderId,omitempty"`
 	// SellClientOrderTime holds the value of the "sellClientOrderTime" field.
 	SellClientOrderTime *int64 `json:"sellClientOrderTime,omitempty"`
-	selectValues        sql.SelectValues
+	// RunId holds the value of the "runId" field.
+	RunId        *int `json:"runId,omitempty"`
+	selectValues sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -54,7 +56,7 @@ func (*Grid) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case grid.FieldPrice, grid.FieldQuantity:
 			values[i] = new(decimal.Decimal)
-		case grid.FieldID, grid.FieldLevel, grid.FieldBuyClientOrderTime, grid.FieldSellClientOrderTime:
+		case grid.FieldID, grid.FieldLevel, grid.FieldBuyClientOrderTime, grid.FieldSellClientOrderTime, grid.FieldRunId:
 			values[i] = new(sql.NullInt64)
 		case grid.FieldStrategyId, grid.FieldExchange, grid.FieldSymbol, grid.FieldAccount, grid.FieldBuyClientOrderId, grid.FieldSellClientOrderId:
 			values[i] = new(sql.NullString)
@@ -163,6 +165,13 @@ func (_m *Grid) assignValues(columns []string, values []any) error {
 				_m.SellClientOrderTime = new(int64)
 				*_m.SellClientOrderTime = value.Int64
 			}
+		case grid.FieldRunId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field runId", values[i])
+			} else if value.Valid {
+				_m.RunId = new(int)
+				*_m.RunId = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -245,6 +254,11 @@ func (_m *Grid) String() string {
 		builder.WriteString("sellClientOrderTime=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	if v := _m.RunId; v != nil {
+		builder.WriteString("runId=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -39,6 +39,8 @@ const (
 	FieldSellClientOrderId = "sell_client_order_id"
 	// FieldSellClientOrderTime holds the string denoting the sellclientordertime field in the database.
 	FieldSellClientOrderTime = "sell_client_order_time"
+	// FieldRunId holds the string denoting the runid field in the database.
+	FieldRunId = "run_id"
 	// Table holds the table name of the grid in the database.
 	Table = "grids"
 )
@@ -59,6 +61,7 @@ var Columns = []string{
 	FieldBuyClientOrderTime,
 	FieldSellClientOrderId,
 	FieldSellClientOrderTime,
+	FieldRunId,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -158,3 +161,8 @@ func BySellClientOrderId(opts ...sql.OrderTermOption) OrderOption {
 func BySellClientOrderTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSellClientOrderTime, opts...).ToFunc()
 }
+
+// ByRunId orders the results by the runId field.
+func ByRunId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunId, opts...).ToFunc()
+}
@@ -120,6 +120,11 @@ func SellClientOrderTime(v int64) predicate.Grid {
 	return predicate.Grid(sql.FieldEQ(FieldSellClientOrderTime, v))
 }
 
+// RunId applies equality check predicate on the "runId" field. It's identical to RunIdEQ.
+func RunId(v int) predicate.Grid {
+	return predicate.Grid(sql.FieldEQ(FieldRunId, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Grid {
 	return predicate.Grid(sql.FieldEQ(FieldCreateTime, v))
@@ -890,6 +895,56 @@ func SellClientOrderTimeNotNil() predicate.Grid {
 	return predicate.Grid(sql.FieldNotNull(FieldSellClientOrderTime))
 }
 
+// RunIdEQ applies the EQ predicate on the "runId" field.
+func RunIdEQ(v int) predicate.Grid {
+	return predicate.Grid(sql.FieldEQ(FieldRunId, v))
+}
+
+// RunIdNEQ applies the NEQ predicate on the "runId" field.
+func RunIdNEQ(v int) predicate.Grid {
+	return predicate.Grid(sql.FieldNEQ(FieldRunId, v))
+}
+
+// RunIdIn applies the In predicate on the "runId" field.
+func RunIdIn(vs ...int) predicate.Grid {
+	return predicate.Grid(sql.FieldIn(FieldRunId, vs...))
+}
+
+// RunIdNotIn applies the NotIn predicate on the "runId" field.
+func RunIdNotIn(vs ...int) predicate.Grid {
+	return predicate.Grid(sql.FieldNotIn(FieldRunId, vs...))
+}
+
+// RunIdGT applies the GT predicate on the "runId" field.
+func RunIdGT(v int) predicate.Grid {
+	return predicate.Grid(sql.FieldGT(FieldRunId, v))
+}
+
+// RunIdGTE applies the GTE predicate on the "runId" field.
+func RunIdGTE(v int) predicate.Grid {
+	return predicate.Grid(sql.FieldGTE(FieldRunId, v))
+}
+
+// RunIdLT applies the LT predicate on the "runId" field.
+func RunIdLT(v int) predicate.Grid {
+	return predicate.Grid(sql.FieldLT(FieldRunId, v))
+}
+
+// RunIdLTE applies the LTE predicate on the "runId" field.
+func RunIdLTE(v int) predicate.Grid {
+	return predicate.Grid(sql.FieldLTE(FieldRunId, v))
+}
+
+// RunIdIsNil applies the IsNil predicate on the "runId" field.
+func RunIdIsNil() predicate.Grid {
+	return predicate.Grid(sql.FieldIsNull(FieldRunId))
+}
+
+// RunIdNotNil applies the NotNil predicate on the "runId" field.
+func RunIdNotNil() predicate.Grid {
+	return predicate.Grid(sql.FieldNotNull(FieldRunId))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Grid) predicate.Grid {
 	return predicate.Grid(sql.AndPredicates(predicates...))
@@ -149,6 +149,20 @@ func (_c *GridCreate) SetNillableSellClientOrderTime(v *int64) *GridCreate {
 	return _c
 }
 
+// SetRunId sets the "runId" field.
+func (_c *GridCreate) SetRunId(v int) *GridCreate {
+	_c.mutation.SetRunId(v)
+	return _c
+}
+
+// SetNillableRunId sets the "runId" field if the given value is not nil.
+func (_c *GridCreate) SetNillableRunId(v *int) *GridCreate {
+	if v != nil {
+		_c.SetRunId(*v)
+	}
+	return _c
+}
+
 // Mutation returns the GridMutation object of the builder.
 func (_c *GridCreate) Mutation() *GridMutation {
 	return _c.mutation
@@ -317,6 +331,10 @@ func (_c *GridCreate) createSpec() (*Grid, *sqlgraph.CreateSpec) {
 		_spec.SetField(grid.FieldSellClientOrderTime, field.TypeInt64, value)
 		_node.SellClientOrderTime = &value
 	}
+	if value, ok := _c.mutation.RunId(); ok {
+		_spec.SetField(grid.FieldRunId, field.TypeInt, value)
+		_node.RunId = &value
+	}
 	return _node, _spec
 }
 
@@ -555,6 +573,30 @@ func (u *GridUpsert) ClearSellClientOrderTime() *GridUpsert {
 	return u
 }
 
+// SetRunId sets the "runId" field.
+func (u *GridUpsert) SetRunId(v int) *GridUpsert {
+	u.Set(grid.FieldRunId, v)
+	return u
+}
+
+// UpdateRunId sets the "runId" field to the value that was provided on create.
+func (u *GridUpsert) UpdateRunId() *GridUpsert {
+	u.SetExcluded(grid.FieldRunId)
+	return u
+}
+
+// AddRunId adds v to the "runId" field.
+func (u *GridUpsert) AddRunId(v int) *GridUpsert {
+	u.Add(grid.FieldRunId, v)
+	return u
+}
+
+// ClearRunId clears the value of the "runId" field.
+func (u *GridUpsert) ClearRunId() *GridUpsert {
+	u.SetNull(grid.FieldRunId)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -817,6 +859,34 @@ func (u *GridUpsertOne) ClearSellClientOrderTime() *GridUpsertOne {
 	})
 }
 
+// SetRunId sets the "runId" field.
+func (u *GridUpsertOne) SetRunId(v int) *GridUpsertOne {
+	return u.Update(func(s *GridUpsert) {
+		s.SetRunId(v)
+	})
+}
+
+// AddRunId adds v to the "runId" field.
+func (u *GridUpsertOne) AddRunId(v int) *GridUpsertOne {
+	return u.Update(func(s *GridUpsert) {
+		s.AddRunId(v)
+	})
+}
+
+// UpdateRunId sets the "runId" field to the value that was provided on create.
+func (u *GridUpsertOne) UpdateRunId() *GridUpsertOne {
+	return u.Update(func(s *GridUpsert) {
+		s.UpdateRunId()
+	})
+}
+
+// ClearRunId clears the value of the "runId" field.
+func (u *GridUpsertOne) ClearRunId() *GridUpsertOne {
+	return u.Update(func(s *GridUpsert) {
+		s.ClearRunId()
+	})
+}
+
 // Exec executes the query.
 func (u *GridUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1245,6 +1315,34 @@ func (u *GridUpsertBulk) ClearSellClientOrderTime() *GridUpsertBulk {
 	})
 }
 
+// SetRunId sets the "runId" field.
+func (u *GridUpsertBulk) SetRunId(v int) *GridUpsertBulk {
+	return u.Update(func(s *GridUpsert) {
+		s.SetRunId(v)
+	})
+}
+
+// AddRunId adds v to the "runId" field.
+func (u *GridUpsertBulk) AddRunId(v int) *GridUpsertBulk {
+	return u.Update(func(s *GridUpsert) {
+		s.AddRunId(v)
+	})
+}
+
+// UpdateRunId sets the "runId" field to the value that was provided on create.
+func (u *GridUpsertBulk) UpdateRunId() *GridUpsertBulk {
+	return u.Update(func(s *GridUpsert) {
+		s.UpdateRunId()
+	})
+}
+
+// ClearRunId clears the value of the "runId" field.
+func (u *GridUpsertBulk) ClearRunId() *GridUpsertBulk {
+	return u.Update(func(s *GridUpsert) {
+		s.ClearRunId()
+	})
+}
+
 // Exec executes the query.
 func (u *GridUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
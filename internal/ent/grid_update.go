@@ -234,6 +234,33 @@ func (_u *GridUpdate) ClearSellClientOrderTime() *GridUpdate {
 	return _u
 }
 
+// SetRunId sets the "runId" field.
+func (_u *GridUpdate) SetRunId(v int) *GridUpdate {
+	_u.mutation.ResetRunId()
+	_u.mutation.SetRunId(v)
+	return _u
+}
+
+// SetNillableRunId sets the "runId" field if the given value is not nil.
+func (_u *GridUpdate) SetNillableRunId(v *int) *GridUpdate {
+	if v != nil {
+		_u.SetRunId(*v)
+	}
+	return _u
+}
+
+// AddRunId adds value to the "runId" field.
+func (_u *GridUpdate) AddRunId(v int) *GridUpdate {
+	_u.mutation.AddRunId(v)
+	return _u
+}
+
+// ClearRunId clears the value of the "runId" field.
+func (_u *GridUpdate) ClearRunId() *GridUpdate {
+	_u.mutation.ClearRunId()
+	return _u
+}
+
 // Mutation returns the GridMutation object of the builder.
 func (_u *GridUpdate) Mutation() *GridMutation {
 	return _u.mutation
@@ -364,6 +391,15 @@ func (_u *GridUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.SellClientOrderTimeCleared() {
 		_spec.ClearField(grid.FieldSellClientOrderTime, field.TypeInt64)
 	}
+	if value, ok := _u.mutation.RunId(); ok {
+		_spec.SetField(grid.FieldRunId, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRunId(); ok {
+		_spec.AddField(grid.FieldRunId, field.TypeInt, value)
+	}
+	if _u.mutation.RunIdCleared() {
+		_spec.ClearField(grid.FieldRunId, field.TypeInt)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{grid.Label}
@@ -589,6 +625,33 @@ func (_u *GridUpdateOne) ClearSellClientOrderTime() *GridUpdateOne {
 	return _u
 }
 
+// SetRunId sets the "runId" field.
+func (_u *GridUpdateOne) SetRunId(v int) *GridUpdateOne {
+	_u.mutation.ResetRunId()
+	_u.mutation.SetRunId(v)
+	return _u
+}
+
+// SetNillableRunId sets the "runId" field if the given value is not nil.
+func (_u *GridUpdateOne) SetNillableRunId(v *int) *GridUpdateOne {
+	if v != nil {
+		_u.SetRunId(*v)
+	}
+	return _u
+}
+
+// AddRunId adds value to the "runId" field.
+func (_u *GridUpdateOne) AddRunId(v int) *GridUpdateOne {
+	_u.mutation.AddRunId(v)
+	return _u
+}
+
+// ClearRunId clears the value of the "runId" field.
+func (_u *GridUpdateOne) ClearRunId() *GridUpdateOne {
+	_u.mutation.ClearRunId()
+	return _u
+}
+
 // Mutation returns the GridMutation object of the builder.
 func (_u *GridUpdateOne) Mutation() *GridMutation {
 	return _u.mutation
@@ -749,6 +812,15 @@ func (_u *GridUpdateOne) sqlSave(ctx context.Context) (_node *Grid, err error) {
 	if _u.mutation.SellClientOrderTimeCleared() {
 		_spec.ClearField(grid.FieldSellClientOrderTime, field.TypeInt64)
 	}
+	if value, ok := _u.mutation.RunId(); ok {
+		_spec.SetField(grid.FieldRunId, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRunId(); ok {
+		_spec.AddField(grid.FieldRunId, field.TypeInt, value)
+	}
+	if _u.mutation.RunIdCleared() {
+		_spec.ClearField(grid.FieldRunId, field.TypeInt)
+	}
 	_node = &Grid{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
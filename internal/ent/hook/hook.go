@@ -9,6 +9,42 @@ import (
 	"github.com/fachebot/omni-grid-bot/internal/ent"
 )
 
+// The ExchangeAccountFunc type is an adapter to allow the use of ordinary
+// function as ExchangeAccount mutator.
+type ExchangeAccountFunc func(context.Context, *ent.ExchangeAccountMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ExchangeAccountFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ExchangeAccountMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExchangeAccountMutation", m)
+}
+
+// The FillFunc type is an adapter to allow the use of ordinary
+// function as Fill mutator.
+type FillFunc func(context.Context, *ent.FillMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FillFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FillMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FillMutation", m)
+}
+
+// The FundingPaymentFunc type is an adapter to allow the use of ordinary
+// function as FundingPayment mutator.
+type FundingPaymentFunc func(context.Context, *ent.FundingPaymentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FundingPaymentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FundingPaymentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FundingPaymentMutation", m)
+}
+
 // The GridFunc type is an adapter to allow the use of ordinary
 // function as Grid mutator.
 type GridFunc func(context.Context, *ent.GridMutation) (ent.Value, error)
@@ -45,6 +81,18 @@ func (f OrderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OrderMutation", m)
 }
 
+// The OrderRepairFunc type is an adapter to allow the use of ordinary
+// function as OrderRepair mutator.
+type OrderRepairFunc func(context.Context, *ent.OrderRepairMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OrderRepairFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OrderRepairMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OrderRepairMutation", m)
+}
+
 // The StrategyFunc type is an adapter to allow the use of ordinary
 // function as Strategy mutator.
 type StrategyFunc func(context.Context, *ent.StrategyMutation) (ent.Value, error)
@@ -57,6 +105,18 @@ func (f StrategyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StrategyMutation", m)
 }
 
+// The StrategyRunFunc type is an adapter to allow the use of ordinary
+// function as StrategyRun mutator.
+type StrategyRunFunc func(context.Context, *ent.StrategyRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StrategyRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StrategyRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StrategyRunMutation", m)
+}
+
 // The SyncProgressFunc type is an adapter to allow the use of ordinary
 // function as SyncProgress mutator.
 type SyncProgressFunc func(context.Context, *ent.SyncProgressMutation) (ent.Value, error)
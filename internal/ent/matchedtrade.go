@@ -36,6 +36,10 @@ type MatchedTrade struct {
 	BuyQuoteAmount *decimal.Decimal `json:"buyQuoteAmount,omitempty"`
 	// BuyOrderTimestamp holds the value of the "buyOrderTimestamp" field.
 	BuyOrderTimestamp *int64 `json:"buyOrderTimestamp,omitempty"`
+	// BuyPartialBaseAmount holds the value of the "buyPartialBaseAmount" field.
+	BuyPartialBaseAmount *decimal.Decimal `json:"buyPartialBaseAmount,omitempty"`
+	// BuyPartialQuoteAmount holds the value of the "buyPartialQuoteAmount" field.
+	BuyPartialQuoteAmount *decimal.Decimal `json:"buyPartialQuoteAmount,omitempty"`
 	// SellClientOrderId holds the value of the "sellClientOrderId" field.
 	SellClientOrderId *string `json:"sellClientOrderId,omitempty"`
 	// SellBaseAmount holds the value of the "sellBaseAmount" field.
@@ -44,8 +48,18 @@ type MatchedTrade struct {
 	SellQuoteAmount *decimal.Decimal `json:"sellQuoteAmount,omitempty"`
 	// SellOrderTimestamp holds the value of the "sellOrderTimestamp" field.
 	SellOrderTimestamp *int64 `json:"sellOrderTimestamp,omitempty"`
+	// SellPartialBaseAmount holds the value of the "sellPartialBaseAmount" field.
+	SellPartialBaseAmount *decimal.Decimal `json:"sellPartialBaseAmount,omitempty"`
+	// SellPartialQuoteAmount holds the value of the "sellPartialQuoteAmount" field.
+	SellPartialQuoteAmount *decimal.Decimal `json:"sellPartialQuoteAmount,omitempty"`
 	// Profit holds the value of the "profit" field.
-	Profit       *float64 `json:"profit,omitempty"`
+	Profit *float64 `json:"profit,omitempty"`
+	// Fee holds the value of the "fee" field.
+	Fee *float64 `json:"fee,omitempty"`
+	// NetProfit holds the value of the "netProfit" field.
+	NetProfit *float64 `json:"netProfit,omitempty"`
+	// RunId holds the value of the "runId" field.
+	RunId        *int `json:"runId,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -54,11 +68,11 @@ func (*MatchedTrade) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case matchedtrade.FieldBuyBaseAmount, matchedtrade.FieldBuyQuoteAmount, matchedtrade.FieldSellBaseAmount, matchedtrade.FieldSellQuoteAmount:
+		case matchedtrade.FieldBuyBaseAmount, matchedtrade.FieldBuyQuoteAmount, matchedtrade.FieldBuyPartialBaseAmount, matchedtrade.FieldBuyPartialQuoteAmount, matchedtrade.FieldSellBaseAmount, matchedtrade.FieldSellQuoteAmount, matchedtrade.FieldSellPartialBaseAmount, matchedtrade.FieldSellPartialQuoteAmount:
 			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
-		case matchedtrade.FieldProfit:
+		case matchedtrade.FieldProfit, matchedtrade.FieldFee, matchedtrade.FieldNetProfit:
 			values[i] = new(sql.NullFloat64)
-		case matchedtrade.FieldID, matchedtrade.FieldBuyOrderTimestamp, matchedtrade.FieldSellOrderTimestamp:
+		case matchedtrade.FieldID, matchedtrade.FieldBuyOrderTimestamp, matchedtrade.FieldSellOrderTimestamp, matchedtrade.FieldRunId:
 			values[i] = new(sql.NullInt64)
 		case matchedtrade.FieldStrategyId, matchedtrade.FieldAccount, matchedtrade.FieldSymbol, matchedtrade.FieldBuyClientOrderId, matchedtrade.FieldSellClientOrderId:
 			values[i] = new(sql.NullString)
@@ -143,6 +157,20 @@ func (_m *MatchedTrade) assignValues(columns []string, values []any) error {
 				_m.BuyOrderTimestamp = new(int64)
 				*_m.BuyOrderTimestamp = value.Int64
 			}
+		case matchedtrade.FieldBuyPartialBaseAmount:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field buyPartialBaseAmount", values[i])
+			} else if value.Valid {
+				_m.BuyPartialBaseAmount = new(decimal.Decimal)
+				*_m.BuyPartialBaseAmount = *value.S.(*decimal.Decimal)
+			}
+		case matchedtrade.FieldBuyPartialQuoteAmount:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field buyPartialQuoteAmount", values[i])
+			} else if value.Valid {
+				_m.BuyPartialQuoteAmount = new(decimal.Decimal)
+				*_m.BuyPartialQuoteAmount = *value.S.(*decimal.Decimal)
+			}
 		case matchedtrade.FieldSellClientOrderId:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field sellClientOrderId", values[i])
@@ -171,6 +199,20 @@ func (_m *MatchedTrade) assignValues(columns []string, values []any) error {
 				_m.SellOrderTimestamp = new(int64)
 				*_m.SellOrderTimestamp = value.Int64
 			}
+		case matchedtrade.FieldSellPartialBaseAmount:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field sellPartialBaseAmount", values[i])
+			} else if value.Valid {
+				_m.SellPartialBaseAmount = new(decimal.Decimal)
+				*_m.SellPartialBaseAmount = *value.S.(*decimal.Decimal)
+			}
+		case matchedtrade.FieldSellPartialQuoteAmount:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field sellPartialQuoteAmount", values[i])
+			} else if value.Valid {
+				_m.SellPartialQuoteAmount = new(decimal.Decimal)
+				*_m.SellPartialQuoteAmount = *value.S.(*decimal.Decimal)
+			}
 		case matchedtrade.FieldProfit:
 			if value, ok := values[i].(*sql.NullFloat64); !ok {
 				return fmt.Errorf("unexpected type %T for field profit", values[i])
@@ -178,6 +220,27 @@ func (_m *MatchedTrade) assignValues(columns []string, values []any) error {
 				_m.Profit = new(float64)
 				*_m.Profit = value.Float64
 			}
+		case matchedtrade.FieldFee:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field fee", values[i])
+			} else if value.Valid {
+				_m.Fee = new(float64)
+				*_m.Fee = value.Float64
+			}
+		case matchedtrade.FieldNetProfit:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field netProfit", values[i])
+			} else if value.Valid {
+				_m.NetProfit = new(float64)
+				*_m.NetProfit = value.Float64
+			}
+		case matchedtrade.FieldRunId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field runId", values[i])
+			} else if value.Valid {
+				_m.RunId = new(int)
+				*_m.RunId = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -249,6 +312,16 @@ func (_m *MatchedTrade) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := _m.BuyPartialBaseAmount; v != nil {
+		builder.WriteString("buyPartialBaseAmount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.BuyPartialQuoteAmount; v != nil {
+		builder.WriteString("buyPartialQuoteAmount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	if v := _m.SellClientOrderId; v != nil {
 		builder.WriteString("sellClientOrderId=")
 		builder.WriteString(*v)
@@ -269,10 +342,35 @@ func (_m *MatchedTrade) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := _m.SellPartialBaseAmount; v != nil {
+		builder.WriteString("sellPartialBaseAmount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.SellPartialQuoteAmount; v != nil {
+		builder.WriteString("sellPartialQuoteAmount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	if v := _m.Profit; v != nil {
 		builder.WriteString("profit=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	if v := _m.Fee; v != nil {
+		builder.WriteString("fee=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.NetProfit; v != nil {
+		builder.WriteString("netProfit=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RunId; v != nil {
+		builder.WriteString("runId=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
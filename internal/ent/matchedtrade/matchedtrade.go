@@ -31,6 +31,10 @@ const (
 	FieldBuyQuoteAmount = "buy_quote_amount"
 	// FieldBuyOrderTimestamp holds the string denoting the buyordertimestamp field in the database.
 	FieldBuyOrderTimestamp = "buy_order_timestamp"
+	// FieldBuyPartialBaseAmount holds the string denoting the buypartialbaseamount field in the database.
+	FieldBuyPartialBaseAmount = "buy_partial_base_amount"
+	// FieldBuyPartialQuoteAmount holds the string denoting the buypartialquoteamount field in the database.
+	FieldBuyPartialQuoteAmount = "buy_partial_quote_amount"
 	// FieldSellClientOrderId holds the string denoting the sellclientorderid field in the database.
 	FieldSellClientOrderId = "sell_client_order_id"
 	// FieldSellBaseAmount holds the string denoting the sellbaseamount field in the database.
@@ -39,8 +43,18 @@ const (
 	FieldSellQuoteAmount = "sell_quote_amount"
 	// FieldSellOrderTimestamp holds the string denoting the sellordertimestamp field in the database.
 	FieldSellOrderTimestamp = "sell_order_timestamp"
+	// FieldSellPartialBaseAmount holds the string denoting the sellpartialbaseamount field in the database.
+	FieldSellPartialBaseAmount = "sell_partial_base_amount"
+	// FieldSellPartialQuoteAmount holds the string denoting the sellpartialquoteamount field in the database.
+	FieldSellPartialQuoteAmount = "sell_partial_quote_amount"
 	// FieldProfit holds the string denoting the profit field in the database.
 	FieldProfit = "profit"
+	// FieldFee holds the string denoting the fee field in the database.
+	FieldFee = "fee"
+	// FieldNetProfit holds the string denoting the netprofit field in the database.
+	FieldNetProfit = "net_profit"
+	// FieldRunId holds the string denoting the runid field in the database.
+	FieldRunId = "run_id"
 	// Table holds the table name of the matchedtrade in the database.
 	Table = "matched_trades"
 )
@@ -57,11 +71,18 @@ var Columns = []string{
 	FieldBuyBaseAmount,
 	FieldBuyQuoteAmount,
 	FieldBuyOrderTimestamp,
+	FieldBuyPartialBaseAmount,
+	FieldBuyPartialQuoteAmount,
 	FieldSellClientOrderId,
 	FieldSellBaseAmount,
 	FieldSellQuoteAmount,
 	FieldSellOrderTimestamp,
+	FieldSellPartialBaseAmount,
+	FieldSellPartialQuoteAmount,
 	FieldProfit,
+	FieldFee,
+	FieldNetProfit,
+	FieldRunId,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -138,6 +159,16 @@ func ByBuyOrderTimestamp(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBuyOrderTimestamp, opts...).ToFunc()
 }
 
+// ByBuyPartialBaseAmount orders the results by the buyPartialBaseAmount field.
+func ByBuyPartialBaseAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuyPartialBaseAmount, opts...).ToFunc()
+}
+
+// ByBuyPartialQuoteAmount orders the results by the buyPartialQuoteAmount field.
+func ByBuyPartialQuoteAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuyPartialQuoteAmount, opts...).ToFunc()
+}
+
 // BySellClientOrderId orders the results by the sellClientOrderId field.
 func BySellClientOrderId(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSellClientOrderId, opts...).ToFunc()
@@ -158,7 +189,32 @@ func BySellOrderTimestamp(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSellOrderTimestamp, opts...).ToFunc()
 }
 
+// BySellPartialBaseAmount orders the results by the sellPartialBaseAmount field.
+func BySellPartialBaseAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSellPartialBaseAmount, opts...).ToFunc()
+}
+
+// BySellPartialQuoteAmount orders the results by the sellPartialQuoteAmount field.
+func BySellPartialQuoteAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSellPartialQuoteAmount, opts...).ToFunc()
+}
+
 // ByProfit orders the results by the profit field.
 func ByProfit(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProfit, opts...).ToFunc()
 }
+
+// ByFee orders the results by the fee field.
+func ByFee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFee, opts...).ToFunc()
+}
+
+// ByNetProfit orders the results by the netProfit field.
+func ByNetProfit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNetProfit, opts...).ToFunc()
+}
+
+// ByRunId orders the results by the runId field.
+func ByRunId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunId, opts...).ToFunc()
+}
@@ -100,6 +100,16 @@ func BuyOrderTimestamp(v int64) predicate.MatchedTrade {
 	return predicate.MatchedTrade(sql.FieldEQ(FieldBuyOrderTimestamp, v))
 }
 
+// BuyPartialBaseAmount applies equality check predicate on the "buyPartialBaseAmount" field. It's identical to BuyPartialBaseAmountEQ.
+func BuyPartialBaseAmount(v decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldEQ(FieldBuyPartialBaseAmount, v))
+}
+
+// BuyPartialQuoteAmount applies equality check predicate on the "buyPartialQuoteAmount" field. It's identical to BuyPartialQuoteAmountEQ.
+func BuyPartialQuoteAmount(v decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldEQ(FieldBuyPartialQuoteAmount, v))
+}
+
 // SellClientOrderId applies equality check predicate on the "sellClientOrderId" field. It's identical to SellClientOrderIdEQ.
 func SellClientOrderId(v string) predicate.MatchedTrade {
 	return predicate.MatchedTrade(sql.FieldEQ(FieldSellClientOrderId, v))
@@ -120,11 +130,36 @@ func SellOrderTimestamp(v int64) predicate.MatchedTrade {
 	return predicate.MatchedTrade(sql.FieldEQ(FieldSellOrderTimestamp, v))
 }
 
+// SellPartialBaseAmount applies equality check predicate on the "sellPartialBaseAmount" field. It's identical to SellPartialBaseAmountEQ.
+func SellPartialBaseAmount(v decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldEQ(FieldSellPartialBaseAmount, v))
+}
+
+// SellPartialQuoteAmount applies equality check predicate on the "sellPartialQuoteAmount" field. It's identical to SellPartialQuoteAmountEQ.
+func SellPartialQuoteAmount(v decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldEQ(FieldSellPartialQuoteAmount, v))
+}
+
 // Profit applies equality check predicate on the "profit" field. It's identical to ProfitEQ.
 func Profit(v float64) predicate.MatchedTrade {
 	return predicate.MatchedTrade(sql.FieldEQ(FieldProfit, v))
 }
 
+// Fee applies equality check predicate on the "fee" field. It's identical to FeeEQ.
+func Fee(v float64) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldEQ(FieldFee, v))
+}
+
+// NetProfit applies equality check predicate on the "netProfit" field. It's identical to NetProfitEQ.
+func NetProfit(v float64) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldEQ(FieldNetProfit, v))
+}
+
+// RunId applies equality check predicate on the "runId" field. It's identical to RunIdEQ.
+func RunId(v int) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldEQ(FieldRunId, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.MatchedTrade {
 	return predicate.MatchedTrade(sql.FieldEQ(FieldCreateTime, v))
@@ -685,6 +720,166 @@ func BuyOrderTimestampNotNil() predicate.MatchedTrade {
 	return predicate.MatchedTrade(sql.FieldNotNull(FieldBuyOrderTimestamp))
 }
 
+// BuyPartialBaseAmountEQ applies the EQ predicate on the "buyPartialBaseAmount" field.
+func BuyPartialBaseAmountEQ(v decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldEQ(FieldBuyPartialBaseAmount, v))
+}
+
+// BuyPartialBaseAmountNEQ applies the NEQ predicate on the "buyPartialBaseAmount" field.
+func BuyPartialBaseAmountNEQ(v decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldNEQ(FieldBuyPartialBaseAmount, v))
+}
+
+// BuyPartialBaseAmountIn applies the In predicate on the "buyPartialBaseAmount" field.
+func BuyPartialBaseAmountIn(vs ...decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldIn(FieldBuyPartialBaseAmount, vs...))
+}
+
+// BuyPartialBaseAmountNotIn applies the NotIn predicate on the "buyPartialBaseAmount" field.
+func BuyPartialBaseAmountNotIn(vs ...decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldNotIn(FieldBuyPartialBaseAmount, vs...))
+}
+
+// BuyPartialBaseAmountGT applies the GT predicate on the "buyPartialBaseAmount" field.
+func BuyPartialBaseAmountGT(v decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldGT(FieldBuyPartialBaseAmount, v))
+}
+
+// BuyPartialBaseAmountGTE applies the GTE predicate on the "buyPartialBaseAmount" field.
+func BuyPartialBaseAmountGTE(v decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldGTE(FieldBuyPartialBaseAmount, v))
+}
+
+// BuyPartialBaseAmountLT applies the LT predicate on the "buyPartialBaseAmount" field.
+func BuyPartialBaseAmountLT(v decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldLT(FieldBuyPartialBaseAmount, v))
+}
+
+// BuyPartialBaseAmountLTE applies the LTE predicate on the "buyPartialBaseAmount" field.
+func BuyPartialBaseAmountLTE(v decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldLTE(FieldBuyPartialBaseAmount, v))
+}
+
+// BuyPartialBaseAmountContains applies the Contains predicate on the "buyPartialBaseAmount" field.
+func BuyPartialBaseAmountContains(v decimal.Decimal) predicate.MatchedTrade {
+	vc := v.String()
+	return predicate.MatchedTrade(sql.FieldContains(FieldBuyPartialBaseAmount, vc))
+}
+
+// BuyPartialBaseAmountHasPrefix applies the HasPrefix predicate on the "buyPartialBaseAmount" field.
+func BuyPartialBaseAmountHasPrefix(v decimal.Decimal) predicate.MatchedTrade {
+	vc := v.String()
+	return predicate.MatchedTrade(sql.FieldHasPrefix(FieldBuyPartialBaseAmount, vc))
+}
+
+// BuyPartialBaseAmountHasSuffix applies the HasSuffix predicate on the "buyPartialBaseAmount" field.
+func BuyPartialBaseAmountHasSuffix(v decimal.Decimal) predicate.MatchedTrade {
+	vc := v.String()
+	return predicate.MatchedTrade(sql.FieldHasSuffix(FieldBuyPartialBaseAmount, vc))
+}
+
+// BuyPartialBaseAmountIsNil applies the IsNil predicate on the "buyPartialBaseAmount" field.
+func BuyPartialBaseAmountIsNil() predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldIsNull(FieldBuyPartialBaseAmount))
+}
+
+// BuyPartialBaseAmountNotNil applies the NotNil predicate on the "buyPartialBaseAmount" field.
+func BuyPartialBaseAmountNotNil() predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldNotNull(FieldBuyPartialBaseAmount))
+}
+
+// BuyPartialBaseAmountEqualFold applies the EqualFold predicate on the "buyPartialBaseAmount" field.
+func BuyPartialBaseAmountEqualFold(v decimal.Decimal) predicate.MatchedTrade {
+	vc := v.String()
+	return predicate.MatchedTrade(sql.FieldEqualFold(FieldBuyPartialBaseAmount, vc))
+}
+
+// BuyPartialBaseAmountContainsFold applies the ContainsFold predicate on the "buyPartialBaseAmount" field.
+func BuyPartialBaseAmountContainsFold(v decimal.Decimal) predicate.MatchedTrade {
+	vc := v.String()
+	return predicate.MatchedTrade(sql.FieldContainsFold(FieldBuyPartialBaseAmount, vc))
+}
+
+// BuyPartialQuoteAmountEQ applies the EQ predicate on the "buyPartialQuoteAmount" field.
+func BuyPartialQuoteAmountEQ(v decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldEQ(FieldBuyPartialQuoteAmount, v))
+}
+
+// BuyPartialQuoteAmountNEQ applies the NEQ predicate on the "buyPartialQuoteAmount" field.
+func BuyPartialQuoteAmountNEQ(v decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldNEQ(FieldBuyPartialQuoteAmount, v))
+}
+
+// BuyPartialQuoteAmountIn applies the In predicate on the "buyPartialQuoteAmount" field.
+func BuyPartialQuoteAmountIn(vs ...decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldIn(FieldBuyPartialQuoteAmount, vs...))
+}
+
+// BuyPartialQuoteAmountNotIn applies the NotIn predicate on the "buyPartialQuoteAmount" field.
+func BuyPartialQuoteAmountNotIn(vs ...decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldNotIn(FieldBuyPartialQuoteAmount, vs...))
+}
+
+// BuyPartialQuoteAmountGT applies the GT predicate on the "buyPartialQuoteAmount" field.
+func BuyPartialQuoteAmountGT(v decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldGT(FieldBuyPartialQuoteAmount, v))
+}
+
+// BuyPartialQuoteAmountGTE applies the GTE predicate on the "buyPartialQuoteAmount" field.
+func BuyPartialQuoteAmountGTE(v decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldGTE(FieldBuyPartialQuoteAmount, v))
+}
+
+// BuyPartialQuoteAmountLT applies the LT predicate on the "buyPartialQuoteAmount" field.
+func BuyPartialQuoteAmountLT(v decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldLT(FieldBuyPartialQuoteAmount, v))
+}
+
+// BuyPartialQuoteAmountLTE applies the LTE predicate on the "buyPartialQuoteAmount" field.
+func BuyPartialQuoteAmountLTE(v decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldLTE(FieldBuyPartialQuoteAmount, v))
+}
+
+// BuyPartialQuoteAmountContains applies the Contains predicate on the "buyPartialQuoteAmount" field.
+func BuyPartialQuoteAmountContains(v decimal.Decimal) predicate.MatchedTrade {
+	vc := v.String()
+	return predicate.MatchedTrade(sql.FieldContains(FieldBuyPartialQuoteAmount, vc))
+}
+
+// BuyPartialQuoteAmountHasPrefix applies the HasPrefix predicate on the "buyPartialQuoteAmount" field.
+func BuyPartialQuoteAmountHasPrefix(v decimal.Decimal) predicate.MatchedTrade {
+	vc := v.String()
+	return predicate.MatchedTrade(sql.FieldHasPrefix(FieldBuyPartialQuoteAmount, vc))
+}
+
+// BuyPartialQuoteAmountHasSuffix applies the HasSuffix predicate on the "buyPartialQuoteAmount" field.
+func BuyPartialQuoteAmountHasSuffix(v decimal.Decimal) predicate.MatchedTrade {
+	vc := v.String()
+	return predicate.MatchedTrade(sql.FieldHasSuffix(FieldBuyPartialQuoteAmount, vc))
+}
+
+// BuyPartialQuoteAmountIsNil applies the IsNil predicate on the "buyPartialQuoteAmount" field.
+func BuyPartialQuoteAmountIsNil() predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldIsNull(FieldBuyPartialQuoteAmount))
+}
+
+// BuyPartialQuoteAmountNotNil applies the NotNil predicate on the "buyPartialQuoteAmount" field.
+func BuyPartialQuoteAmountNotNil() predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldNotNull(FieldBuyPartialQuoteAmount))
+}
+
+// BuyPartialQuoteAmountEqualFold applies the EqualFold predicate on the "buyPartialQuoteAmount" field.
+func BuyPartialQuoteAmountEqualFold(v decimal.Decimal) predicate.MatchedTrade {
+	vc := v.String()
+	return predicate.MatchedTrade(sql.FieldEqualFold(FieldBuyPartialQuoteAmount, vc))
+}
+
+// BuyPartialQuoteAmountContainsFold applies the ContainsFold predicate on the "buyPartialQuoteAmount" field.
+func BuyPartialQuoteAmountContainsFold(v decimal.Decimal) predicate.MatchedTrade {
+	vc := v.String()
+	return predicate.MatchedTrade(sql.FieldContainsFold(FieldBuyPartialQuoteAmount, vc))
+}
+
 // SellClientOrderIdEQ applies the EQ predicate on the "sellClientOrderId" field.
 func SellClientOrderIdEQ(v string) predicate.MatchedTrade {
 	return predicate.MatchedTrade(sql.FieldEQ(FieldSellClientOrderId, v))
@@ -970,6 +1165,166 @@ func SellOrderTimestampNotNil() predicate.MatchedTrade {
 	return predicate.MatchedTrade(sql.FieldNotNull(FieldSellOrderTimestamp))
 }
 
+// SellPartialBaseAmountEQ applies the EQ predicate on the "sellPartialBaseAmount" field.
+func SellPartialBaseAmountEQ(v decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldEQ(FieldSellPartialBaseAmount, v))
+}
+
+// SellPartialBaseAmountNEQ applies the NEQ predicate on the "sellPartialBaseAmount" field.
+func SellPartialBaseAmountNEQ(v decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldNEQ(FieldSellPartialBaseAmount, v))
+}
+
+// SellPartialBaseAmountIn applies the In predicate on the "sellPartialBaseAmount" field.
+func SellPartialBaseAmountIn(vs ...decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldIn(FieldSellPartialBaseAmount, vs...))
+}
+
+// SellPartialBaseAmountNotIn applies the NotIn predicate on the "sellPartialBaseAmount" field.
+func SellPartialBaseAmountNotIn(vs ...decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldNotIn(FieldSellPartialBaseAmount, vs...))
+}
+
+// SellPartialBaseAmountGT applies the GT predicate on the "sellPartialBaseAmount" field.
+func SellPartialBaseAmountGT(v decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldGT(FieldSellPartialBaseAmount, v))
+}
+
+// SellPartialBaseAmountGTE applies the GTE predicate on the "sellPartialBaseAmount" field.
+func SellPartialBaseAmountGTE(v decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldGTE(FieldSellPartialBaseAmount, v))
+}
+
+// SellPartialBaseAmountLT applies the LT predicate on the "sellPartialBaseAmount" field.
+func SellPartialBaseAmountLT(v decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldLT(FieldSellPartialBaseAmount, v))
+}
+
+// SellPartialBaseAmountLTE applies the LTE predicate on the "sellPartialBaseAmount" field.
+func SellPartialBaseAmountLTE(v decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldLTE(FieldSellPartialBaseAmount, v))
+}
+
+// SellPartialBaseAmountContains applies the Contains predicate on the "sellPartialBaseAmount" field.
+func SellPartialBaseAmountContains(v decimal.Decimal) predicate.MatchedTrade {
+	vc := v.String()
+	return predicate.MatchedTrade(sql.FieldContains(FieldSellPartialBaseAmount, vc))
+}
+
+// SellPartialBaseAmountHasPrefix applies the HasPrefix predicate on the "sellPartialBaseAmount" field.
+func SellPartialBaseAmountHasPrefix(v decimal.Decimal) predicate.MatchedTrade {
+	vc := v.String()
+	return predicate.MatchedTrade(sql.FieldHasPrefix(FieldSellPartialBaseAmount, vc))
+}
+
+// SellPartialBaseAmountHasSuffix applies the HasSuffix predicate on the "sellPartialBaseAmount" field.
+func SellPartialBaseAmountHasSuffix(v decimal.Decimal) predicate.MatchedTrade {
+	vc := v.String()
+	return predicate.MatchedTrade(sql.FieldHasSuffix(FieldSellPartialBaseAmount, vc))
+}
+
+// SellPartialBaseAmountIsNil applies the IsNil predicate on the "sellPartialBaseAmount" field.
+func SellPartialBaseAmountIsNil() predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldIsNull(FieldSellPartialBaseAmount))
+}
+
+// SellPartialBaseAmountNotNil applies the NotNil predicate on the "sellPartialBaseAmount" field.
+func SellPartialBaseAmountNotNil() predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldNotNull(FieldSellPartialBaseAmount))
+}
+
+// SellPartialBaseAmountEqualFold applies the EqualFold predicate on the "sellPartialBaseAmount" field.
+func SellPartialBaseAmountEqualFold(v decimal.Decimal) predicate.MatchedTrade {
+	vc := v.String()
+	return predicate.MatchedTrade(sql.FieldEqualFold(FieldSellPartialBaseAmount, vc))
+}
+
+// SellPartialBaseAmountContainsFold applies the ContainsFold predicate on the "sellPartialBaseAmount" field.
+func SellPartialBaseAmountContainsFold(v decimal.Decimal) predicate.MatchedTrade {
+	vc := v.String()
+	return predicate.MatchedTrade(sql.FieldContainsFold(FieldSellPartialBaseAmount, vc))
+}
+
+// SellPartialQuoteAmountEQ applies the EQ predicate on the "sellPartialQuoteAmount" field.
+func SellPartialQuoteAmountEQ(v decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldEQ(FieldSellPartialQuoteAmount, v))
+}
+
+// SellPartialQuoteAmountNEQ applies the NEQ predicate on the "sellPartialQuoteAmount" field.
+func SellPartialQuoteAmountNEQ(v decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldNEQ(FieldSellPartialQuoteAmount, v))
+}
+
+// SellPartialQuoteAmountIn applies the In predicate on the "sellPartialQuoteAmount" field.
+func SellPartialQuoteAmountIn(vs ...decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldIn(FieldSellPartialQuoteAmount, vs...))
+}
+
+// SellPartialQuoteAmountNotIn applies the NotIn predicate on the "sellPartialQuoteAmount" field.
+func SellPartialQuoteAmountNotIn(vs ...decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldNotIn(FieldSellPartialQuoteAmount, vs...))
+}
+
+// SellPartialQuoteAmountGT applies the GT predicate on the "sellPartialQuoteAmount" field.
+func SellPartialQuoteAmountGT(v decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldGT(FieldSellPartialQuoteAmount, v))
+}
+
+// SellPartialQuoteAmountGTE applies the GTE predicate on the "sellPartialQuoteAmount" field.
+func SellPartialQuoteAmountGTE(v decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldGTE(FieldSellPartialQuoteAmount, v))
+}
+
+// SellPartialQuoteAmountLT applies the LT predicate on the "sellPartialQuoteAmount" field.
+func SellPartialQuoteAmountLT(v decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldLT(FieldSellPartialQuoteAmount, v))
+}
+
+// SellPartialQuoteAmountLTE applies the LTE predicate on the "sellPartialQuoteAmount" field.
+func SellPartialQuoteAmountLTE(v decimal.Decimal) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldLTE(FieldSellPartialQuoteAmount, v))
+}
+
+// SellPartialQuoteAmountContains applies the Contains predicate on the "sellPartialQuoteAmount" field.
+func SellPartialQuoteAmountContains(v decimal.Decimal) predicate.MatchedTrade {
+	vc := v.String()
+	return predicate.MatchedTrade(sql.FieldContains(FieldSellPartialQuoteAmount, vc))
+}
+
+// SellPartialQuoteAmountHasPrefix applies the HasPrefix predicate on the "sellPartialQuoteAmount" field.
+func SellPartialQuoteAmountHasPrefix(v decimal.Decimal) predicate.MatchedTrade {
+	vc := v.String()
+	return predicate.MatchedTrade(sql.FieldHasPrefix(FieldSellPartialQuoteAmount, vc))
+}
+
+// SellPartialQuoteAmountHasSuffix applies the HasSuffix predicate on the "sellPartialQuoteAmount" field.
+func SellPartialQuoteAmountHasSuffix(v decimal.Decimal) predicate.MatchedTrade {
+	vc := v.String()
+	return predicate.MatchedTrade(sql.FieldHasSuffix(FieldSellPartialQuoteAmount, vc))
+}
+
+// SellPartialQuoteAmountIsNil applies the IsNil predicate on the "sellPartialQuoteAmount" field.
+func SellPartialQuoteAmountIsNil() predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldIsNull(FieldSellPartialQuoteAmount))
+}
+
+// SellPartialQuoteAmountNotNil applies the NotNil predicate on the "sellPartialQuoteAmount" field.
+func SellPartialQuoteAmountNotNil() predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldNotNull(FieldSellPartialQuoteAmount))
+}
+
+// SellPartialQuoteAmountEqualFold applies the EqualFold predicate on the "sellPartialQuoteAmount" field.
+func SellPartialQuoteAmountEqualFold(v decimal.Decimal) predicate.MatchedTrade {
+	vc := v.String()
+	return predicate.MatchedTrade(sql.FieldEqualFold(FieldSellPartialQuoteAmount, vc))
+}
+
+// SellPartialQuoteAmountContainsFold applies the ContainsFold predicate on the "sellPartialQuoteAmount" field.
+func SellPartialQuoteAmountContainsFold(v decimal.Decimal) predicate.MatchedTrade {
+	vc := v.String()
+	return predicate.MatchedTrade(sql.FieldContainsFold(FieldSellPartialQuoteAmount, vc))
+}
+
 // ProfitEQ applies the EQ predicate on the "profit" field.
 func ProfitEQ(v float64) predicate.MatchedTrade {
 	return predicate.MatchedTrade(sql.FieldEQ(FieldProfit, v))
@@ -1020,6 +1375,156 @@ func ProfitNotNil() predicate.MatchedTrade {
 	return predicate.MatchedTrade(sql.FieldNotNull(FieldProfit))
 }
 
+// FeeEQ applies the EQ predicate on the "fee" field.
+func FeeEQ(v float64) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldEQ(FieldFee, v))
+}
+
+// FeeNEQ applies the NEQ predicate on the "fee" field.
+func FeeNEQ(v float64) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldNEQ(FieldFee, v))
+}
+
+// FeeIn applies the In predicate on the "fee" field.
+func FeeIn(vs ...float64) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldIn(FieldFee, vs...))
+}
+
+// FeeNotIn applies the NotIn predicate on the "fee" field.
+func FeeNotIn(vs ...float64) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldNotIn(FieldFee, vs...))
+}
+
+// FeeGT applies the GT predicate on the "fee" field.
+func FeeGT(v float64) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldGT(FieldFee, v))
+}
+
+// FeeGTE applies the GTE predicate on the "fee" field.
+func FeeGTE(v float64) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldGTE(FieldFee, v))
+}
+
+// FeeLT applies the LT predicate on the "fee" field.
+func FeeLT(v float64) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldLT(FieldFee, v))
+}
+
+// FeeLTE applies the LTE predicate on the "fee" field.
+func FeeLTE(v float64) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldLTE(FieldFee, v))
+}
+
+// FeeIsNil applies the IsNil predicate on the "fee" field.
+func FeeIsNil() predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldIsNull(FieldFee))
+}
+
+// FeeNotNil applies the NotNil predicate on the "fee" field.
+func FeeNotNil() predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldNotNull(FieldFee))
+}
+
+// NetProfitEQ applies the EQ predicate on the "netProfit" field.
+func NetProfitEQ(v float64) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldEQ(FieldNetProfit, v))
+}
+
+// NetProfitNEQ applies the NEQ predicate on the "netProfit" field.
+func NetProfitNEQ(v float64) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldNEQ(FieldNetProfit, v))
+}
+
+// NetProfitIn applies the In predicate on the "netProfit" field.
+func NetProfitIn(vs ...float64) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldIn(FieldNetProfit, vs...))
+}
+
+// NetProfitNotIn applies the NotIn predicate on the "netProfit" field.
+func NetProfitNotIn(vs ...float64) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldNotIn(FieldNetProfit, vs...))
+}
+
+// NetProfitGT applies the GT predicate on the "netProfit" field.
+func NetProfitGT(v float64) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldGT(FieldNetProfit, v))
+}
+
+// NetProfitGTE applies the GTE predicate on the "netProfit" field.
+func NetProfitGTE(v float64) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldGTE(FieldNetProfit, v))
+}
+
+// NetProfitLT applies the LT predicate on the "netProfit" field.
+func NetProfitLT(v float64) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldLT(FieldNetProfit, v))
+}
+
+// NetProfitLTE applies the LTE predicate on the "netProfit" field.
+func NetProfitLTE(v float64) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldLTE(FieldNetProfit, v))
+}
+
+// NetProfitIsNil applies the IsNil predicate on the "netProfit" field.
+func NetProfitIsNil() predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldIsNull(FieldNetProfit))
+}
+
+// NetProfitNotNil applies the NotNil predicate on the "netProfit" field.
+func NetProfitNotNil() predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldNotNull(FieldNetProfit))
+}
+
+// RunIdEQ applies the EQ predicate on the "runId" field.
+func RunIdEQ(v int) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldEQ(FieldRunId, v))
+}
+
+// RunIdNEQ applies the NEQ predicate on the "runId" field.
+func RunIdNEQ(v int) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldNEQ(FieldRunId, v))
+}
+
+// RunIdIn applies the In predicate on the "runId" field.
+func RunIdIn(vs ...int) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldIn(FieldRunId, vs...))
+}
+
+// RunIdNotIn applies the NotIn predicate on the "runId" field.
+func RunIdNotIn(vs ...int) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldNotIn(FieldRunId, vs...))
+}
+
+// RunIdGT applies the GT predicate on the "runId" field.
+func RunIdGT(v int) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldGT(FieldRunId, v))
+}
+
+// RunIdGTE applies the GTE predicate on the "runId" field.
+func RunIdGTE(v int) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldGTE(FieldRunId, v))
+}
+
+// RunIdLT applies the LT predicate on the "runId" field.
+func RunIdLT(v int) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldLT(FieldRunId, v))
+}
+
+// RunIdLTE applies the LTE predicate on the "runId" field.
+func RunIdLTE(v int) predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldLTE(FieldRunId, v))
+}
+
+// RunIdIsNil applies the IsNil predicate on the "runId" field.
+func RunIdIsNil() predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldIsNull(FieldRunId))
+}
+
+// RunIdNotNil applies the NotNil predicate on the "runId" field.
+func RunIdNotNil() predicate.MatchedTrade {
+	return predicate.MatchedTrade(sql.FieldNotNull(FieldRunId))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.MatchedTrade) predicate.MatchedTrade {
 	return predicate.MatchedTrade(sql.AndPredicates(predicates...))
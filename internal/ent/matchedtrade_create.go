@@ -125,6 +125,34 @@ func (_c *MatchedTradeCreate) SetNillableBuyOrderTimestamp(v *int64) *MatchedTra
 	return _c
 }
 
+// SetBuyPartialBaseAmount sets the "buyPartialBaseAmount" field.
+func (_c *MatchedTradeCreate) SetBuyPartialBaseAmount(v decimal.Decimal) *MatchedTradeCreate {
+	_c.mutation.SetBuyPartialBaseAmount(v)
+	return _c
+}
+
+// SetNillableBuyPartialBaseAmount sets the "buyPartialBaseAmount" field if the given value is not nil.
+func (_c *MatchedTradeCreate) SetNillableBuyPartialBaseAmount(v *decimal.Decimal) *MatchedTradeCreate {
+	if v != nil {
+		_c.SetBuyPartialBaseAmount(*v)
+	}
+	return _c
+}
+
+// SetBuyPartialQuoteAmount sets the "buyPartialQuoteAmount" field.
+func (_c *MatchedTradeCreate) SetBuyPartialQuoteAmount(v decimal.Decimal) *MatchedTradeCreate {
+	_c.mutation.SetBuyPartialQuoteAmount(v)
+	return _c
+}
+
+// SetNillableBuyPartialQuoteAmount sets the "buyPartialQuoteAmount" field if the given value is not nil.
+func (_c *MatchedTradeCreate) SetNillableBuyPartialQuoteAmount(v *decimal.Decimal) *MatchedTradeCreate {
+	if v != nil {
+		_c.SetBuyPartialQuoteAmount(*v)
+	}
+	return _c
+}
+
 // SetSellClientOrderId sets the "sellClientOrderId" field.
 func (_c *MatchedTradeCreate) SetSellClientOrderId(v string) *MatchedTradeCreate {
 	_c.mutation.SetSellClientOrderId(v)
@@ -181,6 +209,34 @@ func (_c *MatchedTradeCreate) SetNillableSellOrderTimestamp(v *int64) *MatchedTr
 	return _c
 }
 
+// SetSellPartialBaseAmount sets the "sellPartialBaseAmount" field.
+func (_c *MatchedTradeCreate) SetSellPartialBaseAmount(v decimal.Decimal) *MatchedTradeCreate {
+	_c.mutation.SetSellPartialBaseAmount(v)
+	return _c
+}
+
+// SetNillableSellPartialBaseAmount sets the "sellPartialBaseAmount" field if the given value is not nil.
+func (_c *MatchedTradeCreate) SetNillableSellPartialBaseAmount(v *decimal.Decimal) *MatchedTradeCreate {
+	if v != nil {
+		_c.SetSellPartialBaseAmount(*v)
+	}
+	return _c
+}
+
+// SetSellPartialQuoteAmount sets the "sellPartialQuoteAmount" field.
+func (_c *MatchedTradeCreate) SetSellPartialQuoteAmount(v decimal.Decimal) *MatchedTradeCreate {
+	_c.mutation.SetSellPartialQuoteAmount(v)
+	return _c
+}
+
+// SetNillableSellPartialQuoteAmount sets the "sellPartialQuoteAmount" field if the given value is not nil.
+func (_c *MatchedTradeCreate) SetNillableSellPartialQuoteAmount(v *decimal.Decimal) *MatchedTradeCreate {
+	if v != nil {
+		_c.SetSellPartialQuoteAmount(*v)
+	}
+	return _c
+}
+
 // SetProfit sets the "profit" field.
 func (_c *MatchedTradeCreate) SetProfit(v float64) *MatchedTradeCreate {
 	_c.mutation.SetProfit(v)
@@ -195,6 +251,48 @@ func (_c *MatchedTradeCreate) SetNillableProfit(v *float64) *MatchedTradeCreate
 	return _c
 }
 
+// SetFee sets the "fee" field.
+func (_c *MatchedTradeCreate) SetFee(v float64) *MatchedTradeCreate {
+	_c.mutation.SetFee(v)
+	return _c
+}
+
+// SetNillableFee sets the "fee" field if the given value is not nil.
+func (_c *MatchedTradeCreate) SetNillableFee(v *float64) *MatchedTradeCreate {
+	if v != nil {
+		_c.SetFee(*v)
+	}
+	return _c
+}
+
+// SetNetProfit sets the "netProfit" field.
+func (_c *MatchedTradeCreate) SetNetProfit(v float64) *MatchedTradeCreate {
+	_c.mutation.SetNetProfit(v)
+	return _c
+}
+
+// SetNillableNetProfit sets the "netProfit" field if the given value is not nil.
+func (_c *MatchedTradeCreate) SetNillableNetProfit(v *float64) *MatchedTradeCreate {
+	if v != nil {
+		_c.SetNetProfit(*v)
+	}
+	return _c
+}
+
+// SetRunId sets the "runId" field.
+func (_c *MatchedTradeCreate) SetRunId(v int) *MatchedTradeCreate {
+	_c.mutation.SetRunId(v)
+	return _c
+}
+
+// SetNillableRunId sets the "runId" field if the given value is not nil.
+func (_c *MatchedTradeCreate) SetNillableRunId(v *int) *MatchedTradeCreate {
+	if v != nil {
+		_c.SetRunId(*v)
+	}
+	return _c
+}
+
 // Mutation returns the MatchedTradeMutation object of the builder.
 func (_c *MatchedTradeCreate) Mutation() *MatchedTradeMutation {
 	return _c.mutation
@@ -325,6 +423,14 @@ func (_c *MatchedTradeCreate) createSpec() (*MatchedTrade, *sqlgraph.CreateSpec)
 		_spec.SetField(matchedtrade.FieldBuyOrderTimestamp, field.TypeInt64, value)
 		_node.BuyOrderTimestamp = &value
 	}
+	if value, ok := _c.mutation.BuyPartialBaseAmount(); ok {
+		_spec.SetField(matchedtrade.FieldBuyPartialBaseAmount, field.TypeString, value)
+		_node.BuyPartialBaseAmount = &value
+	}
+	if value, ok := _c.mutation.BuyPartialQuoteAmount(); ok {
+		_spec.SetField(matchedtrade.FieldBuyPartialQuoteAmount, field.TypeString, value)
+		_node.BuyPartialQuoteAmount = &value
+	}
 	if value, ok := _c.mutation.SellClientOrderId(); ok {
 		_spec.SetField(matchedtrade.FieldSellClientOrderId, field.TypeString, value)
 		_node.SellClientOrderId = &value
@@ -341,10 +447,30 @@ func (_c *MatchedTradeCreate) createSpec() (*MatchedTrade, *sqlgraph.CreateSpec)
 		_spec.SetField(matchedtrade.FieldSellOrderTimestamp, field.TypeInt64, value)
 		_node.SellOrderTimestamp = &value
 	}
+	if value, ok := _c.mutation.SellPartialBaseAmount(); ok {
+		_spec.SetField(matchedtrade.FieldSellPartialBaseAmount, field.TypeString, value)
+		_node.SellPartialBaseAmount = &value
+	}
+	if value, ok := _c.mutation.SellPartialQuoteAmount(); ok {
+		_spec.SetField(matchedtrade.FieldSellPartialQuoteAmount, field.TypeString, value)
+		_node.SellPartialQuoteAmount = &value
+	}
 	if value, ok := _c.mutation.Profit(); ok {
 		_spec.SetField(matchedtrade.FieldProfit, field.TypeFloat64, value)
 		_node.Profit = &value
 	}
+	if value, ok := _c.mutation.Fee(); ok {
+		_spec.SetField(matchedtrade.FieldFee, field.TypeFloat64, value)
+		_node.Fee = &value
+	}
+	if value, ok := _c.mutation.NetProfit(); ok {
+		_spec.SetField(matchedtrade.FieldNetProfit, field.TypeFloat64, value)
+		_node.NetProfit = &value
+	}
+	if value, ok := _c.mutation.RunId(); ok {
+		_spec.SetField(matchedtrade.FieldRunId, field.TypeInt, value)
+		_node.RunId = &value
+	}
 	return _node, _spec
 }
 
@@ -523,6 +649,42 @@ func (u *MatchedTradeUpsert) ClearBuyOrderTimestamp() *MatchedTradeUpsert {
 	return u
 }
 
+// SetBuyPartialBaseAmount sets the "buyPartialBaseAmount" field.
+func (u *MatchedTradeUpsert) SetBuyPartialBaseAmount(v decimal.Decimal) *MatchedTradeUpsert {
+	u.Set(matchedtrade.FieldBuyPartialBaseAmount, v)
+	return u
+}
+
+// UpdateBuyPartialBaseAmount sets the "buyPartialBaseAmount" field to the value that was provided on create.
+func (u *MatchedTradeUpsert) UpdateBuyPartialBaseAmount() *MatchedTradeUpsert {
+	u.SetExcluded(matchedtrade.FieldBuyPartialBaseAmount)
+	return u
+}
+
+// ClearBuyPartialBaseAmount clears the value of the "buyPartialBaseAmount" field.
+func (u *MatchedTradeUpsert) ClearBuyPartialBaseAmount() *MatchedTradeUpsert {
+	u.SetNull(matchedtrade.FieldBuyPartialBaseAmount)
+	return u
+}
+
+// SetBuyPartialQuoteAmount sets the "buyPartialQuoteAmount" field.
+func (u *MatchedTradeUpsert) SetBuyPartialQuoteAmount(v decimal.Decimal) *MatchedTradeUpsert {
+	u.Set(matchedtrade.FieldBuyPartialQuoteAmount, v)
+	return u
+}
+
+// UpdateBuyPartialQuoteAmount sets the "buyPartialQuoteAmount" field to the value that was provided on create.
+func (u *MatchedTradeUpsert) UpdateBuyPartialQuoteAmount() *MatchedTradeUpsert {
+	u.SetExcluded(matchedtrade.FieldBuyPartialQuoteAmount)
+	return u
+}
+
+// ClearBuyPartialQuoteAmount clears the value of the "buyPartialQuoteAmount" field.
+func (u *MatchedTradeUpsert) ClearBuyPartialQuoteAmount() *MatchedTradeUpsert {
+	u.SetNull(matchedtrade.FieldBuyPartialQuoteAmount)
+	return u
+}
+
 // SetSellClientOrderId sets the "sellClientOrderId" field.
 func (u *MatchedTradeUpsert) SetSellClientOrderId(v string) *MatchedTradeUpsert {
 	u.Set(matchedtrade.FieldSellClientOrderId, v)
@@ -601,6 +763,42 @@ func (u *MatchedTradeUpsert) ClearSellOrderTimestamp() *MatchedTradeUpsert {
 	return u
 }
 
+// SetSellPartialBaseAmount sets the "sellPartialBaseAmount" field.
+func (u *MatchedTradeUpsert) SetSellPartialBaseAmount(v decimal.Decimal) *MatchedTradeUpsert {
+	u.Set(matchedtrade.FieldSellPartialBaseAmount, v)
+	return u
+}
+
+// UpdateSellPartialBaseAmount sets the "sellPartialBaseAmount" field to the value that was provided on create.
+func (u *MatchedTradeUpsert) UpdateSellPartialBaseAmount() *MatchedTradeUpsert {
+	u.SetExcluded(matchedtrade.FieldSellPartialBaseAmount)
+	return u
+}
+
+// ClearSellPartialBaseAmount clears the value of the "sellPartialBaseAmount" field.
+func (u *MatchedTradeUpsert) ClearSellPartialBaseAmount() *MatchedTradeUpsert {
+	u.SetNull(matchedtrade.FieldSellPartialBaseAmount)
+	return u
+}
+
+// SetSellPartialQuoteAmount sets the "sellPartialQuoteAmount" field.
+func (u *MatchedTradeUpsert) SetSellPartialQuoteAmount(v decimal.Decimal) *MatchedTradeUpsert {
+	u.Set(matchedtrade.FieldSellPartialQuoteAmount, v)
+	return u
+}
+
+// UpdateSellPartialQuoteAmount sets the "sellPartialQuoteAmount" field to the value that was provided on create.
+func (u *MatchedTradeUpsert) UpdateSellPartialQuoteAmount() *MatchedTradeUpsert {
+	u.SetExcluded(matchedtrade.FieldSellPartialQuoteAmount)
+	return u
+}
+
+// ClearSellPartialQuoteAmount clears the value of the "sellPartialQuoteAmount" field.
+func (u *MatchedTradeUpsert) ClearSellPartialQuoteAmount() *MatchedTradeUpsert {
+	u.SetNull(matchedtrade.FieldSellPartialQuoteAmount)
+	return u
+}
+
 // SetProfit sets the "profit" field.
 func (u *MatchedTradeUpsert) SetProfit(v float64) *MatchedTradeUpsert {
 	u.Set(matchedtrade.FieldProfit, v)
@@ -625,6 +823,78 @@ func (u *MatchedTradeUpsert) ClearProfit() *MatchedTradeUpsert {
 	return u
 }
 
+// SetFee sets the "fee" field.
+func (u *MatchedTradeUpsert) SetFee(v float64) *MatchedTradeUpsert {
+	u.Set(matchedtrade.FieldFee, v)
+	return u
+}
+
+// UpdateFee sets the "fee" field to the value that was provided on create.
+func (u *MatchedTradeUpsert) UpdateFee() *MatchedTradeUpsert {
+	u.SetExcluded(matchedtrade.FieldFee)
+	return u
+}
+
+// AddFee adds v to the "fee" field.
+func (u *MatchedTradeUpsert) AddFee(v float64) *MatchedTradeUpsert {
+	u.Add(matchedtrade.FieldFee, v)
+	return u
+}
+
+// ClearFee clears the value of the "fee" field.
+func (u *MatchedTradeUpsert) ClearFee() *MatchedTradeUpsert {
+	u.SetNull(matchedtrade.FieldFee)
+	return u
+}
+
+// SetNetProfit sets the "netProfit" field.
+func (u *MatchedTradeUpsert) SetNetProfit(v float64) *MatchedTradeUpsert {
+	u.Set(matchedtrade.FieldNetProfit, v)
+	return u
+}
+
+// UpdateNetProfit sets the "netProfit" field to the value that was provided on create.
+func (u *MatchedTradeUpsert) UpdateNetProfit() *MatchedTradeUpsert {
+	u.SetExcluded(matchedtrade.FieldNetProfit)
+	return u
+}
+
+// AddNetProfit adds v to the "netProfit" field.
+func (u *MatchedTradeUpsert) AddNetProfit(v float64) *MatchedTradeUpsert {
+	u.Add(matchedtrade.FieldNetProfit, v)
+	return u
+}
+
+// ClearNetProfit clears the value of the "netProfit" field.
+func (u *MatchedTradeUpsert) ClearNetProfit() *MatchedTradeUpsert {
+	u.SetNull(matchedtrade.FieldNetProfit)
+	return u
+}
+
+// SetRunId sets the "runId" field.
+func (u *MatchedTradeUpsert) SetRunId(v int) *MatchedTradeUpsert {
+	u.Set(matchedtrade.FieldRunId, v)
+	return u
+}
+
+// UpdateRunId sets the "runId" field to the value that was provided on create.
+func (u *MatchedTradeUpsert) UpdateRunId() *MatchedTradeUpsert {
+	u.SetExcluded(matchedtrade.FieldRunId)
+	return u
+}
+
+// AddRunId adds v to the "runId" field.
+func (u *MatchedTradeUpsert) AddRunId(v int) *MatchedTradeUpsert {
+	u.Add(matchedtrade.FieldRunId, v)
+	return u
+}
+
+// ClearRunId clears the value of the "runId" field.
+func (u *MatchedTradeUpsert) ClearRunId() *MatchedTradeUpsert {
+	u.SetNull(matchedtrade.FieldRunId)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -817,6 +1087,48 @@ func (u *MatchedTradeUpsertOne) ClearBuyOrderTimestamp() *MatchedTradeUpsertOne
 	})
 }
 
+// SetBuyPartialBaseAmount sets the "buyPartialBaseAmount" field.
+func (u *MatchedTradeUpsertOne) SetBuyPartialBaseAmount(v decimal.Decimal) *MatchedTradeUpsertOne {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.SetBuyPartialBaseAmount(v)
+	})
+}
+
+// UpdateBuyPartialBaseAmount sets the "buyPartialBaseAmount" field to the value that was provided on create.
+func (u *MatchedTradeUpsertOne) UpdateBuyPartialBaseAmount() *MatchedTradeUpsertOne {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.UpdateBuyPartialBaseAmount()
+	})
+}
+
+// ClearBuyPartialBaseAmount clears the value of the "buyPartialBaseAmount" field.
+func (u *MatchedTradeUpsertOne) ClearBuyPartialBaseAmount() *MatchedTradeUpsertOne {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.ClearBuyPartialBaseAmount()
+	})
+}
+
+// SetBuyPartialQuoteAmount sets the "buyPartialQuoteAmount" field.
+func (u *MatchedTradeUpsertOne) SetBuyPartialQuoteAmount(v decimal.Decimal) *MatchedTradeUpsertOne {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.SetBuyPartialQuoteAmount(v)
+	})
+}
+
+// UpdateBuyPartialQuoteAmount sets the "buyPartialQuoteAmount" field to the value that was provided on create.
+func (u *MatchedTradeUpsertOne) UpdateBuyPartialQuoteAmount() *MatchedTradeUpsertOne {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.UpdateBuyPartialQuoteAmount()
+	})
+}
+
+// ClearBuyPartialQuoteAmount clears the value of the "buyPartialQuoteAmount" field.
+func (u *MatchedTradeUpsertOne) ClearBuyPartialQuoteAmount() *MatchedTradeUpsertOne {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.ClearBuyPartialQuoteAmount()
+	})
+}
+
 // SetSellClientOrderId sets the "sellClientOrderId" field.
 func (u *MatchedTradeUpsertOne) SetSellClientOrderId(v string) *MatchedTradeUpsertOne {
 	return u.Update(func(s *MatchedTradeUpsert) {
@@ -908,6 +1220,48 @@ func (u *MatchedTradeUpsertOne) ClearSellOrderTimestamp() *MatchedTradeUpsertOne
 	})
 }
 
+// SetSellPartialBaseAmount sets the "sellPartialBaseAmount" field.
+func (u *MatchedTradeUpsertOne) SetSellPartialBaseAmount(v decimal.Decimal) *MatchedTradeUpsertOne {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.SetSellPartialBaseAmount(v)
+	})
+}
+
+// UpdateSellPartialBaseAmount sets the "sellPartialBaseAmount" field to the value that was provided on create.
+func (u *MatchedTradeUpsertOne) UpdateSellPartialBaseAmount() *MatchedTradeUpsertOne {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.UpdateSellPartialBaseAmount()
+	})
+}
+
+// ClearSellPartialBaseAmount clears the value of the "sellPartialBaseAmount" field.
+func (u *MatchedTradeUpsertOne) ClearSellPartialBaseAmount() *MatchedTradeUpsertOne {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.ClearSellPartialBaseAmount()
+	})
+}
+
+// SetSellPartialQuoteAmount sets the "sellPartialQuoteAmount" field.
+func (u *MatchedTradeUpsertOne) SetSellPartialQuoteAmount(v decimal.Decimal) *MatchedTradeUpsertOne {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.SetSellPartialQuoteAmount(v)
+	})
+}
+
+// UpdateSellPartialQuoteAmount sets the "sellPartialQuoteAmount" field to the value that was provided on create.
+func (u *MatchedTradeUpsertOne) UpdateSellPartialQuoteAmount() *MatchedTradeUpsertOne {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.UpdateSellPartialQuoteAmount()
+	})
+}
+
+// ClearSellPartialQuoteAmount clears the value of the "sellPartialQuoteAmount" field.
+func (u *MatchedTradeUpsertOne) ClearSellPartialQuoteAmount() *MatchedTradeUpsertOne {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.ClearSellPartialQuoteAmount()
+	})
+}
+
 // SetProfit sets the "profit" field.
 func (u *MatchedTradeUpsertOne) SetProfit(v float64) *MatchedTradeUpsertOne {
 	return u.Update(func(s *MatchedTradeUpsert) {
@@ -936,6 +1290,90 @@ func (u *MatchedTradeUpsertOne) ClearProfit() *MatchedTradeUpsertOne {
 	})
 }
 
+// SetFee sets the "fee" field.
+func (u *MatchedTradeUpsertOne) SetFee(v float64) *MatchedTradeUpsertOne {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.SetFee(v)
+	})
+}
+
+// AddFee adds v to the "fee" field.
+func (u *MatchedTradeUpsertOne) AddFee(v float64) *MatchedTradeUpsertOne {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.AddFee(v)
+	})
+}
+
+// UpdateFee sets the "fee" field to the value that was provided on create.
+func (u *MatchedTradeUpsertOne) UpdateFee() *MatchedTradeUpsertOne {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.UpdateFee()
+	})
+}
+
+// ClearFee clears the value of the "fee" field.
+func (u *MatchedTradeUpsertOne) ClearFee() *MatchedTradeUpsertOne {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.ClearFee()
+	})
+}
+
+// SetNetProfit sets the "netProfit" field.
+func (u *MatchedTradeUpsertOne) SetNetProfit(v float64) *MatchedTradeUpsertOne {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.SetNetProfit(v)
+	})
+}
+
+// AddNetProfit adds v to the "netProfit" field.
+func (u *MatchedTradeUpsertOne) AddNetProfit(v float64) *MatchedTradeUpsertOne {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.AddNetProfit(v)
+	})
+}
+
+// UpdateNetProfit sets the "netProfit" field to the value that was provided on create.
+func (u *MatchedTradeUpsertOne) UpdateNetProfit() *MatchedTradeUpsertOne {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.UpdateNetProfit()
+	})
+}
+
+// ClearNetProfit clears the value of the "netProfit" field.
+func (u *MatchedTradeUpsertOne) ClearNetProfit() *MatchedTradeUpsertOne {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.ClearNetProfit()
+	})
+}
+
+// SetRunId sets the "runId" field.
+func (u *MatchedTradeUpsertOne) SetRunId(v int) *MatchedTradeUpsertOne {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.SetRunId(v)
+	})
+}
+
+// AddRunId adds v to the "runId" field.
+func (u *MatchedTradeUpsertOne) AddRunId(v int) *MatchedTradeUpsertOne {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.AddRunId(v)
+	})
+}
+
+// UpdateRunId sets the "runId" field to the value that was provided on create.
+func (u *MatchedTradeUpsertOne) UpdateRunId() *MatchedTradeUpsertOne {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.UpdateRunId()
+	})
+}
+
+// ClearRunId clears the value of the "runId" field.
+func (u *MatchedTradeUpsertOne) ClearRunId() *MatchedTradeUpsertOne {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.ClearRunId()
+	})
+}
+
 // Exec executes the query.
 func (u *MatchedTradeUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1294,6 +1732,48 @@ func (u *MatchedTradeUpsertBulk) ClearBuyOrderTimestamp() *MatchedTradeUpsertBul
 	})
 }
 
+// SetBuyPartialBaseAmount sets the "buyPartialBaseAmount" field.
+func (u *MatchedTradeUpsertBulk) SetBuyPartialBaseAmount(v decimal.Decimal) *MatchedTradeUpsertBulk {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.SetBuyPartialBaseAmount(v)
+	})
+}
+
+// UpdateBuyPartialBaseAmount sets the "buyPartialBaseAmount" field to the value that was provided on create.
+func (u *MatchedTradeUpsertBulk) UpdateBuyPartialBaseAmount() *MatchedTradeUpsertBulk {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.UpdateBuyPartialBaseAmount()
+	})
+}
+
+// ClearBuyPartialBaseAmount clears the value of the "buyPartialBaseAmount" field.
+func (u *MatchedTradeUpsertBulk) ClearBuyPartialBaseAmount() *MatchedTradeUpsertBulk {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.ClearBuyPartialBaseAmount()
+	})
+}
+
+// SetBuyPartialQuoteAmount sets the "buyPartialQuoteAmount" field.
+func (u *MatchedTradeUpsertBulk) SetBuyPartialQuoteAmount(v decimal.Decimal) *MatchedTradeUpsertBulk {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.SetBuyPartialQuoteAmount(v)
+	})
+}
+
+// UpdateBuyPartialQuoteAmount sets the "buyPartialQuoteAmount" field to the value that was provided on create.
+func (u *MatchedTradeUpsertBulk) UpdateBuyPartialQuoteAmount() *MatchedTradeUpsertBulk {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.UpdateBuyPartialQuoteAmount()
+	})
+}
+
+// ClearBuyPartialQuoteAmount clears the value of the "buyPartialQuoteAmount" field.
+func (u *MatchedTradeUpsertBulk) ClearBuyPartialQuoteAmount() *MatchedTradeUpsertBulk {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.ClearBuyPartialQuoteAmount()
+	})
+}
+
 // SetSellClientOrderId sets the "sellClientOrderId" field.
 func (u *MatchedTradeUpsertBulk) SetSellClientOrderId(v string) *MatchedTradeUpsertBulk {
 	return u.Update(func(s *MatchedTradeUpsert) {
@@ -1385,6 +1865,48 @@ func (u *MatchedTradeUpsertBulk) ClearSellOrderTimestamp() *MatchedTradeUpsertBu
 	})
 }
 
+// SetSellPartialBaseAmount sets the "sellPartialBaseAmount" field.
+func (u *MatchedTradeUpsertBulk) SetSellPartialBaseAmount(v decimal.Decimal) *MatchedTradeUpsertBulk {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.SetSellPartialBaseAmount(v)
+	})
+}
+
+// UpdateSellPartialBaseAmount sets the "sellPartialBaseAmount" field to the value that was provided on create.
+func (u *MatchedTradeUpsertBulk) UpdateSellPartialBaseAmount() *MatchedTradeUpsertBulk {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.UpdateSellPartialBaseAmount()
+	})
+}
+
+// ClearSellPartialBaseAmount clears the value of the "sellPartialBaseAmount" field.
+func (u *MatchedTradeUpsertBulk) ClearSellPartialBaseAmount() *MatchedTradeUpsertBulk {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.ClearSellPartialBaseAmount()
+	})
+}
+
+// SetSellPartialQuoteAmount sets the "sellPartialQuoteAmount" field.
+func (u *MatchedTradeUpsertBulk) SetSellPartialQuoteAmount(v decimal.Decimal) *MatchedTradeUpsertBulk {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.SetSellPartialQuoteAmount(v)
+	})
+}
+
+// UpdateSellPartialQuoteAmount sets the "sellPartialQuoteAmount" field to the value that was provided on create.
+func (u *MatchedTradeUpsertBulk) UpdateSellPartialQuoteAmount() *MatchedTradeUpsertBulk {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.UpdateSellPartialQuoteAmount()
+	})
+}
+
+// ClearSellPartialQuoteAmount clears the value of the "sellPartialQuoteAmount" field.
+func (u *MatchedTradeUpsertBulk) ClearSellPartialQuoteAmount() *MatchedTradeUpsertBulk {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.ClearSellPartialQuoteAmount()
+	})
+}
+
 // SetProfit sets the "profit" field.
 func (u *MatchedTradeUpsertBulk) SetProfit(v float64) *MatchedTradeUpsertBulk {
 	return u.Update(func(s *MatchedTradeUpsert) {
@@ -1413,6 +1935,90 @@ func (u *MatchedTradeUpsertBulk) ClearProfit() *MatchedTradeUpsertBulk {
 	})
 }
 
+// SetFee sets the "fee" field.
+func (u *MatchedTradeUpsertBulk) SetFee(v float64) *MatchedTradeUpsertBulk {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.SetFee(v)
+	})
+}
+
+// AddFee adds v to the "fee" field.
+func (u *MatchedTradeUpsertBulk) AddFee(v float64) *MatchedTradeUpsertBulk {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.AddFee(v)
+	})
+}
+
+// UpdateFee sets the "fee" field to the value that was provided on create.
+func (u *MatchedTradeUpsertBulk) UpdateFee() *MatchedTradeUpsertBulk {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.UpdateFee()
+	})
+}
+
+// ClearFee clears the value of the "fee" field.
+func (u *MatchedTradeUpsertBulk) ClearFee() *MatchedTradeUpsertBulk {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.ClearFee()
+	})
+}
+
+// SetNetProfit sets the "netProfit" field.
+func (u *MatchedTradeUpsertBulk) SetNetProfit(v float64) *MatchedTradeUpsertBulk {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.SetNetProfit(v)
+	})
+}
+
+// AddNetProfit adds v to the "netProfit" field.
+func (u *MatchedTradeUpsertBulk) AddNetProfit(v float64) *MatchedTradeUpsertBulk {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.AddNetProfit(v)
+	})
+}
+
+// UpdateNetProfit sets the "netProfit" field to the value that was provided on create.
+func (u *MatchedTradeUpsertBulk) UpdateNetProfit() *MatchedTradeUpsertBulk {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.UpdateNetProfit()
+	})
+}
+
+// ClearNetProfit clears the value of the "netProfit" field.
+func (u *MatchedTradeUpsertBulk) ClearNetProfit() *MatchedTradeUpsertBulk {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.ClearNetProfit()
+	})
+}
+
+// SetRunId sets the "runId" field.
+func (u *MatchedTradeUpsertBulk) SetRunId(v int) *MatchedTradeUpsertBulk {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.SetRunId(v)
+	})
+}
+
+// AddRunId adds v to the "runId" field.
+func (u *MatchedTradeUpsertBulk) AddRunId(v int) *MatchedTradeUpsertBulk {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.AddRunId(v)
+	})
+}
+
+// UpdateRunId sets the "runId" field to the value that was provided on create.
+func (u *MatchedTradeUpsertBulk) UpdateRunId() *MatchedTradeUpsertBulk {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.UpdateRunId()
+	})
+}
+
+// ClearRunId clears the value of the "runId" field.
+func (u *MatchedTradeUpsertBulk) ClearRunId() *MatchedTradeUpsertBulk {
+	return u.Update(func(s *MatchedTradeUpsert) {
+		s.ClearRunId()
+	})
+}
+
 // Exec executes the query.
 func (u *MatchedTradeUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -164,6 +164,46 @@ func (_u *MatchedTradeUpdate) ClearBuyOrderTimestamp() *MatchedTradeUpdate {
 	return _u
 }
 
+// SetBuyPartialBaseAmount sets the "buyPartialBaseAmount" field.
+func (_u *MatchedTradeUpdate) SetBuyPartialBaseAmount(v decimal.Decimal) *MatchedTradeUpdate {
+	_u.mutation.SetBuyPartialBaseAmount(v)
+	return _u
+}
+
+// SetNillableBuyPartialBaseAmount sets the "buyPartialBaseAmount" field if the given value is not nil.
+func (_u *MatchedTradeUpdate) SetNillableBuyPartialBaseAmount(v *decimal.Decimal) *MatchedTradeUpdate {
+	if v != nil {
+		_u.SetBuyPartialBaseAmount(*v)
+	}
+	return _u
+}
+
+// ClearBuyPartialBaseAmount clears the value of the "buyPartialBaseAmount" field.
+func (_u *MatchedTradeUpdate) ClearBuyPartialBaseAmount() *MatchedTradeUpdate {
+	_u.mutation.ClearBuyPartialBaseAmount()
+	return _u
+}
+
+// SetBuyPartialQuoteAmount sets the "buyPartialQuoteAmount" field.
+func (_u *MatchedTradeUpdate) SetBuyPartialQuoteAmount(v decimal.Decimal) *MatchedTradeUpdate {
+	_u.mutation.SetBuyPartialQuoteAmount(v)
+	return _u
+}
+
+// SetNillableBuyPartialQuoteAmount sets the "buyPartialQuoteAmount" field if the given value is not nil.
+func (_u *MatchedTradeUpdate) SetNillableBuyPartialQuoteAmount(v *decimal.Decimal) *MatchedTradeUpdate {
+	if v != nil {
+		_u.SetBuyPartialQuoteAmount(*v)
+	}
+	return _u
+}
+
+// ClearBuyPartialQuoteAmount clears the value of the "buyPartialQuoteAmount" field.
+func (_u *MatchedTradeUpdate) ClearBuyPartialQuoteAmount() *MatchedTradeUpdate {
+	_u.mutation.ClearBuyPartialQuoteAmount()
+	return _u
+}
+
 // SetSellClientOrderId sets the "sellClientOrderId" field.
 func (_u *MatchedTradeUpdate) SetSellClientOrderId(v string) *MatchedTradeUpdate {
 	_u.mutation.SetSellClientOrderId(v)
@@ -251,6 +291,46 @@ func (_u *MatchedTradeUpdate) ClearSellOrderTimestamp() *MatchedTradeUpdate {
 	return _u
 }
 
+// SetSellPartialBaseAmount sets the "sellPartialBaseAmount" field.
+func (_u *MatchedTradeUpdate) SetSellPartialBaseAmount(v decimal.Decimal) *MatchedTradeUpdate {
+	_u.mutation.SetSellPartialBaseAmount(v)
+	return _u
+}
+
+// SetNillableSellPartialBaseAmount sets the "sellPartialBaseAmount" field if the given value is not nil.
+func (_u *MatchedTradeUpdate) SetNillableSellPartialBaseAmount(v *decimal.Decimal) *MatchedTradeUpdate {
+	if v != nil {
+		_u.SetSellPartialBaseAmount(*v)
+	}
+	return _u
+}
+
+// ClearSellPartialBaseAmount clears the value of the "sellPartialBaseAmount" field.
+func (_u *MatchedTradeUpdate) ClearSellPartialBaseAmount() *MatchedTradeUpdate {
+	_u.mutation.ClearSellPartialBaseAmount()
+	return _u
+}
+
+// SetSellPartialQuoteAmount sets the "sellPartialQuoteAmount" field.
+func (_u *MatchedTradeUpdate) SetSellPartialQuoteAmount(v decimal.Decimal) *MatchedTradeUpdate {
+	_u.mutation.SetSellPartialQuoteAmount(v)
+	return _u
+}
+
+// SetNillableSellPartialQuoteAmount sets the "sellPartialQuoteAmount" field if the given value is not nil.
+func (_u *MatchedTradeUpdate) SetNillableSellPartialQuoteAmount(v *decimal.Decimal) *MatchedTradeUpdate {
+	if v != nil {
+		_u.SetSellPartialQuoteAmount(*v)
+	}
+	return _u
+}
+
+// ClearSellPartialQuoteAmount clears the value of the "sellPartialQuoteAmount" field.
+func (_u *MatchedTradeUpdate) ClearSellPartialQuoteAmount() *MatchedTradeUpdate {
+	_u.mutation.ClearSellPartialQuoteAmount()
+	return _u
+}
+
 // SetProfit sets the "profit" field.
 func (_u *MatchedTradeUpdate) SetProfit(v float64) *MatchedTradeUpdate {
 	_u.mutation.ResetProfit()
@@ -278,6 +358,87 @@ func (_u *MatchedTradeUpdate) ClearProfit() *MatchedTradeUpdate {
 	return _u
 }
 
+// SetFee sets the "fee" field.
+func (_u *MatchedTradeUpdate) SetFee(v float64) *MatchedTradeUpdate {
+	_u.mutation.ResetFee()
+	_u.mutation.SetFee(v)
+	return _u
+}
+
+// SetNillableFee sets the "fee" field if the given value is not nil.
+func (_u *MatchedTradeUpdate) SetNillableFee(v *float64) *MatchedTradeUpdate {
+	if v != nil {
+		_u.SetFee(*v)
+	}
+	return _u
+}
+
+// AddFee adds value to the "fee" field.
+func (_u *MatchedTradeUpdate) AddFee(v float64) *MatchedTradeUpdate {
+	_u.mutation.AddFee(v)
+	return _u
+}
+
+// ClearFee clears the value of the "fee" field.
+func (_u *MatchedTradeUpdate) ClearFee() *MatchedTradeUpdate {
+	_u.mutation.ClearFee()
+	return _u
+}
+
+// SetNetProfit sets the "netProfit" field.
+func (_u *MatchedTradeUpdate) SetNetProfit(v float64) *MatchedTradeUpdate {
+	_u.mutation.ResetNetProfit()
+	_u.mutation.SetNetProfit(v)
+	return _u
+}
+
+// SetNillableNetProfit sets the "netProfit" field if the given value is not nil.
+func (_u *MatchedTradeUpdate) SetNillableNetProfit(v *float64) *MatchedTradeUpdate {
+	if v != nil {
+		_u.SetNetProfit(*v)
+	}
+	return _u
+}
+
+// AddNetProfit adds value to the "netProfit" field.
+func (_u *MatchedTradeUpdate) AddNetProfit(v float64) *MatchedTradeUpdate {
+	_u.mutation.AddNetProfit(v)
+	return _u
+}
+
+// ClearNetProfit clears the value of the "netProfit" field.
+func (_u *MatchedTradeUpdate) ClearNetProfit() *MatchedTradeUpdate {
+	_u.mutation.ClearNetProfit()
+	return _u
+}
+
+// SetRunId sets the "runId" field.
+func (_u *MatchedTradeUpdate) SetRunId(v int) *MatchedTradeUpdate {
+	_u.mutation.ResetRunId()
+	_u.mutation.SetRunId(v)
+	return _u
+}
+
+// SetNillableRunId sets the "runId" field if the given value is not nil.
+func (_u *MatchedTradeUpdate) SetNillableRunId(v *int) *MatchedTradeUpdate {
+	if v != nil {
+		_u.SetRunId(*v)
+	}
+	return _u
+}
+
+// AddRunId adds value to the "runId" field.
+func (_u *MatchedTradeUpdate) AddRunId(v int) *MatchedTradeUpdate {
+	_u.mutation.AddRunId(v)
+	return _u
+}
+
+// ClearRunId clears the value of the "runId" field.
+func (_u *MatchedTradeUpdate) ClearRunId() *MatchedTradeUpdate {
+	_u.mutation.ClearRunId()
+	return _u
+}
+
 // Mutation returns the MatchedTradeMutation object of the builder.
 func (_u *MatchedTradeUpdate) Mutation() *MatchedTradeMutation {
 	return _u.mutation
@@ -380,6 +541,18 @@ func (_u *MatchedTradeUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if _u.mutation.BuyOrderTimestampCleared() {
 		_spec.ClearField(matchedtrade.FieldBuyOrderTimestamp, field.TypeInt64)
 	}
+	if value, ok := _u.mutation.BuyPartialBaseAmount(); ok {
+		_spec.SetField(matchedtrade.FieldBuyPartialBaseAmount, field.TypeString, value)
+	}
+	if _u.mutation.BuyPartialBaseAmountCleared() {
+		_spec.ClearField(matchedtrade.FieldBuyPartialBaseAmount, field.TypeString)
+	}
+	if value, ok := _u.mutation.BuyPartialQuoteAmount(); ok {
+		_spec.SetField(matchedtrade.FieldBuyPartialQuoteAmount, field.TypeString, value)
+	}
+	if _u.mutation.BuyPartialQuoteAmountCleared() {
+		_spec.ClearField(matchedtrade.FieldBuyPartialQuoteAmount, field.TypeString)
+	}
 	if value, ok := _u.mutation.SellClientOrderId(); ok {
 		_spec.SetField(matchedtrade.FieldSellClientOrderId, field.TypeString, value)
 	}
@@ -407,6 +580,18 @@ func (_u *MatchedTradeUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if _u.mutation.SellOrderTimestampCleared() {
 		_spec.ClearField(matchedtrade.FieldSellOrderTimestamp, field.TypeInt64)
 	}
+	if value, ok := _u.mutation.SellPartialBaseAmount(); ok {
+		_spec.SetField(matchedtrade.FieldSellPartialBaseAmount, field.TypeString, value)
+	}
+	if _u.mutation.SellPartialBaseAmountCleared() {
+		_spec.ClearField(matchedtrade.FieldSellPartialBaseAmount, field.TypeString)
+	}
+	if value, ok := _u.mutation.SellPartialQuoteAmount(); ok {
+		_spec.SetField(matchedtrade.FieldSellPartialQuoteAmount, field.TypeString, value)
+	}
+	if _u.mutation.SellPartialQuoteAmountCleared() {
+		_spec.ClearField(matchedtrade.FieldSellPartialQuoteAmount, field.TypeString)
+	}
 	if value, ok := _u.mutation.Profit(); ok {
 		_spec.SetField(matchedtrade.FieldProfit, field.TypeFloat64, value)
 	}
@@ -416,6 +601,33 @@ func (_u *MatchedTradeUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if _u.mutation.ProfitCleared() {
 		_spec.ClearField(matchedtrade.FieldProfit, field.TypeFloat64)
 	}
+	if value, ok := _u.mutation.Fee(); ok {
+		_spec.SetField(matchedtrade.FieldFee, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedFee(); ok {
+		_spec.AddField(matchedtrade.FieldFee, field.TypeFloat64, value)
+	}
+	if _u.mutation.FeeCleared() {
+		_spec.ClearField(matchedtrade.FieldFee, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.NetProfit(); ok {
+		_spec.SetField(matchedtrade.FieldNetProfit, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedNetProfit(); ok {
+		_spec.AddField(matchedtrade.FieldNetProfit, field.TypeFloat64, value)
+	}
+	if _u.mutation.NetProfitCleared() {
+		_spec.ClearField(matchedtrade.FieldNetProfit, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.RunId(); ok {
+		_spec.SetField(matchedtrade.FieldRunId, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRunId(); ok {
+		_spec.AddField(matchedtrade.FieldRunId, field.TypeInt, value)
+	}
+	if _u.mutation.RunIdCleared() {
+		_spec.ClearField(matchedtrade.FieldRunId, field.TypeInt)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{matchedtrade.Label}
@@ -571,6 +783,46 @@ func (_u *MatchedTradeUpdateOne) ClearBuyOrderTimestamp() *MatchedTradeUpdateOne
 	return _u
 }
 
+// SetBuyPartialBaseAmount sets the "buyPartialBaseAmount" field.
+func (_u *MatchedTradeUpdateOne) SetBuyPartialBaseAmount(v decimal.Decimal) *MatchedTradeUpdateOne {
+	_u.mutation.SetBuyPartialBaseAmount(v)
+	return _u
+}
+
+// SetNillableBuyPartialBaseAmount sets the "buyPartialBaseAmount" field if the given value is not nil.
+func (_u *MatchedTradeUpdateOne) SetNillableBuyPartialBaseAmount(v *decimal.Decimal) *MatchedTradeUpdateOne {
+	if v != nil {
+		_u.SetBuyPartialBaseAmount(*v)
+	}
+	return _u
+}
+
+// ClearBuyPartialBaseAmount clears the value of the "buyPartialBaseAmount" field.
+func (_u *MatchedTradeUpdateOne) ClearBuyPartialBaseAmount() *MatchedTradeUpdateOne {
+	_u.mutation.ClearBuyPartialBaseAmount()
+	return _u
+}
+
+// SetBuyPartialQuoteAmount sets the "buyPartialQuoteAmount" field.
+func (_u *MatchedTradeUpdateOne) SetBuyPartialQuoteAmount(v decimal.Decimal) *MatchedTradeUpdateOne {
+	_u.mutation.SetBuyPartialQuoteAmount(v)
+	return _u
+}
+
+// SetNillableBuyPartialQuoteAmount sets the "buyPartialQuoteAmount" field if the given value is not nil.
+func (_u *MatchedTradeUpdateOne) SetNillableBuyPartialQuoteAmount(v *decimal.Decimal) *MatchedTradeUpdateOne {
+	if v != nil {
+		_u.SetBuyPartialQuoteAmount(*v)
+	}
+	return _u
+}
+
+// ClearBuyPartialQuoteAmount clears the value of the "buyPartialQuoteAmount" field.
+func (_u *MatchedTradeUpdateOne) ClearBuyPartialQuoteAmount() *MatchedTradeUpdateOne {
+	_u.mutation.ClearBuyPartialQuoteAmount()
+	return _u
+}
+
 // SetSellClientOrderId sets the "sellClientOrderId" field.
 func (_u *MatchedTradeUpdateOne) SetSellClientOrderId(v string) *MatchedTradeUpdateOne {
 	_u.mutation.SetSellClientOrderId(v)
@@ -658,6 +910,46 @@ func (_u *MatchedTradeUpdateOne) ClearSellOrderTimestamp() *MatchedTradeUpdateOn
 	return _u
 }
 
+// SetSellPartialBaseAmount sets the "sellPartialBaseAmount" field.
+func (_u *MatchedTradeUpdateOne) SetSellPartialBaseAmount(v decimal.Decimal) *MatchedTradeUpdateOne {
+	_u.mutation.SetSellPartialBaseAmount(v)
+	return _u
+}
+
+// SetNillableSellPartialBaseAmount sets the "sellPartialBaseAmount" field if the given value is not nil.
+func (_u *MatchedTradeUpdateOne) SetNillableSellPartialBaseAmount(v *decimal.Decimal) *MatchedTradeUpdateOne {
+	if v != nil {
+		_u.SetSellPartialBaseAmount(*v)
+	}
+	return _u
+}
+
+// ClearSellPartialBaseAmount clears the value of the "sellPartialBaseAmount" field.
+func (_u *MatchedTradeUpdateOne) ClearSellPartialBaseAmount() *MatchedTradeUpdateOne {
+	_u.mutation.ClearSellPartialBaseAmount()
+	return _u
+}
+
+// SetSellPartialQuoteAmount sets the "sellPartialQuoteAmount" field.
+func (_u *MatchedTradeUpdateOne) SetSellPartialQuoteAmount(v decimal.Decimal) *MatchedTradeUpdateOne {
+	_u.mutation.SetSellPartialQuoteAmount(v)
+	return _u
+}
+
+// SetNillableSellPartialQuoteAmount sets the "sellPartialQuoteAmount" field if the given value is not nil.
+func (_u *MatchedTradeUpdateOne) SetNillableSellPartialQuoteAmount(v *decimal.Decimal) *MatchedTradeUpdateOne {
+	if v != nil {
+		_u.SetSellPartialQuoteAmount(*v)
+	}
+	return _u
+}
+
+// ClearSellPartialQuoteAmount clears the value of the "sellPartialQuoteAmount" field.
+func (_u *MatchedTradeUpdateOne) ClearSellPartialQuoteAmount() *MatchedTradeUpdateOne {
+	_u.mutation.ClearSellPartialQuoteAmount()
+	return _u
+}
+
 // SetProfit sets the "profit" field.
 func (_u *MatchedTradeUpdateOne) SetProfit(v float64) *MatchedTradeUpdateOne {
 	_u.mutation.ResetProfit()
@@ -685,6 +977,87 @@ func (_u *MatchedTradeUpdateOne) ClearProfit() *MatchedTradeUpdateOne {
 	return _u
 }
 
+// SetFee sets the "fee" field.
+func (_u *MatchedTradeUpdateOne) SetFee(v float64) *MatchedTradeUpdateOne {
+	_u.mutation.ResetFee()
+	_u.mutation.SetFee(v)
+	return _u
+}
+
+// SetNillableFee sets the "fee" field if the given value is not nil.
+func (_u *MatchedTradeUpdateOne) SetNillableFee(v *float64) *MatchedTradeUpdateOne {
+	if v != nil {
+		_u.SetFee(*v)
+	}
+	return _u
+}
+
+// AddFee adds value to the "fee" field.
+func (_u *MatchedTradeUpdateOne) AddFee(v float64) *MatchedTradeUpdateOne {
+	_u.mutation.AddFee(v)
+	return _u
+}
+
+// ClearFee clears the value of the "fee" field.
+func (_u *MatchedTradeUpdateOne) ClearFee() *MatchedTradeUpdateOne {
+	_u.mutation.ClearFee()
+	return _u
+}
+
+// SetNetProfit sets the "netProfit" field.
+func (_u *MatchedTradeUpdateOne) SetNetProfit(v float64) *MatchedTradeUpdateOne {
+	_u.mutation.ResetNetProfit()
+	_u.mutation.SetNetProfit(v)
+	return _u
+}
+
+// SetNillableNetProfit sets the "netProfit" field if the given value is not nil.
+func (_u *MatchedTradeUpdateOne) SetNillableNetProfit(v *float64) *MatchedTradeUpdateOne {
+	if v != nil {
+		_u.SetNetProfit(*v)
+	}
+	return _u
+}
+
+// AddNetProfit adds value to the "netProfit" field.
+func (_u *MatchedTradeUpdateOne) AddNetProfit(v float64) *MatchedTradeUpdateOne {
+	_u.mutation.AddNetProfit(v)
+	return _u
+}
+
+// ClearNetProfit clears the value of the "netProfit" field.
+func (_u *MatchedTradeUpdateOne) ClearNetProfit() *MatchedTradeUpdateOne {
+	_u.mutation.ClearNetProfit()
+	return _u
+}
+
+// SetRunId sets the "runId" field.
+func (_u *MatchedTradeUpdateOne) SetRunId(v int) *MatchedTradeUpdateOne {
+	_u.mutation.ResetRunId()
+	_u.mutation.SetRunId(v)
+	return _u
+}
+
+// SetNillableRunId sets the "runId" field if the given value is not nil.
+func (_u *MatchedTradeUpdateOne) SetNillableRunId(v *int) *MatchedTradeUpdateOne {
+	if v != nil {
+		_u.SetRunId(*v)
+	}
+	return _u
+}
+
+// AddRunId adds value to the "runId" field.
+func (_u *MatchedTradeUpdateOne) AddRunId(v int) *MatchedTradeUpdateOne {
+	_u.mutation.AddRunId(v)
+	return _u
+}
+
+// ClearRunId clears the value of the "runId" field.
+func (_u *MatchedTradeUpdateOne) ClearRunId() *MatchedTradeUpdateOne {
+	_u.mutation.ClearRunId()
+	return _u
+}
+
 // Mutation returns the MatchedTradeMutation object of the builder.
 func (_u *MatchedTradeUpdateOne) Mutation() *MatchedTradeMutation {
 	return _u.mutation
@@ -817,6 +1190,18 @@ func (_u *MatchedTradeUpdateOne) sqlSave(ctx context.Context) (_node *MatchedTra
 	if _u.mutation.BuyOrderTimestampCleared() {
 		_spec.ClearField(matchedtrade.FieldBuyOrderTimestamp, field.TypeInt64)
 	}
+	if value, ok := _u.mutation.BuyPartialBaseAmount(); ok {
+		_spec.SetField(matchedtrade.FieldBuyPartialBaseAmount, field.TypeString, value)
+	}
+	if _u.mutation.BuyPartialBaseAmountCleared() {
+		_spec.ClearField(matchedtrade.FieldBuyPartialBaseAmount, field.TypeString)
+	}
+	if value, ok := _u.mutation.BuyPartialQuoteAmount(); ok {
+		_spec.SetField(matchedtrade.FieldBuyPartialQuoteAmount, field.TypeString, value)
+	}
+	if _u.mutation.BuyPartialQuoteAmountCleared() {
+		_spec.ClearField(matchedtrade.FieldBuyPartialQuoteAmount, field.TypeString)
+	}
 	if value, ok := _u.mutation.SellClientOrderId(); ok {
 		_spec.SetField(matchedtrade.FieldSellClientOrderId, field.TypeString, value)
 	}
@@ -844,6 +1229,18 @@ func (_u *MatchedTradeUpdateOne) sqlSave(ctx context.Context) (_node *MatchedTra
 	if _u.mutation.SellOrderTimestampCleared() {
 		_spec.ClearField(matchedtrade.FieldSellOrderTimestamp, field.TypeInt64)
 	}
+	if value, ok := _u.mutation.SellPartialBaseAmount(); ok {
+		_spec.SetField(matchedtrade.FieldSellPartialBaseAmount, field.TypeString, value)
+	}
+	if _u.mutation.SellPartialBaseAmountCleared() {
+		_spec.ClearField(matchedtrade.FieldSellPartialBaseAmount, field.TypeString)
+	}
+	if value, ok := _u.mutation.SellPartialQuoteAmount(); ok {
+		_spec.SetField(matchedtrade.FieldSellPartialQuoteAmount, field.TypeString, value)
+	}
+	if _u.mutation.SellPartialQuoteAmountCleared() {
+		_spec.ClearField(matchedtrade.FieldSellPartialQuoteAmount, field.TypeString)
+	}
 	if value, ok := _u.mutation.Profit(); ok {
 		_spec.SetField(matchedtrade.FieldProfit, field.TypeFloat64, value)
 	}
@@ -853,6 +1250,33 @@ func (_u *MatchedTradeUpdateOne) sqlSave(ctx context.Context) (_node *MatchedTra
 	if _u.mutation.ProfitCleared() {
 		_spec.ClearField(matchedtrade.FieldProfit, field.TypeFloat64)
 	}
+	if value, ok := _u.mutation.Fee(); ok {
+		_spec.SetField(matchedtrade.FieldFee, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedFee(); ok {
+		_spec.AddField(matchedtrade.FieldFee, field.TypeFloat64, value)
+	}
+	if _u.mutation.FeeCleared() {
+		_spec.ClearField(matchedtrade.FieldFee, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.NetProfit(); ok {
+		_spec.SetField(matchedtrade.FieldNetProfit, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedNetProfit(); ok {
+		_spec.AddField(matchedtrade.FieldNetProfit, field.TypeFloat64, value)
+	}
+	if _u.mutation.NetProfitCleared() {
+		_spec.ClearField(matchedtrade.FieldNetProfit, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.RunId(); ok {
+		_spec.SetField(matchedtrade.FieldRunId, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRunId(); ok {
+		_spec.AddField(matchedtrade.FieldRunId, field.TypeInt, value)
+	}
+	if _u.mutation.RunIdCleared() {
+		_spec.ClearField(matchedtrade.FieldRunId, field.TypeInt)
+	}
 	_node = &MatchedTrade{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
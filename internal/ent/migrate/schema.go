@@ -3,11 +3,120 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// ExchangeAccountsColumns holds the columns for the "exchange_accounts" table.
+	ExchangeAccountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "owner", Type: field.TypeInt64},
+		{Name: "exchange", Type: field.TypeString, Size: 50},
+		{Name: "label", Type: field.TypeString, Size: 64},
+		{Name: "account", Type: field.TypeString},
+		{Name: "secret_key", Type: field.TypeString},
+		{Name: "passphrase", Type: field.TypeString},
+		{Name: "testnet", Type: field.TypeBool, Default: false},
+	}
+	// ExchangeAccountsTable holds the schema information for the "exchange_accounts" table.
+	ExchangeAccountsTable = &schema.Table{
+		Name:       "exchange_accounts",
+		Columns:    ExchangeAccountsColumns,
+		PrimaryKey: []*schema.Column{ExchangeAccountsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "exchangeaccount_owner",
+				Unique:  false,
+				Columns: []*schema.Column{ExchangeAccountsColumns[3]},
+			},
+		},
+	}
+	// FillsColumns holds the columns for the "fills" table.
+	FillsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "exchange", Type: field.TypeString, Size: 50},
+		{Name: "account", Type: field.TypeString},
+		{Name: "symbol", Type: field.TypeString, Size: 32},
+		{Name: "fill_id", Type: field.TypeString},
+		{Name: "order_id", Type: field.TypeString},
+		{Name: "client_order_id", Type: field.TypeString, Nullable: true},
+		{Name: "side", Type: field.TypeEnum, Enums: []string{"buy", "sell"}},
+		{Name: "price", Type: field.TypeString},
+		{Name: "base_amount", Type: field.TypeString},
+		{Name: "quote_amount", Type: field.TypeString},
+		{Name: "fee", Type: field.TypeString},
+		{Name: "fee_asset", Type: field.TypeString, Size: 32},
+		{Name: "is_maker", Type: field.TypeBool, Default: false},
+		{Name: "timestamp", Type: field.TypeInt64},
+	}
+	// FillsTable holds the schema information for the "fills" table.
+	FillsTable = &schema.Table{
+		Name:       "fills",
+		Columns:    FillsColumns,
+		PrimaryKey: []*schema.Column{FillsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "fill_exchange_account_fill_id",
+				Unique:  true,
+				Columns: []*schema.Column{FillsColumns[3], FillsColumns[4], FillsColumns[6]},
+			},
+			{
+				Name:    "fill_exchange_account_order_id",
+				Unique:  false,
+				Columns: []*schema.Column{FillsColumns[3], FillsColumns[4], FillsColumns[7]},
+			},
+			{
+				Name:    "fill_exchange_account_timestamp",
+				Unique:  false,
+				Columns: []*schema.Column{FillsColumns[3], FillsColumns[4], FillsColumns[16]},
+			},
+		},
+	}
+	// FundingPaymentsColumns holds the columns for the "funding_payments" table.
+	FundingPaymentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "exchange", Type: field.TypeString, Size: 50},
+		{Name: "account", Type: field.TypeString},
+		{Name: "symbol", Type: field.TypeString, Size: 32},
+		{Name: "payment_id", Type: field.TypeString},
+		{Name: "strategy_id", Type: field.TypeString, Nullable: true, Size: 50},
+		{Name: "amount", Type: field.TypeString},
+		{Name: "rate", Type: field.TypeString},
+		{Name: "position_size", Type: field.TypeString},
+		{Name: "timestamp", Type: field.TypeInt64},
+		{Name: "run_id", Type: field.TypeInt, Nullable: true},
+	}
+	// FundingPaymentsTable holds the schema information for the "funding_payments" table.
+	FundingPaymentsTable = &schema.Table{
+		Name:       "funding_payments",
+		Columns:    FundingPaymentsColumns,
+		PrimaryKey: []*schema.Column{FundingPaymentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "fundingpayment_exchange_account_payment_id_strategy_id",
+				Unique:  true,
+				Columns: []*schema.Column{FundingPaymentsColumns[3], FundingPaymentsColumns[4], FundingPaymentsColumns[6], FundingPaymentsColumns[7]},
+			},
+			{
+				Name:    "fundingpayment_exchange_account_symbol_timestamp",
+				Unique:  false,
+				Columns: []*schema.Column{FundingPaymentsColumns[3], FundingPaymentsColumns[4], FundingPaymentsColumns[5], FundingPaymentsColumns[11]},
+			},
+			{
+				Name:    "fundingpayment_strategy_id",
+				Unique:  false,
+				Columns: []*schema.Column{FundingPaymentsColumns[7]},
+			},
+		},
+	}
 	// GridsColumns holds the columns for the "grids" table.
 	GridsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -24,6 +133,7 @@ var (
 		{Name: "buy_client_order_time", Type: field.TypeInt64, Nullable: true},
 		{Name: "sell_client_order_id", Type: field.TypeString, Nullable: true},
 		{Name: "sell_client_order_time", Type: field.TypeInt64, Nullable: true},
+		{Name: "run_id", Type: field.TypeInt, Nullable: true},
 	}
 	// GridsTable holds the schema information for the "grids" table.
 	GridsTable = &schema.Table{
@@ -42,9 +152,12 @@ var (
 				Columns: []*schema.Column{GridsColumns[3]},
 			},
 			{
-				Name:    "grid_strategy_id_level",
+				Name:    "grid_active_strategy_level",
 				Unique:  true,
 				Columns: []*schema.Column{GridsColumns[3], GridsColumns[7]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "run_id IS NULL",
+				},
 			},
 			{
 				Name:    "grid_exchange_symbol_account",
@@ -65,11 +178,18 @@ var (
 		{Name: "buy_base_amount", Type: field.TypeString, Nullable: true},
 		{Name: "buy_quote_amount", Type: field.TypeString, Nullable: true},
 		{Name: "buy_order_timestamp", Type: field.TypeInt64, Nullable: true},
+		{Name: "buy_partial_base_amount", Type: field.TypeString, Nullable: true},
+		{Name: "buy_partial_quote_amount", Type: field.TypeString, Nullable: true},
 		{Name: "sell_client_order_id", Type: field.TypeString, Nullable: true},
 		{Name: "sell_base_amount", Type: field.TypeString, Nullable: true},
 		{Name: "sell_quote_amount", Type: field.TypeString, Nullable: true},
 		{Name: "sell_order_timestamp", Type: field.TypeInt64, Nullable: true},
+		{Name: "sell_partial_base_amount", Type: field.TypeString, Nullable: true},
+		{Name: "sell_partial_quote_amount", Type: field.TypeString, Nullable: true},
 		{Name: "profit", Type: field.TypeFloat64, Nullable: true},
+		{Name: "fee", Type: field.TypeFloat64, Nullable: true},
+		{Name: "net_profit", Type: field.TypeFloat64, Nullable: true},
+		{Name: "run_id", Type: field.TypeInt, Nullable: true},
 	}
 	// MatchedTradesTable holds the schema information for the "matched_trades" table.
 	MatchedTradesTable = &schema.Table{
@@ -90,7 +210,7 @@ var (
 			{
 				Name:    "matchedtrade_strategy_id_buy_client_order_id_sell_client_order_id",
 				Unique:  true,
-				Columns: []*schema.Column{MatchedTradesColumns[3], MatchedTradesColumns[6], MatchedTradesColumns[10]},
+				Columns: []*schema.Column{MatchedTradesColumns[3], MatchedTradesColumns[6], MatchedTradesColumns[12]},
 			},
 		},
 	}
@@ -135,6 +255,48 @@ var (
 			},
 		},
 	}
+	// OrderRepairsColumns holds the columns for the "order_repairs" table.
+	OrderRepairsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "strategy_id", Type: field.TypeString, Size: 50},
+		{Name: "exchange", Type: field.TypeString, Size: 50},
+		{Name: "symbol", Type: field.TypeString, Size: 32},
+		{Name: "account", Type: field.TypeString},
+		{Name: "level", Type: field.TypeInt},
+		{Name: "side", Type: field.TypeEnum, Enums: []string{"buy", "sell"}},
+		{Name: "price", Type: field.TypeString},
+		{Name: "quantity", Type: field.TypeString},
+		{Name: "canceled_client_order_id", Type: field.TypeString},
+		{Name: "new_client_order_id", Type: field.TypeString, Nullable: true},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"repair", "skip", "stop"}},
+		{Name: "attempt", Type: field.TypeInt},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+	}
+	// OrderRepairsTable holds the schema information for the "order_repairs" table.
+	OrderRepairsTable = &schema.Table{
+		Name:       "order_repairs",
+		Columns:    OrderRepairsColumns,
+		PrimaryKey: []*schema.Column{OrderRepairsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "orderrepair_strategy_id",
+				Unique:  false,
+				Columns: []*schema.Column{OrderRepairsColumns[3]},
+			},
+			{
+				Name:    "orderrepair_strategy_id_canceled_client_order_id",
+				Unique:  false,
+				Columns: []*schema.Column{OrderRepairsColumns[3], OrderRepairsColumns[11]},
+			},
+			{
+				Name:    "orderrepair_strategy_id_new_client_order_id",
+				Unique:  false,
+				Columns: []*schema.Column{OrderRepairsColumns[3], OrderRepairsColumns[12]},
+			},
+		},
+	}
 	// StrategiesColumns holds the columns for the "strategies" table.
 	StrategiesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -145,18 +307,43 @@ var (
 		{Name: "exchange", Type: field.TypeString, Size: 50},
 		{Name: "symbol", Type: field.TypeString, Size: 32},
 		{Name: "account", Type: field.TypeString},
-		{Name: "mode", Type: field.TypeEnum, Enums: []string{"long", "short"}},
+		{Name: "mode", Type: field.TypeEnum, Enums: []string{"long", "short", "neutral"}},
 		{Name: "margin_mode", Type: field.TypeEnum, Enums: []string{"cross", "isolated"}},
-		{Name: "quantity_mode", Type: field.TypeEnum, Enums: []string{"arithmetic", "geometric"}},
+		{Name: "quantity_mode", Type: field.TypeEnum, Enums: []string{"arithmetic", "geometric", "adaptive"}},
 		{Name: "price_upper", Type: field.TypeString},
 		{Name: "price_lower", Type: field.TypeString},
 		{Name: "grid_num", Type: field.TypeInt, Default: 10},
 		{Name: "leverage", Type: field.TypeInt, Default: 1},
 		{Name: "initial_order_size", Type: field.TypeString},
+		{Name: "sizing_mode", Type: field.TypeEnum, Enums: []string{"fixed", "pyramid", "geometric", "equal_notional", "custom"}, Default: "fixed"},
+		{Name: "sizing_factor", Type: field.TypeString, Nullable: true},
+		{Name: "sizing_weights", Type: field.TypeString, Nullable: true, Size: 512},
 		{Name: "slippage_bps", Type: field.TypeInt, Nullable: true},
 		{Name: "entry_price", Type: field.TypeString, Nullable: true},
 		{Name: "trigger_stop_loss_price", Type: field.TypeString, Nullable: true},
 		{Name: "trigger_take_profit_price", Type: field.TypeString, Nullable: true},
+		{Name: "max_loss_amount", Type: field.TypeString, Nullable: true},
+		{Name: "max_loss_percent", Type: field.TypeString, Nullable: true},
+		{Name: "profit_target_amount", Type: field.TypeString, Nullable: true},
+		{Name: "trailing_stop_loss_amount", Type: field.TypeString, Nullable: true},
+		{Name: "trailing_stop_loss_percent", Type: field.TypeString, Nullable: true},
+		{Name: "trailing_take_profit_activation_price", Type: field.TypeString, Nullable: true},
+		{Name: "trailing_take_profit_amount", Type: field.TypeString, Nullable: true},
+		{Name: "trailing_take_profit_percent", Type: field.TypeString, Nullable: true},
+		{Name: "trailing_extreme_price", Type: field.TypeString, Nullable: true},
+		{Name: "trailing_levels", Type: field.TypeInt, Nullable: true},
+		{Name: "trailing_price_upper_limit", Type: field.TypeString, Nullable: true},
+		{Name: "trailing_price_lower_limit", Type: field.TypeString, Nullable: true},
+		{Name: "atr_period", Type: field.TypeInt, Nullable: true},
+		{Name: "atr_multiplier", Type: field.TypeString, Nullable: true},
+		{Name: "adaptive_threshold", Type: field.TypeString, Nullable: true},
+		{Name: "adaptive_interval_hours", Type: field.TypeInt, Nullable: true},
+		{Name: "adaptive_atr", Type: field.TypeString, Nullable: true},
+		{Name: "adaptive_updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cancel_repair_policy", Type: field.TypeEnum, Enums: []string{"repair", "skip", "stop"}, Default: "repair"},
+		{Name: "cancel_repair_max_attempts", Type: field.TypeInt, Nullable: true},
+		{Name: "reconcile_policy", Type: field.TypeEnum, Enums: []string{"alert", "fix"}, Default: "alert"},
+		{Name: "partial_fill_threshold", Type: field.TypeString, Nullable: true},
 		{Name: "enable_push_notification", Type: field.TypeBool},
 		{Name: "enable_push_matched_notification", Type: field.TypeBool, Nullable: true},
 		{Name: "last_lower_threshold_alert_time", Type: field.TypeTime, Nullable: true},
@@ -167,12 +354,21 @@ var (
 		{Name: "exchange_passphrase", Type: field.TypeString},
 		{Name: "exchange_testnet", Type: field.TypeBool, Default: false},
 		{Name: "start_time", Type: field.TypeTime, Nullable: true},
+		{Name: "account_id", Type: field.TypeInt, Nullable: true},
 	}
 	// StrategiesTable holds the schema information for the "strategies" table.
 	StrategiesTable = &schema.Table{
 		Name:       "strategies",
 		Columns:    StrategiesColumns,
 		PrimaryKey: []*schema.Column{StrategiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "strategies_exchange_accounts_strategies",
+				Columns:    []*schema.Column{StrategiesColumns[55]},
+				RefColumns: []*schema.Column{ExchangeAccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "strategy_owner",
@@ -191,6 +387,51 @@ var (
 			},
 		},
 	}
+	// StrategyRunsColumns holds the columns for the "strategy_runs" table.
+	StrategyRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "strategy_id", Type: field.TypeString, Size: 50},
+		{Name: "owner", Type: field.TypeInt64},
+		{Name: "exchange", Type: field.TypeString, Size: 50},
+		{Name: "symbol", Type: field.TypeString, Size: 32},
+		{Name: "account", Type: field.TypeString},
+		{Name: "mode", Type: field.TypeEnum, Enums: []string{"long", "short", "neutral"}},
+		{Name: "price_upper", Type: field.TypeString},
+		{Name: "price_lower", Type: field.TypeString},
+		{Name: "grid_num", Type: field.TypeInt},
+		{Name: "leverage", Type: field.TypeInt},
+		{Name: "initial_order_size", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"running", "stopped"}, Default: "running"},
+		{Name: "start_time", Type: field.TypeTime},
+		{Name: "end_time", Type: field.TypeTime, Nullable: true},
+		{Name: "stop_reason", Type: field.TypeEnum, Nullable: true, Enums: []string{"manual", "close_position", "stop_loss", "take_profit", "order_canceled", "liquidation_risk", "max_loss", "profit_target", "trailing_stop_loss", "trailing_take_profit"}},
+		{Name: "matched_trades", Type: field.TypeInt, Default: 0},
+		{Name: "realized_profit", Type: field.TypeString, Nullable: true},
+		{Name: "fee", Type: field.TypeString, Nullable: true},
+		{Name: "funding", Type: field.TypeString, Nullable: true},
+		{Name: "unrealized_profit", Type: field.TypeString, Nullable: true},
+		{Name: "total_profit", Type: field.TypeString, Nullable: true},
+	}
+	// StrategyRunsTable holds the schema information for the "strategy_runs" table.
+	StrategyRunsTable = &schema.Table{
+		Name:       "strategy_runs",
+		Columns:    StrategyRunsColumns,
+		PrimaryKey: []*schema.Column{StrategyRunsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "strategyrun_strategy_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{StrategyRunsColumns[3], StrategyRunsColumns[14]},
+			},
+			{
+				Name:    "strategyrun_owner",
+				Unique:  false,
+				Columns: []*schema.Column{StrategyRunsColumns[4]},
+			},
+		},
+	}
 	// SyncProgressesColumns holds the columns for the "sync_progresses" table.
 	SyncProgressesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -215,13 +456,19 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		ExchangeAccountsTable,
+		FillsTable,
+		FundingPaymentsTable,
 		GridsTable,
 		MatchedTradesTable,
 		OrdersTable,
+		OrderRepairsTable,
 		StrategiesTable,
+		StrategyRunsTable,
 		SyncProgressesTable,
 	}
 )
 
 func init() {
+	StrategiesTable.ForeignKeys[0].RefTable = ExchangeAccountsTable
 }
@@ -11,11 +11,16 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/fachebot/omni-grid-bot/internal/ent/exchangeaccount"
+	"github.com/fachebot/omni-grid-bot/internal/ent/fill"
+	"github.com/fachebot/omni-grid-bot/internal/ent/fundingpayment"
 	"github.com/fachebot/omni-grid-bot/internal/ent/grid"
 	"github.com/fachebot/omni-grid-bot/internal/ent/matchedtrade"
 	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/ent/orderrepair"
 	"github.com/fachebot/omni-grid-bot/internal/ent/predicate"
 	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategyrun"
 	"github.com/fachebot/omni-grid-bot/internal/ent/syncprogress"
 	"github.com/shopspring/decimal"
 )
@@ -29,52 +34,54 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeGrid         = "Grid"
-	TypeMatchedTrade = "MatchedTrade"
-	TypeOrder        = "Order"
-	TypeStrategy     = "Strategy"
-	TypeSyncProgress = "SyncProgress"
+	TypeExchangeAccount = "ExchangeAccount"
+	TypeFill            = "Fill"
+	TypeFundingPayment  = "FundingPayment"
+	TypeGrid            = "Grid"
+	TypeMatchedTrade    = "MatchedTrade"
+	TypeOrder           = "Order"
+	TypeOrderRepair     = "OrderRepair"
+	TypeStrategy        = "Strategy"
+	TypeStrategyRun     = "StrategyRun"
+	TypeSyncProgress    = "SyncProgress"
 )
 
-// GridMutation represents an operation that mutates the Grid nodes in the graph.
-type GridMutation struct {
+// ExchangeAccountMutation represents an operation that mutates the ExchangeAccount nodes in the graph.
+type ExchangeAccountMutation struct {
 	config
-	op                     Op
-	typ                    string
-	id                     *int
-	create_time            *time.Time
-	update_time            *time.Time
-	strategyId             *string
-	exchange               *string
-	symbol                 *string
-	account                *string
-	level                  *int
-	addlevel               *int
-	price                  *decimal.Decimal
-	quantity               *decimal.Decimal
-	buyClientOrderId       *string
-	buyClientOrderTime     *int64
-	addbuyClientOrderTime  *int64
-	sellClientOrderId      *string
-	sellClientOrderTime    *int64
-	addsellClientOrderTime *int64
-	clearedFields          map[string]struct{}
-	done                   bool
-	oldValue               func(context.Context) (*Grid, error)
-	predicates             []predicate.Grid
+	op                Op
+	typ               string
+	id                *int
+	create_time       *time.Time
+	update_time       *time.Time
+	owner             *int64
+	addowner          *int64
+	exchange          *string
+	label             *string
+	account           *string
+	secretKey         *string
+	passphrase        *string
+	testnet           *bool
+	clearedFields     map[string]struct{}
+	strategies        map[int]struct{}
+	removedstrategies map[int]struct{}
+	clearedstrategies bool
+	done              bool
+	oldValue          func(context.Context) (*ExchangeAccount, error)
+	predicates        []predicate.ExchangeAccount
 }
 
-var _ ent.Mutation = (*GridMutation)(nil)
+var _ ent.Mutation = (*ExchangeAccountMutation)(nil)
 
-// gridOption allows management of the mutation configuration using functional options.
-type gridOption func(*GridMutation)
+// exchangeaccountOption allows management of the mutation configuration using functional options.
+type exchangeaccountOption func(*ExchangeAccountMutation)
 
-// newGridMutation creates new mutation for the Grid entity.
-func newGridMutation(c config, op Op, opts ...gridOption) *GridMutation {
-	m := &GridMutation{
+// newExchangeAccountMutation creates new mutation for the ExchangeAccount entity.
+func newExchangeAccountMutation(c config, op Op, opts ...exchangeaccountOption) *ExchangeAccountMutation {
+	m := &ExchangeAccountMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeGrid,
+		typ:           TypeExchangeAccount,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -83,20 +90,20 @@ func newGridMutation(c config, op Op, opts ...gridOption) *GridMutation {
 	return m
 }
 
-// withGridID sets the ID field of the mutation.
-func withGridID(id int) gridOption {
-	return func(m *GridMutation) {
+// withExchangeAccountID sets the ID field of the mutation.
+func withExchangeAccountID(id int) exchangeaccountOption {
+	return func(m *ExchangeAccountMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Grid
+			value *ExchangeAccount
 		)
-		m.oldValue = func(ctx context.Context) (*Grid, error) {
+		m.oldValue = func(ctx context.Context) (*ExchangeAccount, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Grid.Get(ctx, id)
+					value, err = m.Client().ExchangeAccount.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -105,10 +112,10 @@ func withGridID(id int) gridOption {
 	}
 }
 
-// withGrid sets the old Grid of the mutation.
-func withGrid(node *Grid) gridOption {
-	return func(m *GridMutation) {
-		m.oldValue = func(context.Context) (*Grid, error) {
+// withExchangeAccount sets the old ExchangeAccount of the mutation.
+func withExchangeAccount(node *ExchangeAccount) exchangeaccountOption {
+	return func(m *ExchangeAccountMutation) {
+		m.oldValue = func(context.Context) (*ExchangeAccount, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -117,7 +124,7 @@ func withGrid(node *Grid) gridOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GridMutation) Client() *Client {
+func (m ExchangeAccountMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -125,7 +132,7 @@ func (m GridMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m GridMutation) Tx() (*Tx, error) {
+func (m ExchangeAccountMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -136,7 +143,7 @@ func (m GridMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *GridMutation) ID() (id int, exists bool) {
+func (m *ExchangeAccountMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -147,7 +154,7 @@ func (m *GridMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *GridMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ExchangeAccountMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -156,19 +163,19 @@ func (m *GridMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Grid.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ExchangeAccount.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *GridMutation) SetCreateTime(t time.Time) {
+func (m *ExchangeAccountMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *GridMutation) CreateTime() (r time.Time, exists bool) {
+func (m *ExchangeAccountMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -176,10 +183,10 @@ func (m *GridMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Grid entity.
-// If the Grid object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the ExchangeAccount entity.
+// If the ExchangeAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GridMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *ExchangeAccountMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -194,17 +201,17 @@ func (m *GridMutation) OldCreateTime(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *GridMutation) ResetCreateTime() {
+func (m *ExchangeAccountMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *GridMutation) SetUpdateTime(t time.Time) {
+func (m *ExchangeAccountMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *GridMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *ExchangeAccountMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -212,10 +219,10 @@ func (m *GridMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Grid entity.
-// If the Grid object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the ExchangeAccount entity.
+// If the ExchangeAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GridMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *ExchangeAccountMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -230,53 +237,73 @@ func (m *GridMutation) OldUpdateTime(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *GridMutation) ResetUpdateTime() {
+func (m *ExchangeAccountMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetStrategyId sets the "strategyId" field.
-func (m *GridMutation) SetStrategyId(s string) {
-	m.strategyId = &s
+// SetOwner sets the "owner" field.
+func (m *ExchangeAccountMutation) SetOwner(i int64) {
+	m.owner = &i
+	m.addowner = nil
 }
 
-// StrategyId returns the value of the "strategyId" field in the mutation.
-func (m *GridMutation) StrategyId() (r string, exists bool) {
-	v := m.strategyId
+// Owner returns the value of the "owner" field in the mutation.
+func (m *ExchangeAccountMutation) Owner() (r int64, exists bool) {
+	v := m.owner
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStrategyId returns the old "strategyId" field's value of the Grid entity.
-// If the Grid object wasn't provided to the builder, the object is fetched from the database.
+// OldOwner returns the old "owner" field's value of the ExchangeAccount entity.
+// If the ExchangeAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GridMutation) OldStrategyId(ctx context.Context) (v string, err error) {
+func (m *ExchangeAccountMutation) OldOwner(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStrategyId is only allowed on UpdateOne operations")
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStrategyId requires an ID field in the mutation")
+		return v, errors.New("OldOwner requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStrategyId: %w", err)
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
 	}
-	return oldValue.StrategyId, nil
+	return oldValue.Owner, nil
 }
 
-// ResetStrategyId resets all changes to the "strategyId" field.
-func (m *GridMutation) ResetStrategyId() {
-	m.strategyId = nil
+// AddOwner adds i to the "owner" field.
+func (m *ExchangeAccountMutation) AddOwner(i int64) {
+	if m.addowner != nil {
+		*m.addowner += i
+	} else {
+		m.addowner = &i
+	}
+}
+
+// AddedOwner returns the value that was added to the "owner" field in this mutation.
+func (m *ExchangeAccountMutation) AddedOwner() (r int64, exists bool) {
+	v := m.addowner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOwner resets all changes to the "owner" field.
+func (m *ExchangeAccountMutation) ResetOwner() {
+	m.owner = nil
+	m.addowner = nil
 }
 
 // SetExchange sets the "exchange" field.
-func (m *GridMutation) SetExchange(s string) {
+func (m *ExchangeAccountMutation) SetExchange(s string) {
 	m.exchange = &s
 }
 
 // Exchange returns the value of the "exchange" field in the mutation.
-func (m *GridMutation) Exchange() (r string, exists bool) {
+func (m *ExchangeAccountMutation) Exchange() (r string, exists bool) {
 	v := m.exchange
 	if v == nil {
 		return
@@ -284,10 +311,10 @@ func (m *GridMutation) Exchange() (r string, exists bool) {
 	return *v, true
 }
 
-// OldExchange returns the old "exchange" field's value of the Grid entity.
-// If the Grid object wasn't provided to the builder, the object is fetched from the database.
+// OldExchange returns the old "exchange" field's value of the ExchangeAccount entity.
+// If the ExchangeAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GridMutation) OldExchange(ctx context.Context) (v string, err error) {
+func (m *ExchangeAccountMutation) OldExchange(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldExchange is only allowed on UpdateOne operations")
 	}
@@ -302,53 +329,53 @@ func (m *GridMutation) OldExchange(ctx context.Context) (v string, err error) {
 }
 
 // ResetExchange resets all changes to the "exchange" field.
-func (m *GridMutation) ResetExchange() {
+func (m *ExchangeAccountMutation) ResetExchange() {
 	m.exchange = nil
 }
 
-// SetSymbol sets the "symbol" field.
-func (m *GridMutation) SetSymbol(s string) {
-	m.symbol = &s
+// SetLabel sets the "label" field.
+func (m *ExchangeAccountMutation) SetLabel(s string) {
+	m.label = &s
 }
 
-// Symbol returns the value of the "symbol" field in the mutation.
-func (m *GridMutation) Symbol() (r string, exists bool) {
-	v := m.symbol
+// Label returns the value of the "label" field in the mutation.
+func (m *ExchangeAccountMutation) Label() (r string, exists bool) {
+	v := m.label
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSymbol returns the old "symbol" field's value of the Grid entity.
-// If the Grid object wasn't provided to the builder, the object is fetched from the database.
+// OldLabel returns the old "label" field's value of the ExchangeAccount entity.
+// If the ExchangeAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GridMutation) OldSymbol(ctx context.Context) (v string, err error) {
+func (m *ExchangeAccountMutation) OldLabel(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+		return v, errors.New("OldLabel is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSymbol requires an ID field in the mutation")
+		return v, errors.New("OldLabel requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+		return v, fmt.Errorf("querying old value for OldLabel: %w", err)
 	}
-	return oldValue.Symbol, nil
+	return oldValue.Label, nil
 }
 
-// ResetSymbol resets all changes to the "symbol" field.
-func (m *GridMutation) ResetSymbol() {
-	m.symbol = nil
+// ResetLabel resets all changes to the "label" field.
+func (m *ExchangeAccountMutation) ResetLabel() {
+	m.label = nil
 }
 
 // SetAccount sets the "account" field.
-func (m *GridMutation) SetAccount(s string) {
+func (m *ExchangeAccountMutation) SetAccount(s string) {
 	m.account = &s
 }
 
 // Account returns the value of the "account" field in the mutation.
-func (m *GridMutation) Account() (r string, exists bool) {
+func (m *ExchangeAccountMutation) Account() (r string, exists bool) {
 	v := m.account
 	if v == nil {
 		return
@@ -356,10 +383,10 @@ func (m *GridMutation) Account() (r string, exists bool) {
 	return *v, true
 }
 
-// OldAccount returns the old "account" field's value of the Grid entity.
-// If the Grid object wasn't provided to the builder, the object is fetched from the database.
+// OldAccount returns the old "account" field's value of the ExchangeAccount entity.
+// If the ExchangeAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GridMutation) OldAccount(ctx context.Context) (v string, err error) {
+func (m *ExchangeAccountMutation) OldAccount(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
 	}
@@ -374,385 +401,181 @@ func (m *GridMutation) OldAccount(ctx context.Context) (v string, err error) {
 }
 
 // ResetAccount resets all changes to the "account" field.
-func (m *GridMutation) ResetAccount() {
+func (m *ExchangeAccountMutation) ResetAccount() {
 	m.account = nil
 }
 
-// SetLevel sets the "level" field.
-func (m *GridMutation) SetLevel(i int) {
-	m.level = &i
-	m.addlevel = nil
+// SetSecretKey sets the "secretKey" field.
+func (m *ExchangeAccountMutation) SetSecretKey(s string) {
+	m.secretKey = &s
 }
 
-// Level returns the value of the "level" field in the mutation.
-func (m *GridMutation) Level() (r int, exists bool) {
-	v := m.level
+// SecretKey returns the value of the "secretKey" field in the mutation.
+func (m *ExchangeAccountMutation) SecretKey() (r string, exists bool) {
+	v := m.secretKey
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLevel returns the old "level" field's value of the Grid entity.
-// If the Grid object wasn't provided to the builder, the object is fetched from the database.
+// OldSecretKey returns the old "secretKey" field's value of the ExchangeAccount entity.
+// If the ExchangeAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GridMutation) OldLevel(ctx context.Context) (v int, err error) {
+func (m *ExchangeAccountMutation) OldSecretKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLevel is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecretKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLevel requires an ID field in the mutation")
+		return v, errors.New("OldSecretKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLevel: %w", err)
-	}
-	return oldValue.Level, nil
-}
-
-// AddLevel adds i to the "level" field.
-func (m *GridMutation) AddLevel(i int) {
-	if m.addlevel != nil {
-		*m.addlevel += i
-	} else {
-		m.addlevel = &i
-	}
-}
-
-// AddedLevel returns the value that was added to the "level" field in this mutation.
-func (m *GridMutation) AddedLevel() (r int, exists bool) {
-	v := m.addlevel
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldSecretKey: %w", err)
 	}
-	return *v, true
+	return oldValue.SecretKey, nil
 }
 
-// ResetLevel resets all changes to the "level" field.
-func (m *GridMutation) ResetLevel() {
-	m.level = nil
-	m.addlevel = nil
+// ResetSecretKey resets all changes to the "secretKey" field.
+func (m *ExchangeAccountMutation) ResetSecretKey() {
+	m.secretKey = nil
 }
 
-// SetPrice sets the "price" field.
-func (m *GridMutation) SetPrice(d decimal.Decimal) {
-	m.price = &d
+// SetPassphrase sets the "passphrase" field.
+func (m *ExchangeAccountMutation) SetPassphrase(s string) {
+	m.passphrase = &s
 }
 
-// Price returns the value of the "price" field in the mutation.
-func (m *GridMutation) Price() (r decimal.Decimal, exists bool) {
-	v := m.price
+// Passphrase returns the value of the "passphrase" field in the mutation.
+func (m *ExchangeAccountMutation) Passphrase() (r string, exists bool) {
+	v := m.passphrase
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPrice returns the old "price" field's value of the Grid entity.
-// If the Grid object wasn't provided to the builder, the object is fetched from the database.
+// OldPassphrase returns the old "passphrase" field's value of the ExchangeAccount entity.
+// If the ExchangeAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GridMutation) OldPrice(ctx context.Context) (v decimal.Decimal, err error) {
+func (m *ExchangeAccountMutation) OldPassphrase(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+		return v, errors.New("OldPassphrase is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrice requires an ID field in the mutation")
+		return v, errors.New("OldPassphrase requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+		return v, fmt.Errorf("querying old value for OldPassphrase: %w", err)
 	}
-	return oldValue.Price, nil
+	return oldValue.Passphrase, nil
 }
 
-// ResetPrice resets all changes to the "price" field.
-func (m *GridMutation) ResetPrice() {
-	m.price = nil
+// ResetPassphrase resets all changes to the "passphrase" field.
+func (m *ExchangeAccountMutation) ResetPassphrase() {
+	m.passphrase = nil
 }
 
-// SetQuantity sets the "quantity" field.
-func (m *GridMutation) SetQuantity(d decimal.Decimal) {
-	m.quantity = &d
+// SetTestnet sets the "testnet" field.
+func (m *ExchangeAccountMutation) SetTestnet(b bool) {
+	m.testnet = &b
 }
 
-// Quantity returns the value of the "quantity" field in the mutation.
-func (m *GridMutation) Quantity() (r decimal.Decimal, exists bool) {
-	v := m.quantity
+// Testnet returns the value of the "testnet" field in the mutation.
+func (m *ExchangeAccountMutation) Testnet() (r bool, exists bool) {
+	v := m.testnet
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuantity returns the old "quantity" field's value of the Grid entity.
-// If the Grid object wasn't provided to the builder, the object is fetched from the database.
+// OldTestnet returns the old "testnet" field's value of the ExchangeAccount entity.
+// If the ExchangeAccount object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GridMutation) OldQuantity(ctx context.Context) (v decimal.Decimal, err error) {
+func (m *ExchangeAccountMutation) OldTestnet(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+		return v, errors.New("OldTestnet is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuantity requires an ID field in the mutation")
+		return v, errors.New("OldTestnet requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+		return v, fmt.Errorf("querying old value for OldTestnet: %w", err)
 	}
-	return oldValue.Quantity, nil
+	return oldValue.Testnet, nil
 }
 
-// ResetQuantity resets all changes to the "quantity" field.
-func (m *GridMutation) ResetQuantity() {
-	m.quantity = nil
+// ResetTestnet resets all changes to the "testnet" field.
+func (m *ExchangeAccountMutation) ResetTestnet() {
+	m.testnet = nil
 }
 
-// SetBuyClientOrderId sets the "buyClientOrderId" field.
-func (m *GridMutation) SetBuyClientOrderId(s string) {
-	m.buyClientOrderId = &s
+// AddStrategyIDs adds the "strategies" edge to the Strategy entity by ids.
+func (m *ExchangeAccountMutation) AddStrategyIDs(ids ...int) {
+	if m.strategies == nil {
+		m.strategies = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.strategies[ids[i]] = struct{}{}
+	}
 }
 
-// BuyClientOrderId returns the value of the "buyClientOrderId" field in the mutation.
-func (m *GridMutation) BuyClientOrderId() (r string, exists bool) {
-	v := m.buyClientOrderId
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearStrategies clears the "strategies" edge to the Strategy entity.
+func (m *ExchangeAccountMutation) ClearStrategies() {
+	m.clearedstrategies = true
 }
 
-// OldBuyClientOrderId returns the old "buyClientOrderId" field's value of the Grid entity.
-// If the Grid object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GridMutation) OldBuyClientOrderId(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBuyClientOrderId is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBuyClientOrderId requires an ID field in the mutation")
+// StrategiesCleared reports if the "strategies" edge to the Strategy entity was cleared.
+func (m *ExchangeAccountMutation) StrategiesCleared() bool {
+	return m.clearedstrategies
+}
+
+// RemoveStrategyIDs removes the "strategies" edge to the Strategy entity by IDs.
+func (m *ExchangeAccountMutation) RemoveStrategyIDs(ids ...int) {
+	if m.removedstrategies == nil {
+		m.removedstrategies = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBuyClientOrderId: %w", err)
+	for i := range ids {
+		delete(m.strategies, ids[i])
+		m.removedstrategies[ids[i]] = struct{}{}
 	}
-	return oldValue.BuyClientOrderId, nil
 }
 
-// ClearBuyClientOrderId clears the value of the "buyClientOrderId" field.
-func (m *GridMutation) ClearBuyClientOrderId() {
-	m.buyClientOrderId = nil
-	m.clearedFields[grid.FieldBuyClientOrderId] = struct{}{}
+// RemovedStrategies returns the removed IDs of the "strategies" edge to the Strategy entity.
+func (m *ExchangeAccountMutation) RemovedStrategiesIDs() (ids []int) {
+	for id := range m.removedstrategies {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// BuyClientOrderIdCleared returns if the "buyClientOrderId" field was cleared in this mutation.
-func (m *GridMutation) BuyClientOrderIdCleared() bool {
-	_, ok := m.clearedFields[grid.FieldBuyClientOrderId]
-	return ok
+// StrategiesIDs returns the "strategies" edge IDs in the mutation.
+func (m *ExchangeAccountMutation) StrategiesIDs() (ids []int) {
+	for id := range m.strategies {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ResetBuyClientOrderId resets all changes to the "buyClientOrderId" field.
-func (m *GridMutation) ResetBuyClientOrderId() {
-	m.buyClientOrderId = nil
-	delete(m.clearedFields, grid.FieldBuyClientOrderId)
+// ResetStrategies resets all changes to the "strategies" edge.
+func (m *ExchangeAccountMutation) ResetStrategies() {
+	m.strategies = nil
+	m.clearedstrategies = false
+	m.removedstrategies = nil
 }
 
-// SetBuyClientOrderTime sets the "buyClientOrderTime" field.
-func (m *GridMutation) SetBuyClientOrderTime(i int64) {
-	m.buyClientOrderTime = &i
-	m.addbuyClientOrderTime = nil
+// Where appends a list predicates to the ExchangeAccountMutation builder.
+func (m *ExchangeAccountMutation) Where(ps ...predicate.ExchangeAccount) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// BuyClientOrderTime returns the value of the "buyClientOrderTime" field in the mutation.
-func (m *GridMutation) BuyClientOrderTime() (r int64, exists bool) {
-	v := m.buyClientOrderTime
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldBuyClientOrderTime returns the old "buyClientOrderTime" field's value of the Grid entity.
-// If the Grid object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GridMutation) OldBuyClientOrderTime(ctx context.Context) (v *int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBuyClientOrderTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBuyClientOrderTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBuyClientOrderTime: %w", err)
-	}
-	return oldValue.BuyClientOrderTime, nil
-}
-
-// AddBuyClientOrderTime adds i to the "buyClientOrderTime" field.
-func (m *GridMutation) AddBuyClientOrderTime(i int64) {
-	if m.addbuyClientOrderTime != nil {
-		*m.addbuyClientOrderTime += i
-	} else {
-		m.addbuyClientOrderTime = &i
-	}
-}
-
-// AddedBuyClientOrderTime returns the value that was added to the "buyClientOrderTime" field in this mutation.
-func (m *GridMutation) AddedBuyClientOrderTime() (r int64, exists bool) {
-	v := m.addbuyClientOrderTime
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ClearBuyClientOrderTime clears the value of the "buyClientOrderTime" field.
-func (m *GridMutation) ClearBuyClientOrderTime() {
-	m.buyClientOrderTime = nil
-	m.addbuyClientOrderTime = nil
-	m.clearedFields[grid.FieldBuyClientOrderTime] = struct{}{}
-}
-
-// BuyClientOrderTimeCleared returns if the "buyClientOrderTime" field was cleared in this mutation.
-func (m *GridMutation) BuyClientOrderTimeCleared() bool {
-	_, ok := m.clearedFields[grid.FieldBuyClientOrderTime]
-	return ok
-}
-
-// ResetBuyClientOrderTime resets all changes to the "buyClientOrderTime" field.
-func (m *GridMutation) ResetBuyClientOrderTime() {
-	m.buyClientOrderTime = nil
-	m.addbuyClientOrderTime = nil
-	delete(m.clearedFields, grid.FieldBuyClientOrderTime)
-}
-
-// SetSellClientOrderId sets the "sellClientOrderId" field.
-func (m *GridMutation) SetSellClientOrderId(s string) {
-	m.sellClientOrderId = &s
-}
-
-// SellClientOrderId returns the value of the "sellClientOrderId" field in the mutation.
-func (m *GridMutation) SellClientOrderId() (r string, exists bool) {
-	v := m.sellClientOrderId
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldSellClientOrderId returns the old "sellClientOrderId" field's value of the Grid entity.
-// If the Grid object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GridMutation) OldSellClientOrderId(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSellClientOrderId is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSellClientOrderId requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSellClientOrderId: %w", err)
-	}
-	return oldValue.SellClientOrderId, nil
-}
-
-// ClearSellClientOrderId clears the value of the "sellClientOrderId" field.
-func (m *GridMutation) ClearSellClientOrderId() {
-	m.sellClientOrderId = nil
-	m.clearedFields[grid.FieldSellClientOrderId] = struct{}{}
-}
-
-// SellClientOrderIdCleared returns if the "sellClientOrderId" field was cleared in this mutation.
-func (m *GridMutation) SellClientOrderIdCleared() bool {
-	_, ok := m.clearedFields[grid.FieldSellClientOrderId]
-	return ok
-}
-
-// ResetSellClientOrderId resets all changes to the "sellClientOrderId" field.
-func (m *GridMutation) ResetSellClientOrderId() {
-	m.sellClientOrderId = nil
-	delete(m.clearedFields, grid.FieldSellClientOrderId)
-}
-
-// SetSellClientOrderTime sets the "sellClientOrderTime" field.
-func (m *GridMutation) SetSellClientOrderTime(i int64) {
-	m.sellClientOrderTime = &i
-	m.addsellClientOrderTime = nil
-}
-
-// SellClientOrderTime returns the value of the "sellClientOrderTime" field in the mutation.
-func (m *GridMutation) SellClientOrderTime() (r int64, exists bool) {
-	v := m.sellClientOrderTime
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldSellClientOrderTime returns the old "sellClientOrderTime" field's value of the Grid entity.
-// If the Grid object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GridMutation) OldSellClientOrderTime(ctx context.Context) (v *int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSellClientOrderTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSellClientOrderTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSellClientOrderTime: %w", err)
-	}
-	return oldValue.SellClientOrderTime, nil
-}
-
-// AddSellClientOrderTime adds i to the "sellClientOrderTime" field.
-func (m *GridMutation) AddSellClientOrderTime(i int64) {
-	if m.addsellClientOrderTime != nil {
-		*m.addsellClientOrderTime += i
-	} else {
-		m.addsellClientOrderTime = &i
-	}
-}
-
-// AddedSellClientOrderTime returns the value that was added to the "sellClientOrderTime" field in this mutation.
-func (m *GridMutation) AddedSellClientOrderTime() (r int64, exists bool) {
-	v := m.addsellClientOrderTime
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ClearSellClientOrderTime clears the value of the "sellClientOrderTime" field.
-func (m *GridMutation) ClearSellClientOrderTime() {
-	m.sellClientOrderTime = nil
-	m.addsellClientOrderTime = nil
-	m.clearedFields[grid.FieldSellClientOrderTime] = struct{}{}
-}
-
-// SellClientOrderTimeCleared returns if the "sellClientOrderTime" field was cleared in this mutation.
-func (m *GridMutation) SellClientOrderTimeCleared() bool {
-	_, ok := m.clearedFields[grid.FieldSellClientOrderTime]
-	return ok
-}
-
-// ResetSellClientOrderTime resets all changes to the "sellClientOrderTime" field.
-func (m *GridMutation) ResetSellClientOrderTime() {
-	m.sellClientOrderTime = nil
-	m.addsellClientOrderTime = nil
-	delete(m.clearedFields, grid.FieldSellClientOrderTime)
-}
-
-// Where appends a list predicates to the GridMutation builder.
-func (m *GridMutation) Where(ps ...predicate.Grid) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the GridMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ExchangeAccountMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GridMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Grid, len(ps))
+func (m *ExchangeAccountMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExchangeAccount, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -760,63 +583,51 @@ func (m *GridMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *GridMutation) Op() Op {
+func (m *ExchangeAccountMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *GridMutation) SetOp(op Op) {
+func (m *ExchangeAccountMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Grid).
-func (m *GridMutation) Type() string {
+// Type returns the node type of this mutation (ExchangeAccount).
+func (m *ExchangeAccountMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *GridMutation) Fields() []string {
-	fields := make([]string, 0, 13)
+func (m *ExchangeAccountMutation) Fields() []string {
+	fields := make([]string, 0, 9)
 	if m.create_time != nil {
-		fields = append(fields, grid.FieldCreateTime)
+		fields = append(fields, exchangeaccount.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, grid.FieldUpdateTime)
+		fields = append(fields, exchangeaccount.FieldUpdateTime)
 	}
-	if m.strategyId != nil {
-		fields = append(fields, grid.FieldStrategyId)
+	if m.owner != nil {
+		fields = append(fields, exchangeaccount.FieldOwner)
 	}
 	if m.exchange != nil {
-		fields = append(fields, grid.FieldExchange)
+		fields = append(fields, exchangeaccount.FieldExchange)
 	}
-	if m.symbol != nil {
-		fields = append(fields, grid.FieldSymbol)
+	if m.label != nil {
+		fields = append(fields, exchangeaccount.FieldLabel)
 	}
 	if m.account != nil {
-		fields = append(fields, grid.FieldAccount)
-	}
-	if m.level != nil {
-		fields = append(fields, grid.FieldLevel)
-	}
-	if m.price != nil {
-		fields = append(fields, grid.FieldPrice)
-	}
-	if m.quantity != nil {
-		fields = append(fields, grid.FieldQuantity)
+		fields = append(fields, exchangeaccount.FieldAccount)
 	}
-	if m.buyClientOrderId != nil {
-		fields = append(fields, grid.FieldBuyClientOrderId)
-	}
-	if m.buyClientOrderTime != nil {
-		fields = append(fields, grid.FieldBuyClientOrderTime)
+	if m.secretKey != nil {
+		fields = append(fields, exchangeaccount.FieldSecretKey)
 	}
-	if m.sellClientOrderId != nil {
-		fields = append(fields, grid.FieldSellClientOrderId)
+	if m.passphrase != nil {
+		fields = append(fields, exchangeaccount.FieldPassphrase)
 	}
-	if m.sellClientOrderTime != nil {
-		fields = append(fields, grid.FieldSellClientOrderTime)
+	if m.testnet != nil {
+		fields = append(fields, exchangeaccount.FieldTestnet)
 	}
 	return fields
 }
@@ -824,34 +635,26 @@ func (m *GridMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *GridMutation) Field(name string) (ent.Value, bool) {
+func (m *ExchangeAccountMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case grid.FieldCreateTime:
+	case exchangeaccount.FieldCreateTime:
 		return m.CreateTime()
-	case grid.FieldUpdateTime:
+	case exchangeaccount.FieldUpdateTime:
 		return m.UpdateTime()
-	case grid.FieldStrategyId:
-		return m.StrategyId()
-	case grid.FieldExchange:
+	case exchangeaccount.FieldOwner:
+		return m.Owner()
+	case exchangeaccount.FieldExchange:
 		return m.Exchange()
-	case grid.FieldSymbol:
-		return m.Symbol()
-	case grid.FieldAccount:
+	case exchangeaccount.FieldLabel:
+		return m.Label()
+	case exchangeaccount.FieldAccount:
 		return m.Account()
-	case grid.FieldLevel:
-		return m.Level()
-	case grid.FieldPrice:
-		return m.Price()
-	case grid.FieldQuantity:
-		return m.Quantity()
-	case grid.FieldBuyClientOrderId:
-		return m.BuyClientOrderId()
-	case grid.FieldBuyClientOrderTime:
-		return m.BuyClientOrderTime()
-	case grid.FieldSellClientOrderId:
-		return m.SellClientOrderId()
-	case grid.FieldSellClientOrderTime:
-		return m.SellClientOrderTime()
+	case exchangeaccount.FieldSecretKey:
+		return m.SecretKey()
+	case exchangeaccount.FieldPassphrase:
+		return m.Passphrase()
+	case exchangeaccount.FieldTestnet:
+		return m.Testnet()
 	}
 	return nil, false
 }
@@ -859,150 +662,108 @@ func (m *GridMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *GridMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ExchangeAccountMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case grid.FieldCreateTime:
+	case exchangeaccount.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case grid.FieldUpdateTime:
+	case exchangeaccount.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case grid.FieldStrategyId:
-		return m.OldStrategyId(ctx)
-	case grid.FieldExchange:
+	case exchangeaccount.FieldOwner:
+		return m.OldOwner(ctx)
+	case exchangeaccount.FieldExchange:
 		return m.OldExchange(ctx)
-	case grid.FieldSymbol:
-		return m.OldSymbol(ctx)
-	case grid.FieldAccount:
+	case exchangeaccount.FieldLabel:
+		return m.OldLabel(ctx)
+	case exchangeaccount.FieldAccount:
 		return m.OldAccount(ctx)
-	case grid.FieldLevel:
-		return m.OldLevel(ctx)
-	case grid.FieldPrice:
-		return m.OldPrice(ctx)
-	case grid.FieldQuantity:
-		return m.OldQuantity(ctx)
-	case grid.FieldBuyClientOrderId:
-		return m.OldBuyClientOrderId(ctx)
-	case grid.FieldBuyClientOrderTime:
-		return m.OldBuyClientOrderTime(ctx)
-	case grid.FieldSellClientOrderId:
-		return m.OldSellClientOrderId(ctx)
-	case grid.FieldSellClientOrderTime:
-		return m.OldSellClientOrderTime(ctx)
+	case exchangeaccount.FieldSecretKey:
+		return m.OldSecretKey(ctx)
+	case exchangeaccount.FieldPassphrase:
+		return m.OldPassphrase(ctx)
+	case exchangeaccount.FieldTestnet:
+		return m.OldTestnet(ctx)
 	}
-	return nil, fmt.Errorf("unknown Grid field %s", name)
+	return nil, fmt.Errorf("unknown ExchangeAccount field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GridMutation) SetField(name string, value ent.Value) error {
+func (m *ExchangeAccountMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case grid.FieldCreateTime:
+	case exchangeaccount.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case grid.FieldUpdateTime:
+	case exchangeaccount.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case grid.FieldStrategyId:
-		v, ok := value.(string)
+	case exchangeaccount.FieldOwner:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStrategyId(v)
+		m.SetOwner(v)
 		return nil
-	case grid.FieldExchange:
+	case exchangeaccount.FieldExchange:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetExchange(v)
 		return nil
-	case grid.FieldSymbol:
+	case exchangeaccount.FieldLabel:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSymbol(v)
+		m.SetLabel(v)
 		return nil
-	case grid.FieldAccount:
+	case exchangeaccount.FieldAccount:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetAccount(v)
 		return nil
-	case grid.FieldLevel:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLevel(v)
-		return nil
-	case grid.FieldPrice:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPrice(v)
-		return nil
-	case grid.FieldQuantity:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetQuantity(v)
-		return nil
-	case grid.FieldBuyClientOrderId:
+	case exchangeaccount.FieldSecretKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBuyClientOrderId(v)
-		return nil
-	case grid.FieldBuyClientOrderTime:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBuyClientOrderTime(v)
+		m.SetSecretKey(v)
 		return nil
-	case grid.FieldSellClientOrderId:
+	case exchangeaccount.FieldPassphrase:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSellClientOrderId(v)
+		m.SetPassphrase(v)
 		return nil
-	case grid.FieldSellClientOrderTime:
-		v, ok := value.(int64)
+	case exchangeaccount.FieldTestnet:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSellClientOrderTime(v)
+		m.SetTestnet(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Grid field %s", name)
+	return fmt.Errorf("unknown ExchangeAccount field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *GridMutation) AddedFields() []string {
+func (m *ExchangeAccountMutation) AddedFields() []string {
 	var fields []string
-	if m.addlevel != nil {
-		fields = append(fields, grid.FieldLevel)
-	}
-	if m.addbuyClientOrderTime != nil {
-		fields = append(fields, grid.FieldBuyClientOrderTime)
-	}
-	if m.addsellClientOrderTime != nil {
-		fields = append(fields, grid.FieldSellClientOrderTime)
+	if m.addowner != nil {
+		fields = append(fields, exchangeaccount.FieldOwner)
 	}
 	return fields
 }
@@ -1010,14 +771,10 @@ func (m *GridMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *GridMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ExchangeAccountMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case grid.FieldLevel:
-		return m.AddedLevel()
-	case grid.FieldBuyClientOrderTime:
-		return m.AddedBuyClientOrderTime()
-	case grid.FieldSellClientOrderTime:
-		return m.AddedSellClientOrderTime()
+	case exchangeaccount.FieldOwner:
+		return m.AddedOwner()
 	}
 	return nil, false
 }
@@ -1025,214 +782,197 @@ func (m *GridMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GridMutation) AddField(name string, value ent.Value) error {
+func (m *ExchangeAccountMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case grid.FieldLevel:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddLevel(v)
-		return nil
-	case grid.FieldBuyClientOrderTime:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddBuyClientOrderTime(v)
-		return nil
-	case grid.FieldSellClientOrderTime:
+	case exchangeaccount.FieldOwner:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSellClientOrderTime(v)
+		m.AddOwner(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Grid numeric field %s", name)
+	return fmt.Errorf("unknown ExchangeAccount numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *GridMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(grid.FieldBuyClientOrderId) {
-		fields = append(fields, grid.FieldBuyClientOrderId)
-	}
-	if m.FieldCleared(grid.FieldBuyClientOrderTime) {
-		fields = append(fields, grid.FieldBuyClientOrderTime)
-	}
-	if m.FieldCleared(grid.FieldSellClientOrderId) {
-		fields = append(fields, grid.FieldSellClientOrderId)
-	}
-	if m.FieldCleared(grid.FieldSellClientOrderTime) {
-		fields = append(fields, grid.FieldSellClientOrderTime)
-	}
-	return fields
+func (m *ExchangeAccountMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *GridMutation) FieldCleared(name string) bool {
+func (m *ExchangeAccountMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GridMutation) ClearField(name string) error {
-	switch name {
-	case grid.FieldBuyClientOrderId:
-		m.ClearBuyClientOrderId()
-		return nil
-	case grid.FieldBuyClientOrderTime:
-		m.ClearBuyClientOrderTime()
-		return nil
-	case grid.FieldSellClientOrderId:
-		m.ClearSellClientOrderId()
-		return nil
-	case grid.FieldSellClientOrderTime:
-		m.ClearSellClientOrderTime()
-		return nil
-	}
-	return fmt.Errorf("unknown Grid nullable field %s", name)
+func (m *ExchangeAccountMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ExchangeAccount nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *GridMutation) ResetField(name string) error {
+func (m *ExchangeAccountMutation) ResetField(name string) error {
 	switch name {
-	case grid.FieldCreateTime:
+	case exchangeaccount.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case grid.FieldUpdateTime:
+	case exchangeaccount.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case grid.FieldStrategyId:
-		m.ResetStrategyId()
+	case exchangeaccount.FieldOwner:
+		m.ResetOwner()
 		return nil
-	case grid.FieldExchange:
+	case exchangeaccount.FieldExchange:
 		m.ResetExchange()
 		return nil
-	case grid.FieldSymbol:
-		m.ResetSymbol()
+	case exchangeaccount.FieldLabel:
+		m.ResetLabel()
 		return nil
-	case grid.FieldAccount:
+	case exchangeaccount.FieldAccount:
 		m.ResetAccount()
 		return nil
-	case grid.FieldLevel:
-		m.ResetLevel()
-		return nil
-	case grid.FieldPrice:
-		m.ResetPrice()
-		return nil
-	case grid.FieldQuantity:
-		m.ResetQuantity()
-		return nil
-	case grid.FieldBuyClientOrderId:
-		m.ResetBuyClientOrderId()
-		return nil
-	case grid.FieldBuyClientOrderTime:
-		m.ResetBuyClientOrderTime()
+	case exchangeaccount.FieldSecretKey:
+		m.ResetSecretKey()
 		return nil
-	case grid.FieldSellClientOrderId:
-		m.ResetSellClientOrderId()
+	case exchangeaccount.FieldPassphrase:
+		m.ResetPassphrase()
 		return nil
-	case grid.FieldSellClientOrderTime:
-		m.ResetSellClientOrderTime()
+	case exchangeaccount.FieldTestnet:
+		m.ResetTestnet()
 		return nil
 	}
-	return fmt.Errorf("unknown Grid field %s", name)
+	return fmt.Errorf("unknown ExchangeAccount field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GridMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ExchangeAccountMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.strategies != nil {
+		edges = append(edges, exchangeaccount.EdgeStrategies)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *GridMutation) AddedIDs(name string) []ent.Value {
+func (m *ExchangeAccountMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case exchangeaccount.EdgeStrategies:
+		ids := make([]ent.Value, 0, len(m.strategies))
+		for id := range m.strategies {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GridMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ExchangeAccountMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedstrategies != nil {
+		edges = append(edges, exchangeaccount.EdgeStrategies)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *GridMutation) RemovedIDs(name string) []ent.Value {
+func (m *ExchangeAccountMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case exchangeaccount.EdgeStrategies:
+		ids := make([]ent.Value, 0, len(m.removedstrategies))
+		for id := range m.removedstrategies {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GridMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ExchangeAccountMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedstrategies {
+		edges = append(edges, exchangeaccount.EdgeStrategies)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *GridMutation) EdgeCleared(name string) bool {
+func (m *ExchangeAccountMutation) EdgeCleared(name string) bool {
+	switch name {
+	case exchangeaccount.EdgeStrategies:
+		return m.clearedstrategies
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *GridMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Grid unique edge %s", name)
+func (m *ExchangeAccountMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ExchangeAccount unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *GridMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Grid edge %s", name)
+func (m *ExchangeAccountMutation) ResetEdge(name string) error {
+	switch name {
+	case exchangeaccount.EdgeStrategies:
+		m.ResetStrategies()
+		return nil
+	}
+	return fmt.Errorf("unknown ExchangeAccount edge %s", name)
 }
 
-// MatchedTradeMutation represents an operation that mutates the MatchedTrade nodes in the graph.
-type MatchedTradeMutation struct {
+// FillMutation represents an operation that mutates the Fill nodes in the graph.
+type FillMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *int
-	create_time           *time.Time
-	update_time           *time.Time
-	strategyId            *string
-	account               *string
-	symbol                *string
-	buyClientOrderId      *string
-	buyBaseAmount         *decimal.Decimal
-	buyQuoteAmount        *decimal.Decimal
-	buyOrderTimestamp     *int64
-	addbuyOrderTimestamp  *int64
-	sellClientOrderId     *string
-	sellBaseAmount        *decimal.Decimal
-	sellQuoteAmount       *decimal.Decimal
-	sellOrderTimestamp    *int64
-	addsellOrderTimestamp *int64
-	profit                *float64
-	addprofit             *float64
-	clearedFields         map[string]struct{}
-	done                  bool
-	oldValue              func(context.Context) (*MatchedTrade, error)
-	predicates            []predicate.MatchedTrade
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	update_time   *time.Time
+	exchange      *string
+	account       *string
+	symbol        *string
+	fillId        *string
+	orderId       *string
+	clientOrderId *string
+	side          *fill.Side
+	price         *decimal.Decimal
+	baseAmount    *decimal.Decimal
+	quoteAmount   *decimal.Decimal
+	fee           *decimal.Decimal
+	feeAsset      *string
+	isMaker       *bool
+	timestamp     *int64
+	addtimestamp  *int64
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Fill, error)
+	predicates    []predicate.Fill
 }
 
-var _ ent.Mutation = (*MatchedTradeMutation)(nil)
+var _ ent.Mutation = (*FillMutation)(nil)
 
-// matchedtradeOption allows management of the mutation configuration using functional options.
-type matchedtradeOption func(*MatchedTradeMutation)
+// fillOption allows management of the mutation configuration using functional options.
+type fillOption func(*FillMutation)
 
-// newMatchedTradeMutation creates new mutation for the MatchedTrade entity.
-func newMatchedTradeMutation(c config, op Op, opts ...matchedtradeOption) *MatchedTradeMutation {
-	m := &MatchedTradeMutation{
+// newFillMutation creates new mutation for the Fill entity.
+func newFillMutation(c config, op Op, opts ...fillOption) *FillMutation {
+	m := &FillMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMatchedTrade,
+		typ:           TypeFill,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1241,20 +981,20 @@ func newMatchedTradeMutation(c config, op Op, opts ...matchedtradeOption) *Match
 	return m
 }
 
-// withMatchedTradeID sets the ID field of the mutation.
-func withMatchedTradeID(id int) matchedtradeOption {
-	return func(m *MatchedTradeMutation) {
+// withFillID sets the ID field of the mutation.
+func withFillID(id int) fillOption {
+	return func(m *FillMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MatchedTrade
+			value *Fill
 		)
-		m.oldValue = func(ctx context.Context) (*MatchedTrade, error) {
+		m.oldValue = func(ctx context.Context) (*Fill, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MatchedTrade.Get(ctx, id)
+					value, err = m.Client().Fill.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1263,10 +1003,10 @@ func withMatchedTradeID(id int) matchedtradeOption {
 	}
 }
 
-// withMatchedTrade sets the old MatchedTrade of the mutation.
-func withMatchedTrade(node *MatchedTrade) matchedtradeOption {
-	return func(m *MatchedTradeMutation) {
-		m.oldValue = func(context.Context) (*MatchedTrade, error) {
+// withFill sets the old Fill of the mutation.
+func withFill(node *Fill) fillOption {
+	return func(m *FillMutation) {
+		m.oldValue = func(context.Context) (*Fill, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1275,7 +1015,7 @@ func withMatchedTrade(node *MatchedTrade) matchedtradeOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MatchedTradeMutation) Client() *Client {
+func (m FillMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1283,7 +1023,7 @@ func (m MatchedTradeMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MatchedTradeMutation) Tx() (*Tx, error) {
+func (m FillMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1294,7 +1034,7 @@ func (m MatchedTradeMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MatchedTradeMutation) ID() (id int, exists bool) {
+func (m *FillMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1305,7 +1045,7 @@ func (m *MatchedTradeMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MatchedTradeMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *FillMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1314,19 +1054,19 @@ func (m *MatchedTradeMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MatchedTrade.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Fill.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *MatchedTradeMutation) SetCreateTime(t time.Time) {
+func (m *FillMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *MatchedTradeMutation) CreateTime() (r time.Time, exists bool) {
+func (m *FillMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -1334,10 +1074,10 @@ func (m *MatchedTradeMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the MatchedTrade entity.
-// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the Fill entity.
+// If the Fill object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MatchedTradeMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *FillMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -1352,17 +1092,17 @@ func (m *MatchedTradeMutation) OldCreateTime(ctx context.Context) (v time.Time,
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *MatchedTradeMutation) ResetCreateTime() {
+func (m *FillMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *MatchedTradeMutation) SetUpdateTime(t time.Time) {
+func (m *FillMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *MatchedTradeMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *FillMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -1370,10 +1110,10 @@ func (m *MatchedTradeMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the MatchedTrade entity.
-// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the Fill entity.
+// If the Fill object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MatchedTradeMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *FillMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -1388,53 +1128,53 @@ func (m *MatchedTradeMutation) OldUpdateTime(ctx context.Context) (v time.Time,
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *MatchedTradeMutation) ResetUpdateTime() {
+func (m *FillMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetStrategyId sets the "strategyId" field.
-func (m *MatchedTradeMutation) SetStrategyId(s string) {
-	m.strategyId = &s
+// SetExchange sets the "exchange" field.
+func (m *FillMutation) SetExchange(s string) {
+	m.exchange = &s
 }
 
-// StrategyId returns the value of the "strategyId" field in the mutation.
-func (m *MatchedTradeMutation) StrategyId() (r string, exists bool) {
-	v := m.strategyId
+// Exchange returns the value of the "exchange" field in the mutation.
+func (m *FillMutation) Exchange() (r string, exists bool) {
+	v := m.exchange
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStrategyId returns the old "strategyId" field's value of the MatchedTrade entity.
-// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// OldExchange returns the old "exchange" field's value of the Fill entity.
+// If the Fill object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MatchedTradeMutation) OldStrategyId(ctx context.Context) (v string, err error) {
+func (m *FillMutation) OldExchange(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStrategyId is only allowed on UpdateOne operations")
+		return v, errors.New("OldExchange is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStrategyId requires an ID field in the mutation")
+		return v, errors.New("OldExchange requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStrategyId: %w", err)
+		return v, fmt.Errorf("querying old value for OldExchange: %w", err)
 	}
-	return oldValue.StrategyId, nil
+	return oldValue.Exchange, nil
 }
 
-// ResetStrategyId resets all changes to the "strategyId" field.
-func (m *MatchedTradeMutation) ResetStrategyId() {
-	m.strategyId = nil
+// ResetExchange resets all changes to the "exchange" field.
+func (m *FillMutation) ResetExchange() {
+	m.exchange = nil
 }
 
 // SetAccount sets the "account" field.
-func (m *MatchedTradeMutation) SetAccount(s string) {
+func (m *FillMutation) SetAccount(s string) {
 	m.account = &s
 }
 
 // Account returns the value of the "account" field in the mutation.
-func (m *MatchedTradeMutation) Account() (r string, exists bool) {
+func (m *FillMutation) Account() (r string, exists bool) {
 	v := m.account
 	if v == nil {
 		return
@@ -1442,10 +1182,10 @@ func (m *MatchedTradeMutation) Account() (r string, exists bool) {
 	return *v, true
 }
 
-// OldAccount returns the old "account" field's value of the MatchedTrade entity.
-// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// OldAccount returns the old "account" field's value of the Fill entity.
+// If the Fill object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MatchedTradeMutation) OldAccount(ctx context.Context) (v string, err error) {
+func (m *FillMutation) OldAccount(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
 	}
@@ -1460,17 +1200,17 @@ func (m *MatchedTradeMutation) OldAccount(ctx context.Context) (v string, err er
 }
 
 // ResetAccount resets all changes to the "account" field.
-func (m *MatchedTradeMutation) ResetAccount() {
+func (m *FillMutation) ResetAccount() {
 	m.account = nil
 }
 
 // SetSymbol sets the "symbol" field.
-func (m *MatchedTradeMutation) SetSymbol(s string) {
+func (m *FillMutation) SetSymbol(s string) {
 	m.symbol = &s
 }
 
 // Symbol returns the value of the "symbol" field in the mutation.
-func (m *MatchedTradeMutation) Symbol() (r string, exists bool) {
+func (m *FillMutation) Symbol() (r string, exists bool) {
 	v := m.symbol
 	if v == nil {
 		return
@@ -1478,10 +1218,10 @@ func (m *MatchedTradeMutation) Symbol() (r string, exists bool) {
 	return *v, true
 }
 
-// OldSymbol returns the old "symbol" field's value of the MatchedTrade entity.
-// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// OldSymbol returns the old "symbol" field's value of the Fill entity.
+// If the Fill object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MatchedTradeMutation) OldSymbol(ctx context.Context) (v string, err error) {
+func (m *FillMutation) OldSymbol(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
 	}
@@ -1496,523 +1236,448 @@ func (m *MatchedTradeMutation) OldSymbol(ctx context.Context) (v string, err err
 }
 
 // ResetSymbol resets all changes to the "symbol" field.
-func (m *MatchedTradeMutation) ResetSymbol() {
+func (m *FillMutation) ResetSymbol() {
 	m.symbol = nil
 }
 
-// SetBuyClientOrderId sets the "buyClientOrderId" field.
-func (m *MatchedTradeMutation) SetBuyClientOrderId(s string) {
-	m.buyClientOrderId = &s
+// SetFillId sets the "fillId" field.
+func (m *FillMutation) SetFillId(s string) {
+	m.fillId = &s
 }
 
-// BuyClientOrderId returns the value of the "buyClientOrderId" field in the mutation.
-func (m *MatchedTradeMutation) BuyClientOrderId() (r string, exists bool) {
-	v := m.buyClientOrderId
+// FillId returns the value of the "fillId" field in the mutation.
+func (m *FillMutation) FillId() (r string, exists bool) {
+	v := m.fillId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBuyClientOrderId returns the old "buyClientOrderId" field's value of the MatchedTrade entity.
-// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// OldFillId returns the old "fillId" field's value of the Fill entity.
+// If the Fill object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MatchedTradeMutation) OldBuyClientOrderId(ctx context.Context) (v *string, err error) {
+func (m *FillMutation) OldFillId(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBuyClientOrderId is only allowed on UpdateOne operations")
+		return v, errors.New("OldFillId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBuyClientOrderId requires an ID field in the mutation")
+		return v, errors.New("OldFillId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBuyClientOrderId: %w", err)
+		return v, fmt.Errorf("querying old value for OldFillId: %w", err)
 	}
-	return oldValue.BuyClientOrderId, nil
-}
-
-// ClearBuyClientOrderId clears the value of the "buyClientOrderId" field.
-func (m *MatchedTradeMutation) ClearBuyClientOrderId() {
-	m.buyClientOrderId = nil
-	m.clearedFields[matchedtrade.FieldBuyClientOrderId] = struct{}{}
-}
-
-// BuyClientOrderIdCleared returns if the "buyClientOrderId" field was cleared in this mutation.
-func (m *MatchedTradeMutation) BuyClientOrderIdCleared() bool {
-	_, ok := m.clearedFields[matchedtrade.FieldBuyClientOrderId]
-	return ok
+	return oldValue.FillId, nil
 }
 
-// ResetBuyClientOrderId resets all changes to the "buyClientOrderId" field.
-func (m *MatchedTradeMutation) ResetBuyClientOrderId() {
-	m.buyClientOrderId = nil
-	delete(m.clearedFields, matchedtrade.FieldBuyClientOrderId)
+// ResetFillId resets all changes to the "fillId" field.
+func (m *FillMutation) ResetFillId() {
+	m.fillId = nil
 }
 
-// SetBuyBaseAmount sets the "buyBaseAmount" field.
-func (m *MatchedTradeMutation) SetBuyBaseAmount(d decimal.Decimal) {
-	m.buyBaseAmount = &d
+// SetOrderId sets the "orderId" field.
+func (m *FillMutation) SetOrderId(s string) {
+	m.orderId = &s
 }
 
-// BuyBaseAmount returns the value of the "buyBaseAmount" field in the mutation.
-func (m *MatchedTradeMutation) BuyBaseAmount() (r decimal.Decimal, exists bool) {
-	v := m.buyBaseAmount
+// OrderId returns the value of the "orderId" field in the mutation.
+func (m *FillMutation) OrderId() (r string, exists bool) {
+	v := m.orderId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBuyBaseAmount returns the old "buyBaseAmount" field's value of the MatchedTrade entity.
-// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// OldOrderId returns the old "orderId" field's value of the Fill entity.
+// If the Fill object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MatchedTradeMutation) OldBuyBaseAmount(ctx context.Context) (v *decimal.Decimal, err error) {
+func (m *FillMutation) OldOrderId(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBuyBaseAmount is only allowed on UpdateOne operations")
+		return v, errors.New("OldOrderId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBuyBaseAmount requires an ID field in the mutation")
+		return v, errors.New("OldOrderId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBuyBaseAmount: %w", err)
+		return v, fmt.Errorf("querying old value for OldOrderId: %w", err)
 	}
-	return oldValue.BuyBaseAmount, nil
-}
-
-// ClearBuyBaseAmount clears the value of the "buyBaseAmount" field.
-func (m *MatchedTradeMutation) ClearBuyBaseAmount() {
-	m.buyBaseAmount = nil
-	m.clearedFields[matchedtrade.FieldBuyBaseAmount] = struct{}{}
-}
-
-// BuyBaseAmountCleared returns if the "buyBaseAmount" field was cleared in this mutation.
-func (m *MatchedTradeMutation) BuyBaseAmountCleared() bool {
-	_, ok := m.clearedFields[matchedtrade.FieldBuyBaseAmount]
-	return ok
+	return oldValue.OrderId, nil
 }
 
-// ResetBuyBaseAmount resets all changes to the "buyBaseAmount" field.
-func (m *MatchedTradeMutation) ResetBuyBaseAmount() {
-	m.buyBaseAmount = nil
-	delete(m.clearedFields, matchedtrade.FieldBuyBaseAmount)
+// ResetOrderId resets all changes to the "orderId" field.
+func (m *FillMutation) ResetOrderId() {
+	m.orderId = nil
 }
 
-// SetBuyQuoteAmount sets the "buyQuoteAmount" field.
-func (m *MatchedTradeMutation) SetBuyQuoteAmount(d decimal.Decimal) {
-	m.buyQuoteAmount = &d
+// SetClientOrderId sets the "clientOrderId" field.
+func (m *FillMutation) SetClientOrderId(s string) {
+	m.clientOrderId = &s
 }
 
-// BuyQuoteAmount returns the value of the "buyQuoteAmount" field in the mutation.
-func (m *MatchedTradeMutation) BuyQuoteAmount() (r decimal.Decimal, exists bool) {
-	v := m.buyQuoteAmount
+// ClientOrderId returns the value of the "clientOrderId" field in the mutation.
+func (m *FillMutation) ClientOrderId() (r string, exists bool) {
+	v := m.clientOrderId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBuyQuoteAmount returns the old "buyQuoteAmount" field's value of the MatchedTrade entity.
-// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// OldClientOrderId returns the old "clientOrderId" field's value of the Fill entity.
+// If the Fill object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MatchedTradeMutation) OldBuyQuoteAmount(ctx context.Context) (v *decimal.Decimal, err error) {
+func (m *FillMutation) OldClientOrderId(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBuyQuoteAmount is only allowed on UpdateOne operations")
+		return v, errors.New("OldClientOrderId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBuyQuoteAmount requires an ID field in the mutation")
+		return v, errors.New("OldClientOrderId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBuyQuoteAmount: %w", err)
+		return v, fmt.Errorf("querying old value for OldClientOrderId: %w", err)
 	}
-	return oldValue.BuyQuoteAmount, nil
+	return oldValue.ClientOrderId, nil
 }
 
-// ClearBuyQuoteAmount clears the value of the "buyQuoteAmount" field.
-func (m *MatchedTradeMutation) ClearBuyQuoteAmount() {
-	m.buyQuoteAmount = nil
-	m.clearedFields[matchedtrade.FieldBuyQuoteAmount] = struct{}{}
+// ClearClientOrderId clears the value of the "clientOrderId" field.
+func (m *FillMutation) ClearClientOrderId() {
+	m.clientOrderId = nil
+	m.clearedFields[fill.FieldClientOrderId] = struct{}{}
 }
 
-// BuyQuoteAmountCleared returns if the "buyQuoteAmount" field was cleared in this mutation.
-func (m *MatchedTradeMutation) BuyQuoteAmountCleared() bool {
-	_, ok := m.clearedFields[matchedtrade.FieldBuyQuoteAmount]
+// ClientOrderIdCleared returns if the "clientOrderId" field was cleared in this mutation.
+func (m *FillMutation) ClientOrderIdCleared() bool {
+	_, ok := m.clearedFields[fill.FieldClientOrderId]
 	return ok
 }
 
-// ResetBuyQuoteAmount resets all changes to the "buyQuoteAmount" field.
-func (m *MatchedTradeMutation) ResetBuyQuoteAmount() {
-	m.buyQuoteAmount = nil
-	delete(m.clearedFields, matchedtrade.FieldBuyQuoteAmount)
+// ResetClientOrderId resets all changes to the "clientOrderId" field.
+func (m *FillMutation) ResetClientOrderId() {
+	m.clientOrderId = nil
+	delete(m.clearedFields, fill.FieldClientOrderId)
 }
 
-// SetBuyOrderTimestamp sets the "buyOrderTimestamp" field.
-func (m *MatchedTradeMutation) SetBuyOrderTimestamp(i int64) {
-	m.buyOrderTimestamp = &i
-	m.addbuyOrderTimestamp = nil
+// SetSide sets the "side" field.
+func (m *FillMutation) SetSide(f fill.Side) {
+	m.side = &f
 }
 
-// BuyOrderTimestamp returns the value of the "buyOrderTimestamp" field in the mutation.
-func (m *MatchedTradeMutation) BuyOrderTimestamp() (r int64, exists bool) {
-	v := m.buyOrderTimestamp
+// Side returns the value of the "side" field in the mutation.
+func (m *FillMutation) Side() (r fill.Side, exists bool) {
+	v := m.side
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBuyOrderTimestamp returns the old "buyOrderTimestamp" field's value of the MatchedTrade entity.
-// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// OldSide returns the old "side" field's value of the Fill entity.
+// If the Fill object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MatchedTradeMutation) OldBuyOrderTimestamp(ctx context.Context) (v *int64, err error) {
+func (m *FillMutation) OldSide(ctx context.Context) (v fill.Side, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBuyOrderTimestamp is only allowed on UpdateOne operations")
+		return v, errors.New("OldSide is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBuyOrderTimestamp requires an ID field in the mutation")
+		return v, errors.New("OldSide requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBuyOrderTimestamp: %w", err)
+		return v, fmt.Errorf("querying old value for OldSide: %w", err)
 	}
-	return oldValue.BuyOrderTimestamp, nil
+	return oldValue.Side, nil
 }
 
-// AddBuyOrderTimestamp adds i to the "buyOrderTimestamp" field.
-func (m *MatchedTradeMutation) AddBuyOrderTimestamp(i int64) {
-	if m.addbuyOrderTimestamp != nil {
-		*m.addbuyOrderTimestamp += i
-	} else {
-		m.addbuyOrderTimestamp = &i
-	}
+// ResetSide resets all changes to the "side" field.
+func (m *FillMutation) ResetSide() {
+	m.side = nil
 }
 
-// AddedBuyOrderTimestamp returns the value that was added to the "buyOrderTimestamp" field in this mutation.
-func (m *MatchedTradeMutation) AddedBuyOrderTimestamp() (r int64, exists bool) {
-	v := m.addbuyOrderTimestamp
+// SetPrice sets the "price" field.
+func (m *FillMutation) SetPrice(d decimal.Decimal) {
+	m.price = &d
+}
+
+// Price returns the value of the "price" field in the mutation.
+func (m *FillMutation) Price() (r decimal.Decimal, exists bool) {
+	v := m.price
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearBuyOrderTimestamp clears the value of the "buyOrderTimestamp" field.
-func (m *MatchedTradeMutation) ClearBuyOrderTimestamp() {
-	m.buyOrderTimestamp = nil
-	m.addbuyOrderTimestamp = nil
-	m.clearedFields[matchedtrade.FieldBuyOrderTimestamp] = struct{}{}
-}
-
-// BuyOrderTimestampCleared returns if the "buyOrderTimestamp" field was cleared in this mutation.
-func (m *MatchedTradeMutation) BuyOrderTimestampCleared() bool {
-	_, ok := m.clearedFields[matchedtrade.FieldBuyOrderTimestamp]
-	return ok
+// OldPrice returns the old "price" field's value of the Fill entity.
+// If the Fill object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FillMutation) OldPrice(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+	}
+	return oldValue.Price, nil
 }
 
-// ResetBuyOrderTimestamp resets all changes to the "buyOrderTimestamp" field.
-func (m *MatchedTradeMutation) ResetBuyOrderTimestamp() {
-	m.buyOrderTimestamp = nil
-	m.addbuyOrderTimestamp = nil
-	delete(m.clearedFields, matchedtrade.FieldBuyOrderTimestamp)
+// ResetPrice resets all changes to the "price" field.
+func (m *FillMutation) ResetPrice() {
+	m.price = nil
 }
 
-// SetSellClientOrderId sets the "sellClientOrderId" field.
-func (m *MatchedTradeMutation) SetSellClientOrderId(s string) {
-	m.sellClientOrderId = &s
+// SetBaseAmount sets the "baseAmount" field.
+func (m *FillMutation) SetBaseAmount(d decimal.Decimal) {
+	m.baseAmount = &d
 }
 
-// SellClientOrderId returns the value of the "sellClientOrderId" field in the mutation.
-func (m *MatchedTradeMutation) SellClientOrderId() (r string, exists bool) {
-	v := m.sellClientOrderId
+// BaseAmount returns the value of the "baseAmount" field in the mutation.
+func (m *FillMutation) BaseAmount() (r decimal.Decimal, exists bool) {
+	v := m.baseAmount
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSellClientOrderId returns the old "sellClientOrderId" field's value of the MatchedTrade entity.
-// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// OldBaseAmount returns the old "baseAmount" field's value of the Fill entity.
+// If the Fill object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MatchedTradeMutation) OldSellClientOrderId(ctx context.Context) (v *string, err error) {
+func (m *FillMutation) OldBaseAmount(ctx context.Context) (v decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSellClientOrderId is only allowed on UpdateOne operations")
+		return v, errors.New("OldBaseAmount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSellClientOrderId requires an ID field in the mutation")
+		return v, errors.New("OldBaseAmount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSellClientOrderId: %w", err)
+		return v, fmt.Errorf("querying old value for OldBaseAmount: %w", err)
 	}
-	return oldValue.SellClientOrderId, nil
-}
-
-// ClearSellClientOrderId clears the value of the "sellClientOrderId" field.
-func (m *MatchedTradeMutation) ClearSellClientOrderId() {
-	m.sellClientOrderId = nil
-	m.clearedFields[matchedtrade.FieldSellClientOrderId] = struct{}{}
-}
-
-// SellClientOrderIdCleared returns if the "sellClientOrderId" field was cleared in this mutation.
-func (m *MatchedTradeMutation) SellClientOrderIdCleared() bool {
-	_, ok := m.clearedFields[matchedtrade.FieldSellClientOrderId]
-	return ok
+	return oldValue.BaseAmount, nil
 }
 
-// ResetSellClientOrderId resets all changes to the "sellClientOrderId" field.
-func (m *MatchedTradeMutation) ResetSellClientOrderId() {
-	m.sellClientOrderId = nil
-	delete(m.clearedFields, matchedtrade.FieldSellClientOrderId)
+// ResetBaseAmount resets all changes to the "baseAmount" field.
+func (m *FillMutation) ResetBaseAmount() {
+	m.baseAmount = nil
 }
 
-// SetSellBaseAmount sets the "sellBaseAmount" field.
-func (m *MatchedTradeMutation) SetSellBaseAmount(d decimal.Decimal) {
-	m.sellBaseAmount = &d
+// SetQuoteAmount sets the "quoteAmount" field.
+func (m *FillMutation) SetQuoteAmount(d decimal.Decimal) {
+	m.quoteAmount = &d
 }
 
-// SellBaseAmount returns the value of the "sellBaseAmount" field in the mutation.
-func (m *MatchedTradeMutation) SellBaseAmount() (r decimal.Decimal, exists bool) {
-	v := m.sellBaseAmount
+// QuoteAmount returns the value of the "quoteAmount" field in the mutation.
+func (m *FillMutation) QuoteAmount() (r decimal.Decimal, exists bool) {
+	v := m.quoteAmount
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSellBaseAmount returns the old "sellBaseAmount" field's value of the MatchedTrade entity.
-// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// OldQuoteAmount returns the old "quoteAmount" field's value of the Fill entity.
+// If the Fill object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MatchedTradeMutation) OldSellBaseAmount(ctx context.Context) (v *decimal.Decimal, err error) {
+func (m *FillMutation) OldQuoteAmount(ctx context.Context) (v decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSellBaseAmount is only allowed on UpdateOne operations")
+		return v, errors.New("OldQuoteAmount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSellBaseAmount requires an ID field in the mutation")
+		return v, errors.New("OldQuoteAmount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSellBaseAmount: %w", err)
+		return v, fmt.Errorf("querying old value for OldQuoteAmount: %w", err)
 	}
-	return oldValue.SellBaseAmount, nil
+	return oldValue.QuoteAmount, nil
 }
 
-// ClearSellBaseAmount clears the value of the "sellBaseAmount" field.
-func (m *MatchedTradeMutation) ClearSellBaseAmount() {
-	m.sellBaseAmount = nil
-	m.clearedFields[matchedtrade.FieldSellBaseAmount] = struct{}{}
-}
-
-// SellBaseAmountCleared returns if the "sellBaseAmount" field was cleared in this mutation.
-func (m *MatchedTradeMutation) SellBaseAmountCleared() bool {
-	_, ok := m.clearedFields[matchedtrade.FieldSellBaseAmount]
-	return ok
+// ResetQuoteAmount resets all changes to the "quoteAmount" field.
+func (m *FillMutation) ResetQuoteAmount() {
+	m.quoteAmount = nil
 }
 
-// ResetSellBaseAmount resets all changes to the "sellBaseAmount" field.
-func (m *MatchedTradeMutation) ResetSellBaseAmount() {
-	m.sellBaseAmount = nil
-	delete(m.clearedFields, matchedtrade.FieldSellBaseAmount)
-}
-
-// SetSellQuoteAmount sets the "sellQuoteAmount" field.
-func (m *MatchedTradeMutation) SetSellQuoteAmount(d decimal.Decimal) {
-	m.sellQuoteAmount = &d
+// SetFee sets the "fee" field.
+func (m *FillMutation) SetFee(d decimal.Decimal) {
+	m.fee = &d
 }
 
-// SellQuoteAmount returns the value of the "sellQuoteAmount" field in the mutation.
-func (m *MatchedTradeMutation) SellQuoteAmount() (r decimal.Decimal, exists bool) {
-	v := m.sellQuoteAmount
+// Fee returns the value of the "fee" field in the mutation.
+func (m *FillMutation) Fee() (r decimal.Decimal, exists bool) {
+	v := m.fee
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSellQuoteAmount returns the old "sellQuoteAmount" field's value of the MatchedTrade entity.
-// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// OldFee returns the old "fee" field's value of the Fill entity.
+// If the Fill object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MatchedTradeMutation) OldSellQuoteAmount(ctx context.Context) (v *decimal.Decimal, err error) {
+func (m *FillMutation) OldFee(ctx context.Context) (v decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSellQuoteAmount is only allowed on UpdateOne operations")
+		return v, errors.New("OldFee is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSellQuoteAmount requires an ID field in the mutation")
+		return v, errors.New("OldFee requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSellQuoteAmount: %w", err)
+		return v, fmt.Errorf("querying old value for OldFee: %w", err)
 	}
-	return oldValue.SellQuoteAmount, nil
-}
-
-// ClearSellQuoteAmount clears the value of the "sellQuoteAmount" field.
-func (m *MatchedTradeMutation) ClearSellQuoteAmount() {
-	m.sellQuoteAmount = nil
-	m.clearedFields[matchedtrade.FieldSellQuoteAmount] = struct{}{}
-}
-
-// SellQuoteAmountCleared returns if the "sellQuoteAmount" field was cleared in this mutation.
-func (m *MatchedTradeMutation) SellQuoteAmountCleared() bool {
-	_, ok := m.clearedFields[matchedtrade.FieldSellQuoteAmount]
-	return ok
+	return oldValue.Fee, nil
 }
 
-// ResetSellQuoteAmount resets all changes to the "sellQuoteAmount" field.
-func (m *MatchedTradeMutation) ResetSellQuoteAmount() {
-	m.sellQuoteAmount = nil
-	delete(m.clearedFields, matchedtrade.FieldSellQuoteAmount)
+// ResetFee resets all changes to the "fee" field.
+func (m *FillMutation) ResetFee() {
+	m.fee = nil
 }
 
-// SetSellOrderTimestamp sets the "sellOrderTimestamp" field.
-func (m *MatchedTradeMutation) SetSellOrderTimestamp(i int64) {
-	m.sellOrderTimestamp = &i
-	m.addsellOrderTimestamp = nil
+// SetFeeAsset sets the "feeAsset" field.
+func (m *FillMutation) SetFeeAsset(s string) {
+	m.feeAsset = &s
 }
 
-// SellOrderTimestamp returns the value of the "sellOrderTimestamp" field in the mutation.
-func (m *MatchedTradeMutation) SellOrderTimestamp() (r int64, exists bool) {
-	v := m.sellOrderTimestamp
+// FeeAsset returns the value of the "feeAsset" field in the mutation.
+func (m *FillMutation) FeeAsset() (r string, exists bool) {
+	v := m.feeAsset
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSellOrderTimestamp returns the old "sellOrderTimestamp" field's value of the MatchedTrade entity.
-// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// OldFeeAsset returns the old "feeAsset" field's value of the Fill entity.
+// If the Fill object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MatchedTradeMutation) OldSellOrderTimestamp(ctx context.Context) (v *int64, err error) {
+func (m *FillMutation) OldFeeAsset(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSellOrderTimestamp is only allowed on UpdateOne operations")
+		return v, errors.New("OldFeeAsset is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSellOrderTimestamp requires an ID field in the mutation")
+		return v, errors.New("OldFeeAsset requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSellOrderTimestamp: %w", err)
+		return v, fmt.Errorf("querying old value for OldFeeAsset: %w", err)
 	}
-	return oldValue.SellOrderTimestamp, nil
+	return oldValue.FeeAsset, nil
 }
 
-// AddSellOrderTimestamp adds i to the "sellOrderTimestamp" field.
-func (m *MatchedTradeMutation) AddSellOrderTimestamp(i int64) {
-	if m.addsellOrderTimestamp != nil {
-		*m.addsellOrderTimestamp += i
-	} else {
-		m.addsellOrderTimestamp = &i
-	}
+// ResetFeeAsset resets all changes to the "feeAsset" field.
+func (m *FillMutation) ResetFeeAsset() {
+	m.feeAsset = nil
 }
 
-// AddedSellOrderTimestamp returns the value that was added to the "sellOrderTimestamp" field in this mutation.
-func (m *MatchedTradeMutation) AddedSellOrderTimestamp() (r int64, exists bool) {
-	v := m.addsellOrderTimestamp
+// SetIsMaker sets the "isMaker" field.
+func (m *FillMutation) SetIsMaker(b bool) {
+	m.isMaker = &b
+}
+
+// IsMaker returns the value of the "isMaker" field in the mutation.
+func (m *FillMutation) IsMaker() (r bool, exists bool) {
+	v := m.isMaker
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearSellOrderTimestamp clears the value of the "sellOrderTimestamp" field.
-func (m *MatchedTradeMutation) ClearSellOrderTimestamp() {
-	m.sellOrderTimestamp = nil
-	m.addsellOrderTimestamp = nil
-	m.clearedFields[matchedtrade.FieldSellOrderTimestamp] = struct{}{}
-}
-
-// SellOrderTimestampCleared returns if the "sellOrderTimestamp" field was cleared in this mutation.
-func (m *MatchedTradeMutation) SellOrderTimestampCleared() bool {
-	_, ok := m.clearedFields[matchedtrade.FieldSellOrderTimestamp]
-	return ok
+// OldIsMaker returns the old "isMaker" field's value of the Fill entity.
+// If the Fill object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FillMutation) OldIsMaker(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsMaker is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsMaker requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsMaker: %w", err)
+	}
+	return oldValue.IsMaker, nil
 }
 
-// ResetSellOrderTimestamp resets all changes to the "sellOrderTimestamp" field.
-func (m *MatchedTradeMutation) ResetSellOrderTimestamp() {
-	m.sellOrderTimestamp = nil
-	m.addsellOrderTimestamp = nil
-	delete(m.clearedFields, matchedtrade.FieldSellOrderTimestamp)
+// ResetIsMaker resets all changes to the "isMaker" field.
+func (m *FillMutation) ResetIsMaker() {
+	m.isMaker = nil
 }
 
-// SetProfit sets the "profit" field.
-func (m *MatchedTradeMutation) SetProfit(f float64) {
-	m.profit = &f
-	m.addprofit = nil
+// SetTimestamp sets the "timestamp" field.
+func (m *FillMutation) SetTimestamp(i int64) {
+	m.timestamp = &i
+	m.addtimestamp = nil
 }
 
-// Profit returns the value of the "profit" field in the mutation.
-func (m *MatchedTradeMutation) Profit() (r float64, exists bool) {
-	v := m.profit
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *FillMutation) Timestamp() (r int64, exists bool) {
+	v := m.timestamp
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProfit returns the old "profit" field's value of the MatchedTrade entity.
-// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// OldTimestamp returns the old "timestamp" field's value of the Fill entity.
+// If the Fill object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MatchedTradeMutation) OldProfit(ctx context.Context) (v *float64, err error) {
+func (m *FillMutation) OldTimestamp(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProfit is only allowed on UpdateOne operations")
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProfit requires an ID field in the mutation")
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProfit: %w", err)
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
 	}
-	return oldValue.Profit, nil
+	return oldValue.Timestamp, nil
 }
 
-// AddProfit adds f to the "profit" field.
-func (m *MatchedTradeMutation) AddProfit(f float64) {
-	if m.addprofit != nil {
-		*m.addprofit += f
+// AddTimestamp adds i to the "timestamp" field.
+func (m *FillMutation) AddTimestamp(i int64) {
+	if m.addtimestamp != nil {
+		*m.addtimestamp += i
 	} else {
-		m.addprofit = &f
+		m.addtimestamp = &i
 	}
 }
 
-// AddedProfit returns the value that was added to the "profit" field in this mutation.
-func (m *MatchedTradeMutation) AddedProfit() (r float64, exists bool) {
-	v := m.addprofit
+// AddedTimestamp returns the value that was added to the "timestamp" field in this mutation.
+func (m *FillMutation) AddedTimestamp() (r int64, exists bool) {
+	v := m.addtimestamp
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearProfit clears the value of the "profit" field.
-func (m *MatchedTradeMutation) ClearProfit() {
-	m.profit = nil
-	m.addprofit = nil
-	m.clearedFields[matchedtrade.FieldProfit] = struct{}{}
-}
-
-// ProfitCleared returns if the "profit" field was cleared in this mutation.
-func (m *MatchedTradeMutation) ProfitCleared() bool {
-	_, ok := m.clearedFields[matchedtrade.FieldProfit]
-	return ok
-}
-
-// ResetProfit resets all changes to the "profit" field.
-func (m *MatchedTradeMutation) ResetProfit() {
-	m.profit = nil
-	m.addprofit = nil
-	delete(m.clearedFields, matchedtrade.FieldProfit)
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *FillMutation) ResetTimestamp() {
+	m.timestamp = nil
+	m.addtimestamp = nil
 }
 
-// Where appends a list predicates to the MatchedTradeMutation builder.
-func (m *MatchedTradeMutation) Where(ps ...predicate.MatchedTrade) {
+// Where appends a list predicates to the FillMutation builder.
+func (m *FillMutation) Where(ps ...predicate.Fill) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MatchedTradeMutation builder. Using this method,
+// WhereP appends storage-level predicates to the FillMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MatchedTradeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MatchedTrade, len(ps))
+func (m *FillMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Fill, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2020,66 +1685,72 @@ func (m *MatchedTradeMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MatchedTradeMutation) Op() Op {
+func (m *FillMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MatchedTradeMutation) SetOp(op Op) {
+func (m *FillMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (MatchedTrade).
-func (m *MatchedTradeMutation) Type() string {
+// Type returns the node type of this mutation (Fill).
+func (m *FillMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MatchedTradeMutation) Fields() []string {
-	fields := make([]string, 0, 14)
+func (m *FillMutation) Fields() []string {
+	fields := make([]string, 0, 16)
 	if m.create_time != nil {
-		fields = append(fields, matchedtrade.FieldCreateTime)
+		fields = append(fields, fill.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, matchedtrade.FieldUpdateTime)
+		fields = append(fields, fill.FieldUpdateTime)
 	}
-	if m.strategyId != nil {
-		fields = append(fields, matchedtrade.FieldStrategyId)
+	if m.exchange != nil {
+		fields = append(fields, fill.FieldExchange)
 	}
 	if m.account != nil {
-		fields = append(fields, matchedtrade.FieldAccount)
+		fields = append(fields, fill.FieldAccount)
 	}
 	if m.symbol != nil {
-		fields = append(fields, matchedtrade.FieldSymbol)
+		fields = append(fields, fill.FieldSymbol)
 	}
-	if m.buyClientOrderId != nil {
-		fields = append(fields, matchedtrade.FieldBuyClientOrderId)
+	if m.fillId != nil {
+		fields = append(fields, fill.FieldFillId)
 	}
-	if m.buyBaseAmount != nil {
-		fields = append(fields, matchedtrade.FieldBuyBaseAmount)
+	if m.orderId != nil {
+		fields = append(fields, fill.FieldOrderId)
 	}
-	if m.buyQuoteAmount != nil {
-		fields = append(fields, matchedtrade.FieldBuyQuoteAmount)
+	if m.clientOrderId != nil {
+		fields = append(fields, fill.FieldClientOrderId)
 	}
-	if m.buyOrderTimestamp != nil {
-		fields = append(fields, matchedtrade.FieldBuyOrderTimestamp)
+	if m.side != nil {
+		fields = append(fields, fill.FieldSide)
 	}
-	if m.sellClientOrderId != nil {
-		fields = append(fields, matchedtrade.FieldSellClientOrderId)
+	if m.price != nil {
+		fields = append(fields, fill.FieldPrice)
 	}
-	if m.sellBaseAmount != nil {
-		fields = append(fields, matchedtrade.FieldSellBaseAmount)
+	if m.baseAmount != nil {
+		fields = append(fields, fill.FieldBaseAmount)
 	}
-	if m.sellQuoteAmount != nil {
-		fields = append(fields, matchedtrade.FieldSellQuoteAmount)
+	if m.quoteAmount != nil {
+		fields = append(fields, fill.FieldQuoteAmount)
 	}
-	if m.sellOrderTimestamp != nil {
-		fields = append(fields, matchedtrade.FieldSellOrderTimestamp)
+	if m.fee != nil {
+		fields = append(fields, fill.FieldFee)
 	}
-	if m.profit != nil {
-		fields = append(fields, matchedtrade.FieldProfit)
+	if m.feeAsset != nil {
+		fields = append(fields, fill.FieldFeeAsset)
+	}
+	if m.isMaker != nil {
+		fields = append(fields, fill.FieldIsMaker)
+	}
+	if m.timestamp != nil {
+		fields = append(fields, fill.FieldTimestamp)
 	}
 	return fields
 }
@@ -2087,36 +1758,40 @@ func (m *MatchedTradeMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MatchedTradeMutation) Field(name string) (ent.Value, bool) {
+func (m *FillMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case matchedtrade.FieldCreateTime:
+	case fill.FieldCreateTime:
 		return m.CreateTime()
-	case matchedtrade.FieldUpdateTime:
+	case fill.FieldUpdateTime:
 		return m.UpdateTime()
-	case matchedtrade.FieldStrategyId:
-		return m.StrategyId()
-	case matchedtrade.FieldAccount:
+	case fill.FieldExchange:
+		return m.Exchange()
+	case fill.FieldAccount:
 		return m.Account()
-	case matchedtrade.FieldSymbol:
+	case fill.FieldSymbol:
 		return m.Symbol()
-	case matchedtrade.FieldBuyClientOrderId:
-		return m.BuyClientOrderId()
-	case matchedtrade.FieldBuyBaseAmount:
-		return m.BuyBaseAmount()
-	case matchedtrade.FieldBuyQuoteAmount:
-		return m.BuyQuoteAmount()
-	case matchedtrade.FieldBuyOrderTimestamp:
-		return m.BuyOrderTimestamp()
-	case matchedtrade.FieldSellClientOrderId:
-		return m.SellClientOrderId()
-	case matchedtrade.FieldSellBaseAmount:
-		return m.SellBaseAmount()
-	case matchedtrade.FieldSellQuoteAmount:
-		return m.SellQuoteAmount()
-	case matchedtrade.FieldSellOrderTimestamp:
-		return m.SellOrderTimestamp()
-	case matchedtrade.FieldProfit:
-		return m.Profit()
+	case fill.FieldFillId:
+		return m.FillId()
+	case fill.FieldOrderId:
+		return m.OrderId()
+	case fill.FieldClientOrderId:
+		return m.ClientOrderId()
+	case fill.FieldSide:
+		return m.Side()
+	case fill.FieldPrice:
+		return m.Price()
+	case fill.FieldBaseAmount:
+		return m.BaseAmount()
+	case fill.FieldQuoteAmount:
+		return m.QuoteAmount()
+	case fill.FieldFee:
+		return m.Fee()
+	case fill.FieldFeeAsset:
+		return m.FeeAsset()
+	case fill.FieldIsMaker:
+		return m.IsMaker()
+	case fill.FieldTimestamp:
+		return m.Timestamp()
 	}
 	return nil, false
 }
@@ -2124,159 +1799,171 @@ func (m *MatchedTradeMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MatchedTradeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *FillMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case matchedtrade.FieldCreateTime:
+	case fill.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case matchedtrade.FieldUpdateTime:
+	case fill.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case matchedtrade.FieldStrategyId:
-		return m.OldStrategyId(ctx)
-	case matchedtrade.FieldAccount:
+	case fill.FieldExchange:
+		return m.OldExchange(ctx)
+	case fill.FieldAccount:
 		return m.OldAccount(ctx)
-	case matchedtrade.FieldSymbol:
+	case fill.FieldSymbol:
 		return m.OldSymbol(ctx)
-	case matchedtrade.FieldBuyClientOrderId:
-		return m.OldBuyClientOrderId(ctx)
-	case matchedtrade.FieldBuyBaseAmount:
-		return m.OldBuyBaseAmount(ctx)
-	case matchedtrade.FieldBuyQuoteAmount:
-		return m.OldBuyQuoteAmount(ctx)
-	case matchedtrade.FieldBuyOrderTimestamp:
-		return m.OldBuyOrderTimestamp(ctx)
-	case matchedtrade.FieldSellClientOrderId:
-		return m.OldSellClientOrderId(ctx)
-	case matchedtrade.FieldSellBaseAmount:
-		return m.OldSellBaseAmount(ctx)
-	case matchedtrade.FieldSellQuoteAmount:
-		return m.OldSellQuoteAmount(ctx)
-	case matchedtrade.FieldSellOrderTimestamp:
-		return m.OldSellOrderTimestamp(ctx)
-	case matchedtrade.FieldProfit:
-		return m.OldProfit(ctx)
-	}
-	return nil, fmt.Errorf("unknown MatchedTrade field %s", name)
-}
+	case fill.FieldFillId:
+		return m.OldFillId(ctx)
+	case fill.FieldOrderId:
+		return m.OldOrderId(ctx)
+	case fill.FieldClientOrderId:
+		return m.OldClientOrderId(ctx)
+	case fill.FieldSide:
+		return m.OldSide(ctx)
+	case fill.FieldPrice:
+		return m.OldPrice(ctx)
+	case fill.FieldBaseAmount:
+		return m.OldBaseAmount(ctx)
+	case fill.FieldQuoteAmount:
+		return m.OldQuoteAmount(ctx)
+	case fill.FieldFee:
+		return m.OldFee(ctx)
+	case fill.FieldFeeAsset:
+		return m.OldFeeAsset(ctx)
+	case fill.FieldIsMaker:
+		return m.OldIsMaker(ctx)
+	case fill.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	}
+	return nil, fmt.Errorf("unknown Fill field %s", name)
+}
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MatchedTradeMutation) SetField(name string, value ent.Value) error {
+func (m *FillMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case matchedtrade.FieldCreateTime:
+	case fill.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case matchedtrade.FieldUpdateTime:
+	case fill.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case matchedtrade.FieldStrategyId:
+	case fill.FieldExchange:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStrategyId(v)
+		m.SetExchange(v)
 		return nil
-	case matchedtrade.FieldAccount:
+	case fill.FieldAccount:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetAccount(v)
 		return nil
-	case matchedtrade.FieldSymbol:
+	case fill.FieldSymbol:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetSymbol(v)
 		return nil
-	case matchedtrade.FieldBuyClientOrderId:
+	case fill.FieldFillId:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBuyClientOrderId(v)
+		m.SetFillId(v)
 		return nil
-	case matchedtrade.FieldBuyBaseAmount:
-		v, ok := value.(decimal.Decimal)
+	case fill.FieldOrderId:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBuyBaseAmount(v)
+		m.SetOrderId(v)
 		return nil
-	case matchedtrade.FieldBuyQuoteAmount:
-		v, ok := value.(decimal.Decimal)
+	case fill.FieldClientOrderId:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBuyQuoteAmount(v)
+		m.SetClientOrderId(v)
 		return nil
-	case matchedtrade.FieldBuyOrderTimestamp:
-		v, ok := value.(int64)
+	case fill.FieldSide:
+		v, ok := value.(fill.Side)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBuyOrderTimestamp(v)
+		m.SetSide(v)
 		return nil
-	case matchedtrade.FieldSellClientOrderId:
-		v, ok := value.(string)
+	case fill.FieldPrice:
+		v, ok := value.(decimal.Decimal)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSellClientOrderId(v)
+		m.SetPrice(v)
 		return nil
-	case matchedtrade.FieldSellBaseAmount:
+	case fill.FieldBaseAmount:
 		v, ok := value.(decimal.Decimal)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSellBaseAmount(v)
+		m.SetBaseAmount(v)
 		return nil
-	case matchedtrade.FieldSellQuoteAmount:
+	case fill.FieldQuoteAmount:
 		v, ok := value.(decimal.Decimal)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSellQuoteAmount(v)
+		m.SetQuoteAmount(v)
 		return nil
-	case matchedtrade.FieldSellOrderTimestamp:
-		v, ok := value.(int64)
+	case fill.FieldFee:
+		v, ok := value.(decimal.Decimal)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSellOrderTimestamp(v)
+		m.SetFee(v)
 		return nil
-	case matchedtrade.FieldProfit:
-		v, ok := value.(float64)
+	case fill.FieldFeeAsset:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProfit(v)
+		m.SetFeeAsset(v)
+		return nil
+	case fill.FieldIsMaker:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsMaker(v)
+		return nil
+	case fill.FieldTimestamp:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MatchedTrade field %s", name)
+	return fmt.Errorf("unknown Fill field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MatchedTradeMutation) AddedFields() []string {
+func (m *FillMutation) AddedFields() []string {
 	var fields []string
-	if m.addbuyOrderTimestamp != nil {
-		fields = append(fields, matchedtrade.FieldBuyOrderTimestamp)
-	}
-	if m.addsellOrderTimestamp != nil {
-		fields = append(fields, matchedtrade.FieldSellOrderTimestamp)
-	}
-	if m.addprofit != nil {
-		fields = append(fields, matchedtrade.FieldProfit)
+	if m.addtimestamp != nil {
+		fields = append(fields, fill.FieldTimestamp)
 	}
 	return fields
 }
@@ -2284,14 +1971,10 @@ func (m *MatchedTradeMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MatchedTradeMutation) AddedField(name string) (ent.Value, bool) {
+func (m *FillMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case matchedtrade.FieldBuyOrderTimestamp:
-		return m.AddedBuyOrderTimestamp()
-	case matchedtrade.FieldSellOrderTimestamp:
-		return m.AddedSellOrderTimestamp()
-	case matchedtrade.FieldProfit:
-		return m.AddedProfit()
+	case fill.FieldTimestamp:
+		return m.AddedTimestamp()
 	}
 	return nil, false
 }
@@ -2299,245 +1982,188 @@ func (m *MatchedTradeMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MatchedTradeMutation) AddField(name string, value ent.Value) error {
+func (m *FillMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case matchedtrade.FieldBuyOrderTimestamp:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddBuyOrderTimestamp(v)
-		return nil
-	case matchedtrade.FieldSellOrderTimestamp:
+	case fill.FieldTimestamp:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSellOrderTimestamp(v)
-		return nil
-	case matchedtrade.FieldProfit:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddProfit(v)
+		m.AddTimestamp(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MatchedTrade numeric field %s", name)
+	return fmt.Errorf("unknown Fill numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MatchedTradeMutation) ClearedFields() []string {
+func (m *FillMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(matchedtrade.FieldBuyClientOrderId) {
-		fields = append(fields, matchedtrade.FieldBuyClientOrderId)
-	}
-	if m.FieldCleared(matchedtrade.FieldBuyBaseAmount) {
-		fields = append(fields, matchedtrade.FieldBuyBaseAmount)
-	}
-	if m.FieldCleared(matchedtrade.FieldBuyQuoteAmount) {
-		fields = append(fields, matchedtrade.FieldBuyQuoteAmount)
-	}
-	if m.FieldCleared(matchedtrade.FieldBuyOrderTimestamp) {
-		fields = append(fields, matchedtrade.FieldBuyOrderTimestamp)
-	}
-	if m.FieldCleared(matchedtrade.FieldSellClientOrderId) {
-		fields = append(fields, matchedtrade.FieldSellClientOrderId)
-	}
-	if m.FieldCleared(matchedtrade.FieldSellBaseAmount) {
-		fields = append(fields, matchedtrade.FieldSellBaseAmount)
-	}
-	if m.FieldCleared(matchedtrade.FieldSellQuoteAmount) {
-		fields = append(fields, matchedtrade.FieldSellQuoteAmount)
-	}
-	if m.FieldCleared(matchedtrade.FieldSellOrderTimestamp) {
-		fields = append(fields, matchedtrade.FieldSellOrderTimestamp)
-	}
-	if m.FieldCleared(matchedtrade.FieldProfit) {
-		fields = append(fields, matchedtrade.FieldProfit)
+	if m.FieldCleared(fill.FieldClientOrderId) {
+		fields = append(fields, fill.FieldClientOrderId)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MatchedTradeMutation) FieldCleared(name string) bool {
+func (m *FillMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MatchedTradeMutation) ClearField(name string) error {
+func (m *FillMutation) ClearField(name string) error {
 	switch name {
-	case matchedtrade.FieldBuyClientOrderId:
-		m.ClearBuyClientOrderId()
-		return nil
-	case matchedtrade.FieldBuyBaseAmount:
-		m.ClearBuyBaseAmount()
-		return nil
-	case matchedtrade.FieldBuyQuoteAmount:
-		m.ClearBuyQuoteAmount()
-		return nil
-	case matchedtrade.FieldBuyOrderTimestamp:
-		m.ClearBuyOrderTimestamp()
-		return nil
-	case matchedtrade.FieldSellClientOrderId:
-		m.ClearSellClientOrderId()
-		return nil
-	case matchedtrade.FieldSellBaseAmount:
-		m.ClearSellBaseAmount()
-		return nil
-	case matchedtrade.FieldSellQuoteAmount:
-		m.ClearSellQuoteAmount()
-		return nil
-	case matchedtrade.FieldSellOrderTimestamp:
-		m.ClearSellOrderTimestamp()
-		return nil
-	case matchedtrade.FieldProfit:
-		m.ClearProfit()
+	case fill.FieldClientOrderId:
+		m.ClearClientOrderId()
 		return nil
 	}
-	return fmt.Errorf("unknown MatchedTrade nullable field %s", name)
+	return fmt.Errorf("unknown Fill nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MatchedTradeMutation) ResetField(name string) error {
+func (m *FillMutation) ResetField(name string) error {
 	switch name {
-	case matchedtrade.FieldCreateTime:
+	case fill.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case matchedtrade.FieldUpdateTime:
+	case fill.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case matchedtrade.FieldStrategyId:
-		m.ResetStrategyId()
+	case fill.FieldExchange:
+		m.ResetExchange()
 		return nil
-	case matchedtrade.FieldAccount:
+	case fill.FieldAccount:
 		m.ResetAccount()
 		return nil
-	case matchedtrade.FieldSymbol:
+	case fill.FieldSymbol:
 		m.ResetSymbol()
 		return nil
-	case matchedtrade.FieldBuyClientOrderId:
-		m.ResetBuyClientOrderId()
+	case fill.FieldFillId:
+		m.ResetFillId()
 		return nil
-	case matchedtrade.FieldBuyBaseAmount:
-		m.ResetBuyBaseAmount()
+	case fill.FieldOrderId:
+		m.ResetOrderId()
 		return nil
-	case matchedtrade.FieldBuyQuoteAmount:
-		m.ResetBuyQuoteAmount()
+	case fill.FieldClientOrderId:
+		m.ResetClientOrderId()
 		return nil
-	case matchedtrade.FieldBuyOrderTimestamp:
-		m.ResetBuyOrderTimestamp()
+	case fill.FieldSide:
+		m.ResetSide()
 		return nil
-	case matchedtrade.FieldSellClientOrderId:
-		m.ResetSellClientOrderId()
+	case fill.FieldPrice:
+		m.ResetPrice()
 		return nil
-	case matchedtrade.FieldSellBaseAmount:
-		m.ResetSellBaseAmount()
+	case fill.FieldBaseAmount:
+		m.ResetBaseAmount()
 		return nil
-	case matchedtrade.FieldSellQuoteAmount:
-		m.ResetSellQuoteAmount()
+	case fill.FieldQuoteAmount:
+		m.ResetQuoteAmount()
 		return nil
-	case matchedtrade.FieldSellOrderTimestamp:
-		m.ResetSellOrderTimestamp()
+	case fill.FieldFee:
+		m.ResetFee()
 		return nil
-	case matchedtrade.FieldProfit:
-		m.ResetProfit()
+	case fill.FieldFeeAsset:
+		m.ResetFeeAsset()
+		return nil
+	case fill.FieldIsMaker:
+		m.ResetIsMaker()
+		return nil
+	case fill.FieldTimestamp:
+		m.ResetTimestamp()
 		return nil
 	}
-	return fmt.Errorf("unknown MatchedTrade field %s", name)
+	return fmt.Errorf("unknown Fill field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MatchedTradeMutation) AddedEdges() []string {
+func (m *FillMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MatchedTradeMutation) AddedIDs(name string) []ent.Value {
+func (m *FillMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MatchedTradeMutation) RemovedEdges() []string {
+func (m *FillMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MatchedTradeMutation) RemovedIDs(name string) []ent.Value {
+func (m *FillMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MatchedTradeMutation) ClearedEdges() []string {
+func (m *FillMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MatchedTradeMutation) EdgeCleared(name string) bool {
+func (m *FillMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MatchedTradeMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown MatchedTrade unique edge %s", name)
+func (m *FillMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Fill unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MatchedTradeMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown MatchedTrade edge %s", name)
+func (m *FillMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Fill edge %s", name)
 }
 
-// OrderMutation represents an operation that mutates the Order nodes in the graph.
-type OrderMutation struct {
+// FundingPaymentMutation represents an operation that mutates the FundingPayment nodes in the graph.
+type FundingPaymentMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int
-	create_time       *time.Time
-	update_time       *time.Time
-	exchange          *string
-	account           *string
-	symbol            *string
-	orderId           *string
-	clientOrderId     *string
-	side              *order.Side
-	price             *decimal.Decimal
-	baseAmount        *decimal.Decimal
-	filledBaseAmount  *decimal.Decimal
-	filledQuoteAmount *decimal.Decimal
-	status            *order.Status
-	timestamp         *int64
-	addtimestamp      *int64
-	clearedFields     map[string]struct{}
-	done              bool
-	oldValue          func(context.Context) (*Order, error)
-	predicates        []predicate.Order
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	update_time   *time.Time
+	exchange      *string
+	account       *string
+	symbol        *string
+	paymentId     *string
+	strategyId    *string
+	amount        *decimal.Decimal
+	rate          *decimal.Decimal
+	positionSize  *decimal.Decimal
+	timestamp     *int64
+	addtimestamp  *int64
+	runId         *int
+	addrunId      *int
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*FundingPayment, error)
+	predicates    []predicate.FundingPayment
 }
 
-var _ ent.Mutation = (*OrderMutation)(nil)
+var _ ent.Mutation = (*FundingPaymentMutation)(nil)
 
-// orderOption allows management of the mutation configuration using functional options.
-type orderOption func(*OrderMutation)
+// fundingpaymentOption allows management of the mutation configuration using functional options.
+type fundingpaymentOption func(*FundingPaymentMutation)
 
-// newOrderMutation creates new mutation for the Order entity.
-func newOrderMutation(c config, op Op, opts ...orderOption) *OrderMutation {
-	m := &OrderMutation{
+// newFundingPaymentMutation creates new mutation for the FundingPayment entity.
+func newFundingPaymentMutation(c config, op Op, opts ...fundingpaymentOption) *FundingPaymentMutation {
+	m := &FundingPaymentMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeOrder,
+		typ:           TypeFundingPayment,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2546,20 +2172,20 @@ func newOrderMutation(c config, op Op, opts ...orderOption) *OrderMutation {
 	return m
 }
 
-// withOrderID sets the ID field of the mutation.
-func withOrderID(id int) orderOption {
-	return func(m *OrderMutation) {
+// withFundingPaymentID sets the ID field of the mutation.
+func withFundingPaymentID(id int) fundingpaymentOption {
+	return func(m *FundingPaymentMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Order
+			value *FundingPayment
 		)
-		m.oldValue = func(ctx context.Context) (*Order, error) {
+		m.oldValue = func(ctx context.Context) (*FundingPayment, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Order.Get(ctx, id)
+					value, err = m.Client().FundingPayment.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2568,10 +2194,10 @@ func withOrderID(id int) orderOption {
 	}
 }
 
-// withOrder sets the old Order of the mutation.
-func withOrder(node *Order) orderOption {
-	return func(m *OrderMutation) {
-		m.oldValue = func(context.Context) (*Order, error) {
+// withFundingPayment sets the old FundingPayment of the mutation.
+func withFundingPayment(node *FundingPayment) fundingpaymentOption {
+	return func(m *FundingPaymentMutation) {
+		m.oldValue = func(context.Context) (*FundingPayment, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2580,7 +2206,7 @@ func withOrder(node *Order) orderOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m OrderMutation) Client() *Client {
+func (m FundingPaymentMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2588,7 +2214,7 @@ func (m OrderMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m OrderMutation) Tx() (*Tx, error) {
+func (m FundingPaymentMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2599,7 +2225,7 @@ func (m OrderMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *OrderMutation) ID() (id int, exists bool) {
+func (m *FundingPaymentMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2610,7 +2236,7 @@ func (m *OrderMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *OrderMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *FundingPaymentMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2619,19 +2245,19 @@ func (m *OrderMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Order.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().FundingPayment.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *OrderMutation) SetCreateTime(t time.Time) {
+func (m *FundingPaymentMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *OrderMutation) CreateTime() (r time.Time, exists bool) {
+func (m *FundingPaymentMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -2639,10 +2265,10 @@ func (m *OrderMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the FundingPayment entity.
+// If the FundingPayment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *FundingPaymentMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -2657,17 +2283,17 @@ func (m *OrderMutation) OldCreateTime(ctx context.Context) (v time.Time, err err
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *OrderMutation) ResetCreateTime() {
+func (m *FundingPaymentMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *OrderMutation) SetUpdateTime(t time.Time) {
+func (m *FundingPaymentMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *OrderMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *FundingPaymentMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -2675,10 +2301,10 @@ func (m *OrderMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the FundingPayment entity.
+// If the FundingPayment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *FundingPaymentMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -2693,17 +2319,17 @@ func (m *OrderMutation) OldUpdateTime(ctx context.Context) (v time.Time, err err
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *OrderMutation) ResetUpdateTime() {
+func (m *FundingPaymentMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
 // SetExchange sets the "exchange" field.
-func (m *OrderMutation) SetExchange(s string) {
+func (m *FundingPaymentMutation) SetExchange(s string) {
 	m.exchange = &s
 }
 
 // Exchange returns the value of the "exchange" field in the mutation.
-func (m *OrderMutation) Exchange() (r string, exists bool) {
+func (m *FundingPaymentMutation) Exchange() (r string, exists bool) {
 	v := m.exchange
 	if v == nil {
 		return
@@ -2711,10 +2337,10 @@ func (m *OrderMutation) Exchange() (r string, exists bool) {
 	return *v, true
 }
 
-// OldExchange returns the old "exchange" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// OldExchange returns the old "exchange" field's value of the FundingPayment entity.
+// If the FundingPayment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldExchange(ctx context.Context) (v string, err error) {
+func (m *FundingPaymentMutation) OldExchange(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldExchange is only allowed on UpdateOne operations")
 	}
@@ -2729,17 +2355,17 @@ func (m *OrderMutation) OldExchange(ctx context.Context) (v string, err error) {
 }
 
 // ResetExchange resets all changes to the "exchange" field.
-func (m *OrderMutation) ResetExchange() {
+func (m *FundingPaymentMutation) ResetExchange() {
 	m.exchange = nil
 }
 
 // SetAccount sets the "account" field.
-func (m *OrderMutation) SetAccount(s string) {
+func (m *FundingPaymentMutation) SetAccount(s string) {
 	m.account = &s
 }
 
 // Account returns the value of the "account" field in the mutation.
-func (m *OrderMutation) Account() (r string, exists bool) {
+func (m *FundingPaymentMutation) Account() (r string, exists bool) {
 	v := m.account
 	if v == nil {
 		return
@@ -2747,10 +2373,10 @@ func (m *OrderMutation) Account() (r string, exists bool) {
 	return *v, true
 }
 
-// OldAccount returns the old "account" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// OldAccount returns the old "account" field's value of the FundingPayment entity.
+// If the FundingPayment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldAccount(ctx context.Context) (v string, err error) {
+func (m *FundingPaymentMutation) OldAccount(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
 	}
@@ -2765,17 +2391,17 @@ func (m *OrderMutation) OldAccount(ctx context.Context) (v string, err error) {
 }
 
 // ResetAccount resets all changes to the "account" field.
-func (m *OrderMutation) ResetAccount() {
+func (m *FundingPaymentMutation) ResetAccount() {
 	m.account = nil
 }
 
 // SetSymbol sets the "symbol" field.
-func (m *OrderMutation) SetSymbol(s string) {
+func (m *FundingPaymentMutation) SetSymbol(s string) {
 	m.symbol = &s
 }
 
 // Symbol returns the value of the "symbol" field in the mutation.
-func (m *OrderMutation) Symbol() (r string, exists bool) {
+func (m *FundingPaymentMutation) Symbol() (r string, exists bool) {
 	v := m.symbol
 	if v == nil {
 		return
@@ -2783,10 +2409,10 @@ func (m *OrderMutation) Symbol() (r string, exists bool) {
 	return *v, true
 }
 
-// OldSymbol returns the old "symbol" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// OldSymbol returns the old "symbol" field's value of the FundingPayment entity.
+// If the FundingPayment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldSymbol(ctx context.Context) (v string, err error) {
+func (m *FundingPaymentMutation) OldSymbol(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
 	}
@@ -2801,363 +2427,338 @@ func (m *OrderMutation) OldSymbol(ctx context.Context) (v string, err error) {
 }
 
 // ResetSymbol resets all changes to the "symbol" field.
-func (m *OrderMutation) ResetSymbol() {
+func (m *FundingPaymentMutation) ResetSymbol() {
 	m.symbol = nil
 }
 
-// SetOrderId sets the "orderId" field.
-func (m *OrderMutation) SetOrderId(s string) {
-	m.orderId = &s
+// SetPaymentId sets the "paymentId" field.
+func (m *FundingPaymentMutation) SetPaymentId(s string) {
+	m.paymentId = &s
 }
 
-// OrderId returns the value of the "orderId" field in the mutation.
-func (m *OrderMutation) OrderId() (r string, exists bool) {
-	v := m.orderId
+// PaymentId returns the value of the "paymentId" field in the mutation.
+func (m *FundingPaymentMutation) PaymentId() (r string, exists bool) {
+	v := m.paymentId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOrderId returns the old "orderId" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// OldPaymentId returns the old "paymentId" field's value of the FundingPayment entity.
+// If the FundingPayment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldOrderId(ctx context.Context) (v string, err error) {
+func (m *FundingPaymentMutation) OldPaymentId(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOrderId is only allowed on UpdateOne operations")
+		return v, errors.New("OldPaymentId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOrderId requires an ID field in the mutation")
+		return v, errors.New("OldPaymentId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOrderId: %w", err)
+		return v, fmt.Errorf("querying old value for OldPaymentId: %w", err)
 	}
-	return oldValue.OrderId, nil
+	return oldValue.PaymentId, nil
 }
 
-// ResetOrderId resets all changes to the "orderId" field.
-func (m *OrderMutation) ResetOrderId() {
-	m.orderId = nil
+// ResetPaymentId resets all changes to the "paymentId" field.
+func (m *FundingPaymentMutation) ResetPaymentId() {
+	m.paymentId = nil
 }
 
-// SetClientOrderId sets the "clientOrderId" field.
-func (m *OrderMutation) SetClientOrderId(s string) {
-	m.clientOrderId = &s
+// SetStrategyId sets the "strategyId" field.
+func (m *FundingPaymentMutation) SetStrategyId(s string) {
+	m.strategyId = &s
 }
 
-// ClientOrderId returns the value of the "clientOrderId" field in the mutation.
-func (m *OrderMutation) ClientOrderId() (r string, exists bool) {
-	v := m.clientOrderId
+// StrategyId returns the value of the "strategyId" field in the mutation.
+func (m *FundingPaymentMutation) StrategyId() (r string, exists bool) {
+	v := m.strategyId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClientOrderId returns the old "clientOrderId" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// OldStrategyId returns the old "strategyId" field's value of the FundingPayment entity.
+// If the FundingPayment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldClientOrderId(ctx context.Context) (v string, err error) {
+func (m *FundingPaymentMutation) OldStrategyId(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientOrderId is only allowed on UpdateOne operations")
+		return v, errors.New("OldStrategyId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientOrderId requires an ID field in the mutation")
+		return v, errors.New("OldStrategyId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientOrderId: %w", err)
+		return v, fmt.Errorf("querying old value for OldStrategyId: %w", err)
 	}
-	return oldValue.ClientOrderId, nil
-}
-
-// ResetClientOrderId resets all changes to the "clientOrderId" field.
-func (m *OrderMutation) ResetClientOrderId() {
-	m.clientOrderId = nil
-}
-
-// SetSide sets the "side" field.
-func (m *OrderMutation) SetSide(o order.Side) {
-	m.side = &o
+	return oldValue.StrategyId, nil
 }
 
-// Side returns the value of the "side" field in the mutation.
-func (m *OrderMutation) Side() (r order.Side, exists bool) {
-	v := m.side
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearStrategyId clears the value of the "strategyId" field.
+func (m *FundingPaymentMutation) ClearStrategyId() {
+	m.strategyId = nil
+	m.clearedFields[fundingpayment.FieldStrategyId] = struct{}{}
 }
 
-// OldSide returns the old "side" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldSide(ctx context.Context) (v order.Side, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSide is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSide requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSide: %w", err)
-	}
-	return oldValue.Side, nil
+// StrategyIdCleared returns if the "strategyId" field was cleared in this mutation.
+func (m *FundingPaymentMutation) StrategyIdCleared() bool {
+	_, ok := m.clearedFields[fundingpayment.FieldStrategyId]
+	return ok
 }
 
-// ResetSide resets all changes to the "side" field.
-func (m *OrderMutation) ResetSide() {
-	m.side = nil
+// ResetStrategyId resets all changes to the "strategyId" field.
+func (m *FundingPaymentMutation) ResetStrategyId() {
+	m.strategyId = nil
+	delete(m.clearedFields, fundingpayment.FieldStrategyId)
 }
 
-// SetPrice sets the "price" field.
-func (m *OrderMutation) SetPrice(d decimal.Decimal) {
-	m.price = &d
+// SetAmount sets the "amount" field.
+func (m *FundingPaymentMutation) SetAmount(d decimal.Decimal) {
+	m.amount = &d
 }
 
-// Price returns the value of the "price" field in the mutation.
-func (m *OrderMutation) Price() (r decimal.Decimal, exists bool) {
-	v := m.price
+// Amount returns the value of the "amount" field in the mutation.
+func (m *FundingPaymentMutation) Amount() (r decimal.Decimal, exists bool) {
+	v := m.amount
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPrice returns the old "price" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// OldAmount returns the old "amount" field's value of the FundingPayment entity.
+// If the FundingPayment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldPrice(ctx context.Context) (v decimal.Decimal, err error) {
+func (m *FundingPaymentMutation) OldAmount(ctx context.Context) (v decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrice requires an ID field in the mutation")
+		return v, errors.New("OldAmount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
 	}
-	return oldValue.Price, nil
+	return oldValue.Amount, nil
 }
 
-// ResetPrice resets all changes to the "price" field.
-func (m *OrderMutation) ResetPrice() {
-	m.price = nil
+// ResetAmount resets all changes to the "amount" field.
+func (m *FundingPaymentMutation) ResetAmount() {
+	m.amount = nil
 }
 
-// SetBaseAmount sets the "baseAmount" field.
-func (m *OrderMutation) SetBaseAmount(d decimal.Decimal) {
-	m.baseAmount = &d
+// SetRate sets the "rate" field.
+func (m *FundingPaymentMutation) SetRate(d decimal.Decimal) {
+	m.rate = &d
 }
 
-// BaseAmount returns the value of the "baseAmount" field in the mutation.
-func (m *OrderMutation) BaseAmount() (r decimal.Decimal, exists bool) {
-	v := m.baseAmount
+// Rate returns the value of the "rate" field in the mutation.
+func (m *FundingPaymentMutation) Rate() (r decimal.Decimal, exists bool) {
+	v := m.rate
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBaseAmount returns the old "baseAmount" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// OldRate returns the old "rate" field's value of the FundingPayment entity.
+// If the FundingPayment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldBaseAmount(ctx context.Context) (v decimal.Decimal, err error) {
+func (m *FundingPaymentMutation) OldRate(ctx context.Context) (v decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBaseAmount is only allowed on UpdateOne operations")
+		return v, errors.New("OldRate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBaseAmount requires an ID field in the mutation")
+		return v, errors.New("OldRate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBaseAmount: %w", err)
+		return v, fmt.Errorf("querying old value for OldRate: %w", err)
 	}
-	return oldValue.BaseAmount, nil
+	return oldValue.Rate, nil
 }
 
-// ResetBaseAmount resets all changes to the "baseAmount" field.
-func (m *OrderMutation) ResetBaseAmount() {
-	m.baseAmount = nil
+// ResetRate resets all changes to the "rate" field.
+func (m *FundingPaymentMutation) ResetRate() {
+	m.rate = nil
 }
 
-// SetFilledBaseAmount sets the "filledBaseAmount" field.
-func (m *OrderMutation) SetFilledBaseAmount(d decimal.Decimal) {
-	m.filledBaseAmount = &d
+// SetPositionSize sets the "positionSize" field.
+func (m *FundingPaymentMutation) SetPositionSize(d decimal.Decimal) {
+	m.positionSize = &d
 }
 
-// FilledBaseAmount returns the value of the "filledBaseAmount" field in the mutation.
-func (m *OrderMutation) FilledBaseAmount() (r decimal.Decimal, exists bool) {
-	v := m.filledBaseAmount
+// PositionSize returns the value of the "positionSize" field in the mutation.
+func (m *FundingPaymentMutation) PositionSize() (r decimal.Decimal, exists bool) {
+	v := m.positionSize
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFilledBaseAmount returns the old "filledBaseAmount" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// OldPositionSize returns the old "positionSize" field's value of the FundingPayment entity.
+// If the FundingPayment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldFilledBaseAmount(ctx context.Context) (v decimal.Decimal, err error) {
+func (m *FundingPaymentMutation) OldPositionSize(ctx context.Context) (v decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFilledBaseAmount is only allowed on UpdateOne operations")
+		return v, errors.New("OldPositionSize is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFilledBaseAmount requires an ID field in the mutation")
+		return v, errors.New("OldPositionSize requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFilledBaseAmount: %w", err)
+		return v, fmt.Errorf("querying old value for OldPositionSize: %w", err)
 	}
-	return oldValue.FilledBaseAmount, nil
+	return oldValue.PositionSize, nil
 }
 
-// ResetFilledBaseAmount resets all changes to the "filledBaseAmount" field.
-func (m *OrderMutation) ResetFilledBaseAmount() {
-	m.filledBaseAmount = nil
+// ResetPositionSize resets all changes to the "positionSize" field.
+func (m *FundingPaymentMutation) ResetPositionSize() {
+	m.positionSize = nil
 }
 
-// SetFilledQuoteAmount sets the "filledQuoteAmount" field.
-func (m *OrderMutation) SetFilledQuoteAmount(d decimal.Decimal) {
-	m.filledQuoteAmount = &d
+// SetTimestamp sets the "timestamp" field.
+func (m *FundingPaymentMutation) SetTimestamp(i int64) {
+	m.timestamp = &i
+	m.addtimestamp = nil
 }
 
-// FilledQuoteAmount returns the value of the "filledQuoteAmount" field in the mutation.
-func (m *OrderMutation) FilledQuoteAmount() (r decimal.Decimal, exists bool) {
-	v := m.filledQuoteAmount
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *FundingPaymentMutation) Timestamp() (r int64, exists bool) {
+	v := m.timestamp
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFilledQuoteAmount returns the old "filledQuoteAmount" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// OldTimestamp returns the old "timestamp" field's value of the FundingPayment entity.
+// If the FundingPayment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldFilledQuoteAmount(ctx context.Context) (v decimal.Decimal, err error) {
+func (m *FundingPaymentMutation) OldTimestamp(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFilledQuoteAmount is only allowed on UpdateOne operations")
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFilledQuoteAmount requires an ID field in the mutation")
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFilledQuoteAmount: %w", err)
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
 	}
-	return oldValue.FilledQuoteAmount, nil
-}
-
-// ResetFilledQuoteAmount resets all changes to the "filledQuoteAmount" field.
-func (m *OrderMutation) ResetFilledQuoteAmount() {
-	m.filledQuoteAmount = nil
+	return oldValue.Timestamp, nil
 }
 
-// SetStatus sets the "status" field.
-func (m *OrderMutation) SetStatus(o order.Status) {
-	m.status = &o
+// AddTimestamp adds i to the "timestamp" field.
+func (m *FundingPaymentMutation) AddTimestamp(i int64) {
+	if m.addtimestamp != nil {
+		*m.addtimestamp += i
+	} else {
+		m.addtimestamp = &i
+	}
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *OrderMutation) Status() (r order.Status, exists bool) {
-	v := m.status
+// AddedTimestamp returns the value that was added to the "timestamp" field in this mutation.
+func (m *FundingPaymentMutation) AddedTimestamp() (r int64, exists bool) {
+	v := m.addtimestamp
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldStatus(ctx context.Context) (v order.Status, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
-	}
-	return oldValue.Status, nil
-}
-
-// ResetStatus resets all changes to the "status" field.
-func (m *OrderMutation) ResetStatus() {
-	m.status = nil
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *FundingPaymentMutation) ResetTimestamp() {
+	m.timestamp = nil
+	m.addtimestamp = nil
 }
 
-// SetTimestamp sets the "timestamp" field.
-func (m *OrderMutation) SetTimestamp(i int64) {
-	m.timestamp = &i
-	m.addtimestamp = nil
+// SetRunId sets the "runId" field.
+func (m *FundingPaymentMutation) SetRunId(i int) {
+	m.runId = &i
+	m.addrunId = nil
 }
 
-// Timestamp returns the value of the "timestamp" field in the mutation.
-func (m *OrderMutation) Timestamp() (r int64, exists bool) {
-	v := m.timestamp
+// RunId returns the value of the "runId" field in the mutation.
+func (m *FundingPaymentMutation) RunId() (r int, exists bool) {
+	v := m.runId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTimestamp returns the old "timestamp" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// OldRunId returns the old "runId" field's value of the FundingPayment entity.
+// If the FundingPayment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldTimestamp(ctx context.Context) (v int64, err error) {
+func (m *FundingPaymentMutation) OldRunId(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+		return v, errors.New("OldRunId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+		return v, errors.New("OldRunId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+		return v, fmt.Errorf("querying old value for OldRunId: %w", err)
 	}
-	return oldValue.Timestamp, nil
+	return oldValue.RunId, nil
 }
 
-// AddTimestamp adds i to the "timestamp" field.
-func (m *OrderMutation) AddTimestamp(i int64) {
-	if m.addtimestamp != nil {
-		*m.addtimestamp += i
+// AddRunId adds i to the "runId" field.
+func (m *FundingPaymentMutation) AddRunId(i int) {
+	if m.addrunId != nil {
+		*m.addrunId += i
 	} else {
-		m.addtimestamp = &i
+		m.addrunId = &i
 	}
 }
 
-// AddedTimestamp returns the value that was added to the "timestamp" field in this mutation.
-func (m *OrderMutation) AddedTimestamp() (r int64, exists bool) {
-	v := m.addtimestamp
+// AddedRunId returns the value that was added to the "runId" field in this mutation.
+func (m *FundingPaymentMutation) AddedRunId() (r int, exists bool) {
+	v := m.addrunId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTimestamp resets all changes to the "timestamp" field.
-func (m *OrderMutation) ResetTimestamp() {
-	m.timestamp = nil
-	m.addtimestamp = nil
+// ClearRunId clears the value of the "runId" field.
+func (m *FundingPaymentMutation) ClearRunId() {
+	m.runId = nil
+	m.addrunId = nil
+	m.clearedFields[fundingpayment.FieldRunId] = struct{}{}
 }
 
-// Where appends a list predicates to the OrderMutation builder.
-func (m *OrderMutation) Where(ps ...predicate.Order) {
+// RunIdCleared returns if the "runId" field was cleared in this mutation.
+func (m *FundingPaymentMutation) RunIdCleared() bool {
+	_, ok := m.clearedFields[fundingpayment.FieldRunId]
+	return ok
+}
+
+// ResetRunId resets all changes to the "runId" field.
+func (m *FundingPaymentMutation) ResetRunId() {
+	m.runId = nil
+	m.addrunId = nil
+	delete(m.clearedFields, fundingpayment.FieldRunId)
+}
+
+// Where appends a list predicates to the FundingPaymentMutation builder.
+func (m *FundingPaymentMutation) Where(ps ...predicate.FundingPayment) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the OrderMutation builder. Using this method,
+// WhereP appends storage-level predicates to the FundingPaymentMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *OrderMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Order, len(ps))
+func (m *FundingPaymentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FundingPayment, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3165,66 +2766,60 @@ func (m *OrderMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *OrderMutation) Op() Op {
+func (m *FundingPaymentMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *OrderMutation) SetOp(op Op) {
+func (m *FundingPaymentMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Order).
-func (m *OrderMutation) Type() string {
+// Type returns the node type of this mutation (FundingPayment).
+func (m *FundingPaymentMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *OrderMutation) Fields() []string {
-	fields := make([]string, 0, 14)
+func (m *FundingPaymentMutation) Fields() []string {
+	fields := make([]string, 0, 12)
 	if m.create_time != nil {
-		fields = append(fields, order.FieldCreateTime)
+		fields = append(fields, fundingpayment.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, order.FieldUpdateTime)
+		fields = append(fields, fundingpayment.FieldUpdateTime)
 	}
 	if m.exchange != nil {
-		fields = append(fields, order.FieldExchange)
+		fields = append(fields, fundingpayment.FieldExchange)
 	}
 	if m.account != nil {
-		fields = append(fields, order.FieldAccount)
+		fields = append(fields, fundingpayment.FieldAccount)
 	}
 	if m.symbol != nil {
-		fields = append(fields, order.FieldSymbol)
-	}
-	if m.orderId != nil {
-		fields = append(fields, order.FieldOrderId)
-	}
-	if m.clientOrderId != nil {
-		fields = append(fields, order.FieldClientOrderId)
+		fields = append(fields, fundingpayment.FieldSymbol)
 	}
-	if m.side != nil {
-		fields = append(fields, order.FieldSide)
-	}
-	if m.price != nil {
-		fields = append(fields, order.FieldPrice)
+	if m.paymentId != nil {
+		fields = append(fields, fundingpayment.FieldPaymentId)
 	}
-	if m.baseAmount != nil {
-		fields = append(fields, order.FieldBaseAmount)
+	if m.strategyId != nil {
+		fields = append(fields, fundingpayment.FieldStrategyId)
 	}
-	if m.filledBaseAmount != nil {
-		fields = append(fields, order.FieldFilledBaseAmount)
+	if m.amount != nil {
+		fields = append(fields, fundingpayment.FieldAmount)
 	}
-	if m.filledQuoteAmount != nil {
-		fields = append(fields, order.FieldFilledQuoteAmount)
+	if m.rate != nil {
+		fields = append(fields, fundingpayment.FieldRate)
 	}
-	if m.status != nil {
-		fields = append(fields, order.FieldStatus)
+	if m.positionSize != nil {
+		fields = append(fields, fundingpayment.FieldPositionSize)
 	}
 	if m.timestamp != nil {
-		fields = append(fields, order.FieldTimestamp)
+		fields = append(fields, fundingpayment.FieldTimestamp)
+	}
+	if m.runId != nil {
+		fields = append(fields, fundingpayment.FieldRunId)
 	}
 	return fields
 }
@@ -3232,36 +2827,32 @@ func (m *OrderMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *OrderMutation) Field(name string) (ent.Value, bool) {
+func (m *FundingPaymentMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case order.FieldCreateTime:
+	case fundingpayment.FieldCreateTime:
 		return m.CreateTime()
-	case order.FieldUpdateTime:
+	case fundingpayment.FieldUpdateTime:
 		return m.UpdateTime()
-	case order.FieldExchange:
+	case fundingpayment.FieldExchange:
 		return m.Exchange()
-	case order.FieldAccount:
+	case fundingpayment.FieldAccount:
 		return m.Account()
-	case order.FieldSymbol:
+	case fundingpayment.FieldSymbol:
 		return m.Symbol()
-	case order.FieldOrderId:
-		return m.OrderId()
-	case order.FieldClientOrderId:
-		return m.ClientOrderId()
-	case order.FieldSide:
-		return m.Side()
-	case order.FieldPrice:
-		return m.Price()
-	case order.FieldBaseAmount:
-		return m.BaseAmount()
-	case order.FieldFilledBaseAmount:
-		return m.FilledBaseAmount()
-	case order.FieldFilledQuoteAmount:
-		return m.FilledQuoteAmount()
-	case order.FieldStatus:
-		return m.Status()
-	case order.FieldTimestamp:
+	case fundingpayment.FieldPaymentId:
+		return m.PaymentId()
+	case fundingpayment.FieldStrategyId:
+		return m.StrategyId()
+	case fundingpayment.FieldAmount:
+		return m.Amount()
+	case fundingpayment.FieldRate:
+		return m.Rate()
+	case fundingpayment.FieldPositionSize:
+		return m.PositionSize()
+	case fundingpayment.FieldTimestamp:
 		return m.Timestamp()
+	case fundingpayment.FieldRunId:
+		return m.RunId()
 	}
 	return nil, false
 }
@@ -3269,153 +2860,138 @@ func (m *OrderMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *OrderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *FundingPaymentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case order.FieldCreateTime:
+	case fundingpayment.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case order.FieldUpdateTime:
+	case fundingpayment.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case order.FieldExchange:
+	case fundingpayment.FieldExchange:
 		return m.OldExchange(ctx)
-	case order.FieldAccount:
+	case fundingpayment.FieldAccount:
 		return m.OldAccount(ctx)
-	case order.FieldSymbol:
+	case fundingpayment.FieldSymbol:
 		return m.OldSymbol(ctx)
-	case order.FieldOrderId:
-		return m.OldOrderId(ctx)
-	case order.FieldClientOrderId:
-		return m.OldClientOrderId(ctx)
-	case order.FieldSide:
-		return m.OldSide(ctx)
-	case order.FieldPrice:
-		return m.OldPrice(ctx)
-	case order.FieldBaseAmount:
-		return m.OldBaseAmount(ctx)
-	case order.FieldFilledBaseAmount:
-		return m.OldFilledBaseAmount(ctx)
-	case order.FieldFilledQuoteAmount:
-		return m.OldFilledQuoteAmount(ctx)
-	case order.FieldStatus:
-		return m.OldStatus(ctx)
-	case order.FieldTimestamp:
+	case fundingpayment.FieldPaymentId:
+		return m.OldPaymentId(ctx)
+	case fundingpayment.FieldStrategyId:
+		return m.OldStrategyId(ctx)
+	case fundingpayment.FieldAmount:
+		return m.OldAmount(ctx)
+	case fundingpayment.FieldRate:
+		return m.OldRate(ctx)
+	case fundingpayment.FieldPositionSize:
+		return m.OldPositionSize(ctx)
+	case fundingpayment.FieldTimestamp:
 		return m.OldTimestamp(ctx)
+	case fundingpayment.FieldRunId:
+		return m.OldRunId(ctx)
 	}
-	return nil, fmt.Errorf("unknown Order field %s", name)
+	return nil, fmt.Errorf("unknown FundingPayment field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OrderMutation) SetField(name string, value ent.Value) error {
+func (m *FundingPaymentMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case order.FieldCreateTime:
+	case fundingpayment.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case order.FieldUpdateTime:
+	case fundingpayment.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case order.FieldExchange:
+	case fundingpayment.FieldExchange:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetExchange(v)
 		return nil
-	case order.FieldAccount:
+	case fundingpayment.FieldAccount:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetAccount(v)
 		return nil
-	case order.FieldSymbol:
+	case fundingpayment.FieldSymbol:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetSymbol(v)
 		return nil
-	case order.FieldOrderId:
+	case fundingpayment.FieldPaymentId:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOrderId(v)
+		m.SetPaymentId(v)
 		return nil
-	case order.FieldClientOrderId:
+	case fundingpayment.FieldStrategyId:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientOrderId(v)
-		return nil
-	case order.FieldSide:
-		v, ok := value.(order.Side)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSide(v)
-		return nil
-	case order.FieldPrice:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPrice(v)
+		m.SetStrategyId(v)
 		return nil
-	case order.FieldBaseAmount:
+	case fundingpayment.FieldAmount:
 		v, ok := value.(decimal.Decimal)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBaseAmount(v)
+		m.SetAmount(v)
 		return nil
-	case order.FieldFilledBaseAmount:
+	case fundingpayment.FieldRate:
 		v, ok := value.(decimal.Decimal)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFilledBaseAmount(v)
+		m.SetRate(v)
 		return nil
-	case order.FieldFilledQuoteAmount:
+	case fundingpayment.FieldPositionSize:
 		v, ok := value.(decimal.Decimal)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFilledQuoteAmount(v)
+		m.SetPositionSize(v)
 		return nil
-	case order.FieldStatus:
-		v, ok := value.(order.Status)
+	case fundingpayment.FieldTimestamp:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetTimestamp(v)
 		return nil
-	case order.FieldTimestamp:
-		v, ok := value.(int64)
+	case fundingpayment.FieldRunId:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTimestamp(v)
+		m.SetRunId(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Order field %s", name)
+	return fmt.Errorf("unknown FundingPayment field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *OrderMutation) AddedFields() []string {
+func (m *FundingPaymentMutation) AddedFields() []string {
 	var fields []string
 	if m.addtimestamp != nil {
-		fields = append(fields, order.FieldTimestamp)
+		fields = append(fields, fundingpayment.FieldTimestamp)
+	}
+	if m.addrunId != nil {
+		fields = append(fields, fundingpayment.FieldRunId)
 	}
 	return fields
 }
@@ -3423,10 +2999,12 @@ func (m *OrderMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *OrderMutation) AddedField(name string) (ent.Value, bool) {
+func (m *FundingPaymentMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case order.FieldTimestamp:
+	case fundingpayment.FieldTimestamp:
 		return m.AddedTimestamp()
+	case fundingpayment.FieldRunId:
+		return m.AddedRunId()
 	}
 	return nil, false
 }
@@ -3434,192 +3012,193 @@ func (m *OrderMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OrderMutation) AddField(name string, value ent.Value) error {
+func (m *FundingPaymentMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case order.FieldTimestamp:
+	case fundingpayment.FieldTimestamp:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddTimestamp(v)
 		return nil
+	case fundingpayment.FieldRunId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRunId(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Order numeric field %s", name)
+	return fmt.Errorf("unknown FundingPayment numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *OrderMutation) ClearedFields() []string {
-	return nil
+func (m *FundingPaymentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(fundingpayment.FieldStrategyId) {
+		fields = append(fields, fundingpayment.FieldStrategyId)
+	}
+	if m.FieldCleared(fundingpayment.FieldRunId) {
+		fields = append(fields, fundingpayment.FieldRunId)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *OrderMutation) FieldCleared(name string) bool {
+func (m *FundingPaymentMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *OrderMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Order nullable field %s", name)
+func (m *FundingPaymentMutation) ClearField(name string) error {
+	switch name {
+	case fundingpayment.FieldStrategyId:
+		m.ClearStrategyId()
+		return nil
+	case fundingpayment.FieldRunId:
+		m.ClearRunId()
+		return nil
+	}
+	return fmt.Errorf("unknown FundingPayment nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *OrderMutation) ResetField(name string) error {
+func (m *FundingPaymentMutation) ResetField(name string) error {
 	switch name {
-	case order.FieldCreateTime:
+	case fundingpayment.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case order.FieldUpdateTime:
+	case fundingpayment.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case order.FieldExchange:
+	case fundingpayment.FieldExchange:
 		m.ResetExchange()
 		return nil
-	case order.FieldAccount:
+	case fundingpayment.FieldAccount:
 		m.ResetAccount()
 		return nil
-	case order.FieldSymbol:
+	case fundingpayment.FieldSymbol:
 		m.ResetSymbol()
 		return nil
-	case order.FieldOrderId:
-		m.ResetOrderId()
-		return nil
-	case order.FieldClientOrderId:
-		m.ResetClientOrderId()
-		return nil
-	case order.FieldSide:
-		m.ResetSide()
-		return nil
-	case order.FieldPrice:
-		m.ResetPrice()
+	case fundingpayment.FieldPaymentId:
+		m.ResetPaymentId()
 		return nil
-	case order.FieldBaseAmount:
-		m.ResetBaseAmount()
+	case fundingpayment.FieldStrategyId:
+		m.ResetStrategyId()
 		return nil
-	case order.FieldFilledBaseAmount:
-		m.ResetFilledBaseAmount()
+	case fundingpayment.FieldAmount:
+		m.ResetAmount()
 		return nil
-	case order.FieldFilledQuoteAmount:
-		m.ResetFilledQuoteAmount()
+	case fundingpayment.FieldRate:
+		m.ResetRate()
 		return nil
-	case order.FieldStatus:
-		m.ResetStatus()
+	case fundingpayment.FieldPositionSize:
+		m.ResetPositionSize()
 		return nil
-	case order.FieldTimestamp:
+	case fundingpayment.FieldTimestamp:
 		m.ResetTimestamp()
 		return nil
+	case fundingpayment.FieldRunId:
+		m.ResetRunId()
+		return nil
 	}
-	return fmt.Errorf("unknown Order field %s", name)
+	return fmt.Errorf("unknown FundingPayment field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *OrderMutation) AddedEdges() []string {
+func (m *FundingPaymentMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *OrderMutation) AddedIDs(name string) []ent.Value {
+func (m *FundingPaymentMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *OrderMutation) RemovedEdges() []string {
+func (m *FundingPaymentMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *OrderMutation) RemovedIDs(name string) []ent.Value {
+func (m *FundingPaymentMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *OrderMutation) ClearedEdges() []string {
+func (m *FundingPaymentMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *OrderMutation) EdgeCleared(name string) bool {
+func (m *FundingPaymentMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *OrderMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Order unique edge %s", name)
+func (m *FundingPaymentMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown FundingPayment unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *OrderMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Order edge %s", name)
+func (m *FundingPaymentMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown FundingPayment edge %s", name)
 }
 
-// StrategyMutation represents an operation that mutates the Strategy nodes in the graph.
-type StrategyMutation struct {
+// GridMutation represents an operation that mutates the Grid nodes in the graph.
+type GridMutation struct {
 	config
-	op                            Op
-	typ                           string
-	id                            *int
-	create_time                   *time.Time
-	update_time                   *time.Time
-	guid                          *string
-	owner                         *int64
-	addowner                      *int64
-	exchange                      *string
-	symbol                        *string
-	account                       *string
-	mode                          *strategy.Mode
-	marginMode                    *strategy.MarginMode
-	quantityMode                  *strategy.QuantityMode
-	priceUpper                    *decimal.Decimal
-	priceLower                    *decimal.Decimal
-	gridNum                       *int
-	addgridNum                    *int
-	leverage                      *int
-	addleverage                   *int
-	initialOrderSize              *decimal.Decimal
-	slippageBps                   *int
-	addslippageBps                *int
-	entryPrice                    *decimal.Decimal
-	triggerStopLossPrice          *decimal.Decimal
-	triggerTakeProfitPrice        *decimal.Decimal
-	enablePushNotification        *bool
-	enablePushMatchedNotification *bool
-	lastLowerThresholdAlertTime   *time.Time
-	lastUpperThresholdAlertTime   *time.Time
-	status                        *strategy.Status
-	exchangeApiKey                *string
-	exchangeSecretKey             *string
-	exchangePassphrase            *string
-	exchangeTestnet               *bool
-	startTime                     *time.Time
-	clearedFields                 map[string]struct{}
-	done                          bool
-	oldValue                      func(context.Context) (*Strategy, error)
-	predicates                    []predicate.Strategy
+	op                     Op
+	typ                    string
+	id                     *int
+	create_time            *time.Time
+	update_time            *time.Time
+	strategyId             *string
+	exchange               *string
+	symbol                 *string
+	account                *string
+	level                  *int
+	addlevel               *int
+	price                  *decimal.Decimal
+	quantity               *decimal.Decimal
+	buyClientOrderId       *string
+	buyClientOrderTime     *int64
+	addbuyClientOrderTime  *int64
+	sellClientOrderId      *string
+	sellClientOrderTime    *int64
+	addsellClientOrderTime *int64
+	runId                  *int
+	addrunId               *int
+	clearedFields          map[string]struct{}
+	done                   bool
+	oldValue               func(context.Context) (*Grid, error)
+	predicates             []predicate.Grid
 }
 
-var _ ent.Mutation = (*StrategyMutation)(nil)
+var _ ent.Mutation = (*GridMutation)(nil)
 
-// strategyOption allows management of the mutation configuration using functional options.
-type strategyOption func(*StrategyMutation)
+// gridOption allows management of the mutation configuration using functional options.
+type gridOption func(*GridMutation)
 
-// newStrategyMutation creates new mutation for the Strategy entity.
-func newStrategyMutation(c config, op Op, opts ...strategyOption) *StrategyMutation {
-	m := &StrategyMutation{
+// newGridMutation creates new mutation for the Grid entity.
+func newGridMutation(c config, op Op, opts ...gridOption) *GridMutation {
+	m := &GridMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeStrategy,
+		typ:           TypeGrid,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3628,20 +3207,20 @@ func newStrategyMutation(c config, op Op, opts ...strategyOption) *StrategyMutat
 	return m
 }
 
-// withStrategyID sets the ID field of the mutation.
-func withStrategyID(id int) strategyOption {
-	return func(m *StrategyMutation) {
+// withGridID sets the ID field of the mutation.
+func withGridID(id int) gridOption {
+	return func(m *GridMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Strategy
+			value *Grid
 		)
-		m.oldValue = func(ctx context.Context) (*Strategy, error) {
+		m.oldValue = func(ctx context.Context) (*Grid, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Strategy.Get(ctx, id)
+					value, err = m.Client().Grid.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3650,10 +3229,10 @@ func withStrategyID(id int) strategyOption {
 	}
 }
 
-// withStrategy sets the old Strategy of the mutation.
-func withStrategy(node *Strategy) strategyOption {
-	return func(m *StrategyMutation) {
-		m.oldValue = func(context.Context) (*Strategy, error) {
+// withGrid sets the old Grid of the mutation.
+func withGrid(node *Grid) gridOption {
+	return func(m *GridMutation) {
+		m.oldValue = func(context.Context) (*Grid, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3662,7 +3241,7 @@ func withStrategy(node *Strategy) strategyOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m StrategyMutation) Client() *Client {
+func (m GridMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3670,7 +3249,7 @@ func (m StrategyMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m StrategyMutation) Tx() (*Tx, error) {
+func (m GridMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3681,7 +3260,7 @@ func (m StrategyMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *StrategyMutation) ID() (id int, exists bool) {
+func (m *GridMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3692,7 +3271,7 @@ func (m *StrategyMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *StrategyMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *GridMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3701,19 +3280,19 @@ func (m *StrategyMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Strategy.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Grid.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *StrategyMutation) SetCreateTime(t time.Time) {
+func (m *GridMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *StrategyMutation) CreateTime() (r time.Time, exists bool) {
+func (m *GridMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -3721,10 +3300,10 @@ func (m *StrategyMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the Grid entity.
+// If the Grid object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *GridMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -3739,17 +3318,17 @@ func (m *StrategyMutation) OldCreateTime(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *StrategyMutation) ResetCreateTime() {
+func (m *GridMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *StrategyMutation) SetUpdateTime(t time.Time) {
+func (m *GridMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *StrategyMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *GridMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -3757,10 +3336,10 @@ func (m *StrategyMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the Grid entity.
+// If the Grid object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *GridMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -3775,1176 +3354,10568 @@ func (m *StrategyMutation) OldUpdateTime(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *StrategyMutation) ResetUpdateTime() {
+func (m *GridMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetGUID sets the "guid" field.
-func (m *StrategyMutation) SetGUID(s string) {
-	m.guid = &s
+// SetStrategyId sets the "strategyId" field.
+func (m *GridMutation) SetStrategyId(s string) {
+	m.strategyId = &s
 }
 
-// GUID returns the value of the "guid" field in the mutation.
-func (m *StrategyMutation) GUID() (r string, exists bool) {
-	v := m.guid
+// StrategyId returns the value of the "strategyId" field in the mutation.
+func (m *GridMutation) StrategyId() (r string, exists bool) {
+	v := m.strategyId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGUID returns the old "guid" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldStrategyId returns the old "strategyId" field's value of the Grid entity.
+// If the Grid object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldGUID(ctx context.Context) (v string, err error) {
+func (m *GridMutation) OldStrategyId(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGUID is only allowed on UpdateOne operations")
+		return v, errors.New("OldStrategyId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGUID requires an ID field in the mutation")
+		return v, errors.New("OldStrategyId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGUID: %w", err)
+		return v, fmt.Errorf("querying old value for OldStrategyId: %w", err)
 	}
-	return oldValue.GUID, nil
+	return oldValue.StrategyId, nil
 }
 
-// ResetGUID resets all changes to the "guid" field.
-func (m *StrategyMutation) ResetGUID() {
-	m.guid = nil
+// ResetStrategyId resets all changes to the "strategyId" field.
+func (m *GridMutation) ResetStrategyId() {
+	m.strategyId = nil
 }
 
-// SetOwner sets the "owner" field.
-func (m *StrategyMutation) SetOwner(i int64) {
-	m.owner = &i
-	m.addowner = nil
+// SetExchange sets the "exchange" field.
+func (m *GridMutation) SetExchange(s string) {
+	m.exchange = &s
 }
 
-// Owner returns the value of the "owner" field in the mutation.
-func (m *StrategyMutation) Owner() (r int64, exists bool) {
-	v := m.owner
+// Exchange returns the value of the "exchange" field in the mutation.
+func (m *GridMutation) Exchange() (r string, exists bool) {
+	v := m.exchange
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOwner returns the old "owner" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldExchange returns the old "exchange" field's value of the Grid entity.
+// If the Grid object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldOwner(ctx context.Context) (v int64, err error) {
+func (m *GridMutation) OldExchange(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
+		return v, errors.New("OldExchange is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwner requires an ID field in the mutation")
+		return v, errors.New("OldExchange requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
+		return v, fmt.Errorf("querying old value for OldExchange: %w", err)
 	}
-	return oldValue.Owner, nil
+	return oldValue.Exchange, nil
 }
 
-// AddOwner adds i to the "owner" field.
-func (m *StrategyMutation) AddOwner(i int64) {
-	if m.addowner != nil {
-		*m.addowner += i
+// ResetExchange resets all changes to the "exchange" field.
+func (m *GridMutation) ResetExchange() {
+	m.exchange = nil
+}
+
+// SetSymbol sets the "symbol" field.
+func (m *GridMutation) SetSymbol(s string) {
+	m.symbol = &s
+}
+
+// Symbol returns the value of the "symbol" field in the mutation.
+func (m *GridMutation) Symbol() (r string, exists bool) {
+	v := m.symbol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSymbol returns the old "symbol" field's value of the Grid entity.
+// If the Grid object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GridMutation) OldSymbol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSymbol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+	}
+	return oldValue.Symbol, nil
+}
+
+// ResetSymbol resets all changes to the "symbol" field.
+func (m *GridMutation) ResetSymbol() {
+	m.symbol = nil
+}
+
+// SetAccount sets the "account" field.
+func (m *GridMutation) SetAccount(s string) {
+	m.account = &s
+}
+
+// Account returns the value of the "account" field in the mutation.
+func (m *GridMutation) Account() (r string, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccount returns the old "account" field's value of the Grid entity.
+// If the Grid object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GridMutation) OldAccount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccount: %w", err)
+	}
+	return oldValue.Account, nil
+}
+
+// ResetAccount resets all changes to the "account" field.
+func (m *GridMutation) ResetAccount() {
+	m.account = nil
+}
+
+// SetLevel sets the "level" field.
+func (m *GridMutation) SetLevel(i int) {
+	m.level = &i
+	m.addlevel = nil
+}
+
+// Level returns the value of the "level" field in the mutation.
+func (m *GridMutation) Level() (r int, exists bool) {
+	v := m.level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLevel returns the old "level" field's value of the Grid entity.
+// If the Grid object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GridMutation) OldLevel(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLevel: %w", err)
+	}
+	return oldValue.Level, nil
+}
+
+// AddLevel adds i to the "level" field.
+func (m *GridMutation) AddLevel(i int) {
+	if m.addlevel != nil {
+		*m.addlevel += i
 	} else {
-		m.addowner = &i
+		m.addlevel = &i
 	}
 }
 
-// AddedOwner returns the value that was added to the "owner" field in this mutation.
-func (m *StrategyMutation) AddedOwner() (r int64, exists bool) {
-	v := m.addowner
+// AddedLevel returns the value that was added to the "level" field in this mutation.
+func (m *GridMutation) AddedLevel() (r int, exists bool) {
+	v := m.addlevel
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetOwner resets all changes to the "owner" field.
-func (m *StrategyMutation) ResetOwner() {
-	m.owner = nil
-	m.addowner = nil
+// ResetLevel resets all changes to the "level" field.
+func (m *GridMutation) ResetLevel() {
+	m.level = nil
+	m.addlevel = nil
 }
 
-// SetExchange sets the "exchange" field.
-func (m *StrategyMutation) SetExchange(s string) {
-	m.exchange = &s
+// SetPrice sets the "price" field.
+func (m *GridMutation) SetPrice(d decimal.Decimal) {
+	m.price = &d
 }
 
-// Exchange returns the value of the "exchange" field in the mutation.
-func (m *StrategyMutation) Exchange() (r string, exists bool) {
-	v := m.exchange
+// Price returns the value of the "price" field in the mutation.
+func (m *GridMutation) Price() (r decimal.Decimal, exists bool) {
+	v := m.price
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExchange returns the old "exchange" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldPrice returns the old "price" field's value of the Grid entity.
+// If the Grid object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldExchange(ctx context.Context) (v string, err error) {
+func (m *GridMutation) OldPrice(ctx context.Context) (v decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExchange is only allowed on UpdateOne operations")
+		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExchange requires an ID field in the mutation")
+		return v, errors.New("OldPrice requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExchange: %w", err)
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
 	}
-	return oldValue.Exchange, nil
+	return oldValue.Price, nil
 }
 
-// ResetExchange resets all changes to the "exchange" field.
-func (m *StrategyMutation) ResetExchange() {
-	m.exchange = nil
+// ResetPrice resets all changes to the "price" field.
+func (m *GridMutation) ResetPrice() {
+	m.price = nil
 }
 
-// SetSymbol sets the "symbol" field.
-func (m *StrategyMutation) SetSymbol(s string) {
-	m.symbol = &s
+// SetQuantity sets the "quantity" field.
+func (m *GridMutation) SetQuantity(d decimal.Decimal) {
+	m.quantity = &d
+}
+
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *GridMutation) Quantity() (r decimal.Decimal, exists bool) {
+	v := m.quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuantity returns the old "quantity" field's value of the Grid entity.
+// If the Grid object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GridMutation) OldQuantity(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+	}
+	return oldValue.Quantity, nil
+}
+
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *GridMutation) ResetQuantity() {
+	m.quantity = nil
+}
+
+// SetBuyClientOrderId sets the "buyClientOrderId" field.
+func (m *GridMutation) SetBuyClientOrderId(s string) {
+	m.buyClientOrderId = &s
+}
+
+// BuyClientOrderId returns the value of the "buyClientOrderId" field in the mutation.
+func (m *GridMutation) BuyClientOrderId() (r string, exists bool) {
+	v := m.buyClientOrderId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuyClientOrderId returns the old "buyClientOrderId" field's value of the Grid entity.
+// If the Grid object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GridMutation) OldBuyClientOrderId(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuyClientOrderId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuyClientOrderId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuyClientOrderId: %w", err)
+	}
+	return oldValue.BuyClientOrderId, nil
+}
+
+// ClearBuyClientOrderId clears the value of the "buyClientOrderId" field.
+func (m *GridMutation) ClearBuyClientOrderId() {
+	m.buyClientOrderId = nil
+	m.clearedFields[grid.FieldBuyClientOrderId] = struct{}{}
+}
+
+// BuyClientOrderIdCleared returns if the "buyClientOrderId" field was cleared in this mutation.
+func (m *GridMutation) BuyClientOrderIdCleared() bool {
+	_, ok := m.clearedFields[grid.FieldBuyClientOrderId]
+	return ok
+}
+
+// ResetBuyClientOrderId resets all changes to the "buyClientOrderId" field.
+func (m *GridMutation) ResetBuyClientOrderId() {
+	m.buyClientOrderId = nil
+	delete(m.clearedFields, grid.FieldBuyClientOrderId)
+}
+
+// SetBuyClientOrderTime sets the "buyClientOrderTime" field.
+func (m *GridMutation) SetBuyClientOrderTime(i int64) {
+	m.buyClientOrderTime = &i
+	m.addbuyClientOrderTime = nil
+}
+
+// BuyClientOrderTime returns the value of the "buyClientOrderTime" field in the mutation.
+func (m *GridMutation) BuyClientOrderTime() (r int64, exists bool) {
+	v := m.buyClientOrderTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuyClientOrderTime returns the old "buyClientOrderTime" field's value of the Grid entity.
+// If the Grid object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GridMutation) OldBuyClientOrderTime(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuyClientOrderTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuyClientOrderTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuyClientOrderTime: %w", err)
+	}
+	return oldValue.BuyClientOrderTime, nil
+}
+
+// AddBuyClientOrderTime adds i to the "buyClientOrderTime" field.
+func (m *GridMutation) AddBuyClientOrderTime(i int64) {
+	if m.addbuyClientOrderTime != nil {
+		*m.addbuyClientOrderTime += i
+	} else {
+		m.addbuyClientOrderTime = &i
+	}
+}
+
+// AddedBuyClientOrderTime returns the value that was added to the "buyClientOrderTime" field in this mutation.
+func (m *GridMutation) AddedBuyClientOrderTime() (r int64, exists bool) {
+	v := m.addbuyClientOrderTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearBuyClientOrderTime clears the value of the "buyClientOrderTime" field.
+func (m *GridMutation) ClearBuyClientOrderTime() {
+	m.buyClientOrderTime = nil
+	m.addbuyClientOrderTime = nil
+	m.clearedFields[grid.FieldBuyClientOrderTime] = struct{}{}
+}
+
+// BuyClientOrderTimeCleared returns if the "buyClientOrderTime" field was cleared in this mutation.
+func (m *GridMutation) BuyClientOrderTimeCleared() bool {
+	_, ok := m.clearedFields[grid.FieldBuyClientOrderTime]
+	return ok
+}
+
+// ResetBuyClientOrderTime resets all changes to the "buyClientOrderTime" field.
+func (m *GridMutation) ResetBuyClientOrderTime() {
+	m.buyClientOrderTime = nil
+	m.addbuyClientOrderTime = nil
+	delete(m.clearedFields, grid.FieldBuyClientOrderTime)
+}
+
+// SetSellClientOrderId sets the "sellClientOrderId" field.
+func (m *GridMutation) SetSellClientOrderId(s string) {
+	m.sellClientOrderId = &s
+}
+
+// SellClientOrderId returns the value of the "sellClientOrderId" field in the mutation.
+func (m *GridMutation) SellClientOrderId() (r string, exists bool) {
+	v := m.sellClientOrderId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSellClientOrderId returns the old "sellClientOrderId" field's value of the Grid entity.
+// If the Grid object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GridMutation) OldSellClientOrderId(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSellClientOrderId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSellClientOrderId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSellClientOrderId: %w", err)
+	}
+	return oldValue.SellClientOrderId, nil
+}
+
+// ClearSellClientOrderId clears the value of the "sellClientOrderId" field.
+func (m *GridMutation) ClearSellClientOrderId() {
+	m.sellClientOrderId = nil
+	m.clearedFields[grid.FieldSellClientOrderId] = struct{}{}
+}
+
+// SellClientOrderIdCleared returns if the "sellClientOrderId" field was cleared in this mutation.
+func (m *GridMutation) SellClientOrderIdCleared() bool {
+	_, ok := m.clearedFields[grid.FieldSellClientOrderId]
+	return ok
+}
+
+// ResetSellClientOrderId resets all changes to the "sellClientOrderId" field.
+func (m *GridMutation) ResetSellClientOrderId() {
+	m.sellClientOrderId = nil
+	delete(m.clearedFields, grid.FieldSellClientOrderId)
+}
+
+// SetSellClientOrderTime sets the "sellClientOrderTime" field.
+func (m *GridMutation) SetSellClientOrderTime(i int64) {
+	m.sellClientOrderTime = &i
+	m.addsellClientOrderTime = nil
+}
+
+// SellClientOrderTime returns the value of the "sellClientOrderTime" field in the mutation.
+func (m *GridMutation) SellClientOrderTime() (r int64, exists bool) {
+	v := m.sellClientOrderTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSellClientOrderTime returns the old "sellClientOrderTime" field's value of the Grid entity.
+// If the Grid object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GridMutation) OldSellClientOrderTime(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSellClientOrderTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSellClientOrderTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSellClientOrderTime: %w", err)
+	}
+	return oldValue.SellClientOrderTime, nil
+}
+
+// AddSellClientOrderTime adds i to the "sellClientOrderTime" field.
+func (m *GridMutation) AddSellClientOrderTime(i int64) {
+	if m.addsellClientOrderTime != nil {
+		*m.addsellClientOrderTime += i
+	} else {
+		m.addsellClientOrderTime = &i
+	}
+}
+
+// AddedSellClientOrderTime returns the value that was added to the "sellClientOrderTime" field in this mutation.
+func (m *GridMutation) AddedSellClientOrderTime() (r int64, exists bool) {
+	v := m.addsellClientOrderTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSellClientOrderTime clears the value of the "sellClientOrderTime" field.
+func (m *GridMutation) ClearSellClientOrderTime() {
+	m.sellClientOrderTime = nil
+	m.addsellClientOrderTime = nil
+	m.clearedFields[grid.FieldSellClientOrderTime] = struct{}{}
+}
+
+// SellClientOrderTimeCleared returns if the "sellClientOrderTime" field was cleared in this mutation.
+func (m *GridMutation) SellClientOrderTimeCleared() bool {
+	_, ok := m.clearedFields[grid.FieldSellClientOrderTime]
+	return ok
+}
+
+// ResetSellClientOrderTime resets all changes to the "sellClientOrderTime" field.
+func (m *GridMutation) ResetSellClientOrderTime() {
+	m.sellClientOrderTime = nil
+	m.addsellClientOrderTime = nil
+	delete(m.clearedFields, grid.FieldSellClientOrderTime)
+}
+
+// SetRunId sets the "runId" field.
+func (m *GridMutation) SetRunId(i int) {
+	m.runId = &i
+	m.addrunId = nil
+}
+
+// RunId returns the value of the "runId" field in the mutation.
+func (m *GridMutation) RunId() (r int, exists bool) {
+	v := m.runId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunId returns the old "runId" field's value of the Grid entity.
+// If the Grid object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GridMutation) OldRunId(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunId: %w", err)
+	}
+	return oldValue.RunId, nil
+}
+
+// AddRunId adds i to the "runId" field.
+func (m *GridMutation) AddRunId(i int) {
+	if m.addrunId != nil {
+		*m.addrunId += i
+	} else {
+		m.addrunId = &i
+	}
+}
+
+// AddedRunId returns the value that was added to the "runId" field in this mutation.
+func (m *GridMutation) AddedRunId() (r int, exists bool) {
+	v := m.addrunId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRunId clears the value of the "runId" field.
+func (m *GridMutation) ClearRunId() {
+	m.runId = nil
+	m.addrunId = nil
+	m.clearedFields[grid.FieldRunId] = struct{}{}
+}
+
+// RunIdCleared returns if the "runId" field was cleared in this mutation.
+func (m *GridMutation) RunIdCleared() bool {
+	_, ok := m.clearedFields[grid.FieldRunId]
+	return ok
+}
+
+// ResetRunId resets all changes to the "runId" field.
+func (m *GridMutation) ResetRunId() {
+	m.runId = nil
+	m.addrunId = nil
+	delete(m.clearedFields, grid.FieldRunId)
+}
+
+// Where appends a list predicates to the GridMutation builder.
+func (m *GridMutation) Where(ps ...predicate.Grid) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GridMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GridMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Grid, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GridMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GridMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Grid).
+func (m *GridMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GridMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.create_time != nil {
+		fields = append(fields, grid.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, grid.FieldUpdateTime)
+	}
+	if m.strategyId != nil {
+		fields = append(fields, grid.FieldStrategyId)
+	}
+	if m.exchange != nil {
+		fields = append(fields, grid.FieldExchange)
+	}
+	if m.symbol != nil {
+		fields = append(fields, grid.FieldSymbol)
+	}
+	if m.account != nil {
+		fields = append(fields, grid.FieldAccount)
+	}
+	if m.level != nil {
+		fields = append(fields, grid.FieldLevel)
+	}
+	if m.price != nil {
+		fields = append(fields, grid.FieldPrice)
+	}
+	if m.quantity != nil {
+		fields = append(fields, grid.FieldQuantity)
+	}
+	if m.buyClientOrderId != nil {
+		fields = append(fields, grid.FieldBuyClientOrderId)
+	}
+	if m.buyClientOrderTime != nil {
+		fields = append(fields, grid.FieldBuyClientOrderTime)
+	}
+	if m.sellClientOrderId != nil {
+		fields = append(fields, grid.FieldSellClientOrderId)
+	}
+	if m.sellClientOrderTime != nil {
+		fields = append(fields, grid.FieldSellClientOrderTime)
+	}
+	if m.runId != nil {
+		fields = append(fields, grid.FieldRunId)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GridMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case grid.FieldCreateTime:
+		return m.CreateTime()
+	case grid.FieldUpdateTime:
+		return m.UpdateTime()
+	case grid.FieldStrategyId:
+		return m.StrategyId()
+	case grid.FieldExchange:
+		return m.Exchange()
+	case grid.FieldSymbol:
+		return m.Symbol()
+	case grid.FieldAccount:
+		return m.Account()
+	case grid.FieldLevel:
+		return m.Level()
+	case grid.FieldPrice:
+		return m.Price()
+	case grid.FieldQuantity:
+		return m.Quantity()
+	case grid.FieldBuyClientOrderId:
+		return m.BuyClientOrderId()
+	case grid.FieldBuyClientOrderTime:
+		return m.BuyClientOrderTime()
+	case grid.FieldSellClientOrderId:
+		return m.SellClientOrderId()
+	case grid.FieldSellClientOrderTime:
+		return m.SellClientOrderTime()
+	case grid.FieldRunId:
+		return m.RunId()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GridMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case grid.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case grid.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case grid.FieldStrategyId:
+		return m.OldStrategyId(ctx)
+	case grid.FieldExchange:
+		return m.OldExchange(ctx)
+	case grid.FieldSymbol:
+		return m.OldSymbol(ctx)
+	case grid.FieldAccount:
+		return m.OldAccount(ctx)
+	case grid.FieldLevel:
+		return m.OldLevel(ctx)
+	case grid.FieldPrice:
+		return m.OldPrice(ctx)
+	case grid.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case grid.FieldBuyClientOrderId:
+		return m.OldBuyClientOrderId(ctx)
+	case grid.FieldBuyClientOrderTime:
+		return m.OldBuyClientOrderTime(ctx)
+	case grid.FieldSellClientOrderId:
+		return m.OldSellClientOrderId(ctx)
+	case grid.FieldSellClientOrderTime:
+		return m.OldSellClientOrderTime(ctx)
+	case grid.FieldRunId:
+		return m.OldRunId(ctx)
+	}
+	return nil, fmt.Errorf("unknown Grid field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GridMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case grid.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case grid.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case grid.FieldStrategyId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStrategyId(v)
+		return nil
+	case grid.FieldExchange:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExchange(v)
+		return nil
+	case grid.FieldSymbol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSymbol(v)
+		return nil
+	case grid.FieldAccount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccount(v)
+		return nil
+	case grid.FieldLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLevel(v)
+		return nil
+	case grid.FieldPrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case grid.FieldQuantity:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case grid.FieldBuyClientOrderId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuyClientOrderId(v)
+		return nil
+	case grid.FieldBuyClientOrderTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuyClientOrderTime(v)
+		return nil
+	case grid.FieldSellClientOrderId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSellClientOrderId(v)
+		return nil
+	case grid.FieldSellClientOrderTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSellClientOrderTime(v)
+		return nil
+	case grid.FieldRunId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunId(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Grid field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GridMutation) AddedFields() []string {
+	var fields []string
+	if m.addlevel != nil {
+		fields = append(fields, grid.FieldLevel)
+	}
+	if m.addbuyClientOrderTime != nil {
+		fields = append(fields, grid.FieldBuyClientOrderTime)
+	}
+	if m.addsellClientOrderTime != nil {
+		fields = append(fields, grid.FieldSellClientOrderTime)
+	}
+	if m.addrunId != nil {
+		fields = append(fields, grid.FieldRunId)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GridMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case grid.FieldLevel:
+		return m.AddedLevel()
+	case grid.FieldBuyClientOrderTime:
+		return m.AddedBuyClientOrderTime()
+	case grid.FieldSellClientOrderTime:
+		return m.AddedSellClientOrderTime()
+	case grid.FieldRunId:
+		return m.AddedRunId()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GridMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case grid.FieldLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLevel(v)
+		return nil
+	case grid.FieldBuyClientOrderTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBuyClientOrderTime(v)
+		return nil
+	case grid.FieldSellClientOrderTime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSellClientOrderTime(v)
+		return nil
+	case grid.FieldRunId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRunId(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Grid numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GridMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(grid.FieldBuyClientOrderId) {
+		fields = append(fields, grid.FieldBuyClientOrderId)
+	}
+	if m.FieldCleared(grid.FieldBuyClientOrderTime) {
+		fields = append(fields, grid.FieldBuyClientOrderTime)
+	}
+	if m.FieldCleared(grid.FieldSellClientOrderId) {
+		fields = append(fields, grid.FieldSellClientOrderId)
+	}
+	if m.FieldCleared(grid.FieldSellClientOrderTime) {
+		fields = append(fields, grid.FieldSellClientOrderTime)
+	}
+	if m.FieldCleared(grid.FieldRunId) {
+		fields = append(fields, grid.FieldRunId)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GridMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GridMutation) ClearField(name string) error {
+	switch name {
+	case grid.FieldBuyClientOrderId:
+		m.ClearBuyClientOrderId()
+		return nil
+	case grid.FieldBuyClientOrderTime:
+		m.ClearBuyClientOrderTime()
+		return nil
+	case grid.FieldSellClientOrderId:
+		m.ClearSellClientOrderId()
+		return nil
+	case grid.FieldSellClientOrderTime:
+		m.ClearSellClientOrderTime()
+		return nil
+	case grid.FieldRunId:
+		m.ClearRunId()
+		return nil
+	}
+	return fmt.Errorf("unknown Grid nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GridMutation) ResetField(name string) error {
+	switch name {
+	case grid.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case grid.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case grid.FieldStrategyId:
+		m.ResetStrategyId()
+		return nil
+	case grid.FieldExchange:
+		m.ResetExchange()
+		return nil
+	case grid.FieldSymbol:
+		m.ResetSymbol()
+		return nil
+	case grid.FieldAccount:
+		m.ResetAccount()
+		return nil
+	case grid.FieldLevel:
+		m.ResetLevel()
+		return nil
+	case grid.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case grid.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case grid.FieldBuyClientOrderId:
+		m.ResetBuyClientOrderId()
+		return nil
+	case grid.FieldBuyClientOrderTime:
+		m.ResetBuyClientOrderTime()
+		return nil
+	case grid.FieldSellClientOrderId:
+		m.ResetSellClientOrderId()
+		return nil
+	case grid.FieldSellClientOrderTime:
+		m.ResetSellClientOrderTime()
+		return nil
+	case grid.FieldRunId:
+		m.ResetRunId()
+		return nil
+	}
+	return fmt.Errorf("unknown Grid field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GridMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GridMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GridMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GridMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GridMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GridMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GridMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Grid unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GridMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Grid edge %s", name)
+}
+
+// MatchedTradeMutation represents an operation that mutates the MatchedTrade nodes in the graph.
+type MatchedTradeMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int
+	create_time            *time.Time
+	update_time            *time.Time
+	strategyId             *string
+	account                *string
+	symbol                 *string
+	buyClientOrderId       *string
+	buyBaseAmount          *decimal.Decimal
+	buyQuoteAmount         *decimal.Decimal
+	buyOrderTimestamp      *int64
+	addbuyOrderTimestamp   *int64
+	buyPartialBaseAmount   *decimal.Decimal
+	buyPartialQuoteAmount  *decimal.Decimal
+	sellClientOrderId      *string
+	sellBaseAmount         *decimal.Decimal
+	sellQuoteAmount        *decimal.Decimal
+	sellOrderTimestamp     *int64
+	addsellOrderTimestamp  *int64
+	sellPartialBaseAmount  *decimal.Decimal
+	sellPartialQuoteAmount *decimal.Decimal
+	profit                 *float64
+	addprofit              *float64
+	fee                    *float64
+	addfee                 *float64
+	netProfit              *float64
+	addnetProfit           *float64
+	runId                  *int
+	addrunId               *int
+	clearedFields          map[string]struct{}
+	done                   bool
+	oldValue               func(context.Context) (*MatchedTrade, error)
+	predicates             []predicate.MatchedTrade
+}
+
+var _ ent.Mutation = (*MatchedTradeMutation)(nil)
+
+// matchedtradeOption allows management of the mutation configuration using functional options.
+type matchedtradeOption func(*MatchedTradeMutation)
+
+// newMatchedTradeMutation creates new mutation for the MatchedTrade entity.
+func newMatchedTradeMutation(c config, op Op, opts ...matchedtradeOption) *MatchedTradeMutation {
+	m := &MatchedTradeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMatchedTrade,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMatchedTradeID sets the ID field of the mutation.
+func withMatchedTradeID(id int) matchedtradeOption {
+	return func(m *MatchedTradeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MatchedTrade
+		)
+		m.oldValue = func(ctx context.Context) (*MatchedTrade, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MatchedTrade.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMatchedTrade sets the old MatchedTrade of the mutation.
+func withMatchedTrade(node *MatchedTrade) matchedtradeOption {
+	return func(m *MatchedTradeMutation) {
+		m.oldValue = func(context.Context) (*MatchedTrade, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MatchedTradeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MatchedTradeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MatchedTradeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MatchedTradeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MatchedTrade.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *MatchedTradeMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *MatchedTradeMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the MatchedTrade entity.
+// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchedTradeMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *MatchedTradeMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *MatchedTradeMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *MatchedTradeMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the MatchedTrade entity.
+// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchedTradeMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *MatchedTradeMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetStrategyId sets the "strategyId" field.
+func (m *MatchedTradeMutation) SetStrategyId(s string) {
+	m.strategyId = &s
+}
+
+// StrategyId returns the value of the "strategyId" field in the mutation.
+func (m *MatchedTradeMutation) StrategyId() (r string, exists bool) {
+	v := m.strategyId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStrategyId returns the old "strategyId" field's value of the MatchedTrade entity.
+// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchedTradeMutation) OldStrategyId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStrategyId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStrategyId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStrategyId: %w", err)
+	}
+	return oldValue.StrategyId, nil
+}
+
+// ResetStrategyId resets all changes to the "strategyId" field.
+func (m *MatchedTradeMutation) ResetStrategyId() {
+	m.strategyId = nil
+}
+
+// SetAccount sets the "account" field.
+func (m *MatchedTradeMutation) SetAccount(s string) {
+	m.account = &s
+}
+
+// Account returns the value of the "account" field in the mutation.
+func (m *MatchedTradeMutation) Account() (r string, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccount returns the old "account" field's value of the MatchedTrade entity.
+// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchedTradeMutation) OldAccount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccount: %w", err)
+	}
+	return oldValue.Account, nil
+}
+
+// ResetAccount resets all changes to the "account" field.
+func (m *MatchedTradeMutation) ResetAccount() {
+	m.account = nil
+}
+
+// SetSymbol sets the "symbol" field.
+func (m *MatchedTradeMutation) SetSymbol(s string) {
+	m.symbol = &s
+}
+
+// Symbol returns the value of the "symbol" field in the mutation.
+func (m *MatchedTradeMutation) Symbol() (r string, exists bool) {
+	v := m.symbol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSymbol returns the old "symbol" field's value of the MatchedTrade entity.
+// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchedTradeMutation) OldSymbol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSymbol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+	}
+	return oldValue.Symbol, nil
+}
+
+// ResetSymbol resets all changes to the "symbol" field.
+func (m *MatchedTradeMutation) ResetSymbol() {
+	m.symbol = nil
+}
+
+// SetBuyClientOrderId sets the "buyClientOrderId" field.
+func (m *MatchedTradeMutation) SetBuyClientOrderId(s string) {
+	m.buyClientOrderId = &s
+}
+
+// BuyClientOrderId returns the value of the "buyClientOrderId" field in the mutation.
+func (m *MatchedTradeMutation) BuyClientOrderId() (r string, exists bool) {
+	v := m.buyClientOrderId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuyClientOrderId returns the old "buyClientOrderId" field's value of the MatchedTrade entity.
+// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchedTradeMutation) OldBuyClientOrderId(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuyClientOrderId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuyClientOrderId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuyClientOrderId: %w", err)
+	}
+	return oldValue.BuyClientOrderId, nil
+}
+
+// ClearBuyClientOrderId clears the value of the "buyClientOrderId" field.
+func (m *MatchedTradeMutation) ClearBuyClientOrderId() {
+	m.buyClientOrderId = nil
+	m.clearedFields[matchedtrade.FieldBuyClientOrderId] = struct{}{}
+}
+
+// BuyClientOrderIdCleared returns if the "buyClientOrderId" field was cleared in this mutation.
+func (m *MatchedTradeMutation) BuyClientOrderIdCleared() bool {
+	_, ok := m.clearedFields[matchedtrade.FieldBuyClientOrderId]
+	return ok
+}
+
+// ResetBuyClientOrderId resets all changes to the "buyClientOrderId" field.
+func (m *MatchedTradeMutation) ResetBuyClientOrderId() {
+	m.buyClientOrderId = nil
+	delete(m.clearedFields, matchedtrade.FieldBuyClientOrderId)
+}
+
+// SetBuyBaseAmount sets the "buyBaseAmount" field.
+func (m *MatchedTradeMutation) SetBuyBaseAmount(d decimal.Decimal) {
+	m.buyBaseAmount = &d
+}
+
+// BuyBaseAmount returns the value of the "buyBaseAmount" field in the mutation.
+func (m *MatchedTradeMutation) BuyBaseAmount() (r decimal.Decimal, exists bool) {
+	v := m.buyBaseAmount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuyBaseAmount returns the old "buyBaseAmount" field's value of the MatchedTrade entity.
+// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchedTradeMutation) OldBuyBaseAmount(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuyBaseAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuyBaseAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuyBaseAmount: %w", err)
+	}
+	return oldValue.BuyBaseAmount, nil
+}
+
+// ClearBuyBaseAmount clears the value of the "buyBaseAmount" field.
+func (m *MatchedTradeMutation) ClearBuyBaseAmount() {
+	m.buyBaseAmount = nil
+	m.clearedFields[matchedtrade.FieldBuyBaseAmount] = struct{}{}
+}
+
+// BuyBaseAmountCleared returns if the "buyBaseAmount" field was cleared in this mutation.
+func (m *MatchedTradeMutation) BuyBaseAmountCleared() bool {
+	_, ok := m.clearedFields[matchedtrade.FieldBuyBaseAmount]
+	return ok
+}
+
+// ResetBuyBaseAmount resets all changes to the "buyBaseAmount" field.
+func (m *MatchedTradeMutation) ResetBuyBaseAmount() {
+	m.buyBaseAmount = nil
+	delete(m.clearedFields, matchedtrade.FieldBuyBaseAmount)
+}
+
+// SetBuyQuoteAmount sets the "buyQuoteAmount" field.
+func (m *MatchedTradeMutation) SetBuyQuoteAmount(d decimal.Decimal) {
+	m.buyQuoteAmount = &d
+}
+
+// BuyQuoteAmount returns the value of the "buyQuoteAmount" field in the mutation.
+func (m *MatchedTradeMutation) BuyQuoteAmount() (r decimal.Decimal, exists bool) {
+	v := m.buyQuoteAmount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuyQuoteAmount returns the old "buyQuoteAmount" field's value of the MatchedTrade entity.
+// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchedTradeMutation) OldBuyQuoteAmount(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuyQuoteAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuyQuoteAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuyQuoteAmount: %w", err)
+	}
+	return oldValue.BuyQuoteAmount, nil
+}
+
+// ClearBuyQuoteAmount clears the value of the "buyQuoteAmount" field.
+func (m *MatchedTradeMutation) ClearBuyQuoteAmount() {
+	m.buyQuoteAmount = nil
+	m.clearedFields[matchedtrade.FieldBuyQuoteAmount] = struct{}{}
+}
+
+// BuyQuoteAmountCleared returns if the "buyQuoteAmount" field was cleared in this mutation.
+func (m *MatchedTradeMutation) BuyQuoteAmountCleared() bool {
+	_, ok := m.clearedFields[matchedtrade.FieldBuyQuoteAmount]
+	return ok
+}
+
+// ResetBuyQuoteAmount resets all changes to the "buyQuoteAmount" field.
+func (m *MatchedTradeMutation) ResetBuyQuoteAmount() {
+	m.buyQuoteAmount = nil
+	delete(m.clearedFields, matchedtrade.FieldBuyQuoteAmount)
+}
+
+// SetBuyOrderTimestamp sets the "buyOrderTimestamp" field.
+func (m *MatchedTradeMutation) SetBuyOrderTimestamp(i int64) {
+	m.buyOrderTimestamp = &i
+	m.addbuyOrderTimestamp = nil
+}
+
+// BuyOrderTimestamp returns the value of the "buyOrderTimestamp" field in the mutation.
+func (m *MatchedTradeMutation) BuyOrderTimestamp() (r int64, exists bool) {
+	v := m.buyOrderTimestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuyOrderTimestamp returns the old "buyOrderTimestamp" field's value of the MatchedTrade entity.
+// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchedTradeMutation) OldBuyOrderTimestamp(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuyOrderTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuyOrderTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuyOrderTimestamp: %w", err)
+	}
+	return oldValue.BuyOrderTimestamp, nil
+}
+
+// AddBuyOrderTimestamp adds i to the "buyOrderTimestamp" field.
+func (m *MatchedTradeMutation) AddBuyOrderTimestamp(i int64) {
+	if m.addbuyOrderTimestamp != nil {
+		*m.addbuyOrderTimestamp += i
+	} else {
+		m.addbuyOrderTimestamp = &i
+	}
+}
+
+// AddedBuyOrderTimestamp returns the value that was added to the "buyOrderTimestamp" field in this mutation.
+func (m *MatchedTradeMutation) AddedBuyOrderTimestamp() (r int64, exists bool) {
+	v := m.addbuyOrderTimestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearBuyOrderTimestamp clears the value of the "buyOrderTimestamp" field.
+func (m *MatchedTradeMutation) ClearBuyOrderTimestamp() {
+	m.buyOrderTimestamp = nil
+	m.addbuyOrderTimestamp = nil
+	m.clearedFields[matchedtrade.FieldBuyOrderTimestamp] = struct{}{}
+}
+
+// BuyOrderTimestampCleared returns if the "buyOrderTimestamp" field was cleared in this mutation.
+func (m *MatchedTradeMutation) BuyOrderTimestampCleared() bool {
+	_, ok := m.clearedFields[matchedtrade.FieldBuyOrderTimestamp]
+	return ok
+}
+
+// ResetBuyOrderTimestamp resets all changes to the "buyOrderTimestamp" field.
+func (m *MatchedTradeMutation) ResetBuyOrderTimestamp() {
+	m.buyOrderTimestamp = nil
+	m.addbuyOrderTimestamp = nil
+	delete(m.clearedFields, matchedtrade.FieldBuyOrderTimestamp)
+}
+
+// SetBuyPartialBaseAmount sets the "buyPartialBaseAmount" field.
+func (m *MatchedTradeMutation) SetBuyPartialBaseAmount(d decimal.Decimal) {
+	m.buyPartialBaseAmount = &d
+}
+
+// BuyPartialBaseAmount returns the value of the "buyPartialBaseAmount" field in the mutation.
+func (m *MatchedTradeMutation) BuyPartialBaseAmount() (r decimal.Decimal, exists bool) {
+	v := m.buyPartialBaseAmount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuyPartialBaseAmount returns the old "buyPartialBaseAmount" field's value of the MatchedTrade entity.
+// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchedTradeMutation) OldBuyPartialBaseAmount(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuyPartialBaseAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuyPartialBaseAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuyPartialBaseAmount: %w", err)
+	}
+	return oldValue.BuyPartialBaseAmount, nil
+}
+
+// ClearBuyPartialBaseAmount clears the value of the "buyPartialBaseAmount" field.
+func (m *MatchedTradeMutation) ClearBuyPartialBaseAmount() {
+	m.buyPartialBaseAmount = nil
+	m.clearedFields[matchedtrade.FieldBuyPartialBaseAmount] = struct{}{}
+}
+
+// BuyPartialBaseAmountCleared returns if the "buyPartialBaseAmount" field was cleared in this mutation.
+func (m *MatchedTradeMutation) BuyPartialBaseAmountCleared() bool {
+	_, ok := m.clearedFields[matchedtrade.FieldBuyPartialBaseAmount]
+	return ok
+}
+
+// ResetBuyPartialBaseAmount resets all changes to the "buyPartialBaseAmount" field.
+func (m *MatchedTradeMutation) ResetBuyPartialBaseAmount() {
+	m.buyPartialBaseAmount = nil
+	delete(m.clearedFields, matchedtrade.FieldBuyPartialBaseAmount)
+}
+
+// SetBuyPartialQuoteAmount sets the "buyPartialQuoteAmount" field.
+func (m *MatchedTradeMutation) SetBuyPartialQuoteAmount(d decimal.Decimal) {
+	m.buyPartialQuoteAmount = &d
+}
+
+// BuyPartialQuoteAmount returns the value of the "buyPartialQuoteAmount" field in the mutation.
+func (m *MatchedTradeMutation) BuyPartialQuoteAmount() (r decimal.Decimal, exists bool) {
+	v := m.buyPartialQuoteAmount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuyPartialQuoteAmount returns the old "buyPartialQuoteAmount" field's value of the MatchedTrade entity.
+// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchedTradeMutation) OldBuyPartialQuoteAmount(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuyPartialQuoteAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuyPartialQuoteAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuyPartialQuoteAmount: %w", err)
+	}
+	return oldValue.BuyPartialQuoteAmount, nil
+}
+
+// ClearBuyPartialQuoteAmount clears the value of the "buyPartialQuoteAmount" field.
+func (m *MatchedTradeMutation) ClearBuyPartialQuoteAmount() {
+	m.buyPartialQuoteAmount = nil
+	m.clearedFields[matchedtrade.FieldBuyPartialQuoteAmount] = struct{}{}
+}
+
+// BuyPartialQuoteAmountCleared returns if the "buyPartialQuoteAmount" field was cleared in this mutation.
+func (m *MatchedTradeMutation) BuyPartialQuoteAmountCleared() bool {
+	_, ok := m.clearedFields[matchedtrade.FieldBuyPartialQuoteAmount]
+	return ok
+}
+
+// ResetBuyPartialQuoteAmount resets all changes to the "buyPartialQuoteAmount" field.
+func (m *MatchedTradeMutation) ResetBuyPartialQuoteAmount() {
+	m.buyPartialQuoteAmount = nil
+	delete(m.clearedFields, matchedtrade.FieldBuyPartialQuoteAmount)
+}
+
+// SetSellClientOrderId sets the "sellClientOrderId" field.
+func (m *MatchedTradeMutation) SetSellClientOrderId(s string) {
+	m.sellClientOrderId = &s
+}
+
+// SellClientOrderId returns the value of the "sellClientOrderId" field in the mutation.
+func (m *MatchedTradeMutation) SellClientOrderId() (r string, exists bool) {
+	v := m.sellClientOrderId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSellClientOrderId returns the old "sellClientOrderId" field's value of the MatchedTrade entity.
+// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchedTradeMutation) OldSellClientOrderId(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSellClientOrderId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSellClientOrderId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSellClientOrderId: %w", err)
+	}
+	return oldValue.SellClientOrderId, nil
+}
+
+// ClearSellClientOrderId clears the value of the "sellClientOrderId" field.
+func (m *MatchedTradeMutation) ClearSellClientOrderId() {
+	m.sellClientOrderId = nil
+	m.clearedFields[matchedtrade.FieldSellClientOrderId] = struct{}{}
+}
+
+// SellClientOrderIdCleared returns if the "sellClientOrderId" field was cleared in this mutation.
+func (m *MatchedTradeMutation) SellClientOrderIdCleared() bool {
+	_, ok := m.clearedFields[matchedtrade.FieldSellClientOrderId]
+	return ok
+}
+
+// ResetSellClientOrderId resets all changes to the "sellClientOrderId" field.
+func (m *MatchedTradeMutation) ResetSellClientOrderId() {
+	m.sellClientOrderId = nil
+	delete(m.clearedFields, matchedtrade.FieldSellClientOrderId)
+}
+
+// SetSellBaseAmount sets the "sellBaseAmount" field.
+func (m *MatchedTradeMutation) SetSellBaseAmount(d decimal.Decimal) {
+	m.sellBaseAmount = &d
+}
+
+// SellBaseAmount returns the value of the "sellBaseAmount" field in the mutation.
+func (m *MatchedTradeMutation) SellBaseAmount() (r decimal.Decimal, exists bool) {
+	v := m.sellBaseAmount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSellBaseAmount returns the old "sellBaseAmount" field's value of the MatchedTrade entity.
+// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchedTradeMutation) OldSellBaseAmount(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSellBaseAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSellBaseAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSellBaseAmount: %w", err)
+	}
+	return oldValue.SellBaseAmount, nil
+}
+
+// ClearSellBaseAmount clears the value of the "sellBaseAmount" field.
+func (m *MatchedTradeMutation) ClearSellBaseAmount() {
+	m.sellBaseAmount = nil
+	m.clearedFields[matchedtrade.FieldSellBaseAmount] = struct{}{}
+}
+
+// SellBaseAmountCleared returns if the "sellBaseAmount" field was cleared in this mutation.
+func (m *MatchedTradeMutation) SellBaseAmountCleared() bool {
+	_, ok := m.clearedFields[matchedtrade.FieldSellBaseAmount]
+	return ok
+}
+
+// ResetSellBaseAmount resets all changes to the "sellBaseAmount" field.
+func (m *MatchedTradeMutation) ResetSellBaseAmount() {
+	m.sellBaseAmount = nil
+	delete(m.clearedFields, matchedtrade.FieldSellBaseAmount)
+}
+
+// SetSellQuoteAmount sets the "sellQuoteAmount" field.
+func (m *MatchedTradeMutation) SetSellQuoteAmount(d decimal.Decimal) {
+	m.sellQuoteAmount = &d
+}
+
+// SellQuoteAmount returns the value of the "sellQuoteAmount" field in the mutation.
+func (m *MatchedTradeMutation) SellQuoteAmount() (r decimal.Decimal, exists bool) {
+	v := m.sellQuoteAmount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSellQuoteAmount returns the old "sellQuoteAmount" field's value of the MatchedTrade entity.
+// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchedTradeMutation) OldSellQuoteAmount(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSellQuoteAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSellQuoteAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSellQuoteAmount: %w", err)
+	}
+	return oldValue.SellQuoteAmount, nil
+}
+
+// ClearSellQuoteAmount clears the value of the "sellQuoteAmount" field.
+func (m *MatchedTradeMutation) ClearSellQuoteAmount() {
+	m.sellQuoteAmount = nil
+	m.clearedFields[matchedtrade.FieldSellQuoteAmount] = struct{}{}
+}
+
+// SellQuoteAmountCleared returns if the "sellQuoteAmount" field was cleared in this mutation.
+func (m *MatchedTradeMutation) SellQuoteAmountCleared() bool {
+	_, ok := m.clearedFields[matchedtrade.FieldSellQuoteAmount]
+	return ok
+}
+
+// ResetSellQuoteAmount resets all changes to the "sellQuoteAmount" field.
+func (m *MatchedTradeMutation) ResetSellQuoteAmount() {
+	m.sellQuoteAmount = nil
+	delete(m.clearedFields, matchedtrade.FieldSellQuoteAmount)
+}
+
+// SetSellOrderTimestamp sets the "sellOrderTimestamp" field.
+func (m *MatchedTradeMutation) SetSellOrderTimestamp(i int64) {
+	m.sellOrderTimestamp = &i
+	m.addsellOrderTimestamp = nil
+}
+
+// SellOrderTimestamp returns the value of the "sellOrderTimestamp" field in the mutation.
+func (m *MatchedTradeMutation) SellOrderTimestamp() (r int64, exists bool) {
+	v := m.sellOrderTimestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSellOrderTimestamp returns the old "sellOrderTimestamp" field's value of the MatchedTrade entity.
+// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchedTradeMutation) OldSellOrderTimestamp(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSellOrderTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSellOrderTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSellOrderTimestamp: %w", err)
+	}
+	return oldValue.SellOrderTimestamp, nil
+}
+
+// AddSellOrderTimestamp adds i to the "sellOrderTimestamp" field.
+func (m *MatchedTradeMutation) AddSellOrderTimestamp(i int64) {
+	if m.addsellOrderTimestamp != nil {
+		*m.addsellOrderTimestamp += i
+	} else {
+		m.addsellOrderTimestamp = &i
+	}
+}
+
+// AddedSellOrderTimestamp returns the value that was added to the "sellOrderTimestamp" field in this mutation.
+func (m *MatchedTradeMutation) AddedSellOrderTimestamp() (r int64, exists bool) {
+	v := m.addsellOrderTimestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSellOrderTimestamp clears the value of the "sellOrderTimestamp" field.
+func (m *MatchedTradeMutation) ClearSellOrderTimestamp() {
+	m.sellOrderTimestamp = nil
+	m.addsellOrderTimestamp = nil
+	m.clearedFields[matchedtrade.FieldSellOrderTimestamp] = struct{}{}
+}
+
+// SellOrderTimestampCleared returns if the "sellOrderTimestamp" field was cleared in this mutation.
+func (m *MatchedTradeMutation) SellOrderTimestampCleared() bool {
+	_, ok := m.clearedFields[matchedtrade.FieldSellOrderTimestamp]
+	return ok
+}
+
+// ResetSellOrderTimestamp resets all changes to the "sellOrderTimestamp" field.
+func (m *MatchedTradeMutation) ResetSellOrderTimestamp() {
+	m.sellOrderTimestamp = nil
+	m.addsellOrderTimestamp = nil
+	delete(m.clearedFields, matchedtrade.FieldSellOrderTimestamp)
+}
+
+// SetSellPartialBaseAmount sets the "sellPartialBaseAmount" field.
+func (m *MatchedTradeMutation) SetSellPartialBaseAmount(d decimal.Decimal) {
+	m.sellPartialBaseAmount = &d
+}
+
+// SellPartialBaseAmount returns the value of the "sellPartialBaseAmount" field in the mutation.
+func (m *MatchedTradeMutation) SellPartialBaseAmount() (r decimal.Decimal, exists bool) {
+	v := m.sellPartialBaseAmount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSellPartialBaseAmount returns the old "sellPartialBaseAmount" field's value of the MatchedTrade entity.
+// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchedTradeMutation) OldSellPartialBaseAmount(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSellPartialBaseAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSellPartialBaseAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSellPartialBaseAmount: %w", err)
+	}
+	return oldValue.SellPartialBaseAmount, nil
+}
+
+// ClearSellPartialBaseAmount clears the value of the "sellPartialBaseAmount" field.
+func (m *MatchedTradeMutation) ClearSellPartialBaseAmount() {
+	m.sellPartialBaseAmount = nil
+	m.clearedFields[matchedtrade.FieldSellPartialBaseAmount] = struct{}{}
+}
+
+// SellPartialBaseAmountCleared returns if the "sellPartialBaseAmount" field was cleared in this mutation.
+func (m *MatchedTradeMutation) SellPartialBaseAmountCleared() bool {
+	_, ok := m.clearedFields[matchedtrade.FieldSellPartialBaseAmount]
+	return ok
+}
+
+// ResetSellPartialBaseAmount resets all changes to the "sellPartialBaseAmount" field.
+func (m *MatchedTradeMutation) ResetSellPartialBaseAmount() {
+	m.sellPartialBaseAmount = nil
+	delete(m.clearedFields, matchedtrade.FieldSellPartialBaseAmount)
+}
+
+// SetSellPartialQuoteAmount sets the "sellPartialQuoteAmount" field.
+func (m *MatchedTradeMutation) SetSellPartialQuoteAmount(d decimal.Decimal) {
+	m.sellPartialQuoteAmount = &d
+}
+
+// SellPartialQuoteAmount returns the value of the "sellPartialQuoteAmount" field in the mutation.
+func (m *MatchedTradeMutation) SellPartialQuoteAmount() (r decimal.Decimal, exists bool) {
+	v := m.sellPartialQuoteAmount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSellPartialQuoteAmount returns the old "sellPartialQuoteAmount" field's value of the MatchedTrade entity.
+// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchedTradeMutation) OldSellPartialQuoteAmount(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSellPartialQuoteAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSellPartialQuoteAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSellPartialQuoteAmount: %w", err)
+	}
+	return oldValue.SellPartialQuoteAmount, nil
+}
+
+// ClearSellPartialQuoteAmount clears the value of the "sellPartialQuoteAmount" field.
+func (m *MatchedTradeMutation) ClearSellPartialQuoteAmount() {
+	m.sellPartialQuoteAmount = nil
+	m.clearedFields[matchedtrade.FieldSellPartialQuoteAmount] = struct{}{}
+}
+
+// SellPartialQuoteAmountCleared returns if the "sellPartialQuoteAmount" field was cleared in this mutation.
+func (m *MatchedTradeMutation) SellPartialQuoteAmountCleared() bool {
+	_, ok := m.clearedFields[matchedtrade.FieldSellPartialQuoteAmount]
+	return ok
+}
+
+// ResetSellPartialQuoteAmount resets all changes to the "sellPartialQuoteAmount" field.
+func (m *MatchedTradeMutation) ResetSellPartialQuoteAmount() {
+	m.sellPartialQuoteAmount = nil
+	delete(m.clearedFields, matchedtrade.FieldSellPartialQuoteAmount)
+}
+
+// SetProfit sets the "profit" field.
+func (m *MatchedTradeMutation) SetProfit(f float64) {
+	m.profit = &f
+	m.addprofit = nil
+}
+
+// Profit returns the value of the "profit" field in the mutation.
+func (m *MatchedTradeMutation) Profit() (r float64, exists bool) {
+	v := m.profit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProfit returns the old "profit" field's value of the MatchedTrade entity.
+// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchedTradeMutation) OldProfit(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProfit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProfit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProfit: %w", err)
+	}
+	return oldValue.Profit, nil
+}
+
+// AddProfit adds f to the "profit" field.
+func (m *MatchedTradeMutation) AddProfit(f float64) {
+	if m.addprofit != nil {
+		*m.addprofit += f
+	} else {
+		m.addprofit = &f
+	}
+}
+
+// AddedProfit returns the value that was added to the "profit" field in this mutation.
+func (m *MatchedTradeMutation) AddedProfit() (r float64, exists bool) {
+	v := m.addprofit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearProfit clears the value of the "profit" field.
+func (m *MatchedTradeMutation) ClearProfit() {
+	m.profit = nil
+	m.addprofit = nil
+	m.clearedFields[matchedtrade.FieldProfit] = struct{}{}
+}
+
+// ProfitCleared returns if the "profit" field was cleared in this mutation.
+func (m *MatchedTradeMutation) ProfitCleared() bool {
+	_, ok := m.clearedFields[matchedtrade.FieldProfit]
+	return ok
+}
+
+// ResetProfit resets all changes to the "profit" field.
+func (m *MatchedTradeMutation) ResetProfit() {
+	m.profit = nil
+	m.addprofit = nil
+	delete(m.clearedFields, matchedtrade.FieldProfit)
+}
+
+// SetFee sets the "fee" field.
+func (m *MatchedTradeMutation) SetFee(f float64) {
+	m.fee = &f
+	m.addfee = nil
+}
+
+// Fee returns the value of the "fee" field in the mutation.
+func (m *MatchedTradeMutation) Fee() (r float64, exists bool) {
+	v := m.fee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFee returns the old "fee" field's value of the MatchedTrade entity.
+// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchedTradeMutation) OldFee(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFee is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFee requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFee: %w", err)
+	}
+	return oldValue.Fee, nil
+}
+
+// AddFee adds f to the "fee" field.
+func (m *MatchedTradeMutation) AddFee(f float64) {
+	if m.addfee != nil {
+		*m.addfee += f
+	} else {
+		m.addfee = &f
+	}
+}
+
+// AddedFee returns the value that was added to the "fee" field in this mutation.
+func (m *MatchedTradeMutation) AddedFee() (r float64, exists bool) {
+	v := m.addfee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFee clears the value of the "fee" field.
+func (m *MatchedTradeMutation) ClearFee() {
+	m.fee = nil
+	m.addfee = nil
+	m.clearedFields[matchedtrade.FieldFee] = struct{}{}
+}
+
+// FeeCleared returns if the "fee" field was cleared in this mutation.
+func (m *MatchedTradeMutation) FeeCleared() bool {
+	_, ok := m.clearedFields[matchedtrade.FieldFee]
+	return ok
+}
+
+// ResetFee resets all changes to the "fee" field.
+func (m *MatchedTradeMutation) ResetFee() {
+	m.fee = nil
+	m.addfee = nil
+	delete(m.clearedFields, matchedtrade.FieldFee)
+}
+
+// SetNetProfit sets the "netProfit" field.
+func (m *MatchedTradeMutation) SetNetProfit(f float64) {
+	m.netProfit = &f
+	m.addnetProfit = nil
+}
+
+// NetProfit returns the value of the "netProfit" field in the mutation.
+func (m *MatchedTradeMutation) NetProfit() (r float64, exists bool) {
+	v := m.netProfit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNetProfit returns the old "netProfit" field's value of the MatchedTrade entity.
+// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchedTradeMutation) OldNetProfit(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNetProfit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNetProfit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNetProfit: %w", err)
+	}
+	return oldValue.NetProfit, nil
+}
+
+// AddNetProfit adds f to the "netProfit" field.
+func (m *MatchedTradeMutation) AddNetProfit(f float64) {
+	if m.addnetProfit != nil {
+		*m.addnetProfit += f
+	} else {
+		m.addnetProfit = &f
+	}
+}
+
+// AddedNetProfit returns the value that was added to the "netProfit" field in this mutation.
+func (m *MatchedTradeMutation) AddedNetProfit() (r float64, exists bool) {
+	v := m.addnetProfit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearNetProfit clears the value of the "netProfit" field.
+func (m *MatchedTradeMutation) ClearNetProfit() {
+	m.netProfit = nil
+	m.addnetProfit = nil
+	m.clearedFields[matchedtrade.FieldNetProfit] = struct{}{}
+}
+
+// NetProfitCleared returns if the "netProfit" field was cleared in this mutation.
+func (m *MatchedTradeMutation) NetProfitCleared() bool {
+	_, ok := m.clearedFields[matchedtrade.FieldNetProfit]
+	return ok
+}
+
+// ResetNetProfit resets all changes to the "netProfit" field.
+func (m *MatchedTradeMutation) ResetNetProfit() {
+	m.netProfit = nil
+	m.addnetProfit = nil
+	delete(m.clearedFields, matchedtrade.FieldNetProfit)
+}
+
+// SetRunId sets the "runId" field.
+func (m *MatchedTradeMutation) SetRunId(i int) {
+	m.runId = &i
+	m.addrunId = nil
+}
+
+// RunId returns the value of the "runId" field in the mutation.
+func (m *MatchedTradeMutation) RunId() (r int, exists bool) {
+	v := m.runId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunId returns the old "runId" field's value of the MatchedTrade entity.
+// If the MatchedTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchedTradeMutation) OldRunId(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunId: %w", err)
+	}
+	return oldValue.RunId, nil
+}
+
+// AddRunId adds i to the "runId" field.
+func (m *MatchedTradeMutation) AddRunId(i int) {
+	if m.addrunId != nil {
+		*m.addrunId += i
+	} else {
+		m.addrunId = &i
+	}
+}
+
+// AddedRunId returns the value that was added to the "runId" field in this mutation.
+func (m *MatchedTradeMutation) AddedRunId() (r int, exists bool) {
+	v := m.addrunId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRunId clears the value of the "runId" field.
+func (m *MatchedTradeMutation) ClearRunId() {
+	m.runId = nil
+	m.addrunId = nil
+	m.clearedFields[matchedtrade.FieldRunId] = struct{}{}
+}
+
+// RunIdCleared returns if the "runId" field was cleared in this mutation.
+func (m *MatchedTradeMutation) RunIdCleared() bool {
+	_, ok := m.clearedFields[matchedtrade.FieldRunId]
+	return ok
+}
+
+// ResetRunId resets all changes to the "runId" field.
+func (m *MatchedTradeMutation) ResetRunId() {
+	m.runId = nil
+	m.addrunId = nil
+	delete(m.clearedFields, matchedtrade.FieldRunId)
+}
+
+// Where appends a list predicates to the MatchedTradeMutation builder.
+func (m *MatchedTradeMutation) Where(ps ...predicate.MatchedTrade) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MatchedTradeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MatchedTradeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MatchedTrade, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MatchedTradeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MatchedTradeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MatchedTrade).
+func (m *MatchedTradeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MatchedTradeMutation) Fields() []string {
+	fields := make([]string, 0, 21)
+	if m.create_time != nil {
+		fields = append(fields, matchedtrade.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, matchedtrade.FieldUpdateTime)
+	}
+	if m.strategyId != nil {
+		fields = append(fields, matchedtrade.FieldStrategyId)
+	}
+	if m.account != nil {
+		fields = append(fields, matchedtrade.FieldAccount)
+	}
+	if m.symbol != nil {
+		fields = append(fields, matchedtrade.FieldSymbol)
+	}
+	if m.buyClientOrderId != nil {
+		fields = append(fields, matchedtrade.FieldBuyClientOrderId)
+	}
+	if m.buyBaseAmount != nil {
+		fields = append(fields, matchedtrade.FieldBuyBaseAmount)
+	}
+	if m.buyQuoteAmount != nil {
+		fields = append(fields, matchedtrade.FieldBuyQuoteAmount)
+	}
+	if m.buyOrderTimestamp != nil {
+		fields = append(fields, matchedtrade.FieldBuyOrderTimestamp)
+	}
+	if m.buyPartialBaseAmount != nil {
+		fields = append(fields, matchedtrade.FieldBuyPartialBaseAmount)
+	}
+	if m.buyPartialQuoteAmount != nil {
+		fields = append(fields, matchedtrade.FieldBuyPartialQuoteAmount)
+	}
+	if m.sellClientOrderId != nil {
+		fields = append(fields, matchedtrade.FieldSellClientOrderId)
+	}
+	if m.sellBaseAmount != nil {
+		fields = append(fields, matchedtrade.FieldSellBaseAmount)
+	}
+	if m.sellQuoteAmount != nil {
+		fields = append(fields, matchedtrade.FieldSellQuoteAmount)
+	}
+	if m.sellOrderTimestamp != nil {
+		fields = append(fields, matchedtrade.FieldSellOrderTimestamp)
+	}
+	if m.sellPartialBaseAmount != nil {
+		fields = append(fields, matchedtrade.FieldSellPartialBaseAmount)
+	}
+	if m.sellPartialQuoteAmount != nil {
+		fields = append(fields, matchedtrade.FieldSellPartialQuoteAmount)
+	}
+	if m.profit != nil {
+		fields = append(fields, matchedtrade.FieldProfit)
+	}
+	if m.fee != nil {
+		fields = append(fields, matchedtrade.FieldFee)
+	}
+	if m.netProfit != nil {
+		fields = append(fields, matchedtrade.FieldNetProfit)
+	}
+	if m.runId != nil {
+		fields = append(fields, matchedtrade.FieldRunId)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MatchedTradeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case matchedtrade.FieldCreateTime:
+		return m.CreateTime()
+	case matchedtrade.FieldUpdateTime:
+		return m.UpdateTime()
+	case matchedtrade.FieldStrategyId:
+		return m.StrategyId()
+	case matchedtrade.FieldAccount:
+		return m.Account()
+	case matchedtrade.FieldSymbol:
+		return m.Symbol()
+	case matchedtrade.FieldBuyClientOrderId:
+		return m.BuyClientOrderId()
+	case matchedtrade.FieldBuyBaseAmount:
+		return m.BuyBaseAmount()
+	case matchedtrade.FieldBuyQuoteAmount:
+		return m.BuyQuoteAmount()
+	case matchedtrade.FieldBuyOrderTimestamp:
+		return m.BuyOrderTimestamp()
+	case matchedtrade.FieldBuyPartialBaseAmount:
+		return m.BuyPartialBaseAmount()
+	case matchedtrade.FieldBuyPartialQuoteAmount:
+		return m.BuyPartialQuoteAmount()
+	case matchedtrade.FieldSellClientOrderId:
+		return m.SellClientOrderId()
+	case matchedtrade.FieldSellBaseAmount:
+		return m.SellBaseAmount()
+	case matchedtrade.FieldSellQuoteAmount:
+		return m.SellQuoteAmount()
+	case matchedtrade.FieldSellOrderTimestamp:
+		return m.SellOrderTimestamp()
+	case matchedtrade.FieldSellPartialBaseAmount:
+		return m.SellPartialBaseAmount()
+	case matchedtrade.FieldSellPartialQuoteAmount:
+		return m.SellPartialQuoteAmount()
+	case matchedtrade.FieldProfit:
+		return m.Profit()
+	case matchedtrade.FieldFee:
+		return m.Fee()
+	case matchedtrade.FieldNetProfit:
+		return m.NetProfit()
+	case matchedtrade.FieldRunId:
+		return m.RunId()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MatchedTradeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case matchedtrade.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case matchedtrade.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case matchedtrade.FieldStrategyId:
+		return m.OldStrategyId(ctx)
+	case matchedtrade.FieldAccount:
+		return m.OldAccount(ctx)
+	case matchedtrade.FieldSymbol:
+		return m.OldSymbol(ctx)
+	case matchedtrade.FieldBuyClientOrderId:
+		return m.OldBuyClientOrderId(ctx)
+	case matchedtrade.FieldBuyBaseAmount:
+		return m.OldBuyBaseAmount(ctx)
+	case matchedtrade.FieldBuyQuoteAmount:
+		return m.OldBuyQuoteAmount(ctx)
+	case matchedtrade.FieldBuyOrderTimestamp:
+		return m.OldBuyOrderTimestamp(ctx)
+	case matchedtrade.FieldBuyPartialBaseAmount:
+		return m.OldBuyPartialBaseAmount(ctx)
+	case matchedtrade.FieldBuyPartialQuoteAmount:
+		return m.OldBuyPartialQuoteAmount(ctx)
+	case matchedtrade.FieldSellClientOrderId:
+		return m.OldSellClientOrderId(ctx)
+	case matchedtrade.FieldSellBaseAmount:
+		return m.OldSellBaseAmount(ctx)
+	case matchedtrade.FieldSellQuoteAmount:
+		return m.OldSellQuoteAmount(ctx)
+	case matchedtrade.FieldSellOrderTimestamp:
+		return m.OldSellOrderTimestamp(ctx)
+	case matchedtrade.FieldSellPartialBaseAmount:
+		return m.OldSellPartialBaseAmount(ctx)
+	case matchedtrade.FieldSellPartialQuoteAmount:
+		return m.OldSellPartialQuoteAmount(ctx)
+	case matchedtrade.FieldProfit:
+		return m.OldProfit(ctx)
+	case matchedtrade.FieldFee:
+		return m.OldFee(ctx)
+	case matchedtrade.FieldNetProfit:
+		return m.OldNetProfit(ctx)
+	case matchedtrade.FieldRunId:
+		return m.OldRunId(ctx)
+	}
+	return nil, fmt.Errorf("unknown MatchedTrade field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MatchedTradeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case matchedtrade.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case matchedtrade.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case matchedtrade.FieldStrategyId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStrategyId(v)
+		return nil
+	case matchedtrade.FieldAccount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccount(v)
+		return nil
+	case matchedtrade.FieldSymbol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSymbol(v)
+		return nil
+	case matchedtrade.FieldBuyClientOrderId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuyClientOrderId(v)
+		return nil
+	case matchedtrade.FieldBuyBaseAmount:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuyBaseAmount(v)
+		return nil
+	case matchedtrade.FieldBuyQuoteAmount:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuyQuoteAmount(v)
+		return nil
+	case matchedtrade.FieldBuyOrderTimestamp:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuyOrderTimestamp(v)
+		return nil
+	case matchedtrade.FieldBuyPartialBaseAmount:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuyPartialBaseAmount(v)
+		return nil
+	case matchedtrade.FieldBuyPartialQuoteAmount:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuyPartialQuoteAmount(v)
+		return nil
+	case matchedtrade.FieldSellClientOrderId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSellClientOrderId(v)
+		return nil
+	case matchedtrade.FieldSellBaseAmount:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSellBaseAmount(v)
+		return nil
+	case matchedtrade.FieldSellQuoteAmount:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSellQuoteAmount(v)
+		return nil
+	case matchedtrade.FieldSellOrderTimestamp:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSellOrderTimestamp(v)
+		return nil
+	case matchedtrade.FieldSellPartialBaseAmount:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSellPartialBaseAmount(v)
+		return nil
+	case matchedtrade.FieldSellPartialQuoteAmount:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSellPartialQuoteAmount(v)
+		return nil
+	case matchedtrade.FieldProfit:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProfit(v)
+		return nil
+	case matchedtrade.FieldFee:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFee(v)
+		return nil
+	case matchedtrade.FieldNetProfit:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNetProfit(v)
+		return nil
+	case matchedtrade.FieldRunId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunId(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MatchedTrade field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MatchedTradeMutation) AddedFields() []string {
+	var fields []string
+	if m.addbuyOrderTimestamp != nil {
+		fields = append(fields, matchedtrade.FieldBuyOrderTimestamp)
+	}
+	if m.addsellOrderTimestamp != nil {
+		fields = append(fields, matchedtrade.FieldSellOrderTimestamp)
+	}
+	if m.addprofit != nil {
+		fields = append(fields, matchedtrade.FieldProfit)
+	}
+	if m.addfee != nil {
+		fields = append(fields, matchedtrade.FieldFee)
+	}
+	if m.addnetProfit != nil {
+		fields = append(fields, matchedtrade.FieldNetProfit)
+	}
+	if m.addrunId != nil {
+		fields = append(fields, matchedtrade.FieldRunId)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MatchedTradeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case matchedtrade.FieldBuyOrderTimestamp:
+		return m.AddedBuyOrderTimestamp()
+	case matchedtrade.FieldSellOrderTimestamp:
+		return m.AddedSellOrderTimestamp()
+	case matchedtrade.FieldProfit:
+		return m.AddedProfit()
+	case matchedtrade.FieldFee:
+		return m.AddedFee()
+	case matchedtrade.FieldNetProfit:
+		return m.AddedNetProfit()
+	case matchedtrade.FieldRunId:
+		return m.AddedRunId()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MatchedTradeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case matchedtrade.FieldBuyOrderTimestamp:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBuyOrderTimestamp(v)
+		return nil
+	case matchedtrade.FieldSellOrderTimestamp:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSellOrderTimestamp(v)
+		return nil
+	case matchedtrade.FieldProfit:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProfit(v)
+		return nil
+	case matchedtrade.FieldFee:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFee(v)
+		return nil
+	case matchedtrade.FieldNetProfit:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNetProfit(v)
+		return nil
+	case matchedtrade.FieldRunId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRunId(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MatchedTrade numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MatchedTradeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(matchedtrade.FieldBuyClientOrderId) {
+		fields = append(fields, matchedtrade.FieldBuyClientOrderId)
+	}
+	if m.FieldCleared(matchedtrade.FieldBuyBaseAmount) {
+		fields = append(fields, matchedtrade.FieldBuyBaseAmount)
+	}
+	if m.FieldCleared(matchedtrade.FieldBuyQuoteAmount) {
+		fields = append(fields, matchedtrade.FieldBuyQuoteAmount)
+	}
+	if m.FieldCleared(matchedtrade.FieldBuyOrderTimestamp) {
+		fields = append(fields, matchedtrade.FieldBuyOrderTimestamp)
+	}
+	if m.FieldCleared(matchedtrade.FieldBuyPartialBaseAmount) {
+		fields = append(fields, matchedtrade.FieldBuyPartialBaseAmount)
+	}
+	if m.FieldCleared(matchedtrade.FieldBuyPartialQuoteAmount) {
+		fields = append(fields, matchedtrade.FieldBuyPartialQuoteAmount)
+	}
+	if m.FieldCleared(matchedtrade.FieldSellClientOrderId) {
+		fields = append(fields, matchedtrade.FieldSellClientOrderId)
+	}
+	if m.FieldCleared(matchedtrade.FieldSellBaseAmount) {
+		fields = append(fields, matchedtrade.FieldSellBaseAmount)
+	}
+	if m.FieldCleared(matchedtrade.FieldSellQuoteAmount) {
+		fields = append(fields, matchedtrade.FieldSellQuoteAmount)
+	}
+	if m.FieldCleared(matchedtrade.FieldSellOrderTimestamp) {
+		fields = append(fields, matchedtrade.FieldSellOrderTimestamp)
+	}
+	if m.FieldCleared(matchedtrade.FieldSellPartialBaseAmount) {
+		fields = append(fields, matchedtrade.FieldSellPartialBaseAmount)
+	}
+	if m.FieldCleared(matchedtrade.FieldSellPartialQuoteAmount) {
+		fields = append(fields, matchedtrade.FieldSellPartialQuoteAmount)
+	}
+	if m.FieldCleared(matchedtrade.FieldProfit) {
+		fields = append(fields, matchedtrade.FieldProfit)
+	}
+	if m.FieldCleared(matchedtrade.FieldFee) {
+		fields = append(fields, matchedtrade.FieldFee)
+	}
+	if m.FieldCleared(matchedtrade.FieldNetProfit) {
+		fields = append(fields, matchedtrade.FieldNetProfit)
+	}
+	if m.FieldCleared(matchedtrade.FieldRunId) {
+		fields = append(fields, matchedtrade.FieldRunId)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MatchedTradeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MatchedTradeMutation) ClearField(name string) error {
+	switch name {
+	case matchedtrade.FieldBuyClientOrderId:
+		m.ClearBuyClientOrderId()
+		return nil
+	case matchedtrade.FieldBuyBaseAmount:
+		m.ClearBuyBaseAmount()
+		return nil
+	case matchedtrade.FieldBuyQuoteAmount:
+		m.ClearBuyQuoteAmount()
+		return nil
+	case matchedtrade.FieldBuyOrderTimestamp:
+		m.ClearBuyOrderTimestamp()
+		return nil
+	case matchedtrade.FieldBuyPartialBaseAmount:
+		m.ClearBuyPartialBaseAmount()
+		return nil
+	case matchedtrade.FieldBuyPartialQuoteAmount:
+		m.ClearBuyPartialQuoteAmount()
+		return nil
+	case matchedtrade.FieldSellClientOrderId:
+		m.ClearSellClientOrderId()
+		return nil
+	case matchedtrade.FieldSellBaseAmount:
+		m.ClearSellBaseAmount()
+		return nil
+	case matchedtrade.FieldSellQuoteAmount:
+		m.ClearSellQuoteAmount()
+		return nil
+	case matchedtrade.FieldSellOrderTimestamp:
+		m.ClearSellOrderTimestamp()
+		return nil
+	case matchedtrade.FieldSellPartialBaseAmount:
+		m.ClearSellPartialBaseAmount()
+		return nil
+	case matchedtrade.FieldSellPartialQuoteAmount:
+		m.ClearSellPartialQuoteAmount()
+		return nil
+	case matchedtrade.FieldProfit:
+		m.ClearProfit()
+		return nil
+	case matchedtrade.FieldFee:
+		m.ClearFee()
+		return nil
+	case matchedtrade.FieldNetProfit:
+		m.ClearNetProfit()
+		return nil
+	case matchedtrade.FieldRunId:
+		m.ClearRunId()
+		return nil
+	}
+	return fmt.Errorf("unknown MatchedTrade nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MatchedTradeMutation) ResetField(name string) error {
+	switch name {
+	case matchedtrade.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case matchedtrade.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case matchedtrade.FieldStrategyId:
+		m.ResetStrategyId()
+		return nil
+	case matchedtrade.FieldAccount:
+		m.ResetAccount()
+		return nil
+	case matchedtrade.FieldSymbol:
+		m.ResetSymbol()
+		return nil
+	case matchedtrade.FieldBuyClientOrderId:
+		m.ResetBuyClientOrderId()
+		return nil
+	case matchedtrade.FieldBuyBaseAmount:
+		m.ResetBuyBaseAmount()
+		return nil
+	case matchedtrade.FieldBuyQuoteAmount:
+		m.ResetBuyQuoteAmount()
+		return nil
+	case matchedtrade.FieldBuyOrderTimestamp:
+		m.ResetBuyOrderTimestamp()
+		return nil
+	case matchedtrade.FieldBuyPartialBaseAmount:
+		m.ResetBuyPartialBaseAmount()
+		return nil
+	case matchedtrade.FieldBuyPartialQuoteAmount:
+		m.ResetBuyPartialQuoteAmount()
+		return nil
+	case matchedtrade.FieldSellClientOrderId:
+		m.ResetSellClientOrderId()
+		return nil
+	case matchedtrade.FieldSellBaseAmount:
+		m.ResetSellBaseAmount()
+		return nil
+	case matchedtrade.FieldSellQuoteAmount:
+		m.ResetSellQuoteAmount()
+		return nil
+	case matchedtrade.FieldSellOrderTimestamp:
+		m.ResetSellOrderTimestamp()
+		return nil
+	case matchedtrade.FieldSellPartialBaseAmount:
+		m.ResetSellPartialBaseAmount()
+		return nil
+	case matchedtrade.FieldSellPartialQuoteAmount:
+		m.ResetSellPartialQuoteAmount()
+		return nil
+	case matchedtrade.FieldProfit:
+		m.ResetProfit()
+		return nil
+	case matchedtrade.FieldFee:
+		m.ResetFee()
+		return nil
+	case matchedtrade.FieldNetProfit:
+		m.ResetNetProfit()
+		return nil
+	case matchedtrade.FieldRunId:
+		m.ResetRunId()
+		return nil
+	}
+	return fmt.Errorf("unknown MatchedTrade field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MatchedTradeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MatchedTradeMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MatchedTradeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MatchedTradeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MatchedTradeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MatchedTradeMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MatchedTradeMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MatchedTrade unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MatchedTradeMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MatchedTrade edge %s", name)
+}
+
+// OrderMutation represents an operation that mutates the Order nodes in the graph.
+type OrderMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	create_time       *time.Time
+	update_time       *time.Time
+	exchange          *string
+	account           *string
+	symbol            *string
+	orderId           *string
+	clientOrderId     *string
+	side              *order.Side
+	price             *decimal.Decimal
+	baseAmount        *decimal.Decimal
+	filledBaseAmount  *decimal.Decimal
+	filledQuoteAmount *decimal.Decimal
+	status            *order.Status
+	timestamp         *int64
+	addtimestamp      *int64
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*Order, error)
+	predicates        []predicate.Order
+}
+
+var _ ent.Mutation = (*OrderMutation)(nil)
+
+// orderOption allows management of the mutation configuration using functional options.
+type orderOption func(*OrderMutation)
+
+// newOrderMutation creates new mutation for the Order entity.
+func newOrderMutation(c config, op Op, opts ...orderOption) *OrderMutation {
+	m := &OrderMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOrder,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOrderID sets the ID field of the mutation.
+func withOrderID(id int) orderOption {
+	return func(m *OrderMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Order
+		)
+		m.oldValue = func(ctx context.Context) (*Order, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Order.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOrder sets the old Order of the mutation.
+func withOrder(node *Order) orderOption {
+	return func(m *OrderMutation) {
+		m.oldValue = func(context.Context) (*Order, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OrderMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OrderMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OrderMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OrderMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Order.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *OrderMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *OrderMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *OrderMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *OrderMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *OrderMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *OrderMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetExchange sets the "exchange" field.
+func (m *OrderMutation) SetExchange(s string) {
+	m.exchange = &s
+}
+
+// Exchange returns the value of the "exchange" field in the mutation.
+func (m *OrderMutation) Exchange() (r string, exists bool) {
+	v := m.exchange
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExchange returns the old "exchange" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldExchange(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExchange is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExchange requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExchange: %w", err)
+	}
+	return oldValue.Exchange, nil
+}
+
+// ResetExchange resets all changes to the "exchange" field.
+func (m *OrderMutation) ResetExchange() {
+	m.exchange = nil
+}
+
+// SetAccount sets the "account" field.
+func (m *OrderMutation) SetAccount(s string) {
+	m.account = &s
+}
+
+// Account returns the value of the "account" field in the mutation.
+func (m *OrderMutation) Account() (r string, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccount returns the old "account" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldAccount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccount: %w", err)
+	}
+	return oldValue.Account, nil
+}
+
+// ResetAccount resets all changes to the "account" field.
+func (m *OrderMutation) ResetAccount() {
+	m.account = nil
+}
+
+// SetSymbol sets the "symbol" field.
+func (m *OrderMutation) SetSymbol(s string) {
+	m.symbol = &s
+}
+
+// Symbol returns the value of the "symbol" field in the mutation.
+func (m *OrderMutation) Symbol() (r string, exists bool) {
+	v := m.symbol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSymbol returns the old "symbol" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldSymbol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSymbol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+	}
+	return oldValue.Symbol, nil
+}
+
+// ResetSymbol resets all changes to the "symbol" field.
+func (m *OrderMutation) ResetSymbol() {
+	m.symbol = nil
+}
+
+// SetOrderId sets the "orderId" field.
+func (m *OrderMutation) SetOrderId(s string) {
+	m.orderId = &s
+}
+
+// OrderId returns the value of the "orderId" field in the mutation.
+func (m *OrderMutation) OrderId() (r string, exists bool) {
+	v := m.orderId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrderId returns the old "orderId" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldOrderId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrderId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrderId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrderId: %w", err)
+	}
+	return oldValue.OrderId, nil
+}
+
+// ResetOrderId resets all changes to the "orderId" field.
+func (m *OrderMutation) ResetOrderId() {
+	m.orderId = nil
+}
+
+// SetClientOrderId sets the "clientOrderId" field.
+func (m *OrderMutation) SetClientOrderId(s string) {
+	m.clientOrderId = &s
+}
+
+// ClientOrderId returns the value of the "clientOrderId" field in the mutation.
+func (m *OrderMutation) ClientOrderId() (r string, exists bool) {
+	v := m.clientOrderId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientOrderId returns the old "clientOrderId" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldClientOrderId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientOrderId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientOrderId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientOrderId: %w", err)
+	}
+	return oldValue.ClientOrderId, nil
+}
+
+// ResetClientOrderId resets all changes to the "clientOrderId" field.
+func (m *OrderMutation) ResetClientOrderId() {
+	m.clientOrderId = nil
+}
+
+// SetSide sets the "side" field.
+func (m *OrderMutation) SetSide(o order.Side) {
+	m.side = &o
+}
+
+// Side returns the value of the "side" field in the mutation.
+func (m *OrderMutation) Side() (r order.Side, exists bool) {
+	v := m.side
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSide returns the old "side" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldSide(ctx context.Context) (v order.Side, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSide is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSide requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSide: %w", err)
+	}
+	return oldValue.Side, nil
+}
+
+// ResetSide resets all changes to the "side" field.
+func (m *OrderMutation) ResetSide() {
+	m.side = nil
+}
+
+// SetPrice sets the "price" field.
+func (m *OrderMutation) SetPrice(d decimal.Decimal) {
+	m.price = &d
+}
+
+// Price returns the value of the "price" field in the mutation.
+func (m *OrderMutation) Price() (r decimal.Decimal, exists bool) {
+	v := m.price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrice returns the old "price" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldPrice(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+	}
+	return oldValue.Price, nil
+}
+
+// ResetPrice resets all changes to the "price" field.
+func (m *OrderMutation) ResetPrice() {
+	m.price = nil
+}
+
+// SetBaseAmount sets the "baseAmount" field.
+func (m *OrderMutation) SetBaseAmount(d decimal.Decimal) {
+	m.baseAmount = &d
+}
+
+// BaseAmount returns the value of the "baseAmount" field in the mutation.
+func (m *OrderMutation) BaseAmount() (r decimal.Decimal, exists bool) {
+	v := m.baseAmount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBaseAmount returns the old "baseAmount" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldBaseAmount(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBaseAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBaseAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBaseAmount: %w", err)
+	}
+	return oldValue.BaseAmount, nil
+}
+
+// ResetBaseAmount resets all changes to the "baseAmount" field.
+func (m *OrderMutation) ResetBaseAmount() {
+	m.baseAmount = nil
+}
+
+// SetFilledBaseAmount sets the "filledBaseAmount" field.
+func (m *OrderMutation) SetFilledBaseAmount(d decimal.Decimal) {
+	m.filledBaseAmount = &d
+}
+
+// FilledBaseAmount returns the value of the "filledBaseAmount" field in the mutation.
+func (m *OrderMutation) FilledBaseAmount() (r decimal.Decimal, exists bool) {
+	v := m.filledBaseAmount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilledBaseAmount returns the old "filledBaseAmount" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldFilledBaseAmount(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilledBaseAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilledBaseAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilledBaseAmount: %w", err)
+	}
+	return oldValue.FilledBaseAmount, nil
+}
+
+// ResetFilledBaseAmount resets all changes to the "filledBaseAmount" field.
+func (m *OrderMutation) ResetFilledBaseAmount() {
+	m.filledBaseAmount = nil
+}
+
+// SetFilledQuoteAmount sets the "filledQuoteAmount" field.
+func (m *OrderMutation) SetFilledQuoteAmount(d decimal.Decimal) {
+	m.filledQuoteAmount = &d
+}
+
+// FilledQuoteAmount returns the value of the "filledQuoteAmount" field in the mutation.
+func (m *OrderMutation) FilledQuoteAmount() (r decimal.Decimal, exists bool) {
+	v := m.filledQuoteAmount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilledQuoteAmount returns the old "filledQuoteAmount" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldFilledQuoteAmount(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilledQuoteAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilledQuoteAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilledQuoteAmount: %w", err)
+	}
+	return oldValue.FilledQuoteAmount, nil
+}
+
+// ResetFilledQuoteAmount resets all changes to the "filledQuoteAmount" field.
+func (m *OrderMutation) ResetFilledQuoteAmount() {
+	m.filledQuoteAmount = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *OrderMutation) SetStatus(o order.Status) {
+	m.status = &o
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *OrderMutation) Status() (r order.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldStatus(ctx context.Context) (v order.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *OrderMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (m *OrderMutation) SetTimestamp(i int64) {
+	m.timestamp = &i
+	m.addtimestamp = nil
+}
+
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *OrderMutation) Timestamp() (r int64, exists bool) {
+	v := m.timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimestamp returns the old "timestamp" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldTimestamp(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+	}
+	return oldValue.Timestamp, nil
+}
+
+// AddTimestamp adds i to the "timestamp" field.
+func (m *OrderMutation) AddTimestamp(i int64) {
+	if m.addtimestamp != nil {
+		*m.addtimestamp += i
+	} else {
+		m.addtimestamp = &i
+	}
+}
+
+// AddedTimestamp returns the value that was added to the "timestamp" field in this mutation.
+func (m *OrderMutation) AddedTimestamp() (r int64, exists bool) {
+	v := m.addtimestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *OrderMutation) ResetTimestamp() {
+	m.timestamp = nil
+	m.addtimestamp = nil
+}
+
+// Where appends a list predicates to the OrderMutation builder.
+func (m *OrderMutation) Where(ps ...predicate.Order) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OrderMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OrderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Order, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OrderMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OrderMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Order).
+func (m *OrderMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OrderMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.create_time != nil {
+		fields = append(fields, order.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, order.FieldUpdateTime)
+	}
+	if m.exchange != nil {
+		fields = append(fields, order.FieldExchange)
+	}
+	if m.account != nil {
+		fields = append(fields, order.FieldAccount)
+	}
+	if m.symbol != nil {
+		fields = append(fields, order.FieldSymbol)
+	}
+	if m.orderId != nil {
+		fields = append(fields, order.FieldOrderId)
+	}
+	if m.clientOrderId != nil {
+		fields = append(fields, order.FieldClientOrderId)
+	}
+	if m.side != nil {
+		fields = append(fields, order.FieldSide)
+	}
+	if m.price != nil {
+		fields = append(fields, order.FieldPrice)
+	}
+	if m.baseAmount != nil {
+		fields = append(fields, order.FieldBaseAmount)
+	}
+	if m.filledBaseAmount != nil {
+		fields = append(fields, order.FieldFilledBaseAmount)
+	}
+	if m.filledQuoteAmount != nil {
+		fields = append(fields, order.FieldFilledQuoteAmount)
+	}
+	if m.status != nil {
+		fields = append(fields, order.FieldStatus)
+	}
+	if m.timestamp != nil {
+		fields = append(fields, order.FieldTimestamp)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OrderMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case order.FieldCreateTime:
+		return m.CreateTime()
+	case order.FieldUpdateTime:
+		return m.UpdateTime()
+	case order.FieldExchange:
+		return m.Exchange()
+	case order.FieldAccount:
+		return m.Account()
+	case order.FieldSymbol:
+		return m.Symbol()
+	case order.FieldOrderId:
+		return m.OrderId()
+	case order.FieldClientOrderId:
+		return m.ClientOrderId()
+	case order.FieldSide:
+		return m.Side()
+	case order.FieldPrice:
+		return m.Price()
+	case order.FieldBaseAmount:
+		return m.BaseAmount()
+	case order.FieldFilledBaseAmount:
+		return m.FilledBaseAmount()
+	case order.FieldFilledQuoteAmount:
+		return m.FilledQuoteAmount()
+	case order.FieldStatus:
+		return m.Status()
+	case order.FieldTimestamp:
+		return m.Timestamp()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OrderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case order.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case order.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case order.FieldExchange:
+		return m.OldExchange(ctx)
+	case order.FieldAccount:
+		return m.OldAccount(ctx)
+	case order.FieldSymbol:
+		return m.OldSymbol(ctx)
+	case order.FieldOrderId:
+		return m.OldOrderId(ctx)
+	case order.FieldClientOrderId:
+		return m.OldClientOrderId(ctx)
+	case order.FieldSide:
+		return m.OldSide(ctx)
+	case order.FieldPrice:
+		return m.OldPrice(ctx)
+	case order.FieldBaseAmount:
+		return m.OldBaseAmount(ctx)
+	case order.FieldFilledBaseAmount:
+		return m.OldFilledBaseAmount(ctx)
+	case order.FieldFilledQuoteAmount:
+		return m.OldFilledQuoteAmount(ctx)
+	case order.FieldStatus:
+		return m.OldStatus(ctx)
+	case order.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	}
+	return nil, fmt.Errorf("unknown Order field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrderMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case order.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case order.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case order.FieldExchange:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExchange(v)
+		return nil
+	case order.FieldAccount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccount(v)
+		return nil
+	case order.FieldSymbol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSymbol(v)
+		return nil
+	case order.FieldOrderId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrderId(v)
+		return nil
+	case order.FieldClientOrderId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientOrderId(v)
+		return nil
+	case order.FieldSide:
+		v, ok := value.(order.Side)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSide(v)
+		return nil
+	case order.FieldPrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case order.FieldBaseAmount:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBaseAmount(v)
+		return nil
+	case order.FieldFilledBaseAmount:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilledBaseAmount(v)
+		return nil
+	case order.FieldFilledQuoteAmount:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilledQuoteAmount(v)
+		return nil
+	case order.FieldStatus:
+		v, ok := value.(order.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case order.FieldTimestamp:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Order field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OrderMutation) AddedFields() []string {
+	var fields []string
+	if m.addtimestamp != nil {
+		fields = append(fields, order.FieldTimestamp)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OrderMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case order.FieldTimestamp:
+		return m.AddedTimestamp()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrderMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case order.FieldTimestamp:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTimestamp(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Order numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OrderMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OrderMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OrderMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Order nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OrderMutation) ResetField(name string) error {
+	switch name {
+	case order.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case order.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case order.FieldExchange:
+		m.ResetExchange()
+		return nil
+	case order.FieldAccount:
+		m.ResetAccount()
+		return nil
+	case order.FieldSymbol:
+		m.ResetSymbol()
+		return nil
+	case order.FieldOrderId:
+		m.ResetOrderId()
+		return nil
+	case order.FieldClientOrderId:
+		m.ResetClientOrderId()
+		return nil
+	case order.FieldSide:
+		m.ResetSide()
+		return nil
+	case order.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case order.FieldBaseAmount:
+		m.ResetBaseAmount()
+		return nil
+	case order.FieldFilledBaseAmount:
+		m.ResetFilledBaseAmount()
+		return nil
+	case order.FieldFilledQuoteAmount:
+		m.ResetFilledQuoteAmount()
+		return nil
+	case order.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case order.FieldTimestamp:
+		m.ResetTimestamp()
+		return nil
+	}
+	return fmt.Errorf("unknown Order field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OrderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OrderMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OrderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OrderMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OrderMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OrderMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OrderMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Order unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OrderMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Order edge %s", name)
+}
+
+// OrderRepairMutation represents an operation that mutates the OrderRepair nodes in the graph.
+type OrderRepairMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	create_time           *time.Time
+	update_time           *time.Time
+	strategyId            *string
+	exchange              *string
+	symbol                *string
+	account               *string
+	level                 *int
+	addlevel              *int
+	side                  *orderrepair.Side
+	price                 *decimal.Decimal
+	quantity              *decimal.Decimal
+	canceledClientOrderId *string
+	newClientOrderId      *string
+	action                *orderrepair.Action
+	attempt               *int
+	addattempt            *int
+	reason                *string
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*OrderRepair, error)
+	predicates            []predicate.OrderRepair
+}
+
+var _ ent.Mutation = (*OrderRepairMutation)(nil)
+
+// orderrepairOption allows management of the mutation configuration using functional options.
+type orderrepairOption func(*OrderRepairMutation)
+
+// newOrderRepairMutation creates new mutation for the OrderRepair entity.
+func newOrderRepairMutation(c config, op Op, opts ...orderrepairOption) *OrderRepairMutation {
+	m := &OrderRepairMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOrderRepair,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOrderRepairID sets the ID field of the mutation.
+func withOrderRepairID(id int) orderrepairOption {
+	return func(m *OrderRepairMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OrderRepair
+		)
+		m.oldValue = func(ctx context.Context) (*OrderRepair, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OrderRepair.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOrderRepair sets the old OrderRepair of the mutation.
+func withOrderRepair(node *OrderRepair) orderrepairOption {
+	return func(m *OrderRepairMutation) {
+		m.oldValue = func(context.Context) (*OrderRepair, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OrderRepairMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OrderRepairMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OrderRepairMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OrderRepairMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OrderRepair.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *OrderRepairMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *OrderRepairMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the OrderRepair entity.
+// If the OrderRepair object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderRepairMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *OrderRepairMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *OrderRepairMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *OrderRepairMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the OrderRepair entity.
+// If the OrderRepair object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderRepairMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *OrderRepairMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetStrategyId sets the "strategyId" field.
+func (m *OrderRepairMutation) SetStrategyId(s string) {
+	m.strategyId = &s
+}
+
+// StrategyId returns the value of the "strategyId" field in the mutation.
+func (m *OrderRepairMutation) StrategyId() (r string, exists bool) {
+	v := m.strategyId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStrategyId returns the old "strategyId" field's value of the OrderRepair entity.
+// If the OrderRepair object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderRepairMutation) OldStrategyId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStrategyId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStrategyId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStrategyId: %w", err)
+	}
+	return oldValue.StrategyId, nil
+}
+
+// ResetStrategyId resets all changes to the "strategyId" field.
+func (m *OrderRepairMutation) ResetStrategyId() {
+	m.strategyId = nil
+}
+
+// SetExchange sets the "exchange" field.
+func (m *OrderRepairMutation) SetExchange(s string) {
+	m.exchange = &s
+}
+
+// Exchange returns the value of the "exchange" field in the mutation.
+func (m *OrderRepairMutation) Exchange() (r string, exists bool) {
+	v := m.exchange
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExchange returns the old "exchange" field's value of the OrderRepair entity.
+// If the OrderRepair object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderRepairMutation) OldExchange(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExchange is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExchange requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExchange: %w", err)
+	}
+	return oldValue.Exchange, nil
+}
+
+// ResetExchange resets all changes to the "exchange" field.
+func (m *OrderRepairMutation) ResetExchange() {
+	m.exchange = nil
+}
+
+// SetSymbol sets the "symbol" field.
+func (m *OrderRepairMutation) SetSymbol(s string) {
+	m.symbol = &s
+}
+
+// Symbol returns the value of the "symbol" field in the mutation.
+func (m *OrderRepairMutation) Symbol() (r string, exists bool) {
+	v := m.symbol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSymbol returns the old "symbol" field's value of the OrderRepair entity.
+// If the OrderRepair object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderRepairMutation) OldSymbol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSymbol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+	}
+	return oldValue.Symbol, nil
+}
+
+// ResetSymbol resets all changes to the "symbol" field.
+func (m *OrderRepairMutation) ResetSymbol() {
+	m.symbol = nil
+}
+
+// SetAccount sets the "account" field.
+func (m *OrderRepairMutation) SetAccount(s string) {
+	m.account = &s
+}
+
+// Account returns the value of the "account" field in the mutation.
+func (m *OrderRepairMutation) Account() (r string, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccount returns the old "account" field's value of the OrderRepair entity.
+// If the OrderRepair object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderRepairMutation) OldAccount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccount: %w", err)
+	}
+	return oldValue.Account, nil
+}
+
+// ResetAccount resets all changes to the "account" field.
+func (m *OrderRepairMutation) ResetAccount() {
+	m.account = nil
+}
+
+// SetLevel sets the "level" field.
+func (m *OrderRepairMutation) SetLevel(i int) {
+	m.level = &i
+	m.addlevel = nil
+}
+
+// Level returns the value of the "level" field in the mutation.
+func (m *OrderRepairMutation) Level() (r int, exists bool) {
+	v := m.level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLevel returns the old "level" field's value of the OrderRepair entity.
+// If the OrderRepair object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderRepairMutation) OldLevel(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLevel: %w", err)
+	}
+	return oldValue.Level, nil
+}
+
+// AddLevel adds i to the "level" field.
+func (m *OrderRepairMutation) AddLevel(i int) {
+	if m.addlevel != nil {
+		*m.addlevel += i
+	} else {
+		m.addlevel = &i
+	}
+}
+
+// AddedLevel returns the value that was added to the "level" field in this mutation.
+func (m *OrderRepairMutation) AddedLevel() (r int, exists bool) {
+	v := m.addlevel
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLevel resets all changes to the "level" field.
+func (m *OrderRepairMutation) ResetLevel() {
+	m.level = nil
+	m.addlevel = nil
+}
+
+// SetSide sets the "side" field.
+func (m *OrderRepairMutation) SetSide(o orderrepair.Side) {
+	m.side = &o
+}
+
+// Side returns the value of the "side" field in the mutation.
+func (m *OrderRepairMutation) Side() (r orderrepair.Side, exists bool) {
+	v := m.side
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSide returns the old "side" field's value of the OrderRepair entity.
+// If the OrderRepair object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderRepairMutation) OldSide(ctx context.Context) (v orderrepair.Side, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSide is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSide requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSide: %w", err)
+	}
+	return oldValue.Side, nil
+}
+
+// ResetSide resets all changes to the "side" field.
+func (m *OrderRepairMutation) ResetSide() {
+	m.side = nil
+}
+
+// SetPrice sets the "price" field.
+func (m *OrderRepairMutation) SetPrice(d decimal.Decimal) {
+	m.price = &d
+}
+
+// Price returns the value of the "price" field in the mutation.
+func (m *OrderRepairMutation) Price() (r decimal.Decimal, exists bool) {
+	v := m.price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrice returns the old "price" field's value of the OrderRepair entity.
+// If the OrderRepair object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderRepairMutation) OldPrice(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+	}
+	return oldValue.Price, nil
+}
+
+// ResetPrice resets all changes to the "price" field.
+func (m *OrderRepairMutation) ResetPrice() {
+	m.price = nil
+}
+
+// SetQuantity sets the "quantity" field.
+func (m *OrderRepairMutation) SetQuantity(d decimal.Decimal) {
+	m.quantity = &d
+}
+
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *OrderRepairMutation) Quantity() (r decimal.Decimal, exists bool) {
+	v := m.quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuantity returns the old "quantity" field's value of the OrderRepair entity.
+// If the OrderRepair object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderRepairMutation) OldQuantity(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+	}
+	return oldValue.Quantity, nil
+}
+
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *OrderRepairMutation) ResetQuantity() {
+	m.quantity = nil
+}
+
+// SetCanceledClientOrderId sets the "canceledClientOrderId" field.
+func (m *OrderRepairMutation) SetCanceledClientOrderId(s string) {
+	m.canceledClientOrderId = &s
+}
+
+// CanceledClientOrderId returns the value of the "canceledClientOrderId" field in the mutation.
+func (m *OrderRepairMutation) CanceledClientOrderId() (r string, exists bool) {
+	v := m.canceledClientOrderId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCanceledClientOrderId returns the old "canceledClientOrderId" field's value of the OrderRepair entity.
+// If the OrderRepair object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderRepairMutation) OldCanceledClientOrderId(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCanceledClientOrderId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCanceledClientOrderId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCanceledClientOrderId: %w", err)
+	}
+	return oldValue.CanceledClientOrderId, nil
+}
+
+// ResetCanceledClientOrderId resets all changes to the "canceledClientOrderId" field.
+func (m *OrderRepairMutation) ResetCanceledClientOrderId() {
+	m.canceledClientOrderId = nil
+}
+
+// SetNewClientOrderId sets the "newClientOrderId" field.
+func (m *OrderRepairMutation) SetNewClientOrderId(s string) {
+	m.newClientOrderId = &s
+}
+
+// NewClientOrderId returns the value of the "newClientOrderId" field in the mutation.
+func (m *OrderRepairMutation) NewClientOrderId() (r string, exists bool) {
+	v := m.newClientOrderId
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNewClientOrderId returns the old "newClientOrderId" field's value of the OrderRepair entity.
+// If the OrderRepair object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderRepairMutation) OldNewClientOrderId(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNewClientOrderId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNewClientOrderId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNewClientOrderId: %w", err)
+	}
+	return oldValue.NewClientOrderId, nil
+}
+
+// ClearNewClientOrderId clears the value of the "newClientOrderId" field.
+func (m *OrderRepairMutation) ClearNewClientOrderId() {
+	m.newClientOrderId = nil
+	m.clearedFields[orderrepair.FieldNewClientOrderId] = struct{}{}
+}
+
+// NewClientOrderIdCleared returns if the "newClientOrderId" field was cleared in this mutation.
+func (m *OrderRepairMutation) NewClientOrderIdCleared() bool {
+	_, ok := m.clearedFields[orderrepair.FieldNewClientOrderId]
+	return ok
+}
+
+// ResetNewClientOrderId resets all changes to the "newClientOrderId" field.
+func (m *OrderRepairMutation) ResetNewClientOrderId() {
+	m.newClientOrderId = nil
+	delete(m.clearedFields, orderrepair.FieldNewClientOrderId)
+}
+
+// SetAction sets the "action" field.
+func (m *OrderRepairMutation) SetAction(o orderrepair.Action) {
+	m.action = &o
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *OrderRepairMutation) Action() (r orderrepair.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the OrderRepair entity.
+// If the OrderRepair object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderRepairMutation) OldAction(ctx context.Context) (v orderrepair.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *OrderRepairMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetAttempt sets the "attempt" field.
+func (m *OrderRepairMutation) SetAttempt(i int) {
+	m.attempt = &i
+	m.addattempt = nil
+}
+
+// Attempt returns the value of the "attempt" field in the mutation.
+func (m *OrderRepairMutation) Attempt() (r int, exists bool) {
+	v := m.attempt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempt returns the old "attempt" field's value of the OrderRepair entity.
+// If the OrderRepair object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderRepairMutation) OldAttempt(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempt: %w", err)
+	}
+	return oldValue.Attempt, nil
+}
+
+// AddAttempt adds i to the "attempt" field.
+func (m *OrderRepairMutation) AddAttempt(i int) {
+	if m.addattempt != nil {
+		*m.addattempt += i
+	} else {
+		m.addattempt = &i
+	}
+}
+
+// AddedAttempt returns the value that was added to the "attempt" field in this mutation.
+func (m *OrderRepairMutation) AddedAttempt() (r int, exists bool) {
+	v := m.addattempt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempt resets all changes to the "attempt" field.
+func (m *OrderRepairMutation) ResetAttempt() {
+	m.attempt = nil
+	m.addattempt = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *OrderRepairMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *OrderRepairMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the OrderRepair entity.
+// If the OrderRepair object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderRepairMutation) OldReason(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *OrderRepairMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[orderrepair.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *OrderRepairMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[orderrepair.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *OrderRepairMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, orderrepair.FieldReason)
+}
+
+// Where appends a list predicates to the OrderRepairMutation builder.
+func (m *OrderRepairMutation) Where(ps ...predicate.OrderRepair) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OrderRepairMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OrderRepairMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OrderRepair, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OrderRepairMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OrderRepairMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OrderRepair).
+func (m *OrderRepairMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OrderRepairMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.create_time != nil {
+		fields = append(fields, orderrepair.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, orderrepair.FieldUpdateTime)
+	}
+	if m.strategyId != nil {
+		fields = append(fields, orderrepair.FieldStrategyId)
+	}
+	if m.exchange != nil {
+		fields = append(fields, orderrepair.FieldExchange)
+	}
+	if m.symbol != nil {
+		fields = append(fields, orderrepair.FieldSymbol)
+	}
+	if m.account != nil {
+		fields = append(fields, orderrepair.FieldAccount)
+	}
+	if m.level != nil {
+		fields = append(fields, orderrepair.FieldLevel)
+	}
+	if m.side != nil {
+		fields = append(fields, orderrepair.FieldSide)
+	}
+	if m.price != nil {
+		fields = append(fields, orderrepair.FieldPrice)
+	}
+	if m.quantity != nil {
+		fields = append(fields, orderrepair.FieldQuantity)
+	}
+	if m.canceledClientOrderId != nil {
+		fields = append(fields, orderrepair.FieldCanceledClientOrderId)
+	}
+	if m.newClientOrderId != nil {
+		fields = append(fields, orderrepair.FieldNewClientOrderId)
+	}
+	if m.action != nil {
+		fields = append(fields, orderrepair.FieldAction)
+	}
+	if m.attempt != nil {
+		fields = append(fields, orderrepair.FieldAttempt)
+	}
+	if m.reason != nil {
+		fields = append(fields, orderrepair.FieldReason)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OrderRepairMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case orderrepair.FieldCreateTime:
+		return m.CreateTime()
+	case orderrepair.FieldUpdateTime:
+		return m.UpdateTime()
+	case orderrepair.FieldStrategyId:
+		return m.StrategyId()
+	case orderrepair.FieldExchange:
+		return m.Exchange()
+	case orderrepair.FieldSymbol:
+		return m.Symbol()
+	case orderrepair.FieldAccount:
+		return m.Account()
+	case orderrepair.FieldLevel:
+		return m.Level()
+	case orderrepair.FieldSide:
+		return m.Side()
+	case orderrepair.FieldPrice:
+		return m.Price()
+	case orderrepair.FieldQuantity:
+		return m.Quantity()
+	case orderrepair.FieldCanceledClientOrderId:
+		return m.CanceledClientOrderId()
+	case orderrepair.FieldNewClientOrderId:
+		return m.NewClientOrderId()
+	case orderrepair.FieldAction:
+		return m.Action()
+	case orderrepair.FieldAttempt:
+		return m.Attempt()
+	case orderrepair.FieldReason:
+		return m.Reason()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OrderRepairMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case orderrepair.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case orderrepair.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case orderrepair.FieldStrategyId:
+		return m.OldStrategyId(ctx)
+	case orderrepair.FieldExchange:
+		return m.OldExchange(ctx)
+	case orderrepair.FieldSymbol:
+		return m.OldSymbol(ctx)
+	case orderrepair.FieldAccount:
+		return m.OldAccount(ctx)
+	case orderrepair.FieldLevel:
+		return m.OldLevel(ctx)
+	case orderrepair.FieldSide:
+		return m.OldSide(ctx)
+	case orderrepair.FieldPrice:
+		return m.OldPrice(ctx)
+	case orderrepair.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case orderrepair.FieldCanceledClientOrderId:
+		return m.OldCanceledClientOrderId(ctx)
+	case orderrepair.FieldNewClientOrderId:
+		return m.OldNewClientOrderId(ctx)
+	case orderrepair.FieldAction:
+		return m.OldAction(ctx)
+	case orderrepair.FieldAttempt:
+		return m.OldAttempt(ctx)
+	case orderrepair.FieldReason:
+		return m.OldReason(ctx)
+	}
+	return nil, fmt.Errorf("unknown OrderRepair field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrderRepairMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case orderrepair.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case orderrepair.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case orderrepair.FieldStrategyId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStrategyId(v)
+		return nil
+	case orderrepair.FieldExchange:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExchange(v)
+		return nil
+	case orderrepair.FieldSymbol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSymbol(v)
+		return nil
+	case orderrepair.FieldAccount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccount(v)
+		return nil
+	case orderrepair.FieldLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLevel(v)
+		return nil
+	case orderrepair.FieldSide:
+		v, ok := value.(orderrepair.Side)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSide(v)
+		return nil
+	case orderrepair.FieldPrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case orderrepair.FieldQuantity:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case orderrepair.FieldCanceledClientOrderId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCanceledClientOrderId(v)
+		return nil
+	case orderrepair.FieldNewClientOrderId:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNewClientOrderId(v)
+		return nil
+	case orderrepair.FieldAction:
+		v, ok := value.(orderrepair.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case orderrepair.FieldAttempt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempt(v)
+		return nil
+	case orderrepair.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OrderRepair field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OrderRepairMutation) AddedFields() []string {
+	var fields []string
+	if m.addlevel != nil {
+		fields = append(fields, orderrepair.FieldLevel)
+	}
+	if m.addattempt != nil {
+		fields = append(fields, orderrepair.FieldAttempt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OrderRepairMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case orderrepair.FieldLevel:
+		return m.AddedLevel()
+	case orderrepair.FieldAttempt:
+		return m.AddedAttempt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrderRepairMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case orderrepair.FieldLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLevel(v)
+		return nil
+	case orderrepair.FieldAttempt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OrderRepair numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OrderRepairMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(orderrepair.FieldNewClientOrderId) {
+		fields = append(fields, orderrepair.FieldNewClientOrderId)
+	}
+	if m.FieldCleared(orderrepair.FieldReason) {
+		fields = append(fields, orderrepair.FieldReason)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OrderRepairMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OrderRepairMutation) ClearField(name string) error {
+	switch name {
+	case orderrepair.FieldNewClientOrderId:
+		m.ClearNewClientOrderId()
+		return nil
+	case orderrepair.FieldReason:
+		m.ClearReason()
+		return nil
+	}
+	return fmt.Errorf("unknown OrderRepair nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OrderRepairMutation) ResetField(name string) error {
+	switch name {
+	case orderrepair.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case orderrepair.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case orderrepair.FieldStrategyId:
+		m.ResetStrategyId()
+		return nil
+	case orderrepair.FieldExchange:
+		m.ResetExchange()
+		return nil
+	case orderrepair.FieldSymbol:
+		m.ResetSymbol()
+		return nil
+	case orderrepair.FieldAccount:
+		m.ResetAccount()
+		return nil
+	case orderrepair.FieldLevel:
+		m.ResetLevel()
+		return nil
+	case orderrepair.FieldSide:
+		m.ResetSide()
+		return nil
+	case orderrepair.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case orderrepair.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case orderrepair.FieldCanceledClientOrderId:
+		m.ResetCanceledClientOrderId()
+		return nil
+	case orderrepair.FieldNewClientOrderId:
+		m.ResetNewClientOrderId()
+		return nil
+	case orderrepair.FieldAction:
+		m.ResetAction()
+		return nil
+	case orderrepair.FieldAttempt:
+		m.ResetAttempt()
+		return nil
+	case orderrepair.FieldReason:
+		m.ResetReason()
+		return nil
+	}
+	return fmt.Errorf("unknown OrderRepair field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OrderRepairMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OrderRepairMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OrderRepairMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OrderRepairMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OrderRepairMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OrderRepairMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OrderRepairMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown OrderRepair unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OrderRepairMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown OrderRepair edge %s", name)
+}
+
+// StrategyMutation represents an operation that mutates the Strategy nodes in the graph.
+type StrategyMutation struct {
+	config
+	op                                Op
+	typ                               string
+	id                                *int
+	create_time                       *time.Time
+	update_time                       *time.Time
+	guid                              *string
+	owner                             *int64
+	addowner                          *int64
+	exchange                          *string
+	symbol                            *string
+	account                           *string
+	mode                              *strategy.Mode
+	marginMode                        *strategy.MarginMode
+	quantityMode                      *strategy.QuantityMode
+	priceUpper                        *decimal.Decimal
+	priceLower                        *decimal.Decimal
+	gridNum                           *int
+	addgridNum                        *int
+	leverage                          *int
+	addleverage                       *int
+	initialOrderSize                  *decimal.Decimal
+	sizingMode                        *strategy.SizingMode
+	sizingFactor                      *decimal.Decimal
+	sizingWeights                     *string
+	slippageBps                       *int
+	addslippageBps                    *int
+	entryPrice                        *decimal.Decimal
+	triggerStopLossPrice              *decimal.Decimal
+	triggerTakeProfitPrice            *decimal.Decimal
+	maxLossAmount                     *decimal.Decimal
+	maxLossPercent                    *decimal.Decimal
+	profitTargetAmount                *decimal.Decimal
+	trailingStopLossAmount            *decimal.Decimal
+	trailingStopLossPercent           *decimal.Decimal
+	trailingTakeProfitActivationPrice *decimal.Decimal
+	trailingTakeProfitAmount          *decimal.Decimal
+	trailingTakeProfitPercent         *decimal.Decimal
+	trailingExtremePrice              *decimal.Decimal
+	trailingLevels                    *int
+	addtrailingLevels                 *int
+	trailingPriceUpperLimit           *decimal.Decimal
+	trailingPriceLowerLimit           *decimal.Decimal
+	atrPeriod                         *int
+	addatrPeriod                      *int
+	atrMultiplier                     *decimal.Decimal
+	adaptiveThreshold                 *decimal.Decimal
+	adaptiveIntervalHours             *int
+	addadaptiveIntervalHours          *int
+	adaptiveAtr                       *decimal.Decimal
+	adaptiveUpdatedAt                 *time.Time
+	cancelRepairPolicy                *strategy.CancelRepairPolicy
+	cancelRepairMaxAttempts           *int
+	addcancelRepairMaxAttempts        *int
+	reconcilePolicy                   *strategy.ReconcilePolicy
+	partialFillThreshold              *decimal.Decimal
+	enablePushNotification            *bool
+	enablePushMatchedNotification     *bool
+	lastLowerThresholdAlertTime       *time.Time
+	lastUpperThresholdAlertTime       *time.Time
+	status                            *strategy.Status
+	exchangeApiKey                    *string
+	exchangeSecretKey                 *string
+	exchangePassphrase                *string
+	exchangeTestnet                   *bool
+	startTime                         *time.Time
+	clearedFields                     map[string]struct{}
+	exchangeAccount                   *int
+	clearedexchangeAccount            bool
+	done                              bool
+	oldValue                          func(context.Context) (*Strategy, error)
+	predicates                        []predicate.Strategy
+}
+
+var _ ent.Mutation = (*StrategyMutation)(nil)
+
+// strategyOption allows management of the mutation configuration using functional options.
+type strategyOption func(*StrategyMutation)
+
+// newStrategyMutation creates new mutation for the Strategy entity.
+func newStrategyMutation(c config, op Op, opts ...strategyOption) *StrategyMutation {
+	m := &StrategyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStrategy,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStrategyID sets the ID field of the mutation.
+func withStrategyID(id int) strategyOption {
+	return func(m *StrategyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Strategy
+		)
+		m.oldValue = func(ctx context.Context) (*Strategy, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Strategy.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStrategy sets the old Strategy of the mutation.
+func withStrategy(node *Strategy) strategyOption {
+	return func(m *StrategyMutation) {
+		m.oldValue = func(context.Context) (*Strategy, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StrategyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StrategyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StrategyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StrategyMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Strategy.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *StrategyMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *StrategyMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *StrategyMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *StrategyMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *StrategyMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *StrategyMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetGUID sets the "guid" field.
+func (m *StrategyMutation) SetGUID(s string) {
+	m.guid = &s
+}
+
+// GUID returns the value of the "guid" field in the mutation.
+func (m *StrategyMutation) GUID() (r string, exists bool) {
+	v := m.guid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGUID returns the old "guid" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldGUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGUID: %w", err)
+	}
+	return oldValue.GUID, nil
+}
+
+// ResetGUID resets all changes to the "guid" field.
+func (m *StrategyMutation) ResetGUID() {
+	m.guid = nil
+}
+
+// SetOwner sets the "owner" field.
+func (m *StrategyMutation) SetOwner(i int64) {
+	m.owner = &i
+	m.addowner = nil
+}
+
+// Owner returns the value of the "owner" field in the mutation.
+func (m *StrategyMutation) Owner() (r int64, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwner returns the old "owner" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldOwner(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
+	}
+	return oldValue.Owner, nil
+}
+
+// AddOwner adds i to the "owner" field.
+func (m *StrategyMutation) AddOwner(i int64) {
+	if m.addowner != nil {
+		*m.addowner += i
+	} else {
+		m.addowner = &i
+	}
+}
+
+// AddedOwner returns the value that was added to the "owner" field in this mutation.
+func (m *StrategyMutation) AddedOwner() (r int64, exists bool) {
+	v := m.addowner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOwner resets all changes to the "owner" field.
+func (m *StrategyMutation) ResetOwner() {
+	m.owner = nil
+	m.addowner = nil
+}
+
+// SetExchange sets the "exchange" field.
+func (m *StrategyMutation) SetExchange(s string) {
+	m.exchange = &s
+}
+
+// Exchange returns the value of the "exchange" field in the mutation.
+func (m *StrategyMutation) Exchange() (r string, exists bool) {
+	v := m.exchange
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExchange returns the old "exchange" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldExchange(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExchange is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExchange requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExchange: %w", err)
+	}
+	return oldValue.Exchange, nil
+}
+
+// ResetExchange resets all changes to the "exchange" field.
+func (m *StrategyMutation) ResetExchange() {
+	m.exchange = nil
+}
+
+// SetSymbol sets the "symbol" field.
+func (m *StrategyMutation) SetSymbol(s string) {
+	m.symbol = &s
+}
+
+// Symbol returns the value of the "symbol" field in the mutation.
+func (m *StrategyMutation) Symbol() (r string, exists bool) {
+	v := m.symbol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSymbol returns the old "symbol" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldSymbol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSymbol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+	}
+	return oldValue.Symbol, nil
+}
+
+// ResetSymbol resets all changes to the "symbol" field.
+func (m *StrategyMutation) ResetSymbol() {
+	m.symbol = nil
+}
+
+// SetAccount sets the "account" field.
+func (m *StrategyMutation) SetAccount(s string) {
+	m.account = &s
+}
+
+// Account returns the value of the "account" field in the mutation.
+func (m *StrategyMutation) Account() (r string, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccount returns the old "account" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldAccount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccount: %w", err)
+	}
+	return oldValue.Account, nil
+}
+
+// ResetAccount resets all changes to the "account" field.
+func (m *StrategyMutation) ResetAccount() {
+	m.account = nil
+}
+
+// SetMode sets the "mode" field.
+func (m *StrategyMutation) SetMode(s strategy.Mode) {
+	m.mode = &s
+}
+
+// Mode returns the value of the "mode" field in the mutation.
+func (m *StrategyMutation) Mode() (r strategy.Mode, exists bool) {
+	v := m.mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMode returns the old "mode" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldMode(ctx context.Context) (v strategy.Mode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMode: %w", err)
+	}
+	return oldValue.Mode, nil
+}
+
+// ResetMode resets all changes to the "mode" field.
+func (m *StrategyMutation) ResetMode() {
+	m.mode = nil
+}
+
+// SetMarginMode sets the "marginMode" field.
+func (m *StrategyMutation) SetMarginMode(sm strategy.MarginMode) {
+	m.marginMode = &sm
+}
+
+// MarginMode returns the value of the "marginMode" field in the mutation.
+func (m *StrategyMutation) MarginMode() (r strategy.MarginMode, exists bool) {
+	v := m.marginMode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMarginMode returns the old "marginMode" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldMarginMode(ctx context.Context) (v strategy.MarginMode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMarginMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMarginMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMarginMode: %w", err)
+	}
+	return oldValue.MarginMode, nil
+}
+
+// ResetMarginMode resets all changes to the "marginMode" field.
+func (m *StrategyMutation) ResetMarginMode() {
+	m.marginMode = nil
+}
+
+// SetQuantityMode sets the "quantityMode" field.
+func (m *StrategyMutation) SetQuantityMode(sm strategy.QuantityMode) {
+	m.quantityMode = &sm
+}
+
+// QuantityMode returns the value of the "quantityMode" field in the mutation.
+func (m *StrategyMutation) QuantityMode() (r strategy.QuantityMode, exists bool) {
+	v := m.quantityMode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuantityMode returns the old "quantityMode" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldQuantityMode(ctx context.Context) (v strategy.QuantityMode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuantityMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuantityMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantityMode: %w", err)
+	}
+	return oldValue.QuantityMode, nil
+}
+
+// ResetQuantityMode resets all changes to the "quantityMode" field.
+func (m *StrategyMutation) ResetQuantityMode() {
+	m.quantityMode = nil
+}
+
+// SetPriceUpper sets the "priceUpper" field.
+func (m *StrategyMutation) SetPriceUpper(d decimal.Decimal) {
+	m.priceUpper = &d
+}
+
+// PriceUpper returns the value of the "priceUpper" field in the mutation.
+func (m *StrategyMutation) PriceUpper() (r decimal.Decimal, exists bool) {
+	v := m.priceUpper
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriceUpper returns the old "priceUpper" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldPriceUpper(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriceUpper is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriceUpper requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriceUpper: %w", err)
+	}
+	return oldValue.PriceUpper, nil
+}
+
+// ResetPriceUpper resets all changes to the "priceUpper" field.
+func (m *StrategyMutation) ResetPriceUpper() {
+	m.priceUpper = nil
+}
+
+// SetPriceLower sets the "priceLower" field.
+func (m *StrategyMutation) SetPriceLower(d decimal.Decimal) {
+	m.priceLower = &d
+}
+
+// PriceLower returns the value of the "priceLower" field in the mutation.
+func (m *StrategyMutation) PriceLower() (r decimal.Decimal, exists bool) {
+	v := m.priceLower
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriceLower returns the old "priceLower" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldPriceLower(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriceLower is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriceLower requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriceLower: %w", err)
+	}
+	return oldValue.PriceLower, nil
+}
+
+// ResetPriceLower resets all changes to the "priceLower" field.
+func (m *StrategyMutation) ResetPriceLower() {
+	m.priceLower = nil
+}
+
+// SetGridNum sets the "gridNum" field.
+func (m *StrategyMutation) SetGridNum(i int) {
+	m.gridNum = &i
+	m.addgridNum = nil
+}
+
+// GridNum returns the value of the "gridNum" field in the mutation.
+func (m *StrategyMutation) GridNum() (r int, exists bool) {
+	v := m.gridNum
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGridNum returns the old "gridNum" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldGridNum(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGridNum is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGridNum requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGridNum: %w", err)
+	}
+	return oldValue.GridNum, nil
+}
+
+// AddGridNum adds i to the "gridNum" field.
+func (m *StrategyMutation) AddGridNum(i int) {
+	if m.addgridNum != nil {
+		*m.addgridNum += i
+	} else {
+		m.addgridNum = &i
+	}
+}
+
+// AddedGridNum returns the value that was added to the "gridNum" field in this mutation.
+func (m *StrategyMutation) AddedGridNum() (r int, exists bool) {
+	v := m.addgridNum
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetGridNum resets all changes to the "gridNum" field.
+func (m *StrategyMutation) ResetGridNum() {
+	m.gridNum = nil
+	m.addgridNum = nil
+}
+
+// SetLeverage sets the "leverage" field.
+func (m *StrategyMutation) SetLeverage(i int) {
+	m.leverage = &i
+	m.addleverage = nil
+}
+
+// Leverage returns the value of the "leverage" field in the mutation.
+func (m *StrategyMutation) Leverage() (r int, exists bool) {
+	v := m.leverage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeverage returns the old "leverage" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldLeverage(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeverage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeverage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeverage: %w", err)
+	}
+	return oldValue.Leverage, nil
+}
+
+// AddLeverage adds i to the "leverage" field.
+func (m *StrategyMutation) AddLeverage(i int) {
+	if m.addleverage != nil {
+		*m.addleverage += i
+	} else {
+		m.addleverage = &i
+	}
+}
+
+// AddedLeverage returns the value that was added to the "leverage" field in this mutation.
+func (m *StrategyMutation) AddedLeverage() (r int, exists bool) {
+	v := m.addleverage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLeverage resets all changes to the "leverage" field.
+func (m *StrategyMutation) ResetLeverage() {
+	m.leverage = nil
+	m.addleverage = nil
+}
+
+// SetInitialOrderSize sets the "initialOrderSize" field.
+func (m *StrategyMutation) SetInitialOrderSize(d decimal.Decimal) {
+	m.initialOrderSize = &d
+}
+
+// InitialOrderSize returns the value of the "initialOrderSize" field in the mutation.
+func (m *StrategyMutation) InitialOrderSize() (r decimal.Decimal, exists bool) {
+	v := m.initialOrderSize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInitialOrderSize returns the old "initialOrderSize" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldInitialOrderSize(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInitialOrderSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInitialOrderSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInitialOrderSize: %w", err)
+	}
+	return oldValue.InitialOrderSize, nil
+}
+
+// ResetInitialOrderSize resets all changes to the "initialOrderSize" field.
+func (m *StrategyMutation) ResetInitialOrderSize() {
+	m.initialOrderSize = nil
+}
+
+// SetSizingMode sets the "sizingMode" field.
+func (m *StrategyMutation) SetSizingMode(sm strategy.SizingMode) {
+	m.sizingMode = &sm
+}
+
+// SizingMode returns the value of the "sizingMode" field in the mutation.
+func (m *StrategyMutation) SizingMode() (r strategy.SizingMode, exists bool) {
+	v := m.sizingMode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSizingMode returns the old "sizingMode" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldSizingMode(ctx context.Context) (v strategy.SizingMode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSizingMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSizingMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSizingMode: %w", err)
+	}
+	return oldValue.SizingMode, nil
+}
+
+// ResetSizingMode resets all changes to the "sizingMode" field.
+func (m *StrategyMutation) ResetSizingMode() {
+	m.sizingMode = nil
+}
+
+// SetSizingFactor sets the "sizingFactor" field.
+func (m *StrategyMutation) SetSizingFactor(d decimal.Decimal) {
+	m.sizingFactor = &d
+}
+
+// SizingFactor returns the value of the "sizingFactor" field in the mutation.
+func (m *StrategyMutation) SizingFactor() (r decimal.Decimal, exists bool) {
+	v := m.sizingFactor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSizingFactor returns the old "sizingFactor" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldSizingFactor(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSizingFactor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSizingFactor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSizingFactor: %w", err)
+	}
+	return oldValue.SizingFactor, nil
+}
+
+// ClearSizingFactor clears the value of the "sizingFactor" field.
+func (m *StrategyMutation) ClearSizingFactor() {
+	m.sizingFactor = nil
+	m.clearedFields[strategy.FieldSizingFactor] = struct{}{}
+}
+
+// SizingFactorCleared returns if the "sizingFactor" field was cleared in this mutation.
+func (m *StrategyMutation) SizingFactorCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldSizingFactor]
+	return ok
+}
+
+// ResetSizingFactor resets all changes to the "sizingFactor" field.
+func (m *StrategyMutation) ResetSizingFactor() {
+	m.sizingFactor = nil
+	delete(m.clearedFields, strategy.FieldSizingFactor)
+}
+
+// SetSizingWeights sets the "sizingWeights" field.
+func (m *StrategyMutation) SetSizingWeights(s string) {
+	m.sizingWeights = &s
+}
+
+// SizingWeights returns the value of the "sizingWeights" field in the mutation.
+func (m *StrategyMutation) SizingWeights() (r string, exists bool) {
+	v := m.sizingWeights
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSizingWeights returns the old "sizingWeights" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldSizingWeights(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSizingWeights is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSizingWeights requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSizingWeights: %w", err)
+	}
+	return oldValue.SizingWeights, nil
+}
+
+// ClearSizingWeights clears the value of the "sizingWeights" field.
+func (m *StrategyMutation) ClearSizingWeights() {
+	m.sizingWeights = nil
+	m.clearedFields[strategy.FieldSizingWeights] = struct{}{}
+}
+
+// SizingWeightsCleared returns if the "sizingWeights" field was cleared in this mutation.
+func (m *StrategyMutation) SizingWeightsCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldSizingWeights]
+	return ok
+}
+
+// ResetSizingWeights resets all changes to the "sizingWeights" field.
+func (m *StrategyMutation) ResetSizingWeights() {
+	m.sizingWeights = nil
+	delete(m.clearedFields, strategy.FieldSizingWeights)
+}
+
+// SetSlippageBps sets the "slippageBps" field.
+func (m *StrategyMutation) SetSlippageBps(i int) {
+	m.slippageBps = &i
+	m.addslippageBps = nil
+}
+
+// SlippageBps returns the value of the "slippageBps" field in the mutation.
+func (m *StrategyMutation) SlippageBps() (r int, exists bool) {
+	v := m.slippageBps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlippageBps returns the old "slippageBps" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldSlippageBps(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlippageBps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlippageBps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlippageBps: %w", err)
+	}
+	return oldValue.SlippageBps, nil
+}
+
+// AddSlippageBps adds i to the "slippageBps" field.
+func (m *StrategyMutation) AddSlippageBps(i int) {
+	if m.addslippageBps != nil {
+		*m.addslippageBps += i
+	} else {
+		m.addslippageBps = &i
+	}
+}
+
+// AddedSlippageBps returns the value that was added to the "slippageBps" field in this mutation.
+func (m *StrategyMutation) AddedSlippageBps() (r int, exists bool) {
+	v := m.addslippageBps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSlippageBps clears the value of the "slippageBps" field.
+func (m *StrategyMutation) ClearSlippageBps() {
+	m.slippageBps = nil
+	m.addslippageBps = nil
+	m.clearedFields[strategy.FieldSlippageBps] = struct{}{}
+}
+
+// SlippageBpsCleared returns if the "slippageBps" field was cleared in this mutation.
+func (m *StrategyMutation) SlippageBpsCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldSlippageBps]
+	return ok
+}
+
+// ResetSlippageBps resets all changes to the "slippageBps" field.
+func (m *StrategyMutation) ResetSlippageBps() {
+	m.slippageBps = nil
+	m.addslippageBps = nil
+	delete(m.clearedFields, strategy.FieldSlippageBps)
+}
+
+// SetEntryPrice sets the "entryPrice" field.
+func (m *StrategyMutation) SetEntryPrice(d decimal.Decimal) {
+	m.entryPrice = &d
+}
+
+// EntryPrice returns the value of the "entryPrice" field in the mutation.
+func (m *StrategyMutation) EntryPrice() (r decimal.Decimal, exists bool) {
+	v := m.entryPrice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntryPrice returns the old "entryPrice" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldEntryPrice(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntryPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntryPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntryPrice: %w", err)
+	}
+	return oldValue.EntryPrice, nil
+}
+
+// ClearEntryPrice clears the value of the "entryPrice" field.
+func (m *StrategyMutation) ClearEntryPrice() {
+	m.entryPrice = nil
+	m.clearedFields[strategy.FieldEntryPrice] = struct{}{}
+}
+
+// EntryPriceCleared returns if the "entryPrice" field was cleared in this mutation.
+func (m *StrategyMutation) EntryPriceCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldEntryPrice]
+	return ok
+}
+
+// ResetEntryPrice resets all changes to the "entryPrice" field.
+func (m *StrategyMutation) ResetEntryPrice() {
+	m.entryPrice = nil
+	delete(m.clearedFields, strategy.FieldEntryPrice)
+}
+
+// SetTriggerStopLossPrice sets the "triggerStopLossPrice" field.
+func (m *StrategyMutation) SetTriggerStopLossPrice(d decimal.Decimal) {
+	m.triggerStopLossPrice = &d
+}
+
+// TriggerStopLossPrice returns the value of the "triggerStopLossPrice" field in the mutation.
+func (m *StrategyMutation) TriggerStopLossPrice() (r decimal.Decimal, exists bool) {
+	v := m.triggerStopLossPrice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTriggerStopLossPrice returns the old "triggerStopLossPrice" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldTriggerStopLossPrice(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTriggerStopLossPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTriggerStopLossPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTriggerStopLossPrice: %w", err)
+	}
+	return oldValue.TriggerStopLossPrice, nil
+}
+
+// ClearTriggerStopLossPrice clears the value of the "triggerStopLossPrice" field.
+func (m *StrategyMutation) ClearTriggerStopLossPrice() {
+	m.triggerStopLossPrice = nil
+	m.clearedFields[strategy.FieldTriggerStopLossPrice] = struct{}{}
+}
+
+// TriggerStopLossPriceCleared returns if the "triggerStopLossPrice" field was cleared in this mutation.
+func (m *StrategyMutation) TriggerStopLossPriceCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldTriggerStopLossPrice]
+	return ok
+}
+
+// ResetTriggerStopLossPrice resets all changes to the "triggerStopLossPrice" field.
+func (m *StrategyMutation) ResetTriggerStopLossPrice() {
+	m.triggerStopLossPrice = nil
+	delete(m.clearedFields, strategy.FieldTriggerStopLossPrice)
+}
+
+// SetTriggerTakeProfitPrice sets the "triggerTakeProfitPrice" field.
+func (m *StrategyMutation) SetTriggerTakeProfitPrice(d decimal.Decimal) {
+	m.triggerTakeProfitPrice = &d
+}
+
+// TriggerTakeProfitPrice returns the value of the "triggerTakeProfitPrice" field in the mutation.
+func (m *StrategyMutation) TriggerTakeProfitPrice() (r decimal.Decimal, exists bool) {
+	v := m.triggerTakeProfitPrice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTriggerTakeProfitPrice returns the old "triggerTakeProfitPrice" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldTriggerTakeProfitPrice(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTriggerTakeProfitPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTriggerTakeProfitPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTriggerTakeProfitPrice: %w", err)
+	}
+	return oldValue.TriggerTakeProfitPrice, nil
+}
+
+// ClearTriggerTakeProfitPrice clears the value of the "triggerTakeProfitPrice" field.
+func (m *StrategyMutation) ClearTriggerTakeProfitPrice() {
+	m.triggerTakeProfitPrice = nil
+	m.clearedFields[strategy.FieldTriggerTakeProfitPrice] = struct{}{}
+}
+
+// TriggerTakeProfitPriceCleared returns if the "triggerTakeProfitPrice" field was cleared in this mutation.
+func (m *StrategyMutation) TriggerTakeProfitPriceCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldTriggerTakeProfitPrice]
+	return ok
+}
+
+// ResetTriggerTakeProfitPrice resets all changes to the "triggerTakeProfitPrice" field.
+func (m *StrategyMutation) ResetTriggerTakeProfitPrice() {
+	m.triggerTakeProfitPrice = nil
+	delete(m.clearedFields, strategy.FieldTriggerTakeProfitPrice)
+}
+
+// SetMaxLossAmount sets the "maxLossAmount" field.
+func (m *StrategyMutation) SetMaxLossAmount(d decimal.Decimal) {
+	m.maxLossAmount = &d
+}
+
+// MaxLossAmount returns the value of the "maxLossAmount" field in the mutation.
+func (m *StrategyMutation) MaxLossAmount() (r decimal.Decimal, exists bool) {
+	v := m.maxLossAmount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxLossAmount returns the old "maxLossAmount" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldMaxLossAmount(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxLossAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxLossAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxLossAmount: %w", err)
+	}
+	return oldValue.MaxLossAmount, nil
+}
+
+// ClearMaxLossAmount clears the value of the "maxLossAmount" field.
+func (m *StrategyMutation) ClearMaxLossAmount() {
+	m.maxLossAmount = nil
+	m.clearedFields[strategy.FieldMaxLossAmount] = struct{}{}
+}
+
+// MaxLossAmountCleared returns if the "maxLossAmount" field was cleared in this mutation.
+func (m *StrategyMutation) MaxLossAmountCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldMaxLossAmount]
+	return ok
+}
+
+// ResetMaxLossAmount resets all changes to the "maxLossAmount" field.
+func (m *StrategyMutation) ResetMaxLossAmount() {
+	m.maxLossAmount = nil
+	delete(m.clearedFields, strategy.FieldMaxLossAmount)
+}
+
+// SetMaxLossPercent sets the "maxLossPercent" field.
+func (m *StrategyMutation) SetMaxLossPercent(d decimal.Decimal) {
+	m.maxLossPercent = &d
+}
+
+// MaxLossPercent returns the value of the "maxLossPercent" field in the mutation.
+func (m *StrategyMutation) MaxLossPercent() (r decimal.Decimal, exists bool) {
+	v := m.maxLossPercent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxLossPercent returns the old "maxLossPercent" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldMaxLossPercent(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxLossPercent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxLossPercent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxLossPercent: %w", err)
+	}
+	return oldValue.MaxLossPercent, nil
+}
+
+// ClearMaxLossPercent clears the value of the "maxLossPercent" field.
+func (m *StrategyMutation) ClearMaxLossPercent() {
+	m.maxLossPercent = nil
+	m.clearedFields[strategy.FieldMaxLossPercent] = struct{}{}
+}
+
+// MaxLossPercentCleared returns if the "maxLossPercent" field was cleared in this mutation.
+func (m *StrategyMutation) MaxLossPercentCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldMaxLossPercent]
+	return ok
+}
+
+// ResetMaxLossPercent resets all changes to the "maxLossPercent" field.
+func (m *StrategyMutation) ResetMaxLossPercent() {
+	m.maxLossPercent = nil
+	delete(m.clearedFields, strategy.FieldMaxLossPercent)
+}
+
+// SetProfitTargetAmount sets the "profitTargetAmount" field.
+func (m *StrategyMutation) SetProfitTargetAmount(d decimal.Decimal) {
+	m.profitTargetAmount = &d
+}
+
+// ProfitTargetAmount returns the value of the "profitTargetAmount" field in the mutation.
+func (m *StrategyMutation) ProfitTargetAmount() (r decimal.Decimal, exists bool) {
+	v := m.profitTargetAmount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProfitTargetAmount returns the old "profitTargetAmount" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldProfitTargetAmount(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProfitTargetAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProfitTargetAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProfitTargetAmount: %w", err)
+	}
+	return oldValue.ProfitTargetAmount, nil
+}
+
+// ClearProfitTargetAmount clears the value of the "profitTargetAmount" field.
+func (m *StrategyMutation) ClearProfitTargetAmount() {
+	m.profitTargetAmount = nil
+	m.clearedFields[strategy.FieldProfitTargetAmount] = struct{}{}
+}
+
+// ProfitTargetAmountCleared returns if the "profitTargetAmount" field was cleared in this mutation.
+func (m *StrategyMutation) ProfitTargetAmountCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldProfitTargetAmount]
+	return ok
+}
+
+// ResetProfitTargetAmount resets all changes to the "profitTargetAmount" field.
+func (m *StrategyMutation) ResetProfitTargetAmount() {
+	m.profitTargetAmount = nil
+	delete(m.clearedFields, strategy.FieldProfitTargetAmount)
+}
+
+// SetTrailingStopLossAmount sets the "trailingStopLossAmount" field.
+func (m *StrategyMutation) SetTrailingStopLossAmount(d decimal.Decimal) {
+	m.trailingStopLossAmount = &d
+}
+
+// TrailingStopLossAmount returns the value of the "trailingStopLossAmount" field in the mutation.
+func (m *StrategyMutation) TrailingStopLossAmount() (r decimal.Decimal, exists bool) {
+	v := m.trailingStopLossAmount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrailingStopLossAmount returns the old "trailingStopLossAmount" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldTrailingStopLossAmount(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrailingStopLossAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrailingStopLossAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrailingStopLossAmount: %w", err)
+	}
+	return oldValue.TrailingStopLossAmount, nil
+}
+
+// ClearTrailingStopLossAmount clears the value of the "trailingStopLossAmount" field.
+func (m *StrategyMutation) ClearTrailingStopLossAmount() {
+	m.trailingStopLossAmount = nil
+	m.clearedFields[strategy.FieldTrailingStopLossAmount] = struct{}{}
+}
+
+// TrailingStopLossAmountCleared returns if the "trailingStopLossAmount" field was cleared in this mutation.
+func (m *StrategyMutation) TrailingStopLossAmountCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldTrailingStopLossAmount]
+	return ok
+}
+
+// ResetTrailingStopLossAmount resets all changes to the "trailingStopLossAmount" field.
+func (m *StrategyMutation) ResetTrailingStopLossAmount() {
+	m.trailingStopLossAmount = nil
+	delete(m.clearedFields, strategy.FieldTrailingStopLossAmount)
+}
+
+// SetTrailingStopLossPercent sets the "trailingStopLossPercent" field.
+func (m *StrategyMutation) SetTrailingStopLossPercent(d decimal.Decimal) {
+	m.trailingStopLossPercent = &d
+}
+
+// TrailingStopLossPercent returns the value of the "trailingStopLossPercent" field in the mutation.
+func (m *StrategyMutation) TrailingStopLossPercent() (r decimal.Decimal, exists bool) {
+	v := m.trailingStopLossPercent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrailingStopLossPercent returns the old "trailingStopLossPercent" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldTrailingStopLossPercent(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrailingStopLossPercent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrailingStopLossPercent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrailingStopLossPercent: %w", err)
+	}
+	return oldValue.TrailingStopLossPercent, nil
+}
+
+// ClearTrailingStopLossPercent clears the value of the "trailingStopLossPercent" field.
+func (m *StrategyMutation) ClearTrailingStopLossPercent() {
+	m.trailingStopLossPercent = nil
+	m.clearedFields[strategy.FieldTrailingStopLossPercent] = struct{}{}
+}
+
+// TrailingStopLossPercentCleared returns if the "trailingStopLossPercent" field was cleared in this mutation.
+func (m *StrategyMutation) TrailingStopLossPercentCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldTrailingStopLossPercent]
+	return ok
+}
+
+// ResetTrailingStopLossPercent resets all changes to the "trailingStopLossPercent" field.
+func (m *StrategyMutation) ResetTrailingStopLossPercent() {
+	m.trailingStopLossPercent = nil
+	delete(m.clearedFields, strategy.FieldTrailingStopLossPercent)
+}
+
+// SetTrailingTakeProfitActivationPrice sets the "trailingTakeProfitActivationPrice" field.
+func (m *StrategyMutation) SetTrailingTakeProfitActivationPrice(d decimal.Decimal) {
+	m.trailingTakeProfitActivationPrice = &d
+}
+
+// TrailingTakeProfitActivationPrice returns the value of the "trailingTakeProfitActivationPrice" field in the mutation.
+func (m *StrategyMutation) TrailingTakeProfitActivationPrice() (r decimal.Decimal, exists bool) {
+	v := m.trailingTakeProfitActivationPrice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrailingTakeProfitActivationPrice returns the old "trailingTakeProfitActivationPrice" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldTrailingTakeProfitActivationPrice(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrailingTakeProfitActivationPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrailingTakeProfitActivationPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrailingTakeProfitActivationPrice: %w", err)
+	}
+	return oldValue.TrailingTakeProfitActivationPrice, nil
+}
+
+// ClearTrailingTakeProfitActivationPrice clears the value of the "trailingTakeProfitActivationPrice" field.
+func (m *StrategyMutation) ClearTrailingTakeProfitActivationPrice() {
+	m.trailingTakeProfitActivationPrice = nil
+	m.clearedFields[strategy.FieldTrailingTakeProfitActivationPrice] = struct{}{}
+}
+
+// TrailingTakeProfitActivationPriceCleared returns if the "trailingTakeProfitActivationPrice" field was cleared in this mutation.
+func (m *StrategyMutation) TrailingTakeProfitActivationPriceCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldTrailingTakeProfitActivationPrice]
+	return ok
+}
+
+// ResetTrailingTakeProfitActivationPrice resets all changes to the "trailingTakeProfitActivationPrice" field.
+func (m *StrategyMutation) ResetTrailingTakeProfitActivationPrice() {
+	m.trailingTakeProfitActivationPrice = nil
+	delete(m.clearedFields, strategy.FieldTrailingTakeProfitActivationPrice)
+}
+
+// SetTrailingTakeProfitAmount sets the "trailingTakeProfitAmount" field.
+func (m *StrategyMutation) SetTrailingTakeProfitAmount(d decimal.Decimal) {
+	m.trailingTakeProfitAmount = &d
+}
+
+// TrailingTakeProfitAmount returns the value of the "trailingTakeProfitAmount" field in the mutation.
+func (m *StrategyMutation) TrailingTakeProfitAmount() (r decimal.Decimal, exists bool) {
+	v := m.trailingTakeProfitAmount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrailingTakeProfitAmount returns the old "trailingTakeProfitAmount" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldTrailingTakeProfitAmount(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrailingTakeProfitAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrailingTakeProfitAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrailingTakeProfitAmount: %w", err)
+	}
+	return oldValue.TrailingTakeProfitAmount, nil
+}
+
+// ClearTrailingTakeProfitAmount clears the value of the "trailingTakeProfitAmount" field.
+func (m *StrategyMutation) ClearTrailingTakeProfitAmount() {
+	m.trailingTakeProfitAmount = nil
+	m.clearedFields[strategy.FieldTrailingTakeProfitAmount] = struct{}{}
+}
+
+// TrailingTakeProfitAmountCleared returns if the "trailingTakeProfitAmount" field was cleared in this mutation.
+func (m *StrategyMutation) TrailingTakeProfitAmountCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldTrailingTakeProfitAmount]
+	return ok
+}
+
+// ResetTrailingTakeProfitAmount resets all changes to the "trailingTakeProfitAmount" field.
+func (m *StrategyMutation) ResetTrailingTakeProfitAmount() {
+	m.trailingTakeProfitAmount = nil
+	delete(m.clearedFields, strategy.FieldTrailingTakeProfitAmount)
+}
+
+// SetTrailingTakeProfitPercent sets the "trailingTakeProfitPercent" field.
+func (m *StrategyMutation) SetTrailingTakeProfitPercent(d decimal.Decimal) {
+	m.trailingTakeProfitPercent = &d
+}
+
+// TrailingTakeProfitPercent returns the value of the "trailingTakeProfitPercent" field in the mutation.
+func (m *StrategyMutation) TrailingTakeProfitPercent() (r decimal.Decimal, exists bool) {
+	v := m.trailingTakeProfitPercent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrailingTakeProfitPercent returns the old "trailingTakeProfitPercent" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldTrailingTakeProfitPercent(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrailingTakeProfitPercent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrailingTakeProfitPercent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrailingTakeProfitPercent: %w", err)
+	}
+	return oldValue.TrailingTakeProfitPercent, nil
+}
+
+// ClearTrailingTakeProfitPercent clears the value of the "trailingTakeProfitPercent" field.
+func (m *StrategyMutation) ClearTrailingTakeProfitPercent() {
+	m.trailingTakeProfitPercent = nil
+	m.clearedFields[strategy.FieldTrailingTakeProfitPercent] = struct{}{}
+}
+
+// TrailingTakeProfitPercentCleared returns if the "trailingTakeProfitPercent" field was cleared in this mutation.
+func (m *StrategyMutation) TrailingTakeProfitPercentCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldTrailingTakeProfitPercent]
+	return ok
+}
+
+// ResetTrailingTakeProfitPercent resets all changes to the "trailingTakeProfitPercent" field.
+func (m *StrategyMutation) ResetTrailingTakeProfitPercent() {
+	m.trailingTakeProfitPercent = nil
+	delete(m.clearedFields, strategy.FieldTrailingTakeProfitPercent)
+}
+
+// SetTrailingExtremePrice sets the "trailingExtremePrice" field.
+func (m *StrategyMutation) SetTrailingExtremePrice(d decimal.Decimal) {
+	m.trailingExtremePrice = &d
+}
+
+// TrailingExtremePrice returns the value of the "trailingExtremePrice" field in the mutation.
+func (m *StrategyMutation) TrailingExtremePrice() (r decimal.Decimal, exists bool) {
+	v := m.trailingExtremePrice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrailingExtremePrice returns the old "trailingExtremePrice" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldTrailingExtremePrice(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrailingExtremePrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrailingExtremePrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrailingExtremePrice: %w", err)
+	}
+	return oldValue.TrailingExtremePrice, nil
+}
+
+// ClearTrailingExtremePrice clears the value of the "trailingExtremePrice" field.
+func (m *StrategyMutation) ClearTrailingExtremePrice() {
+	m.trailingExtremePrice = nil
+	m.clearedFields[strategy.FieldTrailingExtremePrice] = struct{}{}
+}
+
+// TrailingExtremePriceCleared returns if the "trailingExtremePrice" field was cleared in this mutation.
+func (m *StrategyMutation) TrailingExtremePriceCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldTrailingExtremePrice]
+	return ok
+}
+
+// ResetTrailingExtremePrice resets all changes to the "trailingExtremePrice" field.
+func (m *StrategyMutation) ResetTrailingExtremePrice() {
+	m.trailingExtremePrice = nil
+	delete(m.clearedFields, strategy.FieldTrailingExtremePrice)
+}
+
+// SetTrailingLevels sets the "trailingLevels" field.
+func (m *StrategyMutation) SetTrailingLevels(i int) {
+	m.trailingLevels = &i
+	m.addtrailingLevels = nil
+}
+
+// TrailingLevels returns the value of the "trailingLevels" field in the mutation.
+func (m *StrategyMutation) TrailingLevels() (r int, exists bool) {
+	v := m.trailingLevels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrailingLevels returns the old "trailingLevels" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldTrailingLevels(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrailingLevels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrailingLevels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrailingLevels: %w", err)
+	}
+	return oldValue.TrailingLevels, nil
+}
+
+// AddTrailingLevels adds i to the "trailingLevels" field.
+func (m *StrategyMutation) AddTrailingLevels(i int) {
+	if m.addtrailingLevels != nil {
+		*m.addtrailingLevels += i
+	} else {
+		m.addtrailingLevels = &i
+	}
+}
+
+// AddedTrailingLevels returns the value that was added to the "trailingLevels" field in this mutation.
+func (m *StrategyMutation) AddedTrailingLevels() (r int, exists bool) {
+	v := m.addtrailingLevels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTrailingLevels clears the value of the "trailingLevels" field.
+func (m *StrategyMutation) ClearTrailingLevels() {
+	m.trailingLevels = nil
+	m.addtrailingLevels = nil
+	m.clearedFields[strategy.FieldTrailingLevels] = struct{}{}
+}
+
+// TrailingLevelsCleared returns if the "trailingLevels" field was cleared in this mutation.
+func (m *StrategyMutation) TrailingLevelsCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldTrailingLevels]
+	return ok
+}
+
+// ResetTrailingLevels resets all changes to the "trailingLevels" field.
+func (m *StrategyMutation) ResetTrailingLevels() {
+	m.trailingLevels = nil
+	m.addtrailingLevels = nil
+	delete(m.clearedFields, strategy.FieldTrailingLevels)
+}
+
+// SetTrailingPriceUpperLimit sets the "trailingPriceUpperLimit" field.
+func (m *StrategyMutation) SetTrailingPriceUpperLimit(d decimal.Decimal) {
+	m.trailingPriceUpperLimit = &d
+}
+
+// TrailingPriceUpperLimit returns the value of the "trailingPriceUpperLimit" field in the mutation.
+func (m *StrategyMutation) TrailingPriceUpperLimit() (r decimal.Decimal, exists bool) {
+	v := m.trailingPriceUpperLimit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrailingPriceUpperLimit returns the old "trailingPriceUpperLimit" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldTrailingPriceUpperLimit(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrailingPriceUpperLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrailingPriceUpperLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrailingPriceUpperLimit: %w", err)
+	}
+	return oldValue.TrailingPriceUpperLimit, nil
+}
+
+// ClearTrailingPriceUpperLimit clears the value of the "trailingPriceUpperLimit" field.
+func (m *StrategyMutation) ClearTrailingPriceUpperLimit() {
+	m.trailingPriceUpperLimit = nil
+	m.clearedFields[strategy.FieldTrailingPriceUpperLimit] = struct{}{}
+}
+
+// TrailingPriceUpperLimitCleared returns if the "trailingPriceUpperLimit" field was cleared in this mutation.
+func (m *StrategyMutation) TrailingPriceUpperLimitCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldTrailingPriceUpperLimit]
+	return ok
+}
+
+// ResetTrailingPriceUpperLimit resets all changes to the "trailingPriceUpperLimit" field.
+func (m *StrategyMutation) ResetTrailingPriceUpperLimit() {
+	m.trailingPriceUpperLimit = nil
+	delete(m.clearedFields, strategy.FieldTrailingPriceUpperLimit)
+}
+
+// SetTrailingPriceLowerLimit sets the "trailingPriceLowerLimit" field.
+func (m *StrategyMutation) SetTrailingPriceLowerLimit(d decimal.Decimal) {
+	m.trailingPriceLowerLimit = &d
+}
+
+// TrailingPriceLowerLimit returns the value of the "trailingPriceLowerLimit" field in the mutation.
+func (m *StrategyMutation) TrailingPriceLowerLimit() (r decimal.Decimal, exists bool) {
+	v := m.trailingPriceLowerLimit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrailingPriceLowerLimit returns the old "trailingPriceLowerLimit" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldTrailingPriceLowerLimit(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrailingPriceLowerLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrailingPriceLowerLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrailingPriceLowerLimit: %w", err)
+	}
+	return oldValue.TrailingPriceLowerLimit, nil
+}
+
+// ClearTrailingPriceLowerLimit clears the value of the "trailingPriceLowerLimit" field.
+func (m *StrategyMutation) ClearTrailingPriceLowerLimit() {
+	m.trailingPriceLowerLimit = nil
+	m.clearedFields[strategy.FieldTrailingPriceLowerLimit] = struct{}{}
+}
+
+// TrailingPriceLowerLimitCleared returns if the "trailingPriceLowerLimit" field was cleared in this mutation.
+func (m *StrategyMutation) TrailingPriceLowerLimitCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldTrailingPriceLowerLimit]
+	return ok
+}
+
+// ResetTrailingPriceLowerLimit resets all changes to the "trailingPriceLowerLimit" field.
+func (m *StrategyMutation) ResetTrailingPriceLowerLimit() {
+	m.trailingPriceLowerLimit = nil
+	delete(m.clearedFields, strategy.FieldTrailingPriceLowerLimit)
+}
+
+// SetAtrPeriod sets the "atrPeriod" field.
+func (m *StrategyMutation) SetAtrPeriod(i int) {
+	m.atrPeriod = &i
+	m.addatrPeriod = nil
+}
+
+// AtrPeriod returns the value of the "atrPeriod" field in the mutation.
+func (m *StrategyMutation) AtrPeriod() (r int, exists bool) {
+	v := m.atrPeriod
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAtrPeriod returns the old "atrPeriod" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldAtrPeriod(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAtrPeriod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAtrPeriod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAtrPeriod: %w", err)
+	}
+	return oldValue.AtrPeriod, nil
+}
+
+// AddAtrPeriod adds i to the "atrPeriod" field.
+func (m *StrategyMutation) AddAtrPeriod(i int) {
+	if m.addatrPeriod != nil {
+		*m.addatrPeriod += i
+	} else {
+		m.addatrPeriod = &i
+	}
+}
+
+// AddedAtrPeriod returns the value that was added to the "atrPeriod" field in this mutation.
+func (m *StrategyMutation) AddedAtrPeriod() (r int, exists bool) {
+	v := m.addatrPeriod
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAtrPeriod clears the value of the "atrPeriod" field.
+func (m *StrategyMutation) ClearAtrPeriod() {
+	m.atrPeriod = nil
+	m.addatrPeriod = nil
+	m.clearedFields[strategy.FieldAtrPeriod] = struct{}{}
+}
+
+// AtrPeriodCleared returns if the "atrPeriod" field was cleared in this mutation.
+func (m *StrategyMutation) AtrPeriodCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldAtrPeriod]
+	return ok
+}
+
+// ResetAtrPeriod resets all changes to the "atrPeriod" field.
+func (m *StrategyMutation) ResetAtrPeriod() {
+	m.atrPeriod = nil
+	m.addatrPeriod = nil
+	delete(m.clearedFields, strategy.FieldAtrPeriod)
+}
+
+// SetAtrMultiplier sets the "atrMultiplier" field.
+func (m *StrategyMutation) SetAtrMultiplier(d decimal.Decimal) {
+	m.atrMultiplier = &d
+}
+
+// AtrMultiplier returns the value of the "atrMultiplier" field in the mutation.
+func (m *StrategyMutation) AtrMultiplier() (r decimal.Decimal, exists bool) {
+	v := m.atrMultiplier
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAtrMultiplier returns the old "atrMultiplier" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldAtrMultiplier(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAtrMultiplier is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAtrMultiplier requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAtrMultiplier: %w", err)
+	}
+	return oldValue.AtrMultiplier, nil
+}
+
+// ClearAtrMultiplier clears the value of the "atrMultiplier" field.
+func (m *StrategyMutation) ClearAtrMultiplier() {
+	m.atrMultiplier = nil
+	m.clearedFields[strategy.FieldAtrMultiplier] = struct{}{}
+}
+
+// AtrMultiplierCleared returns if the "atrMultiplier" field was cleared in this mutation.
+func (m *StrategyMutation) AtrMultiplierCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldAtrMultiplier]
+	return ok
+}
+
+// ResetAtrMultiplier resets all changes to the "atrMultiplier" field.
+func (m *StrategyMutation) ResetAtrMultiplier() {
+	m.atrMultiplier = nil
+	delete(m.clearedFields, strategy.FieldAtrMultiplier)
+}
+
+// SetAdaptiveThreshold sets the "adaptiveThreshold" field.
+func (m *StrategyMutation) SetAdaptiveThreshold(d decimal.Decimal) {
+	m.adaptiveThreshold = &d
+}
+
+// AdaptiveThreshold returns the value of the "adaptiveThreshold" field in the mutation.
+func (m *StrategyMutation) AdaptiveThreshold() (r decimal.Decimal, exists bool) {
+	v := m.adaptiveThreshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAdaptiveThreshold returns the old "adaptiveThreshold" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldAdaptiveThreshold(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAdaptiveThreshold is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAdaptiveThreshold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAdaptiveThreshold: %w", err)
+	}
+	return oldValue.AdaptiveThreshold, nil
+}
+
+// ClearAdaptiveThreshold clears the value of the "adaptiveThreshold" field.
+func (m *StrategyMutation) ClearAdaptiveThreshold() {
+	m.adaptiveThreshold = nil
+	m.clearedFields[strategy.FieldAdaptiveThreshold] = struct{}{}
+}
+
+// AdaptiveThresholdCleared returns if the "adaptiveThreshold" field was cleared in this mutation.
+func (m *StrategyMutation) AdaptiveThresholdCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldAdaptiveThreshold]
+	return ok
+}
+
+// ResetAdaptiveThreshold resets all changes to the "adaptiveThreshold" field.
+func (m *StrategyMutation) ResetAdaptiveThreshold() {
+	m.adaptiveThreshold = nil
+	delete(m.clearedFields, strategy.FieldAdaptiveThreshold)
+}
+
+// SetAdaptiveIntervalHours sets the "adaptiveIntervalHours" field.
+func (m *StrategyMutation) SetAdaptiveIntervalHours(i int) {
+	m.adaptiveIntervalHours = &i
+	m.addadaptiveIntervalHours = nil
+}
+
+// AdaptiveIntervalHours returns the value of the "adaptiveIntervalHours" field in the mutation.
+func (m *StrategyMutation) AdaptiveIntervalHours() (r int, exists bool) {
+	v := m.adaptiveIntervalHours
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAdaptiveIntervalHours returns the old "adaptiveIntervalHours" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldAdaptiveIntervalHours(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAdaptiveIntervalHours is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAdaptiveIntervalHours requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAdaptiveIntervalHours: %w", err)
+	}
+	return oldValue.AdaptiveIntervalHours, nil
+}
+
+// AddAdaptiveIntervalHours adds i to the "adaptiveIntervalHours" field.
+func (m *StrategyMutation) AddAdaptiveIntervalHours(i int) {
+	if m.addadaptiveIntervalHours != nil {
+		*m.addadaptiveIntervalHours += i
+	} else {
+		m.addadaptiveIntervalHours = &i
+	}
+}
+
+// AddedAdaptiveIntervalHours returns the value that was added to the "adaptiveIntervalHours" field in this mutation.
+func (m *StrategyMutation) AddedAdaptiveIntervalHours() (r int, exists bool) {
+	v := m.addadaptiveIntervalHours
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAdaptiveIntervalHours clears the value of the "adaptiveIntervalHours" field.
+func (m *StrategyMutation) ClearAdaptiveIntervalHours() {
+	m.adaptiveIntervalHours = nil
+	m.addadaptiveIntervalHours = nil
+	m.clearedFields[strategy.FieldAdaptiveIntervalHours] = struct{}{}
+}
+
+// AdaptiveIntervalHoursCleared returns if the "adaptiveIntervalHours" field was cleared in this mutation.
+func (m *StrategyMutation) AdaptiveIntervalHoursCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldAdaptiveIntervalHours]
+	return ok
+}
+
+// ResetAdaptiveIntervalHours resets all changes to the "adaptiveIntervalHours" field.
+func (m *StrategyMutation) ResetAdaptiveIntervalHours() {
+	m.adaptiveIntervalHours = nil
+	m.addadaptiveIntervalHours = nil
+	delete(m.clearedFields, strategy.FieldAdaptiveIntervalHours)
+}
+
+// SetAdaptiveAtr sets the "adaptiveAtr" field.
+func (m *StrategyMutation) SetAdaptiveAtr(d decimal.Decimal) {
+	m.adaptiveAtr = &d
+}
+
+// AdaptiveAtr returns the value of the "adaptiveAtr" field in the mutation.
+func (m *StrategyMutation) AdaptiveAtr() (r decimal.Decimal, exists bool) {
+	v := m.adaptiveAtr
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAdaptiveAtr returns the old "adaptiveAtr" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldAdaptiveAtr(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAdaptiveAtr is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAdaptiveAtr requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAdaptiveAtr: %w", err)
+	}
+	return oldValue.AdaptiveAtr, nil
+}
+
+// ClearAdaptiveAtr clears the value of the "adaptiveAtr" field.
+func (m *StrategyMutation) ClearAdaptiveAtr() {
+	m.adaptiveAtr = nil
+	m.clearedFields[strategy.FieldAdaptiveAtr] = struct{}{}
+}
+
+// AdaptiveAtrCleared returns if the "adaptiveAtr" field was cleared in this mutation.
+func (m *StrategyMutation) AdaptiveAtrCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldAdaptiveAtr]
+	return ok
+}
+
+// ResetAdaptiveAtr resets all changes to the "adaptiveAtr" field.
+func (m *StrategyMutation) ResetAdaptiveAtr() {
+	m.adaptiveAtr = nil
+	delete(m.clearedFields, strategy.FieldAdaptiveAtr)
+}
+
+// SetAdaptiveUpdatedAt sets the "adaptiveUpdatedAt" field.
+func (m *StrategyMutation) SetAdaptiveUpdatedAt(t time.Time) {
+	m.adaptiveUpdatedAt = &t
+}
+
+// AdaptiveUpdatedAt returns the value of the "adaptiveUpdatedAt" field in the mutation.
+func (m *StrategyMutation) AdaptiveUpdatedAt() (r time.Time, exists bool) {
+	v := m.adaptiveUpdatedAt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAdaptiveUpdatedAt returns the old "adaptiveUpdatedAt" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldAdaptiveUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAdaptiveUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAdaptiveUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAdaptiveUpdatedAt: %w", err)
+	}
+	return oldValue.AdaptiveUpdatedAt, nil
+}
+
+// ClearAdaptiveUpdatedAt clears the value of the "adaptiveUpdatedAt" field.
+func (m *StrategyMutation) ClearAdaptiveUpdatedAt() {
+	m.adaptiveUpdatedAt = nil
+	m.clearedFields[strategy.FieldAdaptiveUpdatedAt] = struct{}{}
+}
+
+// AdaptiveUpdatedAtCleared returns if the "adaptiveUpdatedAt" field was cleared in this mutation.
+func (m *StrategyMutation) AdaptiveUpdatedAtCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldAdaptiveUpdatedAt]
+	return ok
+}
+
+// ResetAdaptiveUpdatedAt resets all changes to the "adaptiveUpdatedAt" field.
+func (m *StrategyMutation) ResetAdaptiveUpdatedAt() {
+	m.adaptiveUpdatedAt = nil
+	delete(m.clearedFields, strategy.FieldAdaptiveUpdatedAt)
+}
+
+// SetCancelRepairPolicy sets the "cancelRepairPolicy" field.
+func (m *StrategyMutation) SetCancelRepairPolicy(srp strategy.CancelRepairPolicy) {
+	m.cancelRepairPolicy = &srp
+}
+
+// CancelRepairPolicy returns the value of the "cancelRepairPolicy" field in the mutation.
+func (m *StrategyMutation) CancelRepairPolicy() (r strategy.CancelRepairPolicy, exists bool) {
+	v := m.cancelRepairPolicy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCancelRepairPolicy returns the old "cancelRepairPolicy" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldCancelRepairPolicy(ctx context.Context) (v strategy.CancelRepairPolicy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCancelRepairPolicy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCancelRepairPolicy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCancelRepairPolicy: %w", err)
+	}
+	return oldValue.CancelRepairPolicy, nil
+}
+
+// ResetCancelRepairPolicy resets all changes to the "cancelRepairPolicy" field.
+func (m *StrategyMutation) ResetCancelRepairPolicy() {
+	m.cancelRepairPolicy = nil
+}
+
+// SetCancelRepairMaxAttempts sets the "cancelRepairMaxAttempts" field.
+func (m *StrategyMutation) SetCancelRepairMaxAttempts(i int) {
+	m.cancelRepairMaxAttempts = &i
+	m.addcancelRepairMaxAttempts = nil
+}
+
+// CancelRepairMaxAttempts returns the value of the "cancelRepairMaxAttempts" field in the mutation.
+func (m *StrategyMutation) CancelRepairMaxAttempts() (r int, exists bool) {
+	v := m.cancelRepairMaxAttempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCancelRepairMaxAttempts returns the old "cancelRepairMaxAttempts" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldCancelRepairMaxAttempts(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCancelRepairMaxAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCancelRepairMaxAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCancelRepairMaxAttempts: %w", err)
+	}
+	return oldValue.CancelRepairMaxAttempts, nil
+}
+
+// AddCancelRepairMaxAttempts adds i to the "cancelRepairMaxAttempts" field.
+func (m *StrategyMutation) AddCancelRepairMaxAttempts(i int) {
+	if m.addcancelRepairMaxAttempts != nil {
+		*m.addcancelRepairMaxAttempts += i
+	} else {
+		m.addcancelRepairMaxAttempts = &i
+	}
+}
+
+// AddedCancelRepairMaxAttempts returns the value that was added to the "cancelRepairMaxAttempts" field in this mutation.
+func (m *StrategyMutation) AddedCancelRepairMaxAttempts() (r int, exists bool) {
+	v := m.addcancelRepairMaxAttempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCancelRepairMaxAttempts clears the value of the "cancelRepairMaxAttempts" field.
+func (m *StrategyMutation) ClearCancelRepairMaxAttempts() {
+	m.cancelRepairMaxAttempts = nil
+	m.addcancelRepairMaxAttempts = nil
+	m.clearedFields[strategy.FieldCancelRepairMaxAttempts] = struct{}{}
+}
+
+// CancelRepairMaxAttemptsCleared returns if the "cancelRepairMaxAttempts" field was cleared in this mutation.
+func (m *StrategyMutation) CancelRepairMaxAttemptsCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldCancelRepairMaxAttempts]
+	return ok
+}
+
+// ResetCancelRepairMaxAttempts resets all changes to the "cancelRepairMaxAttempts" field.
+func (m *StrategyMutation) ResetCancelRepairMaxAttempts() {
+	m.cancelRepairMaxAttempts = nil
+	m.addcancelRepairMaxAttempts = nil
+	delete(m.clearedFields, strategy.FieldCancelRepairMaxAttempts)
+}
+
+// SetReconcilePolicy sets the "reconcilePolicy" field.
+func (m *StrategyMutation) SetReconcilePolicy(sp strategy.ReconcilePolicy) {
+	m.reconcilePolicy = &sp
+}
+
+// ReconcilePolicy returns the value of the "reconcilePolicy" field in the mutation.
+func (m *StrategyMutation) ReconcilePolicy() (r strategy.ReconcilePolicy, exists bool) {
+	v := m.reconcilePolicy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReconcilePolicy returns the old "reconcilePolicy" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldReconcilePolicy(ctx context.Context) (v strategy.ReconcilePolicy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReconcilePolicy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReconcilePolicy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReconcilePolicy: %w", err)
+	}
+	return oldValue.ReconcilePolicy, nil
+}
+
+// ResetReconcilePolicy resets all changes to the "reconcilePolicy" field.
+func (m *StrategyMutation) ResetReconcilePolicy() {
+	m.reconcilePolicy = nil
+}
+
+// SetPartialFillThreshold sets the "partialFillThreshold" field.
+func (m *StrategyMutation) SetPartialFillThreshold(d decimal.Decimal) {
+	m.partialFillThreshold = &d
+}
+
+// PartialFillThreshold returns the value of the "partialFillThreshold" field in the mutation.
+func (m *StrategyMutation) PartialFillThreshold() (r decimal.Decimal, exists bool) {
+	v := m.partialFillThreshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPartialFillThreshold returns the old "partialFillThreshold" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldPartialFillThreshold(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPartialFillThreshold is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPartialFillThreshold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPartialFillThreshold: %w", err)
+	}
+	return oldValue.PartialFillThreshold, nil
+}
+
+// ClearPartialFillThreshold clears the value of the "partialFillThreshold" field.
+func (m *StrategyMutation) ClearPartialFillThreshold() {
+	m.partialFillThreshold = nil
+	m.clearedFields[strategy.FieldPartialFillThreshold] = struct{}{}
+}
+
+// PartialFillThresholdCleared returns if the "partialFillThreshold" field was cleared in this mutation.
+func (m *StrategyMutation) PartialFillThresholdCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldPartialFillThreshold]
+	return ok
+}
+
+// ResetPartialFillThreshold resets all changes to the "partialFillThreshold" field.
+func (m *StrategyMutation) ResetPartialFillThreshold() {
+	m.partialFillThreshold = nil
+	delete(m.clearedFields, strategy.FieldPartialFillThreshold)
+}
+
+// SetEnablePushNotification sets the "enablePushNotification" field.
+func (m *StrategyMutation) SetEnablePushNotification(b bool) {
+	m.enablePushNotification = &b
+}
+
+// EnablePushNotification returns the value of the "enablePushNotification" field in the mutation.
+func (m *StrategyMutation) EnablePushNotification() (r bool, exists bool) {
+	v := m.enablePushNotification
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnablePushNotification returns the old "enablePushNotification" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldEnablePushNotification(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnablePushNotification is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnablePushNotification requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnablePushNotification: %w", err)
+	}
+	return oldValue.EnablePushNotification, nil
+}
+
+// ResetEnablePushNotification resets all changes to the "enablePushNotification" field.
+func (m *StrategyMutation) ResetEnablePushNotification() {
+	m.enablePushNotification = nil
+}
+
+// SetEnablePushMatchedNotification sets the "enablePushMatchedNotification" field.
+func (m *StrategyMutation) SetEnablePushMatchedNotification(b bool) {
+	m.enablePushMatchedNotification = &b
+}
+
+// EnablePushMatchedNotification returns the value of the "enablePushMatchedNotification" field in the mutation.
+func (m *StrategyMutation) EnablePushMatchedNotification() (r bool, exists bool) {
+	v := m.enablePushMatchedNotification
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnablePushMatchedNotification returns the old "enablePushMatchedNotification" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldEnablePushMatchedNotification(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnablePushMatchedNotification is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnablePushMatchedNotification requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnablePushMatchedNotification: %w", err)
+	}
+	return oldValue.EnablePushMatchedNotification, nil
+}
+
+// ClearEnablePushMatchedNotification clears the value of the "enablePushMatchedNotification" field.
+func (m *StrategyMutation) ClearEnablePushMatchedNotification() {
+	m.enablePushMatchedNotification = nil
+	m.clearedFields[strategy.FieldEnablePushMatchedNotification] = struct{}{}
+}
+
+// EnablePushMatchedNotificationCleared returns if the "enablePushMatchedNotification" field was cleared in this mutation.
+func (m *StrategyMutation) EnablePushMatchedNotificationCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldEnablePushMatchedNotification]
+	return ok
+}
+
+// ResetEnablePushMatchedNotification resets all changes to the "enablePushMatchedNotification" field.
+func (m *StrategyMutation) ResetEnablePushMatchedNotification() {
+	m.enablePushMatchedNotification = nil
+	delete(m.clearedFields, strategy.FieldEnablePushMatchedNotification)
+}
+
+// SetLastLowerThresholdAlertTime sets the "lastLowerThresholdAlertTime" field.
+func (m *StrategyMutation) SetLastLowerThresholdAlertTime(t time.Time) {
+	m.lastLowerThresholdAlertTime = &t
+}
+
+// LastLowerThresholdAlertTime returns the value of the "lastLowerThresholdAlertTime" field in the mutation.
+func (m *StrategyMutation) LastLowerThresholdAlertTime() (r time.Time, exists bool) {
+	v := m.lastLowerThresholdAlertTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastLowerThresholdAlertTime returns the old "lastLowerThresholdAlertTime" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldLastLowerThresholdAlertTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastLowerThresholdAlertTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastLowerThresholdAlertTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastLowerThresholdAlertTime: %w", err)
+	}
+	return oldValue.LastLowerThresholdAlertTime, nil
+}
+
+// ClearLastLowerThresholdAlertTime clears the value of the "lastLowerThresholdAlertTime" field.
+func (m *StrategyMutation) ClearLastLowerThresholdAlertTime() {
+	m.lastLowerThresholdAlertTime = nil
+	m.clearedFields[strategy.FieldLastLowerThresholdAlertTime] = struct{}{}
+}
+
+// LastLowerThresholdAlertTimeCleared returns if the "lastLowerThresholdAlertTime" field was cleared in this mutation.
+func (m *StrategyMutation) LastLowerThresholdAlertTimeCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldLastLowerThresholdAlertTime]
+	return ok
+}
+
+// ResetLastLowerThresholdAlertTime resets all changes to the "lastLowerThresholdAlertTime" field.
+func (m *StrategyMutation) ResetLastLowerThresholdAlertTime() {
+	m.lastLowerThresholdAlertTime = nil
+	delete(m.clearedFields, strategy.FieldLastLowerThresholdAlertTime)
+}
+
+// SetLastUpperThresholdAlertTime sets the "lastUpperThresholdAlertTime" field.
+func (m *StrategyMutation) SetLastUpperThresholdAlertTime(t time.Time) {
+	m.lastUpperThresholdAlertTime = &t
+}
+
+// LastUpperThresholdAlertTime returns the value of the "lastUpperThresholdAlertTime" field in the mutation.
+func (m *StrategyMutation) LastUpperThresholdAlertTime() (r time.Time, exists bool) {
+	v := m.lastUpperThresholdAlertTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUpperThresholdAlertTime returns the old "lastUpperThresholdAlertTime" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldLastUpperThresholdAlertTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUpperThresholdAlertTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUpperThresholdAlertTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUpperThresholdAlertTime: %w", err)
+	}
+	return oldValue.LastUpperThresholdAlertTime, nil
+}
+
+// ClearLastUpperThresholdAlertTime clears the value of the "lastUpperThresholdAlertTime" field.
+func (m *StrategyMutation) ClearLastUpperThresholdAlertTime() {
+	m.lastUpperThresholdAlertTime = nil
+	m.clearedFields[strategy.FieldLastUpperThresholdAlertTime] = struct{}{}
+}
+
+// LastUpperThresholdAlertTimeCleared returns if the "lastUpperThresholdAlertTime" field was cleared in this mutation.
+func (m *StrategyMutation) LastUpperThresholdAlertTimeCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldLastUpperThresholdAlertTime]
+	return ok
+}
+
+// ResetLastUpperThresholdAlertTime resets all changes to the "lastUpperThresholdAlertTime" field.
+func (m *StrategyMutation) ResetLastUpperThresholdAlertTime() {
+	m.lastUpperThresholdAlertTime = nil
+	delete(m.clearedFields, strategy.FieldLastUpperThresholdAlertTime)
+}
+
+// SetStatus sets the "status" field.
+func (m *StrategyMutation) SetStatus(s strategy.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *StrategyMutation) Status() (r strategy.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldStatus(ctx context.Context) (v strategy.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *StrategyMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetExchangeApiKey sets the "exchangeApiKey" field.
+func (m *StrategyMutation) SetExchangeApiKey(s string) {
+	m.exchangeApiKey = &s
+}
+
+// ExchangeApiKey returns the value of the "exchangeApiKey" field in the mutation.
+func (m *StrategyMutation) ExchangeApiKey() (r string, exists bool) {
+	v := m.exchangeApiKey
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExchangeApiKey returns the old "exchangeApiKey" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldExchangeApiKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExchangeApiKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExchangeApiKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExchangeApiKey: %w", err)
+	}
+	return oldValue.ExchangeApiKey, nil
+}
+
+// ResetExchangeApiKey resets all changes to the "exchangeApiKey" field.
+func (m *StrategyMutation) ResetExchangeApiKey() {
+	m.exchangeApiKey = nil
+}
+
+// SetExchangeSecretKey sets the "exchangeSecretKey" field.
+func (m *StrategyMutation) SetExchangeSecretKey(s string) {
+	m.exchangeSecretKey = &s
+}
+
+// ExchangeSecretKey returns the value of the "exchangeSecretKey" field in the mutation.
+func (m *StrategyMutation) ExchangeSecretKey() (r string, exists bool) {
+	v := m.exchangeSecretKey
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExchangeSecretKey returns the old "exchangeSecretKey" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldExchangeSecretKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExchangeSecretKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExchangeSecretKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExchangeSecretKey: %w", err)
+	}
+	return oldValue.ExchangeSecretKey, nil
+}
+
+// ResetExchangeSecretKey resets all changes to the "exchangeSecretKey" field.
+func (m *StrategyMutation) ResetExchangeSecretKey() {
+	m.exchangeSecretKey = nil
+}
+
+// SetExchangePassphrase sets the "exchangePassphrase" field.
+func (m *StrategyMutation) SetExchangePassphrase(s string) {
+	m.exchangePassphrase = &s
+}
+
+// ExchangePassphrase returns the value of the "exchangePassphrase" field in the mutation.
+func (m *StrategyMutation) ExchangePassphrase() (r string, exists bool) {
+	v := m.exchangePassphrase
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExchangePassphrase returns the old "exchangePassphrase" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldExchangePassphrase(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExchangePassphrase is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExchangePassphrase requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExchangePassphrase: %w", err)
+	}
+	return oldValue.ExchangePassphrase, nil
+}
+
+// ResetExchangePassphrase resets all changes to the "exchangePassphrase" field.
+func (m *StrategyMutation) ResetExchangePassphrase() {
+	m.exchangePassphrase = nil
+}
+
+// SetExchangeTestnet sets the "exchangeTestnet" field.
+func (m *StrategyMutation) SetExchangeTestnet(b bool) {
+	m.exchangeTestnet = &b
+}
+
+// ExchangeTestnet returns the value of the "exchangeTestnet" field in the mutation.
+func (m *StrategyMutation) ExchangeTestnet() (r bool, exists bool) {
+	v := m.exchangeTestnet
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExchangeTestnet returns the old "exchangeTestnet" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldExchangeTestnet(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExchangeTestnet is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExchangeTestnet requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExchangeTestnet: %w", err)
+	}
+	return oldValue.ExchangeTestnet, nil
+}
+
+// ResetExchangeTestnet resets all changes to the "exchangeTestnet" field.
+func (m *StrategyMutation) ResetExchangeTestnet() {
+	m.exchangeTestnet = nil
+}
+
+// SetAccountId sets the "accountId" field.
+func (m *StrategyMutation) SetAccountId(i int) {
+	m.exchangeAccount = &i
+}
+
+// AccountId returns the value of the "accountId" field in the mutation.
+func (m *StrategyMutation) AccountId() (r int, exists bool) {
+	v := m.exchangeAccount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountId returns the old "accountId" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldAccountId(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountId is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountId requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountId: %w", err)
+	}
+	return oldValue.AccountId, nil
+}
+
+// ClearAccountId clears the value of the "accountId" field.
+func (m *StrategyMutation) ClearAccountId() {
+	m.exchangeAccount = nil
+	m.clearedFields[strategy.FieldAccountId] = struct{}{}
+}
+
+// AccountIdCleared returns if the "accountId" field was cleared in this mutation.
+func (m *StrategyMutation) AccountIdCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldAccountId]
+	return ok
+}
+
+// ResetAccountId resets all changes to the "accountId" field.
+func (m *StrategyMutation) ResetAccountId() {
+	m.exchangeAccount = nil
+	delete(m.clearedFields, strategy.FieldAccountId)
+}
+
+// SetStartTime sets the "startTime" field.
+func (m *StrategyMutation) SetStartTime(t time.Time) {
+	m.startTime = &t
+}
+
+// StartTime returns the value of the "startTime" field in the mutation.
+func (m *StrategyMutation) StartTime() (r time.Time, exists bool) {
+	v := m.startTime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartTime returns the old "startTime" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldStartTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
+	}
+	return oldValue.StartTime, nil
+}
+
+// ClearStartTime clears the value of the "startTime" field.
+func (m *StrategyMutation) ClearStartTime() {
+	m.startTime = nil
+	m.clearedFields[strategy.FieldStartTime] = struct{}{}
+}
+
+// StartTimeCleared returns if the "startTime" field was cleared in this mutation.
+func (m *StrategyMutation) StartTimeCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldStartTime]
+	return ok
+}
+
+// ResetStartTime resets all changes to the "startTime" field.
+func (m *StrategyMutation) ResetStartTime() {
+	m.startTime = nil
+	delete(m.clearedFields, strategy.FieldStartTime)
+}
+
+// SetExchangeAccountID sets the "exchangeAccount" edge to the ExchangeAccount entity by id.
+func (m *StrategyMutation) SetExchangeAccountID(id int) {
+	m.exchangeAccount = &id
+}
+
+// ClearExchangeAccount clears the "exchangeAccount" edge to the ExchangeAccount entity.
+func (m *StrategyMutation) ClearExchangeAccount() {
+	m.clearedexchangeAccount = true
+	m.clearedFields[strategy.FieldAccountId] = struct{}{}
+}
+
+// ExchangeAccountCleared reports if the "exchangeAccount" edge to the ExchangeAccount entity was cleared.
+func (m *StrategyMutation) ExchangeAccountCleared() bool {
+	return m.AccountIdCleared() || m.clearedexchangeAccount
+}
+
+// ExchangeAccountID returns the "exchangeAccount" edge ID in the mutation.
+func (m *StrategyMutation) ExchangeAccountID() (id int, exists bool) {
+	if m.exchangeAccount != nil {
+		return *m.exchangeAccount, true
+	}
+	return
+}
+
+// ExchangeAccountIDs returns the "exchangeAccount" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ExchangeAccountID instead. It exists only for internal usage by the builders.
+func (m *StrategyMutation) ExchangeAccountIDs() (ids []int) {
+	if id := m.exchangeAccount; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetExchangeAccount resets all changes to the "exchangeAccount" edge.
+func (m *StrategyMutation) ResetExchangeAccount() {
+	m.exchangeAccount = nil
+	m.clearedexchangeAccount = false
+}
+
+// Where appends a list predicates to the StrategyMutation builder.
+func (m *StrategyMutation) Where(ps ...predicate.Strategy) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the StrategyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *StrategyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Strategy, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *StrategyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *StrategyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Strategy).
+func (m *StrategyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *StrategyMutation) Fields() []string {
+	fields := make([]string, 0, 55)
+	if m.create_time != nil {
+		fields = append(fields, strategy.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, strategy.FieldUpdateTime)
+	}
+	if m.guid != nil {
+		fields = append(fields, strategy.FieldGUID)
+	}
+	if m.owner != nil {
+		fields = append(fields, strategy.FieldOwner)
+	}
+	if m.exchange != nil {
+		fields = append(fields, strategy.FieldExchange)
+	}
+	if m.symbol != nil {
+		fields = append(fields, strategy.FieldSymbol)
+	}
+	if m.account != nil {
+		fields = append(fields, strategy.FieldAccount)
+	}
+	if m.mode != nil {
+		fields = append(fields, strategy.FieldMode)
+	}
+	if m.marginMode != nil {
+		fields = append(fields, strategy.FieldMarginMode)
+	}
+	if m.quantityMode != nil {
+		fields = append(fields, strategy.FieldQuantityMode)
+	}
+	if m.priceUpper != nil {
+		fields = append(fields, strategy.FieldPriceUpper)
+	}
+	if m.priceLower != nil {
+		fields = append(fields, strategy.FieldPriceLower)
+	}
+	if m.gridNum != nil {
+		fields = append(fields, strategy.FieldGridNum)
+	}
+	if m.leverage != nil {
+		fields = append(fields, strategy.FieldLeverage)
+	}
+	if m.initialOrderSize != nil {
+		fields = append(fields, strategy.FieldInitialOrderSize)
+	}
+	if m.sizingMode != nil {
+		fields = append(fields, strategy.FieldSizingMode)
+	}
+	if m.sizingFactor != nil {
+		fields = append(fields, strategy.FieldSizingFactor)
+	}
+	if m.sizingWeights != nil {
+		fields = append(fields, strategy.FieldSizingWeights)
+	}
+	if m.slippageBps != nil {
+		fields = append(fields, strategy.FieldSlippageBps)
+	}
+	if m.entryPrice != nil {
+		fields = append(fields, strategy.FieldEntryPrice)
+	}
+	if m.triggerStopLossPrice != nil {
+		fields = append(fields, strategy.FieldTriggerStopLossPrice)
+	}
+	if m.triggerTakeProfitPrice != nil {
+		fields = append(fields, strategy.FieldTriggerTakeProfitPrice)
+	}
+	if m.maxLossAmount != nil {
+		fields = append(fields, strategy.FieldMaxLossAmount)
+	}
+	if m.maxLossPercent != nil {
+		fields = append(fields, strategy.FieldMaxLossPercent)
+	}
+	if m.profitTargetAmount != nil {
+		fields = append(fields, strategy.FieldProfitTargetAmount)
+	}
+	if m.trailingStopLossAmount != nil {
+		fields = append(fields, strategy.FieldTrailingStopLossAmount)
+	}
+	if m.trailingStopLossPercent != nil {
+		fields = append(fields, strategy.FieldTrailingStopLossPercent)
+	}
+	if m.trailingTakeProfitActivationPrice != nil {
+		fields = append(fields, strategy.FieldTrailingTakeProfitActivationPrice)
+	}
+	if m.trailingTakeProfitAmount != nil {
+		fields = append(fields, strategy.FieldTrailingTakeProfitAmount)
+	}
+	if m.trailingTakeProfitPercent != nil {
+		fields = append(fields, strategy.FieldTrailingTakeProfitPercent)
+	}
+	if m.trailingExtremePrice != nil {
+		fields = append(fields, strategy.FieldTrailingExtremePrice)
+	}
+	if m.trailingLevels != nil {
+		fields = append(fields, strategy.FieldTrailingLevels)
+	}
+	if m.trailingPriceUpperLimit != nil {
+		fields = append(fields, strategy.FieldTrailingPriceUpperLimit)
+	}
+	if m.trailingPriceLowerLimit != nil {
+		fields = append(fields, strategy.FieldTrailingPriceLowerLimit)
+	}
+	if m.atrPeriod != nil {
+		fields = append(fields, strategy.FieldAtrPeriod)
+	}
+	if m.atrMultiplier != nil {
+		fields = append(fields, strategy.FieldAtrMultiplier)
+	}
+	if m.adaptiveThreshold != nil {
+		fields = append(fields, strategy.FieldAdaptiveThreshold)
+	}
+	if m.adaptiveIntervalHours != nil {
+		fields = append(fields, strategy.FieldAdaptiveIntervalHours)
+	}
+	if m.adaptiveAtr != nil {
+		fields = append(fields, strategy.FieldAdaptiveAtr)
+	}
+	if m.adaptiveUpdatedAt != nil {
+		fields = append(fields, strategy.FieldAdaptiveUpdatedAt)
+	}
+	if m.cancelRepairPolicy != nil {
+		fields = append(fields, strategy.FieldCancelRepairPolicy)
+	}
+	if m.cancelRepairMaxAttempts != nil {
+		fields = append(fields, strategy.FieldCancelRepairMaxAttempts)
+	}
+	if m.reconcilePolicy != nil {
+		fields = append(fields, strategy.FieldReconcilePolicy)
+	}
+	if m.partialFillThreshold != nil {
+		fields = append(fields, strategy.FieldPartialFillThreshold)
+	}
+	if m.enablePushNotification != nil {
+		fields = append(fields, strategy.FieldEnablePushNotification)
+	}
+	if m.enablePushMatchedNotification != nil {
+		fields = append(fields, strategy.FieldEnablePushMatchedNotification)
+	}
+	if m.lastLowerThresholdAlertTime != nil {
+		fields = append(fields, strategy.FieldLastLowerThresholdAlertTime)
+	}
+	if m.lastUpperThresholdAlertTime != nil {
+		fields = append(fields, strategy.FieldLastUpperThresholdAlertTime)
+	}
+	if m.status != nil {
+		fields = append(fields, strategy.FieldStatus)
+	}
+	if m.exchangeApiKey != nil {
+		fields = append(fields, strategy.FieldExchangeApiKey)
+	}
+	if m.exchangeSecretKey != nil {
+		fields = append(fields, strategy.FieldExchangeSecretKey)
+	}
+	if m.exchangePassphrase != nil {
+		fields = append(fields, strategy.FieldExchangePassphrase)
+	}
+	if m.exchangeTestnet != nil {
+		fields = append(fields, strategy.FieldExchangeTestnet)
+	}
+	if m.exchangeAccount != nil {
+		fields = append(fields, strategy.FieldAccountId)
+	}
+	if m.startTime != nil {
+		fields = append(fields, strategy.FieldStartTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *StrategyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case strategy.FieldCreateTime:
+		return m.CreateTime()
+	case strategy.FieldUpdateTime:
+		return m.UpdateTime()
+	case strategy.FieldGUID:
+		return m.GUID()
+	case strategy.FieldOwner:
+		return m.Owner()
+	case strategy.FieldExchange:
+		return m.Exchange()
+	case strategy.FieldSymbol:
+		return m.Symbol()
+	case strategy.FieldAccount:
+		return m.Account()
+	case strategy.FieldMode:
+		return m.Mode()
+	case strategy.FieldMarginMode:
+		return m.MarginMode()
+	case strategy.FieldQuantityMode:
+		return m.QuantityMode()
+	case strategy.FieldPriceUpper:
+		return m.PriceUpper()
+	case strategy.FieldPriceLower:
+		return m.PriceLower()
+	case strategy.FieldGridNum:
+		return m.GridNum()
+	case strategy.FieldLeverage:
+		return m.Leverage()
+	case strategy.FieldInitialOrderSize:
+		return m.InitialOrderSize()
+	case strategy.FieldSizingMode:
+		return m.SizingMode()
+	case strategy.FieldSizingFactor:
+		return m.SizingFactor()
+	case strategy.FieldSizingWeights:
+		return m.SizingWeights()
+	case strategy.FieldSlippageBps:
+		return m.SlippageBps()
+	case strategy.FieldEntryPrice:
+		return m.EntryPrice()
+	case strategy.FieldTriggerStopLossPrice:
+		return m.TriggerStopLossPrice()
+	case strategy.FieldTriggerTakeProfitPrice:
+		return m.TriggerTakeProfitPrice()
+	case strategy.FieldMaxLossAmount:
+		return m.MaxLossAmount()
+	case strategy.FieldMaxLossPercent:
+		return m.MaxLossPercent()
+	case strategy.FieldProfitTargetAmount:
+		return m.ProfitTargetAmount()
+	case strategy.FieldTrailingStopLossAmount:
+		return m.TrailingStopLossAmount()
+	case strategy.FieldTrailingStopLossPercent:
+		return m.TrailingStopLossPercent()
+	case strategy.FieldTrailingTakeProfitActivationPrice:
+		return m.TrailingTakeProfitActivationPrice()
+	case strategy.FieldTrailingTakeProfitAmount:
+		return m.TrailingTakeProfitAmount()
+	case strategy.FieldTrailingTakeProfitPercent:
+		return m.TrailingTakeProfitPercent()
+	case strategy.FieldTrailingExtremePrice:
+		return m.TrailingExtremePrice()
+	case strategy.FieldTrailingLevels:
+		return m.TrailingLevels()
+	case strategy.FieldTrailingPriceUpperLimit:
+		return m.TrailingPriceUpperLimit()
+	case strategy.FieldTrailingPriceLowerLimit:
+		return m.TrailingPriceLowerLimit()
+	case strategy.FieldAtrPeriod:
+		return m.AtrPeriod()
+	case strategy.FieldAtrMultiplier:
+		return m.AtrMultiplier()
+	case strategy.FieldAdaptiveThreshold:
+		return m.AdaptiveThreshold()
+	case strategy.FieldAdaptiveIntervalHours:
+		return m.AdaptiveIntervalHours()
+	case strategy.FieldAdaptiveAtr:
+		return m.AdaptiveAtr()
+	case strategy.FieldAdaptiveUpdatedAt:
+		return m.AdaptiveUpdatedAt()
+	case strategy.FieldCancelRepairPolicy:
+		return m.CancelRepairPolicy()
+	case strategy.FieldCancelRepairMaxAttempts:
+		return m.CancelRepairMaxAttempts()
+	case strategy.FieldReconcilePolicy:
+		return m.ReconcilePolicy()
+	case strategy.FieldPartialFillThreshold:
+		return m.PartialFillThreshold()
+	case strategy.FieldEnablePushNotification:
+		return m.EnablePushNotification()
+	case strategy.FieldEnablePushMatchedNotification:
+		return m.EnablePushMatchedNotification()
+	case strategy.FieldLastLowerThresholdAlertTime:
+		return m.LastLowerThresholdAlertTime()
+	case strategy.FieldLastUpperThresholdAlertTime:
+		return m.LastUpperThresholdAlertTime()
+	case strategy.FieldStatus:
+		return m.Status()
+	case strategy.FieldExchangeApiKey:
+		return m.ExchangeApiKey()
+	case strategy.FieldExchangeSecretKey:
+		return m.ExchangeSecretKey()
+	case strategy.FieldExchangePassphrase:
+		return m.ExchangePassphrase()
+	case strategy.FieldExchangeTestnet:
+		return m.ExchangeTestnet()
+	case strategy.FieldAccountId:
+		return m.AccountId()
+	case strategy.FieldStartTime:
+		return m.StartTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *StrategyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case strategy.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case strategy.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case strategy.FieldGUID:
+		return m.OldGUID(ctx)
+	case strategy.FieldOwner:
+		return m.OldOwner(ctx)
+	case strategy.FieldExchange:
+		return m.OldExchange(ctx)
+	case strategy.FieldSymbol:
+		return m.OldSymbol(ctx)
+	case strategy.FieldAccount:
+		return m.OldAccount(ctx)
+	case strategy.FieldMode:
+		return m.OldMode(ctx)
+	case strategy.FieldMarginMode:
+		return m.OldMarginMode(ctx)
+	case strategy.FieldQuantityMode:
+		return m.OldQuantityMode(ctx)
+	case strategy.FieldPriceUpper:
+		return m.OldPriceUpper(ctx)
+	case strategy.FieldPriceLower:
+		return m.OldPriceLower(ctx)
+	case strategy.FieldGridNum:
+		return m.OldGridNum(ctx)
+	case strategy.FieldLeverage:
+		return m.OldLeverage(ctx)
+	case strategy.FieldInitialOrderSize:
+		return m.OldInitialOrderSize(ctx)
+	case strategy.FieldSizingMode:
+		return m.OldSizingMode(ctx)
+	case strategy.FieldSizingFactor:
+		return m.OldSizingFactor(ctx)
+	case strategy.FieldSizingWeights:
+		return m.OldSizingWeights(ctx)
+	case strategy.FieldSlippageBps:
+		return m.OldSlippageBps(ctx)
+	case strategy.FieldEntryPrice:
+		return m.OldEntryPrice(ctx)
+	case strategy.FieldTriggerStopLossPrice:
+		return m.OldTriggerStopLossPrice(ctx)
+	case strategy.FieldTriggerTakeProfitPrice:
+		return m.OldTriggerTakeProfitPrice(ctx)
+	case strategy.FieldMaxLossAmount:
+		return m.OldMaxLossAmount(ctx)
+	case strategy.FieldMaxLossPercent:
+		return m.OldMaxLossPercent(ctx)
+	case strategy.FieldProfitTargetAmount:
+		return m.OldProfitTargetAmount(ctx)
+	case strategy.FieldTrailingStopLossAmount:
+		return m.OldTrailingStopLossAmount(ctx)
+	case strategy.FieldTrailingStopLossPercent:
+		return m.OldTrailingStopLossPercent(ctx)
+	case strategy.FieldTrailingTakeProfitActivationPrice:
+		return m.OldTrailingTakeProfitActivationPrice(ctx)
+	case strategy.FieldTrailingTakeProfitAmount:
+		return m.OldTrailingTakeProfitAmount(ctx)
+	case strategy.FieldTrailingTakeProfitPercent:
+		return m.OldTrailingTakeProfitPercent(ctx)
+	case strategy.FieldTrailingExtremePrice:
+		return m.OldTrailingExtremePrice(ctx)
+	case strategy.FieldTrailingLevels:
+		return m.OldTrailingLevels(ctx)
+	case strategy.FieldTrailingPriceUpperLimit:
+		return m.OldTrailingPriceUpperLimit(ctx)
+	case strategy.FieldTrailingPriceLowerLimit:
+		return m.OldTrailingPriceLowerLimit(ctx)
+	case strategy.FieldAtrPeriod:
+		return m.OldAtrPeriod(ctx)
+	case strategy.FieldAtrMultiplier:
+		return m.OldAtrMultiplier(ctx)
+	case strategy.FieldAdaptiveThreshold:
+		return m.OldAdaptiveThreshold(ctx)
+	case strategy.FieldAdaptiveIntervalHours:
+		return m.OldAdaptiveIntervalHours(ctx)
+	case strategy.FieldAdaptiveAtr:
+		return m.OldAdaptiveAtr(ctx)
+	case strategy.FieldAdaptiveUpdatedAt:
+		return m.OldAdaptiveUpdatedAt(ctx)
+	case strategy.FieldCancelRepairPolicy:
+		return m.OldCancelRepairPolicy(ctx)
+	case strategy.FieldCancelRepairMaxAttempts:
+		return m.OldCancelRepairMaxAttempts(ctx)
+	case strategy.FieldReconcilePolicy:
+		return m.OldReconcilePolicy(ctx)
+	case strategy.FieldPartialFillThreshold:
+		return m.OldPartialFillThreshold(ctx)
+	case strategy.FieldEnablePushNotification:
+		return m.OldEnablePushNotification(ctx)
+	case strategy.FieldEnablePushMatchedNotification:
+		return m.OldEnablePushMatchedNotification(ctx)
+	case strategy.FieldLastLowerThresholdAlertTime:
+		return m.OldLastLowerThresholdAlertTime(ctx)
+	case strategy.FieldLastUpperThresholdAlertTime:
+		return m.OldLastUpperThresholdAlertTime(ctx)
+	case strategy.FieldStatus:
+		return m.OldStatus(ctx)
+	case strategy.FieldExchangeApiKey:
+		return m.OldExchangeApiKey(ctx)
+	case strategy.FieldExchangeSecretKey:
+		return m.OldExchangeSecretKey(ctx)
+	case strategy.FieldExchangePassphrase:
+		return m.OldExchangePassphrase(ctx)
+	case strategy.FieldExchangeTestnet:
+		return m.OldExchangeTestnet(ctx)
+	case strategy.FieldAccountId:
+		return m.OldAccountId(ctx)
+	case strategy.FieldStartTime:
+		return m.OldStartTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown Strategy field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StrategyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case strategy.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case strategy.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case strategy.FieldGUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGUID(v)
+		return nil
+	case strategy.FieldOwner:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwner(v)
+		return nil
+	case strategy.FieldExchange:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExchange(v)
+		return nil
+	case strategy.FieldSymbol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSymbol(v)
+		return nil
+	case strategy.FieldAccount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccount(v)
+		return nil
+	case strategy.FieldMode:
+		v, ok := value.(strategy.Mode)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMode(v)
+		return nil
+	case strategy.FieldMarginMode:
+		v, ok := value.(strategy.MarginMode)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMarginMode(v)
+		return nil
+	case strategy.FieldQuantityMode:
+		v, ok := value.(strategy.QuantityMode)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantityMode(v)
+		return nil
+	case strategy.FieldPriceUpper:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriceUpper(v)
+		return nil
+	case strategy.FieldPriceLower:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriceLower(v)
+		return nil
+	case strategy.FieldGridNum:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGridNum(v)
+		return nil
+	case strategy.FieldLeverage:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeverage(v)
+		return nil
+	case strategy.FieldInitialOrderSize:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInitialOrderSize(v)
+		return nil
+	case strategy.FieldSizingMode:
+		v, ok := value.(strategy.SizingMode)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSizingMode(v)
+		return nil
+	case strategy.FieldSizingFactor:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSizingFactor(v)
+		return nil
+	case strategy.FieldSizingWeights:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSizingWeights(v)
+		return nil
+	case strategy.FieldSlippageBps:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlippageBps(v)
+		return nil
+	case strategy.FieldEntryPrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntryPrice(v)
+		return nil
+	case strategy.FieldTriggerStopLossPrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTriggerStopLossPrice(v)
+		return nil
+	case strategy.FieldTriggerTakeProfitPrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTriggerTakeProfitPrice(v)
+		return nil
+	case strategy.FieldMaxLossAmount:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxLossAmount(v)
+		return nil
+	case strategy.FieldMaxLossPercent:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxLossPercent(v)
+		return nil
+	case strategy.FieldProfitTargetAmount:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProfitTargetAmount(v)
+		return nil
+	case strategy.FieldTrailingStopLossAmount:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrailingStopLossAmount(v)
+		return nil
+	case strategy.FieldTrailingStopLossPercent:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrailingStopLossPercent(v)
+		return nil
+	case strategy.FieldTrailingTakeProfitActivationPrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrailingTakeProfitActivationPrice(v)
+		return nil
+	case strategy.FieldTrailingTakeProfitAmount:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrailingTakeProfitAmount(v)
+		return nil
+	case strategy.FieldTrailingTakeProfitPercent:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrailingTakeProfitPercent(v)
+		return nil
+	case strategy.FieldTrailingExtremePrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrailingExtremePrice(v)
+		return nil
+	case strategy.FieldTrailingLevels:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrailingLevels(v)
+		return nil
+	case strategy.FieldTrailingPriceUpperLimit:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrailingPriceUpperLimit(v)
+		return nil
+	case strategy.FieldTrailingPriceLowerLimit:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrailingPriceLowerLimit(v)
+		return nil
+	case strategy.FieldAtrPeriod:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAtrPeriod(v)
+		return nil
+	case strategy.FieldAtrMultiplier:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAtrMultiplier(v)
+		return nil
+	case strategy.FieldAdaptiveThreshold:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdaptiveThreshold(v)
+		return nil
+	case strategy.FieldAdaptiveIntervalHours:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdaptiveIntervalHours(v)
+		return nil
+	case strategy.FieldAdaptiveAtr:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdaptiveAtr(v)
+		return nil
+	case strategy.FieldAdaptiveUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdaptiveUpdatedAt(v)
+		return nil
+	case strategy.FieldCancelRepairPolicy:
+		v, ok := value.(strategy.CancelRepairPolicy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCancelRepairPolicy(v)
+		return nil
+	case strategy.FieldCancelRepairMaxAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCancelRepairMaxAttempts(v)
+		return nil
+	case strategy.FieldReconcilePolicy:
+		v, ok := value.(strategy.ReconcilePolicy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReconcilePolicy(v)
+		return nil
+	case strategy.FieldPartialFillThreshold:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPartialFillThreshold(v)
+		return nil
+	case strategy.FieldEnablePushNotification:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnablePushNotification(v)
+		return nil
+	case strategy.FieldEnablePushMatchedNotification:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnablePushMatchedNotification(v)
+		return nil
+	case strategy.FieldLastLowerThresholdAlertTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastLowerThresholdAlertTime(v)
+		return nil
+	case strategy.FieldLastUpperThresholdAlertTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUpperThresholdAlertTime(v)
+		return nil
+	case strategy.FieldStatus:
+		v, ok := value.(strategy.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case strategy.FieldExchangeApiKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExchangeApiKey(v)
+		return nil
+	case strategy.FieldExchangeSecretKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExchangeSecretKey(v)
+		return nil
+	case strategy.FieldExchangePassphrase:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExchangePassphrase(v)
+		return nil
+	case strategy.FieldExchangeTestnet:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExchangeTestnet(v)
+		return nil
+	case strategy.FieldAccountId:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountId(v)
+		return nil
+	case strategy.FieldStartTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Strategy field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *StrategyMutation) AddedFields() []string {
+	var fields []string
+	if m.addowner != nil {
+		fields = append(fields, strategy.FieldOwner)
+	}
+	if m.addgridNum != nil {
+		fields = append(fields, strategy.FieldGridNum)
+	}
+	if m.addleverage != nil {
+		fields = append(fields, strategy.FieldLeverage)
+	}
+	if m.addslippageBps != nil {
+		fields = append(fields, strategy.FieldSlippageBps)
+	}
+	if m.addtrailingLevels != nil {
+		fields = append(fields, strategy.FieldTrailingLevels)
+	}
+	if m.addatrPeriod != nil {
+		fields = append(fields, strategy.FieldAtrPeriod)
+	}
+	if m.addadaptiveIntervalHours != nil {
+		fields = append(fields, strategy.FieldAdaptiveIntervalHours)
+	}
+	if m.addcancelRepairMaxAttempts != nil {
+		fields = append(fields, strategy.FieldCancelRepairMaxAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *StrategyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case strategy.FieldOwner:
+		return m.AddedOwner()
+	case strategy.FieldGridNum:
+		return m.AddedGridNum()
+	case strategy.FieldLeverage:
+		return m.AddedLeverage()
+	case strategy.FieldSlippageBps:
+		return m.AddedSlippageBps()
+	case strategy.FieldTrailingLevels:
+		return m.AddedTrailingLevels()
+	case strategy.FieldAtrPeriod:
+		return m.AddedAtrPeriod()
+	case strategy.FieldAdaptiveIntervalHours:
+		return m.AddedAdaptiveIntervalHours()
+	case strategy.FieldCancelRepairMaxAttempts:
+		return m.AddedCancelRepairMaxAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StrategyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case strategy.FieldOwner:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOwner(v)
+		return nil
+	case strategy.FieldGridNum:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGridNum(v)
+		return nil
+	case strategy.FieldLeverage:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLeverage(v)
+		return nil
+	case strategy.FieldSlippageBps:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSlippageBps(v)
+		return nil
+	case strategy.FieldTrailingLevels:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTrailingLevels(v)
+		return nil
+	case strategy.FieldAtrPeriod:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAtrPeriod(v)
+		return nil
+	case strategy.FieldAdaptiveIntervalHours:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAdaptiveIntervalHours(v)
+		return nil
+	case strategy.FieldCancelRepairMaxAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCancelRepairMaxAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Strategy numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *StrategyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(strategy.FieldSizingFactor) {
+		fields = append(fields, strategy.FieldSizingFactor)
+	}
+	if m.FieldCleared(strategy.FieldSizingWeights) {
+		fields = append(fields, strategy.FieldSizingWeights)
+	}
+	if m.FieldCleared(strategy.FieldSlippageBps) {
+		fields = append(fields, strategy.FieldSlippageBps)
+	}
+	if m.FieldCleared(strategy.FieldEntryPrice) {
+		fields = append(fields, strategy.FieldEntryPrice)
+	}
+	if m.FieldCleared(strategy.FieldTriggerStopLossPrice) {
+		fields = append(fields, strategy.FieldTriggerStopLossPrice)
+	}
+	if m.FieldCleared(strategy.FieldTriggerTakeProfitPrice) {
+		fields = append(fields, strategy.FieldTriggerTakeProfitPrice)
+	}
+	if m.FieldCleared(strategy.FieldMaxLossAmount) {
+		fields = append(fields, strategy.FieldMaxLossAmount)
+	}
+	if m.FieldCleared(strategy.FieldMaxLossPercent) {
+		fields = append(fields, strategy.FieldMaxLossPercent)
+	}
+	if m.FieldCleared(strategy.FieldProfitTargetAmount) {
+		fields = append(fields, strategy.FieldProfitTargetAmount)
+	}
+	if m.FieldCleared(strategy.FieldTrailingStopLossAmount) {
+		fields = append(fields, strategy.FieldTrailingStopLossAmount)
+	}
+	if m.FieldCleared(strategy.FieldTrailingStopLossPercent) {
+		fields = append(fields, strategy.FieldTrailingStopLossPercent)
+	}
+	if m.FieldCleared(strategy.FieldTrailingTakeProfitActivationPrice) {
+		fields = append(fields, strategy.FieldTrailingTakeProfitActivationPrice)
+	}
+	if m.FieldCleared(strategy.FieldTrailingTakeProfitAmount) {
+		fields = append(fields, strategy.FieldTrailingTakeProfitAmount)
+	}
+	if m.FieldCleared(strategy.FieldTrailingTakeProfitPercent) {
+		fields = append(fields, strategy.FieldTrailingTakeProfitPercent)
+	}
+	if m.FieldCleared(strategy.FieldTrailingExtremePrice) {
+		fields = append(fields, strategy.FieldTrailingExtremePrice)
+	}
+	if m.FieldCleared(strategy.FieldTrailingLevels) {
+		fields = append(fields, strategy.FieldTrailingLevels)
+	}
+	if m.FieldCleared(strategy.FieldTrailingPriceUpperLimit) {
+		fields = append(fields, strategy.FieldTrailingPriceUpperLimit)
+	}
+	if m.FieldCleared(strategy.FieldTrailingPriceLowerLimit) {
+		fields = append(fields, strategy.FieldTrailingPriceLowerLimit)
+	}
+	if m.FieldCleared(strategy.FieldAtrPeriod) {
+		fields = append(fields, strategy.FieldAtrPeriod)
+	}
+	if m.FieldCleared(strategy.FieldAtrMultiplier) {
+		fields = append(fields, strategy.FieldAtrMultiplier)
+	}
+	if m.FieldCleared(strategy.FieldAdaptiveThreshold) {
+		fields = append(fields, strategy.FieldAdaptiveThreshold)
+	}
+	if m.FieldCleared(strategy.FieldAdaptiveIntervalHours) {
+		fields = append(fields, strategy.FieldAdaptiveIntervalHours)
+	}
+	if m.FieldCleared(strategy.FieldAdaptiveAtr) {
+		fields = append(fields, strategy.FieldAdaptiveAtr)
+	}
+	if m.FieldCleared(strategy.FieldAdaptiveUpdatedAt) {
+		fields = append(fields, strategy.FieldAdaptiveUpdatedAt)
+	}
+	if m.FieldCleared(strategy.FieldCancelRepairMaxAttempts) {
+		fields = append(fields, strategy.FieldCancelRepairMaxAttempts)
+	}
+	if m.FieldCleared(strategy.FieldPartialFillThreshold) {
+		fields = append(fields, strategy.FieldPartialFillThreshold)
+	}
+	if m.FieldCleared(strategy.FieldEnablePushMatchedNotification) {
+		fields = append(fields, strategy.FieldEnablePushMatchedNotification)
+	}
+	if m.FieldCleared(strategy.FieldLastLowerThresholdAlertTime) {
+		fields = append(fields, strategy.FieldLastLowerThresholdAlertTime)
+	}
+	if m.FieldCleared(strategy.FieldLastUpperThresholdAlertTime) {
+		fields = append(fields, strategy.FieldLastUpperThresholdAlertTime)
+	}
+	if m.FieldCleared(strategy.FieldAccountId) {
+		fields = append(fields, strategy.FieldAccountId)
+	}
+	if m.FieldCleared(strategy.FieldStartTime) {
+		fields = append(fields, strategy.FieldStartTime)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *StrategyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *StrategyMutation) ClearField(name string) error {
+	switch name {
+	case strategy.FieldSizingFactor:
+		m.ClearSizingFactor()
+		return nil
+	case strategy.FieldSizingWeights:
+		m.ClearSizingWeights()
+		return nil
+	case strategy.FieldSlippageBps:
+		m.ClearSlippageBps()
+		return nil
+	case strategy.FieldEntryPrice:
+		m.ClearEntryPrice()
+		return nil
+	case strategy.FieldTriggerStopLossPrice:
+		m.ClearTriggerStopLossPrice()
+		return nil
+	case strategy.FieldTriggerTakeProfitPrice:
+		m.ClearTriggerTakeProfitPrice()
+		return nil
+	case strategy.FieldMaxLossAmount:
+		m.ClearMaxLossAmount()
+		return nil
+	case strategy.FieldMaxLossPercent:
+		m.ClearMaxLossPercent()
+		return nil
+	case strategy.FieldProfitTargetAmount:
+		m.ClearProfitTargetAmount()
+		return nil
+	case strategy.FieldTrailingStopLossAmount:
+		m.ClearTrailingStopLossAmount()
+		return nil
+	case strategy.FieldTrailingStopLossPercent:
+		m.ClearTrailingStopLossPercent()
+		return nil
+	case strategy.FieldTrailingTakeProfitActivationPrice:
+		m.ClearTrailingTakeProfitActivationPrice()
+		return nil
+	case strategy.FieldTrailingTakeProfitAmount:
+		m.ClearTrailingTakeProfitAmount()
+		return nil
+	case strategy.FieldTrailingTakeProfitPercent:
+		m.ClearTrailingTakeProfitPercent()
+		return nil
+	case strategy.FieldTrailingExtremePrice:
+		m.ClearTrailingExtremePrice()
+		return nil
+	case strategy.FieldTrailingLevels:
+		m.ClearTrailingLevels()
+		return nil
+	case strategy.FieldTrailingPriceUpperLimit:
+		m.ClearTrailingPriceUpperLimit()
+		return nil
+	case strategy.FieldTrailingPriceLowerLimit:
+		m.ClearTrailingPriceLowerLimit()
+		return nil
+	case strategy.FieldAtrPeriod:
+		m.ClearAtrPeriod()
+		return nil
+	case strategy.FieldAtrMultiplier:
+		m.ClearAtrMultiplier()
+		return nil
+	case strategy.FieldAdaptiveThreshold:
+		m.ClearAdaptiveThreshold()
+		return nil
+	case strategy.FieldAdaptiveIntervalHours:
+		m.ClearAdaptiveIntervalHours()
+		return nil
+	case strategy.FieldAdaptiveAtr:
+		m.ClearAdaptiveAtr()
+		return nil
+	case strategy.FieldAdaptiveUpdatedAt:
+		m.ClearAdaptiveUpdatedAt()
+		return nil
+	case strategy.FieldCancelRepairMaxAttempts:
+		m.ClearCancelRepairMaxAttempts()
+		return nil
+	case strategy.FieldPartialFillThreshold:
+		m.ClearPartialFillThreshold()
+		return nil
+	case strategy.FieldEnablePushMatchedNotification:
+		m.ClearEnablePushMatchedNotification()
+		return nil
+	case strategy.FieldLastLowerThresholdAlertTime:
+		m.ClearLastLowerThresholdAlertTime()
+		return nil
+	case strategy.FieldLastUpperThresholdAlertTime:
+		m.ClearLastUpperThresholdAlertTime()
+		return nil
+	case strategy.FieldAccountId:
+		m.ClearAccountId()
+		return nil
+	case strategy.FieldStartTime:
+		m.ClearStartTime()
+		return nil
+	}
+	return fmt.Errorf("unknown Strategy nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *StrategyMutation) ResetField(name string) error {
+	switch name {
+	case strategy.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case strategy.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case strategy.FieldGUID:
+		m.ResetGUID()
+		return nil
+	case strategy.FieldOwner:
+		m.ResetOwner()
+		return nil
+	case strategy.FieldExchange:
+		m.ResetExchange()
+		return nil
+	case strategy.FieldSymbol:
+		m.ResetSymbol()
+		return nil
+	case strategy.FieldAccount:
+		m.ResetAccount()
+		return nil
+	case strategy.FieldMode:
+		m.ResetMode()
+		return nil
+	case strategy.FieldMarginMode:
+		m.ResetMarginMode()
+		return nil
+	case strategy.FieldQuantityMode:
+		m.ResetQuantityMode()
+		return nil
+	case strategy.FieldPriceUpper:
+		m.ResetPriceUpper()
+		return nil
+	case strategy.FieldPriceLower:
+		m.ResetPriceLower()
+		return nil
+	case strategy.FieldGridNum:
+		m.ResetGridNum()
+		return nil
+	case strategy.FieldLeverage:
+		m.ResetLeverage()
+		return nil
+	case strategy.FieldInitialOrderSize:
+		m.ResetInitialOrderSize()
+		return nil
+	case strategy.FieldSizingMode:
+		m.ResetSizingMode()
+		return nil
+	case strategy.FieldSizingFactor:
+		m.ResetSizingFactor()
+		return nil
+	case strategy.FieldSizingWeights:
+		m.ResetSizingWeights()
+		return nil
+	case strategy.FieldSlippageBps:
+		m.ResetSlippageBps()
+		return nil
+	case strategy.FieldEntryPrice:
+		m.ResetEntryPrice()
+		return nil
+	case strategy.FieldTriggerStopLossPrice:
+		m.ResetTriggerStopLossPrice()
+		return nil
+	case strategy.FieldTriggerTakeProfitPrice:
+		m.ResetTriggerTakeProfitPrice()
+		return nil
+	case strategy.FieldMaxLossAmount:
+		m.ResetMaxLossAmount()
+		return nil
+	case strategy.FieldMaxLossPercent:
+		m.ResetMaxLossPercent()
+		return nil
+	case strategy.FieldProfitTargetAmount:
+		m.ResetProfitTargetAmount()
+		return nil
+	case strategy.FieldTrailingStopLossAmount:
+		m.ResetTrailingStopLossAmount()
+		return nil
+	case strategy.FieldTrailingStopLossPercent:
+		m.ResetTrailingStopLossPercent()
+		return nil
+	case strategy.FieldTrailingTakeProfitActivationPrice:
+		m.ResetTrailingTakeProfitActivationPrice()
+		return nil
+	case strategy.FieldTrailingTakeProfitAmount:
+		m.ResetTrailingTakeProfitAmount()
+		return nil
+	case strategy.FieldTrailingTakeProfitPercent:
+		m.ResetTrailingTakeProfitPercent()
+		return nil
+	case strategy.FieldTrailingExtremePrice:
+		m.ResetTrailingExtremePrice()
+		return nil
+	case strategy.FieldTrailingLevels:
+		m.ResetTrailingLevels()
+		return nil
+	case strategy.FieldTrailingPriceUpperLimit:
+		m.ResetTrailingPriceUpperLimit()
+		return nil
+	case strategy.FieldTrailingPriceLowerLimit:
+		m.ResetTrailingPriceLowerLimit()
+		return nil
+	case strategy.FieldAtrPeriod:
+		m.ResetAtrPeriod()
+		return nil
+	case strategy.FieldAtrMultiplier:
+		m.ResetAtrMultiplier()
+		return nil
+	case strategy.FieldAdaptiveThreshold:
+		m.ResetAdaptiveThreshold()
+		return nil
+	case strategy.FieldAdaptiveIntervalHours:
+		m.ResetAdaptiveIntervalHours()
+		return nil
+	case strategy.FieldAdaptiveAtr:
+		m.ResetAdaptiveAtr()
+		return nil
+	case strategy.FieldAdaptiveUpdatedAt:
+		m.ResetAdaptiveUpdatedAt()
+		return nil
+	case strategy.FieldCancelRepairPolicy:
+		m.ResetCancelRepairPolicy()
+		return nil
+	case strategy.FieldCancelRepairMaxAttempts:
+		m.ResetCancelRepairMaxAttempts()
+		return nil
+	case strategy.FieldReconcilePolicy:
+		m.ResetReconcilePolicy()
+		return nil
+	case strategy.FieldPartialFillThreshold:
+		m.ResetPartialFillThreshold()
+		return nil
+	case strategy.FieldEnablePushNotification:
+		m.ResetEnablePushNotification()
+		return nil
+	case strategy.FieldEnablePushMatchedNotification:
+		m.ResetEnablePushMatchedNotification()
+		return nil
+	case strategy.FieldLastLowerThresholdAlertTime:
+		m.ResetLastLowerThresholdAlertTime()
+		return nil
+	case strategy.FieldLastUpperThresholdAlertTime:
+		m.ResetLastUpperThresholdAlertTime()
+		return nil
+	case strategy.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case strategy.FieldExchangeApiKey:
+		m.ResetExchangeApiKey()
+		return nil
+	case strategy.FieldExchangeSecretKey:
+		m.ResetExchangeSecretKey()
+		return nil
+	case strategy.FieldExchangePassphrase:
+		m.ResetExchangePassphrase()
+		return nil
+	case strategy.FieldExchangeTestnet:
+		m.ResetExchangeTestnet()
+		return nil
+	case strategy.FieldAccountId:
+		m.ResetAccountId()
+		return nil
+	case strategy.FieldStartTime:
+		m.ResetStartTime()
+		return nil
+	}
+	return fmt.Errorf("unknown Strategy field %s", name)
 }
 
-// Symbol returns the value of the "symbol" field in the mutation.
-func (m *StrategyMutation) Symbol() (r string, exists bool) {
-	v := m.symbol
-	if v == nil {
-		return
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *StrategyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.exchangeAccount != nil {
+		edges = append(edges, strategy.EdgeExchangeAccount)
 	}
-	return *v, true
+	return edges
 }
 
-// OldSymbol returns the old "symbol" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldSymbol(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSymbol requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *StrategyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case strategy.EdgeExchangeAccount:
+		if id := m.exchangeAccount; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return oldValue.Symbol, nil
+	return nil
 }
 
-// ResetSymbol resets all changes to the "symbol" field.
-func (m *StrategyMutation) ResetSymbol() {
-	m.symbol = nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *StrategyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// SetAccount sets the "account" field.
-func (m *StrategyMutation) SetAccount(s string) {
-	m.account = &s
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *StrategyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// Account returns the value of the "account" field in the mutation.
-func (m *StrategyMutation) Account() (r string, exists bool) {
-	v := m.account
-	if v == nil {
-		return
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *StrategyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedexchangeAccount {
+		edges = append(edges, strategy.EdgeExchangeAccount)
 	}
-	return *v, true
+	return edges
 }
 
-// OldAccount returns the old "account" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldAccount(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAccount requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAccount: %w", err)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *StrategyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case strategy.EdgeExchangeAccount:
+		return m.clearedexchangeAccount
 	}
-	return oldValue.Account, nil
-}
-
-// ResetAccount resets all changes to the "account" field.
-func (m *StrategyMutation) ResetAccount() {
-	m.account = nil
+	return false
 }
 
-// SetMode sets the "mode" field.
-func (m *StrategyMutation) SetMode(s strategy.Mode) {
-	m.mode = &s
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *StrategyMutation) ClearEdge(name string) error {
+	switch name {
+	case strategy.EdgeExchangeAccount:
+		m.ClearExchangeAccount()
+		return nil
+	}
+	return fmt.Errorf("unknown Strategy unique edge %s", name)
 }
 
-// Mode returns the value of the "mode" field in the mutation.
-func (m *StrategyMutation) Mode() (r strategy.Mode, exists bool) {
-	v := m.mode
-	if v == nil {
-		return
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *StrategyMutation) ResetEdge(name string) error {
+	switch name {
+	case strategy.EdgeExchangeAccount:
+		m.ResetExchangeAccount()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Strategy edge %s", name)
 }
 
-// OldMode returns the old "mode" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldMode(ctx context.Context) (v strategy.Mode, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMode is only allowed on UpdateOne operations")
+// StrategyRunMutation represents an operation that mutates the StrategyRun nodes in the graph.
+type StrategyRunMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	create_time      *time.Time
+	update_time      *time.Time
+	strategyId       *string
+	owner            *int64
+	addowner         *int64
+	exchange         *string
+	symbol           *string
+	account          *string
+	mode             *strategyrun.Mode
+	priceUpper       *decimal.Decimal
+	priceLower       *decimal.Decimal
+	gridNum          *int
+	addgridNum       *int
+	leverage         *int
+	addleverage      *int
+	initialOrderSize *decimal.Decimal
+	status           *strategyrun.Status
+	startTime        *time.Time
+	endTime          *time.Time
+	stopReason       *strategyrun.StopReason
+	matchedTrades    *int
+	addmatchedTrades *int
+	realizedProfit   *decimal.Decimal
+	fee              *decimal.Decimal
+	funding          *decimal.Decimal
+	unrealizedProfit *decimal.Decimal
+	totalProfit      *decimal.Decimal
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*StrategyRun, error)
+	predicates       []predicate.StrategyRun
+}
+
+var _ ent.Mutation = (*StrategyRunMutation)(nil)
+
+// strategyrunOption allows management of the mutation configuration using functional options.
+type strategyrunOption func(*StrategyRunMutation)
+
+// newStrategyRunMutation creates new mutation for the StrategyRun entity.
+func newStrategyRunMutation(c config, op Op, opts ...strategyrunOption) *StrategyRunMutation {
+	m := &StrategyRunMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStrategyRun,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMode requires an ID field in the mutation")
+	for _, opt := range opts {
+		opt(m)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMode: %w", err)
+	return m
+}
+
+// withStrategyRunID sets the ID field of the mutation.
+func withStrategyRunID(id int) strategyrunOption {
+	return func(m *StrategyRunMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *StrategyRun
+		)
+		m.oldValue = func(ctx context.Context) (*StrategyRun, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().StrategyRun.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.Mode, nil
 }
 
-// ResetMode resets all changes to the "mode" field.
-func (m *StrategyMutation) ResetMode() {
-	m.mode = nil
+// withStrategyRun sets the old StrategyRun of the mutation.
+func withStrategyRun(node *StrategyRun) strategyrunOption {
+	return func(m *StrategyRunMutation) {
+		m.oldValue = func(context.Context) (*StrategyRun, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// SetMarginMode sets the "marginMode" field.
-func (m *StrategyMutation) SetMarginMode(sm strategy.MarginMode) {
-	m.marginMode = &sm
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StrategyRunMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// MarginMode returns the value of the "marginMode" field in the mutation.
-func (m *StrategyMutation) MarginMode() (r strategy.MarginMode, exists bool) {
-	v := m.marginMode
-	if v == nil {
-		return
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StrategyRunMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return *v, true
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// OldMarginMode returns the old "marginMode" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldMarginMode(ctx context.Context) (v strategy.MarginMode, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMarginMode is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMarginMode requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMarginMode: %w", err)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StrategyRunMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return oldValue.MarginMode, nil
+	return *m.id, true
 }
 
-// ResetMarginMode resets all changes to the "marginMode" field.
-func (m *StrategyMutation) ResetMarginMode() {
-	m.marginMode = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StrategyRunMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().StrategyRun.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetQuantityMode sets the "quantityMode" field.
-func (m *StrategyMutation) SetQuantityMode(sm strategy.QuantityMode) {
-	m.quantityMode = &sm
+// SetCreateTime sets the "create_time" field.
+func (m *StrategyRunMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// QuantityMode returns the value of the "quantityMode" field in the mutation.
-func (m *StrategyMutation) QuantityMode() (r strategy.QuantityMode, exists bool) {
-	v := m.quantityMode
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *StrategyRunMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuantityMode returns the old "quantityMode" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the StrategyRun entity.
+// If the StrategyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldQuantityMode(ctx context.Context) (v strategy.QuantityMode, err error) {
+func (m *StrategyRunMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuantityMode is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuantityMode requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuantityMode: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return oldValue.QuantityMode, nil
+	return oldValue.CreateTime, nil
 }
 
-// ResetQuantityMode resets all changes to the "quantityMode" field.
-func (m *StrategyMutation) ResetQuantityMode() {
-	m.quantityMode = nil
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *StrategyRunMutation) ResetCreateTime() {
+	m.create_time = nil
 }
 
-// SetPriceUpper sets the "priceUpper" field.
-func (m *StrategyMutation) SetPriceUpper(d decimal.Decimal) {
-	m.priceUpper = &d
+// SetUpdateTime sets the "update_time" field.
+func (m *StrategyRunMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// PriceUpper returns the value of the "priceUpper" field in the mutation.
-func (m *StrategyMutation) PriceUpper() (r decimal.Decimal, exists bool) {
-	v := m.priceUpper
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *StrategyRunMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPriceUpper returns the old "priceUpper" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the StrategyRun entity.
+// If the StrategyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldPriceUpper(ctx context.Context) (v decimal.Decimal, err error) {
+func (m *StrategyRunMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPriceUpper is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPriceUpper requires an ID field in the mutation")
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPriceUpper: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
 	}
-	return oldValue.PriceUpper, nil
+	return oldValue.UpdateTime, nil
 }
 
-// ResetPriceUpper resets all changes to the "priceUpper" field.
-func (m *StrategyMutation) ResetPriceUpper() {
-	m.priceUpper = nil
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *StrategyRunMutation) ResetUpdateTime() {
+	m.update_time = nil
 }
 
-// SetPriceLower sets the "priceLower" field.
-func (m *StrategyMutation) SetPriceLower(d decimal.Decimal) {
-	m.priceLower = &d
+// SetStrategyId sets the "strategyId" field.
+func (m *StrategyRunMutation) SetStrategyId(s string) {
+	m.strategyId = &s
 }
 
-// PriceLower returns the value of the "priceLower" field in the mutation.
-func (m *StrategyMutation) PriceLower() (r decimal.Decimal, exists bool) {
-	v := m.priceLower
+// StrategyId returns the value of the "strategyId" field in the mutation.
+func (m *StrategyRunMutation) StrategyId() (r string, exists bool) {
+	v := m.strategyId
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPriceLower returns the old "priceLower" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldStrategyId returns the old "strategyId" field's value of the StrategyRun entity.
+// If the StrategyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldPriceLower(ctx context.Context) (v decimal.Decimal, err error) {
+func (m *StrategyRunMutation) OldStrategyId(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPriceLower is only allowed on UpdateOne operations")
+		return v, errors.New("OldStrategyId is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPriceLower requires an ID field in the mutation")
+		return v, errors.New("OldStrategyId requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPriceLower: %w", err)
+		return v, fmt.Errorf("querying old value for OldStrategyId: %w", err)
 	}
-	return oldValue.PriceLower, nil
+	return oldValue.StrategyId, nil
 }
 
-// ResetPriceLower resets all changes to the "priceLower" field.
-func (m *StrategyMutation) ResetPriceLower() {
-	m.priceLower = nil
+// ResetStrategyId resets all changes to the "strategyId" field.
+func (m *StrategyRunMutation) ResetStrategyId() {
+	m.strategyId = nil
 }
 
-// SetGridNum sets the "gridNum" field.
-func (m *StrategyMutation) SetGridNum(i int) {
-	m.gridNum = &i
-	m.addgridNum = nil
+// SetOwner sets the "owner" field.
+func (m *StrategyRunMutation) SetOwner(i int64) {
+	m.owner = &i
+	m.addowner = nil
 }
 
-// GridNum returns the value of the "gridNum" field in the mutation.
-func (m *StrategyMutation) GridNum() (r int, exists bool) {
-	v := m.gridNum
+// Owner returns the value of the "owner" field in the mutation.
+func (m *StrategyRunMutation) Owner() (r int64, exists bool) {
+	v := m.owner
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGridNum returns the old "gridNum" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldOwner returns the old "owner" field's value of the StrategyRun entity.
+// If the StrategyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldGridNum(ctx context.Context) (v int, err error) {
+func (m *StrategyRunMutation) OldOwner(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGridNum is only allowed on UpdateOne operations")
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGridNum requires an ID field in the mutation")
+		return v, errors.New("OldOwner requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGridNum: %w", err)
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
 	}
-	return oldValue.GridNum, nil
+	return oldValue.Owner, nil
 }
 
-// AddGridNum adds i to the "gridNum" field.
-func (m *StrategyMutation) AddGridNum(i int) {
-	if m.addgridNum != nil {
-		*m.addgridNum += i
+// AddOwner adds i to the "owner" field.
+func (m *StrategyRunMutation) AddOwner(i int64) {
+	if m.addowner != nil {
+		*m.addowner += i
 	} else {
-		m.addgridNum = &i
+		m.addowner = &i
 	}
 }
 
-// AddedGridNum returns the value that was added to the "gridNum" field in this mutation.
-func (m *StrategyMutation) AddedGridNum() (r int, exists bool) {
-	v := m.addgridNum
+// AddedOwner returns the value that was added to the "owner" field in this mutation.
+func (m *StrategyRunMutation) AddedOwner() (r int64, exists bool) {
+	v := m.addowner
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetGridNum resets all changes to the "gridNum" field.
-func (m *StrategyMutation) ResetGridNum() {
-	m.gridNum = nil
-	m.addgridNum = nil
+// ResetOwner resets all changes to the "owner" field.
+func (m *StrategyRunMutation) ResetOwner() {
+	m.owner = nil
+	m.addowner = nil
 }
 
-// SetLeverage sets the "leverage" field.
-func (m *StrategyMutation) SetLeverage(i int) {
-	m.leverage = &i
-	m.addleverage = nil
+// SetExchange sets the "exchange" field.
+func (m *StrategyRunMutation) SetExchange(s string) {
+	m.exchange = &s
 }
 
-// Leverage returns the value of the "leverage" field in the mutation.
-func (m *StrategyMutation) Leverage() (r int, exists bool) {
-	v := m.leverage
+// Exchange returns the value of the "exchange" field in the mutation.
+func (m *StrategyRunMutation) Exchange() (r string, exists bool) {
+	v := m.exchange
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLeverage returns the old "leverage" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldExchange returns the old "exchange" field's value of the StrategyRun entity.
+// If the StrategyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldLeverage(ctx context.Context) (v int, err error) {
+func (m *StrategyRunMutation) OldExchange(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLeverage is only allowed on UpdateOne operations")
+		return v, errors.New("OldExchange is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLeverage requires an ID field in the mutation")
+		return v, errors.New("OldExchange requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLeverage: %w", err)
+		return v, fmt.Errorf("querying old value for OldExchange: %w", err)
 	}
-	return oldValue.Leverage, nil
+	return oldValue.Exchange, nil
 }
 
-// AddLeverage adds i to the "leverage" field.
-func (m *StrategyMutation) AddLeverage(i int) {
-	if m.addleverage != nil {
-		*m.addleverage += i
-	} else {
-		m.addleverage = &i
-	}
+// ResetExchange resets all changes to the "exchange" field.
+func (m *StrategyRunMutation) ResetExchange() {
+	m.exchange = nil
 }
 
-// AddedLeverage returns the value that was added to the "leverage" field in this mutation.
-func (m *StrategyMutation) AddedLeverage() (r int, exists bool) {
-	v := m.addleverage
+// SetSymbol sets the "symbol" field.
+func (m *StrategyRunMutation) SetSymbol(s string) {
+	m.symbol = &s
+}
+
+// Symbol returns the value of the "symbol" field in the mutation.
+func (m *StrategyRunMutation) Symbol() (r string, exists bool) {
+	v := m.symbol
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetLeverage resets all changes to the "leverage" field.
-func (m *StrategyMutation) ResetLeverage() {
-	m.leverage = nil
-	m.addleverage = nil
+// OldSymbol returns the old "symbol" field's value of the StrategyRun entity.
+// If the StrategyRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyRunMutation) OldSymbol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSymbol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+	}
+	return oldValue.Symbol, nil
 }
 
-// SetInitialOrderSize sets the "initialOrderSize" field.
-func (m *StrategyMutation) SetInitialOrderSize(d decimal.Decimal) {
-	m.initialOrderSize = &d
+// ResetSymbol resets all changes to the "symbol" field.
+func (m *StrategyRunMutation) ResetSymbol() {
+	m.symbol = nil
 }
 
-// InitialOrderSize returns the value of the "initialOrderSize" field in the mutation.
-func (m *StrategyMutation) InitialOrderSize() (r decimal.Decimal, exists bool) {
-	v := m.initialOrderSize
+// SetAccount sets the "account" field.
+func (m *StrategyRunMutation) SetAccount(s string) {
+	m.account = &s
+}
+
+// Account returns the value of the "account" field in the mutation.
+func (m *StrategyRunMutation) Account() (r string, exists bool) {
+	v := m.account
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInitialOrderSize returns the old "initialOrderSize" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldAccount returns the old "account" field's value of the StrategyRun entity.
+// If the StrategyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldInitialOrderSize(ctx context.Context) (v decimal.Decimal, err error) {
+func (m *StrategyRunMutation) OldAccount(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInitialOrderSize is only allowed on UpdateOne operations")
+		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInitialOrderSize requires an ID field in the mutation")
+		return v, errors.New("OldAccount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInitialOrderSize: %w", err)
+		return v, fmt.Errorf("querying old value for OldAccount: %w", err)
 	}
-	return oldValue.InitialOrderSize, nil
+	return oldValue.Account, nil
 }
 
-// ResetInitialOrderSize resets all changes to the "initialOrderSize" field.
-func (m *StrategyMutation) ResetInitialOrderSize() {
-	m.initialOrderSize = nil
+// ResetAccount resets all changes to the "account" field.
+func (m *StrategyRunMutation) ResetAccount() {
+	m.account = nil
 }
 
-// SetSlippageBps sets the "slippageBps" field.
-func (m *StrategyMutation) SetSlippageBps(i int) {
-	m.slippageBps = &i
-	m.addslippageBps = nil
+// SetMode sets the "mode" field.
+func (m *StrategyRunMutation) SetMode(s strategyrun.Mode) {
+	m.mode = &s
 }
 
-// SlippageBps returns the value of the "slippageBps" field in the mutation.
-func (m *StrategyMutation) SlippageBps() (r int, exists bool) {
-	v := m.slippageBps
+// Mode returns the value of the "mode" field in the mutation.
+func (m *StrategyRunMutation) Mode() (r strategyrun.Mode, exists bool) {
+	v := m.mode
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSlippageBps returns the old "slippageBps" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldMode returns the old "mode" field's value of the StrategyRun entity.
+// If the StrategyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldSlippageBps(ctx context.Context) (v *int, err error) {
+func (m *StrategyRunMutation) OldMode(ctx context.Context) (v strategyrun.Mode, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSlippageBps is only allowed on UpdateOne operations")
+		return v, errors.New("OldMode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSlippageBps requires an ID field in the mutation")
+		return v, errors.New("OldMode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSlippageBps: %w", err)
+		return v, fmt.Errorf("querying old value for OldMode: %w", err)
 	}
-	return oldValue.SlippageBps, nil
+	return oldValue.Mode, nil
 }
 
-// AddSlippageBps adds i to the "slippageBps" field.
-func (m *StrategyMutation) AddSlippageBps(i int) {
-	if m.addslippageBps != nil {
-		*m.addslippageBps += i
-	} else {
-		m.addslippageBps = &i
-	}
+// ResetMode resets all changes to the "mode" field.
+func (m *StrategyRunMutation) ResetMode() {
+	m.mode = nil
 }
 
-// AddedSlippageBps returns the value that was added to the "slippageBps" field in this mutation.
-func (m *StrategyMutation) AddedSlippageBps() (r int, exists bool) {
-	v := m.addslippageBps
+// SetPriceUpper sets the "priceUpper" field.
+func (m *StrategyRunMutation) SetPriceUpper(d decimal.Decimal) {
+	m.priceUpper = &d
+}
+
+// PriceUpper returns the value of the "priceUpper" field in the mutation.
+func (m *StrategyRunMutation) PriceUpper() (r decimal.Decimal, exists bool) {
+	v := m.priceUpper
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearSlippageBps clears the value of the "slippageBps" field.
-func (m *StrategyMutation) ClearSlippageBps() {
-	m.slippageBps = nil
-	m.addslippageBps = nil
-	m.clearedFields[strategy.FieldSlippageBps] = struct{}{}
+// OldPriceUpper returns the old "priceUpper" field's value of the StrategyRun entity.
+// If the StrategyRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyRunMutation) OldPriceUpper(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriceUpper is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriceUpper requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriceUpper: %w", err)
+	}
+	return oldValue.PriceUpper, nil
 }
 
-// SlippageBpsCleared returns if the "slippageBps" field was cleared in this mutation.
-func (m *StrategyMutation) SlippageBpsCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldSlippageBps]
-	return ok
+// ResetPriceUpper resets all changes to the "priceUpper" field.
+func (m *StrategyRunMutation) ResetPriceUpper() {
+	m.priceUpper = nil
 }
 
-// ResetSlippageBps resets all changes to the "slippageBps" field.
-func (m *StrategyMutation) ResetSlippageBps() {
-	m.slippageBps = nil
-	m.addslippageBps = nil
-	delete(m.clearedFields, strategy.FieldSlippageBps)
+// SetPriceLower sets the "priceLower" field.
+func (m *StrategyRunMutation) SetPriceLower(d decimal.Decimal) {
+	m.priceLower = &d
 }
 
-// SetEntryPrice sets the "entryPrice" field.
-func (m *StrategyMutation) SetEntryPrice(d decimal.Decimal) {
-	m.entryPrice = &d
+// PriceLower returns the value of the "priceLower" field in the mutation.
+func (m *StrategyRunMutation) PriceLower() (r decimal.Decimal, exists bool) {
+	v := m.priceLower
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriceLower returns the old "priceLower" field's value of the StrategyRun entity.
+// If the StrategyRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyRunMutation) OldPriceLower(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriceLower is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriceLower requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriceLower: %w", err)
+	}
+	return oldValue.PriceLower, nil
+}
+
+// ResetPriceLower resets all changes to the "priceLower" field.
+func (m *StrategyRunMutation) ResetPriceLower() {
+	m.priceLower = nil
+}
+
+// SetGridNum sets the "gridNum" field.
+func (m *StrategyRunMutation) SetGridNum(i int) {
+	m.gridNum = &i
+	m.addgridNum = nil
 }
 
-// EntryPrice returns the value of the "entryPrice" field in the mutation.
-func (m *StrategyMutation) EntryPrice() (r decimal.Decimal, exists bool) {
-	v := m.entryPrice
+// GridNum returns the value of the "gridNum" field in the mutation.
+func (m *StrategyRunMutation) GridNum() (r int, exists bool) {
+	v := m.gridNum
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEntryPrice returns the old "entryPrice" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldGridNum returns the old "gridNum" field's value of the StrategyRun entity.
+// If the StrategyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldEntryPrice(ctx context.Context) (v *decimal.Decimal, err error) {
+func (m *StrategyRunMutation) OldGridNum(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEntryPrice is only allowed on UpdateOne operations")
+		return v, errors.New("OldGridNum is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEntryPrice requires an ID field in the mutation")
+		return v, errors.New("OldGridNum requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEntryPrice: %w", err)
+		return v, fmt.Errorf("querying old value for OldGridNum: %w", err)
 	}
-	return oldValue.EntryPrice, nil
+	return oldValue.GridNum, nil
 }
 
-// ClearEntryPrice clears the value of the "entryPrice" field.
-func (m *StrategyMutation) ClearEntryPrice() {
-	m.entryPrice = nil
-	m.clearedFields[strategy.FieldEntryPrice] = struct{}{}
+// AddGridNum adds i to the "gridNum" field.
+func (m *StrategyRunMutation) AddGridNum(i int) {
+	if m.addgridNum != nil {
+		*m.addgridNum += i
+	} else {
+		m.addgridNum = &i
+	}
 }
 
-// EntryPriceCleared returns if the "entryPrice" field was cleared in this mutation.
-func (m *StrategyMutation) EntryPriceCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldEntryPrice]
-	return ok
+// AddedGridNum returns the value that was added to the "gridNum" field in this mutation.
+func (m *StrategyRunMutation) AddedGridNum() (r int, exists bool) {
+	v := m.addgridNum
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetEntryPrice resets all changes to the "entryPrice" field.
-func (m *StrategyMutation) ResetEntryPrice() {
-	m.entryPrice = nil
-	delete(m.clearedFields, strategy.FieldEntryPrice)
+// ResetGridNum resets all changes to the "gridNum" field.
+func (m *StrategyRunMutation) ResetGridNum() {
+	m.gridNum = nil
+	m.addgridNum = nil
 }
 
-// SetTriggerStopLossPrice sets the "triggerStopLossPrice" field.
-func (m *StrategyMutation) SetTriggerStopLossPrice(d decimal.Decimal) {
-	m.triggerStopLossPrice = &d
+// SetLeverage sets the "leverage" field.
+func (m *StrategyRunMutation) SetLeverage(i int) {
+	m.leverage = &i
+	m.addleverage = nil
 }
 
-// TriggerStopLossPrice returns the value of the "triggerStopLossPrice" field in the mutation.
-func (m *StrategyMutation) TriggerStopLossPrice() (r decimal.Decimal, exists bool) {
-	v := m.triggerStopLossPrice
+// Leverage returns the value of the "leverage" field in the mutation.
+func (m *StrategyRunMutation) Leverage() (r int, exists bool) {
+	v := m.leverage
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTriggerStopLossPrice returns the old "triggerStopLossPrice" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldLeverage returns the old "leverage" field's value of the StrategyRun entity.
+// If the StrategyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldTriggerStopLossPrice(ctx context.Context) (v *decimal.Decimal, err error) {
+func (m *StrategyRunMutation) OldLeverage(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTriggerStopLossPrice is only allowed on UpdateOne operations")
+		return v, errors.New("OldLeverage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTriggerStopLossPrice requires an ID field in the mutation")
+		return v, errors.New("OldLeverage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTriggerStopLossPrice: %w", err)
+		return v, fmt.Errorf("querying old value for OldLeverage: %w", err)
 	}
-	return oldValue.TriggerStopLossPrice, nil
+	return oldValue.Leverage, nil
 }
 
-// ClearTriggerStopLossPrice clears the value of the "triggerStopLossPrice" field.
-func (m *StrategyMutation) ClearTriggerStopLossPrice() {
-	m.triggerStopLossPrice = nil
-	m.clearedFields[strategy.FieldTriggerStopLossPrice] = struct{}{}
+// AddLeverage adds i to the "leverage" field.
+func (m *StrategyRunMutation) AddLeverage(i int) {
+	if m.addleverage != nil {
+		*m.addleverage += i
+	} else {
+		m.addleverage = &i
+	}
 }
 
-// TriggerStopLossPriceCleared returns if the "triggerStopLossPrice" field was cleared in this mutation.
-func (m *StrategyMutation) TriggerStopLossPriceCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldTriggerStopLossPrice]
-	return ok
+// AddedLeverage returns the value that was added to the "leverage" field in this mutation.
+func (m *StrategyRunMutation) AddedLeverage() (r int, exists bool) {
+	v := m.addleverage
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetTriggerStopLossPrice resets all changes to the "triggerStopLossPrice" field.
-func (m *StrategyMutation) ResetTriggerStopLossPrice() {
-	m.triggerStopLossPrice = nil
-	delete(m.clearedFields, strategy.FieldTriggerStopLossPrice)
+// ResetLeverage resets all changes to the "leverage" field.
+func (m *StrategyRunMutation) ResetLeverage() {
+	m.leverage = nil
+	m.addleverage = nil
 }
 
-// SetTriggerTakeProfitPrice sets the "triggerTakeProfitPrice" field.
-func (m *StrategyMutation) SetTriggerTakeProfitPrice(d decimal.Decimal) {
-	m.triggerTakeProfitPrice = &d
+// SetInitialOrderSize sets the "initialOrderSize" field.
+func (m *StrategyRunMutation) SetInitialOrderSize(d decimal.Decimal) {
+	m.initialOrderSize = &d
 }
 
-// TriggerTakeProfitPrice returns the value of the "triggerTakeProfitPrice" field in the mutation.
-func (m *StrategyMutation) TriggerTakeProfitPrice() (r decimal.Decimal, exists bool) {
-	v := m.triggerTakeProfitPrice
+// InitialOrderSize returns the value of the "initialOrderSize" field in the mutation.
+func (m *StrategyRunMutation) InitialOrderSize() (r decimal.Decimal, exists bool) {
+	v := m.initialOrderSize
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTriggerTakeProfitPrice returns the old "triggerTakeProfitPrice" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldInitialOrderSize returns the old "initialOrderSize" field's value of the StrategyRun entity.
+// If the StrategyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldTriggerTakeProfitPrice(ctx context.Context) (v *decimal.Decimal, err error) {
+func (m *StrategyRunMutation) OldInitialOrderSize(ctx context.Context) (v decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTriggerTakeProfitPrice is only allowed on UpdateOne operations")
+		return v, errors.New("OldInitialOrderSize is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTriggerTakeProfitPrice requires an ID field in the mutation")
+		return v, errors.New("OldInitialOrderSize requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTriggerTakeProfitPrice: %w", err)
+		return v, fmt.Errorf("querying old value for OldInitialOrderSize: %w", err)
 	}
-	return oldValue.TriggerTakeProfitPrice, nil
-}
-
-// ClearTriggerTakeProfitPrice clears the value of the "triggerTakeProfitPrice" field.
-func (m *StrategyMutation) ClearTriggerTakeProfitPrice() {
-	m.triggerTakeProfitPrice = nil
-	m.clearedFields[strategy.FieldTriggerTakeProfitPrice] = struct{}{}
-}
-
-// TriggerTakeProfitPriceCleared returns if the "triggerTakeProfitPrice" field was cleared in this mutation.
-func (m *StrategyMutation) TriggerTakeProfitPriceCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldTriggerTakeProfitPrice]
-	return ok
+	return oldValue.InitialOrderSize, nil
 }
 
-// ResetTriggerTakeProfitPrice resets all changes to the "triggerTakeProfitPrice" field.
-func (m *StrategyMutation) ResetTriggerTakeProfitPrice() {
-	m.triggerTakeProfitPrice = nil
-	delete(m.clearedFields, strategy.FieldTriggerTakeProfitPrice)
+// ResetInitialOrderSize resets all changes to the "initialOrderSize" field.
+func (m *StrategyRunMutation) ResetInitialOrderSize() {
+	m.initialOrderSize = nil
 }
 
-// SetEnablePushNotification sets the "enablePushNotification" field.
-func (m *StrategyMutation) SetEnablePushNotification(b bool) {
-	m.enablePushNotification = &b
+// SetStatus sets the "status" field.
+func (m *StrategyRunMutation) SetStatus(s strategyrun.Status) {
+	m.status = &s
 }
 
-// EnablePushNotification returns the value of the "enablePushNotification" field in the mutation.
-func (m *StrategyMutation) EnablePushNotification() (r bool, exists bool) {
-	v := m.enablePushNotification
+// Status returns the value of the "status" field in the mutation.
+func (m *StrategyRunMutation) Status() (r strategyrun.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnablePushNotification returns the old "enablePushNotification" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the StrategyRun entity.
+// If the StrategyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldEnablePushNotification(ctx context.Context) (v bool, err error) {
+func (m *StrategyRunMutation) OldStatus(ctx context.Context) (v strategyrun.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnablePushNotification is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnablePushNotification requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnablePushNotification: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.EnablePushNotification, nil
+	return oldValue.Status, nil
 }
 
-// ResetEnablePushNotification resets all changes to the "enablePushNotification" field.
-func (m *StrategyMutation) ResetEnablePushNotification() {
-	m.enablePushNotification = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *StrategyRunMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetEnablePushMatchedNotification sets the "enablePushMatchedNotification" field.
-func (m *StrategyMutation) SetEnablePushMatchedNotification(b bool) {
-	m.enablePushMatchedNotification = &b
+// SetStartTime sets the "startTime" field.
+func (m *StrategyRunMutation) SetStartTime(t time.Time) {
+	m.startTime = &t
 }
 
-// EnablePushMatchedNotification returns the value of the "enablePushMatchedNotification" field in the mutation.
-func (m *StrategyMutation) EnablePushMatchedNotification() (r bool, exists bool) {
-	v := m.enablePushMatchedNotification
+// StartTime returns the value of the "startTime" field in the mutation.
+func (m *StrategyRunMutation) StartTime() (r time.Time, exists bool) {
+	v := m.startTime
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnablePushMatchedNotification returns the old "enablePushMatchedNotification" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldStartTime returns the old "startTime" field's value of the StrategyRun entity.
+// If the StrategyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldEnablePushMatchedNotification(ctx context.Context) (v *bool, err error) {
+func (m *StrategyRunMutation) OldStartTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnablePushMatchedNotification is only allowed on UpdateOne operations")
+		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnablePushMatchedNotification requires an ID field in the mutation")
+		return v, errors.New("OldStartTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnablePushMatchedNotification: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
 	}
-	return oldValue.EnablePushMatchedNotification, nil
-}
-
-// ClearEnablePushMatchedNotification clears the value of the "enablePushMatchedNotification" field.
-func (m *StrategyMutation) ClearEnablePushMatchedNotification() {
-	m.enablePushMatchedNotification = nil
-	m.clearedFields[strategy.FieldEnablePushMatchedNotification] = struct{}{}
-}
-
-// EnablePushMatchedNotificationCleared returns if the "enablePushMatchedNotification" field was cleared in this mutation.
-func (m *StrategyMutation) EnablePushMatchedNotificationCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldEnablePushMatchedNotification]
-	return ok
+	return oldValue.StartTime, nil
 }
 
-// ResetEnablePushMatchedNotification resets all changes to the "enablePushMatchedNotification" field.
-func (m *StrategyMutation) ResetEnablePushMatchedNotification() {
-	m.enablePushMatchedNotification = nil
-	delete(m.clearedFields, strategy.FieldEnablePushMatchedNotification)
+// ResetStartTime resets all changes to the "startTime" field.
+func (m *StrategyRunMutation) ResetStartTime() {
+	m.startTime = nil
 }
 
-// SetLastLowerThresholdAlertTime sets the "lastLowerThresholdAlertTime" field.
-func (m *StrategyMutation) SetLastLowerThresholdAlertTime(t time.Time) {
-	m.lastLowerThresholdAlertTime = &t
+// SetEndTime sets the "endTime" field.
+func (m *StrategyRunMutation) SetEndTime(t time.Time) {
+	m.endTime = &t
 }
 
-// LastLowerThresholdAlertTime returns the value of the "lastLowerThresholdAlertTime" field in the mutation.
-func (m *StrategyMutation) LastLowerThresholdAlertTime() (r time.Time, exists bool) {
-	v := m.lastLowerThresholdAlertTime
+// EndTime returns the value of the "endTime" field in the mutation.
+func (m *StrategyRunMutation) EndTime() (r time.Time, exists bool) {
+	v := m.endTime
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastLowerThresholdAlertTime returns the old "lastLowerThresholdAlertTime" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldEndTime returns the old "endTime" field's value of the StrategyRun entity.
+// If the StrategyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldLastLowerThresholdAlertTime(ctx context.Context) (v *time.Time, err error) {
+func (m *StrategyRunMutation) OldEndTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastLowerThresholdAlertTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastLowerThresholdAlertTime requires an ID field in the mutation")
+		return v, errors.New("OldEndTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastLowerThresholdAlertTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
 	}
-	return oldValue.LastLowerThresholdAlertTime, nil
+	return oldValue.EndTime, nil
 }
 
-// ClearLastLowerThresholdAlertTime clears the value of the "lastLowerThresholdAlertTime" field.
-func (m *StrategyMutation) ClearLastLowerThresholdAlertTime() {
-	m.lastLowerThresholdAlertTime = nil
-	m.clearedFields[strategy.FieldLastLowerThresholdAlertTime] = struct{}{}
+// ClearEndTime clears the value of the "endTime" field.
+func (m *StrategyRunMutation) ClearEndTime() {
+	m.endTime = nil
+	m.clearedFields[strategyrun.FieldEndTime] = struct{}{}
 }
 
-// LastLowerThresholdAlertTimeCleared returns if the "lastLowerThresholdAlertTime" field was cleared in this mutation.
-func (m *StrategyMutation) LastLowerThresholdAlertTimeCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldLastLowerThresholdAlertTime]
+// EndTimeCleared returns if the "endTime" field was cleared in this mutation.
+func (m *StrategyRunMutation) EndTimeCleared() bool {
+	_, ok := m.clearedFields[strategyrun.FieldEndTime]
 	return ok
 }
 
-// ResetLastLowerThresholdAlertTime resets all changes to the "lastLowerThresholdAlertTime" field.
-func (m *StrategyMutation) ResetLastLowerThresholdAlertTime() {
-	m.lastLowerThresholdAlertTime = nil
-	delete(m.clearedFields, strategy.FieldLastLowerThresholdAlertTime)
+// ResetEndTime resets all changes to the "endTime" field.
+func (m *StrategyRunMutation) ResetEndTime() {
+	m.endTime = nil
+	delete(m.clearedFields, strategyrun.FieldEndTime)
 }
 
-// SetLastUpperThresholdAlertTime sets the "lastUpperThresholdAlertTime" field.
-func (m *StrategyMutation) SetLastUpperThresholdAlertTime(t time.Time) {
-	m.lastUpperThresholdAlertTime = &t
+// SetStopReason sets the "stopReason" field.
+func (m *StrategyRunMutation) SetStopReason(sr strategyrun.StopReason) {
+	m.stopReason = &sr
 }
 
-// LastUpperThresholdAlertTime returns the value of the "lastUpperThresholdAlertTime" field in the mutation.
-func (m *StrategyMutation) LastUpperThresholdAlertTime() (r time.Time, exists bool) {
-	v := m.lastUpperThresholdAlertTime
+// StopReason returns the value of the "stopReason" field in the mutation.
+func (m *StrategyRunMutation) StopReason() (r strategyrun.StopReason, exists bool) {
+	v := m.stopReason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastUpperThresholdAlertTime returns the old "lastUpperThresholdAlertTime" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldStopReason returns the old "stopReason" field's value of the StrategyRun entity.
+// If the StrategyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldLastUpperThresholdAlertTime(ctx context.Context) (v *time.Time, err error) {
+func (m *StrategyRunMutation) OldStopReason(ctx context.Context) (v *strategyrun.StopReason, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastUpperThresholdAlertTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldStopReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastUpperThresholdAlertTime requires an ID field in the mutation")
+		return v, errors.New("OldStopReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastUpperThresholdAlertTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldStopReason: %w", err)
 	}
-	return oldValue.LastUpperThresholdAlertTime, nil
+	return oldValue.StopReason, nil
 }
 
-// ClearLastUpperThresholdAlertTime clears the value of the "lastUpperThresholdAlertTime" field.
-func (m *StrategyMutation) ClearLastUpperThresholdAlertTime() {
-	m.lastUpperThresholdAlertTime = nil
-	m.clearedFields[strategy.FieldLastUpperThresholdAlertTime] = struct{}{}
+// ClearStopReason clears the value of the "stopReason" field.
+func (m *StrategyRunMutation) ClearStopReason() {
+	m.stopReason = nil
+	m.clearedFields[strategyrun.FieldStopReason] = struct{}{}
 }
 
-// LastUpperThresholdAlertTimeCleared returns if the "lastUpperThresholdAlertTime" field was cleared in this mutation.
-func (m *StrategyMutation) LastUpperThresholdAlertTimeCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldLastUpperThresholdAlertTime]
+// StopReasonCleared returns if the "stopReason" field was cleared in this mutation.
+func (m *StrategyRunMutation) StopReasonCleared() bool {
+	_, ok := m.clearedFields[strategyrun.FieldStopReason]
 	return ok
 }
 
-// ResetLastUpperThresholdAlertTime resets all changes to the "lastUpperThresholdAlertTime" field.
-func (m *StrategyMutation) ResetLastUpperThresholdAlertTime() {
-	m.lastUpperThresholdAlertTime = nil
-	delete(m.clearedFields, strategy.FieldLastUpperThresholdAlertTime)
+// ResetStopReason resets all changes to the "stopReason" field.
+func (m *StrategyRunMutation) ResetStopReason() {
+	m.stopReason = nil
+	delete(m.clearedFields, strategyrun.FieldStopReason)
 }
 
-// SetStatus sets the "status" field.
-func (m *StrategyMutation) SetStatus(s strategy.Status) {
-	m.status = &s
+// SetMatchedTrades sets the "matchedTrades" field.
+func (m *StrategyRunMutation) SetMatchedTrades(i int) {
+	m.matchedTrades = &i
+	m.addmatchedTrades = nil
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *StrategyMutation) Status() (r strategy.Status, exists bool) {
-	v := m.status
+// MatchedTrades returns the value of the "matchedTrades" field in the mutation.
+func (m *StrategyRunMutation) MatchedTrades() (r int, exists bool) {
+	v := m.matchedTrades
 	if v == nil {
 		return
 	}
 	return *v, true
 }
-
-// OldStatus returns the old "status" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+
+// OldMatchedTrades returns the old "matchedTrades" field's value of the StrategyRun entity.
+// If the StrategyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldStatus(ctx context.Context) (v strategy.Status, err error) {
+func (m *StrategyRunMutation) OldMatchedTrades(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldMatchedTrades is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldMatchedTrades requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldMatchedTrades: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.MatchedTrades, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *StrategyMutation) ResetStatus() {
-	m.status = nil
+// AddMatchedTrades adds i to the "matchedTrades" field.
+func (m *StrategyRunMutation) AddMatchedTrades(i int) {
+	if m.addmatchedTrades != nil {
+		*m.addmatchedTrades += i
+	} else {
+		m.addmatchedTrades = &i
+	}
 }
 
-// SetExchangeApiKey sets the "exchangeApiKey" field.
-func (m *StrategyMutation) SetExchangeApiKey(s string) {
-	m.exchangeApiKey = &s
+// AddedMatchedTrades returns the value that was added to the "matchedTrades" field in this mutation.
+func (m *StrategyRunMutation) AddedMatchedTrades() (r int, exists bool) {
+	v := m.addmatchedTrades
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ExchangeApiKey returns the value of the "exchangeApiKey" field in the mutation.
-func (m *StrategyMutation) ExchangeApiKey() (r string, exists bool) {
-	v := m.exchangeApiKey
+// ResetMatchedTrades resets all changes to the "matchedTrades" field.
+func (m *StrategyRunMutation) ResetMatchedTrades() {
+	m.matchedTrades = nil
+	m.addmatchedTrades = nil
+}
+
+// SetRealizedProfit sets the "realizedProfit" field.
+func (m *StrategyRunMutation) SetRealizedProfit(d decimal.Decimal) {
+	m.realizedProfit = &d
+}
+
+// RealizedProfit returns the value of the "realizedProfit" field in the mutation.
+func (m *StrategyRunMutation) RealizedProfit() (r decimal.Decimal, exists bool) {
+	v := m.realizedProfit
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExchangeApiKey returns the old "exchangeApiKey" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldRealizedProfit returns the old "realizedProfit" field's value of the StrategyRun entity.
+// If the StrategyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldExchangeApiKey(ctx context.Context) (v string, err error) {
+func (m *StrategyRunMutation) OldRealizedProfit(ctx context.Context) (v *decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExchangeApiKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldRealizedProfit is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExchangeApiKey requires an ID field in the mutation")
+		return v, errors.New("OldRealizedProfit requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExchangeApiKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldRealizedProfit: %w", err)
 	}
-	return oldValue.ExchangeApiKey, nil
+	return oldValue.RealizedProfit, nil
 }
 
-// ResetExchangeApiKey resets all changes to the "exchangeApiKey" field.
-func (m *StrategyMutation) ResetExchangeApiKey() {
-	m.exchangeApiKey = nil
+// ClearRealizedProfit clears the value of the "realizedProfit" field.
+func (m *StrategyRunMutation) ClearRealizedProfit() {
+	m.realizedProfit = nil
+	m.clearedFields[strategyrun.FieldRealizedProfit] = struct{}{}
 }
 
-// SetExchangeSecretKey sets the "exchangeSecretKey" field.
-func (m *StrategyMutation) SetExchangeSecretKey(s string) {
-	m.exchangeSecretKey = &s
+// RealizedProfitCleared returns if the "realizedProfit" field was cleared in this mutation.
+func (m *StrategyRunMutation) RealizedProfitCleared() bool {
+	_, ok := m.clearedFields[strategyrun.FieldRealizedProfit]
+	return ok
 }
 
-// ExchangeSecretKey returns the value of the "exchangeSecretKey" field in the mutation.
-func (m *StrategyMutation) ExchangeSecretKey() (r string, exists bool) {
-	v := m.exchangeSecretKey
+// ResetRealizedProfit resets all changes to the "realizedProfit" field.
+func (m *StrategyRunMutation) ResetRealizedProfit() {
+	m.realizedProfit = nil
+	delete(m.clearedFields, strategyrun.FieldRealizedProfit)
+}
+
+// SetFee sets the "fee" field.
+func (m *StrategyRunMutation) SetFee(d decimal.Decimal) {
+	m.fee = &d
+}
+
+// Fee returns the value of the "fee" field in the mutation.
+func (m *StrategyRunMutation) Fee() (r decimal.Decimal, exists bool) {
+	v := m.fee
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExchangeSecretKey returns the old "exchangeSecretKey" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldFee returns the old "fee" field's value of the StrategyRun entity.
+// If the StrategyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldExchangeSecretKey(ctx context.Context) (v string, err error) {
+func (m *StrategyRunMutation) OldFee(ctx context.Context) (v *decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExchangeSecretKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldFee is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExchangeSecretKey requires an ID field in the mutation")
+		return v, errors.New("OldFee requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExchangeSecretKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldFee: %w", err)
 	}
-	return oldValue.ExchangeSecretKey, nil
+	return oldValue.Fee, nil
 }
 
-// ResetExchangeSecretKey resets all changes to the "exchangeSecretKey" field.
-func (m *StrategyMutation) ResetExchangeSecretKey() {
-	m.exchangeSecretKey = nil
+// ClearFee clears the value of the "fee" field.
+func (m *StrategyRunMutation) ClearFee() {
+	m.fee = nil
+	m.clearedFields[strategyrun.FieldFee] = struct{}{}
 }
 
-// SetExchangePassphrase sets the "exchangePassphrase" field.
-func (m *StrategyMutation) SetExchangePassphrase(s string) {
-	m.exchangePassphrase = &s
+// FeeCleared returns if the "fee" field was cleared in this mutation.
+func (m *StrategyRunMutation) FeeCleared() bool {
+	_, ok := m.clearedFields[strategyrun.FieldFee]
+	return ok
 }
 
-// ExchangePassphrase returns the value of the "exchangePassphrase" field in the mutation.
-func (m *StrategyMutation) ExchangePassphrase() (r string, exists bool) {
-	v := m.exchangePassphrase
+// ResetFee resets all changes to the "fee" field.
+func (m *StrategyRunMutation) ResetFee() {
+	m.fee = nil
+	delete(m.clearedFields, strategyrun.FieldFee)
+}
+
+// SetFunding sets the "funding" field.
+func (m *StrategyRunMutation) SetFunding(d decimal.Decimal) {
+	m.funding = &d
+}
+
+// Funding returns the value of the "funding" field in the mutation.
+func (m *StrategyRunMutation) Funding() (r decimal.Decimal, exists bool) {
+	v := m.funding
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExchangePassphrase returns the old "exchangePassphrase" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldFunding returns the old "funding" field's value of the StrategyRun entity.
+// If the StrategyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldExchangePassphrase(ctx context.Context) (v string, err error) {
+func (m *StrategyRunMutation) OldFunding(ctx context.Context) (v *decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExchangePassphrase is only allowed on UpdateOne operations")
+		return v, errors.New("OldFunding is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExchangePassphrase requires an ID field in the mutation")
+		return v, errors.New("OldFunding requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExchangePassphrase: %w", err)
+		return v, fmt.Errorf("querying old value for OldFunding: %w", err)
 	}
-	return oldValue.ExchangePassphrase, nil
+	return oldValue.Funding, nil
 }
 
-// ResetExchangePassphrase resets all changes to the "exchangePassphrase" field.
-func (m *StrategyMutation) ResetExchangePassphrase() {
-	m.exchangePassphrase = nil
+// ClearFunding clears the value of the "funding" field.
+func (m *StrategyRunMutation) ClearFunding() {
+	m.funding = nil
+	m.clearedFields[strategyrun.FieldFunding] = struct{}{}
 }
 
-// SetExchangeTestnet sets the "exchangeTestnet" field.
-func (m *StrategyMutation) SetExchangeTestnet(b bool) {
-	m.exchangeTestnet = &b
+// FundingCleared returns if the "funding" field was cleared in this mutation.
+func (m *StrategyRunMutation) FundingCleared() bool {
+	_, ok := m.clearedFields[strategyrun.FieldFunding]
+	return ok
 }
 
-// ExchangeTestnet returns the value of the "exchangeTestnet" field in the mutation.
-func (m *StrategyMutation) ExchangeTestnet() (r bool, exists bool) {
-	v := m.exchangeTestnet
+// ResetFunding resets all changes to the "funding" field.
+func (m *StrategyRunMutation) ResetFunding() {
+	m.funding = nil
+	delete(m.clearedFields, strategyrun.FieldFunding)
+}
+
+// SetUnrealizedProfit sets the "unrealizedProfit" field.
+func (m *StrategyRunMutation) SetUnrealizedProfit(d decimal.Decimal) {
+	m.unrealizedProfit = &d
+}
+
+// UnrealizedProfit returns the value of the "unrealizedProfit" field in the mutation.
+func (m *StrategyRunMutation) UnrealizedProfit() (r decimal.Decimal, exists bool) {
+	v := m.unrealizedProfit
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExchangeTestnet returns the old "exchangeTestnet" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldUnrealizedProfit returns the old "unrealizedProfit" field's value of the StrategyRun entity.
+// If the StrategyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldExchangeTestnet(ctx context.Context) (v bool, err error) {
+func (m *StrategyRunMutation) OldUnrealizedProfit(ctx context.Context) (v *decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExchangeTestnet is only allowed on UpdateOne operations")
+		return v, errors.New("OldUnrealizedProfit is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExchangeTestnet requires an ID field in the mutation")
+		return v, errors.New("OldUnrealizedProfit requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExchangeTestnet: %w", err)
+		return v, fmt.Errorf("querying old value for OldUnrealizedProfit: %w", err)
 	}
-	return oldValue.ExchangeTestnet, nil
+	return oldValue.UnrealizedProfit, nil
 }
 
-// ResetExchangeTestnet resets all changes to the "exchangeTestnet" field.
-func (m *StrategyMutation) ResetExchangeTestnet() {
-	m.exchangeTestnet = nil
+// ClearUnrealizedProfit clears the value of the "unrealizedProfit" field.
+func (m *StrategyRunMutation) ClearUnrealizedProfit() {
+	m.unrealizedProfit = nil
+	m.clearedFields[strategyrun.FieldUnrealizedProfit] = struct{}{}
 }
 
-// SetStartTime sets the "startTime" field.
-func (m *StrategyMutation) SetStartTime(t time.Time) {
-	m.startTime = &t
+// UnrealizedProfitCleared returns if the "unrealizedProfit" field was cleared in this mutation.
+func (m *StrategyRunMutation) UnrealizedProfitCleared() bool {
+	_, ok := m.clearedFields[strategyrun.FieldUnrealizedProfit]
+	return ok
 }
 
-// StartTime returns the value of the "startTime" field in the mutation.
-func (m *StrategyMutation) StartTime() (r time.Time, exists bool) {
-	v := m.startTime
+// ResetUnrealizedProfit resets all changes to the "unrealizedProfit" field.
+func (m *StrategyRunMutation) ResetUnrealizedProfit() {
+	m.unrealizedProfit = nil
+	delete(m.clearedFields, strategyrun.FieldUnrealizedProfit)
+}
+
+// SetTotalProfit sets the "totalProfit" field.
+func (m *StrategyRunMutation) SetTotalProfit(d decimal.Decimal) {
+	m.totalProfit = &d
+}
+
+// TotalProfit returns the value of the "totalProfit" field in the mutation.
+func (m *StrategyRunMutation) TotalProfit() (r decimal.Decimal, exists bool) {
+	v := m.totalProfit
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartTime returns the old "startTime" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldTotalProfit returns the old "totalProfit" field's value of the StrategyRun entity.
+// If the StrategyRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldStartTime(ctx context.Context) (v *time.Time, err error) {
+func (m *StrategyRunMutation) OldTotalProfit(ctx context.Context) (v *decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotalProfit is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartTime requires an ID field in the mutation")
+		return v, errors.New("OldTotalProfit requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotalProfit: %w", err)
 	}
-	return oldValue.StartTime, nil
+	return oldValue.TotalProfit, nil
 }
 
-// ClearStartTime clears the value of the "startTime" field.
-func (m *StrategyMutation) ClearStartTime() {
-	m.startTime = nil
-	m.clearedFields[strategy.FieldStartTime] = struct{}{}
+// ClearTotalProfit clears the value of the "totalProfit" field.
+func (m *StrategyRunMutation) ClearTotalProfit() {
+	m.totalProfit = nil
+	m.clearedFields[strategyrun.FieldTotalProfit] = struct{}{}
 }
 
-// StartTimeCleared returns if the "startTime" field was cleared in this mutation.
-func (m *StrategyMutation) StartTimeCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldStartTime]
+// TotalProfitCleared returns if the "totalProfit" field was cleared in this mutation.
+func (m *StrategyRunMutation) TotalProfitCleared() bool {
+	_, ok := m.clearedFields[strategyrun.FieldTotalProfit]
 	return ok
 }
 
-// ResetStartTime resets all changes to the "startTime" field.
-func (m *StrategyMutation) ResetStartTime() {
-	m.startTime = nil
-	delete(m.clearedFields, strategy.FieldStartTime)
+// ResetTotalProfit resets all changes to the "totalProfit" field.
+func (m *StrategyRunMutation) ResetTotalProfit() {
+	m.totalProfit = nil
+	delete(m.clearedFields, strategyrun.FieldTotalProfit)
 }
 
-// Where appends a list predicates to the StrategyMutation builder.
-func (m *StrategyMutation) Where(ps ...predicate.Strategy) {
+// Where appends a list predicates to the StrategyRunMutation builder.
+func (m *StrategyRunMutation) Where(ps ...predicate.StrategyRun) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the StrategyMutation builder. Using this method,
+// WhereP appends storage-level predicates to the StrategyRunMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *StrategyMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Strategy, len(ps))
+func (m *StrategyRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.StrategyRun, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4952,111 +13923,93 @@ func (m *StrategyMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *StrategyMutation) Op() Op {
+func (m *StrategyRunMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *StrategyMutation) SetOp(op Op) {
+func (m *StrategyRunMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Strategy).
-func (m *StrategyMutation) Type() string {
+// Type returns the node type of this mutation (StrategyRun).
+func (m *StrategyRunMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *StrategyMutation) Fields() []string {
-	fields := make([]string, 0, 29)
+func (m *StrategyRunMutation) Fields() []string {
+	fields := make([]string, 0, 23)
 	if m.create_time != nil {
-		fields = append(fields, strategy.FieldCreateTime)
+		fields = append(fields, strategyrun.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, strategy.FieldUpdateTime)
+		fields = append(fields, strategyrun.FieldUpdateTime)
 	}
-	if m.guid != nil {
-		fields = append(fields, strategy.FieldGUID)
+	if m.strategyId != nil {
+		fields = append(fields, strategyrun.FieldStrategyId)
 	}
 	if m.owner != nil {
-		fields = append(fields, strategy.FieldOwner)
+		fields = append(fields, strategyrun.FieldOwner)
 	}
 	if m.exchange != nil {
-		fields = append(fields, strategy.FieldExchange)
+		fields = append(fields, strategyrun.FieldExchange)
 	}
 	if m.symbol != nil {
-		fields = append(fields, strategy.FieldSymbol)
+		fields = append(fields, strategyrun.FieldSymbol)
 	}
 	if m.account != nil {
-		fields = append(fields, strategy.FieldAccount)
+		fields = append(fields, strategyrun.FieldAccount)
 	}
 	if m.mode != nil {
-		fields = append(fields, strategy.FieldMode)
-	}
-	if m.marginMode != nil {
-		fields = append(fields, strategy.FieldMarginMode)
-	}
-	if m.quantityMode != nil {
-		fields = append(fields, strategy.FieldQuantityMode)
+		fields = append(fields, strategyrun.FieldMode)
 	}
 	if m.priceUpper != nil {
-		fields = append(fields, strategy.FieldPriceUpper)
+		fields = append(fields, strategyrun.FieldPriceUpper)
 	}
 	if m.priceLower != nil {
-		fields = append(fields, strategy.FieldPriceLower)
+		fields = append(fields, strategyrun.FieldPriceLower)
 	}
 	if m.gridNum != nil {
-		fields = append(fields, strategy.FieldGridNum)
+		fields = append(fields, strategyrun.FieldGridNum)
 	}
 	if m.leverage != nil {
-		fields = append(fields, strategy.FieldLeverage)
+		fields = append(fields, strategyrun.FieldLeverage)
 	}
 	if m.initialOrderSize != nil {
-		fields = append(fields, strategy.FieldInitialOrderSize)
-	}
-	if m.slippageBps != nil {
-		fields = append(fields, strategy.FieldSlippageBps)
-	}
-	if m.entryPrice != nil {
-		fields = append(fields, strategy.FieldEntryPrice)
-	}
-	if m.triggerStopLossPrice != nil {
-		fields = append(fields, strategy.FieldTriggerStopLossPrice)
-	}
-	if m.triggerTakeProfitPrice != nil {
-		fields = append(fields, strategy.FieldTriggerTakeProfitPrice)
+		fields = append(fields, strategyrun.FieldInitialOrderSize)
 	}
-	if m.enablePushNotification != nil {
-		fields = append(fields, strategy.FieldEnablePushNotification)
+	if m.status != nil {
+		fields = append(fields, strategyrun.FieldStatus)
 	}
-	if m.enablePushMatchedNotification != nil {
-		fields = append(fields, strategy.FieldEnablePushMatchedNotification)
+	if m.startTime != nil {
+		fields = append(fields, strategyrun.FieldStartTime)
 	}
-	if m.lastLowerThresholdAlertTime != nil {
-		fields = append(fields, strategy.FieldLastLowerThresholdAlertTime)
+	if m.endTime != nil {
+		fields = append(fields, strategyrun.FieldEndTime)
 	}
-	if m.lastUpperThresholdAlertTime != nil {
-		fields = append(fields, strategy.FieldLastUpperThresholdAlertTime)
+	if m.stopReason != nil {
+		fields = append(fields, strategyrun.FieldStopReason)
 	}
-	if m.status != nil {
-		fields = append(fields, strategy.FieldStatus)
+	if m.matchedTrades != nil {
+		fields = append(fields, strategyrun.FieldMatchedTrades)
 	}
-	if m.exchangeApiKey != nil {
-		fields = append(fields, strategy.FieldExchangeApiKey)
+	if m.realizedProfit != nil {
+		fields = append(fields, strategyrun.FieldRealizedProfit)
 	}
-	if m.exchangeSecretKey != nil {
-		fields = append(fields, strategy.FieldExchangeSecretKey)
+	if m.fee != nil {
+		fields = append(fields, strategyrun.FieldFee)
 	}
-	if m.exchangePassphrase != nil {
-		fields = append(fields, strategy.FieldExchangePassphrase)
+	if m.funding != nil {
+		fields = append(fields, strategyrun.FieldFunding)
 	}
-	if m.exchangeTestnet != nil {
-		fields = append(fields, strategy.FieldExchangeTestnet)
+	if m.unrealizedProfit != nil {
+		fields = append(fields, strategyrun.FieldUnrealizedProfit)
 	}
-	if m.startTime != nil {
-		fields = append(fields, strategy.FieldStartTime)
+	if m.totalProfit != nil {
+		fields = append(fields, strategyrun.FieldTotalProfit)
 	}
 	return fields
 }
@@ -5064,66 +14017,54 @@ func (m *StrategyMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *StrategyMutation) Field(name string) (ent.Value, bool) {
+func (m *StrategyRunMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case strategy.FieldCreateTime:
+	case strategyrun.FieldCreateTime:
 		return m.CreateTime()
-	case strategy.FieldUpdateTime:
+	case strategyrun.FieldUpdateTime:
 		return m.UpdateTime()
-	case strategy.FieldGUID:
-		return m.GUID()
-	case strategy.FieldOwner:
+	case strategyrun.FieldStrategyId:
+		return m.StrategyId()
+	case strategyrun.FieldOwner:
 		return m.Owner()
-	case strategy.FieldExchange:
+	case strategyrun.FieldExchange:
 		return m.Exchange()
-	case strategy.FieldSymbol:
+	case strategyrun.FieldSymbol:
 		return m.Symbol()
-	case strategy.FieldAccount:
+	case strategyrun.FieldAccount:
 		return m.Account()
-	case strategy.FieldMode:
+	case strategyrun.FieldMode:
 		return m.Mode()
-	case strategy.FieldMarginMode:
-		return m.MarginMode()
-	case strategy.FieldQuantityMode:
-		return m.QuantityMode()
-	case strategy.FieldPriceUpper:
+	case strategyrun.FieldPriceUpper:
 		return m.PriceUpper()
-	case strategy.FieldPriceLower:
+	case strategyrun.FieldPriceLower:
 		return m.PriceLower()
-	case strategy.FieldGridNum:
+	case strategyrun.FieldGridNum:
 		return m.GridNum()
-	case strategy.FieldLeverage:
+	case strategyrun.FieldLeverage:
 		return m.Leverage()
-	case strategy.FieldInitialOrderSize:
+	case strategyrun.FieldInitialOrderSize:
 		return m.InitialOrderSize()
-	case strategy.FieldSlippageBps:
-		return m.SlippageBps()
-	case strategy.FieldEntryPrice:
-		return m.EntryPrice()
-	case strategy.FieldTriggerStopLossPrice:
-		return m.TriggerStopLossPrice()
-	case strategy.FieldTriggerTakeProfitPrice:
-		return m.TriggerTakeProfitPrice()
-	case strategy.FieldEnablePushNotification:
-		return m.EnablePushNotification()
-	case strategy.FieldEnablePushMatchedNotification:
-		return m.EnablePushMatchedNotification()
-	case strategy.FieldLastLowerThresholdAlertTime:
-		return m.LastLowerThresholdAlertTime()
-	case strategy.FieldLastUpperThresholdAlertTime:
-		return m.LastUpperThresholdAlertTime()
-	case strategy.FieldStatus:
+	case strategyrun.FieldStatus:
 		return m.Status()
-	case strategy.FieldExchangeApiKey:
-		return m.ExchangeApiKey()
-	case strategy.FieldExchangeSecretKey:
-		return m.ExchangeSecretKey()
-	case strategy.FieldExchangePassphrase:
-		return m.ExchangePassphrase()
-	case strategy.FieldExchangeTestnet:
-		return m.ExchangeTestnet()
-	case strategy.FieldStartTime:
+	case strategyrun.FieldStartTime:
 		return m.StartTime()
+	case strategyrun.FieldEndTime:
+		return m.EndTime()
+	case strategyrun.FieldStopReason:
+		return m.StopReason()
+	case strategyrun.FieldMatchedTrades:
+		return m.MatchedTrades()
+	case strategyrun.FieldRealizedProfit:
+		return m.RealizedProfit()
+	case strategyrun.FieldFee:
+		return m.Fee()
+	case strategyrun.FieldFunding:
+		return m.Funding()
+	case strategyrun.FieldUnrealizedProfit:
+		return m.UnrealizedProfit()
+	case strategyrun.FieldTotalProfit:
+		return m.TotalProfit()
 	}
 	return nil, false
 }
@@ -5131,297 +14072,243 @@ func (m *StrategyMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *StrategyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *StrategyRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case strategy.FieldCreateTime:
+	case strategyrun.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case strategy.FieldUpdateTime:
+	case strategyrun.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case strategy.FieldGUID:
-		return m.OldGUID(ctx)
-	case strategy.FieldOwner:
+	case strategyrun.FieldStrategyId:
+		return m.OldStrategyId(ctx)
+	case strategyrun.FieldOwner:
 		return m.OldOwner(ctx)
-	case strategy.FieldExchange:
+	case strategyrun.FieldExchange:
 		return m.OldExchange(ctx)
-	case strategy.FieldSymbol:
+	case strategyrun.FieldSymbol:
 		return m.OldSymbol(ctx)
-	case strategy.FieldAccount:
-		return m.OldAccount(ctx)
-	case strategy.FieldMode:
-		return m.OldMode(ctx)
-	case strategy.FieldMarginMode:
-		return m.OldMarginMode(ctx)
-	case strategy.FieldQuantityMode:
-		return m.OldQuantityMode(ctx)
-	case strategy.FieldPriceUpper:
+	case strategyrun.FieldAccount:
+		return m.OldAccount(ctx)
+	case strategyrun.FieldMode:
+		return m.OldMode(ctx)
+	case strategyrun.FieldPriceUpper:
 		return m.OldPriceUpper(ctx)
-	case strategy.FieldPriceLower:
+	case strategyrun.FieldPriceLower:
 		return m.OldPriceLower(ctx)
-	case strategy.FieldGridNum:
+	case strategyrun.FieldGridNum:
 		return m.OldGridNum(ctx)
-	case strategy.FieldLeverage:
+	case strategyrun.FieldLeverage:
 		return m.OldLeverage(ctx)
-	case strategy.FieldInitialOrderSize:
+	case strategyrun.FieldInitialOrderSize:
 		return m.OldInitialOrderSize(ctx)
-	case strategy.FieldSlippageBps:
-		return m.OldSlippageBps(ctx)
-	case strategy.FieldEntryPrice:
-		return m.OldEntryPrice(ctx)
-	case strategy.FieldTriggerStopLossPrice:
-		return m.OldTriggerStopLossPrice(ctx)
-	case strategy.FieldTriggerTakeProfitPrice:
-		return m.OldTriggerTakeProfitPrice(ctx)
-	case strategy.FieldEnablePushNotification:
-		return m.OldEnablePushNotification(ctx)
-	case strategy.FieldEnablePushMatchedNotification:
-		return m.OldEnablePushMatchedNotification(ctx)
-	case strategy.FieldLastLowerThresholdAlertTime:
-		return m.OldLastLowerThresholdAlertTime(ctx)
-	case strategy.FieldLastUpperThresholdAlertTime:
-		return m.OldLastUpperThresholdAlertTime(ctx)
-	case strategy.FieldStatus:
+	case strategyrun.FieldStatus:
 		return m.OldStatus(ctx)
-	case strategy.FieldExchangeApiKey:
-		return m.OldExchangeApiKey(ctx)
-	case strategy.FieldExchangeSecretKey:
-		return m.OldExchangeSecretKey(ctx)
-	case strategy.FieldExchangePassphrase:
-		return m.OldExchangePassphrase(ctx)
-	case strategy.FieldExchangeTestnet:
-		return m.OldExchangeTestnet(ctx)
-	case strategy.FieldStartTime:
+	case strategyrun.FieldStartTime:
 		return m.OldStartTime(ctx)
-	}
-	return nil, fmt.Errorf("unknown Strategy field %s", name)
+	case strategyrun.FieldEndTime:
+		return m.OldEndTime(ctx)
+	case strategyrun.FieldStopReason:
+		return m.OldStopReason(ctx)
+	case strategyrun.FieldMatchedTrades:
+		return m.OldMatchedTrades(ctx)
+	case strategyrun.FieldRealizedProfit:
+		return m.OldRealizedProfit(ctx)
+	case strategyrun.FieldFee:
+		return m.OldFee(ctx)
+	case strategyrun.FieldFunding:
+		return m.OldFunding(ctx)
+	case strategyrun.FieldUnrealizedProfit:
+		return m.OldUnrealizedProfit(ctx)
+	case strategyrun.FieldTotalProfit:
+		return m.OldTotalProfit(ctx)
+	}
+	return nil, fmt.Errorf("unknown StrategyRun field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StrategyMutation) SetField(name string, value ent.Value) error {
+func (m *StrategyRunMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case strategy.FieldCreateTime:
+	case strategyrun.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case strategy.FieldUpdateTime:
+	case strategyrun.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case strategy.FieldGUID:
+	case strategyrun.FieldStrategyId:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGUID(v)
+		m.SetStrategyId(v)
 		return nil
-	case strategy.FieldOwner:
+	case strategyrun.FieldOwner:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetOwner(v)
 		return nil
-	case strategy.FieldExchange:
+	case strategyrun.FieldExchange:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetExchange(v)
 		return nil
-	case strategy.FieldSymbol:
+	case strategyrun.FieldSymbol:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetSymbol(v)
 		return nil
-	case strategy.FieldAccount:
+	case strategyrun.FieldAccount:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetAccount(v)
 		return nil
-	case strategy.FieldMode:
-		v, ok := value.(strategy.Mode)
+	case strategyrun.FieldMode:
+		v, ok := value.(strategyrun.Mode)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetMode(v)
 		return nil
-	case strategy.FieldMarginMode:
-		v, ok := value.(strategy.MarginMode)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMarginMode(v)
-		return nil
-	case strategy.FieldQuantityMode:
-		v, ok := value.(strategy.QuantityMode)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetQuantityMode(v)
-		return nil
-	case strategy.FieldPriceUpper:
+	case strategyrun.FieldPriceUpper:
 		v, ok := value.(decimal.Decimal)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetPriceUpper(v)
 		return nil
-	case strategy.FieldPriceLower:
+	case strategyrun.FieldPriceLower:
 		v, ok := value.(decimal.Decimal)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetPriceLower(v)
 		return nil
-	case strategy.FieldGridNum:
+	case strategyrun.FieldGridNum:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetGridNum(v)
 		return nil
-	case strategy.FieldLeverage:
+	case strategyrun.FieldLeverage:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetLeverage(v)
 		return nil
-	case strategy.FieldInitialOrderSize:
+	case strategyrun.FieldInitialOrderSize:
 		v, ok := value.(decimal.Decimal)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetInitialOrderSize(v)
 		return nil
-	case strategy.FieldSlippageBps:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSlippageBps(v)
-		return nil
-	case strategy.FieldEntryPrice:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEntryPrice(v)
-		return nil
-	case strategy.FieldTriggerStopLossPrice:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTriggerStopLossPrice(v)
-		return nil
-	case strategy.FieldTriggerTakeProfitPrice:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTriggerTakeProfitPrice(v)
-		return nil
-	case strategy.FieldEnablePushNotification:
-		v, ok := value.(bool)
+	case strategyrun.FieldStatus:
+		v, ok := value.(strategyrun.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnablePushNotification(v)
+		m.SetStatus(v)
 		return nil
-	case strategy.FieldEnablePushMatchedNotification:
-		v, ok := value.(bool)
+	case strategyrun.FieldStartTime:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnablePushMatchedNotification(v)
+		m.SetStartTime(v)
 		return nil
-	case strategy.FieldLastLowerThresholdAlertTime:
+	case strategyrun.FieldEndTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastLowerThresholdAlertTime(v)
+		m.SetEndTime(v)
 		return nil
-	case strategy.FieldLastUpperThresholdAlertTime:
-		v, ok := value.(time.Time)
+	case strategyrun.FieldStopReason:
+		v, ok := value.(strategyrun.StopReason)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastUpperThresholdAlertTime(v)
+		m.SetStopReason(v)
 		return nil
-	case strategy.FieldStatus:
-		v, ok := value.(strategy.Status)
+	case strategyrun.FieldMatchedTrades:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetMatchedTrades(v)
 		return nil
-	case strategy.FieldExchangeApiKey:
-		v, ok := value.(string)
+	case strategyrun.FieldRealizedProfit:
+		v, ok := value.(decimal.Decimal)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExchangeApiKey(v)
+		m.SetRealizedProfit(v)
 		return nil
-	case strategy.FieldExchangeSecretKey:
-		v, ok := value.(string)
+	case strategyrun.FieldFee:
+		v, ok := value.(decimal.Decimal)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExchangeSecretKey(v)
+		m.SetFee(v)
 		return nil
-	case strategy.FieldExchangePassphrase:
-		v, ok := value.(string)
+	case strategyrun.FieldFunding:
+		v, ok := value.(decimal.Decimal)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExchangePassphrase(v)
+		m.SetFunding(v)
 		return nil
-	case strategy.FieldExchangeTestnet:
-		v, ok := value.(bool)
+	case strategyrun.FieldUnrealizedProfit:
+		v, ok := value.(decimal.Decimal)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExchangeTestnet(v)
+		m.SetUnrealizedProfit(v)
 		return nil
-	case strategy.FieldStartTime:
-		v, ok := value.(time.Time)
+	case strategyrun.FieldTotalProfit:
+		v, ok := value.(decimal.Decimal)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStartTime(v)
+		m.SetTotalProfit(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Strategy field %s", name)
+	return fmt.Errorf("unknown StrategyRun field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *StrategyMutation) AddedFields() []string {
+func (m *StrategyRunMutation) AddedFields() []string {
 	var fields []string
 	if m.addowner != nil {
-		fields = append(fields, strategy.FieldOwner)
+		fields = append(fields, strategyrun.FieldOwner)
 	}
 	if m.addgridNum != nil {
-		fields = append(fields, strategy.FieldGridNum)
+		fields = append(fields, strategyrun.FieldGridNum)
 	}
 	if m.addleverage != nil {
-		fields = append(fields, strategy.FieldLeverage)
+		fields = append(fields, strategyrun.FieldLeverage)
 	}
-	if m.addslippageBps != nil {
-		fields = append(fields, strategy.FieldSlippageBps)
+	if m.addmatchedTrades != nil {
+		fields = append(fields, strategyrun.FieldMatchedTrades)
 	}
 	return fields
 }
@@ -5429,16 +14316,16 @@ func (m *StrategyMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *StrategyMutation) AddedField(name string) (ent.Value, bool) {
+func (m *StrategyRunMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case strategy.FieldOwner:
+	case strategyrun.FieldOwner:
 		return m.AddedOwner()
-	case strategy.FieldGridNum:
+	case strategyrun.FieldGridNum:
 		return m.AddedGridNum()
-	case strategy.FieldLeverage:
+	case strategyrun.FieldLeverage:
 		return m.AddedLeverage()
-	case strategy.FieldSlippageBps:
-		return m.AddedSlippageBps()
+	case strategyrun.FieldMatchedTrades:
+		return m.AddedMatchedTrades()
 	}
 	return nil, false
 }
@@ -5446,251 +14333,227 @@ func (m *StrategyMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StrategyMutation) AddField(name string, value ent.Value) error {
+func (m *StrategyRunMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case strategy.FieldOwner:
+	case strategyrun.FieldOwner:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddOwner(v)
 		return nil
-	case strategy.FieldGridNum:
+	case strategyrun.FieldGridNum:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddGridNum(v)
 		return nil
-	case strategy.FieldLeverage:
+	case strategyrun.FieldLeverage:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddLeverage(v)
 		return nil
-	case strategy.FieldSlippageBps:
+	case strategyrun.FieldMatchedTrades:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSlippageBps(v)
+		m.AddMatchedTrades(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Strategy numeric field %s", name)
+	return fmt.Errorf("unknown StrategyRun numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *StrategyMutation) ClearedFields() []string {
+func (m *StrategyRunMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(strategy.FieldSlippageBps) {
-		fields = append(fields, strategy.FieldSlippageBps)
-	}
-	if m.FieldCleared(strategy.FieldEntryPrice) {
-		fields = append(fields, strategy.FieldEntryPrice)
+	if m.FieldCleared(strategyrun.FieldEndTime) {
+		fields = append(fields, strategyrun.FieldEndTime)
 	}
-	if m.FieldCleared(strategy.FieldTriggerStopLossPrice) {
-		fields = append(fields, strategy.FieldTriggerStopLossPrice)
+	if m.FieldCleared(strategyrun.FieldStopReason) {
+		fields = append(fields, strategyrun.FieldStopReason)
 	}
-	if m.FieldCleared(strategy.FieldTriggerTakeProfitPrice) {
-		fields = append(fields, strategy.FieldTriggerTakeProfitPrice)
+	if m.FieldCleared(strategyrun.FieldRealizedProfit) {
+		fields = append(fields, strategyrun.FieldRealizedProfit)
 	}
-	if m.FieldCleared(strategy.FieldEnablePushMatchedNotification) {
-		fields = append(fields, strategy.FieldEnablePushMatchedNotification)
+	if m.FieldCleared(strategyrun.FieldFee) {
+		fields = append(fields, strategyrun.FieldFee)
 	}
-	if m.FieldCleared(strategy.FieldLastLowerThresholdAlertTime) {
-		fields = append(fields, strategy.FieldLastLowerThresholdAlertTime)
+	if m.FieldCleared(strategyrun.FieldFunding) {
+		fields = append(fields, strategyrun.FieldFunding)
 	}
-	if m.FieldCleared(strategy.FieldLastUpperThresholdAlertTime) {
-		fields = append(fields, strategy.FieldLastUpperThresholdAlertTime)
+	if m.FieldCleared(strategyrun.FieldUnrealizedProfit) {
+		fields = append(fields, strategyrun.FieldUnrealizedProfit)
 	}
-	if m.FieldCleared(strategy.FieldStartTime) {
-		fields = append(fields, strategy.FieldStartTime)
+	if m.FieldCleared(strategyrun.FieldTotalProfit) {
+		fields = append(fields, strategyrun.FieldTotalProfit)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *StrategyMutation) FieldCleared(name string) bool {
+func (m *StrategyRunMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *StrategyMutation) ClearField(name string) error {
+func (m *StrategyRunMutation) ClearField(name string) error {
 	switch name {
-	case strategy.FieldSlippageBps:
-		m.ClearSlippageBps()
-		return nil
-	case strategy.FieldEntryPrice:
-		m.ClearEntryPrice()
+	case strategyrun.FieldEndTime:
+		m.ClearEndTime()
 		return nil
-	case strategy.FieldTriggerStopLossPrice:
-		m.ClearTriggerStopLossPrice()
+	case strategyrun.FieldStopReason:
+		m.ClearStopReason()
 		return nil
-	case strategy.FieldTriggerTakeProfitPrice:
-		m.ClearTriggerTakeProfitPrice()
+	case strategyrun.FieldRealizedProfit:
+		m.ClearRealizedProfit()
 		return nil
-	case strategy.FieldEnablePushMatchedNotification:
-		m.ClearEnablePushMatchedNotification()
+	case strategyrun.FieldFee:
+		m.ClearFee()
 		return nil
-	case strategy.FieldLastLowerThresholdAlertTime:
-		m.ClearLastLowerThresholdAlertTime()
+	case strategyrun.FieldFunding:
+		m.ClearFunding()
 		return nil
-	case strategy.FieldLastUpperThresholdAlertTime:
-		m.ClearLastUpperThresholdAlertTime()
+	case strategyrun.FieldUnrealizedProfit:
+		m.ClearUnrealizedProfit()
 		return nil
-	case strategy.FieldStartTime:
-		m.ClearStartTime()
+	case strategyrun.FieldTotalProfit:
+		m.ClearTotalProfit()
 		return nil
 	}
-	return fmt.Errorf("unknown Strategy nullable field %s", name)
+	return fmt.Errorf("unknown StrategyRun nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *StrategyMutation) ResetField(name string) error {
+func (m *StrategyRunMutation) ResetField(name string) error {
 	switch name {
-	case strategy.FieldCreateTime:
+	case strategyrun.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case strategy.FieldUpdateTime:
+	case strategyrun.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case strategy.FieldGUID:
-		m.ResetGUID()
+	case strategyrun.FieldStrategyId:
+		m.ResetStrategyId()
 		return nil
-	case strategy.FieldOwner:
+	case strategyrun.FieldOwner:
 		m.ResetOwner()
 		return nil
-	case strategy.FieldExchange:
+	case strategyrun.FieldExchange:
 		m.ResetExchange()
 		return nil
-	case strategy.FieldSymbol:
+	case strategyrun.FieldSymbol:
 		m.ResetSymbol()
 		return nil
-	case strategy.FieldAccount:
+	case strategyrun.FieldAccount:
 		m.ResetAccount()
 		return nil
-	case strategy.FieldMode:
+	case strategyrun.FieldMode:
 		m.ResetMode()
 		return nil
-	case strategy.FieldMarginMode:
-		m.ResetMarginMode()
-		return nil
-	case strategy.FieldQuantityMode:
-		m.ResetQuantityMode()
-		return nil
-	case strategy.FieldPriceUpper:
+	case strategyrun.FieldPriceUpper:
 		m.ResetPriceUpper()
 		return nil
-	case strategy.FieldPriceLower:
+	case strategyrun.FieldPriceLower:
 		m.ResetPriceLower()
 		return nil
-	case strategy.FieldGridNum:
+	case strategyrun.FieldGridNum:
 		m.ResetGridNum()
 		return nil
-	case strategy.FieldLeverage:
+	case strategyrun.FieldLeverage:
 		m.ResetLeverage()
 		return nil
-	case strategy.FieldInitialOrderSize:
+	case strategyrun.FieldInitialOrderSize:
 		m.ResetInitialOrderSize()
 		return nil
-	case strategy.FieldSlippageBps:
-		m.ResetSlippageBps()
-		return nil
-	case strategy.FieldEntryPrice:
-		m.ResetEntryPrice()
-		return nil
-	case strategy.FieldTriggerStopLossPrice:
-		m.ResetTriggerStopLossPrice()
-		return nil
-	case strategy.FieldTriggerTakeProfitPrice:
-		m.ResetTriggerTakeProfitPrice()
-		return nil
-	case strategy.FieldEnablePushNotification:
-		m.ResetEnablePushNotification()
+	case strategyrun.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case strategy.FieldEnablePushMatchedNotification:
-		m.ResetEnablePushMatchedNotification()
+	case strategyrun.FieldStartTime:
+		m.ResetStartTime()
 		return nil
-	case strategy.FieldLastLowerThresholdAlertTime:
-		m.ResetLastLowerThresholdAlertTime()
+	case strategyrun.FieldEndTime:
+		m.ResetEndTime()
 		return nil
-	case strategy.FieldLastUpperThresholdAlertTime:
-		m.ResetLastUpperThresholdAlertTime()
+	case strategyrun.FieldStopReason:
+		m.ResetStopReason()
 		return nil
-	case strategy.FieldStatus:
-		m.ResetStatus()
+	case strategyrun.FieldMatchedTrades:
+		m.ResetMatchedTrades()
 		return nil
-	case strategy.FieldExchangeApiKey:
-		m.ResetExchangeApiKey()
+	case strategyrun.FieldRealizedProfit:
+		m.ResetRealizedProfit()
 		return nil
-	case strategy.FieldExchangeSecretKey:
-		m.ResetExchangeSecretKey()
+	case strategyrun.FieldFee:
+		m.ResetFee()
 		return nil
-	case strategy.FieldExchangePassphrase:
-		m.ResetExchangePassphrase()
+	case strategyrun.FieldFunding:
+		m.ResetFunding()
 		return nil
-	case strategy.FieldExchangeTestnet:
-		m.ResetExchangeTestnet()
+	case strategyrun.FieldUnrealizedProfit:
+		m.ResetUnrealizedProfit()
 		return nil
-	case strategy.FieldStartTime:
-		m.ResetStartTime()
+	case strategyrun.FieldTotalProfit:
+		m.ResetTotalProfit()
 		return nil
 	}
-	return fmt.Errorf("unknown Strategy field %s", name)
+	return fmt.Errorf("unknown StrategyRun field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *StrategyMutation) AddedEdges() []string {
+func (m *StrategyRunMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *StrategyMutation) AddedIDs(name string) []ent.Value {
+func (m *StrategyRunMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *StrategyMutation) RemovedEdges() []string {
+func (m *StrategyRunMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *StrategyMutation) RemovedIDs(name string) []ent.Value {
+func (m *StrategyRunMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *StrategyMutation) ClearedEdges() []string {
+func (m *StrategyRunMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *StrategyMutation) EdgeCleared(name string) bool {
+func (m *StrategyRunMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *StrategyMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Strategy unique edge %s", name)
+func (m *StrategyRunMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown StrategyRun unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *StrategyMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Strategy edge %s", name)
+func (m *StrategyRunMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown StrategyRun edge %s", name)
 }
 
 // SyncProgressMutation represents an operation that mutates the SyncProgress nodes in the graph.
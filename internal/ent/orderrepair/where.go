@@ -0,0 +1,951 @@
+// Code generated by ent, DO NOT EDIT.
+
+package orderrepair
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/fachebot/omni-grid-bot/internal/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// StrategyId applies equality check predicate on the "strategyId" field. It's identical to StrategyIdEQ.
+func StrategyId(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldStrategyId, v))
+}
+
+// Exchange applies equality check predicate on the "exchange" field. It's identical to ExchangeEQ.
+func Exchange(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldExchange, v))
+}
+
+// Symbol applies equality check predicate on the "symbol" field. It's identical to SymbolEQ.
+func Symbol(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldSymbol, v))
+}
+
+// Account applies equality check predicate on the "account" field. It's identical to AccountEQ.
+func Account(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldAccount, v))
+}
+
+// Level applies equality check predicate on the "level" field. It's identical to LevelEQ.
+func Level(v int) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldLevel, v))
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v decimal.Decimal) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldPrice, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v decimal.Decimal) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldQuantity, v))
+}
+
+// CanceledClientOrderId applies equality check predicate on the "canceledClientOrderId" field. It's identical to CanceledClientOrderIdEQ.
+func CanceledClientOrderId(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldCanceledClientOrderId, v))
+}
+
+// NewClientOrderId applies equality check predicate on the "newClientOrderId" field. It's identical to NewClientOrderIdEQ.
+func NewClientOrderId(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldNewClientOrderId, v))
+}
+
+// Attempt applies equality check predicate on the "attempt" field. It's identical to AttemptEQ.
+func Attempt(v int) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldAttempt, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldReason, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// StrategyIdEQ applies the EQ predicate on the "strategyId" field.
+func StrategyIdEQ(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldStrategyId, v))
+}
+
+// StrategyIdNEQ applies the NEQ predicate on the "strategyId" field.
+func StrategyIdNEQ(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNEQ(FieldStrategyId, v))
+}
+
+// StrategyIdIn applies the In predicate on the "strategyId" field.
+func StrategyIdIn(vs ...string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldIn(FieldStrategyId, vs...))
+}
+
+// StrategyIdNotIn applies the NotIn predicate on the "strategyId" field.
+func StrategyIdNotIn(vs ...string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNotIn(FieldStrategyId, vs...))
+}
+
+// StrategyIdGT applies the GT predicate on the "strategyId" field.
+func StrategyIdGT(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldGT(FieldStrategyId, v))
+}
+
+// StrategyIdGTE applies the GTE predicate on the "strategyId" field.
+func StrategyIdGTE(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldGTE(FieldStrategyId, v))
+}
+
+// StrategyIdLT applies the LT predicate on the "strategyId" field.
+func StrategyIdLT(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldLT(FieldStrategyId, v))
+}
+
+// StrategyIdLTE applies the LTE predicate on the "strategyId" field.
+func StrategyIdLTE(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldLTE(FieldStrategyId, v))
+}
+
+// StrategyIdContains applies the Contains predicate on the "strategyId" field.
+func StrategyIdContains(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldContains(FieldStrategyId, v))
+}
+
+// StrategyIdHasPrefix applies the HasPrefix predicate on the "strategyId" field.
+func StrategyIdHasPrefix(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldHasPrefix(FieldStrategyId, v))
+}
+
+// StrategyIdHasSuffix applies the HasSuffix predicate on the "strategyId" field.
+func StrategyIdHasSuffix(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldHasSuffix(FieldStrategyId, v))
+}
+
+// StrategyIdEqualFold applies the EqualFold predicate on the "strategyId" field.
+func StrategyIdEqualFold(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEqualFold(FieldStrategyId, v))
+}
+
+// StrategyIdContainsFold applies the ContainsFold predicate on the "strategyId" field.
+func StrategyIdContainsFold(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldContainsFold(FieldStrategyId, v))
+}
+
+// ExchangeEQ applies the EQ predicate on the "exchange" field.
+func ExchangeEQ(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldExchange, v))
+}
+
+// ExchangeNEQ applies the NEQ predicate on the "exchange" field.
+func ExchangeNEQ(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNEQ(FieldExchange, v))
+}
+
+// ExchangeIn applies the In predicate on the "exchange" field.
+func ExchangeIn(vs ...string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldIn(FieldExchange, vs...))
+}
+
+// ExchangeNotIn applies the NotIn predicate on the "exchange" field.
+func ExchangeNotIn(vs ...string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNotIn(FieldExchange, vs...))
+}
+
+// ExchangeGT applies the GT predicate on the "exchange" field.
+func ExchangeGT(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldGT(FieldExchange, v))
+}
+
+// ExchangeGTE applies the GTE predicate on the "exchange" field.
+func ExchangeGTE(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldGTE(FieldExchange, v))
+}
+
+// ExchangeLT applies the LT predicate on the "exchange" field.
+func ExchangeLT(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldLT(FieldExchange, v))
+}
+
+// ExchangeLTE applies the LTE predicate on the "exchange" field.
+func ExchangeLTE(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldLTE(FieldExchange, v))
+}
+
+// ExchangeContains applies the Contains predicate on the "exchange" field.
+func ExchangeContains(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldContains(FieldExchange, v))
+}
+
+// ExchangeHasPrefix applies the HasPrefix predicate on the "exchange" field.
+func ExchangeHasPrefix(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldHasPrefix(FieldExchange, v))
+}
+
+// ExchangeHasSuffix applies the HasSuffix predicate on the "exchange" field.
+func ExchangeHasSuffix(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldHasSuffix(FieldExchange, v))
+}
+
+// ExchangeEqualFold applies the EqualFold predicate on the "exchange" field.
+func ExchangeEqualFold(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEqualFold(FieldExchange, v))
+}
+
+// ExchangeContainsFold applies the ContainsFold predicate on the "exchange" field.
+func ExchangeContainsFold(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldContainsFold(FieldExchange, v))
+}
+
+// SymbolEQ applies the EQ predicate on the "symbol" field.
+func SymbolEQ(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldSymbol, v))
+}
+
+// SymbolNEQ applies the NEQ predicate on the "symbol" field.
+func SymbolNEQ(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNEQ(FieldSymbol, v))
+}
+
+// SymbolIn applies the In predicate on the "symbol" field.
+func SymbolIn(vs ...string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldIn(FieldSymbol, vs...))
+}
+
+// SymbolNotIn applies the NotIn predicate on the "symbol" field.
+func SymbolNotIn(vs ...string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNotIn(FieldSymbol, vs...))
+}
+
+// SymbolGT applies the GT predicate on the "symbol" field.
+func SymbolGT(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldGT(FieldSymbol, v))
+}
+
+// SymbolGTE applies the GTE predicate on the "symbol" field.
+func SymbolGTE(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldGTE(FieldSymbol, v))
+}
+
+// SymbolLT applies the LT predicate on the "symbol" field.
+func SymbolLT(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldLT(FieldSymbol, v))
+}
+
+// SymbolLTE applies the LTE predicate on the "symbol" field.
+func SymbolLTE(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldLTE(FieldSymbol, v))
+}
+
+// SymbolContains applies the Contains predicate on the "symbol" field.
+func SymbolContains(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldContains(FieldSymbol, v))
+}
+
+// SymbolHasPrefix applies the HasPrefix predicate on the "symbol" field.
+func SymbolHasPrefix(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldHasPrefix(FieldSymbol, v))
+}
+
+// SymbolHasSuffix applies the HasSuffix predicate on the "symbol" field.
+func SymbolHasSuffix(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldHasSuffix(FieldSymbol, v))
+}
+
+// SymbolEqualFold applies the EqualFold predicate on the "symbol" field.
+func SymbolEqualFold(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEqualFold(FieldSymbol, v))
+}
+
+// SymbolContainsFold applies the ContainsFold predicate on the "symbol" field.
+func SymbolContainsFold(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldContainsFold(FieldSymbol, v))
+}
+
+// AccountEQ applies the EQ predicate on the "account" field.
+func AccountEQ(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldAccount, v))
+}
+
+// AccountNEQ applies the NEQ predicate on the "account" field.
+func AccountNEQ(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNEQ(FieldAccount, v))
+}
+
+// AccountIn applies the In predicate on the "account" field.
+func AccountIn(vs ...string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldIn(FieldAccount, vs...))
+}
+
+// AccountNotIn applies the NotIn predicate on the "account" field.
+func AccountNotIn(vs ...string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNotIn(FieldAccount, vs...))
+}
+
+// AccountGT applies the GT predicate on the "account" field.
+func AccountGT(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldGT(FieldAccount, v))
+}
+
+// AccountGTE applies the GTE predicate on the "account" field.
+func AccountGTE(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldGTE(FieldAccount, v))
+}
+
+// AccountLT applies the LT predicate on the "account" field.
+func AccountLT(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldLT(FieldAccount, v))
+}
+
+// AccountLTE applies the LTE predicate on the "account" field.
+func AccountLTE(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldLTE(FieldAccount, v))
+}
+
+// AccountContains applies the Contains predicate on the "account" field.
+func AccountContains(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldContains(FieldAccount, v))
+}
+
+// AccountHasPrefix applies the HasPrefix predicate on the "account" field.
+func AccountHasPrefix(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldHasPrefix(FieldAccount, v))
+}
+
+// AccountHasSuffix applies the HasSuffix predicate on the "account" field.
+func AccountHasSuffix(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldHasSuffix(FieldAccount, v))
+}
+
+// AccountEqualFold applies the EqualFold predicate on the "account" field.
+func AccountEqualFold(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEqualFold(FieldAccount, v))
+}
+
+// AccountContainsFold applies the ContainsFold predicate on the "account" field.
+func AccountContainsFold(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldContainsFold(FieldAccount, v))
+}
+
+// LevelEQ applies the EQ predicate on the "level" field.
+func LevelEQ(v int) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldLevel, v))
+}
+
+// LevelNEQ applies the NEQ predicate on the "level" field.
+func LevelNEQ(v int) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNEQ(FieldLevel, v))
+}
+
+// LevelIn applies the In predicate on the "level" field.
+func LevelIn(vs ...int) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldIn(FieldLevel, vs...))
+}
+
+// LevelNotIn applies the NotIn predicate on the "level" field.
+func LevelNotIn(vs ...int) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNotIn(FieldLevel, vs...))
+}
+
+// LevelGT applies the GT predicate on the "level" field.
+func LevelGT(v int) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldGT(FieldLevel, v))
+}
+
+// LevelGTE applies the GTE predicate on the "level" field.
+func LevelGTE(v int) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldGTE(FieldLevel, v))
+}
+
+// LevelLT applies the LT predicate on the "level" field.
+func LevelLT(v int) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldLT(FieldLevel, v))
+}
+
+// LevelLTE applies the LTE predicate on the "level" field.
+func LevelLTE(v int) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldLTE(FieldLevel, v))
+}
+
+// SideEQ applies the EQ predicate on the "side" field.
+func SideEQ(v Side) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldSide, v))
+}
+
+// SideNEQ applies the NEQ predicate on the "side" field.
+func SideNEQ(v Side) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNEQ(FieldSide, v))
+}
+
+// SideIn applies the In predicate on the "side" field.
+func SideIn(vs ...Side) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldIn(FieldSide, vs...))
+}
+
+// SideNotIn applies the NotIn predicate on the "side" field.
+func SideNotIn(vs ...Side) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNotIn(FieldSide, vs...))
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v decimal.Decimal) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldPrice, v))
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v decimal.Decimal) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNEQ(FieldPrice, v))
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...decimal.Decimal) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldIn(FieldPrice, vs...))
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...decimal.Decimal) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNotIn(FieldPrice, vs...))
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v decimal.Decimal) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldGT(FieldPrice, v))
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v decimal.Decimal) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldGTE(FieldPrice, v))
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v decimal.Decimal) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldLT(FieldPrice, v))
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v decimal.Decimal) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldLTE(FieldPrice, v))
+}
+
+// PriceContains applies the Contains predicate on the "price" field.
+func PriceContains(v decimal.Decimal) predicate.OrderRepair {
+	vc := v.String()
+	return predicate.OrderRepair(sql.FieldContains(FieldPrice, vc))
+}
+
+// PriceHasPrefix applies the HasPrefix predicate on the "price" field.
+func PriceHasPrefix(v decimal.Decimal) predicate.OrderRepair {
+	vc := v.String()
+	return predicate.OrderRepair(sql.FieldHasPrefix(FieldPrice, vc))
+}
+
+// PriceHasSuffix applies the HasSuffix predicate on the "price" field.
+func PriceHasSuffix(v decimal.Decimal) predicate.OrderRepair {
+	vc := v.String()
+	return predicate.OrderRepair(sql.FieldHasSuffix(FieldPrice, vc))
+}
+
+// PriceEqualFold applies the EqualFold predicate on the "price" field.
+func PriceEqualFold(v decimal.Decimal) predicate.OrderRepair {
+	vc := v.String()
+	return predicate.OrderRepair(sql.FieldEqualFold(FieldPrice, vc))
+}
+
+// PriceContainsFold applies the ContainsFold predicate on the "price" field.
+func PriceContainsFold(v decimal.Decimal) predicate.OrderRepair {
+	vc := v.String()
+	return predicate.OrderRepair(sql.FieldContainsFold(FieldPrice, vc))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v decimal.Decimal) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v decimal.Decimal) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...decimal.Decimal) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...decimal.Decimal) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v decimal.Decimal) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v decimal.Decimal) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v decimal.Decimal) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v decimal.Decimal) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldLTE(FieldQuantity, v))
+}
+
+// QuantityContains applies the Contains predicate on the "quantity" field.
+func QuantityContains(v decimal.Decimal) predicate.OrderRepair {
+	vc := v.String()
+	return predicate.OrderRepair(sql.FieldContains(FieldQuantity, vc))
+}
+
+// QuantityHasPrefix applies the HasPrefix predicate on the "quantity" field.
+func QuantityHasPrefix(v decimal.Decimal) predicate.OrderRepair {
+	vc := v.String()
+	return predicate.OrderRepair(sql.FieldHasPrefix(FieldQuantity, vc))
+}
+
+// QuantityHasSuffix applies the HasSuffix predicate on the "quantity" field.
+func QuantityHasSuffix(v decimal.Decimal) predicate.OrderRepair {
+	vc := v.String()
+	return predicate.OrderRepair(sql.FieldHasSuffix(FieldQuantity, vc))
+}
+
+// QuantityEqualFold applies the EqualFold predicate on the "quantity" field.
+func QuantityEqualFold(v decimal.Decimal) predicate.OrderRepair {
+	vc := v.String()
+	return predicate.OrderRepair(sql.FieldEqualFold(FieldQuantity, vc))
+}
+
+// QuantityContainsFold applies the ContainsFold predicate on the "quantity" field.
+func QuantityContainsFold(v decimal.Decimal) predicate.OrderRepair {
+	vc := v.String()
+	return predicate.OrderRepair(sql.FieldContainsFold(FieldQuantity, vc))
+}
+
+// CanceledClientOrderIdEQ applies the EQ predicate on the "canceledClientOrderId" field.
+func CanceledClientOrderIdEQ(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldCanceledClientOrderId, v))
+}
+
+// CanceledClientOrderIdNEQ applies the NEQ predicate on the "canceledClientOrderId" field.
+func CanceledClientOrderIdNEQ(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNEQ(FieldCanceledClientOrderId, v))
+}
+
+// CanceledClientOrderIdIn applies the In predicate on the "canceledClientOrderId" field.
+func CanceledClientOrderIdIn(vs ...string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldIn(FieldCanceledClientOrderId, vs...))
+}
+
+// CanceledClientOrderIdNotIn applies the NotIn predicate on the "canceledClientOrderId" field.
+func CanceledClientOrderIdNotIn(vs ...string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNotIn(FieldCanceledClientOrderId, vs...))
+}
+
+// CanceledClientOrderIdGT applies the GT predicate on the "canceledClientOrderId" field.
+func CanceledClientOrderIdGT(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldGT(FieldCanceledClientOrderId, v))
+}
+
+// CanceledClientOrderIdGTE applies the GTE predicate on the "canceledClientOrderId" field.
+func CanceledClientOrderIdGTE(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldGTE(FieldCanceledClientOrderId, v))
+}
+
+// CanceledClientOrderIdLT applies the LT predicate on the "canceledClientOrderId" field.
+func CanceledClientOrderIdLT(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldLT(FieldCanceledClientOrderId, v))
+}
+
+// CanceledClientOrderIdLTE applies the LTE predicate on the "canceledClientOrderId" field.
+func CanceledClientOrderIdLTE(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldLTE(FieldCanceledClientOrderId, v))
+}
+
+// CanceledClientOrderIdContains applies the Contains predicate on the "canceledClientOrderId" field.
+func CanceledClientOrderIdContains(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldContains(FieldCanceledClientOrderId, v))
+}
+
+// CanceledClientOrderIdHasPrefix applies the HasPrefix predicate on the "canceledClientOrderId" field.
+func CanceledClientOrderIdHasPrefix(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldHasPrefix(FieldCanceledClientOrderId, v))
+}
+
+// CanceledClientOrderIdHasSuffix applies the HasSuffix predicate on the "canceledClientOrderId" field.
+func CanceledClientOrderIdHasSuffix(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldHasSuffix(FieldCanceledClientOrderId, v))
+}
+
+// CanceledClientOrderIdEqualFold applies the EqualFold predicate on the "canceledClientOrderId" field.
+func CanceledClientOrderIdEqualFold(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEqualFold(FieldCanceledClientOrderId, v))
+}
+
+// CanceledClientOrderIdContainsFold applies the ContainsFold predicate on the "canceledClientOrderId" field.
+func CanceledClientOrderIdContainsFold(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldContainsFold(FieldCanceledClientOrderId, v))
+}
+
+// NewClientOrderIdEQ applies the EQ predicate on the "newClientOrderId" field.
+func NewClientOrderIdEQ(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldNewClientOrderId, v))
+}
+
+// NewClientOrderIdNEQ applies the NEQ predicate on the "newClientOrderId" field.
+func NewClientOrderIdNEQ(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNEQ(FieldNewClientOrderId, v))
+}
+
+// NewClientOrderIdIn applies the In predicate on the "newClientOrderId" field.
+func NewClientOrderIdIn(vs ...string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldIn(FieldNewClientOrderId, vs...))
+}
+
+// NewClientOrderIdNotIn applies the NotIn predicate on the "newClientOrderId" field.
+func NewClientOrderIdNotIn(vs ...string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNotIn(FieldNewClientOrderId, vs...))
+}
+
+// NewClientOrderIdGT applies the GT predicate on the "newClientOrderId" field.
+func NewClientOrderIdGT(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldGT(FieldNewClientOrderId, v))
+}
+
+// NewClientOrderIdGTE applies the GTE predicate on the "newClientOrderId" field.
+func NewClientOrderIdGTE(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldGTE(FieldNewClientOrderId, v))
+}
+
+// NewClientOrderIdLT applies the LT predicate on the "newClientOrderId" field.
+func NewClientOrderIdLT(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldLT(FieldNewClientOrderId, v))
+}
+
+// NewClientOrderIdLTE applies the LTE predicate on the "newClientOrderId" field.
+func NewClientOrderIdLTE(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldLTE(FieldNewClientOrderId, v))
+}
+
+// NewClientOrderIdContains applies the Contains predicate on the "newClientOrderId" field.
+func NewClientOrderIdContains(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldContains(FieldNewClientOrderId, v))
+}
+
+// NewClientOrderIdHasPrefix applies the HasPrefix predicate on the "newClientOrderId" field.
+func NewClientOrderIdHasPrefix(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldHasPrefix(FieldNewClientOrderId, v))
+}
+
+// NewClientOrderIdHasSuffix applies the HasSuffix predicate on the "newClientOrderId" field.
+func NewClientOrderIdHasSuffix(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldHasSuffix(FieldNewClientOrderId, v))
+}
+
+// NewClientOrderIdIsNil applies the IsNil predicate on the "newClientOrderId" field.
+func NewClientOrderIdIsNil() predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldIsNull(FieldNewClientOrderId))
+}
+
+// NewClientOrderIdNotNil applies the NotNil predicate on the "newClientOrderId" field.
+func NewClientOrderIdNotNil() predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNotNull(FieldNewClientOrderId))
+}
+
+// NewClientOrderIdEqualFold applies the EqualFold predicate on the "newClientOrderId" field.
+func NewClientOrderIdEqualFold(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEqualFold(FieldNewClientOrderId, v))
+}
+
+// NewClientOrderIdContainsFold applies the ContainsFold predicate on the "newClientOrderId" field.
+func NewClientOrderIdContainsFold(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldContainsFold(FieldNewClientOrderId, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// AttemptEQ applies the EQ predicate on the "attempt" field.
+func AttemptEQ(v int) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldAttempt, v))
+}
+
+// AttemptNEQ applies the NEQ predicate on the "attempt" field.
+func AttemptNEQ(v int) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNEQ(FieldAttempt, v))
+}
+
+// AttemptIn applies the In predicate on the "attempt" field.
+func AttemptIn(vs ...int) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldIn(FieldAttempt, vs...))
+}
+
+// AttemptNotIn applies the NotIn predicate on the "attempt" field.
+func AttemptNotIn(vs ...int) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNotIn(FieldAttempt, vs...))
+}
+
+// AttemptGT applies the GT predicate on the "attempt" field.
+func AttemptGT(v int) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldGT(FieldAttempt, v))
+}
+
+// AttemptGTE applies the GTE predicate on the "attempt" field.
+func AttemptGTE(v int) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldGTE(FieldAttempt, v))
+}
+
+// AttemptLT applies the LT predicate on the "attempt" field.
+func AttemptLT(v int) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldLT(FieldAttempt, v))
+}
+
+// AttemptLTE applies the LTE predicate on the "attempt" field.
+func AttemptLTE(v int) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldLTE(FieldAttempt, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.FieldContainsFold(FieldReason, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OrderRepair) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OrderRepair) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OrderRepair) predicate.OrderRepair {
+	return predicate.OrderRepair(sql.NotPredicates(p))
+}
@@ -0,0 +1,1320 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/fachebot/omni-grid-bot/internal/ent/orderrepair"
+	"github.com/shopspring/decimal"
+)
+
+// OrderRepairCreate is the builder for creating a OrderRepair entity.
+type OrderRepairCreate struct {
+	config
+	mutation *OrderRepairMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *OrderRepairCreate) SetCreateTime(v time.Time) *OrderRepairCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *OrderRepairCreate) SetNillableCreateTime(v *time.Time) *OrderRepairCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *OrderRepairCreate) SetUpdateTime(v time.Time) *OrderRepairCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *OrderRepairCreate) SetNillableUpdateTime(v *time.Time) *OrderRepairCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetStrategyId sets the "strategyId" field.
+func (_c *OrderRepairCreate) SetStrategyId(v string) *OrderRepairCreate {
+	_c.mutation.SetStrategyId(v)
+	return _c
+}
+
+// SetExchange sets the "exchange" field.
+func (_c *OrderRepairCreate) SetExchange(v string) *OrderRepairCreate {
+	_c.mutation.SetExchange(v)
+	return _c
+}
+
+// SetSymbol sets the "symbol" field.
+func (_c *OrderRepairCreate) SetSymbol(v string) *OrderRepairCreate {
+	_c.mutation.SetSymbol(v)
+	return _c
+}
+
+// SetAccount sets the "account" field.
+func (_c *OrderRepairCreate) SetAccount(v string) *OrderRepairCreate {
+	_c.mutation.SetAccount(v)
+	return _c
+}
+
+// SetLevel sets the "level" field.
+func (_c *OrderRepairCreate) SetLevel(v int) *OrderRepairCreate {
+	_c.mutation.SetLevel(v)
+	return _c
+}
+
+// SetSide sets the "side" field.
+func (_c *OrderRepairCreate) SetSide(v orderrepair.Side) *OrderRepairCreate {
+	_c.mutation.SetSide(v)
+	return _c
+}
+
+// SetPrice sets the "price" field.
+func (_c *OrderRepairCreate) SetPrice(v decimal.Decimal) *OrderRepairCreate {
+	_c.mutation.SetPrice(v)
+	return _c
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *OrderRepairCreate) SetQuantity(v decimal.Decimal) *OrderRepairCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetCanceledClientOrderId sets the "canceledClientOrderId" field.
+func (_c *OrderRepairCreate) SetCanceledClientOrderId(v string) *OrderRepairCreate {
+	_c.mutation.SetCanceledClientOrderId(v)
+	return _c
+}
+
+// SetNewClientOrderId sets the "newClientOrderId" field.
+func (_c *OrderRepairCreate) SetNewClientOrderId(v string) *OrderRepairCreate {
+	_c.mutation.SetNewClientOrderId(v)
+	return _c
+}
+
+// SetNillableNewClientOrderId sets the "newClientOrderId" field if the given value is not nil.
+func (_c *OrderRepairCreate) SetNillableNewClientOrderId(v *string) *OrderRepairCreate {
+	if v != nil {
+		_c.SetNewClientOrderId(*v)
+	}
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *OrderRepairCreate) SetAction(v orderrepair.Action) *OrderRepairCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetAttempt sets the "attempt" field.
+func (_c *OrderRepairCreate) SetAttempt(v int) *OrderRepairCreate {
+	_c.mutation.SetAttempt(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *OrderRepairCreate) SetReason(v string) *OrderRepairCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *OrderRepairCreate) SetNillableReason(v *string) *OrderRepairCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// Mutation returns the OrderRepairMutation object of the builder.
+func (_c *OrderRepairCreate) Mutation() *OrderRepairMutation {
+	return _c.mutation
+}
+
+// Save creates the OrderRepair in the database.
+func (_c *OrderRepairCreate) Save(ctx context.Context) (*OrderRepair, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OrderRepairCreate) SaveX(ctx context.Context) *OrderRepair {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OrderRepairCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OrderRepairCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OrderRepairCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := orderrepair.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := orderrepair.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OrderRepairCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "OrderRepair.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "OrderRepair.update_time"`)}
+	}
+	if _, ok := _c.mutation.StrategyId(); !ok {
+		return &ValidationError{Name: "strategyId", err: errors.New(`ent: missing required field "OrderRepair.strategyId"`)}
+	}
+	if v, ok := _c.mutation.StrategyId(); ok {
+		if err := orderrepair.StrategyIdValidator(v); err != nil {
+			return &ValidationError{Name: "strategyId", err: fmt.Errorf(`ent: validator failed for field "OrderRepair.strategyId": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Exchange(); !ok {
+		return &ValidationError{Name: "exchange", err: errors.New(`ent: missing required field "OrderRepair.exchange"`)}
+	}
+	if v, ok := _c.mutation.Exchange(); ok {
+		if err := orderrepair.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "OrderRepair.exchange": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Symbol(); !ok {
+		return &ValidationError{Name: "symbol", err: errors.New(`ent: missing required field "OrderRepair.symbol"`)}
+	}
+	if v, ok := _c.mutation.Symbol(); ok {
+		if err := orderrepair.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "OrderRepair.symbol": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Account(); !ok {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required field "OrderRepair.account"`)}
+	}
+	if _, ok := _c.mutation.Level(); !ok {
+		return &ValidationError{Name: "level", err: errors.New(`ent: missing required field "OrderRepair.level"`)}
+	}
+	if _, ok := _c.mutation.Side(); !ok {
+		return &ValidationError{Name: "side", err: errors.New(`ent: missing required field "OrderRepair.side"`)}
+	}
+	if v, ok := _c.mutation.Side(); ok {
+		if err := orderrepair.SideValidator(v); err != nil {
+			return &ValidationError{Name: "side", err: fmt.Errorf(`ent: validator failed for field "OrderRepair.side": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Price(); !ok {
+		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "OrderRepair.price"`)}
+	}
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "OrderRepair.quantity"`)}
+	}
+	if _, ok := _c.mutation.CanceledClientOrderId(); !ok {
+		return &ValidationError{Name: "canceledClientOrderId", err: errors.New(`ent: missing required field "OrderRepair.canceledClientOrderId"`)}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "OrderRepair.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := orderrepair.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "OrderRepair.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempt(); !ok {
+		return &ValidationError{Name: "attempt", err: errors.New(`ent: missing required field "OrderRepair.attempt"`)}
+	}
+	if v, ok := _c.mutation.Attempt(); ok {
+		if err := orderrepair.AttemptValidator(v); err != nil {
+			return &ValidationError{Name: "attempt", err: fmt.Errorf(`ent: validator failed for field "OrderRepair.attempt": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *OrderRepairCreate) sqlSave(ctx context.Context) (*OrderRepair, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OrderRepairCreate) createSpec() (*OrderRepair, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OrderRepair{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(orderrepair.Table, sqlgraph.NewFieldSpec(orderrepair.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(orderrepair.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(orderrepair.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.StrategyId(); ok {
+		_spec.SetField(orderrepair.FieldStrategyId, field.TypeString, value)
+		_node.StrategyId = value
+	}
+	if value, ok := _c.mutation.Exchange(); ok {
+		_spec.SetField(orderrepair.FieldExchange, field.TypeString, value)
+		_node.Exchange = value
+	}
+	if value, ok := _c.mutation.Symbol(); ok {
+		_spec.SetField(orderrepair.FieldSymbol, field.TypeString, value)
+		_node.Symbol = value
+	}
+	if value, ok := _c.mutation.Account(); ok {
+		_spec.SetField(orderrepair.FieldAccount, field.TypeString, value)
+		_node.Account = value
+	}
+	if value, ok := _c.mutation.Level(); ok {
+		_spec.SetField(orderrepair.FieldLevel, field.TypeInt, value)
+		_node.Level = value
+	}
+	if value, ok := _c.mutation.Side(); ok {
+		_spec.SetField(orderrepair.FieldSide, field.TypeEnum, value)
+		_node.Side = value
+	}
+	if value, ok := _c.mutation.Price(); ok {
+		_spec.SetField(orderrepair.FieldPrice, field.TypeString, value)
+		_node.Price = value
+	}
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(orderrepair.FieldQuantity, field.TypeString, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.CanceledClientOrderId(); ok {
+		_spec.SetField(orderrepair.FieldCanceledClientOrderId, field.TypeString, value)
+		_node.CanceledClientOrderId = value
+	}
+	if value, ok := _c.mutation.NewClientOrderId(); ok {
+		_spec.SetField(orderrepair.FieldNewClientOrderId, field.TypeString, value)
+		_node.NewClientOrderId = &value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(orderrepair.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Attempt(); ok {
+		_spec.SetField(orderrepair.FieldAttempt, field.TypeInt, value)
+		_node.Attempt = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(orderrepair.FieldReason, field.TypeString, value)
+		_node.Reason = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OrderRepair.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OrderRepairUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *OrderRepairCreate) OnConflict(opts ...sql.ConflictOption) *OrderRepairUpsertOne {
+	_c.conflict = opts
+	return &OrderRepairUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OrderRepair.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *OrderRepairCreate) OnConflictColumns(columns ...string) *OrderRepairUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &OrderRepairUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// OrderRepairUpsertOne is the builder for "upsert"-ing
+	//  one OrderRepair node.
+	OrderRepairUpsertOne struct {
+		create *OrderRepairCreate
+	}
+
+	// OrderRepairUpsert is the "OnConflict" setter.
+	OrderRepairUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *OrderRepairUpsert) SetUpdateTime(v time.Time) *OrderRepairUpsert {
+	u.Set(orderrepair.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *OrderRepairUpsert) UpdateUpdateTime() *OrderRepairUpsert {
+	u.SetExcluded(orderrepair.FieldUpdateTime)
+	return u
+}
+
+// SetStrategyId sets the "strategyId" field.
+func (u *OrderRepairUpsert) SetStrategyId(v string) *OrderRepairUpsert {
+	u.Set(orderrepair.FieldStrategyId, v)
+	return u
+}
+
+// UpdateStrategyId sets the "strategyId" field to the value that was provided on create.
+func (u *OrderRepairUpsert) UpdateStrategyId() *OrderRepairUpsert {
+	u.SetExcluded(orderrepair.FieldStrategyId)
+	return u
+}
+
+// SetExchange sets the "exchange" field.
+func (u *OrderRepairUpsert) SetExchange(v string) *OrderRepairUpsert {
+	u.Set(orderrepair.FieldExchange, v)
+	return u
+}
+
+// UpdateExchange sets the "exchange" field to the value that was provided on create.
+func (u *OrderRepairUpsert) UpdateExchange() *OrderRepairUpsert {
+	u.SetExcluded(orderrepair.FieldExchange)
+	return u
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *OrderRepairUpsert) SetSymbol(v string) *OrderRepairUpsert {
+	u.Set(orderrepair.FieldSymbol, v)
+	return u
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *OrderRepairUpsert) UpdateSymbol() *OrderRepairUpsert {
+	u.SetExcluded(orderrepair.FieldSymbol)
+	return u
+}
+
+// SetAccount sets the "account" field.
+func (u *OrderRepairUpsert) SetAccount(v string) *OrderRepairUpsert {
+	u.Set(orderrepair.FieldAccount, v)
+	return u
+}
+
+// UpdateAccount sets the "account" field to the value that was provided on create.
+func (u *OrderRepairUpsert) UpdateAccount() *OrderRepairUpsert {
+	u.SetExcluded(orderrepair.FieldAccount)
+	return u
+}
+
+// SetLevel sets the "level" field.
+func (u *OrderRepairUpsert) SetLevel(v int) *OrderRepairUpsert {
+	u.Set(orderrepair.FieldLevel, v)
+	return u
+}
+
+// UpdateLevel sets the "level" field to the value that was provided on create.
+func (u *OrderRepairUpsert) UpdateLevel() *OrderRepairUpsert {
+	u.SetExcluded(orderrepair.FieldLevel)
+	return u
+}
+
+// AddLevel adds v to the "level" field.
+func (u *OrderRepairUpsert) AddLevel(v int) *OrderRepairUpsert {
+	u.Add(orderrepair.FieldLevel, v)
+	return u
+}
+
+// SetSide sets the "side" field.
+func (u *OrderRepairUpsert) SetSide(v orderrepair.Side) *OrderRepairUpsert {
+	u.Set(orderrepair.FieldSide, v)
+	return u
+}
+
+// UpdateSide sets the "side" field to the value that was provided on create.
+func (u *OrderRepairUpsert) UpdateSide() *OrderRepairUpsert {
+	u.SetExcluded(orderrepair.FieldSide)
+	return u
+}
+
+// SetPrice sets the "price" field.
+func (u *OrderRepairUpsert) SetPrice(v decimal.Decimal) *OrderRepairUpsert {
+	u.Set(orderrepair.FieldPrice, v)
+	return u
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *OrderRepairUpsert) UpdatePrice() *OrderRepairUpsert {
+	u.SetExcluded(orderrepair.FieldPrice)
+	return u
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *OrderRepairUpsert) SetQuantity(v decimal.Decimal) *OrderRepairUpsert {
+	u.Set(orderrepair.FieldQuantity, v)
+	return u
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *OrderRepairUpsert) UpdateQuantity() *OrderRepairUpsert {
+	u.SetExcluded(orderrepair.FieldQuantity)
+	return u
+}
+
+// SetCanceledClientOrderId sets the "canceledClientOrderId" field.
+func (u *OrderRepairUpsert) SetCanceledClientOrderId(v string) *OrderRepairUpsert {
+	u.Set(orderrepair.FieldCanceledClientOrderId, v)
+	return u
+}
+
+// UpdateCanceledClientOrderId sets the "canceledClientOrderId" field to the value that was provided on create.
+func (u *OrderRepairUpsert) UpdateCanceledClientOrderId() *OrderRepairUpsert {
+	u.SetExcluded(orderrepair.FieldCanceledClientOrderId)
+	return u
+}
+
+// SetNewClientOrderId sets the "newClientOrderId" field.
+func (u *OrderRepairUpsert) SetNewClientOrderId(v string) *OrderRepairUpsert {
+	u.Set(orderrepair.FieldNewClientOrderId, v)
+	return u
+}
+
+// UpdateNewClientOrderId sets the "newClientOrderId" field to the value that was provided on create.
+func (u *OrderRepairUpsert) UpdateNewClientOrderId() *OrderRepairUpsert {
+	u.SetExcluded(orderrepair.FieldNewClientOrderId)
+	return u
+}
+
+// ClearNewClientOrderId clears the value of the "newClientOrderId" field.
+func (u *OrderRepairUpsert) ClearNewClientOrderId() *OrderRepairUpsert {
+	u.SetNull(orderrepair.FieldNewClientOrderId)
+	return u
+}
+
+// SetAction sets the "action" field.
+func (u *OrderRepairUpsert) SetAction(v orderrepair.Action) *OrderRepairUpsert {
+	u.Set(orderrepair.FieldAction, v)
+	return u
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *OrderRepairUpsert) UpdateAction() *OrderRepairUpsert {
+	u.SetExcluded(orderrepair.FieldAction)
+	return u
+}
+
+// SetAttempt sets the "attempt" field.
+func (u *OrderRepairUpsert) SetAttempt(v int) *OrderRepairUpsert {
+	u.Set(orderrepair.FieldAttempt, v)
+	return u
+}
+
+// UpdateAttempt sets the "attempt" field to the value that was provided on create.
+func (u *OrderRepairUpsert) UpdateAttempt() *OrderRepairUpsert {
+	u.SetExcluded(orderrepair.FieldAttempt)
+	return u
+}
+
+// AddAttempt adds v to the "attempt" field.
+func (u *OrderRepairUpsert) AddAttempt(v int) *OrderRepairUpsert {
+	u.Add(orderrepair.FieldAttempt, v)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *OrderRepairUpsert) SetReason(v string) *OrderRepairUpsert {
+	u.Set(orderrepair.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *OrderRepairUpsert) UpdateReason() *OrderRepairUpsert {
+	u.SetExcluded(orderrepair.FieldReason)
+	return u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *OrderRepairUpsert) ClearReason() *OrderRepairUpsert {
+	u.SetNull(orderrepair.FieldReason)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.OrderRepair.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *OrderRepairUpsertOne) UpdateNewValues() *OrderRepairUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(orderrepair.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OrderRepair.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *OrderRepairUpsertOne) Ignore() *OrderRepairUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OrderRepairUpsertOne) DoNothing() *OrderRepairUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OrderRepairCreate.OnConflict
+// documentation for more info.
+func (u *OrderRepairUpsertOne) Update(set func(*OrderRepairUpsert)) *OrderRepairUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OrderRepairUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *OrderRepairUpsertOne) SetUpdateTime(v time.Time) *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *OrderRepairUpsertOne) UpdateUpdateTime() *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetStrategyId sets the "strategyId" field.
+func (u *OrderRepairUpsertOne) SetStrategyId(v string) *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.SetStrategyId(v)
+	})
+}
+
+// UpdateStrategyId sets the "strategyId" field to the value that was provided on create.
+func (u *OrderRepairUpsertOne) UpdateStrategyId() *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.UpdateStrategyId()
+	})
+}
+
+// SetExchange sets the "exchange" field.
+func (u *OrderRepairUpsertOne) SetExchange(v string) *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.SetExchange(v)
+	})
+}
+
+// UpdateExchange sets the "exchange" field to the value that was provided on create.
+func (u *OrderRepairUpsertOne) UpdateExchange() *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.UpdateExchange()
+	})
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *OrderRepairUpsertOne) SetSymbol(v string) *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.SetSymbol(v)
+	})
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *OrderRepairUpsertOne) UpdateSymbol() *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.UpdateSymbol()
+	})
+}
+
+// SetAccount sets the "account" field.
+func (u *OrderRepairUpsertOne) SetAccount(v string) *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.SetAccount(v)
+	})
+}
+
+// UpdateAccount sets the "account" field to the value that was provided on create.
+func (u *OrderRepairUpsertOne) UpdateAccount() *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.UpdateAccount()
+	})
+}
+
+// SetLevel sets the "level" field.
+func (u *OrderRepairUpsertOne) SetLevel(v int) *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.SetLevel(v)
+	})
+}
+
+// AddLevel adds v to the "level" field.
+func (u *OrderRepairUpsertOne) AddLevel(v int) *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.AddLevel(v)
+	})
+}
+
+// UpdateLevel sets the "level" field to the value that was provided on create.
+func (u *OrderRepairUpsertOne) UpdateLevel() *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.UpdateLevel()
+	})
+}
+
+// SetSide sets the "side" field.
+func (u *OrderRepairUpsertOne) SetSide(v orderrepair.Side) *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.SetSide(v)
+	})
+}
+
+// UpdateSide sets the "side" field to the value that was provided on create.
+func (u *OrderRepairUpsertOne) UpdateSide() *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.UpdateSide()
+	})
+}
+
+// SetPrice sets the "price" field.
+func (u *OrderRepairUpsertOne) SetPrice(v decimal.Decimal) *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *OrderRepairUpsertOne) UpdatePrice() *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *OrderRepairUpsertOne) SetQuantity(v decimal.Decimal) *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *OrderRepairUpsertOne) UpdateQuantity() *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetCanceledClientOrderId sets the "canceledClientOrderId" field.
+func (u *OrderRepairUpsertOne) SetCanceledClientOrderId(v string) *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.SetCanceledClientOrderId(v)
+	})
+}
+
+// UpdateCanceledClientOrderId sets the "canceledClientOrderId" field to the value that was provided on create.
+func (u *OrderRepairUpsertOne) UpdateCanceledClientOrderId() *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.UpdateCanceledClientOrderId()
+	})
+}
+
+// SetNewClientOrderId sets the "newClientOrderId" field.
+func (u *OrderRepairUpsertOne) SetNewClientOrderId(v string) *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.SetNewClientOrderId(v)
+	})
+}
+
+// UpdateNewClientOrderId sets the "newClientOrderId" field to the value that was provided on create.
+func (u *OrderRepairUpsertOne) UpdateNewClientOrderId() *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.UpdateNewClientOrderId()
+	})
+}
+
+// ClearNewClientOrderId clears the value of the "newClientOrderId" field.
+func (u *OrderRepairUpsertOne) ClearNewClientOrderId() *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.ClearNewClientOrderId()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *OrderRepairUpsertOne) SetAction(v orderrepair.Action) *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *OrderRepairUpsertOne) UpdateAction() *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetAttempt sets the "attempt" field.
+func (u *OrderRepairUpsertOne) SetAttempt(v int) *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.SetAttempt(v)
+	})
+}
+
+// AddAttempt adds v to the "attempt" field.
+func (u *OrderRepairUpsertOne) AddAttempt(v int) *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.AddAttempt(v)
+	})
+}
+
+// UpdateAttempt sets the "attempt" field to the value that was provided on create.
+func (u *OrderRepairUpsertOne) UpdateAttempt() *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.UpdateAttempt()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *OrderRepairUpsertOne) SetReason(v string) *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *OrderRepairUpsertOne) UpdateReason() *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *OrderRepairUpsertOne) ClearReason() *OrderRepairUpsertOne {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.ClearReason()
+	})
+}
+
+// Exec executes the query.
+func (u *OrderRepairUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OrderRepairCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OrderRepairUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *OrderRepairUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *OrderRepairUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// OrderRepairCreateBulk is the builder for creating many OrderRepair entities in bulk.
+type OrderRepairCreateBulk struct {
+	config
+	err      error
+	builders []*OrderRepairCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the OrderRepair entities in the database.
+func (_c *OrderRepairCreateBulk) Save(ctx context.Context) ([]*OrderRepair, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OrderRepair, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OrderRepairMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OrderRepairCreateBulk) SaveX(ctx context.Context) []*OrderRepair {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OrderRepairCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OrderRepairCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.OrderRepair.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OrderRepairUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *OrderRepairCreateBulk) OnConflict(opts ...sql.ConflictOption) *OrderRepairUpsertBulk {
+	_c.conflict = opts
+	return &OrderRepairUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.OrderRepair.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *OrderRepairCreateBulk) OnConflictColumns(columns ...string) *OrderRepairUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &OrderRepairUpsertBulk{
+		create: _c,
+	}
+}
+
+// OrderRepairUpsertBulk is the builder for "upsert"-ing
+// a bulk of OrderRepair nodes.
+type OrderRepairUpsertBulk struct {
+	create *OrderRepairCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.OrderRepair.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *OrderRepairUpsertBulk) UpdateNewValues() *OrderRepairUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(orderrepair.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.OrderRepair.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *OrderRepairUpsertBulk) Ignore() *OrderRepairUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OrderRepairUpsertBulk) DoNothing() *OrderRepairUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OrderRepairCreateBulk.OnConflict
+// documentation for more info.
+func (u *OrderRepairUpsertBulk) Update(set func(*OrderRepairUpsert)) *OrderRepairUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OrderRepairUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *OrderRepairUpsertBulk) SetUpdateTime(v time.Time) *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *OrderRepairUpsertBulk) UpdateUpdateTime() *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetStrategyId sets the "strategyId" field.
+func (u *OrderRepairUpsertBulk) SetStrategyId(v string) *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.SetStrategyId(v)
+	})
+}
+
+// UpdateStrategyId sets the "strategyId" field to the value that was provided on create.
+func (u *OrderRepairUpsertBulk) UpdateStrategyId() *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.UpdateStrategyId()
+	})
+}
+
+// SetExchange sets the "exchange" field.
+func (u *OrderRepairUpsertBulk) SetExchange(v string) *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.SetExchange(v)
+	})
+}
+
+// UpdateExchange sets the "exchange" field to the value that was provided on create.
+func (u *OrderRepairUpsertBulk) UpdateExchange() *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.UpdateExchange()
+	})
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *OrderRepairUpsertBulk) SetSymbol(v string) *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.SetSymbol(v)
+	})
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *OrderRepairUpsertBulk) UpdateSymbol() *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.UpdateSymbol()
+	})
+}
+
+// SetAccount sets the "account" field.
+func (u *OrderRepairUpsertBulk) SetAccount(v string) *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.SetAccount(v)
+	})
+}
+
+// UpdateAccount sets the "account" field to the value that was provided on create.
+func (u *OrderRepairUpsertBulk) UpdateAccount() *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.UpdateAccount()
+	})
+}
+
+// SetLevel sets the "level" field.
+func (u *OrderRepairUpsertBulk) SetLevel(v int) *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.SetLevel(v)
+	})
+}
+
+// AddLevel adds v to the "level" field.
+func (u *OrderRepairUpsertBulk) AddLevel(v int) *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.AddLevel(v)
+	})
+}
+
+// UpdateLevel sets the "level" field to the value that was provided on create.
+func (u *OrderRepairUpsertBulk) UpdateLevel() *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.UpdateLevel()
+	})
+}
+
+// SetSide sets the "side" field.
+func (u *OrderRepairUpsertBulk) SetSide(v orderrepair.Side) *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.SetSide(v)
+	})
+}
+
+// UpdateSide sets the "side" field to the value that was provided on create.
+func (u *OrderRepairUpsertBulk) UpdateSide() *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.UpdateSide()
+	})
+}
+
+// SetPrice sets the "price" field.
+func (u *OrderRepairUpsertBulk) SetPrice(v decimal.Decimal) *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *OrderRepairUpsertBulk) UpdatePrice() *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *OrderRepairUpsertBulk) SetQuantity(v decimal.Decimal) *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *OrderRepairUpsertBulk) UpdateQuantity() *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetCanceledClientOrderId sets the "canceledClientOrderId" field.
+func (u *OrderRepairUpsertBulk) SetCanceledClientOrderId(v string) *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.SetCanceledClientOrderId(v)
+	})
+}
+
+// UpdateCanceledClientOrderId sets the "canceledClientOrderId" field to the value that was provided on create.
+func (u *OrderRepairUpsertBulk) UpdateCanceledClientOrderId() *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.UpdateCanceledClientOrderId()
+	})
+}
+
+// SetNewClientOrderId sets the "newClientOrderId" field.
+func (u *OrderRepairUpsertBulk) SetNewClientOrderId(v string) *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.SetNewClientOrderId(v)
+	})
+}
+
+// UpdateNewClientOrderId sets the "newClientOrderId" field to the value that was provided on create.
+func (u *OrderRepairUpsertBulk) UpdateNewClientOrderId() *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.UpdateNewClientOrderId()
+	})
+}
+
+// ClearNewClientOrderId clears the value of the "newClientOrderId" field.
+func (u *OrderRepairUpsertBulk) ClearNewClientOrderId() *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.ClearNewClientOrderId()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *OrderRepairUpsertBulk) SetAction(v orderrepair.Action) *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *OrderRepairUpsertBulk) UpdateAction() *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetAttempt sets the "attempt" field.
+func (u *OrderRepairUpsertBulk) SetAttempt(v int) *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.SetAttempt(v)
+	})
+}
+
+// AddAttempt adds v to the "attempt" field.
+func (u *OrderRepairUpsertBulk) AddAttempt(v int) *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.AddAttempt(v)
+	})
+}
+
+// UpdateAttempt sets the "attempt" field to the value that was provided on create.
+func (u *OrderRepairUpsertBulk) UpdateAttempt() *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.UpdateAttempt()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *OrderRepairUpsertBulk) SetReason(v string) *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *OrderRepairUpsertBulk) UpdateReason() *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *OrderRepairUpsertBulk) ClearReason() *OrderRepairUpsertBulk {
+	return u.Update(func(s *OrderRepairUpsert) {
+		s.ClearReason()
+	})
+}
+
+// Exec executes the query.
+func (u *OrderRepairUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the OrderRepairCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OrderRepairCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OrderRepairUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
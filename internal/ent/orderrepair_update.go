@@ -0,0 +1,807 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/fachebot/omni-grid-bot/internal/ent/orderrepair"
+	"github.com/fachebot/omni-grid-bot/internal/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// OrderRepairUpdate is the builder for updating OrderRepair entities.
+type OrderRepairUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OrderRepairMutation
+}
+
+// Where appends a list predicates to the OrderRepairUpdate builder.
+func (_u *OrderRepairUpdate) Where(ps ...predicate.OrderRepair) *OrderRepairUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *OrderRepairUpdate) SetUpdateTime(v time.Time) *OrderRepairUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetStrategyId sets the "strategyId" field.
+func (_u *OrderRepairUpdate) SetStrategyId(v string) *OrderRepairUpdate {
+	_u.mutation.SetStrategyId(v)
+	return _u
+}
+
+// SetNillableStrategyId sets the "strategyId" field if the given value is not nil.
+func (_u *OrderRepairUpdate) SetNillableStrategyId(v *string) *OrderRepairUpdate {
+	if v != nil {
+		_u.SetStrategyId(*v)
+	}
+	return _u
+}
+
+// SetExchange sets the "exchange" field.
+func (_u *OrderRepairUpdate) SetExchange(v string) *OrderRepairUpdate {
+	_u.mutation.SetExchange(v)
+	return _u
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_u *OrderRepairUpdate) SetNillableExchange(v *string) *OrderRepairUpdate {
+	if v != nil {
+		_u.SetExchange(*v)
+	}
+	return _u
+}
+
+// SetSymbol sets the "symbol" field.
+func (_u *OrderRepairUpdate) SetSymbol(v string) *OrderRepairUpdate {
+	_u.mutation.SetSymbol(v)
+	return _u
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_u *OrderRepairUpdate) SetNillableSymbol(v *string) *OrderRepairUpdate {
+	if v != nil {
+		_u.SetSymbol(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" field.
+func (_u *OrderRepairUpdate) SetAccount(v string) *OrderRepairUpdate {
+	_u.mutation.SetAccount(v)
+	return _u
+}
+
+// SetNillableAccount sets the "account" field if the given value is not nil.
+func (_u *OrderRepairUpdate) SetNillableAccount(v *string) *OrderRepairUpdate {
+	if v != nil {
+		_u.SetAccount(*v)
+	}
+	return _u
+}
+
+// SetLevel sets the "level" field.
+func (_u *OrderRepairUpdate) SetLevel(v int) *OrderRepairUpdate {
+	_u.mutation.ResetLevel()
+	_u.mutation.SetLevel(v)
+	return _u
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (_u *OrderRepairUpdate) SetNillableLevel(v *int) *OrderRepairUpdate {
+	if v != nil {
+		_u.SetLevel(*v)
+	}
+	return _u
+}
+
+// AddLevel adds value to the "level" field.
+func (_u *OrderRepairUpdate) AddLevel(v int) *OrderRepairUpdate {
+	_u.mutation.AddLevel(v)
+	return _u
+}
+
+// SetSide sets the "side" field.
+func (_u *OrderRepairUpdate) SetSide(v orderrepair.Side) *OrderRepairUpdate {
+	_u.mutation.SetSide(v)
+	return _u
+}
+
+// SetNillableSide sets the "side" field if the given value is not nil.
+func (_u *OrderRepairUpdate) SetNillableSide(v *orderrepair.Side) *OrderRepairUpdate {
+	if v != nil {
+		_u.SetSide(*v)
+	}
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *OrderRepairUpdate) SetPrice(v decimal.Decimal) *OrderRepairUpdate {
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *OrderRepairUpdate) SetNillablePrice(v *decimal.Decimal) *OrderRepairUpdate {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *OrderRepairUpdate) SetQuantity(v decimal.Decimal) *OrderRepairUpdate {
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *OrderRepairUpdate) SetNillableQuantity(v *decimal.Decimal) *OrderRepairUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// SetCanceledClientOrderId sets the "canceledClientOrderId" field.
+func (_u *OrderRepairUpdate) SetCanceledClientOrderId(v string) *OrderRepairUpdate {
+	_u.mutation.SetCanceledClientOrderId(v)
+	return _u
+}
+
+// SetNillableCanceledClientOrderId sets the "canceledClientOrderId" field if the given value is not nil.
+func (_u *OrderRepairUpdate) SetNillableCanceledClientOrderId(v *string) *OrderRepairUpdate {
+	if v != nil {
+		_u.SetCanceledClientOrderId(*v)
+	}
+	return _u
+}
+
+// SetNewClientOrderId sets the "newClientOrderId" field.
+func (_u *OrderRepairUpdate) SetNewClientOrderId(v string) *OrderRepairUpdate {
+	_u.mutation.SetNewClientOrderId(v)
+	return _u
+}
+
+// SetNillableNewClientOrderId sets the "newClientOrderId" field if the given value is not nil.
+func (_u *OrderRepairUpdate) SetNillableNewClientOrderId(v *string) *OrderRepairUpdate {
+	if v != nil {
+		_u.SetNewClientOrderId(*v)
+	}
+	return _u
+}
+
+// ClearNewClientOrderId clears the value of the "newClientOrderId" field.
+func (_u *OrderRepairUpdate) ClearNewClientOrderId() *OrderRepairUpdate {
+	_u.mutation.ClearNewClientOrderId()
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *OrderRepairUpdate) SetAction(v orderrepair.Action) *OrderRepairUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *OrderRepairUpdate) SetNillableAction(v *orderrepair.Action) *OrderRepairUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetAttempt sets the "attempt" field.
+func (_u *OrderRepairUpdate) SetAttempt(v int) *OrderRepairUpdate {
+	_u.mutation.ResetAttempt()
+	_u.mutation.SetAttempt(v)
+	return _u
+}
+
+// SetNillableAttempt sets the "attempt" field if the given value is not nil.
+func (_u *OrderRepairUpdate) SetNillableAttempt(v *int) *OrderRepairUpdate {
+	if v != nil {
+		_u.SetAttempt(*v)
+	}
+	return _u
+}
+
+// AddAttempt adds value to the "attempt" field.
+func (_u *OrderRepairUpdate) AddAttempt(v int) *OrderRepairUpdate {
+	_u.mutation.AddAttempt(v)
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *OrderRepairUpdate) SetReason(v string) *OrderRepairUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *OrderRepairUpdate) SetNillableReason(v *string) *OrderRepairUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *OrderRepairUpdate) ClearReason() *OrderRepairUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// Mutation returns the OrderRepairMutation object of the builder.
+func (_u *OrderRepairUpdate) Mutation() *OrderRepairMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OrderRepairUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OrderRepairUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OrderRepairUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OrderRepairUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OrderRepairUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := orderrepair.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OrderRepairUpdate) check() error {
+	if v, ok := _u.mutation.StrategyId(); ok {
+		if err := orderrepair.StrategyIdValidator(v); err != nil {
+			return &ValidationError{Name: "strategyId", err: fmt.Errorf(`ent: validator failed for field "OrderRepair.strategyId": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Exchange(); ok {
+		if err := orderrepair.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "OrderRepair.exchange": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Symbol(); ok {
+		if err := orderrepair.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "OrderRepair.symbol": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Side(); ok {
+		if err := orderrepair.SideValidator(v); err != nil {
+			return &ValidationError{Name: "side", err: fmt.Errorf(`ent: validator failed for field "OrderRepair.side": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := orderrepair.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "OrderRepair.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Attempt(); ok {
+		if err := orderrepair.AttemptValidator(v); err != nil {
+			return &ValidationError{Name: "attempt", err: fmt.Errorf(`ent: validator failed for field "OrderRepair.attempt": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OrderRepairUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(orderrepair.Table, orderrepair.Columns, sqlgraph.NewFieldSpec(orderrepair.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(orderrepair.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.StrategyId(); ok {
+		_spec.SetField(orderrepair.FieldStrategyId, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Exchange(); ok {
+		_spec.SetField(orderrepair.FieldExchange, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Symbol(); ok {
+		_spec.SetField(orderrepair.FieldSymbol, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Account(); ok {
+		_spec.SetField(orderrepair.FieldAccount, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Level(); ok {
+		_spec.SetField(orderrepair.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLevel(); ok {
+		_spec.AddField(orderrepair.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Side(); ok {
+		_spec.SetField(orderrepair.FieldSide, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(orderrepair.FieldPrice, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(orderrepair.FieldQuantity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CanceledClientOrderId(); ok {
+		_spec.SetField(orderrepair.FieldCanceledClientOrderId, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.NewClientOrderId(); ok {
+		_spec.SetField(orderrepair.FieldNewClientOrderId, field.TypeString, value)
+	}
+	if _u.mutation.NewClientOrderIdCleared() {
+		_spec.ClearField(orderrepair.FieldNewClientOrderId, field.TypeString)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(orderrepair.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempt(); ok {
+		_spec.SetField(orderrepair.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempt(); ok {
+		_spec.AddField(orderrepair.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(orderrepair.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(orderrepair.FieldReason, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{orderrepair.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OrderRepairUpdateOne is the builder for updating a single OrderRepair entity.
+type OrderRepairUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OrderRepairMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *OrderRepairUpdateOne) SetUpdateTime(v time.Time) *OrderRepairUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetStrategyId sets the "strategyId" field.
+func (_u *OrderRepairUpdateOne) SetStrategyId(v string) *OrderRepairUpdateOne {
+	_u.mutation.SetStrategyId(v)
+	return _u
+}
+
+// SetNillableStrategyId sets the "strategyId" field if the given value is not nil.
+func (_u *OrderRepairUpdateOne) SetNillableStrategyId(v *string) *OrderRepairUpdateOne {
+	if v != nil {
+		_u.SetStrategyId(*v)
+	}
+	return _u
+}
+
+// SetExchange sets the "exchange" field.
+func (_u *OrderRepairUpdateOne) SetExchange(v string) *OrderRepairUpdateOne {
+	_u.mutation.SetExchange(v)
+	return _u
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_u *OrderRepairUpdateOne) SetNillableExchange(v *string) *OrderRepairUpdateOne {
+	if v != nil {
+		_u.SetExchange(*v)
+	}
+	return _u
+}
+
+// SetSymbol sets the "symbol" field.
+func (_u *OrderRepairUpdateOne) SetSymbol(v string) *OrderRepairUpdateOne {
+	_u.mutation.SetSymbol(v)
+	return _u
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_u *OrderRepairUpdateOne) SetNillableSymbol(v *string) *OrderRepairUpdateOne {
+	if v != nil {
+		_u.SetSymbol(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" field.
+func (_u *OrderRepairUpdateOne) SetAccount(v string) *OrderRepairUpdateOne {
+	_u.mutation.SetAccount(v)
+	return _u
+}
+
+// SetNillableAccount sets the "account" field if the given value is not nil.
+func (_u *OrderRepairUpdateOne) SetNillableAccount(v *string) *OrderRepairUpdateOne {
+	if v != nil {
+		_u.SetAccount(*v)
+	}
+	return _u
+}
+
+// SetLevel sets the "level" field.
+func (_u *OrderRepairUpdateOne) SetLevel(v int) *OrderRepairUpdateOne {
+	_u.mutation.ResetLevel()
+	_u.mutation.SetLevel(v)
+	return _u
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (_u *OrderRepairUpdateOne) SetNillableLevel(v *int) *OrderRepairUpdateOne {
+	if v != nil {
+		_u.SetLevel(*v)
+	}
+	return _u
+}
+
+// AddLevel adds value to the "level" field.
+func (_u *OrderRepairUpdateOne) AddLevel(v int) *OrderRepairUpdateOne {
+	_u.mutation.AddLevel(v)
+	return _u
+}
+
+// SetSide sets the "side" field.
+func (_u *OrderRepairUpdateOne) SetSide(v orderrepair.Side) *OrderRepairUpdateOne {
+	_u.mutation.SetSide(v)
+	return _u
+}
+
+// SetNillableSide sets the "side" field if the given value is not nil.
+func (_u *OrderRepairUpdateOne) SetNillableSide(v *orderrepair.Side) *OrderRepairUpdateOne {
+	if v != nil {
+		_u.SetSide(*v)
+	}
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *OrderRepairUpdateOne) SetPrice(v decimal.Decimal) *OrderRepairUpdateOne {
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *OrderRepairUpdateOne) SetNillablePrice(v *decimal.Decimal) *OrderRepairUpdateOne {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *OrderRepairUpdateOne) SetQuantity(v decimal.Decimal) *OrderRepairUpdateOne {
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *OrderRepairUpdateOne) SetNillableQuantity(v *decimal.Decimal) *OrderRepairUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// SetCanceledClientOrderId sets the "canceledClientOrderId" field.
+func (_u *OrderRepairUpdateOne) SetCanceledClientOrderId(v string) *OrderRepairUpdateOne {
+	_u.mutation.SetCanceledClientOrderId(v)
+	return _u
+}
+
+// SetNillableCanceledClientOrderId sets the "canceledClientOrderId" field if the given value is not nil.
+func (_u *OrderRepairUpdateOne) SetNillableCanceledClientOrderId(v *string) *OrderRepairUpdateOne {
+	if v != nil {
+		_u.SetCanceledClientOrderId(*v)
+	}
+	return _u
+}
+
+// SetNewClientOrderId sets the "newClientOrderId" field.
+func (_u *OrderRepairUpdateOne) SetNewClientOrderId(v string) *OrderRepairUpdateOne {
+	_u.mutation.SetNewClientOrderId(v)
+	return _u
+}
+
+// SetNillableNewClientOrderId sets the "newClientOrderId" field if the given value is not nil.
+func (_u *OrderRepairUpdateOne) SetNillableNewClientOrderId(v *string) *OrderRepairUpdateOne {
+	if v != nil {
+		_u.SetNewClientOrderId(*v)
+	}
+	return _u
+}
+
+// ClearNewClientOrderId clears the value of the "newClientOrderId" field.
+func (_u *OrderRepairUpdateOne) ClearNewClientOrderId() *OrderRepairUpdateOne {
+	_u.mutation.ClearNewClientOrderId()
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *OrderRepairUpdateOne) SetAction(v orderrepair.Action) *OrderRepairUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *OrderRepairUpdateOne) SetNillableAction(v *orderrepair.Action) *OrderRepairUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetAttempt sets the "attempt" field.
+func (_u *OrderRepairUpdateOne) SetAttempt(v int) *OrderRepairUpdateOne {
+	_u.mutation.ResetAttempt()
+	_u.mutation.SetAttempt(v)
+	return _u
+}
+
+// SetNillableAttempt sets the "attempt" field if the given value is not nil.
+func (_u *OrderRepairUpdateOne) SetNillableAttempt(v *int) *OrderRepairUpdateOne {
+	if v != nil {
+		_u.SetAttempt(*v)
+	}
+	return _u
+}
+
+// AddAttempt adds value to the "attempt" field.
+func (_u *OrderRepairUpdateOne) AddAttempt(v int) *OrderRepairUpdateOne {
+	_u.mutation.AddAttempt(v)
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *OrderRepairUpdateOne) SetReason(v string) *OrderRepairUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *OrderRepairUpdateOne) SetNillableReason(v *string) *OrderRepairUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *OrderRepairUpdateOne) ClearReason() *OrderRepairUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// Mutation returns the OrderRepairMutation object of the builder.
+func (_u *OrderRepairUpdateOne) Mutation() *OrderRepairMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OrderRepairUpdate builder.
+func (_u *OrderRepairUpdateOne) Where(ps ...predicate.OrderRepair) *OrderRepairUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OrderRepairUpdateOne) Select(field string, fields ...string) *OrderRepairUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OrderRepair entity.
+func (_u *OrderRepairUpdateOne) Save(ctx context.Context) (*OrderRepair, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OrderRepairUpdateOne) SaveX(ctx context.Context) *OrderRepair {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OrderRepairUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OrderRepairUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OrderRepairUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := orderrepair.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OrderRepairUpdateOne) check() error {
+	if v, ok := _u.mutation.StrategyId(); ok {
+		if err := orderrepair.StrategyIdValidator(v); err != nil {
+			return &ValidationError{Name: "strategyId", err: fmt.Errorf(`ent: validator failed for field "OrderRepair.strategyId": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Exchange(); ok {
+		if err := orderrepair.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "OrderRepair.exchange": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Symbol(); ok {
+		if err := orderrepair.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "OrderRepair.symbol": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Side(); ok {
+		if err := orderrepair.SideValidator(v); err != nil {
+			return &ValidationError{Name: "side", err: fmt.Errorf(`ent: validator failed for field "OrderRepair.side": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := orderrepair.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "OrderRepair.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Attempt(); ok {
+		if err := orderrepair.AttemptValidator(v); err != nil {
+			return &ValidationError{Name: "attempt", err: fmt.Errorf(`ent: validator failed for field "OrderRepair.attempt": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OrderRepairUpdateOne) sqlSave(ctx context.Context) (_node *OrderRepair, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(orderrepair.Table, orderrepair.Columns, sqlgraph.NewFieldSpec(orderrepair.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OrderRepair.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, orderrepair.FieldID)
+		for _, f := range fields {
+			if !orderrepair.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != orderrepair.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(orderrepair.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.StrategyId(); ok {
+		_spec.SetField(orderrepair.FieldStrategyId, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Exchange(); ok {
+		_spec.SetField(orderrepair.FieldExchange, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Symbol(); ok {
+		_spec.SetField(orderrepair.FieldSymbol, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Account(); ok {
+		_spec.SetField(orderrepair.FieldAccount, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Level(); ok {
+		_spec.SetField(orderrepair.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLevel(); ok {
+		_spec.AddField(orderrepair.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Side(); ok {
+		_spec.SetField(orderrepair.FieldSide, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(orderrepair.FieldPrice, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(orderrepair.FieldQuantity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CanceledClientOrderId(); ok {
+		_spec.SetField(orderrepair.FieldCanceledClientOrderId, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.NewClientOrderId(); ok {
+		_spec.SetField(orderrepair.FieldNewClientOrderId, field.TypeString, value)
+	}
+	if _u.mutation.NewClientOrderIdCleared() {
+		_spec.ClearField(orderrepair.FieldNewClientOrderId, field.TypeString)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(orderrepair.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempt(); ok {
+		_spec.SetField(orderrepair.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempt(); ok {
+		_spec.AddField(orderrepair.FieldAttempt, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(orderrepair.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(orderrepair.FieldReason, field.TypeString)
+	}
+	_node = &OrderRepair{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{orderrepair.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
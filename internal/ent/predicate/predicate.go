@@ -6,6 +6,26 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// ExchangeAccount is the predicate function for exchangeaccount builders.
+type ExchangeAccount func(*sql.Selector)
+
+// ExchangeAccountOrErr calls the predicate only if the error is not nit.
+func ExchangeAccountOrErr(p ExchangeAccount, err error) ExchangeAccount {
+	return func(s *sql.Selector) {
+		if err != nil {
+			s.AddError(err)
+			return
+		}
+		p(s)
+	}
+}
+
+// Fill is the predicate function for fill builders.
+type Fill func(*sql.Selector)
+
+// FundingPayment is the predicate function for fundingpayment builders.
+type FundingPayment func(*sql.Selector)
+
 // Grid is the predicate function for grid builders.
 type Grid func(*sql.Selector)
 
@@ -15,8 +35,25 @@ type MatchedTrade func(*sql.Selector)
 // Order is the predicate function for order builders.
 type Order func(*sql.Selector)
 
+// OrderRepair is the predicate function for orderrepair builders.
+type OrderRepair func(*sql.Selector)
+
 // Strategy is the predicate function for strategy builders.
 type Strategy func(*sql.Selector)
 
+// StrategyOrErr calls the predicate only if the error is not nit.
+func StrategyOrErr(p Strategy, err error) Strategy {
+	return func(s *sql.Selector) {
+		if err != nil {
+			s.AddError(err)
+			return
+		}
+		p(s)
+	}
+}
+
+// StrategyRun is the predicate function for strategyrun builders.
+type StrategyRun func(*sql.Selector)
+
 // SyncProgress is the predicate function for syncprogress builders.
 type SyncProgress func(*sql.Selector)
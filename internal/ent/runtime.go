@@ -5,18 +5,116 @@ package ent
 import (
 	"time"
 
+	"github.com/fachebot/omni-grid-bot/internal/ent/exchangeaccount"
+	"github.com/fachebot/omni-grid-bot/internal/ent/fill"
+	"github.com/fachebot/omni-grid-bot/internal/ent/fundingpayment"
 	"github.com/fachebot/omni-grid-bot/internal/ent/grid"
 	"github.com/fachebot/omni-grid-bot/internal/ent/matchedtrade"
 	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/ent/orderrepair"
 	"github.com/fachebot/omni-grid-bot/internal/ent/schema"
 	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategyrun"
 	"github.com/fachebot/omni-grid-bot/internal/ent/syncprogress"
+
+	"entgo.io/ent/schema/field"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	exchangeaccountMixin := schema.ExchangeAccount{}.Mixin()
+	exchangeaccountMixinFields0 := exchangeaccountMixin[0].Fields()
+	_ = exchangeaccountMixinFields0
+	exchangeaccountFields := schema.ExchangeAccount{}.Fields()
+	_ = exchangeaccountFields
+	// exchangeaccountDescCreateTime is the schema descriptor for create_time field.
+	exchangeaccountDescCreateTime := exchangeaccountMixinFields0[0].Descriptor()
+	// exchangeaccount.DefaultCreateTime holds the default value on creation for the create_time field.
+	exchangeaccount.DefaultCreateTime = exchangeaccountDescCreateTime.Default.(func() time.Time)
+	// exchangeaccountDescUpdateTime is the schema descriptor for update_time field.
+	exchangeaccountDescUpdateTime := exchangeaccountMixinFields0[1].Descriptor()
+	// exchangeaccount.DefaultUpdateTime holds the default value on creation for the update_time field.
+	exchangeaccount.DefaultUpdateTime = exchangeaccountDescUpdateTime.Default.(func() time.Time)
+	// exchangeaccount.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	exchangeaccount.UpdateDefaultUpdateTime = exchangeaccountDescUpdateTime.UpdateDefault.(func() time.Time)
+	// exchangeaccountDescExchange is the schema descriptor for exchange field.
+	exchangeaccountDescExchange := exchangeaccountFields[1].Descriptor()
+	// exchangeaccount.ExchangeValidator is a validator for the "exchange" field. It is called by the builders before save.
+	exchangeaccount.ExchangeValidator = exchangeaccountDescExchange.Validators[0].(func(string) error)
+	// exchangeaccountDescLabel is the schema descriptor for label field.
+	exchangeaccountDescLabel := exchangeaccountFields[2].Descriptor()
+	// exchangeaccount.LabelValidator is a validator for the "label" field. It is called by the builders before save.
+	exchangeaccount.LabelValidator = exchangeaccountDescLabel.Validators[0].(func(string) error)
+	// exchangeaccountDescSecretKey is the schema descriptor for secretKey field.
+	exchangeaccountDescSecretKey := exchangeaccountFields[4].Descriptor()
+	exchangeaccount.ValueScanner.SecretKey = exchangeaccountDescSecretKey.ValueScanner.(field.TypeValueScanner[string])
+	// exchangeaccountDescPassphrase is the schema descriptor for passphrase field.
+	exchangeaccountDescPassphrase := exchangeaccountFields[5].Descriptor()
+	exchangeaccount.ValueScanner.Passphrase = exchangeaccountDescPassphrase.ValueScanner.(field.TypeValueScanner[string])
+	// exchangeaccountDescTestnet is the schema descriptor for testnet field.
+	exchangeaccountDescTestnet := exchangeaccountFields[6].Descriptor()
+	// exchangeaccount.DefaultTestnet holds the default value on creation for the testnet field.
+	exchangeaccount.DefaultTestnet = exchangeaccountDescTestnet.Default.(bool)
+	fillMixin := schema.Fill{}.Mixin()
+	fillMixinFields0 := fillMixin[0].Fields()
+	_ = fillMixinFields0
+	fillFields := schema.Fill{}.Fields()
+	_ = fillFields
+	// fillDescCreateTime is the schema descriptor for create_time field.
+	fillDescCreateTime := fillMixinFields0[0].Descriptor()
+	// fill.DefaultCreateTime holds the default value on creation for the create_time field.
+	fill.DefaultCreateTime = fillDescCreateTime.Default.(func() time.Time)
+	// fillDescUpdateTime is the schema descriptor for update_time field.
+	fillDescUpdateTime := fillMixinFields0[1].Descriptor()
+	// fill.DefaultUpdateTime holds the default value on creation for the update_time field.
+	fill.DefaultUpdateTime = fillDescUpdateTime.Default.(func() time.Time)
+	// fill.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	fill.UpdateDefaultUpdateTime = fillDescUpdateTime.UpdateDefault.(func() time.Time)
+	// fillDescExchange is the schema descriptor for exchange field.
+	fillDescExchange := fillFields[0].Descriptor()
+	// fill.ExchangeValidator is a validator for the "exchange" field. It is called by the builders before save.
+	fill.ExchangeValidator = fillDescExchange.Validators[0].(func(string) error)
+	// fillDescSymbol is the schema descriptor for symbol field.
+	fillDescSymbol := fillFields[2].Descriptor()
+	// fill.SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
+	fill.SymbolValidator = fillDescSymbol.Validators[0].(func(string) error)
+	// fillDescFeeAsset is the schema descriptor for feeAsset field.
+	fillDescFeeAsset := fillFields[11].Descriptor()
+	// fill.FeeAssetValidator is a validator for the "feeAsset" field. It is called by the builders before save.
+	fill.FeeAssetValidator = fillDescFeeAsset.Validators[0].(func(string) error)
+	// fillDescIsMaker is the schema descriptor for isMaker field.
+	fillDescIsMaker := fillFields[12].Descriptor()
+	// fill.DefaultIsMaker holds the default value on creation for the isMaker field.
+	fill.DefaultIsMaker = fillDescIsMaker.Default.(bool)
+	fundingpaymentMixin := schema.FundingPayment{}.Mixin()
+	fundingpaymentMixinFields0 := fundingpaymentMixin[0].Fields()
+	_ = fundingpaymentMixinFields0
+	fundingpaymentFields := schema.FundingPayment{}.Fields()
+	_ = fundingpaymentFields
+	// fundingpaymentDescCreateTime is the schema descriptor for create_time field.
+	fundingpaymentDescCreateTime := fundingpaymentMixinFields0[0].Descriptor()
+	// fundingpayment.DefaultCreateTime holds the default value on creation for the create_time field.
+	fundingpayment.DefaultCreateTime = fundingpaymentDescCreateTime.Default.(func() time.Time)
+	// fundingpaymentDescUpdateTime is the schema descriptor for update_time field.
+	fundingpaymentDescUpdateTime := fundingpaymentMixinFields0[1].Descriptor()
+	// fundingpayment.DefaultUpdateTime holds the default value on creation for the update_time field.
+	fundingpayment.DefaultUpdateTime = fundingpaymentDescUpdateTime.Default.(func() time.Time)
+	// fundingpayment.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	fundingpayment.UpdateDefaultUpdateTime = fundingpaymentDescUpdateTime.UpdateDefault.(func() time.Time)
+	// fundingpaymentDescExchange is the schema descriptor for exchange field.
+	fundingpaymentDescExchange := fundingpaymentFields[0].Descriptor()
+	// fundingpayment.ExchangeValidator is a validator for the "exchange" field. It is called by the builders before save.
+	fundingpayment.ExchangeValidator = fundingpaymentDescExchange.Validators[0].(func(string) error)
+	// fundingpaymentDescSymbol is the schema descriptor for symbol field.
+	fundingpaymentDescSymbol := fundingpaymentFields[2].Descriptor()
+	// fundingpayment.SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
+	fundingpayment.SymbolValidator = fundingpaymentDescSymbol.Validators[0].(func(string) error)
+	// fundingpaymentDescStrategyId is the schema descriptor for strategyId field.
+	fundingpaymentDescStrategyId := fundingpaymentFields[4].Descriptor()
+	// fundingpayment.StrategyIdValidator is a validator for the "strategyId" field. It is called by the builders before save.
+	fundingpayment.StrategyIdValidator = fundingpaymentDescStrategyId.Validators[0].(func(string) error)
 	gridMixin := schema.Grid{}.Mixin()
 	gridMixinFields0 := gridMixin[0].Fields()
 	_ = gridMixinFields0
@@ -78,6 +176,37 @@ func init() {
 	order.DefaultUpdateTime = orderDescUpdateTime.Default.(func() time.Time)
 	// order.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
 	order.UpdateDefaultUpdateTime = orderDescUpdateTime.UpdateDefault.(func() time.Time)
+	orderrepairMixin := schema.OrderRepair{}.Mixin()
+	orderrepairMixinFields0 := orderrepairMixin[0].Fields()
+	_ = orderrepairMixinFields0
+	orderrepairFields := schema.OrderRepair{}.Fields()
+	_ = orderrepairFields
+	// orderrepairDescCreateTime is the schema descriptor for create_time field.
+	orderrepairDescCreateTime := orderrepairMixinFields0[0].Descriptor()
+	// orderrepair.DefaultCreateTime holds the default value on creation for the create_time field.
+	orderrepair.DefaultCreateTime = orderrepairDescCreateTime.Default.(func() time.Time)
+	// orderrepairDescUpdateTime is the schema descriptor for update_time field.
+	orderrepairDescUpdateTime := orderrepairMixinFields0[1].Descriptor()
+	// orderrepair.DefaultUpdateTime holds the default value on creation for the update_time field.
+	orderrepair.DefaultUpdateTime = orderrepairDescUpdateTime.Default.(func() time.Time)
+	// orderrepair.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	orderrepair.UpdateDefaultUpdateTime = orderrepairDescUpdateTime.UpdateDefault.(func() time.Time)
+	// orderrepairDescStrategyId is the schema descriptor for strategyId field.
+	orderrepairDescStrategyId := orderrepairFields[0].Descriptor()
+	// orderrepair.StrategyIdValidator is a validator for the "strategyId" field. It is called by the builders before save.
+	orderrepair.StrategyIdValidator = orderrepairDescStrategyId.Validators[0].(func(string) error)
+	// orderrepairDescExchange is the schema descriptor for exchange field.
+	orderrepairDescExchange := orderrepairFields[1].Descriptor()
+	// orderrepair.ExchangeValidator is a validator for the "exchange" field. It is called by the builders before save.
+	orderrepair.ExchangeValidator = orderrepairDescExchange.Validators[0].(func(string) error)
+	// orderrepairDescSymbol is the schema descriptor for symbol field.
+	orderrepairDescSymbol := orderrepairFields[2].Descriptor()
+	// orderrepair.SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
+	orderrepair.SymbolValidator = orderrepairDescSymbol.Validators[0].(func(string) error)
+	// orderrepairDescAttempt is the schema descriptor for attempt field.
+	orderrepairDescAttempt := orderrepairFields[11].Descriptor()
+	// orderrepair.AttemptValidator is a validator for the "attempt" field. It is called by the builders before save.
+	orderrepair.AttemptValidator = orderrepairDescAttempt.Validators[0].(func(int) error)
 	strategyMixin := schema.Strategy{}.Mixin()
 	strategyMixinFields0 := strategyMixin[0].Fields()
 	_ = strategyMixinFields0
@@ -117,8 +246,12 @@ func init() {
 	strategy.DefaultLeverage = strategyDescLeverage.Default.(int)
 	// strategy.LeverageValidator is a validator for the "leverage" field. It is called by the builders before save.
 	strategy.LeverageValidator = strategyDescLeverage.Validators[0].(func(int) error)
+	// strategyDescSizingWeights is the schema descriptor for sizingWeights field.
+	strategyDescSizingWeights := strategyFields[15].Descriptor()
+	// strategy.SizingWeightsValidator is a validator for the "sizingWeights" field. It is called by the builders before save.
+	strategy.SizingWeightsValidator = strategyDescSizingWeights.Validators[0].(func(string) error)
 	// strategyDescSlippageBps is the schema descriptor for slippageBps field.
-	strategyDescSlippageBps := strategyFields[13].Descriptor()
+	strategyDescSlippageBps := strategyFields[16].Descriptor()
 	// strategy.SlippageBpsValidator is a validator for the "slippageBps" field. It is called by the builders before save.
 	strategy.SlippageBpsValidator = func() func(int) error {
 		validators := strategyDescSlippageBps.Validators
@@ -135,10 +268,66 @@ func init() {
 			return nil
 		}
 	}()
+	// strategyDescTrailingLevels is the schema descriptor for trailingLevels field.
+	strategyDescTrailingLevels := strategyFields[29].Descriptor()
+	// strategy.TrailingLevelsValidator is a validator for the "trailingLevels" field. It is called by the builders before save.
+	strategy.TrailingLevelsValidator = strategyDescTrailingLevels.Validators[0].(func(int) error)
+	// strategyDescAtrPeriod is the schema descriptor for atrPeriod field.
+	strategyDescAtrPeriod := strategyFields[32].Descriptor()
+	// strategy.AtrPeriodValidator is a validator for the "atrPeriod" field. It is called by the builders before save.
+	strategy.AtrPeriodValidator = strategyDescAtrPeriod.Validators[0].(func(int) error)
+	// strategyDescAdaptiveIntervalHours is the schema descriptor for adaptiveIntervalHours field.
+	strategyDescAdaptiveIntervalHours := strategyFields[35].Descriptor()
+	// strategy.AdaptiveIntervalHoursValidator is a validator for the "adaptiveIntervalHours" field. It is called by the builders before save.
+	strategy.AdaptiveIntervalHoursValidator = strategyDescAdaptiveIntervalHours.Validators[0].(func(int) error)
+	// strategyDescCancelRepairMaxAttempts is the schema descriptor for cancelRepairMaxAttempts field.
+	strategyDescCancelRepairMaxAttempts := strategyFields[39].Descriptor()
+	// strategy.CancelRepairMaxAttemptsValidator is a validator for the "cancelRepairMaxAttempts" field. It is called by the builders before save.
+	strategy.CancelRepairMaxAttemptsValidator = strategyDescCancelRepairMaxAttempts.Validators[0].(func(int) error)
+	// strategyDescExchangeApiKey is the schema descriptor for exchangeApiKey field.
+	strategyDescExchangeApiKey := strategyFields[47].Descriptor()
+	strategy.ValueScanner.ExchangeApiKey = strategyDescExchangeApiKey.ValueScanner.(field.TypeValueScanner[string])
+	// strategyDescExchangeSecretKey is the schema descriptor for exchangeSecretKey field.
+	strategyDescExchangeSecretKey := strategyFields[48].Descriptor()
+	strategy.ValueScanner.ExchangeSecretKey = strategyDescExchangeSecretKey.ValueScanner.(field.TypeValueScanner[string])
+	// strategyDescExchangePassphrase is the schema descriptor for exchangePassphrase field.
+	strategyDescExchangePassphrase := strategyFields[49].Descriptor()
+	strategy.ValueScanner.ExchangePassphrase = strategyDescExchangePassphrase.ValueScanner.(field.TypeValueScanner[string])
 	// strategyDescExchangeTestnet is the schema descriptor for exchangeTestnet field.
-	strategyDescExchangeTestnet := strategyFields[25].Descriptor()
+	strategyDescExchangeTestnet := strategyFields[50].Descriptor()
 	// strategy.DefaultExchangeTestnet holds the default value on creation for the exchangeTestnet field.
 	strategy.DefaultExchangeTestnet = strategyDescExchangeTestnet.Default.(bool)
+	strategyrunMixin := schema.StrategyRun{}.Mixin()
+	strategyrunMixinFields0 := strategyrunMixin[0].Fields()
+	_ = strategyrunMixinFields0
+	strategyrunFields := schema.StrategyRun{}.Fields()
+	_ = strategyrunFields
+	// strategyrunDescCreateTime is the schema descriptor for create_time field.
+	strategyrunDescCreateTime := strategyrunMixinFields0[0].Descriptor()
+	// strategyrun.DefaultCreateTime holds the default value on creation for the create_time field.
+	strategyrun.DefaultCreateTime = strategyrunDescCreateTime.Default.(func() time.Time)
+	// strategyrunDescUpdateTime is the schema descriptor for update_time field.
+	strategyrunDescUpdateTime := strategyrunMixinFields0[1].Descriptor()
+	// strategyrun.DefaultUpdateTime holds the default value on creation for the update_time field.
+	strategyrun.DefaultUpdateTime = strategyrunDescUpdateTime.Default.(func() time.Time)
+	// strategyrun.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	strategyrun.UpdateDefaultUpdateTime = strategyrunDescUpdateTime.UpdateDefault.(func() time.Time)
+	// strategyrunDescStrategyId is the schema descriptor for strategyId field.
+	strategyrunDescStrategyId := strategyrunFields[0].Descriptor()
+	// strategyrun.StrategyIdValidator is a validator for the "strategyId" field. It is called by the builders before save.
+	strategyrun.StrategyIdValidator = strategyrunDescStrategyId.Validators[0].(func(string) error)
+	// strategyrunDescExchange is the schema descriptor for exchange field.
+	strategyrunDescExchange := strategyrunFields[2].Descriptor()
+	// strategyrun.ExchangeValidator is a validator for the "exchange" field. It is called by the builders before save.
+	strategyrun.ExchangeValidator = strategyrunDescExchange.Validators[0].(func(string) error)
+	// strategyrunDescSymbol is the schema descriptor for symbol field.
+	strategyrunDescSymbol := strategyrunFields[3].Descriptor()
+	// strategyrun.SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
+	strategyrun.SymbolValidator = strategyrunDescSymbol.Validators[0].(func(string) error)
+	// strategyrunDescMatchedTrades is the schema descriptor for matchedTrades field.
+	strategyrunDescMatchedTrades := strategyrunFields[15].Descriptor()
+	// strategyrun.DefaultMatchedTrades holds the default value on creation for the matchedTrades field.
+	strategyrun.DefaultMatchedTrades = strategyrunDescMatchedTrades.Default.(int)
 	syncprogressMixin := schema.SyncProgress{}.Mixin()
 	syncprogressMixinFields0 := syncprogressMixin[0].Fields()
 	_ = syncprogressMixinFields0
@@ -0,0 +1,22 @@
+package schema
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"entgo.io/ent/schema/field"
+	"github.com/fachebot/omni-grid-bot/internal/util/secret"
+)
+
+// credentialValueScanner 交易所凭证字段的加解密，写入数据库前使用主密钥加密，读取时解密
+var credentialValueScanner = field.ValueScannerFunc[string, *sql.NullString]{
+	V: func(s string) (driver.Value, error) {
+		return secret.EncryptValue(s)
+	},
+	S: func(ns *sql.NullString) (string, error) {
+		if !ns.Valid {
+			return "", nil
+		}
+		return secret.DecryptValue(ns.String)
+	},
+}
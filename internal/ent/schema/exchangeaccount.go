@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// ExchangeAccount holds the schema definition for the ExchangeAccount entity.
+type ExchangeAccount struct {
+	ent.Schema
+}
+
+func (ExchangeAccount) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the ExchangeAccount.
+func (ExchangeAccount) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("owner"),
+		field.String("exchange").MaxLen(50),
+		field.String("label").MaxLen(64),
+		field.String("account"),
+		field.String("secretKey").ValueScanner(credentialValueScanner),
+		field.String("passphrase").ValueScanner(credentialValueScanner),
+		field.Bool("testnet").Default(false),
+	}
+}
+
+// Edges of the ExchangeAccount.
+func (ExchangeAccount) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("strategies", Strategy.Type),
+	}
+}
+
+// Indexes of the ExchangeAccount.
+func (ExchangeAccount) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner"),
+	}
+}
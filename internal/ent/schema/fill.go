@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/shopspring/decimal"
+)
+
+// Fill holds the schema definition for the Fill entity.
+type Fill struct {
+	ent.Schema
+}
+
+func (Fill) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the Fill.
+func (Fill) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("exchange").MaxLen(50),
+		field.String("account"),
+		field.String("symbol").MaxLen(32),
+		field.String("fillId"),
+		field.String("orderId"),
+		field.String("clientOrderId").Nillable().Optional(),
+		field.Enum("side").Values("buy", "sell"),
+		field.String("price").GoType(decimal.Decimal{}),
+		field.String("baseAmount").GoType(decimal.Decimal{}),
+		field.String("quoteAmount").GoType(decimal.Decimal{}),
+		field.String("fee").GoType(decimal.Decimal{}),
+		field.String("feeAsset").MaxLen(32),
+		field.Bool("isMaker").Default(false),
+		field.Int64("timestamp"),
+	}
+}
+
+// Edges of the Fill.
+func (Fill) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Fill.
+func (Fill) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("exchange", "account", "fillId").Unique(),
+		index.Fields("exchange", "account", "orderId"),
+		index.Fields("exchange", "account", "timestamp"),
+	}
+}
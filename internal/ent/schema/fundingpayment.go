@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/shopspring/decimal"
+)
+
+// FundingPayment holds the schema definition for the FundingPayment entity.
+type FundingPayment struct {
+	ent.Schema
+}
+
+func (FundingPayment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the FundingPayment.
+func (FundingPayment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("exchange").MaxLen(50),
+		field.String("account"),
+		field.String("symbol").MaxLen(32),
+		field.String("paymentId"),
+		field.String("strategyId").MaxLen(50).Nillable().Optional(),
+		field.String("amount").GoType(decimal.Decimal{}),
+		field.String("rate").GoType(decimal.Decimal{}),
+		field.String("positionSize").GoType(decimal.Decimal{}),
+		field.Int64("timestamp"),
+		field.Int("runId").Nillable().Optional(),
+	}
+}
+
+// Edges of the FundingPayment.
+func (FundingPayment) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the FundingPayment.
+func (FundingPayment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("exchange", "account", "paymentId", "strategyId").Unique(),
+		index.Fields("exchange", "account", "symbol", "timestamp"),
+		index.Fields("strategyId"),
+	}
+}
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
@@ -33,6 +34,7 @@ func (Grid) Fields() []ent.Field {
 		field.Int64("buyClientOrderTime").Nillable().Optional(),
 		field.String("sellClientOrderId").Nillable().Optional(),
 		field.Int64("sellClientOrderTime").Nillable().Optional(),
+		field.Int("runId").Nillable().Optional(),
 	}
 }
 
@@ -46,7 +48,9 @@ func (Grid) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("account"),
 		index.Fields("strategyId"),
-		index.Fields("strategyId", "level").Unique(),
+		index.Fields("strategyId", "level").Unique().
+			Annotations(entsql.IndexWhere("run_id IS NULL")).
+			StorageKey("grid_active_strategy_level"),
 		index.Fields("exchange", "symbol", "account"),
 	}
 }
@@ -29,11 +29,18 @@ func (MatchedTrade) Fields() []ent.Field {
 		field.String("buyBaseAmount").GoType(decimal.Decimal{}).Nillable().Optional(),
 		field.String("buyQuoteAmount").GoType(decimal.Decimal{}).Nillable().Optional(),
 		field.Int64("buyOrderTimestamp").Nillable().Optional(),
+		field.String("buyPartialBaseAmount").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.String("buyPartialQuoteAmount").GoType(decimal.Decimal{}).Nillable().Optional(),
 		field.String("sellClientOrderId").Nillable().Optional(),
 		field.String("sellBaseAmount").GoType(decimal.Decimal{}).Nillable().Optional(),
 		field.String("sellQuoteAmount").GoType(decimal.Decimal{}).Nillable().Optional(),
 		field.Int64("sellOrderTimestamp").Nillable().Optional(),
+		field.String("sellPartialBaseAmount").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.String("sellPartialQuoteAmount").GoType(decimal.Decimal{}).Nillable().Optional(),
 		field.Float("profit").Nillable().Optional(),
+		field.Float("fee").Nillable().Optional(),
+		field.Float("netProfit").Nillable().Optional(),
+		field.Int("runId").Nillable().Optional(),
 	}
 }
 
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/shopspring/decimal"
+)
+
+// OrderRepair holds the schema definition for the OrderRepair entity.
+type OrderRepair struct {
+	ent.Schema
+}
+
+func (OrderRepair) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the OrderRepair.
+func (OrderRepair) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("strategyId").MaxLen(50),
+		field.String("exchange").MaxLen(50),
+		field.String("symbol").MaxLen(32),
+		field.String("account"),
+		field.Int("level"),
+		field.Enum("side").Values("buy", "sell"),
+		field.String("price").GoType(decimal.Decimal{}),
+		field.String("quantity").GoType(decimal.Decimal{}),
+		field.String("canceledClientOrderId"),
+		field.String("newClientOrderId").Nillable().Optional(),
+		field.Enum("action").Values("repair", "skip", "stop"),
+		field.Int("attempt").Min(0),
+		field.String("reason").Nillable().Optional(),
+	}
+}
+
+// Edges of the OrderRepair.
+func (OrderRepair) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the OrderRepair.
+func (OrderRepair) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("strategyId"),
+		index.Fields("strategyId", "canceledClientOrderId"),
+		index.Fields("strategyId", "newClientOrderId"),
+	}
+}
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
@@ -27,34 +28,62 @@ func (Strategy) Fields() []ent.Field {
 		field.String("exchange").MaxLen(50),
 		field.String("symbol").MaxLen(32),
 		field.String("account"),
-		field.Enum("mode").Values("long", "short"),
+		field.Enum("mode").Values("long", "short", "neutral"),
 		field.Enum("marginMode").Values("cross", "isolated"),
-		field.Enum("quantityMode").Values("arithmetic", "geometric"),
+		field.Enum("quantityMode").Values("arithmetic", "geometric", "adaptive"),
 		field.String("priceUpper").GoType(decimal.Decimal{}),
 		field.String("priceLower").GoType(decimal.Decimal{}),
 		field.Int("gridNum").Min(1).Default(10),
 		field.Int("leverage").Min(1).Default(1),
 		field.String("initialOrderSize").GoType(decimal.Decimal{}),
+		field.Enum("sizingMode").Values("fixed", "pyramid", "geometric", "equal_notional", "custom").Default("fixed"),
+		field.String("sizingFactor").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.String("sizingWeights").MaxLen(512).Nillable().Optional(),
 		field.Int("slippageBps").Min(0).Max(10000).Nillable().Optional(),
 		field.String("entryPrice").GoType(decimal.Decimal{}).Nillable().Optional(),
 		field.String("triggerStopLossPrice").GoType(decimal.Decimal{}).Nillable().Optional(),
 		field.String("triggerTakeProfitPrice").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.String("maxLossAmount").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.String("maxLossPercent").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.String("profitTargetAmount").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.String("trailingStopLossAmount").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.String("trailingStopLossPercent").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.String("trailingTakeProfitActivationPrice").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.String("trailingTakeProfitAmount").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.String("trailingTakeProfitPercent").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.String("trailingExtremePrice").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.Int("trailingLevels").Min(0).Nillable().Optional(),
+		field.String("trailingPriceUpperLimit").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.String("trailingPriceLowerLimit").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.Int("atrPeriod").Min(1).Nillable().Optional(),
+		field.String("atrMultiplier").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.String("adaptiveThreshold").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.Int("adaptiveIntervalHours").Min(0).Nillable().Optional(),
+		field.String("adaptiveAtr").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.Time("adaptiveUpdatedAt").Nillable().Optional(),
+		field.Enum("cancelRepairPolicy").Values("repair", "skip", "stop").Default("repair"),
+		field.Int("cancelRepairMaxAttempts").Min(0).Nillable().Optional(),
+		field.Enum("reconcilePolicy").Values("alert", "fix").Default("alert"),
+		field.String("partialFillThreshold").GoType(decimal.Decimal{}).Nillable().Optional(),
 		field.Bool("enablePushNotification"),
 		field.Bool("enablePushMatchedNotification").Nillable().Optional(),
 		field.Time("lastLowerThresholdAlertTime").Nillable().Optional(),
 		field.Time("lastUpperThresholdAlertTime").Nillable().Optional(),
 		field.Enum("status").Values("active", "inactive"),
-		field.String("exchangeApiKey"),
-		field.String("exchangeSecretKey"),
-		field.String("exchangePassphrase"),
+		field.String("exchangeApiKey").ValueScanner(credentialValueScanner),
+		field.String("exchangeSecretKey").ValueScanner(credentialValueScanner),
+		field.String("exchangePassphrase").ValueScanner(credentialValueScanner),
 		field.Bool("exchangeTestnet").Default(false),
+		field.Int("accountId").Nillable().Optional(),
 		field.Time("startTime").Nillable().Optional(),
 	}
 }
 
 // Edges of the Strategy.
 func (Strategy) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.From("exchangeAccount", ExchangeAccount.Type).Ref("strategies").Field("accountId").Unique(),
+	}
 }
 
 // Indexes of the Strategy.
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/shopspring/decimal"
+)
+
+// StrategyRun holds the schema definition for the StrategyRun entity.
+type StrategyRun struct {
+	ent.Schema
+}
+
+func (StrategyRun) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the StrategyRun.
+func (StrategyRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("strategyId").MaxLen(50),
+		field.Int64("owner"),
+		field.String("exchange").MaxLen(50),
+		field.String("symbol").MaxLen(32),
+		field.String("account"),
+		field.Enum("mode").Values("long", "short", "neutral"),
+		field.String("priceUpper").GoType(decimal.Decimal{}),
+		field.String("priceLower").GoType(decimal.Decimal{}),
+		field.Int("gridNum"),
+		field.Int("leverage"),
+		field.String("initialOrderSize").GoType(decimal.Decimal{}),
+		field.Enum("status").Values("running", "stopped").Default("running"),
+		field.Time("startTime"),
+		field.Time("endTime").Nillable().Optional(),
+		field.Enum("stopReason").Values("manual", "close_position", "stop_loss", "take_profit", "order_canceled", "liquidation_risk", "max_loss", "profit_target", "trailing_stop_loss", "trailing_take_profit").Nillable().Optional(),
+		field.Int("matchedTrades").Default(0),
+		field.String("realizedProfit").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.String("fee").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.String("funding").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.String("unrealizedProfit").GoType(decimal.Decimal{}).Nillable().Optional(),
+		field.String("totalProfit").GoType(decimal.Decimal{}).Nillable().Optional(),
+	}
+}
+
+// Edges of the StrategyRun.
+func (StrategyRun) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the StrategyRun.
+func (StrategyRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("strategyId", "status"),
+		index.Fields("owner"),
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/fachebot/omni-grid-bot/internal/ent/exchangeaccount"
 	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
 	"github.com/shopspring/decimal"
 )
@@ -48,6 +49,12 @@ type Strategy struct {
 	Leverage int `json:"leverage,omitempty"`
 	// InitialOrderSize holds the value of the "initialOrderSize" field.
 	InitialOrderSize decimal.Decimal `json:"initialOrderSize,omitempty"`
+	// SizingMode holds the value of the "sizingMode" field.
+	SizingMode strategy.SizingMode `json:"sizingMode,omitempty"`
+	// SizingFactor holds the value of the "sizingFactor" field.
+	SizingFactor *decimal.Decimal `json:"sizingFactor,omitempty"`
+	// SizingWeights holds the value of the "sizingWeights" field.
+	SizingWeights *string `json:"sizingWeights,omitempty"`
 	// SlippageBps holds the value of the "slippageBps" field.
 	SlippageBps *int `json:"slippageBps,omitempty"`
 	// EntryPrice holds the value of the "entryPrice" field.
@@ -56,6 +63,50 @@ type Strategy struct {
 	TriggerStopLossPrice *decimal.Decimal `json:"triggerStopLossPrice,omitempty"`
 	// TriggerTakeProfitPrice holds the value of the "triggerTakeProfitPrice" field.
 	TriggerTakeProfitPrice *decimal.Decimal `json:"triggerTakeProfitPrice,omitempty"`
+	// MaxLossAmount holds the value of the "maxLossAmount" field.
+	MaxLossAmount *decimal.Decimal `json:"maxLossAmount,omitempty"`
+	// MaxLossPercent holds the value of the "maxLossPercent" field.
+	MaxLossPercent *decimal.Decimal `json:"maxLossPercent,omitempty"`
+	// ProfitTargetAmount holds the value of the "profitTargetAmount" field.
+	ProfitTargetAmount *decimal.Decimal `json:"profitTargetAmount,omitempty"`
+	// TrailingStopLossAmount holds the value of the "trailingStopLossAmount" field.
+	TrailingStopLossAmount *decimal.Decimal `json:"trailingStopLossAmount,omitempty"`
+	// TrailingStopLossPercent holds the value of the "trailingStopLossPercent" field.
+	TrailingStopLossPercent *decimal.Decimal `json:"trailingStopLossPercent,omitempty"`
+	// TrailingTakeProfitActivationPrice holds the value of the "trailingTakeProfitActivationPrice" field.
+	TrailingTakeProfitActivationPrice *decimal.Decimal `json:"trailingTakeProfitActivationPrice,omitempty"`
+	// TrailingTakeProfitAmount holds the value of the "trailingTakeProfitAmount" field.
+	TrailingTakeProfitAmount *decimal.Decimal `json:"trailingTakeProfitAmount,omitempty"`
+	// TrailingTakeProfitPercent holds the value of the "trailingTakeProfitPercent" field.
+	TrailingTakeProfitPercent *decimal.Decimal `json:"trailingTakeProfitPercent,omitempty"`
+	// TrailingExtremePrice holds the value of the "trailingExtremePrice" field.
+	TrailingExtremePrice *decimal.Decimal `json:"trailingExtremePrice,omitempty"`
+	// TrailingLevels holds the value of the "trailingLevels" field.
+	TrailingLevels *int `json:"trailingLevels,omitempty"`
+	// TrailingPriceUpperLimit holds the value of the "trailingPriceUpperLimit" field.
+	TrailingPriceUpperLimit *decimal.Decimal `json:"trailingPriceUpperLimit,omitempty"`
+	// TrailingPriceLowerLimit holds the value of the "trailingPriceLowerLimit" field.
+	TrailingPriceLowerLimit *decimal.Decimal `json:"trailingPriceLowerLimit,omitempty"`
+	// AtrPeriod holds the value of the "atrPeriod" field.
+	AtrPeriod *int `json:"atrPeriod,omitempty"`
+	// AtrMultiplier holds the value of the "atrMultiplier" field.
+	AtrMultiplier *decimal.Decimal `json:"atrMultiplier,omitempty"`
+	// AdaptiveThreshold holds the value of the "adaptiveThreshold" field.
+	AdaptiveThreshold *decimal.Decimal `json:"adaptiveThreshold,omitempty"`
+	// AdaptiveIntervalHours holds the value of the "adaptiveIntervalHours" field.
+	AdaptiveIntervalHours *int `json:"adaptiveIntervalHours,omitempty"`
+	// AdaptiveAtr holds the value of the "adaptiveAtr" field.
+	AdaptiveAtr *decimal.Decimal `json:"adaptiveAtr,omitempty"`
+	// AdaptiveUpdatedAt holds the value of the "adaptiveUpdatedAt" field.
+	AdaptiveUpdatedAt *time.Time `json:"adaptiveUpdatedAt,omitempty"`
+	// CancelRepairPolicy holds the value of the "cancelRepairPolicy" field.
+	CancelRepairPolicy strategy.CancelRepairPolicy `json:"cancelRepairPolicy,omitempty"`
+	// CancelRepairMaxAttempts holds the value of the "cancelRepairMaxAttempts" field.
+	CancelRepairMaxAttempts *int `json:"cancelRepairMaxAttempts,omitempty"`
+	// ReconcilePolicy holds the value of the "reconcilePolicy" field.
+	ReconcilePolicy strategy.ReconcilePolicy `json:"reconcilePolicy,omitempty"`
+	// PartialFillThreshold holds the value of the "partialFillThreshold" field.
+	PartialFillThreshold *decimal.Decimal `json:"partialFillThreshold,omitempty"`
 	// EnablePushNotification holds the value of the "enablePushNotification" field.
 	EnablePushNotification bool `json:"enablePushNotification,omitempty"`
 	// EnablePushMatchedNotification holds the value of the "enablePushMatchedNotification" field.
@@ -74,28 +125,59 @@ type Strategy struct {
 	ExchangePassphrase string `json:"exchangePassphrase,omitempty"`
 	// ExchangeTestnet holds the value of the "exchangeTestnet" field.
 	ExchangeTestnet bool `json:"exchangeTestnet,omitempty"`
+	// AccountId holds the value of the "accountId" field.
+	AccountId *int `json:"accountId,omitempty"`
 	// StartTime holds the value of the "startTime" field.
-	StartTime    *time.Time `json:"startTime,omitempty"`
+	StartTime *time.Time `json:"startTime,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the StrategyQuery when eager-loading is set.
+	Edges        StrategyEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// StrategyEdges holds the relations/edges for other nodes in the graph.
+type StrategyEdges struct {
+	// ExchangeAccount holds the value of the exchangeAccount edge.
+	ExchangeAccount *ExchangeAccount `json:"exchangeAccount,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ExchangeAccountOrErr returns the ExchangeAccount value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StrategyEdges) ExchangeAccountOrErr() (*ExchangeAccount, error) {
+	if e.ExchangeAccount != nil {
+		return e.ExchangeAccount, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: exchangeaccount.Label}
+	}
+	return nil, &NotLoadedError{edge: "exchangeAccount"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Strategy) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case strategy.FieldEntryPrice, strategy.FieldTriggerStopLossPrice, strategy.FieldTriggerTakeProfitPrice:
+		case strategy.FieldSizingFactor, strategy.FieldEntryPrice, strategy.FieldTriggerStopLossPrice, strategy.FieldTriggerTakeProfitPrice, strategy.FieldMaxLossAmount, strategy.FieldMaxLossPercent, strategy.FieldProfitTargetAmount, strategy.FieldTrailingStopLossAmount, strategy.FieldTrailingStopLossPercent, strategy.FieldTrailingTakeProfitActivationPrice, strategy.FieldTrailingTakeProfitAmount, strategy.FieldTrailingTakeProfitPercent, strategy.FieldTrailingExtremePrice, strategy.FieldTrailingPriceUpperLimit, strategy.FieldTrailingPriceLowerLimit, strategy.FieldAtrMultiplier, strategy.FieldAdaptiveThreshold, strategy.FieldAdaptiveAtr, strategy.FieldPartialFillThreshold:
 			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
 		case strategy.FieldPriceUpper, strategy.FieldPriceLower, strategy.FieldInitialOrderSize:
 			values[i] = new(decimal.Decimal)
 		case strategy.FieldEnablePushNotification, strategy.FieldEnablePushMatchedNotification, strategy.FieldExchangeTestnet:
 			values[i] = new(sql.NullBool)
-		case strategy.FieldID, strategy.FieldOwner, strategy.FieldGridNum, strategy.FieldLeverage, strategy.FieldSlippageBps:
+		case strategy.FieldID, strategy.FieldOwner, strategy.FieldGridNum, strategy.FieldLeverage, strategy.FieldSlippageBps, strategy.FieldTrailingLevels, strategy.FieldAtrPeriod, strategy.FieldAdaptiveIntervalHours, strategy.FieldCancelRepairMaxAttempts, strategy.FieldAccountId:
 			values[i] = new(sql.NullInt64)
-		case strategy.FieldGUID, strategy.FieldExchange, strategy.FieldSymbol, strategy.FieldAccount, strategy.FieldMode, strategy.FieldMarginMode, strategy.FieldQuantityMode, strategy.FieldStatus, strategy.FieldExchangeApiKey, strategy.FieldExchangeSecretKey, strategy.FieldExchangePassphrase:
+		case strategy.FieldGUID, strategy.FieldExchange, strategy.FieldSymbol, strategy.FieldAccount, strategy.FieldMode, strategy.FieldMarginMode, strategy.FieldQuantityMode, strategy.FieldSizingMode, strategy.FieldSizingWeights, strategy.FieldCancelRepairPolicy, strategy.FieldReconcilePolicy, strategy.FieldStatus:
 			values[i] = new(sql.NullString)
-		case strategy.FieldCreateTime, strategy.FieldUpdateTime, strategy.FieldLastLowerThresholdAlertTime, strategy.FieldLastUpperThresholdAlertTime, strategy.FieldStartTime:
+		case strategy.FieldCreateTime, strategy.FieldUpdateTime, strategy.FieldAdaptiveUpdatedAt, strategy.FieldLastLowerThresholdAlertTime, strategy.FieldLastUpperThresholdAlertTime, strategy.FieldStartTime:
 			values[i] = new(sql.NullTime)
+		case strategy.FieldExchangeApiKey:
+			values[i] = strategy.ValueScanner.ExchangeApiKey.ScanValue()
+		case strategy.FieldExchangeSecretKey:
+			values[i] = strategy.ValueScanner.ExchangeSecretKey.ScanValue()
+		case strategy.FieldExchangePassphrase:
+			values[i] = strategy.ValueScanner.ExchangePassphrase.ScanValue()
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -207,6 +289,26 @@ func (_m *Strategy) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.InitialOrderSize = *value
 			}
+		case strategy.FieldSizingMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sizingMode", values[i])
+			} else if value.Valid {
+				_m.SizingMode = strategy.SizingMode(value.String)
+			}
+		case strategy.FieldSizingFactor:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field sizingFactor", values[i])
+			} else if value.Valid {
+				_m.SizingFactor = new(decimal.Decimal)
+				*_m.SizingFactor = *value.S.(*decimal.Decimal)
+			}
+		case strategy.FieldSizingWeights:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sizingWeights", values[i])
+			} else if value.Valid {
+				_m.SizingWeights = new(string)
+				*_m.SizingWeights = value.String
+			}
 		case strategy.FieldSlippageBps:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field slippageBps", values[i])
@@ -235,6 +337,158 @@ func (_m *Strategy) assignValues(columns []string, values []any) error {
 				_m.TriggerTakeProfitPrice = new(decimal.Decimal)
 				*_m.TriggerTakeProfitPrice = *value.S.(*decimal.Decimal)
 			}
+		case strategy.FieldMaxLossAmount:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field maxLossAmount", values[i])
+			} else if value.Valid {
+				_m.MaxLossAmount = new(decimal.Decimal)
+				*_m.MaxLossAmount = *value.S.(*decimal.Decimal)
+			}
+		case strategy.FieldMaxLossPercent:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field maxLossPercent", values[i])
+			} else if value.Valid {
+				_m.MaxLossPercent = new(decimal.Decimal)
+				*_m.MaxLossPercent = *value.S.(*decimal.Decimal)
+			}
+		case strategy.FieldProfitTargetAmount:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field profitTargetAmount", values[i])
+			} else if value.Valid {
+				_m.ProfitTargetAmount = new(decimal.Decimal)
+				*_m.ProfitTargetAmount = *value.S.(*decimal.Decimal)
+			}
+		case strategy.FieldTrailingStopLossAmount:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field trailingStopLossAmount", values[i])
+			} else if value.Valid {
+				_m.TrailingStopLossAmount = new(decimal.Decimal)
+				*_m.TrailingStopLossAmount = *value.S.(*decimal.Decimal)
+			}
+		case strategy.FieldTrailingStopLossPercent:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field trailingStopLossPercent", values[i])
+			} else if value.Valid {
+				_m.TrailingStopLossPercent = new(decimal.Decimal)
+				*_m.TrailingStopLossPercent = *value.S.(*decimal.Decimal)
+			}
+		case strategy.FieldTrailingTakeProfitActivationPrice:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field trailingTakeProfitActivationPrice", values[i])
+			} else if value.Valid {
+				_m.TrailingTakeProfitActivationPrice = new(decimal.Decimal)
+				*_m.TrailingTakeProfitActivationPrice = *value.S.(*decimal.Decimal)
+			}
+		case strategy.FieldTrailingTakeProfitAmount:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field trailingTakeProfitAmount", values[i])
+			} else if value.Valid {
+				_m.TrailingTakeProfitAmount = new(decimal.Decimal)
+				*_m.TrailingTakeProfitAmount = *value.S.(*decimal.Decimal)
+			}
+		case strategy.FieldTrailingTakeProfitPercent:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field trailingTakeProfitPercent", values[i])
+			} else if value.Valid {
+				_m.TrailingTakeProfitPercent = new(decimal.Decimal)
+				*_m.TrailingTakeProfitPercent = *value.S.(*decimal.Decimal)
+			}
+		case strategy.FieldTrailingExtremePrice:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field trailingExtremePrice", values[i])
+			} else if value.Valid {
+				_m.TrailingExtremePrice = new(decimal.Decimal)
+				*_m.TrailingExtremePrice = *value.S.(*decimal.Decimal)
+			}
+		case strategy.FieldTrailingLevels:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field trailingLevels", values[i])
+			} else if value.Valid {
+				_m.TrailingLevels = new(int)
+				*_m.TrailingLevels = int(value.Int64)
+			}
+		case strategy.FieldTrailingPriceUpperLimit:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field trailingPriceUpperLimit", values[i])
+			} else if value.Valid {
+				_m.TrailingPriceUpperLimit = new(decimal.Decimal)
+				*_m.TrailingPriceUpperLimit = *value.S.(*decimal.Decimal)
+			}
+		case strategy.FieldTrailingPriceLowerLimit:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field trailingPriceLowerLimit", values[i])
+			} else if value.Valid {
+				_m.TrailingPriceLowerLimit = new(decimal.Decimal)
+				*_m.TrailingPriceLowerLimit = *value.S.(*decimal.Decimal)
+			}
+		case strategy.FieldAtrPeriod:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field atrPeriod", values[i])
+			} else if value.Valid {
+				_m.AtrPeriod = new(int)
+				*_m.AtrPeriod = int(value.Int64)
+			}
+		case strategy.FieldAtrMultiplier:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field atrMultiplier", values[i])
+			} else if value.Valid {
+				_m.AtrMultiplier = new(decimal.Decimal)
+				*_m.AtrMultiplier = *value.S.(*decimal.Decimal)
+			}
+		case strategy.FieldAdaptiveThreshold:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field adaptiveThreshold", values[i])
+			} else if value.Valid {
+				_m.AdaptiveThreshold = new(decimal.Decimal)
+				*_m.AdaptiveThreshold = *value.S.(*decimal.Decimal)
+			}
+		case strategy.FieldAdaptiveIntervalHours:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field adaptiveIntervalHours", values[i])
+			} else if value.Valid {
+				_m.AdaptiveIntervalHours = new(int)
+				*_m.AdaptiveIntervalHours = int(value.Int64)
+			}
+		case strategy.FieldAdaptiveAtr:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field adaptiveAtr", values[i])
+			} else if value.Valid {
+				_m.AdaptiveAtr = new(decimal.Decimal)
+				*_m.AdaptiveAtr = *value.S.(*decimal.Decimal)
+			}
+		case strategy.FieldAdaptiveUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field adaptiveUpdatedAt", values[i])
+			} else if value.Valid {
+				_m.AdaptiveUpdatedAt = new(time.Time)
+				*_m.AdaptiveUpdatedAt = value.Time
+			}
+		case strategy.FieldCancelRepairPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelRepairPolicy", values[i])
+			} else if value.Valid {
+				_m.CancelRepairPolicy = strategy.CancelRepairPolicy(value.String)
+			}
+		case strategy.FieldCancelRepairMaxAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelRepairMaxAttempts", values[i])
+			} else if value.Valid {
+				_m.CancelRepairMaxAttempts = new(int)
+				*_m.CancelRepairMaxAttempts = int(value.Int64)
+			}
+		case strategy.FieldReconcilePolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reconcilePolicy", values[i])
+			} else if value.Valid {
+				_m.ReconcilePolicy = strategy.ReconcilePolicy(value.String)
+			}
+		case strategy.FieldPartialFillThreshold:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field partialFillThreshold", values[i])
+			} else if value.Valid {
+				_m.PartialFillThreshold = new(decimal.Decimal)
+				*_m.PartialFillThreshold = *value.S.(*decimal.Decimal)
+			}
 		case strategy.FieldEnablePushNotification:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field enablePushNotification", values[i])
@@ -269,22 +523,22 @@ func (_m *Strategy) assignValues(columns []string, values []any) error {
 				_m.Status = strategy.Status(value.String)
 			}
 		case strategy.FieldExchangeApiKey:
-			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field exchangeApiKey", values[i])
-			} else if value.Valid {
-				_m.ExchangeApiKey = value.String
+			if value, err := strategy.ValueScanner.ExchangeApiKey.FromValue(values[i]); err != nil {
+				return err
+			} else {
+				_m.ExchangeApiKey = value
 			}
 		case strategy.FieldExchangeSecretKey:
-			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field exchangeSecretKey", values[i])
-			} else if value.Valid {
-				_m.ExchangeSecretKey = value.String
+			if value, err := strategy.ValueScanner.ExchangeSecretKey.FromValue(values[i]); err != nil {
+				return err
+			} else {
+				_m.ExchangeSecretKey = value
 			}
 		case strategy.FieldExchangePassphrase:
-			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field exchangePassphrase", values[i])
-			} else if value.Valid {
-				_m.ExchangePassphrase = value.String
+			if value, err := strategy.ValueScanner.ExchangePassphrase.FromValue(values[i]); err != nil {
+				return err
+			} else {
+				_m.ExchangePassphrase = value
 			}
 		case strategy.FieldExchangeTestnet:
 			if value, ok := values[i].(*sql.NullBool); !ok {
@@ -292,6 +546,13 @@ func (_m *Strategy) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ExchangeTestnet = value.Bool
 			}
+		case strategy.FieldAccountId:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field accountId", values[i])
+			} else if value.Valid {
+				_m.AccountId = new(int)
+				*_m.AccountId = int(value.Int64)
+			}
 		case strategy.FieldStartTime:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field startTime", values[i])
@@ -312,6 +573,11 @@ func (_m *Strategy) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryExchangeAccount queries the "exchangeAccount" edge of the Strategy entity.
+func (_m *Strategy) QueryExchangeAccount() *ExchangeAccountQuery {
+	return NewStrategyClient(_m.config).QueryExchangeAccount(_m)
+}
+
 // Update returns a builder for updating this Strategy.
 // Note that you need to call Strategy.Unwrap() before calling this method if this Strategy
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -380,6 +646,19 @@ func (_m *Strategy) String() string {
 	builder.WriteString("initialOrderSize=")
 	builder.WriteString(fmt.Sprintf("%v", _m.InitialOrderSize))
 	builder.WriteString(", ")
+	builder.WriteString("sizingMode=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SizingMode))
+	builder.WriteString(", ")
+	if v := _m.SizingFactor; v != nil {
+		builder.WriteString("sizingFactor=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.SizingWeights; v != nil {
+		builder.WriteString("sizingWeights=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	if v := _m.SlippageBps; v != nil {
 		builder.WriteString("slippageBps=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
@@ -400,6 +679,112 @@ func (_m *Strategy) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := _m.MaxLossAmount; v != nil {
+		builder.WriteString("maxLossAmount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MaxLossPercent; v != nil {
+		builder.WriteString("maxLossPercent=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ProfitTargetAmount; v != nil {
+		builder.WriteString("profitTargetAmount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TrailingStopLossAmount; v != nil {
+		builder.WriteString("trailingStopLossAmount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TrailingStopLossPercent; v != nil {
+		builder.WriteString("trailingStopLossPercent=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TrailingTakeProfitActivationPrice; v != nil {
+		builder.WriteString("trailingTakeProfitActivationPrice=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TrailingTakeProfitAmount; v != nil {
+		builder.WriteString("trailingTakeProfitAmount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TrailingTakeProfitPercent; v != nil {
+		builder.WriteString("trailingTakeProfitPercent=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TrailingExtremePrice; v != nil {
+		builder.WriteString("trailingExtremePrice=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TrailingLevels; v != nil {
+		builder.WriteString("trailingLevels=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TrailingPriceUpperLimit; v != nil {
+		builder.WriteString("trailingPriceUpperLimit=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TrailingPriceLowerLimit; v != nil {
+		builder.WriteString("trailingPriceLowerLimit=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.AtrPeriod; v != nil {
+		builder.WriteString("atrPeriod=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.AtrMultiplier; v != nil {
+		builder.WriteString("atrMultiplier=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.AdaptiveThreshold; v != nil {
+		builder.WriteString("adaptiveThreshold=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.AdaptiveIntervalHours; v != nil {
+		builder.WriteString("adaptiveIntervalHours=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.AdaptiveAtr; v != nil {
+		builder.WriteString("adaptiveAtr=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.AdaptiveUpdatedAt; v != nil {
+		builder.WriteString("adaptiveUpdatedAt=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cancelRepairPolicy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CancelRepairPolicy))
+	builder.WriteString(", ")
+	if v := _m.CancelRepairMaxAttempts; v != nil {
+		builder.WriteString("cancelRepairMaxAttempts=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reconcilePolicy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReconcilePolicy))
+	builder.WriteString(", ")
+	if v := _m.PartialFillThreshold; v != nil {
+		builder.WriteString("partialFillThreshold=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("enablePushNotification=")
 	builder.WriteString(fmt.Sprintf("%v", _m.EnablePushNotification))
 	builder.WriteString(", ")
@@ -433,6 +818,11 @@ func (_m *Strategy) String() string {
 	builder.WriteString("exchangeTestnet=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ExchangeTestnet))
 	builder.WriteString(", ")
+	if v := _m.AccountId; v != nil {
+		builder.WriteString("accountId=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	if v := _m.StartTime; v != nil {
 		builder.WriteString("startTime=")
 		builder.WriteString(v.Format(time.ANSIC))
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
 )
 
 const (
@@ -44,6 +46,12 @@ const (
 	FieldLeverage = "leverage"
 	// FieldInitialOrderSize holds the string denoting the initialordersize field in the database.
 	FieldInitialOrderSize = "initial_order_size"
+	// FieldSizingMode holds the string denoting the sizingmode field in the database.
+	FieldSizingMode = "sizing_mode"
+	// FieldSizingFactor holds the string denoting the sizingfactor field in the database.
+	FieldSizingFactor = "sizing_factor"
+	// FieldSizingWeights holds the string denoting the sizingweights field in the database.
+	FieldSizingWeights = "sizing_weights"
 	// FieldSlippageBps holds the string denoting the slippagebps field in the database.
 	FieldSlippageBps = "slippage_bps"
 	// FieldEntryPrice holds the string denoting the entryprice field in the database.
@@ -52,6 +60,50 @@ const (
 	FieldTriggerStopLossPrice = "trigger_stop_loss_price"
 	// FieldTriggerTakeProfitPrice holds the string denoting the triggertakeprofitprice field in the database.
 	FieldTriggerTakeProfitPrice = "trigger_take_profit_price"
+	// FieldMaxLossAmount holds the string denoting the maxlossamount field in the database.
+	FieldMaxLossAmount = "max_loss_amount"
+	// FieldMaxLossPercent holds the string denoting the maxlosspercent field in the database.
+	FieldMaxLossPercent = "max_loss_percent"
+	// FieldProfitTargetAmount holds the string denoting the profittargetamount field in the database.
+	FieldProfitTargetAmount = "profit_target_amount"
+	// FieldTrailingStopLossAmount holds the string denoting the trailingstoplossamount field in the database.
+	FieldTrailingStopLossAmount = "trailing_stop_loss_amount"
+	// FieldTrailingStopLossPercent holds the string denoting the trailingstoplosspercent field in the database.
+	FieldTrailingStopLossPercent = "trailing_stop_loss_percent"
+	// FieldTrailingTakeProfitActivationPrice holds the string denoting the trailingtakeprofitactivationprice field in the database.
+	FieldTrailingTakeProfitActivationPrice = "trailing_take_profit_activation_price"
+	// FieldTrailingTakeProfitAmount holds the string denoting the trailingtakeprofitamount field in the database.
+	FieldTrailingTakeProfitAmount = "trailing_take_profit_amount"
+	// FieldTrailingTakeProfitPercent holds the string denoting the trailingtakeprofitpercent field in the database.
+	FieldTrailingTakeProfitPercent = "trailing_take_profit_percent"
+	// FieldTrailingExtremePrice holds the string denoting the trailingextremeprice field in the database.
+	FieldTrailingExtremePrice = "trailing_extreme_price"
+	// FieldTrailingLevels holds the string denoting the trailinglevels field in the database.
+	FieldTrailingLevels = "trailing_levels"
+	// FieldTrailingPriceUpperLimit holds the string denoting the trailingpriceupperlimit field in the database.
+	FieldTrailingPriceUpperLimit = "trailing_price_upper_limit"
+	// FieldTrailingPriceLowerLimit holds the string denoting the trailingpricelowerlimit field in the database.
+	FieldTrailingPriceLowerLimit = "trailing_price_lower_limit"
+	// FieldAtrPeriod holds the string denoting the atrperiod field in the database.
+	FieldAtrPeriod = "atr_period"
+	// FieldAtrMultiplier holds the string denoting the atrmultiplier field in the database.
+	FieldAtrMultiplier = "atr_multiplier"
+	// FieldAdaptiveThreshold holds the string denoting the adaptivethreshold field in the database.
+	FieldAdaptiveThreshold = "adaptive_threshold"
+	// FieldAdaptiveIntervalHours holds the string denoting the adaptiveintervalhours field in the database.
+	FieldAdaptiveIntervalHours = "adaptive_interval_hours"
+	// FieldAdaptiveAtr holds the string denoting the adaptiveatr field in the database.
+	FieldAdaptiveAtr = "adaptive_atr"
+	// FieldAdaptiveUpdatedAt holds the string denoting the adaptiveupdatedat field in the database.
+	FieldAdaptiveUpdatedAt = "adaptive_updated_at"
+	// FieldCancelRepairPolicy holds the string denoting the cancelrepairpolicy field in the database.
+	FieldCancelRepairPolicy = "cancel_repair_policy"
+	// FieldCancelRepairMaxAttempts holds the string denoting the cancelrepairmaxattempts field in the database.
+	FieldCancelRepairMaxAttempts = "cancel_repair_max_attempts"
+	// FieldReconcilePolicy holds the string denoting the reconcilepolicy field in the database.
+	FieldReconcilePolicy = "reconcile_policy"
+	// FieldPartialFillThreshold holds the string denoting the partialfillthreshold field in the database.
+	FieldPartialFillThreshold = "partial_fill_threshold"
 	// FieldEnablePushNotification holds the string denoting the enablepushnotification field in the database.
 	FieldEnablePushNotification = "enable_push_notification"
 	// FieldEnablePushMatchedNotification holds the string denoting the enablepushmatchednotification field in the database.
@@ -70,10 +122,21 @@ const (
 	FieldExchangePassphrase = "exchange_passphrase"
 	// FieldExchangeTestnet holds the string denoting the exchangetestnet field in the database.
 	FieldExchangeTestnet = "exchange_testnet"
+	// FieldAccountId holds the string denoting the accountid field in the database.
+	FieldAccountId = "account_id"
 	// FieldStartTime holds the string denoting the starttime field in the database.
 	FieldStartTime = "start_time"
+	// EdgeExchangeAccount holds the string denoting the exchangeaccount edge name in mutations.
+	EdgeExchangeAccount = "exchangeAccount"
 	// Table holds the table name of the strategy in the database.
 	Table = "strategies"
+	// ExchangeAccountTable is the table that holds the exchangeAccount relation/edge.
+	ExchangeAccountTable = "strategies"
+	// ExchangeAccountInverseTable is the table name for the ExchangeAccount entity.
+	// It exists in this package in order to avoid circular dependency with the "exchangeaccount" package.
+	ExchangeAccountInverseTable = "exchange_accounts"
+	// ExchangeAccountColumn is the table column denoting the exchangeAccount relation/edge.
+	ExchangeAccountColumn = "account_id"
 )
 
 // Columns holds all SQL columns for strategy fields.
@@ -94,10 +157,35 @@ var Columns = []string{
 	FieldGridNum,
 	FieldLeverage,
 	FieldInitialOrderSize,
+	FieldSizingMode,
+	FieldSizingFactor,
+	FieldSizingWeights,
 	FieldSlippageBps,
 	FieldEntryPrice,
 	FieldTriggerStopLossPrice,
 	FieldTriggerTakeProfitPrice,
+	FieldMaxLossAmount,
+	FieldMaxLossPercent,
+	FieldProfitTargetAmount,
+	FieldTrailingStopLossAmount,
+	FieldTrailingStopLossPercent,
+	FieldTrailingTakeProfitActivationPrice,
+	FieldTrailingTakeProfitAmount,
+	FieldTrailingTakeProfitPercent,
+	FieldTrailingExtremePrice,
+	FieldTrailingLevels,
+	FieldTrailingPriceUpperLimit,
+	FieldTrailingPriceLowerLimit,
+	FieldAtrPeriod,
+	FieldAtrMultiplier,
+	FieldAdaptiveThreshold,
+	FieldAdaptiveIntervalHours,
+	FieldAdaptiveAtr,
+	FieldAdaptiveUpdatedAt,
+	FieldCancelRepairPolicy,
+	FieldCancelRepairMaxAttempts,
+	FieldReconcilePolicy,
+	FieldPartialFillThreshold,
 	FieldEnablePushNotification,
 	FieldEnablePushMatchedNotification,
 	FieldLastLowerThresholdAlertTime,
@@ -107,6 +195,7 @@ var Columns = []string{
 	FieldExchangeSecretKey,
 	FieldExchangePassphrase,
 	FieldExchangeTestnet,
+	FieldAccountId,
 	FieldStartTime,
 }
 
@@ -141,10 +230,26 @@ var (
 	DefaultLeverage int
 	// LeverageValidator is a validator for the "leverage" field. It is called by the builders before save.
 	LeverageValidator func(int) error
+	// SizingWeightsValidator is a validator for the "sizingWeights" field. It is called by the builders before save.
+	SizingWeightsValidator func(string) error
 	// SlippageBpsValidator is a validator for the "slippageBps" field. It is called by the builders before save.
 	SlippageBpsValidator func(int) error
+	// TrailingLevelsValidator is a validator for the "trailingLevels" field. It is called by the builders before save.
+	TrailingLevelsValidator func(int) error
+	// AtrPeriodValidator is a validator for the "atrPeriod" field. It is called by the builders before save.
+	AtrPeriodValidator func(int) error
+	// AdaptiveIntervalHoursValidator is a validator for the "adaptiveIntervalHours" field. It is called by the builders before save.
+	AdaptiveIntervalHoursValidator func(int) error
+	// CancelRepairMaxAttemptsValidator is a validator for the "cancelRepairMaxAttempts" field. It is called by the builders before save.
+	CancelRepairMaxAttemptsValidator func(int) error
 	// DefaultExchangeTestnet holds the default value on creation for the "exchangeTestnet" field.
 	DefaultExchangeTestnet bool
+	// ValueScanner of all Strategy fields.
+	ValueScanner struct {
+		ExchangeApiKey     field.TypeValueScanner[string]
+		ExchangeSecretKey  field.TypeValueScanner[string]
+		ExchangePassphrase field.TypeValueScanner[string]
+	}
 )
 
 // Mode defines the type for the "mode" enum field.
@@ -152,8 +257,9 @@ type Mode string
 
 // Mode values.
 const (
-	ModeLong  Mode = "long"
-	ModeShort Mode = "short"
+	ModeLong    Mode = "long"
+	ModeShort   Mode = "short"
+	ModeNeutral Mode = "neutral"
 )
 
 func (m Mode) String() string {
@@ -163,7 +269,7 @@ func (m Mode) String() string {
 // ModeValidator is a validator for the "mode" field enum values. It is called by the builders before save.
 func ModeValidator(m Mode) error {
 	switch m {
-	case ModeLong, ModeShort:
+	case ModeLong, ModeShort, ModeNeutral:
 		return nil
 	default:
 		return fmt.Errorf("strategy: invalid enum value for mode field: %q", m)
@@ -200,6 +306,7 @@ type QuantityMode string
 const (
 	QuantityModeArithmetic QuantityMode = "arithmetic"
 	QuantityModeGeometric  QuantityMode = "geometric"
+	QuantityModeAdaptive   QuantityMode = "adaptive"
 )
 
 func (qm QuantityMode) String() string {
@@ -209,13 +316,95 @@ func (qm QuantityMode) String() string {
 // QuantityModeValidator is a validator for the "quantityMode" field enum values. It is called by the builders before save.
 func QuantityModeValidator(qm QuantityMode) error {
 	switch qm {
-	case QuantityModeArithmetic, QuantityModeGeometric:
+	case QuantityModeArithmetic, QuantityModeGeometric, QuantityModeAdaptive:
 		return nil
 	default:
 		return fmt.Errorf("strategy: invalid enum value for quantityMode field: %q", qm)
 	}
 }
 
+// SizingMode defines the type for the "sizingMode" enum field.
+type SizingMode string
+
+// SizingModeFixed is the default value of the SizingMode enum.
+const DefaultSizingMode = SizingModeFixed
+
+// SizingMode values.
+const (
+	SizingModeFixed         SizingMode = "fixed"
+	SizingModePyramid       SizingMode = "pyramid"
+	SizingModeGeometric     SizingMode = "geometric"
+	SizingModeEqualNotional SizingMode = "equal_notional"
+	SizingModeCustom        SizingMode = "custom"
+)
+
+func (sm SizingMode) String() string {
+	return string(sm)
+}
+
+// SizingModeValidator is a validator for the "sizingMode" field enum values. It is called by the builders before save.
+func SizingModeValidator(sm SizingMode) error {
+	switch sm {
+	case SizingModeFixed, SizingModePyramid, SizingModeGeometric, SizingModeEqualNotional, SizingModeCustom:
+		return nil
+	default:
+		return fmt.Errorf("strategy: invalid enum value for sizingMode field: %q", sm)
+	}
+}
+
+// CancelRepairPolicy defines the type for the "cancelRepairPolicy" enum field.
+type CancelRepairPolicy string
+
+// CancelRepairPolicyRepair is the default value of the CancelRepairPolicy enum.
+const DefaultCancelRepairPolicy = CancelRepairPolicyRepair
+
+// CancelRepairPolicy values.
+const (
+	CancelRepairPolicyRepair CancelRepairPolicy = "repair"
+	CancelRepairPolicySkip   CancelRepairPolicy = "skip"
+	CancelRepairPolicyStop   CancelRepairPolicy = "stop"
+)
+
+func (crp CancelRepairPolicy) String() string {
+	return string(crp)
+}
+
+// CancelRepairPolicyValidator is a validator for the "cancelRepairPolicy" field enum values. It is called by the builders before save.
+func CancelRepairPolicyValidator(crp CancelRepairPolicy) error {
+	switch crp {
+	case CancelRepairPolicyRepair, CancelRepairPolicySkip, CancelRepairPolicyStop:
+		return nil
+	default:
+		return fmt.Errorf("strategy: invalid enum value for cancelRepairPolicy field: %q", crp)
+	}
+}
+
+// ReconcilePolicy defines the type for the "reconcilePolicy" enum field.
+type ReconcilePolicy string
+
+// ReconcilePolicyAlert is the default value of the ReconcilePolicy enum.
+const DefaultReconcilePolicy = ReconcilePolicyAlert
+
+// ReconcilePolicy values.
+const (
+	ReconcilePolicyAlert ReconcilePolicy = "alert"
+	ReconcilePolicyFix   ReconcilePolicy = "fix"
+)
+
+func (rp ReconcilePolicy) String() string {
+	return string(rp)
+}
+
+// ReconcilePolicyValidator is a validator for the "reconcilePolicy" field enum values. It is called by the builders before save.
+func ReconcilePolicyValidator(rp ReconcilePolicy) error {
+	switch rp {
+	case ReconcilePolicyAlert, ReconcilePolicyFix:
+		return nil
+	default:
+		return fmt.Errorf("strategy: invalid enum value for reconcilePolicy field: %q", rp)
+	}
+}
+
 // Status defines the type for the "status" enum field.
 type Status string
 
@@ -322,6 +511,21 @@ func ByInitialOrderSize(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldInitialOrderSize, opts...).ToFunc()
 }
 
+// BySizingMode orders the results by the sizingMode field.
+func BySizingMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSizingMode, opts...).ToFunc()
+}
+
+// BySizingFactor orders the results by the sizingFactor field.
+func BySizingFactor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSizingFactor, opts...).ToFunc()
+}
+
+// BySizingWeights orders the results by the sizingWeights field.
+func BySizingWeights(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSizingWeights, opts...).ToFunc()
+}
+
 // BySlippageBps orders the results by the slippageBps field.
 func BySlippageBps(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSlippageBps, opts...).ToFunc()
@@ -342,6 +546,116 @@ func ByTriggerTakeProfitPrice(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTriggerTakeProfitPrice, opts...).ToFunc()
 }
 
+// ByMaxLossAmount orders the results by the maxLossAmount field.
+func ByMaxLossAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxLossAmount, opts...).ToFunc()
+}
+
+// ByMaxLossPercent orders the results by the maxLossPercent field.
+func ByMaxLossPercent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxLossPercent, opts...).ToFunc()
+}
+
+// ByProfitTargetAmount orders the results by the profitTargetAmount field.
+func ByProfitTargetAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProfitTargetAmount, opts...).ToFunc()
+}
+
+// ByTrailingStopLossAmount orders the results by the trailingStopLossAmount field.
+func ByTrailingStopLossAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrailingStopLossAmount, opts...).ToFunc()
+}
+
+// ByTrailingStopLossPercent orders the results by the trailingStopLossPercent field.
+func ByTrailingStopLossPercent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrailingStopLossPercent, opts...).ToFunc()
+}
+
+// ByTrailingTakeProfitActivationPrice orders the results by the trailingTakeProfitActivationPrice field.
+func ByTrailingTakeProfitActivationPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrailingTakeProfitActivationPrice, opts...).ToFunc()
+}
+
+// ByTrailingTakeProfitAmount orders the results by the trailingTakeProfitAmount field.
+func ByTrailingTakeProfitAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrailingTakeProfitAmount, opts...).ToFunc()
+}
+
+// ByTrailingTakeProfitPercent orders the results by the trailingTakeProfitPercent field.
+func ByTrailingTakeProfitPercent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrailingTakeProfitPercent, opts...).ToFunc()
+}
+
+// ByTrailingExtremePrice orders the results by the trailingExtremePrice field.
+func ByTrailingExtremePrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrailingExtremePrice, opts...).ToFunc()
+}
+
+// ByTrailingLevels orders the results by the trailingLevels field.
+func ByTrailingLevels(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrailingLevels, opts...).ToFunc()
+}
+
+// ByTrailingPriceUpperLimit orders the results by the trailingPriceUpperLimit field.
+func ByTrailingPriceUpperLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrailingPriceUpperLimit, opts...).ToFunc()
+}
+
+// ByTrailingPriceLowerLimit orders the results by the trailingPriceLowerLimit field.
+func ByTrailingPriceLowerLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrailingPriceLowerLimit, opts...).ToFunc()
+}
+
+// ByAtrPeriod orders the results by the atrPeriod field.
+func ByAtrPeriod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAtrPeriod, opts...).ToFunc()
+}
+
+// ByAtrMultiplier orders the results by the atrMultiplier field.
+func ByAtrMultiplier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAtrMultiplier, opts...).ToFunc()
+}
+
+// ByAdaptiveThreshold orders the results by the adaptiveThreshold field.
+func ByAdaptiveThreshold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAdaptiveThreshold, opts...).ToFunc()
+}
+
+// ByAdaptiveIntervalHours orders the results by the adaptiveIntervalHours field.
+func ByAdaptiveIntervalHours(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAdaptiveIntervalHours, opts...).ToFunc()
+}
+
+// ByAdaptiveAtr orders the results by the adaptiveAtr field.
+func ByAdaptiveAtr(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAdaptiveAtr, opts...).ToFunc()
+}
+
+// ByAdaptiveUpdatedAt orders the results by the adaptiveUpdatedAt field.
+func ByAdaptiveUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAdaptiveUpdatedAt, opts...).ToFunc()
+}
+
+// ByCancelRepairPolicy orders the results by the cancelRepairPolicy field.
+func ByCancelRepairPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelRepairPolicy, opts...).ToFunc()
+}
+
+// ByCancelRepairMaxAttempts orders the results by the cancelRepairMaxAttempts field.
+func ByCancelRepairMaxAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelRepairMaxAttempts, opts...).ToFunc()
+}
+
+// ByReconcilePolicy orders the results by the reconcilePolicy field.
+func ByReconcilePolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReconcilePolicy, opts...).ToFunc()
+}
+
+// ByPartialFillThreshold orders the results by the partialFillThreshold field.
+func ByPartialFillThreshold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPartialFillThreshold, opts...).ToFunc()
+}
+
 // ByEnablePushNotification orders the results by the enablePushNotification field.
 func ByEnablePushNotification(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEnablePushNotification, opts...).ToFunc()
@@ -387,7 +701,26 @@ func ByExchangeTestnet(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExchangeTestnet, opts...).ToFunc()
 }
 
+// ByAccountId orders the results by the accountId field.
+func ByAccountId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountId, opts...).ToFunc()
+}
+
 // ByStartTime orders the results by the startTime field.
 func ByStartTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStartTime, opts...).ToFunc()
 }
+
+// ByExchangeAccountField orders the results by exchangeAccount field.
+func ByExchangeAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newExchangeAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newExchangeAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ExchangeAccountInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ExchangeAccountTable, ExchangeAccountColumn),
+	)
+}
@@ -3,9 +3,11 @@
 package strategy
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/fachebot/omni-grid-bot/internal/ent/predicate"
 	"github.com/shopspring/decimal"
 )
@@ -115,6 +117,16 @@ func InitialOrderSize(v decimal.Decimal) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldInitialOrderSize, v))
 }
 
+// SizingFactor applies equality check predicate on the "sizingFactor" field. It's identical to SizingFactorEQ.
+func SizingFactor(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldSizingFactor, v))
+}
+
+// SizingWeights applies equality check predicate on the "sizingWeights" field. It's identical to SizingWeightsEQ.
+func SizingWeights(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldSizingWeights, v))
+}
+
 // SlippageBps applies equality check predicate on the "slippageBps" field. It's identical to SlippageBpsEQ.
 func SlippageBps(v int) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldSlippageBps, v))
@@ -135,6 +147,106 @@ func TriggerTakeProfitPrice(v decimal.Decimal) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldTriggerTakeProfitPrice, v))
 }
 
+// MaxLossAmount applies equality check predicate on the "maxLossAmount" field. It's identical to MaxLossAmountEQ.
+func MaxLossAmount(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldMaxLossAmount, v))
+}
+
+// MaxLossPercent applies equality check predicate on the "maxLossPercent" field. It's identical to MaxLossPercentEQ.
+func MaxLossPercent(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldMaxLossPercent, v))
+}
+
+// ProfitTargetAmount applies equality check predicate on the "profitTargetAmount" field. It's identical to ProfitTargetAmountEQ.
+func ProfitTargetAmount(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldProfitTargetAmount, v))
+}
+
+// TrailingStopLossAmount applies equality check predicate on the "trailingStopLossAmount" field. It's identical to TrailingStopLossAmountEQ.
+func TrailingStopLossAmount(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingStopLossAmount, v))
+}
+
+// TrailingStopLossPercent applies equality check predicate on the "trailingStopLossPercent" field. It's identical to TrailingStopLossPercentEQ.
+func TrailingStopLossPercent(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingStopLossPercent, v))
+}
+
+// TrailingTakeProfitActivationPrice applies equality check predicate on the "trailingTakeProfitActivationPrice" field. It's identical to TrailingTakeProfitActivationPriceEQ.
+func TrailingTakeProfitActivationPrice(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingTakeProfitActivationPrice, v))
+}
+
+// TrailingTakeProfitAmount applies equality check predicate on the "trailingTakeProfitAmount" field. It's identical to TrailingTakeProfitAmountEQ.
+func TrailingTakeProfitAmount(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingTakeProfitAmount, v))
+}
+
+// TrailingTakeProfitPercent applies equality check predicate on the "trailingTakeProfitPercent" field. It's identical to TrailingTakeProfitPercentEQ.
+func TrailingTakeProfitPercent(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingTakeProfitPercent, v))
+}
+
+// TrailingExtremePrice applies equality check predicate on the "trailingExtremePrice" field. It's identical to TrailingExtremePriceEQ.
+func TrailingExtremePrice(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingExtremePrice, v))
+}
+
+// TrailingLevels applies equality check predicate on the "trailingLevels" field. It's identical to TrailingLevelsEQ.
+func TrailingLevels(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingLevels, v))
+}
+
+// TrailingPriceUpperLimit applies equality check predicate on the "trailingPriceUpperLimit" field. It's identical to TrailingPriceUpperLimitEQ.
+func TrailingPriceUpperLimit(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingPriceUpperLimit, v))
+}
+
+// TrailingPriceLowerLimit applies equality check predicate on the "trailingPriceLowerLimit" field. It's identical to TrailingPriceLowerLimitEQ.
+func TrailingPriceLowerLimit(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingPriceLowerLimit, v))
+}
+
+// AtrPeriod applies equality check predicate on the "atrPeriod" field. It's identical to AtrPeriodEQ.
+func AtrPeriod(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldAtrPeriod, v))
+}
+
+// AtrMultiplier applies equality check predicate on the "atrMultiplier" field. It's identical to AtrMultiplierEQ.
+func AtrMultiplier(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldAtrMultiplier, v))
+}
+
+// AdaptiveThreshold applies equality check predicate on the "adaptiveThreshold" field. It's identical to AdaptiveThresholdEQ.
+func AdaptiveThreshold(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldAdaptiveThreshold, v))
+}
+
+// AdaptiveIntervalHours applies equality check predicate on the "adaptiveIntervalHours" field. It's identical to AdaptiveIntervalHoursEQ.
+func AdaptiveIntervalHours(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldAdaptiveIntervalHours, v))
+}
+
+// AdaptiveAtr applies equality check predicate on the "adaptiveAtr" field. It's identical to AdaptiveAtrEQ.
+func AdaptiveAtr(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldAdaptiveAtr, v))
+}
+
+// AdaptiveUpdatedAt applies equality check predicate on the "adaptiveUpdatedAt" field. It's identical to AdaptiveUpdatedAtEQ.
+func AdaptiveUpdatedAt(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldAdaptiveUpdatedAt, v))
+}
+
+// CancelRepairMaxAttempts applies equality check predicate on the "cancelRepairMaxAttempts" field. It's identical to CancelRepairMaxAttemptsEQ.
+func CancelRepairMaxAttempts(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldCancelRepairMaxAttempts, v))
+}
+
+// PartialFillThreshold applies equality check predicate on the "partialFillThreshold" field. It's identical to PartialFillThresholdEQ.
+func PartialFillThreshold(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldPartialFillThreshold, v))
+}
+
 // EnablePushNotification applies equality check predicate on the "enablePushNotification" field. It's identical to EnablePushNotificationEQ.
 func EnablePushNotification(v bool) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldEnablePushNotification, v))
@@ -157,17 +269,20 @@ func LastUpperThresholdAlertTime(v time.Time) predicate.Strategy {
 
 // ExchangeApiKey applies equality check predicate on the "exchangeApiKey" field. It's identical to ExchangeApiKeyEQ.
 func ExchangeApiKey(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldEQ(FieldExchangeApiKey, v))
+	vc, err := ValueScanner.ExchangeApiKey.Value(v)
+	return predicate.StrategyOrErr(sql.FieldEQ(FieldExchangeApiKey, vc), err)
 }
 
 // ExchangeSecretKey applies equality check predicate on the "exchangeSecretKey" field. It's identical to ExchangeSecretKeyEQ.
 func ExchangeSecretKey(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldEQ(FieldExchangeSecretKey, v))
+	vc, err := ValueScanner.ExchangeSecretKey.Value(v)
+	return predicate.StrategyOrErr(sql.FieldEQ(FieldExchangeSecretKey, vc), err)
 }
 
 // ExchangePassphrase applies equality check predicate on the "exchangePassphrase" field. It's identical to ExchangePassphraseEQ.
 func ExchangePassphrase(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldEQ(FieldExchangePassphrase, v))
+	vc, err := ValueScanner.ExchangePassphrase.Value(v)
+	return predicate.StrategyOrErr(sql.FieldEQ(FieldExchangePassphrase, vc), err)
 }
 
 // ExchangeTestnet applies equality check predicate on the "exchangeTestnet" field. It's identical to ExchangeTestnetEQ.
@@ -175,6 +290,11 @@ func ExchangeTestnet(v bool) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldExchangeTestnet, v))
 }
 
+// AccountId applies equality check predicate on the "accountId" field. It's identical to AccountIdEQ.
+func AccountId(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldAccountId, v))
+}
+
 // StartTime applies equality check predicate on the "startTime" field. It's identical to StartTimeEQ.
 func StartTime(v time.Time) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldStartTime, v))
@@ -910,6 +1030,181 @@ func InitialOrderSizeContainsFold(v decimal.Decimal) predicate.Strategy {
 	return predicate.Strategy(sql.FieldContainsFold(FieldInitialOrderSize, vc))
 }
 
+// SizingModeEQ applies the EQ predicate on the "sizingMode" field.
+func SizingModeEQ(v SizingMode) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldSizingMode, v))
+}
+
+// SizingModeNEQ applies the NEQ predicate on the "sizingMode" field.
+func SizingModeNEQ(v SizingMode) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldSizingMode, v))
+}
+
+// SizingModeIn applies the In predicate on the "sizingMode" field.
+func SizingModeIn(vs ...SizingMode) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldSizingMode, vs...))
+}
+
+// SizingModeNotIn applies the NotIn predicate on the "sizingMode" field.
+func SizingModeNotIn(vs ...SizingMode) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldSizingMode, vs...))
+}
+
+// SizingFactorEQ applies the EQ predicate on the "sizingFactor" field.
+func SizingFactorEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldSizingFactor, v))
+}
+
+// SizingFactorNEQ applies the NEQ predicate on the "sizingFactor" field.
+func SizingFactorNEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldSizingFactor, v))
+}
+
+// SizingFactorIn applies the In predicate on the "sizingFactor" field.
+func SizingFactorIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldSizingFactor, vs...))
+}
+
+// SizingFactorNotIn applies the NotIn predicate on the "sizingFactor" field.
+func SizingFactorNotIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldSizingFactor, vs...))
+}
+
+// SizingFactorGT applies the GT predicate on the "sizingFactor" field.
+func SizingFactorGT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldSizingFactor, v))
+}
+
+// SizingFactorGTE applies the GTE predicate on the "sizingFactor" field.
+func SizingFactorGTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldSizingFactor, v))
+}
+
+// SizingFactorLT applies the LT predicate on the "sizingFactor" field.
+func SizingFactorLT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldSizingFactor, v))
+}
+
+// SizingFactorLTE applies the LTE predicate on the "sizingFactor" field.
+func SizingFactorLTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldSizingFactor, v))
+}
+
+// SizingFactorContains applies the Contains predicate on the "sizingFactor" field.
+func SizingFactorContains(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContains(FieldSizingFactor, vc))
+}
+
+// SizingFactorHasPrefix applies the HasPrefix predicate on the "sizingFactor" field.
+func SizingFactorHasPrefix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasPrefix(FieldSizingFactor, vc))
+}
+
+// SizingFactorHasSuffix applies the HasSuffix predicate on the "sizingFactor" field.
+func SizingFactorHasSuffix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasSuffix(FieldSizingFactor, vc))
+}
+
+// SizingFactorIsNil applies the IsNil predicate on the "sizingFactor" field.
+func SizingFactorIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldSizingFactor))
+}
+
+// SizingFactorNotNil applies the NotNil predicate on the "sizingFactor" field.
+func SizingFactorNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldSizingFactor))
+}
+
+// SizingFactorEqualFold applies the EqualFold predicate on the "sizingFactor" field.
+func SizingFactorEqualFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldEqualFold(FieldSizingFactor, vc))
+}
+
+// SizingFactorContainsFold applies the ContainsFold predicate on the "sizingFactor" field.
+func SizingFactorContainsFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContainsFold(FieldSizingFactor, vc))
+}
+
+// SizingWeightsEQ applies the EQ predicate on the "sizingWeights" field.
+func SizingWeightsEQ(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldSizingWeights, v))
+}
+
+// SizingWeightsNEQ applies the NEQ predicate on the "sizingWeights" field.
+func SizingWeightsNEQ(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldSizingWeights, v))
+}
+
+// SizingWeightsIn applies the In predicate on the "sizingWeights" field.
+func SizingWeightsIn(vs ...string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldSizingWeights, vs...))
+}
+
+// SizingWeightsNotIn applies the NotIn predicate on the "sizingWeights" field.
+func SizingWeightsNotIn(vs ...string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldSizingWeights, vs...))
+}
+
+// SizingWeightsGT applies the GT predicate on the "sizingWeights" field.
+func SizingWeightsGT(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldSizingWeights, v))
+}
+
+// SizingWeightsGTE applies the GTE predicate on the "sizingWeights" field.
+func SizingWeightsGTE(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldSizingWeights, v))
+}
+
+// SizingWeightsLT applies the LT predicate on the "sizingWeights" field.
+func SizingWeightsLT(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldSizingWeights, v))
+}
+
+// SizingWeightsLTE applies the LTE predicate on the "sizingWeights" field.
+func SizingWeightsLTE(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldSizingWeights, v))
+}
+
+// SizingWeightsContains applies the Contains predicate on the "sizingWeights" field.
+func SizingWeightsContains(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldContains(FieldSizingWeights, v))
+}
+
+// SizingWeightsHasPrefix applies the HasPrefix predicate on the "sizingWeights" field.
+func SizingWeightsHasPrefix(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldHasPrefix(FieldSizingWeights, v))
+}
+
+// SizingWeightsHasSuffix applies the HasSuffix predicate on the "sizingWeights" field.
+func SizingWeightsHasSuffix(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldHasSuffix(FieldSizingWeights, v))
+}
+
+// SizingWeightsIsNil applies the IsNil predicate on the "sizingWeights" field.
+func SizingWeightsIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldSizingWeights))
+}
+
+// SizingWeightsNotNil applies the NotNil predicate on the "sizingWeights" field.
+func SizingWeightsNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldSizingWeights))
+}
+
+// SizingWeightsEqualFold applies the EqualFold predicate on the "sizingWeights" field.
+func SizingWeightsEqualFold(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEqualFold(FieldSizingWeights, v))
+}
+
+// SizingWeightsContainsFold applies the ContainsFold predicate on the "sizingWeights" field.
+func SizingWeightsContainsFold(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldContainsFold(FieldSizingWeights, v))
+}
+
 // SlippageBpsEQ applies the EQ predicate on the "slippageBps" field.
 func SlippageBpsEQ(v int) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldSlippageBps, v))
@@ -1200,349 +1495,1986 @@ func TriggerTakeProfitPriceContainsFold(v decimal.Decimal) predicate.Strategy {
 	return predicate.Strategy(sql.FieldContainsFold(FieldTriggerTakeProfitPrice, vc))
 }
 
-// EnablePushNotificationEQ applies the EQ predicate on the "enablePushNotification" field.
-func EnablePushNotificationEQ(v bool) predicate.Strategy {
-	return predicate.Strategy(sql.FieldEQ(FieldEnablePushNotification, v))
+// MaxLossAmountEQ applies the EQ predicate on the "maxLossAmount" field.
+func MaxLossAmountEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldMaxLossAmount, v))
 }
 
-// EnablePushNotificationNEQ applies the NEQ predicate on the "enablePushNotification" field.
-func EnablePushNotificationNEQ(v bool) predicate.Strategy {
-	return predicate.Strategy(sql.FieldNEQ(FieldEnablePushNotification, v))
+// MaxLossAmountNEQ applies the NEQ predicate on the "maxLossAmount" field.
+func MaxLossAmountNEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldMaxLossAmount, v))
 }
 
-// EnablePushMatchedNotificationEQ applies the EQ predicate on the "enablePushMatchedNotification" field.
-func EnablePushMatchedNotificationEQ(v bool) predicate.Strategy {
-	return predicate.Strategy(sql.FieldEQ(FieldEnablePushMatchedNotification, v))
+// MaxLossAmountIn applies the In predicate on the "maxLossAmount" field.
+func MaxLossAmountIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldMaxLossAmount, vs...))
 }
 
-// EnablePushMatchedNotificationNEQ applies the NEQ predicate on the "enablePushMatchedNotification" field.
-func EnablePushMatchedNotificationNEQ(v bool) predicate.Strategy {
-	return predicate.Strategy(sql.FieldNEQ(FieldEnablePushMatchedNotification, v))
+// MaxLossAmountNotIn applies the NotIn predicate on the "maxLossAmount" field.
+func MaxLossAmountNotIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldMaxLossAmount, vs...))
 }
 
-// EnablePushMatchedNotificationIsNil applies the IsNil predicate on the "enablePushMatchedNotification" field.
-func EnablePushMatchedNotificationIsNil() predicate.Strategy {
-	return predicate.Strategy(sql.FieldIsNull(FieldEnablePushMatchedNotification))
+// MaxLossAmountGT applies the GT predicate on the "maxLossAmount" field.
+func MaxLossAmountGT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldMaxLossAmount, v))
 }
 
-// EnablePushMatchedNotificationNotNil applies the NotNil predicate on the "enablePushMatchedNotification" field.
-func EnablePushMatchedNotificationNotNil() predicate.Strategy {
-	return predicate.Strategy(sql.FieldNotNull(FieldEnablePushMatchedNotification))
+// MaxLossAmountGTE applies the GTE predicate on the "maxLossAmount" field.
+func MaxLossAmountGTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldMaxLossAmount, v))
 }
 
-// LastLowerThresholdAlertTimeEQ applies the EQ predicate on the "lastLowerThresholdAlertTime" field.
-func LastLowerThresholdAlertTimeEQ(v time.Time) predicate.Strategy {
-	return predicate.Strategy(sql.FieldEQ(FieldLastLowerThresholdAlertTime, v))
+// MaxLossAmountLT applies the LT predicate on the "maxLossAmount" field.
+func MaxLossAmountLT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldMaxLossAmount, v))
 }
 
-// LastLowerThresholdAlertTimeNEQ applies the NEQ predicate on the "lastLowerThresholdAlertTime" field.
-func LastLowerThresholdAlertTimeNEQ(v time.Time) predicate.Strategy {
-	return predicate.Strategy(sql.FieldNEQ(FieldLastLowerThresholdAlertTime, v))
+// MaxLossAmountLTE applies the LTE predicate on the "maxLossAmount" field.
+func MaxLossAmountLTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldMaxLossAmount, v))
 }
 
-// LastLowerThresholdAlertTimeIn applies the In predicate on the "lastLowerThresholdAlertTime" field.
-func LastLowerThresholdAlertTimeIn(vs ...time.Time) predicate.Strategy {
-	return predicate.Strategy(sql.FieldIn(FieldLastLowerThresholdAlertTime, vs...))
+// MaxLossAmountContains applies the Contains predicate on the "maxLossAmount" field.
+func MaxLossAmountContains(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContains(FieldMaxLossAmount, vc))
 }
 
-// LastLowerThresholdAlertTimeNotIn applies the NotIn predicate on the "lastLowerThresholdAlertTime" field.
-func LastLowerThresholdAlertTimeNotIn(vs ...time.Time) predicate.Strategy {
-	return predicate.Strategy(sql.FieldNotIn(FieldLastLowerThresholdAlertTime, vs...))
+// MaxLossAmountHasPrefix applies the HasPrefix predicate on the "maxLossAmount" field.
+func MaxLossAmountHasPrefix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasPrefix(FieldMaxLossAmount, vc))
 }
 
-// LastLowerThresholdAlertTimeGT applies the GT predicate on the "lastLowerThresholdAlertTime" field.
-func LastLowerThresholdAlertTimeGT(v time.Time) predicate.Strategy {
-	return predicate.Strategy(sql.FieldGT(FieldLastLowerThresholdAlertTime, v))
+// MaxLossAmountHasSuffix applies the HasSuffix predicate on the "maxLossAmount" field.
+func MaxLossAmountHasSuffix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasSuffix(FieldMaxLossAmount, vc))
 }
 
-// LastLowerThresholdAlertTimeGTE applies the GTE predicate on the "lastLowerThresholdAlertTime" field.
-func LastLowerThresholdAlertTimeGTE(v time.Time) predicate.Strategy {
-	return predicate.Strategy(sql.FieldGTE(FieldLastLowerThresholdAlertTime, v))
+// MaxLossAmountIsNil applies the IsNil predicate on the "maxLossAmount" field.
+func MaxLossAmountIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldMaxLossAmount))
 }
 
-// LastLowerThresholdAlertTimeLT applies the LT predicate on the "lastLowerThresholdAlertTime" field.
-func LastLowerThresholdAlertTimeLT(v time.Time) predicate.Strategy {
-	return predicate.Strategy(sql.FieldLT(FieldLastLowerThresholdAlertTime, v))
+// MaxLossAmountNotNil applies the NotNil predicate on the "maxLossAmount" field.
+func MaxLossAmountNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldMaxLossAmount))
 }
 
-// LastLowerThresholdAlertTimeLTE applies the LTE predicate on the "lastLowerThresholdAlertTime" field.
-func LastLowerThresholdAlertTimeLTE(v time.Time) predicate.Strategy {
-	return predicate.Strategy(sql.FieldLTE(FieldLastLowerThresholdAlertTime, v))
+// MaxLossAmountEqualFold applies the EqualFold predicate on the "maxLossAmount" field.
+func MaxLossAmountEqualFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldEqualFold(FieldMaxLossAmount, vc))
 }
 
-// LastLowerThresholdAlertTimeIsNil applies the IsNil predicate on the "lastLowerThresholdAlertTime" field.
-func LastLowerThresholdAlertTimeIsNil() predicate.Strategy {
-	return predicate.Strategy(sql.FieldIsNull(FieldLastLowerThresholdAlertTime))
+// MaxLossAmountContainsFold applies the ContainsFold predicate on the "maxLossAmount" field.
+func MaxLossAmountContainsFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContainsFold(FieldMaxLossAmount, vc))
 }
 
-// LastLowerThresholdAlertTimeNotNil applies the NotNil predicate on the "lastLowerThresholdAlertTime" field.
-func LastLowerThresholdAlertTimeNotNil() predicate.Strategy {
-	return predicate.Strategy(sql.FieldNotNull(FieldLastLowerThresholdAlertTime))
+// MaxLossPercentEQ applies the EQ predicate on the "maxLossPercent" field.
+func MaxLossPercentEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldMaxLossPercent, v))
 }
 
-// LastUpperThresholdAlertTimeEQ applies the EQ predicate on the "lastUpperThresholdAlertTime" field.
-func LastUpperThresholdAlertTimeEQ(v time.Time) predicate.Strategy {
-	return predicate.Strategy(sql.FieldEQ(FieldLastUpperThresholdAlertTime, v))
+// MaxLossPercentNEQ applies the NEQ predicate on the "maxLossPercent" field.
+func MaxLossPercentNEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldMaxLossPercent, v))
 }
 
-// LastUpperThresholdAlertTimeNEQ applies the NEQ predicate on the "lastUpperThresholdAlertTime" field.
-func LastUpperThresholdAlertTimeNEQ(v time.Time) predicate.Strategy {
-	return predicate.Strategy(sql.FieldNEQ(FieldLastUpperThresholdAlertTime, v))
+// MaxLossPercentIn applies the In predicate on the "maxLossPercent" field.
+func MaxLossPercentIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldMaxLossPercent, vs...))
 }
 
-// LastUpperThresholdAlertTimeIn applies the In predicate on the "lastUpperThresholdAlertTime" field.
-func LastUpperThresholdAlertTimeIn(vs ...time.Time) predicate.Strategy {
-	return predicate.Strategy(sql.FieldIn(FieldLastUpperThresholdAlertTime, vs...))
+// MaxLossPercentNotIn applies the NotIn predicate on the "maxLossPercent" field.
+func MaxLossPercentNotIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldMaxLossPercent, vs...))
 }
 
-// LastUpperThresholdAlertTimeNotIn applies the NotIn predicate on the "lastUpperThresholdAlertTime" field.
-func LastUpperThresholdAlertTimeNotIn(vs ...time.Time) predicate.Strategy {
-	return predicate.Strategy(sql.FieldNotIn(FieldLastUpperThresholdAlertTime, vs...))
+// MaxLossPercentGT applies the GT predicate on the "maxLossPercent" field.
+func MaxLossPercentGT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldMaxLossPercent, v))
 }
 
-// LastUpperThresholdAlertTimeGT applies the GT predicate on the "lastUpperThresholdAlertTime" field.
-func LastUpperThresholdAlertTimeGT(v time.Time) predicate.Strategy {
-	return predicate.Strategy(sql.FieldGT(FieldLastUpperThresholdAlertTime, v))
+// MaxLossPercentGTE applies the GTE predicate on the "maxLossPercent" field.
+func MaxLossPercentGTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldMaxLossPercent, v))
 }
 
-// LastUpperThresholdAlertTimeGTE applies the GTE predicate on the "lastUpperThresholdAlertTime" field.
-func LastUpperThresholdAlertTimeGTE(v time.Time) predicate.Strategy {
-	return predicate.Strategy(sql.FieldGTE(FieldLastUpperThresholdAlertTime, v))
+// MaxLossPercentLT applies the LT predicate on the "maxLossPercent" field.
+func MaxLossPercentLT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldMaxLossPercent, v))
 }
 
-// LastUpperThresholdAlertTimeLT applies the LT predicate on the "lastUpperThresholdAlertTime" field.
-func LastUpperThresholdAlertTimeLT(v time.Time) predicate.Strategy {
-	return predicate.Strategy(sql.FieldLT(FieldLastUpperThresholdAlertTime, v))
+// MaxLossPercentLTE applies the LTE predicate on the "maxLossPercent" field.
+func MaxLossPercentLTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldMaxLossPercent, v))
 }
 
-// LastUpperThresholdAlertTimeLTE applies the LTE predicate on the "lastUpperThresholdAlertTime" field.
-func LastUpperThresholdAlertTimeLTE(v time.Time) predicate.Strategy {
-	return predicate.Strategy(sql.FieldLTE(FieldLastUpperThresholdAlertTime, v))
+// MaxLossPercentContains applies the Contains predicate on the "maxLossPercent" field.
+func MaxLossPercentContains(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContains(FieldMaxLossPercent, vc))
 }
 
-// LastUpperThresholdAlertTimeIsNil applies the IsNil predicate on the "lastUpperThresholdAlertTime" field.
-func LastUpperThresholdAlertTimeIsNil() predicate.Strategy {
-	return predicate.Strategy(sql.FieldIsNull(FieldLastUpperThresholdAlertTime))
+// MaxLossPercentHasPrefix applies the HasPrefix predicate on the "maxLossPercent" field.
+func MaxLossPercentHasPrefix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasPrefix(FieldMaxLossPercent, vc))
 }
 
-// LastUpperThresholdAlertTimeNotNil applies the NotNil predicate on the "lastUpperThresholdAlertTime" field.
-func LastUpperThresholdAlertTimeNotNil() predicate.Strategy {
-	return predicate.Strategy(sql.FieldNotNull(FieldLastUpperThresholdAlertTime))
+// MaxLossPercentHasSuffix applies the HasSuffix predicate on the "maxLossPercent" field.
+func MaxLossPercentHasSuffix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasSuffix(FieldMaxLossPercent, vc))
 }
 
-// StatusEQ applies the EQ predicate on the "status" field.
-func StatusEQ(v Status) predicate.Strategy {
-	return predicate.Strategy(sql.FieldEQ(FieldStatus, v))
+// MaxLossPercentIsNil applies the IsNil predicate on the "maxLossPercent" field.
+func MaxLossPercentIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldMaxLossPercent))
 }
 
-// StatusNEQ applies the NEQ predicate on the "status" field.
-func StatusNEQ(v Status) predicate.Strategy {
-	return predicate.Strategy(sql.FieldNEQ(FieldStatus, v))
+// MaxLossPercentNotNil applies the NotNil predicate on the "maxLossPercent" field.
+func MaxLossPercentNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldMaxLossPercent))
 }
 
-// StatusIn applies the In predicate on the "status" field.
-func StatusIn(vs ...Status) predicate.Strategy {
-	return predicate.Strategy(sql.FieldIn(FieldStatus, vs...))
+// MaxLossPercentEqualFold applies the EqualFold predicate on the "maxLossPercent" field.
+func MaxLossPercentEqualFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldEqualFold(FieldMaxLossPercent, vc))
 }
 
-// StatusNotIn applies the NotIn predicate on the "status" field.
-func StatusNotIn(vs ...Status) predicate.Strategy {
-	return predicate.Strategy(sql.FieldNotIn(FieldStatus, vs...))
+// MaxLossPercentContainsFold applies the ContainsFold predicate on the "maxLossPercent" field.
+func MaxLossPercentContainsFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContainsFold(FieldMaxLossPercent, vc))
 }
 
-// ExchangeApiKeyEQ applies the EQ predicate on the "exchangeApiKey" field.
-func ExchangeApiKeyEQ(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldEQ(FieldExchangeApiKey, v))
+// ProfitTargetAmountEQ applies the EQ predicate on the "profitTargetAmount" field.
+func ProfitTargetAmountEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldProfitTargetAmount, v))
 }
 
-// ExchangeApiKeyNEQ applies the NEQ predicate on the "exchangeApiKey" field.
-func ExchangeApiKeyNEQ(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldNEQ(FieldExchangeApiKey, v))
+// ProfitTargetAmountNEQ applies the NEQ predicate on the "profitTargetAmount" field.
+func ProfitTargetAmountNEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldProfitTargetAmount, v))
 }
 
-// ExchangeApiKeyIn applies the In predicate on the "exchangeApiKey" field.
-func ExchangeApiKeyIn(vs ...string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldIn(FieldExchangeApiKey, vs...))
+// ProfitTargetAmountIn applies the In predicate on the "profitTargetAmount" field.
+func ProfitTargetAmountIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldProfitTargetAmount, vs...))
 }
 
-// ExchangeApiKeyNotIn applies the NotIn predicate on the "exchangeApiKey" field.
-func ExchangeApiKeyNotIn(vs ...string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldNotIn(FieldExchangeApiKey, vs...))
+// ProfitTargetAmountNotIn applies the NotIn predicate on the "profitTargetAmount" field.
+func ProfitTargetAmountNotIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldProfitTargetAmount, vs...))
 }
 
-// ExchangeApiKeyGT applies the GT predicate on the "exchangeApiKey" field.
-func ExchangeApiKeyGT(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldGT(FieldExchangeApiKey, v))
+// ProfitTargetAmountGT applies the GT predicate on the "profitTargetAmount" field.
+func ProfitTargetAmountGT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldProfitTargetAmount, v))
 }
 
-// ExchangeApiKeyGTE applies the GTE predicate on the "exchangeApiKey" field.
-func ExchangeApiKeyGTE(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldGTE(FieldExchangeApiKey, v))
+// ProfitTargetAmountGTE applies the GTE predicate on the "profitTargetAmount" field.
+func ProfitTargetAmountGTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldProfitTargetAmount, v))
 }
 
-// ExchangeApiKeyLT applies the LT predicate on the "exchangeApiKey" field.
-func ExchangeApiKeyLT(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldLT(FieldExchangeApiKey, v))
+// ProfitTargetAmountLT applies the LT predicate on the "profitTargetAmount" field.
+func ProfitTargetAmountLT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldProfitTargetAmount, v))
 }
 
-// ExchangeApiKeyLTE applies the LTE predicate on the "exchangeApiKey" field.
-func ExchangeApiKeyLTE(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldLTE(FieldExchangeApiKey, v))
+// ProfitTargetAmountLTE applies the LTE predicate on the "profitTargetAmount" field.
+func ProfitTargetAmountLTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldProfitTargetAmount, v))
 }
 
-// ExchangeApiKeyContains applies the Contains predicate on the "exchangeApiKey" field.
-func ExchangeApiKeyContains(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldContains(FieldExchangeApiKey, v))
+// ProfitTargetAmountContains applies the Contains predicate on the "profitTargetAmount" field.
+func ProfitTargetAmountContains(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContains(FieldProfitTargetAmount, vc))
 }
 
-// ExchangeApiKeyHasPrefix applies the HasPrefix predicate on the "exchangeApiKey" field.
-func ExchangeApiKeyHasPrefix(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldHasPrefix(FieldExchangeApiKey, v))
+// ProfitTargetAmountHasPrefix applies the HasPrefix predicate on the "profitTargetAmount" field.
+func ProfitTargetAmountHasPrefix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasPrefix(FieldProfitTargetAmount, vc))
 }
 
-// ExchangeApiKeyHasSuffix applies the HasSuffix predicate on the "exchangeApiKey" field.
-func ExchangeApiKeyHasSuffix(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldHasSuffix(FieldExchangeApiKey, v))
+// ProfitTargetAmountHasSuffix applies the HasSuffix predicate on the "profitTargetAmount" field.
+func ProfitTargetAmountHasSuffix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasSuffix(FieldProfitTargetAmount, vc))
 }
 
-// ExchangeApiKeyEqualFold applies the EqualFold predicate on the "exchangeApiKey" field.
-func ExchangeApiKeyEqualFold(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldEqualFold(FieldExchangeApiKey, v))
+// ProfitTargetAmountIsNil applies the IsNil predicate on the "profitTargetAmount" field.
+func ProfitTargetAmountIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldProfitTargetAmount))
 }
 
-// ExchangeApiKeyContainsFold applies the ContainsFold predicate on the "exchangeApiKey" field.
-func ExchangeApiKeyContainsFold(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldContainsFold(FieldExchangeApiKey, v))
+// ProfitTargetAmountNotNil applies the NotNil predicate on the "profitTargetAmount" field.
+func ProfitTargetAmountNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldProfitTargetAmount))
 }
 
-// ExchangeSecretKeyEQ applies the EQ predicate on the "exchangeSecretKey" field.
-func ExchangeSecretKeyEQ(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldEQ(FieldExchangeSecretKey, v))
+// ProfitTargetAmountEqualFold applies the EqualFold predicate on the "profitTargetAmount" field.
+func ProfitTargetAmountEqualFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldEqualFold(FieldProfitTargetAmount, vc))
 }
 
-// ExchangeSecretKeyNEQ applies the NEQ predicate on the "exchangeSecretKey" field.
-func ExchangeSecretKeyNEQ(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldNEQ(FieldExchangeSecretKey, v))
+// ProfitTargetAmountContainsFold applies the ContainsFold predicate on the "profitTargetAmount" field.
+func ProfitTargetAmountContainsFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContainsFold(FieldProfitTargetAmount, vc))
 }
 
-// ExchangeSecretKeyIn applies the In predicate on the "exchangeSecretKey" field.
-func ExchangeSecretKeyIn(vs ...string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldIn(FieldExchangeSecretKey, vs...))
+// TrailingStopLossAmountEQ applies the EQ predicate on the "trailingStopLossAmount" field.
+func TrailingStopLossAmountEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingStopLossAmount, v))
+}
+
+// TrailingStopLossAmountNEQ applies the NEQ predicate on the "trailingStopLossAmount" field.
+func TrailingStopLossAmountNEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldTrailingStopLossAmount, v))
+}
+
+// TrailingStopLossAmountIn applies the In predicate on the "trailingStopLossAmount" field.
+func TrailingStopLossAmountIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldTrailingStopLossAmount, vs...))
+}
+
+// TrailingStopLossAmountNotIn applies the NotIn predicate on the "trailingStopLossAmount" field.
+func TrailingStopLossAmountNotIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldTrailingStopLossAmount, vs...))
+}
+
+// TrailingStopLossAmountGT applies the GT predicate on the "trailingStopLossAmount" field.
+func TrailingStopLossAmountGT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldTrailingStopLossAmount, v))
+}
+
+// TrailingStopLossAmountGTE applies the GTE predicate on the "trailingStopLossAmount" field.
+func TrailingStopLossAmountGTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldTrailingStopLossAmount, v))
+}
+
+// TrailingStopLossAmountLT applies the LT predicate on the "trailingStopLossAmount" field.
+func TrailingStopLossAmountLT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldTrailingStopLossAmount, v))
+}
+
+// TrailingStopLossAmountLTE applies the LTE predicate on the "trailingStopLossAmount" field.
+func TrailingStopLossAmountLTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldTrailingStopLossAmount, v))
+}
+
+// TrailingStopLossAmountContains applies the Contains predicate on the "trailingStopLossAmount" field.
+func TrailingStopLossAmountContains(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContains(FieldTrailingStopLossAmount, vc))
+}
+
+// TrailingStopLossAmountHasPrefix applies the HasPrefix predicate on the "trailingStopLossAmount" field.
+func TrailingStopLossAmountHasPrefix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasPrefix(FieldTrailingStopLossAmount, vc))
+}
+
+// TrailingStopLossAmountHasSuffix applies the HasSuffix predicate on the "trailingStopLossAmount" field.
+func TrailingStopLossAmountHasSuffix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasSuffix(FieldTrailingStopLossAmount, vc))
+}
+
+// TrailingStopLossAmountIsNil applies the IsNil predicate on the "trailingStopLossAmount" field.
+func TrailingStopLossAmountIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldTrailingStopLossAmount))
+}
+
+// TrailingStopLossAmountNotNil applies the NotNil predicate on the "trailingStopLossAmount" field.
+func TrailingStopLossAmountNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldTrailingStopLossAmount))
+}
+
+// TrailingStopLossAmountEqualFold applies the EqualFold predicate on the "trailingStopLossAmount" field.
+func TrailingStopLossAmountEqualFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldEqualFold(FieldTrailingStopLossAmount, vc))
+}
+
+// TrailingStopLossAmountContainsFold applies the ContainsFold predicate on the "trailingStopLossAmount" field.
+func TrailingStopLossAmountContainsFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContainsFold(FieldTrailingStopLossAmount, vc))
+}
+
+// TrailingStopLossPercentEQ applies the EQ predicate on the "trailingStopLossPercent" field.
+func TrailingStopLossPercentEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingStopLossPercent, v))
+}
+
+// TrailingStopLossPercentNEQ applies the NEQ predicate on the "trailingStopLossPercent" field.
+func TrailingStopLossPercentNEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldTrailingStopLossPercent, v))
+}
+
+// TrailingStopLossPercentIn applies the In predicate on the "trailingStopLossPercent" field.
+func TrailingStopLossPercentIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldTrailingStopLossPercent, vs...))
+}
+
+// TrailingStopLossPercentNotIn applies the NotIn predicate on the "trailingStopLossPercent" field.
+func TrailingStopLossPercentNotIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldTrailingStopLossPercent, vs...))
+}
+
+// TrailingStopLossPercentGT applies the GT predicate on the "trailingStopLossPercent" field.
+func TrailingStopLossPercentGT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldTrailingStopLossPercent, v))
+}
+
+// TrailingStopLossPercentGTE applies the GTE predicate on the "trailingStopLossPercent" field.
+func TrailingStopLossPercentGTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldTrailingStopLossPercent, v))
+}
+
+// TrailingStopLossPercentLT applies the LT predicate on the "trailingStopLossPercent" field.
+func TrailingStopLossPercentLT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldTrailingStopLossPercent, v))
+}
+
+// TrailingStopLossPercentLTE applies the LTE predicate on the "trailingStopLossPercent" field.
+func TrailingStopLossPercentLTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldTrailingStopLossPercent, v))
+}
+
+// TrailingStopLossPercentContains applies the Contains predicate on the "trailingStopLossPercent" field.
+func TrailingStopLossPercentContains(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContains(FieldTrailingStopLossPercent, vc))
+}
+
+// TrailingStopLossPercentHasPrefix applies the HasPrefix predicate on the "trailingStopLossPercent" field.
+func TrailingStopLossPercentHasPrefix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasPrefix(FieldTrailingStopLossPercent, vc))
+}
+
+// TrailingStopLossPercentHasSuffix applies the HasSuffix predicate on the "trailingStopLossPercent" field.
+func TrailingStopLossPercentHasSuffix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasSuffix(FieldTrailingStopLossPercent, vc))
+}
+
+// TrailingStopLossPercentIsNil applies the IsNil predicate on the "trailingStopLossPercent" field.
+func TrailingStopLossPercentIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldTrailingStopLossPercent))
+}
+
+// TrailingStopLossPercentNotNil applies the NotNil predicate on the "trailingStopLossPercent" field.
+func TrailingStopLossPercentNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldTrailingStopLossPercent))
+}
+
+// TrailingStopLossPercentEqualFold applies the EqualFold predicate on the "trailingStopLossPercent" field.
+func TrailingStopLossPercentEqualFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldEqualFold(FieldTrailingStopLossPercent, vc))
+}
+
+// TrailingStopLossPercentContainsFold applies the ContainsFold predicate on the "trailingStopLossPercent" field.
+func TrailingStopLossPercentContainsFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContainsFold(FieldTrailingStopLossPercent, vc))
+}
+
+// TrailingTakeProfitActivationPriceEQ applies the EQ predicate on the "trailingTakeProfitActivationPrice" field.
+func TrailingTakeProfitActivationPriceEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingTakeProfitActivationPrice, v))
+}
+
+// TrailingTakeProfitActivationPriceNEQ applies the NEQ predicate on the "trailingTakeProfitActivationPrice" field.
+func TrailingTakeProfitActivationPriceNEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldTrailingTakeProfitActivationPrice, v))
+}
+
+// TrailingTakeProfitActivationPriceIn applies the In predicate on the "trailingTakeProfitActivationPrice" field.
+func TrailingTakeProfitActivationPriceIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldTrailingTakeProfitActivationPrice, vs...))
+}
+
+// TrailingTakeProfitActivationPriceNotIn applies the NotIn predicate on the "trailingTakeProfitActivationPrice" field.
+func TrailingTakeProfitActivationPriceNotIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldTrailingTakeProfitActivationPrice, vs...))
+}
+
+// TrailingTakeProfitActivationPriceGT applies the GT predicate on the "trailingTakeProfitActivationPrice" field.
+func TrailingTakeProfitActivationPriceGT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldTrailingTakeProfitActivationPrice, v))
+}
+
+// TrailingTakeProfitActivationPriceGTE applies the GTE predicate on the "trailingTakeProfitActivationPrice" field.
+func TrailingTakeProfitActivationPriceGTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldTrailingTakeProfitActivationPrice, v))
+}
+
+// TrailingTakeProfitActivationPriceLT applies the LT predicate on the "trailingTakeProfitActivationPrice" field.
+func TrailingTakeProfitActivationPriceLT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldTrailingTakeProfitActivationPrice, v))
+}
+
+// TrailingTakeProfitActivationPriceLTE applies the LTE predicate on the "trailingTakeProfitActivationPrice" field.
+func TrailingTakeProfitActivationPriceLTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldTrailingTakeProfitActivationPrice, v))
+}
+
+// TrailingTakeProfitActivationPriceContains applies the Contains predicate on the "trailingTakeProfitActivationPrice" field.
+func TrailingTakeProfitActivationPriceContains(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContains(FieldTrailingTakeProfitActivationPrice, vc))
+}
+
+// TrailingTakeProfitActivationPriceHasPrefix applies the HasPrefix predicate on the "trailingTakeProfitActivationPrice" field.
+func TrailingTakeProfitActivationPriceHasPrefix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasPrefix(FieldTrailingTakeProfitActivationPrice, vc))
+}
+
+// TrailingTakeProfitActivationPriceHasSuffix applies the HasSuffix predicate on the "trailingTakeProfitActivationPrice" field.
+func TrailingTakeProfitActivationPriceHasSuffix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasSuffix(FieldTrailingTakeProfitActivationPrice, vc))
+}
+
+// TrailingTakeProfitActivationPriceIsNil applies the IsNil predicate on the "trailingTakeProfitActivationPrice" field.
+func TrailingTakeProfitActivationPriceIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldTrailingTakeProfitActivationPrice))
+}
+
+// TrailingTakeProfitActivationPriceNotNil applies the NotNil predicate on the "trailingTakeProfitActivationPrice" field.
+func TrailingTakeProfitActivationPriceNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldTrailingTakeProfitActivationPrice))
+}
+
+// TrailingTakeProfitActivationPriceEqualFold applies the EqualFold predicate on the "trailingTakeProfitActivationPrice" field.
+func TrailingTakeProfitActivationPriceEqualFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldEqualFold(FieldTrailingTakeProfitActivationPrice, vc))
+}
+
+// TrailingTakeProfitActivationPriceContainsFold applies the ContainsFold predicate on the "trailingTakeProfitActivationPrice" field.
+func TrailingTakeProfitActivationPriceContainsFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContainsFold(FieldTrailingTakeProfitActivationPrice, vc))
+}
+
+// TrailingTakeProfitAmountEQ applies the EQ predicate on the "trailingTakeProfitAmount" field.
+func TrailingTakeProfitAmountEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingTakeProfitAmount, v))
+}
+
+// TrailingTakeProfitAmountNEQ applies the NEQ predicate on the "trailingTakeProfitAmount" field.
+func TrailingTakeProfitAmountNEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldTrailingTakeProfitAmount, v))
+}
+
+// TrailingTakeProfitAmountIn applies the In predicate on the "trailingTakeProfitAmount" field.
+func TrailingTakeProfitAmountIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldTrailingTakeProfitAmount, vs...))
+}
+
+// TrailingTakeProfitAmountNotIn applies the NotIn predicate on the "trailingTakeProfitAmount" field.
+func TrailingTakeProfitAmountNotIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldTrailingTakeProfitAmount, vs...))
+}
+
+// TrailingTakeProfitAmountGT applies the GT predicate on the "trailingTakeProfitAmount" field.
+func TrailingTakeProfitAmountGT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldTrailingTakeProfitAmount, v))
+}
+
+// TrailingTakeProfitAmountGTE applies the GTE predicate on the "trailingTakeProfitAmount" field.
+func TrailingTakeProfitAmountGTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldTrailingTakeProfitAmount, v))
+}
+
+// TrailingTakeProfitAmountLT applies the LT predicate on the "trailingTakeProfitAmount" field.
+func TrailingTakeProfitAmountLT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldTrailingTakeProfitAmount, v))
+}
+
+// TrailingTakeProfitAmountLTE applies the LTE predicate on the "trailingTakeProfitAmount" field.
+func TrailingTakeProfitAmountLTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldTrailingTakeProfitAmount, v))
+}
+
+// TrailingTakeProfitAmountContains applies the Contains predicate on the "trailingTakeProfitAmount" field.
+func TrailingTakeProfitAmountContains(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContains(FieldTrailingTakeProfitAmount, vc))
+}
+
+// TrailingTakeProfitAmountHasPrefix applies the HasPrefix predicate on the "trailingTakeProfitAmount" field.
+func TrailingTakeProfitAmountHasPrefix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasPrefix(FieldTrailingTakeProfitAmount, vc))
+}
+
+// TrailingTakeProfitAmountHasSuffix applies the HasSuffix predicate on the "trailingTakeProfitAmount" field.
+func TrailingTakeProfitAmountHasSuffix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasSuffix(FieldTrailingTakeProfitAmount, vc))
+}
+
+// TrailingTakeProfitAmountIsNil applies the IsNil predicate on the "trailingTakeProfitAmount" field.
+func TrailingTakeProfitAmountIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldTrailingTakeProfitAmount))
+}
+
+// TrailingTakeProfitAmountNotNil applies the NotNil predicate on the "trailingTakeProfitAmount" field.
+func TrailingTakeProfitAmountNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldTrailingTakeProfitAmount))
+}
+
+// TrailingTakeProfitAmountEqualFold applies the EqualFold predicate on the "trailingTakeProfitAmount" field.
+func TrailingTakeProfitAmountEqualFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldEqualFold(FieldTrailingTakeProfitAmount, vc))
+}
+
+// TrailingTakeProfitAmountContainsFold applies the ContainsFold predicate on the "trailingTakeProfitAmount" field.
+func TrailingTakeProfitAmountContainsFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContainsFold(FieldTrailingTakeProfitAmount, vc))
+}
+
+// TrailingTakeProfitPercentEQ applies the EQ predicate on the "trailingTakeProfitPercent" field.
+func TrailingTakeProfitPercentEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingTakeProfitPercent, v))
+}
+
+// TrailingTakeProfitPercentNEQ applies the NEQ predicate on the "trailingTakeProfitPercent" field.
+func TrailingTakeProfitPercentNEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldTrailingTakeProfitPercent, v))
+}
+
+// TrailingTakeProfitPercentIn applies the In predicate on the "trailingTakeProfitPercent" field.
+func TrailingTakeProfitPercentIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldTrailingTakeProfitPercent, vs...))
+}
+
+// TrailingTakeProfitPercentNotIn applies the NotIn predicate on the "trailingTakeProfitPercent" field.
+func TrailingTakeProfitPercentNotIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldTrailingTakeProfitPercent, vs...))
+}
+
+// TrailingTakeProfitPercentGT applies the GT predicate on the "trailingTakeProfitPercent" field.
+func TrailingTakeProfitPercentGT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldTrailingTakeProfitPercent, v))
+}
+
+// TrailingTakeProfitPercentGTE applies the GTE predicate on the "trailingTakeProfitPercent" field.
+func TrailingTakeProfitPercentGTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldTrailingTakeProfitPercent, v))
+}
+
+// TrailingTakeProfitPercentLT applies the LT predicate on the "trailingTakeProfitPercent" field.
+func TrailingTakeProfitPercentLT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldTrailingTakeProfitPercent, v))
+}
+
+// TrailingTakeProfitPercentLTE applies the LTE predicate on the "trailingTakeProfitPercent" field.
+func TrailingTakeProfitPercentLTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldTrailingTakeProfitPercent, v))
+}
+
+// TrailingTakeProfitPercentContains applies the Contains predicate on the "trailingTakeProfitPercent" field.
+func TrailingTakeProfitPercentContains(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContains(FieldTrailingTakeProfitPercent, vc))
+}
+
+// TrailingTakeProfitPercentHasPrefix applies the HasPrefix predicate on the "trailingTakeProfitPercent" field.
+func TrailingTakeProfitPercentHasPrefix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasPrefix(FieldTrailingTakeProfitPercent, vc))
+}
+
+// TrailingTakeProfitPercentHasSuffix applies the HasSuffix predicate on the "trailingTakeProfitPercent" field.
+func TrailingTakeProfitPercentHasSuffix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasSuffix(FieldTrailingTakeProfitPercent, vc))
+}
+
+// TrailingTakeProfitPercentIsNil applies the IsNil predicate on the "trailingTakeProfitPercent" field.
+func TrailingTakeProfitPercentIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldTrailingTakeProfitPercent))
+}
+
+// TrailingTakeProfitPercentNotNil applies the NotNil predicate on the "trailingTakeProfitPercent" field.
+func TrailingTakeProfitPercentNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldTrailingTakeProfitPercent))
+}
+
+// TrailingTakeProfitPercentEqualFold applies the EqualFold predicate on the "trailingTakeProfitPercent" field.
+func TrailingTakeProfitPercentEqualFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldEqualFold(FieldTrailingTakeProfitPercent, vc))
+}
+
+// TrailingTakeProfitPercentContainsFold applies the ContainsFold predicate on the "trailingTakeProfitPercent" field.
+func TrailingTakeProfitPercentContainsFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContainsFold(FieldTrailingTakeProfitPercent, vc))
+}
+
+// TrailingExtremePriceEQ applies the EQ predicate on the "trailingExtremePrice" field.
+func TrailingExtremePriceEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingExtremePrice, v))
+}
+
+// TrailingExtremePriceNEQ applies the NEQ predicate on the "trailingExtremePrice" field.
+func TrailingExtremePriceNEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldTrailingExtremePrice, v))
+}
+
+// TrailingExtremePriceIn applies the In predicate on the "trailingExtremePrice" field.
+func TrailingExtremePriceIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldTrailingExtremePrice, vs...))
+}
+
+// TrailingExtremePriceNotIn applies the NotIn predicate on the "trailingExtremePrice" field.
+func TrailingExtremePriceNotIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldTrailingExtremePrice, vs...))
+}
+
+// TrailingExtremePriceGT applies the GT predicate on the "trailingExtremePrice" field.
+func TrailingExtremePriceGT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldTrailingExtremePrice, v))
+}
+
+// TrailingExtremePriceGTE applies the GTE predicate on the "trailingExtremePrice" field.
+func TrailingExtremePriceGTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldTrailingExtremePrice, v))
+}
+
+// TrailingExtremePriceLT applies the LT predicate on the "trailingExtremePrice" field.
+func TrailingExtremePriceLT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldTrailingExtremePrice, v))
+}
+
+// TrailingExtremePriceLTE applies the LTE predicate on the "trailingExtremePrice" field.
+func TrailingExtremePriceLTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldTrailingExtremePrice, v))
+}
+
+// TrailingExtremePriceContains applies the Contains predicate on the "trailingExtremePrice" field.
+func TrailingExtremePriceContains(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContains(FieldTrailingExtremePrice, vc))
+}
+
+// TrailingExtremePriceHasPrefix applies the HasPrefix predicate on the "trailingExtremePrice" field.
+func TrailingExtremePriceHasPrefix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasPrefix(FieldTrailingExtremePrice, vc))
+}
+
+// TrailingExtremePriceHasSuffix applies the HasSuffix predicate on the "trailingExtremePrice" field.
+func TrailingExtremePriceHasSuffix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasSuffix(FieldTrailingExtremePrice, vc))
+}
+
+// TrailingExtremePriceIsNil applies the IsNil predicate on the "trailingExtremePrice" field.
+func TrailingExtremePriceIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldTrailingExtremePrice))
+}
+
+// TrailingExtremePriceNotNil applies the NotNil predicate on the "trailingExtremePrice" field.
+func TrailingExtremePriceNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldTrailingExtremePrice))
+}
+
+// TrailingExtremePriceEqualFold applies the EqualFold predicate on the "trailingExtremePrice" field.
+func TrailingExtremePriceEqualFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldEqualFold(FieldTrailingExtremePrice, vc))
+}
+
+// TrailingExtremePriceContainsFold applies the ContainsFold predicate on the "trailingExtremePrice" field.
+func TrailingExtremePriceContainsFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContainsFold(FieldTrailingExtremePrice, vc))
+}
+
+// TrailingLevelsEQ applies the EQ predicate on the "trailingLevels" field.
+func TrailingLevelsEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingLevels, v))
+}
+
+// TrailingLevelsNEQ applies the NEQ predicate on the "trailingLevels" field.
+func TrailingLevelsNEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldTrailingLevels, v))
+}
+
+// TrailingLevelsIn applies the In predicate on the "trailingLevels" field.
+func TrailingLevelsIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldTrailingLevels, vs...))
+}
+
+// TrailingLevelsNotIn applies the NotIn predicate on the "trailingLevels" field.
+func TrailingLevelsNotIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldTrailingLevels, vs...))
+}
+
+// TrailingLevelsGT applies the GT predicate on the "trailingLevels" field.
+func TrailingLevelsGT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldTrailingLevels, v))
+}
+
+// TrailingLevelsGTE applies the GTE predicate on the "trailingLevels" field.
+func TrailingLevelsGTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldTrailingLevels, v))
+}
+
+// TrailingLevelsLT applies the LT predicate on the "trailingLevels" field.
+func TrailingLevelsLT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldTrailingLevels, v))
+}
+
+// TrailingLevelsLTE applies the LTE predicate on the "trailingLevels" field.
+func TrailingLevelsLTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldTrailingLevels, v))
+}
+
+// TrailingLevelsIsNil applies the IsNil predicate on the "trailingLevels" field.
+func TrailingLevelsIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldTrailingLevels))
+}
+
+// TrailingLevelsNotNil applies the NotNil predicate on the "trailingLevels" field.
+func TrailingLevelsNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldTrailingLevels))
+}
+
+// TrailingPriceUpperLimitEQ applies the EQ predicate on the "trailingPriceUpperLimit" field.
+func TrailingPriceUpperLimitEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingPriceUpperLimit, v))
+}
+
+// TrailingPriceUpperLimitNEQ applies the NEQ predicate on the "trailingPriceUpperLimit" field.
+func TrailingPriceUpperLimitNEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldTrailingPriceUpperLimit, v))
+}
+
+// TrailingPriceUpperLimitIn applies the In predicate on the "trailingPriceUpperLimit" field.
+func TrailingPriceUpperLimitIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldTrailingPriceUpperLimit, vs...))
+}
+
+// TrailingPriceUpperLimitNotIn applies the NotIn predicate on the "trailingPriceUpperLimit" field.
+func TrailingPriceUpperLimitNotIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldTrailingPriceUpperLimit, vs...))
+}
+
+// TrailingPriceUpperLimitGT applies the GT predicate on the "trailingPriceUpperLimit" field.
+func TrailingPriceUpperLimitGT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldTrailingPriceUpperLimit, v))
+}
+
+// TrailingPriceUpperLimitGTE applies the GTE predicate on the "trailingPriceUpperLimit" field.
+func TrailingPriceUpperLimitGTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldTrailingPriceUpperLimit, v))
+}
+
+// TrailingPriceUpperLimitLT applies the LT predicate on the "trailingPriceUpperLimit" field.
+func TrailingPriceUpperLimitLT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldTrailingPriceUpperLimit, v))
+}
+
+// TrailingPriceUpperLimitLTE applies the LTE predicate on the "trailingPriceUpperLimit" field.
+func TrailingPriceUpperLimitLTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldTrailingPriceUpperLimit, v))
+}
+
+// TrailingPriceUpperLimitContains applies the Contains predicate on the "trailingPriceUpperLimit" field.
+func TrailingPriceUpperLimitContains(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContains(FieldTrailingPriceUpperLimit, vc))
+}
+
+// TrailingPriceUpperLimitHasPrefix applies the HasPrefix predicate on the "trailingPriceUpperLimit" field.
+func TrailingPriceUpperLimitHasPrefix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasPrefix(FieldTrailingPriceUpperLimit, vc))
+}
+
+// TrailingPriceUpperLimitHasSuffix applies the HasSuffix predicate on the "trailingPriceUpperLimit" field.
+func TrailingPriceUpperLimitHasSuffix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasSuffix(FieldTrailingPriceUpperLimit, vc))
+}
+
+// TrailingPriceUpperLimitIsNil applies the IsNil predicate on the "trailingPriceUpperLimit" field.
+func TrailingPriceUpperLimitIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldTrailingPriceUpperLimit))
+}
+
+// TrailingPriceUpperLimitNotNil applies the NotNil predicate on the "trailingPriceUpperLimit" field.
+func TrailingPriceUpperLimitNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldTrailingPriceUpperLimit))
+}
+
+// TrailingPriceUpperLimitEqualFold applies the EqualFold predicate on the "trailingPriceUpperLimit" field.
+func TrailingPriceUpperLimitEqualFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldEqualFold(FieldTrailingPriceUpperLimit, vc))
+}
+
+// TrailingPriceUpperLimitContainsFold applies the ContainsFold predicate on the "trailingPriceUpperLimit" field.
+func TrailingPriceUpperLimitContainsFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContainsFold(FieldTrailingPriceUpperLimit, vc))
+}
+
+// TrailingPriceLowerLimitEQ applies the EQ predicate on the "trailingPriceLowerLimit" field.
+func TrailingPriceLowerLimitEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTrailingPriceLowerLimit, v))
+}
+
+// TrailingPriceLowerLimitNEQ applies the NEQ predicate on the "trailingPriceLowerLimit" field.
+func TrailingPriceLowerLimitNEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldTrailingPriceLowerLimit, v))
+}
+
+// TrailingPriceLowerLimitIn applies the In predicate on the "trailingPriceLowerLimit" field.
+func TrailingPriceLowerLimitIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldTrailingPriceLowerLimit, vs...))
+}
+
+// TrailingPriceLowerLimitNotIn applies the NotIn predicate on the "trailingPriceLowerLimit" field.
+func TrailingPriceLowerLimitNotIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldTrailingPriceLowerLimit, vs...))
+}
+
+// TrailingPriceLowerLimitGT applies the GT predicate on the "trailingPriceLowerLimit" field.
+func TrailingPriceLowerLimitGT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldTrailingPriceLowerLimit, v))
+}
+
+// TrailingPriceLowerLimitGTE applies the GTE predicate on the "trailingPriceLowerLimit" field.
+func TrailingPriceLowerLimitGTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldTrailingPriceLowerLimit, v))
+}
+
+// TrailingPriceLowerLimitLT applies the LT predicate on the "trailingPriceLowerLimit" field.
+func TrailingPriceLowerLimitLT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldTrailingPriceLowerLimit, v))
+}
+
+// TrailingPriceLowerLimitLTE applies the LTE predicate on the "trailingPriceLowerLimit" field.
+func TrailingPriceLowerLimitLTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldTrailingPriceLowerLimit, v))
+}
+
+// TrailingPriceLowerLimitContains applies the Contains predicate on the "trailingPriceLowerLimit" field.
+func TrailingPriceLowerLimitContains(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContains(FieldTrailingPriceLowerLimit, vc))
+}
+
+// TrailingPriceLowerLimitHasPrefix applies the HasPrefix predicate on the "trailingPriceLowerLimit" field.
+func TrailingPriceLowerLimitHasPrefix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasPrefix(FieldTrailingPriceLowerLimit, vc))
+}
+
+// TrailingPriceLowerLimitHasSuffix applies the HasSuffix predicate on the "trailingPriceLowerLimit" field.
+func TrailingPriceLowerLimitHasSuffix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasSuffix(FieldTrailingPriceLowerLimit, vc))
+}
+
+// TrailingPriceLowerLimitIsNil applies the IsNil predicate on the "trailingPriceLowerLimit" field.
+func TrailingPriceLowerLimitIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldTrailingPriceLowerLimit))
+}
+
+// TrailingPriceLowerLimitNotNil applies the NotNil predicate on the "trailingPriceLowerLimit" field.
+func TrailingPriceLowerLimitNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldTrailingPriceLowerLimit))
+}
+
+// TrailingPriceLowerLimitEqualFold applies the EqualFold predicate on the "trailingPriceLowerLimit" field.
+func TrailingPriceLowerLimitEqualFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldEqualFold(FieldTrailingPriceLowerLimit, vc))
+}
+
+// TrailingPriceLowerLimitContainsFold applies the ContainsFold predicate on the "trailingPriceLowerLimit" field.
+func TrailingPriceLowerLimitContainsFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContainsFold(FieldTrailingPriceLowerLimit, vc))
+}
+
+// AtrPeriodEQ applies the EQ predicate on the "atrPeriod" field.
+func AtrPeriodEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldAtrPeriod, v))
+}
+
+// AtrPeriodNEQ applies the NEQ predicate on the "atrPeriod" field.
+func AtrPeriodNEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldAtrPeriod, v))
+}
+
+// AtrPeriodIn applies the In predicate on the "atrPeriod" field.
+func AtrPeriodIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldAtrPeriod, vs...))
+}
+
+// AtrPeriodNotIn applies the NotIn predicate on the "atrPeriod" field.
+func AtrPeriodNotIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldAtrPeriod, vs...))
+}
+
+// AtrPeriodGT applies the GT predicate on the "atrPeriod" field.
+func AtrPeriodGT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldAtrPeriod, v))
+}
+
+// AtrPeriodGTE applies the GTE predicate on the "atrPeriod" field.
+func AtrPeriodGTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldAtrPeriod, v))
+}
+
+// AtrPeriodLT applies the LT predicate on the "atrPeriod" field.
+func AtrPeriodLT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldAtrPeriod, v))
+}
+
+// AtrPeriodLTE applies the LTE predicate on the "atrPeriod" field.
+func AtrPeriodLTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldAtrPeriod, v))
+}
+
+// AtrPeriodIsNil applies the IsNil predicate on the "atrPeriod" field.
+func AtrPeriodIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldAtrPeriod))
+}
+
+// AtrPeriodNotNil applies the NotNil predicate on the "atrPeriod" field.
+func AtrPeriodNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldAtrPeriod))
+}
+
+// AtrMultiplierEQ applies the EQ predicate on the "atrMultiplier" field.
+func AtrMultiplierEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldAtrMultiplier, v))
+}
+
+// AtrMultiplierNEQ applies the NEQ predicate on the "atrMultiplier" field.
+func AtrMultiplierNEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldAtrMultiplier, v))
+}
+
+// AtrMultiplierIn applies the In predicate on the "atrMultiplier" field.
+func AtrMultiplierIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldAtrMultiplier, vs...))
+}
+
+// AtrMultiplierNotIn applies the NotIn predicate on the "atrMultiplier" field.
+func AtrMultiplierNotIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldAtrMultiplier, vs...))
+}
+
+// AtrMultiplierGT applies the GT predicate on the "atrMultiplier" field.
+func AtrMultiplierGT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldAtrMultiplier, v))
+}
+
+// AtrMultiplierGTE applies the GTE predicate on the "atrMultiplier" field.
+func AtrMultiplierGTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldAtrMultiplier, v))
+}
+
+// AtrMultiplierLT applies the LT predicate on the "atrMultiplier" field.
+func AtrMultiplierLT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldAtrMultiplier, v))
+}
+
+// AtrMultiplierLTE applies the LTE predicate on the "atrMultiplier" field.
+func AtrMultiplierLTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldAtrMultiplier, v))
+}
+
+// AtrMultiplierContains applies the Contains predicate on the "atrMultiplier" field.
+func AtrMultiplierContains(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContains(FieldAtrMultiplier, vc))
+}
+
+// AtrMultiplierHasPrefix applies the HasPrefix predicate on the "atrMultiplier" field.
+func AtrMultiplierHasPrefix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasPrefix(FieldAtrMultiplier, vc))
+}
+
+// AtrMultiplierHasSuffix applies the HasSuffix predicate on the "atrMultiplier" field.
+func AtrMultiplierHasSuffix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasSuffix(FieldAtrMultiplier, vc))
+}
+
+// AtrMultiplierIsNil applies the IsNil predicate on the "atrMultiplier" field.
+func AtrMultiplierIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldAtrMultiplier))
+}
+
+// AtrMultiplierNotNil applies the NotNil predicate on the "atrMultiplier" field.
+func AtrMultiplierNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldAtrMultiplier))
+}
+
+// AtrMultiplierEqualFold applies the EqualFold predicate on the "atrMultiplier" field.
+func AtrMultiplierEqualFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldEqualFold(FieldAtrMultiplier, vc))
+}
+
+// AtrMultiplierContainsFold applies the ContainsFold predicate on the "atrMultiplier" field.
+func AtrMultiplierContainsFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContainsFold(FieldAtrMultiplier, vc))
+}
+
+// AdaptiveThresholdEQ applies the EQ predicate on the "adaptiveThreshold" field.
+func AdaptiveThresholdEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldAdaptiveThreshold, v))
+}
+
+// AdaptiveThresholdNEQ applies the NEQ predicate on the "adaptiveThreshold" field.
+func AdaptiveThresholdNEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldAdaptiveThreshold, v))
+}
+
+// AdaptiveThresholdIn applies the In predicate on the "adaptiveThreshold" field.
+func AdaptiveThresholdIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldAdaptiveThreshold, vs...))
+}
+
+// AdaptiveThresholdNotIn applies the NotIn predicate on the "adaptiveThreshold" field.
+func AdaptiveThresholdNotIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldAdaptiveThreshold, vs...))
+}
+
+// AdaptiveThresholdGT applies the GT predicate on the "adaptiveThreshold" field.
+func AdaptiveThresholdGT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldAdaptiveThreshold, v))
+}
+
+// AdaptiveThresholdGTE applies the GTE predicate on the "adaptiveThreshold" field.
+func AdaptiveThresholdGTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldAdaptiveThreshold, v))
+}
+
+// AdaptiveThresholdLT applies the LT predicate on the "adaptiveThreshold" field.
+func AdaptiveThresholdLT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldAdaptiveThreshold, v))
+}
+
+// AdaptiveThresholdLTE applies the LTE predicate on the "adaptiveThreshold" field.
+func AdaptiveThresholdLTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldAdaptiveThreshold, v))
+}
+
+// AdaptiveThresholdContains applies the Contains predicate on the "adaptiveThreshold" field.
+func AdaptiveThresholdContains(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContains(FieldAdaptiveThreshold, vc))
+}
+
+// AdaptiveThresholdHasPrefix applies the HasPrefix predicate on the "adaptiveThreshold" field.
+func AdaptiveThresholdHasPrefix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasPrefix(FieldAdaptiveThreshold, vc))
+}
+
+// AdaptiveThresholdHasSuffix applies the HasSuffix predicate on the "adaptiveThreshold" field.
+func AdaptiveThresholdHasSuffix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasSuffix(FieldAdaptiveThreshold, vc))
+}
+
+// AdaptiveThresholdIsNil applies the IsNil predicate on the "adaptiveThreshold" field.
+func AdaptiveThresholdIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldAdaptiveThreshold))
+}
+
+// AdaptiveThresholdNotNil applies the NotNil predicate on the "adaptiveThreshold" field.
+func AdaptiveThresholdNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldAdaptiveThreshold))
+}
+
+// AdaptiveThresholdEqualFold applies the EqualFold predicate on the "adaptiveThreshold" field.
+func AdaptiveThresholdEqualFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldEqualFold(FieldAdaptiveThreshold, vc))
+}
+
+// AdaptiveThresholdContainsFold applies the ContainsFold predicate on the "adaptiveThreshold" field.
+func AdaptiveThresholdContainsFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContainsFold(FieldAdaptiveThreshold, vc))
+}
+
+// AdaptiveIntervalHoursEQ applies the EQ predicate on the "adaptiveIntervalHours" field.
+func AdaptiveIntervalHoursEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldAdaptiveIntervalHours, v))
+}
+
+// AdaptiveIntervalHoursNEQ applies the NEQ predicate on the "adaptiveIntervalHours" field.
+func AdaptiveIntervalHoursNEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldAdaptiveIntervalHours, v))
+}
+
+// AdaptiveIntervalHoursIn applies the In predicate on the "adaptiveIntervalHours" field.
+func AdaptiveIntervalHoursIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldAdaptiveIntervalHours, vs...))
+}
+
+// AdaptiveIntervalHoursNotIn applies the NotIn predicate on the "adaptiveIntervalHours" field.
+func AdaptiveIntervalHoursNotIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldAdaptiveIntervalHours, vs...))
+}
+
+// AdaptiveIntervalHoursGT applies the GT predicate on the "adaptiveIntervalHours" field.
+func AdaptiveIntervalHoursGT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldAdaptiveIntervalHours, v))
+}
+
+// AdaptiveIntervalHoursGTE applies the GTE predicate on the "adaptiveIntervalHours" field.
+func AdaptiveIntervalHoursGTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldAdaptiveIntervalHours, v))
+}
+
+// AdaptiveIntervalHoursLT applies the LT predicate on the "adaptiveIntervalHours" field.
+func AdaptiveIntervalHoursLT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldAdaptiveIntervalHours, v))
+}
+
+// AdaptiveIntervalHoursLTE applies the LTE predicate on the "adaptiveIntervalHours" field.
+func AdaptiveIntervalHoursLTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldAdaptiveIntervalHours, v))
+}
+
+// AdaptiveIntervalHoursIsNil applies the IsNil predicate on the "adaptiveIntervalHours" field.
+func AdaptiveIntervalHoursIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldAdaptiveIntervalHours))
+}
+
+// AdaptiveIntervalHoursNotNil applies the NotNil predicate on the "adaptiveIntervalHours" field.
+func AdaptiveIntervalHoursNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldAdaptiveIntervalHours))
+}
+
+// AdaptiveAtrEQ applies the EQ predicate on the "adaptiveAtr" field.
+func AdaptiveAtrEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldAdaptiveAtr, v))
+}
+
+// AdaptiveAtrNEQ applies the NEQ predicate on the "adaptiveAtr" field.
+func AdaptiveAtrNEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldAdaptiveAtr, v))
+}
+
+// AdaptiveAtrIn applies the In predicate on the "adaptiveAtr" field.
+func AdaptiveAtrIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldAdaptiveAtr, vs...))
+}
+
+// AdaptiveAtrNotIn applies the NotIn predicate on the "adaptiveAtr" field.
+func AdaptiveAtrNotIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldAdaptiveAtr, vs...))
+}
+
+// AdaptiveAtrGT applies the GT predicate on the "adaptiveAtr" field.
+func AdaptiveAtrGT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldAdaptiveAtr, v))
+}
+
+// AdaptiveAtrGTE applies the GTE predicate on the "adaptiveAtr" field.
+func AdaptiveAtrGTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldAdaptiveAtr, v))
+}
+
+// AdaptiveAtrLT applies the LT predicate on the "adaptiveAtr" field.
+func AdaptiveAtrLT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldAdaptiveAtr, v))
+}
+
+// AdaptiveAtrLTE applies the LTE predicate on the "adaptiveAtr" field.
+func AdaptiveAtrLTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldAdaptiveAtr, v))
+}
+
+// AdaptiveAtrContains applies the Contains predicate on the "adaptiveAtr" field.
+func AdaptiveAtrContains(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContains(FieldAdaptiveAtr, vc))
+}
+
+// AdaptiveAtrHasPrefix applies the HasPrefix predicate on the "adaptiveAtr" field.
+func AdaptiveAtrHasPrefix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasPrefix(FieldAdaptiveAtr, vc))
+}
+
+// AdaptiveAtrHasSuffix applies the HasSuffix predicate on the "adaptiveAtr" field.
+func AdaptiveAtrHasSuffix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasSuffix(FieldAdaptiveAtr, vc))
+}
+
+// AdaptiveAtrIsNil applies the IsNil predicate on the "adaptiveAtr" field.
+func AdaptiveAtrIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldAdaptiveAtr))
+}
+
+// AdaptiveAtrNotNil applies the NotNil predicate on the "adaptiveAtr" field.
+func AdaptiveAtrNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldAdaptiveAtr))
+}
+
+// AdaptiveAtrEqualFold applies the EqualFold predicate on the "adaptiveAtr" field.
+func AdaptiveAtrEqualFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldEqualFold(FieldAdaptiveAtr, vc))
+}
+
+// AdaptiveAtrContainsFold applies the ContainsFold predicate on the "adaptiveAtr" field.
+func AdaptiveAtrContainsFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContainsFold(FieldAdaptiveAtr, vc))
+}
+
+// AdaptiveUpdatedAtEQ applies the EQ predicate on the "adaptiveUpdatedAt" field.
+func AdaptiveUpdatedAtEQ(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldAdaptiveUpdatedAt, v))
+}
+
+// AdaptiveUpdatedAtNEQ applies the NEQ predicate on the "adaptiveUpdatedAt" field.
+func AdaptiveUpdatedAtNEQ(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldAdaptiveUpdatedAt, v))
+}
+
+// AdaptiveUpdatedAtIn applies the In predicate on the "adaptiveUpdatedAt" field.
+func AdaptiveUpdatedAtIn(vs ...time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldAdaptiveUpdatedAt, vs...))
+}
+
+// AdaptiveUpdatedAtNotIn applies the NotIn predicate on the "adaptiveUpdatedAt" field.
+func AdaptiveUpdatedAtNotIn(vs ...time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldAdaptiveUpdatedAt, vs...))
+}
+
+// AdaptiveUpdatedAtGT applies the GT predicate on the "adaptiveUpdatedAt" field.
+func AdaptiveUpdatedAtGT(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldAdaptiveUpdatedAt, v))
+}
+
+// AdaptiveUpdatedAtGTE applies the GTE predicate on the "adaptiveUpdatedAt" field.
+func AdaptiveUpdatedAtGTE(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldAdaptiveUpdatedAt, v))
+}
+
+// AdaptiveUpdatedAtLT applies the LT predicate on the "adaptiveUpdatedAt" field.
+func AdaptiveUpdatedAtLT(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldAdaptiveUpdatedAt, v))
+}
+
+// AdaptiveUpdatedAtLTE applies the LTE predicate on the "adaptiveUpdatedAt" field.
+func AdaptiveUpdatedAtLTE(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldAdaptiveUpdatedAt, v))
+}
+
+// AdaptiveUpdatedAtIsNil applies the IsNil predicate on the "adaptiveUpdatedAt" field.
+func AdaptiveUpdatedAtIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldAdaptiveUpdatedAt))
+}
+
+// AdaptiveUpdatedAtNotNil applies the NotNil predicate on the "adaptiveUpdatedAt" field.
+func AdaptiveUpdatedAtNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldAdaptiveUpdatedAt))
+}
+
+// CancelRepairPolicyEQ applies the EQ predicate on the "cancelRepairPolicy" field.
+func CancelRepairPolicyEQ(v CancelRepairPolicy) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldCancelRepairPolicy, v))
+}
+
+// CancelRepairPolicyNEQ applies the NEQ predicate on the "cancelRepairPolicy" field.
+func CancelRepairPolicyNEQ(v CancelRepairPolicy) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldCancelRepairPolicy, v))
+}
+
+// CancelRepairPolicyIn applies the In predicate on the "cancelRepairPolicy" field.
+func CancelRepairPolicyIn(vs ...CancelRepairPolicy) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldCancelRepairPolicy, vs...))
+}
+
+// CancelRepairPolicyNotIn applies the NotIn predicate on the "cancelRepairPolicy" field.
+func CancelRepairPolicyNotIn(vs ...CancelRepairPolicy) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldCancelRepairPolicy, vs...))
+}
+
+// CancelRepairMaxAttemptsEQ applies the EQ predicate on the "cancelRepairMaxAttempts" field.
+func CancelRepairMaxAttemptsEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldCancelRepairMaxAttempts, v))
+}
+
+// CancelRepairMaxAttemptsNEQ applies the NEQ predicate on the "cancelRepairMaxAttempts" field.
+func CancelRepairMaxAttemptsNEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldCancelRepairMaxAttempts, v))
+}
+
+// CancelRepairMaxAttemptsIn applies the In predicate on the "cancelRepairMaxAttempts" field.
+func CancelRepairMaxAttemptsIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldCancelRepairMaxAttempts, vs...))
+}
+
+// CancelRepairMaxAttemptsNotIn applies the NotIn predicate on the "cancelRepairMaxAttempts" field.
+func CancelRepairMaxAttemptsNotIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldCancelRepairMaxAttempts, vs...))
+}
+
+// CancelRepairMaxAttemptsGT applies the GT predicate on the "cancelRepairMaxAttempts" field.
+func CancelRepairMaxAttemptsGT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldCancelRepairMaxAttempts, v))
+}
+
+// CancelRepairMaxAttemptsGTE applies the GTE predicate on the "cancelRepairMaxAttempts" field.
+func CancelRepairMaxAttemptsGTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldCancelRepairMaxAttempts, v))
+}
+
+// CancelRepairMaxAttemptsLT applies the LT predicate on the "cancelRepairMaxAttempts" field.
+func CancelRepairMaxAttemptsLT(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldCancelRepairMaxAttempts, v))
+}
+
+// CancelRepairMaxAttemptsLTE applies the LTE predicate on the "cancelRepairMaxAttempts" field.
+func CancelRepairMaxAttemptsLTE(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldCancelRepairMaxAttempts, v))
+}
+
+// CancelRepairMaxAttemptsIsNil applies the IsNil predicate on the "cancelRepairMaxAttempts" field.
+func CancelRepairMaxAttemptsIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldCancelRepairMaxAttempts))
+}
+
+// CancelRepairMaxAttemptsNotNil applies the NotNil predicate on the "cancelRepairMaxAttempts" field.
+func CancelRepairMaxAttemptsNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldCancelRepairMaxAttempts))
+}
+
+// ReconcilePolicyEQ applies the EQ predicate on the "reconcilePolicy" field.
+func ReconcilePolicyEQ(v ReconcilePolicy) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldReconcilePolicy, v))
+}
+
+// ReconcilePolicyNEQ applies the NEQ predicate on the "reconcilePolicy" field.
+func ReconcilePolicyNEQ(v ReconcilePolicy) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldReconcilePolicy, v))
+}
+
+// ReconcilePolicyIn applies the In predicate on the "reconcilePolicy" field.
+func ReconcilePolicyIn(vs ...ReconcilePolicy) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldReconcilePolicy, vs...))
+}
+
+// ReconcilePolicyNotIn applies the NotIn predicate on the "reconcilePolicy" field.
+func ReconcilePolicyNotIn(vs ...ReconcilePolicy) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldReconcilePolicy, vs...))
+}
+
+// PartialFillThresholdEQ applies the EQ predicate on the "partialFillThreshold" field.
+func PartialFillThresholdEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldPartialFillThreshold, v))
+}
+
+// PartialFillThresholdNEQ applies the NEQ predicate on the "partialFillThreshold" field.
+func PartialFillThresholdNEQ(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldPartialFillThreshold, v))
+}
+
+// PartialFillThresholdIn applies the In predicate on the "partialFillThreshold" field.
+func PartialFillThresholdIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldPartialFillThreshold, vs...))
+}
+
+// PartialFillThresholdNotIn applies the NotIn predicate on the "partialFillThreshold" field.
+func PartialFillThresholdNotIn(vs ...decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldPartialFillThreshold, vs...))
+}
+
+// PartialFillThresholdGT applies the GT predicate on the "partialFillThreshold" field.
+func PartialFillThresholdGT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldPartialFillThreshold, v))
+}
+
+// PartialFillThresholdGTE applies the GTE predicate on the "partialFillThreshold" field.
+func PartialFillThresholdGTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldPartialFillThreshold, v))
+}
+
+// PartialFillThresholdLT applies the LT predicate on the "partialFillThreshold" field.
+func PartialFillThresholdLT(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldPartialFillThreshold, v))
+}
+
+// PartialFillThresholdLTE applies the LTE predicate on the "partialFillThreshold" field.
+func PartialFillThresholdLTE(v decimal.Decimal) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldPartialFillThreshold, v))
+}
+
+// PartialFillThresholdContains applies the Contains predicate on the "partialFillThreshold" field.
+func PartialFillThresholdContains(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContains(FieldPartialFillThreshold, vc))
+}
+
+// PartialFillThresholdHasPrefix applies the HasPrefix predicate on the "partialFillThreshold" field.
+func PartialFillThresholdHasPrefix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasPrefix(FieldPartialFillThreshold, vc))
+}
+
+// PartialFillThresholdHasSuffix applies the HasSuffix predicate on the "partialFillThreshold" field.
+func PartialFillThresholdHasSuffix(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldHasSuffix(FieldPartialFillThreshold, vc))
+}
+
+// PartialFillThresholdIsNil applies the IsNil predicate on the "partialFillThreshold" field.
+func PartialFillThresholdIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldPartialFillThreshold))
+}
+
+// PartialFillThresholdNotNil applies the NotNil predicate on the "partialFillThreshold" field.
+func PartialFillThresholdNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldPartialFillThreshold))
+}
+
+// PartialFillThresholdEqualFold applies the EqualFold predicate on the "partialFillThreshold" field.
+func PartialFillThresholdEqualFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldEqualFold(FieldPartialFillThreshold, vc))
+}
+
+// PartialFillThresholdContainsFold applies the ContainsFold predicate on the "partialFillThreshold" field.
+func PartialFillThresholdContainsFold(v decimal.Decimal) predicate.Strategy {
+	vc := v.String()
+	return predicate.Strategy(sql.FieldContainsFold(FieldPartialFillThreshold, vc))
+}
+
+// EnablePushNotificationEQ applies the EQ predicate on the "enablePushNotification" field.
+func EnablePushNotificationEQ(v bool) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldEnablePushNotification, v))
+}
+
+// EnablePushNotificationNEQ applies the NEQ predicate on the "enablePushNotification" field.
+func EnablePushNotificationNEQ(v bool) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldEnablePushNotification, v))
+}
+
+// EnablePushMatchedNotificationEQ applies the EQ predicate on the "enablePushMatchedNotification" field.
+func EnablePushMatchedNotificationEQ(v bool) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldEnablePushMatchedNotification, v))
+}
+
+// EnablePushMatchedNotificationNEQ applies the NEQ predicate on the "enablePushMatchedNotification" field.
+func EnablePushMatchedNotificationNEQ(v bool) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldEnablePushMatchedNotification, v))
+}
+
+// EnablePushMatchedNotificationIsNil applies the IsNil predicate on the "enablePushMatchedNotification" field.
+func EnablePushMatchedNotificationIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldEnablePushMatchedNotification))
+}
+
+// EnablePushMatchedNotificationNotNil applies the NotNil predicate on the "enablePushMatchedNotification" field.
+func EnablePushMatchedNotificationNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldEnablePushMatchedNotification))
+}
+
+// LastLowerThresholdAlertTimeEQ applies the EQ predicate on the "lastLowerThresholdAlertTime" field.
+func LastLowerThresholdAlertTimeEQ(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldLastLowerThresholdAlertTime, v))
+}
+
+// LastLowerThresholdAlertTimeNEQ applies the NEQ predicate on the "lastLowerThresholdAlertTime" field.
+func LastLowerThresholdAlertTimeNEQ(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldLastLowerThresholdAlertTime, v))
+}
+
+// LastLowerThresholdAlertTimeIn applies the In predicate on the "lastLowerThresholdAlertTime" field.
+func LastLowerThresholdAlertTimeIn(vs ...time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldLastLowerThresholdAlertTime, vs...))
+}
+
+// LastLowerThresholdAlertTimeNotIn applies the NotIn predicate on the "lastLowerThresholdAlertTime" field.
+func LastLowerThresholdAlertTimeNotIn(vs ...time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldLastLowerThresholdAlertTime, vs...))
+}
+
+// LastLowerThresholdAlertTimeGT applies the GT predicate on the "lastLowerThresholdAlertTime" field.
+func LastLowerThresholdAlertTimeGT(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldLastLowerThresholdAlertTime, v))
+}
+
+// LastLowerThresholdAlertTimeGTE applies the GTE predicate on the "lastLowerThresholdAlertTime" field.
+func LastLowerThresholdAlertTimeGTE(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldLastLowerThresholdAlertTime, v))
+}
+
+// LastLowerThresholdAlertTimeLT applies the LT predicate on the "lastLowerThresholdAlertTime" field.
+func LastLowerThresholdAlertTimeLT(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldLastLowerThresholdAlertTime, v))
+}
+
+// LastLowerThresholdAlertTimeLTE applies the LTE predicate on the "lastLowerThresholdAlertTime" field.
+func LastLowerThresholdAlertTimeLTE(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldLastLowerThresholdAlertTime, v))
+}
+
+// LastLowerThresholdAlertTimeIsNil applies the IsNil predicate on the "lastLowerThresholdAlertTime" field.
+func LastLowerThresholdAlertTimeIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldLastLowerThresholdAlertTime))
+}
+
+// LastLowerThresholdAlertTimeNotNil applies the NotNil predicate on the "lastLowerThresholdAlertTime" field.
+func LastLowerThresholdAlertTimeNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldLastLowerThresholdAlertTime))
+}
+
+// LastUpperThresholdAlertTimeEQ applies the EQ predicate on the "lastUpperThresholdAlertTime" field.
+func LastUpperThresholdAlertTimeEQ(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldLastUpperThresholdAlertTime, v))
+}
+
+// LastUpperThresholdAlertTimeNEQ applies the NEQ predicate on the "lastUpperThresholdAlertTime" field.
+func LastUpperThresholdAlertTimeNEQ(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldLastUpperThresholdAlertTime, v))
+}
+
+// LastUpperThresholdAlertTimeIn applies the In predicate on the "lastUpperThresholdAlertTime" field.
+func LastUpperThresholdAlertTimeIn(vs ...time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldLastUpperThresholdAlertTime, vs...))
+}
+
+// LastUpperThresholdAlertTimeNotIn applies the NotIn predicate on the "lastUpperThresholdAlertTime" field.
+func LastUpperThresholdAlertTimeNotIn(vs ...time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldLastUpperThresholdAlertTime, vs...))
+}
+
+// LastUpperThresholdAlertTimeGT applies the GT predicate on the "lastUpperThresholdAlertTime" field.
+func LastUpperThresholdAlertTimeGT(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldLastUpperThresholdAlertTime, v))
+}
+
+// LastUpperThresholdAlertTimeGTE applies the GTE predicate on the "lastUpperThresholdAlertTime" field.
+func LastUpperThresholdAlertTimeGTE(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldLastUpperThresholdAlertTime, v))
+}
+
+// LastUpperThresholdAlertTimeLT applies the LT predicate on the "lastUpperThresholdAlertTime" field.
+func LastUpperThresholdAlertTimeLT(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldLastUpperThresholdAlertTime, v))
+}
+
+// LastUpperThresholdAlertTimeLTE applies the LTE predicate on the "lastUpperThresholdAlertTime" field.
+func LastUpperThresholdAlertTimeLTE(v time.Time) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldLastUpperThresholdAlertTime, v))
+}
+
+// LastUpperThresholdAlertTimeIsNil applies the IsNil predicate on the "lastUpperThresholdAlertTime" field.
+func LastUpperThresholdAlertTimeIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldLastUpperThresholdAlertTime))
+}
+
+// LastUpperThresholdAlertTimeNotNil applies the NotNil predicate on the "lastUpperThresholdAlertTime" field.
+func LastUpperThresholdAlertTimeNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldLastUpperThresholdAlertTime))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ExchangeApiKeyEQ applies the EQ predicate on the "exchangeApiKey" field.
+func ExchangeApiKeyEQ(v string) predicate.Strategy {
+	vc, err := ValueScanner.ExchangeApiKey.Value(v)
+	return predicate.StrategyOrErr(sql.FieldEQ(FieldExchangeApiKey, vc), err)
+}
+
+// ExchangeApiKeyNEQ applies the NEQ predicate on the "exchangeApiKey" field.
+func ExchangeApiKeyNEQ(v string) predicate.Strategy {
+	vc, err := ValueScanner.ExchangeApiKey.Value(v)
+	return predicate.StrategyOrErr(sql.FieldNEQ(FieldExchangeApiKey, vc), err)
+}
+
+// ExchangeApiKeyIn applies the In predicate on the "exchangeApiKey" field.
+func ExchangeApiKeyIn(vs ...string) predicate.Strategy {
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.ExchangeApiKey.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.StrategyOrErr(sql.FieldIn(FieldExchangeApiKey, v...), err)
+}
+
+// ExchangeApiKeyNotIn applies the NotIn predicate on the "exchangeApiKey" field.
+func ExchangeApiKeyNotIn(vs ...string) predicate.Strategy {
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.ExchangeApiKey.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.StrategyOrErr(sql.FieldNotIn(FieldExchangeApiKey, v...), err)
+}
+
+// ExchangeApiKeyGT applies the GT predicate on the "exchangeApiKey" field.
+func ExchangeApiKeyGT(v string) predicate.Strategy {
+	vc, err := ValueScanner.ExchangeApiKey.Value(v)
+	return predicate.StrategyOrErr(sql.FieldGT(FieldExchangeApiKey, vc), err)
+}
+
+// ExchangeApiKeyGTE applies the GTE predicate on the "exchangeApiKey" field.
+func ExchangeApiKeyGTE(v string) predicate.Strategy {
+	vc, err := ValueScanner.ExchangeApiKey.Value(v)
+	return predicate.StrategyOrErr(sql.FieldGTE(FieldExchangeApiKey, vc), err)
+}
+
+// ExchangeApiKeyLT applies the LT predicate on the "exchangeApiKey" field.
+func ExchangeApiKeyLT(v string) predicate.Strategy {
+	vc, err := ValueScanner.ExchangeApiKey.Value(v)
+	return predicate.StrategyOrErr(sql.FieldLT(FieldExchangeApiKey, vc), err)
+}
+
+// ExchangeApiKeyLTE applies the LTE predicate on the "exchangeApiKey" field.
+func ExchangeApiKeyLTE(v string) predicate.Strategy {
+	vc, err := ValueScanner.ExchangeApiKey.Value(v)
+	return predicate.StrategyOrErr(sql.FieldLTE(FieldExchangeApiKey, vc), err)
+}
+
+// ExchangeApiKeyContains applies the Contains predicate on the "exchangeApiKey" field.
+func ExchangeApiKeyContains(v string) predicate.Strategy {
+	vc, err := ValueScanner.ExchangeApiKey.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("exchangeApiKey value is not a string: %T", vc)
+	}
+	return predicate.StrategyOrErr(sql.FieldContains(FieldExchangeApiKey, vcs), err)
+}
+
+// ExchangeApiKeyHasPrefix applies the HasPrefix predicate on the "exchangeApiKey" field.
+func ExchangeApiKeyHasPrefix(v string) predicate.Strategy {
+	vc, err := ValueScanner.ExchangeApiKey.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("exchangeApiKey value is not a string: %T", vc)
+	}
+	return predicate.StrategyOrErr(sql.FieldHasPrefix(FieldExchangeApiKey, vcs), err)
+}
+
+// ExchangeApiKeyHasSuffix applies the HasSuffix predicate on the "exchangeApiKey" field.
+func ExchangeApiKeyHasSuffix(v string) predicate.Strategy {
+	vc, err := ValueScanner.ExchangeApiKey.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("exchangeApiKey value is not a string: %T", vc)
+	}
+	return predicate.StrategyOrErr(sql.FieldHasSuffix(FieldExchangeApiKey, vcs), err)
+}
+
+// ExchangeApiKeyEqualFold applies the EqualFold predicate on the "exchangeApiKey" field.
+func ExchangeApiKeyEqualFold(v string) predicate.Strategy {
+	vc, err := ValueScanner.ExchangeApiKey.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("exchangeApiKey value is not a string: %T", vc)
+	}
+	return predicate.StrategyOrErr(sql.FieldEqualFold(FieldExchangeApiKey, vcs), err)
+}
+
+// ExchangeApiKeyContainsFold applies the ContainsFold predicate on the "exchangeApiKey" field.
+func ExchangeApiKeyContainsFold(v string) predicate.Strategy {
+	vc, err := ValueScanner.ExchangeApiKey.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("exchangeApiKey value is not a string: %T", vc)
+	}
+	return predicate.StrategyOrErr(sql.FieldContainsFold(FieldExchangeApiKey, vcs), err)
+}
+
+// ExchangeSecretKeyEQ applies the EQ predicate on the "exchangeSecretKey" field.
+func ExchangeSecretKeyEQ(v string) predicate.Strategy {
+	vc, err := ValueScanner.ExchangeSecretKey.Value(v)
+	return predicate.StrategyOrErr(sql.FieldEQ(FieldExchangeSecretKey, vc), err)
+}
+
+// ExchangeSecretKeyNEQ applies the NEQ predicate on the "exchangeSecretKey" field.
+func ExchangeSecretKeyNEQ(v string) predicate.Strategy {
+	vc, err := ValueScanner.ExchangeSecretKey.Value(v)
+	return predicate.StrategyOrErr(sql.FieldNEQ(FieldExchangeSecretKey, vc), err)
+}
+
+// ExchangeSecretKeyIn applies the In predicate on the "exchangeSecretKey" field.
+func ExchangeSecretKeyIn(vs ...string) predicate.Strategy {
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.ExchangeSecretKey.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.StrategyOrErr(sql.FieldIn(FieldExchangeSecretKey, v...), err)
 }
 
 // ExchangeSecretKeyNotIn applies the NotIn predicate on the "exchangeSecretKey" field.
 func ExchangeSecretKeyNotIn(vs ...string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldNotIn(FieldExchangeSecretKey, vs...))
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.ExchangeSecretKey.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.StrategyOrErr(sql.FieldNotIn(FieldExchangeSecretKey, v...), err)
 }
 
 // ExchangeSecretKeyGT applies the GT predicate on the "exchangeSecretKey" field.
 func ExchangeSecretKeyGT(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldGT(FieldExchangeSecretKey, v))
+	vc, err := ValueScanner.ExchangeSecretKey.Value(v)
+	return predicate.StrategyOrErr(sql.FieldGT(FieldExchangeSecretKey, vc), err)
 }
 
 // ExchangeSecretKeyGTE applies the GTE predicate on the "exchangeSecretKey" field.
 func ExchangeSecretKeyGTE(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldGTE(FieldExchangeSecretKey, v))
+	vc, err := ValueScanner.ExchangeSecretKey.Value(v)
+	return predicate.StrategyOrErr(sql.FieldGTE(FieldExchangeSecretKey, vc), err)
 }
 
 // ExchangeSecretKeyLT applies the LT predicate on the "exchangeSecretKey" field.
 func ExchangeSecretKeyLT(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldLT(FieldExchangeSecretKey, v))
+	vc, err := ValueScanner.ExchangeSecretKey.Value(v)
+	return predicate.StrategyOrErr(sql.FieldLT(FieldExchangeSecretKey, vc), err)
 }
 
 // ExchangeSecretKeyLTE applies the LTE predicate on the "exchangeSecretKey" field.
 func ExchangeSecretKeyLTE(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldLTE(FieldExchangeSecretKey, v))
+	vc, err := ValueScanner.ExchangeSecretKey.Value(v)
+	return predicate.StrategyOrErr(sql.FieldLTE(FieldExchangeSecretKey, vc), err)
 }
 
 // ExchangeSecretKeyContains applies the Contains predicate on the "exchangeSecretKey" field.
 func ExchangeSecretKeyContains(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldContains(FieldExchangeSecretKey, v))
+	vc, err := ValueScanner.ExchangeSecretKey.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("exchangeSecretKey value is not a string: %T", vc)
+	}
+	return predicate.StrategyOrErr(sql.FieldContains(FieldExchangeSecretKey, vcs), err)
 }
 
 // ExchangeSecretKeyHasPrefix applies the HasPrefix predicate on the "exchangeSecretKey" field.
 func ExchangeSecretKeyHasPrefix(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldHasPrefix(FieldExchangeSecretKey, v))
+	vc, err := ValueScanner.ExchangeSecretKey.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("exchangeSecretKey value is not a string: %T", vc)
+	}
+	return predicate.StrategyOrErr(sql.FieldHasPrefix(FieldExchangeSecretKey, vcs), err)
 }
 
 // ExchangeSecretKeyHasSuffix applies the HasSuffix predicate on the "exchangeSecretKey" field.
 func ExchangeSecretKeyHasSuffix(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldHasSuffix(FieldExchangeSecretKey, v))
+	vc, err := ValueScanner.ExchangeSecretKey.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("exchangeSecretKey value is not a string: %T", vc)
+	}
+	return predicate.StrategyOrErr(sql.FieldHasSuffix(FieldExchangeSecretKey, vcs), err)
 }
 
 // ExchangeSecretKeyEqualFold applies the EqualFold predicate on the "exchangeSecretKey" field.
 func ExchangeSecretKeyEqualFold(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldEqualFold(FieldExchangeSecretKey, v))
+	vc, err := ValueScanner.ExchangeSecretKey.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("exchangeSecretKey value is not a string: %T", vc)
+	}
+	return predicate.StrategyOrErr(sql.FieldEqualFold(FieldExchangeSecretKey, vcs), err)
 }
 
 // ExchangeSecretKeyContainsFold applies the ContainsFold predicate on the "exchangeSecretKey" field.
 func ExchangeSecretKeyContainsFold(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldContainsFold(FieldExchangeSecretKey, v))
+	vc, err := ValueScanner.ExchangeSecretKey.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("exchangeSecretKey value is not a string: %T", vc)
+	}
+	return predicate.StrategyOrErr(sql.FieldContainsFold(FieldExchangeSecretKey, vcs), err)
 }
 
 // ExchangePassphraseEQ applies the EQ predicate on the "exchangePassphrase" field.
 func ExchangePassphraseEQ(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldEQ(FieldExchangePassphrase, v))
+	vc, err := ValueScanner.ExchangePassphrase.Value(v)
+	return predicate.StrategyOrErr(sql.FieldEQ(FieldExchangePassphrase, vc), err)
 }
 
 // ExchangePassphraseNEQ applies the NEQ predicate on the "exchangePassphrase" field.
 func ExchangePassphraseNEQ(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldNEQ(FieldExchangePassphrase, v))
+	vc, err := ValueScanner.ExchangePassphrase.Value(v)
+	return predicate.StrategyOrErr(sql.FieldNEQ(FieldExchangePassphrase, vc), err)
 }
 
 // ExchangePassphraseIn applies the In predicate on the "exchangePassphrase" field.
 func ExchangePassphraseIn(vs ...string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldIn(FieldExchangePassphrase, vs...))
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.ExchangePassphrase.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.StrategyOrErr(sql.FieldIn(FieldExchangePassphrase, v...), err)
 }
 
 // ExchangePassphraseNotIn applies the NotIn predicate on the "exchangePassphrase" field.
 func ExchangePassphraseNotIn(vs ...string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldNotIn(FieldExchangePassphrase, vs...))
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.ExchangePassphrase.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.StrategyOrErr(sql.FieldNotIn(FieldExchangePassphrase, v...), err)
 }
 
 // ExchangePassphraseGT applies the GT predicate on the "exchangePassphrase" field.
 func ExchangePassphraseGT(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldGT(FieldExchangePassphrase, v))
+	vc, err := ValueScanner.ExchangePassphrase.Value(v)
+	return predicate.StrategyOrErr(sql.FieldGT(FieldExchangePassphrase, vc), err)
 }
 
 // ExchangePassphraseGTE applies the GTE predicate on the "exchangePassphrase" field.
 func ExchangePassphraseGTE(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldGTE(FieldExchangePassphrase, v))
+	vc, err := ValueScanner.ExchangePassphrase.Value(v)
+	return predicate.StrategyOrErr(sql.FieldGTE(FieldExchangePassphrase, vc), err)
 }
 
 // ExchangePassphraseLT applies the LT predicate on the "exchangePassphrase" field.
 func ExchangePassphraseLT(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldLT(FieldExchangePassphrase, v))
+	vc, err := ValueScanner.ExchangePassphrase.Value(v)
+	return predicate.StrategyOrErr(sql.FieldLT(FieldExchangePassphrase, vc), err)
 }
 
 // ExchangePassphraseLTE applies the LTE predicate on the "exchangePassphrase" field.
 func ExchangePassphraseLTE(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldLTE(FieldExchangePassphrase, v))
+	vc, err := ValueScanner.ExchangePassphrase.Value(v)
+	return predicate.StrategyOrErr(sql.FieldLTE(FieldExchangePassphrase, vc), err)
 }
 
 // ExchangePassphraseContains applies the Contains predicate on the "exchangePassphrase" field.
 func ExchangePassphraseContains(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldContains(FieldExchangePassphrase, v))
+	vc, err := ValueScanner.ExchangePassphrase.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("exchangePassphrase value is not a string: %T", vc)
+	}
+	return predicate.StrategyOrErr(sql.FieldContains(FieldExchangePassphrase, vcs), err)
 }
 
 // ExchangePassphraseHasPrefix applies the HasPrefix predicate on the "exchangePassphrase" field.
 func ExchangePassphraseHasPrefix(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldHasPrefix(FieldExchangePassphrase, v))
+	vc, err := ValueScanner.ExchangePassphrase.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("exchangePassphrase value is not a string: %T", vc)
+	}
+	return predicate.StrategyOrErr(sql.FieldHasPrefix(FieldExchangePassphrase, vcs), err)
 }
 
 // ExchangePassphraseHasSuffix applies the HasSuffix predicate on the "exchangePassphrase" field.
 func ExchangePassphraseHasSuffix(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldHasSuffix(FieldExchangePassphrase, v))
+	vc, err := ValueScanner.ExchangePassphrase.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("exchangePassphrase value is not a string: %T", vc)
+	}
+	return predicate.StrategyOrErr(sql.FieldHasSuffix(FieldExchangePassphrase, vcs), err)
 }
 
 // ExchangePassphraseEqualFold applies the EqualFold predicate on the "exchangePassphrase" field.
 func ExchangePassphraseEqualFold(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldEqualFold(FieldExchangePassphrase, v))
+	vc, err := ValueScanner.ExchangePassphrase.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("exchangePassphrase value is not a string: %T", vc)
+	}
+	return predicate.StrategyOrErr(sql.FieldEqualFold(FieldExchangePassphrase, vcs), err)
 }
 
 // ExchangePassphraseContainsFold applies the ContainsFold predicate on the "exchangePassphrase" field.
 func ExchangePassphraseContainsFold(v string) predicate.Strategy {
-	return predicate.Strategy(sql.FieldContainsFold(FieldExchangePassphrase, v))
+	vc, err := ValueScanner.ExchangePassphrase.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("exchangePassphrase value is not a string: %T", vc)
+	}
+	return predicate.StrategyOrErr(sql.FieldContainsFold(FieldExchangePassphrase, vcs), err)
 }
 
 // ExchangeTestnetEQ applies the EQ predicate on the "exchangeTestnet" field.
@@ -1555,6 +3487,36 @@ func ExchangeTestnetNEQ(v bool) predicate.Strategy {
 	return predicate.Strategy(sql.FieldNEQ(FieldExchangeTestnet, v))
 }
 
+// AccountIdEQ applies the EQ predicate on the "accountId" field.
+func AccountIdEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldAccountId, v))
+}
+
+// AccountIdNEQ applies the NEQ predicate on the "accountId" field.
+func AccountIdNEQ(v int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldAccountId, v))
+}
+
+// AccountIdIn applies the In predicate on the "accountId" field.
+func AccountIdIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldAccountId, vs...))
+}
+
+// AccountIdNotIn applies the NotIn predicate on the "accountId" field.
+func AccountIdNotIn(vs ...int) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldAccountId, vs...))
+}
+
+// AccountIdIsNil applies the IsNil predicate on the "accountId" field.
+func AccountIdIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldAccountId))
+}
+
+// AccountIdNotNil applies the NotNil predicate on the "accountId" field.
+func AccountIdNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldAccountId))
+}
+
 // StartTimeEQ applies the EQ predicate on the "startTime" field.
 func StartTimeEQ(v time.Time) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldStartTime, v))
@@ -1605,6 +3567,29 @@ func StartTimeNotNil() predicate.Strategy {
 	return predicate.Strategy(sql.FieldNotNull(FieldStartTime))
 }
 
+// HasExchangeAccount applies the HasEdge predicate on the "exchangeAccount" edge.
+func HasExchangeAccount() predicate.Strategy {
+	return predicate.Strategy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ExchangeAccountTable, ExchangeAccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasExchangeAccountWith applies the HasEdge predicate on the "exchangeAccount" edge with a given conditions (other predicates).
+func HasExchangeAccountWith(preds ...predicate.ExchangeAccount) predicate.Strategy {
+	return predicate.Strategy(func(s *sql.Selector) {
+		step := newExchangeAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Strategy) predicate.Strategy {
 	return predicate.Strategy(sql.AndPredicates(predicates...))
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/fachebot/omni-grid-bot/internal/ent/exchangeaccount"
 	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
 	"github.com/shopspring/decimal"
 )
@@ -145,6 +146,48 @@ func (_c *StrategyCreate) SetInitialOrderSize(v decimal.Decimal) *StrategyCreate
 	return _c
 }
 
+// SetSizingMode sets the "sizingMode" field.
+func (_c *StrategyCreate) SetSizingMode(v strategy.SizingMode) *StrategyCreate {
+	_c.mutation.SetSizingMode(v)
+	return _c
+}
+
+// SetNillableSizingMode sets the "sizingMode" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableSizingMode(v *strategy.SizingMode) *StrategyCreate {
+	if v != nil {
+		_c.SetSizingMode(*v)
+	}
+	return _c
+}
+
+// SetSizingFactor sets the "sizingFactor" field.
+func (_c *StrategyCreate) SetSizingFactor(v decimal.Decimal) *StrategyCreate {
+	_c.mutation.SetSizingFactor(v)
+	return _c
+}
+
+// SetNillableSizingFactor sets the "sizingFactor" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableSizingFactor(v *decimal.Decimal) *StrategyCreate {
+	if v != nil {
+		_c.SetSizingFactor(*v)
+	}
+	return _c
+}
+
+// SetSizingWeights sets the "sizingWeights" field.
+func (_c *StrategyCreate) SetSizingWeights(v string) *StrategyCreate {
+	_c.mutation.SetSizingWeights(v)
+	return _c
+}
+
+// SetNillableSizingWeights sets the "sizingWeights" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableSizingWeights(v *string) *StrategyCreate {
+	if v != nil {
+		_c.SetSizingWeights(*v)
+	}
+	return _c
+}
+
 // SetSlippageBps sets the "slippageBps" field.
 func (_c *StrategyCreate) SetSlippageBps(v int) *StrategyCreate {
 	_c.mutation.SetSlippageBps(v)
@@ -201,6 +244,314 @@ func (_c *StrategyCreate) SetNillableTriggerTakeProfitPrice(v *decimal.Decimal)
 	return _c
 }
 
+// SetMaxLossAmount sets the "maxLossAmount" field.
+func (_c *StrategyCreate) SetMaxLossAmount(v decimal.Decimal) *StrategyCreate {
+	_c.mutation.SetMaxLossAmount(v)
+	return _c
+}
+
+// SetNillableMaxLossAmount sets the "maxLossAmount" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableMaxLossAmount(v *decimal.Decimal) *StrategyCreate {
+	if v != nil {
+		_c.SetMaxLossAmount(*v)
+	}
+	return _c
+}
+
+// SetMaxLossPercent sets the "maxLossPercent" field.
+func (_c *StrategyCreate) SetMaxLossPercent(v decimal.Decimal) *StrategyCreate {
+	_c.mutation.SetMaxLossPercent(v)
+	return _c
+}
+
+// SetNillableMaxLossPercent sets the "maxLossPercent" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableMaxLossPercent(v *decimal.Decimal) *StrategyCreate {
+	if v != nil {
+		_c.SetMaxLossPercent(*v)
+	}
+	return _c
+}
+
+// SetProfitTargetAmount sets the "profitTargetAmount" field.
+func (_c *StrategyCreate) SetProfitTargetAmount(v decimal.Decimal) *StrategyCreate {
+	_c.mutation.SetProfitTargetAmount(v)
+	return _c
+}
+
+// SetNillableProfitTargetAmount sets the "profitTargetAmount" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableProfitTargetAmount(v *decimal.Decimal) *StrategyCreate {
+	if v != nil {
+		_c.SetProfitTargetAmount(*v)
+	}
+	return _c
+}
+
+// SetTrailingStopLossAmount sets the "trailingStopLossAmount" field.
+func (_c *StrategyCreate) SetTrailingStopLossAmount(v decimal.Decimal) *StrategyCreate {
+	_c.mutation.SetTrailingStopLossAmount(v)
+	return _c
+}
+
+// SetNillableTrailingStopLossAmount sets the "trailingStopLossAmount" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableTrailingStopLossAmount(v *decimal.Decimal) *StrategyCreate {
+	if v != nil {
+		_c.SetTrailingStopLossAmount(*v)
+	}
+	return _c
+}
+
+// SetTrailingStopLossPercent sets the "trailingStopLossPercent" field.
+func (_c *StrategyCreate) SetTrailingStopLossPercent(v decimal.Decimal) *StrategyCreate {
+	_c.mutation.SetTrailingStopLossPercent(v)
+	return _c
+}
+
+// SetNillableTrailingStopLossPercent sets the "trailingStopLossPercent" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableTrailingStopLossPercent(v *decimal.Decimal) *StrategyCreate {
+	if v != nil {
+		_c.SetTrailingStopLossPercent(*v)
+	}
+	return _c
+}
+
+// SetTrailingTakeProfitActivationPrice sets the "trailingTakeProfitActivationPrice" field.
+func (_c *StrategyCreate) SetTrailingTakeProfitActivationPrice(v decimal.Decimal) *StrategyCreate {
+	_c.mutation.SetTrailingTakeProfitActivationPrice(v)
+	return _c
+}
+
+// SetNillableTrailingTakeProfitActivationPrice sets the "trailingTakeProfitActivationPrice" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableTrailingTakeProfitActivationPrice(v *decimal.Decimal) *StrategyCreate {
+	if v != nil {
+		_c.SetTrailingTakeProfitActivationPrice(*v)
+	}
+	return _c
+}
+
+// SetTrailingTakeProfitAmount sets the "trailingTakeProfitAmount" field.
+func (_c *StrategyCreate) SetTrailingTakeProfitAmount(v decimal.Decimal) *StrategyCreate {
+	_c.mutation.SetTrailingTakeProfitAmount(v)
+	return _c
+}
+
+// SetNillableTrailingTakeProfitAmount sets the "trailingTakeProfitAmount" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableTrailingTakeProfitAmount(v *decimal.Decimal) *StrategyCreate {
+	if v != nil {
+		_c.SetTrailingTakeProfitAmount(*v)
+	}
+	return _c
+}
+
+// SetTrailingTakeProfitPercent sets the "trailingTakeProfitPercent" field.
+func (_c *StrategyCreate) SetTrailingTakeProfitPercent(v decimal.Decimal) *StrategyCreate {
+	_c.mutation.SetTrailingTakeProfitPercent(v)
+	return _c
+}
+
+// SetNillableTrailingTakeProfitPercent sets the "trailingTakeProfitPercent" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableTrailingTakeProfitPercent(v *decimal.Decimal) *StrategyCreate {
+	if v != nil {
+		_c.SetTrailingTakeProfitPercent(*v)
+	}
+	return _c
+}
+
+// SetTrailingExtremePrice sets the "trailingExtremePrice" field.
+func (_c *StrategyCreate) SetTrailingExtremePrice(v decimal.Decimal) *StrategyCreate {
+	_c.mutation.SetTrailingExtremePrice(v)
+	return _c
+}
+
+// SetNillableTrailingExtremePrice sets the "trailingExtremePrice" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableTrailingExtremePrice(v *decimal.Decimal) *StrategyCreate {
+	if v != nil {
+		_c.SetTrailingExtremePrice(*v)
+	}
+	return _c
+}
+
+// SetTrailingLevels sets the "trailingLevels" field.
+func (_c *StrategyCreate) SetTrailingLevels(v int) *StrategyCreate {
+	_c.mutation.SetTrailingLevels(v)
+	return _c
+}
+
+// SetNillableTrailingLevels sets the "trailingLevels" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableTrailingLevels(v *int) *StrategyCreate {
+	if v != nil {
+		_c.SetTrailingLevels(*v)
+	}
+	return _c
+}
+
+// SetTrailingPriceUpperLimit sets the "trailingPriceUpperLimit" field.
+func (_c *StrategyCreate) SetTrailingPriceUpperLimit(v decimal.Decimal) *StrategyCreate {
+	_c.mutation.SetTrailingPriceUpperLimit(v)
+	return _c
+}
+
+// SetNillableTrailingPriceUpperLimit sets the "trailingPriceUpperLimit" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableTrailingPriceUpperLimit(v *decimal.Decimal) *StrategyCreate {
+	if v != nil {
+		_c.SetTrailingPriceUpperLimit(*v)
+	}
+	return _c
+}
+
+// SetTrailingPriceLowerLimit sets the "trailingPriceLowerLimit" field.
+func (_c *StrategyCreate) SetTrailingPriceLowerLimit(v decimal.Decimal) *StrategyCreate {
+	_c.mutation.SetTrailingPriceLowerLimit(v)
+	return _c
+}
+
+// SetNillableTrailingPriceLowerLimit sets the "trailingPriceLowerLimit" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableTrailingPriceLowerLimit(v *decimal.Decimal) *StrategyCreate {
+	if v != nil {
+		_c.SetTrailingPriceLowerLimit(*v)
+	}
+	return _c
+}
+
+// SetAtrPeriod sets the "atrPeriod" field.
+func (_c *StrategyCreate) SetAtrPeriod(v int) *StrategyCreate {
+	_c.mutation.SetAtrPeriod(v)
+	return _c
+}
+
+// SetNillableAtrPeriod sets the "atrPeriod" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableAtrPeriod(v *int) *StrategyCreate {
+	if v != nil {
+		_c.SetAtrPeriod(*v)
+	}
+	return _c
+}
+
+// SetAtrMultiplier sets the "atrMultiplier" field.
+func (_c *StrategyCreate) SetAtrMultiplier(v decimal.Decimal) *StrategyCreate {
+	_c.mutation.SetAtrMultiplier(v)
+	return _c
+}
+
+// SetNillableAtrMultiplier sets the "atrMultiplier" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableAtrMultiplier(v *decimal.Decimal) *StrategyCreate {
+	if v != nil {
+		_c.SetAtrMultiplier(*v)
+	}
+	return _c
+}
+
+// SetAdaptiveThreshold sets the "adaptiveThreshold" field.
+func (_c *StrategyCreate) SetAdaptiveThreshold(v decimal.Decimal) *StrategyCreate {
+	_c.mutation.SetAdaptiveThreshold(v)
+	return _c
+}
+
+// SetNillableAdaptiveThreshold sets the "adaptiveThreshold" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableAdaptiveThreshold(v *decimal.Decimal) *StrategyCreate {
+	if v != nil {
+		_c.SetAdaptiveThreshold(*v)
+	}
+	return _c
+}
+
+// SetAdaptiveIntervalHours sets the "adaptiveIntervalHours" field.
+func (_c *StrategyCreate) SetAdaptiveIntervalHours(v int) *StrategyCreate {
+	_c.mutation.SetAdaptiveIntervalHours(v)
+	return _c
+}
+
+// SetNillableAdaptiveIntervalHours sets the "adaptiveIntervalHours" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableAdaptiveIntervalHours(v *int) *StrategyCreate {
+	if v != nil {
+		_c.SetAdaptiveIntervalHours(*v)
+	}
+	return _c
+}
+
+// SetAdaptiveAtr sets the "adaptiveAtr" field.
+func (_c *StrategyCreate) SetAdaptiveAtr(v decimal.Decimal) *StrategyCreate {
+	_c.mutation.SetAdaptiveAtr(v)
+	return _c
+}
+
+// SetNillableAdaptiveAtr sets the "adaptiveAtr" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableAdaptiveAtr(v *decimal.Decimal) *StrategyCreate {
+	if v != nil {
+		_c.SetAdaptiveAtr(*v)
+	}
+	return _c
+}
+
+// SetAdaptiveUpdatedAt sets the "adaptiveUpdatedAt" field.
+func (_c *StrategyCreate) SetAdaptiveUpdatedAt(v time.Time) *StrategyCreate {
+	_c.mutation.SetAdaptiveUpdatedAt(v)
+	return _c
+}
+
+// SetNillableAdaptiveUpdatedAt sets the "adaptiveUpdatedAt" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableAdaptiveUpdatedAt(v *time.Time) *StrategyCreate {
+	if v != nil {
+		_c.SetAdaptiveUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetCancelRepairPolicy sets the "cancelRepairPolicy" field.
+func (_c *StrategyCreate) SetCancelRepairPolicy(v strategy.CancelRepairPolicy) *StrategyCreate {
+	_c.mutation.SetCancelRepairPolicy(v)
+	return _c
+}
+
+// SetNillableCancelRepairPolicy sets the "cancelRepairPolicy" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableCancelRepairPolicy(v *strategy.CancelRepairPolicy) *StrategyCreate {
+	if v != nil {
+		_c.SetCancelRepairPolicy(*v)
+	}
+	return _c
+}
+
+// SetCancelRepairMaxAttempts sets the "cancelRepairMaxAttempts" field.
+func (_c *StrategyCreate) SetCancelRepairMaxAttempts(v int) *StrategyCreate {
+	_c.mutation.SetCancelRepairMaxAttempts(v)
+	return _c
+}
+
+// SetNillableCancelRepairMaxAttempts sets the "cancelRepairMaxAttempts" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableCancelRepairMaxAttempts(v *int) *StrategyCreate {
+	if v != nil {
+		_c.SetCancelRepairMaxAttempts(*v)
+	}
+	return _c
+}
+
+// SetReconcilePolicy sets the "reconcilePolicy" field.
+func (_c *StrategyCreate) SetReconcilePolicy(v strategy.ReconcilePolicy) *StrategyCreate {
+	_c.mutation.SetReconcilePolicy(v)
+	return _c
+}
+
+// SetNillableReconcilePolicy sets the "reconcilePolicy" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableReconcilePolicy(v *strategy.ReconcilePolicy) *StrategyCreate {
+	if v != nil {
+		_c.SetReconcilePolicy(*v)
+	}
+	return _c
+}
+
+// SetPartialFillThreshold sets the "partialFillThreshold" field.
+func (_c *StrategyCreate) SetPartialFillThreshold(v decimal.Decimal) *StrategyCreate {
+	_c.mutation.SetPartialFillThreshold(v)
+	return _c
+}
+
+// SetNillablePartialFillThreshold sets the "partialFillThreshold" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillablePartialFillThreshold(v *decimal.Decimal) *StrategyCreate {
+	if v != nil {
+		_c.SetPartialFillThreshold(*v)
+	}
+	return _c
+}
+
 // SetEnablePushNotification sets the "enablePushNotification" field.
 func (_c *StrategyCreate) SetEnablePushNotification(v bool) *StrategyCreate {
 	_c.mutation.SetEnablePushNotification(v)
@@ -287,6 +638,20 @@ func (_c *StrategyCreate) SetNillableExchangeTestnet(v *bool) *StrategyCreate {
 	return _c
 }
 
+// SetAccountId sets the "accountId" field.
+func (_c *StrategyCreate) SetAccountId(v int) *StrategyCreate {
+	_c.mutation.SetAccountId(v)
+	return _c
+}
+
+// SetNillableAccountId sets the "accountId" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableAccountId(v *int) *StrategyCreate {
+	if v != nil {
+		_c.SetAccountId(*v)
+	}
+	return _c
+}
+
 // SetStartTime sets the "startTime" field.
 func (_c *StrategyCreate) SetStartTime(v time.Time) *StrategyCreate {
 	_c.mutation.SetStartTime(v)
@@ -301,6 +666,25 @@ func (_c *StrategyCreate) SetNillableStartTime(v *time.Time) *StrategyCreate {
 	return _c
 }
 
+// SetExchangeAccountID sets the "exchangeAccount" edge to the ExchangeAccount entity by ID.
+func (_c *StrategyCreate) SetExchangeAccountID(id int) *StrategyCreate {
+	_c.mutation.SetExchangeAccountID(id)
+	return _c
+}
+
+// SetNillableExchangeAccountID sets the "exchangeAccount" edge to the ExchangeAccount entity by ID if the given value is not nil.
+func (_c *StrategyCreate) SetNillableExchangeAccountID(id *int) *StrategyCreate {
+	if id != nil {
+		_c = _c.SetExchangeAccountID(*id)
+	}
+	return _c
+}
+
+// SetExchangeAccount sets the "exchangeAccount" edge to the ExchangeAccount entity.
+func (_c *StrategyCreate) SetExchangeAccount(v *ExchangeAccount) *StrategyCreate {
+	return _c.SetExchangeAccountID(v.ID)
+}
+
 // Mutation returns the StrategyMutation object of the builder.
 func (_c *StrategyCreate) Mutation() *StrategyMutation {
 	return _c.mutation
@@ -352,6 +736,18 @@ func (_c *StrategyCreate) defaults() {
 		v := strategy.DefaultLeverage
 		_c.mutation.SetLeverage(v)
 	}
+	if _, ok := _c.mutation.SizingMode(); !ok {
+		v := strategy.DefaultSizingMode
+		_c.mutation.SetSizingMode(v)
+	}
+	if _, ok := _c.mutation.CancelRepairPolicy(); !ok {
+		v := strategy.DefaultCancelRepairPolicy
+		_c.mutation.SetCancelRepairPolicy(v)
+	}
+	if _, ok := _c.mutation.ReconcilePolicy(); !ok {
+		v := strategy.DefaultReconcilePolicy
+		_c.mutation.SetReconcilePolicy(v)
+	}
 	if _, ok := _c.mutation.ExchangeTestnet(); !ok {
 		v := strategy.DefaultExchangeTestnet
 		_c.mutation.SetExchangeTestnet(v)
@@ -445,11 +841,60 @@ func (_c *StrategyCreate) check() error {
 	if _, ok := _c.mutation.InitialOrderSize(); !ok {
 		return &ValidationError{Name: "initialOrderSize", err: errors.New(`ent: missing required field "Strategy.initialOrderSize"`)}
 	}
+	if _, ok := _c.mutation.SizingMode(); !ok {
+		return &ValidationError{Name: "sizingMode", err: errors.New(`ent: missing required field "Strategy.sizingMode"`)}
+	}
+	if v, ok := _c.mutation.SizingMode(); ok {
+		if err := strategy.SizingModeValidator(v); err != nil {
+			return &ValidationError{Name: "sizingMode", err: fmt.Errorf(`ent: validator failed for field "Strategy.sizingMode": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.SizingWeights(); ok {
+		if err := strategy.SizingWeightsValidator(v); err != nil {
+			return &ValidationError{Name: "sizingWeights", err: fmt.Errorf(`ent: validator failed for field "Strategy.sizingWeights": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.SlippageBps(); ok {
 		if err := strategy.SlippageBpsValidator(v); err != nil {
 			return &ValidationError{Name: "slippageBps", err: fmt.Errorf(`ent: validator failed for field "Strategy.slippageBps": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.TrailingLevels(); ok {
+		if err := strategy.TrailingLevelsValidator(v); err != nil {
+			return &ValidationError{Name: "trailingLevels", err: fmt.Errorf(`ent: validator failed for field "Strategy.trailingLevels": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.AtrPeriod(); ok {
+		if err := strategy.AtrPeriodValidator(v); err != nil {
+			return &ValidationError{Name: "atrPeriod", err: fmt.Errorf(`ent: validator failed for field "Strategy.atrPeriod": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.AdaptiveIntervalHours(); ok {
+		if err := strategy.AdaptiveIntervalHoursValidator(v); err != nil {
+			return &ValidationError{Name: "adaptiveIntervalHours", err: fmt.Errorf(`ent: validator failed for field "Strategy.adaptiveIntervalHours": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CancelRepairPolicy(); !ok {
+		return &ValidationError{Name: "cancelRepairPolicy", err: errors.New(`ent: missing required field "Strategy.cancelRepairPolicy"`)}
+	}
+	if v, ok := _c.mutation.CancelRepairPolicy(); ok {
+		if err := strategy.CancelRepairPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "cancelRepairPolicy", err: fmt.Errorf(`ent: validator failed for field "Strategy.cancelRepairPolicy": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.CancelRepairMaxAttempts(); ok {
+		if err := strategy.CancelRepairMaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "cancelRepairMaxAttempts", err: fmt.Errorf(`ent: validator failed for field "Strategy.cancelRepairMaxAttempts": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ReconcilePolicy(); !ok {
+		return &ValidationError{Name: "reconcilePolicy", err: errors.New(`ent: missing required field "Strategy.reconcilePolicy"`)}
+	}
+	if v, ok := _c.mutation.ReconcilePolicy(); ok {
+		if err := strategy.ReconcilePolicyValidator(v); err != nil {
+			return &ValidationError{Name: "reconcilePolicy", err: fmt.Errorf(`ent: validator failed for field "Strategy.reconcilePolicy": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.EnablePushNotification(); !ok {
 		return &ValidationError{Name: "enablePushNotification", err: errors.New(`ent: missing required field "Strategy.enablePushNotification"`)}
 	}
@@ -480,7 +925,10 @@ func (_c *StrategyCreate) sqlSave(ctx context.Context) (*Strategy, error) {
 	if err := _c.check(); err != nil {
 		return nil, err
 	}
-	_node, _spec := _c.createSpec()
+	_node, _spec, err := _c.createSpec()
+	if err != nil {
+		return nil, err
+	}
 	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
@@ -494,7 +942,7 @@ func (_c *StrategyCreate) sqlSave(ctx context.Context) (*Strategy, error) {
 	return _node, nil
 }
 
-func (_c *StrategyCreate) createSpec() (*Strategy, *sqlgraph.CreateSpec) {
+func (_c *StrategyCreate) createSpec() (*Strategy, *sqlgraph.CreateSpec, error) {
 	var (
 		_node = &Strategy{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(strategy.Table, sqlgraph.NewFieldSpec(strategy.FieldID, field.TypeInt))
@@ -560,6 +1008,18 @@ func (_c *StrategyCreate) createSpec() (*Strategy, *sqlgraph.CreateSpec) {
 		_spec.SetField(strategy.FieldInitialOrderSize, field.TypeString, value)
 		_node.InitialOrderSize = value
 	}
+	if value, ok := _c.mutation.SizingMode(); ok {
+		_spec.SetField(strategy.FieldSizingMode, field.TypeEnum, value)
+		_node.SizingMode = value
+	}
+	if value, ok := _c.mutation.SizingFactor(); ok {
+		_spec.SetField(strategy.FieldSizingFactor, field.TypeString, value)
+		_node.SizingFactor = &value
+	}
+	if value, ok := _c.mutation.SizingWeights(); ok {
+		_spec.SetField(strategy.FieldSizingWeights, field.TypeString, value)
+		_node.SizingWeights = &value
+	}
 	if value, ok := _c.mutation.SlippageBps(); ok {
 		_spec.SetField(strategy.FieldSlippageBps, field.TypeInt, value)
 		_node.SlippageBps = &value
@@ -576,20 +1036,108 @@ func (_c *StrategyCreate) createSpec() (*Strategy, *sqlgraph.CreateSpec) {
 		_spec.SetField(strategy.FieldTriggerTakeProfitPrice, field.TypeString, value)
 		_node.TriggerTakeProfitPrice = &value
 	}
-	if value, ok := _c.mutation.EnablePushNotification(); ok {
-		_spec.SetField(strategy.FieldEnablePushNotification, field.TypeBool, value)
-		_node.EnablePushNotification = value
+	if value, ok := _c.mutation.MaxLossAmount(); ok {
+		_spec.SetField(strategy.FieldMaxLossAmount, field.TypeString, value)
+		_node.MaxLossAmount = &value
 	}
-	if value, ok := _c.mutation.EnablePushMatchedNotification(); ok {
-		_spec.SetField(strategy.FieldEnablePushMatchedNotification, field.TypeBool, value)
-		_node.EnablePushMatchedNotification = &value
+	if value, ok := _c.mutation.MaxLossPercent(); ok {
+		_spec.SetField(strategy.FieldMaxLossPercent, field.TypeString, value)
+		_node.MaxLossPercent = &value
 	}
-	if value, ok := _c.mutation.LastLowerThresholdAlertTime(); ok {
-		_spec.SetField(strategy.FieldLastLowerThresholdAlertTime, field.TypeTime, value)
-		_node.LastLowerThresholdAlertTime = &value
+	if value, ok := _c.mutation.ProfitTargetAmount(); ok {
+		_spec.SetField(strategy.FieldProfitTargetAmount, field.TypeString, value)
+		_node.ProfitTargetAmount = &value
 	}
-	if value, ok := _c.mutation.LastUpperThresholdAlertTime(); ok {
-		_spec.SetField(strategy.FieldLastUpperThresholdAlertTime, field.TypeTime, value)
+	if value, ok := _c.mutation.TrailingStopLossAmount(); ok {
+		_spec.SetField(strategy.FieldTrailingStopLossAmount, field.TypeString, value)
+		_node.TrailingStopLossAmount = &value
+	}
+	if value, ok := _c.mutation.TrailingStopLossPercent(); ok {
+		_spec.SetField(strategy.FieldTrailingStopLossPercent, field.TypeString, value)
+		_node.TrailingStopLossPercent = &value
+	}
+	if value, ok := _c.mutation.TrailingTakeProfitActivationPrice(); ok {
+		_spec.SetField(strategy.FieldTrailingTakeProfitActivationPrice, field.TypeString, value)
+		_node.TrailingTakeProfitActivationPrice = &value
+	}
+	if value, ok := _c.mutation.TrailingTakeProfitAmount(); ok {
+		_spec.SetField(strategy.FieldTrailingTakeProfitAmount, field.TypeString, value)
+		_node.TrailingTakeProfitAmount = &value
+	}
+	if value, ok := _c.mutation.TrailingTakeProfitPercent(); ok {
+		_spec.SetField(strategy.FieldTrailingTakeProfitPercent, field.TypeString, value)
+		_node.TrailingTakeProfitPercent = &value
+	}
+	if value, ok := _c.mutation.TrailingExtremePrice(); ok {
+		_spec.SetField(strategy.FieldTrailingExtremePrice, field.TypeString, value)
+		_node.TrailingExtremePrice = &value
+	}
+	if value, ok := _c.mutation.TrailingLevels(); ok {
+		_spec.SetField(strategy.FieldTrailingLevels, field.TypeInt, value)
+		_node.TrailingLevels = &value
+	}
+	if value, ok := _c.mutation.TrailingPriceUpperLimit(); ok {
+		_spec.SetField(strategy.FieldTrailingPriceUpperLimit, field.TypeString, value)
+		_node.TrailingPriceUpperLimit = &value
+	}
+	if value, ok := _c.mutation.TrailingPriceLowerLimit(); ok {
+		_spec.SetField(strategy.FieldTrailingPriceLowerLimit, field.TypeString, value)
+		_node.TrailingPriceLowerLimit = &value
+	}
+	if value, ok := _c.mutation.AtrPeriod(); ok {
+		_spec.SetField(strategy.FieldAtrPeriod, field.TypeInt, value)
+		_node.AtrPeriod = &value
+	}
+	if value, ok := _c.mutation.AtrMultiplier(); ok {
+		_spec.SetField(strategy.FieldAtrMultiplier, field.TypeString, value)
+		_node.AtrMultiplier = &value
+	}
+	if value, ok := _c.mutation.AdaptiveThreshold(); ok {
+		_spec.SetField(strategy.FieldAdaptiveThreshold, field.TypeString, value)
+		_node.AdaptiveThreshold = &value
+	}
+	if value, ok := _c.mutation.AdaptiveIntervalHours(); ok {
+		_spec.SetField(strategy.FieldAdaptiveIntervalHours, field.TypeInt, value)
+		_node.AdaptiveIntervalHours = &value
+	}
+	if value, ok := _c.mutation.AdaptiveAtr(); ok {
+		_spec.SetField(strategy.FieldAdaptiveAtr, field.TypeString, value)
+		_node.AdaptiveAtr = &value
+	}
+	if value, ok := _c.mutation.AdaptiveUpdatedAt(); ok {
+		_spec.SetField(strategy.FieldAdaptiveUpdatedAt, field.TypeTime, value)
+		_node.AdaptiveUpdatedAt = &value
+	}
+	if value, ok := _c.mutation.CancelRepairPolicy(); ok {
+		_spec.SetField(strategy.FieldCancelRepairPolicy, field.TypeEnum, value)
+		_node.CancelRepairPolicy = value
+	}
+	if value, ok := _c.mutation.CancelRepairMaxAttempts(); ok {
+		_spec.SetField(strategy.FieldCancelRepairMaxAttempts, field.TypeInt, value)
+		_node.CancelRepairMaxAttempts = &value
+	}
+	if value, ok := _c.mutation.ReconcilePolicy(); ok {
+		_spec.SetField(strategy.FieldReconcilePolicy, field.TypeEnum, value)
+		_node.ReconcilePolicy = value
+	}
+	if value, ok := _c.mutation.PartialFillThreshold(); ok {
+		_spec.SetField(strategy.FieldPartialFillThreshold, field.TypeString, value)
+		_node.PartialFillThreshold = &value
+	}
+	if value, ok := _c.mutation.EnablePushNotification(); ok {
+		_spec.SetField(strategy.FieldEnablePushNotification, field.TypeBool, value)
+		_node.EnablePushNotification = value
+	}
+	if value, ok := _c.mutation.EnablePushMatchedNotification(); ok {
+		_spec.SetField(strategy.FieldEnablePushMatchedNotification, field.TypeBool, value)
+		_node.EnablePushMatchedNotification = &value
+	}
+	if value, ok := _c.mutation.LastLowerThresholdAlertTime(); ok {
+		_spec.SetField(strategy.FieldLastLowerThresholdAlertTime, field.TypeTime, value)
+		_node.LastLowerThresholdAlertTime = &value
+	}
+	if value, ok := _c.mutation.LastUpperThresholdAlertTime(); ok {
+		_spec.SetField(strategy.FieldLastUpperThresholdAlertTime, field.TypeTime, value)
 		_node.LastUpperThresholdAlertTime = &value
 	}
 	if value, ok := _c.mutation.Status(); ok {
@@ -597,15 +1145,27 @@ func (_c *StrategyCreate) createSpec() (*Strategy, *sqlgraph.CreateSpec) {
 		_node.Status = value
 	}
 	if value, ok := _c.mutation.ExchangeApiKey(); ok {
-		_spec.SetField(strategy.FieldExchangeApiKey, field.TypeString, value)
+		vv, err := strategy.ValueScanner.ExchangeApiKey.Value(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		_spec.SetField(strategy.FieldExchangeApiKey, field.TypeString, vv)
 		_node.ExchangeApiKey = value
 	}
 	if value, ok := _c.mutation.ExchangeSecretKey(); ok {
-		_spec.SetField(strategy.FieldExchangeSecretKey, field.TypeString, value)
+		vv, err := strategy.ValueScanner.ExchangeSecretKey.Value(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		_spec.SetField(strategy.FieldExchangeSecretKey, field.TypeString, vv)
 		_node.ExchangeSecretKey = value
 	}
 	if value, ok := _c.mutation.ExchangePassphrase(); ok {
-		_spec.SetField(strategy.FieldExchangePassphrase, field.TypeString, value)
+		vv, err := strategy.ValueScanner.ExchangePassphrase.Value(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		_spec.SetField(strategy.FieldExchangePassphrase, field.TypeString, vv)
 		_node.ExchangePassphrase = value
 	}
 	if value, ok := _c.mutation.ExchangeTestnet(); ok {
@@ -616,7 +1176,24 @@ func (_c *StrategyCreate) createSpec() (*Strategy, *sqlgraph.CreateSpec) {
 		_spec.SetField(strategy.FieldStartTime, field.TypeTime, value)
 		_node.StartTime = &value
 	}
-	return _node, _spec
+	if nodes := _c.mutation.ExchangeAccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   strategy.ExchangeAccountTable,
+			Columns: []string{strategy.ExchangeAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exchangeaccount.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountId = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec, nil
 }
 
 // OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
@@ -854,6 +1431,54 @@ func (u *StrategyUpsert) UpdateInitialOrderSize() *StrategyUpsert {
 	return u
 }
 
+// SetSizingMode sets the "sizingMode" field.
+func (u *StrategyUpsert) SetSizingMode(v strategy.SizingMode) *StrategyUpsert {
+	u.Set(strategy.FieldSizingMode, v)
+	return u
+}
+
+// UpdateSizingMode sets the "sizingMode" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateSizingMode() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldSizingMode)
+	return u
+}
+
+// SetSizingFactor sets the "sizingFactor" field.
+func (u *StrategyUpsert) SetSizingFactor(v decimal.Decimal) *StrategyUpsert {
+	u.Set(strategy.FieldSizingFactor, v)
+	return u
+}
+
+// UpdateSizingFactor sets the "sizingFactor" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateSizingFactor() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldSizingFactor)
+	return u
+}
+
+// ClearSizingFactor clears the value of the "sizingFactor" field.
+func (u *StrategyUpsert) ClearSizingFactor() *StrategyUpsert {
+	u.SetNull(strategy.FieldSizingFactor)
+	return u
+}
+
+// SetSizingWeights sets the "sizingWeights" field.
+func (u *StrategyUpsert) SetSizingWeights(v string) *StrategyUpsert {
+	u.Set(strategy.FieldSizingWeights, v)
+	return u
+}
+
+// UpdateSizingWeights sets the "sizingWeights" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateSizingWeights() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldSizingWeights)
+	return u
+}
+
+// ClearSizingWeights clears the value of the "sizingWeights" field.
+func (u *StrategyUpsert) ClearSizingWeights() *StrategyUpsert {
+	u.SetNull(strategy.FieldSizingWeights)
+	return u
+}
+
 // SetSlippageBps sets the "slippageBps" field.
 func (u *StrategyUpsert) SetSlippageBps(v int) *StrategyUpsert {
 	u.Set(strategy.FieldSlippageBps, v)
@@ -932,1187 +1557,2701 @@ func (u *StrategyUpsert) ClearTriggerTakeProfitPrice() *StrategyUpsert {
 	return u
 }
 
-// SetEnablePushNotification sets the "enablePushNotification" field.
-func (u *StrategyUpsert) SetEnablePushNotification(v bool) *StrategyUpsert {
-	u.Set(strategy.FieldEnablePushNotification, v)
+// SetMaxLossAmount sets the "maxLossAmount" field.
+func (u *StrategyUpsert) SetMaxLossAmount(v decimal.Decimal) *StrategyUpsert {
+	u.Set(strategy.FieldMaxLossAmount, v)
 	return u
 }
 
-// UpdateEnablePushNotification sets the "enablePushNotification" field to the value that was provided on create.
-func (u *StrategyUpsert) UpdateEnablePushNotification() *StrategyUpsert {
-	u.SetExcluded(strategy.FieldEnablePushNotification)
+// UpdateMaxLossAmount sets the "maxLossAmount" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateMaxLossAmount() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldMaxLossAmount)
 	return u
 }
 
-// SetEnablePushMatchedNotification sets the "enablePushMatchedNotification" field.
-func (u *StrategyUpsert) SetEnablePushMatchedNotification(v bool) *StrategyUpsert {
-	u.Set(strategy.FieldEnablePushMatchedNotification, v)
+// ClearMaxLossAmount clears the value of the "maxLossAmount" field.
+func (u *StrategyUpsert) ClearMaxLossAmount() *StrategyUpsert {
+	u.SetNull(strategy.FieldMaxLossAmount)
 	return u
 }
 
-// UpdateEnablePushMatchedNotification sets the "enablePushMatchedNotification" field to the value that was provided on create.
-func (u *StrategyUpsert) UpdateEnablePushMatchedNotification() *StrategyUpsert {
-	u.SetExcluded(strategy.FieldEnablePushMatchedNotification)
+// SetMaxLossPercent sets the "maxLossPercent" field.
+func (u *StrategyUpsert) SetMaxLossPercent(v decimal.Decimal) *StrategyUpsert {
+	u.Set(strategy.FieldMaxLossPercent, v)
 	return u
 }
 
-// ClearEnablePushMatchedNotification clears the value of the "enablePushMatchedNotification" field.
-func (u *StrategyUpsert) ClearEnablePushMatchedNotification() *StrategyUpsert {
-	u.SetNull(strategy.FieldEnablePushMatchedNotification)
+// UpdateMaxLossPercent sets the "maxLossPercent" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateMaxLossPercent() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldMaxLossPercent)
 	return u
 }
 
-// SetLastLowerThresholdAlertTime sets the "lastLowerThresholdAlertTime" field.
-func (u *StrategyUpsert) SetLastLowerThresholdAlertTime(v time.Time) *StrategyUpsert {
-	u.Set(strategy.FieldLastLowerThresholdAlertTime, v)
+// ClearMaxLossPercent clears the value of the "maxLossPercent" field.
+func (u *StrategyUpsert) ClearMaxLossPercent() *StrategyUpsert {
+	u.SetNull(strategy.FieldMaxLossPercent)
 	return u
 }
 
-// UpdateLastLowerThresholdAlertTime sets the "lastLowerThresholdAlertTime" field to the value that was provided on create.
-func (u *StrategyUpsert) UpdateLastLowerThresholdAlertTime() *StrategyUpsert {
-	u.SetExcluded(strategy.FieldLastLowerThresholdAlertTime)
+// SetProfitTargetAmount sets the "profitTargetAmount" field.
+func (u *StrategyUpsert) SetProfitTargetAmount(v decimal.Decimal) *StrategyUpsert {
+	u.Set(strategy.FieldProfitTargetAmount, v)
 	return u
 }
 
-// ClearLastLowerThresholdAlertTime clears the value of the "lastLowerThresholdAlertTime" field.
-func (u *StrategyUpsert) ClearLastLowerThresholdAlertTime() *StrategyUpsert {
-	u.SetNull(strategy.FieldLastLowerThresholdAlertTime)
+// UpdateProfitTargetAmount sets the "profitTargetAmount" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateProfitTargetAmount() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldProfitTargetAmount)
 	return u
 }
 
-// SetLastUpperThresholdAlertTime sets the "lastUpperThresholdAlertTime" field.
-func (u *StrategyUpsert) SetLastUpperThresholdAlertTime(v time.Time) *StrategyUpsert {
-	u.Set(strategy.FieldLastUpperThresholdAlertTime, v)
+// ClearProfitTargetAmount clears the value of the "profitTargetAmount" field.
+func (u *StrategyUpsert) ClearProfitTargetAmount() *StrategyUpsert {
+	u.SetNull(strategy.FieldProfitTargetAmount)
 	return u
 }
 
-// UpdateLastUpperThresholdAlertTime sets the "lastUpperThresholdAlertTime" field to the value that was provided on create.
-func (u *StrategyUpsert) UpdateLastUpperThresholdAlertTime() *StrategyUpsert {
-	u.SetExcluded(strategy.FieldLastUpperThresholdAlertTime)
+// SetTrailingStopLossAmount sets the "trailingStopLossAmount" field.
+func (u *StrategyUpsert) SetTrailingStopLossAmount(v decimal.Decimal) *StrategyUpsert {
+	u.Set(strategy.FieldTrailingStopLossAmount, v)
 	return u
 }
 
-// ClearLastUpperThresholdAlertTime clears the value of the "lastUpperThresholdAlertTime" field.
-func (u *StrategyUpsert) ClearLastUpperThresholdAlertTime() *StrategyUpsert {
-	u.SetNull(strategy.FieldLastUpperThresholdAlertTime)
+// UpdateTrailingStopLossAmount sets the "trailingStopLossAmount" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateTrailingStopLossAmount() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldTrailingStopLossAmount)
 	return u
 }
 
-// SetStatus sets the "status" field.
-func (u *StrategyUpsert) SetStatus(v strategy.Status) *StrategyUpsert {
-	u.Set(strategy.FieldStatus, v)
+// ClearTrailingStopLossAmount clears the value of the "trailingStopLossAmount" field.
+func (u *StrategyUpsert) ClearTrailingStopLossAmount() *StrategyUpsert {
+	u.SetNull(strategy.FieldTrailingStopLossAmount)
 	return u
 }
 
-// UpdateStatus sets the "status" field to the value that was provided on create.
-func (u *StrategyUpsert) UpdateStatus() *StrategyUpsert {
-	u.SetExcluded(strategy.FieldStatus)
+// SetTrailingStopLossPercent sets the "trailingStopLossPercent" field.
+func (u *StrategyUpsert) SetTrailingStopLossPercent(v decimal.Decimal) *StrategyUpsert {
+	u.Set(strategy.FieldTrailingStopLossPercent, v)
 	return u
 }
 
-// SetExchangeApiKey sets the "exchangeApiKey" field.
-func (u *StrategyUpsert) SetExchangeApiKey(v string) *StrategyUpsert {
-	u.Set(strategy.FieldExchangeApiKey, v)
+// UpdateTrailingStopLossPercent sets the "trailingStopLossPercent" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateTrailingStopLossPercent() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldTrailingStopLossPercent)
 	return u
 }
 
-// UpdateExchangeApiKey sets the "exchangeApiKey" field to the value that was provided on create.
-func (u *StrategyUpsert) UpdateExchangeApiKey() *StrategyUpsert {
-	u.SetExcluded(strategy.FieldExchangeApiKey)
+// ClearTrailingStopLossPercent clears the value of the "trailingStopLossPercent" field.
+func (u *StrategyUpsert) ClearTrailingStopLossPercent() *StrategyUpsert {
+	u.SetNull(strategy.FieldTrailingStopLossPercent)
 	return u
 }
 
-// SetExchangeSecretKey sets the "exchangeSecretKey" field.
-func (u *StrategyUpsert) SetExchangeSecretKey(v string) *StrategyUpsert {
-	u.Set(strategy.FieldExchangeSecretKey, v)
+// SetTrailingTakeProfitActivationPrice sets the "trailingTakeProfitActivationPrice" field.
+func (u *StrategyUpsert) SetTrailingTakeProfitActivationPrice(v decimal.Decimal) *StrategyUpsert {
+	u.Set(strategy.FieldTrailingTakeProfitActivationPrice, v)
 	return u
 }
 
-// UpdateExchangeSecretKey sets the "exchangeSecretKey" field to the value that was provided on create.
-func (u *StrategyUpsert) UpdateExchangeSecretKey() *StrategyUpsert {
-	u.SetExcluded(strategy.FieldExchangeSecretKey)
+// UpdateTrailingTakeProfitActivationPrice sets the "trailingTakeProfitActivationPrice" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateTrailingTakeProfitActivationPrice() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldTrailingTakeProfitActivationPrice)
 	return u
 }
 
-// SetExchangePassphrase sets the "exchangePassphrase" field.
-func (u *StrategyUpsert) SetExchangePassphrase(v string) *StrategyUpsert {
-	u.Set(strategy.FieldExchangePassphrase, v)
+// ClearTrailingTakeProfitActivationPrice clears the value of the "trailingTakeProfitActivationPrice" field.
+func (u *StrategyUpsert) ClearTrailingTakeProfitActivationPrice() *StrategyUpsert {
+	u.SetNull(strategy.FieldTrailingTakeProfitActivationPrice)
 	return u
 }
 
-// UpdateExchangePassphrase sets the "exchangePassphrase" field to the value that was provided on create.
-func (u *StrategyUpsert) UpdateExchangePassphrase() *StrategyUpsert {
-	u.SetExcluded(strategy.FieldExchangePassphrase)
+// SetTrailingTakeProfitAmount sets the "trailingTakeProfitAmount" field.
+func (u *StrategyUpsert) SetTrailingTakeProfitAmount(v decimal.Decimal) *StrategyUpsert {
+	u.Set(strategy.FieldTrailingTakeProfitAmount, v)
 	return u
 }
 
-// SetExchangeTestnet sets the "exchangeTestnet" field.
-func (u *StrategyUpsert) SetExchangeTestnet(v bool) *StrategyUpsert {
-	u.Set(strategy.FieldExchangeTestnet, v)
+// UpdateTrailingTakeProfitAmount sets the "trailingTakeProfitAmount" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateTrailingTakeProfitAmount() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldTrailingTakeProfitAmount)
 	return u
 }
 
-// UpdateExchangeTestnet sets the "exchangeTestnet" field to the value that was provided on create.
-func (u *StrategyUpsert) UpdateExchangeTestnet() *StrategyUpsert {
-	u.SetExcluded(strategy.FieldExchangeTestnet)
+// ClearTrailingTakeProfitAmount clears the value of the "trailingTakeProfitAmount" field.
+func (u *StrategyUpsert) ClearTrailingTakeProfitAmount() *StrategyUpsert {
+	u.SetNull(strategy.FieldTrailingTakeProfitAmount)
 	return u
 }
 
-// SetStartTime sets the "startTime" field.
-func (u *StrategyUpsert) SetStartTime(v time.Time) *StrategyUpsert {
-	u.Set(strategy.FieldStartTime, v)
+// SetTrailingTakeProfitPercent sets the "trailingTakeProfitPercent" field.
+func (u *StrategyUpsert) SetTrailingTakeProfitPercent(v decimal.Decimal) *StrategyUpsert {
+	u.Set(strategy.FieldTrailingTakeProfitPercent, v)
 	return u
 }
 
-// UpdateStartTime sets the "startTime" field to the value that was provided on create.
-func (u *StrategyUpsert) UpdateStartTime() *StrategyUpsert {
-	u.SetExcluded(strategy.FieldStartTime)
+// UpdateTrailingTakeProfitPercent sets the "trailingTakeProfitPercent" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateTrailingTakeProfitPercent() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldTrailingTakeProfitPercent)
 	return u
 }
 
-// ClearStartTime clears the value of the "startTime" field.
-func (u *StrategyUpsert) ClearStartTime() *StrategyUpsert {
-	u.SetNull(strategy.FieldStartTime)
+// ClearTrailingTakeProfitPercent clears the value of the "trailingTakeProfitPercent" field.
+func (u *StrategyUpsert) ClearTrailingTakeProfitPercent() *StrategyUpsert {
+	u.SetNull(strategy.FieldTrailingTakeProfitPercent)
 	return u
 }
 
-// UpdateNewValues updates the mutable fields using the new values that were set on create.
-// Using this option is equivalent to using:
-//
-//	client.Strategy.Create().
-//		OnConflict(
-//			sql.ResolveWithNewValues(),
-//		).
-//		Exec(ctx)
-func (u *StrategyUpsertOne) UpdateNewValues() *StrategyUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
-		if _, exists := u.create.mutation.CreateTime(); exists {
-			s.SetIgnore(strategy.FieldCreateTime)
-		}
-	}))
+// SetTrailingExtremePrice sets the "trailingExtremePrice" field.
+func (u *StrategyUpsert) SetTrailingExtremePrice(v decimal.Decimal) *StrategyUpsert {
+	u.Set(strategy.FieldTrailingExtremePrice, v)
 	return u
 }
 
-// Ignore sets each column to itself in case of conflict.
-// Using this option is equivalent to using:
-//
-//	client.Strategy.Create().
-//	    OnConflict(sql.ResolveWithIgnore()).
-//	    Exec(ctx)
-func (u *StrategyUpsertOne) Ignore() *StrategyUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+// UpdateTrailingExtremePrice sets the "trailingExtremePrice" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateTrailingExtremePrice() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldTrailingExtremePrice)
 	return u
 }
 
-// DoNothing configures the conflict_action to `DO NOTHING`.
-// Supported only by SQLite and PostgreSQL.
-func (u *StrategyUpsertOne) DoNothing() *StrategyUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+// ClearTrailingExtremePrice clears the value of the "trailingExtremePrice" field.
+func (u *StrategyUpsert) ClearTrailingExtremePrice() *StrategyUpsert {
+	u.SetNull(strategy.FieldTrailingExtremePrice)
 	return u
 }
 
-// Update allows overriding fields `UPDATE` values. See the StrategyCreate.OnConflict
-// documentation for more info.
-func (u *StrategyUpsertOne) Update(set func(*StrategyUpsert)) *StrategyUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
-		set(&StrategyUpsert{UpdateSet: update})
-	}))
+// SetTrailingLevels sets the "trailingLevels" field.
+func (u *StrategyUpsert) SetTrailingLevels(v int) *StrategyUpsert {
+	u.Set(strategy.FieldTrailingLevels, v)
 	return u
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (u *StrategyUpsertOne) SetUpdateTime(v time.Time) *StrategyUpsertOne {
-	return u.Update(func(s *StrategyUpsert) {
-		s.SetUpdateTime(v)
-	})
+// UpdateTrailingLevels sets the "trailingLevels" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateTrailingLevels() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldTrailingLevels)
+	return u
 }
 
-// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
-func (u *StrategyUpsertOne) UpdateUpdateTime() *StrategyUpsertOne {
-	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateUpdateTime()
-	})
+// AddTrailingLevels adds v to the "trailingLevels" field.
+func (u *StrategyUpsert) AddTrailingLevels(v int) *StrategyUpsert {
+	u.Add(strategy.FieldTrailingLevels, v)
+	return u
 }
 
-// SetGUID sets the "guid" field.
-func (u *StrategyUpsertOne) SetGUID(v string) *StrategyUpsertOne {
-	return u.Update(func(s *StrategyUpsert) {
-		s.SetGUID(v)
-	})
+// ClearTrailingLevels clears the value of the "trailingLevels" field.
+func (u *StrategyUpsert) ClearTrailingLevels() *StrategyUpsert {
+	u.SetNull(strategy.FieldTrailingLevels)
+	return u
 }
 
-// UpdateGUID sets the "guid" field to the value that was provided on create.
-func (u *StrategyUpsertOne) UpdateGUID() *StrategyUpsertOne {
-	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateGUID()
-	})
+// SetTrailingPriceUpperLimit sets the "trailingPriceUpperLimit" field.
+func (u *StrategyUpsert) SetTrailingPriceUpperLimit(v decimal.Decimal) *StrategyUpsert {
+	u.Set(strategy.FieldTrailingPriceUpperLimit, v)
+	return u
 }
 
-// SetOwner sets the "owner" field.
-func (u *StrategyUpsertOne) SetOwner(v int64) *StrategyUpsertOne {
-	return u.Update(func(s *StrategyUpsert) {
-		s.SetOwner(v)
-	})
+// UpdateTrailingPriceUpperLimit sets the "trailingPriceUpperLimit" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateTrailingPriceUpperLimit() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldTrailingPriceUpperLimit)
+	return u
 }
 
-// AddOwner adds v to the "owner" field.
-func (u *StrategyUpsertOne) AddOwner(v int64) *StrategyUpsertOne {
-	return u.Update(func(s *StrategyUpsert) {
-		s.AddOwner(v)
-	})
+// ClearTrailingPriceUpperLimit clears the value of the "trailingPriceUpperLimit" field.
+func (u *StrategyUpsert) ClearTrailingPriceUpperLimit() *StrategyUpsert {
+	u.SetNull(strategy.FieldTrailingPriceUpperLimit)
+	return u
 }
 
-// UpdateOwner sets the "owner" field to the value that was provided on create.
-func (u *StrategyUpsertOne) UpdateOwner() *StrategyUpsertOne {
-	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateOwner()
-	})
+// SetTrailingPriceLowerLimit sets the "trailingPriceLowerLimit" field.
+func (u *StrategyUpsert) SetTrailingPriceLowerLimit(v decimal.Decimal) *StrategyUpsert {
+	u.Set(strategy.FieldTrailingPriceLowerLimit, v)
+	return u
 }
 
-// SetExchange sets the "exchange" field.
-func (u *StrategyUpsertOne) SetExchange(v string) *StrategyUpsertOne {
-	return u.Update(func(s *StrategyUpsert) {
-		s.SetExchange(v)
-	})
+// UpdateTrailingPriceLowerLimit sets the "trailingPriceLowerLimit" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateTrailingPriceLowerLimit() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldTrailingPriceLowerLimit)
+	return u
 }
 
-// UpdateExchange sets the "exchange" field to the value that was provided on create.
-func (u *StrategyUpsertOne) UpdateExchange() *StrategyUpsertOne {
-	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateExchange()
-	})
+// ClearTrailingPriceLowerLimit clears the value of the "trailingPriceLowerLimit" field.
+func (u *StrategyUpsert) ClearTrailingPriceLowerLimit() *StrategyUpsert {
+	u.SetNull(strategy.FieldTrailingPriceLowerLimit)
+	return u
 }
 
-// SetSymbol sets the "symbol" field.
-func (u *StrategyUpsertOne) SetSymbol(v string) *StrategyUpsertOne {
-	return u.Update(func(s *StrategyUpsert) {
-		s.SetSymbol(v)
-	})
+// SetAtrPeriod sets the "atrPeriod" field.
+func (u *StrategyUpsert) SetAtrPeriod(v int) *StrategyUpsert {
+	u.Set(strategy.FieldAtrPeriod, v)
+	return u
 }
 
-// UpdateSymbol sets the "symbol" field to the value that was provided on create.
-func (u *StrategyUpsertOne) UpdateSymbol() *StrategyUpsertOne {
-	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateSymbol()
-	})
+// UpdateAtrPeriod sets the "atrPeriod" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateAtrPeriod() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldAtrPeriod)
+	return u
 }
 
-// SetAccount sets the "account" field.
-func (u *StrategyUpsertOne) SetAccount(v string) *StrategyUpsertOne {
-	return u.Update(func(s *StrategyUpsert) {
-		s.SetAccount(v)
-	})
+// AddAtrPeriod adds v to the "atrPeriod" field.
+func (u *StrategyUpsert) AddAtrPeriod(v int) *StrategyUpsert {
+	u.Add(strategy.FieldAtrPeriod, v)
+	return u
 }
 
-// UpdateAccount sets the "account" field to the value that was provided on create.
-func (u *StrategyUpsertOne) UpdateAccount() *StrategyUpsertOne {
-	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateAccount()
-	})
+// ClearAtrPeriod clears the value of the "atrPeriod" field.
+func (u *StrategyUpsert) ClearAtrPeriod() *StrategyUpsert {
+	u.SetNull(strategy.FieldAtrPeriod)
+	return u
 }
 
-// SetMode sets the "mode" field.
-func (u *StrategyUpsertOne) SetMode(v strategy.Mode) *StrategyUpsertOne {
-	return u.Update(func(s *StrategyUpsert) {
-		s.SetMode(v)
-	})
+// SetAtrMultiplier sets the "atrMultiplier" field.
+func (u *StrategyUpsert) SetAtrMultiplier(v decimal.Decimal) *StrategyUpsert {
+	u.Set(strategy.FieldAtrMultiplier, v)
+	return u
 }
 
-// UpdateMode sets the "mode" field to the value that was provided on create.
-func (u *StrategyUpsertOne) UpdateMode() *StrategyUpsertOne {
-	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateMode()
+// UpdateAtrMultiplier sets the "atrMultiplier" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateAtrMultiplier() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldAtrMultiplier)
+	return u
+}
+
+// ClearAtrMultiplier clears the value of the "atrMultiplier" field.
+func (u *StrategyUpsert) ClearAtrMultiplier() *StrategyUpsert {
+	u.SetNull(strategy.FieldAtrMultiplier)
+	return u
+}
+
+// SetAdaptiveThreshold sets the "adaptiveThreshold" field.
+func (u *StrategyUpsert) SetAdaptiveThreshold(v decimal.Decimal) *StrategyUpsert {
+	u.Set(strategy.FieldAdaptiveThreshold, v)
+	return u
+}
+
+// UpdateAdaptiveThreshold sets the "adaptiveThreshold" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateAdaptiveThreshold() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldAdaptiveThreshold)
+	return u
+}
+
+// ClearAdaptiveThreshold clears the value of the "adaptiveThreshold" field.
+func (u *StrategyUpsert) ClearAdaptiveThreshold() *StrategyUpsert {
+	u.SetNull(strategy.FieldAdaptiveThreshold)
+	return u
+}
+
+// SetAdaptiveIntervalHours sets the "adaptiveIntervalHours" field.
+func (u *StrategyUpsert) SetAdaptiveIntervalHours(v int) *StrategyUpsert {
+	u.Set(strategy.FieldAdaptiveIntervalHours, v)
+	return u
+}
+
+// UpdateAdaptiveIntervalHours sets the "adaptiveIntervalHours" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateAdaptiveIntervalHours() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldAdaptiveIntervalHours)
+	return u
+}
+
+// AddAdaptiveIntervalHours adds v to the "adaptiveIntervalHours" field.
+func (u *StrategyUpsert) AddAdaptiveIntervalHours(v int) *StrategyUpsert {
+	u.Add(strategy.FieldAdaptiveIntervalHours, v)
+	return u
+}
+
+// ClearAdaptiveIntervalHours clears the value of the "adaptiveIntervalHours" field.
+func (u *StrategyUpsert) ClearAdaptiveIntervalHours() *StrategyUpsert {
+	u.SetNull(strategy.FieldAdaptiveIntervalHours)
+	return u
+}
+
+// SetAdaptiveAtr sets the "adaptiveAtr" field.
+func (u *StrategyUpsert) SetAdaptiveAtr(v decimal.Decimal) *StrategyUpsert {
+	u.Set(strategy.FieldAdaptiveAtr, v)
+	return u
+}
+
+// UpdateAdaptiveAtr sets the "adaptiveAtr" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateAdaptiveAtr() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldAdaptiveAtr)
+	return u
+}
+
+// ClearAdaptiveAtr clears the value of the "adaptiveAtr" field.
+func (u *StrategyUpsert) ClearAdaptiveAtr() *StrategyUpsert {
+	u.SetNull(strategy.FieldAdaptiveAtr)
+	return u
+}
+
+// SetAdaptiveUpdatedAt sets the "adaptiveUpdatedAt" field.
+func (u *StrategyUpsert) SetAdaptiveUpdatedAt(v time.Time) *StrategyUpsert {
+	u.Set(strategy.FieldAdaptiveUpdatedAt, v)
+	return u
+}
+
+// UpdateAdaptiveUpdatedAt sets the "adaptiveUpdatedAt" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateAdaptiveUpdatedAt() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldAdaptiveUpdatedAt)
+	return u
+}
+
+// ClearAdaptiveUpdatedAt clears the value of the "adaptiveUpdatedAt" field.
+func (u *StrategyUpsert) ClearAdaptiveUpdatedAt() *StrategyUpsert {
+	u.SetNull(strategy.FieldAdaptiveUpdatedAt)
+	return u
+}
+
+// SetCancelRepairPolicy sets the "cancelRepairPolicy" field.
+func (u *StrategyUpsert) SetCancelRepairPolicy(v strategy.CancelRepairPolicy) *StrategyUpsert {
+	u.Set(strategy.FieldCancelRepairPolicy, v)
+	return u
+}
+
+// UpdateCancelRepairPolicy sets the "cancelRepairPolicy" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateCancelRepairPolicy() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldCancelRepairPolicy)
+	return u
+}
+
+// SetCancelRepairMaxAttempts sets the "cancelRepairMaxAttempts" field.
+func (u *StrategyUpsert) SetCancelRepairMaxAttempts(v int) *StrategyUpsert {
+	u.Set(strategy.FieldCancelRepairMaxAttempts, v)
+	return u
+}
+
+// UpdateCancelRepairMaxAttempts sets the "cancelRepairMaxAttempts" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateCancelRepairMaxAttempts() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldCancelRepairMaxAttempts)
+	return u
+}
+
+// AddCancelRepairMaxAttempts adds v to the "cancelRepairMaxAttempts" field.
+func (u *StrategyUpsert) AddCancelRepairMaxAttempts(v int) *StrategyUpsert {
+	u.Add(strategy.FieldCancelRepairMaxAttempts, v)
+	return u
+}
+
+// ClearCancelRepairMaxAttempts clears the value of the "cancelRepairMaxAttempts" field.
+func (u *StrategyUpsert) ClearCancelRepairMaxAttempts() *StrategyUpsert {
+	u.SetNull(strategy.FieldCancelRepairMaxAttempts)
+	return u
+}
+
+// SetReconcilePolicy sets the "reconcilePolicy" field.
+func (u *StrategyUpsert) SetReconcilePolicy(v strategy.ReconcilePolicy) *StrategyUpsert {
+	u.Set(strategy.FieldReconcilePolicy, v)
+	return u
+}
+
+// UpdateReconcilePolicy sets the "reconcilePolicy" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateReconcilePolicy() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldReconcilePolicy)
+	return u
+}
+
+// SetPartialFillThreshold sets the "partialFillThreshold" field.
+func (u *StrategyUpsert) SetPartialFillThreshold(v decimal.Decimal) *StrategyUpsert {
+	u.Set(strategy.FieldPartialFillThreshold, v)
+	return u
+}
+
+// UpdatePartialFillThreshold sets the "partialFillThreshold" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdatePartialFillThreshold() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldPartialFillThreshold)
+	return u
+}
+
+// ClearPartialFillThreshold clears the value of the "partialFillThreshold" field.
+func (u *StrategyUpsert) ClearPartialFillThreshold() *StrategyUpsert {
+	u.SetNull(strategy.FieldPartialFillThreshold)
+	return u
+}
+
+// SetEnablePushNotification sets the "enablePushNotification" field.
+func (u *StrategyUpsert) SetEnablePushNotification(v bool) *StrategyUpsert {
+	u.Set(strategy.FieldEnablePushNotification, v)
+	return u
+}
+
+// UpdateEnablePushNotification sets the "enablePushNotification" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateEnablePushNotification() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldEnablePushNotification)
+	return u
+}
+
+// SetEnablePushMatchedNotification sets the "enablePushMatchedNotification" field.
+func (u *StrategyUpsert) SetEnablePushMatchedNotification(v bool) *StrategyUpsert {
+	u.Set(strategy.FieldEnablePushMatchedNotification, v)
+	return u
+}
+
+// UpdateEnablePushMatchedNotification sets the "enablePushMatchedNotification" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateEnablePushMatchedNotification() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldEnablePushMatchedNotification)
+	return u
+}
+
+// ClearEnablePushMatchedNotification clears the value of the "enablePushMatchedNotification" field.
+func (u *StrategyUpsert) ClearEnablePushMatchedNotification() *StrategyUpsert {
+	u.SetNull(strategy.FieldEnablePushMatchedNotification)
+	return u
+}
+
+// SetLastLowerThresholdAlertTime sets the "lastLowerThresholdAlertTime" field.
+func (u *StrategyUpsert) SetLastLowerThresholdAlertTime(v time.Time) *StrategyUpsert {
+	u.Set(strategy.FieldLastLowerThresholdAlertTime, v)
+	return u
+}
+
+// UpdateLastLowerThresholdAlertTime sets the "lastLowerThresholdAlertTime" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateLastLowerThresholdAlertTime() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldLastLowerThresholdAlertTime)
+	return u
+}
+
+// ClearLastLowerThresholdAlertTime clears the value of the "lastLowerThresholdAlertTime" field.
+func (u *StrategyUpsert) ClearLastLowerThresholdAlertTime() *StrategyUpsert {
+	u.SetNull(strategy.FieldLastLowerThresholdAlertTime)
+	return u
+}
+
+// SetLastUpperThresholdAlertTime sets the "lastUpperThresholdAlertTime" field.
+func (u *StrategyUpsert) SetLastUpperThresholdAlertTime(v time.Time) *StrategyUpsert {
+	u.Set(strategy.FieldLastUpperThresholdAlertTime, v)
+	return u
+}
+
+// UpdateLastUpperThresholdAlertTime sets the "lastUpperThresholdAlertTime" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateLastUpperThresholdAlertTime() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldLastUpperThresholdAlertTime)
+	return u
+}
+
+// ClearLastUpperThresholdAlertTime clears the value of the "lastUpperThresholdAlertTime" field.
+func (u *StrategyUpsert) ClearLastUpperThresholdAlertTime() *StrategyUpsert {
+	u.SetNull(strategy.FieldLastUpperThresholdAlertTime)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *StrategyUpsert) SetStatus(v strategy.Status) *StrategyUpsert {
+	u.Set(strategy.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateStatus() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldStatus)
+	return u
+}
+
+// SetExchangeApiKey sets the "exchangeApiKey" field.
+func (u *StrategyUpsert) SetExchangeApiKey(v string) *StrategyUpsert {
+	u.Set(strategy.FieldExchangeApiKey, v)
+	return u
+}
+
+// UpdateExchangeApiKey sets the "exchangeApiKey" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateExchangeApiKey() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldExchangeApiKey)
+	return u
+}
+
+// SetExchangeSecretKey sets the "exchangeSecretKey" field.
+func (u *StrategyUpsert) SetExchangeSecretKey(v string) *StrategyUpsert {
+	u.Set(strategy.FieldExchangeSecretKey, v)
+	return u
+}
+
+// UpdateExchangeSecretKey sets the "exchangeSecretKey" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateExchangeSecretKey() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldExchangeSecretKey)
+	return u
+}
+
+// SetExchangePassphrase sets the "exchangePassphrase" field.
+func (u *StrategyUpsert) SetExchangePassphrase(v string) *StrategyUpsert {
+	u.Set(strategy.FieldExchangePassphrase, v)
+	return u
+}
+
+// UpdateExchangePassphrase sets the "exchangePassphrase" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateExchangePassphrase() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldExchangePassphrase)
+	return u
+}
+
+// SetExchangeTestnet sets the "exchangeTestnet" field.
+func (u *StrategyUpsert) SetExchangeTestnet(v bool) *StrategyUpsert {
+	u.Set(strategy.FieldExchangeTestnet, v)
+	return u
+}
+
+// UpdateExchangeTestnet sets the "exchangeTestnet" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateExchangeTestnet() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldExchangeTestnet)
+	return u
+}
+
+// SetAccountId sets the "accountId" field.
+func (u *StrategyUpsert) SetAccountId(v int) *StrategyUpsert {
+	u.Set(strategy.FieldAccountId, v)
+	return u
+}
+
+// UpdateAccountId sets the "accountId" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateAccountId() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldAccountId)
+	return u
+}
+
+// ClearAccountId clears the value of the "accountId" field.
+func (u *StrategyUpsert) ClearAccountId() *StrategyUpsert {
+	u.SetNull(strategy.FieldAccountId)
+	return u
+}
+
+// SetStartTime sets the "startTime" field.
+func (u *StrategyUpsert) SetStartTime(v time.Time) *StrategyUpsert {
+	u.Set(strategy.FieldStartTime, v)
+	return u
+}
+
+// UpdateStartTime sets the "startTime" field to the value that was provided on create.
+func (u *StrategyUpsert) UpdateStartTime() *StrategyUpsert {
+	u.SetExcluded(strategy.FieldStartTime)
+	return u
+}
+
+// ClearStartTime clears the value of the "startTime" field.
+func (u *StrategyUpsert) ClearStartTime() *StrategyUpsert {
+	u.SetNull(strategy.FieldStartTime)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Strategy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *StrategyUpsertOne) UpdateNewValues() *StrategyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(strategy.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Strategy.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *StrategyUpsertOne) Ignore() *StrategyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *StrategyUpsertOne) DoNothing() *StrategyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the StrategyCreate.OnConflict
+// documentation for more info.
+func (u *StrategyUpsertOne) Update(set func(*StrategyUpsert)) *StrategyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&StrategyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *StrategyUpsertOne) SetUpdateTime(v time.Time) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateUpdateTime() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetGUID sets the "guid" field.
+func (u *StrategyUpsertOne) SetGUID(v string) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetGUID(v)
+	})
+}
+
+// UpdateGUID sets the "guid" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateGUID() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateGUID()
+	})
+}
+
+// SetOwner sets the "owner" field.
+func (u *StrategyUpsertOne) SetOwner(v int64) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetOwner(v)
+	})
+}
+
+// AddOwner adds v to the "owner" field.
+func (u *StrategyUpsertOne) AddOwner(v int64) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.AddOwner(v)
+	})
+}
+
+// UpdateOwner sets the "owner" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateOwner() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateOwner()
+	})
+}
+
+// SetExchange sets the "exchange" field.
+func (u *StrategyUpsertOne) SetExchange(v string) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetExchange(v)
+	})
+}
+
+// UpdateExchange sets the "exchange" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateExchange() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateExchange()
+	})
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *StrategyUpsertOne) SetSymbol(v string) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetSymbol(v)
+	})
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateSymbol() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateSymbol()
+	})
+}
+
+// SetAccount sets the "account" field.
+func (u *StrategyUpsertOne) SetAccount(v string) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetAccount(v)
+	})
+}
+
+// UpdateAccount sets the "account" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateAccount() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateAccount()
+	})
+}
+
+// SetMode sets the "mode" field.
+func (u *StrategyUpsertOne) SetMode(v strategy.Mode) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetMode(v)
+	})
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateMode() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateMode()
+	})
+}
+
+// SetMarginMode sets the "marginMode" field.
+func (u *StrategyUpsertOne) SetMarginMode(v strategy.MarginMode) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetMarginMode(v)
+	})
+}
+
+// UpdateMarginMode sets the "marginMode" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateMarginMode() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateMarginMode()
+	})
+}
+
+// SetQuantityMode sets the "quantityMode" field.
+func (u *StrategyUpsertOne) SetQuantityMode(v strategy.QuantityMode) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetQuantityMode(v)
+	})
+}
+
+// UpdateQuantityMode sets the "quantityMode" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateQuantityMode() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateQuantityMode()
+	})
+}
+
+// SetPriceUpper sets the "priceUpper" field.
+func (u *StrategyUpsertOne) SetPriceUpper(v decimal.Decimal) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetPriceUpper(v)
+	})
+}
+
+// UpdatePriceUpper sets the "priceUpper" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdatePriceUpper() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdatePriceUpper()
+	})
+}
+
+// SetPriceLower sets the "priceLower" field.
+func (u *StrategyUpsertOne) SetPriceLower(v decimal.Decimal) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetPriceLower(v)
+	})
+}
+
+// UpdatePriceLower sets the "priceLower" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdatePriceLower() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdatePriceLower()
+	})
+}
+
+// SetGridNum sets the "gridNum" field.
+func (u *StrategyUpsertOne) SetGridNum(v int) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetGridNum(v)
+	})
+}
+
+// AddGridNum adds v to the "gridNum" field.
+func (u *StrategyUpsertOne) AddGridNum(v int) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.AddGridNum(v)
+	})
+}
+
+// UpdateGridNum sets the "gridNum" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateGridNum() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateGridNum()
+	})
+}
+
+// SetLeverage sets the "leverage" field.
+func (u *StrategyUpsertOne) SetLeverage(v int) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetLeverage(v)
+	})
+}
+
+// AddLeverage adds v to the "leverage" field.
+func (u *StrategyUpsertOne) AddLeverage(v int) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.AddLeverage(v)
+	})
+}
+
+// UpdateLeverage sets the "leverage" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateLeverage() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateLeverage()
+	})
+}
+
+// SetInitialOrderSize sets the "initialOrderSize" field.
+func (u *StrategyUpsertOne) SetInitialOrderSize(v decimal.Decimal) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetInitialOrderSize(v)
+	})
+}
+
+// UpdateInitialOrderSize sets the "initialOrderSize" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateInitialOrderSize() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateInitialOrderSize()
+	})
+}
+
+// SetSizingMode sets the "sizingMode" field.
+func (u *StrategyUpsertOne) SetSizingMode(v strategy.SizingMode) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetSizingMode(v)
+	})
+}
+
+// UpdateSizingMode sets the "sizingMode" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateSizingMode() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateSizingMode()
+	})
+}
+
+// SetSizingFactor sets the "sizingFactor" field.
+func (u *StrategyUpsertOne) SetSizingFactor(v decimal.Decimal) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetSizingFactor(v)
+	})
+}
+
+// UpdateSizingFactor sets the "sizingFactor" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateSizingFactor() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateSizingFactor()
+	})
+}
+
+// ClearSizingFactor clears the value of the "sizingFactor" field.
+func (u *StrategyUpsertOne) ClearSizingFactor() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearSizingFactor()
+	})
+}
+
+// SetSizingWeights sets the "sizingWeights" field.
+func (u *StrategyUpsertOne) SetSizingWeights(v string) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetSizingWeights(v)
+	})
+}
+
+// UpdateSizingWeights sets the "sizingWeights" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateSizingWeights() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateSizingWeights()
+	})
+}
+
+// ClearSizingWeights clears the value of the "sizingWeights" field.
+func (u *StrategyUpsertOne) ClearSizingWeights() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearSizingWeights()
+	})
+}
+
+// SetSlippageBps sets the "slippageBps" field.
+func (u *StrategyUpsertOne) SetSlippageBps(v int) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetSlippageBps(v)
+	})
+}
+
+// AddSlippageBps adds v to the "slippageBps" field.
+func (u *StrategyUpsertOne) AddSlippageBps(v int) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.AddSlippageBps(v)
+	})
+}
+
+// UpdateSlippageBps sets the "slippageBps" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateSlippageBps() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateSlippageBps()
+	})
+}
+
+// ClearSlippageBps clears the value of the "slippageBps" field.
+func (u *StrategyUpsertOne) ClearSlippageBps() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearSlippageBps()
+	})
+}
+
+// SetEntryPrice sets the "entryPrice" field.
+func (u *StrategyUpsertOne) SetEntryPrice(v decimal.Decimal) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetEntryPrice(v)
+	})
+}
+
+// UpdateEntryPrice sets the "entryPrice" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateEntryPrice() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateEntryPrice()
+	})
+}
+
+// ClearEntryPrice clears the value of the "entryPrice" field.
+func (u *StrategyUpsertOne) ClearEntryPrice() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearEntryPrice()
+	})
+}
+
+// SetTriggerStopLossPrice sets the "triggerStopLossPrice" field.
+func (u *StrategyUpsertOne) SetTriggerStopLossPrice(v decimal.Decimal) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetTriggerStopLossPrice(v)
+	})
+}
+
+// UpdateTriggerStopLossPrice sets the "triggerStopLossPrice" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateTriggerStopLossPrice() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateTriggerStopLossPrice()
+	})
+}
+
+// ClearTriggerStopLossPrice clears the value of the "triggerStopLossPrice" field.
+func (u *StrategyUpsertOne) ClearTriggerStopLossPrice() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearTriggerStopLossPrice()
+	})
+}
+
+// SetTriggerTakeProfitPrice sets the "triggerTakeProfitPrice" field.
+func (u *StrategyUpsertOne) SetTriggerTakeProfitPrice(v decimal.Decimal) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetTriggerTakeProfitPrice(v)
+	})
+}
+
+// UpdateTriggerTakeProfitPrice sets the "triggerTakeProfitPrice" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateTriggerTakeProfitPrice() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateTriggerTakeProfitPrice()
+	})
+}
+
+// ClearTriggerTakeProfitPrice clears the value of the "triggerTakeProfitPrice" field.
+func (u *StrategyUpsertOne) ClearTriggerTakeProfitPrice() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearTriggerTakeProfitPrice()
+	})
+}
+
+// SetMaxLossAmount sets the "maxLossAmount" field.
+func (u *StrategyUpsertOne) SetMaxLossAmount(v decimal.Decimal) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetMaxLossAmount(v)
+	})
+}
+
+// UpdateMaxLossAmount sets the "maxLossAmount" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateMaxLossAmount() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateMaxLossAmount()
+	})
+}
+
+// ClearMaxLossAmount clears the value of the "maxLossAmount" field.
+func (u *StrategyUpsertOne) ClearMaxLossAmount() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearMaxLossAmount()
+	})
+}
+
+// SetMaxLossPercent sets the "maxLossPercent" field.
+func (u *StrategyUpsertOne) SetMaxLossPercent(v decimal.Decimal) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetMaxLossPercent(v)
+	})
+}
+
+// UpdateMaxLossPercent sets the "maxLossPercent" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateMaxLossPercent() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateMaxLossPercent()
+	})
+}
+
+// ClearMaxLossPercent clears the value of the "maxLossPercent" field.
+func (u *StrategyUpsertOne) ClearMaxLossPercent() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearMaxLossPercent()
+	})
+}
+
+// SetProfitTargetAmount sets the "profitTargetAmount" field.
+func (u *StrategyUpsertOne) SetProfitTargetAmount(v decimal.Decimal) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetProfitTargetAmount(v)
+	})
+}
+
+// UpdateProfitTargetAmount sets the "profitTargetAmount" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateProfitTargetAmount() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateProfitTargetAmount()
+	})
+}
+
+// ClearProfitTargetAmount clears the value of the "profitTargetAmount" field.
+func (u *StrategyUpsertOne) ClearProfitTargetAmount() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearProfitTargetAmount()
+	})
+}
+
+// SetTrailingStopLossAmount sets the "trailingStopLossAmount" field.
+func (u *StrategyUpsertOne) SetTrailingStopLossAmount(v decimal.Decimal) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetTrailingStopLossAmount(v)
+	})
+}
+
+// UpdateTrailingStopLossAmount sets the "trailingStopLossAmount" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateTrailingStopLossAmount() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateTrailingStopLossAmount()
+	})
+}
+
+// ClearTrailingStopLossAmount clears the value of the "trailingStopLossAmount" field.
+func (u *StrategyUpsertOne) ClearTrailingStopLossAmount() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearTrailingStopLossAmount()
+	})
+}
+
+// SetTrailingStopLossPercent sets the "trailingStopLossPercent" field.
+func (u *StrategyUpsertOne) SetTrailingStopLossPercent(v decimal.Decimal) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetTrailingStopLossPercent(v)
+	})
+}
+
+// UpdateTrailingStopLossPercent sets the "trailingStopLossPercent" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateTrailingStopLossPercent() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateTrailingStopLossPercent()
+	})
+}
+
+// ClearTrailingStopLossPercent clears the value of the "trailingStopLossPercent" field.
+func (u *StrategyUpsertOne) ClearTrailingStopLossPercent() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearTrailingStopLossPercent()
+	})
+}
+
+// SetTrailingTakeProfitActivationPrice sets the "trailingTakeProfitActivationPrice" field.
+func (u *StrategyUpsertOne) SetTrailingTakeProfitActivationPrice(v decimal.Decimal) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetTrailingTakeProfitActivationPrice(v)
+	})
+}
+
+// UpdateTrailingTakeProfitActivationPrice sets the "trailingTakeProfitActivationPrice" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateTrailingTakeProfitActivationPrice() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateTrailingTakeProfitActivationPrice()
+	})
+}
+
+// ClearTrailingTakeProfitActivationPrice clears the value of the "trailingTakeProfitActivationPrice" field.
+func (u *StrategyUpsertOne) ClearTrailingTakeProfitActivationPrice() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearTrailingTakeProfitActivationPrice()
+	})
+}
+
+// SetTrailingTakeProfitAmount sets the "trailingTakeProfitAmount" field.
+func (u *StrategyUpsertOne) SetTrailingTakeProfitAmount(v decimal.Decimal) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetTrailingTakeProfitAmount(v)
+	})
+}
+
+// UpdateTrailingTakeProfitAmount sets the "trailingTakeProfitAmount" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateTrailingTakeProfitAmount() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateTrailingTakeProfitAmount()
+	})
+}
+
+// ClearTrailingTakeProfitAmount clears the value of the "trailingTakeProfitAmount" field.
+func (u *StrategyUpsertOne) ClearTrailingTakeProfitAmount() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearTrailingTakeProfitAmount()
+	})
+}
+
+// SetTrailingTakeProfitPercent sets the "trailingTakeProfitPercent" field.
+func (u *StrategyUpsertOne) SetTrailingTakeProfitPercent(v decimal.Decimal) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetTrailingTakeProfitPercent(v)
+	})
+}
+
+// UpdateTrailingTakeProfitPercent sets the "trailingTakeProfitPercent" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateTrailingTakeProfitPercent() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateTrailingTakeProfitPercent()
+	})
+}
+
+// ClearTrailingTakeProfitPercent clears the value of the "trailingTakeProfitPercent" field.
+func (u *StrategyUpsertOne) ClearTrailingTakeProfitPercent() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearTrailingTakeProfitPercent()
+	})
+}
+
+// SetTrailingExtremePrice sets the "trailingExtremePrice" field.
+func (u *StrategyUpsertOne) SetTrailingExtremePrice(v decimal.Decimal) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetTrailingExtremePrice(v)
+	})
+}
+
+// UpdateTrailingExtremePrice sets the "trailingExtremePrice" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateTrailingExtremePrice() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateTrailingExtremePrice()
+	})
+}
+
+// ClearTrailingExtremePrice clears the value of the "trailingExtremePrice" field.
+func (u *StrategyUpsertOne) ClearTrailingExtremePrice() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearTrailingExtremePrice()
+	})
+}
+
+// SetTrailingLevels sets the "trailingLevels" field.
+func (u *StrategyUpsertOne) SetTrailingLevels(v int) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetTrailingLevels(v)
+	})
+}
+
+// AddTrailingLevels adds v to the "trailingLevels" field.
+func (u *StrategyUpsertOne) AddTrailingLevels(v int) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.AddTrailingLevels(v)
+	})
+}
+
+// UpdateTrailingLevels sets the "trailingLevels" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateTrailingLevels() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateTrailingLevels()
+	})
+}
+
+// ClearTrailingLevels clears the value of the "trailingLevels" field.
+func (u *StrategyUpsertOne) ClearTrailingLevels() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearTrailingLevels()
+	})
+}
+
+// SetTrailingPriceUpperLimit sets the "trailingPriceUpperLimit" field.
+func (u *StrategyUpsertOne) SetTrailingPriceUpperLimit(v decimal.Decimal) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetTrailingPriceUpperLimit(v)
+	})
+}
+
+// UpdateTrailingPriceUpperLimit sets the "trailingPriceUpperLimit" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateTrailingPriceUpperLimit() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateTrailingPriceUpperLimit()
+	})
+}
+
+// ClearTrailingPriceUpperLimit clears the value of the "trailingPriceUpperLimit" field.
+func (u *StrategyUpsertOne) ClearTrailingPriceUpperLimit() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearTrailingPriceUpperLimit()
+	})
+}
+
+// SetTrailingPriceLowerLimit sets the "trailingPriceLowerLimit" field.
+func (u *StrategyUpsertOne) SetTrailingPriceLowerLimit(v decimal.Decimal) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetTrailingPriceLowerLimit(v)
+	})
+}
+
+// UpdateTrailingPriceLowerLimit sets the "trailingPriceLowerLimit" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateTrailingPriceLowerLimit() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateTrailingPriceLowerLimit()
+	})
+}
+
+// ClearTrailingPriceLowerLimit clears the value of the "trailingPriceLowerLimit" field.
+func (u *StrategyUpsertOne) ClearTrailingPriceLowerLimit() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearTrailingPriceLowerLimit()
+	})
+}
+
+// SetAtrPeriod sets the "atrPeriod" field.
+func (u *StrategyUpsertOne) SetAtrPeriod(v int) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetAtrPeriod(v)
+	})
+}
+
+// AddAtrPeriod adds v to the "atrPeriod" field.
+func (u *StrategyUpsertOne) AddAtrPeriod(v int) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.AddAtrPeriod(v)
+	})
+}
+
+// UpdateAtrPeriod sets the "atrPeriod" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateAtrPeriod() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateAtrPeriod()
+	})
+}
+
+// ClearAtrPeriod clears the value of the "atrPeriod" field.
+func (u *StrategyUpsertOne) ClearAtrPeriod() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearAtrPeriod()
+	})
+}
+
+// SetAtrMultiplier sets the "atrMultiplier" field.
+func (u *StrategyUpsertOne) SetAtrMultiplier(v decimal.Decimal) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetAtrMultiplier(v)
+	})
+}
+
+// UpdateAtrMultiplier sets the "atrMultiplier" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateAtrMultiplier() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateAtrMultiplier()
+	})
+}
+
+// ClearAtrMultiplier clears the value of the "atrMultiplier" field.
+func (u *StrategyUpsertOne) ClearAtrMultiplier() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearAtrMultiplier()
+	})
+}
+
+// SetAdaptiveThreshold sets the "adaptiveThreshold" field.
+func (u *StrategyUpsertOne) SetAdaptiveThreshold(v decimal.Decimal) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetAdaptiveThreshold(v)
+	})
+}
+
+// UpdateAdaptiveThreshold sets the "adaptiveThreshold" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateAdaptiveThreshold() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateAdaptiveThreshold()
+	})
+}
+
+// ClearAdaptiveThreshold clears the value of the "adaptiveThreshold" field.
+func (u *StrategyUpsertOne) ClearAdaptiveThreshold() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearAdaptiveThreshold()
+	})
+}
+
+// SetAdaptiveIntervalHours sets the "adaptiveIntervalHours" field.
+func (u *StrategyUpsertOne) SetAdaptiveIntervalHours(v int) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetAdaptiveIntervalHours(v)
+	})
+}
+
+// AddAdaptiveIntervalHours adds v to the "adaptiveIntervalHours" field.
+func (u *StrategyUpsertOne) AddAdaptiveIntervalHours(v int) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.AddAdaptiveIntervalHours(v)
+	})
+}
+
+// UpdateAdaptiveIntervalHours sets the "adaptiveIntervalHours" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateAdaptiveIntervalHours() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateAdaptiveIntervalHours()
+	})
+}
+
+// ClearAdaptiveIntervalHours clears the value of the "adaptiveIntervalHours" field.
+func (u *StrategyUpsertOne) ClearAdaptiveIntervalHours() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearAdaptiveIntervalHours()
+	})
+}
+
+// SetAdaptiveAtr sets the "adaptiveAtr" field.
+func (u *StrategyUpsertOne) SetAdaptiveAtr(v decimal.Decimal) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetAdaptiveAtr(v)
+	})
+}
+
+// UpdateAdaptiveAtr sets the "adaptiveAtr" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateAdaptiveAtr() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateAdaptiveAtr()
+	})
+}
+
+// ClearAdaptiveAtr clears the value of the "adaptiveAtr" field.
+func (u *StrategyUpsertOne) ClearAdaptiveAtr() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearAdaptiveAtr()
+	})
+}
+
+// SetAdaptiveUpdatedAt sets the "adaptiveUpdatedAt" field.
+func (u *StrategyUpsertOne) SetAdaptiveUpdatedAt(v time.Time) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetAdaptiveUpdatedAt(v)
+	})
+}
+
+// UpdateAdaptiveUpdatedAt sets the "adaptiveUpdatedAt" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateAdaptiveUpdatedAt() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateAdaptiveUpdatedAt()
+	})
+}
+
+// ClearAdaptiveUpdatedAt clears the value of the "adaptiveUpdatedAt" field.
+func (u *StrategyUpsertOne) ClearAdaptiveUpdatedAt() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearAdaptiveUpdatedAt()
+	})
+}
+
+// SetCancelRepairPolicy sets the "cancelRepairPolicy" field.
+func (u *StrategyUpsertOne) SetCancelRepairPolicy(v strategy.CancelRepairPolicy) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetCancelRepairPolicy(v)
+	})
+}
+
+// UpdateCancelRepairPolicy sets the "cancelRepairPolicy" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateCancelRepairPolicy() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateCancelRepairPolicy()
+	})
+}
+
+// SetCancelRepairMaxAttempts sets the "cancelRepairMaxAttempts" field.
+func (u *StrategyUpsertOne) SetCancelRepairMaxAttempts(v int) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetCancelRepairMaxAttempts(v)
+	})
+}
+
+// AddCancelRepairMaxAttempts adds v to the "cancelRepairMaxAttempts" field.
+func (u *StrategyUpsertOne) AddCancelRepairMaxAttempts(v int) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.AddCancelRepairMaxAttempts(v)
+	})
+}
+
+// UpdateCancelRepairMaxAttempts sets the "cancelRepairMaxAttempts" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateCancelRepairMaxAttempts() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateCancelRepairMaxAttempts()
+	})
+}
+
+// ClearCancelRepairMaxAttempts clears the value of the "cancelRepairMaxAttempts" field.
+func (u *StrategyUpsertOne) ClearCancelRepairMaxAttempts() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearCancelRepairMaxAttempts()
+	})
+}
+
+// SetReconcilePolicy sets the "reconcilePolicy" field.
+func (u *StrategyUpsertOne) SetReconcilePolicy(v strategy.ReconcilePolicy) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetReconcilePolicy(v)
+	})
+}
+
+// UpdateReconcilePolicy sets the "reconcilePolicy" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateReconcilePolicy() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateReconcilePolicy()
+	})
+}
+
+// SetPartialFillThreshold sets the "partialFillThreshold" field.
+func (u *StrategyUpsertOne) SetPartialFillThreshold(v decimal.Decimal) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetPartialFillThreshold(v)
+	})
+}
+
+// UpdatePartialFillThreshold sets the "partialFillThreshold" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdatePartialFillThreshold() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdatePartialFillThreshold()
+	})
+}
+
+// ClearPartialFillThreshold clears the value of the "partialFillThreshold" field.
+func (u *StrategyUpsertOne) ClearPartialFillThreshold() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearPartialFillThreshold()
+	})
+}
+
+// SetEnablePushNotification sets the "enablePushNotification" field.
+func (u *StrategyUpsertOne) SetEnablePushNotification(v bool) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetEnablePushNotification(v)
+	})
+}
+
+// UpdateEnablePushNotification sets the "enablePushNotification" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateEnablePushNotification() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateEnablePushNotification()
+	})
+}
+
+// SetEnablePushMatchedNotification sets the "enablePushMatchedNotification" field.
+func (u *StrategyUpsertOne) SetEnablePushMatchedNotification(v bool) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetEnablePushMatchedNotification(v)
+	})
+}
+
+// UpdateEnablePushMatchedNotification sets the "enablePushMatchedNotification" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateEnablePushMatchedNotification() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateEnablePushMatchedNotification()
+	})
+}
+
+// ClearEnablePushMatchedNotification clears the value of the "enablePushMatchedNotification" field.
+func (u *StrategyUpsertOne) ClearEnablePushMatchedNotification() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearEnablePushMatchedNotification()
+	})
+}
+
+// SetLastLowerThresholdAlertTime sets the "lastLowerThresholdAlertTime" field.
+func (u *StrategyUpsertOne) SetLastLowerThresholdAlertTime(v time.Time) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetLastLowerThresholdAlertTime(v)
+	})
+}
+
+// UpdateLastLowerThresholdAlertTime sets the "lastLowerThresholdAlertTime" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateLastLowerThresholdAlertTime() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateLastLowerThresholdAlertTime()
+	})
+}
+
+// ClearLastLowerThresholdAlertTime clears the value of the "lastLowerThresholdAlertTime" field.
+func (u *StrategyUpsertOne) ClearLastLowerThresholdAlertTime() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearLastLowerThresholdAlertTime()
+	})
+}
+
+// SetLastUpperThresholdAlertTime sets the "lastUpperThresholdAlertTime" field.
+func (u *StrategyUpsertOne) SetLastUpperThresholdAlertTime(v time.Time) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetLastUpperThresholdAlertTime(v)
+	})
+}
+
+// UpdateLastUpperThresholdAlertTime sets the "lastUpperThresholdAlertTime" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateLastUpperThresholdAlertTime() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateLastUpperThresholdAlertTime()
+	})
+}
+
+// ClearLastUpperThresholdAlertTime clears the value of the "lastUpperThresholdAlertTime" field.
+func (u *StrategyUpsertOne) ClearLastUpperThresholdAlertTime() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearLastUpperThresholdAlertTime()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *StrategyUpsertOne) SetStatus(v strategy.Status) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateStatus() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetExchangeApiKey sets the "exchangeApiKey" field.
+func (u *StrategyUpsertOne) SetExchangeApiKey(v string) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetExchangeApiKey(v)
+	})
+}
+
+// UpdateExchangeApiKey sets the "exchangeApiKey" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateExchangeApiKey() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateExchangeApiKey()
+	})
+}
+
+// SetExchangeSecretKey sets the "exchangeSecretKey" field.
+func (u *StrategyUpsertOne) SetExchangeSecretKey(v string) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetExchangeSecretKey(v)
+	})
+}
+
+// UpdateExchangeSecretKey sets the "exchangeSecretKey" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateExchangeSecretKey() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateExchangeSecretKey()
+	})
+}
+
+// SetExchangePassphrase sets the "exchangePassphrase" field.
+func (u *StrategyUpsertOne) SetExchangePassphrase(v string) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetExchangePassphrase(v)
+	})
+}
+
+// UpdateExchangePassphrase sets the "exchangePassphrase" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateExchangePassphrase() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateExchangePassphrase()
+	})
+}
+
+// SetExchangeTestnet sets the "exchangeTestnet" field.
+func (u *StrategyUpsertOne) SetExchangeTestnet(v bool) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetExchangeTestnet(v)
+	})
+}
+
+// UpdateExchangeTestnet sets the "exchangeTestnet" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateExchangeTestnet() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateExchangeTestnet()
+	})
+}
+
+// SetAccountId sets the "accountId" field.
+func (u *StrategyUpsertOne) SetAccountId(v int) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetAccountId(v)
+	})
+}
+
+// UpdateAccountId sets the "accountId" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateAccountId() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateAccountId()
+	})
+}
+
+// ClearAccountId clears the value of the "accountId" field.
+func (u *StrategyUpsertOne) ClearAccountId() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearAccountId()
+	})
+}
+
+// SetStartTime sets the "startTime" field.
+func (u *StrategyUpsertOne) SetStartTime(v time.Time) *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetStartTime(v)
+	})
+}
+
+// UpdateStartTime sets the "startTime" field to the value that was provided on create.
+func (u *StrategyUpsertOne) UpdateStartTime() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateStartTime()
+	})
+}
+
+// ClearStartTime clears the value of the "startTime" field.
+func (u *StrategyUpsertOne) ClearStartTime() *StrategyUpsertOne {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearStartTime()
+	})
+}
+
+// Exec executes the query.
+func (u *StrategyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for StrategyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *StrategyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *StrategyUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *StrategyUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// StrategyCreateBulk is the builder for creating many Strategy entities in bulk.
+type StrategyCreateBulk struct {
+	config
+	err      error
+	builders []*StrategyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Strategy entities in the database.
+func (_c *StrategyCreateBulk) Save(ctx context.Context) ([]*Strategy, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Strategy, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StrategyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i], err = builder.createSpec()
+				if err != nil {
+					return nil, err
+				}
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *StrategyCreateBulk) SaveX(ctx context.Context) []*Strategy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StrategyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StrategyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Strategy.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.StrategyUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *StrategyCreateBulk) OnConflict(opts ...sql.ConflictOption) *StrategyUpsertBulk {
+	_c.conflict = opts
+	return &StrategyUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Strategy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *StrategyCreateBulk) OnConflictColumns(columns ...string) *StrategyUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &StrategyUpsertBulk{
+		create: _c,
+	}
+}
+
+// StrategyUpsertBulk is the builder for "upsert"-ing
+// a bulk of Strategy nodes.
+type StrategyUpsertBulk struct {
+	create *StrategyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Strategy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *StrategyUpsertBulk) UpdateNewValues() *StrategyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(strategy.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Strategy.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *StrategyUpsertBulk) Ignore() *StrategyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *StrategyUpsertBulk) DoNothing() *StrategyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the StrategyCreateBulk.OnConflict
+// documentation for more info.
+func (u *StrategyUpsertBulk) Update(set func(*StrategyUpsert)) *StrategyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&StrategyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *StrategyUpsertBulk) SetUpdateTime(v time.Time) *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateUpdateTime() *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetGUID sets the "guid" field.
+func (u *StrategyUpsertBulk) SetGUID(v string) *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetGUID(v)
+	})
+}
+
+// UpdateGUID sets the "guid" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateGUID() *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateGUID()
+	})
+}
+
+// SetOwner sets the "owner" field.
+func (u *StrategyUpsertBulk) SetOwner(v int64) *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetOwner(v)
+	})
+}
+
+// AddOwner adds v to the "owner" field.
+func (u *StrategyUpsertBulk) AddOwner(v int64) *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.AddOwner(v)
+	})
+}
+
+// UpdateOwner sets the "owner" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateOwner() *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateOwner()
+	})
+}
+
+// SetExchange sets the "exchange" field.
+func (u *StrategyUpsertBulk) SetExchange(v string) *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetExchange(v)
+	})
+}
+
+// UpdateExchange sets the "exchange" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateExchange() *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateExchange()
+	})
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *StrategyUpsertBulk) SetSymbol(v string) *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetSymbol(v)
+	})
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateSymbol() *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateSymbol()
+	})
+}
+
+// SetAccount sets the "account" field.
+func (u *StrategyUpsertBulk) SetAccount(v string) *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetAccount(v)
+	})
+}
+
+// UpdateAccount sets the "account" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateAccount() *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateAccount()
+	})
+}
+
+// SetMode sets the "mode" field.
+func (u *StrategyUpsertBulk) SetMode(v strategy.Mode) *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetMode(v)
+	})
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateMode() *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateMode()
 	})
 }
 
 // SetMarginMode sets the "marginMode" field.
-func (u *StrategyUpsertOne) SetMarginMode(v strategy.MarginMode) *StrategyUpsertOne {
+func (u *StrategyUpsertBulk) SetMarginMode(v strategy.MarginMode) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
 		s.SetMarginMode(v)
 	})
 }
 
 // UpdateMarginMode sets the "marginMode" field to the value that was provided on create.
-func (u *StrategyUpsertOne) UpdateMarginMode() *StrategyUpsertOne {
+func (u *StrategyUpsertBulk) UpdateMarginMode() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
 		s.UpdateMarginMode()
 	})
 }
 
 // SetQuantityMode sets the "quantityMode" field.
-func (u *StrategyUpsertOne) SetQuantityMode(v strategy.QuantityMode) *StrategyUpsertOne {
+func (u *StrategyUpsertBulk) SetQuantityMode(v strategy.QuantityMode) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
 		s.SetQuantityMode(v)
 	})
 }
 
 // UpdateQuantityMode sets the "quantityMode" field to the value that was provided on create.
-func (u *StrategyUpsertOne) UpdateQuantityMode() *StrategyUpsertOne {
+func (u *StrategyUpsertBulk) UpdateQuantityMode() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
 		s.UpdateQuantityMode()
 	})
 }
 
 // SetPriceUpper sets the "priceUpper" field.
-func (u *StrategyUpsertOne) SetPriceUpper(v decimal.Decimal) *StrategyUpsertOne {
+func (u *StrategyUpsertBulk) SetPriceUpper(v decimal.Decimal) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
 		s.SetPriceUpper(v)
 	})
 }
 
 // UpdatePriceUpper sets the "priceUpper" field to the value that was provided on create.
-func (u *StrategyUpsertOne) UpdatePriceUpper() *StrategyUpsertOne {
+func (u *StrategyUpsertBulk) UpdatePriceUpper() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
 		s.UpdatePriceUpper()
 	})
 }
 
 // SetPriceLower sets the "priceLower" field.
-func (u *StrategyUpsertOne) SetPriceLower(v decimal.Decimal) *StrategyUpsertOne {
+func (u *StrategyUpsertBulk) SetPriceLower(v decimal.Decimal) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
 		s.SetPriceLower(v)
 	})
 }
 
 // UpdatePriceLower sets the "priceLower" field to the value that was provided on create.
-func (u *StrategyUpsertOne) UpdatePriceLower() *StrategyUpsertOne {
+func (u *StrategyUpsertBulk) UpdatePriceLower() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
 		s.UpdatePriceLower()
 	})
 }
 
 // SetGridNum sets the "gridNum" field.
-func (u *StrategyUpsertOne) SetGridNum(v int) *StrategyUpsertOne {
+func (u *StrategyUpsertBulk) SetGridNum(v int) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
 		s.SetGridNum(v)
 	})
 }
 
 // AddGridNum adds v to the "gridNum" field.
-func (u *StrategyUpsertOne) AddGridNum(v int) *StrategyUpsertOne {
+func (u *StrategyUpsertBulk) AddGridNum(v int) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
 		s.AddGridNum(v)
 	})
 }
 
 // UpdateGridNum sets the "gridNum" field to the value that was provided on create.
-func (u *StrategyUpsertOne) UpdateGridNum() *StrategyUpsertOne {
+func (u *StrategyUpsertBulk) UpdateGridNum() *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateGridNum()
+	})
+}
+
+// SetLeverage sets the "leverage" field.
+func (u *StrategyUpsertBulk) SetLeverage(v int) *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetLeverage(v)
+	})
+}
+
+// AddLeverage adds v to the "leverage" field.
+func (u *StrategyUpsertBulk) AddLeverage(v int) *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.AddLeverage(v)
+	})
+}
+
+// UpdateLeverage sets the "leverage" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateLeverage() *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateLeverage()
+	})
+}
+
+// SetInitialOrderSize sets the "initialOrderSize" field.
+func (u *StrategyUpsertBulk) SetInitialOrderSize(v decimal.Decimal) *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetInitialOrderSize(v)
+	})
+}
+
+// UpdateInitialOrderSize sets the "initialOrderSize" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateInitialOrderSize() *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateInitialOrderSize()
+	})
+}
+
+// SetSizingMode sets the "sizingMode" field.
+func (u *StrategyUpsertBulk) SetSizingMode(v strategy.SizingMode) *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetSizingMode(v)
+	})
+}
+
+// UpdateSizingMode sets the "sizingMode" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateSizingMode() *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateSizingMode()
+	})
+}
+
+// SetSizingFactor sets the "sizingFactor" field.
+func (u *StrategyUpsertBulk) SetSizingFactor(v decimal.Decimal) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateGridNum()
+		s.SetSizingFactor(v)
 	})
 }
 
-// SetLeverage sets the "leverage" field.
-func (u *StrategyUpsertOne) SetLeverage(v int) *StrategyUpsertOne {
+// UpdateSizingFactor sets the "sizingFactor" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateSizingFactor() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.SetLeverage(v)
+		s.UpdateSizingFactor()
 	})
 }
 
-// AddLeverage adds v to the "leverage" field.
-func (u *StrategyUpsertOne) AddLeverage(v int) *StrategyUpsertOne {
+// ClearSizingFactor clears the value of the "sizingFactor" field.
+func (u *StrategyUpsertBulk) ClearSizingFactor() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.AddLeverage(v)
+		s.ClearSizingFactor()
 	})
 }
 
-// UpdateLeverage sets the "leverage" field to the value that was provided on create.
-func (u *StrategyUpsertOne) UpdateLeverage() *StrategyUpsertOne {
+// SetSizingWeights sets the "sizingWeights" field.
+func (u *StrategyUpsertBulk) SetSizingWeights(v string) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateLeverage()
+		s.SetSizingWeights(v)
 	})
 }
 
-// SetInitialOrderSize sets the "initialOrderSize" field.
-func (u *StrategyUpsertOne) SetInitialOrderSize(v decimal.Decimal) *StrategyUpsertOne {
+// UpdateSizingWeights sets the "sizingWeights" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateSizingWeights() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.SetInitialOrderSize(v)
+		s.UpdateSizingWeights()
 	})
 }
 
-// UpdateInitialOrderSize sets the "initialOrderSize" field to the value that was provided on create.
-func (u *StrategyUpsertOne) UpdateInitialOrderSize() *StrategyUpsertOne {
+// ClearSizingWeights clears the value of the "sizingWeights" field.
+func (u *StrategyUpsertBulk) ClearSizingWeights() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateInitialOrderSize()
+		s.ClearSizingWeights()
 	})
 }
 
 // SetSlippageBps sets the "slippageBps" field.
-func (u *StrategyUpsertOne) SetSlippageBps(v int) *StrategyUpsertOne {
+func (u *StrategyUpsertBulk) SetSlippageBps(v int) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
 		s.SetSlippageBps(v)
 	})
 }
 
 // AddSlippageBps adds v to the "slippageBps" field.
-func (u *StrategyUpsertOne) AddSlippageBps(v int) *StrategyUpsertOne {
+func (u *StrategyUpsertBulk) AddSlippageBps(v int) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
 		s.AddSlippageBps(v)
 	})
 }
 
 // UpdateSlippageBps sets the "slippageBps" field to the value that was provided on create.
-func (u *StrategyUpsertOne) UpdateSlippageBps() *StrategyUpsertOne {
+func (u *StrategyUpsertBulk) UpdateSlippageBps() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
 		s.UpdateSlippageBps()
 	})
 }
 
 // ClearSlippageBps clears the value of the "slippageBps" field.
-func (u *StrategyUpsertOne) ClearSlippageBps() *StrategyUpsertOne {
+func (u *StrategyUpsertBulk) ClearSlippageBps() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
 		s.ClearSlippageBps()
 	})
 }
 
 // SetEntryPrice sets the "entryPrice" field.
-func (u *StrategyUpsertOne) SetEntryPrice(v decimal.Decimal) *StrategyUpsertOne {
+func (u *StrategyUpsertBulk) SetEntryPrice(v decimal.Decimal) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
 		s.SetEntryPrice(v)
 	})
 }
 
 // UpdateEntryPrice sets the "entryPrice" field to the value that was provided on create.
-func (u *StrategyUpsertOne) UpdateEntryPrice() *StrategyUpsertOne {
+func (u *StrategyUpsertBulk) UpdateEntryPrice() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
 		s.UpdateEntryPrice()
 	})
 }
 
 // ClearEntryPrice clears the value of the "entryPrice" field.
-func (u *StrategyUpsertOne) ClearEntryPrice() *StrategyUpsertOne {
+func (u *StrategyUpsertBulk) ClearEntryPrice() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
 		s.ClearEntryPrice()
 	})
 }
 
 // SetTriggerStopLossPrice sets the "triggerStopLossPrice" field.
-func (u *StrategyUpsertOne) SetTriggerStopLossPrice(v decimal.Decimal) *StrategyUpsertOne {
+func (u *StrategyUpsertBulk) SetTriggerStopLossPrice(v decimal.Decimal) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
 		s.SetTriggerStopLossPrice(v)
 	})
 }
 
 // UpdateTriggerStopLossPrice sets the "triggerStopLossPrice" field to the value that was provided on create.
-func (u *StrategyUpsertOne) UpdateTriggerStopLossPrice() *StrategyUpsertOne {
+func (u *StrategyUpsertBulk) UpdateTriggerStopLossPrice() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
 		s.UpdateTriggerStopLossPrice()
 	})
 }
 
 // ClearTriggerStopLossPrice clears the value of the "triggerStopLossPrice" field.
-func (u *StrategyUpsertOne) ClearTriggerStopLossPrice() *StrategyUpsertOne {
+func (u *StrategyUpsertBulk) ClearTriggerStopLossPrice() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
 		s.ClearTriggerStopLossPrice()
 	})
 }
 
 // SetTriggerTakeProfitPrice sets the "triggerTakeProfitPrice" field.
-func (u *StrategyUpsertOne) SetTriggerTakeProfitPrice(v decimal.Decimal) *StrategyUpsertOne {
+func (u *StrategyUpsertBulk) SetTriggerTakeProfitPrice(v decimal.Decimal) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
 		s.SetTriggerTakeProfitPrice(v)
 	})
 }
 
 // UpdateTriggerTakeProfitPrice sets the "triggerTakeProfitPrice" field to the value that was provided on create.
-func (u *StrategyUpsertOne) UpdateTriggerTakeProfitPrice() *StrategyUpsertOne {
+func (u *StrategyUpsertBulk) UpdateTriggerTakeProfitPrice() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
 		s.UpdateTriggerTakeProfitPrice()
 	})
 }
 
 // ClearTriggerTakeProfitPrice clears the value of the "triggerTakeProfitPrice" field.
-func (u *StrategyUpsertOne) ClearTriggerTakeProfitPrice() *StrategyUpsertOne {
+func (u *StrategyUpsertBulk) ClearTriggerTakeProfitPrice() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
 		s.ClearTriggerTakeProfitPrice()
 	})
 }
 
-// SetEnablePushNotification sets the "enablePushNotification" field.
-func (u *StrategyUpsertOne) SetEnablePushNotification(v bool) *StrategyUpsertOne {
-	return u.Update(func(s *StrategyUpsert) {
-		s.SetEnablePushNotification(v)
-	})
-}
-
-// UpdateEnablePushNotification sets the "enablePushNotification" field to the value that was provided on create.
-func (u *StrategyUpsertOne) UpdateEnablePushNotification() *StrategyUpsertOne {
-	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateEnablePushNotification()
-	})
-}
-
-// SetEnablePushMatchedNotification sets the "enablePushMatchedNotification" field.
-func (u *StrategyUpsertOne) SetEnablePushMatchedNotification(v bool) *StrategyUpsertOne {
-	return u.Update(func(s *StrategyUpsert) {
-		s.SetEnablePushMatchedNotification(v)
-	})
-}
-
-// UpdateEnablePushMatchedNotification sets the "enablePushMatchedNotification" field to the value that was provided on create.
-func (u *StrategyUpsertOne) UpdateEnablePushMatchedNotification() *StrategyUpsertOne {
-	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateEnablePushMatchedNotification()
-	})
-}
-
-// ClearEnablePushMatchedNotification clears the value of the "enablePushMatchedNotification" field.
-func (u *StrategyUpsertOne) ClearEnablePushMatchedNotification() *StrategyUpsertOne {
-	return u.Update(func(s *StrategyUpsert) {
-		s.ClearEnablePushMatchedNotification()
-	})
-}
-
-// SetLastLowerThresholdAlertTime sets the "lastLowerThresholdAlertTime" field.
-func (u *StrategyUpsertOne) SetLastLowerThresholdAlertTime(v time.Time) *StrategyUpsertOne {
-	return u.Update(func(s *StrategyUpsert) {
-		s.SetLastLowerThresholdAlertTime(v)
-	})
-}
-
-// UpdateLastLowerThresholdAlertTime sets the "lastLowerThresholdAlertTime" field to the value that was provided on create.
-func (u *StrategyUpsertOne) UpdateLastLowerThresholdAlertTime() *StrategyUpsertOne {
-	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateLastLowerThresholdAlertTime()
-	})
-}
-
-// ClearLastLowerThresholdAlertTime clears the value of the "lastLowerThresholdAlertTime" field.
-func (u *StrategyUpsertOne) ClearLastLowerThresholdAlertTime() *StrategyUpsertOne {
-	return u.Update(func(s *StrategyUpsert) {
-		s.ClearLastLowerThresholdAlertTime()
-	})
-}
-
-// SetLastUpperThresholdAlertTime sets the "lastUpperThresholdAlertTime" field.
-func (u *StrategyUpsertOne) SetLastUpperThresholdAlertTime(v time.Time) *StrategyUpsertOne {
-	return u.Update(func(s *StrategyUpsert) {
-		s.SetLastUpperThresholdAlertTime(v)
-	})
-}
-
-// UpdateLastUpperThresholdAlertTime sets the "lastUpperThresholdAlertTime" field to the value that was provided on create.
-func (u *StrategyUpsertOne) UpdateLastUpperThresholdAlertTime() *StrategyUpsertOne {
-	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateLastUpperThresholdAlertTime()
-	})
-}
-
-// ClearLastUpperThresholdAlertTime clears the value of the "lastUpperThresholdAlertTime" field.
-func (u *StrategyUpsertOne) ClearLastUpperThresholdAlertTime() *StrategyUpsertOne {
-	return u.Update(func(s *StrategyUpsert) {
-		s.ClearLastUpperThresholdAlertTime()
-	})
-}
-
-// SetStatus sets the "status" field.
-func (u *StrategyUpsertOne) SetStatus(v strategy.Status) *StrategyUpsertOne {
+// SetMaxLossAmount sets the "maxLossAmount" field.
+func (u *StrategyUpsertBulk) SetMaxLossAmount(v decimal.Decimal) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.SetStatus(v)
+		s.SetMaxLossAmount(v)
 	})
 }
 
-// UpdateStatus sets the "status" field to the value that was provided on create.
-func (u *StrategyUpsertOne) UpdateStatus() *StrategyUpsertOne {
+// UpdateMaxLossAmount sets the "maxLossAmount" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateMaxLossAmount() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateStatus()
+		s.UpdateMaxLossAmount()
 	})
 }
 
-// SetExchangeApiKey sets the "exchangeApiKey" field.
-func (u *StrategyUpsertOne) SetExchangeApiKey(v string) *StrategyUpsertOne {
+// ClearMaxLossAmount clears the value of the "maxLossAmount" field.
+func (u *StrategyUpsertBulk) ClearMaxLossAmount() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.SetExchangeApiKey(v)
+		s.ClearMaxLossAmount()
 	})
 }
 
-// UpdateExchangeApiKey sets the "exchangeApiKey" field to the value that was provided on create.
-func (u *StrategyUpsertOne) UpdateExchangeApiKey() *StrategyUpsertOne {
+// SetMaxLossPercent sets the "maxLossPercent" field.
+func (u *StrategyUpsertBulk) SetMaxLossPercent(v decimal.Decimal) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateExchangeApiKey()
+		s.SetMaxLossPercent(v)
 	})
 }
 
-// SetExchangeSecretKey sets the "exchangeSecretKey" field.
-func (u *StrategyUpsertOne) SetExchangeSecretKey(v string) *StrategyUpsertOne {
+// UpdateMaxLossPercent sets the "maxLossPercent" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateMaxLossPercent() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.SetExchangeSecretKey(v)
+		s.UpdateMaxLossPercent()
 	})
 }
 
-// UpdateExchangeSecretKey sets the "exchangeSecretKey" field to the value that was provided on create.
-func (u *StrategyUpsertOne) UpdateExchangeSecretKey() *StrategyUpsertOne {
+// ClearMaxLossPercent clears the value of the "maxLossPercent" field.
+func (u *StrategyUpsertBulk) ClearMaxLossPercent() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateExchangeSecretKey()
+		s.ClearMaxLossPercent()
 	})
 }
 
-// SetExchangePassphrase sets the "exchangePassphrase" field.
-func (u *StrategyUpsertOne) SetExchangePassphrase(v string) *StrategyUpsertOne {
+// SetProfitTargetAmount sets the "profitTargetAmount" field.
+func (u *StrategyUpsertBulk) SetProfitTargetAmount(v decimal.Decimal) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.SetExchangePassphrase(v)
+		s.SetProfitTargetAmount(v)
 	})
 }
 
-// UpdateExchangePassphrase sets the "exchangePassphrase" field to the value that was provided on create.
-func (u *StrategyUpsertOne) UpdateExchangePassphrase() *StrategyUpsertOne {
+// UpdateProfitTargetAmount sets the "profitTargetAmount" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateProfitTargetAmount() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateExchangePassphrase()
+		s.UpdateProfitTargetAmount()
 	})
 }
 
-// SetExchangeTestnet sets the "exchangeTestnet" field.
-func (u *StrategyUpsertOne) SetExchangeTestnet(v bool) *StrategyUpsertOne {
+// ClearProfitTargetAmount clears the value of the "profitTargetAmount" field.
+func (u *StrategyUpsertBulk) ClearProfitTargetAmount() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.SetExchangeTestnet(v)
+		s.ClearProfitTargetAmount()
 	})
 }
 
-// UpdateExchangeTestnet sets the "exchangeTestnet" field to the value that was provided on create.
-func (u *StrategyUpsertOne) UpdateExchangeTestnet() *StrategyUpsertOne {
+// SetTrailingStopLossAmount sets the "trailingStopLossAmount" field.
+func (u *StrategyUpsertBulk) SetTrailingStopLossAmount(v decimal.Decimal) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateExchangeTestnet()
+		s.SetTrailingStopLossAmount(v)
 	})
 }
 
-// SetStartTime sets the "startTime" field.
-func (u *StrategyUpsertOne) SetStartTime(v time.Time) *StrategyUpsertOne {
+// UpdateTrailingStopLossAmount sets the "trailingStopLossAmount" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateTrailingStopLossAmount() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.SetStartTime(v)
+		s.UpdateTrailingStopLossAmount()
 	})
 }
 
-// UpdateStartTime sets the "startTime" field to the value that was provided on create.
-func (u *StrategyUpsertOne) UpdateStartTime() *StrategyUpsertOne {
+// ClearTrailingStopLossAmount clears the value of the "trailingStopLossAmount" field.
+func (u *StrategyUpsertBulk) ClearTrailingStopLossAmount() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateStartTime()
+		s.ClearTrailingStopLossAmount()
 	})
 }
 
-// ClearStartTime clears the value of the "startTime" field.
-func (u *StrategyUpsertOne) ClearStartTime() *StrategyUpsertOne {
+// SetTrailingStopLossPercent sets the "trailingStopLossPercent" field.
+func (u *StrategyUpsertBulk) SetTrailingStopLossPercent(v decimal.Decimal) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.ClearStartTime()
+		s.SetTrailingStopLossPercent(v)
 	})
 }
 
-// Exec executes the query.
-func (u *StrategyUpsertOne) Exec(ctx context.Context) error {
-	if len(u.create.conflict) == 0 {
-		return errors.New("ent: missing options for StrategyCreate.OnConflict")
-	}
-	return u.create.Exec(ctx)
-}
-
-// ExecX is like Exec, but panics if an error occurs.
-func (u *StrategyUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
-		panic(err)
-	}
-}
-
-// Exec executes the UPSERT query and returns the inserted/updated ID.
-func (u *StrategyUpsertOne) ID(ctx context.Context) (id int, err error) {
-	node, err := u.create.Save(ctx)
-	if err != nil {
-		return id, err
-	}
-	return node.ID, nil
-}
-
-// IDX is like ID, but panics if an error occurs.
-func (u *StrategyUpsertOne) IDX(ctx context.Context) int {
-	id, err := u.ID(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return id
-}
-
-// StrategyCreateBulk is the builder for creating many Strategy entities in bulk.
-type StrategyCreateBulk struct {
-	config
-	err      error
-	builders []*StrategyCreate
-	conflict []sql.ConflictOption
-}
-
-// Save creates the Strategy entities in the database.
-func (_c *StrategyCreateBulk) Save(ctx context.Context) ([]*Strategy, error) {
-	if _c.err != nil {
-		return nil, _c.err
-	}
-	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
-	nodes := make([]*Strategy, len(_c.builders))
-	mutators := make([]Mutator, len(_c.builders))
-	for i := range _c.builders {
-		func(i int, root context.Context) {
-			builder := _c.builders[i]
-			builder.defaults()
-			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				mutation, ok := m.(*StrategyMutation)
-				if !ok {
-					return nil, fmt.Errorf("unexpected mutation type %T", m)
-				}
-				if err := builder.check(); err != nil {
-					return nil, err
-				}
-				builder.mutation = mutation
-				var err error
-				nodes[i], specs[i] = builder.createSpec()
-				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
-				} else {
-					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
-					spec.OnConflict = _c.conflict
-					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
-						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
-						}
-					}
-				}
-				if err != nil {
-					return nil, err
-				}
-				mutation.id = &nodes[i].ID
-				if specs[i].ID.Value != nil {
-					id := specs[i].ID.Value.(int64)
-					nodes[i].ID = int(id)
-				}
-				mutation.done = true
-				return nodes[i], nil
-			})
-			for i := len(builder.hooks) - 1; i >= 0; i-- {
-				mut = builder.hooks[i](mut)
-			}
-			mutators[i] = mut
-		}(i, ctx)
-	}
-	if len(mutators) > 0 {
-		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
-			return nil, err
-		}
-	}
-	return nodes, nil
+// UpdateTrailingStopLossPercent sets the "trailingStopLossPercent" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateTrailingStopLossPercent() *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateTrailingStopLossPercent()
+	})
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_c *StrategyCreateBulk) SaveX(ctx context.Context) []*Strategy {
-	v, err := _c.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
+// ClearTrailingStopLossPercent clears the value of the "trailingStopLossPercent" field.
+func (u *StrategyUpsertBulk) ClearTrailingStopLossPercent() *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearTrailingStopLossPercent()
+	})
 }
 
-// Exec executes the query.
-func (_c *StrategyCreateBulk) Exec(ctx context.Context) error {
-	_, err := _c.Save(ctx)
-	return err
+// SetTrailingTakeProfitActivationPrice sets the "trailingTakeProfitActivationPrice" field.
+func (u *StrategyUpsertBulk) SetTrailingTakeProfitActivationPrice(v decimal.Decimal) *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetTrailingTakeProfitActivationPrice(v)
+	})
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_c *StrategyCreateBulk) ExecX(ctx context.Context) {
-	if err := _c.Exec(ctx); err != nil {
-		panic(err)
-	}
+// UpdateTrailingTakeProfitActivationPrice sets the "trailingTakeProfitActivationPrice" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateTrailingTakeProfitActivationPrice() *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateTrailingTakeProfitActivationPrice()
+	})
 }
 
-// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
-// of the `INSERT` statement. For example:
-//
-//	client.Strategy.CreateBulk(builders...).
-//		OnConflict(
-//			// Update the row with the new values
-//			// the was proposed for insertion.
-//			sql.ResolveWithNewValues(),
-//		).
-//		// Override some of the fields with custom
-//		// update values.
-//		Update(func(u *ent.StrategyUpsert) {
-//			SetCreateTime(v+v).
-//		}).
-//		Exec(ctx)
-func (_c *StrategyCreateBulk) OnConflict(opts ...sql.ConflictOption) *StrategyUpsertBulk {
-	_c.conflict = opts
-	return &StrategyUpsertBulk{
-		create: _c,
-	}
+// ClearTrailingTakeProfitActivationPrice clears the value of the "trailingTakeProfitActivationPrice" field.
+func (u *StrategyUpsertBulk) ClearTrailingTakeProfitActivationPrice() *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearTrailingTakeProfitActivationPrice()
+	})
 }
 
-// OnConflictColumns calls `OnConflict` and configures the columns
-// as conflict target. Using this option is equivalent to using:
-//
-//	client.Strategy.Create().
-//		OnConflict(sql.ConflictColumns(columns...)).
-//		Exec(ctx)
-func (_c *StrategyCreateBulk) OnConflictColumns(columns ...string) *StrategyUpsertBulk {
-	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
-	return &StrategyUpsertBulk{
-		create: _c,
-	}
+// SetTrailingTakeProfitAmount sets the "trailingTakeProfitAmount" field.
+func (u *StrategyUpsertBulk) SetTrailingTakeProfitAmount(v decimal.Decimal) *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetTrailingTakeProfitAmount(v)
+	})
 }
 
-// StrategyUpsertBulk is the builder for "upsert"-ing
-// a bulk of Strategy nodes.
-type StrategyUpsertBulk struct {
-	create *StrategyCreateBulk
+// UpdateTrailingTakeProfitAmount sets the "trailingTakeProfitAmount" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateTrailingTakeProfitAmount() *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateTrailingTakeProfitAmount()
+	})
 }
 
-// UpdateNewValues updates the mutable fields using the new values that
-// were set on create. Using this option is equivalent to using:
-//
-//	client.Strategy.Create().
-//		OnConflict(
-//			sql.ResolveWithNewValues(),
-//		).
-//		Exec(ctx)
-func (u *StrategyUpsertBulk) UpdateNewValues() *StrategyUpsertBulk {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
-		for _, b := range u.create.builders {
-			if _, exists := b.mutation.CreateTime(); exists {
-				s.SetIgnore(strategy.FieldCreateTime)
-			}
-		}
-	}))
-	return u
+// ClearTrailingTakeProfitAmount clears the value of the "trailingTakeProfitAmount" field.
+func (u *StrategyUpsertBulk) ClearTrailingTakeProfitAmount() *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearTrailingTakeProfitAmount()
+	})
 }
 
-// Ignore sets each column to itself in case of conflict.
-// Using this option is equivalent to using:
-//
-//	client.Strategy.Create().
-//		OnConflict(sql.ResolveWithIgnore()).
-//		Exec(ctx)
-func (u *StrategyUpsertBulk) Ignore() *StrategyUpsertBulk {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
-	return u
+// SetTrailingTakeProfitPercent sets the "trailingTakeProfitPercent" field.
+func (u *StrategyUpsertBulk) SetTrailingTakeProfitPercent(v decimal.Decimal) *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetTrailingTakeProfitPercent(v)
+	})
 }
 
-// DoNothing configures the conflict_action to `DO NOTHING`.
-// Supported only by SQLite and PostgreSQL.
-func (u *StrategyUpsertBulk) DoNothing() *StrategyUpsertBulk {
-	u.create.conflict = append(u.create.conflict, sql.DoNothing())
-	return u
+// UpdateTrailingTakeProfitPercent sets the "trailingTakeProfitPercent" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateTrailingTakeProfitPercent() *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateTrailingTakeProfitPercent()
+	})
 }
 
-// Update allows overriding fields `UPDATE` values. See the StrategyCreateBulk.OnConflict
-// documentation for more info.
-func (u *StrategyUpsertBulk) Update(set func(*StrategyUpsert)) *StrategyUpsertBulk {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
-		set(&StrategyUpsert{UpdateSet: update})
-	}))
-	return u
+// ClearTrailingTakeProfitPercent clears the value of the "trailingTakeProfitPercent" field.
+func (u *StrategyUpsertBulk) ClearTrailingTakeProfitPercent() *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearTrailingTakeProfitPercent()
+	})
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (u *StrategyUpsertBulk) SetUpdateTime(v time.Time) *StrategyUpsertBulk {
+// SetTrailingExtremePrice sets the "trailingExtremePrice" field.
+func (u *StrategyUpsertBulk) SetTrailingExtremePrice(v decimal.Decimal) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.SetUpdateTime(v)
+		s.SetTrailingExtremePrice(v)
 	})
 }
 
-// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
-func (u *StrategyUpsertBulk) UpdateUpdateTime() *StrategyUpsertBulk {
+// UpdateTrailingExtremePrice sets the "trailingExtremePrice" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateTrailingExtremePrice() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateUpdateTime()
+		s.UpdateTrailingExtremePrice()
 	})
 }
 
-// SetGUID sets the "guid" field.
-func (u *StrategyUpsertBulk) SetGUID(v string) *StrategyUpsertBulk {
+// ClearTrailingExtremePrice clears the value of the "trailingExtremePrice" field.
+func (u *StrategyUpsertBulk) ClearTrailingExtremePrice() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.SetGUID(v)
+		s.ClearTrailingExtremePrice()
 	})
 }
 
-// UpdateGUID sets the "guid" field to the value that was provided on create.
-func (u *StrategyUpsertBulk) UpdateGUID() *StrategyUpsertBulk {
+// SetTrailingLevels sets the "trailingLevels" field.
+func (u *StrategyUpsertBulk) SetTrailingLevels(v int) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateGUID()
+		s.SetTrailingLevels(v)
 	})
 }
 
-// SetOwner sets the "owner" field.
-func (u *StrategyUpsertBulk) SetOwner(v int64) *StrategyUpsertBulk {
+// AddTrailingLevels adds v to the "trailingLevels" field.
+func (u *StrategyUpsertBulk) AddTrailingLevels(v int) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.SetOwner(v)
+		s.AddTrailingLevels(v)
 	})
 }
 
-// AddOwner adds v to the "owner" field.
-func (u *StrategyUpsertBulk) AddOwner(v int64) *StrategyUpsertBulk {
+// UpdateTrailingLevels sets the "trailingLevels" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateTrailingLevels() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.AddOwner(v)
+		s.UpdateTrailingLevels()
 	})
 }
 
-// UpdateOwner sets the "owner" field to the value that was provided on create.
-func (u *StrategyUpsertBulk) UpdateOwner() *StrategyUpsertBulk {
+// ClearTrailingLevels clears the value of the "trailingLevels" field.
+func (u *StrategyUpsertBulk) ClearTrailingLevels() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateOwner()
+		s.ClearTrailingLevels()
 	})
 }
 
-// SetExchange sets the "exchange" field.
-func (u *StrategyUpsertBulk) SetExchange(v string) *StrategyUpsertBulk {
+// SetTrailingPriceUpperLimit sets the "trailingPriceUpperLimit" field.
+func (u *StrategyUpsertBulk) SetTrailingPriceUpperLimit(v decimal.Decimal) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.SetExchange(v)
+		s.SetTrailingPriceUpperLimit(v)
 	})
 }
 
-// UpdateExchange sets the "exchange" field to the value that was provided on create.
-func (u *StrategyUpsertBulk) UpdateExchange() *StrategyUpsertBulk {
+// UpdateTrailingPriceUpperLimit sets the "trailingPriceUpperLimit" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateTrailingPriceUpperLimit() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateExchange()
+		s.UpdateTrailingPriceUpperLimit()
 	})
 }
 
-// SetSymbol sets the "symbol" field.
-func (u *StrategyUpsertBulk) SetSymbol(v string) *StrategyUpsertBulk {
+// ClearTrailingPriceUpperLimit clears the value of the "trailingPriceUpperLimit" field.
+func (u *StrategyUpsertBulk) ClearTrailingPriceUpperLimit() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.SetSymbol(v)
+		s.ClearTrailingPriceUpperLimit()
 	})
 }
 
-// UpdateSymbol sets the "symbol" field to the value that was provided on create.
-func (u *StrategyUpsertBulk) UpdateSymbol() *StrategyUpsertBulk {
+// SetTrailingPriceLowerLimit sets the "trailingPriceLowerLimit" field.
+func (u *StrategyUpsertBulk) SetTrailingPriceLowerLimit(v decimal.Decimal) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateSymbol()
+		s.SetTrailingPriceLowerLimit(v)
 	})
 }
 
-// SetAccount sets the "account" field.
-func (u *StrategyUpsertBulk) SetAccount(v string) *StrategyUpsertBulk {
+// UpdateTrailingPriceLowerLimit sets the "trailingPriceLowerLimit" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateTrailingPriceLowerLimit() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.SetAccount(v)
+		s.UpdateTrailingPriceLowerLimit()
 	})
 }
 
-// UpdateAccount sets the "account" field to the value that was provided on create.
-func (u *StrategyUpsertBulk) UpdateAccount() *StrategyUpsertBulk {
+// ClearTrailingPriceLowerLimit clears the value of the "trailingPriceLowerLimit" field.
+func (u *StrategyUpsertBulk) ClearTrailingPriceLowerLimit() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateAccount()
+		s.ClearTrailingPriceLowerLimit()
 	})
 }
 
-// SetMode sets the "mode" field.
-func (u *StrategyUpsertBulk) SetMode(v strategy.Mode) *StrategyUpsertBulk {
+// SetAtrPeriod sets the "atrPeriod" field.
+func (u *StrategyUpsertBulk) SetAtrPeriod(v int) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.SetMode(v)
+		s.SetAtrPeriod(v)
 	})
 }
 
-// UpdateMode sets the "mode" field to the value that was provided on create.
-func (u *StrategyUpsertBulk) UpdateMode() *StrategyUpsertBulk {
+// AddAtrPeriod adds v to the "atrPeriod" field.
+func (u *StrategyUpsertBulk) AddAtrPeriod(v int) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateMode()
+		s.AddAtrPeriod(v)
 	})
 }
 
-// SetMarginMode sets the "marginMode" field.
-func (u *StrategyUpsertBulk) SetMarginMode(v strategy.MarginMode) *StrategyUpsertBulk {
+// UpdateAtrPeriod sets the "atrPeriod" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateAtrPeriod() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.SetMarginMode(v)
+		s.UpdateAtrPeriod()
 	})
 }
 
-// UpdateMarginMode sets the "marginMode" field to the value that was provided on create.
-func (u *StrategyUpsertBulk) UpdateMarginMode() *StrategyUpsertBulk {
+// ClearAtrPeriod clears the value of the "atrPeriod" field.
+func (u *StrategyUpsertBulk) ClearAtrPeriod() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateMarginMode()
+		s.ClearAtrPeriod()
 	})
 }
 
-// SetQuantityMode sets the "quantityMode" field.
-func (u *StrategyUpsertBulk) SetQuantityMode(v strategy.QuantityMode) *StrategyUpsertBulk {
+// SetAtrMultiplier sets the "atrMultiplier" field.
+func (u *StrategyUpsertBulk) SetAtrMultiplier(v decimal.Decimal) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.SetQuantityMode(v)
+		s.SetAtrMultiplier(v)
 	})
 }
 
-// UpdateQuantityMode sets the "quantityMode" field to the value that was provided on create.
-func (u *StrategyUpsertBulk) UpdateQuantityMode() *StrategyUpsertBulk {
+// UpdateAtrMultiplier sets the "atrMultiplier" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateAtrMultiplier() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateQuantityMode()
+		s.UpdateAtrMultiplier()
 	})
 }
 
-// SetPriceUpper sets the "priceUpper" field.
-func (u *StrategyUpsertBulk) SetPriceUpper(v decimal.Decimal) *StrategyUpsertBulk {
+// ClearAtrMultiplier clears the value of the "atrMultiplier" field.
+func (u *StrategyUpsertBulk) ClearAtrMultiplier() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.SetPriceUpper(v)
+		s.ClearAtrMultiplier()
 	})
 }
 
-// UpdatePriceUpper sets the "priceUpper" field to the value that was provided on create.
-func (u *StrategyUpsertBulk) UpdatePriceUpper() *StrategyUpsertBulk {
+// SetAdaptiveThreshold sets the "adaptiveThreshold" field.
+func (u *StrategyUpsertBulk) SetAdaptiveThreshold(v decimal.Decimal) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.UpdatePriceUpper()
+		s.SetAdaptiveThreshold(v)
 	})
 }
 
-// SetPriceLower sets the "priceLower" field.
-func (u *StrategyUpsertBulk) SetPriceLower(v decimal.Decimal) *StrategyUpsertBulk {
+// UpdateAdaptiveThreshold sets the "adaptiveThreshold" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateAdaptiveThreshold() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.SetPriceLower(v)
+		s.UpdateAdaptiveThreshold()
 	})
 }
 
-// UpdatePriceLower sets the "priceLower" field to the value that was provided on create.
-func (u *StrategyUpsertBulk) UpdatePriceLower() *StrategyUpsertBulk {
+// ClearAdaptiveThreshold clears the value of the "adaptiveThreshold" field.
+func (u *StrategyUpsertBulk) ClearAdaptiveThreshold() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.UpdatePriceLower()
+		s.ClearAdaptiveThreshold()
 	})
 }
 
-// SetGridNum sets the "gridNum" field.
-func (u *StrategyUpsertBulk) SetGridNum(v int) *StrategyUpsertBulk {
+// SetAdaptiveIntervalHours sets the "adaptiveIntervalHours" field.
+func (u *StrategyUpsertBulk) SetAdaptiveIntervalHours(v int) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.SetGridNum(v)
+		s.SetAdaptiveIntervalHours(v)
 	})
 }
 
-// AddGridNum adds v to the "gridNum" field.
-func (u *StrategyUpsertBulk) AddGridNum(v int) *StrategyUpsertBulk {
+// AddAdaptiveIntervalHours adds v to the "adaptiveIntervalHours" field.
+func (u *StrategyUpsertBulk) AddAdaptiveIntervalHours(v int) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.AddGridNum(v)
+		s.AddAdaptiveIntervalHours(v)
 	})
 }
 
-// UpdateGridNum sets the "gridNum" field to the value that was provided on create.
-func (u *StrategyUpsertBulk) UpdateGridNum() *StrategyUpsertBulk {
+// UpdateAdaptiveIntervalHours sets the "adaptiveIntervalHours" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateAdaptiveIntervalHours() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateGridNum()
+		s.UpdateAdaptiveIntervalHours()
 	})
 }
 
-// SetLeverage sets the "leverage" field.
-func (u *StrategyUpsertBulk) SetLeverage(v int) *StrategyUpsertBulk {
+// ClearAdaptiveIntervalHours clears the value of the "adaptiveIntervalHours" field.
+func (u *StrategyUpsertBulk) ClearAdaptiveIntervalHours() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.SetLeverage(v)
+		s.ClearAdaptiveIntervalHours()
 	})
 }
 
-// AddLeverage adds v to the "leverage" field.
-func (u *StrategyUpsertBulk) AddLeverage(v int) *StrategyUpsertBulk {
+// SetAdaptiveAtr sets the "adaptiveAtr" field.
+func (u *StrategyUpsertBulk) SetAdaptiveAtr(v decimal.Decimal) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.AddLeverage(v)
+		s.SetAdaptiveAtr(v)
 	})
 }
 
-// UpdateLeverage sets the "leverage" field to the value that was provided on create.
-func (u *StrategyUpsertBulk) UpdateLeverage() *StrategyUpsertBulk {
+// UpdateAdaptiveAtr sets the "adaptiveAtr" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateAdaptiveAtr() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateLeverage()
+		s.UpdateAdaptiveAtr()
 	})
 }
 
-// SetInitialOrderSize sets the "initialOrderSize" field.
-func (u *StrategyUpsertBulk) SetInitialOrderSize(v decimal.Decimal) *StrategyUpsertBulk {
+// ClearAdaptiveAtr clears the value of the "adaptiveAtr" field.
+func (u *StrategyUpsertBulk) ClearAdaptiveAtr() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.SetInitialOrderSize(v)
+		s.ClearAdaptiveAtr()
 	})
 }
 
-// UpdateInitialOrderSize sets the "initialOrderSize" field to the value that was provided on create.
-func (u *StrategyUpsertBulk) UpdateInitialOrderSize() *StrategyUpsertBulk {
+// SetAdaptiveUpdatedAt sets the "adaptiveUpdatedAt" field.
+func (u *StrategyUpsertBulk) SetAdaptiveUpdatedAt(v time.Time) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateInitialOrderSize()
+		s.SetAdaptiveUpdatedAt(v)
 	})
 }
 
-// SetSlippageBps sets the "slippageBps" field.
-func (u *StrategyUpsertBulk) SetSlippageBps(v int) *StrategyUpsertBulk {
+// UpdateAdaptiveUpdatedAt sets the "adaptiveUpdatedAt" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateAdaptiveUpdatedAt() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.SetSlippageBps(v)
+		s.UpdateAdaptiveUpdatedAt()
 	})
 }
 
-// AddSlippageBps adds v to the "slippageBps" field.
-func (u *StrategyUpsertBulk) AddSlippageBps(v int) *StrategyUpsertBulk {
+// ClearAdaptiveUpdatedAt clears the value of the "adaptiveUpdatedAt" field.
+func (u *StrategyUpsertBulk) ClearAdaptiveUpdatedAt() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.AddSlippageBps(v)
+		s.ClearAdaptiveUpdatedAt()
 	})
 }
 
-// UpdateSlippageBps sets the "slippageBps" field to the value that was provided on create.
-func (u *StrategyUpsertBulk) UpdateSlippageBps() *StrategyUpsertBulk {
+// SetCancelRepairPolicy sets the "cancelRepairPolicy" field.
+func (u *StrategyUpsertBulk) SetCancelRepairPolicy(v strategy.CancelRepairPolicy) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateSlippageBps()
+		s.SetCancelRepairPolicy(v)
 	})
 }
 
-// ClearSlippageBps clears the value of the "slippageBps" field.
-func (u *StrategyUpsertBulk) ClearSlippageBps() *StrategyUpsertBulk {
+// UpdateCancelRepairPolicy sets the "cancelRepairPolicy" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateCancelRepairPolicy() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.ClearSlippageBps()
+		s.UpdateCancelRepairPolicy()
 	})
 }
 
-// SetEntryPrice sets the "entryPrice" field.
-func (u *StrategyUpsertBulk) SetEntryPrice(v decimal.Decimal) *StrategyUpsertBulk {
+// SetCancelRepairMaxAttempts sets the "cancelRepairMaxAttempts" field.
+func (u *StrategyUpsertBulk) SetCancelRepairMaxAttempts(v int) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.SetEntryPrice(v)
+		s.SetCancelRepairMaxAttempts(v)
 	})
 }
 
-// UpdateEntryPrice sets the "entryPrice" field to the value that was provided on create.
-func (u *StrategyUpsertBulk) UpdateEntryPrice() *StrategyUpsertBulk {
+// AddCancelRepairMaxAttempts adds v to the "cancelRepairMaxAttempts" field.
+func (u *StrategyUpsertBulk) AddCancelRepairMaxAttempts(v int) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateEntryPrice()
+		s.AddCancelRepairMaxAttempts(v)
 	})
 }
 
-// ClearEntryPrice clears the value of the "entryPrice" field.
-func (u *StrategyUpsertBulk) ClearEntryPrice() *StrategyUpsertBulk {
+// UpdateCancelRepairMaxAttempts sets the "cancelRepairMaxAttempts" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateCancelRepairMaxAttempts() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.ClearEntryPrice()
+		s.UpdateCancelRepairMaxAttempts()
 	})
 }
 
-// SetTriggerStopLossPrice sets the "triggerStopLossPrice" field.
-func (u *StrategyUpsertBulk) SetTriggerStopLossPrice(v decimal.Decimal) *StrategyUpsertBulk {
+// ClearCancelRepairMaxAttempts clears the value of the "cancelRepairMaxAttempts" field.
+func (u *StrategyUpsertBulk) ClearCancelRepairMaxAttempts() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.SetTriggerStopLossPrice(v)
+		s.ClearCancelRepairMaxAttempts()
 	})
 }
 
-// UpdateTriggerStopLossPrice sets the "triggerStopLossPrice" field to the value that was provided on create.
-func (u *StrategyUpsertBulk) UpdateTriggerStopLossPrice() *StrategyUpsertBulk {
+// SetReconcilePolicy sets the "reconcilePolicy" field.
+func (u *StrategyUpsertBulk) SetReconcilePolicy(v strategy.ReconcilePolicy) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateTriggerStopLossPrice()
+		s.SetReconcilePolicy(v)
 	})
 }
 
-// ClearTriggerStopLossPrice clears the value of the "triggerStopLossPrice" field.
-func (u *StrategyUpsertBulk) ClearTriggerStopLossPrice() *StrategyUpsertBulk {
+// UpdateReconcilePolicy sets the "reconcilePolicy" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateReconcilePolicy() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.ClearTriggerStopLossPrice()
+		s.UpdateReconcilePolicy()
 	})
 }
 
-// SetTriggerTakeProfitPrice sets the "triggerTakeProfitPrice" field.
-func (u *StrategyUpsertBulk) SetTriggerTakeProfitPrice(v decimal.Decimal) *StrategyUpsertBulk {
+// SetPartialFillThreshold sets the "partialFillThreshold" field.
+func (u *StrategyUpsertBulk) SetPartialFillThreshold(v decimal.Decimal) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.SetTriggerTakeProfitPrice(v)
+		s.SetPartialFillThreshold(v)
 	})
 }
 
-// UpdateTriggerTakeProfitPrice sets the "triggerTakeProfitPrice" field to the value that was provided on create.
-func (u *StrategyUpsertBulk) UpdateTriggerTakeProfitPrice() *StrategyUpsertBulk {
+// UpdatePartialFillThreshold sets the "partialFillThreshold" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdatePartialFillThreshold() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.UpdateTriggerTakeProfitPrice()
+		s.UpdatePartialFillThreshold()
 	})
 }
 
-// ClearTriggerTakeProfitPrice clears the value of the "triggerTakeProfitPrice" field.
-func (u *StrategyUpsertBulk) ClearTriggerTakeProfitPrice() *StrategyUpsertBulk {
+// ClearPartialFillThreshold clears the value of the "partialFillThreshold" field.
+func (u *StrategyUpsertBulk) ClearPartialFillThreshold() *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
-		s.ClearTriggerTakeProfitPrice()
+		s.ClearPartialFillThreshold()
 	})
 }
 
@@ -2263,6 +4402,27 @@ func (u *StrategyUpsertBulk) UpdateExchangeTestnet() *StrategyUpsertBulk {
 	})
 }
 
+// SetAccountId sets the "accountId" field.
+func (u *StrategyUpsertBulk) SetAccountId(v int) *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.SetAccountId(v)
+	})
+}
+
+// UpdateAccountId sets the "accountId" field to the value that was provided on create.
+func (u *StrategyUpsertBulk) UpdateAccountId() *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.UpdateAccountId()
+	})
+}
+
+// ClearAccountId clears the value of the "accountId" field.
+func (u *StrategyUpsertBulk) ClearAccountId() *StrategyUpsertBulk {
+	return u.Update(func(s *StrategyUpsert) {
+		s.ClearAccountId()
+	})
+}
+
 // SetStartTime sets the "startTime" field.
 func (u *StrategyUpsertBulk) SetStartTime(v time.Time) *StrategyUpsertBulk {
 	return u.Update(func(s *StrategyUpsert) {
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/fachebot/omni-grid-bot/internal/ent/exchangeaccount"
 	"github.com/fachebot/omni-grid-bot/internal/ent/predicate"
 	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
 )
@@ -18,10 +19,11 @@ import (
 // StrategyQuery is the builder for querying Strategy entities.
 type StrategyQuery struct {
 	config
-	ctx        *QueryContext
-	order      []strategy.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Strategy
+	ctx                 *QueryContext
+	order               []strategy.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.Strategy
+	withExchangeAccount *ExchangeAccountQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -58,6 +60,28 @@ func (_q *StrategyQuery) Order(o ...strategy.OrderOption) *StrategyQuery {
 	return _q
 }
 
+// QueryExchangeAccount chains the current query on the "exchangeAccount" edge.
+func (_q *StrategyQuery) QueryExchangeAccount() *ExchangeAccountQuery {
+	query := (&ExchangeAccountClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(strategy.Table, strategy.FieldID, selector),
+			sqlgraph.To(exchangeaccount.Table, exchangeaccount.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, strategy.ExchangeAccountTable, strategy.ExchangeAccountColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Strategy entity from the query.
 // Returns a *NotFoundError when no Strategy was found.
 func (_q *StrategyQuery) First(ctx context.Context) (*Strategy, error) {
@@ -245,17 +269,29 @@ func (_q *StrategyQuery) Clone() *StrategyQuery {
 		return nil
 	}
 	return &StrategyQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]strategy.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.Strategy{}, _q.predicates...),
+		config:              _q.config,
+		ctx:                 _q.ctx.Clone(),
+		order:               append([]strategy.OrderOption{}, _q.order...),
+		inters:              append([]Interceptor{}, _q.inters...),
+		predicates:          append([]predicate.Strategy{}, _q.predicates...),
+		withExchangeAccount: _q.withExchangeAccount.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
 	}
 }
 
+// WithExchangeAccount tells the query-builder to eager-load the nodes that are connected to
+// the "exchangeAccount" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *StrategyQuery) WithExchangeAccount(opts ...func(*ExchangeAccountQuery)) *StrategyQuery {
+	query := (&ExchangeAccountClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withExchangeAccount = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -332,8 +368,11 @@ func (_q *StrategyQuery) prepareQuery(ctx context.Context) error {
 
 func (_q *StrategyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Strategy, error) {
 	var (
-		nodes = []*Strategy{}
-		_spec = _q.querySpec()
+		nodes       = []*Strategy{}
+		_spec       = _q.querySpec()
+		loadedTypes = [1]bool{
+			_q.withExchangeAccount != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Strategy).scanValues(nil, columns)
@@ -341,6 +380,7 @@ func (_q *StrategyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Str
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &Strategy{config: _q.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
@@ -352,9 +392,48 @@ func (_q *StrategyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Str
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := _q.withExchangeAccount; query != nil {
+		if err := _q.loadExchangeAccount(ctx, query, nodes, nil,
+			func(n *Strategy, e *ExchangeAccount) { n.Edges.ExchangeAccount = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (_q *StrategyQuery) loadExchangeAccount(ctx context.Context, query *ExchangeAccountQuery, nodes []*Strategy, init func(*Strategy), assign func(*Strategy, *ExchangeAccount)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Strategy)
+	for i := range nodes {
+		if nodes[i].AccountId == nil {
+			continue
+		}
+		fk := *nodes[i].AccountId
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(exchangeaccount.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "accountId" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
 func (_q *StrategyQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
 	_spec.Node.Columns = _q.ctx.Fields
@@ -380,6 +459,9 @@ func (_q *StrategyQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withExchangeAccount != nil {
+			_spec.Node.AddColumnOnce(strategy.FieldAccountId)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/fachebot/omni-grid-bot/internal/ent/exchangeaccount"
 	"github.com/fachebot/omni-grid-bot/internal/ent/predicate"
 	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
 	"github.com/shopspring/decimal"
@@ -238,6 +239,60 @@ func (_u *StrategyUpdate) SetNillableInitialOrderSize(v *decimal.Decimal) *Strat
 	return _u
 }
 
+// SetSizingMode sets the "sizingMode" field.
+func (_u *StrategyUpdate) SetSizingMode(v strategy.SizingMode) *StrategyUpdate {
+	_u.mutation.SetSizingMode(v)
+	return _u
+}
+
+// SetNillableSizingMode sets the "sizingMode" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableSizingMode(v *strategy.SizingMode) *StrategyUpdate {
+	if v != nil {
+		_u.SetSizingMode(*v)
+	}
+	return _u
+}
+
+// SetSizingFactor sets the "sizingFactor" field.
+func (_u *StrategyUpdate) SetSizingFactor(v decimal.Decimal) *StrategyUpdate {
+	_u.mutation.SetSizingFactor(v)
+	return _u
+}
+
+// SetNillableSizingFactor sets the "sizingFactor" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableSizingFactor(v *decimal.Decimal) *StrategyUpdate {
+	if v != nil {
+		_u.SetSizingFactor(*v)
+	}
+	return _u
+}
+
+// ClearSizingFactor clears the value of the "sizingFactor" field.
+func (_u *StrategyUpdate) ClearSizingFactor() *StrategyUpdate {
+	_u.mutation.ClearSizingFactor()
+	return _u
+}
+
+// SetSizingWeights sets the "sizingWeights" field.
+func (_u *StrategyUpdate) SetSizingWeights(v string) *StrategyUpdate {
+	_u.mutation.SetSizingWeights(v)
+	return _u
+}
+
+// SetNillableSizingWeights sets the "sizingWeights" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableSizingWeights(v *string) *StrategyUpdate {
+	if v != nil {
+		_u.SetSizingWeights(*v)
+	}
+	return _u
+}
+
+// ClearSizingWeights clears the value of the "sizingWeights" field.
+func (_u *StrategyUpdate) ClearSizingWeights() *StrategyUpdate {
+	_u.mutation.ClearSizingWeights()
+	return _u
+}
+
 // SetSlippageBps sets the "slippageBps" field.
 func (_u *StrategyUpdate) SetSlippageBps(v int) *StrategyUpdate {
 	_u.mutation.ResetSlippageBps()
@@ -325,246 +380,747 @@ func (_u *StrategyUpdate) ClearTriggerTakeProfitPrice() *StrategyUpdate {
 	return _u
 }
 
-// SetEnablePushNotification sets the "enablePushNotification" field.
-func (_u *StrategyUpdate) SetEnablePushNotification(v bool) *StrategyUpdate {
-	_u.mutation.SetEnablePushNotification(v)
+// SetMaxLossAmount sets the "maxLossAmount" field.
+func (_u *StrategyUpdate) SetMaxLossAmount(v decimal.Decimal) *StrategyUpdate {
+	_u.mutation.SetMaxLossAmount(v)
 	return _u
 }
 
-// SetNillableEnablePushNotification sets the "enablePushNotification" field if the given value is not nil.
-func (_u *StrategyUpdate) SetNillableEnablePushNotification(v *bool) *StrategyUpdate {
+// SetNillableMaxLossAmount sets the "maxLossAmount" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableMaxLossAmount(v *decimal.Decimal) *StrategyUpdate {
 	if v != nil {
-		_u.SetEnablePushNotification(*v)
+		_u.SetMaxLossAmount(*v)
 	}
 	return _u
 }
 
-// SetEnablePushMatchedNotification sets the "enablePushMatchedNotification" field.
-func (_u *StrategyUpdate) SetEnablePushMatchedNotification(v bool) *StrategyUpdate {
-	_u.mutation.SetEnablePushMatchedNotification(v)
+// ClearMaxLossAmount clears the value of the "maxLossAmount" field.
+func (_u *StrategyUpdate) ClearMaxLossAmount() *StrategyUpdate {
+	_u.mutation.ClearMaxLossAmount()
 	return _u
 }
 
-// SetNillableEnablePushMatchedNotification sets the "enablePushMatchedNotification" field if the given value is not nil.
-func (_u *StrategyUpdate) SetNillableEnablePushMatchedNotification(v *bool) *StrategyUpdate {
+// SetMaxLossPercent sets the "maxLossPercent" field.
+func (_u *StrategyUpdate) SetMaxLossPercent(v decimal.Decimal) *StrategyUpdate {
+	_u.mutation.SetMaxLossPercent(v)
+	return _u
+}
+
+// SetNillableMaxLossPercent sets the "maxLossPercent" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableMaxLossPercent(v *decimal.Decimal) *StrategyUpdate {
 	if v != nil {
-		_u.SetEnablePushMatchedNotification(*v)
+		_u.SetMaxLossPercent(*v)
 	}
 	return _u
 }
 
-// ClearEnablePushMatchedNotification clears the value of the "enablePushMatchedNotification" field.
-func (_u *StrategyUpdate) ClearEnablePushMatchedNotification() *StrategyUpdate {
-	_u.mutation.ClearEnablePushMatchedNotification()
+// ClearMaxLossPercent clears the value of the "maxLossPercent" field.
+func (_u *StrategyUpdate) ClearMaxLossPercent() *StrategyUpdate {
+	_u.mutation.ClearMaxLossPercent()
 	return _u
 }
 
-// SetLastLowerThresholdAlertTime sets the "lastLowerThresholdAlertTime" field.
-func (_u *StrategyUpdate) SetLastLowerThresholdAlertTime(v time.Time) *StrategyUpdate {
-	_u.mutation.SetLastLowerThresholdAlertTime(v)
+// SetProfitTargetAmount sets the "profitTargetAmount" field.
+func (_u *StrategyUpdate) SetProfitTargetAmount(v decimal.Decimal) *StrategyUpdate {
+	_u.mutation.SetProfitTargetAmount(v)
 	return _u
 }
 
-// SetNillableLastLowerThresholdAlertTime sets the "lastLowerThresholdAlertTime" field if the given value is not nil.
-func (_u *StrategyUpdate) SetNillableLastLowerThresholdAlertTime(v *time.Time) *StrategyUpdate {
+// SetNillableProfitTargetAmount sets the "profitTargetAmount" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableProfitTargetAmount(v *decimal.Decimal) *StrategyUpdate {
 	if v != nil {
-		_u.SetLastLowerThresholdAlertTime(*v)
+		_u.SetProfitTargetAmount(*v)
 	}
 	return _u
 }
 
-// ClearLastLowerThresholdAlertTime clears the value of the "lastLowerThresholdAlertTime" field.
-func (_u *StrategyUpdate) ClearLastLowerThresholdAlertTime() *StrategyUpdate {
-	_u.mutation.ClearLastLowerThresholdAlertTime()
+// ClearProfitTargetAmount clears the value of the "profitTargetAmount" field.
+func (_u *StrategyUpdate) ClearProfitTargetAmount() *StrategyUpdate {
+	_u.mutation.ClearProfitTargetAmount()
 	return _u
 }
 
-// SetLastUpperThresholdAlertTime sets the "lastUpperThresholdAlertTime" field.
-func (_u *StrategyUpdate) SetLastUpperThresholdAlertTime(v time.Time) *StrategyUpdate {
-	_u.mutation.SetLastUpperThresholdAlertTime(v)
+// SetTrailingStopLossAmount sets the "trailingStopLossAmount" field.
+func (_u *StrategyUpdate) SetTrailingStopLossAmount(v decimal.Decimal) *StrategyUpdate {
+	_u.mutation.SetTrailingStopLossAmount(v)
 	return _u
 }
 
-// SetNillableLastUpperThresholdAlertTime sets the "lastUpperThresholdAlertTime" field if the given value is not nil.
-func (_u *StrategyUpdate) SetNillableLastUpperThresholdAlertTime(v *time.Time) *StrategyUpdate {
+// SetNillableTrailingStopLossAmount sets the "trailingStopLossAmount" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableTrailingStopLossAmount(v *decimal.Decimal) *StrategyUpdate {
 	if v != nil {
-		_u.SetLastUpperThresholdAlertTime(*v)
+		_u.SetTrailingStopLossAmount(*v)
 	}
 	return _u
 }
 
-// ClearLastUpperThresholdAlertTime clears the value of the "lastUpperThresholdAlertTime" field.
-func (_u *StrategyUpdate) ClearLastUpperThresholdAlertTime() *StrategyUpdate {
-	_u.mutation.ClearLastUpperThresholdAlertTime()
+// ClearTrailingStopLossAmount clears the value of the "trailingStopLossAmount" field.
+func (_u *StrategyUpdate) ClearTrailingStopLossAmount() *StrategyUpdate {
+	_u.mutation.ClearTrailingStopLossAmount()
 	return _u
 }
 
-// SetStatus sets the "status" field.
-func (_u *StrategyUpdate) SetStatus(v strategy.Status) *StrategyUpdate {
-	_u.mutation.SetStatus(v)
+// SetTrailingStopLossPercent sets the "trailingStopLossPercent" field.
+func (_u *StrategyUpdate) SetTrailingStopLossPercent(v decimal.Decimal) *StrategyUpdate {
+	_u.mutation.SetTrailingStopLossPercent(v)
 	return _u
 }
 
-// SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *StrategyUpdate) SetNillableStatus(v *strategy.Status) *StrategyUpdate {
+// SetNillableTrailingStopLossPercent sets the "trailingStopLossPercent" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableTrailingStopLossPercent(v *decimal.Decimal) *StrategyUpdate {
 	if v != nil {
-		_u.SetStatus(*v)
+		_u.SetTrailingStopLossPercent(*v)
 	}
 	return _u
 }
 
-// SetExchangeApiKey sets the "exchangeApiKey" field.
-func (_u *StrategyUpdate) SetExchangeApiKey(v string) *StrategyUpdate {
-	_u.mutation.SetExchangeApiKey(v)
+// ClearTrailingStopLossPercent clears the value of the "trailingStopLossPercent" field.
+func (_u *StrategyUpdate) ClearTrailingStopLossPercent() *StrategyUpdate {
+	_u.mutation.ClearTrailingStopLossPercent()
 	return _u
 }
 
-// SetNillableExchangeApiKey sets the "exchangeApiKey" field if the given value is not nil.
-func (_u *StrategyUpdate) SetNillableExchangeApiKey(v *string) *StrategyUpdate {
+// SetTrailingTakeProfitActivationPrice sets the "trailingTakeProfitActivationPrice" field.
+func (_u *StrategyUpdate) SetTrailingTakeProfitActivationPrice(v decimal.Decimal) *StrategyUpdate {
+	_u.mutation.SetTrailingTakeProfitActivationPrice(v)
+	return _u
+}
+
+// SetNillableTrailingTakeProfitActivationPrice sets the "trailingTakeProfitActivationPrice" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableTrailingTakeProfitActivationPrice(v *decimal.Decimal) *StrategyUpdate {
 	if v != nil {
-		_u.SetExchangeApiKey(*v)
+		_u.SetTrailingTakeProfitActivationPrice(*v)
 	}
 	return _u
 }
 
-// SetExchangeSecretKey sets the "exchangeSecretKey" field.
-func (_u *StrategyUpdate) SetExchangeSecretKey(v string) *StrategyUpdate {
-	_u.mutation.SetExchangeSecretKey(v)
+// ClearTrailingTakeProfitActivationPrice clears the value of the "trailingTakeProfitActivationPrice" field.
+func (_u *StrategyUpdate) ClearTrailingTakeProfitActivationPrice() *StrategyUpdate {
+	_u.mutation.ClearTrailingTakeProfitActivationPrice()
 	return _u
 }
 
-// SetNillableExchangeSecretKey sets the "exchangeSecretKey" field if the given value is not nil.
-func (_u *StrategyUpdate) SetNillableExchangeSecretKey(v *string) *StrategyUpdate {
+// SetTrailingTakeProfitAmount sets the "trailingTakeProfitAmount" field.
+func (_u *StrategyUpdate) SetTrailingTakeProfitAmount(v decimal.Decimal) *StrategyUpdate {
+	_u.mutation.SetTrailingTakeProfitAmount(v)
+	return _u
+}
+
+// SetNillableTrailingTakeProfitAmount sets the "trailingTakeProfitAmount" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableTrailingTakeProfitAmount(v *decimal.Decimal) *StrategyUpdate {
 	if v != nil {
-		_u.SetExchangeSecretKey(*v)
+		_u.SetTrailingTakeProfitAmount(*v)
 	}
 	return _u
 }
 
-// SetExchangePassphrase sets the "exchangePassphrase" field.
-func (_u *StrategyUpdate) SetExchangePassphrase(v string) *StrategyUpdate {
-	_u.mutation.SetExchangePassphrase(v)
+// ClearTrailingTakeProfitAmount clears the value of the "trailingTakeProfitAmount" field.
+func (_u *StrategyUpdate) ClearTrailingTakeProfitAmount() *StrategyUpdate {
+	_u.mutation.ClearTrailingTakeProfitAmount()
 	return _u
 }
 
-// SetNillableExchangePassphrase sets the "exchangePassphrase" field if the given value is not nil.
-func (_u *StrategyUpdate) SetNillableExchangePassphrase(v *string) *StrategyUpdate {
+// SetTrailingTakeProfitPercent sets the "trailingTakeProfitPercent" field.
+func (_u *StrategyUpdate) SetTrailingTakeProfitPercent(v decimal.Decimal) *StrategyUpdate {
+	_u.mutation.SetTrailingTakeProfitPercent(v)
+	return _u
+}
+
+// SetNillableTrailingTakeProfitPercent sets the "trailingTakeProfitPercent" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableTrailingTakeProfitPercent(v *decimal.Decimal) *StrategyUpdate {
 	if v != nil {
-		_u.SetExchangePassphrase(*v)
+		_u.SetTrailingTakeProfitPercent(*v)
 	}
 	return _u
 }
 
-// SetExchangeTestnet sets the "exchangeTestnet" field.
-func (_u *StrategyUpdate) SetExchangeTestnet(v bool) *StrategyUpdate {
-	_u.mutation.SetExchangeTestnet(v)
+// ClearTrailingTakeProfitPercent clears the value of the "trailingTakeProfitPercent" field.
+func (_u *StrategyUpdate) ClearTrailingTakeProfitPercent() *StrategyUpdate {
+	_u.mutation.ClearTrailingTakeProfitPercent()
 	return _u
 }
 
-// SetNillableExchangeTestnet sets the "exchangeTestnet" field if the given value is not nil.
-func (_u *StrategyUpdate) SetNillableExchangeTestnet(v *bool) *StrategyUpdate {
+// SetTrailingExtremePrice sets the "trailingExtremePrice" field.
+func (_u *StrategyUpdate) SetTrailingExtremePrice(v decimal.Decimal) *StrategyUpdate {
+	_u.mutation.SetTrailingExtremePrice(v)
+	return _u
+}
+
+// SetNillableTrailingExtremePrice sets the "trailingExtremePrice" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableTrailingExtremePrice(v *decimal.Decimal) *StrategyUpdate {
 	if v != nil {
-		_u.SetExchangeTestnet(*v)
+		_u.SetTrailingExtremePrice(*v)
 	}
 	return _u
 }
 
-// SetStartTime sets the "startTime" field.
-func (_u *StrategyUpdate) SetStartTime(v time.Time) *StrategyUpdate {
-	_u.mutation.SetStartTime(v)
+// ClearTrailingExtremePrice clears the value of the "trailingExtremePrice" field.
+func (_u *StrategyUpdate) ClearTrailingExtremePrice() *StrategyUpdate {
+	_u.mutation.ClearTrailingExtremePrice()
 	return _u
 }
 
-// SetNillableStartTime sets the "startTime" field if the given value is not nil.
-func (_u *StrategyUpdate) SetNillableStartTime(v *time.Time) *StrategyUpdate {
+// SetTrailingLevels sets the "trailingLevels" field.
+func (_u *StrategyUpdate) SetTrailingLevels(v int) *StrategyUpdate {
+	_u.mutation.ResetTrailingLevels()
+	_u.mutation.SetTrailingLevels(v)
+	return _u
+}
+
+// SetNillableTrailingLevels sets the "trailingLevels" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableTrailingLevels(v *int) *StrategyUpdate {
 	if v != nil {
-		_u.SetStartTime(*v)
+		_u.SetTrailingLevels(*v)
 	}
 	return _u
 }
 
-// ClearStartTime clears the value of the "startTime" field.
-func (_u *StrategyUpdate) ClearStartTime() *StrategyUpdate {
-	_u.mutation.ClearStartTime()
+// AddTrailingLevels adds value to the "trailingLevels" field.
+func (_u *StrategyUpdate) AddTrailingLevels(v int) *StrategyUpdate {
+	_u.mutation.AddTrailingLevels(v)
 	return _u
 }
 
-// Mutation returns the StrategyMutation object of the builder.
-func (_u *StrategyUpdate) Mutation() *StrategyMutation {
-	return _u.mutation
+// ClearTrailingLevels clears the value of the "trailingLevels" field.
+func (_u *StrategyUpdate) ClearTrailingLevels() *StrategyUpdate {
+	_u.mutation.ClearTrailingLevels()
+	return _u
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (_u *StrategyUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// SetTrailingPriceUpperLimit sets the "trailingPriceUpperLimit" field.
+func (_u *StrategyUpdate) SetTrailingPriceUpperLimit(v decimal.Decimal) *StrategyUpdate {
+	_u.mutation.SetTrailingPriceUpperLimit(v)
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *StrategyUpdate) SaveX(ctx context.Context) int {
-	affected, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+// SetNillableTrailingPriceUpperLimit sets the "trailingPriceUpperLimit" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableTrailingPriceUpperLimit(v *decimal.Decimal) *StrategyUpdate {
+	if v != nil {
+		_u.SetTrailingPriceUpperLimit(*v)
 	}
-	return affected
+	return _u
 }
 
-// Exec executes the query.
-func (_u *StrategyUpdate) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// ClearTrailingPriceUpperLimit clears the value of the "trailingPriceUpperLimit" field.
+func (_u *StrategyUpdate) ClearTrailingPriceUpperLimit() *StrategyUpdate {
+	_u.mutation.ClearTrailingPriceUpperLimit()
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *StrategyUpdate) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
+// SetTrailingPriceLowerLimit sets the "trailingPriceLowerLimit" field.
+func (_u *StrategyUpdate) SetTrailingPriceLowerLimit(v decimal.Decimal) *StrategyUpdate {
+	_u.mutation.SetTrailingPriceLowerLimit(v)
+	return _u
+}
+
+// SetNillableTrailingPriceLowerLimit sets the "trailingPriceLowerLimit" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableTrailingPriceLowerLimit(v *decimal.Decimal) *StrategyUpdate {
+	if v != nil {
+		_u.SetTrailingPriceLowerLimit(*v)
 	}
+	return _u
 }
 
-// defaults sets the default values of the builder before save.
-func (_u *StrategyUpdate) defaults() {
-	if _, ok := _u.mutation.UpdateTime(); !ok {
-		v := strategy.UpdateDefaultUpdateTime()
-		_u.mutation.SetUpdateTime(v)
+// ClearTrailingPriceLowerLimit clears the value of the "trailingPriceLowerLimit" field.
+func (_u *StrategyUpdate) ClearTrailingPriceLowerLimit() *StrategyUpdate {
+	_u.mutation.ClearTrailingPriceLowerLimit()
+	return _u
+}
+
+// SetAtrPeriod sets the "atrPeriod" field.
+func (_u *StrategyUpdate) SetAtrPeriod(v int) *StrategyUpdate {
+	_u.mutation.ResetAtrPeriod()
+	_u.mutation.SetAtrPeriod(v)
+	return _u
+}
+
+// SetNillableAtrPeriod sets the "atrPeriod" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableAtrPeriod(v *int) *StrategyUpdate {
+	if v != nil {
+		_u.SetAtrPeriod(*v)
 	}
+	return _u
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (_u *StrategyUpdate) check() error {
-	if v, ok := _u.mutation.GUID(); ok {
-		if err := strategy.GUIDValidator(v); err != nil {
-			return &ValidationError{Name: "guid", err: fmt.Errorf(`ent: validator failed for field "Strategy.guid": %w`, err)}
-		}
+// AddAtrPeriod adds value to the "atrPeriod" field.
+func (_u *StrategyUpdate) AddAtrPeriod(v int) *StrategyUpdate {
+	_u.mutation.AddAtrPeriod(v)
+	return _u
+}
+
+// ClearAtrPeriod clears the value of the "atrPeriod" field.
+func (_u *StrategyUpdate) ClearAtrPeriod() *StrategyUpdate {
+	_u.mutation.ClearAtrPeriod()
+	return _u
+}
+
+// SetAtrMultiplier sets the "atrMultiplier" field.
+func (_u *StrategyUpdate) SetAtrMultiplier(v decimal.Decimal) *StrategyUpdate {
+	_u.mutation.SetAtrMultiplier(v)
+	return _u
+}
+
+// SetNillableAtrMultiplier sets the "atrMultiplier" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableAtrMultiplier(v *decimal.Decimal) *StrategyUpdate {
+	if v != nil {
+		_u.SetAtrMultiplier(*v)
 	}
-	if v, ok := _u.mutation.Exchange(); ok {
-		if err := strategy.ExchangeValidator(v); err != nil {
-			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "Strategy.exchange": %w`, err)}
-		}
+	return _u
+}
+
+// ClearAtrMultiplier clears the value of the "atrMultiplier" field.
+func (_u *StrategyUpdate) ClearAtrMultiplier() *StrategyUpdate {
+	_u.mutation.ClearAtrMultiplier()
+	return _u
+}
+
+// SetAdaptiveThreshold sets the "adaptiveThreshold" field.
+func (_u *StrategyUpdate) SetAdaptiveThreshold(v decimal.Decimal) *StrategyUpdate {
+	_u.mutation.SetAdaptiveThreshold(v)
+	return _u
+}
+
+// SetNillableAdaptiveThreshold sets the "adaptiveThreshold" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableAdaptiveThreshold(v *decimal.Decimal) *StrategyUpdate {
+	if v != nil {
+		_u.SetAdaptiveThreshold(*v)
 	}
-	if v, ok := _u.mutation.Symbol(); ok {
-		if err := strategy.SymbolValidator(v); err != nil {
-			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "Strategy.symbol": %w`, err)}
-		}
+	return _u
+}
+
+// ClearAdaptiveThreshold clears the value of the "adaptiveThreshold" field.
+func (_u *StrategyUpdate) ClearAdaptiveThreshold() *StrategyUpdate {
+	_u.mutation.ClearAdaptiveThreshold()
+	return _u
+}
+
+// SetAdaptiveIntervalHours sets the "adaptiveIntervalHours" field.
+func (_u *StrategyUpdate) SetAdaptiveIntervalHours(v int) *StrategyUpdate {
+	_u.mutation.ResetAdaptiveIntervalHours()
+	_u.mutation.SetAdaptiveIntervalHours(v)
+	return _u
+}
+
+// SetNillableAdaptiveIntervalHours sets the "adaptiveIntervalHours" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableAdaptiveIntervalHours(v *int) *StrategyUpdate {
+	if v != nil {
+		_u.SetAdaptiveIntervalHours(*v)
 	}
-	if v, ok := _u.mutation.Mode(); ok {
-		if err := strategy.ModeValidator(v); err != nil {
-			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "Strategy.mode": %w`, err)}
-		}
+	return _u
+}
+
+// AddAdaptiveIntervalHours adds value to the "adaptiveIntervalHours" field.
+func (_u *StrategyUpdate) AddAdaptiveIntervalHours(v int) *StrategyUpdate {
+	_u.mutation.AddAdaptiveIntervalHours(v)
+	return _u
+}
+
+// ClearAdaptiveIntervalHours clears the value of the "adaptiveIntervalHours" field.
+func (_u *StrategyUpdate) ClearAdaptiveIntervalHours() *StrategyUpdate {
+	_u.mutation.ClearAdaptiveIntervalHours()
+	return _u
+}
+
+// SetAdaptiveAtr sets the "adaptiveAtr" field.
+func (_u *StrategyUpdate) SetAdaptiveAtr(v decimal.Decimal) *StrategyUpdate {
+	_u.mutation.SetAdaptiveAtr(v)
+	return _u
+}
+
+// SetNillableAdaptiveAtr sets the "adaptiveAtr" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableAdaptiveAtr(v *decimal.Decimal) *StrategyUpdate {
+	if v != nil {
+		_u.SetAdaptiveAtr(*v)
 	}
-	if v, ok := _u.mutation.MarginMode(); ok {
-		if err := strategy.MarginModeValidator(v); err != nil {
-			return &ValidationError{Name: "marginMode", err: fmt.Errorf(`ent: validator failed for field "Strategy.marginMode": %w`, err)}
-		}
+	return _u
+}
+
+// ClearAdaptiveAtr clears the value of the "adaptiveAtr" field.
+func (_u *StrategyUpdate) ClearAdaptiveAtr() *StrategyUpdate {
+	_u.mutation.ClearAdaptiveAtr()
+	return _u
+}
+
+// SetAdaptiveUpdatedAt sets the "adaptiveUpdatedAt" field.
+func (_u *StrategyUpdate) SetAdaptiveUpdatedAt(v time.Time) *StrategyUpdate {
+	_u.mutation.SetAdaptiveUpdatedAt(v)
+	return _u
+}
+
+// SetNillableAdaptiveUpdatedAt sets the "adaptiveUpdatedAt" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableAdaptiveUpdatedAt(v *time.Time) *StrategyUpdate {
+	if v != nil {
+		_u.SetAdaptiveUpdatedAt(*v)
 	}
-	if v, ok := _u.mutation.QuantityMode(); ok {
-		if err := strategy.QuantityModeValidator(v); err != nil {
-			return &ValidationError{Name: "quantityMode", err: fmt.Errorf(`ent: validator failed for field "Strategy.quantityMode": %w`, err)}
-		}
+	return _u
+}
+
+// ClearAdaptiveUpdatedAt clears the value of the "adaptiveUpdatedAt" field.
+func (_u *StrategyUpdate) ClearAdaptiveUpdatedAt() *StrategyUpdate {
+	_u.mutation.ClearAdaptiveUpdatedAt()
+	return _u
+}
+
+// SetCancelRepairPolicy sets the "cancelRepairPolicy" field.
+func (_u *StrategyUpdate) SetCancelRepairPolicy(v strategy.CancelRepairPolicy) *StrategyUpdate {
+	_u.mutation.SetCancelRepairPolicy(v)
+	return _u
+}
+
+// SetNillableCancelRepairPolicy sets the "cancelRepairPolicy" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableCancelRepairPolicy(v *strategy.CancelRepairPolicy) *StrategyUpdate {
+	if v != nil {
+		_u.SetCancelRepairPolicy(*v)
 	}
-	if v, ok := _u.mutation.GridNum(); ok {
-		if err := strategy.GridNumValidator(v); err != nil {
-			return &ValidationError{Name: "gridNum", err: fmt.Errorf(`ent: validator failed for field "Strategy.gridNum": %w`, err)}
+	return _u
+}
+
+// SetCancelRepairMaxAttempts sets the "cancelRepairMaxAttempts" field.
+func (_u *StrategyUpdate) SetCancelRepairMaxAttempts(v int) *StrategyUpdate {
+	_u.mutation.ResetCancelRepairMaxAttempts()
+	_u.mutation.SetCancelRepairMaxAttempts(v)
+	return _u
+}
+
+// SetNillableCancelRepairMaxAttempts sets the "cancelRepairMaxAttempts" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableCancelRepairMaxAttempts(v *int) *StrategyUpdate {
+	if v != nil {
+		_u.SetCancelRepairMaxAttempts(*v)
+	}
+	return _u
+}
+
+// AddCancelRepairMaxAttempts adds value to the "cancelRepairMaxAttempts" field.
+func (_u *StrategyUpdate) AddCancelRepairMaxAttempts(v int) *StrategyUpdate {
+	_u.mutation.AddCancelRepairMaxAttempts(v)
+	return _u
+}
+
+// ClearCancelRepairMaxAttempts clears the value of the "cancelRepairMaxAttempts" field.
+func (_u *StrategyUpdate) ClearCancelRepairMaxAttempts() *StrategyUpdate {
+	_u.mutation.ClearCancelRepairMaxAttempts()
+	return _u
+}
+
+// SetReconcilePolicy sets the "reconcilePolicy" field.
+func (_u *StrategyUpdate) SetReconcilePolicy(v strategy.ReconcilePolicy) *StrategyUpdate {
+	_u.mutation.SetReconcilePolicy(v)
+	return _u
+}
+
+// SetNillableReconcilePolicy sets the "reconcilePolicy" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableReconcilePolicy(v *strategy.ReconcilePolicy) *StrategyUpdate {
+	if v != nil {
+		_u.SetReconcilePolicy(*v)
+	}
+	return _u
+}
+
+// SetPartialFillThreshold sets the "partialFillThreshold" field.
+func (_u *StrategyUpdate) SetPartialFillThreshold(v decimal.Decimal) *StrategyUpdate {
+	_u.mutation.SetPartialFillThreshold(v)
+	return _u
+}
+
+// SetNillablePartialFillThreshold sets the "partialFillThreshold" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillablePartialFillThreshold(v *decimal.Decimal) *StrategyUpdate {
+	if v != nil {
+		_u.SetPartialFillThreshold(*v)
+	}
+	return _u
+}
+
+// ClearPartialFillThreshold clears the value of the "partialFillThreshold" field.
+func (_u *StrategyUpdate) ClearPartialFillThreshold() *StrategyUpdate {
+	_u.mutation.ClearPartialFillThreshold()
+	return _u
+}
+
+// SetEnablePushNotification sets the "enablePushNotification" field.
+func (_u *StrategyUpdate) SetEnablePushNotification(v bool) *StrategyUpdate {
+	_u.mutation.SetEnablePushNotification(v)
+	return _u
+}
+
+// SetNillableEnablePushNotification sets the "enablePushNotification" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableEnablePushNotification(v *bool) *StrategyUpdate {
+	if v != nil {
+		_u.SetEnablePushNotification(*v)
+	}
+	return _u
+}
+
+// SetEnablePushMatchedNotification sets the "enablePushMatchedNotification" field.
+func (_u *StrategyUpdate) SetEnablePushMatchedNotification(v bool) *StrategyUpdate {
+	_u.mutation.SetEnablePushMatchedNotification(v)
+	return _u
+}
+
+// SetNillableEnablePushMatchedNotification sets the "enablePushMatchedNotification" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableEnablePushMatchedNotification(v *bool) *StrategyUpdate {
+	if v != nil {
+		_u.SetEnablePushMatchedNotification(*v)
+	}
+	return _u
+}
+
+// ClearEnablePushMatchedNotification clears the value of the "enablePushMatchedNotification" field.
+func (_u *StrategyUpdate) ClearEnablePushMatchedNotification() *StrategyUpdate {
+	_u.mutation.ClearEnablePushMatchedNotification()
+	return _u
+}
+
+// SetLastLowerThresholdAlertTime sets the "lastLowerThresholdAlertTime" field.
+func (_u *StrategyUpdate) SetLastLowerThresholdAlertTime(v time.Time) *StrategyUpdate {
+	_u.mutation.SetLastLowerThresholdAlertTime(v)
+	return _u
+}
+
+// SetNillableLastLowerThresholdAlertTime sets the "lastLowerThresholdAlertTime" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableLastLowerThresholdAlertTime(v *time.Time) *StrategyUpdate {
+	if v != nil {
+		_u.SetLastLowerThresholdAlertTime(*v)
+	}
+	return _u
+}
+
+// ClearLastLowerThresholdAlertTime clears the value of the "lastLowerThresholdAlertTime" field.
+func (_u *StrategyUpdate) ClearLastLowerThresholdAlertTime() *StrategyUpdate {
+	_u.mutation.ClearLastLowerThresholdAlertTime()
+	return _u
+}
+
+// SetLastUpperThresholdAlertTime sets the "lastUpperThresholdAlertTime" field.
+func (_u *StrategyUpdate) SetLastUpperThresholdAlertTime(v time.Time) *StrategyUpdate {
+	_u.mutation.SetLastUpperThresholdAlertTime(v)
+	return _u
+}
+
+// SetNillableLastUpperThresholdAlertTime sets the "lastUpperThresholdAlertTime" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableLastUpperThresholdAlertTime(v *time.Time) *StrategyUpdate {
+	if v != nil {
+		_u.SetLastUpperThresholdAlertTime(*v)
+	}
+	return _u
+}
+
+// ClearLastUpperThresholdAlertTime clears the value of the "lastUpperThresholdAlertTime" field.
+func (_u *StrategyUpdate) ClearLastUpperThresholdAlertTime() *StrategyUpdate {
+	_u.mutation.ClearLastUpperThresholdAlertTime()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *StrategyUpdate) SetStatus(v strategy.Status) *StrategyUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableStatus(v *strategy.Status) *StrategyUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetExchangeApiKey sets the "exchangeApiKey" field.
+func (_u *StrategyUpdate) SetExchangeApiKey(v string) *StrategyUpdate {
+	_u.mutation.SetExchangeApiKey(v)
+	return _u
+}
+
+// SetNillableExchangeApiKey sets the "exchangeApiKey" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableExchangeApiKey(v *string) *StrategyUpdate {
+	if v != nil {
+		_u.SetExchangeApiKey(*v)
+	}
+	return _u
+}
+
+// SetExchangeSecretKey sets the "exchangeSecretKey" field.
+func (_u *StrategyUpdate) SetExchangeSecretKey(v string) *StrategyUpdate {
+	_u.mutation.SetExchangeSecretKey(v)
+	return _u
+}
+
+// SetNillableExchangeSecretKey sets the "exchangeSecretKey" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableExchangeSecretKey(v *string) *StrategyUpdate {
+	if v != nil {
+		_u.SetExchangeSecretKey(*v)
+	}
+	return _u
+}
+
+// SetExchangePassphrase sets the "exchangePassphrase" field.
+func (_u *StrategyUpdate) SetExchangePassphrase(v string) *StrategyUpdate {
+	_u.mutation.SetExchangePassphrase(v)
+	return _u
+}
+
+// SetNillableExchangePassphrase sets the "exchangePassphrase" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableExchangePassphrase(v *string) *StrategyUpdate {
+	if v != nil {
+		_u.SetExchangePassphrase(*v)
+	}
+	return _u
+}
+
+// SetExchangeTestnet sets the "exchangeTestnet" field.
+func (_u *StrategyUpdate) SetExchangeTestnet(v bool) *StrategyUpdate {
+	_u.mutation.SetExchangeTestnet(v)
+	return _u
+}
+
+// SetNillableExchangeTestnet sets the "exchangeTestnet" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableExchangeTestnet(v *bool) *StrategyUpdate {
+	if v != nil {
+		_u.SetExchangeTestnet(*v)
+	}
+	return _u
+}
+
+// SetAccountId sets the "accountId" field.
+func (_u *StrategyUpdate) SetAccountId(v int) *StrategyUpdate {
+	_u.mutation.SetAccountId(v)
+	return _u
+}
+
+// SetNillableAccountId sets the "accountId" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableAccountId(v *int) *StrategyUpdate {
+	if v != nil {
+		_u.SetAccountId(*v)
+	}
+	return _u
+}
+
+// ClearAccountId clears the value of the "accountId" field.
+func (_u *StrategyUpdate) ClearAccountId() *StrategyUpdate {
+	_u.mutation.ClearAccountId()
+	return _u
+}
+
+// SetStartTime sets the "startTime" field.
+func (_u *StrategyUpdate) SetStartTime(v time.Time) *StrategyUpdate {
+	_u.mutation.SetStartTime(v)
+	return _u
+}
+
+// SetNillableStartTime sets the "startTime" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableStartTime(v *time.Time) *StrategyUpdate {
+	if v != nil {
+		_u.SetStartTime(*v)
+	}
+	return _u
+}
+
+// ClearStartTime clears the value of the "startTime" field.
+func (_u *StrategyUpdate) ClearStartTime() *StrategyUpdate {
+	_u.mutation.ClearStartTime()
+	return _u
+}
+
+// SetExchangeAccountID sets the "exchangeAccount" edge to the ExchangeAccount entity by ID.
+func (_u *StrategyUpdate) SetExchangeAccountID(id int) *StrategyUpdate {
+	_u.mutation.SetExchangeAccountID(id)
+	return _u
+}
+
+// SetNillableExchangeAccountID sets the "exchangeAccount" edge to the ExchangeAccount entity by ID if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableExchangeAccountID(id *int) *StrategyUpdate {
+	if id != nil {
+		_u = _u.SetExchangeAccountID(*id)
+	}
+	return _u
+}
+
+// SetExchangeAccount sets the "exchangeAccount" edge to the ExchangeAccount entity.
+func (_u *StrategyUpdate) SetExchangeAccount(v *ExchangeAccount) *StrategyUpdate {
+	return _u.SetExchangeAccountID(v.ID)
+}
+
+// Mutation returns the StrategyMutation object of the builder.
+func (_u *StrategyUpdate) Mutation() *StrategyMutation {
+	return _u.mutation
+}
+
+// ClearExchangeAccount clears the "exchangeAccount" edge to the ExchangeAccount entity.
+func (_u *StrategyUpdate) ClearExchangeAccount() *StrategyUpdate {
+	_u.mutation.ClearExchangeAccount()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *StrategyUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StrategyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *StrategyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StrategyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *StrategyUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := strategy.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StrategyUpdate) check() error {
+	if v, ok := _u.mutation.GUID(); ok {
+		if err := strategy.GUIDValidator(v); err != nil {
+			return &ValidationError{Name: "guid", err: fmt.Errorf(`ent: validator failed for field "Strategy.guid": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Exchange(); ok {
+		if err := strategy.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "Strategy.exchange": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Symbol(); ok {
+		if err := strategy.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "Strategy.symbol": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Mode(); ok {
+		if err := strategy.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "Strategy.mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MarginMode(); ok {
+		if err := strategy.MarginModeValidator(v); err != nil {
+			return &ValidationError{Name: "marginMode", err: fmt.Errorf(`ent: validator failed for field "Strategy.marginMode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.QuantityMode(); ok {
+		if err := strategy.QuantityModeValidator(v); err != nil {
+			return &ValidationError{Name: "quantityMode", err: fmt.Errorf(`ent: validator failed for field "Strategy.quantityMode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GridNum(); ok {
+		if err := strategy.GridNumValidator(v); err != nil {
+			return &ValidationError{Name: "gridNum", err: fmt.Errorf(`ent: validator failed for field "Strategy.gridNum": %w`, err)}
 		}
 	}
 	if v, ok := _u.mutation.Leverage(); ok {
@@ -572,11 +1128,51 @@ func (_u *StrategyUpdate) check() error {
 			return &ValidationError{Name: "leverage", err: fmt.Errorf(`ent: validator failed for field "Strategy.leverage": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.SizingMode(); ok {
+		if err := strategy.SizingModeValidator(v); err != nil {
+			return &ValidationError{Name: "sizingMode", err: fmt.Errorf(`ent: validator failed for field "Strategy.sizingMode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SizingWeights(); ok {
+		if err := strategy.SizingWeightsValidator(v); err != nil {
+			return &ValidationError{Name: "sizingWeights", err: fmt.Errorf(`ent: validator failed for field "Strategy.sizingWeights": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.SlippageBps(); ok {
 		if err := strategy.SlippageBpsValidator(v); err != nil {
 			return &ValidationError{Name: "slippageBps", err: fmt.Errorf(`ent: validator failed for field "Strategy.slippageBps": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.TrailingLevels(); ok {
+		if err := strategy.TrailingLevelsValidator(v); err != nil {
+			return &ValidationError{Name: "trailingLevels", err: fmt.Errorf(`ent: validator failed for field "Strategy.trailingLevels": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AtrPeriod(); ok {
+		if err := strategy.AtrPeriodValidator(v); err != nil {
+			return &ValidationError{Name: "atrPeriod", err: fmt.Errorf(`ent: validator failed for field "Strategy.atrPeriod": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AdaptiveIntervalHours(); ok {
+		if err := strategy.AdaptiveIntervalHoursValidator(v); err != nil {
+			return &ValidationError{Name: "adaptiveIntervalHours", err: fmt.Errorf(`ent: validator failed for field "Strategy.adaptiveIntervalHours": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CancelRepairPolicy(); ok {
+		if err := strategy.CancelRepairPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "cancelRepairPolicy", err: fmt.Errorf(`ent: validator failed for field "Strategy.cancelRepairPolicy": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CancelRepairMaxAttempts(); ok {
+		if err := strategy.CancelRepairMaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "cancelRepairMaxAttempts", err: fmt.Errorf(`ent: validator failed for field "Strategy.cancelRepairMaxAttempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReconcilePolicy(); ok {
+		if err := strategy.ReconcilePolicyValidator(v); err != nil {
+			return &ValidationError{Name: "reconcilePolicy", err: fmt.Errorf(`ent: validator failed for field "Strategy.reconcilePolicy": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Status(); ok {
 		if err := strategy.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Strategy.status": %w`, err)}
@@ -597,439 +1193,1143 @@ func (_u *StrategyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
-	if value, ok := _u.mutation.UpdateTime(); ok {
-		_spec.SetField(strategy.FieldUpdateTime, field.TypeTime, value)
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(strategy.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.GUID(); ok {
+		_spec.SetField(strategy.FieldGUID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Owner(); ok {
+		_spec.SetField(strategy.FieldOwner, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOwner(); ok {
+		_spec.AddField(strategy.FieldOwner, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Exchange(); ok {
+		_spec.SetField(strategy.FieldExchange, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Symbol(); ok {
+		_spec.SetField(strategy.FieldSymbol, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Account(); ok {
+		_spec.SetField(strategy.FieldAccount, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(strategy.FieldMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.MarginMode(); ok {
+		_spec.SetField(strategy.FieldMarginMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.QuantityMode(); ok {
+		_spec.SetField(strategy.FieldQuantityMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PriceUpper(); ok {
+		_spec.SetField(strategy.FieldPriceUpper, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PriceLower(); ok {
+		_spec.SetField(strategy.FieldPriceLower, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GridNum(); ok {
+		_spec.SetField(strategy.FieldGridNum, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGridNum(); ok {
+		_spec.AddField(strategy.FieldGridNum, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Leverage(); ok {
+		_spec.SetField(strategy.FieldLeverage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLeverage(); ok {
+		_spec.AddField(strategy.FieldLeverage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.InitialOrderSize(); ok {
+		_spec.SetField(strategy.FieldInitialOrderSize, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SizingMode(); ok {
+		_spec.SetField(strategy.FieldSizingMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.SizingFactor(); ok {
+		_spec.SetField(strategy.FieldSizingFactor, field.TypeString, value)
+	}
+	if _u.mutation.SizingFactorCleared() {
+		_spec.ClearField(strategy.FieldSizingFactor, field.TypeString)
+	}
+	if value, ok := _u.mutation.SizingWeights(); ok {
+		_spec.SetField(strategy.FieldSizingWeights, field.TypeString, value)
+	}
+	if _u.mutation.SizingWeightsCleared() {
+		_spec.ClearField(strategy.FieldSizingWeights, field.TypeString)
+	}
+	if value, ok := _u.mutation.SlippageBps(); ok {
+		_spec.SetField(strategy.FieldSlippageBps, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSlippageBps(); ok {
+		_spec.AddField(strategy.FieldSlippageBps, field.TypeInt, value)
+	}
+	if _u.mutation.SlippageBpsCleared() {
+		_spec.ClearField(strategy.FieldSlippageBps, field.TypeInt)
+	}
+	if value, ok := _u.mutation.EntryPrice(); ok {
+		_spec.SetField(strategy.FieldEntryPrice, field.TypeString, value)
+	}
+	if _u.mutation.EntryPriceCleared() {
+		_spec.ClearField(strategy.FieldEntryPrice, field.TypeString)
+	}
+	if value, ok := _u.mutation.TriggerStopLossPrice(); ok {
+		_spec.SetField(strategy.FieldTriggerStopLossPrice, field.TypeString, value)
+	}
+	if _u.mutation.TriggerStopLossPriceCleared() {
+		_spec.ClearField(strategy.FieldTriggerStopLossPrice, field.TypeString)
+	}
+	if value, ok := _u.mutation.TriggerTakeProfitPrice(); ok {
+		_spec.SetField(strategy.FieldTriggerTakeProfitPrice, field.TypeString, value)
+	}
+	if _u.mutation.TriggerTakeProfitPriceCleared() {
+		_spec.ClearField(strategy.FieldTriggerTakeProfitPrice, field.TypeString)
+	}
+	if value, ok := _u.mutation.MaxLossAmount(); ok {
+		_spec.SetField(strategy.FieldMaxLossAmount, field.TypeString, value)
+	}
+	if _u.mutation.MaxLossAmountCleared() {
+		_spec.ClearField(strategy.FieldMaxLossAmount, field.TypeString)
+	}
+	if value, ok := _u.mutation.MaxLossPercent(); ok {
+		_spec.SetField(strategy.FieldMaxLossPercent, field.TypeString, value)
+	}
+	if _u.mutation.MaxLossPercentCleared() {
+		_spec.ClearField(strategy.FieldMaxLossPercent, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProfitTargetAmount(); ok {
+		_spec.SetField(strategy.FieldProfitTargetAmount, field.TypeString, value)
+	}
+	if _u.mutation.ProfitTargetAmountCleared() {
+		_spec.ClearField(strategy.FieldProfitTargetAmount, field.TypeString)
+	}
+	if value, ok := _u.mutation.TrailingStopLossAmount(); ok {
+		_spec.SetField(strategy.FieldTrailingStopLossAmount, field.TypeString, value)
+	}
+	if _u.mutation.TrailingStopLossAmountCleared() {
+		_spec.ClearField(strategy.FieldTrailingStopLossAmount, field.TypeString)
+	}
+	if value, ok := _u.mutation.TrailingStopLossPercent(); ok {
+		_spec.SetField(strategy.FieldTrailingStopLossPercent, field.TypeString, value)
+	}
+	if _u.mutation.TrailingStopLossPercentCleared() {
+		_spec.ClearField(strategy.FieldTrailingStopLossPercent, field.TypeString)
+	}
+	if value, ok := _u.mutation.TrailingTakeProfitActivationPrice(); ok {
+		_spec.SetField(strategy.FieldTrailingTakeProfitActivationPrice, field.TypeString, value)
+	}
+	if _u.mutation.TrailingTakeProfitActivationPriceCleared() {
+		_spec.ClearField(strategy.FieldTrailingTakeProfitActivationPrice, field.TypeString)
+	}
+	if value, ok := _u.mutation.TrailingTakeProfitAmount(); ok {
+		_spec.SetField(strategy.FieldTrailingTakeProfitAmount, field.TypeString, value)
+	}
+	if _u.mutation.TrailingTakeProfitAmountCleared() {
+		_spec.ClearField(strategy.FieldTrailingTakeProfitAmount, field.TypeString)
+	}
+	if value, ok := _u.mutation.TrailingTakeProfitPercent(); ok {
+		_spec.SetField(strategy.FieldTrailingTakeProfitPercent, field.TypeString, value)
+	}
+	if _u.mutation.TrailingTakeProfitPercentCleared() {
+		_spec.ClearField(strategy.FieldTrailingTakeProfitPercent, field.TypeString)
+	}
+	if value, ok := _u.mutation.TrailingExtremePrice(); ok {
+		_spec.SetField(strategy.FieldTrailingExtremePrice, field.TypeString, value)
+	}
+	if _u.mutation.TrailingExtremePriceCleared() {
+		_spec.ClearField(strategy.FieldTrailingExtremePrice, field.TypeString)
+	}
+	if value, ok := _u.mutation.TrailingLevels(); ok {
+		_spec.SetField(strategy.FieldTrailingLevels, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTrailingLevels(); ok {
+		_spec.AddField(strategy.FieldTrailingLevels, field.TypeInt, value)
+	}
+	if _u.mutation.TrailingLevelsCleared() {
+		_spec.ClearField(strategy.FieldTrailingLevels, field.TypeInt)
+	}
+	if value, ok := _u.mutation.TrailingPriceUpperLimit(); ok {
+		_spec.SetField(strategy.FieldTrailingPriceUpperLimit, field.TypeString, value)
+	}
+	if _u.mutation.TrailingPriceUpperLimitCleared() {
+		_spec.ClearField(strategy.FieldTrailingPriceUpperLimit, field.TypeString)
+	}
+	if value, ok := _u.mutation.TrailingPriceLowerLimit(); ok {
+		_spec.SetField(strategy.FieldTrailingPriceLowerLimit, field.TypeString, value)
+	}
+	if _u.mutation.TrailingPriceLowerLimitCleared() {
+		_spec.ClearField(strategy.FieldTrailingPriceLowerLimit, field.TypeString)
+	}
+	if value, ok := _u.mutation.AtrPeriod(); ok {
+		_spec.SetField(strategy.FieldAtrPeriod, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAtrPeriod(); ok {
+		_spec.AddField(strategy.FieldAtrPeriod, field.TypeInt, value)
+	}
+	if _u.mutation.AtrPeriodCleared() {
+		_spec.ClearField(strategy.FieldAtrPeriod, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AtrMultiplier(); ok {
+		_spec.SetField(strategy.FieldAtrMultiplier, field.TypeString, value)
+	}
+	if _u.mutation.AtrMultiplierCleared() {
+		_spec.ClearField(strategy.FieldAtrMultiplier, field.TypeString)
+	}
+	if value, ok := _u.mutation.AdaptiveThreshold(); ok {
+		_spec.SetField(strategy.FieldAdaptiveThreshold, field.TypeString, value)
+	}
+	if _u.mutation.AdaptiveThresholdCleared() {
+		_spec.ClearField(strategy.FieldAdaptiveThreshold, field.TypeString)
+	}
+	if value, ok := _u.mutation.AdaptiveIntervalHours(); ok {
+		_spec.SetField(strategy.FieldAdaptiveIntervalHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAdaptiveIntervalHours(); ok {
+		_spec.AddField(strategy.FieldAdaptiveIntervalHours, field.TypeInt, value)
+	}
+	if _u.mutation.AdaptiveIntervalHoursCleared() {
+		_spec.ClearField(strategy.FieldAdaptiveIntervalHours, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AdaptiveAtr(); ok {
+		_spec.SetField(strategy.FieldAdaptiveAtr, field.TypeString, value)
+	}
+	if _u.mutation.AdaptiveAtrCleared() {
+		_spec.ClearField(strategy.FieldAdaptiveAtr, field.TypeString)
+	}
+	if value, ok := _u.mutation.AdaptiveUpdatedAt(); ok {
+		_spec.SetField(strategy.FieldAdaptiveUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AdaptiveUpdatedAtCleared() {
+		_spec.ClearField(strategy.FieldAdaptiveUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CancelRepairPolicy(); ok {
+		_spec.SetField(strategy.FieldCancelRepairPolicy, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CancelRepairMaxAttempts(); ok {
+		_spec.SetField(strategy.FieldCancelRepairMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCancelRepairMaxAttempts(); ok {
+		_spec.AddField(strategy.FieldCancelRepairMaxAttempts, field.TypeInt, value)
+	}
+	if _u.mutation.CancelRepairMaxAttemptsCleared() {
+		_spec.ClearField(strategy.FieldCancelRepairMaxAttempts, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ReconcilePolicy(); ok {
+		_spec.SetField(strategy.FieldReconcilePolicy, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PartialFillThreshold(); ok {
+		_spec.SetField(strategy.FieldPartialFillThreshold, field.TypeString, value)
 	}
-	if value, ok := _u.mutation.GUID(); ok {
-		_spec.SetField(strategy.FieldGUID, field.TypeString, value)
+	if _u.mutation.PartialFillThresholdCleared() {
+		_spec.ClearField(strategy.FieldPartialFillThreshold, field.TypeString)
 	}
-	if value, ok := _u.mutation.Owner(); ok {
-		_spec.SetField(strategy.FieldOwner, field.TypeInt64, value)
+	if value, ok := _u.mutation.EnablePushNotification(); ok {
+		_spec.SetField(strategy.FieldEnablePushNotification, field.TypeBool, value)
 	}
-	if value, ok := _u.mutation.AddedOwner(); ok {
-		_spec.AddField(strategy.FieldOwner, field.TypeInt64, value)
+	if value, ok := _u.mutation.EnablePushMatchedNotification(); ok {
+		_spec.SetField(strategy.FieldEnablePushMatchedNotification, field.TypeBool, value)
 	}
-	if value, ok := _u.mutation.Exchange(); ok {
-		_spec.SetField(strategy.FieldExchange, field.TypeString, value)
+	if _u.mutation.EnablePushMatchedNotificationCleared() {
+		_spec.ClearField(strategy.FieldEnablePushMatchedNotification, field.TypeBool)
 	}
-	if value, ok := _u.mutation.Symbol(); ok {
-		_spec.SetField(strategy.FieldSymbol, field.TypeString, value)
+	if value, ok := _u.mutation.LastLowerThresholdAlertTime(); ok {
+		_spec.SetField(strategy.FieldLastLowerThresholdAlertTime, field.TypeTime, value)
 	}
-	if value, ok := _u.mutation.Account(); ok {
-		_spec.SetField(strategy.FieldAccount, field.TypeString, value)
+	if _u.mutation.LastLowerThresholdAlertTimeCleared() {
+		_spec.ClearField(strategy.FieldLastLowerThresholdAlertTime, field.TypeTime)
 	}
-	if value, ok := _u.mutation.Mode(); ok {
-		_spec.SetField(strategy.FieldMode, field.TypeEnum, value)
+	if value, ok := _u.mutation.LastUpperThresholdAlertTime(); ok {
+		_spec.SetField(strategy.FieldLastUpperThresholdAlertTime, field.TypeTime, value)
 	}
-	if value, ok := _u.mutation.MarginMode(); ok {
-		_spec.SetField(strategy.FieldMarginMode, field.TypeEnum, value)
+	if _u.mutation.LastUpperThresholdAlertTimeCleared() {
+		_spec.ClearField(strategy.FieldLastUpperThresholdAlertTime, field.TypeTime)
 	}
-	if value, ok := _u.mutation.QuantityMode(); ok {
-		_spec.SetField(strategy.FieldQuantityMode, field.TypeEnum, value)
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(strategy.FieldStatus, field.TypeEnum, value)
 	}
-	if value, ok := _u.mutation.PriceUpper(); ok {
-		_spec.SetField(strategy.FieldPriceUpper, field.TypeString, value)
+	if value, ok := _u.mutation.ExchangeApiKey(); ok {
+		vv, err := strategy.ValueScanner.ExchangeApiKey.Value(value)
+		if err != nil {
+			return 0, err
+		}
+		_spec.SetField(strategy.FieldExchangeApiKey, field.TypeString, vv)
 	}
-	if value, ok := _u.mutation.PriceLower(); ok {
-		_spec.SetField(strategy.FieldPriceLower, field.TypeString, value)
+	if value, ok := _u.mutation.ExchangeSecretKey(); ok {
+		vv, err := strategy.ValueScanner.ExchangeSecretKey.Value(value)
+		if err != nil {
+			return 0, err
+		}
+		_spec.SetField(strategy.FieldExchangeSecretKey, field.TypeString, vv)
 	}
-	if value, ok := _u.mutation.GridNum(); ok {
-		_spec.SetField(strategy.FieldGridNum, field.TypeInt, value)
+	if value, ok := _u.mutation.ExchangePassphrase(); ok {
+		vv, err := strategy.ValueScanner.ExchangePassphrase.Value(value)
+		if err != nil {
+			return 0, err
+		}
+		_spec.SetField(strategy.FieldExchangePassphrase, field.TypeString, vv)
 	}
-	if value, ok := _u.mutation.AddedGridNum(); ok {
-		_spec.AddField(strategy.FieldGridNum, field.TypeInt, value)
+	if value, ok := _u.mutation.ExchangeTestnet(); ok {
+		_spec.SetField(strategy.FieldExchangeTestnet, field.TypeBool, value)
 	}
-	if value, ok := _u.mutation.Leverage(); ok {
-		_spec.SetField(strategy.FieldLeverage, field.TypeInt, value)
+	if value, ok := _u.mutation.StartTime(); ok {
+		_spec.SetField(strategy.FieldStartTime, field.TypeTime, value)
 	}
-	if value, ok := _u.mutation.AddedLeverage(); ok {
-		_spec.AddField(strategy.FieldLeverage, field.TypeInt, value)
+	if _u.mutation.StartTimeCleared() {
+		_spec.ClearField(strategy.FieldStartTime, field.TypeTime)
 	}
-	if value, ok := _u.mutation.InitialOrderSize(); ok {
-		_spec.SetField(strategy.FieldInitialOrderSize, field.TypeString, value)
+	if _u.mutation.ExchangeAccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   strategy.ExchangeAccountTable,
+			Columns: []string{strategy.ExchangeAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exchangeaccount.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ExchangeAccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   strategy.ExchangeAccountTable,
+			Columns: []string{strategy.ExchangeAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exchangeaccount.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.SlippageBps(); ok {
-		_spec.SetField(strategy.FieldSlippageBps, field.TypeInt, value)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{strategy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
 	}
-	if value, ok := _u.mutation.AddedSlippageBps(); ok {
-		_spec.AddField(strategy.FieldSlippageBps, field.TypeInt, value)
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// StrategyUpdateOne is the builder for updating a single Strategy entity.
+type StrategyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StrategyMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *StrategyUpdateOne) SetUpdateTime(v time.Time) *StrategyUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetGUID sets the "guid" field.
+func (_u *StrategyUpdateOne) SetGUID(v string) *StrategyUpdateOne {
+	_u.mutation.SetGUID(v)
+	return _u
+}
+
+// SetNillableGUID sets the "guid" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableGUID(v *string) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetGUID(*v)
 	}
-	if _u.mutation.SlippageBpsCleared() {
-		_spec.ClearField(strategy.FieldSlippageBps, field.TypeInt)
+	return _u
+}
+
+// SetOwner sets the "owner" field.
+func (_u *StrategyUpdateOne) SetOwner(v int64) *StrategyUpdateOne {
+	_u.mutation.ResetOwner()
+	_u.mutation.SetOwner(v)
+	return _u
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableOwner(v *int64) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetOwner(*v)
 	}
-	if value, ok := _u.mutation.EntryPrice(); ok {
-		_spec.SetField(strategy.FieldEntryPrice, field.TypeString, value)
+	return _u
+}
+
+// AddOwner adds value to the "owner" field.
+func (_u *StrategyUpdateOne) AddOwner(v int64) *StrategyUpdateOne {
+	_u.mutation.AddOwner(v)
+	return _u
+}
+
+// SetExchange sets the "exchange" field.
+func (_u *StrategyUpdateOne) SetExchange(v string) *StrategyUpdateOne {
+	_u.mutation.SetExchange(v)
+	return _u
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableExchange(v *string) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetExchange(*v)
 	}
-	if _u.mutation.EntryPriceCleared() {
-		_spec.ClearField(strategy.FieldEntryPrice, field.TypeString)
+	return _u
+}
+
+// SetSymbol sets the "symbol" field.
+func (_u *StrategyUpdateOne) SetSymbol(v string) *StrategyUpdateOne {
+	_u.mutation.SetSymbol(v)
+	return _u
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableSymbol(v *string) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetSymbol(*v)
 	}
-	if value, ok := _u.mutation.TriggerStopLossPrice(); ok {
-		_spec.SetField(strategy.FieldTriggerStopLossPrice, field.TypeString, value)
+	return _u
+}
+
+// SetAccount sets the "account" field.
+func (_u *StrategyUpdateOne) SetAccount(v string) *StrategyUpdateOne {
+	_u.mutation.SetAccount(v)
+	return _u
+}
+
+// SetNillableAccount sets the "account" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableAccount(v *string) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetAccount(*v)
 	}
-	if _u.mutation.TriggerStopLossPriceCleared() {
-		_spec.ClearField(strategy.FieldTriggerStopLossPrice, field.TypeString)
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *StrategyUpdateOne) SetMode(v strategy.Mode) *StrategyUpdateOne {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableMode(v *strategy.Mode) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetMode(*v)
 	}
-	if value, ok := _u.mutation.TriggerTakeProfitPrice(); ok {
-		_spec.SetField(strategy.FieldTriggerTakeProfitPrice, field.TypeString, value)
+	return _u
+}
+
+// SetMarginMode sets the "marginMode" field.
+func (_u *StrategyUpdateOne) SetMarginMode(v strategy.MarginMode) *StrategyUpdateOne {
+	_u.mutation.SetMarginMode(v)
+	return _u
+}
+
+// SetNillableMarginMode sets the "marginMode" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableMarginMode(v *strategy.MarginMode) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetMarginMode(*v)
 	}
-	if _u.mutation.TriggerTakeProfitPriceCleared() {
-		_spec.ClearField(strategy.FieldTriggerTakeProfitPrice, field.TypeString)
+	return _u
+}
+
+// SetQuantityMode sets the "quantityMode" field.
+func (_u *StrategyUpdateOne) SetQuantityMode(v strategy.QuantityMode) *StrategyUpdateOne {
+	_u.mutation.SetQuantityMode(v)
+	return _u
+}
+
+// SetNillableQuantityMode sets the "quantityMode" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableQuantityMode(v *strategy.QuantityMode) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetQuantityMode(*v)
 	}
-	if value, ok := _u.mutation.EnablePushNotification(); ok {
-		_spec.SetField(strategy.FieldEnablePushNotification, field.TypeBool, value)
+	return _u
+}
+
+// SetPriceUpper sets the "priceUpper" field.
+func (_u *StrategyUpdateOne) SetPriceUpper(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetPriceUpper(v)
+	return _u
+}
+
+// SetNillablePriceUpper sets the "priceUpper" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillablePriceUpper(v *decimal.Decimal) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetPriceUpper(*v)
+	}
+	return _u
+}
+
+// SetPriceLower sets the "priceLower" field.
+func (_u *StrategyUpdateOne) SetPriceLower(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetPriceLower(v)
+	return _u
+}
+
+// SetNillablePriceLower sets the "priceLower" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillablePriceLower(v *decimal.Decimal) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetPriceLower(*v)
+	}
+	return _u
+}
+
+// SetGridNum sets the "gridNum" field.
+func (_u *StrategyUpdateOne) SetGridNum(v int) *StrategyUpdateOne {
+	_u.mutation.ResetGridNum()
+	_u.mutation.SetGridNum(v)
+	return _u
+}
+
+// SetNillableGridNum sets the "gridNum" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableGridNum(v *int) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetGridNum(*v)
 	}
-	if value, ok := _u.mutation.EnablePushMatchedNotification(); ok {
-		_spec.SetField(strategy.FieldEnablePushMatchedNotification, field.TypeBool, value)
+	return _u
+}
+
+// AddGridNum adds value to the "gridNum" field.
+func (_u *StrategyUpdateOne) AddGridNum(v int) *StrategyUpdateOne {
+	_u.mutation.AddGridNum(v)
+	return _u
+}
+
+// SetLeverage sets the "leverage" field.
+func (_u *StrategyUpdateOne) SetLeverage(v int) *StrategyUpdateOne {
+	_u.mutation.ResetLeverage()
+	_u.mutation.SetLeverage(v)
+	return _u
+}
+
+// SetNillableLeverage sets the "leverage" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableLeverage(v *int) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetLeverage(*v)
 	}
-	if _u.mutation.EnablePushMatchedNotificationCleared() {
-		_spec.ClearField(strategy.FieldEnablePushMatchedNotification, field.TypeBool)
+	return _u
+}
+
+// AddLeverage adds value to the "leverage" field.
+func (_u *StrategyUpdateOne) AddLeverage(v int) *StrategyUpdateOne {
+	_u.mutation.AddLeverage(v)
+	return _u
+}
+
+// SetInitialOrderSize sets the "initialOrderSize" field.
+func (_u *StrategyUpdateOne) SetInitialOrderSize(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetInitialOrderSize(v)
+	return _u
+}
+
+// SetNillableInitialOrderSize sets the "initialOrderSize" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableInitialOrderSize(v *decimal.Decimal) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetInitialOrderSize(*v)
 	}
-	if value, ok := _u.mutation.LastLowerThresholdAlertTime(); ok {
-		_spec.SetField(strategy.FieldLastLowerThresholdAlertTime, field.TypeTime, value)
+	return _u
+}
+
+// SetSizingMode sets the "sizingMode" field.
+func (_u *StrategyUpdateOne) SetSizingMode(v strategy.SizingMode) *StrategyUpdateOne {
+	_u.mutation.SetSizingMode(v)
+	return _u
+}
+
+// SetNillableSizingMode sets the "sizingMode" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableSizingMode(v *strategy.SizingMode) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetSizingMode(*v)
 	}
-	if _u.mutation.LastLowerThresholdAlertTimeCleared() {
-		_spec.ClearField(strategy.FieldLastLowerThresholdAlertTime, field.TypeTime)
+	return _u
+}
+
+// SetSizingFactor sets the "sizingFactor" field.
+func (_u *StrategyUpdateOne) SetSizingFactor(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetSizingFactor(v)
+	return _u
+}
+
+// SetNillableSizingFactor sets the "sizingFactor" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableSizingFactor(v *decimal.Decimal) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetSizingFactor(*v)
 	}
-	if value, ok := _u.mutation.LastUpperThresholdAlertTime(); ok {
-		_spec.SetField(strategy.FieldLastUpperThresholdAlertTime, field.TypeTime, value)
+	return _u
+}
+
+// ClearSizingFactor clears the value of the "sizingFactor" field.
+func (_u *StrategyUpdateOne) ClearSizingFactor() *StrategyUpdateOne {
+	_u.mutation.ClearSizingFactor()
+	return _u
+}
+
+// SetSizingWeights sets the "sizingWeights" field.
+func (_u *StrategyUpdateOne) SetSizingWeights(v string) *StrategyUpdateOne {
+	_u.mutation.SetSizingWeights(v)
+	return _u
+}
+
+// SetNillableSizingWeights sets the "sizingWeights" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableSizingWeights(v *string) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetSizingWeights(*v)
 	}
-	if _u.mutation.LastUpperThresholdAlertTimeCleared() {
-		_spec.ClearField(strategy.FieldLastUpperThresholdAlertTime, field.TypeTime)
+	return _u
+}
+
+// ClearSizingWeights clears the value of the "sizingWeights" field.
+func (_u *StrategyUpdateOne) ClearSizingWeights() *StrategyUpdateOne {
+	_u.mutation.ClearSizingWeights()
+	return _u
+}
+
+// SetSlippageBps sets the "slippageBps" field.
+func (_u *StrategyUpdateOne) SetSlippageBps(v int) *StrategyUpdateOne {
+	_u.mutation.ResetSlippageBps()
+	_u.mutation.SetSlippageBps(v)
+	return _u
+}
+
+// SetNillableSlippageBps sets the "slippageBps" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableSlippageBps(v *int) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetSlippageBps(*v)
 	}
-	if value, ok := _u.mutation.Status(); ok {
-		_spec.SetField(strategy.FieldStatus, field.TypeEnum, value)
+	return _u
+}
+
+// AddSlippageBps adds value to the "slippageBps" field.
+func (_u *StrategyUpdateOne) AddSlippageBps(v int) *StrategyUpdateOne {
+	_u.mutation.AddSlippageBps(v)
+	return _u
+}
+
+// ClearSlippageBps clears the value of the "slippageBps" field.
+func (_u *StrategyUpdateOne) ClearSlippageBps() *StrategyUpdateOne {
+	_u.mutation.ClearSlippageBps()
+	return _u
+}
+
+// SetEntryPrice sets the "entryPrice" field.
+func (_u *StrategyUpdateOne) SetEntryPrice(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetEntryPrice(v)
+	return _u
+}
+
+// SetNillableEntryPrice sets the "entryPrice" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableEntryPrice(v *decimal.Decimal) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetEntryPrice(*v)
 	}
-	if value, ok := _u.mutation.ExchangeApiKey(); ok {
-		_spec.SetField(strategy.FieldExchangeApiKey, field.TypeString, value)
+	return _u
+}
+
+// ClearEntryPrice clears the value of the "entryPrice" field.
+func (_u *StrategyUpdateOne) ClearEntryPrice() *StrategyUpdateOne {
+	_u.mutation.ClearEntryPrice()
+	return _u
+}
+
+// SetTriggerStopLossPrice sets the "triggerStopLossPrice" field.
+func (_u *StrategyUpdateOne) SetTriggerStopLossPrice(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetTriggerStopLossPrice(v)
+	return _u
+}
+
+// SetNillableTriggerStopLossPrice sets the "triggerStopLossPrice" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableTriggerStopLossPrice(v *decimal.Decimal) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetTriggerStopLossPrice(*v)
 	}
-	if value, ok := _u.mutation.ExchangeSecretKey(); ok {
-		_spec.SetField(strategy.FieldExchangeSecretKey, field.TypeString, value)
+	return _u
+}
+
+// ClearTriggerStopLossPrice clears the value of the "triggerStopLossPrice" field.
+func (_u *StrategyUpdateOne) ClearTriggerStopLossPrice() *StrategyUpdateOne {
+	_u.mutation.ClearTriggerStopLossPrice()
+	return _u
+}
+
+// SetTriggerTakeProfitPrice sets the "triggerTakeProfitPrice" field.
+func (_u *StrategyUpdateOne) SetTriggerTakeProfitPrice(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetTriggerTakeProfitPrice(v)
+	return _u
+}
+
+// SetNillableTriggerTakeProfitPrice sets the "triggerTakeProfitPrice" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableTriggerTakeProfitPrice(v *decimal.Decimal) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetTriggerTakeProfitPrice(*v)
 	}
-	if value, ok := _u.mutation.ExchangePassphrase(); ok {
-		_spec.SetField(strategy.FieldExchangePassphrase, field.TypeString, value)
+	return _u
+}
+
+// ClearTriggerTakeProfitPrice clears the value of the "triggerTakeProfitPrice" field.
+func (_u *StrategyUpdateOne) ClearTriggerTakeProfitPrice() *StrategyUpdateOne {
+	_u.mutation.ClearTriggerTakeProfitPrice()
+	return _u
+}
+
+// SetMaxLossAmount sets the "maxLossAmount" field.
+func (_u *StrategyUpdateOne) SetMaxLossAmount(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetMaxLossAmount(v)
+	return _u
+}
+
+// SetNillableMaxLossAmount sets the "maxLossAmount" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableMaxLossAmount(v *decimal.Decimal) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetMaxLossAmount(*v)
 	}
-	if value, ok := _u.mutation.ExchangeTestnet(); ok {
-		_spec.SetField(strategy.FieldExchangeTestnet, field.TypeBool, value)
+	return _u
+}
+
+// ClearMaxLossAmount clears the value of the "maxLossAmount" field.
+func (_u *StrategyUpdateOne) ClearMaxLossAmount() *StrategyUpdateOne {
+	_u.mutation.ClearMaxLossAmount()
+	return _u
+}
+
+// SetMaxLossPercent sets the "maxLossPercent" field.
+func (_u *StrategyUpdateOne) SetMaxLossPercent(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetMaxLossPercent(v)
+	return _u
+}
+
+// SetNillableMaxLossPercent sets the "maxLossPercent" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableMaxLossPercent(v *decimal.Decimal) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetMaxLossPercent(*v)
 	}
-	if value, ok := _u.mutation.StartTime(); ok {
-		_spec.SetField(strategy.FieldStartTime, field.TypeTime, value)
+	return _u
+}
+
+// ClearMaxLossPercent clears the value of the "maxLossPercent" field.
+func (_u *StrategyUpdateOne) ClearMaxLossPercent() *StrategyUpdateOne {
+	_u.mutation.ClearMaxLossPercent()
+	return _u
+}
+
+// SetProfitTargetAmount sets the "profitTargetAmount" field.
+func (_u *StrategyUpdateOne) SetProfitTargetAmount(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetProfitTargetAmount(v)
+	return _u
+}
+
+// SetNillableProfitTargetAmount sets the "profitTargetAmount" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableProfitTargetAmount(v *decimal.Decimal) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetProfitTargetAmount(*v)
 	}
-	if _u.mutation.StartTimeCleared() {
-		_spec.ClearField(strategy.FieldStartTime, field.TypeTime)
+	return _u
+}
+
+// ClearProfitTargetAmount clears the value of the "profitTargetAmount" field.
+func (_u *StrategyUpdateOne) ClearProfitTargetAmount() *StrategyUpdateOne {
+	_u.mutation.ClearProfitTargetAmount()
+	return _u
+}
+
+// SetTrailingStopLossAmount sets the "trailingStopLossAmount" field.
+func (_u *StrategyUpdateOne) SetTrailingStopLossAmount(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetTrailingStopLossAmount(v)
+	return _u
+}
+
+// SetNillableTrailingStopLossAmount sets the "trailingStopLossAmount" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableTrailingStopLossAmount(v *decimal.Decimal) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetTrailingStopLossAmount(*v)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{strategy.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+	return _u
+}
+
+// ClearTrailingStopLossAmount clears the value of the "trailingStopLossAmount" field.
+func (_u *StrategyUpdateOne) ClearTrailingStopLossAmount() *StrategyUpdateOne {
+	_u.mutation.ClearTrailingStopLossAmount()
+	return _u
+}
+
+// SetTrailingStopLossPercent sets the "trailingStopLossPercent" field.
+func (_u *StrategyUpdateOne) SetTrailingStopLossPercent(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetTrailingStopLossPercent(v)
+	return _u
+}
+
+// SetNillableTrailingStopLossPercent sets the "trailingStopLossPercent" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableTrailingStopLossPercent(v *decimal.Decimal) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetTrailingStopLossPercent(*v)
 	}
-	_u.mutation.done = true
-	return _node, nil
+	return _u
 }
 
-// StrategyUpdateOne is the builder for updating a single Strategy entity.
-type StrategyUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *StrategyMutation
+// ClearTrailingStopLossPercent clears the value of the "trailingStopLossPercent" field.
+func (_u *StrategyUpdateOne) ClearTrailingStopLossPercent() *StrategyUpdateOne {
+	_u.mutation.ClearTrailingStopLossPercent()
+	return _u
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (_u *StrategyUpdateOne) SetUpdateTime(v time.Time) *StrategyUpdateOne {
-	_u.mutation.SetUpdateTime(v)
+// SetTrailingTakeProfitActivationPrice sets the "trailingTakeProfitActivationPrice" field.
+func (_u *StrategyUpdateOne) SetTrailingTakeProfitActivationPrice(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetTrailingTakeProfitActivationPrice(v)
 	return _u
 }
 
-// SetGUID sets the "guid" field.
-func (_u *StrategyUpdateOne) SetGUID(v string) *StrategyUpdateOne {
-	_u.mutation.SetGUID(v)
+// SetNillableTrailingTakeProfitActivationPrice sets the "trailingTakeProfitActivationPrice" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableTrailingTakeProfitActivationPrice(v *decimal.Decimal) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetTrailingTakeProfitActivationPrice(*v)
+	}
 	return _u
 }
 
-// SetNillableGUID sets the "guid" field if the given value is not nil.
-func (_u *StrategyUpdateOne) SetNillableGUID(v *string) *StrategyUpdateOne {
+// ClearTrailingTakeProfitActivationPrice clears the value of the "trailingTakeProfitActivationPrice" field.
+func (_u *StrategyUpdateOne) ClearTrailingTakeProfitActivationPrice() *StrategyUpdateOne {
+	_u.mutation.ClearTrailingTakeProfitActivationPrice()
+	return _u
+}
+
+// SetTrailingTakeProfitAmount sets the "trailingTakeProfitAmount" field.
+func (_u *StrategyUpdateOne) SetTrailingTakeProfitAmount(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetTrailingTakeProfitAmount(v)
+	return _u
+}
+
+// SetNillableTrailingTakeProfitAmount sets the "trailingTakeProfitAmount" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableTrailingTakeProfitAmount(v *decimal.Decimal) *StrategyUpdateOne {
 	if v != nil {
-		_u.SetGUID(*v)
+		_u.SetTrailingTakeProfitAmount(*v)
 	}
 	return _u
 }
 
-// SetOwner sets the "owner" field.
-func (_u *StrategyUpdateOne) SetOwner(v int64) *StrategyUpdateOne {
-	_u.mutation.ResetOwner()
-	_u.mutation.SetOwner(v)
+// ClearTrailingTakeProfitAmount clears the value of the "trailingTakeProfitAmount" field.
+func (_u *StrategyUpdateOne) ClearTrailingTakeProfitAmount() *StrategyUpdateOne {
+	_u.mutation.ClearTrailingTakeProfitAmount()
 	return _u
 }
 
-// SetNillableOwner sets the "owner" field if the given value is not nil.
-func (_u *StrategyUpdateOne) SetNillableOwner(v *int64) *StrategyUpdateOne {
+// SetTrailingTakeProfitPercent sets the "trailingTakeProfitPercent" field.
+func (_u *StrategyUpdateOne) SetTrailingTakeProfitPercent(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetTrailingTakeProfitPercent(v)
+	return _u
+}
+
+// SetNillableTrailingTakeProfitPercent sets the "trailingTakeProfitPercent" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableTrailingTakeProfitPercent(v *decimal.Decimal) *StrategyUpdateOne {
 	if v != nil {
-		_u.SetOwner(*v)
+		_u.SetTrailingTakeProfitPercent(*v)
 	}
 	return _u
 }
 
-// AddOwner adds value to the "owner" field.
-func (_u *StrategyUpdateOne) AddOwner(v int64) *StrategyUpdateOne {
-	_u.mutation.AddOwner(v)
+// ClearTrailingTakeProfitPercent clears the value of the "trailingTakeProfitPercent" field.
+func (_u *StrategyUpdateOne) ClearTrailingTakeProfitPercent() *StrategyUpdateOne {
+	_u.mutation.ClearTrailingTakeProfitPercent()
 	return _u
 }
 
-// SetExchange sets the "exchange" field.
-func (_u *StrategyUpdateOne) SetExchange(v string) *StrategyUpdateOne {
-	_u.mutation.SetExchange(v)
+// SetTrailingExtremePrice sets the "trailingExtremePrice" field.
+func (_u *StrategyUpdateOne) SetTrailingExtremePrice(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetTrailingExtremePrice(v)
 	return _u
 }
 
-// SetNillableExchange sets the "exchange" field if the given value is not nil.
-func (_u *StrategyUpdateOne) SetNillableExchange(v *string) *StrategyUpdateOne {
+// SetNillableTrailingExtremePrice sets the "trailingExtremePrice" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableTrailingExtremePrice(v *decimal.Decimal) *StrategyUpdateOne {
 	if v != nil {
-		_u.SetExchange(*v)
+		_u.SetTrailingExtremePrice(*v)
 	}
 	return _u
 }
 
-// SetSymbol sets the "symbol" field.
-func (_u *StrategyUpdateOne) SetSymbol(v string) *StrategyUpdateOne {
-	_u.mutation.SetSymbol(v)
+// ClearTrailingExtremePrice clears the value of the "trailingExtremePrice" field.
+func (_u *StrategyUpdateOne) ClearTrailingExtremePrice() *StrategyUpdateOne {
+	_u.mutation.ClearTrailingExtremePrice()
 	return _u
 }
 
-// SetNillableSymbol sets the "symbol" field if the given value is not nil.
-func (_u *StrategyUpdateOne) SetNillableSymbol(v *string) *StrategyUpdateOne {
+// SetTrailingLevels sets the "trailingLevels" field.
+func (_u *StrategyUpdateOne) SetTrailingLevels(v int) *StrategyUpdateOne {
+	_u.mutation.ResetTrailingLevels()
+	_u.mutation.SetTrailingLevels(v)
+	return _u
+}
+
+// SetNillableTrailingLevels sets the "trailingLevels" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableTrailingLevels(v *int) *StrategyUpdateOne {
 	if v != nil {
-		_u.SetSymbol(*v)
+		_u.SetTrailingLevels(*v)
 	}
 	return _u
 }
 
-// SetAccount sets the "account" field.
-func (_u *StrategyUpdateOne) SetAccount(v string) *StrategyUpdateOne {
-	_u.mutation.SetAccount(v)
+// AddTrailingLevels adds value to the "trailingLevels" field.
+func (_u *StrategyUpdateOne) AddTrailingLevels(v int) *StrategyUpdateOne {
+	_u.mutation.AddTrailingLevels(v)
 	return _u
 }
 
-// SetNillableAccount sets the "account" field if the given value is not nil.
-func (_u *StrategyUpdateOne) SetNillableAccount(v *string) *StrategyUpdateOne {
-	if v != nil {
-		_u.SetAccount(*v)
-	}
+// ClearTrailingLevels clears the value of the "trailingLevels" field.
+func (_u *StrategyUpdateOne) ClearTrailingLevels() *StrategyUpdateOne {
+	_u.mutation.ClearTrailingLevels()
 	return _u
 }
 
-// SetMode sets the "mode" field.
-func (_u *StrategyUpdateOne) SetMode(v strategy.Mode) *StrategyUpdateOne {
-	_u.mutation.SetMode(v)
+// SetTrailingPriceUpperLimit sets the "trailingPriceUpperLimit" field.
+func (_u *StrategyUpdateOne) SetTrailingPriceUpperLimit(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetTrailingPriceUpperLimit(v)
 	return _u
 }
 
-// SetNillableMode sets the "mode" field if the given value is not nil.
-func (_u *StrategyUpdateOne) SetNillableMode(v *strategy.Mode) *StrategyUpdateOne {
+// SetNillableTrailingPriceUpperLimit sets the "trailingPriceUpperLimit" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableTrailingPriceUpperLimit(v *decimal.Decimal) *StrategyUpdateOne {
 	if v != nil {
-		_u.SetMode(*v)
+		_u.SetTrailingPriceUpperLimit(*v)
 	}
 	return _u
 }
 
-// SetMarginMode sets the "marginMode" field.
-func (_u *StrategyUpdateOne) SetMarginMode(v strategy.MarginMode) *StrategyUpdateOne {
-	_u.mutation.SetMarginMode(v)
+// ClearTrailingPriceUpperLimit clears the value of the "trailingPriceUpperLimit" field.
+func (_u *StrategyUpdateOne) ClearTrailingPriceUpperLimit() *StrategyUpdateOne {
+	_u.mutation.ClearTrailingPriceUpperLimit()
 	return _u
 }
 
-// SetNillableMarginMode sets the "marginMode" field if the given value is not nil.
-func (_u *StrategyUpdateOne) SetNillableMarginMode(v *strategy.MarginMode) *StrategyUpdateOne {
+// SetTrailingPriceLowerLimit sets the "trailingPriceLowerLimit" field.
+func (_u *StrategyUpdateOne) SetTrailingPriceLowerLimit(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetTrailingPriceLowerLimit(v)
+	return _u
+}
+
+// SetNillableTrailingPriceLowerLimit sets the "trailingPriceLowerLimit" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableTrailingPriceLowerLimit(v *decimal.Decimal) *StrategyUpdateOne {
 	if v != nil {
-		_u.SetMarginMode(*v)
+		_u.SetTrailingPriceLowerLimit(*v)
 	}
 	return _u
 }
 
-// SetQuantityMode sets the "quantityMode" field.
-func (_u *StrategyUpdateOne) SetQuantityMode(v strategy.QuantityMode) *StrategyUpdateOne {
-	_u.mutation.SetQuantityMode(v)
+// ClearTrailingPriceLowerLimit clears the value of the "trailingPriceLowerLimit" field.
+func (_u *StrategyUpdateOne) ClearTrailingPriceLowerLimit() *StrategyUpdateOne {
+	_u.mutation.ClearTrailingPriceLowerLimit()
 	return _u
 }
 
-// SetNillableQuantityMode sets the "quantityMode" field if the given value is not nil.
-func (_u *StrategyUpdateOne) SetNillableQuantityMode(v *strategy.QuantityMode) *StrategyUpdateOne {
+// SetAtrPeriod sets the "atrPeriod" field.
+func (_u *StrategyUpdateOne) SetAtrPeriod(v int) *StrategyUpdateOne {
+	_u.mutation.ResetAtrPeriod()
+	_u.mutation.SetAtrPeriod(v)
+	return _u
+}
+
+// SetNillableAtrPeriod sets the "atrPeriod" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableAtrPeriod(v *int) *StrategyUpdateOne {
 	if v != nil {
-		_u.SetQuantityMode(*v)
+		_u.SetAtrPeriod(*v)
 	}
 	return _u
 }
 
-// SetPriceUpper sets the "priceUpper" field.
-func (_u *StrategyUpdateOne) SetPriceUpper(v decimal.Decimal) *StrategyUpdateOne {
-	_u.mutation.SetPriceUpper(v)
+// AddAtrPeriod adds value to the "atrPeriod" field.
+func (_u *StrategyUpdateOne) AddAtrPeriod(v int) *StrategyUpdateOne {
+	_u.mutation.AddAtrPeriod(v)
 	return _u
 }
 
-// SetNillablePriceUpper sets the "priceUpper" field if the given value is not nil.
-func (_u *StrategyUpdateOne) SetNillablePriceUpper(v *decimal.Decimal) *StrategyUpdateOne {
-	if v != nil {
-		_u.SetPriceUpper(*v)
-	}
+// ClearAtrPeriod clears the value of the "atrPeriod" field.
+func (_u *StrategyUpdateOne) ClearAtrPeriod() *StrategyUpdateOne {
+	_u.mutation.ClearAtrPeriod()
 	return _u
 }
 
-// SetPriceLower sets the "priceLower" field.
-func (_u *StrategyUpdateOne) SetPriceLower(v decimal.Decimal) *StrategyUpdateOne {
-	_u.mutation.SetPriceLower(v)
+// SetAtrMultiplier sets the "atrMultiplier" field.
+func (_u *StrategyUpdateOne) SetAtrMultiplier(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetAtrMultiplier(v)
 	return _u
 }
 
-// SetNillablePriceLower sets the "priceLower" field if the given value is not nil.
-func (_u *StrategyUpdateOne) SetNillablePriceLower(v *decimal.Decimal) *StrategyUpdateOne {
+// SetNillableAtrMultiplier sets the "atrMultiplier" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableAtrMultiplier(v *decimal.Decimal) *StrategyUpdateOne {
 	if v != nil {
-		_u.SetPriceLower(*v)
+		_u.SetAtrMultiplier(*v)
 	}
 	return _u
 }
 
-// SetGridNum sets the "gridNum" field.
-func (_u *StrategyUpdateOne) SetGridNum(v int) *StrategyUpdateOne {
-	_u.mutation.ResetGridNum()
-	_u.mutation.SetGridNum(v)
+// ClearAtrMultiplier clears the value of the "atrMultiplier" field.
+func (_u *StrategyUpdateOne) ClearAtrMultiplier() *StrategyUpdateOne {
+	_u.mutation.ClearAtrMultiplier()
 	return _u
 }
 
-// SetNillableGridNum sets the "gridNum" field if the given value is not nil.
-func (_u *StrategyUpdateOne) SetNillableGridNum(v *int) *StrategyUpdateOne {
+// SetAdaptiveThreshold sets the "adaptiveThreshold" field.
+func (_u *StrategyUpdateOne) SetAdaptiveThreshold(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetAdaptiveThreshold(v)
+	return _u
+}
+
+// SetNillableAdaptiveThreshold sets the "adaptiveThreshold" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableAdaptiveThreshold(v *decimal.Decimal) *StrategyUpdateOne {
 	if v != nil {
-		_u.SetGridNum(*v)
+		_u.SetAdaptiveThreshold(*v)
 	}
 	return _u
 }
 
-// AddGridNum adds value to the "gridNum" field.
-func (_u *StrategyUpdateOne) AddGridNum(v int) *StrategyUpdateOne {
-	_u.mutation.AddGridNum(v)
+// ClearAdaptiveThreshold clears the value of the "adaptiveThreshold" field.
+func (_u *StrategyUpdateOne) ClearAdaptiveThreshold() *StrategyUpdateOne {
+	_u.mutation.ClearAdaptiveThreshold()
 	return _u
 }
 
-// SetLeverage sets the "leverage" field.
-func (_u *StrategyUpdateOne) SetLeverage(v int) *StrategyUpdateOne {
-	_u.mutation.ResetLeverage()
-	_u.mutation.SetLeverage(v)
+// SetAdaptiveIntervalHours sets the "adaptiveIntervalHours" field.
+func (_u *StrategyUpdateOne) SetAdaptiveIntervalHours(v int) *StrategyUpdateOne {
+	_u.mutation.ResetAdaptiveIntervalHours()
+	_u.mutation.SetAdaptiveIntervalHours(v)
 	return _u
 }
 
-// SetNillableLeverage sets the "leverage" field if the given value is not nil.
-func (_u *StrategyUpdateOne) SetNillableLeverage(v *int) *StrategyUpdateOne {
+// SetNillableAdaptiveIntervalHours sets the "adaptiveIntervalHours" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableAdaptiveIntervalHours(v *int) *StrategyUpdateOne {
 	if v != nil {
-		_u.SetLeverage(*v)
+		_u.SetAdaptiveIntervalHours(*v)
 	}
 	return _u
 }
 
-// AddLeverage adds value to the "leverage" field.
-func (_u *StrategyUpdateOne) AddLeverage(v int) *StrategyUpdateOne {
-	_u.mutation.AddLeverage(v)
+// AddAdaptiveIntervalHours adds value to the "adaptiveIntervalHours" field.
+func (_u *StrategyUpdateOne) AddAdaptiveIntervalHours(v int) *StrategyUpdateOne {
+	_u.mutation.AddAdaptiveIntervalHours(v)
 	return _u
 }
 
-// SetInitialOrderSize sets the "initialOrderSize" field.
-func (_u *StrategyUpdateOne) SetInitialOrderSize(v decimal.Decimal) *StrategyUpdateOne {
-	_u.mutation.SetInitialOrderSize(v)
+// ClearAdaptiveIntervalHours clears the value of the "adaptiveIntervalHours" field.
+func (_u *StrategyUpdateOne) ClearAdaptiveIntervalHours() *StrategyUpdateOne {
+	_u.mutation.ClearAdaptiveIntervalHours()
 	return _u
 }
 
-// SetNillableInitialOrderSize sets the "initialOrderSize" field if the given value is not nil.
-func (_u *StrategyUpdateOne) SetNillableInitialOrderSize(v *decimal.Decimal) *StrategyUpdateOne {
+// SetAdaptiveAtr sets the "adaptiveAtr" field.
+func (_u *StrategyUpdateOne) SetAdaptiveAtr(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetAdaptiveAtr(v)
+	return _u
+}
+
+// SetNillableAdaptiveAtr sets the "adaptiveAtr" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableAdaptiveAtr(v *decimal.Decimal) *StrategyUpdateOne {
 	if v != nil {
-		_u.SetInitialOrderSize(*v)
+		_u.SetAdaptiveAtr(*v)
 	}
 	return _u
 }
 
-// SetSlippageBps sets the "slippageBps" field.
-func (_u *StrategyUpdateOne) SetSlippageBps(v int) *StrategyUpdateOne {
-	_u.mutation.ResetSlippageBps()
-	_u.mutation.SetSlippageBps(v)
+// ClearAdaptiveAtr clears the value of the "adaptiveAtr" field.
+func (_u *StrategyUpdateOne) ClearAdaptiveAtr() *StrategyUpdateOne {
+	_u.mutation.ClearAdaptiveAtr()
 	return _u
 }
 
-// SetNillableSlippageBps sets the "slippageBps" field if the given value is not nil.
-func (_u *StrategyUpdateOne) SetNillableSlippageBps(v *int) *StrategyUpdateOne {
+// SetAdaptiveUpdatedAt sets the "adaptiveUpdatedAt" field.
+func (_u *StrategyUpdateOne) SetAdaptiveUpdatedAt(v time.Time) *StrategyUpdateOne {
+	_u.mutation.SetAdaptiveUpdatedAt(v)
+	return _u
+}
+
+// SetNillableAdaptiveUpdatedAt sets the "adaptiveUpdatedAt" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableAdaptiveUpdatedAt(v *time.Time) *StrategyUpdateOne {
 	if v != nil {
-		_u.SetSlippageBps(*v)
+		_u.SetAdaptiveUpdatedAt(*v)
 	}
 	return _u
 }
 
-// AddSlippageBps adds value to the "slippageBps" field.
-func (_u *StrategyUpdateOne) AddSlippageBps(v int) *StrategyUpdateOne {
-	_u.mutation.AddSlippageBps(v)
+// ClearAdaptiveUpdatedAt clears the value of the "adaptiveUpdatedAt" field.
+func (_u *StrategyUpdateOne) ClearAdaptiveUpdatedAt() *StrategyUpdateOne {
+	_u.mutation.ClearAdaptiveUpdatedAt()
 	return _u
 }
 
-// ClearSlippageBps clears the value of the "slippageBps" field.
-func (_u *StrategyUpdateOne) ClearSlippageBps() *StrategyUpdateOne {
-	_u.mutation.ClearSlippageBps()
+// SetCancelRepairPolicy sets the "cancelRepairPolicy" field.
+func (_u *StrategyUpdateOne) SetCancelRepairPolicy(v strategy.CancelRepairPolicy) *StrategyUpdateOne {
+	_u.mutation.SetCancelRepairPolicy(v)
 	return _u
 }
 
-// SetEntryPrice sets the "entryPrice" field.
-func (_u *StrategyUpdateOne) SetEntryPrice(v decimal.Decimal) *StrategyUpdateOne {
-	_u.mutation.SetEntryPrice(v)
+// SetNillableCancelRepairPolicy sets the "cancelRepairPolicy" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableCancelRepairPolicy(v *strategy.CancelRepairPolicy) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetCancelRepairPolicy(*v)
+	}
 	return _u
 }
 
-// SetNillableEntryPrice sets the "entryPrice" field if the given value is not nil.
-func (_u *StrategyUpdateOne) SetNillableEntryPrice(v *decimal.Decimal) *StrategyUpdateOne {
+// SetCancelRepairMaxAttempts sets the "cancelRepairMaxAttempts" field.
+func (_u *StrategyUpdateOne) SetCancelRepairMaxAttempts(v int) *StrategyUpdateOne {
+	_u.mutation.ResetCancelRepairMaxAttempts()
+	_u.mutation.SetCancelRepairMaxAttempts(v)
+	return _u
+}
+
+// SetNillableCancelRepairMaxAttempts sets the "cancelRepairMaxAttempts" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableCancelRepairMaxAttempts(v *int) *StrategyUpdateOne {
 	if v != nil {
-		_u.SetEntryPrice(*v)
+		_u.SetCancelRepairMaxAttempts(*v)
 	}
 	return _u
 }
 
-// ClearEntryPrice clears the value of the "entryPrice" field.
-func (_u *StrategyUpdateOne) ClearEntryPrice() *StrategyUpdateOne {
-	_u.mutation.ClearEntryPrice()
+// AddCancelRepairMaxAttempts adds value to the "cancelRepairMaxAttempts" field.
+func (_u *StrategyUpdateOne) AddCancelRepairMaxAttempts(v int) *StrategyUpdateOne {
+	_u.mutation.AddCancelRepairMaxAttempts(v)
 	return _u
 }
 
-// SetTriggerStopLossPrice sets the "triggerStopLossPrice" field.
-func (_u *StrategyUpdateOne) SetTriggerStopLossPrice(v decimal.Decimal) *StrategyUpdateOne {
-	_u.mutation.SetTriggerStopLossPrice(v)
+// ClearCancelRepairMaxAttempts clears the value of the "cancelRepairMaxAttempts" field.
+func (_u *StrategyUpdateOne) ClearCancelRepairMaxAttempts() *StrategyUpdateOne {
+	_u.mutation.ClearCancelRepairMaxAttempts()
 	return _u
 }
 
-// SetNillableTriggerStopLossPrice sets the "triggerStopLossPrice" field if the given value is not nil.
-func (_u *StrategyUpdateOne) SetNillableTriggerStopLossPrice(v *decimal.Decimal) *StrategyUpdateOne {
-	if v != nil {
-		_u.SetTriggerStopLossPrice(*v)
-	}
+// SetReconcilePolicy sets the "reconcilePolicy" field.
+func (_u *StrategyUpdateOne) SetReconcilePolicy(v strategy.ReconcilePolicy) *StrategyUpdateOne {
+	_u.mutation.SetReconcilePolicy(v)
 	return _u
 }
 
-// ClearTriggerStopLossPrice clears the value of the "triggerStopLossPrice" field.
-func (_u *StrategyUpdateOne) ClearTriggerStopLossPrice() *StrategyUpdateOne {
-	_u.mutation.ClearTriggerStopLossPrice()
+// SetNillableReconcilePolicy sets the "reconcilePolicy" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableReconcilePolicy(v *strategy.ReconcilePolicy) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetReconcilePolicy(*v)
+	}
 	return _u
 }
 
-// SetTriggerTakeProfitPrice sets the "triggerTakeProfitPrice" field.
-func (_u *StrategyUpdateOne) SetTriggerTakeProfitPrice(v decimal.Decimal) *StrategyUpdateOne {
-	_u.mutation.SetTriggerTakeProfitPrice(v)
+// SetPartialFillThreshold sets the "partialFillThreshold" field.
+func (_u *StrategyUpdateOne) SetPartialFillThreshold(v decimal.Decimal) *StrategyUpdateOne {
+	_u.mutation.SetPartialFillThreshold(v)
 	return _u
 }
 
-// SetNillableTriggerTakeProfitPrice sets the "triggerTakeProfitPrice" field if the given value is not nil.
-func (_u *StrategyUpdateOne) SetNillableTriggerTakeProfitPrice(v *decimal.Decimal) *StrategyUpdateOne {
+// SetNillablePartialFillThreshold sets the "partialFillThreshold" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillablePartialFillThreshold(v *decimal.Decimal) *StrategyUpdateOne {
 	if v != nil {
-		_u.SetTriggerTakeProfitPrice(*v)
+		_u.SetPartialFillThreshold(*v)
 	}
 	return _u
 }
 
-// ClearTriggerTakeProfitPrice clears the value of the "triggerTakeProfitPrice" field.
-func (_u *StrategyUpdateOne) ClearTriggerTakeProfitPrice() *StrategyUpdateOne {
-	_u.mutation.ClearTriggerTakeProfitPrice()
+// ClearPartialFillThreshold clears the value of the "partialFillThreshold" field.
+func (_u *StrategyUpdateOne) ClearPartialFillThreshold() *StrategyUpdateOne {
+	_u.mutation.ClearPartialFillThreshold()
 	return _u
 }
 
@@ -1177,6 +2477,26 @@ func (_u *StrategyUpdateOne) SetNillableExchangeTestnet(v *bool) *StrategyUpdate
 	return _u
 }
 
+// SetAccountId sets the "accountId" field.
+func (_u *StrategyUpdateOne) SetAccountId(v int) *StrategyUpdateOne {
+	_u.mutation.SetAccountId(v)
+	return _u
+}
+
+// SetNillableAccountId sets the "accountId" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableAccountId(v *int) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetAccountId(*v)
+	}
+	return _u
+}
+
+// ClearAccountId clears the value of the "accountId" field.
+func (_u *StrategyUpdateOne) ClearAccountId() *StrategyUpdateOne {
+	_u.mutation.ClearAccountId()
+	return _u
+}
+
 // SetStartTime sets the "startTime" field.
 func (_u *StrategyUpdateOne) SetStartTime(v time.Time) *StrategyUpdateOne {
 	_u.mutation.SetStartTime(v)
@@ -1197,11 +2517,36 @@ func (_u *StrategyUpdateOne) ClearStartTime() *StrategyUpdateOne {
 	return _u
 }
 
+// SetExchangeAccountID sets the "exchangeAccount" edge to the ExchangeAccount entity by ID.
+func (_u *StrategyUpdateOne) SetExchangeAccountID(id int) *StrategyUpdateOne {
+	_u.mutation.SetExchangeAccountID(id)
+	return _u
+}
+
+// SetNillableExchangeAccountID sets the "exchangeAccount" edge to the ExchangeAccount entity by ID if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableExchangeAccountID(id *int) *StrategyUpdateOne {
+	if id != nil {
+		_u = _u.SetExchangeAccountID(*id)
+	}
+	return _u
+}
+
+// SetExchangeAccount sets the "exchangeAccount" edge to the ExchangeAccount entity.
+func (_u *StrategyUpdateOne) SetExchangeAccount(v *ExchangeAccount) *StrategyUpdateOne {
+	return _u.SetExchangeAccountID(v.ID)
+}
+
 // Mutation returns the StrategyMutation object of the builder.
 func (_u *StrategyUpdateOne) Mutation() *StrategyMutation {
 	return _u.mutation
 }
 
+// ClearExchangeAccount clears the "exchangeAccount" edge to the ExchangeAccount entity.
+func (_u *StrategyUpdateOne) ClearExchangeAccount() *StrategyUpdateOne {
+	_u.mutation.ClearExchangeAccount()
+	return _u
+}
+
 // Where appends a list predicates to the StrategyUpdate builder.
 func (_u *StrategyUpdateOne) Where(ps ...predicate.Strategy) *StrategyUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1293,11 +2638,51 @@ func (_u *StrategyUpdateOne) check() error {
 			return &ValidationError{Name: "leverage", err: fmt.Errorf(`ent: validator failed for field "Strategy.leverage": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.SizingMode(); ok {
+		if err := strategy.SizingModeValidator(v); err != nil {
+			return &ValidationError{Name: "sizingMode", err: fmt.Errorf(`ent: validator failed for field "Strategy.sizingMode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SizingWeights(); ok {
+		if err := strategy.SizingWeightsValidator(v); err != nil {
+			return &ValidationError{Name: "sizingWeights", err: fmt.Errorf(`ent: validator failed for field "Strategy.sizingWeights": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.SlippageBps(); ok {
 		if err := strategy.SlippageBpsValidator(v); err != nil {
 			return &ValidationError{Name: "slippageBps", err: fmt.Errorf(`ent: validator failed for field "Strategy.slippageBps": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.TrailingLevels(); ok {
+		if err := strategy.TrailingLevelsValidator(v); err != nil {
+			return &ValidationError{Name: "trailingLevels", err: fmt.Errorf(`ent: validator failed for field "Strategy.trailingLevels": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AtrPeriod(); ok {
+		if err := strategy.AtrPeriodValidator(v); err != nil {
+			return &ValidationError{Name: "atrPeriod", err: fmt.Errorf(`ent: validator failed for field "Strategy.atrPeriod": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AdaptiveIntervalHours(); ok {
+		if err := strategy.AdaptiveIntervalHoursValidator(v); err != nil {
+			return &ValidationError{Name: "adaptiveIntervalHours", err: fmt.Errorf(`ent: validator failed for field "Strategy.adaptiveIntervalHours": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CancelRepairPolicy(); ok {
+		if err := strategy.CancelRepairPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "cancelRepairPolicy", err: fmt.Errorf(`ent: validator failed for field "Strategy.cancelRepairPolicy": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CancelRepairMaxAttempts(); ok {
+		if err := strategy.CancelRepairMaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "cancelRepairMaxAttempts", err: fmt.Errorf(`ent: validator failed for field "Strategy.cancelRepairMaxAttempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReconcilePolicy(); ok {
+		if err := strategy.ReconcilePolicyValidator(v); err != nil {
+			return &ValidationError{Name: "reconcilePolicy", err: fmt.Errorf(`ent: validator failed for field "Strategy.reconcilePolicy": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Status(); ok {
 		if err := strategy.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Strategy.status": %w`, err)}
@@ -1386,6 +2771,21 @@ func (_u *StrategyUpdateOne) sqlSave(ctx context.Context) (_node *Strategy, err
 	if value, ok := _u.mutation.InitialOrderSize(); ok {
 		_spec.SetField(strategy.FieldInitialOrderSize, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.SizingMode(); ok {
+		_spec.SetField(strategy.FieldSizingMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.SizingFactor(); ok {
+		_spec.SetField(strategy.FieldSizingFactor, field.TypeString, value)
+	}
+	if _u.mutation.SizingFactorCleared() {
+		_spec.ClearField(strategy.FieldSizingFactor, field.TypeString)
+	}
+	if value, ok := _u.mutation.SizingWeights(); ok {
+		_spec.SetField(strategy.FieldSizingWeights, field.TypeString, value)
+	}
+	if _u.mutation.SizingWeightsCleared() {
+		_spec.ClearField(strategy.FieldSizingWeights, field.TypeString)
+	}
 	if value, ok := _u.mutation.SlippageBps(); ok {
 		_spec.SetField(strategy.FieldSlippageBps, field.TypeInt, value)
 	}
@@ -1413,6 +2813,144 @@ func (_u *StrategyUpdateOne) sqlSave(ctx context.Context) (_node *Strategy, err
 	if _u.mutation.TriggerTakeProfitPriceCleared() {
 		_spec.ClearField(strategy.FieldTriggerTakeProfitPrice, field.TypeString)
 	}
+	if value, ok := _u.mutation.MaxLossAmount(); ok {
+		_spec.SetField(strategy.FieldMaxLossAmount, field.TypeString, value)
+	}
+	if _u.mutation.MaxLossAmountCleared() {
+		_spec.ClearField(strategy.FieldMaxLossAmount, field.TypeString)
+	}
+	if value, ok := _u.mutation.MaxLossPercent(); ok {
+		_spec.SetField(strategy.FieldMaxLossPercent, field.TypeString, value)
+	}
+	if _u.mutation.MaxLossPercentCleared() {
+		_spec.ClearField(strategy.FieldMaxLossPercent, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProfitTargetAmount(); ok {
+		_spec.SetField(strategy.FieldProfitTargetAmount, field.TypeString, value)
+	}
+	if _u.mutation.ProfitTargetAmountCleared() {
+		_spec.ClearField(strategy.FieldProfitTargetAmount, field.TypeString)
+	}
+	if value, ok := _u.mutation.TrailingStopLossAmount(); ok {
+		_spec.SetField(strategy.FieldTrailingStopLossAmount, field.TypeString, value)
+	}
+	if _u.mutation.TrailingStopLossAmountCleared() {
+		_spec.ClearField(strategy.FieldTrailingStopLossAmount, field.TypeString)
+	}
+	if value, ok := _u.mutation.TrailingStopLossPercent(); ok {
+		_spec.SetField(strategy.FieldTrailingStopLossPercent, field.TypeString, value)
+	}
+	if _u.mutation.TrailingStopLossPercentCleared() {
+		_spec.ClearField(strategy.FieldTrailingStopLossPercent, field.TypeString)
+	}
+	if value, ok := _u.mutation.TrailingTakeProfitActivationPrice(); ok {
+		_spec.SetField(strategy.FieldTrailingTakeProfitActivationPrice, field.TypeString, value)
+	}
+	if _u.mutation.TrailingTakeProfitActivationPriceCleared() {
+		_spec.ClearField(strategy.FieldTrailingTakeProfitActivationPrice, field.TypeString)
+	}
+	if value, ok := _u.mutation.TrailingTakeProfitAmount(); ok {
+		_spec.SetField(strategy.FieldTrailingTakeProfitAmount, field.TypeString, value)
+	}
+	if _u.mutation.TrailingTakeProfitAmountCleared() {
+		_spec.ClearField(strategy.FieldTrailingTakeProfitAmount, field.TypeString)
+	}
+	if value, ok := _u.mutation.TrailingTakeProfitPercent(); ok {
+		_spec.SetField(strategy.FieldTrailingTakeProfitPercent, field.TypeString, value)
+	}
+	if _u.mutation.TrailingTakeProfitPercentCleared() {
+		_spec.ClearField(strategy.FieldTrailingTakeProfitPercent, field.TypeString)
+	}
+	if value, ok := _u.mutation.TrailingExtremePrice(); ok {
+		_spec.SetField(strategy.FieldTrailingExtremePrice, field.TypeString, value)
+	}
+	if _u.mutation.TrailingExtremePriceCleared() {
+		_spec.ClearField(strategy.FieldTrailingExtremePrice, field.TypeString)
+	}
+	if value, ok := _u.mutation.TrailingLevels(); ok {
+		_spec.SetField(strategy.FieldTrailingLevels, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTrailingLevels(); ok {
+		_spec.AddField(strategy.FieldTrailingLevels, field.TypeInt, value)
+	}
+	if _u.mutation.TrailingLevelsCleared() {
+		_spec.ClearField(strategy.FieldTrailingLevels, field.TypeInt)
+	}
+	if value, ok := _u.mutation.TrailingPriceUpperLimit(); ok {
+		_spec.SetField(strategy.FieldTrailingPriceUpperLimit, field.TypeString, value)
+	}
+	if _u.mutation.TrailingPriceUpperLimitCleared() {
+		_spec.ClearField(strategy.FieldTrailingPriceUpperLimit, field.TypeString)
+	}
+	if value, ok := _u.mutation.TrailingPriceLowerLimit(); ok {
+		_spec.SetField(strategy.FieldTrailingPriceLowerLimit, field.TypeString, value)
+	}
+	if _u.mutation.TrailingPriceLowerLimitCleared() {
+		_spec.ClearField(strategy.FieldTrailingPriceLowerLimit, field.TypeString)
+	}
+	if value, ok := _u.mutation.AtrPeriod(); ok {
+		_spec.SetField(strategy.FieldAtrPeriod, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAtrPeriod(); ok {
+		_spec.AddField(strategy.FieldAtrPeriod, field.TypeInt, value)
+	}
+	if _u.mutation.AtrPeriodCleared() {
+		_spec.ClearField(strategy.FieldAtrPeriod, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AtrMultiplier(); ok {
+		_spec.SetField(strategy.FieldAtrMultiplier, field.TypeString, value)
+	}
+	if _u.mutation.AtrMultiplierCleared() {
+		_spec.ClearField(strategy.FieldAtrMultiplier, field.TypeString)
+	}
+	if value, ok := _u.mutation.AdaptiveThreshold(); ok {
+		_spec.SetField(strategy.FieldAdaptiveThreshold, field.TypeString, value)
+	}
+	if _u.mutation.AdaptiveThresholdCleared() {
+		_spec.ClearField(strategy.FieldAdaptiveThreshold, field.TypeString)
+	}
+	if value, ok := _u.mutation.AdaptiveIntervalHours(); ok {
+		_spec.SetField(strategy.FieldAdaptiveIntervalHours, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAdaptiveIntervalHours(); ok {
+		_spec.AddField(strategy.FieldAdaptiveIntervalHours, field.TypeInt, value)
+	}
+	if _u.mutation.AdaptiveIntervalHoursCleared() {
+		_spec.ClearField(strategy.FieldAdaptiveIntervalHours, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AdaptiveAtr(); ok {
+		_spec.SetField(strategy.FieldAdaptiveAtr, field.TypeString, value)
+	}
+	if _u.mutation.AdaptiveAtrCleared() {
+		_spec.ClearField(strategy.FieldAdaptiveAtr, field.TypeString)
+	}
+	if value, ok := _u.mutation.AdaptiveUpdatedAt(); ok {
+		_spec.SetField(strategy.FieldAdaptiveUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AdaptiveUpdatedAtCleared() {
+		_spec.ClearField(strategy.FieldAdaptiveUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CancelRepairPolicy(); ok {
+		_spec.SetField(strategy.FieldCancelRepairPolicy, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CancelRepairMaxAttempts(); ok {
+		_spec.SetField(strategy.FieldCancelRepairMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCancelRepairMaxAttempts(); ok {
+		_spec.AddField(strategy.FieldCancelRepairMaxAttempts, field.TypeInt, value)
+	}
+	if _u.mutation.CancelRepairMaxAttemptsCleared() {
+		_spec.ClearField(strategy.FieldCancelRepairMaxAttempts, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ReconcilePolicy(); ok {
+		_spec.SetField(strategy.FieldReconcilePolicy, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PartialFillThreshold(); ok {
+		_spec.SetField(strategy.FieldPartialFillThreshold, field.TypeString, value)
+	}
+	if _u.mutation.PartialFillThresholdCleared() {
+		_spec.ClearField(strategy.FieldPartialFillThreshold, field.TypeString)
+	}
 	if value, ok := _u.mutation.EnablePushNotification(); ok {
 		_spec.SetField(strategy.FieldEnablePushNotification, field.TypeBool, value)
 	}
@@ -1438,13 +2976,25 @@ func (_u *StrategyUpdateOne) sqlSave(ctx context.Context) (_node *Strategy, err
 		_spec.SetField(strategy.FieldStatus, field.TypeEnum, value)
 	}
 	if value, ok := _u.mutation.ExchangeApiKey(); ok {
-		_spec.SetField(strategy.FieldExchangeApiKey, field.TypeString, value)
+		vv, err := strategy.ValueScanner.ExchangeApiKey.Value(value)
+		if err != nil {
+			return nil, err
+		}
+		_spec.SetField(strategy.FieldExchangeApiKey, field.TypeString, vv)
 	}
 	if value, ok := _u.mutation.ExchangeSecretKey(); ok {
-		_spec.SetField(strategy.FieldExchangeSecretKey, field.TypeString, value)
+		vv, err := strategy.ValueScanner.ExchangeSecretKey.Value(value)
+		if err != nil {
+			return nil, err
+		}
+		_spec.SetField(strategy.FieldExchangeSecretKey, field.TypeString, vv)
 	}
 	if value, ok := _u.mutation.ExchangePassphrase(); ok {
-		_spec.SetField(strategy.FieldExchangePassphrase, field.TypeString, value)
+		vv, err := strategy.ValueScanner.ExchangePassphrase.Value(value)
+		if err != nil {
+			return nil, err
+		}
+		_spec.SetField(strategy.FieldExchangePassphrase, field.TypeString, vv)
 	}
 	if value, ok := _u.mutation.ExchangeTestnet(); ok {
 		_spec.SetField(strategy.FieldExchangeTestnet, field.TypeBool, value)
@@ -1455,6 +3005,35 @@ func (_u *StrategyUpdateOne) sqlSave(ctx context.Context) (_node *Strategy, err
 	if _u.mutation.StartTimeCleared() {
 		_spec.ClearField(strategy.FieldStartTime, field.TypeTime)
 	}
+	if _u.mutation.ExchangeAccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   strategy.ExchangeAccountTable,
+			Columns: []string{strategy.ExchangeAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exchangeaccount.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ExchangeAccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   strategy.ExchangeAccountTable,
+			Columns: []string{strategy.ExchangeAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exchangeaccount.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Strategy{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,374 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategyrun"
+	"github.com/shopspring/decimal"
+)
+
+// StrategyRun is the model entity for the StrategyRun schema.
+type StrategyRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// StrategyId holds the value of the "strategyId" field.
+	StrategyId string `json:"strategyId,omitempty"`
+	// Owner holds the value of the "owner" field.
+	Owner int64 `json:"owner,omitempty"`
+	// Exchange holds the value of the "exchange" field.
+	Exchange string `json:"exchange,omitempty"`
+	// Symbol holds the value of the "symbol" field.
+	Symbol string `json:"symbol,omitempty"`
+	// Account holds the value of the "account" field.
+	Account string `json:"account,omitempty"`
+	// Mode holds the value of the "mode" field.
+	Mode strategyrun.Mode `json:"mode,omitempty"`
+	// PriceUpper holds the value of the "priceUpper" field.
+	PriceUpper decimal.Decimal `json:"priceUpper,omitempty"`
+	// PriceLower holds the value of the "priceLower" field.
+	PriceLower decimal.Decimal `json:"priceLower,omitempty"`
+	// GridNum holds the value of the "gridNum" field.
+	GridNum int `json:"gridNum,omitempty"`
+	// Leverage holds the value of the "leverage" field.
+	Leverage int `json:"leverage,omitempty"`
+	// InitialOrderSize holds the value of the "initialOrderSize" field.
+	InitialOrderSize decimal.Decimal `json:"initialOrderSize,omitempty"`
+	// Status holds the value of the "status" field.
+	Status strategyrun.Status `json:"status,omitempty"`
+	// StartTime holds the value of the "startTime" field.
+	StartTime time.Time `json:"startTime,omitempty"`
+	// EndTime holds the value of the "endTime" field.
+	EndTime *time.Time `json:"endTime,omitempty"`
+	// StopReason holds the value of the "stopReason" field.
+	StopReason *strategyrun.StopReason `json:"stopReason,omitempty"`
+	// MatchedTrades holds the value of the "matchedTrades" field.
+	MatchedTrades int `json:"matchedTrades,omitempty"`
+	// RealizedProfit holds the value of the "realizedProfit" field.
+	RealizedProfit *decimal.Decimal `json:"realizedProfit,omitempty"`
+	// Fee holds the value of the "fee" field.
+	Fee *decimal.Decimal `json:"fee,omitempty"`
+	// Funding holds the value of the "funding" field.
+	Funding *decimal.Decimal `json:"funding,omitempty"`
+	// UnrealizedProfit holds the value of the "unrealizedProfit" field.
+	UnrealizedProfit *decimal.Decimal `json:"unrealizedProfit,omitempty"`
+	// TotalProfit holds the value of the "totalProfit" field.
+	TotalProfit  *decimal.Decimal `json:"totalProfit,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StrategyRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case strategyrun.FieldRealizedProfit, strategyrun.FieldFee, strategyrun.FieldFunding, strategyrun.FieldUnrealizedProfit, strategyrun.FieldTotalProfit:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case strategyrun.FieldPriceUpper, strategyrun.FieldPriceLower, strategyrun.FieldInitialOrderSize:
+			values[i] = new(decimal.Decimal)
+		case strategyrun.FieldID, strategyrun.FieldOwner, strategyrun.FieldGridNum, strategyrun.FieldLeverage, strategyrun.FieldMatchedTrades:
+			values[i] = new(sql.NullInt64)
+		case strategyrun.FieldStrategyId, strategyrun.FieldExchange, strategyrun.FieldSymbol, strategyrun.FieldAccount, strategyrun.FieldMode, strategyrun.FieldStatus, strategyrun.FieldStopReason:
+			values[i] = new(sql.NullString)
+		case strategyrun.FieldCreateTime, strategyrun.FieldUpdateTime, strategyrun.FieldStartTime, strategyrun.FieldEndTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StrategyRun fields.
+func (_m *StrategyRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case strategyrun.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case strategyrun.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case strategyrun.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case strategyrun.FieldStrategyId:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field strategyId", values[i])
+			} else if value.Valid {
+				_m.StrategyId = value.String
+			}
+		case strategyrun.FieldOwner:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field owner", values[i])
+			} else if value.Valid {
+				_m.Owner = value.Int64
+			}
+		case strategyrun.FieldExchange:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange", values[i])
+			} else if value.Valid {
+				_m.Exchange = value.String
+			}
+		case strategyrun.FieldSymbol:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field symbol", values[i])
+			} else if value.Valid {
+				_m.Symbol = value.String
+			}
+		case strategyrun.FieldAccount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account", values[i])
+			} else if value.Valid {
+				_m.Account = value.String
+			}
+		case strategyrun.FieldMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mode", values[i])
+			} else if value.Valid {
+				_m.Mode = strategyrun.Mode(value.String)
+			}
+		case strategyrun.FieldPriceUpper:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field priceUpper", values[i])
+			} else if value != nil {
+				_m.PriceUpper = *value
+			}
+		case strategyrun.FieldPriceLower:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field priceLower", values[i])
+			} else if value != nil {
+				_m.PriceLower = *value
+			}
+		case strategyrun.FieldGridNum:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field gridNum", values[i])
+			} else if value.Valid {
+				_m.GridNum = int(value.Int64)
+			}
+		case strategyrun.FieldLeverage:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field leverage", values[i])
+			} else if value.Valid {
+				_m.Leverage = int(value.Int64)
+			}
+		case strategyrun.FieldInitialOrderSize:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field initialOrderSize", values[i])
+			} else if value != nil {
+				_m.InitialOrderSize = *value
+			}
+		case strategyrun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = strategyrun.Status(value.String)
+			}
+		case strategyrun.FieldStartTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field startTime", values[i])
+			} else if value.Valid {
+				_m.StartTime = value.Time
+			}
+		case strategyrun.FieldEndTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field endTime", values[i])
+			} else if value.Valid {
+				_m.EndTime = new(time.Time)
+				*_m.EndTime = value.Time
+			}
+		case strategyrun.FieldStopReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field stopReason", values[i])
+			} else if value.Valid {
+				_m.StopReason = new(strategyrun.StopReason)
+				*_m.StopReason = strategyrun.StopReason(value.String)
+			}
+		case strategyrun.FieldMatchedTrades:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field matchedTrades", values[i])
+			} else if value.Valid {
+				_m.MatchedTrades = int(value.Int64)
+			}
+		case strategyrun.FieldRealizedProfit:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field realizedProfit", values[i])
+			} else if value.Valid {
+				_m.RealizedProfit = new(decimal.Decimal)
+				*_m.RealizedProfit = *value.S.(*decimal.Decimal)
+			}
+		case strategyrun.FieldFee:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field fee", values[i])
+			} else if value.Valid {
+				_m.Fee = new(decimal.Decimal)
+				*_m.Fee = *value.S.(*decimal.Decimal)
+			}
+		case strategyrun.FieldFunding:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field funding", values[i])
+			} else if value.Valid {
+				_m.Funding = new(decimal.Decimal)
+				*_m.Funding = *value.S.(*decimal.Decimal)
+			}
+		case strategyrun.FieldUnrealizedProfit:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field unrealizedProfit", values[i])
+			} else if value.Valid {
+				_m.UnrealizedProfit = new(decimal.Decimal)
+				*_m.UnrealizedProfit = *value.S.(*decimal.Decimal)
+			}
+		case strategyrun.FieldTotalProfit:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field totalProfit", values[i])
+			} else if value.Valid {
+				_m.TotalProfit = new(decimal.Decimal)
+				*_m.TotalProfit = *value.S.(*decimal.Decimal)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StrategyRun.
+// This includes values selected through modifiers, order, etc.
+func (_m *StrategyRun) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this StrategyRun.
+// Note that you need to call StrategyRun.Unwrap() before calling this method if this StrategyRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *StrategyRun) Update() *StrategyRunUpdateOne {
+	return NewStrategyRunClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the StrategyRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *StrategyRun) Unwrap() *StrategyRun {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StrategyRun is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *StrategyRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("StrategyRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("strategyId=")
+	builder.WriteString(_m.StrategyId)
+	builder.WriteString(", ")
+	builder.WriteString("owner=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Owner))
+	builder.WriteString(", ")
+	builder.WriteString("exchange=")
+	builder.WriteString(_m.Exchange)
+	builder.WriteString(", ")
+	builder.WriteString("symbol=")
+	builder.WriteString(_m.Symbol)
+	builder.WriteString(", ")
+	builder.WriteString("account=")
+	builder.WriteString(_m.Account)
+	builder.WriteString(", ")
+	builder.WriteString("mode=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Mode))
+	builder.WriteString(", ")
+	builder.WriteString("priceUpper=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PriceUpper))
+	builder.WriteString(", ")
+	builder.WriteString("priceLower=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PriceLower))
+	builder.WriteString(", ")
+	builder.WriteString("gridNum=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GridNum))
+	builder.WriteString(", ")
+	builder.WriteString("leverage=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Leverage))
+	builder.WriteString(", ")
+	builder.WriteString("initialOrderSize=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InitialOrderSize))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("startTime=")
+	builder.WriteString(_m.StartTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.EndTime; v != nil {
+		builder.WriteString("endTime=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.StopReason; v != nil {
+		builder.WriteString("stopReason=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("matchedTrades=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MatchedTrades))
+	builder.WriteString(", ")
+	if v := _m.RealizedProfit; v != nil {
+		builder.WriteString("realizedProfit=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Fee; v != nil {
+		builder.WriteString("fee=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Funding; v != nil {
+		builder.WriteString("funding=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UnrealizedProfit; v != nil {
+		builder.WriteString("unrealizedProfit=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TotalProfit; v != nil {
+		builder.WriteString("totalProfit=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StrategyRuns is a parsable slice of StrategyRun.
+type StrategyRuns []*StrategyRun
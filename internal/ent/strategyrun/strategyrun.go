@@ -0,0 +1,324 @@
+// Code generated by ent, DO NOT EDIT.
+
+package strategyrun
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the strategyrun type in the database.
+	Label = "strategy_run"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldStrategyId holds the string denoting the strategyid field in the database.
+	FieldStrategyId = "strategy_id"
+	// FieldOwner holds the string denoting the owner field in the database.
+	FieldOwner = "owner"
+	// FieldExchange holds the string denoting the exchange field in the database.
+	FieldExchange = "exchange"
+	// FieldSymbol holds the string denoting the symbol field in the database.
+	FieldSymbol = "symbol"
+	// FieldAccount holds the string denoting the account field in the database.
+	FieldAccount = "account"
+	// FieldMode holds the string denoting the mode field in the database.
+	FieldMode = "mode"
+	// FieldPriceUpper holds the string denoting the priceupper field in the database.
+	FieldPriceUpper = "price_upper"
+	// FieldPriceLower holds the string denoting the pricelower field in the database.
+	FieldPriceLower = "price_lower"
+	// FieldGridNum holds the string denoting the gridnum field in the database.
+	FieldGridNum = "grid_num"
+	// FieldLeverage holds the string denoting the leverage field in the database.
+	FieldLeverage = "leverage"
+	// FieldInitialOrderSize holds the string denoting the initialordersize field in the database.
+	FieldInitialOrderSize = "initial_order_size"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldStartTime holds the string denoting the starttime field in the database.
+	FieldStartTime = "start_time"
+	// FieldEndTime holds the string denoting the endtime field in the database.
+	FieldEndTime = "end_time"
+	// FieldStopReason holds the string denoting the stopreason field in the database.
+	FieldStopReason = "stop_reason"
+	// FieldMatchedTrades holds the string denoting the matchedtrades field in the database.
+	FieldMatchedTrades = "matched_trades"
+	// FieldRealizedProfit holds the string denoting the realizedprofit field in the database.
+	FieldRealizedProfit = "realized_profit"
+	// FieldFee holds the string denoting the fee field in the database.
+	FieldFee = "fee"
+	// FieldFunding holds the string denoting the funding field in the database.
+	FieldFunding = "funding"
+	// FieldUnrealizedProfit holds the string denoting the unrealizedprofit field in the database.
+	FieldUnrealizedProfit = "unrealized_profit"
+	// FieldTotalProfit holds the string denoting the totalprofit field in the database.
+	FieldTotalProfit = "total_profit"
+	// Table holds the table name of the strategyrun in the database.
+	Table = "strategy_runs"
+)
+
+// Columns holds all SQL columns for strategyrun fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldStrategyId,
+	FieldOwner,
+	FieldExchange,
+	FieldSymbol,
+	FieldAccount,
+	FieldMode,
+	FieldPriceUpper,
+	FieldPriceLower,
+	FieldGridNum,
+	FieldLeverage,
+	FieldInitialOrderSize,
+	FieldStatus,
+	FieldStartTime,
+	FieldEndTime,
+	FieldStopReason,
+	FieldMatchedTrades,
+	FieldRealizedProfit,
+	FieldFee,
+	FieldFunding,
+	FieldUnrealizedProfit,
+	FieldTotalProfit,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// StrategyIdValidator is a validator for the "strategyId" field. It is called by the builders before save.
+	StrategyIdValidator func(string) error
+	// ExchangeValidator is a validator for the "exchange" field. It is called by the builders before save.
+	ExchangeValidator func(string) error
+	// SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
+	SymbolValidator func(string) error
+	// DefaultMatchedTrades holds the default value on creation for the "matchedTrades" field.
+	DefaultMatchedTrades int
+)
+
+// Mode defines the type for the "mode" enum field.
+type Mode string
+
+// Mode values.
+const (
+	ModeLong    Mode = "long"
+	ModeShort   Mode = "short"
+	ModeNeutral Mode = "neutral"
+)
+
+func (m Mode) String() string {
+	return string(m)
+}
+
+// ModeValidator is a validator for the "mode" field enum values. It is called by the builders before save.
+func ModeValidator(m Mode) error {
+	switch m {
+	case ModeLong, ModeShort, ModeNeutral:
+		return nil
+	default:
+		return fmt.Errorf("strategyrun: invalid enum value for mode field: %q", m)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusRunning is the default value of the Status enum.
+const DefaultStatus = StatusRunning
+
+// Status values.
+const (
+	StatusRunning Status = "running"
+	StatusStopped Status = "stopped"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusRunning, StatusStopped:
+		return nil
+	default:
+		return fmt.Errorf("strategyrun: invalid enum value for status field: %q", s)
+	}
+}
+
+// StopReason defines the type for the "stopReason" enum field.
+type StopReason string
+
+// StopReason values.
+const (
+	StopReasonManual             StopReason = "manual"
+	StopReasonClosePosition      StopReason = "close_position"
+	StopReasonStopLoss           StopReason = "stop_loss"
+	StopReasonTakeProfit         StopReason = "take_profit"
+	StopReasonOrderCanceled      StopReason = "order_canceled"
+	StopReasonLiquidationRisk    StopReason = "liquidation_risk"
+	StopReasonMaxLoss            StopReason = "max_loss"
+	StopReasonProfitTarget       StopReason = "profit_target"
+	StopReasonTrailingStopLoss   StopReason = "trailing_stop_loss"
+	StopReasonTrailingTakeProfit StopReason = "trailing_take_profit"
+)
+
+func (sr StopReason) String() string {
+	return string(sr)
+}
+
+// StopReasonValidator is a validator for the "stopReason" field enum values. It is called by the builders before save.
+func StopReasonValidator(sr StopReason) error {
+	switch sr {
+	case StopReasonManual, StopReasonClosePosition, StopReasonStopLoss, StopReasonTakeProfit, StopReasonOrderCanceled, StopReasonLiquidationRisk, StopReasonMaxLoss, StopReasonProfitTarget, StopReasonTrailingStopLoss, StopReasonTrailingTakeProfit:
+		return nil
+	default:
+		return fmt.Errorf("strategyrun: invalid enum value for stopReason field: %q", sr)
+	}
+}
+
+// OrderOption defines the ordering options for the StrategyRun queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByStrategyId orders the results by the strategyId field.
+func ByStrategyId(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStrategyId, opts...).ToFunc()
+}
+
+// ByOwner orders the results by the owner field.
+func ByOwner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwner, opts...).ToFunc()
+}
+
+// ByExchange orders the results by the exchange field.
+func ByExchange(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExchange, opts...).ToFunc()
+}
+
+// BySymbol orders the results by the symbol field.
+func BySymbol(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSymbol, opts...).ToFunc()
+}
+
+// ByAccount orders the results by the account field.
+func ByAccount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccount, opts...).ToFunc()
+}
+
+// ByMode orders the results by the mode field.
+func ByMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMode, opts...).ToFunc()
+}
+
+// ByPriceUpper orders the results by the priceUpper field.
+func ByPriceUpper(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriceUpper, opts...).ToFunc()
+}
+
+// ByPriceLower orders the results by the priceLower field.
+func ByPriceLower(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriceLower, opts...).ToFunc()
+}
+
+// ByGridNum orders the results by the gridNum field.
+func ByGridNum(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGridNum, opts...).ToFunc()
+}
+
+// ByLeverage orders the results by the leverage field.
+func ByLeverage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLeverage, opts...).ToFunc()
+}
+
+// ByInitialOrderSize orders the results by the initialOrderSize field.
+func ByInitialOrderSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInitialOrderSize, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByStartTime orders the results by the startTime field.
+func ByStartTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartTime, opts...).ToFunc()
+}
+
+// ByEndTime orders the results by the endTime field.
+func ByEndTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndTime, opts...).ToFunc()
+}
+
+// ByStopReason orders the results by the stopReason field.
+func ByStopReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStopReason, opts...).ToFunc()
+}
+
+// ByMatchedTrades orders the results by the matchedTrades field.
+func ByMatchedTrades(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMatchedTrades, opts...).ToFunc()
+}
+
+// ByRealizedProfit orders the results by the realizedProfit field.
+func ByRealizedProfit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRealizedProfit, opts...).ToFunc()
+}
+
+// ByFee orders the results by the fee field.
+func ByFee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFee, opts...).ToFunc()
+}
+
+// ByFunding orders the results by the funding field.
+func ByFunding(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFunding, opts...).ToFunc()
+}
+
+// ByUnrealizedProfit orders the results by the unrealizedProfit field.
+func ByUnrealizedProfit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnrealizedProfit, opts...).ToFunc()
+}
+
+// ByTotalProfit orders the results by the totalProfit field.
+func ByTotalProfit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalProfit, opts...).ToFunc()
+}
@@ -0,0 +1,1441 @@
+// Code generated by ent, DO NOT EDIT.
+
+package strategyrun
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/fachebot/omni-grid-bot/internal/ent/predicate"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// StrategyId applies equality check predicate on the "strategyId" field. It's identical to StrategyIdEQ.
+func StrategyId(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldStrategyId, v))
+}
+
+// Owner applies equality check predicate on the "owner" field. It's identical to OwnerEQ.
+func Owner(v int64) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldOwner, v))
+}
+
+// Exchange applies equality check predicate on the "exchange" field. It's identical to ExchangeEQ.
+func Exchange(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldExchange, v))
+}
+
+// Symbol applies equality check predicate on the "symbol" field. It's identical to SymbolEQ.
+func Symbol(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldSymbol, v))
+}
+
+// Account applies equality check predicate on the "account" field. It's identical to AccountEQ.
+func Account(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldAccount, v))
+}
+
+// PriceUpper applies equality check predicate on the "priceUpper" field. It's identical to PriceUpperEQ.
+func PriceUpper(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldPriceUpper, v))
+}
+
+// PriceLower applies equality check predicate on the "priceLower" field. It's identical to PriceLowerEQ.
+func PriceLower(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldPriceLower, v))
+}
+
+// GridNum applies equality check predicate on the "gridNum" field. It's identical to GridNumEQ.
+func GridNum(v int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldGridNum, v))
+}
+
+// Leverage applies equality check predicate on the "leverage" field. It's identical to LeverageEQ.
+func Leverage(v int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldLeverage, v))
+}
+
+// InitialOrderSize applies equality check predicate on the "initialOrderSize" field. It's identical to InitialOrderSizeEQ.
+func InitialOrderSize(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldInitialOrderSize, v))
+}
+
+// StartTime applies equality check predicate on the "startTime" field. It's identical to StartTimeEQ.
+func StartTime(v time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldStartTime, v))
+}
+
+// EndTime applies equality check predicate on the "endTime" field. It's identical to EndTimeEQ.
+func EndTime(v time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldEndTime, v))
+}
+
+// MatchedTrades applies equality check predicate on the "matchedTrades" field. It's identical to MatchedTradesEQ.
+func MatchedTrades(v int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldMatchedTrades, v))
+}
+
+// RealizedProfit applies equality check predicate on the "realizedProfit" field. It's identical to RealizedProfitEQ.
+func RealizedProfit(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldRealizedProfit, v))
+}
+
+// Fee applies equality check predicate on the "fee" field. It's identical to FeeEQ.
+func Fee(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldFee, v))
+}
+
+// Funding applies equality check predicate on the "funding" field. It's identical to FundingEQ.
+func Funding(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldFunding, v))
+}
+
+// UnrealizedProfit applies equality check predicate on the "unrealizedProfit" field. It's identical to UnrealizedProfitEQ.
+func UnrealizedProfit(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldUnrealizedProfit, v))
+}
+
+// TotalProfit applies equality check predicate on the "totalProfit" field. It's identical to TotalProfitEQ.
+func TotalProfit(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldTotalProfit, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// StrategyIdEQ applies the EQ predicate on the "strategyId" field.
+func StrategyIdEQ(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldStrategyId, v))
+}
+
+// StrategyIdNEQ applies the NEQ predicate on the "strategyId" field.
+func StrategyIdNEQ(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNEQ(FieldStrategyId, v))
+}
+
+// StrategyIdIn applies the In predicate on the "strategyId" field.
+func StrategyIdIn(vs ...string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIn(FieldStrategyId, vs...))
+}
+
+// StrategyIdNotIn applies the NotIn predicate on the "strategyId" field.
+func StrategyIdNotIn(vs ...string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotIn(FieldStrategyId, vs...))
+}
+
+// StrategyIdGT applies the GT predicate on the "strategyId" field.
+func StrategyIdGT(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGT(FieldStrategyId, v))
+}
+
+// StrategyIdGTE applies the GTE predicate on the "strategyId" field.
+func StrategyIdGTE(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGTE(FieldStrategyId, v))
+}
+
+// StrategyIdLT applies the LT predicate on the "strategyId" field.
+func StrategyIdLT(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLT(FieldStrategyId, v))
+}
+
+// StrategyIdLTE applies the LTE predicate on the "strategyId" field.
+func StrategyIdLTE(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLTE(FieldStrategyId, v))
+}
+
+// StrategyIdContains applies the Contains predicate on the "strategyId" field.
+func StrategyIdContains(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldContains(FieldStrategyId, v))
+}
+
+// StrategyIdHasPrefix applies the HasPrefix predicate on the "strategyId" field.
+func StrategyIdHasPrefix(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldHasPrefix(FieldStrategyId, v))
+}
+
+// StrategyIdHasSuffix applies the HasSuffix predicate on the "strategyId" field.
+func StrategyIdHasSuffix(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldHasSuffix(FieldStrategyId, v))
+}
+
+// StrategyIdEqualFold applies the EqualFold predicate on the "strategyId" field.
+func StrategyIdEqualFold(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEqualFold(FieldStrategyId, v))
+}
+
+// StrategyIdContainsFold applies the ContainsFold predicate on the "strategyId" field.
+func StrategyIdContainsFold(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldContainsFold(FieldStrategyId, v))
+}
+
+// OwnerEQ applies the EQ predicate on the "owner" field.
+func OwnerEQ(v int64) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldOwner, v))
+}
+
+// OwnerNEQ applies the NEQ predicate on the "owner" field.
+func OwnerNEQ(v int64) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNEQ(FieldOwner, v))
+}
+
+// OwnerIn applies the In predicate on the "owner" field.
+func OwnerIn(vs ...int64) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIn(FieldOwner, vs...))
+}
+
+// OwnerNotIn applies the NotIn predicate on the "owner" field.
+func OwnerNotIn(vs ...int64) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotIn(FieldOwner, vs...))
+}
+
+// OwnerGT applies the GT predicate on the "owner" field.
+func OwnerGT(v int64) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGT(FieldOwner, v))
+}
+
+// OwnerGTE applies the GTE predicate on the "owner" field.
+func OwnerGTE(v int64) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGTE(FieldOwner, v))
+}
+
+// OwnerLT applies the LT predicate on the "owner" field.
+func OwnerLT(v int64) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLT(FieldOwner, v))
+}
+
+// OwnerLTE applies the LTE predicate on the "owner" field.
+func OwnerLTE(v int64) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLTE(FieldOwner, v))
+}
+
+// ExchangeEQ applies the EQ predicate on the "exchange" field.
+func ExchangeEQ(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldExchange, v))
+}
+
+// ExchangeNEQ applies the NEQ predicate on the "exchange" field.
+func ExchangeNEQ(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNEQ(FieldExchange, v))
+}
+
+// ExchangeIn applies the In predicate on the "exchange" field.
+func ExchangeIn(vs ...string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIn(FieldExchange, vs...))
+}
+
+// ExchangeNotIn applies the NotIn predicate on the "exchange" field.
+func ExchangeNotIn(vs ...string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotIn(FieldExchange, vs...))
+}
+
+// ExchangeGT applies the GT predicate on the "exchange" field.
+func ExchangeGT(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGT(FieldExchange, v))
+}
+
+// ExchangeGTE applies the GTE predicate on the "exchange" field.
+func ExchangeGTE(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGTE(FieldExchange, v))
+}
+
+// ExchangeLT applies the LT predicate on the "exchange" field.
+func ExchangeLT(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLT(FieldExchange, v))
+}
+
+// ExchangeLTE applies the LTE predicate on the "exchange" field.
+func ExchangeLTE(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLTE(FieldExchange, v))
+}
+
+// ExchangeContains applies the Contains predicate on the "exchange" field.
+func ExchangeContains(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldContains(FieldExchange, v))
+}
+
+// ExchangeHasPrefix applies the HasPrefix predicate on the "exchange" field.
+func ExchangeHasPrefix(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldHasPrefix(FieldExchange, v))
+}
+
+// ExchangeHasSuffix applies the HasSuffix predicate on the "exchange" field.
+func ExchangeHasSuffix(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldHasSuffix(FieldExchange, v))
+}
+
+// ExchangeEqualFold applies the EqualFold predicate on the "exchange" field.
+func ExchangeEqualFold(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEqualFold(FieldExchange, v))
+}
+
+// ExchangeContainsFold applies the ContainsFold predicate on the "exchange" field.
+func ExchangeContainsFold(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldContainsFold(FieldExchange, v))
+}
+
+// SymbolEQ applies the EQ predicate on the "symbol" field.
+func SymbolEQ(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldSymbol, v))
+}
+
+// SymbolNEQ applies the NEQ predicate on the "symbol" field.
+func SymbolNEQ(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNEQ(FieldSymbol, v))
+}
+
+// SymbolIn applies the In predicate on the "symbol" field.
+func SymbolIn(vs ...string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIn(FieldSymbol, vs...))
+}
+
+// SymbolNotIn applies the NotIn predicate on the "symbol" field.
+func SymbolNotIn(vs ...string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotIn(FieldSymbol, vs...))
+}
+
+// SymbolGT applies the GT predicate on the "symbol" field.
+func SymbolGT(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGT(FieldSymbol, v))
+}
+
+// SymbolGTE applies the GTE predicate on the "symbol" field.
+func SymbolGTE(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGTE(FieldSymbol, v))
+}
+
+// SymbolLT applies the LT predicate on the "symbol" field.
+func SymbolLT(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLT(FieldSymbol, v))
+}
+
+// SymbolLTE applies the LTE predicate on the "symbol" field.
+func SymbolLTE(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLTE(FieldSymbol, v))
+}
+
+// SymbolContains applies the Contains predicate on the "symbol" field.
+func SymbolContains(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldContains(FieldSymbol, v))
+}
+
+// SymbolHasPrefix applies the HasPrefix predicate on the "symbol" field.
+func SymbolHasPrefix(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldHasPrefix(FieldSymbol, v))
+}
+
+// SymbolHasSuffix applies the HasSuffix predicate on the "symbol" field.
+func SymbolHasSuffix(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldHasSuffix(FieldSymbol, v))
+}
+
+// SymbolEqualFold applies the EqualFold predicate on the "symbol" field.
+func SymbolEqualFold(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEqualFold(FieldSymbol, v))
+}
+
+// SymbolContainsFold applies the ContainsFold predicate on the "symbol" field.
+func SymbolContainsFold(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldContainsFold(FieldSymbol, v))
+}
+
+// AccountEQ applies the EQ predicate on the "account" field.
+func AccountEQ(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldAccount, v))
+}
+
+// AccountNEQ applies the NEQ predicate on the "account" field.
+func AccountNEQ(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNEQ(FieldAccount, v))
+}
+
+// AccountIn applies the In predicate on the "account" field.
+func AccountIn(vs ...string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIn(FieldAccount, vs...))
+}
+
+// AccountNotIn applies the NotIn predicate on the "account" field.
+func AccountNotIn(vs ...string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotIn(FieldAccount, vs...))
+}
+
+// AccountGT applies the GT predicate on the "account" field.
+func AccountGT(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGT(FieldAccount, v))
+}
+
+// AccountGTE applies the GTE predicate on the "account" field.
+func AccountGTE(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGTE(FieldAccount, v))
+}
+
+// AccountLT applies the LT predicate on the "account" field.
+func AccountLT(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLT(FieldAccount, v))
+}
+
+// AccountLTE applies the LTE predicate on the "account" field.
+func AccountLTE(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLTE(FieldAccount, v))
+}
+
+// AccountContains applies the Contains predicate on the "account" field.
+func AccountContains(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldContains(FieldAccount, v))
+}
+
+// AccountHasPrefix applies the HasPrefix predicate on the "account" field.
+func AccountHasPrefix(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldHasPrefix(FieldAccount, v))
+}
+
+// AccountHasSuffix applies the HasSuffix predicate on the "account" field.
+func AccountHasSuffix(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldHasSuffix(FieldAccount, v))
+}
+
+// AccountEqualFold applies the EqualFold predicate on the "account" field.
+func AccountEqualFold(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEqualFold(FieldAccount, v))
+}
+
+// AccountContainsFold applies the ContainsFold predicate on the "account" field.
+func AccountContainsFold(v string) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldContainsFold(FieldAccount, v))
+}
+
+// ModeEQ applies the EQ predicate on the "mode" field.
+func ModeEQ(v Mode) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldMode, v))
+}
+
+// ModeNEQ applies the NEQ predicate on the "mode" field.
+func ModeNEQ(v Mode) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNEQ(FieldMode, v))
+}
+
+// ModeIn applies the In predicate on the "mode" field.
+func ModeIn(vs ...Mode) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIn(FieldMode, vs...))
+}
+
+// ModeNotIn applies the NotIn predicate on the "mode" field.
+func ModeNotIn(vs ...Mode) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotIn(FieldMode, vs...))
+}
+
+// PriceUpperEQ applies the EQ predicate on the "priceUpper" field.
+func PriceUpperEQ(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldPriceUpper, v))
+}
+
+// PriceUpperNEQ applies the NEQ predicate on the "priceUpper" field.
+func PriceUpperNEQ(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNEQ(FieldPriceUpper, v))
+}
+
+// PriceUpperIn applies the In predicate on the "priceUpper" field.
+func PriceUpperIn(vs ...decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIn(FieldPriceUpper, vs...))
+}
+
+// PriceUpperNotIn applies the NotIn predicate on the "priceUpper" field.
+func PriceUpperNotIn(vs ...decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotIn(FieldPriceUpper, vs...))
+}
+
+// PriceUpperGT applies the GT predicate on the "priceUpper" field.
+func PriceUpperGT(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGT(FieldPriceUpper, v))
+}
+
+// PriceUpperGTE applies the GTE predicate on the "priceUpper" field.
+func PriceUpperGTE(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGTE(FieldPriceUpper, v))
+}
+
+// PriceUpperLT applies the LT predicate on the "priceUpper" field.
+func PriceUpperLT(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLT(FieldPriceUpper, v))
+}
+
+// PriceUpperLTE applies the LTE predicate on the "priceUpper" field.
+func PriceUpperLTE(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLTE(FieldPriceUpper, v))
+}
+
+// PriceUpperContains applies the Contains predicate on the "priceUpper" field.
+func PriceUpperContains(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldContains(FieldPriceUpper, vc))
+}
+
+// PriceUpperHasPrefix applies the HasPrefix predicate on the "priceUpper" field.
+func PriceUpperHasPrefix(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldHasPrefix(FieldPriceUpper, vc))
+}
+
+// PriceUpperHasSuffix applies the HasSuffix predicate on the "priceUpper" field.
+func PriceUpperHasSuffix(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldHasSuffix(FieldPriceUpper, vc))
+}
+
+// PriceUpperEqualFold applies the EqualFold predicate on the "priceUpper" field.
+func PriceUpperEqualFold(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldEqualFold(FieldPriceUpper, vc))
+}
+
+// PriceUpperContainsFold applies the ContainsFold predicate on the "priceUpper" field.
+func PriceUpperContainsFold(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldContainsFold(FieldPriceUpper, vc))
+}
+
+// PriceLowerEQ applies the EQ predicate on the "priceLower" field.
+func PriceLowerEQ(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldPriceLower, v))
+}
+
+// PriceLowerNEQ applies the NEQ predicate on the "priceLower" field.
+func PriceLowerNEQ(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNEQ(FieldPriceLower, v))
+}
+
+// PriceLowerIn applies the In predicate on the "priceLower" field.
+func PriceLowerIn(vs ...decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIn(FieldPriceLower, vs...))
+}
+
+// PriceLowerNotIn applies the NotIn predicate on the "priceLower" field.
+func PriceLowerNotIn(vs ...decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotIn(FieldPriceLower, vs...))
+}
+
+// PriceLowerGT applies the GT predicate on the "priceLower" field.
+func PriceLowerGT(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGT(FieldPriceLower, v))
+}
+
+// PriceLowerGTE applies the GTE predicate on the "priceLower" field.
+func PriceLowerGTE(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGTE(FieldPriceLower, v))
+}
+
+// PriceLowerLT applies the LT predicate on the "priceLower" field.
+func PriceLowerLT(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLT(FieldPriceLower, v))
+}
+
+// PriceLowerLTE applies the LTE predicate on the "priceLower" field.
+func PriceLowerLTE(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLTE(FieldPriceLower, v))
+}
+
+// PriceLowerContains applies the Contains predicate on the "priceLower" field.
+func PriceLowerContains(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldContains(FieldPriceLower, vc))
+}
+
+// PriceLowerHasPrefix applies the HasPrefix predicate on the "priceLower" field.
+func PriceLowerHasPrefix(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldHasPrefix(FieldPriceLower, vc))
+}
+
+// PriceLowerHasSuffix applies the HasSuffix predicate on the "priceLower" field.
+func PriceLowerHasSuffix(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldHasSuffix(FieldPriceLower, vc))
+}
+
+// PriceLowerEqualFold applies the EqualFold predicate on the "priceLower" field.
+func PriceLowerEqualFold(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldEqualFold(FieldPriceLower, vc))
+}
+
+// PriceLowerContainsFold applies the ContainsFold predicate on the "priceLower" field.
+func PriceLowerContainsFold(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldContainsFold(FieldPriceLower, vc))
+}
+
+// GridNumEQ applies the EQ predicate on the "gridNum" field.
+func GridNumEQ(v int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldGridNum, v))
+}
+
+// GridNumNEQ applies the NEQ predicate on the "gridNum" field.
+func GridNumNEQ(v int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNEQ(FieldGridNum, v))
+}
+
+// GridNumIn applies the In predicate on the "gridNum" field.
+func GridNumIn(vs ...int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIn(FieldGridNum, vs...))
+}
+
+// GridNumNotIn applies the NotIn predicate on the "gridNum" field.
+func GridNumNotIn(vs ...int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotIn(FieldGridNum, vs...))
+}
+
+// GridNumGT applies the GT predicate on the "gridNum" field.
+func GridNumGT(v int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGT(FieldGridNum, v))
+}
+
+// GridNumGTE applies the GTE predicate on the "gridNum" field.
+func GridNumGTE(v int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGTE(FieldGridNum, v))
+}
+
+// GridNumLT applies the LT predicate on the "gridNum" field.
+func GridNumLT(v int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLT(FieldGridNum, v))
+}
+
+// GridNumLTE applies the LTE predicate on the "gridNum" field.
+func GridNumLTE(v int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLTE(FieldGridNum, v))
+}
+
+// LeverageEQ applies the EQ predicate on the "leverage" field.
+func LeverageEQ(v int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldLeverage, v))
+}
+
+// LeverageNEQ applies the NEQ predicate on the "leverage" field.
+func LeverageNEQ(v int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNEQ(FieldLeverage, v))
+}
+
+// LeverageIn applies the In predicate on the "leverage" field.
+func LeverageIn(vs ...int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIn(FieldLeverage, vs...))
+}
+
+// LeverageNotIn applies the NotIn predicate on the "leverage" field.
+func LeverageNotIn(vs ...int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotIn(FieldLeverage, vs...))
+}
+
+// LeverageGT applies the GT predicate on the "leverage" field.
+func LeverageGT(v int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGT(FieldLeverage, v))
+}
+
+// LeverageGTE applies the GTE predicate on the "leverage" field.
+func LeverageGTE(v int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGTE(FieldLeverage, v))
+}
+
+// LeverageLT applies the LT predicate on the "leverage" field.
+func LeverageLT(v int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLT(FieldLeverage, v))
+}
+
+// LeverageLTE applies the LTE predicate on the "leverage" field.
+func LeverageLTE(v int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLTE(FieldLeverage, v))
+}
+
+// InitialOrderSizeEQ applies the EQ predicate on the "initialOrderSize" field.
+func InitialOrderSizeEQ(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldInitialOrderSize, v))
+}
+
+// InitialOrderSizeNEQ applies the NEQ predicate on the "initialOrderSize" field.
+func InitialOrderSizeNEQ(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNEQ(FieldInitialOrderSize, v))
+}
+
+// InitialOrderSizeIn applies the In predicate on the "initialOrderSize" field.
+func InitialOrderSizeIn(vs ...decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIn(FieldInitialOrderSize, vs...))
+}
+
+// InitialOrderSizeNotIn applies the NotIn predicate on the "initialOrderSize" field.
+func InitialOrderSizeNotIn(vs ...decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotIn(FieldInitialOrderSize, vs...))
+}
+
+// InitialOrderSizeGT applies the GT predicate on the "initialOrderSize" field.
+func InitialOrderSizeGT(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGT(FieldInitialOrderSize, v))
+}
+
+// InitialOrderSizeGTE applies the GTE predicate on the "initialOrderSize" field.
+func InitialOrderSizeGTE(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGTE(FieldInitialOrderSize, v))
+}
+
+// InitialOrderSizeLT applies the LT predicate on the "initialOrderSize" field.
+func InitialOrderSizeLT(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLT(FieldInitialOrderSize, v))
+}
+
+// InitialOrderSizeLTE applies the LTE predicate on the "initialOrderSize" field.
+func InitialOrderSizeLTE(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLTE(FieldInitialOrderSize, v))
+}
+
+// InitialOrderSizeContains applies the Contains predicate on the "initialOrderSize" field.
+func InitialOrderSizeContains(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldContains(FieldInitialOrderSize, vc))
+}
+
+// InitialOrderSizeHasPrefix applies the HasPrefix predicate on the "initialOrderSize" field.
+func InitialOrderSizeHasPrefix(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldHasPrefix(FieldInitialOrderSize, vc))
+}
+
+// InitialOrderSizeHasSuffix applies the HasSuffix predicate on the "initialOrderSize" field.
+func InitialOrderSizeHasSuffix(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldHasSuffix(FieldInitialOrderSize, vc))
+}
+
+// InitialOrderSizeEqualFold applies the EqualFold predicate on the "initialOrderSize" field.
+func InitialOrderSizeEqualFold(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldEqualFold(FieldInitialOrderSize, vc))
+}
+
+// InitialOrderSizeContainsFold applies the ContainsFold predicate on the "initialOrderSize" field.
+func InitialOrderSizeContainsFold(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldContainsFold(FieldInitialOrderSize, vc))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StartTimeEQ applies the EQ predicate on the "startTime" field.
+func StartTimeEQ(v time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldStartTime, v))
+}
+
+// StartTimeNEQ applies the NEQ predicate on the "startTime" field.
+func StartTimeNEQ(v time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNEQ(FieldStartTime, v))
+}
+
+// StartTimeIn applies the In predicate on the "startTime" field.
+func StartTimeIn(vs ...time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIn(FieldStartTime, vs...))
+}
+
+// StartTimeNotIn applies the NotIn predicate on the "startTime" field.
+func StartTimeNotIn(vs ...time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotIn(FieldStartTime, vs...))
+}
+
+// StartTimeGT applies the GT predicate on the "startTime" field.
+func StartTimeGT(v time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGT(FieldStartTime, v))
+}
+
+// StartTimeGTE applies the GTE predicate on the "startTime" field.
+func StartTimeGTE(v time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGTE(FieldStartTime, v))
+}
+
+// StartTimeLT applies the LT predicate on the "startTime" field.
+func StartTimeLT(v time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLT(FieldStartTime, v))
+}
+
+// StartTimeLTE applies the LTE predicate on the "startTime" field.
+func StartTimeLTE(v time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLTE(FieldStartTime, v))
+}
+
+// EndTimeEQ applies the EQ predicate on the "endTime" field.
+func EndTimeEQ(v time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldEndTime, v))
+}
+
+// EndTimeNEQ applies the NEQ predicate on the "endTime" field.
+func EndTimeNEQ(v time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNEQ(FieldEndTime, v))
+}
+
+// EndTimeIn applies the In predicate on the "endTime" field.
+func EndTimeIn(vs ...time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIn(FieldEndTime, vs...))
+}
+
+// EndTimeNotIn applies the NotIn predicate on the "endTime" field.
+func EndTimeNotIn(vs ...time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotIn(FieldEndTime, vs...))
+}
+
+// EndTimeGT applies the GT predicate on the "endTime" field.
+func EndTimeGT(v time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGT(FieldEndTime, v))
+}
+
+// EndTimeGTE applies the GTE predicate on the "endTime" field.
+func EndTimeGTE(v time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGTE(FieldEndTime, v))
+}
+
+// EndTimeLT applies the LT predicate on the "endTime" field.
+func EndTimeLT(v time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLT(FieldEndTime, v))
+}
+
+// EndTimeLTE applies the LTE predicate on the "endTime" field.
+func EndTimeLTE(v time.Time) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLTE(FieldEndTime, v))
+}
+
+// EndTimeIsNil applies the IsNil predicate on the "endTime" field.
+func EndTimeIsNil() predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIsNull(FieldEndTime))
+}
+
+// EndTimeNotNil applies the NotNil predicate on the "endTime" field.
+func EndTimeNotNil() predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotNull(FieldEndTime))
+}
+
+// StopReasonEQ applies the EQ predicate on the "stopReason" field.
+func StopReasonEQ(v StopReason) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldStopReason, v))
+}
+
+// StopReasonNEQ applies the NEQ predicate on the "stopReason" field.
+func StopReasonNEQ(v StopReason) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNEQ(FieldStopReason, v))
+}
+
+// StopReasonIn applies the In predicate on the "stopReason" field.
+func StopReasonIn(vs ...StopReason) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIn(FieldStopReason, vs...))
+}
+
+// StopReasonNotIn applies the NotIn predicate on the "stopReason" field.
+func StopReasonNotIn(vs ...StopReason) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotIn(FieldStopReason, vs...))
+}
+
+// StopReasonIsNil applies the IsNil predicate on the "stopReason" field.
+func StopReasonIsNil() predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIsNull(FieldStopReason))
+}
+
+// StopReasonNotNil applies the NotNil predicate on the "stopReason" field.
+func StopReasonNotNil() predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotNull(FieldStopReason))
+}
+
+// MatchedTradesEQ applies the EQ predicate on the "matchedTrades" field.
+func MatchedTradesEQ(v int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldMatchedTrades, v))
+}
+
+// MatchedTradesNEQ applies the NEQ predicate on the "matchedTrades" field.
+func MatchedTradesNEQ(v int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNEQ(FieldMatchedTrades, v))
+}
+
+// MatchedTradesIn applies the In predicate on the "matchedTrades" field.
+func MatchedTradesIn(vs ...int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIn(FieldMatchedTrades, vs...))
+}
+
+// MatchedTradesNotIn applies the NotIn predicate on the "matchedTrades" field.
+func MatchedTradesNotIn(vs ...int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotIn(FieldMatchedTrades, vs...))
+}
+
+// MatchedTradesGT applies the GT predicate on the "matchedTrades" field.
+func MatchedTradesGT(v int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGT(FieldMatchedTrades, v))
+}
+
+// MatchedTradesGTE applies the GTE predicate on the "matchedTrades" field.
+func MatchedTradesGTE(v int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGTE(FieldMatchedTrades, v))
+}
+
+// MatchedTradesLT applies the LT predicate on the "matchedTrades" field.
+func MatchedTradesLT(v int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLT(FieldMatchedTrades, v))
+}
+
+// MatchedTradesLTE applies the LTE predicate on the "matchedTrades" field.
+func MatchedTradesLTE(v int) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLTE(FieldMatchedTrades, v))
+}
+
+// RealizedProfitEQ applies the EQ predicate on the "realizedProfit" field.
+func RealizedProfitEQ(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldRealizedProfit, v))
+}
+
+// RealizedProfitNEQ applies the NEQ predicate on the "realizedProfit" field.
+func RealizedProfitNEQ(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNEQ(FieldRealizedProfit, v))
+}
+
+// RealizedProfitIn applies the In predicate on the "realizedProfit" field.
+func RealizedProfitIn(vs ...decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIn(FieldRealizedProfit, vs...))
+}
+
+// RealizedProfitNotIn applies the NotIn predicate on the "realizedProfit" field.
+func RealizedProfitNotIn(vs ...decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotIn(FieldRealizedProfit, vs...))
+}
+
+// RealizedProfitGT applies the GT predicate on the "realizedProfit" field.
+func RealizedProfitGT(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGT(FieldRealizedProfit, v))
+}
+
+// RealizedProfitGTE applies the GTE predicate on the "realizedProfit" field.
+func RealizedProfitGTE(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGTE(FieldRealizedProfit, v))
+}
+
+// RealizedProfitLT applies the LT predicate on the "realizedProfit" field.
+func RealizedProfitLT(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLT(FieldRealizedProfit, v))
+}
+
+// RealizedProfitLTE applies the LTE predicate on the "realizedProfit" field.
+func RealizedProfitLTE(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLTE(FieldRealizedProfit, v))
+}
+
+// RealizedProfitContains applies the Contains predicate on the "realizedProfit" field.
+func RealizedProfitContains(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldContains(FieldRealizedProfit, vc))
+}
+
+// RealizedProfitHasPrefix applies the HasPrefix predicate on the "realizedProfit" field.
+func RealizedProfitHasPrefix(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldHasPrefix(FieldRealizedProfit, vc))
+}
+
+// RealizedProfitHasSuffix applies the HasSuffix predicate on the "realizedProfit" field.
+func RealizedProfitHasSuffix(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldHasSuffix(FieldRealizedProfit, vc))
+}
+
+// RealizedProfitIsNil applies the IsNil predicate on the "realizedProfit" field.
+func RealizedProfitIsNil() predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIsNull(FieldRealizedProfit))
+}
+
+// RealizedProfitNotNil applies the NotNil predicate on the "realizedProfit" field.
+func RealizedProfitNotNil() predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotNull(FieldRealizedProfit))
+}
+
+// RealizedProfitEqualFold applies the EqualFold predicate on the "realizedProfit" field.
+func RealizedProfitEqualFold(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldEqualFold(FieldRealizedProfit, vc))
+}
+
+// RealizedProfitContainsFold applies the ContainsFold predicate on the "realizedProfit" field.
+func RealizedProfitContainsFold(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldContainsFold(FieldRealizedProfit, vc))
+}
+
+// FeeEQ applies the EQ predicate on the "fee" field.
+func FeeEQ(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldFee, v))
+}
+
+// FeeNEQ applies the NEQ predicate on the "fee" field.
+func FeeNEQ(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNEQ(FieldFee, v))
+}
+
+// FeeIn applies the In predicate on the "fee" field.
+func FeeIn(vs ...decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIn(FieldFee, vs...))
+}
+
+// FeeNotIn applies the NotIn predicate on the "fee" field.
+func FeeNotIn(vs ...decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotIn(FieldFee, vs...))
+}
+
+// FeeGT applies the GT predicate on the "fee" field.
+func FeeGT(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGT(FieldFee, v))
+}
+
+// FeeGTE applies the GTE predicate on the "fee" field.
+func FeeGTE(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGTE(FieldFee, v))
+}
+
+// FeeLT applies the LT predicate on the "fee" field.
+func FeeLT(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLT(FieldFee, v))
+}
+
+// FeeLTE applies the LTE predicate on the "fee" field.
+func FeeLTE(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLTE(FieldFee, v))
+}
+
+// FeeContains applies the Contains predicate on the "fee" field.
+func FeeContains(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldContains(FieldFee, vc))
+}
+
+// FeeHasPrefix applies the HasPrefix predicate on the "fee" field.
+func FeeHasPrefix(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldHasPrefix(FieldFee, vc))
+}
+
+// FeeHasSuffix applies the HasSuffix predicate on the "fee" field.
+func FeeHasSuffix(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldHasSuffix(FieldFee, vc))
+}
+
+// FeeIsNil applies the IsNil predicate on the "fee" field.
+func FeeIsNil() predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIsNull(FieldFee))
+}
+
+// FeeNotNil applies the NotNil predicate on the "fee" field.
+func FeeNotNil() predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotNull(FieldFee))
+}
+
+// FeeEqualFold applies the EqualFold predicate on the "fee" field.
+func FeeEqualFold(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldEqualFold(FieldFee, vc))
+}
+
+// FeeContainsFold applies the ContainsFold predicate on the "fee" field.
+func FeeContainsFold(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldContainsFold(FieldFee, vc))
+}
+
+// FundingEQ applies the EQ predicate on the "funding" field.
+func FundingEQ(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldFunding, v))
+}
+
+// FundingNEQ applies the NEQ predicate on the "funding" field.
+func FundingNEQ(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNEQ(FieldFunding, v))
+}
+
+// FundingIn applies the In predicate on the "funding" field.
+func FundingIn(vs ...decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIn(FieldFunding, vs...))
+}
+
+// FundingNotIn applies the NotIn predicate on the "funding" field.
+func FundingNotIn(vs ...decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotIn(FieldFunding, vs...))
+}
+
+// FundingGT applies the GT predicate on the "funding" field.
+func FundingGT(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGT(FieldFunding, v))
+}
+
+// FundingGTE applies the GTE predicate on the "funding" field.
+func FundingGTE(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGTE(FieldFunding, v))
+}
+
+// FundingLT applies the LT predicate on the "funding" field.
+func FundingLT(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLT(FieldFunding, v))
+}
+
+// FundingLTE applies the LTE predicate on the "funding" field.
+func FundingLTE(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLTE(FieldFunding, v))
+}
+
+// FundingContains applies the Contains predicate on the "funding" field.
+func FundingContains(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldContains(FieldFunding, vc))
+}
+
+// FundingHasPrefix applies the HasPrefix predicate on the "funding" field.
+func FundingHasPrefix(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldHasPrefix(FieldFunding, vc))
+}
+
+// FundingHasSuffix applies the HasSuffix predicate on the "funding" field.
+func FundingHasSuffix(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldHasSuffix(FieldFunding, vc))
+}
+
+// FundingIsNil applies the IsNil predicate on the "funding" field.
+func FundingIsNil() predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIsNull(FieldFunding))
+}
+
+// FundingNotNil applies the NotNil predicate on the "funding" field.
+func FundingNotNil() predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotNull(FieldFunding))
+}
+
+// FundingEqualFold applies the EqualFold predicate on the "funding" field.
+func FundingEqualFold(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldEqualFold(FieldFunding, vc))
+}
+
+// FundingContainsFold applies the ContainsFold predicate on the "funding" field.
+func FundingContainsFold(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldContainsFold(FieldFunding, vc))
+}
+
+// UnrealizedProfitEQ applies the EQ predicate on the "unrealizedProfit" field.
+func UnrealizedProfitEQ(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldUnrealizedProfit, v))
+}
+
+// UnrealizedProfitNEQ applies the NEQ predicate on the "unrealizedProfit" field.
+func UnrealizedProfitNEQ(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNEQ(FieldUnrealizedProfit, v))
+}
+
+// UnrealizedProfitIn applies the In predicate on the "unrealizedProfit" field.
+func UnrealizedProfitIn(vs ...decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIn(FieldUnrealizedProfit, vs...))
+}
+
+// UnrealizedProfitNotIn applies the NotIn predicate on the "unrealizedProfit" field.
+func UnrealizedProfitNotIn(vs ...decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotIn(FieldUnrealizedProfit, vs...))
+}
+
+// UnrealizedProfitGT applies the GT predicate on the "unrealizedProfit" field.
+func UnrealizedProfitGT(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGT(FieldUnrealizedProfit, v))
+}
+
+// UnrealizedProfitGTE applies the GTE predicate on the "unrealizedProfit" field.
+func UnrealizedProfitGTE(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGTE(FieldUnrealizedProfit, v))
+}
+
+// UnrealizedProfitLT applies the LT predicate on the "unrealizedProfit" field.
+func UnrealizedProfitLT(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLT(FieldUnrealizedProfit, v))
+}
+
+// UnrealizedProfitLTE applies the LTE predicate on the "unrealizedProfit" field.
+func UnrealizedProfitLTE(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLTE(FieldUnrealizedProfit, v))
+}
+
+// UnrealizedProfitContains applies the Contains predicate on the "unrealizedProfit" field.
+func UnrealizedProfitContains(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldContains(FieldUnrealizedProfit, vc))
+}
+
+// UnrealizedProfitHasPrefix applies the HasPrefix predicate on the "unrealizedProfit" field.
+func UnrealizedProfitHasPrefix(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldHasPrefix(FieldUnrealizedProfit, vc))
+}
+
+// UnrealizedProfitHasSuffix applies the HasSuffix predicate on the "unrealizedProfit" field.
+func UnrealizedProfitHasSuffix(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldHasSuffix(FieldUnrealizedProfit, vc))
+}
+
+// UnrealizedProfitIsNil applies the IsNil predicate on the "unrealizedProfit" field.
+func UnrealizedProfitIsNil() predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIsNull(FieldUnrealizedProfit))
+}
+
+// UnrealizedProfitNotNil applies the NotNil predicate on the "unrealizedProfit" field.
+func UnrealizedProfitNotNil() predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotNull(FieldUnrealizedProfit))
+}
+
+// UnrealizedProfitEqualFold applies the EqualFold predicate on the "unrealizedProfit" field.
+func UnrealizedProfitEqualFold(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldEqualFold(FieldUnrealizedProfit, vc))
+}
+
+// UnrealizedProfitContainsFold applies the ContainsFold predicate on the "unrealizedProfit" field.
+func UnrealizedProfitContainsFold(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldContainsFold(FieldUnrealizedProfit, vc))
+}
+
+// TotalProfitEQ applies the EQ predicate on the "totalProfit" field.
+func TotalProfitEQ(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldEQ(FieldTotalProfit, v))
+}
+
+// TotalProfitNEQ applies the NEQ predicate on the "totalProfit" field.
+func TotalProfitNEQ(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNEQ(FieldTotalProfit, v))
+}
+
+// TotalProfitIn applies the In predicate on the "totalProfit" field.
+func TotalProfitIn(vs ...decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIn(FieldTotalProfit, vs...))
+}
+
+// TotalProfitNotIn applies the NotIn predicate on the "totalProfit" field.
+func TotalProfitNotIn(vs ...decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotIn(FieldTotalProfit, vs...))
+}
+
+// TotalProfitGT applies the GT predicate on the "totalProfit" field.
+func TotalProfitGT(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGT(FieldTotalProfit, v))
+}
+
+// TotalProfitGTE applies the GTE predicate on the "totalProfit" field.
+func TotalProfitGTE(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldGTE(FieldTotalProfit, v))
+}
+
+// TotalProfitLT applies the LT predicate on the "totalProfit" field.
+func TotalProfitLT(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLT(FieldTotalProfit, v))
+}
+
+// TotalProfitLTE applies the LTE predicate on the "totalProfit" field.
+func TotalProfitLTE(v decimal.Decimal) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldLTE(FieldTotalProfit, v))
+}
+
+// TotalProfitContains applies the Contains predicate on the "totalProfit" field.
+func TotalProfitContains(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldContains(FieldTotalProfit, vc))
+}
+
+// TotalProfitHasPrefix applies the HasPrefix predicate on the "totalProfit" field.
+func TotalProfitHasPrefix(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldHasPrefix(FieldTotalProfit, vc))
+}
+
+// TotalProfitHasSuffix applies the HasSuffix predicate on the "totalProfit" field.
+func TotalProfitHasSuffix(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldHasSuffix(FieldTotalProfit, vc))
+}
+
+// TotalProfitIsNil applies the IsNil predicate on the "totalProfit" field.
+func TotalProfitIsNil() predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldIsNull(FieldTotalProfit))
+}
+
+// TotalProfitNotNil applies the NotNil predicate on the "totalProfit" field.
+func TotalProfitNotNil() predicate.StrategyRun {
+	return predicate.StrategyRun(sql.FieldNotNull(FieldTotalProfit))
+}
+
+// TotalProfitEqualFold applies the EqualFold predicate on the "totalProfit" field.
+func TotalProfitEqualFold(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldEqualFold(FieldTotalProfit, vc))
+}
+
+// TotalProfitContainsFold applies the ContainsFold predicate on the "totalProfit" field.
+func TotalProfitContainsFold(v decimal.Decimal) predicate.StrategyRun {
+	vc := v.String()
+	return predicate.StrategyRun(sql.FieldContainsFold(FieldTotalProfit, vc))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.StrategyRun) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.StrategyRun) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.StrategyRun) predicate.StrategyRun {
+	return predicate.StrategyRun(sql.NotPredicates(p))
+}
@@ -0,0 +1,1933 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategyrun"
+	"github.com/shopspring/decimal"
+)
+
+// StrategyRunCreate is the builder for creating a StrategyRun entity.
+type StrategyRunCreate struct {
+	config
+	mutation *StrategyRunMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *StrategyRunCreate) SetCreateTime(v time.Time) *StrategyRunCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *StrategyRunCreate) SetNillableCreateTime(v *time.Time) *StrategyRunCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *StrategyRunCreate) SetUpdateTime(v time.Time) *StrategyRunCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *StrategyRunCreate) SetNillableUpdateTime(v *time.Time) *StrategyRunCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetStrategyId sets the "strategyId" field.
+func (_c *StrategyRunCreate) SetStrategyId(v string) *StrategyRunCreate {
+	_c.mutation.SetStrategyId(v)
+	return _c
+}
+
+// SetOwner sets the "owner" field.
+func (_c *StrategyRunCreate) SetOwner(v int64) *StrategyRunCreate {
+	_c.mutation.SetOwner(v)
+	return _c
+}
+
+// SetExchange sets the "exchange" field.
+func (_c *StrategyRunCreate) SetExchange(v string) *StrategyRunCreate {
+	_c.mutation.SetExchange(v)
+	return _c
+}
+
+// SetSymbol sets the "symbol" field.
+func (_c *StrategyRunCreate) SetSymbol(v string) *StrategyRunCreate {
+	_c.mutation.SetSymbol(v)
+	return _c
+}
+
+// SetAccount sets the "account" field.
+func (_c *StrategyRunCreate) SetAccount(v string) *StrategyRunCreate {
+	_c.mutation.SetAccount(v)
+	return _c
+}
+
+// SetMode sets the "mode" field.
+func (_c *StrategyRunCreate) SetMode(v strategyrun.Mode) *StrategyRunCreate {
+	_c.mutation.SetMode(v)
+	return _c
+}
+
+// SetPriceUpper sets the "priceUpper" field.
+func (_c *StrategyRunCreate) SetPriceUpper(v decimal.Decimal) *StrategyRunCreate {
+	_c.mutation.SetPriceUpper(v)
+	return _c
+}
+
+// SetPriceLower sets the "priceLower" field.
+func (_c *StrategyRunCreate) SetPriceLower(v decimal.Decimal) *StrategyRunCreate {
+	_c.mutation.SetPriceLower(v)
+	return _c
+}
+
+// SetGridNum sets the "gridNum" field.
+func (_c *StrategyRunCreate) SetGridNum(v int) *StrategyRunCreate {
+	_c.mutation.SetGridNum(v)
+	return _c
+}
+
+// SetLeverage sets the "leverage" field.
+func (_c *StrategyRunCreate) SetLeverage(v int) *StrategyRunCreate {
+	_c.mutation.SetLeverage(v)
+	return _c
+}
+
+// SetInitialOrderSize sets the "initialOrderSize" field.
+func (_c *StrategyRunCreate) SetInitialOrderSize(v decimal.Decimal) *StrategyRunCreate {
+	_c.mutation.SetInitialOrderSize(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *StrategyRunCreate) SetStatus(v strategyrun.Status) *StrategyRunCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *StrategyRunCreate) SetNillableStatus(v *strategyrun.Status) *StrategyRunCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetStartTime sets the "startTime" field.
+func (_c *StrategyRunCreate) SetStartTime(v time.Time) *StrategyRunCreate {
+	_c.mutation.SetStartTime(v)
+	return _c
+}
+
+// SetEndTime sets the "endTime" field.
+func (_c *StrategyRunCreate) SetEndTime(v time.Time) *StrategyRunCreate {
+	_c.mutation.SetEndTime(v)
+	return _c
+}
+
+// SetNillableEndTime sets the "endTime" field if the given value is not nil.
+func (_c *StrategyRunCreate) SetNillableEndTime(v *time.Time) *StrategyRunCreate {
+	if v != nil {
+		_c.SetEndTime(*v)
+	}
+	return _c
+}
+
+// SetStopReason sets the "stopReason" field.
+func (_c *StrategyRunCreate) SetStopReason(v strategyrun.StopReason) *StrategyRunCreate {
+	_c.mutation.SetStopReason(v)
+	return _c
+}
+
+// SetNillableStopReason sets the "stopReason" field if the given value is not nil.
+func (_c *StrategyRunCreate) SetNillableStopReason(v *strategyrun.StopReason) *StrategyRunCreate {
+	if v != nil {
+		_c.SetStopReason(*v)
+	}
+	return _c
+}
+
+// SetMatchedTrades sets the "matchedTrades" field.
+func (_c *StrategyRunCreate) SetMatchedTrades(v int) *StrategyRunCreate {
+	_c.mutation.SetMatchedTrades(v)
+	return _c
+}
+
+// SetNillableMatchedTrades sets the "matchedTrades" field if the given value is not nil.
+func (_c *StrategyRunCreate) SetNillableMatchedTrades(v *int) *StrategyRunCreate {
+	if v != nil {
+		_c.SetMatchedTrades(*v)
+	}
+	return _c
+}
+
+// SetRealizedProfit sets the "realizedProfit" field.
+func (_c *StrategyRunCreate) SetRealizedProfit(v decimal.Decimal) *StrategyRunCreate {
+	_c.mutation.SetRealizedProfit(v)
+	return _c
+}
+
+// SetNillableRealizedProfit sets the "realizedProfit" field if the given value is not nil.
+func (_c *StrategyRunCreate) SetNillableRealizedProfit(v *decimal.Decimal) *StrategyRunCreate {
+	if v != nil {
+		_c.SetRealizedProfit(*v)
+	}
+	return _c
+}
+
+// SetFee sets the "fee" field.
+func (_c *StrategyRunCreate) SetFee(v decimal.Decimal) *StrategyRunCreate {
+	_c.mutation.SetFee(v)
+	return _c
+}
+
+// SetNillableFee sets the "fee" field if the given value is not nil.
+func (_c *StrategyRunCreate) SetNillableFee(v *decimal.Decimal) *StrategyRunCreate {
+	if v != nil {
+		_c.SetFee(*v)
+	}
+	return _c
+}
+
+// SetFunding sets the "funding" field.
+func (_c *StrategyRunCreate) SetFunding(v decimal.Decimal) *StrategyRunCreate {
+	_c.mutation.SetFunding(v)
+	return _c
+}
+
+// SetNillableFunding sets the "funding" field if the given value is not nil.
+func (_c *StrategyRunCreate) SetNillableFunding(v *decimal.Decimal) *StrategyRunCreate {
+	if v != nil {
+		_c.SetFunding(*v)
+	}
+	return _c
+}
+
+// SetUnrealizedProfit sets the "unrealizedProfit" field.
+func (_c *StrategyRunCreate) SetUnrealizedProfit(v decimal.Decimal) *StrategyRunCreate {
+	_c.mutation.SetUnrealizedProfit(v)
+	return _c
+}
+
+// SetNillableUnrealizedProfit sets the "unrealizedProfit" field if the given value is not nil.
+func (_c *StrategyRunCreate) SetNillableUnrealizedProfit(v *decimal.Decimal) *StrategyRunCreate {
+	if v != nil {
+		_c.SetUnrealizedProfit(*v)
+	}
+	return _c
+}
+
+// SetTotalProfit sets the "totalProfit" field.
+func (_c *StrategyRunCreate) SetTotalProfit(v decimal.Decimal) *StrategyRunCreate {
+	_c.mutation.SetTotalProfit(v)
+	return _c
+}
+
+// SetNillableTotalProfit sets the "totalProfit" field if the given value is not nil.
+func (_c *StrategyRunCreate) SetNillableTotalProfit(v *decimal.Decimal) *StrategyRunCreate {
+	if v != nil {
+		_c.SetTotalProfit(*v)
+	}
+	return _c
+}
+
+// Mutation returns the StrategyRunMutation object of the builder.
+func (_c *StrategyRunCreate) Mutation() *StrategyRunMutation {
+	return _c.mutation
+}
+
+// Save creates the StrategyRun in the database.
+func (_c *StrategyRunCreate) Save(ctx context.Context) (*StrategyRun, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *StrategyRunCreate) SaveX(ctx context.Context) *StrategyRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StrategyRunCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StrategyRunCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *StrategyRunCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := strategyrun.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := strategyrun.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := strategyrun.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.MatchedTrades(); !ok {
+		v := strategyrun.DefaultMatchedTrades
+		_c.mutation.SetMatchedTrades(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *StrategyRunCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "StrategyRun.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "StrategyRun.update_time"`)}
+	}
+	if _, ok := _c.mutation.StrategyId(); !ok {
+		return &ValidationError{Name: "strategyId", err: errors.New(`ent: missing required field "StrategyRun.strategyId"`)}
+	}
+	if v, ok := _c.mutation.StrategyId(); ok {
+		if err := strategyrun.StrategyIdValidator(v); err != nil {
+			return &ValidationError{Name: "strategyId", err: fmt.Errorf(`ent: validator failed for field "StrategyRun.strategyId": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Owner(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required field "StrategyRun.owner"`)}
+	}
+	if _, ok := _c.mutation.Exchange(); !ok {
+		return &ValidationError{Name: "exchange", err: errors.New(`ent: missing required field "StrategyRun.exchange"`)}
+	}
+	if v, ok := _c.mutation.Exchange(); ok {
+		if err := strategyrun.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "StrategyRun.exchange": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Symbol(); !ok {
+		return &ValidationError{Name: "symbol", err: errors.New(`ent: missing required field "StrategyRun.symbol"`)}
+	}
+	if v, ok := _c.mutation.Symbol(); ok {
+		if err := strategyrun.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "StrategyRun.symbol": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Account(); !ok {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required field "StrategyRun.account"`)}
+	}
+	if _, ok := _c.mutation.Mode(); !ok {
+		return &ValidationError{Name: "mode", err: errors.New(`ent: missing required field "StrategyRun.mode"`)}
+	}
+	if v, ok := _c.mutation.Mode(); ok {
+		if err := strategyrun.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "StrategyRun.mode": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PriceUpper(); !ok {
+		return &ValidationError{Name: "priceUpper", err: errors.New(`ent: missing required field "StrategyRun.priceUpper"`)}
+	}
+	if _, ok := _c.mutation.PriceLower(); !ok {
+		return &ValidationError{Name: "priceLower", err: errors.New(`ent: missing required field "StrategyRun.priceLower"`)}
+	}
+	if _, ok := _c.mutation.GridNum(); !ok {
+		return &ValidationError{Name: "gridNum", err: errors.New(`ent: missing required field "StrategyRun.gridNum"`)}
+	}
+	if _, ok := _c.mutation.Leverage(); !ok {
+		return &ValidationError{Name: "leverage", err: errors.New(`ent: missing required field "StrategyRun.leverage"`)}
+	}
+	if _, ok := _c.mutation.InitialOrderSize(); !ok {
+		return &ValidationError{Name: "initialOrderSize", err: errors.New(`ent: missing required field "StrategyRun.initialOrderSize"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "StrategyRun.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := strategyrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "StrategyRun.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StartTime(); !ok {
+		return &ValidationError{Name: "startTime", err: errors.New(`ent: missing required field "StrategyRun.startTime"`)}
+	}
+	if v, ok := _c.mutation.StopReason(); ok {
+		if err := strategyrun.StopReasonValidator(v); err != nil {
+			return &ValidationError{Name: "stopReason", err: fmt.Errorf(`ent: validator failed for field "StrategyRun.stopReason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MatchedTrades(); !ok {
+		return &ValidationError{Name: "matchedTrades", err: errors.New(`ent: missing required field "StrategyRun.matchedTrades"`)}
+	}
+	return nil
+}
+
+func (_c *StrategyRunCreate) sqlSave(ctx context.Context) (*StrategyRun, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *StrategyRunCreate) createSpec() (*StrategyRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StrategyRun{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(strategyrun.Table, sqlgraph.NewFieldSpec(strategyrun.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(strategyrun.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(strategyrun.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.StrategyId(); ok {
+		_spec.SetField(strategyrun.FieldStrategyId, field.TypeString, value)
+		_node.StrategyId = value
+	}
+	if value, ok := _c.mutation.Owner(); ok {
+		_spec.SetField(strategyrun.FieldOwner, field.TypeInt64, value)
+		_node.Owner = value
+	}
+	if value, ok := _c.mutation.Exchange(); ok {
+		_spec.SetField(strategyrun.FieldExchange, field.TypeString, value)
+		_node.Exchange = value
+	}
+	if value, ok := _c.mutation.Symbol(); ok {
+		_spec.SetField(strategyrun.FieldSymbol, field.TypeString, value)
+		_node.Symbol = value
+	}
+	if value, ok := _c.mutation.Account(); ok {
+		_spec.SetField(strategyrun.FieldAccount, field.TypeString, value)
+		_node.Account = value
+	}
+	if value, ok := _c.mutation.Mode(); ok {
+		_spec.SetField(strategyrun.FieldMode, field.TypeEnum, value)
+		_node.Mode = value
+	}
+	if value, ok := _c.mutation.PriceUpper(); ok {
+		_spec.SetField(strategyrun.FieldPriceUpper, field.TypeString, value)
+		_node.PriceUpper = value
+	}
+	if value, ok := _c.mutation.PriceLower(); ok {
+		_spec.SetField(strategyrun.FieldPriceLower, field.TypeString, value)
+		_node.PriceLower = value
+	}
+	if value, ok := _c.mutation.GridNum(); ok {
+		_spec.SetField(strategyrun.FieldGridNum, field.TypeInt, value)
+		_node.GridNum = value
+	}
+	if value, ok := _c.mutation.Leverage(); ok {
+		_spec.SetField(strategyrun.FieldLeverage, field.TypeInt, value)
+		_node.Leverage = value
+	}
+	if value, ok := _c.mutation.InitialOrderSize(); ok {
+		_spec.SetField(strategyrun.FieldInitialOrderSize, field.TypeString, value)
+		_node.InitialOrderSize = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(strategyrun.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.StartTime(); ok {
+		_spec.SetField(strategyrun.FieldStartTime, field.TypeTime, value)
+		_node.StartTime = value
+	}
+	if value, ok := _c.mutation.EndTime(); ok {
+		_spec.SetField(strategyrun.FieldEndTime, field.TypeTime, value)
+		_node.EndTime = &value
+	}
+	if value, ok := _c.mutation.StopReason(); ok {
+		_spec.SetField(strategyrun.FieldStopReason, field.TypeEnum, value)
+		_node.StopReason = &value
+	}
+	if value, ok := _c.mutation.MatchedTrades(); ok {
+		_spec.SetField(strategyrun.FieldMatchedTrades, field.TypeInt, value)
+		_node.MatchedTrades = value
+	}
+	if value, ok := _c.mutation.RealizedProfit(); ok {
+		_spec.SetField(strategyrun.FieldRealizedProfit, field.TypeString, value)
+		_node.RealizedProfit = &value
+	}
+	if value, ok := _c.mutation.Fee(); ok {
+		_spec.SetField(strategyrun.FieldFee, field.TypeString, value)
+		_node.Fee = &value
+	}
+	if value, ok := _c.mutation.Funding(); ok {
+		_spec.SetField(strategyrun.FieldFunding, field.TypeString, value)
+		_node.Funding = &value
+	}
+	if value, ok := _c.mutation.UnrealizedProfit(); ok {
+		_spec.SetField(strategyrun.FieldUnrealizedProfit, field.TypeString, value)
+		_node.UnrealizedProfit = &value
+	}
+	if value, ok := _c.mutation.TotalProfit(); ok {
+		_spec.SetField(strategyrun.FieldTotalProfit, field.TypeString, value)
+		_node.TotalProfit = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.StrategyRun.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.StrategyRunUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *StrategyRunCreate) OnConflict(opts ...sql.ConflictOption) *StrategyRunUpsertOne {
+	_c.conflict = opts
+	return &StrategyRunUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.StrategyRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *StrategyRunCreate) OnConflictColumns(columns ...string) *StrategyRunUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &StrategyRunUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// StrategyRunUpsertOne is the builder for "upsert"-ing
+	//  one StrategyRun node.
+	StrategyRunUpsertOne struct {
+		create *StrategyRunCreate
+	}
+
+	// StrategyRunUpsert is the "OnConflict" setter.
+	StrategyRunUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *StrategyRunUpsert) SetUpdateTime(v time.Time) *StrategyRunUpsert {
+	u.Set(strategyrun.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *StrategyRunUpsert) UpdateUpdateTime() *StrategyRunUpsert {
+	u.SetExcluded(strategyrun.FieldUpdateTime)
+	return u
+}
+
+// SetStrategyId sets the "strategyId" field.
+func (u *StrategyRunUpsert) SetStrategyId(v string) *StrategyRunUpsert {
+	u.Set(strategyrun.FieldStrategyId, v)
+	return u
+}
+
+// UpdateStrategyId sets the "strategyId" field to the value that was provided on create.
+func (u *StrategyRunUpsert) UpdateStrategyId() *StrategyRunUpsert {
+	u.SetExcluded(strategyrun.FieldStrategyId)
+	return u
+}
+
+// SetOwner sets the "owner" field.
+func (u *StrategyRunUpsert) SetOwner(v int64) *StrategyRunUpsert {
+	u.Set(strategyrun.FieldOwner, v)
+	return u
+}
+
+// UpdateOwner sets the "owner" field to the value that was provided on create.
+func (u *StrategyRunUpsert) UpdateOwner() *StrategyRunUpsert {
+	u.SetExcluded(strategyrun.FieldOwner)
+	return u
+}
+
+// AddOwner adds v to the "owner" field.
+func (u *StrategyRunUpsert) AddOwner(v int64) *StrategyRunUpsert {
+	u.Add(strategyrun.FieldOwner, v)
+	return u
+}
+
+// SetExchange sets the "exchange" field.
+func (u *StrategyRunUpsert) SetExchange(v string) *StrategyRunUpsert {
+	u.Set(strategyrun.FieldExchange, v)
+	return u
+}
+
+// UpdateExchange sets the "exchange" field to the value that was provided on create.
+func (u *StrategyRunUpsert) UpdateExchange() *StrategyRunUpsert {
+	u.SetExcluded(strategyrun.FieldExchange)
+	return u
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *StrategyRunUpsert) SetSymbol(v string) *StrategyRunUpsert {
+	u.Set(strategyrun.FieldSymbol, v)
+	return u
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *StrategyRunUpsert) UpdateSymbol() *StrategyRunUpsert {
+	u.SetExcluded(strategyrun.FieldSymbol)
+	return u
+}
+
+// SetAccount sets the "account" field.
+func (u *StrategyRunUpsert) SetAccount(v string) *StrategyRunUpsert {
+	u.Set(strategyrun.FieldAccount, v)
+	return u
+}
+
+// UpdateAccount sets the "account" field to the value that was provided on create.
+func (u *StrategyRunUpsert) UpdateAccount() *StrategyRunUpsert {
+	u.SetExcluded(strategyrun.FieldAccount)
+	return u
+}
+
+// SetMode sets the "mode" field.
+func (u *StrategyRunUpsert) SetMode(v strategyrun.Mode) *StrategyRunUpsert {
+	u.Set(strategyrun.FieldMode, v)
+	return u
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *StrategyRunUpsert) UpdateMode() *StrategyRunUpsert {
+	u.SetExcluded(strategyrun.FieldMode)
+	return u
+}
+
+// SetPriceUpper sets the "priceUpper" field.
+func (u *StrategyRunUpsert) SetPriceUpper(v decimal.Decimal) *StrategyRunUpsert {
+	u.Set(strategyrun.FieldPriceUpper, v)
+	return u
+}
+
+// UpdatePriceUpper sets the "priceUpper" field to the value that was provided on create.
+func (u *StrategyRunUpsert) UpdatePriceUpper() *StrategyRunUpsert {
+	u.SetExcluded(strategyrun.FieldPriceUpper)
+	return u
+}
+
+// SetPriceLower sets the "priceLower" field.
+func (u *StrategyRunUpsert) SetPriceLower(v decimal.Decimal) *StrategyRunUpsert {
+	u.Set(strategyrun.FieldPriceLower, v)
+	return u
+}
+
+// UpdatePriceLower sets the "priceLower" field to the value that was provided on create.
+func (u *StrategyRunUpsert) UpdatePriceLower() *StrategyRunUpsert {
+	u.SetExcluded(strategyrun.FieldPriceLower)
+	return u
+}
+
+// SetGridNum sets the "gridNum" field.
+func (u *StrategyRunUpsert) SetGridNum(v int) *StrategyRunUpsert {
+	u.Set(strategyrun.FieldGridNum, v)
+	return u
+}
+
+// UpdateGridNum sets the "gridNum" field to the value that was provided on create.
+func (u *StrategyRunUpsert) UpdateGridNum() *StrategyRunUpsert {
+	u.SetExcluded(strategyrun.FieldGridNum)
+	return u
+}
+
+// AddGridNum adds v to the "gridNum" field.
+func (u *StrategyRunUpsert) AddGridNum(v int) *StrategyRunUpsert {
+	u.Add(strategyrun.FieldGridNum, v)
+	return u
+}
+
+// SetLeverage sets the "leverage" field.
+func (u *StrategyRunUpsert) SetLeverage(v int) *StrategyRunUpsert {
+	u.Set(strategyrun.FieldLeverage, v)
+	return u
+}
+
+// UpdateLeverage sets the "leverage" field to the value that was provided on create.
+func (u *StrategyRunUpsert) UpdateLeverage() *StrategyRunUpsert {
+	u.SetExcluded(strategyrun.FieldLeverage)
+	return u
+}
+
+// AddLeverage adds v to the "leverage" field.
+func (u *StrategyRunUpsert) AddLeverage(v int) *StrategyRunUpsert {
+	u.Add(strategyrun.FieldLeverage, v)
+	return u
+}
+
+// SetInitialOrderSize sets the "initialOrderSize" field.
+func (u *StrategyRunUpsert) SetInitialOrderSize(v decimal.Decimal) *StrategyRunUpsert {
+	u.Set(strategyrun.FieldInitialOrderSize, v)
+	return u
+}
+
+// UpdateInitialOrderSize sets the "initialOrderSize" field to the value that was provided on create.
+func (u *StrategyRunUpsert) UpdateInitialOrderSize() *StrategyRunUpsert {
+	u.SetExcluded(strategyrun.FieldInitialOrderSize)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *StrategyRunUpsert) SetStatus(v strategyrun.Status) *StrategyRunUpsert {
+	u.Set(strategyrun.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *StrategyRunUpsert) UpdateStatus() *StrategyRunUpsert {
+	u.SetExcluded(strategyrun.FieldStatus)
+	return u
+}
+
+// SetStartTime sets the "startTime" field.
+func (u *StrategyRunUpsert) SetStartTime(v time.Time) *StrategyRunUpsert {
+	u.Set(strategyrun.FieldStartTime, v)
+	return u
+}
+
+// UpdateStartTime sets the "startTime" field to the value that was provided on create.
+func (u *StrategyRunUpsert) UpdateStartTime() *StrategyRunUpsert {
+	u.SetExcluded(strategyrun.FieldStartTime)
+	return u
+}
+
+// SetEndTime sets the "endTime" field.
+func (u *StrategyRunUpsert) SetEndTime(v time.Time) *StrategyRunUpsert {
+	u.Set(strategyrun.FieldEndTime, v)
+	return u
+}
+
+// UpdateEndTime sets the "endTime" field to the value that was provided on create.
+func (u *StrategyRunUpsert) UpdateEndTime() *StrategyRunUpsert {
+	u.SetExcluded(strategyrun.FieldEndTime)
+	return u
+}
+
+// ClearEndTime clears the value of the "endTime" field.
+func (u *StrategyRunUpsert) ClearEndTime() *StrategyRunUpsert {
+	u.SetNull(strategyrun.FieldEndTime)
+	return u
+}
+
+// SetStopReason sets the "stopReason" field.
+func (u *StrategyRunUpsert) SetStopReason(v strategyrun.StopReason) *StrategyRunUpsert {
+	u.Set(strategyrun.FieldStopReason, v)
+	return u
+}
+
+// UpdateStopReason sets the "stopReason" field to the value that was provided on create.
+func (u *StrategyRunUpsert) UpdateStopReason() *StrategyRunUpsert {
+	u.SetExcluded(strategyrun.FieldStopReason)
+	return u
+}
+
+// ClearStopReason clears the value of the "stopReason" field.
+func (u *StrategyRunUpsert) ClearStopReason() *StrategyRunUpsert {
+	u.SetNull(strategyrun.FieldStopReason)
+	return u
+}
+
+// SetMatchedTrades sets the "matchedTrades" field.
+func (u *StrategyRunUpsert) SetMatchedTrades(v int) *StrategyRunUpsert {
+	u.Set(strategyrun.FieldMatchedTrades, v)
+	return u
+}
+
+// UpdateMatchedTrades sets the "matchedTrades" field to the value that was provided on create.
+func (u *StrategyRunUpsert) UpdateMatchedTrades() *StrategyRunUpsert {
+	u.SetExcluded(strategyrun.FieldMatchedTrades)
+	return u
+}
+
+// AddMatchedTrades adds v to the "matchedTrades" field.
+func (u *StrategyRunUpsert) AddMatchedTrades(v int) *StrategyRunUpsert {
+	u.Add(strategyrun.FieldMatchedTrades, v)
+	return u
+}
+
+// SetRealizedProfit sets the "realizedProfit" field.
+func (u *StrategyRunUpsert) SetRealizedProfit(v decimal.Decimal) *StrategyRunUpsert {
+	u.Set(strategyrun.FieldRealizedProfit, v)
+	return u
+}
+
+// UpdateRealizedProfit sets the "realizedProfit" field to the value that was provided on create.
+func (u *StrategyRunUpsert) UpdateRealizedProfit() *StrategyRunUpsert {
+	u.SetExcluded(strategyrun.FieldRealizedProfit)
+	return u
+}
+
+// ClearRealizedProfit clears the value of the "realizedProfit" field.
+func (u *StrategyRunUpsert) ClearRealizedProfit() *StrategyRunUpsert {
+	u.SetNull(strategyrun.FieldRealizedProfit)
+	return u
+}
+
+// SetFee sets the "fee" field.
+func (u *StrategyRunUpsert) SetFee(v decimal.Decimal) *StrategyRunUpsert {
+	u.Set(strategyrun.FieldFee, v)
+	return u
+}
+
+// UpdateFee sets the "fee" field to the value that was provided on create.
+func (u *StrategyRunUpsert) UpdateFee() *StrategyRunUpsert {
+	u.SetExcluded(strategyrun.FieldFee)
+	return u
+}
+
+// ClearFee clears the value of the "fee" field.
+func (u *StrategyRunUpsert) ClearFee() *StrategyRunUpsert {
+	u.SetNull(strategyrun.FieldFee)
+	return u
+}
+
+// SetFunding sets the "funding" field.
+func (u *StrategyRunUpsert) SetFunding(v decimal.Decimal) *StrategyRunUpsert {
+	u.Set(strategyrun.FieldFunding, v)
+	return u
+}
+
+// UpdateFunding sets the "funding" field to the value that was provided on create.
+func (u *StrategyRunUpsert) UpdateFunding() *StrategyRunUpsert {
+	u.SetExcluded(strategyrun.FieldFunding)
+	return u
+}
+
+// ClearFunding clears the value of the "funding" field.
+func (u *StrategyRunUpsert) ClearFunding() *StrategyRunUpsert {
+	u.SetNull(strategyrun.FieldFunding)
+	return u
+}
+
+// SetUnrealizedProfit sets the "unrealizedProfit" field.
+func (u *StrategyRunUpsert) SetUnrealizedProfit(v decimal.Decimal) *StrategyRunUpsert {
+	u.Set(strategyrun.FieldUnrealizedProfit, v)
+	return u
+}
+
+// UpdateUnrealizedProfit sets the "unrealizedProfit" field to the value that was provided on create.
+func (u *StrategyRunUpsert) UpdateUnrealizedProfit() *StrategyRunUpsert {
+	u.SetExcluded(strategyrun.FieldUnrealizedProfit)
+	return u
+}
+
+// ClearUnrealizedProfit clears the value of the "unrealizedProfit" field.
+func (u *StrategyRunUpsert) ClearUnrealizedProfit() *StrategyRunUpsert {
+	u.SetNull(strategyrun.FieldUnrealizedProfit)
+	return u
+}
+
+// SetTotalProfit sets the "totalProfit" field.
+func (u *StrategyRunUpsert) SetTotalProfit(v decimal.Decimal) *StrategyRunUpsert {
+	u.Set(strategyrun.FieldTotalProfit, v)
+	return u
+}
+
+// UpdateTotalProfit sets the "totalProfit" field to the value that was provided on create.
+func (u *StrategyRunUpsert) UpdateTotalProfit() *StrategyRunUpsert {
+	u.SetExcluded(strategyrun.FieldTotalProfit)
+	return u
+}
+
+// ClearTotalProfit clears the value of the "totalProfit" field.
+func (u *StrategyRunUpsert) ClearTotalProfit() *StrategyRunUpsert {
+	u.SetNull(strategyrun.FieldTotalProfit)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.StrategyRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *StrategyRunUpsertOne) UpdateNewValues() *StrategyRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(strategyrun.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.StrategyRun.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *StrategyRunUpsertOne) Ignore() *StrategyRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *StrategyRunUpsertOne) DoNothing() *StrategyRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the StrategyRunCreate.OnConflict
+// documentation for more info.
+func (u *StrategyRunUpsertOne) Update(set func(*StrategyRunUpsert)) *StrategyRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&StrategyRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *StrategyRunUpsertOne) SetUpdateTime(v time.Time) *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *StrategyRunUpsertOne) UpdateUpdateTime() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetStrategyId sets the "strategyId" field.
+func (u *StrategyRunUpsertOne) SetStrategyId(v string) *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetStrategyId(v)
+	})
+}
+
+// UpdateStrategyId sets the "strategyId" field to the value that was provided on create.
+func (u *StrategyRunUpsertOne) UpdateStrategyId() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateStrategyId()
+	})
+}
+
+// SetOwner sets the "owner" field.
+func (u *StrategyRunUpsertOne) SetOwner(v int64) *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetOwner(v)
+	})
+}
+
+// AddOwner adds v to the "owner" field.
+func (u *StrategyRunUpsertOne) AddOwner(v int64) *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.AddOwner(v)
+	})
+}
+
+// UpdateOwner sets the "owner" field to the value that was provided on create.
+func (u *StrategyRunUpsertOne) UpdateOwner() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateOwner()
+	})
+}
+
+// SetExchange sets the "exchange" field.
+func (u *StrategyRunUpsertOne) SetExchange(v string) *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetExchange(v)
+	})
+}
+
+// UpdateExchange sets the "exchange" field to the value that was provided on create.
+func (u *StrategyRunUpsertOne) UpdateExchange() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateExchange()
+	})
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *StrategyRunUpsertOne) SetSymbol(v string) *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetSymbol(v)
+	})
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *StrategyRunUpsertOne) UpdateSymbol() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateSymbol()
+	})
+}
+
+// SetAccount sets the "account" field.
+func (u *StrategyRunUpsertOne) SetAccount(v string) *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetAccount(v)
+	})
+}
+
+// UpdateAccount sets the "account" field to the value that was provided on create.
+func (u *StrategyRunUpsertOne) UpdateAccount() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateAccount()
+	})
+}
+
+// SetMode sets the "mode" field.
+func (u *StrategyRunUpsertOne) SetMode(v strategyrun.Mode) *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetMode(v)
+	})
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *StrategyRunUpsertOne) UpdateMode() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateMode()
+	})
+}
+
+// SetPriceUpper sets the "priceUpper" field.
+func (u *StrategyRunUpsertOne) SetPriceUpper(v decimal.Decimal) *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetPriceUpper(v)
+	})
+}
+
+// UpdatePriceUpper sets the "priceUpper" field to the value that was provided on create.
+func (u *StrategyRunUpsertOne) UpdatePriceUpper() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdatePriceUpper()
+	})
+}
+
+// SetPriceLower sets the "priceLower" field.
+func (u *StrategyRunUpsertOne) SetPriceLower(v decimal.Decimal) *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetPriceLower(v)
+	})
+}
+
+// UpdatePriceLower sets the "priceLower" field to the value that was provided on create.
+func (u *StrategyRunUpsertOne) UpdatePriceLower() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdatePriceLower()
+	})
+}
+
+// SetGridNum sets the "gridNum" field.
+func (u *StrategyRunUpsertOne) SetGridNum(v int) *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetGridNum(v)
+	})
+}
+
+// AddGridNum adds v to the "gridNum" field.
+func (u *StrategyRunUpsertOne) AddGridNum(v int) *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.AddGridNum(v)
+	})
+}
+
+// UpdateGridNum sets the "gridNum" field to the value that was provided on create.
+func (u *StrategyRunUpsertOne) UpdateGridNum() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateGridNum()
+	})
+}
+
+// SetLeverage sets the "leverage" field.
+func (u *StrategyRunUpsertOne) SetLeverage(v int) *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetLeverage(v)
+	})
+}
+
+// AddLeverage adds v to the "leverage" field.
+func (u *StrategyRunUpsertOne) AddLeverage(v int) *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.AddLeverage(v)
+	})
+}
+
+// UpdateLeverage sets the "leverage" field to the value that was provided on create.
+func (u *StrategyRunUpsertOne) UpdateLeverage() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateLeverage()
+	})
+}
+
+// SetInitialOrderSize sets the "initialOrderSize" field.
+func (u *StrategyRunUpsertOne) SetInitialOrderSize(v decimal.Decimal) *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetInitialOrderSize(v)
+	})
+}
+
+// UpdateInitialOrderSize sets the "initialOrderSize" field to the value that was provided on create.
+func (u *StrategyRunUpsertOne) UpdateInitialOrderSize() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateInitialOrderSize()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *StrategyRunUpsertOne) SetStatus(v strategyrun.Status) *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *StrategyRunUpsertOne) UpdateStatus() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetStartTime sets the "startTime" field.
+func (u *StrategyRunUpsertOne) SetStartTime(v time.Time) *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetStartTime(v)
+	})
+}
+
+// UpdateStartTime sets the "startTime" field to the value that was provided on create.
+func (u *StrategyRunUpsertOne) UpdateStartTime() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateStartTime()
+	})
+}
+
+// SetEndTime sets the "endTime" field.
+func (u *StrategyRunUpsertOne) SetEndTime(v time.Time) *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetEndTime(v)
+	})
+}
+
+// UpdateEndTime sets the "endTime" field to the value that was provided on create.
+func (u *StrategyRunUpsertOne) UpdateEndTime() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateEndTime()
+	})
+}
+
+// ClearEndTime clears the value of the "endTime" field.
+func (u *StrategyRunUpsertOne) ClearEndTime() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.ClearEndTime()
+	})
+}
+
+// SetStopReason sets the "stopReason" field.
+func (u *StrategyRunUpsertOne) SetStopReason(v strategyrun.StopReason) *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetStopReason(v)
+	})
+}
+
+// UpdateStopReason sets the "stopReason" field to the value that was provided on create.
+func (u *StrategyRunUpsertOne) UpdateStopReason() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateStopReason()
+	})
+}
+
+// ClearStopReason clears the value of the "stopReason" field.
+func (u *StrategyRunUpsertOne) ClearStopReason() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.ClearStopReason()
+	})
+}
+
+// SetMatchedTrades sets the "matchedTrades" field.
+func (u *StrategyRunUpsertOne) SetMatchedTrades(v int) *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetMatchedTrades(v)
+	})
+}
+
+// AddMatchedTrades adds v to the "matchedTrades" field.
+func (u *StrategyRunUpsertOne) AddMatchedTrades(v int) *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.AddMatchedTrades(v)
+	})
+}
+
+// UpdateMatchedTrades sets the "matchedTrades" field to the value that was provided on create.
+func (u *StrategyRunUpsertOne) UpdateMatchedTrades() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateMatchedTrades()
+	})
+}
+
+// SetRealizedProfit sets the "realizedProfit" field.
+func (u *StrategyRunUpsertOne) SetRealizedProfit(v decimal.Decimal) *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetRealizedProfit(v)
+	})
+}
+
+// UpdateRealizedProfit sets the "realizedProfit" field to the value that was provided on create.
+func (u *StrategyRunUpsertOne) UpdateRealizedProfit() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateRealizedProfit()
+	})
+}
+
+// ClearRealizedProfit clears the value of the "realizedProfit" field.
+func (u *StrategyRunUpsertOne) ClearRealizedProfit() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.ClearRealizedProfit()
+	})
+}
+
+// SetFee sets the "fee" field.
+func (u *StrategyRunUpsertOne) SetFee(v decimal.Decimal) *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetFee(v)
+	})
+}
+
+// UpdateFee sets the "fee" field to the value that was provided on create.
+func (u *StrategyRunUpsertOne) UpdateFee() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateFee()
+	})
+}
+
+// ClearFee clears the value of the "fee" field.
+func (u *StrategyRunUpsertOne) ClearFee() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.ClearFee()
+	})
+}
+
+// SetFunding sets the "funding" field.
+func (u *StrategyRunUpsertOne) SetFunding(v decimal.Decimal) *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetFunding(v)
+	})
+}
+
+// UpdateFunding sets the "funding" field to the value that was provided on create.
+func (u *StrategyRunUpsertOne) UpdateFunding() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateFunding()
+	})
+}
+
+// ClearFunding clears the value of the "funding" field.
+func (u *StrategyRunUpsertOne) ClearFunding() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.ClearFunding()
+	})
+}
+
+// SetUnrealizedProfit sets the "unrealizedProfit" field.
+func (u *StrategyRunUpsertOne) SetUnrealizedProfit(v decimal.Decimal) *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetUnrealizedProfit(v)
+	})
+}
+
+// UpdateUnrealizedProfit sets the "unrealizedProfit" field to the value that was provided on create.
+func (u *StrategyRunUpsertOne) UpdateUnrealizedProfit() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateUnrealizedProfit()
+	})
+}
+
+// ClearUnrealizedProfit clears the value of the "unrealizedProfit" field.
+func (u *StrategyRunUpsertOne) ClearUnrealizedProfit() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.ClearUnrealizedProfit()
+	})
+}
+
+// SetTotalProfit sets the "totalProfit" field.
+func (u *StrategyRunUpsertOne) SetTotalProfit(v decimal.Decimal) *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetTotalProfit(v)
+	})
+}
+
+// UpdateTotalProfit sets the "totalProfit" field to the value that was provided on create.
+func (u *StrategyRunUpsertOne) UpdateTotalProfit() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateTotalProfit()
+	})
+}
+
+// ClearTotalProfit clears the value of the "totalProfit" field.
+func (u *StrategyRunUpsertOne) ClearTotalProfit() *StrategyRunUpsertOne {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.ClearTotalProfit()
+	})
+}
+
+// Exec executes the query.
+func (u *StrategyRunUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for StrategyRunCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *StrategyRunUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *StrategyRunUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *StrategyRunUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// StrategyRunCreateBulk is the builder for creating many StrategyRun entities in bulk.
+type StrategyRunCreateBulk struct {
+	config
+	err      error
+	builders []*StrategyRunCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the StrategyRun entities in the database.
+func (_c *StrategyRunCreateBulk) Save(ctx context.Context) ([]*StrategyRun, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*StrategyRun, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StrategyRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *StrategyRunCreateBulk) SaveX(ctx context.Context) []*StrategyRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StrategyRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StrategyRunCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.StrategyRun.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.StrategyRunUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *StrategyRunCreateBulk) OnConflict(opts ...sql.ConflictOption) *StrategyRunUpsertBulk {
+	_c.conflict = opts
+	return &StrategyRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.StrategyRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *StrategyRunCreateBulk) OnConflictColumns(columns ...string) *StrategyRunUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &StrategyRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// StrategyRunUpsertBulk is the builder for "upsert"-ing
+// a bulk of StrategyRun nodes.
+type StrategyRunUpsertBulk struct {
+	create *StrategyRunCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.StrategyRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *StrategyRunUpsertBulk) UpdateNewValues() *StrategyRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(strategyrun.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.StrategyRun.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *StrategyRunUpsertBulk) Ignore() *StrategyRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *StrategyRunUpsertBulk) DoNothing() *StrategyRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the StrategyRunCreateBulk.OnConflict
+// documentation for more info.
+func (u *StrategyRunUpsertBulk) Update(set func(*StrategyRunUpsert)) *StrategyRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&StrategyRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *StrategyRunUpsertBulk) SetUpdateTime(v time.Time) *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *StrategyRunUpsertBulk) UpdateUpdateTime() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetStrategyId sets the "strategyId" field.
+func (u *StrategyRunUpsertBulk) SetStrategyId(v string) *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetStrategyId(v)
+	})
+}
+
+// UpdateStrategyId sets the "strategyId" field to the value that was provided on create.
+func (u *StrategyRunUpsertBulk) UpdateStrategyId() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateStrategyId()
+	})
+}
+
+// SetOwner sets the "owner" field.
+func (u *StrategyRunUpsertBulk) SetOwner(v int64) *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetOwner(v)
+	})
+}
+
+// AddOwner adds v to the "owner" field.
+func (u *StrategyRunUpsertBulk) AddOwner(v int64) *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.AddOwner(v)
+	})
+}
+
+// UpdateOwner sets the "owner" field to the value that was provided on create.
+func (u *StrategyRunUpsertBulk) UpdateOwner() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateOwner()
+	})
+}
+
+// SetExchange sets the "exchange" field.
+func (u *StrategyRunUpsertBulk) SetExchange(v string) *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetExchange(v)
+	})
+}
+
+// UpdateExchange sets the "exchange" field to the value that was provided on create.
+func (u *StrategyRunUpsertBulk) UpdateExchange() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateExchange()
+	})
+}
+
+// SetSymbol sets the "symbol" field.
+func (u *StrategyRunUpsertBulk) SetSymbol(v string) *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetSymbol(v)
+	})
+}
+
+// UpdateSymbol sets the "symbol" field to the value that was provided on create.
+func (u *StrategyRunUpsertBulk) UpdateSymbol() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateSymbol()
+	})
+}
+
+// SetAccount sets the "account" field.
+func (u *StrategyRunUpsertBulk) SetAccount(v string) *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetAccount(v)
+	})
+}
+
+// UpdateAccount sets the "account" field to the value that was provided on create.
+func (u *StrategyRunUpsertBulk) UpdateAccount() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateAccount()
+	})
+}
+
+// SetMode sets the "mode" field.
+func (u *StrategyRunUpsertBulk) SetMode(v strategyrun.Mode) *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetMode(v)
+	})
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *StrategyRunUpsertBulk) UpdateMode() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateMode()
+	})
+}
+
+// SetPriceUpper sets the "priceUpper" field.
+func (u *StrategyRunUpsertBulk) SetPriceUpper(v decimal.Decimal) *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetPriceUpper(v)
+	})
+}
+
+// UpdatePriceUpper sets the "priceUpper" field to the value that was provided on create.
+func (u *StrategyRunUpsertBulk) UpdatePriceUpper() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdatePriceUpper()
+	})
+}
+
+// SetPriceLower sets the "priceLower" field.
+func (u *StrategyRunUpsertBulk) SetPriceLower(v decimal.Decimal) *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetPriceLower(v)
+	})
+}
+
+// UpdatePriceLower sets the "priceLower" field to the value that was provided on create.
+func (u *StrategyRunUpsertBulk) UpdatePriceLower() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdatePriceLower()
+	})
+}
+
+// SetGridNum sets the "gridNum" field.
+func (u *StrategyRunUpsertBulk) SetGridNum(v int) *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetGridNum(v)
+	})
+}
+
+// AddGridNum adds v to the "gridNum" field.
+func (u *StrategyRunUpsertBulk) AddGridNum(v int) *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.AddGridNum(v)
+	})
+}
+
+// UpdateGridNum sets the "gridNum" field to the value that was provided on create.
+func (u *StrategyRunUpsertBulk) UpdateGridNum() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateGridNum()
+	})
+}
+
+// SetLeverage sets the "leverage" field.
+func (u *StrategyRunUpsertBulk) SetLeverage(v int) *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetLeverage(v)
+	})
+}
+
+// AddLeverage adds v to the "leverage" field.
+func (u *StrategyRunUpsertBulk) AddLeverage(v int) *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.AddLeverage(v)
+	})
+}
+
+// UpdateLeverage sets the "leverage" field to the value that was provided on create.
+func (u *StrategyRunUpsertBulk) UpdateLeverage() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateLeverage()
+	})
+}
+
+// SetInitialOrderSize sets the "initialOrderSize" field.
+func (u *StrategyRunUpsertBulk) SetInitialOrderSize(v decimal.Decimal) *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetInitialOrderSize(v)
+	})
+}
+
+// UpdateInitialOrderSize sets the "initialOrderSize" field to the value that was provided on create.
+func (u *StrategyRunUpsertBulk) UpdateInitialOrderSize() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateInitialOrderSize()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *StrategyRunUpsertBulk) SetStatus(v strategyrun.Status) *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *StrategyRunUpsertBulk) UpdateStatus() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetStartTime sets the "startTime" field.
+func (u *StrategyRunUpsertBulk) SetStartTime(v time.Time) *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetStartTime(v)
+	})
+}
+
+// UpdateStartTime sets the "startTime" field to the value that was provided on create.
+func (u *StrategyRunUpsertBulk) UpdateStartTime() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateStartTime()
+	})
+}
+
+// SetEndTime sets the "endTime" field.
+func (u *StrategyRunUpsertBulk) SetEndTime(v time.Time) *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetEndTime(v)
+	})
+}
+
+// UpdateEndTime sets the "endTime" field to the value that was provided on create.
+func (u *StrategyRunUpsertBulk) UpdateEndTime() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateEndTime()
+	})
+}
+
+// ClearEndTime clears the value of the "endTime" field.
+func (u *StrategyRunUpsertBulk) ClearEndTime() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.ClearEndTime()
+	})
+}
+
+// SetStopReason sets the "stopReason" field.
+func (u *StrategyRunUpsertBulk) SetStopReason(v strategyrun.StopReason) *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetStopReason(v)
+	})
+}
+
+// UpdateStopReason sets the "stopReason" field to the value that was provided on create.
+func (u *StrategyRunUpsertBulk) UpdateStopReason() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateStopReason()
+	})
+}
+
+// ClearStopReason clears the value of the "stopReason" field.
+func (u *StrategyRunUpsertBulk) ClearStopReason() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.ClearStopReason()
+	})
+}
+
+// SetMatchedTrades sets the "matchedTrades" field.
+func (u *StrategyRunUpsertBulk) SetMatchedTrades(v int) *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetMatchedTrades(v)
+	})
+}
+
+// AddMatchedTrades adds v to the "matchedTrades" field.
+func (u *StrategyRunUpsertBulk) AddMatchedTrades(v int) *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.AddMatchedTrades(v)
+	})
+}
+
+// UpdateMatchedTrades sets the "matchedTrades" field to the value that was provided on create.
+func (u *StrategyRunUpsertBulk) UpdateMatchedTrades() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateMatchedTrades()
+	})
+}
+
+// SetRealizedProfit sets the "realizedProfit" field.
+func (u *StrategyRunUpsertBulk) SetRealizedProfit(v decimal.Decimal) *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetRealizedProfit(v)
+	})
+}
+
+// UpdateRealizedProfit sets the "realizedProfit" field to the value that was provided on create.
+func (u *StrategyRunUpsertBulk) UpdateRealizedProfit() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateRealizedProfit()
+	})
+}
+
+// ClearRealizedProfit clears the value of the "realizedProfit" field.
+func (u *StrategyRunUpsertBulk) ClearRealizedProfit() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.ClearRealizedProfit()
+	})
+}
+
+// SetFee sets the "fee" field.
+func (u *StrategyRunUpsertBulk) SetFee(v decimal.Decimal) *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetFee(v)
+	})
+}
+
+// UpdateFee sets the "fee" field to the value that was provided on create.
+func (u *StrategyRunUpsertBulk) UpdateFee() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateFee()
+	})
+}
+
+// ClearFee clears the value of the "fee" field.
+func (u *StrategyRunUpsertBulk) ClearFee() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.ClearFee()
+	})
+}
+
+// SetFunding sets the "funding" field.
+func (u *StrategyRunUpsertBulk) SetFunding(v decimal.Decimal) *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetFunding(v)
+	})
+}
+
+// UpdateFunding sets the "funding" field to the value that was provided on create.
+func (u *StrategyRunUpsertBulk) UpdateFunding() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateFunding()
+	})
+}
+
+// ClearFunding clears the value of the "funding" field.
+func (u *StrategyRunUpsertBulk) ClearFunding() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.ClearFunding()
+	})
+}
+
+// SetUnrealizedProfit sets the "unrealizedProfit" field.
+func (u *StrategyRunUpsertBulk) SetUnrealizedProfit(v decimal.Decimal) *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetUnrealizedProfit(v)
+	})
+}
+
+// UpdateUnrealizedProfit sets the "unrealizedProfit" field to the value that was provided on create.
+func (u *StrategyRunUpsertBulk) UpdateUnrealizedProfit() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateUnrealizedProfit()
+	})
+}
+
+// ClearUnrealizedProfit clears the value of the "unrealizedProfit" field.
+func (u *StrategyRunUpsertBulk) ClearUnrealizedProfit() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.ClearUnrealizedProfit()
+	})
+}
+
+// SetTotalProfit sets the "totalProfit" field.
+func (u *StrategyRunUpsertBulk) SetTotalProfit(v decimal.Decimal) *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.SetTotalProfit(v)
+	})
+}
+
+// UpdateTotalProfit sets the "totalProfit" field to the value that was provided on create.
+func (u *StrategyRunUpsertBulk) UpdateTotalProfit() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.UpdateTotalProfit()
+	})
+}
+
+// ClearTotalProfit clears the value of the "totalProfit" field.
+func (u *StrategyRunUpsertBulk) ClearTotalProfit() *StrategyRunUpsertBulk {
+	return u.Update(func(s *StrategyRunUpsert) {
+		s.ClearTotalProfit()
+	})
+}
+
+// Exec executes the query.
+func (u *StrategyRunUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the StrategyRunCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for StrategyRunCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *StrategyRunUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/fachebot/omni-grid-bot/internal/ent/predicate"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategyrun"
+	"github.com/shopspring/decimal"
+)
+
+// StrategyRunUpdate is the builder for updating StrategyRun entities.
+type StrategyRunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StrategyRunMutation
+}
+
+// Where appends a list predicates to the StrategyRunUpdate builder.
+func (_u *StrategyRunUpdate) Where(ps ...predicate.StrategyRun) *StrategyRunUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *StrategyRunUpdate) SetUpdateTime(v time.Time) *StrategyRunUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetStrategyId sets the "strategyId" field.
+func (_u *StrategyRunUpdate) SetStrategyId(v string) *StrategyRunUpdate {
+	_u.mutation.SetStrategyId(v)
+	return _u
+}
+
+// SetNillableStrategyId sets the "strategyId" field if the given value is not nil.
+func (_u *StrategyRunUpdate) SetNillableStrategyId(v *string) *StrategyRunUpdate {
+	if v != nil {
+		_u.SetStrategyId(*v)
+	}
+	return _u
+}
+
+// SetOwner sets the "owner" field.
+func (_u *StrategyRunUpdate) SetOwner(v int64) *StrategyRunUpdate {
+	_u.mutation.ResetOwner()
+	_u.mutation.SetOwner(v)
+	return _u
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_u *StrategyRunUpdate) SetNillableOwner(v *int64) *StrategyRunUpdate {
+	if v != nil {
+		_u.SetOwner(*v)
+	}
+	return _u
+}
+
+// AddOwner adds value to the "owner" field.
+func (_u *StrategyRunUpdate) AddOwner(v int64) *StrategyRunUpdate {
+	_u.mutation.AddOwner(v)
+	return _u
+}
+
+// SetExchange sets the "exchange" field.
+func (_u *StrategyRunUpdate) SetExchange(v string) *StrategyRunUpdate {
+	_u.mutation.SetExchange(v)
+	return _u
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_u *StrategyRunUpdate) SetNillableExchange(v *string) *StrategyRunUpdate {
+	if v != nil {
+		_u.SetExchange(*v)
+	}
+	return _u
+}
+
+// SetSymbol sets the "symbol" field.
+func (_u *StrategyRunUpdate) SetSymbol(v string) *StrategyRunUpdate {
+	_u.mutation.SetSymbol(v)
+	return _u
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_u *StrategyRunUpdate) SetNillableSymbol(v *string) *StrategyRunUpdate {
+	if v != nil {
+		_u.SetSymbol(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" field.
+func (_u *StrategyRunUpdate) SetAccount(v string) *StrategyRunUpdate {
+	_u.mutation.SetAccount(v)
+	return _u
+}
+
+// SetNillableAccount sets the "account" field if the given value is not nil.
+func (_u *StrategyRunUpdate) SetNillableAccount(v *string) *StrategyRunUpdate {
+	if v != nil {
+		_u.SetAccount(*v)
+	}
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *StrategyRunUpdate) SetMode(v strategyrun.Mode) *StrategyRunUpdate {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *StrategyRunUpdate) SetNillableMode(v *strategyrun.Mode) *StrategyRunUpdate {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// SetPriceUpper sets the "priceUpper" field.
+func (_u *StrategyRunUpdate) SetPriceUpper(v decimal.Decimal) *StrategyRunUpdate {
+	_u.mutation.SetPriceUpper(v)
+	return _u
+}
+
+// SetNillablePriceUpper sets the "priceUpper" field if the given value is not nil.
+func (_u *StrategyRunUpdate) SetNillablePriceUpper(v *decimal.Decimal) *StrategyRunUpdate {
+	if v != nil {
+		_u.SetPriceUpper(*v)
+	}
+	return _u
+}
+
+// SetPriceLower sets the "priceLower" field.
+func (_u *StrategyRunUpdate) SetPriceLower(v decimal.Decimal) *StrategyRunUpdate {
+	_u.mutation.SetPriceLower(v)
+	return _u
+}
+
+// SetNillablePriceLower sets the "priceLower" field if the given value is not nil.
+func (_u *StrategyRunUpdate) SetNillablePriceLower(v *decimal.Decimal) *StrategyRunUpdate {
+	if v != nil {
+		_u.SetPriceLower(*v)
+	}
+	return _u
+}
+
+// SetGridNum sets the "gridNum" field.
+func (_u *StrategyRunUpdate) SetGridNum(v int) *StrategyRunUpdate {
+	_u.mutation.ResetGridNum()
+	_u.mutation.SetGridNum(v)
+	return _u
+}
+
+// SetNillableGridNum sets the "gridNum" field if the given value is not nil.
+func (_u *StrategyRunUpdate) SetNillableGridNum(v *int) *StrategyRunUpdate {
+	if v != nil {
+		_u.SetGridNum(*v)
+	}
+	return _u
+}
+
+// AddGridNum adds value to the "gridNum" field.
+func (_u *StrategyRunUpdate) AddGridNum(v int) *StrategyRunUpdate {
+	_u.mutation.AddGridNum(v)
+	return _u
+}
+
+// SetLeverage sets the "leverage" field.
+func (_u *StrategyRunUpdate) SetLeverage(v int) *StrategyRunUpdate {
+	_u.mutation.ResetLeverage()
+	_u.mutation.SetLeverage(v)
+	return _u
+}
+
+// SetNillableLeverage sets the "leverage" field if the given value is not nil.
+func (_u *StrategyRunUpdate) SetNillableLeverage(v *int) *StrategyRunUpdate {
+	if v != nil {
+		_u.SetLeverage(*v)
+	}
+	return _u
+}
+
+// AddLeverage adds value to the "leverage" field.
+func (_u *StrategyRunUpdate) AddLeverage(v int) *StrategyRunUpdate {
+	_u.mutation.AddLeverage(v)
+	return _u
+}
+
+// SetInitialOrderSize sets the "initialOrderSize" field.
+func (_u *StrategyRunUpdate) SetInitialOrderSize(v decimal.Decimal) *StrategyRunUpdate {
+	_u.mutation.SetInitialOrderSize(v)
+	return _u
+}
+
+// SetNillableInitialOrderSize sets the "initialOrderSize" field if the given value is not nil.
+func (_u *StrategyRunUpdate) SetNillableInitialOrderSize(v *decimal.Decimal) *StrategyRunUpdate {
+	if v != nil {
+		_u.SetInitialOrderSize(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *StrategyRunUpdate) SetStatus(v strategyrun.Status) *StrategyRunUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *StrategyRunUpdate) SetNillableStatus(v *strategyrun.Status) *StrategyRunUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetStartTime sets the "startTime" field.
+func (_u *StrategyRunUpdate) SetStartTime(v time.Time) *StrategyRunUpdate {
+	_u.mutation.SetStartTime(v)
+	return _u
+}
+
+// SetNillableStartTime sets the "startTime" field if the given value is not nil.
+func (_u *StrategyRunUpdate) SetNillableStartTime(v *time.Time) *StrategyRunUpdate {
+	if v != nil {
+		_u.SetStartTime(*v)
+	}
+	return _u
+}
+
+// SetEndTime sets the "endTime" field.
+func (_u *StrategyRunUpdate) SetEndTime(v time.Time) *StrategyRunUpdate {
+	_u.mutation.SetEndTime(v)
+	return _u
+}
+
+// SetNillableEndTime sets the "endTime" field if the given value is not nil.
+func (_u *StrategyRunUpdate) SetNillableEndTime(v *time.Time) *StrategyRunUpdate {
+	if v != nil {
+		_u.SetEndTime(*v)
+	}
+	return _u
+}
+
+// ClearEndTime clears the value of the "endTime" field.
+func (_u *StrategyRunUpdate) ClearEndTime() *StrategyRunUpdate {
+	_u.mutation.ClearEndTime()
+	return _u
+}
+
+// SetStopReason sets the "stopReason" field.
+func (_u *StrategyRunUpdate) SetStopReason(v strategyrun.StopReason) *StrategyRunUpdate {
+	_u.mutation.SetStopReason(v)
+	return _u
+}
+
+// SetNillableStopReason sets the "stopReason" field if the given value is not nil.
+func (_u *StrategyRunUpdate) SetNillableStopReason(v *strategyrun.StopReason) *StrategyRunUpdate {
+	if v != nil {
+		_u.SetStopReason(*v)
+	}
+	return _u
+}
+
+// ClearStopReason clears the value of the "stopReason" field.
+func (_u *StrategyRunUpdate) ClearStopReason() *StrategyRunUpdate {
+	_u.mutation.ClearStopReason()
+	return _u
+}
+
+// SetMatchedTrades sets the "matchedTrades" field.
+func (_u *StrategyRunUpdate) SetMatchedTrades(v int) *StrategyRunUpdate {
+	_u.mutation.ResetMatchedTrades()
+	_u.mutation.SetMatchedTrades(v)
+	return _u
+}
+
+// SetNillableMatchedTrades sets the "matchedTrades" field if the given value is not nil.
+func (_u *StrategyRunUpdate) SetNillableMatchedTrades(v *int) *StrategyRunUpdate {
+	if v != nil {
+		_u.SetMatchedTrades(*v)
+	}
+	return _u
+}
+
+// AddMatchedTrades adds value to the "matchedTrades" field.
+func (_u *StrategyRunUpdate) AddMatchedTrades(v int) *StrategyRunUpdate {
+	_u.mutation.AddMatchedTrades(v)
+	return _u
+}
+
+// SetRealizedProfit sets the "realizedProfit" field.
+func (_u *StrategyRunUpdate) SetRealizedProfit(v decimal.Decimal) *StrategyRunUpdate {
+	_u.mutation.SetRealizedProfit(v)
+	return _u
+}
+
+// SetNillableRealizedProfit sets the "realizedProfit" field if the given value is not nil.
+func (_u *StrategyRunUpdate) SetNillableRealizedProfit(v *decimal.Decimal) *StrategyRunUpdate {
+	if v != nil {
+		_u.SetRealizedProfit(*v)
+	}
+	return _u
+}
+
+// ClearRealizedProfit clears the value of the "realizedProfit" field.
+func (_u *StrategyRunUpdate) ClearRealizedProfit() *StrategyRunUpdate {
+	_u.mutation.ClearRealizedProfit()
+	return _u
+}
+
+// SetFee sets the "fee" field.
+func (_u *StrategyRunUpdate) SetFee(v decimal.Decimal) *StrategyRunUpdate {
+	_u.mutation.SetFee(v)
+	return _u
+}
+
+// SetNillableFee sets the "fee" field if the given value is not nil.
+func (_u *StrategyRunUpdate) SetNillableFee(v *decimal.Decimal) *StrategyRunUpdate {
+	if v != nil {
+		_u.SetFee(*v)
+	}
+	return _u
+}
+
+// ClearFee clears the value of the "fee" field.
+func (_u *StrategyRunUpdate) ClearFee() *StrategyRunUpdate {
+	_u.mutation.ClearFee()
+	return _u
+}
+
+// SetFunding sets the "funding" field.
+func (_u *StrategyRunUpdate) SetFunding(v decimal.Decimal) *StrategyRunUpdate {
+	_u.mutation.SetFunding(v)
+	return _u
+}
+
+// SetNillableFunding sets the "funding" field if the given value is not nil.
+func (_u *StrategyRunUpdate) SetNillableFunding(v *decimal.Decimal) *StrategyRunUpdate {
+	if v != nil {
+		_u.SetFunding(*v)
+	}
+	return _u
+}
+
+// ClearFunding clears the value of the "funding" field.
+func (_u *StrategyRunUpdate) ClearFunding() *StrategyRunUpdate {
+	_u.mutation.ClearFunding()
+	return _u
+}
+
+// SetUnrealizedProfit sets the "unrealizedProfit" field.
+func (_u *StrategyRunUpdate) SetUnrealizedProfit(v decimal.Decimal) *StrategyRunUpdate {
+	_u.mutation.SetUnrealizedProfit(v)
+	return _u
+}
+
+// SetNillableUnrealizedProfit sets the "unrealizedProfit" field if the given value is not nil.
+func (_u *StrategyRunUpdate) SetNillableUnrealizedProfit(v *decimal.Decimal) *StrategyRunUpdate {
+	if v != nil {
+		_u.SetUnrealizedProfit(*v)
+	}
+	return _u
+}
+
+// ClearUnrealizedProfit clears the value of the "unrealizedProfit" field.
+func (_u *StrategyRunUpdate) ClearUnrealizedProfit() *StrategyRunUpdate {
+	_u.mutation.ClearUnrealizedProfit()
+	return _u
+}
+
+// SetTotalProfit sets the "totalProfit" field.
+func (_u *StrategyRunUpdate) SetTotalProfit(v decimal.Decimal) *StrategyRunUpdate {
+	_u.mutation.SetTotalProfit(v)
+	return _u
+}
+
+// SetNillableTotalProfit sets the "totalProfit" field if the given value is not nil.
+func (_u *StrategyRunUpdate) SetNillableTotalProfit(v *decimal.Decimal) *StrategyRunUpdate {
+	if v != nil {
+		_u.SetTotalProfit(*v)
+	}
+	return _u
+}
+
+// ClearTotalProfit clears the value of the "totalProfit" field.
+func (_u *StrategyRunUpdate) ClearTotalProfit() *StrategyRunUpdate {
+	_u.mutation.ClearTotalProfit()
+	return _u
+}
+
+// Mutation returns the StrategyRunMutation object of the builder.
+func (_u *StrategyRunUpdate) Mutation() *StrategyRunMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *StrategyRunUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StrategyRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *StrategyRunUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StrategyRunUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *StrategyRunUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := strategyrun.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StrategyRunUpdate) check() error {
+	if v, ok := _u.mutation.StrategyId(); ok {
+		if err := strategyrun.StrategyIdValidator(v); err != nil {
+			return &ValidationError{Name: "strategyId", err: fmt.Errorf(`ent: validator failed for field "StrategyRun.strategyId": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Exchange(); ok {
+		if err := strategyrun.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "StrategyRun.exchange": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Symbol(); ok {
+		if err := strategyrun.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "StrategyRun.symbol": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Mode(); ok {
+		if err := strategyrun.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "StrategyRun.mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := strategyrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "StrategyRun.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StopReason(); ok {
+		if err := strategyrun.StopReasonValidator(v); err != nil {
+			return &ValidationError{Name: "stopReason", err: fmt.Errorf(`ent: validator failed for field "StrategyRun.stopReason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *StrategyRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(strategyrun.Table, strategyrun.Columns, sqlgraph.NewFieldSpec(strategyrun.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(strategyrun.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.StrategyId(); ok {
+		_spec.SetField(strategyrun.FieldStrategyId, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Owner(); ok {
+		_spec.SetField(strategyrun.FieldOwner, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOwner(); ok {
+		_spec.AddField(strategyrun.FieldOwner, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Exchange(); ok {
+		_spec.SetField(strategyrun.FieldExchange, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Symbol(); ok {
+		_spec.SetField(strategyrun.FieldSymbol, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Account(); ok {
+		_spec.SetField(strategyrun.FieldAccount, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(strategyrun.FieldMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PriceUpper(); ok {
+		_spec.SetField(strategyrun.FieldPriceUpper, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PriceLower(); ok {
+		_spec.SetField(strategyrun.FieldPriceLower, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GridNum(); ok {
+		_spec.SetField(strategyrun.FieldGridNum, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGridNum(); ok {
+		_spec.AddField(strategyrun.FieldGridNum, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Leverage(); ok {
+		_spec.SetField(strategyrun.FieldLeverage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLeverage(); ok {
+		_spec.AddField(strategyrun.FieldLeverage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.InitialOrderSize(); ok {
+		_spec.SetField(strategyrun.FieldInitialOrderSize, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(strategyrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StartTime(); ok {
+		_spec.SetField(strategyrun.FieldStartTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndTime(); ok {
+		_spec.SetField(strategyrun.FieldEndTime, field.TypeTime, value)
+	}
+	if _u.mutation.EndTimeCleared() {
+		_spec.ClearField(strategyrun.FieldEndTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.StopReason(); ok {
+		_spec.SetField(strategyrun.FieldStopReason, field.TypeEnum, value)
+	}
+	if _u.mutation.StopReasonCleared() {
+		_spec.ClearField(strategyrun.FieldStopReason, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.MatchedTrades(); ok {
+		_spec.SetField(strategyrun.FieldMatchedTrades, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMatchedTrades(); ok {
+		_spec.AddField(strategyrun.FieldMatchedTrades, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RealizedProfit(); ok {
+		_spec.SetField(strategyrun.FieldRealizedProfit, field.TypeString, value)
+	}
+	if _u.mutation.RealizedProfitCleared() {
+		_spec.ClearField(strategyrun.FieldRealizedProfit, field.TypeString)
+	}
+	if value, ok := _u.mutation.Fee(); ok {
+		_spec.SetField(strategyrun.FieldFee, field.TypeString, value)
+	}
+	if _u.mutation.FeeCleared() {
+		_spec.ClearField(strategyrun.FieldFee, field.TypeString)
+	}
+	if value, ok := _u.mutation.Funding(); ok {
+		_spec.SetField(strategyrun.FieldFunding, field.TypeString, value)
+	}
+	if _u.mutation.FundingCleared() {
+		_spec.ClearField(strategyrun.FieldFunding, field.TypeString)
+	}
+	if value, ok := _u.mutation.UnrealizedProfit(); ok {
+		_spec.SetField(strategyrun.FieldUnrealizedProfit, field.TypeString, value)
+	}
+	if _u.mutation.UnrealizedProfitCleared() {
+		_spec.ClearField(strategyrun.FieldUnrealizedProfit, field.TypeString)
+	}
+	if value, ok := _u.mutation.TotalProfit(); ok {
+		_spec.SetField(strategyrun.FieldTotalProfit, field.TypeString, value)
+	}
+	if _u.mutation.TotalProfitCleared() {
+		_spec.ClearField(strategyrun.FieldTotalProfit, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{strategyrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// StrategyRunUpdateOne is the builder for updating a single StrategyRun entity.
+type StrategyRunUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StrategyRunMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *StrategyRunUpdateOne) SetUpdateTime(v time.Time) *StrategyRunUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetStrategyId sets the "strategyId" field.
+func (_u *StrategyRunUpdateOne) SetStrategyId(v string) *StrategyRunUpdateOne {
+	_u.mutation.SetStrategyId(v)
+	return _u
+}
+
+// SetNillableStrategyId sets the "strategyId" field if the given value is not nil.
+func (_u *StrategyRunUpdateOne) SetNillableStrategyId(v *string) *StrategyRunUpdateOne {
+	if v != nil {
+		_u.SetStrategyId(*v)
+	}
+	return _u
+}
+
+// SetOwner sets the "owner" field.
+func (_u *StrategyRunUpdateOne) SetOwner(v int64) *StrategyRunUpdateOne {
+	_u.mutation.ResetOwner()
+	_u.mutation.SetOwner(v)
+	return _u
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_u *StrategyRunUpdateOne) SetNillableOwner(v *int64) *StrategyRunUpdateOne {
+	if v != nil {
+		_u.SetOwner(*v)
+	}
+	return _u
+}
+
+// AddOwner adds value to the "owner" field.
+func (_u *StrategyRunUpdateOne) AddOwner(v int64) *StrategyRunUpdateOne {
+	_u.mutation.AddOwner(v)
+	return _u
+}
+
+// SetExchange sets the "exchange" field.
+func (_u *StrategyRunUpdateOne) SetExchange(v string) *StrategyRunUpdateOne {
+	_u.mutation.SetExchange(v)
+	return _u
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_u *StrategyRunUpdateOne) SetNillableExchange(v *string) *StrategyRunUpdateOne {
+	if v != nil {
+		_u.SetExchange(*v)
+	}
+	return _u
+}
+
+// SetSymbol sets the "symbol" field.
+func (_u *StrategyRunUpdateOne) SetSymbol(v string) *StrategyRunUpdateOne {
+	_u.mutation.SetSymbol(v)
+	return _u
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_u *StrategyRunUpdateOne) SetNillableSymbol(v *string) *StrategyRunUpdateOne {
+	if v != nil {
+		_u.SetSymbol(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" field.
+func (_u *StrategyRunUpdateOne) SetAccount(v string) *StrategyRunUpdateOne {
+	_u.mutation.SetAccount(v)
+	return _u
+}
+
+// SetNillableAccount sets the "account" field if the given value is not nil.
+func (_u *StrategyRunUpdateOne) SetNillableAccount(v *string) *StrategyRunUpdateOne {
+	if v != nil {
+		_u.SetAccount(*v)
+	}
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *StrategyRunUpdateOne) SetMode(v strategyrun.Mode) *StrategyRunUpdateOne {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *StrategyRunUpdateOne) SetNillableMode(v *strategyrun.Mode) *StrategyRunUpdateOne {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// SetPriceUpper sets the "priceUpper" field.
+func (_u *StrategyRunUpdateOne) SetPriceUpper(v decimal.Decimal) *StrategyRunUpdateOne {
+	_u.mutation.SetPriceUpper(v)
+	return _u
+}
+
+// SetNillablePriceUpper sets the "priceUpper" field if the given value is not nil.
+func (_u *StrategyRunUpdateOne) SetNillablePriceUpper(v *decimal.Decimal) *StrategyRunUpdateOne {
+	if v != nil {
+		_u.SetPriceUpper(*v)
+	}
+	return _u
+}
+
+// SetPriceLower sets the "priceLower" field.
+func (_u *StrategyRunUpdateOne) SetPriceLower(v decimal.Decimal) *StrategyRunUpdateOne {
+	_u.mutation.SetPriceLower(v)
+	return _u
+}
+
+// SetNillablePriceLower sets the "priceLower" field if the given value is not nil.
+func (_u *StrategyRunUpdateOne) SetNillablePriceLower(v *decimal.Decimal) *StrategyRunUpdateOne {
+	if v != nil {
+		_u.SetPriceLower(*v)
+	}
+	return _u
+}
+
+// SetGridNum sets the "gridNum" field.
+func (_u *StrategyRunUpdateOne) SetGridNum(v int) *StrategyRunUpdateOne {
+	_u.mutation.ResetGridNum()
+	_u.mutation.SetGridNum(v)
+	return _u
+}
+
+// SetNillableGridNum sets the "gridNum" field if the given value is not nil.
+func (_u *StrategyRunUpdateOne) SetNillableGridNum(v *int) *StrategyRunUpdateOne {
+	if v != nil {
+		_u.SetGridNum(*v)
+	}
+	return _u
+}
+
+// AddGridNum adds value to the "gridNum" field.
+func (_u *StrategyRunUpdateOne) AddGridNum(v int) *StrategyRunUpdateOne {
+	_u.mutation.AddGridNum(v)
+	return _u
+}
+
+// SetLeverage sets the "leverage" field.
+func (_u *StrategyRunUpdateOne) SetLeverage(v int) *StrategyRunUpdateOne {
+	_u.mutation.ResetLeverage()
+	_u.mutation.SetLeverage(v)
+	return _u
+}
+
+// SetNillableLeverage sets the "leverage" field if the given value is not nil.
+func (_u *StrategyRunUpdateOne) SetNillableLeverage(v *int) *StrategyRunUpdateOne {
+	if v != nil {
+		_u.SetLeverage(*v)
+	}
+	return _u
+}
+
+// AddLeverage adds value to the "leverage" field.
+func (_u *StrategyRunUpdateOne) AddLeverage(v int) *StrategyRunUpdateOne {
+	_u.mutation.AddLeverage(v)
+	return _u
+}
+
+// SetInitialOrderSize sets the "initialOrderSize" field.
+func (_u *StrategyRunUpdateOne) SetInitialOrderSize(v decimal.Decimal) *StrategyRunUpdateOne {
+	_u.mutation.SetInitialOrderSize(v)
+	return _u
+}
+
+// SetNillableInitialOrderSize sets the "initialOrderSize" field if the given value is not nil.
+func (_u *StrategyRunUpdateOne) SetNillableInitialOrderSize(v *decimal.Decimal) *StrategyRunUpdateOne {
+	if v != nil {
+		_u.SetInitialOrderSize(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *StrategyRunUpdateOne) SetStatus(v strategyrun.Status) *StrategyRunUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *StrategyRunUpdateOne) SetNillableStatus(v *strategyrun.Status) *StrategyRunUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetStartTime sets the "startTime" field.
+func (_u *StrategyRunUpdateOne) SetStartTime(v time.Time) *StrategyRunUpdateOne {
+	_u.mutation.SetStartTime(v)
+	return _u
+}
+
+// SetNillableStartTime sets the "startTime" field if the given value is not nil.
+func (_u *StrategyRunUpdateOne) SetNillableStartTime(v *time.Time) *StrategyRunUpdateOne {
+	if v != nil {
+		_u.SetStartTime(*v)
+	}
+	return _u
+}
+
+// SetEndTime sets the "endTime" field.
+func (_u *StrategyRunUpdateOne) SetEndTime(v time.Time) *StrategyRunUpdateOne {
+	_u.mutation.SetEndTime(v)
+	return _u
+}
+
+// SetNillableEndTime sets the "endTime" field if the given value is not nil.
+func (_u *StrategyRunUpdateOne) SetNillableEndTime(v *time.Time) *StrategyRunUpdateOne {
+	if v != nil {
+		_u.SetEndTime(*v)
+	}
+	return _u
+}
+
+// ClearEndTime clears the value of the "endTime" field.
+func (_u *StrategyRunUpdateOne) ClearEndTime() *StrategyRunUpdateOne {
+	_u.mutation.ClearEndTime()
+	return _u
+}
+
+// SetStopReason sets the "stopReason" field.
+func (_u *StrategyRunUpdateOne) SetStopReason(v strategyrun.StopReason) *StrategyRunUpdateOne {
+	_u.mutation.SetStopReason(v)
+	return _u
+}
+
+// SetNillableStopReason sets the "stopReason" field if the given value is not nil.
+func (_u *StrategyRunUpdateOne) SetNillableStopReason(v *strategyrun.StopReason) *StrategyRunUpdateOne {
+	if v != nil {
+		_u.SetStopReason(*v)
+	}
+	return _u
+}
+
+// ClearStopReason clears the value of the "stopReason" field.
+func (_u *StrategyRunUpdateOne) ClearStopReason() *StrategyRunUpdateOne {
+	_u.mutation.ClearStopReason()
+	return _u
+}
+
+// SetMatchedTrades sets the "matchedTrades" field.
+func (_u *StrategyRunUpdateOne) SetMatchedTrades(v int) *StrategyRunUpdateOne {
+	_u.mutation.ResetMatchedTrades()
+	_u.mutation.SetMatchedTrades(v)
+	return _u
+}
+
+// SetNillableMatchedTrades sets the "matchedTrades" field if the given value is not nil.
+func (_u *StrategyRunUpdateOne) SetNillableMatchedTrades(v *int) *StrategyRunUpdateOne {
+	if v != nil {
+		_u.SetMatchedTrades(*v)
+	}
+	return _u
+}
+
+// AddMatchedTrades adds value to the "matchedTrades" field.
+func (_u *StrategyRunUpdateOne) AddMatchedTrades(v int) *StrategyRunUpdateOne {
+	_u.mutation.AddMatchedTrades(v)
+	return _u
+}
+
+// SetRealizedProfit sets the "realizedProfit" field.
+func (_u *StrategyRunUpdateOne) SetRealizedProfit(v decimal.Decimal) *StrategyRunUpdateOne {
+	_u.mutation.SetRealizedProfit(v)
+	return _u
+}
+
+// SetNillableRealizedProfit sets the "realizedProfit" field if the given value is not nil.
+func (_u *StrategyRunUpdateOne) SetNillableRealizedProfit(v *decimal.Decimal) *StrategyRunUpdateOne {
+	if v != nil {
+		_u.SetRealizedProfit(*v)
+	}
+	return _u
+}
+
+// ClearRealizedProfit clears the value of the "realizedProfit" field.
+func (_u *StrategyRunUpdateOne) ClearRealizedProfit() *StrategyRunUpdateOne {
+	_u.mutation.ClearRealizedProfit()
+	return _u
+}
+
+// SetFee sets the "fee" field.
+func (_u *StrategyRunUpdateOne) SetFee(v decimal.Decimal) *StrategyRunUpdateOne {
+	_u.mutation.SetFee(v)
+	return _u
+}
+
+// SetNillableFee sets the "fee" field if the given value is not nil.
+func (_u *StrategyRunUpdateOne) SetNillableFee(v *decimal.Decimal) *StrategyRunUpdateOne {
+	if v != nil {
+		_u.SetFee(*v)
+	}
+	return _u
+}
+
+// ClearFee clears the value of the "fee" field.
+func (_u *StrategyRunUpdateOne) ClearFee() *StrategyRunUpdateOne {
+	_u.mutation.ClearFee()
+	return _u
+}
+
+// SetFunding sets the "funding" field.
+func (_u *StrategyRunUpdateOne) SetFunding(v decimal.Decimal) *StrategyRunUpdateOne {
+	_u.mutation.SetFunding(v)
+	return _u
+}
+
+// SetNillableFunding sets the "funding" field if the given value is not nil.
+func (_u *StrategyRunUpdateOne) SetNillableFunding(v *decimal.Decimal) *StrategyRunUpdateOne {
+	if v != nil {
+		_u.SetFunding(*v)
+	}
+	return _u
+}
+
+// ClearFunding clears the value of the "funding" field.
+func (_u *StrategyRunUpdateOne) ClearFunding() *StrategyRunUpdateOne {
+	_u.mutation.ClearFunding()
+	return _u
+}
+
+// SetUnrealizedProfit sets the "unrealizedProfit" field.
+func (_u *StrategyRunUpdateOne) SetUnrealizedProfit(v decimal.Decimal) *StrategyRunUpdateOne {
+	_u.mutation.SetUnrealizedProfit(v)
+	return _u
+}
+
+// SetNillableUnrealizedProfit sets the "unrealizedProfit" field if the given value is not nil.
+func (_u *StrategyRunUpdateOne) SetNillableUnrealizedProfit(v *decimal.Decimal) *StrategyRunUpdateOne {
+	if v != nil {
+		_u.SetUnrealizedProfit(*v)
+	}
+	return _u
+}
+
+// ClearUnrealizedProfit clears the value of the "unrealizedProfit" field.
+func (_u *StrategyRunUpdateOne) ClearUnrealizedProfit() *StrategyRunUpdateOne {
+	_u.mutation.ClearUnrealizedProfit()
+	return _u
+}
+
+// SetTotalProfit sets the "totalProfit" field.
+func (_u *StrategyRunUpdateOne) SetTotalProfit(v decimal.Decimal) *StrategyRunUpdateOne {
+	_u.mutation.SetTotalProfit(v)
+	return _u
+}
+
+// SetNillableTotalProfit sets the "totalProfit" field if the given value is not nil.
+func (_u *StrategyRunUpdateOne) SetNillableTotalProfit(v *decimal.Decimal) *StrategyRunUpdateOne {
+	if v != nil {
+		_u.SetTotalProfit(*v)
+	}
+	return _u
+}
+
+// ClearTotalProfit clears the value of the "totalProfit" field.
+func (_u *StrategyRunUpdateOne) ClearTotalProfit() *StrategyRunUpdateOne {
+	_u.mutation.ClearTotalProfit()
+	return _u
+}
+
+// Mutation returns the StrategyRunMutation object of the builder.
+func (_u *StrategyRunUpdateOne) Mutation() *StrategyRunMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the StrategyRunUpdate builder.
+func (_u *StrategyRunUpdateOne) Where(ps ...predicate.StrategyRun) *StrategyRunUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *StrategyRunUpdateOne) Select(field string, fields ...string) *StrategyRunUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated StrategyRun entity.
+func (_u *StrategyRunUpdateOne) Save(ctx context.Context) (*StrategyRun, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StrategyRunUpdateOne) SaveX(ctx context.Context) *StrategyRun {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *StrategyRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StrategyRunUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *StrategyRunUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := strategyrun.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StrategyRunUpdateOne) check() error {
+	if v, ok := _u.mutation.StrategyId(); ok {
+		if err := strategyrun.StrategyIdValidator(v); err != nil {
+			return &ValidationError{Name: "strategyId", err: fmt.Errorf(`ent: validator failed for field "StrategyRun.strategyId": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Exchange(); ok {
+		if err := strategyrun.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "StrategyRun.exchange": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Symbol(); ok {
+		if err := strategyrun.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "StrategyRun.symbol": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Mode(); ok {
+		if err := strategyrun.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "StrategyRun.mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := strategyrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "StrategyRun.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StopReason(); ok {
+		if err := strategyrun.StopReasonValidator(v); err != nil {
+			return &ValidationError{Name: "stopReason", err: fmt.Errorf(`ent: validator failed for field "StrategyRun.stopReason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *StrategyRunUpdateOne) sqlSave(ctx context.Context) (_node *StrategyRun, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(strategyrun.Table, strategyrun.Columns, sqlgraph.NewFieldSpec(strategyrun.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StrategyRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, strategyrun.FieldID)
+		for _, f := range fields {
+			if !strategyrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != strategyrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(strategyrun.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.StrategyId(); ok {
+		_spec.SetField(strategyrun.FieldStrategyId, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Owner(); ok {
+		_spec.SetField(strategyrun.FieldOwner, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOwner(); ok {
+		_spec.AddField(strategyrun.FieldOwner, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Exchange(); ok {
+		_spec.SetField(strategyrun.FieldExchange, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Symbol(); ok {
+		_spec.SetField(strategyrun.FieldSymbol, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Account(); ok {
+		_spec.SetField(strategyrun.FieldAccount, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(strategyrun.FieldMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PriceUpper(); ok {
+		_spec.SetField(strategyrun.FieldPriceUpper, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PriceLower(); ok {
+		_spec.SetField(strategyrun.FieldPriceLower, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GridNum(); ok {
+		_spec.SetField(strategyrun.FieldGridNum, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGridNum(); ok {
+		_spec.AddField(strategyrun.FieldGridNum, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Leverage(); ok {
+		_spec.SetField(strategyrun.FieldLeverage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLeverage(); ok {
+		_spec.AddField(strategyrun.FieldLeverage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.InitialOrderSize(); ok {
+		_spec.SetField(strategyrun.FieldInitialOrderSize, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(strategyrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StartTime(); ok {
+		_spec.SetField(strategyrun.FieldStartTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndTime(); ok {
+		_spec.SetField(strategyrun.FieldEndTime, field.TypeTime, value)
+	}
+	if _u.mutation.EndTimeCleared() {
+		_spec.ClearField(strategyrun.FieldEndTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.StopReason(); ok {
+		_spec.SetField(strategyrun.FieldStopReason, field.TypeEnum, value)
+	}
+	if _u.mutation.StopReasonCleared() {
+		_spec.ClearField(strategyrun.FieldStopReason, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.MatchedTrades(); ok {
+		_spec.SetField(strategyrun.FieldMatchedTrades, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMatchedTrades(); ok {
+		_spec.AddField(strategyrun.FieldMatchedTrades, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RealizedProfit(); ok {
+		_spec.SetField(strategyrun.FieldRealizedProfit, field.TypeString, value)
+	}
+	if _u.mutation.RealizedProfitCleared() {
+		_spec.ClearField(strategyrun.FieldRealizedProfit, field.TypeString)
+	}
+	if value, ok := _u.mutation.Fee(); ok {
+		_spec.SetField(strategyrun.FieldFee, field.TypeString, value)
+	}
+	if _u.mutation.FeeCleared() {
+		_spec.ClearField(strategyrun.FieldFee, field.TypeString)
+	}
+	if value, ok := _u.mutation.Funding(); ok {
+		_spec.SetField(strategyrun.FieldFunding, field.TypeString, value)
+	}
+	if _u.mutation.FundingCleared() {
+		_spec.ClearField(strategyrun.FieldFunding, field.TypeString)
+	}
+	if value, ok := _u.mutation.UnrealizedProfit(); ok {
+		_spec.SetField(strategyrun.FieldUnrealizedProfit, field.TypeString, value)
+	}
+	if _u.mutation.UnrealizedProfitCleared() {
+		_spec.ClearField(strategyrun.FieldUnrealizedProfit, field.TypeString)
+	}
+	if value, ok := _u.mutation.TotalProfit(); ok {
+		_spec.SetField(strategyrun.FieldTotalProfit, field.TypeString, value)
+	}
+	if _u.mutation.TotalProfitCleared() {
+		_spec.ClearField(strategyrun.FieldTotalProfit, field.TypeString)
+	}
+	_node = &StrategyRun{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{strategyrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
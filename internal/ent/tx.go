@@ -12,14 +12,24 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// ExchangeAccount is the client for interacting with the ExchangeAccount builders.
+	ExchangeAccount *ExchangeAccountClient
+	// Fill is the client for interacting with the Fill builders.
+	Fill *FillClient
+	// FundingPayment is the client for interacting with the FundingPayment builders.
+	FundingPayment *FundingPaymentClient
 	// Grid is the client for interacting with the Grid builders.
 	Grid *GridClient
 	// MatchedTrade is the client for interacting with the MatchedTrade builders.
 	MatchedTrade *MatchedTradeClient
 	// Order is the client for interacting with the Order builders.
 	Order *OrderClient
+	// OrderRepair is the client for interacting with the OrderRepair builders.
+	OrderRepair *OrderRepairClient
 	// Strategy is the client for interacting with the Strategy builders.
 	Strategy *StrategyClient
+	// StrategyRun is the client for interacting with the StrategyRun builders.
+	StrategyRun *StrategyRunClient
 	// SyncProgress is the client for interacting with the SyncProgress builders.
 	SyncProgress *SyncProgressClient
 
@@ -153,10 +163,15 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.ExchangeAccount = NewExchangeAccountClient(tx.config)
+	tx.Fill = NewFillClient(tx.config)
+	tx.FundingPayment = NewFundingPaymentClient(tx.config)
 	tx.Grid = NewGridClient(tx.config)
 	tx.MatchedTrade = NewMatchedTradeClient(tx.config)
 	tx.Order = NewOrderClient(tx.config)
+	tx.OrderRepair = NewOrderRepairClient(tx.config)
 	tx.Strategy = NewStrategyClient(tx.config)
+	tx.StrategyRun = NewStrategyRunClient(tx.config)
 	tx.SyncProgress = NewSyncProgressClient(tx.config)
 }
 
@@ -167,7 +182,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Grid.QueryXXX(), the query will be executed
+// applies a query, for example: ExchangeAccount.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
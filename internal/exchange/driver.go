@@ -0,0 +1,102 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrExchangeUnsupported = errors.New("exchange unsupported")
+	ErrIntervalUnsupported = errors.New("candle interval unsupported")
+)
+
+// Driver 交易所驱动
+// 封装单个交易所的订单操作、行情订阅、市场元数据和账户查询，
+// 注册到驱动列表后由策略引擎、订单助手和Telegram机器人统一调度
+type Driver interface {
+	// Name 交易所名称，与策略记录中的 exchange 字段一致
+	Name() string
+
+	// NewOrderHelper 根据策略记录创建订单操作客户端
+	NewOrderHelper(record *ent.Strategy) (OrderHelper, error)
+
+	// Subscriber 返回交易所订阅器
+	Subscriber() Subscriber
+
+	// GetMarketMetadata 获取交易对的市场元数据
+	GetMarketMetadata(ctx context.Context, symbol string) (MarketMetadata, error)
+
+	// GetLastTradePrice 获取交易对的最新成交价格
+	GetLastTradePrice(ctx context.Context, symbol string) (decimal.Decimal, error)
+
+	// GetAccountInfo 获取策略账户的余额和持仓信息
+	GetAccountInfo(ctx context.Context, record *ent.Strategy) (*Account, error)
+
+	// TestConnectivity 测试策略账户配置是否可以正常访问交易所
+	TestConnectivity(ctx context.Context, record *ent.Strategy) error
+
+	// MarketURL 返回交易对在交易所网页端的链接
+	MarketURL(symbol string) string
+}
+
+// CandleProvider 历史K线查询
+// 交易所驱动可选实现，用于根据近期行情回测推荐网格参数
+type CandleProvider interface {
+	// GetCandles 获取 [start, end] 区间内的K线，按时间升序返回
+	// 交易所不支持指定的K线周期时返回 ErrIntervalUnsupported
+	GetCandles(ctx context.Context, symbol string, interval time.Duration, start, end time.Time) ([]Candle, error)
+}
+
+var (
+	driversMutex sync.RWMutex
+	drivers      = make(map[string]Driver)
+	driverNames  = make([]string, 0)
+)
+
+// Register 注册交易所驱动
+// 同名驱动重复注册会触发 panic
+func Register(driver Driver) {
+	if driver == nil {
+		panic("exchange: Register driver is nil")
+	}
+
+	driversMutex.Lock()
+	defer driversMutex.Unlock()
+
+	name := driver.Name()
+	if _, dup := drivers[name]; dup {
+		panic(fmt.Sprintf("exchange: Register called twice for driver %s", name))
+	}
+	drivers[name] = driver
+	driverNames = append(driverNames, name)
+}
+
+// GetDriver 根据交易所名称获取已注册的驱动
+func GetDriver(name string) (Driver, error) {
+	driversMutex.RLock()
+	defer driversMutex.RUnlock()
+
+	driver, ok := drivers[name]
+	if !ok {
+		return nil, ErrExchangeUnsupported
+	}
+	return driver, nil
+}
+
+// Drivers 按注册顺序返回所有已注册的驱动
+func Drivers() []Driver {
+	driversMutex.RLock()
+	defer driversMutex.RUnlock()
+
+	list := make([]Driver, 0, len(driverNames))
+	for _, name := range driverNames {
+		list = append(list, drivers[name])
+	}
+	return list
+}
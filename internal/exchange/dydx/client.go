@@ -0,0 +1,313 @@
+// Package dydx 提供dYdX v4永续合约交易所的客户端实现
+// 通过索引器查询行情和订单，通过验证节点广播签名后的下单、撤单交易
+package dydx
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// dYdX 主网地址
+const (
+	MainnetChainId      = "dydx-mainnet-1"
+	MainnetIndexerURL   = "https://indexer.dydx.trade/v4"
+	MainnetIndexerWsURL = "wss://indexer.dydx.trade/v4/ws"
+	MainnetValidatorURL = "https://dydx-rest.publicnode.com"
+)
+
+var (
+	ErrMarketNotFound = errors.New("market not found")
+)
+
+// Client dYdX交易所HTTP客户端
+// 封装索引器REST接口和验证节点REST接口，并缓存永续合约市场信息
+type Client struct {
+	chainId      string       // 链ID
+	indexerURL   string       // 索引器地址
+	validatorURL string       // 验证节点地址
+	httpClient   *http.Client // HTTP客户端
+
+	mutex   sync.RWMutex
+	markets map[string]*PerpetualMarket // 市场信息缓存
+}
+
+// NewClient 创建dYdX主网客户端
+// validatorURL 为空时使用默认公共节点
+func NewClient(httpClient *http.Client, validatorURL string) *Client {
+	if validatorURL == "" {
+		validatorURL = MainnetValidatorURL
+	}
+	return NewClientWithEndpoint(httpClient, MainnetChainId, MainnetIndexerURL, validatorURL)
+}
+
+// NewClientWithEndpoint 创建指定端点的dYdX客户端
+// 用于连接测试网或本地模拟服务
+func NewClientWithEndpoint(httpClient *http.Client, chainId, indexerURL, validatorURL string) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{
+		chainId:      chainId,
+		indexerURL:   indexerURL,
+		validatorURL: validatorURL,
+		httpClient:   httpClient,
+		markets:      make(map[string]*PerpetualMarket),
+	}
+}
+
+// ChainId 链ID
+func (c *Client) ChainId() string {
+	return c.chainId
+}
+
+// GetPerpetualMarket 获取永续合约市场信息
+// 市场参数在链上极少变更，优先返回本地缓存
+func (c *Client) GetPerpetualMarket(ctx context.Context, ticker string) (*PerpetualMarket, error) {
+	c.mutex.RLock()
+	market, ok := c.markets[ticker]
+	c.mutex.RUnlock()
+	if ok {
+		return market, nil
+	}
+
+	market, err := c.fetchPerpetualMarket(ctx, ticker)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mutex.Lock()
+	c.markets[ticker] = market
+	c.mutex.Unlock()
+
+	return market, nil
+}
+
+// GetOraclePrice 获取预言机价格
+func (c *Client) GetOraclePrice(ctx context.Context, ticker string) (decimal.Decimal, error) {
+	market, err := c.fetchPerpetualMarket(ctx, ticker)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return market.OraclePrice, nil
+}
+
+// GetHeight 获取索引器最新区块高度
+func (c *Client) GetHeight(ctx context.Context) (uint32, error) {
+	var res HeightRes
+	if err := c.get(ctx, c.indexerURL+"/height", &res); err != nil {
+		return 0, err
+	}
+
+	height, err := strconv.ParseUint(res.Height, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(height), nil
+}
+
+// GetSubaccount 获取子账户信息
+func (c *Client) GetSubaccount(ctx context.Context, address string, subaccountNumber uint32) (*Subaccount, error) {
+	var res SubaccountRes
+	path := fmt.Sprintf("%s/addresses/%s/subaccountNumber/%d", c.indexerURL, address, subaccountNumber)
+	if err := c.get(ctx, path, &res); err != nil {
+		return nil, err
+	}
+	return &res.Subaccount, nil
+}
+
+// GetOrders 获取子账户订单列表
+// status 为空时返回所有状态的订单
+func (c *Client) GetOrders(ctx context.Context, address string, subaccountNumber uint32, ticker string, status OrderStatus, limit int) ([]*Order, error) {
+	query := url.Values{
+		"address":          {address},
+		"subaccountNumber": {strconv.FormatUint(uint64(subaccountNumber), 10)},
+		"limit":            {strconv.Itoa(limit)},
+	}
+	if ticker != "" {
+		query.Set("ticker", ticker)
+	}
+	if status != "" {
+		query.Set("status", string(status))
+	}
+
+	var orders []*Order
+	if err := c.get(ctx, c.indexerURL+"/orders?"+query.Encode(), &orders); err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// GetFills 获取子账户成交记录
+// 按成交时间倒序返回，page 从 1 开始
+func (c *Client) GetFills(ctx context.Context, address string, subaccountNumber uint32, limit, page int) ([]*Fill, error) {
+	query := url.Values{
+		"address":          {address},
+		"subaccountNumber": {strconv.FormatUint(uint64(subaccountNumber), 10)},
+		"limit":            {strconv.Itoa(limit)},
+		"page":             {strconv.Itoa(page)},
+	}
+
+	var res FillsRes
+	if err := c.get(ctx, c.indexerURL+"/fills?"+query.Encode(), &res); err != nil {
+		return nil, err
+	}
+	return res.Fills, nil
+}
+
+// GetFundingPayments 获取子账户在指定交易对 afterOrAt 之后的资金费用记录
+// 按结算时间倒序返回，page 从 1 开始
+func (c *Client) GetFundingPayments(ctx context.Context, address string, subaccountNumber uint32, ticker string, afterOrAt time.Time, limit, page int) ([]*FundingPayment, error) {
+	query := url.Values{
+		"address":          {address},
+		"subaccountNumber": {strconv.FormatUint(uint64(subaccountNumber), 10)},
+		"ticker":           {ticker},
+		"afterOrAt":        {afterOrAt.UTC().Format(time.RFC3339)},
+		"limit":            {strconv.Itoa(limit)},
+		"page":             {strconv.Itoa(page)},
+	}
+
+	var res FundingPaymentsRes
+	if err := c.get(ctx, c.indexerURL+"/fundingPayments?"+query.Encode(), &res); err != nil {
+		return nil, err
+	}
+	return res.FundingPayments, nil
+}
+
+// GetCandles 获取历史K线
+// resolution 为 1MIN/5MINS/15MINS/30MINS/1HOUR/4HOURS/1DAY，按时间倒序返回 [from, to] 区间内最近的 limit 根K线
+func (c *Client) GetCandles(ctx context.Context, ticker, resolution string, from, to time.Time, limit int) ([]*Candle, error) {
+	query := url.Values{
+		"resolution": {resolution},
+		"fromISO":    {from.UTC().Format(time.RFC3339)},
+		"toISO":      {to.UTC().Format(time.RFC3339)},
+		"limit":      {strconv.Itoa(limit)},
+	}
+
+	var res CandlesRes
+	path := fmt.Sprintf("%s/candles/perpetualMarkets/%s?%s", c.indexerURL, ticker, query.Encode())
+	if err := c.get(ctx, path, &res); err != nil {
+		return nil, err
+	}
+	return res.Candles, nil
+}
+
+// GetAccount 获取链上账户编号和序号
+func (c *Client) GetAccount(ctx context.Context, address string) (accountNumber, sequence uint64, err error) {
+	var res AccountRes
+	if err = c.get(ctx, fmt.Sprintf("%s/cosmos/auth/v1beta1/accounts/%s", c.validatorURL, address), &res); err != nil {
+		return 0, 0, err
+	}
+
+	accountNumber, err = strconv.ParseUint(res.Account.AccountNumber, 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	sequence, err = strconv.ParseUint(res.Account.Sequence, 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	return accountNumber, sequence, nil
+}
+
+// BroadcastTx 同步广播已签名的交易
+func (c *Client) BroadcastTx(ctx context.Context, txBytes []byte) (*TxResponse, error) {
+	body := map[string]string{
+		"tx_bytes": base64.StdEncoding.EncodeToString(txBytes),
+		"mode":     "BROADCAST_MODE_SYNC",
+	}
+
+	var res BroadcastTxRes
+	if err := c.post(ctx, c.validatorURL+"/cosmos/tx/v1beta1/txs", body, &res); err != nil {
+		return nil, err
+	}
+	if res.TxResponse.Code != 0 {
+		return &res.TxResponse, &TxError{Codespace: res.TxResponse.Codespace, Code: res.TxResponse.Code, RawLog: res.TxResponse.RawLog}
+	}
+	return &res.TxResponse, nil
+}
+
+// fetchPerpetualMarket 从索引器查询永续合约市场信息
+func (c *Client) fetchPerpetualMarket(ctx context.Context, ticker string) (*PerpetualMarket, error) {
+	var res PerpetualMarketsRes
+	query := url.Values{"ticker": {ticker}}
+	if err := c.get(ctx, c.indexerURL+"/perpetualMarkets?"+query.Encode(), &res); err != nil {
+		return nil, err
+	}
+
+	market, ok := res.Markets[ticker]
+	if !ok {
+		return nil, ErrMarketNotFound
+	}
+	return market, nil
+}
+
+// get 发送GET请求并解析JSON响应
+func (c *Client) get(ctx context.Context, url string, result any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	return c.do(req, result)
+}
+
+// post 发送POST请求并解析JSON响应
+func (c *Client) post(ctx context.Context, url string, body any, result any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return c.do(req, result)
+}
+
+func (c *Client) do(req *http.Request, result any) error {
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("http status: %d, body: %s", res.StatusCode, string(data))
+	}
+
+	return json.Unmarshal(data, result)
+}
+
+// TxError 交易执行错误
+type TxError struct {
+	Codespace string
+	Code      uint32
+	RawLog    string
+}
+
+func (e *TxError) Error() string {
+	return fmt.Sprintf("tx error, codespace: %s, code: %d, log: %s", e.Codespace, e.Code, e.RawLog)
+}
+
+// IsSequenceMismatch 是否为账户序号不匹配错误
+func (e *TxError) IsSequenceMismatch() bool {
+	return e.Codespace == "sdk" && e.Code == 32
+}
@@ -0,0 +1,331 @@
+package dydx
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/fachebot/omni-grid-bot/internal/config"
+	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/gorilla/websocket"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	testMnemonic = "mirror actor skill push coach wait confirm orchard lunch mobile athlete gossip awake miracle matter bus reopen team ladder lazy list timber render wait"
+	testAddress  = "dydx14zzueazeh0hj67cghhf9jypslcf9sh2n5k6art"
+)
+
+func readTestData(t *testing.T, name string) []byte {
+	t.Helper()
+
+	data, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatalf("读取测试数据失败, %s, %v", name, err)
+	}
+	return data
+}
+
+// newTestServer 创建回放录制数据的索引器和验证节点服务
+func newTestServer(t *testing.T, broadcast func(txBytes []byte)) *httptest.Server {
+	t.Helper()
+
+	routes := map[string]string{
+		"/v4/perpetualMarkets":                 "perpetual_markets.json",
+		"/v4/height":                           "height.json",
+		"/v4/orders":                           "orders.json",
+		"/v4/candles/perpetualMarkets/BTC-USD": "candles.json",
+		"/v4/addresses/" + testAddress + "/subaccountNumber/0": "subaccount.json",
+		"/cosmos/auth/v1beta1/accounts/" + testAddress:         "account.json",
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/cosmos/tx/v1beta1/txs" {
+			var req struct {
+				TxBytes string `json:"tx_bytes"`
+			}
+			body, _ := io.ReadAll(r.Body)
+			json.Unmarshal(body, &req)
+			txBytes, _ := base64.StdEncoding.DecodeString(req.TxBytes)
+			broadcast(txBytes)
+			w.Write(readTestData(t, "broadcast_tx.json"))
+			return
+		}
+
+		name, ok := routes[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(readTestData(t, name))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestWallet(t *testing.T) {
+	wallet, err := NewWallet(testMnemonic)
+	if err != nil {
+		t.Fatalf("NewWallet() error = %v", err)
+	}
+	if wallet.Address() != testAddress {
+		t.Errorf("Address() = %s, expected %s", wallet.Address(), testAddress)
+	}
+
+	signDoc := []byte("sign doc")
+	signature, err := wallet.Sign(signDoc)
+	if err != nil || len(signature) != 64 {
+		t.Fatalf("Sign() = %x, %v", signature, err)
+	}
+	hash := crypto.Keccak256Hash(signDoc)
+	if crypto.VerifySignature(wallet.PubKey(), hash[:], signature) {
+		t.Errorf("Sign() 应该使用 sha256 摘要")
+	}
+
+	if !IsValidAddress(testAddress) {
+		t.Errorf("IsValidAddress(%s) = false", testAddress)
+	}
+	if IsValidAddress(testAddress[:len(testAddress)-1] + "q") {
+		t.Errorf("IsValidAddress() 应该校验 checksum")
+	}
+}
+
+func TestEncodeOrder(t *testing.T) {
+	ord := &ChainOrder{
+		OrderId: OrderId{
+			Owner:      "dydx1",
+			ClientId:   1,
+			OrderFlags: OrderFlagsLongTerm,
+		},
+		Side:             ChainSideBuy,
+		Quantums:         15000000,
+		Subticks:         6500100000,
+		GoodTilBlockTime: 1800000000,
+	}
+
+	got := hex.EncodeToString(encodeOrder(ord))
+	expected := "0a100a070a05647964783115010000001840100118c0c3930720a0cfbe9b183500d2496b"
+	if got != expected {
+		t.Errorf("encodeOrder() = %s, expected %s", got, expected)
+	}
+}
+
+func TestQuantumsAndSubticks(t *testing.T) {
+	var res PerpetualMarketsRes
+	json.Unmarshal(readTestData(t, "perpetual_markets.json"), &res)
+	market := res.Markets["BTC-USD"]
+
+	tests := []struct {
+		size     string
+		price    string
+		quantums uint64
+		subticks uint64
+	}{
+		{size: "0.0015", price: "65000.5", quantums: 15000000, subticks: 6500100000},
+		{size: "0.00159", price: "108231.45", quantums: 15000000, subticks: 10823100000},
+		{size: "0.00001", price: "0.1", quantums: 1000000, subticks: 100000},
+	}
+
+	for _, tt := range tests {
+		quantums := CalculateQuantums(decimal.RequireFromString(tt.size), market)
+		subticks := CalculateSubticks(decimal.RequireFromString(tt.price), market)
+		if quantums != tt.quantums || subticks != tt.subticks {
+			t.Errorf("size: %s, price: %s, got %d/%d, expected %d/%d", tt.size, tt.price, quantums, subticks, tt.quantums, tt.subticks)
+		}
+	}
+}
+
+func TestUserClient(t *testing.T) {
+	var txs [][]byte
+	server := newTestServer(t, func(txBytes []byte) {
+		txs = append(txs, txBytes)
+	})
+
+	client := NewClientWithEndpoint(server.Client(), "dydx-testnet-4", server.URL+"/v4", server.URL)
+	userClient, err := NewUserClient(client, testAddress, testMnemonic)
+	if err != nil {
+		t.Fatalf("NewUserClient() error = %v", err)
+	}
+	ctx := context.Background()
+
+	if _, err = NewUserClient(client, "dydx1invalid", testMnemonic); err != ErrAddressMismatch {
+		t.Errorf("NewUserClient() error = %v, expected %v", err, ErrAddressMismatch)
+	}
+
+	// 历史K线
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	candles, err := client.GetCandles(ctx, "BTC-USD", "1HOUR", from, from.Add(time.Hour), 100)
+	if err != nil || len(candles) != 2 {
+		t.Fatalf("GetCandles() = %d, %v", len(candles), err)
+	}
+	if candle := ConvertCandle(candles[1]); !candle.Time.Equal(from) || !candle.Low.Equal(decimal.RequireFromString("93310")) {
+		t.Errorf("ConvertCandle() = %+v", candle)
+	}
+
+	// 订单列表
+	orders, err := userClient.GetOrders(ctx, "BTC-USD", "", 100)
+	if err != nil || len(orders) != 2 {
+		t.Fatalf("GetOrders() = %d, %v", len(orders), err)
+	}
+	ord, err := ConvertOrder(orders[0])
+	if err != nil || ord.Symbol != "BTC" || ord.Status != order.StatusFilled || ord.Timestamp != 1792137492345 {
+		t.Errorf("ConvertOrder() = %+v, %v", ord, err)
+	}
+
+	// 子账户
+	subaccount, err := userClient.GetSubaccount(ctx)
+	if err != nil || !subaccount.OpenPerpetualPositions["BTC-USD"].Size.Equal(decimal.RequireFromString("0.0015")) {
+		t.Fatalf("GetSubaccount() = %+v, %v", subaccount, err)
+	}
+
+	// 长期订单消耗账户序号
+	req := PlaceOrderRequest{
+		Ticker:   "BTC-USD",
+		Side:     ChainSideSell,
+		Price:    decimal.RequireFromString("109000"),
+		Size:     decimal.RequireFromString("0.0015"),
+		ClientId: 1823456790,
+	}
+	if err = userClient.PlaceOrder(ctx, req); err != nil {
+		t.Fatalf("PlaceOrder() error = %v", err)
+	}
+	if err = userClient.CancelOrder(ctx, orders[1]); err != nil {
+		t.Fatalf("CancelOrder() error = %v", err)
+	}
+	if len(txs) != 2 || userClient.sequence != 58 {
+		t.Errorf("broadcast count = %d, sequence = %d", len(txs), userClient.sequence)
+	}
+
+	// 短期订单不消耗账户序号
+	req.ShortTerm = true
+	req.TimeInForce = ChainTimeInForceIoc
+	if err = userClient.PlaceOrder(ctx, req); err != nil {
+		t.Fatalf("PlaceOrder() error = %v", err)
+	}
+	if userClient.sequence != 58 {
+		t.Errorf("sequence = %d, expected 58", userClient.sequence)
+	}
+
+	// 交易中包含下单消息类型和链ID签名
+	if !strings.Contains(string(txs[0]), typeURLMsgPlaceOrder) || !strings.Contains(string(txs[1]), typeURLMsgCancelOrder) {
+		t.Errorf("交易消息类型不正确")
+	}
+}
+
+func TestSubscriber(t *testing.T) {
+	frames, err := os.Open("testdata/ws_frames.jsonl")
+	if err != nil {
+		t.Fatalf("读取测试数据失败, %v", err)
+	}
+	defer frames.Close()
+
+	messages := make([]WebSocketMessage, 0)
+	raws := make([][]byte, 0)
+	scanner := bufio.NewScanner(frames)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var v WebSocketMessage
+		json.Unmarshal(scanner.Bytes(), &v)
+		messages = append(messages, v)
+		raws = append(raws, []byte(scanner.Text()))
+	}
+
+	// 回放录制的推送消息
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		conn.WriteMessage(websocket.TextMessage, raws[0])
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+
+			var req struct {
+				Channel string `json:"channel"`
+			}
+			json.Unmarshal(data, &req)
+
+			replay := func() error {
+				for idx, v := range messages {
+					if v.Channel == req.Channel {
+						if err := conn.WriteMessage(websocket.TextMessage, raws[idx]); err != nil {
+							return err
+						}
+					}
+				}
+				return nil
+			}
+			if req.Channel != "v4_markets" {
+				replay()
+				continue
+			}
+
+			// 行情数据持续推送，直到订阅方登记交易对
+			go func() {
+				for replay() == nil {
+					time.Sleep(200 * time.Millisecond)
+				}
+			}()
+		}
+	}))
+	defer server.Close()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+	subscriber := NewDydxSubscriber(url, config.Sock5Proxy{})
+	subChan := subscriber.SubscriptionChan()
+	subscriber.Start()
+	defer subscriber.Stop()
+
+	if err := subscriber.SubscribeMarketStats("BTC"); err != nil {
+		t.Fatalf("SubscribeMarketStats() error = %v", err)
+	}
+	if err := subscriber.SubscribeAccountOrders(testAddress); err != nil {
+		t.Fatalf("SubscribeAccountOrders() error = %v", err)
+	}
+
+	var snapshot, updates, stats bool
+	timeout := time.After(5 * time.Second)
+	for !snapshot || !updates || !stats {
+		select {
+		case msg := <-subChan:
+			if msg.Exchange != exchange.Dydx {
+				t.Errorf("SubMessage.Exchange = %s", msg.Exchange)
+			}
+			if msg.MarketStats != nil {
+				if msg.MarketStats.Symbol != "BTC" {
+					t.Errorf("MarketStats.Symbol = %s, expected BTC", msg.MarketStats.Symbol)
+				}
+				stats = true
+				continue
+			}
+			if msg.UserOrders.IsSnapshot {
+				snapshot = true
+				continue
+			}
+			updates = true
+			ord := msg.UserOrders.Orders[0]
+			if ord.ClientOrderID != "1823456789" || ord.Status != order.StatusFilled {
+				t.Errorf("UserOrders.Orders[0] = %+v", ord)
+			}
+		case <-timeout:
+			t.Fatalf("等待推送超时, snapshot: %v, updates: %v, stats: %v", snapshot, updates, stats)
+		}
+	}
+}
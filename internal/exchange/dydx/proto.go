@@ -0,0 +1,192 @@
+package dydx
+
+import (
+	"encoding/binary"
+)
+
+// protobuf 线类型
+const (
+	wireVarint  = 0
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// protoEncoder 最小化的 protobuf 编码器
+// 仅覆盖下单、撤单和交易签名所需的字段类型，省略默认值字段以与官方编码保持一致
+type protoEncoder struct {
+	buf []byte
+}
+
+func (e *protoEncoder) bytes() []byte {
+	return e.buf
+}
+
+func (e *protoEncoder) tag(field int, wireType int) {
+	e.buf = binary.AppendUvarint(e.buf, uint64(field<<3|wireType))
+}
+
+func (e *protoEncoder) uint64(field int, v uint64) {
+	if v == 0 {
+		return
+	}
+	e.tag(field, wireVarint)
+	e.buf = binary.AppendUvarint(e.buf, v)
+}
+
+func (e *protoEncoder) uint32(field int, v uint32) {
+	e.uint64(field, uint64(v))
+}
+
+func (e *protoEncoder) bool(field int, v bool) {
+	if !v {
+		return
+	}
+	e.tag(field, wireVarint)
+	e.buf = append(e.buf, 1)
+}
+
+func (e *protoEncoder) fixed32(field int, v uint32) {
+	if v == 0 {
+		return
+	}
+	e.tag(field, wireFixed32)
+	e.buf = binary.LittleEndian.AppendUint32(e.buf, v)
+}
+
+func (e *protoEncoder) bytesField(field int, v []byte) {
+	if len(v) == 0 {
+		return
+	}
+	e.tag(field, wireBytes)
+	e.buf = binary.AppendUvarint(e.buf, uint64(len(v)))
+	e.buf = append(e.buf, v...)
+}
+
+func (e *protoEncoder) string(field int, v string) {
+	e.bytesField(field, []byte(v))
+}
+
+// message 嵌套消息总是写入，即使内容为空
+func (e *protoEncoder) message(field int, v []byte) {
+	e.tag(field, wireBytes)
+	e.buf = binary.AppendUvarint(e.buf, uint64(len(v)))
+	e.buf = append(e.buf, v...)
+}
+
+// encodeAny 编码 google.protobuf.Any
+func encodeAny(typeURL string, value []byte) []byte {
+	var e protoEncoder
+	e.string(1, typeURL)
+	e.bytesField(2, value)
+	return e.bytes()
+}
+
+// encodeSubaccountId 编码 dydxprotocol.subaccounts.SubaccountId
+func encodeSubaccountId(owner string, number uint32) []byte {
+	var e protoEncoder
+	e.string(1, owner)
+	e.uint32(2, number)
+	return e.bytes()
+}
+
+// encodeOrderId 编码 dydxprotocol.clob.OrderId
+func encodeOrderId(id OrderId) []byte {
+	var e protoEncoder
+	e.message(1, encodeSubaccountId(id.Owner, id.SubaccountNumber))
+	e.fixed32(2, id.ClientId)
+	e.uint32(3, id.OrderFlags)
+	e.uint32(4, id.ClobPairId)
+	return e.bytes()
+}
+
+// encodeOrder 编码 dydxprotocol.clob.Order
+func encodeOrder(order *ChainOrder) []byte {
+	var e protoEncoder
+	e.message(1, encodeOrderId(order.OrderId))
+	e.uint32(2, uint32(order.Side))
+	e.uint64(3, order.Quantums)
+	e.uint64(4, order.Subticks)
+	if order.OrderId.OrderFlags == OrderFlagsShortTerm {
+		e.uint32(5, order.GoodTilBlock)
+	} else {
+		e.fixed32(6, order.GoodTilBlockTime)
+	}
+	e.uint32(7, uint32(order.TimeInForce))
+	e.bool(8, order.ReduceOnly)
+	e.uint32(9, order.ClientMetadata)
+	return e.bytes()
+}
+
+// encodeMsgPlaceOrder 编码 dydxprotocol.clob.MsgPlaceOrder
+func encodeMsgPlaceOrder(order *ChainOrder) []byte {
+	var e protoEncoder
+	e.message(1, encodeOrder(order))
+	return e.bytes()
+}
+
+// encodeMsgCancelOrder 编码 dydxprotocol.clob.MsgCancelOrder
+func encodeMsgCancelOrder(id OrderId, goodTilBlock, goodTilBlockTime uint32) []byte {
+	var e protoEncoder
+	e.message(1, encodeOrderId(id))
+	if id.OrderFlags == OrderFlagsShortTerm {
+		e.uint32(2, goodTilBlock)
+	} else {
+		e.fixed32(3, goodTilBlockTime)
+	}
+	return e.bytes()
+}
+
+// encodeTxBody 编码 cosmos.tx.v1beta1.TxBody
+func encodeTxBody(messages [][]byte, memo string) []byte {
+	var e protoEncoder
+	for _, msg := range messages {
+		e.message(1, msg)
+	}
+	e.string(2, memo)
+	return e.bytes()
+}
+
+// encodeAuthInfo 编码 cosmos.tx.v1beta1.AuthInfo
+// 使用 SIGN_MODE_DIRECT 单签名，手续费为空(clob 消息免 Gas)
+func encodeAuthInfo(pubKey []byte, sequence uint64, gasLimit uint64) []byte {
+	var key protoEncoder
+	key.bytesField(1, pubKey)
+
+	var single protoEncoder
+	single.uint32(1, signModeDirect)
+
+	var modeInfo protoEncoder
+	modeInfo.message(1, single.bytes())
+
+	var signerInfo protoEncoder
+	signerInfo.message(1, encodeAny(typeURLPubKey, key.bytes()))
+	signerInfo.message(2, modeInfo.bytes())
+	signerInfo.uint64(3, sequence)
+
+	var fee protoEncoder
+	fee.uint64(2, gasLimit)
+
+	var e protoEncoder
+	e.message(1, signerInfo.bytes())
+	e.message(2, fee.bytes())
+	return e.bytes()
+}
+
+// encodeSignDoc 编码 cosmos.tx.v1beta1.SignDoc
+func encodeSignDoc(bodyBytes, authInfoBytes []byte, chainId string, accountNumber uint64) []byte {
+	var e protoEncoder
+	e.bytesField(1, bodyBytes)
+	e.bytesField(2, authInfoBytes)
+	e.string(3, chainId)
+	e.uint64(4, accountNumber)
+	return e.bytes()
+}
+
+// encodeTxRaw 编码 cosmos.tx.v1beta1.TxRaw
+func encodeTxRaw(bodyBytes, authInfoBytes, signature []byte) []byte {
+	var e protoEncoder
+	e.bytesField(1, bodyBytes)
+	e.bytesField(2, authInfoBytes)
+	e.bytesField(3, signature)
+	return e.bytes()
+}
@@ -0,0 +1,180 @@
+// Package dydx 提供dYdX交易所的订阅管理实现
+// 管理公共市场数据连接和用户订单WebSocket连接的生命周期
+package dydx
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/fachebot/omni-grid-bot/internal/config"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"golang.org/x/sync/singleflight"
+)
+
+type DydxSubscriber struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	url    string
+	proxy  config.Sock5Proxy
+
+	mutex     sync.Mutex
+	wg        sync.WaitGroup
+	sf        singleflight.Group
+	publicWs  *DydxPubWS
+	userConns map[string]*DydxWS
+	stopped   atomic.Bool
+
+	subMsgChan        chan exchange.SubMessage
+	userOrdersInChan  chan exchange.UserOrders
+	marketStatsInChan chan exchange.MarketStats
+}
+
+func NewDydxSubscriber(url string, proxy config.Sock5Proxy) *DydxSubscriber {
+	ctx, cancel := context.WithCancel(context.Background())
+	subscriber := &DydxSubscriber{
+		ctx:               ctx,
+		cancel:            cancel,
+		url:               url,
+		proxy:             proxy,
+		userConns:         make(map[string]*DydxWS),
+		userOrdersInChan:  make(chan exchange.UserOrders, 1024*8),
+		marketStatsInChan: make(chan exchange.MarketStats, 1024*8),
+	}
+	subscriber.publicWs = NewDydxPubWS(ctx, url, subscriber.marketStatsInChan, proxy)
+
+	return subscriber
+}
+
+func (subscriber *DydxSubscriber) Stop() {
+	logger.Infof("[DydxSubscriber] 准备停止服务")
+
+	if !subscriber.stopped.CompareAndSwap(false, true) {
+		logger.Warnf("[DydxSubscriber] 服务已经停止")
+		return
+	}
+
+	// 关闭所有连接
+	subscriber.mutex.Lock()
+	conns := make([]*DydxWS, 0, len(subscriber.userConns))
+	for _, conn := range subscriber.userConns {
+		conns = append(conns, conn)
+	}
+	subscriber.mutex.Unlock()
+
+	for _, conn := range conns {
+		conn.Stop()
+	}
+	subscriber.wg.Wait()
+
+	subscriber.publicWs.Stop()
+
+	// 清理服务资源
+	subscriber.cancel()
+	close(subscriber.userOrdersInChan)
+	if subscriber.subMsgChan != nil {
+		close(subscriber.subMsgChan)
+		subscriber.subMsgChan = nil
+	}
+
+	logger.Infof("[DydxSubscriber] 服务已经停止")
+}
+
+func (subscriber *DydxSubscriber) Start() {
+	subscriber.publicWs.Start()
+	subscriber.publicWs.WaitUntilConnected()
+
+	logger.Infof("[DydxSubscriber] 开始运行服务")
+
+	go subscriber.run()
+}
+
+func (subscriber *DydxSubscriber) SubscriptionChan() <-chan exchange.SubMessage {
+	if subscriber.subMsgChan == nil {
+		subscriber.subMsgChan = make(chan exchange.SubMessage, 1024*8)
+	}
+	return subscriber.subMsgChan
+}
+
+func (subscriber *DydxSubscriber) SubscribeMarketStats(symbol string) error {
+	return subscriber.publicWs.SubscribeMarketStats(symbol)
+}
+
+func (subscriber *DydxSubscriber) UnsubscribeMarketStats(symbol string) error {
+	return subscriber.publicWs.UnsubscribeMarketStats(symbol)
+}
+
+func (subscriber *DydxSubscriber) SubscribeAccountOrders(account string) error {
+	_, err, _ := subscriber.sf.Do(account, func() (any, error) {
+		subscriber.mutex.Lock()
+		if _, ok := subscriber.userConns[account]; ok {
+			subscriber.mutex.Unlock()
+			return nil, nil
+		}
+		subscriber.mutex.Unlock()
+
+		subscriber.wg.Add(1)
+		ws := NewDydxWS(
+			subscriber.ctx,
+			subscriber.url,
+			account,
+			subscriber.userOrdersInChan,
+			subscriber.proxy,
+			subscriber.onWsServiceStopped,
+		)
+		ws.Start()
+
+		subscriber.mutex.Lock()
+		subscriber.userConns[account] = ws
+		subscriber.mutex.Unlock()
+
+		return nil, nil
+	})
+
+	return err
+}
+
+func (subscriber *DydxSubscriber) UnsubscribeAccountOrders(account string) error {
+	_, err, _ := subscriber.sf.Do(account, func() (any, error) {
+		subscriber.mutex.Lock()
+		ws, ok := subscriber.userConns[account]
+		if !ok {
+			subscriber.mutex.Unlock()
+			return nil, nil
+		}
+		delete(subscriber.userConns, account)
+		subscriber.mutex.Unlock()
+
+		ws.Stop()
+
+		return nil, nil
+	})
+
+	return err
+}
+
+func (subscriber *DydxSubscriber) run() {
+	for {
+		select {
+		case <-subscriber.ctx.Done():
+			return
+		case data := <-subscriber.userOrdersInChan:
+			if subscriber.subMsgChan != nil {
+				subscriber.subMsgChan <- exchange.SubMessage{Exchange: exchange.Dydx, UserOrders: &data}
+			}
+		case data := <-subscriber.marketStatsInChan:
+			if subscriber.subMsgChan != nil {
+				subscriber.subMsgChan <- exchange.SubMessage{Exchange: exchange.Dydx, MarketStats: &data}
+			}
+		}
+	}
+}
+
+func (subscriber *DydxSubscriber) onWsServiceStopped(account string) {
+	subscriber.mutex.Lock()
+	delete(subscriber.userConns, account)
+	subscriber.mutex.Unlock()
+
+	subscriber.wg.Done()
+}
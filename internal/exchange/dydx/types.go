@@ -0,0 +1,273 @@
+package dydx
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// 链上消息类型和签名参数
+const (
+	typeURLPubKey         = "/cosmos.crypto.secp256k1.PubKey"
+	typeURLMsgPlaceOrder  = "/dydxprotocol.clob.MsgPlaceOrder"
+	typeURLMsgCancelOrder = "/dydxprotocol.clob.MsgCancelOrder"
+
+	signModeDirect = 1
+)
+
+// 订单标志位
+const (
+	OrderFlagsShortTerm   uint32 = 0  // 短期订单, 使用 goodTilBlock, 不占用账户序号
+	OrderFlagsConditional uint32 = 32 // 条件单
+	OrderFlagsLongTerm    uint32 = 64 // 长期(有状态)订单, 使用 goodTilBlockTime
+)
+
+// 订单有效期约束
+const (
+	// ShortBlockWindow 短期订单 goodTilBlock 最多超前当前区块的数量
+	ShortBlockWindow = 20
+
+	// StatefulOrderTimeWindow 长期订单 goodTilBlockTime 最长有效期
+	StatefulOrderTimeWindow = 95 * 24 * time.Hour
+)
+
+// ChainSide 链上订单方向
+type ChainSide uint32
+
+const (
+	ChainSideBuy  ChainSide = 1
+	ChainSideSell ChainSide = 2
+)
+
+// ChainTimeInForce 链上订单有效期类型
+type ChainTimeInForce uint32
+
+const (
+	ChainTimeInForceUnspecified ChainTimeInForce = 0 // 一直有效直到过期
+	ChainTimeInForceIoc         ChainTimeInForce = 1 // 立即成交否则取消
+	ChainTimeInForcePostOnly    ChainTimeInForce = 2 // 只做Maker
+	ChainTimeInForceFillOrKill  ChainTimeInForce = 3 // 全部成交否则取消
+)
+
+// OrderId 链上订单ID
+type OrderId struct {
+	Owner            string // 账户地址
+	SubaccountNumber uint32 // 子账户编号
+	ClientId         uint32 // 客户端订单ID
+	OrderFlags       uint32 // 订单标志位
+	ClobPairId       uint32 // 交易对ID
+}
+
+// ChainOrder 链上订单
+type ChainOrder struct {
+	OrderId          OrderId
+	Side             ChainSide
+	Quantums         uint64 // 数量(基础资产最小单位)
+	Subticks         uint64 // 价格(最小价格单位)
+	GoodTilBlock     uint32 // 短期订单有效区块
+	GoodTilBlockTime uint32 // 长期订单有效时间(秒)
+	TimeInForce      ChainTimeInForce
+	ReduceOnly       bool
+	ClientMetadata   uint32
+}
+
+// OrderSide 索引器订单方向
+type OrderSide string
+
+const (
+	OrderSideBuy  OrderSide = "BUY"
+	OrderSideSell OrderSide = "SELL"
+)
+
+// OrderStatus 索引器订单状态
+type OrderStatus string
+
+const (
+	OrderStatusOpen               OrderStatus = "OPEN"                 // 挂单中
+	OrderStatusFilled             OrderStatus = "FILLED"               // 完全成交
+	OrderStatusCanceled           OrderStatus = "CANCELED"             // 已取消
+	OrderStatusBestEffortCanceled OrderStatus = "BEST_EFFORT_CANCELED" // 短期订单撤单已提交
+	OrderStatusBestEffortOpened   OrderStatus = "BEST_EFFORT_OPENED"   // 短期订单已提交
+	OrderStatusUntriggered        OrderStatus = "UNTRIGGERED"          // 条件单未触发
+)
+
+// PerpetualMarket 永续合约市场信息
+type PerpetualMarket struct {
+	ClobPairId                string          `json:"clobPairId"`                // 交易对ID
+	Ticker                    string          `json:"ticker"`                    // 交易对, 例如 BTC-USD
+	Status                    string          `json:"status"`                    // 市场状态
+	OraclePrice               decimal.Decimal `json:"oraclePrice"`               // 预言机价格
+	AtomicResolution          int32           `json:"atomicResolution"`          // 基础资产精度指数
+	QuantumConversionExponent int32           `json:"quantumConversionExponent"` // 价格换算指数
+	TickSize                  decimal.Decimal `json:"tickSize"`                  // 最小价格变动
+	StepSize                  decimal.Decimal `json:"stepSize"`                  // 最小数量变动
+	StepBaseQuantums          uint64          `json:"stepBaseQuantums"`          // 最小数量(quantums)
+	SubticksPerTick           uint64          `json:"subticksPerTick"`           // 每个tick的subticks
+	InitialMarginFraction     decimal.Decimal `json:"initialMarginFraction"`     // 初始保证金率
+}
+
+// PerpetualMarketsRes 永续合约市场列表响应
+type PerpetualMarketsRes struct {
+	Markets map[string]*PerpetualMarket `json:"markets"`
+}
+
+// Candle 索引器K线
+type Candle struct {
+	StartedAt       time.Time       `json:"startedAt"`       // 开盘时间
+	Ticker          string          `json:"ticker"`          // 交易对
+	Resolution      string          `json:"resolution"`      // K线周期
+	Open            decimal.Decimal `json:"open"`            // 开盘价
+	High            decimal.Decimal `json:"high"`            // 最高价
+	Low             decimal.Decimal `json:"low"`             // 最低价
+	Close           decimal.Decimal `json:"close"`           // 收盘价
+	BaseTokenVolume decimal.Decimal `json:"baseTokenVolume"` // 成交量
+	UsdVolume       decimal.Decimal `json:"usdVolume"`       // 成交额
+}
+
+// CandlesRes K线列表响应
+type CandlesRes struct {
+	Candles []*Candle `json:"candles"`
+}
+
+// Fill 索引器成交记录
+type Fill struct {
+	ID        string          `json:"id"`        // 成交ID
+	Side      OrderSide       `json:"side"`      // 成交方向
+	Liquidity string          `json:"liquidity"` // MAKER 或 TAKER
+	Type      string          `json:"type"`      // 成交类型
+	Market    string          `json:"market"`    // 交易对
+	Price     decimal.Decimal `json:"price"`     // 成交价格
+	Size      decimal.Decimal `json:"size"`      // 成交数量
+	Fee       decimal.Decimal `json:"fee"`       // 手续费(返佣时为负数)
+	CreatedAt time.Time       `json:"createdAt"` // 成交时间
+	OrderId   string          `json:"orderId"`   // 订单ID
+}
+
+// FillsRes 成交记录列表响应
+type FillsRes struct {
+	Fills []*Fill `json:"fills"`
+}
+
+// FundingPayment 索引器资金费用记录
+type FundingPayment struct {
+	CreatedAt       time.Time       `json:"createdAt"`       // 结算时间
+	CreatedAtHeight string          `json:"createdAtHeight"` // 结算区块高度
+	Ticker          string          `json:"ticker"`          // 交易对
+	OraclePrice     decimal.Decimal `json:"oraclePrice"`     // 结算时的预言机价格
+	Size            decimal.Decimal `json:"size"`            // 结算时的持仓数量
+	Side            string          `json:"side"`            // 持仓方向 LONG 或 SHORT
+	Rate            decimal.Decimal `json:"rate"`            // 资金费率
+	Payment         decimal.Decimal `json:"payment"`         // 资金费用(收取为正，支付为负)
+}
+
+// FundingPaymentsRes 资金费用记录列表响应
+type FundingPaymentsRes struct {
+	FundingPayments []*FundingPayment `json:"fundingPayments"`
+}
+
+// Order 索引器订单
+type Order struct {
+	ID               string          `json:"id"`               // 订单ID
+	SubaccountId     string          `json:"subaccountId"`     // 子账户ID
+	ClientId         string          `json:"clientId"`         // 客户端订单ID
+	ClobPairId       string          `json:"clobPairId"`       // 交易对ID
+	Side             OrderSide       `json:"side"`             // 订单方向
+	Size             decimal.Decimal `json:"size"`             // 订单数量
+	TotalFilled      decimal.Decimal `json:"totalFilled"`      // 已成交数量
+	Price            decimal.Decimal `json:"price"`            // 订单价格
+	Type             string          `json:"type"`             // 订单类型
+	Status           OrderStatus     `json:"status"`           // 订单状态
+	TimeInForce      string          `json:"timeInForce"`      // 有效期类型
+	ReduceOnly       bool            `json:"reduceOnly"`       // 是否只减仓
+	OrderFlags       string          `json:"orderFlags"`       // 订单标志位
+	GoodTilBlock     string          `json:"goodTilBlock"`     // 短期订单有效区块
+	GoodTilBlockTime string          `json:"goodTilBlockTime"` // 长期订单有效时间
+	Ticker           string          `json:"ticker"`           // 交易对
+	UpdatedAt        *time.Time      `json:"updatedAt"`        // 更新时间
+}
+
+// PerpetualPosition 永续合约持仓
+type PerpetualPosition struct {
+	Market        string          `json:"market"`        // 交易对
+	Status        string          `json:"status"`        // 持仓状态
+	Side          string          `json:"side"`          // LONG 或 SHORT
+	Size          decimal.Decimal `json:"size"`          // 持仓数量(空头为负数)
+	EntryPrice    decimal.Decimal `json:"entryPrice"`    // 开仓均价
+	RealizedPnl   decimal.Decimal `json:"realizedPnl"`   // 已实现盈亏
+	UnrealizedPnl decimal.Decimal `json:"unrealizedPnl"` // 未实现盈亏
+	NetFunding    decimal.Decimal `json:"netFunding"`    // 累计资金费用
+}
+
+// Subaccount 子账户
+type Subaccount struct {
+	Address                string                        `json:"address"`                // 账户地址
+	SubaccountNumber       uint32                        `json:"subaccountNumber"`       // 子账户编号
+	Equity                 decimal.Decimal               `json:"equity"`                 // 账户权益
+	FreeCollateral         decimal.Decimal               `json:"freeCollateral"`         // 可用保证金
+	OpenPerpetualPositions map[string]*PerpetualPosition `json:"openPerpetualPositions"` // 持仓列表
+}
+
+// SubaccountRes 子账户响应
+type SubaccountRes struct {
+	Subaccount Subaccount `json:"subaccount"`
+}
+
+// HeightRes 区块高度响应
+type HeightRes struct {
+	Height string    `json:"height"`
+	Time   time.Time `json:"time"`
+}
+
+// BaseAccount 链上账户
+type BaseAccount struct {
+	Address       string `json:"address"`
+	AccountNumber string `json:"account_number"`
+	Sequence      string `json:"sequence"`
+}
+
+// AccountRes 链上账户响应
+type AccountRes struct {
+	Account BaseAccount `json:"account"`
+}
+
+// TxResponse 交易广播结果
+type TxResponse struct {
+	Height    string `json:"height"`
+	TxHash    string `json:"txhash"`
+	Codespace string `json:"codespace"`
+	Code      uint32 `json:"code"`
+	RawLog    string `json:"raw_log"`
+}
+
+// BroadcastTxRes 交易广播响应
+type BroadcastTxRes struct {
+	TxResponse TxResponse `json:"tx_response"`
+}
+
+// WebSocketMessage 索引器WebSocket消息
+type WebSocketMessage struct {
+	Type         string          `json:"type"`          // connected/subscribed/channel_data/channel_batch_data/error
+	ConnectionId string          `json:"connection_id"` // 连接ID
+	Channel      string          `json:"channel"`       // 频道名称
+	Id           string          `json:"id"`            // 订阅ID
+	Message      string          `json:"message"`       // 错误信息
+	Contents     json.RawMessage `json:"contents"`      // 数据内容
+}
+
+// MarketsContents v4_markets 频道数据
+// 首次订阅返回 markets 全量数据，后续推送 oraclePrices 增量数据
+type MarketsContents struct {
+	Markets      map[string]*OraclePrice `json:"markets"`
+	OraclePrices map[string]*OraclePrice `json:"oraclePrices"`
+}
+
+// OraclePrice 预言机价格
+type OraclePrice struct {
+	OraclePrice decimal.Decimal `json:"oraclePrice"`
+}
+
+// SubaccountsContents v4_subaccounts 频道数据
+type SubaccountsContents struct {
+	Orders []*Order `json:"orders"`
+}
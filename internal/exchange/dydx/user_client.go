@@ -0,0 +1,217 @@
+package dydx
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// LongTermOrderDuration 网格长期订单有效期
+// 链上限制为 95 天，到期后订单被撤销，由策略按撤单流程补单
+const LongTermOrderDuration = 90 * 24 * time.Hour
+
+// cancelOrderDuration 长期订单撤单消息有效期
+const cancelOrderDuration = 2 * time.Minute
+
+var (
+	ErrAddressMismatch = errors.New("address does not match secret")
+)
+
+// PlaceOrderRequest 下单请求
+type PlaceOrderRequest struct {
+	Ticker      string           // 交易对, 例如 BTC-USD
+	Side        ChainSide        // 订单方向
+	Price       decimal.Decimal  // 价格
+	Size        decimal.Decimal  // 数量
+	ReduceOnly  bool             // 是否只减仓
+	TimeInForce ChainTimeInForce // 有效期类型
+	ClientId    uint32           // 客户端订单ID
+	ShortTerm   bool             // 是否为短期订单
+}
+
+// UserClient dYdX用户客户端
+// 使用钱包签名交易，并在本地维护链上账户序号
+type UserClient struct {
+	client           *Client // dYdX HTTP客户端
+	wallet           *Wallet // 钱包
+	subaccountNumber uint32  // 子账户编号
+
+	mutex         sync.Mutex
+	loaded        bool
+	accountNumber uint64
+	sequence      uint64
+}
+
+// NewUserClient 创建用户客户端实例
+// address 账户地址，secret 助记词或十六进制私钥
+func NewUserClient(client *Client, address, secret string) (*UserClient, error) {
+	wallet, err := NewWallet(secret)
+	if err != nil {
+		return nil, err
+	}
+	if address != "" && wallet.Address() != address {
+		return nil, ErrAddressMismatch
+	}
+	return &UserClient{client: client, wallet: wallet}, nil
+}
+
+// Address 账户地址
+func (c *UserClient) Address() string {
+	return c.wallet.Address()
+}
+
+// GetSubaccount 获取子账户信息
+func (c *UserClient) GetSubaccount(ctx context.Context) (*Subaccount, error) {
+	return c.client.GetSubaccount(ctx, c.Address(), c.subaccountNumber)
+}
+
+// GetOrders 获取订单列表
+func (c *UserClient) GetOrders(ctx context.Context, ticker string, status OrderStatus, limit int) ([]*Order, error) {
+	return c.client.GetOrders(ctx, c.Address(), c.subaccountNumber, ticker, status, limit)
+}
+
+// GetFills 获取成交记录
+func (c *UserClient) GetFills(ctx context.Context, limit, page int) ([]*Fill, error) {
+	return c.client.GetFills(ctx, c.Address(), c.subaccountNumber, limit, page)
+}
+
+// GetFundingPayments 获取资金费用记录
+func (c *UserClient) GetFundingPayments(ctx context.Context, ticker string, afterOrAt time.Time, limit, page int) ([]*FundingPayment, error) {
+	return c.client.GetFundingPayments(ctx, c.Address(), c.subaccountNumber, ticker, afterOrAt, limit, page)
+}
+
+// PlaceOrder 下单
+// 短期订单以 goodTilBlock 控制有效期，长期订单以 goodTilBlockTime 控制有效期
+func (c *UserClient) PlaceOrder(ctx context.Context, req PlaceOrderRequest) error {
+	market, err := c.client.GetPerpetualMarket(ctx, req.Ticker)
+	if err != nil {
+		return err
+	}
+
+	clobPairId, err := strconv.ParseUint(market.ClobPairId, 10, 32)
+	if err != nil {
+		return err
+	}
+
+	ord := &ChainOrder{
+		OrderId: OrderId{
+			Owner:            c.Address(),
+			SubaccountNumber: c.subaccountNumber,
+			ClientId:         req.ClientId,
+			OrderFlags:       OrderFlagsLongTerm,
+			ClobPairId:       uint32(clobPairId),
+		},
+		Side:        req.Side,
+		Quantums:    CalculateQuantums(req.Size, market),
+		Subticks:    CalculateSubticks(req.Price, market),
+		TimeInForce: req.TimeInForce,
+		ReduceOnly:  req.ReduceOnly,
+	}
+
+	if req.ShortTerm {
+		height, err := c.client.GetHeight(ctx)
+		if err != nil {
+			return err
+		}
+		ord.OrderId.OrderFlags = OrderFlagsShortTerm
+		ord.GoodTilBlock = height + ShortBlockWindow/2
+	} else {
+		ord.GoodTilBlockTime = uint32(time.Now().Add(LongTermOrderDuration).Unix())
+	}
+
+	msg := encodeAny(typeURLMsgPlaceOrder, encodeMsgPlaceOrder(ord))
+	return c.broadcast(ctx, msg, !req.ShortTerm)
+}
+
+// CancelOrder 撤销订单
+func (c *UserClient) CancelOrder(ctx context.Context, ord *Order) error {
+	clientId, err := ParseClientId(ord.ClientId)
+	if err != nil {
+		return err
+	}
+	orderFlags, err := strconv.ParseUint(ord.OrderFlags, 10, 32)
+	if err != nil {
+		return err
+	}
+	clobPairId, err := strconv.ParseUint(ord.ClobPairId, 10, 32)
+	if err != nil {
+		return err
+	}
+
+	id := OrderId{
+		Owner:            c.Address(),
+		SubaccountNumber: c.subaccountNumber,
+		ClientId:         clientId,
+		OrderFlags:       uint32(orderFlags),
+		ClobPairId:       uint32(clobPairId),
+	}
+
+	var goodTilBlock, goodTilBlockTime uint32
+	if id.OrderFlags == OrderFlagsShortTerm {
+		// 短期订单撤单的有效区块不能早于订单本身
+		n, err := strconv.ParseUint(ord.GoodTilBlock, 10, 32)
+		if err != nil {
+			return err
+		}
+		goodTilBlock = uint32(n)
+	} else {
+		goodTilBlockTime = uint32(time.Now().Add(cancelOrderDuration).Unix())
+	}
+
+	msg := encodeAny(typeURLMsgCancelOrder, encodeMsgCancelOrder(id, goodTilBlock, goodTilBlockTime))
+	return c.broadcast(ctx, msg, id.OrderFlags != OrderFlagsShortTerm)
+}
+
+// broadcast 签名并广播交易
+// dYdX 要求 clob 消息单独成交易；有状态消息消耗账户序号，短期订单消息不校验序号
+func (c *UserClient) broadcast(ctx context.Context, msg []byte, stateful bool) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for attempt := 0; ; attempt++ {
+		if !c.loaded {
+			accountNumber, sequence, err := c.client.GetAccount(ctx, c.Address())
+			if err != nil {
+				return err
+			}
+			c.accountNumber, c.sequence, c.loaded = accountNumber, sequence, true
+		}
+
+		txBytes, err := c.signTx(msg)
+		if err != nil {
+			return err
+		}
+
+		_, err = c.client.BroadcastTx(ctx, txBytes)
+		if err != nil {
+			var txErr *TxError
+			if attempt == 0 && errors.As(err, &txErr) && txErr.IsSequenceMismatch() {
+				c.loaded = false
+				continue
+			}
+			return err
+		}
+
+		if stateful {
+			c.sequence++
+		}
+		return nil
+	}
+}
+
+// signTx 构建并签名交易
+func (c *UserClient) signTx(msg []byte) ([]byte, error) {
+	bodyBytes := encodeTxBody([][]byte{msg}, "")
+	authInfoBytes := encodeAuthInfo(c.wallet.PubKey(), c.sequence, 0)
+	signDoc := encodeSignDoc(bodyBytes, authInfoBytes, c.client.ChainId(), c.accountNumber)
+
+	signature, err := c.wallet.Sign(signDoc)
+	if err != nil {
+		return nil, err
+	}
+	return encodeTxRaw(bodyBytes, authInfoBytes, signature), nil
+}
@@ -0,0 +1,168 @@
+package dydx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/config"
+	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/gorilla/websocket"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"golang.org/x/net/proxy"
+)
+
+// quoteAtomicResolution USDC 精度指数
+const quoteAtomicResolution = -6
+
+// FormatUsdMarket 格式化交易对
+// BTC -> BTC-USD
+func FormatUsdMarket(symbol string) string {
+	return symbol + "-USD"
+}
+
+// ParseUsdMarket 解析交易对
+// BTC-USD -> BTC
+func ParseUsdMarket(ticker string) (string, error) {
+	symbol, ok := strings.CutSuffix(ticker, "-USD")
+	if !ok {
+		return "", errors.New("invalid market")
+	}
+	return symbol, nil
+}
+
+// candleResolutions 支持的K线周期
+var candleResolutions = map[time.Duration]string{
+	time.Minute:      "1MIN",
+	5 * time.Minute:  "5MINS",
+	15 * time.Minute: "15MINS",
+	30 * time.Minute: "30MINS",
+	time.Hour:        "1HOUR",
+	4 * time.Hour:    "4HOURS",
+	24 * time.Hour:   "1DAY",
+}
+
+// FormatCandleResolution 格式化K线周期
+// time.Hour -> 1HOUR
+func FormatCandleResolution(interval time.Duration) (string, bool) {
+	s, ok := candleResolutions[interval]
+	return s, ok
+}
+
+// ConvertCandle 转换K线
+// 将dYdX索引器K线转换为内部K线格式
+func ConvertCandle(candle *Candle) exchange.Candle {
+	return exchange.Candle{
+		Time:   candle.StartedAt,
+		Open:   candle.Open,
+		High:   candle.High,
+		Low:    candle.Low,
+		Close:  candle.Close,
+		Volume: candle.BaseTokenVolume,
+	}
+}
+
+// ConvertOrderStatus 转换订单状态
+// 将dYdX订单状态转换为内部订单状态
+func ConvertOrderStatus(status OrderStatus) order.Status {
+	switch status {
+	case OrderStatusOpen:
+		return order.StatusOpen
+	case OrderStatusFilled:
+		return order.StatusFilled
+	case OrderStatusCanceled, OrderStatusBestEffortCanceled:
+		return order.StatusCanceled
+	default:
+		return order.StatusPending
+	}
+}
+
+// ConvertOrder 转换索引器订单
+// 索引器不返回成交均价，已成交金额按订单价格估算
+func ConvertOrder(ord *Order) (*exchange.Order, error) {
+	symbol, err := ParseUsdMarket(ord.Ticker)
+	if err != nil {
+		return nil, err
+	}
+
+	var timestamp int64
+	if ord.UpdatedAt != nil {
+		timestamp = ord.UpdatedAt.UnixMilli()
+	}
+
+	return &exchange.Order{
+		Symbol:            symbol,
+		OrderID:           ord.ID,
+		ClientOrderID:     ord.ClientId,
+		Side:              lo.If(ord.Side == OrderSideSell, order.SideSell).Else(order.SideBuy),
+		Price:             ord.Price,
+		BaseAmount:        ord.Size,
+		FilledBaseAmount:  ord.TotalFilled,
+		FilledQuoteAmount: ord.TotalFilled.Mul(ord.Price),
+		Timestamp:         timestamp,
+		Status:            ConvertOrderStatus(ord.Status),
+	}, nil
+}
+
+// CalculateQuantums 计算订单数量(quantums)
+// quantums = size * 10^(-atomicResolution)，向下取整到 stepBaseQuantums 的整数倍
+func CalculateQuantums(size decimal.Decimal, market *PerpetualMarket) uint64 {
+	raw := size.Shift(-market.AtomicResolution).Truncate(0)
+	step := decimal.NewFromUint64(market.StepBaseQuantums)
+	quantums := raw.Div(step).Truncate(0).Mul(step)
+	return lo.Max([]uint64{quantums.BigInt().Uint64(), market.StepBaseQuantums})
+}
+
+// CalculateSubticks 计算订单价格(subticks)
+// subticks = price * 10^(atomicResolution - quantumConversionExponent - quoteAtomicResolution)，
+// 四舍五入到 subticksPerTick 的整数倍
+func CalculateSubticks(price decimal.Decimal, market *PerpetualMarket) uint64 {
+	exponent := market.AtomicResolution - market.QuantumConversionExponent - quoteAtomicResolution
+	raw := price.Shift(exponent)
+	step := decimal.NewFromUint64(market.SubticksPerTick)
+	subticks := raw.Div(step).Round(0).Mul(step)
+	return lo.Max([]uint64{subticks.BigInt().Uint64(), market.SubticksPerTick})
+}
+
+// ParseClientId 解析客户端订单ID
+func ParseClientId(clientId string) (uint32, error) {
+	n, err := strconv.ParseUint(clientId, 10, 32)
+	return uint32(n), err
+}
+
+// dialWebSocket 建立WebSocket连接
+// 启用SOCKS5代理时通过代理拨号
+func dialWebSocket(ctx context.Context, url string, sock5 config.Sock5Proxy) (*websocket.Conn, error) {
+	sock5Proxy := ""
+	if sock5.Enable {
+		sock5Proxy = fmt.Sprintf("%s:%d", sock5.Host, sock5.Port)
+	}
+
+	netDial := func(ctx context.Context, network, addr string) (net.Conn, error) {
+		if sock5Proxy == "" {
+			netDialer := &net.Dialer{}
+			return netDialer.DialContext(ctx, network, addr)
+		}
+
+		dialer, err := proxy.SOCKS5(network, sock5Proxy, nil, proxy.Direct)
+		if err != nil {
+			return nil, err
+		}
+		return dialer.Dial(network, addr)
+	}
+
+	dialer := &websocket.Dialer{
+		NetDial: func(network, addr string) (net.Conn, error) {
+			return netDial(ctx, network, addr)
+		},
+		HandshakeTimeout: 45 * time.Second,
+	}
+	conn, _, err := dialer.Dial(url, nil)
+	return conn, err
+}
@@ -0,0 +1,259 @@
+package dydx
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/pbkdf2"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/crypto/ripemd160"
+)
+
+// AddressPrefix dYdX 账户地址前缀
+const AddressPrefix = "dydx"
+
+// hdPath dYdX 账户派生路径 m/44'/118'/0'/0/0
+var hdPath = []uint32{44 | hardened, 118 | hardened, 0 | hardened, 0, 0}
+
+const hardened = 0x80000000
+
+var (
+	ErrInvalidMnemonic   = errors.New("invalid mnemonic")
+	ErrInvalidPrivateKey = errors.New("invalid private key")
+)
+
+// Wallet dYdX 钱包
+// 持有 secp256k1 私钥，负责生成账户地址和签名交易
+type Wallet struct {
+	privateKey *ecdsa.PrivateKey
+	address    string
+}
+
+// NewWallet 创建钱包
+// secret 可以是助记词(以空格分隔)或十六进制私钥
+func NewWallet(secret string) (*Wallet, error) {
+	secret = strings.TrimSpace(secret)
+	if strings.Contains(secret, " ") {
+		return NewWalletFromMnemonic(secret)
+	}
+	return NewWalletFromPrivateKey(secret)
+}
+
+// NewWalletFromMnemonic 通过助记词创建钱包
+func NewWalletFromMnemonic(mnemonic string) (*Wallet, error) {
+	words := strings.Fields(mnemonic)
+	if len(words) != 12 && len(words) != 24 {
+		return nil, ErrInvalidMnemonic
+	}
+
+	// BIP39: 助记词转换为种子
+	seed, err := pbkdf2.Key(sha512.New, strings.Join(words, " "), []byte("mnemonic"), 2048, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	// BIP32: 按派生路径计算子私钥
+	mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	key, chainCode := sum[:32], sum[32:]
+
+	for _, index := range hdPath {
+		key, chainCode, err = deriveChildKey(key, chainCode, index)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	privateKey, err := crypto.ToECDSA(key)
+	if err != nil {
+		return nil, ErrInvalidMnemonic
+	}
+	return newWallet(privateKey)
+}
+
+// NewWalletFromPrivateKey 通过十六进制私钥创建钱包
+func NewWalletFromPrivateKey(privateKey string) (*Wallet, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(privateKey, "0x"))
+	if err != nil {
+		return nil, ErrInvalidPrivateKey
+	}
+	return newWallet(key)
+}
+
+func newWallet(privateKey *ecdsa.PrivateKey) (*Wallet, error) {
+	pubKey := crypto.CompressPubkey(&privateKey.PublicKey)
+
+	sha := sha256.Sum256(pubKey)
+	hasher := ripemd160.New()
+	hasher.Write(sha[:])
+
+	address, err := bech32Encode(AddressPrefix, hasher.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	return &Wallet{privateKey: privateKey, address: address}, nil
+}
+
+// Address 账户地址
+func (w *Wallet) Address() string {
+	return w.address
+}
+
+// PubKey 压缩格式公钥
+func (w *Wallet) PubKey() []byte {
+	return crypto.CompressPubkey(&w.privateKey.PublicKey)
+}
+
+// Sign 对签名文档进行签名
+// 返回 64 字节的 r||s 签名
+func (w *Wallet) Sign(signDoc []byte) ([]byte, error) {
+	hash := sha256.Sum256(signDoc)
+	sig, err := crypto.Sign(hash[:], w.privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return sig[:64], nil
+}
+
+// deriveChildKey BIP32 子私钥派生
+func deriveChildKey(key, chainCode []byte, index uint32) ([]byte, []byte, error) {
+	var data []byte
+	if index >= hardened {
+		data = append([]byte{0x00}, key...)
+	} else {
+		privateKey, err := crypto.ToECDSA(key)
+		if err != nil {
+			return nil, nil, err
+		}
+		data = crypto.CompressPubkey(&privateKey.PublicKey)
+	}
+	data = binary.BigEndian.AppendUint32(data, index)
+
+	mac := hmac.New(sha512.New, chainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+
+	n := crypto.S256().Params().N
+	il := new(big.Int).SetBytes(sum[:32])
+	if il.Cmp(n) >= 0 {
+		return nil, nil, ErrInvalidMnemonic
+	}
+
+	child := il.Add(il, new(big.Int).SetBytes(key))
+	child.Mod(child, n)
+	if child.Sign() == 0 {
+		return nil, nil, ErrInvalidMnemonic
+	}
+	return child.FillBytes(make([]byte, 32)), sum[32:], nil
+}
+
+// IsValidAddress 检查是否为有效的dYdX账户地址
+// 地址为 dydx 前缀的 bech32 编码，数据部分为 20 字节公钥哈希
+func IsValidAddress(address string) bool {
+	prefix := AddressPrefix + "1"
+	if !strings.HasPrefix(address, prefix) {
+		return false
+	}
+
+	// 20字节哈希编码为32个5位分组，另加6个校验分组
+	data := address[len(prefix):]
+	if len(data) != 32+6 {
+		return false
+	}
+
+	values := make([]byte, 0, len(data))
+	for i := 0; i < len(data); i++ {
+		idx := strings.IndexByte(bech32Charset, data[i])
+		if idx < 0 {
+			return false
+		}
+		values = append(values, byte(idx))
+	}
+
+	checksum := bech32Checksum(AddressPrefix, values[:32])
+	return bytes.Equal(checksum, values[32:])
+}
+
+// bech32Charset bech32 字符表
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// bech32Encode bech32 编码
+func bech32Encode(hrp string, data []byte) (string, error) {
+
+	values, err := convertBits(data, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+
+	checksum := bech32Checksum(hrp, values)
+	var sb strings.Builder
+	sb.WriteString(hrp)
+	sb.WriteByte('1')
+	for _, v := range append(values, checksum...) {
+		sb.WriteByte(bech32Charset[v])
+	}
+	return sb.String(), nil
+}
+
+func bech32Polymod(values []byte) uint32 {
+	gen := []uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (top>>uint(i))&1 == 1 {
+				chk ^= gen[i]
+			}
+		}
+	}
+	return chk
+}
+
+func bech32Checksum(hrp string, data []byte) []byte {
+	values := make([]byte, 0, len(hrp)*2+1+len(data)+6)
+	for i := 0; i < len(hrp); i++ {
+		values = append(values, hrp[i]>>5)
+	}
+	values = append(values, 0)
+	for i := 0; i < len(hrp); i++ {
+		values = append(values, hrp[i]&31)
+	}
+	values = append(values, data...)
+	values = append(values, 0, 0, 0, 0, 0, 0)
+
+	mod := bech32Polymod(values) ^ 1
+	checksum := make([]byte, 6)
+	for i := 0; i < 6; i++ {
+		checksum[i] = byte((mod >> uint(5*(5-i))) & 31)
+	}
+	return checksum
+}
+
+func convertBits(data []byte, fromBits, toBits uint, pad bool) ([]byte, error) {
+	acc, bits := uint(0), uint(0)
+	maxv := uint(1)<<toBits - 1
+	ret := make([]byte, 0, len(data)*int(fromBits)/int(toBits)+1)
+	for _, value := range data {
+		acc = acc<<fromBits | uint(value)
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			ret = append(ret, byte(acc>>bits&maxv))
+		}
+	}
+	if pad && bits > 0 {
+		ret = append(ret, byte(acc<<(toBits-bits)&maxv))
+	} else if !pad && (bits >= fromBits || acc<<(toBits-bits)&maxv != 0) {
+		return nil, errors.New("invalid bech32 padding")
+	}
+	return ret, nil
+}
@@ -0,0 +1,226 @@
+// Package dydx 提供dYdX交易所的WebSocket用户连接实现
+// 支持实时订单推送、重连机制和心跳检测
+package dydx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/config"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+
+	"github.com/gorilla/websocket"
+)
+
+type StoppedCallback func(account string)
+
+type DydxWS struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	stopChan chan struct{}
+
+	url       string
+	conn      *websocket.Conn
+	proxy     config.Sock5Proxy
+	reconnect chan struct{}
+
+	account        string
+	userOrdersChan chan<- exchange.UserOrders
+	callback       StoppedCallback
+}
+
+func NewDydxWS(
+	ctx context.Context,
+	url string,
+	account string,
+	userOrdersChan chan<- exchange.UserOrders,
+	proxy config.Sock5Proxy,
+	callback StoppedCallback,
+) *DydxWS {
+	ctx, cancel := context.WithCancel(ctx)
+	ws := &DydxWS{
+		ctx:            ctx,
+		cancel:         cancel,
+		url:            url,
+		proxy:          proxy,
+		reconnect:      make(chan struct{}, 1),
+		account:        account,
+		userOrdersChan: userOrdersChan,
+		callback:       callback,
+	}
+	return ws
+}
+
+func (ws *DydxWS) Stop() {
+	if ws.stopChan == nil {
+		return
+	}
+
+	logger.Infof("[DydxWS-%s] 准备停止服务", ws.account)
+
+	ws.cancel()
+	if ws.conn != nil {
+		ws.conn.Close()
+	}
+
+	<-ws.stopChan
+
+	close(ws.stopChan)
+	ws.stopChan = nil
+
+	logger.Infof("[DydxWS-%s] 服务已经停止", ws.account)
+}
+
+func (ws *DydxWS) Start() {
+	if ws.stopChan != nil {
+		return
+	}
+
+	ws.stopChan = make(chan struct{}, 1)
+
+	if ws.conn == nil {
+		logger.Infof("[DydxWS-%s] 开始运行服务", ws.account)
+		go ws.run()
+	}
+}
+
+func (ws *DydxWS) run() {
+	ws.connect()
+
+	reconnectDelay := reconnectInitial
+loop:
+	for {
+		select {
+		case <-ws.ctx.Done():
+			break loop
+		case <-ws.reconnect:
+			select {
+			case <-ws.ctx.Done():
+				break loop
+			case <-time.After(reconnectDelay):
+				logger.Infof("[DydxWS-%s] 重新建立连接...", ws.account)
+				ws.connect()
+
+				reconnectDelay *= 2
+				if reconnectDelay > reconnectMax {
+					reconnectDelay = reconnectMax
+				}
+			}
+		}
+	}
+
+	if ws.callback != nil {
+		ws.callback(ws.account)
+	}
+
+	ws.stopChan <- struct{}{}
+}
+
+func (ws *DydxWS) connect() {
+	conn, err := dialWebSocket(ws.ctx, ws.url, ws.proxy)
+	if err != nil {
+		logger.Errorf("[DydxWS-%s] 连接失败, %v", ws.account, err)
+		ws.scheduleReconnect()
+		return
+	}
+
+	ws.conn = conn
+	logger.Infof("[DydxWS-%s] 连接已建立", ws.account)
+
+	go ws.readMessages()
+}
+
+func (ws *DydxWS) readMessages() {
+	defer ws.conn.Close()
+	account := ws.account
+
+	// 订阅子账户
+	message := fmt.Sprintf(`{"type":"subscribe","channel":"v4_subaccounts","id":"%s/0"}`, account)
+	if err := ws.conn.WriteMessage(websocket.TextMessage, []byte(message)); err != nil {
+		logger.Errorf("[DydxWS-%s] 订阅子账户失败, %v", account, err)
+		ws.scheduleReconnect()
+		return
+	}
+
+	// 定时心跳
+	ctx, cancel := context.WithCancel(ws.ctx)
+	defer cancel()
+	go heartbeat(ctx, ws.conn, "DydxWS-"+account)
+
+	// 手动触发
+	// 订阅响应只包含挂单，通过空快照触发订单全量同步
+	userOrders := exchange.UserOrders{
+		Exchange:   exchange.Dydx,
+		Account:    account,
+		Orders:     []*exchange.Order{},
+		IsSnapshot: true,
+	}
+	ws.userOrdersChan <- userOrders
+
+	// 消息循环
+	for {
+		_, data, err := ws.conn.ReadMessage()
+		if err != nil {
+			if strings.Contains(err.Error(), "use of closed network connection") {
+				return
+			}
+			logger.Warnf("[DydxWS-%s] 读取出错, %v", account, err)
+			ws.scheduleReconnect()
+			return
+		}
+
+		logger.Tracef("[DydxWS-%s] 收到新消息, %s", account, data)
+
+		var res WebSocketMessage
+		if err = json.Unmarshal(data, &res); err != nil {
+			logger.Warnf("[DydxWS-%s] 解析响应失败, %s, %v", account, string(data), err)
+			continue
+		}
+
+		switch res.Type {
+		case "error":
+			logger.Errorf("[DydxWS-%s] 请求处理失败, %s", account, res.Message)
+		case "channel_data":
+			if res.Channel != "v4_subaccounts" {
+				continue
+			}
+
+			var v SubaccountsContents
+			if err = json.Unmarshal(res.Contents, &v); err != nil {
+				logger.Warnf("[DydxWS-%s] 解析订阅订单数据失败, %s, %v", account, string(res.Contents), err)
+				continue
+			}
+			if len(v.Orders) == 0 {
+				continue
+			}
+
+			userOrders := exchange.UserOrders{
+				Exchange: exchange.Dydx,
+				Account:  account,
+				Orders:   make([]*exchange.Order, 0, len(v.Orders)),
+			}
+			for _, item := range v.Orders {
+				ord, err := ConvertOrder(item)
+				if err != nil {
+					continue
+				}
+				userOrders.Orders = append(userOrders.Orders, ord)
+			}
+
+			ws.userOrdersChan <- userOrders
+		}
+	}
+}
+
+func (ws *DydxWS) scheduleReconnect() {
+	if ws.ctx.Err() == nil {
+		select {
+		case ws.reconnect <- struct{}{}:
+		default:
+		}
+	}
+}
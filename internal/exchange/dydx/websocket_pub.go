@@ -0,0 +1,264 @@
+// Package dydx 提供dYdX交易所的WebSocket公共数据连接实现
+// 通过索引器 v4_markets 频道获取预言机价格，支持重连机制和心跳检测
+package dydx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/config"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/samber/lo"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	reconnectInitial = 1 * time.Second
+	reconnectMax     = 30 * time.Second
+
+	heartbeatInterval = 20 * time.Second
+)
+
+type DydxPubWS struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	stopChan chan struct{}
+
+	url       string
+	conn      *websocket.Conn
+	proxy     config.Sock5Proxy
+	reconnect chan struct{}
+
+	mutex   sync.RWMutex
+	symbols map[string]struct{}
+
+	marketStatsChan chan<- exchange.MarketStats
+}
+
+func NewDydxPubWS(
+	ctx context.Context,
+	url string,
+	marketStatsChan chan<- exchange.MarketStats,
+	proxy config.Sock5Proxy,
+) *DydxPubWS {
+	ctx, cancel := context.WithCancel(ctx)
+	ws := &DydxPubWS{
+		ctx:             ctx,
+		cancel:          cancel,
+		url:             url,
+		proxy:           proxy,
+		reconnect:       make(chan struct{}, 1),
+		symbols:         make(map[string]struct{}),
+		marketStatsChan: marketStatsChan,
+	}
+	return ws
+}
+
+func (ws *DydxPubWS) Stop() {
+	if ws.stopChan == nil {
+		return
+	}
+
+	logger.Infof("[DydxPubWS] 准备停止服务")
+
+	ws.cancel()
+	if ws.conn != nil {
+		ws.conn.Close()
+	}
+
+	<-ws.stopChan
+
+	close(ws.stopChan)
+	ws.stopChan = nil
+
+	logger.Infof("[DydxPubWS] 服务已经停止")
+}
+
+func (ws *DydxPubWS) Start() {
+	if ws.stopChan != nil {
+		return
+	}
+
+	ws.stopChan = make(chan struct{}, 1)
+
+	if ws.conn == nil {
+		logger.Infof("[DydxPubWS] 开始运行服务")
+		go ws.run()
+	}
+}
+
+func (ws *DydxPubWS) WaitUntilConnected() {
+	for ws.conn == nil {
+		time.Sleep(time.Second * 1)
+	}
+}
+
+// SubscribeMarketStats 订阅交易对行情
+// v4_markets 频道推送全部市场价格，此处仅记录需要分发的交易对
+func (ws *DydxPubWS) SubscribeMarketStats(symbol string) error {
+	if ws.conn == nil {
+		return errors.New("connection is not established")
+	}
+
+	ws.mutex.Lock()
+	ws.symbols[symbol] = struct{}{}
+	ws.mutex.Unlock()
+
+	return nil
+}
+
+func (ws *DydxPubWS) UnsubscribeMarketStats(symbol string) error {
+	ws.mutex.Lock()
+	delete(ws.symbols, symbol)
+	ws.mutex.Unlock()
+
+	return nil
+}
+
+func (ws *DydxPubWS) run() {
+	ws.connect()
+
+	reconnectDelay := reconnectInitial
+loop:
+	for {
+		select {
+		case <-ws.ctx.Done():
+			break loop
+		case <-ws.reconnect:
+			select {
+			case <-ws.ctx.Done():
+				break loop
+			case <-time.After(reconnectDelay):
+				logger.Infof("[DydxPubWS] 重新建立连接...")
+				ws.connect()
+
+				reconnectDelay *= 2
+				if reconnectDelay > reconnectMax {
+					reconnectDelay = reconnectMax
+				}
+			}
+		}
+	}
+
+	ws.stopChan <- struct{}{}
+}
+
+func (ws *DydxPubWS) connect() {
+	conn, err := dialWebSocket(ws.ctx, ws.url, ws.proxy)
+	if err != nil {
+		logger.Errorf("[DydxPubWS] 连接失败, %v", err)
+		ws.scheduleReconnect()
+		return
+	}
+
+	ws.conn = conn
+	logger.Infof("[DydxPubWS] 连接已建立")
+
+	go ws.readMessages()
+}
+
+func (ws *DydxPubWS) readMessages() {
+	defer ws.conn.Close()
+
+	// 订阅市场数据
+	message := `{"type":"subscribe","channel":"v4_markets"}`
+	if err := ws.conn.WriteMessage(websocket.TextMessage, []byte(message)); err != nil {
+		logger.Errorf("[DydxPubWS] 订阅市场数据失败, %v", err)
+		ws.scheduleReconnect()
+		return
+	}
+
+	// 定时心跳
+	ctx, cancel := context.WithCancel(ws.ctx)
+	defer cancel()
+	go heartbeat(ctx, ws.conn, "DydxPubWS")
+
+	// 消息循环
+	for {
+		_, data, err := ws.conn.ReadMessage()
+		if err != nil {
+			if strings.Contains(err.Error(), "use of closed network connection") {
+				return
+			}
+			logger.Warnf("[DydxPubWS] 读取出错, %v", err)
+			ws.scheduleReconnect()
+			return
+		}
+
+		logger.Tracef("[DydxPubWS] 收到新消息, %s", data)
+
+		var res WebSocketMessage
+		if err = json.Unmarshal(data, &res); err != nil {
+			logger.Warnf("[DydxPubWS] 解析响应失败, %s, %v", string(data), err)
+			continue
+		}
+
+		switch res.Type {
+		case "error":
+			logger.Errorf("[DydxPubWS] 请求处理失败, %s", res.Message)
+		case "subscribed", "channel_data":
+			if res.Channel != "v4_markets" {
+				continue
+			}
+
+			var v MarketsContents
+			if err = json.Unmarshal(res.Contents, &v); err != nil {
+				logger.Warnf("[DydxPubWS] 解析市场数据失败, %s, %v", string(res.Contents), err)
+				continue
+			}
+
+			prices := lo.Assign(v.Markets, v.OraclePrices)
+			ws.mutex.RLock()
+			for symbol := range ws.symbols {
+				price, ok := prices[FormatUsdMarket(symbol)]
+				if !ok || price.OraclePrice.IsZero() {
+					continue
+				}
+
+				marketStats := exchange.MarketStats{
+					Symbol:    symbol,
+					Price:     price.OraclePrice,
+					MarkPrice: price.OraclePrice,
+				}
+
+				logger.Tracef("[DydxPubWS] 分发 MarketStats 数据, %+v", marketStats)
+				ws.marketStatsChan <- marketStats
+			}
+			ws.mutex.RUnlock()
+		}
+	}
+}
+
+func (ws *DydxPubWS) scheduleReconnect() {
+	if ws.ctx.Err() == nil {
+		select {
+		case ws.reconnect <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// heartbeat 定时发送心跳
+func heartbeat(ctx context.Context, conn *websocket.Conn, name string) {
+	timer := time.NewTimer(heartbeatInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			if err := conn.WriteMessage(websocket.PingMessage, []byte{}); err != nil {
+				logger.Errorf("[%s] 发送心跳消息失败, %v", name, err)
+				return
+			}
+			timer.Reset(heartbeatInterval)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
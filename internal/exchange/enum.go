@@ -5,4 +5,7 @@ const (
 	Lighter     string = "lighter"     // Lighter交易所
 	Paradex     string = "paradex"     // Paradex交易所
 	Variational string = "variational" // Variational交易所
+	Hyperliquid string = "hyperliquid" // Hyperliquid交易所
+	Dydx        string = "dydx"        // dYdX交易所
+	Paper       string = "paper"       // 模拟交易所
 )
@@ -0,0 +1,238 @@
+// Package hyperliquid 提供Hyperliquid永续合约交易所的客户端实现
+// 支持行情查询、EIP-712签名下单、订单和持仓查询等功能
+package hyperliquid
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/shopspring/decimal"
+)
+
+// Hyperliquid 主网地址
+const (
+	MainnetBaseURL = "https://api.hyperliquid.xyz"
+	MainnetWsURL   = "wss://api.hyperliquid.xyz/ws"
+)
+
+var (
+	ErrAssetNotFound = errors.New("asset not found")
+)
+
+// Client Hyperliquid交易所HTTP客户端
+// 封装 /info 和 /exchange 接口，并缓存永续合约元数据
+type Client struct {
+	endpoint   string       // API端点地址
+	isMainnet  bool         // 是否为主网
+	httpClient *http.Client // HTTP客户端
+
+	mutex sync.RWMutex
+	meta  *Meta // 永续合约元数据缓存
+}
+
+// NewClient 创建Hyperliquid主网客户端
+func NewClient(httpClient *http.Client) *Client {
+	return NewClientWithEndpoint(httpClient, MainnetBaseURL, true)
+}
+
+// NewClientWithEndpoint 创建指定端点的Hyperliquid客户端
+// 用于连接测试网或本地模拟服务
+func NewClientWithEndpoint(httpClient *http.Client, endpoint string, isMainnet bool) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{endpoint: endpoint, isMainnet: isMainnet, httpClient: httpClient}
+}
+
+// IsMainnet 是否为主网
+func (c *Client) IsMainnet() bool {
+	return c.isMainnet
+}
+
+// GetMeta 获取永续合约元数据
+func (c *Client) GetMeta(ctx context.Context) (*Meta, error) {
+	var meta Meta
+	if err := c.info(ctx, map[string]any{"type": "meta"}, &meta); err != nil {
+		return nil, err
+	}
+
+	c.mutex.Lock()
+	c.meta = &meta
+	c.mutex.Unlock()
+
+	return &meta, nil
+}
+
+// GetAssetInfo 获取资产ID和资产信息
+// 资产ID为资产在 meta.universe 中的下标，本地缓存未命中时刷新元数据
+func (c *Client) GetAssetInfo(ctx context.Context, coin string) (int, *AssetInfo, error) {
+	c.mutex.RLock()
+	meta := c.meta
+	c.mutex.RUnlock()
+
+	if meta != nil {
+		if asset, info, ok := findAsset(meta, coin); ok {
+			return asset, info, nil
+		}
+	}
+
+	meta, err := c.GetMeta(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if asset, info, ok := findAsset(meta, coin); ok {
+		return asset, info, nil
+	}
+	return 0, nil, ErrAssetNotFound
+}
+
+// GetAllMids 获取所有资产的中间价
+func (c *Client) GetAllMids(ctx context.Context) (map[string]decimal.Decimal, error) {
+	var mids map[string]decimal.Decimal
+	if err := c.info(ctx, map[string]any{"type": "allMids"}, &mids); err != nil {
+		return nil, err
+	}
+	return mids, nil
+}
+
+// GetOpenOrders 获取用户挂单列表
+func (c *Client) GetOpenOrders(ctx context.Context, user string) ([]*Order, error) {
+	var orders []*Order
+	if err := c.info(ctx, map[string]any{"type": "frontendOpenOrders", "user": user}, &orders); err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// GetHistoricalOrders 获取用户最近的历史订单(最多2000条)
+func (c *Client) GetHistoricalOrders(ctx context.Context, user string) ([]*OrderUpdate, error) {
+	var orders []*OrderUpdate
+	if err := c.info(ctx, map[string]any{"type": "historicalOrders", "user": user}, &orders); err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// GetUserFillsByTime 获取用户在指定时间之后的成交记录
+func (c *Client) GetUserFillsByTime(ctx context.Context, user string, startTime int64) ([]*Fill, error) {
+	var fills []*Fill
+	req := map[string]any{"type": "userFillsByTime", "user": user, "startTime": startTime}
+	if err := c.info(ctx, req, &fills); err != nil {
+		return nil, err
+	}
+	return fills, nil
+}
+
+// GetUserFunding 获取用户在指定时间之后的资金费用记录
+// 单次最多返回 500 条，按时间升序排列
+func (c *Client) GetUserFunding(ctx context.Context, user string, startTime int64) ([]*UserFunding, error) {
+	var items []*UserFunding
+	req := map[string]any{"type": "userFunding", "user": user, "startTime": startTime}
+	if err := c.info(ctx, req, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// GetClearinghouseState 获取用户永续合约账户状态
+func (c *Client) GetClearinghouseState(ctx context.Context, user string) (*ClearinghouseState, error) {
+	var state ClearinghouseState
+	if err := c.info(ctx, map[string]any{"type": "clearinghouseState", "user": user}, &state); err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+// GetCandleSnapshot 获取历史K线
+// interval 为 1m/5m/15m/1h/4h/1d 等周期，单次最多返回最近的 5000 根K线
+func (c *Client) GetCandleSnapshot(ctx context.Context, coin, interval string, startTime, endTime int64) ([]*Candle, error) {
+	var candles []*Candle
+	req := map[string]any{
+		"type": "candleSnapshot",
+		"req": map[string]any{
+			"coin":      coin,
+			"interval":  interval,
+			"startTime": startTime,
+			"endTime":   endTime,
+		},
+	}
+	if err := c.info(ctx, req, &candles); err != nil {
+		return nil, err
+	}
+	return candles, nil
+}
+
+// Exchange 提交已签名的交易请求
+func (c *Client) Exchange(ctx context.Context, req *ExchangeRequest) (*ExchangeResponseData, error) {
+	var res ExchangeResponse
+	if err := c.post(ctx, "/exchange", req, &res); err != nil {
+		return nil, err
+	}
+
+	if res.Status != "ok" {
+		var message string
+		if err := json.Unmarshal(res.Response, &message); err != nil {
+			message = string(res.Response)
+		}
+		return nil, fmt.Errorf("exchange error: %s", message)
+	}
+
+	var data ExchangeResponseData
+	if err := json.Unmarshal(res.Response, &data); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// info 调用 /info 查询接口
+func (c *Client) info(ctx context.Context, req any, result any) error {
+	return c.post(ctx, "/info", req, result)
+}
+
+// post 发送POST请求并解析JSON响应
+func (c *Client) post(ctx context.Context, path string, body any, result any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint+path, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("http status: %d, body: %s", res.StatusCode, string(data))
+	}
+
+	return json.Unmarshal(data, result)
+}
+
+// findAsset 在元数据中查找资产
+func findAsset(meta *Meta, coin string) (int, *AssetInfo, bool) {
+	for idx, item := range meta.Universe {
+		if item.Name == coin {
+			return idx, item, true
+		}
+	}
+	return 0, nil, false
+}
@@ -0,0 +1,306 @@
+package hyperliquid
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/config"
+	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/gorilla/websocket"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	testPrivateKey = "0x0123456789012345678901234567890123456789012345678901234567890123"
+	testAccount    = "0x14791697260E4c9A71f18484C9f997B308e59325"
+)
+
+// newTestServer 创建回放录制数据的HTTP服务
+func newTestServer(t *testing.T, handle func(req map[string]any, body []byte) string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var req map[string]any
+		if err := json.Unmarshal(body, &req); err != nil {
+			t.Errorf("解析请求失败, %v", err)
+			return
+		}
+		if r.URL.Path == "/info" {
+			w.Write(readTestData(t, req["type"].(string)))
+			return
+		}
+		w.Write([]byte(handle(req, body)))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func readTestData(t *testing.T, name string) []byte {
+	t.Helper()
+
+	files := map[string]string{
+		"meta":               "testdata/meta.json",
+		"historicalOrders":   "testdata/historical_orders.json",
+		"clearinghouseState": "testdata/clearinghouse_state.json",
+		"order":              "testdata/order_response.json",
+		"candleSnapshot":     "testdata/candle_snapshot.json",
+	}
+	data, err := os.ReadFile(files[name])
+	if err != nil {
+		t.Fatalf("读取测试数据失败, %s, %v", name, err)
+	}
+	return data
+}
+
+func TestSignL1Action(t *testing.T) {
+	action := OrderedMap{
+		{Key: "type", Value: "dummy"},
+		{Key: "num", Value: int64(100000000000)},
+	}
+
+	tests := []struct {
+		name      string
+		isMainnet bool
+		expected  Signature
+	}{
+		{
+			name:      "主网",
+			isMainnet: true,
+			expected: Signature{
+				R: "0x53749d5b30552aeb2fca34b530185976545bb22d0b3ce6f62e31be961a59298",
+				S: "0x755c40ba9bf05223521753995abb2f73ab3229be8ec921f350cb447e384d8ed8",
+				V: 27,
+			},
+		},
+		{
+			name:      "测试网",
+			isMainnet: false,
+			expected: Signature{
+				R: "0x542af61ef1f429707e3c76c5293c80d01f74ef853e34b76efffcb57e574f9510",
+				S: "0x17b8b32f086e8cdede991f1e2c529f5dd5297cbe8128500e00cbaf766204a613",
+				V: 28,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signer, err := NewSigner(testPrivateKey, tt.isMainnet)
+			if err != nil {
+				t.Fatalf("NewSigner() error = %v", err)
+			}
+
+			signature, err := signer.SignL1Action(action, nil, 0)
+			if err != nil {
+				t.Fatalf("SignL1Action() error = %v", err)
+			}
+			if signature != tt.expected {
+				t.Errorf("SignL1Action() = %+v, expected %+v", signature, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatPrice(t *testing.T) {
+	tests := []struct {
+		price      string
+		szDecimals int
+		expected   string
+	}{
+		{price: "108231.57", szDecimals: 5, expected: "108232"},
+		{price: "2412.734", szDecimals: 4, expected: "2412.7"},
+		{price: "187.1234", szDecimals: 2, expected: "187.12"},
+		{price: "0.0123456", szDecimals: 0, expected: "0.012346"},
+		{price: "0.0123456", szDecimals: 2, expected: "0.0123"},
+	}
+
+	for _, tt := range tests {
+		got := FormatPrice(decimal.RequireFromString(tt.price), tt.szDecimals).String()
+		if got != tt.expected {
+			t.Errorf("FormatPrice(%s, %d) = %s, expected %s", tt.price, tt.szDecimals, got, tt.expected)
+		}
+	}
+}
+
+func TestUserClient(t *testing.T) {
+	var exchangeReq map[string]any
+	server := newTestServer(t, func(req map[string]any, body []byte) string {
+		exchangeReq = req
+		return string(readTestData(t, "order"))
+	})
+
+	client := NewClientWithEndpoint(server.Client(), server.URL, false)
+	userClient, err := NewUserClient(client, testAccount, testPrivateKey)
+	if err != nil {
+		t.Fatalf("NewUserClient() error = %v", err)
+	}
+	ctx := context.Background()
+
+	// 资产ID为 universe 下标
+	asset, info, err := client.GetAssetInfo(ctx, "ETH")
+	if err != nil || asset != 1 || info.SzDecimals != 4 {
+		t.Fatalf("GetAssetInfo() = %d, %+v, %v", asset, info, err)
+	}
+
+	// 历史K线
+	candles, err := client.GetCandleSnapshot(ctx, "BTC", "1h", 1735689600000, 1735696799999)
+	if err != nil || len(candles) != 2 {
+		t.Fatalf("GetCandleSnapshot() = %d, %v", len(candles), err)
+	}
+	if candle := ConvertCandle(candles[1]); candle.Time.UnixMilli() != 1735693200000 || !candle.High.Equal(decimal.RequireFromString("94398")) {
+		t.Errorf("ConvertCandle() = %+v", candle)
+	}
+
+	// 历史订单
+	orders, err := userClient.GetHistoricalOrders(ctx)
+	if err != nil || len(orders) != 2 {
+		t.Fatalf("GetHistoricalOrders() = %d, %v", len(orders), err)
+	}
+	ord := ConvertOrderUpdate(orders[0])
+	if ord.Status != order.StatusFilled || ord.Side != order.SideBuy || !ord.FilledBaseAmount.Equal(decimal.RequireFromString("0.0415")) {
+		t.Errorf("ConvertOrderUpdate() = %+v", ord)
+	}
+
+	// 账户状态
+	state, err := userClient.GetClearinghouseState(ctx)
+	if err != nil || len(state.AssetPositions) != 1 || state.AssetPositions[0].Position.LiquidationPx != nil {
+		t.Fatalf("GetClearinghouseState() = %+v, %v", state, err)
+	}
+
+	// 批量下单
+	statuses, err := userClient.PlaceOrders(ctx, []OrderRequest{
+		{Coin: "ETH", IsBuy: true, LimitPx: decimal.RequireFromString("2412.734"), Sz: decimal.RequireFromString("0.04159"), Tif: TifGtc, Cloid: NewCloid(1)},
+		{Coin: "ETH", IsBuy: false, LimitPx: decimal.RequireFromString("2487.3"), Sz: decimal.RequireFromString("0.001"), Tif: TifGtc},
+	})
+	if err != nil || len(statuses) != 2 {
+		t.Fatalf("PlaceOrders() = %+v, %v", statuses, err)
+	}
+	if statuses[0].Resting == nil || statuses[0].Resting.Oid != 41235893117 || statuses[1].Error == "" {
+		t.Errorf("PlaceOrders() = %+v", statuses)
+	}
+
+	action := exchangeReq["action"].(map[string]any)
+	wire := action["orders"].([]any)[0].(map[string]any)
+	if action["type"] != "order" || wire["a"] != float64(1) || wire["p"] != "2412.7" || wire["s"] != "0.0415" || wire["c"] != NewCloid(1) {
+		t.Errorf("PlaceOrders() action = %+v", action)
+	}
+	if signature := exchangeReq["signature"].(map[string]any); signature["r"] == "" {
+		t.Errorf("PlaceOrders() signature = %+v", signature)
+	}
+}
+
+func TestExchangeError(t *testing.T) {
+	server := newTestServer(t, func(req map[string]any, body []byte) string {
+		return `{"status":"err","response":"User or API Wallet 0x0000000000000000000000000000000000000000 does not exist."}`
+	})
+
+	client := NewClientWithEndpoint(server.Client(), server.URL, false)
+	userClient, err := NewUserClient(client, testAccount, testPrivateKey)
+	if err != nil {
+		t.Fatalf("NewUserClient() error = %v", err)
+	}
+
+	err = userClient.UpdateLeverage(context.Background(), "BTC", 5, true)
+	if err == nil || !strings.Contains(err.Error(), "does not exist") {
+		t.Errorf("UpdateLeverage() error = %v", err)
+	}
+}
+
+func TestSubscriber(t *testing.T) {
+	frames, err := os.Open("testdata/order_updates.jsonl")
+	if err != nil {
+		t.Fatalf("读取测试数据失败, %v", err)
+	}
+	defer frames.Close()
+
+	messages := make([][]byte, 0)
+	scanner := bufio.NewScanner(frames)
+	for scanner.Scan() {
+		messages = append(messages, []byte(scanner.Text()))
+	}
+
+	// 回放录制的推送消息
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var req struct {
+			Subscription struct {
+				Type string `json:"type"`
+			} `json:"subscription"`
+		}
+		json.Unmarshal(data, &req)
+
+		for _, message := range messages {
+			var v WebSocketMessage
+			json.Unmarshal(message, &v)
+			if v.Channel == req.Subscription.Type || (v.Channel != "allMids" && v.Channel != "orderUpdates") {
+				conn.WriteMessage(websocket.TextMessage, message)
+			}
+		}
+
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+	subscriber := NewHyperliquidSubscriber(url, config.Sock5Proxy{})
+	subChan := subscriber.SubscriptionChan()
+	subscriber.Start()
+	defer subscriber.Stop()
+
+	if err := subscriber.SubscribeMarketStats("ETH"); err != nil {
+		t.Fatalf("SubscribeMarketStats() error = %v", err)
+	}
+	if err := subscriber.SubscribeAccountOrders(testAccount); err != nil {
+		t.Fatalf("SubscribeAccountOrders() error = %v", err)
+	}
+
+	var snapshot, updates bool
+	timeout := time.After(5 * time.Second)
+	for !snapshot || !updates {
+		select {
+		case msg := <-subChan:
+			if msg.Exchange != exchange.Hyperliquid || msg.UserOrders == nil {
+				continue
+			}
+			if msg.UserOrders.Account != testAccount {
+				t.Errorf("UserOrders.Account = %s, expected %s", msg.UserOrders.Account, testAccount)
+			}
+			if msg.UserOrders.IsSnapshot {
+				snapshot = true
+				continue
+			}
+			updates = true
+			ord := msg.UserOrders.Orders[0]
+			if ord.Symbol != "ETH" || ord.OrderID != "41235893115" || ord.Status != order.StatusFilled {
+				t.Errorf("UserOrders.Orders[0] = %+v", ord)
+			}
+		case <-timeout:
+			t.Fatalf("等待订单推送超时, snapshot: %v, updates: %v", snapshot, updates)
+		}
+	}
+}
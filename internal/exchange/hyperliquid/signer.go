@@ -0,0 +1,116 @@
+package hyperliquid
+
+import (
+	"crypto/ecdsa"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// eip712DomainTypeHash EIP712Domain 类型哈希
+	eip712DomainTypeHash = crypto.Keccak256([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"))
+
+	// agentTypeHash Agent 类型哈希
+	agentTypeHash = crypto.Keccak256([]byte("Agent(string source,bytes32 connectionId)"))
+
+	// l1DomainSeparator L1 action 签名域
+	// name="Exchange", version="1", chainId=1337, verifyingContract=0x0
+	l1DomainSeparator = crypto.Keccak256(
+		eip712DomainTypeHash,
+		crypto.Keccak256([]byte("Exchange")),
+		crypto.Keccak256([]byte("1")),
+		common.LeftPadBytes(big.NewInt(1337).Bytes(), 32),
+		common.LeftPadBytes(common.Address{}.Bytes(), 32),
+	)
+)
+
+// Signer Hyperliquid签名器
+// 使用API钱包(Agent)私钥对 L1 action 进行 EIP-712 签名
+type Signer struct {
+	privateKey *ecdsa.PrivateKey
+	isMainnet  bool
+
+	mutex     sync.Mutex
+	lastNonce int64
+}
+
+// NewSigner 创建签名器
+// privateKey 十六进制私钥(可带0x前缀)，isMainnet 是否为主网
+func NewSigner(privateKey string, isMainnet bool) (*Signer, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(privateKey, "0x"))
+	if err != nil {
+		return nil, errors.New("invalid private key")
+	}
+	return &Signer{privateKey: key, isMainnet: isMainnet}, nil
+}
+
+// Address 签名器对应的钱包地址
+func (s *Signer) Address() string {
+	return crypto.PubkeyToAddress(s.privateKey.PublicKey).Hex()
+}
+
+// NextNonce 生成下一个nonce
+// Hyperliquid 使用毫秒时间戳作为nonce，且同一签名器的nonce必须唯一
+func (s *Signer) NextNonce() int64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	nonce := time.Now().UnixMilli()
+	if nonce <= s.lastNonce {
+		nonce = s.lastNonce + 1
+	}
+	s.lastNonce = nonce
+	return nonce
+}
+
+// SignL1Action 对 L1 action 进行签名
+func (s *Signer) SignL1Action(action OrderedMap, vaultAddress *string, nonce int64) (Signature, error) {
+	hash, err := ActionHash(action, vaultAddress, nonce)
+	if err != nil {
+		return Signature{}, err
+	}
+
+	source := "b"
+	if s.isMainnet {
+		source = "a"
+	}
+	structHash := crypto.Keccak256(agentTypeHash, crypto.Keccak256([]byte(source)), hash)
+	digest := crypto.Keccak256([]byte{0x19, 0x01}, l1DomainSeparator, structHash)
+
+	sig, err := crypto.Sign(digest, s.privateKey)
+	if err != nil {
+		return Signature{}, err
+	}
+
+	return Signature{
+		R: hexutil.EncodeBig(new(big.Int).SetBytes(sig[:32])),
+		S: hexutil.EncodeBig(new(big.Int).SetBytes(sig[32:64])),
+		V: int(sig[64]) + 27,
+	}, nil
+}
+
+// ActionHash 计算 action 哈希
+// keccak256(msgpack(action) || nonce(8字节大端) || vault标志位 [|| vault地址])
+func ActionHash(action OrderedMap, vaultAddress *string, nonce int64) ([]byte, error) {
+	data, err := packMsgpack(action)
+	if err != nil {
+		return nil, err
+	}
+
+	data = binary.BigEndian.AppendUint64(data, uint64(nonce))
+	if vaultAddress == nil {
+		data = append(data, 0x00)
+	} else {
+		data = append(data, 0x01)
+		data = append(data, common.HexToAddress(*vaultAddress).Bytes()...)
+	}
+	return crypto.Keccak256(data), nil
+}
@@ -0,0 +1,237 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+
+	"github.com/shopspring/decimal"
+)
+
+// OrderSide 订单方向
+type OrderSide string
+
+const (
+	OrderSideBuy  OrderSide = "B" // 买入(Bid)
+	OrderSideSell OrderSide = "A" // 卖出(Ask)
+)
+
+// OrderStatus 订单状态
+type OrderStatus string
+
+const (
+	OrderStatusOpen                    OrderStatus = "open"                    // 挂单中
+	OrderStatusFilled                  OrderStatus = "filled"                  // 完全成交
+	OrderStatusCanceled                OrderStatus = "canceled"                // 用户取消
+	OrderStatusTriggered               OrderStatus = "triggered"               // 条件单已触发
+	OrderStatusRejected                OrderStatus = "rejected"                // 下单被拒绝
+	OrderStatusMarginCanceled          OrderStatus = "marginCanceled"          // 保证金不足取消
+	OrderStatusReduceOnlyCanceled      OrderStatus = "reduceOnlyCanceled"      // 只减仓订单取消
+	OrderStatusSelfTradeCanceled       OrderStatus = "selfTradeCanceled"       // 自成交保护取消
+	OrderStatusSiblingFilledCanceled   OrderStatus = "siblingFilledCanceled"   // 关联订单成交取消
+	OrderStatusLiquidatedCanceled      OrderStatus = "liquidatedCanceled"      // 强平取消
+	OrderStatusScheduledCancel         OrderStatus = "scheduledCancel"         // 定时取消
+	OrderStatusVaultWithdrawalCanceled OrderStatus = "vaultWithdrawalCanceled" // 金库提现取消
+	OrderStatusOpenInterestCapCanceled OrderStatus = "openInterestCapCanceled" // 持仓量上限取消
+	OrderStatusDelistedCanceled        OrderStatus = "delistedCanceled"        // 下架取消
+	OrderStatusPerpMarginRejected      OrderStatus = "perpMarginRejected"      // 保证金不足拒绝
+	OrderStatusMinTradeNtlRejected     OrderStatus = "minTradeNtlRejected"     // 低于最小名义价值拒绝
+	OrderStatusBadAloPxRejected        OrderStatus = "badAloPxRejected"        // post-only价格无效拒绝
+	OrderStatusIocCancelRejected       OrderStatus = "iocCancelRejected"       // IOC未成交拒绝
+	OrderStatusReduceOnlyRejected      OrderStatus = "reduceOnlyRejected"      // 只减仓拒绝
+)
+
+// Tif 订单有效期类型
+type Tif string
+
+const (
+	TifGtc Tif = "Gtc" // 一直有效直到取消
+	TifIoc Tif = "Ioc" // 立即成交否则取消
+	TifAlo Tif = "Alo" // 只做Maker(post-only)
+)
+
+// AssetInfo 永续合约资产信息
+type AssetInfo struct {
+	Name         string `json:"name"`         // 资产名称, 例如 BTC
+	SzDecimals   int    `json:"szDecimals"`   // 数量小数位数
+	MaxLeverage  int    `json:"maxLeverage"`  // 最大杠杆倍数
+	OnlyIsolated bool   `json:"onlyIsolated"` // 是否仅支持逐仓
+	IsDelisted   bool   `json:"isDelisted"`   // 是否已下架
+}
+
+// Meta 永续合约元数据
+type Meta struct {
+	Universe []*AssetInfo `json:"universe"` // 资产列表, 下标即资产ID
+}
+
+// Order 订单信息
+type Order struct {
+	Coin      string          `json:"coin"`      // 资产名称
+	Side      OrderSide       `json:"side"`      // 订单方向
+	LimitPx   decimal.Decimal `json:"limitPx"`   // 限价
+	Sz        decimal.Decimal `json:"sz"`        // 剩余数量
+	OrigSz    decimal.Decimal `json:"origSz"`    // 原始数量
+	Oid       int64           `json:"oid"`       // 订单ID
+	Cloid     string          `json:"cloid"`     // 客户端订单ID
+	Timestamp int64           `json:"timestamp"` // 创建时间(毫秒)
+	OrderType string          `json:"orderType"` // 订单类型
+	Tif       string          `json:"tif"`       // 有效期类型
+}
+
+// OrderUpdate 订单状态更新
+// historicalOrders 接口和 orderUpdates 推送共用此结构
+type OrderUpdate struct {
+	Order           Order       `json:"order"`           // 订单信息
+	Status          OrderStatus `json:"status"`          // 订单状态
+	StatusTimestamp int64       `json:"statusTimestamp"` // 状态更新时间(毫秒)
+}
+
+// Fill 成交记录
+type Fill struct {
+	Coin          string          `json:"coin"`          // 资产名称
+	Px            decimal.Decimal `json:"px"`            // 成交价格
+	Sz            decimal.Decimal `json:"sz"`            // 成交数量
+	Side          OrderSide       `json:"side"`          // 成交方向
+	Time          int64           `json:"time"`          // 成交时间(毫秒)
+	Oid           int64           `json:"oid"`           // 订单ID
+	Cloid         string          `json:"cloid"`         // 客户端订单ID
+	Crossed       bool            `json:"crossed"`       // 是否为Taker
+	Fee           decimal.Decimal `json:"fee"`           // 手续费
+	FeeToken      string          `json:"feeToken"`      // 手续费币种
+	ClosedPnl     decimal.Decimal `json:"closedPnl"`     // 平仓盈亏
+	Hash          string          `json:"hash"`          // 交易哈希
+	Tid           int64           `json:"tid"`           // 成交ID
+	StartPosition decimal.Decimal `json:"startPosition"` // 成交前持仓
+}
+
+// FundingDelta 资金费用变动
+type FundingDelta struct {
+	Type        string          `json:"type"`        // 变动类型(funding)
+	Coin        string          `json:"coin"`        // 资产名称
+	Usdc        decimal.Decimal `json:"usdc"`        // 资金费用(收取为正，支付为负)
+	Szi         decimal.Decimal `json:"szi"`         // 结算时的持仓数量(空头为负数)
+	FundingRate decimal.Decimal `json:"fundingRate"` // 资金费率
+}
+
+// UserFunding 用户资金费用记录
+type UserFunding struct {
+	Time  int64        `json:"time"`  // 结算时间(毫秒)
+	Hash  string       `json:"hash"`  // 交易哈希
+	Delta FundingDelta `json:"delta"` // 资金费用变动
+}
+
+// Candle K线
+type Candle struct {
+	OpenTime  int64           `json:"t"` // 开盘时间(毫秒)
+	CloseTime int64           `json:"T"` // 收盘时间(毫秒)
+	Coin      string          `json:"s"` // 资产名称
+	Interval  string          `json:"i"` // K线周期
+	Open      decimal.Decimal `json:"o"` // 开盘价
+	High      decimal.Decimal `json:"h"` // 最高价
+	Low       decimal.Decimal `json:"l"` // 最低价
+	Close     decimal.Decimal `json:"c"` // 收盘价
+	Volume    decimal.Decimal `json:"v"` // 成交量
+	Trades    int             `json:"n"` // 成交笔数
+}
+
+// Leverage 杠杆信息
+type Leverage struct {
+	Type  string `json:"type"`  // cross 或 isolated
+	Value int    `json:"value"` // 杠杆倍数
+}
+
+// CumFunding 累计资金费用
+type CumFunding struct {
+	AllTime     decimal.Decimal `json:"allTime"`     // 历史累计
+	SinceOpen   decimal.Decimal `json:"sinceOpen"`   // 开仓以来
+	SinceChange decimal.Decimal `json:"sinceChange"` // 仓位变化以来
+}
+
+// Position 持仓信息
+type Position struct {
+	Coin           string           `json:"coin"`           // 资产名称
+	Szi            decimal.Decimal  `json:"szi"`            // 持仓数量(正数为多头, 负数为空头)
+	EntryPx        *decimal.Decimal `json:"entryPx"`        // 开仓均价
+	PositionValue  decimal.Decimal  `json:"positionValue"`  // 持仓价值
+	UnrealizedPnl  decimal.Decimal  `json:"unrealizedPnl"`  // 未实现盈亏
+	LiquidationPx  *decimal.Decimal `json:"liquidationPx"`  // 强平价格
+	MarginUsed     decimal.Decimal  `json:"marginUsed"`     // 占用保证金
+	Leverage       Leverage         `json:"leverage"`       // 杠杆信息
+	CumFunding     CumFunding       `json:"cumFunding"`     // 累计资金费用
+	ReturnOnEquity decimal.Decimal  `json:"returnOnEquity"` // 收益率
+}
+
+// AssetPosition 资产持仓
+type AssetPosition struct {
+	Type     string   `json:"type"`     // 持仓模式
+	Position Position `json:"position"` // 持仓信息
+}
+
+// MarginSummary 保证金摘要
+type MarginSummary struct {
+	AccountValue    decimal.Decimal `json:"accountValue"`    // 账户价值
+	TotalNtlPos     decimal.Decimal `json:"totalNtlPos"`     // 总名义持仓
+	TotalRawUsd     decimal.Decimal `json:"totalRawUsd"`     // 原始USD余额
+	TotalMarginUsed decimal.Decimal `json:"totalMarginUsed"` // 已用保证金
+}
+
+// ClearinghouseState 永续合约账户状态
+type ClearinghouseState struct {
+	MarginSummary      MarginSummary    `json:"marginSummary"`      // 保证金摘要
+	CrossMarginSummary MarginSummary    `json:"crossMarginSummary"` // 全仓保证金摘要
+	Withdrawable       decimal.Decimal  `json:"withdrawable"`       // 可提现金额
+	AssetPositions     []*AssetPosition `json:"assetPositions"`     // 持仓列表
+	Time               int64            `json:"time"`               // 查询时间(毫秒)
+}
+
+// Signature 签名
+type Signature struct {
+	R string `json:"r"`
+	S string `json:"s"`
+	V int    `json:"v"`
+}
+
+// ExchangeRequest 交易接口请求
+type ExchangeRequest struct {
+	Action       any       `json:"action"`       // 操作内容
+	Nonce        int64     `json:"nonce"`        // 请求序号(毫秒时间戳)
+	Signature    Signature `json:"signature"`    // 签名
+	VaultAddress *string   `json:"vaultAddress"` // 金库地址
+}
+
+// ExchangeResponse 交易接口响应
+type ExchangeResponse struct {
+	Status   string          `json:"status"`   // ok 或 err
+	Response json.RawMessage `json:"response"` // 响应内容, 出错时为错误字符串
+}
+
+// ExchangeResponseData 交易接口响应数据
+type ExchangeResponseData struct {
+	Type string `json:"type"`
+	Data struct {
+		Statuses []json.RawMessage `json:"statuses"`
+	} `json:"data"`
+}
+
+// OrderResponseStatus 下单结果
+type OrderResponseStatus struct {
+	Resting *struct {
+		Oid   int64  `json:"oid"`
+		Cloid string `json:"cloid"`
+	} `json:"resting"`
+	Filled *struct {
+		Oid     int64           `json:"oid"`
+		TotalSz decimal.Decimal `json:"totalSz"`
+		AvgPx   decimal.Decimal `json:"avgPx"`
+	} `json:"filled"`
+	Error string `json:"error"`
+}
+
+// WebSocketMessage WebSocket推送消息
+type WebSocketMessage struct {
+	Channel string          `json:"channel"` // 频道名称
+	Data    json.RawMessage `json:"data"`    // 数据内容
+}
+
+// AllMidsData allMids 频道数据
+type AllMidsData struct {
+	Mids map[string]decimal.Decimal `json:"mids"` // 资产名称 -> 中间价
+}
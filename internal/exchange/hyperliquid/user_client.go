@@ -0,0 +1,195 @@
+package hyperliquid
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// OrderRequest 下单请求
+type OrderRequest struct {
+	Coin       string          // 资产名称
+	IsBuy      bool            // 是否为买单
+	LimitPx    decimal.Decimal // 限价
+	Sz         decimal.Decimal // 数量
+	ReduceOnly bool            // 是否只减仓
+	Tif        Tif             // 有效期类型
+	Cloid      string          // 客户端订单ID(可选)
+}
+
+// CancelRequest 撤单请求
+type CancelRequest struct {
+	Coin string // 资产名称
+	Oid  int64  // 订单ID
+}
+
+// UserClient Hyperliquid用户客户端
+// 账户地址用于查询，API钱包私钥用于签名交易请求
+type UserClient struct {
+	client  *Client // Hyperliquid HTTP客户端
+	account string  // 主账户地址
+	signer  *Signer // API钱包签名器
+}
+
+// NewUserClient 创建用户客户端实例
+// account 主账户地址，privateKey 已授权的API钱包私钥
+func NewUserClient(client *Client, account, privateKey string) (*UserClient, error) {
+	signer, err := NewSigner(privateKey, client.IsMainnet())
+	if err != nil {
+		return nil, err
+	}
+	return &UserClient{client: client, account: account, signer: signer}, nil
+}
+
+// Account 获取账户地址
+func (c *UserClient) Account() string {
+	return c.account
+}
+
+// GetOpenOrders 获取当前挂单
+func (c *UserClient) GetOpenOrders(ctx context.Context) ([]*Order, error) {
+	return c.client.GetOpenOrders(ctx, c.account)
+}
+
+// GetHistoricalOrders 获取历史订单
+func (c *UserClient) GetHistoricalOrders(ctx context.Context) ([]*OrderUpdate, error) {
+	return c.client.GetHistoricalOrders(ctx, c.account)
+}
+
+// GetUserFillsByTime 获取指定时间之后的成交记录
+func (c *UserClient) GetUserFillsByTime(ctx context.Context, startTime int64) ([]*Fill, error) {
+	return c.client.GetUserFillsByTime(ctx, c.account, startTime)
+}
+
+// GetUserFunding 获取指定时间之后的资金费用记录
+func (c *UserClient) GetUserFunding(ctx context.Context, startTime int64) ([]*UserFunding, error) {
+	return c.client.GetUserFunding(ctx, c.account, startTime)
+}
+
+// GetClearinghouseState 获取账户状态
+func (c *UserClient) GetClearinghouseState(ctx context.Context) (*ClearinghouseState, error) {
+	return c.client.GetClearinghouseState(ctx, c.account)
+}
+
+// PlaceOrders 批量下单
+// 返回每个订单的下单结果，顺序与请求一致
+func (c *UserClient) PlaceOrders(ctx context.Context, orders []OrderRequest) ([]OrderResponseStatus, error) {
+	if len(orders) == 0 {
+		return nil, nil
+	}
+
+	wires := make([]OrderedMap, 0, len(orders))
+	for _, item := range orders {
+		asset, info, err := c.client.GetAssetInfo(ctx, item.Coin)
+		if err != nil {
+			return nil, err
+		}
+
+		wire := OrderedMap{
+			{Key: "a", Value: asset},
+			{Key: "b", Value: item.IsBuy},
+			{Key: "p", Value: FormatPrice(item.LimitPx, info.SzDecimals).String()},
+			{Key: "s", Value: FormatSize(item.Sz, info.SzDecimals).String()},
+			{Key: "r", Value: item.ReduceOnly},
+			{Key: "t", Value: OrderedMap{{Key: "limit", Value: OrderedMap{{Key: "tif", Value: string(item.Tif)}}}}},
+		}
+		if item.Cloid != "" {
+			wire = append(wire, MapItem{Key: "c", Value: item.Cloid})
+		}
+		wires = append(wires, wire)
+	}
+
+	action := OrderedMap{
+		{Key: "type", Value: "order"},
+		{Key: "orders", Value: wires},
+		{Key: "grouping", Value: "na"},
+	}
+	data, err := c.postAction(ctx, action)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]OrderResponseStatus, 0, len(data.Data.Statuses))
+	for _, raw := range data.Data.Statuses {
+		var status OrderResponseStatus
+		if err = json.Unmarshal(raw, &status); err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// CancelOrders 按订单ID批量撤单
+func (c *UserClient) CancelOrders(ctx context.Context, cancels []CancelRequest) error {
+	if len(cancels) == 0 {
+		return nil
+	}
+
+	wires := make([]OrderedMap, 0, len(cancels))
+	for _, item := range cancels {
+		asset, _, err := c.client.GetAssetInfo(ctx, item.Coin)
+		if err != nil {
+			return err
+		}
+		wires = append(wires, OrderedMap{{Key: "a", Value: asset}, {Key: "o", Value: item.Oid}})
+	}
+
+	action := OrderedMap{
+		{Key: "type", Value: "cancel"},
+		{Key: "cancels", Value: wires},
+	}
+	data, err := c.postAction(ctx, action)
+	if err != nil {
+		return err
+	}
+	return firstStatusError(data)
+}
+
+// UpdateLeverage 更新杠杆倍数
+func (c *UserClient) UpdateLeverage(ctx context.Context, coin string, leverage int, isCross bool) error {
+	asset, _, err := c.client.GetAssetInfo(ctx, coin)
+	if err != nil {
+		return err
+	}
+
+	action := OrderedMap{
+		{Key: "type", Value: "updateLeverage"},
+		{Key: "asset", Value: asset},
+		{Key: "isCross", Value: isCross},
+		{Key: "leverage", Value: leverage},
+	}
+	_, err = c.postAction(ctx, action)
+	return err
+}
+
+// postAction 签名并提交 action
+func (c *UserClient) postAction(ctx context.Context, action OrderedMap) (*ExchangeResponseData, error) {
+	nonce := c.signer.NextNonce()
+	signature, err := c.signer.SignL1Action(action, nil, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &ExchangeRequest{
+		Action:    action,
+		Nonce:     nonce,
+		Signature: signature,
+	}
+	return c.client.Exchange(ctx, req)
+}
+
+// firstStatusError 返回响应中第一个失败状态的错误
+func firstStatusError(data *ExchangeResponseData) error {
+	for _, raw := range data.Data.Statuses {
+		var status struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(raw, &status); err == nil && status.Error != "" {
+			return errors.New(status.Error)
+		}
+	}
+	return nil
+}
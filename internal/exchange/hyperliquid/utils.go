@@ -0,0 +1,163 @@
+package hyperliquid
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/config"
+	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/gorilla/websocket"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"golang.org/x/net/proxy"
+)
+
+const (
+	// maxSignificantFigures 永续合约价格最多有效数字位数
+	maxSignificantFigures = 5
+
+	// maxPerpDecimals 永续合约价格与数量小数位数之和的上限
+	maxPerpDecimals = 6
+)
+
+// candleIntervals 支持的K线周期
+var candleIntervals = map[time.Duration]string{
+	time.Minute:        "1m",
+	5 * time.Minute:    "5m",
+	15 * time.Minute:   "15m",
+	30 * time.Minute:   "30m",
+	time.Hour:          "1h",
+	4 * time.Hour:      "4h",
+	12 * time.Hour:     "12h",
+	24 * time.Hour:     "1d",
+	7 * 24 * time.Hour: "1w",
+}
+
+// FormatCandleInterval 格式化K线周期
+// time.Hour -> 1h
+func FormatCandleInterval(interval time.Duration) (string, bool) {
+	s, ok := candleIntervals[interval]
+	return s, ok
+}
+
+// ConvertCandle 转换K线
+// 将Hyperliquid K线转换为内部K线格式
+func ConvertCandle(candle *Candle) exchange.Candle {
+	return exchange.Candle{
+		Time:   time.UnixMilli(candle.OpenTime),
+		Open:   candle.Open,
+		High:   candle.High,
+		Low:    candle.Low,
+		Close:  candle.Close,
+		Volume: candle.Volume,
+	}
+}
+
+// ConvertOrderStatus 转换订单状态
+// 将Hyperliquid订单状态转换为内部订单状态
+func ConvertOrderStatus(status OrderStatus) order.Status {
+	switch status {
+	case OrderStatusOpen, OrderStatusTriggered:
+		return order.StatusOpen
+	case OrderStatusFilled:
+		return order.StatusFilled
+	}
+
+	if strings.HasSuffix(string(status), "Canceled") ||
+		strings.HasSuffix(string(status), "Rejected") ||
+		status == OrderStatusScheduledCancel {
+		return order.StatusCanceled
+	}
+	return order.StatusPending
+}
+
+// NewCloid 生成客户端订单ID
+// Hyperliquid 要求 cloid 为 16 字节的十六进制字符串
+func NewCloid(n int64) string {
+	return fmt.Sprintf("0x%032x", uint64(n))
+}
+
+// PriceDecimals 计算价格允许的小数位数
+// 价格最多 5 位有效数字，且小数位数不超过 6 - szDecimals，整数价格始终有效
+func PriceDecimals(price decimal.Decimal, szDecimals int) int32 {
+	maxDecimals := int32(maxPerpDecimals - szDecimals)
+	if maxDecimals < 0 {
+		maxDecimals = 0
+	}
+
+	if price.Abs().LessThan(decimal.NewFromInt(1)) {
+		// 小于1时按首个非零小数位计算有效数字
+		leadingZeros := int32(0)
+		for p := price.Abs(); !p.IsZero() && p.LessThan(decimal.NewFromFloat(0.1)); p = p.Shift(1) {
+			leadingZeros++
+		}
+		return min(leadingZeros+maxSignificantFigures, maxDecimals)
+	}
+
+	intDigits := int32(len(price.Abs().Truncate(0).String()))
+	return max(min(maxSignificantFigures-intDigits, maxDecimals), 0)
+}
+
+// FormatPrice 将价格舍入到Hyperliquid允许的精度
+func FormatPrice(price decimal.Decimal, szDecimals int) decimal.Decimal {
+	return price.Round(PriceDecimals(price, szDecimals))
+}
+
+// FormatSize 将数量截断到资产允许的小数位数
+func FormatSize(size decimal.Decimal, szDecimals int) decimal.Decimal {
+	return size.Truncate(int32(szDecimals))
+}
+
+// dialWebSocket 建立WebSocket连接
+// 启用SOCKS5代理时通过代理拨号
+func dialWebSocket(ctx context.Context, url string, sock5 config.Sock5Proxy) (*websocket.Conn, error) {
+	sock5Proxy := ""
+	if sock5.Enable {
+		sock5Proxy = fmt.Sprintf("%s:%d", sock5.Host, sock5.Port)
+	}
+
+	netDial := func(ctx context.Context, network, addr string) (net.Conn, error) {
+		if sock5Proxy == "" {
+			netDialer := &net.Dialer{}
+			return netDialer.DialContext(ctx, network, addr)
+		}
+
+		dialer, err := proxy.SOCKS5(network, sock5Proxy, nil, proxy.Direct)
+		if err != nil {
+			return nil, err
+		}
+		return dialer.Dial(network, addr)
+	}
+
+	dialer := &websocket.Dialer{
+		NetDial: func(network, addr string) (net.Conn, error) {
+			return netDial(ctx, network, addr)
+		},
+		HandshakeTimeout: 45 * time.Second,
+	}
+	conn, _, err := dialer.Dial(url, nil)
+	return conn, err
+}
+
+// ConvertOrderUpdate 转换订单更新
+// orderUpdates 不包含成交均价，已成交金额按限价估算
+func ConvertOrderUpdate(item *OrderUpdate) *exchange.Order {
+	filledBaseAmount := item.Order.OrigSz.Sub(item.Order.Sz)
+	return &exchange.Order{
+		Symbol:            item.Order.Coin,
+		OrderID:           strconv.FormatInt(item.Order.Oid, 10),
+		ClientOrderID:     item.Order.Cloid,
+		Side:              lo.If(item.Order.Side == OrderSideSell, order.SideSell).Else(order.SideBuy),
+		Price:             item.Order.LimitPx,
+		BaseAmount:        item.Order.OrigSz,
+		FilledBaseAmount:  filledBaseAmount,
+		FilledQuoteAmount: filledBaseAmount.Mul(item.Order.LimitPx),
+		Timestamp:         item.StatusTimestamp,
+		Status:            ConvertOrderStatus(item.Status),
+	}
+}
@@ -0,0 +1,218 @@
+// Package hyperliquid 提供Hyperliquid交易所的WebSocket用户连接实现
+// 支持实时订单推送、重连机制和心跳检测
+package hyperliquid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/config"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+
+	"github.com/gorilla/websocket"
+)
+
+type StoppedCallback func(account string)
+
+type HyperliquidWS struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	stopChan chan struct{}
+
+	url       string
+	conn      *websocket.Conn
+	proxy     config.Sock5Proxy
+	reconnect chan struct{}
+
+	account        string
+	userOrdersChan chan<- exchange.UserOrders
+	callback       StoppedCallback
+}
+
+func NewHyperliquidWS(
+	ctx context.Context,
+	url string,
+	account string,
+	userOrdersChan chan<- exchange.UserOrders,
+	proxy config.Sock5Proxy,
+	callback StoppedCallback,
+) *HyperliquidWS {
+	ctx, cancel := context.WithCancel(ctx)
+	ws := &HyperliquidWS{
+		ctx:            ctx,
+		cancel:         cancel,
+		url:            url,
+		proxy:          proxy,
+		reconnect:      make(chan struct{}, 1),
+		account:        account,
+		userOrdersChan: userOrdersChan,
+		callback:       callback,
+	}
+	return ws
+}
+
+func (ws *HyperliquidWS) Stop() {
+	if ws.stopChan == nil {
+		return
+	}
+
+	logger.Infof("[HyperliquidWS-%s] 准备停止服务", ws.account)
+
+	ws.cancel()
+	if ws.conn != nil {
+		ws.conn.Close()
+	}
+
+	<-ws.stopChan
+
+	close(ws.stopChan)
+	ws.stopChan = nil
+
+	logger.Infof("[HyperliquidWS-%s] 服务已经停止", ws.account)
+}
+
+func (ws *HyperliquidWS) Start() {
+	if ws.stopChan != nil {
+		return
+	}
+
+	ws.stopChan = make(chan struct{}, 1)
+
+	if ws.conn == nil {
+		logger.Infof("[HyperliquidWS-%s] 开始运行服务", ws.account)
+		go ws.run()
+	}
+}
+
+func (ws *HyperliquidWS) run() {
+	ws.connect()
+
+	reconnectDelay := reconnectInitial
+loop:
+	for {
+		select {
+		case <-ws.ctx.Done():
+			break loop
+		case <-ws.reconnect:
+			select {
+			case <-ws.ctx.Done():
+				break loop
+			case <-time.After(reconnectDelay):
+				logger.Infof("[HyperliquidWS-%s] 重新建立连接...", ws.account)
+				ws.connect()
+
+				reconnectDelay *= 2
+				if reconnectDelay > reconnectMax {
+					reconnectDelay = reconnectMax
+				}
+			}
+		}
+	}
+
+	if ws.callback != nil {
+		ws.callback(ws.account)
+	}
+
+	ws.stopChan <- struct{}{}
+}
+
+func (ws *HyperliquidWS) connect() {
+	conn, err := dialWebSocket(ws.ctx, ws.url, ws.proxy)
+	if err != nil {
+		logger.Errorf("[HyperliquidWS-%s] 连接失败, %v", ws.account, err)
+		ws.scheduleReconnect()
+		return
+	}
+
+	ws.conn = conn
+	logger.Infof("[HyperliquidWS-%s] 连接已建立", ws.account)
+
+	go ws.readMessages()
+}
+
+func (ws *HyperliquidWS) readMessages() {
+	defer ws.conn.Close()
+	account := ws.account
+
+	// 订阅订单
+	message := fmt.Sprintf(`{"method":"subscribe","subscription":{"type":"orderUpdates","user":"%s"}}`, account)
+	if err := ws.conn.WriteMessage(websocket.TextMessage, []byte(message)); err != nil {
+		logger.Errorf("[HyperliquidWS-%s] 订阅订单更新失败, %v", account, err)
+		ws.scheduleReconnect()
+		return
+	}
+
+	// 定时心跳
+	ctx, cancel := context.WithCancel(ws.ctx)
+	defer cancel()
+	go heartbeat(ctx, ws.conn, "HyperliquidWS-"+account)
+
+	// 手动触发
+	// orderUpdates 频道不推送快照，通过空快照触发订单全量同步
+	userOrders := exchange.UserOrders{
+		Exchange:   exchange.Hyperliquid,
+		Account:    account,
+		Orders:     []*exchange.Order{},
+		IsSnapshot: true,
+	}
+	ws.userOrdersChan <- userOrders
+
+	// 消息循环
+	for {
+		_, data, err := ws.conn.ReadMessage()
+		if err != nil {
+			if strings.Contains(err.Error(), "use of closed network connection") {
+				return
+			}
+			logger.Warnf("[HyperliquidWS-%s] 读取出错, %v", account, err)
+			ws.scheduleReconnect()
+			return
+		}
+
+		logger.Tracef("[HyperliquidWS-%s] 收到新消息, %s", account, data)
+
+		var res WebSocketMessage
+		if err = json.Unmarshal(data, &res); err != nil {
+			logger.Warnf("[HyperliquidWS-%s] 解析响应失败, %s, %v", account, string(data), err)
+			continue
+		}
+
+		switch res.Channel {
+		case "error":
+			logger.Errorf("[HyperliquidWS-%s] 请求处理失败, %s", account, string(res.Data))
+		case "orderUpdates":
+			var updates []*OrderUpdate
+			if err = json.Unmarshal(res.Data, &updates); err != nil {
+				logger.Warnf("[HyperliquidWS-%s] 解析订阅订单数据失败, %s, %v", account, string(res.Data), err)
+				continue
+			}
+			if len(updates) == 0 {
+				continue
+			}
+
+			userOrders := exchange.UserOrders{
+				Exchange: exchange.Hyperliquid,
+				Account:  account,
+				Orders:   make([]*exchange.Order, 0, len(updates)),
+			}
+			for _, item := range updates {
+				userOrders.Orders = append(userOrders.Orders, ConvertOrderUpdate(item))
+			}
+
+			ws.userOrdersChan <- userOrders
+		}
+	}
+}
+
+func (ws *HyperliquidWS) scheduleReconnect() {
+	if ws.ctx.Err() == nil {
+		select {
+		case ws.reconnect <- struct{}{}:
+		default:
+		}
+	}
+}
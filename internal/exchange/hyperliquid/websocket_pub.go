@@ -0,0 +1,260 @@
+// Package hyperliquid 提供Hyperliquid交易所的WebSocket公共数据连接实现
+// 通过 allMids 频道获取中间价，支持重连机制和心跳检测
+package hyperliquid
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/config"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	reconnectInitial = 1 * time.Second
+	reconnectMax     = 30 * time.Second
+
+	// heartbeatInterval 服务端会关闭60秒内无消息的连接
+	heartbeatInterval = 30 * time.Second
+)
+
+type HyperliquidPubWS struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	stopChan chan struct{}
+
+	url       string
+	conn      *websocket.Conn
+	proxy     config.Sock5Proxy
+	reconnect chan struct{}
+
+	mutex   sync.RWMutex
+	symbols map[string]struct{}
+
+	marketStatsChan chan<- exchange.MarketStats
+}
+
+func NewHyperliquidPubWS(
+	ctx context.Context,
+	url string,
+	marketStatsChan chan<- exchange.MarketStats,
+	proxy config.Sock5Proxy,
+) *HyperliquidPubWS {
+	ctx, cancel := context.WithCancel(ctx)
+	ws := &HyperliquidPubWS{
+		ctx:             ctx,
+		cancel:          cancel,
+		url:             url,
+		proxy:           proxy,
+		reconnect:       make(chan struct{}, 1),
+		symbols:         make(map[string]struct{}),
+		marketStatsChan: marketStatsChan,
+	}
+	return ws
+}
+
+func (ws *HyperliquidPubWS) Stop() {
+	if ws.stopChan == nil {
+		return
+	}
+
+	logger.Infof("[HyperliquidPubWS] 准备停止服务")
+
+	ws.cancel()
+	if ws.conn != nil {
+		ws.conn.Close()
+	}
+
+	<-ws.stopChan
+
+	close(ws.stopChan)
+	ws.stopChan = nil
+
+	logger.Infof("[HyperliquidPubWS] 服务已经停止")
+}
+
+func (ws *HyperliquidPubWS) Start() {
+	if ws.stopChan != nil {
+		return
+	}
+
+	ws.stopChan = make(chan struct{}, 1)
+
+	if ws.conn == nil {
+		logger.Infof("[HyperliquidPubWS] 开始运行服务")
+		go ws.run()
+	}
+}
+
+func (ws *HyperliquidPubWS) WaitUntilConnected() {
+	for ws.conn == nil {
+		time.Sleep(time.Second * 1)
+	}
+}
+
+// SubscribeMarketStats 订阅交易对行情
+// allMids 频道推送全部资产中间价，此处仅记录需要分发的交易对
+func (ws *HyperliquidPubWS) SubscribeMarketStats(symbol string) error {
+	if ws.conn == nil {
+		return errors.New("connection is not established")
+	}
+
+	ws.mutex.Lock()
+	ws.symbols[symbol] = struct{}{}
+	ws.mutex.Unlock()
+
+	return nil
+}
+
+func (ws *HyperliquidPubWS) UnsubscribeMarketStats(symbol string) error {
+	ws.mutex.Lock()
+	delete(ws.symbols, symbol)
+	ws.mutex.Unlock()
+
+	return nil
+}
+
+func (ws *HyperliquidPubWS) run() {
+	ws.connect()
+
+	reconnectDelay := reconnectInitial
+loop:
+	for {
+		select {
+		case <-ws.ctx.Done():
+			break loop
+		case <-ws.reconnect:
+			select {
+			case <-ws.ctx.Done():
+				break loop
+			case <-time.After(reconnectDelay):
+				logger.Infof("[HyperliquidPubWS] 重新建立连接...")
+				ws.connect()
+
+				reconnectDelay *= 2
+				if reconnectDelay > reconnectMax {
+					reconnectDelay = reconnectMax
+				}
+			}
+		}
+	}
+
+	ws.stopChan <- struct{}{}
+}
+
+func (ws *HyperliquidPubWS) connect() {
+	conn, err := dialWebSocket(ws.ctx, ws.url, ws.proxy)
+	if err != nil {
+		logger.Errorf("[HyperliquidPubWS] 连接失败, %v", err)
+		ws.scheduleReconnect()
+		return
+	}
+
+	ws.conn = conn
+	logger.Infof("[HyperliquidPubWS] 连接已建立")
+
+	go ws.readMessages()
+}
+
+func (ws *HyperliquidPubWS) readMessages() {
+	defer ws.conn.Close()
+
+	// 订阅中间价
+	message := `{"method":"subscribe","subscription":{"type":"allMids"}}`
+	if err := ws.conn.WriteMessage(websocket.TextMessage, []byte(message)); err != nil {
+		logger.Errorf("[HyperliquidPubWS] 订阅中间价失败, %v", err)
+		ws.scheduleReconnect()
+		return
+	}
+
+	// 定时心跳
+	ctx, cancel := context.WithCancel(ws.ctx)
+	defer cancel()
+	go heartbeat(ctx, ws.conn, "HyperliquidPubWS")
+
+	// 消息循环
+	for {
+		_, data, err := ws.conn.ReadMessage()
+		if err != nil {
+			if strings.Contains(err.Error(), "use of closed network connection") {
+				return
+			}
+			logger.Warnf("[HyperliquidPubWS] 读取出错, %v", err)
+			ws.scheduleReconnect()
+			return
+		}
+
+		logger.Tracef("[HyperliquidPubWS] 收到新消息, %s", data)
+
+		var res WebSocketMessage
+		if err = json.Unmarshal(data, &res); err != nil {
+			logger.Warnf("[HyperliquidPubWS] 解析响应失败, %s, %v", string(data), err)
+			continue
+		}
+
+		switch res.Channel {
+		case "error":
+			logger.Errorf("[HyperliquidPubWS] 请求处理失败, %s", string(res.Data))
+		case "allMids":
+			var v AllMidsData
+			if err = json.Unmarshal(res.Data, &v); err != nil {
+				logger.Warnf("[HyperliquidPubWS] 解析中间价数据失败, %s, %v", string(res.Data), err)
+				continue
+			}
+
+			ws.mutex.RLock()
+			for symbol := range ws.symbols {
+				mid, ok := v.Mids[symbol]
+				if !ok {
+					continue
+				}
+
+				marketStats := exchange.MarketStats{
+					Symbol:    symbol,
+					Price:     mid,
+					MarkPrice: mid,
+				}
+
+				logger.Tracef("[HyperliquidPubWS] 分发 MarketStats 数据, %+v", marketStats)
+				ws.marketStatsChan <- marketStats
+			}
+			ws.mutex.RUnlock()
+		}
+	}
+}
+
+func (ws *HyperliquidPubWS) scheduleReconnect() {
+	if ws.ctx.Err() == nil {
+		select {
+		case ws.reconnect <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// heartbeat 定时发送应用层心跳
+// 服务端回复 pong 频道消息
+func heartbeat(ctx context.Context, conn *websocket.Conn, name string) {
+	timer := time.NewTimer(heartbeatInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(`{"method":"ping"}`)); err != nil {
+				logger.Errorf("[%s] 发送心跳消息失败, %v", name, err)
+				return
+			}
+			timer.Reset(heartbeatInterval)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package lighter
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/samber/lo"
+)
+
+// recordSource 录制帧的连接名称
+const recordSource = "LighterSubscriber"
+
+// messageParser 订阅消息解析器
+// 维护单个连接内已收到订单快照的账户，实盘连接和录制回放共用同一套解析逻辑
+type messageParser struct {
+	ctx               context.Context
+	marketResolver    MarketResolver
+	processedAccounts map[int64]struct{}
+}
+
+func newMessageParser(ctx context.Context, marketResolver MarketResolver) *messageParser {
+	return &messageParser{
+		ctx:               ctx,
+		marketResolver:    marketResolver,
+		processedAccounts: make(map[int64]struct{}),
+	}
+}
+
+// parse 解析订阅消息，返回需要分发的订阅消息
+func (p *messageParser) parse(message WebSocketMessage) []exchange.SubMessage {
+	switch message.Type {
+	case "unsubscribed":
+		const prefix = "account_all_orders:"
+		if !strings.HasPrefix(message.Channel, prefix) {
+			return nil
+		}
+
+		accountIndex, err := strconv.ParseInt(message.Channel[len(prefix):], 10, 64)
+		if err != nil {
+			logger.Errorf("[LighterSubscriber] 解析channel失败, channel: %s, %v", message.Channel, err)
+			return nil
+		}
+
+		delete(p.processedAccounts, accountIndex)
+	case "update/market_stats":
+		if marketStats := p.parseMarketStats(message); marketStats != nil {
+			return []exchange.SubMessage{{Exchange: exchange.Lighter, MarketStats: marketStats}}
+		}
+	case "subscribed/account_all_orders", "update/account_all_orders":
+		if userOrders := p.parseOrders(message); userOrders != nil {
+			return []exchange.SubMessage{{Exchange: exchange.Lighter, UserOrders: userOrders}}
+		}
+	}
+	return nil
+}
+
+// parseMarketStats 解析市场统计数据消息
+func (p *messageParser) parseMarketStats(message WebSocketMessage) *exchange.MarketStats {
+	const prefix = "market_stats:"
+	if !strings.HasPrefix(message.Channel, prefix) {
+		logger.Errorf("[LighterSubscriber] 解析channel失败, channel: %s", message.Channel)
+		return nil
+	}
+
+	marketIndex, err := strconv.ParseInt(message.Channel[len(prefix):], 10, 64)
+	if err != nil {
+		logger.Errorf("[LighterSubscriber] 解析channel失败, channel: %s, %v", message.Channel, err)
+		return nil
+	}
+
+	if message.MarketStats == nil {
+		return nil
+	}
+
+	symbol, err := p.marketResolver.GetSymbolByMarketId(p.ctx, int16(marketIndex))
+	if err != nil {
+		logger.Errorf("[LighterSubscriber] 解析MarketIndex失败, marketIndex: %d, %v", marketIndex, err)
+		return nil
+	}
+
+	marketStats := exchange.MarketStats{
+		Symbol:    symbol,
+		Price:     message.MarketStats.LastTradePrice,
+		MarkPrice: message.MarketStats.MarkPrice,
+	}
+
+	logger.Tracef("[LighterSubscriber] 分发 MarketStats 数据, %+v", marketStats)
+	return &marketStats
+}
+
+// parseOrders 解析订单消息
+// 每个账户在当前连接收到的第一条订单消息视为快照
+func (p *messageParser) parseOrders(message WebSocketMessage) *exchange.UserOrders {
+	const prefix = "account_all_orders:"
+	if !strings.HasPrefix(message.Channel, prefix) {
+		logger.Errorf("[LighterSubscriber] 解析channel失败, channel: %s", message.Channel)
+		return nil
+	}
+
+	accountIndex, err := strconv.ParseInt(message.Channel[len(prefix):], 10, 64)
+	if err != nil {
+		logger.Errorf("[LighterSubscriber] 解析channel失败, channel: %s, %v", message.Channel, err)
+		return nil
+	}
+
+	_, ok := p.processedAccounts[accountIndex]
+	p.processedAccounts[accountIndex] = struct{}{}
+	if message.Orders == nil {
+		return nil
+	}
+
+	userOrders := exchange.UserOrders{
+		Exchange:   exchange.Lighter,
+		Account:    strconv.FormatInt(accountIndex, 10),
+		Orders:     make([]*exchange.Order, 0),
+		IsSnapshot: !ok,
+	}
+
+	for marketIndex, marketOrders := range message.Orders {
+		marketIndexN, err := strconv.Atoi(marketIndex)
+		if err != nil {
+			logger.Errorf("[LighterSubscriber] 解析MarketIndex失败, marketIndex: %s, %v", marketIndex, err)
+			continue
+		}
+
+		symbol, err := p.marketResolver.GetSymbolByMarketId(p.ctx, int16(marketIndexN))
+		if err != nil {
+			logger.Errorf("[LighterSubscriber] 解析MarketIndex失败, marketIndex: %s, %v", marketIndex, err)
+			continue
+		}
+
+		for _, ord := range marketOrders {
+			userOrders.Orders = append(userOrders.Orders, &exchange.Order{
+				Symbol:            symbol,
+				OrderID:           strconv.FormatInt(ord.OrderIndex, 10),
+				ClientOrderID:     strconv.FormatInt(ord.ClientOrderIndex, 10),
+				Side:              lo.If(ord.IsAsk, order.SideSell).Else(order.SideBuy),
+				Price:             ord.Price,
+				BaseAmount:        ord.InitialBaseAmount,
+				FilledBaseAmount:  ord.FilledBaseAmount,
+				FilledQuoteAmount: ord.FilledQuoteAmount,
+				Timestamp:         ord.Timestamp * 1000, // 转化为毫秒数
+				Status:            ConvertOrderStatus(ord.Status),
+			})
+		}
+	}
+
+	logger.Tracef("[LighterSubscriber] 分发 UserOders 数据, account: %d, isSnapshot: %v", accountIndex, userOrders.IsSnapshot)
+	return &userOrders
+}
+
+// replayParser Lighter录制帧解析器
+type replayParser struct {
+	marketResolver MarketResolver
+	parser         *messageParser
+}
+
+// NewReplayParser 创建Lighter录制帧解析器
+// 每个连接建立事件都会重置账户快照状态，与实盘重连后的行为一致
+func NewReplayParser(marketResolver MarketResolver) exchange.FrameParser {
+	return &replayParser{
+		marketResolver: marketResolver,
+		parser:         newMessageParser(context.Background(), marketResolver),
+	}
+}
+
+// Parse 解析单个录制帧
+func (p *replayParser) Parse(frame exchange.Frame) []exchange.SubMessage {
+	if frame.Event == exchange.FrameEventConnected {
+		p.parser = newMessageParser(context.Background(), p.marketResolver)
+		return nil
+	}
+
+	var message WebSocketMessage
+	if err := json.Unmarshal([]byte(frame.Data), &message); err != nil {
+		logger.Errorf("[LighterSubscriber] 解析消息失败, %v", err)
+		return nil
+	}
+	return p.parser.parse(message)
+}
@@ -141,6 +141,80 @@ func (c *Signer) GetAccountInactiveOrders(ctx context.Context, cursor string, li
 	return result, nil
 }
 
+// GetAccountTrades 获取账户成交记录
+// 按时间倒序返回 from 之后的成交记录，from 为毫秒时间戳
+func (c *Signer) GetAccountTrades(ctx context.Context, from int64, cursor string, limit int) (Trades, error) {
+	token, err := c.GetAuthToken(time.Now().Add(time.Second * 30))
+	if err != nil {
+		return Trades{}, err
+	}
+
+	params := map[string]any{
+		"auth":          token,
+		"account_index": c.accountIndex,
+		"sort_by":       "timestamp",
+		"sort_dir":      "desc",
+		"from":          from,
+		"limit":         limit,
+	}
+	if cursor != "" {
+		params["cursor"] = cursor
+	}
+
+	var result Trades
+	err = getAndParseL2HTTPResponse(
+		ctx,
+		c.client.httpClient,
+		c.client.endpoint,
+		"api/v1/trades",
+		params,
+		&result,
+	)
+	if err != nil {
+		return Trades{}, err
+	}
+	if result.Code != 200 {
+		return Trades{}, fmt.Errorf("code: %d, message: %s", result.Code, result.Message)
+	}
+	return result, nil
+}
+
+// GetPositionFundings 获取账户在指定市场的持仓资金费用记录，按时间倒序返回
+func (c *Signer) GetPositionFundings(ctx context.Context, marketId int16, cursor string, limit int) (PositionFundings, error) {
+	token, err := c.GetAuthToken(time.Now().Add(time.Second * 30))
+	if err != nil {
+		return PositionFundings{}, err
+	}
+
+	params := map[string]any{
+		"auth":          token,
+		"account_index": c.accountIndex,
+		"market_id":     marketId,
+		"side":          "all",
+		"limit":         limit,
+	}
+	if cursor != "" {
+		params["cursor"] = cursor
+	}
+
+	var result PositionFundings
+	err = getAndParseL2HTTPResponse(
+		ctx,
+		c.client.httpClient,
+		c.client.endpoint,
+		"api/v1/positionFunding",
+		params,
+		&result,
+	)
+	if err != nil {
+		return PositionFundings{}, err
+	}
+	if result.Code != 200 {
+		return PositionFundings{}, fmt.Errorf("code: %d, message: %s", result.Code, result.Message)
+	}
+	return result, nil
+}
+
 // SignCreateOrder 签名创建订单请求
 func (c *Signer) SignCreateOrder(ctx context.Context, req *CreateOrderTxReq, nonce int64) (string, error) {
 	tx := &types.CreateOrderTxReq{
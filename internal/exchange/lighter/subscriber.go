@@ -7,16 +7,13 @@ import (
 	"fmt"
 	"maps"
 	"net"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/fachebot/omni-grid-bot/internal/config"
-	"github.com/fachebot/omni-grid-bot/internal/ent/order"
 	"github.com/fachebot/omni-grid-bot/internal/exchange"
 	"github.com/fachebot/omni-grid-bot/internal/logger"
-	"github.com/samber/lo"
 
 	"github.com/gorilla/websocket"
 	"golang.org/x/net/proxy"
@@ -52,6 +49,7 @@ type LighterSubscriber struct {
 	marketResolver MarketResolver    // 市场解析器
 
 	subMsgChan chan exchange.SubMessage // 订阅消息通道
+	recorder   *exchange.Recorder       // 原始消息录制器
 }
 
 // NewLighterSubscriber 创建Lighter WebSocket订阅器实例
@@ -107,6 +105,11 @@ func (subscriber *LighterSubscriber) Start() {
 	}
 }
 
+// SetRecorder 设置原始消息录制器，需要在 Start 之前调用
+func (subscriber *LighterSubscriber) SetRecorder(recorder *exchange.Recorder) {
+	subscriber.recorder = recorder
+}
+
 // WaitUntilConnected 等待连接建立
 func (subscriber *LighterSubscriber) WaitUntilConnected() {
 	for subscriber.conn == nil {
@@ -261,7 +264,9 @@ func (subscriber *LighterSubscriber) connect() {
 func (subscriber *LighterSubscriber) readMessages() {
 	defer subscriber.conn.Close()
 
-	processedAccounts := make(map[int64]struct{})
+	subscriber.recorder.Connected(recordSource, "")
+
+	parser := newMessageParser(subscriber.ctx, subscriber.marketResolver)
 	for {
 		_, data, err := subscriber.conn.ReadMessage()
 		if err != nil {
@@ -274,6 +279,7 @@ func (subscriber *LighterSubscriber) readMessages() {
 		}
 
 		logger.Tracef("[LighterSubscriber] 收到新消息, %s", data)
+		subscriber.recorder.Message(recordSource, "", data)
 
 		var message WebSocketMessage
 		if err = json.Unmarshal(data, &message); err != nil {
@@ -296,23 +302,12 @@ func (subscriber *LighterSubscriber) readMessages() {
 			for _, signer := range accounts {
 				subscriber.SubscribeAccountOrders(signer)
 			}
-		case "unsubscribed":
-			const prefix = "account_all_orders:"
-			if !strings.HasPrefix(message.Channel, prefix) {
-				continue
-			}
-
-			accountIndex, err := strconv.ParseInt(message.Channel[len(prefix):], 10, 64)
-			if err != nil {
-				logger.Errorf("[LighterSubscriber] 解析channel失败, channel: %s, %v", message.Channel, err)
-				continue
+		default:
+			for _, msg := range parser.parse(message) {
+				if subscriber.subMsgChan != nil {
+					subscriber.subMsgChan <- msg
+				}
 			}
-
-			delete(processedAccounts, accountIndex)
-		case "update/market_stats":
-			subscriber.handleMarketStatsMessage(message)
-		case "subscribed/account_all_orders", "update/account_all_orders":
-			subscriber.handleOrdersMessage(processedAccounts, message)
 		}
 	}
 }
@@ -326,94 +321,3 @@ func (subscriber *LighterSubscriber) scheduleReconnect() {
 		}
 	}
 }
-
-// handleMarketStatsMessage 处理市场统计数据消息
-func (subscriber *LighterSubscriber) handleMarketStatsMessage(message WebSocketMessage) {
-	const prefix = "market_stats:"
-	if !strings.HasPrefix(message.Channel, prefix) {
-		logger.Errorf("[LighterSubscriber] 解析channel失败, channel: %s", message.Channel)
-		return
-	}
-
-	marketIndex, err := strconv.ParseInt(message.Channel[len(prefix):], 10, 64)
-	if err != nil {
-		logger.Errorf("[LighterSubscriber] 解析channel失败, channel: %s, %v", message.Channel, err)
-		return
-	}
-
-	if subscriber.subMsgChan != nil && message.MarketStats != nil {
-		symbol, err := subscriber.marketResolver.GetSymbolByMarketId(subscriber.ctx, int16(marketIndex))
-		if err != nil {
-			logger.Errorf("[LighterSubscriber] 解析MarketIndex失败, marketIndex: %d, %v", marketIndex, err)
-			return
-		}
-
-		marketStats := exchange.MarketStats{
-			Symbol:    symbol,
-			Price:     message.MarketStats.LastTradePrice,
-			MarkPrice: message.MarketStats.MarkPrice,
-		}
-
-		logger.Tracef("[LighterSubscriber] 分发 MarketStats 数据, %+v", marketStats)
-		subscriber.subMsgChan <- exchange.SubMessage{Exchange: exchange.Lighter, MarketStats: &marketStats}
-	}
-}
-
-// handleOrdersMessage 处理订单消息
-func (subscriber *LighterSubscriber) handleOrdersMessage(processedAccounts map[int64]struct{}, message WebSocketMessage) {
-	const prefix = "account_all_orders:"
-	if !strings.HasPrefix(message.Channel, prefix) {
-		logger.Errorf("[LighterSubscriber] 解析channel失败, channel: %s", message.Channel)
-		return
-	}
-
-	accountIndex, err := strconv.ParseInt(message.Channel[len(prefix):], 10, 64)
-	if err != nil {
-		logger.Errorf("[LighterSubscriber] 解析channel失败, channel: %s, %v", message.Channel, err)
-		return
-	}
-
-	if subscriber.subMsgChan != nil && message.Orders != nil {
-		_, ok := processedAccounts[accountIndex]
-		userOrders := exchange.UserOrders{
-			Exchange:   exchange.Lighter,
-			Account:    strconv.FormatInt(accountIndex, 10),
-			Orders:     make([]*exchange.Order, 0),
-			IsSnapshot: !ok,
-		}
-
-		for marketIndex, marketOrders := range message.Orders {
-			marketIndexN, err := strconv.Atoi(marketIndex)
-			if err != nil {
-				logger.Errorf("[LighterSubscriber] 解析MarketIndex失败, marketIndex: %s, %v", marketIndex, err)
-				continue
-			}
-
-			symbol, err := subscriber.marketResolver.GetSymbolByMarketId(subscriber.ctx, int16(marketIndexN))
-			if err != nil {
-				logger.Errorf("[LighterSubscriber] 解析MarketIndex失败, marketIndex: %s, %v", marketIndex, err)
-				continue
-			}
-
-			for _, ord := range marketOrders {
-				userOrders.Orders = append(userOrders.Orders, &exchange.Order{
-					Symbol:            symbol,
-					OrderID:           strconv.FormatInt(ord.OrderIndex, 10),
-					ClientOrderID:     strconv.FormatInt(ord.ClientOrderIndex, 10),
-					Side:              lo.If(ord.IsAsk, order.SideSell).Else(order.SideBuy),
-					Price:             ord.Price,
-					BaseAmount:        ord.InitialBaseAmount,
-					FilledBaseAmount:  ord.FilledBaseAmount,
-					FilledQuoteAmount: ord.FilledQuoteAmount,
-					Timestamp:         ord.Timestamp * 1000, // 转化为毫秒数
-					Status:            ConvertOrderStatus(ord.Status),
-				})
-			}
-		}
-
-		logger.Tracef("[LighterSubscriber] 分发 UserOders 数据, account: %d, isSnapshot: %v", accountIndex, userOrders.IsSnapshot)
-		subscriber.subMsgChan <- exchange.SubMessage{Exchange: exchange.Lighter, UserOrders: &userOrders}
-	}
-
-	processedAccounts[accountIndex] = struct{}{}
-}
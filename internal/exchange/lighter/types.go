@@ -265,6 +265,56 @@ type Orders struct {
 	Orders     []*Order `json:"orders"`
 }
 
+// FeeRateDenominator 成交记录中手续费率的分母，费率为整数形式，1000000 表示 100%
+const FeeRateDenominator = 1000000
+
+// Trade 成交记录
+type Trade struct {
+	TradeID      int64           `json:"trade_id"`       // 成交ID
+	TxHash       string          `json:"tx_hash"`        // 交易哈希
+	Type         string          `json:"type"`           // 成交类型
+	MarketID     int16           `json:"market_id"`      // 市场索引
+	Size         decimal.Decimal `json:"size"`           // 成交数量
+	Price        decimal.Decimal `json:"price"`          // 成交价格
+	UsdAmount    decimal.Decimal `json:"usd_amount"`     // 成交金额
+	AskID        int64           `json:"ask_id"`         // 卖单订单索引
+	BidID        int64           `json:"bid_id"`         // 买单订单索引
+	AskClientID  int64           `json:"ask_client_id"`  // 卖单客户端订单索引
+	BidClientID  int64           `json:"bid_client_id"`  // 买单客户端订单索引
+	AskAccountID int64           `json:"ask_account_id"` // 卖方账户索引
+	BidAccountID int64           `json:"bid_account_id"` // 买方账户索引
+	IsMakerAsk   bool            `json:"is_maker_ask"`   // 卖方是否为挂单方
+	BlockHeight  int64           `json:"block_height"`   // 区块高度
+	Timestamp    int64           `json:"timestamp"`      // 成交时间戳(毫秒)
+	TakerFee     int64           `json:"taker_fee"`      // 吃单手续费率
+	MakerFee     int64           `json:"maker_fee"`      // 挂单手续费率
+}
+
+// Trades 成交记录列表
+type Trades struct {
+	lighterhttp.ResultCode
+	NextCursor string   `json:"next_cursor,omitempty"`
+	Trades     []*Trade `json:"trades"`
+}
+
+// PositionFunding 持仓资金费用记录
+type PositionFunding struct {
+	Timestamp    int64           `json:"timestamp"`     // 结算时间戳(秒)
+	MarketID     int16           `json:"market_id"`     // 市场索引
+	FundingID    int64           `json:"funding_id"`    // 资金费用记录ID
+	Change       decimal.Decimal `json:"change"`        // 资金费用(收取为正，支付为负)
+	Rate         decimal.Decimal `json:"rate"`          // 资金费率
+	PositionSize decimal.Decimal `json:"position_size"` // 结算时的持仓数量
+	PositionSide string          `json:"position_side"` // 持仓方向(long/short)
+}
+
+// PositionFundings 持仓资金费用记录列表
+type PositionFundings struct {
+	lighterhttp.ResultCode
+	NextCursor       string             `json:"next_cursor,omitempty"`
+	PositionFundings []*PositionFunding `json:"position_fundings"`
+}
+
 // ORDER_TYPE 定义订单类型
 type ORDER_TYPE uint8
 
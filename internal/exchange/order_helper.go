@@ -0,0 +1,96 @@
+package exchange
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// OrderHelper 交易所订单操作统一接口
+// 所有交易所的订单操作都需要实现此接口
+type OrderHelper interface {
+	// UpdateLeverage 更新指定交易对的杠杆倍数和保证金模式
+	UpdateLeverage(ctx context.Context, symbol string, leverage uint, marginMode MarginMode) error
+
+	// CancalAllOrders 取消指定交易对的所有活跃订单
+	CancalAllOrders(ctx context.Context, symbol string) error
+
+	// CancelOrders 取消指定交易对的部分订单
+	// orderIds 交易所订单ID列表，已经成交或取消的订单直接忽略
+	CancelOrders(ctx context.Context, symbol string, orderIds []string) error
+
+	// CreateOrderBatch 批量创建订单
+	// limitOrders 限价单列表，marketOrders 市价单列表
+	// 返回值: 限价单客户端订单ID列表，市价单客户端订单ID列表，错误信息
+	CreateOrderBatch(ctx context.Context, limitOrders []CreateLimitOrderParams, marketOrders []CreateMarketOrderParams) ([]string, []string, error)
+
+	// CreateLimitOrder 创建单个限价单
+	// symbol 交易对名称，isAsk 是否卖单，reduceOnly 是否只减仓
+	// price 订单价格，size 订单数量
+	// 返回值: 客户端订单ID，错误信息
+	CreateLimitOrder(ctx context.Context, symbol string, isAsk, reduceOnly bool, price, size decimal.Decimal) (string, error)
+
+	// SyncUserOrders 同步用户的订单数据到本地数据库
+	SyncUserOrders(ctx context.Context) error
+
+	// ClosePosition 平仓
+	// symbol 交易对名称，side 持仓方向，slippageBps 滑点容忍度(基点)
+	ClosePosition(ctx context.Context, symbol string, side PositionSide, slippageBps int) error
+}
+
+// OpenOrdersProvider 活跃订单查询
+// 订单操作客户端可选实现，用于策略引擎定期核对本地网格和交易所的挂单
+type OpenOrdersProvider interface {
+	// GetOpenOrders 获取指定交易对的全部活跃订单
+	GetOpenOrders(ctx context.Context, symbol string) ([]*Order, error)
+}
+
+// FillsProvider 成交记录查询
+// 订单操作客户端可选实现，用于策略引擎定期拉取成交明细并结算匹配交易的手续费
+type FillsProvider interface {
+	// GetFills 获取账户在 since 之后的全部成交记录，按时间升序返回
+	GetFills(ctx context.Context, since time.Time) ([]*Fill, error)
+}
+
+// FundingProvider 资金费用查询
+// 订单操作客户端可选实现，用于策略引擎定期拉取资金费用并计入策略收益
+type FundingProvider interface {
+	// GetFundingPayments 获取账户在指定交易对 since 之后的全部资金费用记录，按时间升序返回
+	GetFundingPayments(ctx context.Context, symbol string, since time.Time) ([]*FundingPayment, error)
+}
+
+// CancelOrderParams 取消订单参数
+type CancelOrderParams struct {
+	Symbol  string // 交易对名称
+	OrderID int64  // 订单ID
+}
+
+// CreateLimitOrderParams 创建限价单参数
+type CreateLimitOrderParams struct {
+	Symbol     string          // 交易对名称
+	IsAsk      bool            // 是否卖单 (true=卖, false=买)
+	ReduceOnly bool            // 是否只减仓
+	Price      decimal.Decimal // 订单价格
+	Size       decimal.Decimal // 订单数量
+}
+
+// CreateMarketOrderParams 创建市价单参数
+type CreateMarketOrderParams struct {
+	Symbol                   string          // 交易对名称
+	IsAsk                    bool            // 是否卖单 (true=卖, false=买)
+	ReduceOnly               bool            // 是否只减仓
+	SlippageBps              int             // 滑点容忍度(基点)，例如 50 表示 0.5%
+	AcceptableExecutionPrice decimal.Decimal // 可接受的最大成交价格(市价单用)
+	Size                     decimal.Decimal // 订单数量
+}
+
+// MarketMetadata 市场元数据
+// 包含交易所支持的最小区块数量、价格精度等信息
+type MarketMetadata struct {
+	MinBaseAmount          decimal.Decimal // 最小基础货币数量
+	MinQuoteAmount         decimal.Decimal // 最小计价货币数量
+	SupportedSizeDecimals  uint8           // 支持的数量小数位数
+	SupportedPriceDecimals uint8           // 支持的价格小数位数
+	SupportedQuoteDecimals uint8           // 支持的计价小数位数
+}
@@ -0,0 +1,274 @@
+package paper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/shopspring/decimal"
+)
+
+var testFeeModel = FeeModel{
+	MakerFeeRate: decimal.RequireFromString("0.0002"),
+	TakerFeeRate: decimal.RequireFromString("0.0005"),
+	SlippageBps:  10,
+}
+
+func d(s string) decimal.Decimal {
+	return decimal.RequireFromString(s)
+}
+
+func TestSimulatorLimitOrder(t *testing.T) {
+	sim := NewSimulator(testFeeModel, d("10000"))
+
+	updates := make([]*exchange.Order, 0)
+	sim.AddOrderHandler(func(account string, orders []*exchange.Order) {
+		updates = append(updates, orders...)
+	})
+
+	sim.OnPrice("BTC", d("100"))
+
+	// 低于最新价的买单挂单等待成交
+	ord, err := sim.PlaceLimitOrder("alice", "BTC", "", false, false, d("99"), d("2"))
+	if err != nil {
+		t.Fatalf("PlaceLimitOrder() error = %v", err)
+	}
+	if ord.Status != order.StatusOpen || ord.ClientOrderID != ord.OrderID {
+		t.Fatalf("PlaceLimitOrder() = %+v", ord)
+	}
+
+	sim.OnPrice("BTC", d("99.5"))
+	if len(updates) != 1 {
+		t.Fatalf("价格未穿越挂单不应该成交, updates = %d", len(updates))
+	}
+
+	// 价格穿越挂单后按挂单价格成交
+	sim.OnPrice("BTC", d("98"))
+	if len(updates) != 2 {
+		t.Fatalf("价格穿越挂单应该成交, updates = %d", len(updates))
+	}
+	filled := updates[1]
+	if filled.Status != order.StatusFilled || !filled.FilledBaseAmount.Equal(d("2")) || !filled.FilledQuoteAmount.Equal(d("198")) {
+		t.Errorf("OnPrice() filled = %+v", filled)
+	}
+
+	// 高于最新价的买单按吃单立即成交，成交价格不超过限价
+	ord, err = sim.PlaceLimitOrder("alice", "BTC", "", false, false, d("98.05"), d("1"))
+	if err != nil {
+		t.Fatalf("PlaceLimitOrder() error = %v", err)
+	}
+	if ord.Status != order.StatusFilled || !ord.FilledQuoteAmount.Equal(d("98.05")) {
+		t.Errorf("PlaceLimitOrder() marketable = %+v", ord)
+	}
+
+	if size := sim.Position("alice", "BTC"); !size.Equal(d("3")) {
+		t.Errorf("Position() = %s, expected 3", size)
+	}
+	if orders := sim.Orders("alice"); len(orders) != 2 {
+		t.Errorf("Orders() = %d, expected 2", len(orders))
+	}
+}
+
+func TestSimulatorPartialFill(t *testing.T) {
+	sim := NewSimulator(testFeeModel, d("10000"))
+	sim.OnPrice("BTC", d("100"))
+
+	ord, err := sim.PlaceLimitOrder("alice", "BTC", "", false, false, d("99"), d("2"))
+	if err != nil {
+		t.Fatalf("PlaceLimitOrder() error = %v", err)
+	}
+
+	// 部分成交后订单保持挂单
+	partial, err := sim.PartialFill("alice", ord.OrderID, d("0.5"))
+	if err != nil {
+		t.Fatalf("PartialFill() error = %v", err)
+	}
+	if partial.Status != order.StatusOpen || !partial.FilledBaseAmount.Equal(d("0.5")) || !partial.FilledQuoteAmount.Equal(d("49.5")) {
+		t.Errorf("PartialFill() partial = %+v", partial)
+	}
+	if size := sim.Position("alice", "BTC"); !size.Equal(d("0.5")) {
+		t.Errorf("Position() = %s, expected 0.5", size)
+	}
+
+	// 价格穿越挂单后成交剩余数量
+	sim.OnPrice("BTC", d("98"))
+	orders := sim.Orders("alice")
+	if len(orders) != 1 || orders[0].Status != order.StatusFilled || !orders[0].FilledBaseAmount.Equal(d("2")) || !orders[0].FilledQuoteAmount.Equal(d("198")) {
+		t.Errorf("OnPrice() filled = %+v", orders)
+	}
+	if size := sim.Position("alice", "BTC"); !size.Equal(d("2")) {
+		t.Errorf("Position() = %s, expected 2", size)
+	}
+
+	// 每次成交生成一条按挂单费率计费的成交记录
+	fills := sim.Fills("alice", time.Time{})
+	if len(fills) != 2 {
+		t.Fatalf("Fills() count = %d, expected 2", len(fills))
+	}
+	for idx, expected := range []struct{ size, fee string }{{"0.5", "0.0099"}, {"1.5", "0.0297"}} {
+		item := fills[idx]
+		if item.OrderID != ord.OrderID || !item.IsMaker || !item.BaseAmount.Equal(d(expected.size)) || !item.Fee.Equal(d(expected.fee)) {
+			t.Errorf("Fills()[%d] = %+v", idx, item)
+		}
+	}
+
+	if _, err = sim.PartialFill("alice", ord.OrderID, d("1")); err != ErrOrderNotFound {
+		t.Errorf("PartialFill() error = %v, expected ErrOrderNotFound", err)
+	}
+}
+
+func TestSimulatorAccounting(t *testing.T) {
+	testCases := []struct {
+		name          string
+		fills         []bool // true 表示卖出
+		prices        []string
+		sizes         []string
+		expectedSize  string
+		expectedEntry string
+		expectedPnl   string
+	}{
+		{
+			name:          "加仓后部分平仓",
+			fills:         []bool{false, false, true},
+			prices:        []string{"100", "110", "120"},
+			sizes:         []string{"1", "1", "1"},
+			expectedSize:  "1",
+			expectedEntry: "105",
+			expectedPnl:   "15",
+		},
+		{
+			name:          "空头反向开多",
+			fills:         []bool{true, false},
+			prices:        []string{"100", "90"},
+			sizes:         []string{"1", "3"},
+			expectedSize:  "2",
+			expectedEntry: "90",
+			expectedPnl:   "10",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sim := NewSimulator(FeeModel{}, d("1000"))
+			for idx := range tc.fills {
+				sim.OnPrice("ETH", d(tc.prices[idx]))
+				_, err := sim.PlaceMarketOrder("bob", "ETH", "", tc.fills[idx], false, decimal.Zero, d(tc.sizes[idx]))
+				if err != nil {
+					t.Fatalf("PlaceMarketOrder() error = %v", err)
+				}
+			}
+
+			account := sim.Account("bob")
+			if len(account.Positions) != 1 {
+				t.Fatalf("Account() positions = %d", len(account.Positions))
+			}
+			pos := account.Positions[0]
+			if !pos.Position.Equal(d(tc.expectedSize)) || !pos.AvgEntryPrice.Equal(d(tc.expectedEntry)) || !pos.RealizedPnl.Equal(d(tc.expectedPnl)) {
+				t.Errorf("Account() position = %+v", pos)
+			}
+		})
+	}
+}
+
+func TestSimulatorMarketOrder(t *testing.T) {
+	sim := NewSimulator(testFeeModel, d("10000"))
+
+	if _, err := sim.PlaceMarketOrder("carol", "SOL", "", false, false, decimal.Zero, d("1")); err != ErrNoMarketPrice {
+		t.Fatalf("PlaceMarketOrder() error = %v, expected ErrNoMarketPrice", err)
+	}
+
+	sim.OnPrice("SOL", d("100"))
+	sim.SetLeverage("carol", "SOL", 5)
+
+	// 成交价格 100.1 劣于可接受价格时取消
+	ord, _ := sim.PlaceMarketOrder("carol", "SOL", "", false, false, d("100.05"), d("1"))
+	if ord.Status != order.StatusCanceled {
+		t.Errorf("PlaceMarketOrder() = %+v, expected canceled", ord)
+	}
+
+	ord, _ = sim.PlaceMarketOrder("carol", "SOL", "", false, false, d("101"), d("10"))
+	if ord.Status != order.StatusFilled || !ord.Price.Equal(d("100.1")) {
+		t.Errorf("PlaceMarketOrder() = %+v", ord)
+	}
+
+	// 只减仓订单数量不超过持仓
+	ord, _ = sim.PlaceMarketOrder("carol", "SOL", "", true, true, decimal.Zero, d("20"))
+	if ord.Status != order.StatusFilled || !ord.FilledBaseAmount.Equal(d("10")) {
+		t.Errorf("PlaceMarketOrder() reduceOnly = %+v", ord)
+	}
+	ord, _ = sim.PlaceMarketOrder("carol", "SOL", "", true, true, decimal.Zero, d("1"))
+	if ord.Status != order.StatusCanceled {
+		t.Errorf("PlaceMarketOrder() reduceOnly without position = %+v", ord)
+	}
+
+	// 开仓 1001 + 平仓 999 的吃单手续费，平仓亏损 (99.9 - 100.1) * 10
+	account := sim.Account("carol")
+	expected := d("10000").Sub(d("2000").Mul(d("0.0005"))).Sub(d("2"))
+	if !account.TotalAssetValue.Equal(expected) {
+		t.Errorf("Account() total = %s, expected %s", account.TotalAssetValue, expected)
+	}
+}
+
+func TestSimulatorRestore(t *testing.T) {
+	sim := NewSimulator(FeeModel{}, d("1000"))
+	sim.Restore("dave", []*exchange.Order{
+		{Symbol: "BTC", OrderID: "2", Side: order.SideSell, Price: d("110"), BaseAmount: d("1"), Status: order.StatusOpen, Timestamp: 2},
+		{Symbol: "BTC", OrderID: "1", Side: order.SideBuy, Price: d("100"), BaseAmount: d("1"), FilledBaseAmount: d("1"), Status: order.StatusFilled, Timestamp: 1},
+	})
+
+	if size := sim.Position("dave", "BTC"); !size.Equal(d("1")) {
+		t.Fatalf("Position() = %s, expected 1", size)
+	}
+
+	sim.OnPrice("BTC", d("111"))
+	if size := sim.Position("dave", "BTC"); !size.IsZero() {
+		t.Errorf("恢复的挂单应该参与撮合, position = %s", size)
+	}
+	if account := sim.Account("dave"); !account.TotalAssetValue.Equal(d("1010")) {
+		t.Errorf("Account() total = %s, expected 1010", account.TotalAssetValue)
+	}
+}
+
+func TestSubscriber(t *testing.T) {
+	sim := NewSimulator(FeeModel{}, d("1000"))
+	priceFunc := func(ctx context.Context, symbol string) (decimal.Decimal, error) {
+		return d("95"), nil
+	}
+
+	subscriber := NewPaperSubscriber(sim, priceFunc, 50*time.Millisecond)
+	ch := subscriber.SubscriptionChan()
+	subscriber.Start()
+	defer subscriber.Stop()
+
+	subscriber.SubscribeMarketStats("BTC")
+	subscriber.SubscribeAccountOrders("erin")
+
+	sim.OnPrice("BTC", d("100"))
+	if _, err := sim.PlaceLimitOrder("erin", "BTC", "", false, false, d("96"), d("1")); err != nil {
+		t.Fatalf("PlaceLimitOrder() error = %v", err)
+	}
+
+	var snapshot, filled, marketStats bool
+	timeout := time.After(3 * time.Second)
+	for !snapshot || !filled || !marketStats {
+		select {
+		case msg := <-ch:
+			if msg.Exchange != exchange.Paper {
+				t.Fatalf("SubMessage.Exchange = %s", msg.Exchange)
+			}
+			if msg.MarketStats != nil && msg.MarketStats.Symbol == "BTC" && msg.MarketStats.Price.Equal(d("95")) {
+				marketStats = true
+			}
+			if msg.UserOrders != nil && msg.UserOrders.IsSnapshot {
+				snapshot = true
+			}
+			if msg.UserOrders != nil && len(msg.UserOrders.Orders) > 0 && msg.UserOrders.Orders[0].Status == order.StatusFilled {
+				filled = true
+			}
+		case <-timeout:
+			t.Fatalf("等待订阅消息超时, snapshot: %v, filled: %v, marketStats: %v", snapshot, filled, marketStats)
+		}
+	}
+}
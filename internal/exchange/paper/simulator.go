@@ -0,0 +1,682 @@
+// Package paper 提供模拟交易所实现
+// 限价单保存在内存中，使用真实交易所的行情价格进行本地撮合，用于在不动用真实资金的情况下试运行网格参数
+package paper
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/shopspring/decimal"
+)
+
+// Simulator 本地撮合模拟器
+// 维护模拟账户的余额、持仓和挂单，行情价格穿越挂单价格时按挂单价格成交
+type Simulator struct {
+	fee            FeeModel
+	initialBalance decimal.Decimal
+	clock          func() time.Time
+
+	mutex       sync.Mutex
+	nextOrderId int64
+	prices      map[string]decimal.Decimal
+	accounts    map[string]*account
+	handlers    []OrderHandler
+}
+
+// NewSimulator 创建撮合模拟器
+// fee 手续费和滑点模型，initialBalance 模拟账户初始余额
+func NewSimulator(fee FeeModel, initialBalance decimal.Decimal) *Simulator {
+	return &Simulator{
+		fee:            fee,
+		initialBalance: initialBalance,
+		clock:          time.Now,
+		nextOrderId:    time.Now().UnixMicro(),
+		prices:         make(map[string]decimal.Decimal),
+		accounts:       make(map[string]*account),
+	}
+}
+
+// SetClock 设置模拟器时钟
+// 订单时间戳默认使用系统时间，回测时使用历史行情时间
+func (s *Simulator) SetClock(clock func() time.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.clock = clock
+}
+
+// AddOrderHandler 添加订单更新回调
+// 订单创建、成交或取消时按添加顺序调用，需要在模拟器开始使用前添加
+func (s *Simulator) AddOrderHandler(handler OrderHandler) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.handlers = append(s.handlers, handler)
+}
+
+// HasAccount 账户是否已经加载
+func (s *Simulator) HasAccount(name string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	_, ok := s.accounts[name]
+	return ok
+}
+
+// Restore 使用历史订单恢复账户状态
+// 已成交和部分成交的订单按时间顺序重放到持仓，历史订单无法区分挂单和吃单，统一按挂单费率计算手续费；
+// 未完成订单恢复为挂单。账户已经加载时忽略
+func (s *Simulator) Restore(name string, orders []*exchange.Order) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.accounts[name]; ok {
+		return
+	}
+
+	acct := s.ensureAccount(name)
+	sorted := slices.Clone(orders)
+	slices.SortStableFunc(sorted, func(a, b *exchange.Order) int {
+		return cmp.Compare(a.Timestamp, b.Timestamp)
+	})
+
+	for _, item := range sorted {
+		ord := cloneOrder(item)
+		isAsk := ord.Side == order.SideSell
+		if ord.FilledBaseAmount.IsPositive() {
+			s.applyFill(acct, ord.Symbol, isAsk, ord.Price, ord.FilledBaseAmount, s.fee.MakerFeeRate)
+		}
+
+		switch ord.Status {
+		case order.StatusFilled, order.StatusCanceled:
+			acct.appendHistory(ord)
+		default:
+			ord.Status = order.StatusOpen
+			acct.orders[ord.OrderID] = &restingOrder{order: ord, isAsk: isAsk}
+		}
+	}
+}
+
+// LastPrice 获取交易对的最新价格
+func (s *Simulator) LastPrice(symbol string) (decimal.Decimal, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	price, ok := s.prices[symbol]
+	return price, ok
+}
+
+// SetLeverage 设置交易对杠杆倍数
+// 杠杆仅用于计算可用余额
+func (s *Simulator) SetLeverage(name, symbol string, leverage uint) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.ensureAccount(name).leverage[symbol] = max(leverage, 1)
+}
+
+// PlaceLimitOrder 创建限价单
+// 买单价格不低于最新价或卖单价格不高于最新价时立即按吃单成交，否则挂单等待行情穿越
+func (s *Simulator) PlaceLimitOrder(name, symbol, clientOrderId string, isAsk, reduceOnly bool, price, size decimal.Decimal) (*exchange.Order, error) {
+	if !size.IsPositive() {
+		return nil, ErrInvalidOrderSize
+	}
+	if !price.IsPositive() {
+		return nil, ErrInvalidOrderPrice
+	}
+
+	s.mutex.Lock()
+	acct := s.ensureAccount(name)
+	ord := s.newOrder(symbol, clientOrderId, isAsk, price, size)
+
+	lastPrice, ok := s.prices[symbol]
+	marketable := ok && ((!isAsk && price.GreaterThanOrEqual(lastPrice)) || (isAsk && price.LessThanOrEqual(lastPrice)))
+	if marketable {
+		execPrice := s.takerPrice(lastPrice, isAsk)
+		if (!isAsk && execPrice.GreaterThan(price)) || (isAsk && execPrice.LessThan(price)) {
+			execPrice = price
+		}
+		s.fill(acct, ord, isAsk, reduceOnly, execPrice, false)
+		acct.appendHistory(ord)
+	} else {
+		acct.orders[ord.OrderID] = &restingOrder{order: ord, isAsk: isAsk, reduceOnly: reduceOnly}
+	}
+	result := cloneOrder(ord)
+	s.mutex.Unlock()
+
+	s.notify(name, []*exchange.Order{result})
+	return cloneOrder(result), nil
+}
+
+// PlaceMarketOrder 创建市价单
+// 按最新价加滑点吃单成交，成交价格劣于可接受价格时订单取消
+func (s *Simulator) PlaceMarketOrder(name, symbol, clientOrderId string, isAsk, reduceOnly bool, acceptablePrice, size decimal.Decimal) (*exchange.Order, error) {
+	if !size.IsPositive() {
+		return nil, ErrInvalidOrderSize
+	}
+
+	s.mutex.Lock()
+	lastPrice, ok := s.prices[symbol]
+	if !ok {
+		s.mutex.Unlock()
+		return nil, ErrNoMarketPrice
+	}
+
+	acct := s.ensureAccount(name)
+	execPrice := s.takerPrice(lastPrice, isAsk)
+	ord := s.newOrder(symbol, clientOrderId, isAsk, execPrice, size)
+
+	if acceptablePrice.IsPositive() &&
+		((!isAsk && execPrice.GreaterThan(acceptablePrice)) || (isAsk && execPrice.LessThan(acceptablePrice))) {
+		ord.Status = order.StatusCanceled
+	} else {
+		s.fill(acct, ord, isAsk, reduceOnly, execPrice, false)
+	}
+	acct.appendHistory(ord)
+	result := cloneOrder(ord)
+	s.mutex.Unlock()
+
+	s.notify(name, []*exchange.Order{result})
+	return cloneOrder(result), nil
+}
+
+// PartialFill 按挂单价格成交挂单的部分数量，用于模拟流动性不足时的部分成交
+// 成交数量不小于剩余数量时订单完全成交，订单不存在时返回 ErrOrderNotFound
+func (s *Simulator) PartialFill(name, orderId string, size decimal.Decimal) (*exchange.Order, error) {
+	if !size.IsPositive() {
+		return nil, ErrInvalidOrderSize
+	}
+
+	s.mutex.Lock()
+	var item *restingOrder
+	acct, ok := s.accounts[name]
+	if ok {
+		item, ok = acct.orders[orderId]
+	}
+	if !ok {
+		s.mutex.Unlock()
+		return nil, ErrOrderNotFound
+	}
+
+	remaining := item.order.BaseAmount.Sub(item.order.FilledBaseAmount)
+	if size.LessThan(remaining) {
+		fee := s.applyFill(acct, item.order.Symbol, item.isAsk, item.order.Price, size, s.fee.MakerFeeRate)
+		s.recordFill(acct, item.order, item.order.Price, size, fee, true)
+		item.order.FilledBaseAmount = item.order.FilledBaseAmount.Add(size)
+		item.order.FilledQuoteAmount = item.order.FilledQuoteAmount.Add(size.Mul(item.order.Price))
+		item.order.Timestamp = max(s.clock().UnixMilli(), item.order.Timestamp+1)
+	} else {
+		s.fill(acct, item.order, item.isAsk, item.reduceOnly, item.order.Price, true)
+		acct.appendHistory(item.order)
+		delete(acct.orders, orderId)
+	}
+	result := cloneOrder(item.order)
+	s.mutex.Unlock()
+
+	s.notify(name, []*exchange.Order{result})
+	return cloneOrder(result), nil
+}
+
+// CancelAllOrders 取消账户在指定交易对的所有挂单
+func (s *Simulator) CancelAllOrders(name, symbol string) []*exchange.Order {
+	return s.cancelOrders(name, symbol, func(string) bool { return true })
+}
+
+// CancelOrders 取消账户在指定交易对的部分挂单，不存在的订单ID直接忽略
+func (s *Simulator) CancelOrders(name, symbol string, orderIds []string) []*exchange.Order {
+	return s.cancelOrders(name, symbol, func(orderId string) bool { return slices.Contains(orderIds, orderId) })
+}
+
+// cancelOrders 取消满足条件的挂单并推送订单更新
+func (s *Simulator) cancelOrders(name, symbol string, match func(orderId string) bool) []*exchange.Order {
+	s.mutex.Lock()
+	acct, ok := s.accounts[name]
+	if !ok {
+		s.mutex.Unlock()
+		return nil
+	}
+
+	canceled := make([]*exchange.Order, 0)
+	for id, item := range acct.orders {
+		if item.order.Symbol != symbol || !match(id) {
+			continue
+		}
+
+		item.order.Status = order.StatusCanceled
+		item.order.Timestamp = s.clock().UnixMilli()
+		acct.appendHistory(item.order)
+		delete(acct.orders, id)
+		canceled = append(canceled, cloneOrder(item.order))
+	}
+	s.mutex.Unlock()
+
+	if len(canceled) > 0 {
+		s.notify(name, canceled)
+	}
+	return canceled
+}
+
+// OnPrice 处理行情价格更新
+// 价格不高于买单价格或不低于卖单价格时，挂单按挂单价格成交
+func (s *Simulator) OnPrice(symbol string, price decimal.Decimal) {
+	if !price.IsPositive() {
+		return
+	}
+
+	s.mutex.Lock()
+	s.prices[symbol] = price
+
+	updates := make(map[string][]*exchange.Order)
+	for name, acct := range s.accounts {
+		for id, item := range acct.orders {
+			if item.order.Symbol != symbol {
+				continue
+			}
+			if (!item.isAsk && price.GreaterThan(item.order.Price)) || (item.isAsk && price.LessThan(item.order.Price)) {
+				continue
+			}
+
+			s.fill(acct, item.order, item.isAsk, item.reduceOnly, item.order.Price, true)
+			acct.appendHistory(item.order)
+			delete(acct.orders, id)
+			updates[name] = append(updates[name], cloneOrder(item.order))
+		}
+	}
+	s.mutex.Unlock()
+
+	for name, orders := range updates {
+		s.notify(name, orders)
+	}
+}
+
+// Orders 获取账户的挂单和最近完成的订单
+func (s *Simulator) Orders(name string) []*exchange.Order {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	acct, ok := s.accounts[name]
+	if !ok {
+		return nil
+	}
+
+	orders := make([]*exchange.Order, 0, len(acct.orders)+len(acct.history))
+	for _, item := range acct.history {
+		orders = append(orders, cloneOrder(item))
+	}
+	for _, item := range acct.orders {
+		orders = append(orders, cloneOrder(item.order))
+	}
+	return orders
+}
+
+// Fills 获取账户在 since 之后的成交记录，按成交顺序返回
+// 使用历史订单恢复的成交不会重新生成成交记录
+func (s *Simulator) Fills(name string, since time.Time) []*exchange.Fill {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	acct, ok := s.accounts[name]
+	if !ok {
+		return nil
+	}
+
+	fills := make([]*exchange.Fill, 0)
+	for _, item := range acct.fills {
+		if item.Timestamp >= since.UnixMilli() {
+			v := *item
+			fills = append(fills, &v)
+		}
+	}
+	return fills
+}
+
+// OpenOrders 获取账户在指定交易对的挂单，按订单ID升序返回
+func (s *Simulator) OpenOrders(name, symbol string) []*exchange.Order {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	acct, ok := s.accounts[name]
+	if !ok {
+		return nil
+	}
+
+	orders := make([]*exchange.Order, 0, len(acct.orders))
+	for _, item := range acct.orders {
+		if item.order.Symbol == symbol {
+			orders = append(orders, cloneOrder(item.order))
+		}
+	}
+	slices.SortFunc(orders, func(a, b *exchange.Order) int { return cmp.Compare(a.OrderID, b.OrderID) })
+	return orders
+}
+
+// Account 获取账户余额和持仓
+// 可用余额 = 账户权益 - 持仓保证金，已实现盈亏扣除了手续费和资金费用
+func (s *Simulator) Account(name string) exchange.Account {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	acct, ok := s.accounts[name]
+	if !ok {
+		return exchange.Account{
+			AvailableBalance: s.initialBalance,
+			TotalAssetValue:  s.initialBalance,
+			Positions:        make([]*exchange.Position, 0),
+		}
+	}
+
+	equity := acct.balance
+	margin := decimal.Zero
+	positions := make([]*exchange.Position, 0, len(acct.positions))
+	for symbol, item := range acct.positions {
+		if item.size.IsZero() {
+			continue
+		}
+
+		markPrice, ok := s.prices[symbol]
+		if !ok {
+			markPrice = item.avgEntryPrice
+		}
+		unrealizedPnl := item.size.Mul(markPrice.Sub(item.avgEntryPrice))
+		leverage := max(acct.leverage[symbol], 1)
+
+		equity = equity.Add(unrealizedPnl)
+		margin = margin.Add(item.size.Abs().Mul(markPrice).Div(decimal.NewFromInt(int64(leverage))))
+
+		side := exchange.PositionSideLong
+		if item.size.IsNegative() {
+			side = exchange.PositionSideShort
+		}
+		positions = append(positions, &exchange.Position{
+			Symbol:        symbol,
+			Side:          side,
+			Position:      item.size.Abs(),
+			AvgEntryPrice: item.avgEntryPrice,
+			UnrealizedPnl: unrealizedPnl,
+			RealizedPnl:   item.realizedPnl.Sub(item.feePaid).Sub(item.fundingPaid),
+			MarginMode:    exchange.MarginModeCross,
+		})
+	}
+
+	return exchange.Account{
+		AvailableBalance: decimal.Max(equity.Sub(margin), decimal.Zero),
+		TotalAssetValue:  equity,
+		Positions:        positions,
+	}
+}
+
+// ApplyFunding 按资金费率结算持仓资金费用
+// 资金费用 = 持仓数量 * 最新价格 * 资金费率，费率为正时多头支付、空头收取
+// 返回值: 账户支付的资金费用(收取时为负数)
+func (s *Simulator) ApplyFunding(name, symbol string, rate decimal.Decimal) decimal.Decimal {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	acct, ok := s.accounts[name]
+	if !ok {
+		return decimal.Zero
+	}
+	item, ok := acct.positions[symbol]
+	if !ok || item.size.IsZero() {
+		return decimal.Zero
+	}
+	markPrice, ok := s.prices[symbol]
+	if !ok {
+		return decimal.Zero
+	}
+
+	payment := item.size.Mul(markPrice).Mul(rate)
+	item.fundingPaid = item.fundingPaid.Add(payment)
+	acct.balance = acct.balance.Sub(payment)
+
+	s.nextOrderId += 1
+	acct.fundings = append(acct.fundings, &exchange.FundingPayment{
+		Symbol:       symbol,
+		PaymentID:    strconv.FormatInt(s.nextOrderId, 10),
+		Amount:       payment,
+		Rate:         rate,
+		PositionSize: item.size,
+		Timestamp:    s.clock().UnixMilli(),
+	})
+	if len(acct.fundings) > maxOrderHistory {
+		acct.fundings = acct.fundings[len(acct.fundings)-maxOrderHistory:]
+	}
+	return payment
+}
+
+// FundingPayments 获取账户在指定交易对 since 之后的资金费用记录
+func (s *Simulator) FundingPayments(name, symbol string, since time.Time) []*exchange.FundingPayment {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	acct, ok := s.accounts[name]
+	if !ok {
+		return nil
+	}
+
+	payments := make([]*exchange.FundingPayment, 0)
+	for _, item := range acct.fundings {
+		if item.Symbol == symbol && item.Timestamp >= since.UnixMilli() {
+			v := *item
+			payments = append(payments, &v)
+		}
+	}
+	return payments
+}
+
+// Summary 获取账户在指定交易对的持仓统计
+func (s *Simulator) Summary(name, symbol string) PositionSummary {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	acct, ok := s.accounts[name]
+	if !ok {
+		return PositionSummary{}
+	}
+	item, ok := acct.positions[symbol]
+	if !ok {
+		return PositionSummary{}
+	}
+
+	return PositionSummary{
+		Size:          item.size,
+		AvgEntryPrice: item.avgEntryPrice,
+		RealizedPnl:   item.realizedPnl,
+		FeePaid:       item.feePaid,
+		FundingPaid:   item.fundingPaid,
+	}
+}
+
+// Position 获取账户在指定交易对的持仓数量(空头为负数)
+func (s *Simulator) Position(name, symbol string) decimal.Decimal {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	acct, ok := s.accounts[name]
+	if !ok {
+		return decimal.Zero
+	}
+	item, ok := acct.positions[symbol]
+	if !ok {
+		return decimal.Zero
+	}
+	return item.size
+}
+
+func (s *Simulator) ensureAccount(name string) *account {
+	acct, ok := s.accounts[name]
+	if !ok {
+		acct = &account{
+			balance:   s.initialBalance,
+			positions: make(map[string]*position),
+			leverage:  make(map[string]uint),
+			orders:    make(map[string]*restingOrder),
+		}
+		s.accounts[name] = acct
+	}
+	return acct
+}
+
+func (s *Simulator) newOrder(symbol, clientOrderId string, isAsk bool, price, size decimal.Decimal) *exchange.Order {
+	s.nextOrderId += 1
+	orderId := strconv.FormatInt(s.nextOrderId, 10)
+	if clientOrderId == "" {
+		clientOrderId = orderId
+	}
+
+	side := order.SideBuy
+	if isAsk {
+		side = order.SideSell
+	}
+	return &exchange.Order{
+		Symbol:            symbol,
+		OrderID:           orderId,
+		ClientOrderID:     clientOrderId,
+		Side:              side,
+		Price:             price,
+		BaseAmount:        size,
+		FilledBaseAmount:  decimal.Zero,
+		FilledQuoteAmount: decimal.Zero,
+		Timestamp:         s.clock().UnixMilli(),
+		Status:            order.StatusOpen,
+	}
+}
+
+// takerPrice 计算吃单成交价格
+func (s *Simulator) takerPrice(lastPrice decimal.Decimal, isAsk bool) decimal.Decimal {
+	slippage := lastPrice.Mul(decimal.NewFromInt(int64(s.fee.SlippageBps))).Div(decimal.NewFromInt(10000))
+	if isAsk {
+		return lastPrice.Sub(slippage)
+	}
+	return lastPrice.Add(slippage)
+}
+
+// fill 成交订单的剩余数量
+// 只减仓订单的成交数量不超过反向持仓数量，没有可减仓位时订单取消
+func (s *Simulator) fill(acct *account, ord *exchange.Order, isAsk, reduceOnly bool, execPrice decimal.Decimal, isMaker bool) {
+	size := ord.BaseAmount.Sub(ord.FilledBaseAmount)
+	if reduceOnly {
+		current := decimal.Zero
+		if item, ok := acct.positions[ord.Symbol]; ok {
+			current = item.size
+		}
+
+		switch {
+		case isAsk && current.IsPositive():
+			size = decimal.Min(size, current)
+		case !isAsk && current.IsNegative():
+			size = decimal.Min(size, current.Neg())
+		default:
+			size = decimal.Zero
+		}
+	}
+
+	ord.Timestamp = max(s.clock().UnixMilli(), ord.Timestamp+1)
+	if size.IsZero() {
+		ord.Status = order.StatusCanceled
+		return
+	}
+
+	feeRate := s.fee.TakerFeeRate
+	if isMaker {
+		feeRate = s.fee.MakerFeeRate
+	}
+	fee := s.applyFill(acct, ord.Symbol, isAsk, execPrice, size, feeRate)
+	s.recordFill(acct, ord, execPrice, size, fee, isMaker)
+	ord.Status = order.StatusFilled
+	ord.FilledBaseAmount = ord.FilledBaseAmount.Add(size)
+	ord.FilledQuoteAmount = ord.FilledQuoteAmount.Add(size.Mul(execPrice))
+}
+
+// applyFill 将成交记入持仓和余额
+// 返回值: 成交手续费
+func (s *Simulator) applyFill(acct *account, symbol string, isAsk bool, price, size, feeRate decimal.Decimal) decimal.Decimal {
+	item, ok := acct.positions[symbol]
+	if !ok {
+		item = &position{}
+		acct.positions[symbol] = item
+	}
+
+	signed := size
+	if isAsk {
+		signed = size.Neg()
+	}
+
+	if item.size.IsZero() || item.size.Sign() == signed.Sign() {
+		// 开仓或加仓
+		total := item.size.Abs().Add(size)
+		item.avgEntryPrice = item.size.Abs().Mul(item.avgEntryPrice).Add(size.Mul(price)).Div(total)
+		item.size = item.size.Add(signed)
+	} else {
+		// 减仓或反向开仓
+		closed := decimal.Min(size, item.size.Abs())
+		pnl := closed.Mul(price.Sub(item.avgEntryPrice))
+		if item.size.IsNegative() {
+			pnl = pnl.Neg()
+		}
+		item.realizedPnl = item.realizedPnl.Add(pnl)
+		acct.balance = acct.balance.Add(pnl)
+
+		before := item.size
+		item.size = item.size.Add(signed)
+		if item.size.IsZero() {
+			item.avgEntryPrice = decimal.Zero
+		} else if item.size.Sign() != before.Sign() {
+			item.avgEntryPrice = price
+		}
+	}
+
+	fee := size.Mul(price).Mul(feeRate)
+	item.feePaid = item.feePaid.Add(fee)
+	acct.balance = acct.balance.Sub(fee)
+	return fee
+}
+
+// recordFill 记录订单的单笔成交
+func (s *Simulator) recordFill(acct *account, ord *exchange.Order, price, size, fee decimal.Decimal, isMaker bool) {
+	s.nextOrderId += 1
+	acct.fills = append(acct.fills, &exchange.Fill{
+		Symbol:        ord.Symbol,
+		FillID:        strconv.FormatInt(s.nextOrderId, 10),
+		OrderID:       ord.OrderID,
+		ClientOrderID: ord.ClientOrderID,
+		Side:          ord.Side,
+		Price:         price,
+		BaseAmount:    size,
+		QuoteAmount:   size.Mul(price),
+		Fee:           fee,
+		FeeAsset:      feeAsset,
+		IsMaker:       isMaker,
+		Timestamp:     s.clock().UnixMilli(),
+	})
+	if len(acct.fills) > maxOrderHistory {
+		acct.fills = acct.fills[len(acct.fills)-maxOrderHistory:]
+	}
+}
+
+func (s *Simulator) notify(name string, orders []*exchange.Order) {
+	s.mutex.Lock()
+	handlers := slices.Clone(s.handlers)
+	s.mutex.Unlock()
+
+	for _, handler := range handlers {
+		handler(name, orders)
+	}
+}
+
+func (acct *account) appendHistory(ord *exchange.Order) {
+	acct.history = append(acct.history, ord)
+	if len(acct.history) > maxOrderHistory {
+		acct.history = acct.history[len(acct.history)-maxOrderHistory:]
+	}
+}
+
+func cloneOrder(ord *exchange.Order) *exchange.Order {
+	v := *ord
+	return &v
+}
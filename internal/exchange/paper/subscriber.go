@@ -0,0 +1,185 @@
+package paper
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+)
+
+// PaperSubscriber 模拟交易所订阅器
+// 定时查询真实交易所的行情价格驱动模拟撮合，并将行情和订单更新转换为 SubMessage
+type PaperSubscriber struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	simulator *Simulator
+	priceFunc PriceFunc
+	interval  time.Duration
+
+	mutex    sync.Mutex
+	symbols  map[string]struct{}
+	accounts map[string]struct{}
+	stopped  atomic.Bool
+
+	subMsgChan       chan exchange.SubMessage
+	userOrdersInChan chan exchange.UserOrders
+}
+
+// NewPaperSubscriber 创建模拟交易所订阅器
+// simulator 撮合模拟器，priceFunc 行情价格查询函数，interval 行情查询间隔
+func NewPaperSubscriber(simulator *Simulator, priceFunc PriceFunc, interval time.Duration) *PaperSubscriber {
+	ctx, cancel := context.WithCancel(context.Background())
+	subscriber := &PaperSubscriber{
+		ctx:              ctx,
+		cancel:           cancel,
+		simulator:        simulator,
+		priceFunc:        priceFunc,
+		interval:         interval,
+		symbols:          make(map[string]struct{}),
+		accounts:         make(map[string]struct{}),
+		userOrdersInChan: make(chan exchange.UserOrders, 1024*8),
+	}
+	simulator.AddOrderHandler(subscriber.onOrders)
+
+	return subscriber
+}
+
+func (subscriber *PaperSubscriber) Stop() {
+	logger.Infof("[PaperSubscriber] 准备停止服务")
+
+	if !subscriber.stopped.CompareAndSwap(false, true) {
+		logger.Warnf("[PaperSubscriber] 服务已经停止")
+		return
+	}
+
+	subscriber.cancel()
+	if subscriber.subMsgChan != nil {
+		close(subscriber.subMsgChan)
+		subscriber.subMsgChan = nil
+	}
+
+	logger.Infof("[PaperSubscriber] 服务已经停止")
+}
+
+func (subscriber *PaperSubscriber) Start() {
+	logger.Infof("[PaperSubscriber] 开始运行服务")
+
+	go subscriber.run()
+}
+
+func (subscriber *PaperSubscriber) SubscriptionChan() <-chan exchange.SubMessage {
+	if subscriber.subMsgChan == nil {
+		subscriber.subMsgChan = make(chan exchange.SubMessage, 1024*8)
+	}
+	return subscriber.subMsgChan
+}
+
+func (subscriber *PaperSubscriber) SubscribeMarketStats(symbol string) error {
+	subscriber.mutex.Lock()
+	defer subscriber.mutex.Unlock()
+
+	subscriber.symbols[symbol] = struct{}{}
+	return nil
+}
+
+func (subscriber *PaperSubscriber) UnsubscribeMarketStats(symbol string) error {
+	subscriber.mutex.Lock()
+	defer subscriber.mutex.Unlock()
+
+	delete(subscriber.symbols, symbol)
+	return nil
+}
+
+// SubscribeAccountOrders 订阅账户订单
+// 订阅后推送一次空快照，触发策略引擎同步订单
+func (subscriber *PaperSubscriber) SubscribeAccountOrders(account string) error {
+	subscriber.mutex.Lock()
+	subscriber.accounts[account] = struct{}{}
+	subscriber.mutex.Unlock()
+
+	subscriber.userOrdersInChan <- exchange.UserOrders{
+		Exchange:   exchange.Paper,
+		Account:    account,
+		Orders:     make([]*exchange.Order, 0),
+		IsSnapshot: true,
+	}
+	return nil
+}
+
+func (subscriber *PaperSubscriber) UnsubscribeAccountOrders(account string) error {
+	subscriber.mutex.Lock()
+	defer subscriber.mutex.Unlock()
+
+	delete(subscriber.accounts, account)
+	return nil
+}
+
+func (subscriber *PaperSubscriber) run() {
+	ticker := time.NewTicker(subscriber.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-subscriber.ctx.Done():
+			return
+		case <-ticker.C:
+			subscriber.pollPrices()
+		case data := <-subscriber.userOrdersInChan:
+			if subscriber.subMsgChan != nil {
+				subscriber.subMsgChan <- exchange.SubMessage{Exchange: exchange.Paper, UserOrders: &data}
+			}
+		}
+	}
+}
+
+// pollPrices 查询订阅交易对的最新价格，驱动撮合并推送行情
+func (subscriber *PaperSubscriber) pollPrices() {
+	subscriber.mutex.Lock()
+	symbols := make([]string, 0, len(subscriber.symbols))
+	for symbol := range subscriber.symbols {
+		symbols = append(symbols, symbol)
+	}
+	subscriber.mutex.Unlock()
+
+	for _, symbol := range symbols {
+		price, err := subscriber.priceFunc(subscriber.ctx, symbol)
+		if err != nil {
+			logger.Warnf("[PaperSubscriber] 查询行情价格失败, symbol: %s, %v", symbol, err)
+			continue
+		}
+		if !price.IsPositive() {
+			continue
+		}
+
+		subscriber.simulator.OnPrice(symbol, price)
+
+		marketStats := exchange.MarketStats{
+			Symbol:    symbol,
+			Price:     price,
+			MarkPrice: price,
+		}
+		logger.Tracef("[PaperSubscriber] 分发 MarketStats 数据, %+v", marketStats)
+		if subscriber.subMsgChan != nil {
+			subscriber.subMsgChan <- exchange.SubMessage{Exchange: exchange.Paper, MarketStats: &marketStats}
+		}
+	}
+}
+
+// onOrders 模拟器订单更新回调，只转发已订阅账户的订单
+func (subscriber *PaperSubscriber) onOrders(account string, orders []*exchange.Order) {
+	subscriber.mutex.Lock()
+	_, ok := subscriber.accounts[account]
+	subscriber.mutex.Unlock()
+	if !ok || subscriber.stopped.Load() {
+		return
+	}
+
+	subscriber.userOrdersInChan <- exchange.UserOrders{
+		Exchange: exchange.Paper,
+		Account:  account,
+		Orders:   orders,
+	}
+}
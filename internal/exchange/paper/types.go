@@ -0,0 +1,74 @@
+package paper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrNoMarketPrice     = errors.New("no market price")
+	ErrInvalidOrderSize  = errors.New("invalid order size")
+	ErrInvalidOrderPrice = errors.New("invalid order price")
+	ErrOrderNotFound     = errors.New("order not found")
+)
+
+const (
+	// maxOrderHistory 每个账户保留的已完成订单、成交记录和资金费用记录数量
+	maxOrderHistory = 1000
+
+	// feeAsset 模拟账户的手续费币种
+	feeAsset = "USDC"
+)
+
+// FeeModel 手续费和滑点模型
+type FeeModel struct {
+	MakerFeeRate decimal.Decimal // 挂单成交手续费率
+	TakerFeeRate decimal.Decimal // 吃单成交手续费率
+	SlippageBps  int             // 吃单成交相对最新价格的滑点(基点)
+}
+
+// PriceFunc 行情价格查询函数
+// 返回真实交易所的最新价格，用于驱动模拟撮合
+type PriceFunc func(ctx context.Context, symbol string) (decimal.Decimal, error)
+
+// OrderHandler 订单更新回调函数
+type OrderHandler func(account string, orders []*exchange.Order)
+
+// PositionSummary 持仓统计
+type PositionSummary struct {
+	Size          decimal.Decimal // 持仓数量(空头为负数)
+	AvgEntryPrice decimal.Decimal // 开仓均价
+	RealizedPnl   decimal.Decimal // 已实现盈亏(不含手续费和资金费用)
+	FeePaid       decimal.Decimal // 累计手续费
+	FundingPaid   decimal.Decimal // 累计资金费用(收取时为负数)
+}
+
+// restingOrder 挂单中的限价单
+type restingOrder struct {
+	order      *exchange.Order
+	isAsk      bool
+	reduceOnly bool
+}
+
+// position 模拟持仓
+type position struct {
+	size          decimal.Decimal // 持仓数量(空头为负数)
+	avgEntryPrice decimal.Decimal // 开仓均价
+	realizedPnl   decimal.Decimal // 已实现盈亏(不含手续费)
+	feePaid       decimal.Decimal // 累计手续费
+	fundingPaid   decimal.Decimal // 累计资金费用
+}
+
+// account 模拟账户
+type account struct {
+	balance   decimal.Decimal            // 账户余额(初始余额 + 已实现盈亏 - 手续费 - 资金费用)
+	positions map[string]*position       // 交易对 -> 持仓
+	leverage  map[string]uint            // 交易对 -> 杠杆倍数
+	orders    map[string]*restingOrder   // 订单ID -> 挂单
+	history   []*exchange.Order          // 已完成订单
+	fills     []*exchange.Fill           // 成交记录
+	fundings  []*exchange.FundingPayment // 资金费用记录
+}
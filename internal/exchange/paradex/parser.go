@@ -0,0 +1,185 @@
+package paradex
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// 录制帧的连接名称
+const (
+	recordSourceUser   = "ParadexWS"
+	recordSourcePublic = "ParadexPubWS"
+)
+
+// errRequestFailed 服务端返回错误响应，连接需要停止
+var errRequestFailed = errors.New("request failed")
+
+// newSnapshotUserOrders 创建空的订单快照
+// orders.ALL 频道不推送快照，连接建立后通过空快照触发订单全量同步
+func newSnapshotUserOrders(account string) exchange.UserOrders {
+	return exchange.UserOrders{
+		Exchange:   exchange.Paradex,
+		Account:    account,
+		Orders:     []*exchange.Order{},
+		IsSnapshot: true,
+	}
+}
+
+// parseOrdersMessage 解析用户连接消息
+// 非订单推送返回 nil，服务端返回错误响应时返回 errRequestFailed
+func parseOrdersMessage(account string, data []byte) (*exchange.UserOrders, error) {
+	var res JsonRpcMessage
+	if err := json.Unmarshal(data, &res); err != nil {
+		logger.Warnf("[ParadexWS-%s] 解析响应失败, %s, %v", account, string(data), err)
+		return nil, nil
+	}
+
+	if res.Error != nil {
+		logger.Errorf("[ParadexWS-%s] 请求处理失败, %s", account, string(res.Error))
+		return nil, errRequestFailed
+	}
+
+	if res.Method != "subscription" {
+		return nil, nil
+	}
+
+	var subscription SubscriptionPayload
+	if err := json.Unmarshal(res.Params, &subscription); err != nil {
+		logger.Warnf("[ParadexWS-%s] 解析订阅数据失败, %s, %v", account, string(res.Params), err)
+		return nil, nil
+	}
+
+	if subscription.Channel != "orders.ALL" {
+		return nil, nil
+	}
+
+	var ord Order
+	if err := json.Unmarshal(subscription.Data, &ord); err != nil {
+		logger.Warnf("[ParadexWS-%s] 解析订阅订单数据失败, %s, %v", account, string(subscription.Data), err)
+		return nil, nil
+	}
+
+	symbol, err := ParseUsdPerpMarket(ord.Market)
+	if err != nil {
+		return nil, nil
+	}
+
+	filledQuoteAmount := decimal.Zero
+	if ord.AvgFillPrice != "" {
+		avgFillPrice, err := decimal.NewFromString(ord.AvgFillPrice)
+		if err == nil {
+			filledQuoteAmount = ord.Size.Mul(avgFillPrice)
+		}
+	}
+
+	userOrders := exchange.UserOrders{
+		Exchange: exchange.Paradex,
+		Account:  account,
+		Orders: []*exchange.Order{
+			{
+				Symbol:            symbol,
+				OrderID:           ord.ID,
+				ClientOrderID:     ord.ClientID,
+				Side:              lo.If(ord.Side == OrderSideSell, order.SideSell).Else(order.SideBuy),
+				Price:             ord.Price,
+				BaseAmount:        ord.Size,
+				FilledBaseAmount:  ord.Size.Sub(ord.RemainingSize),
+				FilledQuoteAmount: filledQuoteAmount,
+				Timestamp:         ord.LastUpdatedAt,
+				Status:            ConvertOrderStatus(&ord),
+			},
+		},
+	}
+	return &userOrders, nil
+}
+
+// parseMarketStatsMessage 解析公共连接消息
+// 非市场数据推送返回 nil，服务端返回错误响应时返回 errRequestFailed
+func parseMarketStatsMessage(data []byte) (*exchange.MarketStats, error) {
+	var res JsonRpcMessage
+	if err := json.Unmarshal(data, &res); err != nil {
+		logger.Warnf("[ParadexPubWS] 解析响应失败, %s, %v", string(data), err)
+		return nil, nil
+	}
+
+	if res.Error != nil {
+		logger.Errorf("[ParadexPubWS] 请求处理失败, %s", string(res.Error))
+		return nil, errRequestFailed
+	}
+
+	if res.Method != "subscription" {
+		return nil, nil
+	}
+
+	var subscription SubscriptionPayload
+	if err := json.Unmarshal(res.Params, &subscription); err != nil {
+		logger.Warnf("[ParadexPubWS] 解析订阅数据失败, %s, %v", string(res.Params), err)
+		return nil, nil
+	}
+
+	if !strings.HasPrefix(subscription.Channel, "markets_summary") {
+		return nil, nil
+	}
+
+	var v MarketSummary
+	if err := json.Unmarshal(subscription.Data, &v); err != nil {
+		logger.Warnf("[ParadexPubWS] 解析订阅市场数据失败, %s, %v", string(subscription.Data), err)
+		return nil, nil
+	}
+
+	symbol, err := ParseUsdPerpMarket(v.Symbol)
+	if err != nil {
+		return nil, nil
+	}
+
+	marketStats := exchange.MarketStats{
+		Symbol:    symbol,
+		Price:     v.LastTradedPrice,
+		MarkPrice: v.MarkPrice,
+	}
+
+	logger.Tracef("[ParadexPubWS] 分发 MarketStats 数据, %+v", marketStats)
+	return &marketStats, nil
+}
+
+// replayParser Paradex录制帧解析器
+type replayParser struct{}
+
+// NewReplayParser 创建Paradex录制帧解析器
+// 用户连接建立事件会像实盘一样推送空快照
+func NewReplayParser() exchange.FrameParser {
+	return replayParser{}
+}
+
+// Parse 解析单个录制帧
+func (replayParser) Parse(frame exchange.Frame) []exchange.SubMessage {
+	switch frame.Source {
+	case recordSourceUser:
+		if frame.Event == exchange.FrameEventConnected {
+			userOrders := newSnapshotUserOrders(frame.Account)
+			return []exchange.SubMessage{{Exchange: exchange.Paradex, UserOrders: &userOrders}}
+		}
+
+		userOrders, _ := parseOrdersMessage(frame.Account, []byte(frame.Data))
+		if userOrders != nil {
+			return []exchange.SubMessage{{Exchange: exchange.Paradex, UserOrders: userOrders}}
+		}
+	case recordSourcePublic:
+		if frame.Event != exchange.FrameEventMessage {
+			return nil
+		}
+
+		marketStats, _ := parseMarketStatsMessage([]byte(frame.Data))
+		if marketStats != nil {
+			return []exchange.SubMessage{{Exchange: exchange.Paradex, MarketStats: marketStats}}
+		}
+	}
+	return nil
+}
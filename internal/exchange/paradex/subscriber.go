@@ -24,6 +24,7 @@ type ParadexSubscriber struct {
 	publicWs  *ParadexPubWS
 	userConns map[string]*ParadexWS
 	stopped   atomic.Bool
+	recorder  *exchange.Recorder
 
 	subMsgChan        chan exchange.SubMessage
 	userOrdersInChan  chan exchange.UserOrders
@@ -79,6 +80,12 @@ func (subscriber *ParadexSubscriber) Stop() {
 	logger.Infof("[ParadexSubscriber] 服务已经停止")
 }
 
+// SetRecorder 设置原始消息录制器，需要在 Start 之前调用
+func (subscriber *ParadexSubscriber) SetRecorder(recorder *exchange.Recorder) {
+	subscriber.recorder = recorder
+	subscriber.publicWs.SetRecorder(recorder)
+}
+
 func (subscriber *ParadexSubscriber) Start() {
 	subscriber.publicWs.Start()
 	subscriber.publicWs.WaitUntilConnected()
@@ -120,6 +127,7 @@ func (subscriber *ParadexSubscriber) SubscribeAccountOrders(userClient *UserClie
 			userClient,
 			subscriber.userOrdersInChan,
 			subscriber.proxy,
+			subscriber.recorder,
 			subscriber.onWsServiceStopped,
 		)
 		ws.Start()
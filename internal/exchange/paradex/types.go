@@ -559,6 +559,24 @@ type Fill struct {
 	UnderlyingPrice decimal.Decimal `json:"underlying_price"` // 标的资产价格(现货价格)
 }
 
+// FundingPayment 资金费用记录
+type FundingPayment struct {
+	Account   string          `json:"account"`    // 账户地址
+	CreatedAt int64           `json:"created_at"` // 结算时间(毫秒时间戳)
+	FillID    string          `json:"fill_id"`    // 触发结算的成交ID(定期结算时为空)
+	ID        string          `json:"id"`         // 资金费用记录ID
+	Index     decimal.Decimal `json:"index"`      // 结算时的资金费率指数
+	Market    string          `json:"market"`     // 市场名称
+	Payment   decimal.Decimal `json:"payment"`    // 资金费用(收取为正，支付为负)
+}
+
+// FundingPaymentRes 资金费用记录响应
+type FundingPaymentRes struct {
+	Next    string            `json:"next"`    // 获取下一组记录的指针(如果没有更多记录则为null)
+	Prev    string            `json:"prev"`    // 获取上一组记录的指针(如果没有更多记录则为null)
+	Results []*FundingPayment `json:"results"` // 资金费用记录列表
+}
+
 // FillRes 成交记录响应
 type FillRes struct {
 	Next    string  `json:"next"`    // 获取下一组记录的指针(如果没有更多记录则为null)
@@ -242,6 +242,42 @@ func (c *UserClient) ListFills(ctx context.Context, startAt *time.Time, cursor s
 	return &res, nil
 }
 
+// ListFundingPayments 获取指定市场的资金费用记录
+func (c *UserClient) ListFundingPayments(ctx context.Context, market string, startAt *time.Time, cursor string, size int) (*FundingPaymentRes, error) {
+	jwtToken, err := c.EnsureJwtToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params := make(url.Values)
+	params.Set("market", market)
+	if cursor != "" {
+		params.Set("cursor", cursor)
+	}
+	params.Set("page_size", strconv.Itoa(size))
+	if startAt != nil {
+		params.Set("start_at", strconv.FormatInt(startAt.UnixMilli(), 10))
+	}
+
+	var res FundingPaymentRes
+	var errRes *ErrorRes
+	err = requests.URL(fmt.Sprintf("%s/funding/payments", c.client.endpoint)).Client(c.client.httpClient).
+		Params(params).
+		Header("Content-Type", "application/json").
+		Header("Authorization", fmt.Sprintf("Bearer %s", jwtToken)).
+		ErrorJSON(&errRes).
+		ToJSON(&res).
+		Fetch(ctx)
+	if err != nil {
+		if errRes != nil {
+			return nil, errRes
+		}
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 // UpsertAccountMargin 更新保证金设置
 func (c *UserClient) UpsertAccountMargin(ctx context.Context, market string, leverage uint, marginType MarginType) (*MarginConfig, error) {
 	jwtToken, err := c.EnsureJwtToken(ctx)
@@ -405,6 +441,29 @@ func (c *UserClient) CreateBatchOrders(ctx context.Context, batchOrders []*Creat
 	return &CreateBatchOrdersRes{Orders: allOrders, Errors: allErrors}, nil
 }
 
+// CancelOrder 取消单个订单
+func (c *UserClient) CancelOrder(ctx context.Context, orderId string) error {
+	jwtToken, err := c.EnsureJwtToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errRes *ErrorRes
+	err = requests.URL(fmt.Sprintf("%s/orders/%s", c.client.endpoint, orderId)).Client(c.client.httpClient).Delete().
+		Header("Content-Type", "application/json").
+		Header("Authorization", fmt.Sprintf("Bearer %s", jwtToken)).
+		ErrorJSON(&errRes).
+		Fetch(ctx)
+	if err != nil {
+		if errRes != nil {
+			return errRes
+		}
+		return err
+	}
+
+	return nil
+}
+
 // CancelAllOpenOrders 取消所有活跃订单
 func (c *UserClient) CancelAllOpenOrders(ctx context.Context, market string) error {
 	jwtToken, err := c.EnsureJwtToken(ctx)
@@ -4,18 +4,14 @@ package paradex
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net"
 	"strings"
 	"time"
 
 	"github.com/fachebot/omni-grid-bot/internal/config"
-	"github.com/fachebot/omni-grid-bot/internal/ent/order"
 	"github.com/fachebot/omni-grid-bot/internal/exchange"
 	"github.com/fachebot/omni-grid-bot/internal/logger"
-	"github.com/samber/lo"
-	"github.com/shopspring/decimal"
 
 	"github.com/gorilla/websocket"
 	"golang.org/x/net/proxy"
@@ -41,6 +37,7 @@ type ParadexWS struct {
 	userClient     *UserClient
 	userOrdersChan chan<- exchange.UserOrders
 	callback       StoppedCallback
+	recorder       *exchange.Recorder
 }
 
 func NewParadexWS(
@@ -48,6 +45,7 @@ func NewParadexWS(
 	userClient *UserClient,
 	userOrdersChan chan<- exchange.UserOrders,
 	proxy config.Sock5Proxy,
+	recorder *exchange.Recorder,
 	callback StoppedCallback,
 ) *ParadexWS {
 	ctx, cancel := context.WithCancel(ctx)
@@ -60,6 +58,7 @@ func NewParadexWS(
 		userClient:     userClient,
 		userOrdersChan: userOrdersChan,
 		callback:       callback,
+		recorder:       recorder,
 	}
 	return ws
 }
@@ -202,13 +201,8 @@ func (ws *ParadexWS) readMessages() {
 	go ws.heartbeat(ctx)
 
 	// 手动触发
-	userOrders := exchange.UserOrders{
-		Exchange:   exchange.Paradex,
-		Account:    account,
-		Orders:     []*exchange.Order{},
-		IsSnapshot: true,
-	}
-	ws.userOrdersChan <- userOrders
+	ws.recorder.Connected(recordSourceUser, account)
+	ws.userOrdersChan <- newSnapshotUserOrders(account)
 
 	// 消息循环
 	for {
@@ -223,68 +217,16 @@ func (ws *ParadexWS) readMessages() {
 		}
 
 		logger.Tracef("[ParadexWS-%s] 收到新消息, %s", account, data)
+		ws.recorder.Message(recordSourceUser, account, data)
 
-		var res JsonRpcMessage
-		if err = json.Unmarshal(data, &res); err != nil {
-			logger.Warnf("[ParadexWS-%s] 解析响应失败, %s, %v", account, string(data), err)
-			continue
-		}
-
-		if res.Error != nil {
-			logger.Errorf("[ParadexWS-%s] 请求处理失败, %s, %v", account, string(res.Error), err)
+		userOrders, err := parseOrdersMessage(account, data)
+		if err != nil {
 			ws.Stop()
 			return
 		}
 
-		if res.Method == "subscription" {
-			var subscription SubscriptionPayload
-			if err = json.Unmarshal(res.Params, &subscription); err != nil {
-				logger.Warnf("[ParadexWS-%s] 解析订阅数据失败, %s, %v", account, string(res.Params), err)
-				continue
-			}
-
-			switch subscription.Channel {
-			case "orders.ALL":
-				var ord Order
-				if err = json.Unmarshal(subscription.Data, &ord); err != nil {
-					logger.Warnf("[ParadexWS-%s] 解析订阅订单数据失败, %s, %v", account, string(subscription.Data), err)
-					continue
-				}
-
-				symbol, err := ParseUsdPerpMarket(ord.Market)
-				if err != nil {
-					continue
-				}
-
-				filledQuoteAmount := decimal.Zero
-				if ord.AvgFillPrice != "" {
-					avgFillPrice, err := decimal.NewFromString(ord.AvgFillPrice)
-					if err == nil {
-						filledQuoteAmount = ord.Size.Mul(avgFillPrice)
-					}
-				}
-
-				userOrders := exchange.UserOrders{
-					Exchange: exchange.Paradex,
-					Account:  account,
-					Orders: []*exchange.Order{
-						{
-							Symbol:            symbol,
-							OrderID:           ord.ID,
-							ClientOrderID:     ord.ClientID,
-							Side:              lo.If(ord.Side == OrderSideSell, order.SideSell).Else(order.SideBuy),
-							Price:             ord.Price,
-							BaseAmount:        ord.Size,
-							FilledBaseAmount:  ord.Size.Sub(ord.RemainingSize),
-							FilledQuoteAmount: filledQuoteAmount,
-							Timestamp:         ord.LastUpdatedAt,
-							Status:            ConvertOrderStatus(&ord),
-						},
-					},
-				}
-
-				ws.userOrdersChan <- userOrders
-			}
+		if userOrders != nil {
+			ws.userOrdersChan <- *userOrders
 		}
 	}
 }
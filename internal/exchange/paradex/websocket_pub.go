@@ -4,7 +4,6 @@ package paradex
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
@@ -30,6 +29,7 @@ type ParadexPubWS struct {
 	reconnect chan struct{}
 
 	marketStatsChan chan<- exchange.MarketStats
+	recorder        *exchange.Recorder
 }
 
 func NewParadexPubWS(
@@ -82,6 +82,11 @@ func (ws *ParadexPubWS) Start() {
 	}
 }
 
+// SetRecorder 设置原始消息录制器，需要在 Start 之前调用
+func (ws *ParadexPubWS) SetRecorder(recorder *exchange.Recorder) {
+	ws.recorder = recorder
+}
+
 func (ws *ParadexPubWS) WaitUntilConnected() {
 	for ws.conn == nil {
 		time.Sleep(time.Second * 1)
@@ -182,6 +187,8 @@ func (ws *ParadexPubWS) readMessages() {
 	defer cancel()
 	go ws.heartbeat(ctx)
 
+	ws.recorder.Connected(recordSourcePublic, "")
+
 	// 消息循环
 	for {
 		_, data, err := ws.conn.ReadMessage()
@@ -195,47 +202,16 @@ func (ws *ParadexPubWS) readMessages() {
 		}
 
 		logger.Tracef("[ParadexPubWS] 收到新消息, %s", data)
+		ws.recorder.Message(recordSourcePublic, "", data)
 
-		var res JsonRpcMessage
-		if err = json.Unmarshal(data, &res); err != nil {
-			logger.Warnf("[ParadexPubWS] 解析响应失败, %s, %v", string(data), err)
-			continue
-		}
-
-		if res.Error != nil {
-			logger.Errorf("[ParadexPubWS] 请求处理失败, %s, %v", string(res.Error), err)
+		marketStats, err := parseMarketStatsMessage(data)
+		if err != nil {
 			ws.Stop()
 			return
 		}
 
-		if res.Method == "subscription" {
-			var subscription SubscriptionPayload
-			if err = json.Unmarshal(res.Params, &subscription); err != nil {
-				logger.Warnf("[ParadexPubWS] 解析订阅数据失败, %s, %v", string(res.Params), err)
-				continue
-			}
-
-			if strings.HasPrefix(subscription.Channel, "markets_summary") {
-				var v MarketSummary
-				if err = json.Unmarshal(subscription.Data, &v); err != nil {
-					logger.Warnf("[ParadexPubWS] 解析订阅市场数据失败, %s, %v", string(subscription.Data), err)
-					continue
-				}
-
-				symbol, err := ParseUsdPerpMarket(v.Symbol)
-				if err != nil {
-					continue
-				}
-
-				marketStats := exchange.MarketStats{
-					Symbol:    symbol,
-					Price:     v.LastTradedPrice,
-					MarkPrice: v.MarkPrice,
-				}
-
-				logger.Tracef("[ParadexPubWS] 分发 MarketStats 数据, %+v", marketStats)
-				ws.marketStatsChan <- marketStats
-			}
+		if marketStats != nil {
+			ws.marketStatsChan <- *marketStats
 		}
 	}
 }
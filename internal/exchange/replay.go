@@ -0,0 +1,226 @@
+package exchange
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+)
+
+// 录制帧事件类型
+const (
+	FrameEventConnected = "connected" // 连接已建立，回放时重置连接状态
+	FrameEventMessage   = "message"   // 收到原始消息
+)
+
+// Frame 录制的 WebSocket 帧
+// 以 JSON Lines 格式逐行写入录制文件
+type Frame struct {
+	Time    time.Time `json:"time"`              // 接收时间
+	Source  string    `json:"source"`            // 连接名称，例如 ParadexWS、ParadexPubWS
+	Account string    `json:"account,omitempty"` // 用户连接所属账户
+	Event   string    `json:"event"`             // 事件类型
+	Data    string    `json:"data,omitempty"`    // 原始消息内容
+}
+
+// Recorder WebSocket 帧录制器
+// 并发安全，nil 录制器的所有方法都是空操作，订阅器无需判断是否开启录制
+type Recorder struct {
+	mutex   sync.Mutex
+	file    *os.File
+	writer  *bufio.Writer
+	encoder *json.Encoder
+}
+
+// NewRecorder 创建录制器，以追加模式写入 path 指向的文件
+func NewRecorder(path string) (*Recorder, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	writer := bufio.NewWriter(file)
+	return &Recorder{file: file, writer: writer, encoder: json.NewEncoder(writer)}, nil
+}
+
+// Connected 记录连接建立事件
+func (r *Recorder) Connected(source, account string) {
+	r.write(Frame{Time: time.Now(), Source: source, Account: account, Event: FrameEventConnected})
+}
+
+// Message 记录收到的原始消息
+func (r *Recorder) Message(source, account string, data []byte) {
+	r.write(Frame{Time: time.Now(), Source: source, Account: account, Event: FrameEventMessage, Data: string(data)})
+}
+
+// Close 刷新缓冲区并关闭录制文件
+func (r *Recorder) Close() error {
+	if r == nil {
+		return nil
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if err := r.writer.Flush(); err != nil {
+		r.file.Close()
+		return err
+	}
+	return r.file.Close()
+}
+
+// write 写入单个帧
+// 每帧写入后立即刷新，进程异常退出时也能保留事故现场
+func (r *Recorder) write(frame Frame) {
+	if r == nil {
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if err := r.encoder.Encode(frame); err != nil {
+		logger.Warnf("[Recorder] 写入录制帧失败, source: %s, %v", frame.Source, err)
+		return
+	}
+	if err := r.writer.Flush(); err != nil {
+		logger.Warnf("[Recorder] 刷新录制文件失败, source: %s, %v", frame.Source, err)
+	}
+}
+
+// ReadFrames 读取录制文件中的所有帧
+func ReadFrames(path string) ([]Frame, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	frames := make([]Frame, 0)
+	decoder := json.NewDecoder(file)
+	for decoder.More() {
+		var frame Frame
+		if err = decoder.Decode(&frame); err != nil {
+			return nil, err
+		}
+		frames = append(frames, frame)
+	}
+	return frames, nil
+}
+
+// FrameParser 录制帧解析器
+// 由各交易所实现，复用实盘连接的消息解析逻辑，将录制帧转换为订阅消息
+type FrameParser interface {
+	// Parse 解析单个录制帧，返回需要分发的订阅消息
+	Parse(frame Frame) []SubMessage
+}
+
+// ReplaySubscriber 录制回放订阅器
+// 将录制帧依次交给解析器，并通过订阅消息通道推送给策略引擎，用于复现线上问题和编写回归测试
+type ReplaySubscriber struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	exchange string
+	frames   []Frame
+	parser   FrameParser
+	speed    float64
+
+	subMsgChan chan SubMessage
+}
+
+// NewReplaySubscriber 创建录制回放订阅器
+// speed 为回放倍速，按帧之间的录制时间间隔除以倍速等待，小于等于 0 时不等待
+func NewReplaySubscriber(exchange string, frames []Frame, parser FrameParser, speed float64) *ReplaySubscriber {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &ReplaySubscriber{
+		ctx:        ctx,
+		cancel:     cancel,
+		done:       make(chan struct{}),
+		exchange:   exchange,
+		frames:     frames,
+		parser:     parser,
+		speed:      speed,
+		subMsgChan: make(chan SubMessage, 1024),
+	}
+}
+
+// Exchange 订阅器所属的交易所名称
+func (s *ReplaySubscriber) Exchange() string {
+	return s.exchange
+}
+
+// Start 开始回放
+func (s *ReplaySubscriber) Start() {
+	logger.Infof("[ReplaySubscriber] 开始回放, exchange: %s, frames: %d", s.exchange, len(s.frames))
+	go s.run()
+}
+
+// Stop 停止回放
+func (s *ReplaySubscriber) Stop() {
+	s.cancel()
+}
+
+// Done 返回回放结束通知通道，所有帧分发完成或停止回放后关闭
+func (s *ReplaySubscriber) Done() <-chan struct{} {
+	return s.done
+}
+
+// SubscriptionChan 返回订阅消息通道
+func (s *ReplaySubscriber) SubscriptionChan() <-chan SubMessage {
+	return s.subMsgChan
+}
+
+// SubscribeMarketStats 回放内容由录制文件决定，订阅操作无需处理
+func (s *ReplaySubscriber) SubscribeMarketStats(symbol string) error {
+	return nil
+}
+
+// SubscribeAccountOrders 回放内容由录制文件决定，订阅操作无需处理
+func (s *ReplaySubscriber) SubscribeAccountOrders(record *ent.Strategy) error {
+	return nil
+}
+
+// UnsubscribeAccountOrders 回放内容由录制文件决定，订阅操作无需处理
+func (s *ReplaySubscriber) UnsubscribeAccountOrders(record *ent.Strategy) error {
+	return nil
+}
+
+// run 按录制顺序分发帧
+func (s *ReplaySubscriber) run() {
+	defer close(s.done)
+
+	for idx, frame := range s.frames {
+		if s.speed > 0 && idx > 0 {
+			delay := time.Duration(float64(frame.Time.Sub(s.frames[idx-1].Time)) / s.speed)
+			if delay > 0 {
+				select {
+				case <-time.After(delay):
+				case <-s.ctx.Done():
+					return
+				}
+			}
+		}
+
+		for _, msg := range s.parser.Parse(frame) {
+			select {
+			case s.subMsgChan <- msg:
+			case <-s.ctx.Done():
+				return
+			}
+		}
+	}
+
+	logger.Infof("[ReplaySubscriber] 回放结束, exchange: %s", s.exchange)
+}
@@ -0,0 +1,30 @@
+package exchange
+
+import (
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+)
+
+// Subscriber 交易所订阅器
+// 负责维护与交易所的 WebSocket 连接，并将行情和订单推送转换为统一的 SubMessage
+type Subscriber interface {
+	// Exchange 订阅器所属的交易所名称
+	Exchange() string
+
+	// Start 启动订阅器
+	Start()
+
+	// Stop 停止订阅器
+	Stop()
+
+	// SubscriptionChan 返回订阅消息通道
+	SubscriptionChan() <-chan SubMessage
+
+	// SubscribeMarketStats 订阅交易对的市场统计数据
+	SubscribeMarketStats(symbol string) error
+
+	// SubscribeAccountOrders 订阅策略账户的订单推送
+	SubscribeAccountOrders(record *ent.Strategy) error
+
+	// UnsubscribeAccountOrders 取消订阅策略账户的订单推送
+	UnsubscribeAccountOrders(record *ent.Strategy) error
+}
@@ -1,6 +1,8 @@
 package exchange
 
 import (
+	"time"
+
 	"github.com/fachebot/omni-grid-bot/internal/ent/order"
 	"github.com/shopspring/decimal"
 )
@@ -84,3 +86,42 @@ type Account struct {
 	Positions        []*Position     `json:"positions"`        // 当前持仓列表
 	TotalAssetValue  decimal.Decimal `json:"totalAssetValue"`  // 总资产价值(持仓+余额)
 }
+
+// Candle K线数据
+// 交易所历史K线的统一格式，用于回测和网格参数推荐
+type Candle struct {
+	Time   time.Time       `json:"time"`   // 开盘时间
+	Open   decimal.Decimal `json:"open"`   // 开盘价
+	High   decimal.Decimal `json:"high"`   // 最高价
+	Low    decimal.Decimal `json:"low"`    // 最低价
+	Close  decimal.Decimal `json:"close"`  // 收盘价
+	Volume decimal.Decimal `json:"volume"` // 成交量
+}
+
+// Fill 成交记录
+// 订单的单笔成交明细，包含实际成交价格和手续费，用于计算匹配交易的净利润
+type Fill struct {
+	Symbol        string          `json:"symbol"`        // 交易对符号
+	FillID        string          `json:"fillId"`        // 交易所成交ID
+	OrderID       string          `json:"orderId"`       // 交易所订单ID
+	ClientOrderID string          `json:"clientOrderId"` // 客户端订单ID(交易所不返回时为空)
+	Side          order.Side      `json:"side"`          // 成交方向(买入/卖出)
+	Price         decimal.Decimal `json:"price"`         // 成交价格
+	BaseAmount    decimal.Decimal `json:"baseAmount"`    // 成交基础资产数量
+	QuoteAmount   decimal.Decimal `json:"quoteAmount"`   // 成交计价资产数量
+	Fee           decimal.Decimal `json:"fee"`           // 手续费(返佣时为负数)
+	FeeAsset      string          `json:"feeAsset"`      // 手续费币种
+	IsMaker       bool            `json:"isMaker"`       // 是否为挂单成交
+	Timestamp     int64           `json:"timestamp"`     // 成交时间戳(毫秒)
+}
+
+// FundingPayment 资金费用记录
+// 持仓在每个资金费结算周期支付或收取的资金费用，用于计算策略的总收益
+type FundingPayment struct {
+	Symbol       string          `json:"symbol"`       // 交易对符号
+	PaymentID    string          `json:"paymentId"`    // 资金费用记录ID
+	Amount       decimal.Decimal `json:"amount"`       // 支付的资金费用(收取时为负数)
+	Rate         decimal.Decimal `json:"rate"`         // 资金费率(交易所不返回时为0)
+	PositionSize decimal.Decimal `json:"positionSize"` // 结算时的持仓数量(空头为负数，交易所不返回时为0)
+	Timestamp    int64           `json:"timestamp"`    // 结算时间戳(毫秒)
+}
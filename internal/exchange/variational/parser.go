@@ -0,0 +1,151 @@
+package variational
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+)
+
+// 录制帧的连接名称
+const (
+	recordSourceUser   = "VariationalWS"
+	recordSourcePublic = "VariationalPubWS"
+)
+
+// portfolioParser 持仓推送解析器
+// 维护单个连接内各标的的持仓序号，持仓发生变化时通过空快照触发订单全量同步，
+// 实盘连接和录制回放共用同一套解析逻辑
+type portfolioParser struct {
+	account          string
+	first            bool
+	localSequenceMap map[string]int64
+}
+
+func newPortfolioParser(account string) *portfolioParser {
+	return &portfolioParser{
+		account:          account,
+		first:            true,
+		localSequenceMap: make(map[string]int64),
+	}
+}
+
+// parse 解析持仓推送，需要同步订单时返回空快照
+func (p *portfolioParser) parse(data []byte) *exchange.UserOrders {
+	var result PoolPortfolioResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		logger.Warnf("[VariationalWS-%s] 解析结果失败, %s, %v", p.account, string(data), err)
+		return nil
+	}
+
+	// 检查仓位变化
+	positionChanged := false
+	positionSet := make(map[string]struct{})
+	for _, item := range result.Positions {
+		underlying := item.PositionInfo.Instrument.Underlying
+		positionSet[underlying] = struct{}{}
+		lastLocalSequence, ok := p.localSequenceMap[underlying]
+		p.localSequenceMap[underlying] = item.PositionInfo.LastLocalSequence
+
+		if !ok {
+			positionChanged = true
+			continue
+		}
+
+		if item.PositionInfo.LastLocalSequence != lastLocalSequence {
+			positionChanged = true
+			continue
+		}
+	}
+
+	for symbol := range p.localSequenceMap {
+		_, ok := positionSet[symbol]
+		if !ok {
+			positionChanged = true
+			delete(p.localSequenceMap, symbol)
+		}
+	}
+
+	// 触发同步订单
+	if !p.first && !positionChanged {
+		return nil
+	}
+
+	p.first = false
+	userOrders := exchange.UserOrders{
+		Exchange:   exchange.Variational,
+		Account:    p.account,
+		Orders:     []*exchange.Order{},
+		IsSnapshot: true,
+	}
+	return &userOrders
+}
+
+// parseMarketStatsMessage 解析公共连接消息，非价格推送返回 nil
+func parseMarketStatsMessage(data []byte) *exchange.MarketStats {
+	var subscription SubscriptionPayload
+	if err := json.Unmarshal(data, &subscription); err != nil {
+		logger.Warnf("[VariationalPubWS] 解析订阅数据失败, %s, %v", data, err)
+		return nil
+	}
+
+	const priceChannelPrefix = "instrument_price:"
+	if !strings.HasPrefix(subscription.Channel, priceChannelPrefix) || subscription.Pricing == nil {
+		return nil
+	}
+
+	slice := strings.Split(subscription.Channel[len(priceChannelPrefix):], "-")
+	if len(slice) != 4 {
+		return nil
+	}
+
+	symbol := slice[1]
+	marketStats := exchange.MarketStats{
+		Symbol:    symbol,
+		Price:     subscription.Pricing.Price,
+		MarkPrice: subscription.Pricing.Price,
+	}
+
+	logger.Tracef("[VariationalPubWS] 分发 MarketStats 数据, %+v", marketStats)
+	return &marketStats
+}
+
+// replayParser Variational录制帧解析器
+type replayParser struct {
+	portfolios map[string]*portfolioParser
+}
+
+// NewReplayParser 创建Variational录制帧解析器
+// 按账户维护持仓序号，用户连接建立事件会重置对应账户的状态
+func NewReplayParser() exchange.FrameParser {
+	return &replayParser{portfolios: make(map[string]*portfolioParser)}
+}
+
+// Parse 解析单个录制帧
+func (p *replayParser) Parse(frame exchange.Frame) []exchange.SubMessage {
+	switch frame.Source {
+	case recordSourceUser:
+		parser, ok := p.portfolios[frame.Account]
+		if !ok || frame.Event == exchange.FrameEventConnected {
+			parser = newPortfolioParser(frame.Account)
+			p.portfolios[frame.Account] = parser
+		}
+		if frame.Event != exchange.FrameEventMessage {
+			return nil
+		}
+
+		if userOrders := parser.parse([]byte(frame.Data)); userOrders != nil {
+			return []exchange.SubMessage{{Exchange: exchange.Variational, UserOrders: userOrders}}
+		}
+	case recordSourcePublic:
+		if frame.Event != exchange.FrameEventMessage {
+			return nil
+		}
+
+		if marketStats := parseMarketStatsMessage([]byte(frame.Data)); marketStats != nil {
+			return []exchange.SubMessage{{Exchange: exchange.Variational, MarketStats: marketStats}}
+		}
+	}
+	return nil
+}
@@ -24,6 +24,7 @@ type VariationalSubscriber struct {
 	publicWs  *VariationalPubWS
 	userConns map[string]*VariationalWS
 	stopped   atomic.Bool
+	recorder  *exchange.Recorder
 
 	subMsgChan        chan exchange.SubMessage
 	userOrdersInChan  chan exchange.UserOrders
@@ -79,6 +80,12 @@ func (subscriber *VariationalSubscriber) Stop() {
 	logger.Infof("[VariationalSubscriber] 服务已经停止")
 }
 
+// SetRecorder 设置原始消息录制器，需要在 Start 之前调用
+func (subscriber *VariationalSubscriber) SetRecorder(recorder *exchange.Recorder) {
+	subscriber.recorder = recorder
+	subscriber.publicWs.SetRecorder(recorder)
+}
+
 func (subscriber *VariationalSubscriber) Start() {
 	subscriber.publicWs.Start()
 	subscriber.publicWs.WaitUntilConnected()
@@ -119,6 +126,7 @@ func (subscriber *VariationalSubscriber) SubscribeAccountOrders(userClient *User
 			userClient,
 			subscriber.userOrdersInChan,
 			subscriber.proxy,
+			subscriber.recorder,
 			subscriber.onWsServiceStopped,
 		)
 		ws.Start()
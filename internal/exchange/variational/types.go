@@ -201,6 +201,24 @@ type OrdersRes struct {
 	Result     []*Order   `json:"result"`     // 订单结果列表
 }
 
+// Trade 成交记录
+type Trade struct {
+	ID         string           `json:"id"`         // 成交ID
+	RfqID      string           `json:"rfq_id"`     // RFQ ID，与订单的 rfq_id 一致
+	Instrument Instrument       `json:"instrument"` // 交易工具信息
+	Side       OrderSide        `json:"side"`       // 买卖方向
+	Qty        decimal.Decimal  `json:"qty"`        // 成交数量
+	Price      decimal.Decimal  `json:"price"`      // 成交价格
+	Fee        *decimal.Decimal `json:"fee"`        // 手续费
+	CreatedAt  time.Time        `json:"created_at"` // 成交时间
+}
+
+// TradesRes 成交记录列表响应
+type TradesRes struct {
+	Pagination Pagination `json:"pagination"` // 分页信息
+	Result     []*Trade   `json:"result"`     // 成交记录列表
+}
+
 // QtyLimitDetails 数量限制详情
 type QtyLimitDetails struct {
 	MinQtyTick decimal.Decimal `json:"min_qty_tick"` // 最小数量变动单位
@@ -94,6 +94,26 @@ func (c *UserClient) GetUserOrders(ctx context.Context, offset, limit int) (*Ord
 	return &orders, nil
 }
 
+// GetUserTrades 获取用户成交记录，按成交时间倒序返回
+func (c *UserClient) GetUserTrades(ctx context.Context, offset, limit int) (*TradesRes, error) {
+	token, err := c.EnsureJwtToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cookies := []*httpx.Cookie{
+		{Name: "vr-token", Value: token},
+	}
+	url := fmt.Sprintf("%s/trades?order_by=created_at&limit=%d&offset=%d&order=desc", c.client.endpoint, limit, offset)
+	res := c.client.client.Get(g.String(url)).WithContext(ctx).AddCookies(cookies...).Do()
+
+	var trades TradesRes
+	if err := c.client.parseRespone(res, &trades); err != nil {
+		return nil, err
+	}
+	return &trades, nil
+}
+
 // GetUserPendingOrders 获取待处理订单
 func (c *UserClient) GetUserPendingOrders(ctx context.Context, offset, limit int) (*OrdersRes, error) {
 	token, err := c.EnsureJwtToken(ctx)
@@ -4,7 +4,6 @@ package variational
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net"
 	"strings"
@@ -40,6 +39,7 @@ type VariationalWS struct {
 	userClient     *UserClient
 	userOrdersChan chan<- exchange.UserOrders
 	callback       StoppedCallback
+	recorder       *exchange.Recorder
 }
 
 func NewVariationalWS(
@@ -47,6 +47,7 @@ func NewVariationalWS(
 	userClient *UserClient,
 	userOrdersChan chan<- exchange.UserOrders,
 	proxy config.Sock5Proxy,
+	recorder *exchange.Recorder,
 	callback StoppedCallback,
 ) *VariationalWS {
 	ctx, cancel := context.WithCancel(ctx)
@@ -59,6 +60,7 @@ func NewVariationalWS(
 		userClient:     userClient,
 		userOrdersChan: userOrdersChan,
 		callback:       callback,
+		recorder:       recorder,
 	}
 	return ws
 }
@@ -191,9 +193,10 @@ func (ws *VariationalWS) readMessages() {
 	defer cancel()
 	go ws.heartbeat(ctx)
 
+	ws.recorder.Connected(recordSourceUser, account)
+
 	// 消息循环
-	first := true
-	localSequenceMap := make(map[string]int64)
+	parser := newPortfolioParser(account)
 	for {
 		_, data, err := ws.conn.ReadMessage()
 		if err != nil {
@@ -205,50 +208,11 @@ func (ws *VariationalWS) readMessages() {
 			return
 		}
 
-		var result PoolPortfolioResult
-		if err = json.Unmarshal(data, &result); err != nil {
-			logger.Warnf("[VariationalWS-%s] 解析结果失败, %s, %v", account, string(data), err)
-			continue
-		}
-
-		// 检查仓位变化
-		positionChanged := false
-		positionSet := make(map[string]struct{})
-		for _, item := range result.Positions {
-			underlying := item.PositionInfo.Instrument.Underlying
-			positionSet[underlying] = struct{}{}
-			lastLocalSequence, ok := localSequenceMap[underlying]
-			localSequenceMap[underlying] = item.PositionInfo.LastLocalSequence
-
-			if !ok {
-				positionChanged = true
-				continue
-			}
-
-			if item.PositionInfo.LastLocalSequence != lastLocalSequence {
-				positionChanged = true
-				continue
-			}
-		}
-
-		for symbol := range localSequenceMap {
-			_, ok := positionSet[symbol]
-			if !ok {
-				positionChanged = true
-				delete(localSequenceMap, symbol)
-			}
-		}
+		ws.recorder.Message(recordSourceUser, account, data)
 
 		// 触发同步订单
-		if first || positionChanged {
-			first = false
-			userOrders := exchange.UserOrders{
-				Exchange:   exchange.Variational,
-				Account:    account,
-				Orders:     []*exchange.Order{},
-				IsSnapshot: true,
-			}
-			ws.userOrdersChan <- userOrders
+		if userOrders := parser.parse(data); userOrders != nil {
+			ws.userOrdersChan <- *userOrders
 		}
 	}
 }
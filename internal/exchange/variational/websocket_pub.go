@@ -4,7 +4,6 @@ package variational
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
@@ -30,6 +29,7 @@ type VariationalPubWS struct {
 	reconnect chan struct{}
 
 	marketStatsChan chan<- exchange.MarketStats
+	recorder        *exchange.Recorder
 }
 
 func NewVariationalPubWS(
@@ -82,6 +82,11 @@ func (ws *VariationalPubWS) Start() {
 	}
 }
 
+// SetRecorder 设置原始消息录制器，需要在 Start 之前调用
+func (ws *VariationalPubWS) SetRecorder(recorder *exchange.Recorder) {
+	ws.recorder = recorder
+}
+
 func (ws *VariationalPubWS) WaitUntilConnected() {
 	for ws.conn == nil {
 		time.Sleep(time.Second * 1)
@@ -180,6 +185,8 @@ func (ws *VariationalPubWS) readMessages() {
 	defer cancel()
 	go ws.heartbeat(ctx)
 
+	ws.recorder.Connected(recordSourcePublic, "")
+
 	// 消息循环
 	for {
 		_, data, err := ws.conn.ReadMessage()
@@ -193,29 +200,10 @@ func (ws *VariationalPubWS) readMessages() {
 		}
 
 		logger.Tracef("[VariationalPubWS] 收到新消息, %s", data)
+		ws.recorder.Message(recordSourcePublic, "", data)
 
-		var subscription SubscriptionPayload
-		if err = json.Unmarshal(data, &subscription); err != nil {
-			logger.Warnf("[VariationalPubWS] 解析订阅数据失败, %s, %v", data, err)
-			continue
-		}
-
-		const priceChannelPrefix = "instrument_price:"
-		if strings.HasPrefix(subscription.Channel, priceChannelPrefix) && subscription.Pricing != nil {
-			slice := strings.Split(subscription.Channel[len(priceChannelPrefix):], "-")
-			if len(slice) != 4 {
-				continue
-			}
-
-			symbol := slice[1]
-			marketStats := exchange.MarketStats{
-				Symbol:    symbol,
-				Price:     subscription.Pricing.Price,
-				MarkPrice: subscription.Pricing.Price,
-			}
-
-			logger.Tracef("[VariationalPubWS] 分发 MarketStats 数据, %+v", marketStats)
-			ws.marketStatsChan <- marketStats
+		if marketStats := parseMarketStatsMessage(data); marketStats != nil {
+			ws.marketStatsChan <- *marketStats
 		}
 	}
 }
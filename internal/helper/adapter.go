@@ -2,7 +2,7 @@ package helper
 
 import (
 	"context"
-	"errors"
+	"time"
 
 	"github.com/fachebot/omni-grid-bot/internal/ent"
 	"github.com/fachebot/omni-grid-bot/internal/exchange"
@@ -40,44 +40,20 @@ func NewExchangeAdapterFromStrategy(svcCtx *svc.ServiceContext, s *ent.Strategy)
 }
 
 // NewExchangeClient 创建交易所订单操作客户端(工厂函数)
-// 根据交易所类型返回对应的订单操作接口实现
+// 根据交易所名称查找已注册的驱动，返回对应的订单操作接口实现
 // svcCtx 服务上下文，exchangeName 交易所名称，record 策略记录
 // 返回值: 订单操作接口实现，错误信息
 func NewExchangeClient(svcCtx *svc.ServiceContext, exchangeName string, record *ent.Strategy) (OrderHelperInterface, error) {
-	switch exchangeName {
-	case exchange.Lighter:
-		signer, err := GetLighterClient(svcCtx, record)
-		if err != nil {
-			return nil, err
-		}
-		return NewLighterOrderHelper(svcCtx, signer), nil
-	case exchange.Paradex:
-		userClient, err := GetParadexClient(svcCtx, record)
-		if err != nil {
-			return nil, err
-		}
-		return NewParadexOrderHelper(svcCtx, userClient), nil
-	case exchange.Variational:
-		userClient, err := GetVariationalClient(svcCtx, record)
-		if err != nil {
-			return nil, err
-		}
-		return NewVariationalOrderHelper(svcCtx, userClient), nil
-	default:
-		return nil, errors.New("exchange unsupported")
+	driver, err := svcCtx.GetDriver(exchangeName)
+	if err != nil {
+		return nil, err
 	}
+	return driver.NewOrderHelper(record)
 }
 
 // getAccountFromStrategy 从策略记录中获取账户标识
 func getAccountFromStrategy(s *ent.Strategy) string {
-	switch s.Exchange {
-	case exchange.Lighter:
-		return s.ExchangeApiKey
-	case exchange.Paradex, exchange.Variational:
-		return s.ExchangeApiKey
-	default:
-		return ""
-	}
+	return s.ExchangeApiKey
 }
 
 // UpdateLeverage 更新杠杆
@@ -90,6 +66,11 @@ func (adapter *ExchangeAdapter) CancalAllOrders(ctx context.Context, symbol stri
 	return adapter.helper.CancalAllOrders(ctx, symbol)
 }
 
+// CancelOrders 取消指定订单
+func (adapter *ExchangeAdapter) CancelOrders(ctx context.Context, symbol string, orderIds []string) error {
+	return adapter.helper.CancelOrders(ctx, symbol, orderIds)
+}
+
 // CreateOrderBatch 批量创建订单
 func (adapter *ExchangeAdapter) CreateOrderBatch(ctx context.Context, limitOrders []CreateLimitOrderParams, marketOrders []CreateMarketOrderParams) ([]string, []string, error) {
 	return adapter.helper.CreateOrderBatch(ctx, limitOrders, marketOrders)
@@ -105,6 +86,36 @@ func (adapter *ExchangeAdapter) SyncUserOrders(ctx context.Context) error {
 	return adapter.helper.SyncUserOrders(ctx)
 }
 
+// GetOpenOrders 获取活跃订单
+// 订单操作客户端未实现 exchange.OpenOrdersProvider 时返回 exchange.ErrExchangeUnsupported
+func (adapter *ExchangeAdapter) GetOpenOrders(ctx context.Context, symbol string) ([]*exchange.Order, error) {
+	provider, ok := adapter.helper.(exchange.OpenOrdersProvider)
+	if !ok {
+		return nil, exchange.ErrExchangeUnsupported
+	}
+	return provider.GetOpenOrders(ctx, symbol)
+}
+
+// GetFills 获取成交记录
+// 订单操作客户端未实现 exchange.FillsProvider 时返回 exchange.ErrExchangeUnsupported
+func (adapter *ExchangeAdapter) GetFills(ctx context.Context, since time.Time) ([]*exchange.Fill, error) {
+	provider, ok := adapter.helper.(exchange.FillsProvider)
+	if !ok {
+		return nil, exchange.ErrExchangeUnsupported
+	}
+	return provider.GetFills(ctx, since)
+}
+
+// GetFundingPayments 获取资金费用记录
+// 订单操作客户端未实现 exchange.FundingProvider 时返回 exchange.ErrExchangeUnsupported
+func (adapter *ExchangeAdapter) GetFundingPayments(ctx context.Context, symbol string, since time.Time) ([]*exchange.FundingPayment, error) {
+	provider, ok := adapter.helper.(exchange.FundingProvider)
+	if !ok {
+		return nil, exchange.ErrExchangeUnsupported
+	}
+	return provider.GetFundingPayments(ctx, symbol, since)
+}
+
 // ClosePosition 平仓
 func (adapter *ExchangeAdapter) ClosePosition(ctx context.Context, symbol string, side Side, slippageBps int) error {
 	return adapter.helper.ClosePosition(ctx, symbol, side, slippageBps)
@@ -0,0 +1,456 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/exchange/dydx"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/fachebot/omni-grid-bot/internal/svc"
+	"github.com/fachebot/omni-grid-bot/internal/util"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// defaultDydxSlippageBps 市价单未指定价格和滑点时使用的默认滑点(基点)
+const defaultDydxSlippageBps = 500
+
+// DydxOrderHelper dYdX交易所订单操作帮助类
+// 实现 OrderHelperInterface 接口，网格限价单以长期订单提交，市价单以短期 IOC 订单提交
+type DydxOrderHelper struct {
+	svcCtx     *svc.ServiceContext // 服务上下文
+	userClient *dydx.UserClient    // dYdX用户客户端
+}
+
+// GetDydxClient 获取dYdX交易所客户端
+// 策略记录中 API Key 为账户地址，Secret Key 为助记词或私钥
+// svcCtx 服务上下文，record 策略记录
+// 返回值: dYdX用户客户端，错误信息
+func GetDydxClient(svcCtx *svc.ServiceContext, record *ent.Strategy) (*dydx.UserClient, error) {
+	return dydx.NewUserClient(svcCtx.DydxClient, record.ExchangeApiKey, record.ExchangeSecretKey)
+}
+
+// NewDydxOrderHelper 创建dYdX订单操作帮助类实例
+func NewDydxOrderHelper(svcCtx *svc.ServiceContext, userClient *dydx.UserClient) *DydxOrderHelper {
+	return &DydxOrderHelper{svcCtx: svcCtx, userClient: userClient}
+}
+
+// UpdateLeverage 更新指定交易对的杠杆倍数和保证金模式
+// dYdX 全仓模式下杠杆由账户保证金决定，没有独立的杠杆设置
+func (h *DydxOrderHelper) UpdateLeverage(ctx context.Context, symbol string, leverage uint, marginMode exchange.MarginMode) error {
+	logger.Debugf("[DydxOrderHelper] 忽略杠杆设置, account: %s, symbol: %s, leverage: %d", h.userClient.Address(), symbol, leverage)
+	return nil
+}
+
+// CancalAllOrders 取消指定交易对的所有活跃订单
+func (h *DydxOrderHelper) CancalAllOrders(ctx context.Context, symbol string) error {
+	orders, err := h.userClient.GetOrders(ctx, dydx.FormatUsdMarket(symbol), dydx.OrderStatusOpen, 1000)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range orders {
+		if err = h.userClient.CancelOrder(ctx, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CancelOrders 取消指定交易对的部分订单
+// 撤单需要订单的 clobPairId 和有效期等信息，先查询活跃订单再按订单ID过滤
+func (h *DydxOrderHelper) CancelOrders(ctx context.Context, symbol string, orderIds []string) error {
+	if len(orderIds) == 0 {
+		return nil
+	}
+
+	orders, err := h.userClient.GetOrders(ctx, dydx.FormatUsdMarket(symbol), dydx.OrderStatusOpen, 1000)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range orders {
+		if !slices.Contains(orderIds, item.ID) {
+			continue
+		}
+		if err = h.userClient.CancelOrder(ctx, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateOrderBatch 批量创建订单
+// dYdX 要求每笔交易只包含一条 clob 消息，订单按顺序逐笔广播
+func (h *DydxOrderHelper) CreateOrderBatch(ctx context.Context, limitOrders []CreateLimitOrderParams, marketOrders []CreateMarketOrderParams) ([]string, []string, error) {
+	nextClientId := uint32(time.Now().UnixNano())
+	limitOrderClientIds := make([]string, 0)
+	batchOrders := make([]dydx.PlaceOrderRequest, 0)
+
+	// 构建限价单列表
+	for _, item := range limitOrders {
+		limitOrderClientIds = append(limitOrderClientIds, strconv.FormatUint(uint64(nextClientId), 10))
+
+		batchOrders = append(batchOrders, dydx.PlaceOrderRequest{
+			Ticker:      dydx.FormatUsdMarket(item.Symbol),
+			Side:        lo.If(item.IsAsk, dydx.ChainSideSell).Else(dydx.ChainSideBuy),
+			Price:       item.Price,
+			Size:        item.Size,
+			ReduceOnly:  item.ReduceOnly,
+			TimeInForce: dydx.ChainTimeInForceUnspecified,
+			ClientId:    nextClientId,
+		})
+		nextClientId += 1
+	}
+
+	// 构建市价单列表
+	marketOrderClientIds := make([]string, 0)
+	for _, item := range marketOrders {
+		marketOrderClientIds = append(marketOrderClientIds, strconv.FormatUint(uint64(nextClientId), 10))
+
+		price := item.AcceptableExecutionPrice
+		if price.IsZero() {
+			var err error
+			price, err = h.slippagePrice(ctx, item.Symbol, item.IsAsk, item.SlippageBps)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
+		batchOrders = append(batchOrders, dydx.PlaceOrderRequest{
+			Ticker:      dydx.FormatUsdMarket(item.Symbol),
+			Side:        lo.If(item.IsAsk, dydx.ChainSideSell).Else(dydx.ChainSideBuy),
+			Price:       price,
+			Size:        item.Size,
+			ReduceOnly:  item.ReduceOnly,
+			TimeInForce: dydx.ChainTimeInForceIoc,
+			ClientId:    nextClientId,
+			ShortTerm:   true,
+		})
+		nextClientId += 1
+	}
+
+	// 逐笔提交订单
+	for _, item := range batchOrders {
+		if err := h.userClient.PlaceOrder(ctx, item); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return limitOrderClientIds, marketOrderClientIds, nil
+}
+
+// CreateLimitOrder 创建限价单
+func (h *DydxOrderHelper) CreateLimitOrder(ctx context.Context, symbol string, isAsk, reduceOnly bool, price, size decimal.Decimal) (string, error) {
+	p := CreateLimitOrderParams{
+		Symbol:     symbol,
+		IsAsk:      isAsk,
+		ReduceOnly: reduceOnly,
+		Price:      price,
+		Size:       size,
+	}
+	clientIds, _, err := h.CreateOrderBatch(ctx, []CreateLimitOrderParams{p}, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return clientIds[0], nil
+}
+
+// CreateMarketOrder 创建市价单
+func (h *DydxOrderHelper) CreateMarketOrder(ctx context.Context, symbol string, isAsk, reduceOnly bool, acceptableExecutionPrice, size decimal.Decimal) (string, error) {
+	p := CreateMarketOrderParams{
+		Symbol:                   symbol,
+		IsAsk:                    isAsk,
+		ReduceOnly:               reduceOnly,
+		AcceptableExecutionPrice: acceptableExecutionPrice,
+		Size:                     size,
+	}
+	_, clientIds, err := h.CreateOrderBatch(ctx, nil, []CreateMarketOrderParams{p})
+	if err != nil {
+		return "", err
+	}
+
+	return clientIds[0], nil
+}
+
+// SyncUserOrders 同步用户订单数据到本地数据库
+// 从dYdX索引器查询最近订单并存储到本地数据库
+func (h *DydxOrderHelper) SyncUserOrders(ctx context.Context) error {
+	account := h.userClient.Address()
+	logger.Debugf("[DydxOrderHelper] 同步用户订单开始, account: %s", account)
+
+	// 获取同步进度
+	syncProgress, err := h.svcCtx.SyncProgressModel.Ensure(ctx, exchange.Dydx, account)
+	if err != nil {
+		return err
+	}
+
+	// 查询最近订单
+	const limit = 1000
+	orders, err := h.userClient.GetOrders(ctx, "", "", limit)
+	if err != nil {
+		logger.Debugf("[DydxOrderHelper] 查询用户订单记录失败, account: %s, %v", account, err)
+		return err
+	}
+
+	userOrders := make([]*exchange.Order, 0, len(orders))
+	for _, item := range orders {
+		ord, err := dydx.ConvertOrder(item)
+		if err != nil {
+			continue
+		}
+		userOrders = append(userOrders, ord)
+	}
+
+	// 本地排序订单(按更新时间倒序)
+	slices.SortFunc(userOrders, func(a, b *exchange.Order) int {
+		if a.Timestamp > b.Timestamp {
+			return -1
+		} else if a.Timestamp == b.Timestamp {
+			return 0
+		}
+		return 1
+	})
+	// 过滤掉已同步的订单
+	for idx, item := range userOrders {
+		if item.Timestamp <= syncProgress.Timestamp {
+			userOrders = userOrders[:idx]
+			break
+		}
+	}
+
+	logger.Debugf("[DydxOrderHelper] 同步用户订单结束, account: %s, count: %d", account, len(userOrders))
+
+	// 本地化存储用户订单
+	return util.Tx(ctx, h.svcCtx.DbClient, func(tx *ent.Tx) error {
+		for _, item := range userOrders {
+			args := ent.Order{
+				Exchange:          exchange.Dydx,
+				Account:           account,
+				Symbol:            item.Symbol,
+				OrderId:           item.OrderID,
+				ClientOrderId:     item.ClientOrderID,
+				Side:              item.Side,
+				Price:             item.Price,
+				BaseAmount:        item.BaseAmount,
+				FilledBaseAmount:  item.FilledBaseAmount,
+				FilledQuoteAmount: item.FilledQuoteAmount,
+				Status:            item.Status,
+				Timestamp:         item.Timestamp,
+			}
+			err = h.svcCtx.OrderModel.Upsert(ctx, args)
+			if err != nil {
+				return err
+			}
+		}
+
+		// 更新同步进度
+		if len(userOrders) > 0 {
+			ts := userOrders[0].Timestamp
+			err = h.svcCtx.SyncProgressModel.UpdateTimestampByAccount(ctx, exchange.Dydx, account, ts)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetOpenOrders 获取指定交易对的全部活跃订单
+func (h *DydxOrderHelper) GetOpenOrders(ctx context.Context, symbol string) ([]*exchange.Order, error) {
+	orders, err := h.userClient.GetOrders(ctx, dydx.FormatUsdMarket(symbol), dydx.OrderStatusOpen, 1000)
+	if err != nil {
+		return nil, err
+	}
+
+	openOrders := make([]*exchange.Order, 0, len(orders))
+	for _, item := range orders {
+		ord, err := dydx.ConvertOrder(item)
+		if err != nil {
+			return nil, err
+		}
+		openOrders = append(openOrders, ord)
+	}
+	return openOrders, nil
+}
+
+// GetFills 获取账户在 since 之后的全部成交记录
+// 索引器成交记录不包含客户端订单ID，通过订单ID关联本地订单
+func (h *DydxOrderHelper) GetFills(ctx context.Context, since time.Time) ([]*exchange.Fill, error) {
+	const limit = 100
+
+	items := make([]*dydx.Fill, 0)
+exit:
+	for page := 1; ; page++ {
+		res, err := h.userClient.GetFills(ctx, limit, page)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range res {
+			if item.CreatedAt.Before(since) {
+				break exit
+			}
+			items = append(items, item)
+		}
+
+		if len(res) < limit {
+			break
+		}
+	}
+
+	fills := make([]*exchange.Fill, 0, len(items))
+	for _, item := range slices.Backward(items) {
+		symbol, err := dydx.ParseUsdMarket(item.Market)
+		if err != nil {
+			continue
+		}
+
+		fills = append(fills, &exchange.Fill{
+			Symbol:      symbol,
+			FillID:      item.ID,
+			OrderID:     item.OrderId,
+			Side:        lo.If(item.Side == dydx.OrderSideSell, order.SideSell).Else(order.SideBuy),
+			Price:       item.Price,
+			BaseAmount:  item.Size,
+			QuoteAmount: item.Size.Mul(item.Price),
+			Fee:         item.Fee,
+			FeeAsset:    "USDC",
+			IsMaker:     item.Liquidity == "MAKER",
+			Timestamp:   item.CreatedAt.UnixMilli(),
+		})
+	}
+	return fills, nil
+}
+
+// GetFundingPayments 获取账户在指定交易对 since 之后的全部资金费用记录
+// 索引器资金费用记录没有ID，使用交易对和结算区块高度作为记录ID
+func (h *DydxOrderHelper) GetFundingPayments(ctx context.Context, symbol string, since time.Time) ([]*exchange.FundingPayment, error) {
+	const limit = 100
+
+	ticker := dydx.FormatUsdMarket(symbol)
+	items := make([]*dydx.FundingPayment, 0)
+	for page := 1; ; page++ {
+		res, err := h.userClient.GetFundingPayments(ctx, ticker, since, limit, page)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, res...)
+
+		if len(res) < limit {
+			break
+		}
+	}
+
+	payments := make([]*exchange.FundingPayment, 0, len(items))
+	for _, item := range slices.Backward(items) {
+		payments = append(payments, &exchange.FundingPayment{
+			Symbol:       symbol,
+			PaymentID:    fmt.Sprintf("%s-%s", item.Ticker, item.CreatedAtHeight),
+			Amount:       item.Payment.Neg(),
+			Rate:         item.Rate,
+			PositionSize: lo.If(item.Side == "SHORT", item.Size.Abs().Neg()).Else(item.Size.Abs()),
+			Timestamp:    item.CreatedAt.UnixMilli(),
+		})
+	}
+	return payments, nil
+}
+
+// ClosePosition 平仓操作
+// 根据指定的持仓方向，平掉全部仓位
+func (h *DydxOrderHelper) ClosePosition(ctx context.Context, symbol string, side Side, slippageBps int) error {
+	subaccount, err := h.userClient.GetSubaccount(ctx)
+	if err != nil {
+		return err
+	}
+
+	// 查找指定仓位
+	position, ok := subaccount.OpenPerpetualPositions[dydx.FormatUsdMarket(symbol)]
+	if !ok || position.Size.IsZero() {
+		return nil
+	}
+	if (side == LONG) != position.Size.IsPositive() {
+		return nil
+	}
+
+	// 根据持仓方向执行平仓
+	isAsk := position.Size.IsPositive()
+	price, err := h.slippagePrice(ctx, symbol, isAsk, slippageBps)
+	if err != nil {
+		return err
+	}
+
+	size := position.Size.Abs()
+	_, err = h.CreateMarketOrder(ctx, symbol, isAsk, true, price, size)
+	if err != nil {
+		logger.Errorf("[DydxOrderHelper] 关闭仓位失败, account: %s, symbol: %s, size: %s, %v", h.userClient.Address(), symbol, size, err)
+	}
+
+	return err
+}
+
+// slippagePrice 根据预言机价格和滑点计算市价单限价
+func (h *DydxOrderHelper) slippagePrice(ctx context.Context, symbol string, isAsk bool, slippageBps int) (decimal.Decimal, error) {
+	price, err := GetLastTradePrice(ctx, h.svcCtx, exchange.Dydx, symbol)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	if slippageBps <= 0 {
+		slippageBps = defaultDydxSlippageBps
+	}
+	slippage := price.Mul(decimal.NewFromInt(int64(slippageBps)).Div(decimal.NewFromInt(10000)))
+	return lo.If(isAsk, price.Sub(slippage)).Else(price.Add(slippage)), nil
+}
+
+// GetDydxAccountInfo 获取dYdX账户信息
+func GetDydxAccountInfo(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy) (*exchange.Account, error) {
+	client, err := GetDydxClient(svcCtx, record)
+	if err != nil {
+		return nil, err
+	}
+
+	subaccount, err := client.GetSubaccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	account := exchange.Account{
+		AvailableBalance: subaccount.FreeCollateral,
+		TotalAssetValue:  subaccount.Equity,
+		Positions:        make([]*exchange.Position, 0, len(subaccount.OpenPerpetualPositions)),
+	}
+
+	// 转换持仓信息
+	for market, item := range subaccount.OpenPerpetualPositions {
+		if item.Size.IsZero() {
+			continue
+		}
+
+		symbol, err := dydx.ParseUsdMarket(market)
+		if err != nil {
+			continue
+		}
+
+		account.Positions = append(account.Positions, &exchange.Position{
+			Symbol:              symbol,
+			Side:                lo.If(item.Size.IsPositive(), exchange.PositionSideLong).Else(exchange.PositionSideShort),
+			Position:            item.Size.Abs(),
+			AvgEntryPrice:       item.EntryPrice,
+			UnrealizedPnl:       item.UnrealizedPnl,
+			RealizedPnl:         item.RealizedPnl,
+			TotalFundingPaidOut: item.NetFunding.Neg(),
+			MarginMode:          exchange.MarginModeCross,
+		})
+	}
+
+	return &account, nil
+}
@@ -0,0 +1,149 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/exchange/dydx"
+	"github.com/fachebot/omni-grid-bot/internal/svc"
+	"github.com/shopspring/decimal"
+)
+
+// DydxDriver dYdX交易所驱动
+type DydxDriver struct {
+	svcCtx     *svc.ServiceContext
+	subscriber exchange.Subscriber
+}
+
+// NewDydxDriver 创建dYdX交易所驱动
+func NewDydxDriver(svcCtx *svc.ServiceContext, subscriber *dydx.DydxSubscriber) *DydxDriver {
+	return &DydxDriver{svcCtx: svcCtx, subscriber: NewDydxSubscriberAdapter(subscriber)}
+}
+
+// Name 交易所名称
+func (d *DydxDriver) Name() string {
+	return exchange.Dydx
+}
+
+// NewOrderHelper 创建订单操作客户端
+func (d *DydxDriver) NewOrderHelper(record *ent.Strategy) (exchange.OrderHelper, error) {
+	userClient, err := GetDydxClient(d.svcCtx, record)
+	if err != nil {
+		return nil, err
+	}
+	return NewDydxOrderHelper(d.svcCtx, userClient), nil
+}
+
+// Subscriber 返回交易所订阅器
+func (d *DydxDriver) Subscriber() exchange.Subscriber {
+	return d.subscriber
+}
+
+// GetMarketMetadata 获取市场元数据
+// 数量和价格精度由 stepSize 和 tickSize 决定，dYdX 没有最小下单金额限制
+func (d *DydxDriver) GetMarketMetadata(ctx context.Context, symbol string) (exchange.MarketMetadata, error) {
+	market, err := d.svcCtx.DydxClient.GetPerpetualMarket(ctx, dydx.FormatUsdMarket(symbol))
+	if err != nil {
+		return exchange.MarketMetadata{}, err
+	}
+
+	sizeDecimals := uint8(max(-market.StepSize.Exponent(), 0))
+	priceDecimals := uint8(max(-market.TickSize.Exponent(), 0))
+	ret := exchange.MarketMetadata{
+		MinBaseAmount:          market.StepSize,
+		MinQuoteAmount:         decimal.Zero,
+		SupportedSizeDecimals:  sizeDecimals,
+		SupportedPriceDecimals: priceDecimals,
+		SupportedQuoteDecimals: priceDecimals,
+	}
+	return ret, nil
+}
+
+// GetLastTradePrice 获取最新成交价格
+// 使用预言机价格代替最新成交价
+func (d *DydxDriver) GetLastTradePrice(ctx context.Context, symbol string) (decimal.Decimal, error) {
+	return d.svcCtx.DydxClient.GetOraclePrice(ctx, dydx.FormatUsdMarket(symbol))
+}
+
+// GetAccountInfo 获取账户信息
+func (d *DydxDriver) GetAccountInfo(ctx context.Context, record *ent.Strategy) (*exchange.Account, error) {
+	return GetDydxAccountInfo(ctx, d.svcCtx, record)
+}
+
+// TestConnectivity 测试账户连通性
+func (d *DydxDriver) TestConnectivity(ctx context.Context, record *ent.Strategy) error {
+	userClient, err := GetDydxClient(d.svcCtx, record)
+	if err != nil {
+		return err
+	}
+
+	_, err = userClient.GetSubaccount(ctx)
+	return err
+}
+
+// MarketURL 交易对页面链接
+func (d *DydxDriver) MarketURL(symbol string) string {
+	return fmt.Sprintf("https://dydx.trade/trade/%s", dydx.FormatUsdMarket(symbol))
+}
+
+// GetCandles 获取历史K线
+// 索引器按时间倒序分页返回，逐页向前查询直到覆盖起始时间
+func (d *DydxDriver) GetCandles(ctx context.Context, symbol string, interval time.Duration, start, end time.Time) ([]exchange.Candle, error) {
+	resolution, ok := dydx.FormatCandleResolution(interval)
+	if !ok {
+		return nil, exchange.ErrIntervalUnsupported
+	}
+
+	const limit = 1000
+	list := make([]exchange.Candle, 0)
+	ticker := dydx.FormatUsdMarket(symbol)
+	for to := end; !to.Before(start); {
+		candles, err := d.svcCtx.DydxClient.GetCandles(ctx, ticker, resolution, start, to, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range candles {
+			list = append(list, dydx.ConvertCandle(item))
+		}
+		if len(candles) < limit {
+			break
+		}
+		to = candles[len(candles)-1].StartedAt.Add(-time.Second)
+	}
+
+	slices.SortFunc(list, func(a, b exchange.Candle) int {
+		return a.Time.Compare(b.Time)
+	})
+	return list, nil
+}
+
+// DydxSubscriberAdapter dYdX订阅器适配器
+// 将策略记录转换为账户地址，使 DydxSubscriber 满足 exchange.Subscriber 接口
+type DydxSubscriberAdapter struct {
+	*dydx.DydxSubscriber
+}
+
+// NewDydxSubscriberAdapter 创建dYdX订阅器适配器
+func NewDydxSubscriberAdapter(subscriber *dydx.DydxSubscriber) *DydxSubscriberAdapter {
+	return &DydxSubscriberAdapter{DydxSubscriber: subscriber}
+}
+
+// Exchange 交易所名称
+func (s *DydxSubscriberAdapter) Exchange() string {
+	return exchange.Dydx
+}
+
+// SubscribeAccountOrders 订阅账户订单
+func (s *DydxSubscriberAdapter) SubscribeAccountOrders(record *ent.Strategy) error {
+	return s.DydxSubscriber.SubscribeAccountOrders(record.ExchangeApiKey)
+}
+
+// UnsubscribeAccountOrders 取消订阅账户订单
+func (s *DydxSubscriberAdapter) UnsubscribeAccountOrders(record *ent.Strategy) error {
+	return s.DydxSubscriber.UnsubscribeAccountOrders(record.ExchangeApiKey)
+}
@@ -2,17 +2,13 @@ package helper
 
 import (
 	"context"
-	"errors"
+	"time"
 
 	"github.com/fachebot/omni-grid-bot/internal/ent"
 	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
-	entstrategy "github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategyrun"
 	"github.com/fachebot/omni-grid-bot/internal/exchange"
-	"github.com/fachebot/omni-grid-bot/internal/exchange/paradex"
-	"github.com/fachebot/omni-grid-bot/internal/model"
 	"github.com/fachebot/omni-grid-bot/internal/svc"
-	"github.com/fachebot/omni-grid-bot/internal/util"
-	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
 )
 
@@ -21,16 +17,6 @@ const (
 	DefaultSlippageBps = 50
 )
 
-// MarketMetadata 市场元数据
-// 包含交易所支持的最小区块数量、价格精度等信息
-type MarketMetadata struct {
-	MinBaseAmount          decimal.Decimal // 最小基础货币数量
-	MinQuoteAmount         decimal.Decimal // 最小计价货币数量
-	SupportedSizeDecimals  uint8           // 支持的数量小数位数
-	SupportedPriceDecimals uint8           // 支持的价格小数位数
-	SupportedQuoteDecimals uint8           // 支持的计价小数位数
-}
-
 // StrategyEngine 策略引擎接口
 type StrategyEngine interface {
 	StopStrategy(id string)
@@ -41,116 +27,53 @@ type StrategyEngine interface {
 // ctx 上下文，svcCtx 服务上下文，record 策略记录
 // 返回值: 账户信息，错误信息
 func GetAccountInfo(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy) (*exchange.Account, error) {
-	switch record.Exchange {
-	case exchange.Lighter:
-		return GetLighterAccountInfo(ctx, svcCtx, record.Account)
-	case exchange.Paradex:
-		return GetParadexAccountInfo(ctx, svcCtx, record)
-	case exchange.Variational:
-		return GetVariationalAccountInfo(ctx, svcCtx, record)
-	default:
-		return nil, errors.New("exchange unsupported")
+	driver, err := svcCtx.GetDriver(record.Exchange)
+	if err != nil {
+		return nil, err
 	}
+	return driver.GetAccountInfo(ctx, record)
 }
 
 // GetMarketMetadata 获取市场元数据
 // 返回指定交易所和交易对的市场配置信息(最小订单数量、价格精度等)
 func GetMarketMetadata(ctx context.Context, svcCtx *svc.ServiceContext, exchangeType, symbol string) (MarketMetadata, error) {
-	switch exchangeType {
-	case exchange.Lighter:
-		metadata, err := svcCtx.LighterCache.GetOrderBookMetadata(ctx, symbol)
-		if err != nil {
-			return MarketMetadata{}, err
-		}
-
-		ret := MarketMetadata{
-			MinBaseAmount:          metadata.MinBaseAmount,
-			MinQuoteAmount:         metadata.MinQuoteAmount,
-			SupportedSizeDecimals:  metadata.SupportedSizeDecimals,
-			SupportedPriceDecimals: metadata.SupportedPriceDecimals,
-			SupportedQuoteDecimals: metadata.SupportedQuoteDecimals,
-		}
-		return ret, nil
-	case exchange.Paradex:
-		metadata, err := svcCtx.ParadexCache.GetMarketMetadata(ctx, paradex.FormatUsdPerpMarket(symbol))
-		if err != nil {
-			return MarketMetadata{}, err
-		}
-
-		ret := MarketMetadata{
-			MinBaseAmount:          metadata.OrderSizeIncrement,
-			MinQuoteAmount:         metadata.MinNotional,
-			SupportedSizeDecimals:  uint8(-metadata.OrderSizeIncrement.Exponent()),
-			SupportedPriceDecimals: uint8(-metadata.PriceTickSize.Exponent()),
-			SupportedQuoteDecimals: uint8(-metadata.PriceTickSize.Exponent()),
-		}
-		return ret, nil
-	case exchange.Variational:
-		quote, err := svcCtx.VariationalClient.SimpleQuote(ctx, symbol, decimal.NewFromFloat(0.0001))
-		if err != nil {
-			return MarketMetadata{}, err
-		}
-
-		minBaseAmount := quote.QtyLimits.Ask.MinQty
-		if minBaseAmount.LessThan(quote.QtyLimits.Bid.MinQty) {
-			minBaseAmount = quote.QtyLimits.Bid.MinQty
-		}
-
-		askPriceDecimals := uint8(-quote.Ask.Exponent())
-		bidPriceDecimals := uint8(-quote.Bid.Exponent())
-		supportedPriceDecimals := lo.Max([]uint8{askPriceDecimals, bidPriceDecimals})
-
-		ret := MarketMetadata{
-			MinBaseAmount:          minBaseAmount,
-			MinQuoteAmount:         decimal.Zero,
-			SupportedSizeDecimals:  uint8(-minBaseAmount.Exponent()),
-			SupportedPriceDecimals: supportedPriceDecimals,
-			SupportedQuoteDecimals: supportedPriceDecimals,
-		}
-		return ret, nil
-	default:
-		return MarketMetadata{}, errors.New("exchange unsupported")
+	driver, err := svcCtx.GetDriver(exchangeType)
+	if err != nil {
+		return MarketMetadata{}, err
 	}
+	return driver.GetMarketMetadata(ctx, symbol)
 }
 
 // GetLastTradePrice 获取最新成交价格
 func GetLastTradePrice(ctx context.Context, svcCtx *svc.ServiceContext, exchangeType, symbol string) (decimal.Decimal, error) {
-	switch exchangeType {
-	case exchange.Lighter:
-		metadata, err := svcCtx.LighterCache.GetOrderBookMetadata(ctx, symbol)
-		if err != nil {
-			return decimal.Zero, err
-		}
-
-		return svcCtx.LighterClient.GetLastTradePrice(ctx, uint(metadata.MarketID))
-	case exchange.Paradex:
-		marketSummary, err := svcCtx.ParadexClient.GetMarketSummary(ctx, paradex.FormatUsdPerpMarket(symbol))
-		if err != nil {
-			return decimal.Zero, err
-		}
+	driver, err := svcCtx.GetDriver(exchangeType)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return driver.GetLastTradePrice(ctx, symbol)
+}
 
-		if len(marketSummary.Results) == 0 {
-			return decimal.Zero, nil
-		}
+// GetCandles 获取历史K线
+// 交易所驱动未实现 exchange.CandleProvider 时返回 exchange.ErrExchangeUnsupported
+func GetCandles(ctx context.Context, svcCtx *svc.ServiceContext, exchangeType, symbol string, interval time.Duration, start, end time.Time) ([]exchange.Candle, error) {
+	driver, err := svcCtx.GetDriver(exchangeType)
+	if err != nil {
+		return nil, err
+	}
 
-		return marketSummary.Results[0].LastTradedPrice, nil
-	case exchange.Variational:
-		quote, err := svcCtx.VariationalClient.SimpleQuote(ctx, symbol, decimal.NewFromFloat(0.0001))
-		if err != nil {
-			return decimal.Zero, err
-		}
-		return quote.Ask, nil
-	default:
-		return decimal.Zero, errors.New("exchange unsupported")
+	provider, ok := driver.(exchange.CandleProvider)
+	if !ok {
+		return nil, exchange.ErrExchangeUnsupported
 	}
+	return provider.GetCandles(ctx, symbol, interval, start, end)
 }
 
 // StopStrategyAndCancelOrders 停止策略并取消所有订单
 // 1. 停止网格策略运行
 // 2. 取消所有挂出的订单
-// 3. 删除网格和成交记录
+// 3. 结束运行记录并归档网格和成交记录
 // 4. 更新策略状态为inactive
-func StopStrategyAndCancelOrders(ctx context.Context, svcCtx *svc.ServiceContext, strategyEngine StrategyEngine, record *ent.Strategy) error {
+func StopStrategyAndCancelOrders(ctx context.Context, svcCtx *svc.ServiceContext, strategyEngine StrategyEngine, record *ent.Strategy, reason strategyrun.StopReason) error {
 	// 停止网格策略
 	strategyEngine.StopStrategy(record.GUID)
 
@@ -165,25 +88,13 @@ func StopStrategyAndCancelOrders(ctx context.Context, svcCtx *svc.ServiceContext
 	}
 
 	// 更新策略状态
-	return util.Tx(ctx, svcCtx.DbClient, func(tx *ent.Tx) error {
-		err = model.NewGridModel(tx.Grid).DeleteByStrategyId(ctx, record.GUID)
-		if err != nil {
-			return err
-		}
-
-		err = model.NewMatchedTradeModel(tx.MatchedTrade).DeleteByStrategyId(ctx, record.GUID)
-		if err != nil {
-			return err
-		}
-
-		return model.NewStrategyModel(tx.Strategy).UpdateStatus(ctx, record.ID, entstrategy.StatusInactive)
-	})
+	return DeactivateStrategy(ctx, svcCtx, record, reason)
 }
 
 // StopStrategyAndClosePosition 停止策略并平仓
 // 在停止策略的基础上 additionally 执行平仓操作
-func StopStrategyAndClosePosition(ctx context.Context, svcCtx *svc.ServiceContext, strategyEngine StrategyEngine, record *ent.Strategy) error {
-	err := StopStrategyAndCancelOrders(ctx, svcCtx, strategyEngine, record)
+func StopStrategyAndClosePosition(ctx context.Context, svcCtx *svc.ServiceContext, strategyEngine StrategyEngine, record *ent.Strategy, reason strategyrun.StopReason) error {
+	err := StopStrategyAndCancelOrders(ctx, svcCtx, strategyEngine, record, reason)
 	if err != nil {
 		return err
 	}
@@ -200,6 +111,42 @@ func StopStrategyAndClosePosition(ctx context.Context, svcCtx *svc.ServiceContex
 	}
 
 	// 根据策略模式确定平仓方向
-	side := lo.If(record.Mode == strategy.ModeLong, LONG).Else(SHORT)
-	return adapter.ClosePosition(ctx, record.Symbol, side, slippageBps)
+	for _, side := range PositionSides(record) {
+		if err = adapter.ClosePosition(ctx, record.Symbol, side, slippageBps); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PositionSides 获取策略可能持有的仓位方向
+// 中性网格的持仓会随价格在多空之间切换，平仓时两个方向都需要处理
+func PositionSides(record *ent.Strategy) []Side {
+	switch record.Mode {
+	case strategy.ModeLong:
+		return []Side{LONG}
+	case strategy.ModeShort:
+		return []Side{SHORT}
+	default:
+		return []Side{LONG, SHORT}
+	}
+}
+
+// IsLongTrade 判断匹配交易是否为做多配对
+// 中性网格按成交先后判断，先买后卖为做多，先卖后买为做空
+func IsLongTrade(record *ent.Strategy, trade *ent.MatchedTrade) bool {
+	switch record.Mode {
+	case strategy.ModeLong:
+		return true
+	case strategy.ModeShort:
+		return false
+	}
+
+	if trade.BuyOrderTimestamp == nil {
+		return false
+	}
+	if trade.SellOrderTimestamp == nil {
+		return true
+	}
+	return *trade.BuyOrderTimestamp <= *trade.SellOrderTimestamp
 }
@@ -0,0 +1,434 @@
+package helper
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/exchange/hyperliquid"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/fachebot/omni-grid-bot/internal/svc"
+	"github.com/fachebot/omni-grid-bot/internal/util"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// defaultHyperliquidSlippageBps 市价单未指定价格和滑点时使用的默认滑点(基点)
+const defaultHyperliquidSlippageBps = 500
+
+// HyperliquidOrderHelper Hyperliquid交易所订单操作帮助类
+// 实现 OrderHelperInterface 接口，提供Hyperliquid交易所的订单操作功能
+type HyperliquidOrderHelper struct {
+	svcCtx     *svc.ServiceContext     // 服务上下文
+	userClient *hyperliquid.UserClient // Hyperliquid用户客户端
+}
+
+// GetHyperliquidClient 获取Hyperliquid交易所客户端
+// 策略记录中 API Key 为主账户地址，Secret Key 为已授权的API钱包私钥
+// svcCtx 服务上下文，record 策略记录
+// 返回值: Hyperliquid用户客户端，错误信息
+func GetHyperliquidClient(svcCtx *svc.ServiceContext, record *ent.Strategy) (*hyperliquid.UserClient, error) {
+	return hyperliquid.NewUserClient(svcCtx.HyperliquidClient, record.ExchangeApiKey, record.ExchangeSecretKey)
+}
+
+// NewHyperliquidOrderHelper 创建Hyperliquid订单操作帮助类实例
+func NewHyperliquidOrderHelper(svcCtx *svc.ServiceContext, userClient *hyperliquid.UserClient) *HyperliquidOrderHelper {
+	return &HyperliquidOrderHelper{svcCtx: svcCtx, userClient: userClient}
+}
+
+// UpdateLeverage 更新指定交易对的杠杆倍数和保证金模式
+func (h *HyperliquidOrderHelper) UpdateLeverage(ctx context.Context, symbol string, leverage uint, marginMode exchange.MarginMode) error {
+	return h.userClient.UpdateLeverage(ctx, symbol, int(leverage), marginMode == exchange.MarginModeCross)
+}
+
+// CancalAllOrders 取消指定交易对的所有活跃订单
+func (h *HyperliquidOrderHelper) CancalAllOrders(ctx context.Context, symbol string) error {
+	orders, err := h.userClient.GetOpenOrders(ctx)
+	if err != nil {
+		return err
+	}
+
+	cancels := make([]hyperliquid.CancelRequest, 0)
+	for _, item := range orders {
+		if item.Coin == symbol {
+			cancels = append(cancels, hyperliquid.CancelRequest{Coin: item.Coin, Oid: item.Oid})
+		}
+	}
+	return h.userClient.CancelOrders(ctx, cancels)
+}
+
+// CancelOrders 取消指定交易对的部分订单
+func (h *HyperliquidOrderHelper) CancelOrders(ctx context.Context, symbol string, orderIds []string) error {
+	if len(orderIds) == 0 {
+		return nil
+	}
+
+	cancels := make([]hyperliquid.CancelRequest, 0, len(orderIds))
+	for _, item := range orderIds {
+		oid, err := strconv.ParseInt(item, 10, 64)
+		if err != nil {
+			return err
+		}
+		cancels = append(cancels, hyperliquid.CancelRequest{Coin: symbol, Oid: oid})
+	}
+	return h.userClient.CancelOrders(ctx, cancels)
+}
+
+// CreateOrderBatch 批量创建订单
+// Hyperliquid 没有原生市价单，市价单以 IOC 限价单提交
+func (h *HyperliquidOrderHelper) CreateOrderBatch(ctx context.Context, limitOrders []CreateLimitOrderParams, marketOrders []CreateMarketOrderParams) ([]string, []string, error) {
+	nextClientId := time.Now().UnixNano()
+	limitOrderClientIds := make([]string, 0)
+	batchOrders := make([]hyperliquid.OrderRequest, 0)
+
+	// 构建限价单列表
+	for _, item := range limitOrders {
+		clientId := hyperliquid.NewCloid(nextClientId)
+		limitOrderClientIds = append(limitOrderClientIds, clientId)
+
+		batchOrders = append(batchOrders, hyperliquid.OrderRequest{
+			Coin:       item.Symbol,
+			IsBuy:      !item.IsAsk,
+			LimitPx:    item.Price,
+			Sz:         item.Size,
+			ReduceOnly: item.ReduceOnly,
+			Tif:        hyperliquid.TifGtc,
+			Cloid:      clientId,
+		})
+		nextClientId += 1
+	}
+
+	// 构建市价单列表
+	marketOrderClientIds := make([]string, 0)
+	for _, item := range marketOrders {
+		clientId := hyperliquid.NewCloid(nextClientId)
+		marketOrderClientIds = append(marketOrderClientIds, clientId)
+
+		price := item.AcceptableExecutionPrice
+		if price.IsZero() {
+			var err error
+			price, err = h.slippagePrice(ctx, item.Symbol, item.IsAsk, item.SlippageBps)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
+		batchOrders = append(batchOrders, hyperliquid.OrderRequest{
+			Coin:       item.Symbol,
+			IsBuy:      !item.IsAsk,
+			LimitPx:    price,
+			Sz:         item.Size,
+			ReduceOnly: item.ReduceOnly,
+			Tif:        hyperliquid.TifIoc,
+			Cloid:      clientId,
+		})
+		nextClientId += 1
+	}
+
+	// 批量提交订单
+	statuses, err := h.userClient.PlaceOrders(ctx, batchOrders)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 检查批量提交结果中的错误
+	for _, item := range statuses {
+		if item.Error != "" {
+			return nil, nil, fmt.Errorf("place order error: %s", item.Error)
+		}
+	}
+
+	return limitOrderClientIds, marketOrderClientIds, nil
+}
+
+// CreateLimitOrder 创建限价单
+func (h *HyperliquidOrderHelper) CreateLimitOrder(ctx context.Context, symbol string, isAsk, reduceOnly bool, price, size decimal.Decimal) (string, error) {
+	p := CreateLimitOrderParams{
+		Symbol:     symbol,
+		IsAsk:      isAsk,
+		ReduceOnly: reduceOnly,
+		Price:      price,
+		Size:       size,
+	}
+	clientIds, _, err := h.CreateOrderBatch(ctx, []CreateLimitOrderParams{p}, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return clientIds[0], nil
+}
+
+// CreateMarketOrder 创建市价单
+func (h *HyperliquidOrderHelper) CreateMarketOrder(ctx context.Context, symbol string, isAsk, reduceOnly bool, acceptableExecutionPrice, size decimal.Decimal) (string, error) {
+	p := CreateMarketOrderParams{
+		Symbol:                   symbol,
+		IsAsk:                    isAsk,
+		ReduceOnly:               reduceOnly,
+		AcceptableExecutionPrice: acceptableExecutionPrice,
+		Size:                     size,
+	}
+	_, clientIds, err := h.CreateOrderBatch(ctx, nil, []CreateMarketOrderParams{p})
+	if err != nil {
+		return "", err
+	}
+
+	return clientIds[0], nil
+}
+
+// SyncUserOrders 同步用户订单数据到本地数据库
+// 从Hyperliquid交易所查询历史订单并存储到本地数据库
+func (h *HyperliquidOrderHelper) SyncUserOrders(ctx context.Context) error {
+	account := h.userClient.Account()
+	logger.Debugf("[HyperliquidOrderHelper] 同步用户订单开始, account: %s", account)
+
+	// 获取同步进度
+	syncProgress, err := h.svcCtx.SyncProgressModel.Ensure(ctx, exchange.Hyperliquid, account)
+	if err != nil {
+		return err
+	}
+
+	// 查询历史订单
+	userOrders, err := h.userClient.GetHistoricalOrders(ctx)
+	if err != nil {
+		logger.Debugf("[HyperliquidOrderHelper] 查询用户订单记录失败, account: %s, %v", account, err)
+		return err
+	}
+
+	// 本地排序订单(按更新时间倒序)
+	slices.SortFunc(userOrders, func(a, b *hyperliquid.OrderUpdate) int {
+		if a.StatusTimestamp > b.StatusTimestamp {
+			return -1
+		} else if a.StatusTimestamp == b.StatusTimestamp {
+			return 0
+		}
+		return 1
+	})
+	// 过滤掉已同步的订单
+	for idx, item := range userOrders {
+		if item.StatusTimestamp <= syncProgress.Timestamp {
+			userOrders = userOrders[:idx]
+			break
+		}
+	}
+
+	logger.Debugf("[HyperliquidOrderHelper] 同步用户订单结束, account: %s, count: %d", account, len(userOrders))
+
+	// 本地化存储用户订单
+	return util.Tx(ctx, h.svcCtx.DbClient, func(tx *ent.Tx) error {
+		for _, item := range userOrders {
+			ord := hyperliquid.ConvertOrderUpdate(item)
+			args := ent.Order{
+				Exchange:          exchange.Hyperliquid,
+				Account:           account,
+				Symbol:            ord.Symbol,
+				OrderId:           ord.OrderID,
+				ClientOrderId:     ord.ClientOrderID,
+				Side:              ord.Side,
+				Price:             ord.Price,
+				BaseAmount:        ord.BaseAmount,
+				FilledBaseAmount:  ord.FilledBaseAmount,
+				FilledQuoteAmount: ord.FilledQuoteAmount,
+				Status:            ord.Status,
+				Timestamp:         ord.Timestamp,
+			}
+			err = h.svcCtx.OrderModel.Upsert(ctx, args)
+			if err != nil {
+				return err
+			}
+		}
+
+		// 更新同步进度
+		if len(userOrders) > 0 {
+			ts := userOrders[0].StatusTimestamp
+			err = h.svcCtx.SyncProgressModel.UpdateTimestampByAccount(ctx, exchange.Hyperliquid, account, ts)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetOpenOrders 获取指定交易对的全部活跃订单
+func (h *HyperliquidOrderHelper) GetOpenOrders(ctx context.Context, symbol string) ([]*exchange.Order, error) {
+	orders, err := h.userClient.GetOpenOrders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	openOrders := make([]*exchange.Order, 0, len(orders))
+	for _, item := range orders {
+		if item.Coin != symbol {
+			continue
+		}
+		openOrders = append(openOrders, hyperliquid.ConvertOrderUpdate(&hyperliquid.OrderUpdate{
+			Order:           *item,
+			Status:          hyperliquid.OrderStatusOpen,
+			StatusTimestamp: item.Timestamp,
+		}))
+	}
+	return openOrders, nil
+}
+
+// GetFills 获取账户在 since 之后的全部成交记录
+// 成交记录中的 cloid 即为下单时的客户端订单ID
+func (h *HyperliquidOrderHelper) GetFills(ctx context.Context, since time.Time) ([]*exchange.Fill, error) {
+	items, err := h.userClient.GetUserFillsByTime(ctx, since.UnixMilli())
+	if err != nil {
+		return nil, err
+	}
+
+	fills := make([]*exchange.Fill, 0, len(items))
+	for _, item := range items {
+		fills = append(fills, &exchange.Fill{
+			Symbol:        item.Coin,
+			FillID:        strconv.FormatInt(item.Tid, 10),
+			OrderID:       strconv.FormatInt(item.Oid, 10),
+			ClientOrderID: item.Cloid,
+			Side:          lo.If(item.Side == hyperliquid.OrderSideSell, order.SideSell).Else(order.SideBuy),
+			Price:         item.Px,
+			BaseAmount:    item.Sz,
+			QuoteAmount:   item.Sz.Mul(item.Px),
+			Fee:           item.Fee,
+			FeeAsset:      item.FeeToken,
+			IsMaker:       !item.Crossed,
+			Timestamp:     item.Time,
+		})
+	}
+
+	slices.SortStableFunc(fills, func(a, b *exchange.Fill) int { return cmp.Compare(a.Timestamp, b.Timestamp) })
+	return fills, nil
+}
+
+// GetFundingPayments 获取账户在指定交易对 since 之后的全部资金费用记录
+// 资金费用记录的哈希可能为空，使用资产名称和结算时间作为记录ID
+func (h *HyperliquidOrderHelper) GetFundingPayments(ctx context.Context, symbol string, since time.Time) ([]*exchange.FundingPayment, error) {
+	const limit = 500
+
+	startTime := since.UnixMilli()
+	payments := make([]*exchange.FundingPayment, 0)
+	for {
+		items, err := h.userClient.GetUserFunding(ctx, startTime)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			if item.Delta.Type != "funding" || item.Delta.Coin != symbol {
+				continue
+			}
+			payments = append(payments, &exchange.FundingPayment{
+				Symbol:       symbol,
+				PaymentID:    fmt.Sprintf("%s-%d", item.Delta.Coin, item.Time),
+				Amount:       item.Delta.Usdc.Neg(),
+				Rate:         item.Delta.FundingRate,
+				PositionSize: item.Delta.Szi,
+				Timestamp:    item.Time,
+			})
+		}
+
+		if len(items) < limit {
+			break
+		}
+		startTime = items[len(items)-1].Time + 1
+	}
+
+	slices.SortStableFunc(payments, func(a, b *exchange.FundingPayment) int { return cmp.Compare(a.Timestamp, b.Timestamp) })
+	return payments, nil
+}
+
+// ClosePosition 平仓操作
+// 根据指定的持仓方向，平掉全部仓位
+func (h *HyperliquidOrderHelper) ClosePosition(ctx context.Context, symbol string, side Side, slippageBps int) error {
+	state, err := h.userClient.GetClearinghouseState(ctx)
+	if err != nil {
+		return err
+	}
+
+	// 查找指定仓位
+	position, ok := lo.Find(state.AssetPositions, func(item *hyperliquid.AssetPosition) bool {
+		if item.Position.Coin != symbol || item.Position.Szi.IsZero() {
+			return false
+		}
+		return lo.If(side == LONG, item.Position.Szi.IsPositive()).Else(item.Position.Szi.IsNegative())
+	})
+	if !ok {
+		return nil
+	}
+
+	// 根据持仓方向执行平仓
+	isAsk := position.Position.Szi.IsPositive()
+	price, err := h.slippagePrice(ctx, symbol, isAsk, slippageBps)
+	if err != nil {
+		return err
+	}
+
+	size := position.Position.Szi.Abs()
+	_, err = h.CreateMarketOrder(ctx, symbol, isAsk, true, price, size)
+	if err != nil {
+		logger.Errorf("[HyperliquidOrderHelper] 关闭仓位失败, account: %s, symbol: %s, size: %s, %v", h.userClient.Account(), symbol, size, err)
+	}
+
+	return err
+}
+
+// slippagePrice 根据中间价和滑点计算市价单限价
+func (h *HyperliquidOrderHelper) slippagePrice(ctx context.Context, symbol string, isAsk bool, slippageBps int) (decimal.Decimal, error) {
+	price, err := GetLastTradePrice(ctx, h.svcCtx, exchange.Hyperliquid, symbol)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	if slippageBps <= 0 {
+		slippageBps = defaultHyperliquidSlippageBps
+	}
+	slippage := price.Mul(decimal.NewFromInt(int64(slippageBps)).Div(decimal.NewFromInt(10000)))
+	return lo.If(isAsk, price.Sub(slippage)).Else(price.Add(slippage)), nil
+}
+
+// GetHyperliquidAccountInfo 获取Hyperliquid账户信息
+func GetHyperliquidAccountInfo(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy) (*exchange.Account, error) {
+	client, err := GetHyperliquidClient(svcCtx, record)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := client.GetClearinghouseState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	account := exchange.Account{
+		AvailableBalance: state.Withdrawable,
+		TotalAssetValue:  state.MarginSummary.AccountValue,
+		Positions:        make([]*exchange.Position, 0, len(state.AssetPositions)),
+	}
+
+	// 转换持仓信息
+	for _, item := range state.AssetPositions {
+		position := item.Position
+		if position.Szi.IsZero() {
+			continue
+		}
+
+		account.Positions = append(account.Positions, &exchange.Position{
+			Symbol:              position.Coin,
+			Side:                lo.If(position.Szi.IsPositive(), exchange.PositionSideLong).Else(exchange.PositionSideShort),
+			Position:            position.Szi.Abs(),
+			AvgEntryPrice:       lo.FromPtr(position.EntryPx),
+			UnrealizedPnl:       position.UnrealizedPnl,
+			LiquidationPrice:    lo.FromPtr(position.LiquidationPx),
+			TotalFundingPaidOut: position.CumFunding.SinceOpen,
+			MarginMode:          lo.If(position.Leverage.Type == "isolated", exchange.MarginModeIsolated).Else(exchange.MarginModeCross),
+		})
+	}
+
+	return &account, nil
+}
@@ -0,0 +1,139 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/exchange/hyperliquid"
+	"github.com/fachebot/omni-grid-bot/internal/svc"
+	"github.com/shopspring/decimal"
+)
+
+// HyperliquidDriver Hyperliquid交易所驱动
+type HyperliquidDriver struct {
+	svcCtx     *svc.ServiceContext
+	subscriber exchange.Subscriber
+}
+
+// NewHyperliquidDriver 创建Hyperliquid交易所驱动
+func NewHyperliquidDriver(svcCtx *svc.ServiceContext, subscriber *hyperliquid.HyperliquidSubscriber) *HyperliquidDriver {
+	return &HyperliquidDriver{svcCtx: svcCtx, subscriber: NewHyperliquidSubscriberAdapter(subscriber)}
+}
+
+// Name 交易所名称
+func (d *HyperliquidDriver) Name() string {
+	return exchange.Hyperliquid
+}
+
+// NewOrderHelper 创建订单操作客户端
+func (d *HyperliquidDriver) NewOrderHelper(record *ent.Strategy) (exchange.OrderHelper, error) {
+	userClient, err := GetHyperliquidClient(d.svcCtx, record)
+	if err != nil {
+		return nil, err
+	}
+	return NewHyperliquidOrderHelper(d.svcCtx, userClient), nil
+}
+
+// Subscriber 返回交易所订阅器
+func (d *HyperliquidDriver) Subscriber() exchange.Subscriber {
+	return d.subscriber
+}
+
+// GetMarketMetadata 获取市场元数据
+// 价格小数位数上限为 6 - szDecimals，最小下单金额为 10 USDC
+func (d *HyperliquidDriver) GetMarketMetadata(ctx context.Context, symbol string) (exchange.MarketMetadata, error) {
+	_, info, err := d.svcCtx.HyperliquidClient.GetAssetInfo(ctx, symbol)
+	if err != nil {
+		return exchange.MarketMetadata{}, err
+	}
+
+	priceDecimals := uint8(max(6-info.SzDecimals, 0))
+	ret := exchange.MarketMetadata{
+		MinBaseAmount:          decimal.New(1, -int32(info.SzDecimals)),
+		MinQuoteAmount:         decimal.NewFromInt(10),
+		SupportedSizeDecimals:  uint8(info.SzDecimals),
+		SupportedPriceDecimals: priceDecimals,
+		SupportedQuoteDecimals: priceDecimals,
+	}
+	return ret, nil
+}
+
+// GetLastTradePrice 获取最新成交价格
+// 使用中间价代替最新成交价
+func (d *HyperliquidDriver) GetLastTradePrice(ctx context.Context, symbol string) (decimal.Decimal, error) {
+	mids, err := d.svcCtx.HyperliquidClient.GetAllMids(ctx)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return mids[symbol], nil
+}
+
+// GetAccountInfo 获取账户信息
+func (d *HyperliquidDriver) GetAccountInfo(ctx context.Context, record *ent.Strategy) (*exchange.Account, error) {
+	return GetHyperliquidAccountInfo(ctx, d.svcCtx, record)
+}
+
+// TestConnectivity 测试账户连通性
+func (d *HyperliquidDriver) TestConnectivity(ctx context.Context, record *ent.Strategy) error {
+	userClient, err := GetHyperliquidClient(d.svcCtx, record)
+	if err != nil {
+		return err
+	}
+
+	_, err = userClient.GetClearinghouseState(ctx)
+	return err
+}
+
+// MarketURL 交易对页面链接
+func (d *HyperliquidDriver) MarketURL(symbol string) string {
+	return fmt.Sprintf("https://app.hyperliquid.xyz/trade/%s", symbol)
+}
+
+// GetCandles 获取历史K线
+// 单次查询最多返回最近的 5000 根K线
+func (d *HyperliquidDriver) GetCandles(ctx context.Context, symbol string, interval time.Duration, start, end time.Time) ([]exchange.Candle, error) {
+	s, ok := hyperliquid.FormatCandleInterval(interval)
+	if !ok {
+		return nil, exchange.ErrIntervalUnsupported
+	}
+
+	candles, err := d.svcCtx.HyperliquidClient.GetCandleSnapshot(ctx, symbol, s, start.UnixMilli(), end.UnixMilli())
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]exchange.Candle, 0, len(candles))
+	for _, item := range candles {
+		list = append(list, hyperliquid.ConvertCandle(item))
+	}
+	return list, nil
+}
+
+// HyperliquidSubscriberAdapter Hyperliquid订阅器适配器
+// 将策略记录转换为账户地址，使 HyperliquidSubscriber 满足 exchange.Subscriber 接口
+type HyperliquidSubscriberAdapter struct {
+	*hyperliquid.HyperliquidSubscriber
+}
+
+// NewHyperliquidSubscriberAdapter 创建Hyperliquid订阅器适配器
+func NewHyperliquidSubscriberAdapter(subscriber *hyperliquid.HyperliquidSubscriber) *HyperliquidSubscriberAdapter {
+	return &HyperliquidSubscriberAdapter{HyperliquidSubscriber: subscriber}
+}
+
+// Exchange 交易所名称
+func (s *HyperliquidSubscriberAdapter) Exchange() string {
+	return exchange.Hyperliquid
+}
+
+// SubscribeAccountOrders 订阅账户订单
+func (s *HyperliquidSubscriberAdapter) SubscribeAccountOrders(record *ent.Strategy) error {
+	return s.HyperliquidSubscriber.SubscribeAccountOrders(record.ExchangeApiKey)
+}
+
+// UnsubscribeAccountOrders 取消订阅账户订单
+func (s *HyperliquidSubscriberAdapter) UnsubscribeAccountOrders(record *ent.Strategy) error {
+	return s.HyperliquidSubscriber.UnsubscribeAccountOrders(record.ExchangeApiKey)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"time"
 
@@ -139,6 +140,19 @@ func (h *LighterOrderHelper) CancalAllOrders(ctx context.Context, symbol string)
 	return h.CancelOrderBatch(ctx, cancelOrders)
 }
 
+// CancelOrders 取消指定交易对的部分订单
+func (h *LighterOrderHelper) CancelOrders(ctx context.Context, symbol string, orderIds []string) error {
+	cancelOrders := make([]CancelOrderParams, 0, len(orderIds))
+	for _, item := range orderIds {
+		orderId, err := strconv.ParseInt(item, 10, 64)
+		if err != nil {
+			return err
+		}
+		cancelOrders = append(cancelOrders, CancelOrderParams{Symbol: symbol, OrderID: orderId})
+	}
+	return h.CancelOrderBatch(ctx, cancelOrders)
+}
+
 // CancelOrder 取消单个订单
 func (h *LighterOrderHelper) CancelOrder(ctx context.Context, symbol string, orderIndex int64) error {
 	return h.CancelOrderBatch(ctx, []CancelOrderParams{{Symbol: symbol, OrderID: orderIndex}})
@@ -400,6 +414,142 @@ exit:
 	})
 }
 
+// GetOpenOrders 获取指定交易对的全部活跃订单
+func (h *LighterOrderHelper) GetOpenOrders(ctx context.Context, symbol string) ([]*exchange.Order, error) {
+	metadata, err := h.svcCtx.LighterCache.GetOrderBookMetadata(ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order book metadata: %w", err)
+	}
+
+	orders, err := h.signer.GetAccountActiveOrders(ctx, uint(metadata.MarketID))
+	if err != nil {
+		return nil, err
+	}
+
+	openOrders := make([]*exchange.Order, 0, len(orders.Orders))
+	for _, item := range orders.Orders {
+		openOrders = append(openOrders, &exchange.Order{
+			Symbol:            symbol,
+			OrderID:           strconv.FormatInt(item.OrderIndex, 10),
+			ClientOrderID:     strconv.FormatInt(item.ClientOrderIndex, 10),
+			Side:              lo.If(item.IsAsk, order.SideSell).Else(order.SideBuy),
+			Price:             item.Price,
+			BaseAmount:        item.InitialBaseAmount,
+			FilledBaseAmount:  item.FilledBaseAmount,
+			FilledQuoteAmount: item.FilledQuoteAmount,
+			Timestamp:         item.Timestamp * 1000,
+			Status:            lighter.ConvertOrderStatus(item.Status),
+		})
+	}
+	return openOrders, nil
+}
+
+// GetFills 获取账户在 since 之后的全部成交记录
+// 成交记录只返回手续费率，手续费 = 成交金额 * 费率 / lighter.FeeRateDenominator
+func (h *LighterOrderHelper) GetFills(ctx context.Context, since time.Time) ([]*exchange.Fill, error) {
+	cursor := ""
+	const limit = 100
+
+	accountIndex := h.signer.GetAccountIndex()
+	trades := make([]*lighter.Trade, 0)
+exit:
+	for {
+		res, err := h.signer.GetAccountTrades(ctx, since.UnixMilli(), cursor, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range res.Trades {
+			if item.Timestamp < since.UnixMilli() {
+				break exit
+			}
+			trades = append(trades, item)
+		}
+
+		cursor = res.NextCursor
+		if cursor == "" || len(res.Trades) < limit {
+			break
+		}
+	}
+
+	fills := make([]*exchange.Fill, 0, len(trades))
+	for _, item := range slices.Backward(trades) {
+		symbol, err := h.svcCtx.LighterCache.GetSymbolByMarketId(ctx, item.MarketID)
+		if err != nil {
+			return nil, err
+		}
+
+		isAsk := item.AskAccountID == accountIndex
+		isMaker := item.IsMakerAsk == isAsk
+		orderId, clientOrderId := item.BidID, item.BidClientID
+		if isAsk {
+			orderId, clientOrderId = item.AskID, item.AskClientID
+		}
+		feeRate := lo.If(isMaker, item.MakerFee).Else(item.TakerFee)
+
+		fills = append(fills, &exchange.Fill{
+			Symbol:        symbol,
+			FillID:        strconv.FormatInt(item.TradeID, 10),
+			OrderID:       strconv.FormatInt(orderId, 10),
+			ClientOrderID: lo.If(clientOrderId > 0, strconv.FormatInt(clientOrderId, 10)).Else(""),
+			Side:          lo.If(isAsk, order.SideSell).Else(order.SideBuy),
+			Price:         item.Price,
+			BaseAmount:    item.Size,
+			QuoteAmount:   item.UsdAmount,
+			Fee:           item.UsdAmount.Mul(decimal.NewFromInt(feeRate)).Div(decimal.NewFromInt(lighter.FeeRateDenominator)),
+			FeeAsset:      "USDC",
+			IsMaker:       isMaker,
+			Timestamp:     item.Timestamp,
+		})
+	}
+	return fills, nil
+}
+
+// GetFundingPayments 获取账户在指定交易对 since 之后的全部资金费用记录
+func (h *LighterOrderHelper) GetFundingPayments(ctx context.Context, symbol string, since time.Time) ([]*exchange.FundingPayment, error) {
+	cursor := ""
+	const limit = 100
+
+	marketId, err := h.svcCtx.LighterCache.GetMarketIdBySymbol(ctx, symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*lighter.PositionFunding, 0)
+exit:
+	for {
+		res, err := h.signer.GetPositionFundings(ctx, marketId, cursor, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range res.PositionFundings {
+			if item.Timestamp < since.Unix() {
+				break exit
+			}
+			items = append(items, item)
+		}
+
+		cursor = res.NextCursor
+		if cursor == "" || len(res.PositionFundings) < limit {
+			break
+		}
+	}
+
+	payments := make([]*exchange.FundingPayment, 0, len(items))
+	for _, item := range slices.Backward(items) {
+		payments = append(payments, &exchange.FundingPayment{
+			Symbol:       symbol,
+			PaymentID:    strconv.FormatInt(item.FundingID, 10),
+			Amount:       item.Change.Neg(),
+			Rate:         item.Rate,
+			PositionSize: lo.If(item.PositionSide == "short", item.PositionSize.Neg()).Else(item.PositionSize),
+			Timestamp:    item.Timestamp * 1000,
+		})
+	}
+	return payments, nil
+}
+
 // ClosePosition 平仓操作
 // 根据指定的持仓方向，平掉全部仓位
 func (h *LighterOrderHelper) ClosePosition(ctx context.Context, symbol string, side Side, slippageBps int) error {
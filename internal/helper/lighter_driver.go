@@ -0,0 +1,125 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/exchange/lighter"
+	"github.com/fachebot/omni-grid-bot/internal/svc"
+	"github.com/shopspring/decimal"
+)
+
+// LighterDriver Lighter交易所驱动
+type LighterDriver struct {
+	svcCtx     *svc.ServiceContext
+	subscriber exchange.Subscriber
+}
+
+// NewLighterDriver 创建Lighter交易所驱动
+func NewLighterDriver(svcCtx *svc.ServiceContext, subscriber *lighter.LighterSubscriber) *LighterDriver {
+	return &LighterDriver{svcCtx: svcCtx, subscriber: NewLighterSubscriberAdapter(svcCtx, subscriber)}
+}
+
+// Name 交易所名称
+func (d *LighterDriver) Name() string {
+	return exchange.Lighter
+}
+
+// NewOrderHelper 创建订单操作客户端
+func (d *LighterDriver) NewOrderHelper(record *ent.Strategy) (exchange.OrderHelper, error) {
+	signer, err := GetLighterClient(d.svcCtx, record)
+	if err != nil {
+		return nil, err
+	}
+	return NewLighterOrderHelper(d.svcCtx, signer), nil
+}
+
+// Subscriber 返回交易所订阅器
+func (d *LighterDriver) Subscriber() exchange.Subscriber {
+	return d.subscriber
+}
+
+// GetMarketMetadata 获取市场元数据
+func (d *LighterDriver) GetMarketMetadata(ctx context.Context, symbol string) (exchange.MarketMetadata, error) {
+	metadata, err := d.svcCtx.LighterCache.GetOrderBookMetadata(ctx, symbol)
+	if err != nil {
+		return exchange.MarketMetadata{}, err
+	}
+
+	ret := exchange.MarketMetadata{
+		MinBaseAmount:          metadata.MinBaseAmount,
+		MinQuoteAmount:         metadata.MinQuoteAmount,
+		SupportedSizeDecimals:  metadata.SupportedSizeDecimals,
+		SupportedPriceDecimals: metadata.SupportedPriceDecimals,
+		SupportedQuoteDecimals: metadata.SupportedQuoteDecimals,
+	}
+	return ret, nil
+}
+
+// GetLastTradePrice 获取最新成交价格
+func (d *LighterDriver) GetLastTradePrice(ctx context.Context, symbol string) (decimal.Decimal, error) {
+	metadata, err := d.svcCtx.LighterCache.GetOrderBookMetadata(ctx, symbol)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return d.svcCtx.LighterClient.GetLastTradePrice(ctx, uint(metadata.MarketID))
+}
+
+// GetAccountInfo 获取账户信息
+func (d *LighterDriver) GetAccountInfo(ctx context.Context, record *ent.Strategy) (*exchange.Account, error) {
+	return GetLighterAccountInfo(ctx, d.svcCtx, record.Account)
+}
+
+// TestConnectivity 测试账户连通性
+func (d *LighterDriver) TestConnectivity(ctx context.Context, record *ent.Strategy) error {
+	signer, err := GetLighterClient(d.svcCtx, record)
+	if err != nil {
+		return err
+	}
+
+	_, err = signer.GetAccountInactiveOrders(ctx, "", 1)
+	return err
+}
+
+// MarketURL 交易对页面链接
+func (d *LighterDriver) MarketURL(symbol string) string {
+	return fmt.Sprintf("https://app.lighter.xyz/trade/%s", symbol)
+}
+
+// LighterSubscriberAdapter Lighter订阅器适配器
+// 将策略记录转换为账户客户端，使 LighterSubscriber 满足 exchange.Subscriber 接口
+type LighterSubscriberAdapter struct {
+	*lighter.LighterSubscriber
+	svcCtx *svc.ServiceContext
+}
+
+// NewLighterSubscriberAdapter 创建Lighter订阅器适配器
+func NewLighterSubscriberAdapter(svcCtx *svc.ServiceContext, subscriber *lighter.LighterSubscriber) *LighterSubscriberAdapter {
+	return &LighterSubscriberAdapter{LighterSubscriber: subscriber, svcCtx: svcCtx}
+}
+
+// Exchange 交易所名称
+func (s *LighterSubscriberAdapter) Exchange() string {
+	return exchange.Lighter
+}
+
+// SubscribeAccountOrders 订阅账户订单
+func (s *LighterSubscriberAdapter) SubscribeAccountOrders(record *ent.Strategy) error {
+	signer, err := GetLighterClient(s.svcCtx, record)
+	if err != nil {
+		return err
+	}
+	return s.LighterSubscriber.SubscribeAccountOrders(signer)
+}
+
+// UnsubscribeAccountOrders 取消订阅账户订单
+func (s *LighterSubscriberAdapter) UnsubscribeAccountOrders(record *ent.Strategy) error {
+	signer, err := GetLighterClient(s.svcCtx, record)
+	if err != nil {
+		return err
+	}
+	return s.LighterSubscriber.UnsubscribeAccountOrders(signer)
+}
@@ -0,0 +1,210 @@
+package helper
+
+import (
+	"context"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/exchange/paper"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/fachebot/omni-grid-bot/internal/svc"
+	"github.com/fachebot/omni-grid-bot/internal/util"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// PaperOrderHelper 模拟交易所订单操作帮助类
+// 实现 OrderHelperInterface 接口，订单提交到本地撮合模拟器
+type PaperOrderHelper struct {
+	svcCtx       *svc.ServiceContext // 服务上下文
+	exchangeName string              // 交易所名称(模拟交易所或回测)
+	simulator    *paper.Simulator    // 撮合模拟器
+	priceFunc    paper.PriceFunc     // 模拟器没有价格时的行情查询函数
+	account      string              // 模拟账户名称
+}
+
+// NewPaperOrderHelper 创建模拟交易所订单操作帮助类实例
+// exchangeName 保存订单时使用的交易所名称，priceFunc 为空时要求模拟器已有行情价格
+func NewPaperOrderHelper(svcCtx *svc.ServiceContext, exchangeName string, simulator *paper.Simulator, priceFunc paper.PriceFunc, account string) *PaperOrderHelper {
+	return &PaperOrderHelper{
+		svcCtx:       svcCtx,
+		exchangeName: exchangeName,
+		simulator:    simulator,
+		priceFunc:    priceFunc,
+		account:      account,
+	}
+}
+
+// UpdateLeverage 更新指定交易对的杠杆倍数和保证金模式
+// 模拟账户统一按全仓计算，杠杆仅影响可用余额
+func (h *PaperOrderHelper) UpdateLeverage(ctx context.Context, symbol string, leverage uint, marginMode exchange.MarginMode) error {
+	h.simulator.SetLeverage(h.account, symbol, leverage)
+	return nil
+}
+
+// CancalAllOrders 取消指定交易对的所有活跃订单
+func (h *PaperOrderHelper) CancalAllOrders(ctx context.Context, symbol string) error {
+	h.simulator.CancelAllOrders(h.account, symbol)
+	return nil
+}
+
+// CancelOrders 取消指定交易对的部分订单
+func (h *PaperOrderHelper) CancelOrders(ctx context.Context, symbol string, orderIds []string) error {
+	h.simulator.CancelOrders(h.account, symbol, orderIds)
+	return nil
+}
+
+// CreateOrderBatch 批量创建订单
+func (h *PaperOrderHelper) CreateOrderBatch(ctx context.Context, limitOrders []CreateLimitOrderParams, marketOrders []CreateMarketOrderParams) ([]string, []string, error) {
+	// 确保存在最新价格，可成交的订单立即成交
+	for _, item := range limitOrders {
+		if err := h.ensurePrice(ctx, item.Symbol); err != nil {
+			return nil, nil, err
+		}
+	}
+	for _, item := range marketOrders {
+		if err := h.ensurePrice(ctx, item.Symbol); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// 提交限价单
+	limitOrderClientIds := make([]string, 0, len(limitOrders))
+	for _, item := range limitOrders {
+		ord, err := h.simulator.PlaceLimitOrder(h.account, item.Symbol, "", item.IsAsk, item.ReduceOnly, item.Price, item.Size)
+		if err != nil {
+			return nil, nil, err
+		}
+		limitOrderClientIds = append(limitOrderClientIds, ord.ClientOrderID)
+	}
+
+	// 提交市价单
+	marketOrderClientIds := make([]string, 0, len(marketOrders))
+	for _, item := range marketOrders {
+		acceptablePrice := item.AcceptableExecutionPrice
+		if acceptablePrice.IsZero() && item.SlippageBps > 0 {
+			acceptablePrice = h.slippagePrice(item.Symbol, item.IsAsk, item.SlippageBps)
+		}
+
+		ord, err := h.simulator.PlaceMarketOrder(h.account, item.Symbol, "", item.IsAsk, item.ReduceOnly, acceptablePrice, item.Size)
+		if err != nil {
+			return nil, nil, err
+		}
+		marketOrderClientIds = append(marketOrderClientIds, ord.ClientOrderID)
+	}
+
+	return limitOrderClientIds, marketOrderClientIds, nil
+}
+
+// CreateLimitOrder 创建限价单
+func (h *PaperOrderHelper) CreateLimitOrder(ctx context.Context, symbol string, isAsk, reduceOnly bool, price, size decimal.Decimal) (string, error) {
+	p := CreateLimitOrderParams{
+		Symbol:     symbol,
+		IsAsk:      isAsk,
+		ReduceOnly: reduceOnly,
+		Price:      price,
+		Size:       size,
+	}
+	clientIds, _, err := h.CreateOrderBatch(ctx, []CreateLimitOrderParams{p}, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return clientIds[0], nil
+}
+
+// SyncUserOrders 同步用户订单数据到本地数据库
+// 模拟器中的挂单和最近完成的订单即为全部订单
+func (h *PaperOrderHelper) SyncUserOrders(ctx context.Context) error {
+	orders := h.simulator.Orders(h.account)
+	logger.Debugf("[PaperOrderHelper] 同步用户订单, account: %s, count: %d", h.account, len(orders))
+
+	return util.Tx(ctx, h.svcCtx.DbClient, func(tx *ent.Tx) error {
+		for _, item := range orders {
+			if err := h.svcCtx.OrderModel.Upsert(ctx, ToPaperEntOrder(h.exchangeName, h.account, item)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetOpenOrders 获取指定交易对的全部活跃订单
+func (h *PaperOrderHelper) GetOpenOrders(ctx context.Context, symbol string) ([]*exchange.Order, error) {
+	return h.simulator.OpenOrders(h.account, symbol), nil
+}
+
+// GetFills 获取账户在 since 之后的全部成交记录
+func (h *PaperOrderHelper) GetFills(ctx context.Context, since time.Time) ([]*exchange.Fill, error) {
+	return h.simulator.Fills(h.account, since), nil
+}
+
+// GetFundingPayments 获取账户在指定交易对 since 之后的全部资金费用记录
+func (h *PaperOrderHelper) GetFundingPayments(ctx context.Context, symbol string, since time.Time) ([]*exchange.FundingPayment, error) {
+	return h.simulator.FundingPayments(h.account, symbol, since), nil
+}
+
+// ClosePosition 平仓操作
+// 根据指定的持仓方向，平掉全部仓位
+func (h *PaperOrderHelper) ClosePosition(ctx context.Context, symbol string, side Side, slippageBps int) error {
+	size := h.simulator.Position(h.account, symbol)
+	if size.IsZero() || (side == LONG) != size.IsPositive() {
+		return nil
+	}
+
+	if err := h.ensurePrice(ctx, symbol); err != nil {
+		return err
+	}
+
+	isAsk := size.IsPositive()
+	price := h.slippagePrice(symbol, isAsk, slippageBps)
+	_, err := h.simulator.PlaceMarketOrder(h.account, symbol, "", isAsk, true, price, size.Abs())
+	if err != nil {
+		logger.Errorf("[PaperOrderHelper] 关闭仓位失败, account: %s, symbol: %s, size: %s, %v", h.account, symbol, size, err)
+	}
+
+	return err
+}
+
+// ensurePrice 模拟器没有交易对价格时从行情来源交易所获取
+func (h *PaperOrderHelper) ensurePrice(ctx context.Context, symbol string) error {
+	if _, ok := h.simulator.LastPrice(symbol); ok {
+		return nil
+	}
+	if h.priceFunc == nil {
+		return paper.ErrNoMarketPrice
+	}
+
+	price, err := h.priceFunc(ctx, symbol)
+	if err != nil {
+		return err
+	}
+	h.simulator.OnPrice(symbol, price)
+	return nil
+}
+
+// slippagePrice 根据最新价格和滑点计算可接受成交价格
+func (h *PaperOrderHelper) slippagePrice(symbol string, isAsk bool, slippageBps int) decimal.Decimal {
+	price, _ := h.simulator.LastPrice(symbol)
+	slippage := price.Mul(decimal.NewFromInt(int64(slippageBps)).Div(decimal.NewFromInt(10000)))
+	return lo.If(isAsk, price.Sub(slippage)).Else(price.Add(slippage))
+}
+
+// ToPaperEntOrder 将模拟器订单转换为 Ent 订单
+func ToPaperEntOrder(exchangeName, account string, item *exchange.Order) ent.Order {
+	return ent.Order{
+		Exchange:          exchangeName,
+		Account:           account,
+		Symbol:            item.Symbol,
+		OrderId:           item.OrderID,
+		ClientOrderId:     item.ClientOrderID,
+		Side:              item.Side,
+		Price:             item.Price,
+		BaseAmount:        item.BaseAmount,
+		FilledBaseAmount:  item.FilledBaseAmount,
+		FilledQuoteAmount: item.FilledQuoteAmount,
+		Status:            item.Status,
+		Timestamp:         item.Timestamp,
+	}
+}
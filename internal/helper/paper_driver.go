@@ -0,0 +1,211 @@
+package helper
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/exchange/paper"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/fachebot/omni-grid-bot/internal/svc"
+	"github.com/shopspring/decimal"
+)
+
+// NewPaperPriceFunc 创建模拟交易所的行情价格查询函数
+// 使用行情来源交易所驱动查询最新价格
+func NewPaperPriceFunc(priceSource string) paper.PriceFunc {
+	return func(ctx context.Context, symbol string) (decimal.Decimal, error) {
+		driver, err := exchange.GetDriver(priceSource)
+		if err != nil {
+			return decimal.Zero, err
+		}
+		return driver.GetLastTradePrice(ctx, symbol)
+	}
+}
+
+// PaperDriver 模拟交易所驱动
+// 市场元数据和行情来自行情来源交易所，订单在本地撮合模拟器中成交，
+// 订单变化同时写入本地数据库，重启后根据数据库中的订单恢复模拟账户
+type PaperDriver struct {
+	svcCtx      *svc.ServiceContext
+	priceSource string
+	priceFunc   paper.PriceFunc
+	simulator   *paper.Simulator
+	subscriber  exchange.Subscriber
+
+	mutex sync.Mutex
+}
+
+// NewPaperDriver 创建模拟交易所驱动
+// priceSource 行情来源交易所名称，simulator 撮合模拟器，subscriber 模拟交易所订阅器
+func NewPaperDriver(svcCtx *svc.ServiceContext, priceSource string, simulator *paper.Simulator, subscriber *paper.PaperSubscriber) *PaperDriver {
+	d := &PaperDriver{
+		svcCtx:      svcCtx,
+		priceSource: priceSource,
+		priceFunc:   NewPaperPriceFunc(priceSource),
+		simulator:   simulator,
+	}
+	d.subscriber = NewPaperSubscriberAdapter(d, subscriber)
+	simulator.AddOrderHandler(d.saveOrders)
+	return d
+}
+
+// Name 交易所名称
+func (d *PaperDriver) Name() string {
+	return exchange.Paper
+}
+
+// NewOrderHelper 创建订单操作客户端
+func (d *PaperDriver) NewOrderHelper(record *ent.Strategy) (exchange.OrderHelper, error) {
+	if err := d.restoreAccount(context.Background(), record.ExchangeApiKey); err != nil {
+		return nil, err
+	}
+	return NewPaperOrderHelper(d.svcCtx, exchange.Paper, d.simulator, d.priceFunc, record.ExchangeApiKey), nil
+}
+
+// Subscriber 返回交易所订阅器
+func (d *PaperDriver) Subscriber() exchange.Subscriber {
+	return d.subscriber
+}
+
+// GetMarketMetadata 获取市场元数据
+// 使用行情来源交易所的市场元数据
+func (d *PaperDriver) GetMarketMetadata(ctx context.Context, symbol string) (exchange.MarketMetadata, error) {
+	driver, err := exchange.GetDriver(d.priceSource)
+	if err != nil {
+		return exchange.MarketMetadata{}, err
+	}
+	return driver.GetMarketMetadata(ctx, symbol)
+}
+
+// GetLastTradePrice 获取最新成交价格
+func (d *PaperDriver) GetLastTradePrice(ctx context.Context, symbol string) (decimal.Decimal, error) {
+	if price, ok := d.simulator.LastPrice(symbol); ok {
+		return price, nil
+	}
+	return d.priceFunc(ctx, symbol)
+}
+
+// GetAccountInfo 获取账户信息
+func (d *PaperDriver) GetAccountInfo(ctx context.Context, record *ent.Strategy) (*exchange.Account, error) {
+	if err := d.restoreAccount(ctx, record.ExchangeApiKey); err != nil {
+		return nil, err
+	}
+
+	account := d.simulator.Account(record.ExchangeApiKey)
+	return &account, nil
+}
+
+// TestConnectivity 测试账户连通性
+// 模拟账户只需要配置账户名称
+func (d *PaperDriver) TestConnectivity(ctx context.Context, record *ent.Strategy) error {
+	if record.ExchangeApiKey == "" {
+		return errors.New("paper account is not configured")
+	}
+	return nil
+}
+
+// MarketURL 交易对页面链接
+// 使用行情来源交易所的页面链接
+func (d *PaperDriver) MarketURL(symbol string) string {
+	driver, err := exchange.GetDriver(d.priceSource)
+	if err != nil {
+		return ""
+	}
+	return driver.MarketURL(symbol)
+}
+
+// GetCandles 获取历史K线
+// 使用行情来源交易所的历史K线
+func (d *PaperDriver) GetCandles(ctx context.Context, symbol string, interval time.Duration, start, end time.Time) ([]exchange.Candle, error) {
+	driver, err := exchange.GetDriver(d.priceSource)
+	if err != nil {
+		return nil, err
+	}
+
+	provider, ok := driver.(exchange.CandleProvider)
+	if !ok {
+		return nil, exchange.ErrExchangeUnsupported
+	}
+	return provider.GetCandles(ctx, symbol, interval, start, end)
+}
+
+// restoreAccount 首次使用模拟账户时，根据数据库中的订单恢复账户状态
+func (d *PaperDriver) restoreAccount(ctx context.Context, account string) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.simulator.HasAccount(account) {
+		return nil
+	}
+
+	records, err := d.svcCtx.OrderModel.FindAllByAccount(ctx, exchange.Paper, account)
+	if err != nil {
+		return err
+	}
+
+	orders := make([]*exchange.Order, 0, len(records))
+	for _, item := range records {
+		orders = append(orders, &exchange.Order{
+			Symbol:            item.Symbol,
+			OrderID:           item.OrderId,
+			ClientOrderID:     item.ClientOrderId,
+			Side:              item.Side,
+			Price:             item.Price,
+			BaseAmount:        item.BaseAmount,
+			FilledBaseAmount:  item.FilledBaseAmount,
+			FilledQuoteAmount: item.FilledQuoteAmount,
+			Timestamp:         item.Timestamp,
+			Status:            item.Status,
+		})
+	}
+	d.simulator.Restore(account, orders)
+
+	logger.Infof("[PaperDriver] 恢复模拟账户, account: %s, orders: %d", account, len(orders))
+	return nil
+}
+
+// saveOrders 模拟器订单更新回调，将订单写入本地数据库
+// 策略停止后的平仓订单不会经过策略引擎，需要在此保存以便重启后恢复持仓
+func (d *PaperDriver) saveOrders(account string, orders []*exchange.Order) {
+	ctx := context.Background()
+	for _, item := range orders {
+		if err := d.svcCtx.OrderModel.Upsert(ctx, ToPaperEntOrder(exchange.Paper, account, item)); err != nil {
+			logger.Errorf("[PaperDriver] 保存模拟订单失败, account: %s, orderId: %s, %v", account, item.OrderID, err)
+		}
+	}
+}
+
+// PaperSubscriberAdapter 模拟交易所订阅器适配器
+// 将策略记录转换为账户名称，使 PaperSubscriber 满足 exchange.Subscriber 接口
+type PaperSubscriberAdapter struct {
+	*paper.PaperSubscriber
+	driver *PaperDriver
+}
+
+// NewPaperSubscriberAdapter 创建模拟交易所订阅器适配器
+func NewPaperSubscriberAdapter(driver *PaperDriver, subscriber *paper.PaperSubscriber) *PaperSubscriberAdapter {
+	return &PaperSubscriberAdapter{PaperSubscriber: subscriber, driver: driver}
+}
+
+// Exchange 交易所名称
+func (s *PaperSubscriberAdapter) Exchange() string {
+	return exchange.Paper
+}
+
+// SubscribeAccountOrders 订阅账户订单
+// 订阅前先恢复模拟账户，保证重启后挂单继续参与撮合
+func (s *PaperSubscriberAdapter) SubscribeAccountOrders(record *ent.Strategy) error {
+	if err := s.driver.restoreAccount(context.Background(), record.ExchangeApiKey); err != nil {
+		return err
+	}
+	return s.PaperSubscriber.SubscribeAccountOrders(record.ExchangeApiKey)
+}
+
+// UnsubscribeAccountOrders 取消订阅账户订单
+func (s *PaperSubscriberAdapter) UnsubscribeAccountOrders(record *ent.Strategy) error {
+	return s.PaperSubscriber.UnsubscribeAccountOrders(record.ExchangeApiKey)
+}
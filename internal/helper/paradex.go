@@ -1,6 +1,7 @@
 package helper
 
 import (
+	"cmp"
 	"context"
 	"slices"
 	"strconv"
@@ -52,6 +53,16 @@ func (h *ParadexOrderHelper) CancalAllOrders(ctx context.Context, symbol string)
 	return h.userClient.CancelAllOpenOrders(ctx, paradex.FormatUsdPerpMarket(symbol))
 }
 
+// CancelOrders 取消指定交易对的部分订单
+func (h *ParadexOrderHelper) CancelOrders(ctx context.Context, symbol string, orderIds []string) error {
+	for _, orderId := range orderIds {
+		if err := h.userClient.CancelOrder(ctx, orderId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // CreateOrderBatch 批量创建订单
 // 支持同时创建限价单和市价单
 func (h *ParadexOrderHelper) CreateOrderBatch(ctx context.Context, limitOrders []CreateLimitOrderParams, marketOrders []CreateMarketOrderParams) ([]string, []string, error) {
@@ -277,6 +288,117 @@ func (h *ParadexOrderHelper) SyncUserOrders(ctx context.Context) error {
 	})
 }
 
+// GetOpenOrders 获取指定交易对的全部活跃订单
+func (h *ParadexOrderHelper) GetOpenOrders(ctx context.Context, symbol string) ([]*exchange.Order, error) {
+	res, err := h.userClient.GetOpenOrders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	market := paradex.FormatUsdPerpMarket(symbol)
+	openOrders := make([]*exchange.Order, 0, len(res.Results))
+	for _, item := range res.Results {
+		if item.Market != market {
+			continue
+		}
+
+		filledBaseAmount := item.Size.Sub(item.RemainingSize)
+		openOrders = append(openOrders, &exchange.Order{
+			Symbol:            symbol,
+			OrderID:           item.ID,
+			ClientOrderID:     item.ClientID,
+			Side:              lo.If(item.Side == paradex.OrderSideSell, order.SideSell).Else(order.SideBuy),
+			Price:             item.Price,
+			BaseAmount:        item.Size,
+			FilledBaseAmount:  filledBaseAmount,
+			FilledQuoteAmount: filledBaseAmount.Mul(item.Price),
+			Timestamp:         item.CreatedAt,
+			Status:            paradex.ConvertOrderStatus(item),
+		})
+	}
+	return openOrders, nil
+}
+
+// GetFills 获取账户在 since 之后的全部成交记录
+func (h *ParadexOrderHelper) GetFills(ctx context.Context, since time.Time) ([]*exchange.Fill, error) {
+	cursor := ""
+	const limit = 100
+
+	fills := make([]*exchange.Fill, 0)
+	for {
+		res, err := h.userClient.ListFills(ctx, &since, cursor, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range res.Results {
+			if item.FillType == paradex.FillTypeTransfer {
+				continue
+			}
+			symbol, err := paradex.ParseUsdPerpMarket(item.Market)
+			if err != nil {
+				continue
+			}
+
+			fills = append(fills, &exchange.Fill{
+				Symbol:        symbol,
+				FillID:        item.ID,
+				OrderID:       item.OrderID,
+				ClientOrderID: item.ClientID,
+				Side:          lo.If(item.Side == paradex.OrderSideSell, order.SideSell).Else(order.SideBuy),
+				Price:         item.Price,
+				BaseAmount:    item.Size,
+				QuoteAmount:   item.Size.Mul(item.Price),
+				Fee:           item.Fee,
+				FeeAsset:      item.FeeCurrency,
+				IsMaker:       item.Liquidity == paradex.LiquidityMaker,
+				Timestamp:     item.CreatedAt,
+			})
+		}
+
+		if res.Next == "" || len(res.Results) < limit {
+			break
+		}
+		cursor = res.Next
+	}
+
+	slices.SortStableFunc(fills, func(a, b *exchange.Fill) int { return cmp.Compare(a.Timestamp, b.Timestamp) })
+	return fills, nil
+}
+
+// GetFundingPayments 获取账户在指定交易对 since 之后的全部资金费用记录
+// Paradex 的资金费用只返回结算金额，不包含资金费率和持仓数量
+func (h *ParadexOrderHelper) GetFundingPayments(ctx context.Context, symbol string, since time.Time) ([]*exchange.FundingPayment, error) {
+	cursor := ""
+	const limit = 100
+
+	market := paradex.FormatUsdPerpMarket(symbol)
+	payments := make([]*exchange.FundingPayment, 0)
+	for {
+		res, err := h.userClient.ListFundingPayments(ctx, market, &since, cursor, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range res.Results {
+			payments = append(payments, &exchange.FundingPayment{
+				Symbol:    symbol,
+				PaymentID: item.ID,
+				Amount:    item.Payment.Neg(),
+				Timestamp: item.CreatedAt,
+			})
+		}
+
+		if res.Next == "" || len(res.Results) < limit {
+			break
+		}
+		cursor = res.Next
+	}
+
+	slices.SortStableFunc(payments, func(a, b *exchange.FundingPayment) int { return cmp.Compare(a.Timestamp, b.Timestamp) })
+	return payments, nil
+}
+
 // ClosePosition 平仓操作
 // 根据指定的持仓方向，平掉全部仓位
 func (h *ParadexOrderHelper) ClosePosition(ctx context.Context, symbol string, side Side, slippageBps int) error {
@@ -0,0 +1,129 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/exchange/paradex"
+	"github.com/fachebot/omni-grid-bot/internal/svc"
+	"github.com/shopspring/decimal"
+)
+
+// ParadexDriver Paradex交易所驱动
+type ParadexDriver struct {
+	svcCtx     *svc.ServiceContext
+	subscriber exchange.Subscriber
+}
+
+// NewParadexDriver 创建Paradex交易所驱动
+func NewParadexDriver(svcCtx *svc.ServiceContext, subscriber *paradex.ParadexSubscriber) *ParadexDriver {
+	return &ParadexDriver{svcCtx: svcCtx, subscriber: NewParadexSubscriberAdapter(svcCtx, subscriber)}
+}
+
+// Name 交易所名称
+func (d *ParadexDriver) Name() string {
+	return exchange.Paradex
+}
+
+// NewOrderHelper 创建订单操作客户端
+func (d *ParadexDriver) NewOrderHelper(record *ent.Strategy) (exchange.OrderHelper, error) {
+	userClient, err := GetParadexClient(d.svcCtx, record)
+	if err != nil {
+		return nil, err
+	}
+	return NewParadexOrderHelper(d.svcCtx, userClient), nil
+}
+
+// Subscriber 返回交易所订阅器
+func (d *ParadexDriver) Subscriber() exchange.Subscriber {
+	return d.subscriber
+}
+
+// GetMarketMetadata 获取市场元数据
+func (d *ParadexDriver) GetMarketMetadata(ctx context.Context, symbol string) (exchange.MarketMetadata, error) {
+	metadata, err := d.svcCtx.ParadexCache.GetMarketMetadata(ctx, paradex.FormatUsdPerpMarket(symbol))
+	if err != nil {
+		return exchange.MarketMetadata{}, err
+	}
+
+	ret := exchange.MarketMetadata{
+		MinBaseAmount:          metadata.OrderSizeIncrement,
+		MinQuoteAmount:         metadata.MinNotional,
+		SupportedSizeDecimals:  uint8(-metadata.OrderSizeIncrement.Exponent()),
+		SupportedPriceDecimals: uint8(-metadata.PriceTickSize.Exponent()),
+		SupportedQuoteDecimals: uint8(-metadata.PriceTickSize.Exponent()),
+	}
+	return ret, nil
+}
+
+// GetLastTradePrice 获取最新成交价格
+func (d *ParadexDriver) GetLastTradePrice(ctx context.Context, symbol string) (decimal.Decimal, error) {
+	marketSummary, err := d.svcCtx.ParadexClient.GetMarketSummary(ctx, paradex.FormatUsdPerpMarket(symbol))
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	if len(marketSummary.Results) == 0 {
+		return decimal.Zero, nil
+	}
+
+	return marketSummary.Results[0].LastTradedPrice, nil
+}
+
+// GetAccountInfo 获取账户信息
+func (d *ParadexDriver) GetAccountInfo(ctx context.Context, record *ent.Strategy) (*exchange.Account, error) {
+	return GetParadexAccountInfo(ctx, d.svcCtx, record)
+}
+
+// TestConnectivity 测试账户连通性
+func (d *ParadexDriver) TestConnectivity(ctx context.Context, record *ent.Strategy) error {
+	userClient, err := GetParadexClient(d.svcCtx, record)
+	if err != nil {
+		return err
+	}
+
+	_, err = userClient.GetAccount(ctx)
+	return err
+}
+
+// MarketURL 交易对页面链接
+func (d *ParadexDriver) MarketURL(symbol string) string {
+	return fmt.Sprintf("https://app.paradex.trade/trade/%s", paradex.FormatUsdPerpMarket(symbol))
+}
+
+// ParadexSubscriberAdapter Paradex订阅器适配器
+// 将策略记录转换为账户客户端，使 ParadexSubscriber 满足 exchange.Subscriber 接口
+type ParadexSubscriberAdapter struct {
+	*paradex.ParadexSubscriber
+	svcCtx *svc.ServiceContext
+}
+
+// NewParadexSubscriberAdapter 创建Paradex订阅器适配器
+func NewParadexSubscriberAdapter(svcCtx *svc.ServiceContext, subscriber *paradex.ParadexSubscriber) *ParadexSubscriberAdapter {
+	return &ParadexSubscriberAdapter{ParadexSubscriber: subscriber, svcCtx: svcCtx}
+}
+
+// Exchange 交易所名称
+func (s *ParadexSubscriberAdapter) Exchange() string {
+	return exchange.Paradex
+}
+
+// SubscribeAccountOrders 订阅账户订单
+func (s *ParadexSubscriberAdapter) SubscribeAccountOrders(record *ent.Strategy) error {
+	userClient, err := GetParadexClient(s.svcCtx, record)
+	if err != nil {
+		return err
+	}
+	return s.ParadexSubscriber.SubscribeAccountOrders(userClient)
+}
+
+// UnsubscribeAccountOrders 取消订阅账户订单
+func (s *ParadexSubscriberAdapter) UnsubscribeAccountOrders(record *ent.Strategy) error {
+	userClient, err := GetParadexClient(s.svcCtx, record)
+	if err != nil {
+		return err
+	}
+	return s.ParadexSubscriber.UnsubscribeAccountOrders(userClient)
+}
@@ -0,0 +1,192 @@
+package helper
+
+import (
+	"context"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	entstrategy "github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategyrun"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/fachebot/omni-grid-bot/internal/model"
+	"github.com/fachebot/omni-grid-bot/internal/svc"
+	"github.com/fachebot/omni-grid-bot/internal/util"
+	"github.com/shopspring/decimal"
+)
+
+// NewStrategyRun 根据策略当前的配置生成运行记录
+func NewStrategyRun(record *ent.Strategy, startTime time.Time) ent.StrategyRun {
+	return ent.StrategyRun{
+		StrategyId:       record.GUID,
+		Owner:            record.Owner,
+		Exchange:         record.Exchange,
+		Symbol:           record.Symbol,
+		Account:          record.Account,
+		Mode:             strategyrun.Mode(record.Mode),
+		PriceUpper:       record.PriceUpper,
+		PriceLower:       record.PriceLower,
+		GridNum:          record.GridNum,
+		Leverage:         record.Leverage,
+		InitialOrderSize: record.InitialOrderSize,
+		StartTime:        startTime,
+	}
+}
+
+// DeactivateStrategy 结束策略本次运行并更新策略状态为inactive
+// 1. 统计本次运行的匹配交易、已实现利润、手续费、资金费用和未实现利润
+// 2. 结束运行记录，升级前启动的策略没有运行记录时补建一条
+// 3. 将网格、匹配交易和资金费用记录归档到运行记录，不再删除
+func DeactivateStrategy(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy, reason strategyrun.StopReason) error {
+	now := time.Now()
+	args := RunStatistics(ctx, svcCtx, record)
+	args.EndTime = &now
+	args.StopReason = &reason
+
+	return util.Tx(ctx, svcCtx.DbClient, func(tx *ent.Tx) error {
+		runModel := model.NewStrategyRunModel(tx.StrategyRun)
+		run, err := runModel.FindRunning(ctx, record.GUID)
+		if ent.IsNotFound(err) {
+			startTime := now
+			if record.StartTime != nil {
+				startTime = *record.StartTime
+			}
+			run, err = runModel.Create(ctx, NewStrategyRun(record, startTime))
+		}
+		if err != nil {
+			return err
+		}
+
+		if err = CloseStrategyRun(ctx, tx, record.GUID, run.ID, args); err != nil {
+			return err
+		}
+
+		return model.NewStrategyModel(tx.Strategy).UpdateStatus(ctx, record.ID, entstrategy.StatusInactive)
+	})
+}
+
+// CloseStrategyRun 在事务中结束运行记录，并将策略的网格、匹配交易和资金费用记录归档到运行记录
+func CloseStrategyRun(ctx context.Context, tx *ent.Tx, strategyId string, runId int, args ent.StrategyRun) error {
+	err := model.NewStrategyRunModel(tx.StrategyRun).Close(ctx, runId, args)
+	if err != nil {
+		return err
+	}
+
+	err = model.NewGridModel(tx.Grid).ArchiveByStrategyId(ctx, strategyId, runId)
+	if err != nil {
+		return err
+	}
+
+	err = model.NewMatchedTradeModel(tx.MatchedTrade).ArchiveByStrategyId(ctx, strategyId, runId)
+	if err != nil {
+		return err
+	}
+
+	return model.NewFundingPaymentModel(tx.FundingPayment).ArchiveByStrategyId(ctx, strategyId, runId)
+}
+
+// RunStatistics 统计策略本次运行的收益
+// 查询失败的项目留空，未实现利润按最新价格估算，获取价格失败时留空且不计算总利润
+func RunStatistics(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy) ent.StrategyRun {
+	var args ent.StrategyRun
+
+	count, err := svcCtx.MatchedTradeModel.QueryMatchedCount(ctx, record.GUID)
+	if err != nil {
+		logger.Warnf("[DeactivateStrategy] 查询匹配交易数量失败, id: %s, %v", record.GUID, err)
+	}
+	args.MatchedTrades = count
+
+	profit, err := QueryRealizedProfit(ctx, svcCtx, record)
+	if err != nil {
+		logger.Warnf("[DeactivateStrategy] 查询已实现利润失败, id: %s, %v", record.GUID, err)
+		return args
+	}
+	args.RealizedProfit = &profit.RealizedProfit
+	args.Fee = &profit.Fee
+	args.Funding = &profit.Funding
+
+	lastPrice, err := GetLastTradePrice(ctx, svcCtx, record.Exchange, record.Symbol)
+	if err != nil {
+		logger.Warnf("[DeactivateStrategy] 获取最新价格失败, id: %s, %v", record.GUID, err)
+		return args
+	}
+	profit.UnrealizedProfit, err = UnrealizedProfit(ctx, svcCtx, record, lastPrice)
+	if err != nil {
+		logger.Warnf("[DeactivateStrategy] 计算未实现利润失败, id: %s, %v", record.GUID, err)
+		return args
+	}
+	args.UnrealizedProfit = &profit.UnrealizedProfit
+
+	totalPnl := profit.Total()
+	args.TotalProfit = &totalPnl
+
+	return args
+}
+
+// StrategyProfit 策略本次运行的收益构成
+type StrategyProfit struct {
+	RealizedProfit   decimal.Decimal // 已实现利润
+	Fee              decimal.Decimal // 已结算手续费
+	Funding          decimal.Decimal // 资金费用，收取时为负数
+	UnrealizedProfit decimal.Decimal // 未平仓匹配交易的浮动盈亏
+}
+
+// Total 总利润 = 已实现利润 - 已结算手续费 - 资金费用 + 未平仓匹配交易的浮动盈亏，与策略详情页一致
+func (p StrategyProfit) Total() decimal.Decimal {
+	return p.RealizedProfit.Sub(p.Fee).Sub(p.Funding).Add(p.UnrealizedProfit)
+}
+
+// QueryStrategyProfit 按指定价格计算策略本次运行的收益
+func QueryStrategyProfit(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy, price decimal.Decimal) (StrategyProfit, error) {
+	profit, err := QueryRealizedProfit(ctx, svcCtx, record)
+	if err != nil {
+		return StrategyProfit{}, err
+	}
+
+	profit.UnrealizedProfit, err = UnrealizedProfit(ctx, svcCtx, record, price)
+	if err != nil {
+		return StrategyProfit{}, err
+	}
+	return profit, nil
+}
+
+// QueryRealizedProfit 查询策略本次运行的已实现利润、已结算手续费和资金费用，不包括浮动盈亏
+func QueryRealizedProfit(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy) (StrategyProfit, error) {
+	var profit StrategyProfit
+	var err error
+
+	profit.RealizedProfit, err = svcCtx.MatchedTradeModel.QueryTotalProfit(ctx, record.GUID)
+	if err != nil {
+		return StrategyProfit{}, err
+	}
+
+	profit.Fee, err = svcCtx.MatchedTradeModel.QueryTotalFee(ctx, record.GUID)
+	if err != nil {
+		return StrategyProfit{}, err
+	}
+
+	profit.Funding, _, err = svcCtx.FundingPaymentModel.QueryTotalAmount(ctx, record.GUID)
+	if err != nil {
+		return StrategyProfit{}, err
+	}
+	return profit, nil
+}
+
+// UnrealizedProfit 按指定价格计算策略未平仓匹配交易的浮动盈亏
+func UnrealizedProfit(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy, price decimal.Decimal) (decimal.Decimal, error) {
+	pnl := decimal.Zero
+	if record.Mode != entstrategy.ModeShort {
+		size, cost, err := svcCtx.MatchedTradeModel.QueryOpeLongPositionAndCost(ctx, record.GUID)
+		if err != nil {
+			return decimal.Zero, err
+		}
+		pnl = pnl.Add(size.Mul(price).Sub(cost))
+	}
+	if record.Mode != entstrategy.ModeLong {
+		size, cost, err := svcCtx.MatchedTradeModel.QueryOpenShortPositionAndCost(ctx, record.GUID)
+		if err != nil {
+			return decimal.Zero, err
+		}
+		pnl = pnl.Add(cost.Sub(size.Mul(price)))
+	}
+	return pnl, nil
+}
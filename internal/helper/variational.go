@@ -2,6 +2,7 @@ package helper
 
 import (
 	"context"
+	"slices"
 	"strconv"
 	"time"
 
@@ -51,20 +52,40 @@ func (h *VariationalOrderHelper) UpdateLeverage(ctx context.Context, symbol stri
 // CancalAllOrders 取消所有活跃订单
 // Variational需要逐个查询并取消订单
 func (h *VariationalOrderHelper) CancalAllOrders(ctx context.Context, symbol string) error {
+	// 查询订单列表
+	pendingOrders, err := h.queryPendingOrders(ctx)
+	if err != nil {
+		return err
+	}
+
+	// 逐个取消订单(Variational接口限制)
+	errorList := make([]error, 0)
+	for _, item := range pendingOrders {
+		err := h.userClient.CancelOrder(ctx, item.RfqID)
+		if err != nil {
+			errorList = append(errorList, err)
+			logger.Warnf("[VariationalOrderHelper] 取消订单失败, account: %s, rfqId: %s, %v", h.userClient.EthAccount(), item.RfqID, err)
+		}
+	}
+
+	if len(errorList) > 0 {
+		return errorList[0]
+	}
+	return nil
+}
+
+// queryPendingOrders 分页查询全部交易对的待成交订单
+func (h *VariationalOrderHelper) queryPendingOrders(ctx context.Context) ([]*variational.Order, error) {
 	const limit = 100
 
-	// 查询订单列表
 	offset := 0
-	pendingOrders := make([]string, 0)
+	pendingOrders := make([]*variational.Order, 0)
 	for {
 		res, err := h.userClient.GetUserPendingOrders(ctx, offset, limit)
 		if err != nil {
-			return err
-		}
-
-		for _, item := range res.Result {
-			pendingOrders = append(pendingOrders, item.RfqID)
+			return nil, err
 		}
+		pendingOrders = append(pendingOrders, res.Result...)
 
 		if res.Pagination.NextPage == nil {
 			break
@@ -75,20 +96,18 @@ func (h *VariationalOrderHelper) CancalAllOrders(ctx context.Context, symbol str
 		}
 		offset = int(n)
 	}
+	return pendingOrders, nil
+}
 
-	// 逐个取消订单(Variational接口限制)
-	errorList := make([]error, 0)
-	for _, rfqId := range pendingOrders {
-		err := h.userClient.CancelOrder(ctx, rfqId)
-		if err != nil {
-			errorList = append(errorList, err)
+// CancelOrders 取消指定交易对的部分订单
+// Variational 的订单ID即 rfqId，只能逐个取消
+func (h *VariationalOrderHelper) CancelOrders(ctx context.Context, symbol string, orderIds []string) error {
+	for _, rfqId := range orderIds {
+		if err := h.userClient.CancelOrder(ctx, rfqId); err != nil {
 			logger.Warnf("[VariationalOrderHelper] 取消订单失败, account: %s, rfqId: %s, %v", h.userClient.EthAccount(), rfqId, err)
+			return err
 		}
 	}
-
-	if len(errorList) > 0 {
-		return errorList[0]
-	}
 	return nil
 }
 
@@ -247,6 +266,83 @@ exit:
 	})
 }
 
+// GetOpenOrders 获取指定交易对的全部活跃订单
+// Variational 的订单ID和客户端订单ID均为 rfqId
+func (h *VariationalOrderHelper) GetOpenOrders(ctx context.Context, symbol string) ([]*exchange.Order, error) {
+	pendingOrders, err := h.queryPendingOrders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	openOrders := make([]*exchange.Order, 0, len(pendingOrders))
+	for _, item := range pendingOrders {
+		if item.Instrument.Underlying != symbol {
+			continue
+		}
+		openOrders = append(openOrders, &exchange.Order{
+			Symbol:        symbol,
+			OrderID:       item.RfqID,
+			ClientOrderID: item.RfqID,
+			Side:          lo.If(item.Side == variational.OrderSideSell, order.SideSell).Else(order.SideBuy),
+			Price:         lo.FromPtr(item.LimitPrice),
+			BaseAmount:    item.Qty,
+			Timestamp:     item.CreatedAt.UnixMilli(),
+			Status:        variational.ConvertOrderStatus(item),
+		})
+	}
+	return openOrders, nil
+}
+
+// GetFills 获取账户在 since 之后的全部成交记录
+// Variational 按报价成交，成交记录的 rfqId 即为订单ID和客户端订单ID
+func (h *VariationalOrderHelper) GetFills(ctx context.Context, since time.Time) ([]*exchange.Fill, error) {
+	offset := 0
+	const limit = 100
+
+	trades := make([]*variational.Trade, 0)
+exit:
+	for {
+		res, err := h.userClient.GetUserTrades(ctx, offset, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range res.Result {
+			if item.CreatedAt.Before(since) {
+				break exit
+			}
+			trades = append(trades, item)
+		}
+
+		if res.Pagination.NextPage == nil {
+			break
+		}
+		n, err := strconv.ParseInt(res.Pagination.NextPage.Offset, 10, 64)
+		if err != nil {
+			break
+		}
+		offset = int(n)
+	}
+
+	fills := make([]*exchange.Fill, 0, len(trades))
+	for _, item := range slices.Backward(trades) {
+		fills = append(fills, &exchange.Fill{
+			Symbol:        item.Instrument.Underlying,
+			FillID:        item.ID,
+			OrderID:       item.RfqID,
+			ClientOrderID: item.RfqID,
+			Side:          lo.If(item.Side == variational.OrderSideSell, order.SideSell).Else(order.SideBuy),
+			Price:         item.Price,
+			BaseAmount:    item.Qty,
+			QuoteAmount:   item.Qty.Mul(item.Price),
+			Fee:           lo.FromPtr(item.Fee),
+			FeeAsset:      item.Instrument.SettlementAsset,
+			Timestamp:     item.CreatedAt.UnixMilli(),
+		})
+	}
+	return fills, nil
+}
+
 // ClosePosition 平仓操作
 // 根据指定的持仓方向，平掉全部仓位
 func (h *VariationalOrderHelper) ClosePosition(ctx context.Context, symbol string, side Side, slippageBps int) error {
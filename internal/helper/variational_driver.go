@@ -0,0 +1,134 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/exchange/variational"
+	"github.com/fachebot/omni-grid-bot/internal/svc"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// VariationalDriver Variational交易所驱动
+type VariationalDriver struct {
+	svcCtx     *svc.ServiceContext
+	subscriber exchange.Subscriber
+}
+
+// NewVariationalDriver 创建Variational交易所驱动
+func NewVariationalDriver(svcCtx *svc.ServiceContext, subscriber *variational.VariationalSubscriber) *VariationalDriver {
+	return &VariationalDriver{svcCtx: svcCtx, subscriber: NewVariationalSubscriberAdapter(svcCtx, subscriber)}
+}
+
+// Name 交易所名称
+func (d *VariationalDriver) Name() string {
+	return exchange.Variational
+}
+
+// NewOrderHelper 创建订单操作客户端
+func (d *VariationalDriver) NewOrderHelper(record *ent.Strategy) (exchange.OrderHelper, error) {
+	userClient, err := GetVariationalClient(d.svcCtx, record)
+	if err != nil {
+		return nil, err
+	}
+	return NewVariationalOrderHelper(d.svcCtx, userClient), nil
+}
+
+// Subscriber 返回交易所订阅器
+func (d *VariationalDriver) Subscriber() exchange.Subscriber {
+	return d.subscriber
+}
+
+// GetMarketMetadata 获取市场元数据
+func (d *VariationalDriver) GetMarketMetadata(ctx context.Context, symbol string) (exchange.MarketMetadata, error) {
+	quote, err := d.svcCtx.VariationalClient.SimpleQuote(ctx, symbol, decimal.NewFromFloat(0.0001))
+	if err != nil {
+		return exchange.MarketMetadata{}, err
+	}
+
+	minBaseAmount := quote.QtyLimits.Ask.MinQty
+	if minBaseAmount.LessThan(quote.QtyLimits.Bid.MinQty) {
+		minBaseAmount = quote.QtyLimits.Bid.MinQty
+	}
+
+	askPriceDecimals := uint8(-quote.Ask.Exponent())
+	bidPriceDecimals := uint8(-quote.Bid.Exponent())
+	supportedPriceDecimals := lo.Max([]uint8{askPriceDecimals, bidPriceDecimals})
+
+	ret := exchange.MarketMetadata{
+		MinBaseAmount:          minBaseAmount,
+		MinQuoteAmount:         decimal.Zero,
+		SupportedSizeDecimals:  uint8(-minBaseAmount.Exponent()),
+		SupportedPriceDecimals: supportedPriceDecimals,
+		SupportedQuoteDecimals: supportedPriceDecimals,
+	}
+	return ret, nil
+}
+
+// GetLastTradePrice 获取最新成交价格
+func (d *VariationalDriver) GetLastTradePrice(ctx context.Context, symbol string) (decimal.Decimal, error) {
+	quote, err := d.svcCtx.VariationalClient.SimpleQuote(ctx, symbol, decimal.NewFromFloat(0.0001))
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return quote.Ask, nil
+}
+
+// GetAccountInfo 获取账户信息
+func (d *VariationalDriver) GetAccountInfo(ctx context.Context, record *ent.Strategy) (*exchange.Account, error) {
+	return GetVariationalAccountInfo(ctx, d.svcCtx, record)
+}
+
+// TestConnectivity 测试账户连通性
+func (d *VariationalDriver) TestConnectivity(ctx context.Context, record *ent.Strategy) error {
+	userClient, err := GetVariationalClient(d.svcCtx, record)
+	if err != nil {
+		return err
+	}
+
+	_, err = userClient.GetPortfolio(ctx, true)
+	return err
+}
+
+// MarketURL 交易对页面链接
+func (d *VariationalDriver) MarketURL(symbol string) string {
+	return fmt.Sprintf("https://omni.variational.io/perpetual/%s", symbol)
+}
+
+// VariationalSubscriberAdapter Variational订阅器适配器
+// 将策略记录转换为账户客户端，使 VariationalSubscriber 满足 exchange.Subscriber 接口
+type VariationalSubscriberAdapter struct {
+	*variational.VariationalSubscriber
+	svcCtx *svc.ServiceContext
+}
+
+// NewVariationalSubscriberAdapter 创建Variational订阅器适配器
+func NewVariationalSubscriberAdapter(svcCtx *svc.ServiceContext, subscriber *variational.VariationalSubscriber) *VariationalSubscriberAdapter {
+	return &VariationalSubscriberAdapter{VariationalSubscriber: subscriber, svcCtx: svcCtx}
+}
+
+// Exchange 交易所名称
+func (s *VariationalSubscriberAdapter) Exchange() string {
+	return exchange.Variational
+}
+
+// SubscribeAccountOrders 订阅账户订单
+func (s *VariationalSubscriberAdapter) SubscribeAccountOrders(record *ent.Strategy) error {
+	userClient, err := GetVariationalClient(s.svcCtx, record)
+	if err != nil {
+		return err
+	}
+	return s.VariationalSubscriber.SubscribeAccountOrders(userClient)
+}
+
+// UnsubscribeAccountOrders 取消订阅账户订单
+func (s *VariationalSubscriberAdapter) UnsubscribeAccountOrders(record *ent.Strategy) error {
+	userClient, err := GetVariationalClient(s.svcCtx, record)
+	if err != nil {
+		return err
+	}
+	return s.VariationalSubscriber.UnsubscribeAccountOrders(userClient)
+}
@@ -0,0 +1,89 @@
+package model
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/exchangeaccount"
+)
+
+type ExchangeAccountModel struct {
+	client *ent.ExchangeAccountClient
+}
+
+func NewExchangeAccountModel(client *ent.ExchangeAccountClient) *ExchangeAccountModel {
+	return &ExchangeAccountModel{client: client}
+}
+
+func (m *ExchangeAccountModel) Save(ctx context.Context, args ent.ExchangeAccount) (*ent.ExchangeAccount, error) {
+	return m.client.Create().
+		SetOwner(args.Owner).
+		SetExchange(args.Exchange).
+		SetLabel(args.Label).
+		SetAccount(args.Account).
+		SetSecretKey(args.SecretKey).
+		SetPassphrase(args.Passphrase).
+		SetTestnet(args.Testnet).
+		Save(ctx)
+}
+
+func (m *ExchangeAccountModel) FindOne(ctx context.Context, id int) (*ent.ExchangeAccount, error) {
+	return m.client.Get(ctx, id)
+}
+
+func (m *ExchangeAccountModel) FindAllByOwner(ctx context.Context, owner int64, offset, limit int) ([]*ent.ExchangeAccount, int, error) {
+	q := m.client.Query().Where(exchangeaccount.OwnerEQ(owner))
+	count, err := q.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	data, err := q.Order(exchangeaccount.ByID(sql.OrderDesc())).Offset(offset).Limit(limit).All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return data, count, nil
+}
+
+func (m *ExchangeAccountModel) FindAll(ctx context.Context) ([]*ent.ExchangeAccount, error) {
+	return m.client.Query().Order(exchangeaccount.ByID()).All(ctx)
+}
+
+func (m *ExchangeAccountModel) UpdateLabel(ctx context.Context, id int, newValue string) error {
+	return m.client.UpdateOneID(id).SetLabel(newValue).Exec(ctx)
+}
+
+// UpdateAccount 修改账户标识，更换账户后原有的凭证不再可用，同时清空全部凭证
+func (m *ExchangeAccountModel) UpdateAccount(ctx context.Context, id int, newValue string) error {
+	return m.client.UpdateOneID(id).
+		SetAccount(newValue).
+		SetSecretKey("").
+		SetPassphrase("").
+		Exec(ctx)
+}
+
+func (m *ExchangeAccountModel) UpdateSecretKey(ctx context.Context, id int, newValue string) error {
+	return m.client.UpdateOneID(id).SetSecretKey(newValue).Exec(ctx)
+}
+
+func (m *ExchangeAccountModel) UpdatePassphrase(ctx context.Context, id int, newValue string) error {
+	return m.client.UpdateOneID(id).SetPassphrase(newValue).Exec(ctx)
+}
+
+func (m *ExchangeAccountModel) UpdateTestnet(ctx context.Context, id int, newValue bool) error {
+	return m.client.UpdateOneID(id).SetTestnet(newValue).Exec(ctx)
+}
+
+// UpdateCredentials 重新写入交易所凭证，凭证字段写入时使用当前主密钥加密
+func (m *ExchangeAccountModel) UpdateCredentials(ctx context.Context, id int, secretKey, passphrase string) error {
+	return m.client.UpdateOneID(id).
+		SetSecretKey(secretKey).
+		SetPassphrase(passphrase).
+		Exec(ctx)
+}
+
+func (m *ExchangeAccountModel) Delete(ctx context.Context, id int) error {
+	return m.client.DeleteOneID(id).Exec(ctx)
+}
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/fill"
+)
+
+type FillModel struct {
+	client *ent.FillClient
+}
+
+func NewFillModel(client *ent.FillClient) *FillModel {
+	return &FillModel{client: client}
+}
+
+// Upsert 保存成交记录，成交记录不会变化，已存在时直接忽略
+func (m *FillModel) Upsert(ctx context.Context, args ent.Fill) error {
+	exist, err := m.client.Query().
+		Where(fill.ExchangeEQ(args.Exchange), fill.AccountEQ(args.Account), fill.FillIdEQ(args.FillId)).
+		Exist(ctx)
+	if err != nil || exist {
+		return err
+	}
+
+	return m.client.Create().
+		SetExchange(args.Exchange).
+		SetAccount(args.Account).
+		SetSymbol(args.Symbol).
+		SetFillId(args.FillId).
+		SetOrderId(args.OrderId).
+		SetNillableClientOrderId(args.ClientOrderId).
+		SetSide(args.Side).
+		SetPrice(args.Price).
+		SetBaseAmount(args.BaseAmount).
+		SetQuoteAmount(args.QuoteAmount).
+		SetFee(args.Fee).
+		SetFeeAsset(args.FeeAsset).
+		SetIsMaker(args.IsMaker).
+		SetTimestamp(args.Timestamp).
+		Exec(ctx)
+}
+
+// QueryLastTimestamp 查询账户最近一笔成交的时间戳，没有成交记录时返回0
+func (m *FillModel) QueryLastTimestamp(ctx context.Context, exchange, account string) (int64, error) {
+	r, err := m.client.Query().
+		Where(fill.ExchangeEQ(exchange), fill.AccountEQ(account)).
+		Order(fill.ByTimestamp(sql.OrderDesc())).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return r.Timestamp, nil
+}
+
+// FindAllByOrderIds 查询账户指定订单的全部成交记录
+func (m *FillModel) FindAllByOrderIds(ctx context.Context, exchange, account string, orderIds []string) ([]*ent.Fill, error) {
+	if len(orderIds) == 0 {
+		return nil, nil
+	}
+
+	return m.client.Query().
+		Where(fill.ExchangeEQ(exchange), fill.AccountEQ(account), fill.OrderIdIn(orderIds...)).
+		Order(ent.Asc(fill.FieldTimestamp)).
+		All(ctx)
+}
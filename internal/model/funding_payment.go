@@ -0,0 +1,84 @@
+package model
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/fundingpayment"
+	"github.com/shopspring/decimal"
+)
+
+type FundingPaymentModel struct {
+	client *ent.FundingPaymentClient
+}
+
+func NewFundingPaymentModel(client *ent.FundingPaymentClient) *FundingPaymentModel {
+	return &FundingPaymentModel{client: client}
+}
+
+// Upsert 保存资金费用记录，资金费用记录不会变化，已存在时直接忽略
+// 同一笔资金费用按策略拆分为多条记录，按 账户 + 资金费用ID + 策略ID 判断是否已存在
+func (m *FundingPaymentModel) Upsert(ctx context.Context, args ent.FundingPayment) error {
+	owner := fundingpayment.StrategyIdIsNil()
+	if args.StrategyId != nil {
+		owner = fundingpayment.StrategyIdEQ(*args.StrategyId)
+	}
+	exist, err := m.client.Query().
+		Where(fundingpayment.ExchangeEQ(args.Exchange), fundingpayment.AccountEQ(args.Account), fundingpayment.PaymentIdEQ(args.PaymentId), owner).
+		Exist(ctx)
+	if err != nil || exist {
+		return err
+	}
+
+	return m.client.Create().
+		SetExchange(args.Exchange).
+		SetAccount(args.Account).
+		SetSymbol(args.Symbol).
+		SetPaymentId(args.PaymentId).
+		SetNillableStrategyId(args.StrategyId).
+		SetAmount(args.Amount).
+		SetRate(args.Rate).
+		SetPositionSize(args.PositionSize).
+		SetTimestamp(args.Timestamp).
+		Exec(ctx)
+}
+
+// QueryLastTimestamp 查询账户在指定交易对最近一笔资金费用的时间戳，没有资金费用记录时返回0
+func (m *FundingPaymentModel) QueryLastTimestamp(ctx context.Context, exchange, account, symbol string) (int64, error) {
+	r, err := m.client.Query().
+		Where(fundingpayment.ExchangeEQ(exchange), fundingpayment.AccountEQ(account), fundingpayment.SymbolEQ(symbol)).
+		Order(fundingpayment.ByTimestamp(sql.OrderDesc())).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return r.Timestamp, nil
+}
+
+// QueryTotalAmount 查询策略本次运行支付的资金费用合计，收取的资金费用为负数
+// 策略没有资金费用记录时 ok 为 false
+func (m *FundingPaymentModel) QueryTotalAmount(ctx context.Context, strategyId string) (total decimal.Decimal, ok bool, err error) {
+	items, err := m.client.Query().
+		Where(fundingpayment.StrategyIdEQ(strategyId), fundingpayment.RunIdIsNil()).
+		All(ctx)
+	if err != nil {
+		return decimal.Zero, false, err
+	}
+
+	for _, item := range items {
+		total = total.Add(item.Amount)
+	}
+	return total, len(items) > 0, nil
+}
+
+// ArchiveByStrategyId 将策略本次运行的资金费用记录归档到运行记录
+func (m *FundingPaymentModel) ArchiveByStrategyId(ctx context.Context, strategyId string, runId int) error {
+	return m.client.Update().
+		Where(fundingpayment.StrategyIdEQ(strategyId), fundingpayment.RunIdIsNil()).
+		SetRunId(runId).
+		Exec(ctx)
+}
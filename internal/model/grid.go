@@ -39,11 +39,16 @@ func (m *GridModel) CreateBulk(ctx context.Context, items []ent.Grid) error {
 }
 
 func (m *GridModel) FindAllByAccount(ctx context.Context, account string) ([]*ent.Grid, error) {
-	return m.client.Query().Where(grid.AccountEQ(account)).All(ctx)
+	return m.client.Query().Where(grid.AccountEQ(account), grid.RunIdIsNil()).All(ctx)
 }
 
 func (m *GridModel) FindAllByStrategyIdOrderAsc(ctx context.Context, strategyId string) ([]*ent.Grid, error) {
-	return m.client.Query().Where(grid.StrategyIdEQ(strategyId)).Order(grid.ByLevel(sql.OrderAsc())).All(ctx)
+	return m.client.Query().Where(grid.StrategyIdEQ(strategyId), grid.RunIdIsNil()).Order(grid.ByLevel(sql.OrderAsc())).All(ctx)
+}
+
+// FindAllByRunIdOrderAsc 查询已归档的运行记录的网格
+func (m *GridModel) FindAllByRunIdOrderAsc(ctx context.Context, runId int) ([]*ent.Grid, error) {
+	return m.client.Query().Where(grid.RunIdEQ(runId)).Order(grid.ByLevel(sql.OrderAsc())).All(ctx)
 }
 
 func (m *GridModel) UpdateBuyClientOrderId(ctx context.Context, id int, newValue *string, t time.Time) error {
@@ -60,7 +65,18 @@ func (m *GridModel) UpdateSellClientOrderId(ctx context.Context, id int, newValu
 	return m.client.UpdateOneID(id).SetSellClientOrderId(*newValue).SetSellClientOrderTime(t.UnixMilli()).Exec(ctx)
 }
 
+func (m *GridModel) DeleteByIds(ctx context.Context, ids []int) error {
+	_, err := m.client.Delete().Where(grid.IDIn(ids...)).Exec(ctx)
+	return err
+}
+
+// DeleteByStrategyId 删除策略运行中的网格，已归档的网格不受影响
 func (m *GridModel) DeleteByStrategyId(ctx context.Context, strategyId string) error {
-	_, err := m.client.Delete().Where(grid.StrategyIdEQ(strategyId)).Exec(ctx)
+	_, err := m.client.Delete().Where(grid.StrategyIdEQ(strategyId), grid.RunIdIsNil()).Exec(ctx)
 	return err
 }
+
+// ArchiveByStrategyId 将策略运行中的网格归档到运行记录
+func (m *GridModel) ArchiveByStrategyId(ctx context.Context, strategyId string, runId int) error {
+	return m.client.Update().Where(grid.StrategyIdEQ(strategyId), grid.RunIdIsNil()).SetRunId(runId).Exec(ctx)
+}
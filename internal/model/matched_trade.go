@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/fachebot/omni-grid-bot/internal/ent"
 	"github.com/fachebot/omni-grid-bot/internal/ent/matchedtrade"
+	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
 )
 
@@ -26,18 +27,24 @@ func (m *MatchedTradeModel) Create(ctx context.Context, args ent.MatchedTrade) e
 		SetNillableBuyBaseAmount(args.BuyBaseAmount).
 		SetNillableBuyQuoteAmount(args.BuyQuoteAmount).
 		SetNillableBuyOrderTimestamp(args.BuyOrderTimestamp).
+		SetNillableBuyPartialBaseAmount(args.BuyPartialBaseAmount).
+		SetNillableBuyPartialQuoteAmount(args.BuyPartialQuoteAmount).
 		SetNillableSellClientOrderId(args.SellClientOrderId).
 		SetNillableSellBaseAmount(args.SellBaseAmount).
 		SetNillableSellQuoteAmount(args.SellQuoteAmount).
 		SetNillableSellOrderTimestamp(args.SellOrderTimestamp).
+		SetNillableSellPartialBaseAmount(args.SellPartialBaseAmount).
+		SetNillableSellPartialQuoteAmount(args.SellPartialQuoteAmount).
 		SetNillableProfit(args.Profit).
+		SetNillableFee(args.Fee).
+		SetNillableNetProfit(args.NetProfit).
 		Exec(ctx)
 }
 
 func (m *MatchedTradeModel) QueryTotalProfit(ctx context.Context, strategyId string) (decimal.Decimal, error) {
 	var v []struct{ Sum decimal.Decimal }
 	err := m.client.Query().
-		Where(matchedtrade.StrategyIdEQ(strategyId)).
+		Where(matchedtrade.StrategyIdEQ(strategyId), matchedtrade.RunIdIsNil()).
 		Aggregate(ent.Sum(matchedtrade.FieldProfit)).
 		Scan(ctx, &v)
 	if err != nil || len(v) == 0 {
@@ -46,9 +53,29 @@ func (m *MatchedTradeModel) QueryTotalProfit(ctx context.Context, strategyId str
 	return v[0].Sum, nil
 }
 
+// QueryMatchedCount 查询已完成配对的匹配交易数量
+func (m *MatchedTradeModel) QueryMatchedCount(ctx context.Context, strategyId string) (int, error) {
+	return m.client.Query().
+		Where(matchedtrade.StrategyIdEQ(strategyId), matchedtrade.RunIdIsNil(), matchedtrade.ProfitNotNil()).
+		Count(ctx)
+}
+
+// QueryTotalFee 查询已结算手续费的匹配交易的手续费合计
+func (m *MatchedTradeModel) QueryTotalFee(ctx context.Context, strategyId string) (decimal.Decimal, error) {
+	var v []struct{ Sum decimal.Decimal }
+	err := m.client.Query().
+		Where(matchedtrade.StrategyIdEQ(strategyId), matchedtrade.RunIdIsNil(), matchedtrade.FeeNotNil()).
+		Aggregate(ent.Sum(matchedtrade.FieldFee)).
+		Scan(ctx, &v)
+	if err != nil || len(v) == 0 {
+		return decimal.Zero, nil
+	}
+	return v[0].Sum, nil
+}
+
 func (m *MatchedTradeModel) FinAllMatchedTrades(ctx context.Context, strategyId string, offset, limit int) ([]*ent.MatchedTrade, int, error) {
 	q := m.client.Query().
-		Where(matchedtrade.StrategyIdEQ(strategyId), matchedtrade.ProfitNotNil())
+		Where(matchedtrade.StrategyIdEQ(strategyId), matchedtrade.RunIdIsNil(), matchedtrade.ProfitNotNil())
 	count, err := q.Count(ctx)
 	if err != nil {
 		return nil, 0, err
@@ -62,9 +89,11 @@ func (m *MatchedTradeModel) FinAllMatchedTrades(ctx context.Context, strategyId
 	return r, count, nil
 }
 
+// QueryOpeLongPositionAndCost 查询未平仓的多头持仓和成本，扣除平仓卖单已部分成交的数量和对应成本
 func (m *MatchedTradeModel) QueryOpeLongPositionAndCost(ctx context.Context, strategyId string) (position, cost decimal.Decimal, err error) {
 	trades, err := m.client.Query().Where(
 		matchedtrade.StrategyIdEQ(strategyId),
+		matchedtrade.RunIdIsNil(),
 		matchedtrade.BuyOrderTimestampNotNil(),
 		matchedtrade.SellOrderTimestampIsNil(),
 	).All(ctx)
@@ -77,15 +106,18 @@ func (m *MatchedTradeModel) QueryOpeLongPositionAndCost(ctx context.Context, str
 			continue
 		}
 
-		cost = cost.Add(*item.BuyQuoteAmount)
-		position = position.Add(*item.BuyBaseAmount)
+		remaining := remainingPartialRatio(*item.BuyBaseAmount, item.SellPartialBaseAmount)
+		cost = cost.Add(item.BuyQuoteAmount.Mul(remaining))
+		position = position.Add(item.BuyBaseAmount.Mul(remaining))
 	}
 	return position, cost, nil
 }
 
+// QueryOpenShortPositionAndCost 查询未平仓的空头持仓和成本，扣除平仓买单已部分成交的数量和对应成本
 func (m *MatchedTradeModel) QueryOpenShortPositionAndCost(ctx context.Context, strategyId string) (position, cost decimal.Decimal, err error) {
 	trades, err := m.client.Query().Where(
 		matchedtrade.StrategyIdEQ(strategyId),
+		matchedtrade.RunIdIsNil(),
 		matchedtrade.SellOrderTimestampNotNil(),
 		matchedtrade.BuyOrderTimestampIsNil(),
 	).All(ctx)
@@ -98,17 +130,27 @@ func (m *MatchedTradeModel) QueryOpenShortPositionAndCost(ctx context.Context, s
 			continue
 		}
 
-		cost = cost.Add(*item.SellQuoteAmount)
-		position = position.Add(*item.SellBaseAmount)
+		remaining := remainingPartialRatio(*item.SellBaseAmount, item.BuyPartialBaseAmount)
+		cost = cost.Add(item.SellQuoteAmount.Mul(remaining))
+		position = position.Add(item.SellBaseAmount.Mul(remaining))
 	}
 	return position, cost, nil
 }
 
+// remainingPartialRatio 计算开仓数量扣除部分平仓后的剩余比例
+func remainingPartialRatio(openBaseAmount decimal.Decimal, partialBaseAmount *decimal.Decimal) decimal.Decimal {
+	if partialBaseAmount == nil || !openBaseAmount.IsPositive() {
+		return decimal.NewFromInt(1)
+	}
+	return decimal.Max(decimal.Zero, decimal.NewFromInt(1).Sub(partialBaseAmount.Div(openBaseAmount)))
+}
+
 // FindByBuyClientOrderId 根据策略ID和买入订单ID查询匹配交易记录
 func (m *MatchedTradeModel) FindByBuyClientOrderId(ctx context.Context, strategyId, buyClientOrderId string) (*ent.MatchedTrade, error) {
 	return m.client.Query().
 		Where(
 			matchedtrade.StrategyIdEQ(strategyId),
+			matchedtrade.RunIdIsNil(),
 			matchedtrade.BuyClientOrderIdEQ(buyClientOrderId),
 		).
 		First(ctx)
@@ -119,6 +161,7 @@ func (m *MatchedTradeModel) FindBySellClientOrderId(ctx context.Context, strateg
 	return m.client.Query().
 		Where(
 			matchedtrade.StrategyIdEQ(strategyId),
+			matchedtrade.RunIdIsNil(),
 			matchedtrade.SellClientOrderIdEQ(sellClientOrderId),
 		).
 		First(ctx)
@@ -135,6 +178,7 @@ func (m *MatchedTradeModel) UpdateBuyOrderInfo(
 	return m.client.Update().
 		Where(
 			matchedtrade.StrategyIdEQ(strategyId),
+			matchedtrade.RunIdIsNil(),
 			matchedtrade.BuyClientOrderIdEQ(buyClientOrderId),
 		).
 		SetBuyBaseAmount(buyBaseAmount).
@@ -154,6 +198,7 @@ func (m *MatchedTradeModel) UpdateSellOrderInfo(
 	return m.client.Update().
 		Where(
 			matchedtrade.StrategyIdEQ(strategyId),
+			matchedtrade.RunIdIsNil(),
 			matchedtrade.SellClientOrderIdEQ(sellClientOrderId),
 		).
 		SetSellBaseAmount(sellBaseAmount).
@@ -166,6 +211,40 @@ func (m *MatchedTradeModel) UpdateProfit(ctx context.Context, id int, value floa
 	return m.client.UpdateOneID(id).SetProfit(value).Exec(ctx)
 }
 
+// FindAllUnsettled 查询已完成配对但尚未结算手续费的匹配交易，包括策略停止时已归档到运行记录的匹配交易
+func (m *MatchedTradeModel) FindAllUnsettled(ctx context.Context, strategyId string) ([]*ent.MatchedTrade, error) {
+	return m.client.Query().
+		Where(matchedtrade.StrategyIdEQ(strategyId), matchedtrade.ProfitNotNil(), matchedtrade.FeeIsNil()).
+		All(ctx)
+}
+
+// FindAllArchivedUnsettledStrategyIds 查询账户下已归档但尚未结算手续费的匹配交易所属的策略ID
+func (m *MatchedTradeModel) FindAllArchivedUnsettledStrategyIds(ctx context.Context, account string) ([]string, error) {
+	return m.client.Query().
+		Where(matchedtrade.AccountEQ(account), matchedtrade.RunIdNotNil(), matchedtrade.ProfitNotNil(), matchedtrade.FeeIsNil()).
+		Unique(true).
+		Select(matchedtrade.FieldStrategyId).
+		Strings(ctx)
+}
+
+// QueryTotalFeeByRunId 查询归档到运行记录的匹配交易已结算的手续费合计
+func (m *MatchedTradeModel) QueryTotalFeeByRunId(ctx context.Context, runId int) (decimal.Decimal, error) {
+	var v []struct{ Sum decimal.Decimal }
+	err := m.client.Query().
+		Where(matchedtrade.RunIdEQ(runId), matchedtrade.FeeNotNil()).
+		Aggregate(ent.Sum(matchedtrade.FieldFee)).
+		Scan(ctx, &v)
+	if err != nil || len(v) == 0 {
+		return decimal.Zero, err
+	}
+	return v[0].Sum, nil
+}
+
+// UpdateFee 更新匹配交易的手续费和扣除手续费后的净利润
+func (m *MatchedTradeModel) UpdateFee(ctx context.Context, id int, fee, netProfit float64) error {
+	return m.client.UpdateOneID(id).SetFee(fee).SetNetProfit(netProfit).Exec(ctx)
+}
+
 func (m *MatchedTradeModel) UpdateByBuyOrder(
 	ctx context.Context,
 	strategyId string,
@@ -176,7 +255,7 @@ func (m *MatchedTradeModel) UpdateByBuyOrder(
 	sellOrderTimestamp *int64) error {
 
 	return m.client.Update().
-		Where(matchedtrade.StrategyIdEQ(strategyId), matchedtrade.BuyClientOrderIdEQ(buyOrder.ClientOrderId)).
+		Where(matchedtrade.StrategyIdEQ(strategyId), matchedtrade.RunIdIsNil(), matchedtrade.BuyClientOrderIdEQ(buyOrder.ClientOrderId)).
 		SetNillableBuyBaseAmount(&buyOrder.FilledBaseAmount).
 		SetNillableBuyQuoteAmount(&buyOrder.FilledQuoteAmount).
 		SetNillableBuyOrderTimestamp(&buyOrder.Timestamp).
@@ -197,7 +276,7 @@ func (m *MatchedTradeModel) UpdateBySellOrder(
 	buyOrderTimestamp *int64) error {
 
 	return m.client.Update().
-		Where(matchedtrade.StrategyIdEQ(strategyId), matchedtrade.SellClientOrderIdEQ(sellOrder.ClientOrderId)).
+		Where(matchedtrade.StrategyIdEQ(strategyId), matchedtrade.RunIdIsNil(), matchedtrade.SellClientOrderIdEQ(sellOrder.ClientOrderId)).
 		SetNillableSellBaseAmount(&sellOrder.FilledBaseAmount).
 		SetNillableSellQuoteAmount(&sellOrder.FilledQuoteAmount).
 		SetNillableSellOrderTimestamp(&sellOrder.Timestamp).
@@ -208,7 +287,58 @@ func (m *MatchedTradeModel) UpdateBySellOrder(
 		Exec(ctx)
 }
 
-func (m *MatchedTradeModel) DeleteByStrategyId(ctx context.Context, strategyId string) error {
-	_, err := m.client.Delete().Where(matchedtrade.StrategyIdEQ(strategyId)).Exec(ctx)
-	return err
+// ReplaceBuyClientOrderId 替换匹配交易记录关联的买入订单ID，用于重新挂出未成交的平仓买单
+func (m *MatchedTradeModel) ReplaceBuyClientOrderId(ctx context.Context, strategyId, oldValue, newValue string) error {
+	return m.client.Update().
+		Where(matchedtrade.StrategyIdEQ(strategyId), matchedtrade.RunIdIsNil(), matchedtrade.BuyClientOrderIdEQ(oldValue)).
+		SetBuyClientOrderId(newValue).
+		Exec(ctx)
+}
+
+// ReplaceSellClientOrderId 替换匹配交易记录关联的卖出订单ID，用于重新挂出未成交的平仓卖单
+func (m *MatchedTradeModel) ReplaceSellClientOrderId(ctx context.Context, strategyId, oldValue, newValue string) error {
+	return m.client.Update().
+		Where(matchedtrade.StrategyIdEQ(strategyId), matchedtrade.RunIdIsNil(), matchedtrade.SellClientOrderIdEQ(oldValue)).
+		SetSellClientOrderId(newValue).
+		Exec(ctx)
+}
+
+// AddBuyPartialAmount 累加平仓买单被取消前已部分成交的数量和金额，买单重新挂出后成交时合并计入买入信息
+func (m *MatchedTradeModel) AddBuyPartialAmount(ctx context.Context, strategyId, buyClientOrderId string, baseAmount, quoteAmount decimal.Decimal) error {
+	record, err := m.FindByBuyClientOrderId(ctx, strategyId, buyClientOrderId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	return m.client.UpdateOneID(record.ID).
+		SetBuyPartialBaseAmount(lo.FromPtr(record.BuyPartialBaseAmount).Add(baseAmount)).
+		SetBuyPartialQuoteAmount(lo.FromPtr(record.BuyPartialQuoteAmount).Add(quoteAmount)).
+		Exec(ctx)
+}
+
+// AddSellPartialAmount 累加平仓卖单被取消前已部分成交的数量和金额，卖单重新挂出后成交时合并计入卖出信息
+func (m *MatchedTradeModel) AddSellPartialAmount(ctx context.Context, strategyId, sellClientOrderId string, baseAmount, quoteAmount decimal.Decimal) error {
+	record, err := m.FindBySellClientOrderId(ctx, strategyId, sellClientOrderId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	return m.client.UpdateOneID(record.ID).
+		SetSellPartialBaseAmount(lo.FromPtr(record.SellPartialBaseAmount).Add(baseAmount)).
+		SetSellPartialQuoteAmount(lo.FromPtr(record.SellPartialQuoteAmount).Add(quoteAmount)).
+		Exec(ctx)
+}
+
+// ArchiveByStrategyId 将策略运行中的匹配交易归档到运行记录
+func (m *MatchedTradeModel) ArchiveByStrategyId(ctx context.Context, strategyId string, runId int) error {
+	return m.client.Update().
+		Where(matchedtrade.StrategyIdEQ(strategyId), matchedtrade.RunIdIsNil()).
+		SetRunId(runId).
+		Exec(ctx)
 }
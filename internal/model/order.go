@@ -67,6 +67,13 @@ func (m *OrderModel) FindOneByAccountClientOrderId(ctx context.Context, exchange
 		First(ctx)
 }
 
+func (m *OrderModel) FindAllByAccount(ctx context.Context, exchange, account string) ([]*ent.Order, error) {
+	return m.client.Query().
+		Where(order.ExchangeEQ(exchange), order.AccountEQ(account)).
+		Order(ent.Asc(order.FieldTimestamp)).
+		All(ctx)
+}
+
 func (m *OrderModel) FindAllByAccountClientOrderIds(ctx context.Context, exchange, account string, clientOrderIds []string) ([]*ent.Order, error) {
 	if len(clientOrderIds) == 0 {
 		return nil, nil
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/orderrepair"
+)
+
+type OrderRepairModel struct {
+	client *ent.OrderRepairClient
+}
+
+func NewOrderRepairModel(client *ent.OrderRepairClient) *OrderRepairModel {
+	return &OrderRepairModel{client: client}
+}
+
+func (m *OrderRepairModel) Create(ctx context.Context, args ent.OrderRepair) (*ent.OrderRepair, error) {
+	return m.client.Create().
+		SetStrategyId(args.StrategyId).
+		SetExchange(args.Exchange).
+		SetSymbol(args.Symbol).
+		SetAccount(args.Account).
+		SetLevel(args.Level).
+		SetSide(args.Side).
+		SetPrice(args.Price).
+		SetQuantity(args.Quantity).
+		SetCanceledClientOrderId(args.CanceledClientOrderId).
+		SetNillableNewClientOrderId(args.NewClientOrderId).
+		SetAction(args.Action).
+		SetAttempt(args.Attempt).
+		SetNillableReason(args.Reason).
+		Save(ctx)
+}
+
+// QueryLastAttempt 查询订单所在修复链的最近尝试次数
+// 修复链由前一次修复挂出的订单和针对该订单失败的修复尝试组成，订单从未被修复过时返回0
+func (m *OrderRepairModel) QueryLastAttempt(ctx context.Context, strategyId, clientOrderId string) (int, error) {
+	r, err := m.client.Query().
+		Where(
+			orderrepair.StrategyIdEQ(strategyId),
+			orderrepair.Or(
+				orderrepair.CanceledClientOrderIdEQ(clientOrderId),
+				orderrepair.NewClientOrderIdEQ(clientOrderId),
+			),
+		).
+		Order(orderrepair.ByAttempt(sql.OrderDesc())).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return r.Attempt, nil
+}
+
+// FindAllReplaced 查询策略重新挂单成功的修复记录，用于追溯被替换的订单ID
+func (m *OrderRepairModel) FindAllReplaced(ctx context.Context, strategyId string) ([]*ent.OrderRepair, error) {
+	return m.client.Query().
+		Where(orderrepair.StrategyIdEQ(strategyId), orderrepair.NewClientOrderIdNotNil()).
+		All(ctx)
+}
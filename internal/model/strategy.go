@@ -34,10 +34,34 @@ func (m *StrategyModel) Save(ctx context.Context, args ent.Strategy) (*ent.Strat
 		SetGridNum(args.GridNum).
 		SetLeverage(args.Leverage).
 		SetInitialOrderSize(args.InitialOrderSize).
+		SetSizingMode(args.SizingMode).
+		SetNillableSizingFactor(args.SizingFactor).
+		SetNillableSizingWeights(args.SizingWeights).
 		SetNillableSlippageBps(args.SlippageBps).
 		SetNillableEntryPrice(args.EntryPrice).
 		SetNillableTriggerStopLossPrice(args.TriggerStopLossPrice).
 		SetNillableTriggerTakeProfitPrice(args.TriggerTakeProfitPrice).
+		SetNillableMaxLossAmount(args.MaxLossAmount).
+		SetNillableMaxLossPercent(args.MaxLossPercent).
+		SetNillableProfitTargetAmount(args.ProfitTargetAmount).
+		SetNillableTrailingStopLossAmount(args.TrailingStopLossAmount).
+		SetNillableTrailingStopLossPercent(args.TrailingStopLossPercent).
+		SetNillableTrailingTakeProfitActivationPrice(args.TrailingTakeProfitActivationPrice).
+		SetNillableTrailingTakeProfitAmount(args.TrailingTakeProfitAmount).
+		SetNillableTrailingTakeProfitPercent(args.TrailingTakeProfitPercent).
+		SetNillableTrailingLevels(args.TrailingLevels).
+		SetNillableTrailingPriceUpperLimit(args.TrailingPriceUpperLimit).
+		SetNillableTrailingPriceLowerLimit(args.TrailingPriceLowerLimit).
+		SetNillableAtrPeriod(args.AtrPeriod).
+		SetNillableAtrMultiplier(args.AtrMultiplier).
+		SetNillableAdaptiveThreshold(args.AdaptiveThreshold).
+		SetNillableAdaptiveIntervalHours(args.AdaptiveIntervalHours).
+		SetNillableAdaptiveAtr(args.AdaptiveAtr).
+		SetNillableAdaptiveUpdatedAt(args.AdaptiveUpdatedAt).
+		SetCancelRepairPolicy(args.CancelRepairPolicy).
+		SetNillableCancelRepairMaxAttempts(args.CancelRepairMaxAttempts).
+		SetReconcilePolicy(args.ReconcilePolicy).
+		SetNillablePartialFillThreshold(args.PartialFillThreshold).
 		SetEnablePushNotification(args.EnablePushNotification).
 		SetNillableEnablePushMatchedNotification(args.EnablePushMatchedNotification).
 		SetNillableLastLowerThresholdAlertTime(args.LastLowerThresholdAlertTime).
@@ -46,16 +70,26 @@ func (m *StrategyModel) Save(ctx context.Context, args ent.Strategy) (*ent.Strat
 		SetExchangeApiKey(args.ExchangeApiKey).
 		SetExchangeSecretKey(args.ExchangeSecretKey).
 		SetExchangePassphrase(args.ExchangePassphrase).
+		SetNillableAccountId(args.AccountId).
 		SetNillableStartTime(args.StartTime).
 		Save(ctx)
 }
 
+// query 查询策略并加载关联的交易所账户
+func (m *StrategyModel) query() *ent.StrategyQuery {
+	return m.client.Query().WithExchangeAccount()
+}
+
 func (m *StrategyModel) FindOneByGUID(ctx context.Context, guid string) (*ent.Strategy, error) {
-	return m.client.Query().Where(strategy.GUIDEQ(guid)).First(ctx)
+	record, err := m.query().Where(strategy.GUIDEQ(guid)).First(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return BindExchangeAccount(record), nil
 }
 
 func (m *StrategyModel) FindAllByOwner(ctx context.Context, owner int64, offset, limit int) ([]*ent.Strategy, int, error) {
-	q := m.client.Query().Where(strategy.OwnerEQ(owner))
+	q := m.query().Where(strategy.OwnerEQ(owner))
 	count, err := q.Count(ctx)
 	if err != nil {
 		return nil, 0, err
@@ -66,16 +100,25 @@ func (m *StrategyModel) FindAllByOwner(ctx context.Context, owner int64, offset,
 		return nil, 0, err
 	}
 
-	return data, count, nil
+	return bindExchangeAccounts(data), count, nil
 }
 
 func (m *StrategyModel) FindAllByActiveStatus(ctx context.Context, offset, limit int) ([]*ent.Strategy, error) {
-	return m.client.Query().
+	data, err := m.query().
 		Where(strategy.StatusEQ(strategy.StatusActive)).
 		Order(strategy.ByID()).
 		Offset(offset).
 		Limit(limit).
 		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return bindExchangeAccounts(data), nil
+}
+
+// FindAll 查询所有策略，不使用关联的交易所账户覆盖凭证，用于重新加密策略自身保存的凭证
+func (m *StrategyModel) FindAll(ctx context.Context) ([]*ent.Strategy, error) {
+	return m.client.Query().Order(strategy.ByID()).All(ctx)
 }
 
 func (m *StrategyModel) FindAllByExchangeAndAccountAndSymbol(ctx context.Context, exchange, account, symbol string) ([]*ent.Strategy, error) {
@@ -88,7 +131,48 @@ func (m *StrategyModel) FindAllByExchangeAndAccountAndSymbol(ctx context.Context
 		strategy.SymbolEQ(symbol),
 		strategy.AccountEQ(account),
 	}
-	return m.client.Query().Where(ps...).All(ctx)
+	data, err := m.query().Where(ps...).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return bindExchangeAccounts(data), nil
+}
+
+// FindAllActiveByOwner 查询用户所有运行中的策略
+func (m *StrategyModel) FindAllActiveByOwner(ctx context.Context, owner int64) ([]*ent.Strategy, error) {
+	data, err := m.query().
+		Where(strategy.OwnerEQ(owner), strategy.StatusEQ(strategy.StatusActive)).
+		Order(strategy.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return bindExchangeAccounts(data), nil
+}
+
+// FindAllActiveByExchangeAndAccount 查询交易账户所有运行中的策略
+func (m *StrategyModel) FindAllActiveByExchangeAndAccount(ctx context.Context, exchange, account string) ([]*ent.Strategy, error) {
+	if exchange == "" || account == "" {
+		return nil, nil
+	}
+
+	data, err := m.query().
+		Where(strategy.ExchangeEQ(exchange), strategy.AccountEQ(account), strategy.StatusEQ(strategy.StatusActive)).
+		Order(strategy.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return bindExchangeAccounts(data), nil
+}
+
+// FindAllByAccountId 查询关联交易所账户的所有策略
+func (m *StrategyModel) FindAllByAccountId(ctx context.Context, accountId int) ([]*ent.Strategy, error) {
+	data, err := m.query().Where(strategy.AccountIdEQ(accountId)).Order(strategy.ByID()).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return bindExchangeAccounts(data), nil
 }
 
 func (m *StrategyModel) UpdateStatus(ctx context.Context, id int, newValue strategy.Status) error {
@@ -115,6 +199,47 @@ func (m *StrategyModel) UpdateExchangePassphrase(ctx context.Context, id int, ne
 	return m.client.UpdateOneID(id).SetExchangePassphrase(newValue).Exec(ctx)
 }
 
+// UpdateCredentials 重新写入交易所凭证，凭证字段写入时使用当前主密钥加密
+func (m *StrategyModel) UpdateCredentials(ctx context.Context, id int, apiKey, secretKey, passphrase string) error {
+	return m.client.UpdateOneID(id).
+		SetExchangeApiKey(apiKey).
+		SetExchangeSecretKey(secretKey).
+		SetExchangePassphrase(passphrase).
+		Exec(ctx)
+}
+
+// LinkExchangeAccount 关联交易所账户，策略自身保存的凭证清空，使用账户的凭证
+func (m *StrategyModel) LinkExchangeAccount(ctx context.Context, id int, account *ent.ExchangeAccount) error {
+	return m.client.UpdateOneID(id).
+		SetExchange(account.Exchange).
+		SetAccount(account.Account).
+		SetExchangeApiKey("").
+		SetExchangeSecretKey("").
+		SetExchangePassphrase("").
+		SetAccountId(account.ID).
+		Exec(ctx)
+}
+
+// UnlinkExchangeAccount 解除关联交易所账户，账户的凭证复制到策略自身保存
+func (m *StrategyModel) UnlinkExchangeAccount(ctx context.Context, id int, account *ent.ExchangeAccount) error {
+	return m.client.UpdateOneID(id).
+		SetExchangeApiKey(account.Account).
+		SetExchangeSecretKey(account.SecretKey).
+		SetExchangePassphrase(account.Passphrase).
+		SetExchangeTestnet(account.Testnet).
+		ClearAccountId().
+		Exec(ctx)
+}
+
+func (m *StrategyModel) ClearAccountId(ctx context.Context, id int) error {
+	return m.client.UpdateOneID(id).ClearAccountId().Exec(ctx)
+}
+
+// UpdateAccountByAccountId 交易所账户的账户标识修改后，同步更新关联策略的账户标识
+func (m *StrategyModel) UpdateAccountByAccountId(ctx context.Context, accountId int, newValue string) error {
+	return m.client.Update().Where(strategy.AccountIdEQ(accountId)).SetAccount(newValue).Exec(ctx)
+}
+
 func (m *StrategyModel) UpdateGridMode(ctx context.Context, id int, newValue strategy.Mode) error {
 	return m.client.UpdateOneID(id).SetMode(newValue).Exec(ctx)
 }
@@ -155,6 +280,10 @@ func (m *StrategyModel) UpdatePriceUpper(ctx context.Context, id int, newValue d
 	return m.client.UpdateOneID(id).SetPriceUpper(newValue).Exec(ctx)
 }
 
+func (m *StrategyModel) UpdatePriceRange(ctx context.Context, id int, priceLower, priceUpper decimal.Decimal) error {
+	return m.client.UpdateOneID(id).SetPriceLower(priceLower).SetPriceUpper(priceUpper).Exec(ctx)
+}
+
 func (m *StrategyModel) UpdateEnablePushNotification(ctx context.Context, id int, newValue bool) error {
 	return m.client.UpdateOneID(id).SetEnablePushNotification(newValue).Exec(ctx)
 }
@@ -163,6 +292,14 @@ func (m *StrategyModel) UpdateEnablePushMatchedNotification(ctx context.Context,
 	return m.client.UpdateOneID(id).SetEnablePushMatchedNotification(newValue).Exec(ctx)
 }
 
+func (m *StrategyModel) UpdateLastLowerThresholdAlertTime(ctx context.Context, id int, newValue time.Time) error {
+	return m.client.UpdateOneID(id).SetLastLowerThresholdAlertTime(newValue).Exec(ctx)
+}
+
+func (m *StrategyModel) UpdateLastUpperThresholdAlertTime(ctx context.Context, id int, newValue time.Time) error {
+	return m.client.UpdateOneID(id).SetLastUpperThresholdAlertTime(newValue).Exec(ctx)
+}
+
 func (m *StrategyModel) UpdateStartTime(ctx context.Context, id int, newValue time.Time) error {
 	return m.client.UpdateOneID(id).SetStartTime(newValue).Exec(ctx)
 }
@@ -175,10 +312,150 @@ func (m *StrategyModel) UpdateTriggerTakeProfitPrice(ctx context.Context, id int
 	return m.client.UpdateOneID(id).SetTriggerTakeProfitPrice(newValue).Exec(ctx)
 }
 
+func (m *StrategyModel) UpdateMaxLossAmount(ctx context.Context, id int, newValue decimal.Decimal) error {
+	return m.client.UpdateOneID(id).SetMaxLossAmount(newValue).Exec(ctx)
+}
+
+func (m *StrategyModel) UpdateMaxLossPercent(ctx context.Context, id int, newValue decimal.Decimal) error {
+	return m.client.UpdateOneID(id).SetMaxLossPercent(newValue).Exec(ctx)
+}
+
+func (m *StrategyModel) UpdateProfitTargetAmount(ctx context.Context, id int, newValue decimal.Decimal) error {
+	return m.client.UpdateOneID(id).SetProfitTargetAmount(newValue).Exec(ctx)
+}
+
+// UpdateTrailingStopLoss 更新移动止损的回撤距离，amount 和 percent 只保留不为空的一项，都为空时关闭移动止损
+func (m *StrategyModel) UpdateTrailingStopLoss(ctx context.Context, id int, amount, percent *decimal.Decimal) error {
+	update := m.client.UpdateOneID(id)
+	if amount != nil {
+		update.SetTrailingStopLossAmount(*amount)
+	} else {
+		update.ClearTrailingStopLossAmount()
+	}
+	if percent != nil {
+		update.SetTrailingStopLossPercent(*percent)
+	} else {
+		update.ClearTrailingStopLossPercent()
+	}
+	return update.Exec(ctx)
+}
+
+func (m *StrategyModel) UpdateTrailingTakeProfitActivationPrice(ctx context.Context, id int, newValue decimal.Decimal) error {
+	return m.client.UpdateOneID(id).SetTrailingTakeProfitActivationPrice(newValue).Exec(ctx)
+}
+
+// UpdateTrailingTakeProfit 更新移动止盈的回撤距离，amount 和 percent 只保留不为空的一项，都为空时关闭移动止盈
+func (m *StrategyModel) UpdateTrailingTakeProfit(ctx context.Context, id int, amount, percent *decimal.Decimal) error {
+	update := m.client.UpdateOneID(id)
+	if amount != nil {
+		update.SetTrailingTakeProfitAmount(*amount)
+	} else {
+		update.ClearTrailingTakeProfitAmount()
+	}
+	if percent != nil {
+		update.SetTrailingTakeProfitPercent(*percent)
+	} else {
+		update.ClearTrailingTakeProfitPercent()
+	}
+	return update.Exec(ctx)
+}
+
+func (m *StrategyModel) UpdateTrailingExtremePrice(ctx context.Context, id int, newValue decimal.Decimal) error {
+	return m.client.UpdateOneID(id).SetTrailingExtremePrice(newValue).Exec(ctx)
+}
+
+func (m *StrategyModel) ClearTrailingExtremePrice(ctx context.Context, id int) error {
+	return m.client.UpdateOneID(id).ClearTrailingExtremePrice().Exec(ctx)
+}
+
 func (m *StrategyModel) UpdateEntryPrice(ctx context.Context, id int, newValue decimal.Decimal) error {
 	return m.client.UpdateOneID(id).SetEntryPrice(newValue).Exec(ctx)
 }
 
+func (m *StrategyModel) UpdateTrailingLevels(ctx context.Context, id int, newValue int) error {
+	return m.client.UpdateOneID(id).SetTrailingLevels(newValue).Exec(ctx)
+}
+
+func (m *StrategyModel) UpdateTrailingPriceUpperLimit(ctx context.Context, id int, newValue decimal.Decimal) error {
+	return m.client.UpdateOneID(id).SetTrailingPriceUpperLimit(newValue).Exec(ctx)
+}
+
+func (m *StrategyModel) UpdateTrailingPriceLowerLimit(ctx context.Context, id int, newValue decimal.Decimal) error {
+	return m.client.UpdateOneID(id).SetTrailingPriceLowerLimit(newValue).Exec(ctx)
+}
+
+func (m *StrategyModel) UpdateSizingMode(ctx context.Context, id int, newValue strategy.SizingMode) error {
+	return m.client.UpdateOneID(id).SetSizingMode(newValue).Exec(ctx)
+}
+
+func (m *StrategyModel) UpdateSizingFactor(ctx context.Context, id int, newValue decimal.Decimal) error {
+	return m.client.UpdateOneID(id).SetSizingFactor(newValue).Exec(ctx)
+}
+
+func (m *StrategyModel) UpdateSizingWeights(ctx context.Context, id int, newValue string) error {
+	return m.client.UpdateOneID(id).SetSizingWeights(newValue).Exec(ctx)
+}
+
+func (m *StrategyModel) UpdateCancelRepairPolicy(ctx context.Context, id int, newValue strategy.CancelRepairPolicy) error {
+	return m.client.UpdateOneID(id).SetCancelRepairPolicy(newValue).Exec(ctx)
+}
+
+func (m *StrategyModel) UpdateCancelRepairMaxAttempts(ctx context.Context, id int, newValue int) error {
+	return m.client.UpdateOneID(id).SetCancelRepairMaxAttempts(newValue).Exec(ctx)
+}
+
+func (m *StrategyModel) UpdateReconcilePolicy(ctx context.Context, id int, newValue strategy.ReconcilePolicy) error {
+	return m.client.UpdateOneID(id).SetReconcilePolicy(newValue).Exec(ctx)
+}
+
+func (m *StrategyModel) UpdatePartialFillThreshold(ctx context.Context, id int, newValue decimal.Decimal) error {
+	return m.client.UpdateOneID(id).SetPartialFillThreshold(newValue).Exec(ctx)
+}
+
+func (m *StrategyModel) UpdateAtrPeriod(ctx context.Context, id int, newValue int) error {
+	return m.client.UpdateOneID(id).SetAtrPeriod(newValue).Exec(ctx)
+}
+
+func (m *StrategyModel) UpdateAtrMultiplier(ctx context.Context, id int, newValue decimal.Decimal) error {
+	return m.client.UpdateOneID(id).SetAtrMultiplier(newValue).Exec(ctx)
+}
+
+func (m *StrategyModel) UpdateAdaptiveThreshold(ctx context.Context, id int, newValue decimal.Decimal) error {
+	return m.client.UpdateOneID(id).SetAdaptiveThreshold(newValue).Exec(ctx)
+}
+
+func (m *StrategyModel) UpdateAdaptiveIntervalHours(ctx context.Context, id int, newValue int) error {
+	return m.client.UpdateOneID(id).SetAdaptiveIntervalHours(newValue).Exec(ctx)
+}
+
+func (m *StrategyModel) UpdateAdaptiveGrid(ctx context.Context, id int, gridNum int, atr decimal.Decimal, updatedAt time.Time) error {
+	return m.client.UpdateOneID(id).SetGridNum(gridNum).SetAdaptiveAtr(atr).SetAdaptiveUpdatedAt(updatedAt).Exec(ctx)
+}
+
 func (m *StrategyModel) Delete(ctx context.Context, id int) error {
 	return m.client.DeleteOneID(id).Exec(ctx)
 }
+
+// BindExchangeAccount 使用关联的交易所账户覆盖策略的交易所、账户和凭证，没有关联账户时原样返回
+// 与策略自身保存凭证时一致，账户标识同时作为策略的 ExchangeApiKey
+func BindExchangeAccount(record *ent.Strategy) *ent.Strategy {
+	account := record.Edges.ExchangeAccount
+	if account == nil {
+		return record
+	}
+
+	record.Exchange = account.Exchange
+	record.Account = account.Account
+	record.ExchangeApiKey = account.Account
+	record.ExchangeSecretKey = account.SecretKey
+	record.ExchangePassphrase = account.Passphrase
+	record.ExchangeTestnet = account.Testnet
+	return record
+}
+
+func bindExchangeAccounts(records []*ent.Strategy) []*ent.Strategy {
+	for _, record := range records {
+		BindExchangeAccount(record)
+	}
+	return records
+}
@@ -0,0 +1,83 @@
+package model
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategyrun"
+	"github.com/shopspring/decimal"
+)
+
+type StrategyRunModel struct {
+	client *ent.StrategyRunClient
+}
+
+func NewStrategyRunModel(client *ent.StrategyRunClient) *StrategyRunModel {
+	return &StrategyRunModel{client: client}
+}
+
+func (m *StrategyRunModel) Create(ctx context.Context, args ent.StrategyRun) (*ent.StrategyRun, error) {
+	return m.client.Create().
+		SetStrategyId(args.StrategyId).
+		SetOwner(args.Owner).
+		SetExchange(args.Exchange).
+		SetSymbol(args.Symbol).
+		SetAccount(args.Account).
+		SetMode(args.Mode).
+		SetPriceUpper(args.PriceUpper).
+		SetPriceLower(args.PriceLower).
+		SetGridNum(args.GridNum).
+		SetLeverage(args.Leverage).
+		SetInitialOrderSize(args.InitialOrderSize).
+		SetStartTime(args.StartTime).
+		Save(ctx)
+}
+
+// FindRunning 查询策略正在运行的运行记录
+func (m *StrategyRunModel) FindRunning(ctx context.Context, strategyId string) (*ent.StrategyRun, error) {
+	return m.client.Query().
+		Where(strategyrun.StrategyIdEQ(strategyId), strategyrun.StatusEQ(strategyrun.StatusRunning)).
+		Order(strategyrun.ByStartTime(sql.OrderDesc())).
+		First(ctx)
+}
+
+// Close 结束运行记录，记录停止原因和本次运行的收益
+func (m *StrategyRunModel) Close(ctx context.Context, id int, args ent.StrategyRun) error {
+	return m.client.UpdateOneID(id).
+		SetStatus(strategyrun.StatusStopped).
+		SetNillableEndTime(args.EndTime).
+		SetNillableStopReason(args.StopReason).
+		SetMatchedTrades(args.MatchedTrades).
+		SetNillableRealizedProfit(args.RealizedProfit).
+		SetNillableFee(args.Fee).
+		SetNillableFunding(args.Funding).
+		SetNillableUnrealizedProfit(args.UnrealizedProfit).
+		SetNillableTotalProfit(args.TotalProfit).
+		Exec(ctx)
+}
+
+// FindOne 查询运行记录
+func (m *StrategyRunModel) FindOne(ctx context.Context, id int) (*ent.StrategyRun, error) {
+	return m.client.Get(ctx, id)
+}
+
+// UpdateFee 更新运行记录的手续费和总利润
+func (m *StrategyRunModel) UpdateFee(ctx context.Context, id int, fee decimal.Decimal, totalProfit *decimal.Decimal) error {
+	return m.client.UpdateOneID(id).SetFee(fee).SetNillableTotalProfit(totalProfit).Exec(ctx)
+}
+
+// FindAllByStrategyId 分页查询策略的运行记录，按启动时间倒序返回
+func (m *StrategyRunModel) FindAllByStrategyId(ctx context.Context, strategyId string, offset, limit int) ([]*ent.StrategyRun, int, error) {
+	q := m.client.Query().Where(strategyrun.StrategyIdEQ(strategyId))
+	count, err := q.Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	r, err := q.Order(strategyrun.ByStartTime(sql.OrderDesc())).Offset(offset).Limit(limit).All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return r, count, nil
+}
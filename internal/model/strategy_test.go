@@ -0,0 +1,126 @@
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shopspring/decimal"
+)
+
+func newTestClient(t *testing.T, name string) *ent.Client {
+	t.Helper()
+
+	client, err := ent.Open("sqlite3", "file:"+name+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatalf("打开数据库失败: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+	if err = client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("创建数据表失败: %v", err)
+	}
+	return client
+}
+
+func saveTestStrategy(t *testing.T, m *StrategyModel, guid string) *ent.Strategy {
+	t.Helper()
+
+	record, err := m.Save(context.Background(), ent.Strategy{
+		GUID: guid, Owner: 1, Exchange: "paper", Symbol: "BTC", Account: "own", Mode: strategy.ModeLong,
+		MarginMode: strategy.MarginModeCross, QuantityMode: strategy.QuantityModeArithmetic, PriceUpper: decimal.NewFromInt(110),
+		PriceLower: decimal.NewFromInt(90), GridNum: 10, Leverage: 1, InitialOrderSize: decimal.NewFromInt(1),
+		SizingMode: strategy.SizingModeFixed, CancelRepairPolicy: strategy.CancelRepairPolicyRepair,
+		ReconcilePolicy: strategy.ReconcilePolicyAlert, Status: strategy.StatusInactive,
+		ExchangeApiKey: "own", ExchangeSecretKey: "own-secret",
+	})
+	if err != nil {
+		t.Fatalf("保存策略失败: %v", err)
+	}
+	return record
+}
+
+func TestStrategyModelBindExchangeAccount(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "model-exchange-account")
+	strategyModel := NewStrategyModel(client.Strategy)
+	accountModel := NewExchangeAccountModel(client.ExchangeAccount)
+
+	account, err := accountModel.Save(ctx, ent.ExchangeAccount{
+		Owner: 1, Exchange: "hyperliquid", Label: "main", Account: "0xabc", SecretKey: "secret", Passphrase: "pass", Testnet: true,
+	})
+	if err != nil {
+		t.Fatalf("创建交易所账户失败: %v", err)
+	}
+	linked := saveTestStrategy(t, strategyModel, "linked")
+	unlinked := saveTestStrategy(t, strategyModel, "unlinked")
+	if err = strategyModel.LinkExchangeAccount(ctx, linked.ID, account); err != nil {
+		t.Fatalf("关联交易所账户失败: %v", err)
+	}
+
+	requireCredentials := func(t *testing.T, record *ent.Strategy, exchange, account, apiKey, secretKey, passphrase string, testnet bool) {
+		t.Helper()
+		if record.Exchange != exchange || record.Account != account || record.ExchangeApiKey != apiKey ||
+			record.ExchangeSecretKey != secretKey || record.ExchangePassphrase != passphrase || record.ExchangeTestnet != testnet {
+			t.Fatalf("策略 %s 凭证 = %s/%s/%s/%s/%s/%v, want %s/%s/%s/%s/%s/%v", record.GUID,
+				record.Exchange, record.Account, record.ExchangeApiKey, record.ExchangeSecretKey, record.ExchangePassphrase, record.ExchangeTestnet,
+				exchange, account, apiKey, secretKey, passphrase, testnet)
+		}
+	}
+
+	// 单条和批量查询都使用关联账户覆盖策略的交易所、账户和凭证
+	record, err := strategyModel.FindOneByGUID(ctx, linked.GUID)
+	if err != nil {
+		t.Fatalf("查询策略失败: %v", err)
+	}
+	requireCredentials(t, record, "hyperliquid", "0xabc", "0xabc", "secret", "pass", true)
+
+	records, _, err := strategyModel.FindAllByOwner(ctx, 1, 0, 10)
+	if err != nil {
+		t.Fatalf("查询策略失败: %v", err)
+	}
+	for _, item := range records {
+		if item.GUID == linked.GUID {
+			requireCredentials(t, item, "hyperliquid", "0xabc", "0xabc", "secret", "pass", true)
+		} else {
+			requireCredentials(t, item, "paper", "own", "own", "own-secret", "", false)
+		}
+	}
+
+	// 修改账户标识后清空账户的全部凭证，关联策略使用新的账户标识
+	if err = accountModel.UpdateAccount(ctx, account.ID, "0xdef"); err != nil {
+		t.Fatalf("修改账户标识失败: %v", err)
+	}
+	if err = strategyModel.UpdateAccountByAccountId(ctx, account.ID, "0xdef"); err != nil {
+		t.Fatalf("同步策略账户标识失败: %v", err)
+	}
+	account, err = accountModel.FindOne(ctx, account.ID)
+	if err != nil {
+		t.Fatalf("查询交易所账户失败: %v", err)
+	}
+	if account.SecretKey != "" || account.Passphrase != "" {
+		t.Fatalf("修改账户标识后应清空凭证, secretKey: %q, passphrase: %q", account.SecretKey, account.Passphrase)
+	}
+	record, err = strategyModel.FindOneByGUID(ctx, linked.GUID)
+	if err != nil {
+		t.Fatalf("查询策略失败: %v", err)
+	}
+	requireCredentials(t, record, "hyperliquid", "0xdef", "0xdef", "", "", true)
+
+	// 解除关联后账户标识和凭证复制到策略自身保存
+	if err = strategyModel.UnlinkExchangeAccount(ctx, linked.ID, account); err != nil {
+		t.Fatalf("解除关联失败: %v", err)
+	}
+	record, err = strategyModel.FindOneByGUID(ctx, linked.GUID)
+	if err != nil {
+		t.Fatalf("查询策略失败: %v", err)
+	}
+	requireCredentials(t, record, "hyperliquid", "0xdef", "0xdef", "", "", true)
+
+	record, err = strategyModel.FindOneByGUID(ctx, unlinked.GUID)
+	if err != nil {
+		t.Fatalf("查询策略失败: %v", err)
+	}
+	requireCredentials(t, record, "paper", "own", "own", "own-secret", "", false)
+}
@@ -5,16 +5,34 @@ import (
 
 	"github.com/fachebot/omni-grid-bot/internal/ent"
 	"github.com/fachebot/omni-grid-bot/internal/model"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
 )
 
 // MatchedTradeService 处理匹配交易的业务逻辑
 type MatchedTradeService struct {
-	model *model.MatchedTradeModel
+	model            *model.MatchedTradeModel
+	orderModel       *model.OrderModel
+	fillModel        *model.FillModel
+	orderRepairModel *model.OrderRepairModel
+	runModel         *model.StrategyRunModel
 }
 
 // NewMatchedTradeService 创建匹配交易服务实例
-func NewMatchedTradeService(model *model.MatchedTradeModel) *MatchedTradeService {
-	return &MatchedTradeService{model: model}
+func NewMatchedTradeService(
+	model *model.MatchedTradeModel,
+	orderModel *model.OrderModel,
+	fillModel *model.FillModel,
+	orderRepairModel *model.OrderRepairModel,
+	runModel *model.StrategyRunModel,
+) *MatchedTradeService {
+	return &MatchedTradeService{
+		model:            model,
+		orderModel:       orderModel,
+		fillModel:        fillModel,
+		orderRepairModel: orderRepairModel,
+		runModel:         runModel,
+	}
 }
 
 // RecordAndMatchBuyOrder 记录并匹配买入订单
@@ -31,9 +49,13 @@ func (s *MatchedTradeService) RecordAndMatchBuyOrder(
 
 	// 如果记录已存在，更新买入订单信息
 	if err == nil && existing != nil {
+		// 业务逻辑：合并此前被取消的平仓买单已部分成交的数量和金额
+		baseAmount := buyOrder.FilledBaseAmount.Add(lo.FromPtr(existing.BuyPartialBaseAmount))
+		quoteAmount := buyOrder.FilledQuoteAmount.Add(lo.FromPtr(existing.BuyPartialQuoteAmount))
+
 		// 数据存储：更新买入订单信息
 		err = s.model.UpdateBuyOrderInfo(ctx, strategy.GUID, buyOrder.ClientOrderId,
-			buyOrder.FilledBaseAmount, buyOrder.FilledQuoteAmount, buyOrder.Timestamp)
+			baseAmount, quoteAmount, buyOrder.Timestamp)
 		if err != nil {
 			return false, nil, err
 		}
@@ -45,8 +67,8 @@ func (s *MatchedTradeService) RecordAndMatchBuyOrder(
 		if existing.SellClientOrderId != nil && existing.SellOrderTimestamp != nil {
 			// 创建返回对象，包含更新后的买入订单信息
 			completedPair = existing
-			completedPair.BuyBaseAmount = &buyOrder.FilledBaseAmount
-			completedPair.BuyQuoteAmount = &buyOrder.FilledQuoteAmount
+			completedPair.BuyBaseAmount = &baseAmount
+			completedPair.BuyQuoteAmount = &quoteAmount
 			completedPair.BuyOrderTimestamp = &buyOrder.Timestamp
 		}
 
@@ -86,9 +108,13 @@ func (s *MatchedTradeService) RecordAndMatchSellOrder(
 
 	// 如果记录已存在，更新卖出订单信息
 	if err == nil && existing != nil {
+		// 业务逻辑：合并此前被取消的平仓卖单已部分成交的数量和金额
+		baseAmount := sellOrder.FilledBaseAmount.Add(lo.FromPtr(existing.SellPartialBaseAmount))
+		quoteAmount := sellOrder.FilledQuoteAmount.Add(lo.FromPtr(existing.SellPartialQuoteAmount))
+
 		// 数据存储：更新卖出订单信息
 		err = s.model.UpdateSellOrderInfo(ctx, strategy.GUID, sellOrder.ClientOrderId,
-			sellOrder.FilledBaseAmount, sellOrder.FilledQuoteAmount, sellOrder.Timestamp)
+			baseAmount, quoteAmount, sellOrder.Timestamp)
 		if err != nil {
 			return false, nil, err
 		}
@@ -100,8 +126,8 @@ func (s *MatchedTradeService) RecordAndMatchSellOrder(
 		if existing.BuyClientOrderId != nil && existing.BuyOrderTimestamp != nil {
 			// 创建返回对象，包含更新后的卖出订单信息
 			completedPair = existing
-			completedPair.SellBaseAmount = &sellOrder.FilledBaseAmount
-			completedPair.SellQuoteAmount = &sellOrder.FilledQuoteAmount
+			completedPair.SellBaseAmount = &baseAmount
+			completedPair.SellQuoteAmount = &quoteAmount
 			completedPair.SellOrderTimestamp = &sellOrder.Timestamp
 		}
 
@@ -126,3 +152,131 @@ func (s *MatchedTradeService) RecordAndMatchSellOrder(
 
 	return isFirstRecord, nil, nil
 }
+
+// SettleFees 结算策略已完成配对的匹配交易的手续费
+// 业务逻辑：通过客户端订单ID关联本地订单和成交记录，订单被取消后重新挂出时沿修复记录追溯被替换的订单，
+// 买卖订单的成交记录都已完整拉取时，记录全部订单的手续费合计和扣除手续费后的净利润；成交记录不完整的匹配交易等待下次结算。
+// 策略停止时已归档的匹配交易结算后，同步更新所属运行记录的手续费和总利润
+// 返回: 本次结算的匹配交易数量, error
+func (s *MatchedTradeService) SettleFees(ctx context.Context, strategy *ent.Strategy) (int, error) {
+	trades, err := s.model.FindAllUnsettled(ctx, strategy.GUID)
+	if err != nil || len(trades) == 0 {
+		return 0, err
+	}
+
+	// 数据查询：被替换的订单ID，按新订单ID索引
+	repairs, err := s.orderRepairModel.FindAllReplaced(ctx, strategy.GUID)
+	if err != nil {
+		return 0, err
+	}
+	replacedBy := make(map[string]string, len(repairs))
+	for _, item := range repairs {
+		replacedBy[*item.NewClientOrderId] = item.CanceledClientOrderId
+	}
+	orderHistory := func(clientOrderId *string) []string {
+		if clientOrderId == nil {
+			return nil
+		}
+		history := []string{*clientOrderId}
+		for id, ok := replacedBy[*clientOrderId]; ok && !lo.Contains(history, id); id, ok = replacedBy[id] {
+			history = append(history, id)
+		}
+		return history
+	}
+
+	// 数据查询：匹配交易关联的订单和成交记录
+	clientOrderIds := make([]string, 0, len(trades)*2)
+	for _, item := range trades {
+		clientOrderIds = append(clientOrderIds, orderHistory(item.BuyClientOrderId)...)
+		clientOrderIds = append(clientOrderIds, orderHistory(item.SellClientOrderId)...)
+	}
+	orders, err := s.orderModel.FindAllByAccountClientOrderIds(ctx, strategy.Exchange, strategy.Account, clientOrderIds)
+	if err != nil {
+		return 0, err
+	}
+	orderIds := lo.Map(orders, func(item *ent.Order, _ int) string { return item.OrderId })
+	fills, err := s.fillModel.FindAllByOrderIds(ctx, strategy.Exchange, strategy.Account, orderIds)
+	if err != nil {
+		return 0, err
+	}
+
+	// 业务逻辑：按订单汇总成交数量和手续费
+	filledByOrder := make(map[string]decimal.Decimal)
+	feeByOrder := make(map[string]decimal.Decimal)
+	for _, item := range fills {
+		filledByOrder[item.OrderId] = filledByOrder[item.OrderId].Add(item.BaseAmount)
+		feeByOrder[item.OrderId] = feeByOrder[item.OrderId].Add(item.Fee)
+	}
+	ordersByClientId := lo.GroupBy(orders, func(item *ent.Order) string { return item.ClientOrderId })
+	orderFee := func(clientOrderId *string) (decimal.Decimal, bool) {
+		history := orderHistory(clientOrderId)
+		if len(history) == 0 {
+			return decimal.Zero, false
+		}
+
+		fee := decimal.Zero
+		for _, id := range history {
+			items, ok := ordersByClientId[id]
+			if !ok {
+				return decimal.Zero, false
+			}
+			for _, ord := range items {
+				if filledByOrder[ord.OrderId].LessThan(ord.FilledBaseAmount) {
+					return decimal.Zero, false
+				}
+				fee = fee.Add(feeByOrder[ord.OrderId])
+			}
+		}
+		return fee, true
+	}
+
+	// 数据存储：更新手续费和净利润
+	settled := 0
+	runIds := make(map[int]struct{})
+	for _, item := range trades {
+		buyFee, ok := orderFee(item.BuyClientOrderId)
+		if !ok {
+			continue
+		}
+		sellFee, ok := orderFee(item.SellClientOrderId)
+		if !ok {
+			continue
+		}
+
+		fee := buyFee.Add(sellFee).InexactFloat64()
+		if err = s.model.UpdateFee(ctx, item.ID, fee, lo.FromPtr(item.Profit)-fee); err != nil {
+			return settled, err
+		}
+		if item.RunId != nil {
+			runIds[*item.RunId] = struct{}{}
+		}
+		settled++
+	}
+
+	// 数据存储：更新已归档匹配交易所属运行记录的手续费和总利润
+	for runId := range runIds {
+		if err = s.updateRunFee(ctx, runId); err != nil {
+			return settled, err
+		}
+	}
+
+	return settled, nil
+}
+
+// updateRunFee 按归档的匹配交易重新汇总运行记录的手续费，总利润扣除新增的手续费
+func (s *MatchedTradeService) updateRunFee(ctx context.Context, runId int) error {
+	run, err := s.runModel.FindOne(ctx, runId)
+	if err != nil {
+		return err
+	}
+	fee, err := s.model.QueryTotalFeeByRunId(ctx, runId)
+	if err != nil {
+		return err
+	}
+
+	var totalProfit *decimal.Decimal
+	if run.TotalProfit != nil {
+		totalProfit = lo.ToPtr(run.TotalProfit.Sub(fee.Sub(lo.FromPtr(run.Fee))))
+	}
+	return s.runModel.UpdateFee(ctx, runId, fee, totalProfit)
+}
@@ -0,0 +1,402 @@
+package strategy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/helper"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/fachebot/omni-grid-bot/internal/model"
+	"github.com/fachebot/omni-grid-bot/internal/svc"
+	"github.com/fachebot/omni-grid-bot/internal/util"
+	"github.com/fachebot/omni-grid-bot/internal/util/format"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// DefaultAtrPeriod 默认ATR计算周期(K线数量)
+	DefaultAtrPeriod = 14
+
+	// adaptiveCheckInterval 自适应网格检查波动率的间隔
+	adaptiveCheckInterval = 5 * time.Minute
+
+	// minAdaptiveGridNum 自适应网格的最小网格数量
+	minAdaptiveGridNum = 2
+)
+
+var (
+	// DefaultAtrMultiplier 默认网格间距与ATR的倍数
+	DefaultAtrMultiplier = decimal.NewFromInt(1)
+
+	// DefaultAdaptiveThreshold 默认重新生成网格的波动率变化比例
+	DefaultAdaptiveThreshold = decimal.NewFromFloat(0.3)
+)
+
+// CalculateATR 计算最近 period 根K线的平均真实波幅(简单平均)
+func CalculateATR(candles []exchange.Candle, period int) (decimal.Decimal, error) {
+	if period <= 0 || len(candles) < period+1 {
+		return decimal.Zero, ErrInsufficientCandles
+	}
+
+	sum := decimal.Zero
+	for idx := len(candles) - period; idx < len(candles); idx++ {
+		prevClose := candles[idx-1].Close
+		tr := decimal.Max(
+			candles[idx].High.Sub(candles[idx].Low),
+			candles[idx].High.Sub(prevClose).Abs(),
+			candles[idx].Low.Sub(prevClose).Abs(),
+		)
+		sum = sum.Add(tr)
+	}
+	return sum.Div(decimal.NewFromInt(int64(period))), nil
+}
+
+// AdaptiveGridNum 根据ATR计算价格区间内的网格数量
+// 网格间距为 ATR * atrMultiplier，数量限制在 minAdaptiveGridNum ~ MaxGridNumLimit 之间
+func AdaptiveGridNum(record *ent.Strategy, atr decimal.Decimal) int {
+	spacing := atr.Mul(lo.FromPtrOr(record.AtrMultiplier, DefaultAtrMultiplier))
+	if !spacing.IsPositive() {
+		return MaxGridNumLimit
+	}
+
+	n := record.PriceUpper.Sub(record.PriceLower).Div(spacing).IntPart()
+	return int(min(max(n, minAdaptiveGridNum), MaxGridNumLimit))
+}
+
+// fetchAdaptiveCandles 获取计算ATR所需的近期K线
+// 交易所不提供历史K线时使用行情推送聚合的K线
+func fetchAdaptiveCandles(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy, now time.Time) ([]exchange.Candle, error) {
+	period := lo.FromPtrOr(record.AtrPeriod, DefaultAtrPeriod)
+	interval := svcCtx.CandleCache.Interval()
+	start := now.Add(-interval * time.Duration(period+2))
+
+	candles, err := helper.GetCandles(ctx, svcCtx, record.Exchange, record.Symbol, interval, start, now)
+	if errors.Is(err, exchange.ErrExchangeUnsupported) || errors.Is(err, exchange.ErrIntervalUnsupported) {
+		return svcCtx.CandleCache.List(record.Exchange, record.Symbol, start, now), nil
+	}
+	return candles, err
+}
+
+// calculateAdaptiveATR 计算策略当前的ATR
+func calculateAdaptiveATR(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy, now time.Time) (decimal.Decimal, error) {
+	candles, err := fetchAdaptiveCandles(ctx, svcCtx, record, now)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return CalculateATR(candles, lo.FromPtrOr(record.AtrPeriod, DefaultAtrPeriod))
+}
+
+// PrepareAdaptiveGrid 启动自适应网格前根据近期波动率计算网格数量并保存
+func PrepareAdaptiveGrid(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy, now time.Time) error {
+	atr, err := calculateAdaptiveATR(ctx, svcCtx, record, now)
+	if err != nil {
+		return err
+	}
+
+	gridNum := AdaptiveGridNum(record, atr)
+	if err = svcCtx.StrategyModel.UpdateAdaptiveGrid(ctx, record.ID, gridNum, atr, now); err != nil {
+		return err
+	}
+
+	record.GridNum = gridNum
+	record.AdaptiveAtr = &atr
+	record.AdaptiveUpdatedAt = &now
+	return nil
+}
+
+// adaptiveDue 判断是否需要重新生成网格
+// 波动率相对上次生成网格时的变化比例达到阈值，或者距离上次生成网格超过定时周期
+func adaptiveDue(record *ent.Strategy, atr decimal.Decimal, now time.Time) bool {
+	if record.AdaptiveAtr == nil || !record.AdaptiveAtr.IsPositive() || record.AdaptiveUpdatedAt == nil {
+		return true
+	}
+
+	threshold := lo.FromPtrOr(record.AdaptiveThreshold, DefaultAdaptiveThreshold)
+	if atr.Sub(*record.AdaptiveAtr).Abs().Div(*record.AdaptiveAtr).GreaterThanOrEqual(threshold) {
+		return true
+	}
+
+	hours := lo.FromPtrOr(record.AdaptiveIntervalHours, 0)
+	return hours > 0 && !now.Before(record.AdaptiveUpdatedAt.Add(time.Duration(hours)*time.Hour))
+}
+
+// Adapt 根据近期波动率重新生成自适应网格
+// 波动率变化达到阈值或到达定时周期后按新的网格间距重新挂单，未平仓的持仓会在新网格上重新挂出平仓订单
+// 返回值: 是否重新生成网格，错误信息；存在未处理的成交时返回 ErrRegridBlocked
+func (s *GridStrategy) Adapt(ctx context.Context, price decimal.Decimal, now time.Time) (bool, error) {
+	if s.strategy.QuantityMode != strategy.QuantityModeAdaptive {
+		return false, nil
+	}
+
+	atr, err := calculateAdaptiveATR(ctx, s.svcCtx, s.strategy, now)
+	if err != nil {
+		return false, err
+	}
+	if !adaptiveDue(s.strategy, atr, now) {
+		return false, nil
+	}
+
+	// 网格数量不变时只记录本次波动率
+	gridNum := AdaptiveGridNum(s.strategy, atr)
+	if gridNum == s.strategy.GridNum {
+		if err = s.svcCtx.StrategyModel.UpdateAdaptiveGrid(ctx, s.strategy.ID, gridNum, atr, now); err != nil {
+			return false, err
+		}
+		s.strategy.AdaptiveAtr = &atr
+		s.strategy.AdaptiveUpdatedAt = &now
+		return false, nil
+	}
+
+	mm, err := helper.GetMarketMetadata(ctx, s.svcCtx, s.strategy.Exchange, s.strategy.Symbol)
+	if err != nil {
+		return false, err
+	}
+
+	prices, err := GenerateArithmeticGrid(s.strategy.PriceLower, s.strategy.PriceUpper, gridNum, int32(mm.SupportedPriceDecimals))
+	if err != nil {
+		return false, err
+	}
+
+	quantities, err := GenerateGridQuantities(s.strategy, prices, mm.SupportedSizeDecimals)
+	if err != nil {
+		return false, err
+	}
+	if err = ValidateGridQuantities(prices, quantities, mm); err != nil {
+		return false, err
+	}
+
+	state, err := LoadGridStrategyState(ctx, s.svcCtx, s.strategy)
+	if err != nil {
+		return false, err
+	}
+
+	prevGridNum := s.strategy.GridNum
+	if err = state.regrid(prices, quantities, price, atr, now); err != nil {
+		return false, err
+	}
+
+	logger.Infof("[GridStrategy] 重新生成自适应网格, id: %s, symbol: %s, price: %s, atr: %s, gridNum: %d -> %d",
+		s.strategy.GUID, s.strategy.Symbol, price, atr, prevGridNum, gridNum)
+
+	go s.sendAdaptiveNotification(price, atr, prevGridNum)
+
+	return true, nil
+}
+
+func (s *GridStrategy) sendAdaptiveNotification(price, atr decimal.Decimal, prevGridNum int) {
+	if !s.strategy.EnablePushNotification {
+		return
+	}
+
+	chatId := util.ChatId(s.strategy.Owner)
+	link := fmt.Sprintf("[%s](https://t.me/%s?start=%s)",
+		util.StrategyName(s.strategy), s.svcCtx.Bot.Me.Username, s.strategy.GUID)
+	text := fmt.Sprintf("📐 **%s %s** 重新生成自适应网格 %s\n\n",
+		s.strategy.Symbol, strings.ToUpper(string(s.strategy.Mode)), link)
+	text += fmt.Sprintf("💵 当前价格: %s\n", format.Price(price, 5))
+	text += fmt.Sprintf("📊 ATR: %s\n", format.Price(atr, 5))
+	text += fmt.Sprintf("🔢 网格数量: %d -> %d\n", prevGridNum, s.strategy.GridNum)
+	_, err := util.SendMarkdownMessage(s.svcCtx.Bot, chatId, text, nil)
+	if err != nil {
+		logger.Debugf("[GridStrategy] 发送自适应网格通知失败, chat: %d, %v", chatId, err)
+	}
+}
+
+// regridOrder 重新生成网格时需要重新挂出的平仓订单
+type regridOrder struct {
+	ord   *ent.Order
+	level int // 新网格中的档位索引
+}
+
+// regrid 按新的网格价格重新挂单
+// 撤销全部网格挂单后，距离当前价格最近的档位留空，平仓订单按原数量从近到远重新挂到价格一侧的档位并更新匹配记录，
+// 其余档位按策略模式挂出开仓订单；平仓订单数量超过可用档位时返回 ErrRegridBlocked
+// 新网格在挂单前保存，挂单成功后再写回订单ID
+func (state *GridStrategyState) regrid(prices, quantities []decimal.Decimal, lastPrice, atr decimal.Decimal, now time.Time) error {
+	// 检查现有挂单
+	cancelOrderIds := make([]string, 0)
+	closingBuys := make([]regridOrder, 0)
+	closingSells := make([]regridOrder, 0)
+	for _, lvl := range state.sortedGrids {
+		for _, clientOrderId := range []*string{lvl.BuyClientOrderId, lvl.SellClientOrderId} {
+			if clientOrderId == nil {
+				continue
+			}
+
+			ord, ok := state.orders[*clientOrderId]
+			if !ok || !state.isActiveOrder(clientOrderId) || ord.FilledBaseAmount.IsPositive() {
+				return ErrRegridBlocked
+			}
+
+			closing, err := state.isClosingOrder(ord)
+			if err != nil {
+				return err
+			}
+			if closing && ord.Side == order.SideBuy {
+				closingBuys = append(closingBuys, regridOrder{ord: ord})
+			} else if closing {
+				closingSells = append(closingSells, regridOrder{ord: ord})
+			}
+			cancelOrderIds = append(cancelOrderIds, ord.OrderId)
+		}
+	}
+
+	// 生成新的网格档位
+	gridLevels := make([]ent.Grid, 0, len(prices))
+	for level, price := range prices {
+		gridLevels = append(gridLevels, ent.Grid{
+			StrategyId: state.strategy.GUID,
+			Exchange:   state.strategy.Exchange,
+			Symbol:     state.strategy.Symbol,
+			Account:    state.strategy.Account,
+			Level:      level,
+			Price:      price,
+			Quantity:   quantities[level],
+		})
+	}
+
+	// 平仓订单从距离当前价格最近的档位开始分配
+	gap := nearestLevelIndex(gridLevels, lastPrice)
+	if len(closingSells) > len(gridLevels)-gap-1 || len(closingBuys) > gap {
+		return ErrRegridBlocked
+	}
+	slices.SortFunc(closingSells, func(a, b regridOrder) int { return a.ord.Price.Cmp(b.ord.Price) })
+	slices.SortFunc(closingBuys, func(a, b regridOrder) int { return b.ord.Price.Cmp(a.ord.Price) })
+
+	limitOrders := make([]helper.CreateLimitOrderParams, 0, len(gridLevels))
+	limitOrderIndexMap := make(map[int]int)
+	addOrder := func(idx int, isAsk bool, size decimal.Decimal) {
+		limitOrderIndexMap[len(limitOrders)] = idx
+		limitOrders = append(limitOrders, helper.CreateLimitOrderParams{
+			Symbol:     state.strategy.Symbol,
+			IsAsk:      isAsk,
+			ReduceOnly: false,
+			Price:      gridLevels[idx].Price,
+			Size:       size,
+		})
+	}
+
+	// 平仓档位暂时关联已撤销的平仓订单，挂单失败时由再平衡按意外取消在新档位重新挂出
+	closingOrderIndexMap := make(map[int]*ent.Order)
+	for i := range closingSells {
+		closingSells[i].level = gap + 1 + i
+		closingOrderIndexMap[len(limitOrders)] = closingSells[i].ord
+		gridLevels[closingSells[i].level].SellClientOrderId = &closingSells[i].ord.ClientOrderId
+		addOrder(closingSells[i].level, true, closingSells[i].ord.BaseAmount)
+	}
+	for i := range closingBuys {
+		closingBuys[i].level = gap - 1 - i
+		closingOrderIndexMap[len(limitOrders)] = closingBuys[i].ord
+		gridLevels[closingBuys[i].level].BuyClientOrderId = &closingBuys[i].ord.ClientOrderId
+		addOrder(closingBuys[i].level, false, closingBuys[i].ord.BaseAmount)
+	}
+
+	opensLong := state.strategy.Mode != strategy.ModeShort
+	opensShort := state.strategy.Mode != strategy.ModeLong
+	for idx := gap - 1 - len(closingBuys); opensLong && idx >= 0; idx-- {
+		addOrder(idx, false, gridLevels[idx].Quantity)
+	}
+	for idx := gap + 1 + len(closingSells); opensShort && idx < len(gridLevels); idx++ {
+		addOrder(idx, true, gridLevels[idx].Quantity)
+	}
+
+	// 先撤销现有挂单并保存新网格，再挂出新订单，避免挂单失败时已撤销的开仓订单在旧档位按意外取消重新挂出
+	if err := state.adapter.CancelOrders(state.ctx, state.strategy.Symbol, cancelOrderIds); err != nil {
+		return err
+	}
+
+	gridNum := len(prices) - 1
+	err := util.Tx(state.ctx, state.svcCtx.DbClient, func(tx *ent.Tx) error {
+		m := model.NewGridModel(tx.Grid)
+		if err := m.DeleteByStrategyId(state.ctx, state.strategy.GUID); err != nil {
+			return err
+		}
+		if err := m.CreateBulk(state.ctx, gridLevels); err != nil {
+			return err
+		}
+
+		return model.NewStrategyModel(tx.Strategy).UpdateAdaptiveGrid(state.ctx, state.strategy.ID, gridNum, atr, now)
+	})
+	if err != nil {
+		logger.Errorf("[GridStrategyState] 更新自适应网格状态失败, strategy: %s, gridNum: %d, %v",
+			state.strategy.GUID, gridNum, err)
+		return err
+	}
+	state.strategy.GridNum = gridNum
+	state.strategy.AdaptiveAtr = &atr
+	state.strategy.AdaptiveUpdatedAt = &now
+
+	if len(limitOrders) == 0 {
+		return nil
+	}
+
+	// 挂出新订单，失败时开仓档位保持空闲
+	clientOrderIds, _, err := state.adapter.CreateOrderBatch(state.ctx, limitOrders, nil)
+	if err != nil {
+		logger.Errorf("[GridStrategyState] 自适应网格挂单失败, strategy: %s, gridNum: %d, %v",
+			state.strategy.GUID, gridNum, err)
+		return err
+	}
+
+	// 新网格档位在创建后才有ID，按档位编号查询
+	grids, err := state.svcCtx.GridModel.FindAllByStrategyIdOrderAsc(state.ctx, state.strategy.GUID)
+	if err != nil {
+		return err
+	}
+	levels := lo.KeyBy(grids, func(item *ent.Grid) int { return item.Level })
+
+	err = util.Tx(state.ctx, state.svcCtx.DbClient, func(tx *ent.Tx) error {
+		m := model.NewGridModel(tx.Grid)
+		matchedTradeModel := model.NewMatchedTradeModel(tx.MatchedTrade)
+		for idx, clientOrderId := range clientOrderIds {
+			lvl, ok := levels[gridLevels[limitOrderIndexMap[idx]].Level]
+			if !ok {
+				continue
+			}
+
+			var err error
+			if limitOrders[idx].IsAsk {
+				err = m.UpdateSellClientOrderId(state.ctx, lvl.ID, &clientOrderId, now)
+			} else {
+				err = m.UpdateBuyClientOrderId(state.ctx, lvl.ID, &clientOrderId, now)
+			}
+			if err != nil {
+				return err
+			}
+
+			ord, ok := closingOrderIndexMap[idx]
+			if !ok {
+				continue
+			}
+			if ord.Side == order.SideBuy {
+				err = matchedTradeModel.ReplaceBuyClientOrderId(state.ctx, state.strategy.GUID, ord.ClientOrderId, clientOrderId)
+			} else {
+				err = matchedTradeModel.ReplaceSellClientOrderId(state.ctx, state.strategy.GUID, ord.ClientOrderId, clientOrderId)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Errorf("[GridStrategyState] 更新自适应网格挂单失败, strategy: %s, gridNum: %d, %v",
+			state.strategy.GUID, gridNum, err)
+		return err
+	}
+
+	for _, clientOrderId := range clientOrderIds {
+		state.svcCtx.PendingOrdersCache.Add(state.strategy.Exchange, state.strategy.Account, clientOrderId)
+	}
+
+	return nil
+}
@@ -0,0 +1,252 @@
+package strategy
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/samber/lo"
+)
+
+// testCandles 按 最高价/最低价/收盘价 生成K线
+func testCandles(values ...[3]string) []exchange.Candle {
+	candles := make([]exchange.Candle, 0, len(values))
+	for _, item := range values {
+		candles = append(candles, exchange.Candle{High: d(item[0]), Low: d(item[1]), Close: d(item[2])})
+	}
+	return candles
+}
+
+func TestCalculateATR(t *testing.T) {
+	// 真实波幅依次为 3、5、2
+	candles := testCandles(
+		[3]string{"101", "99", "100"},
+		[3]string{"102", "99", "101"},
+		[3]string{"106", "104", "105"},
+		[3]string{"106", "104", "104"},
+	)
+
+	tests := []struct {
+		name    string
+		candles []exchange.Candle
+		period  int
+		want    string
+		wantErr bool
+	}{
+		{name: "取最近一根K线", candles: candles, period: 1, want: "2"},
+		{name: "取最近两根K线", candles: candles, period: 2, want: "3.5"},
+		{name: "跳空时按前收盘价计算", candles: candles[:3], period: 1, want: "5"},
+		{name: "全部K线", candles: candles, period: 3, want: "3.3333333333333333"},
+		{name: "K线数量不足", candles: candles, period: 4, wantErr: true},
+		{name: "没有K线", period: 1, wantErr: true},
+		{name: "周期无效", candles: candles, period: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			atr, err := CalculateATR(tt.candles, tt.period)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInsufficientCandles) {
+					t.Fatalf("CalculateATR err = %v, want %v", err, ErrInsufficientCandles)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("计算ATR失败: %v", err)
+			}
+			requireDecimal(t, "ATR", atr, d(tt.want))
+		})
+	}
+}
+
+func TestAdaptiveGridNum(t *testing.T) {
+	tests := []struct {
+		name       string
+		atr        string
+		multiplier string
+		want       int
+	}{
+		{name: "按ATR计算网格间距", atr: "2", want: 10},
+		{name: "网格间距按倍数放大", atr: "2", multiplier: "2", want: 5},
+		{name: "不足一格时向下取整", atr: "3", want: 6},
+		{name: "不低于最小网格数量", atr: "15", want: minAdaptiveGridNum},
+		{name: "不超过最大网格数量", atr: "0.01", want: MaxGridNumLimit},
+		{name: "ATR为零时使用最大网格数量", atr: "0", want: MaxGridNumLimit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := &ent.Strategy{PriceLower: d("90"), PriceUpper: d("110")}
+			if tt.multiplier != "" {
+				record.AtrMultiplier = lo.ToPtr(d(tt.multiplier))
+			}
+			if got := AdaptiveGridNum(record, d(tt.atr)); got != tt.want {
+				t.Fatalf("AdaptiveGridNum = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAdaptiveDue(t *testing.T) {
+	updatedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		prevAtr   string
+		updated   bool
+		threshold string
+		hours     int
+		atr       string
+		elapsed   time.Duration
+		want      bool
+	}{
+		{name: "尚未记录波动率", updated: true, atr: "1", want: true},
+		{name: "上次波动率为零", prevAtr: "0", updated: true, atr: "1", want: true},
+		{name: "尚未记录生成时间", prevAtr: "1", atr: "1", want: true},
+		{name: "波动率上升达到默认阈值", prevAtr: "1", updated: true, atr: "1.3", want: true},
+		{name: "波动率上升未达到默认阈值", prevAtr: "1", updated: true, atr: "1.29", want: false},
+		{name: "波动率下降达到默认阈值", prevAtr: "1", updated: true, atr: "0.7", want: true},
+		{name: "波动率变化未达到自定义阈值", prevAtr: "1", updated: true, threshold: "0.5", atr: "1.4", want: false},
+		{name: "到达定时周期", prevAtr: "1", updated: true, hours: 4, atr: "1", elapsed: 4 * time.Hour, want: true},
+		{name: "未到定时周期", prevAtr: "1", updated: true, hours: 4, atr: "1", elapsed: 4*time.Hour - time.Minute, want: false},
+		{name: "未设置定时周期", prevAtr: "1", updated: true, atr: "1", elapsed: 1000 * time.Hour, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := &ent.Strategy{AdaptiveAtr: optionalDecimal(tt.prevAtr), AdaptiveThreshold: optionalDecimal(tt.threshold)}
+			if tt.updated {
+				record.AdaptiveUpdatedAt = &updatedAt
+			}
+			if tt.hours > 0 {
+				record.AdaptiveIntervalHours = &tt.hours
+			}
+			if got := adaptiveDue(record, d(tt.atr), updatedAt.Add(tt.elapsed)); got != tt.want {
+				t.Fatalf("adaptiveDue = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// levelPrices 生成 from~to 之间间距为 1 的档位价格
+func levelPrices(from, to int) []string {
+	return lo.Map(lo.RangeFrom(from, to-from+1), func(item int, _ int) string { return strconv.Itoa(item) })
+}
+
+func TestRegrid(t *testing.T) {
+	tests := []struct {
+		name      string
+		mode      strategy.Mode
+		price     string   // 重新生成网格前推送的行情价格
+		gridNum   int      // 新的网格数量
+		buys      []string // 新网格的买单档位
+		sells     []string // 新网格的卖单档位
+		wantErr   error
+		wantGrids int
+	}{
+		{
+			name: "做多平仓卖单从最近档位向上挂出", mode: strategy.ModeLong, price: "100.5", gridNum: 20,
+			buys: levelPrices(90, 99), sells: levelPrices(101, 104), wantGrids: 21,
+		},
+		{
+			name: "做多下跌后平仓卖单增加", mode: strategy.ModeLong, price: "98", gridNum: 20,
+			buys: levelPrices(90, 97), sells: levelPrices(99, 104), wantGrids: 21,
+		},
+		{
+			name: "做空平仓买单从最近档位向下挂出", mode: strategy.ModeShort, price: "104", gridNum: 20,
+			buys: levelPrices(97, 103), sells: levelPrices(105, 110), wantGrids: 21,
+		},
+		{
+			name: "平仓订单超过可用档位", mode: strategy.ModeLong, price: "98", gridNum: 5,
+			wantErr: ErrRegridBlocked, wantGrids: 11,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := testStrategy(tt.mode)
+			args.QuantityMode = strategy.QuantityModeAdaptive
+			h := newTestHarness(t, args, d("100.5"))
+			h.price(tt.price)
+			before := h.grids()
+
+			prices, err := GenerateArithmeticGrid(h.record.PriceLower, h.record.PriceUpper, tt.gridNum, 2)
+			if err != nil {
+				t.Fatalf("生成网格价格失败: %v", err)
+			}
+			quantities, err := GenerateGridQuantities(h.record, prices, 4)
+			if err != nil {
+				t.Fatalf("生成网格数量失败: %v", err)
+			}
+			state, err := LoadGridStrategyState(h.ctx, h.svcCtx, h.record)
+			if err != nil {
+				t.Fatalf("加载网格状态失败: %v", err)
+			}
+
+			err = state.regrid(prices, quantities, d(tt.price), d("4"), time.Now())
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("regrid err = %v, want %v", err, tt.wantErr)
+			}
+			if len(h.grids()) != tt.wantGrids {
+				t.Fatalf("grids = %d, want %d", len(h.grids()), tt.wantGrids)
+			}
+			if tt.wantErr != nil {
+				// 无法重新生成时保留原网格和挂单
+				for idx, lvl := range h.grids() {
+					if lo.FromPtr(lvl.BuyClientOrderId) != lo.FromPtr(before[idx].BuyClientOrderId) ||
+						lo.FromPtr(lvl.SellClientOrderId) != lo.FromPtr(before[idx].SellClientOrderId) {
+						t.Fatalf("网格档位 %s 的挂单不应变化", lvl.Price)
+					}
+				}
+				return
+			}
+
+			buys := lo.FilterMap(h.grids(), func(item *ent.Grid, _ int) (string, bool) {
+				return item.Price.String(), item.BuyClientOrderId != nil
+			})
+			sells := lo.FilterMap(h.grids(), func(item *ent.Grid, _ int) (string, bool) {
+				return item.Price.String(), item.SellClientOrderId != nil
+			})
+			if !lo.ElementsMatch(buys, tt.buys) || !lo.ElementsMatch(sells, tt.sells) {
+				t.Fatalf("buys = %v, sells = %v, want %v, %v", buys, sells, tt.buys, tt.sells)
+			}
+			if open := h.simulator.OpenOrders(testAccount, testSymbol); len(open) != len(tt.buys)+len(tt.sells) {
+				t.Fatalf("open orders = %d, want %d", len(open), len(tt.buys)+len(tt.sells))
+			}
+
+			// 平仓订单按原数量挂出，匹配交易关联新的订单ID
+			trades := lo.Filter(h.matchedTrades(), func(item *ent.MatchedTrade, _ int) bool {
+				return item.BuyOrderTimestamp == nil || item.SellOrderTimestamp == nil
+			})
+			side, closing := order.SideSell, tt.sells
+			if tt.mode == strategy.ModeShort {
+				side, closing = order.SideBuy, tt.buys
+			}
+			if len(trades) != len(closing) {
+				t.Fatalf("open trades = %d, want %d", len(trades), len(closing))
+			}
+			for _, price := range closing {
+				ord := h.levelOrder(price, side)
+				requireDecimal(t, "平仓数量", ord.BaseAmount, d("1"))
+				if !lo.ContainsBy(trades, func(item *ent.MatchedTrade) bool {
+					return lo.FromPtr(item.BuyClientOrderId) == ord.ClientOrderId || lo.FromPtr(item.SellClientOrderId) == ord.ClientOrderId
+				}) {
+					t.Fatalf("网格档位 %s 的平仓订单没有关联匹配交易", price)
+				}
+			}
+
+			record, err := h.svcCtx.StrategyModel.FindOneByGUID(h.ctx, h.record.GUID)
+			if err != nil {
+				t.Fatalf("查询策略失败: %v", err)
+			}
+			if record.GridNum != tt.gridNum {
+				t.Fatalf("GridNum = %d, want %d", record.GridNum, tt.gridNum)
+			}
+			requireDecimal(t, "波动率", lo.FromPtr(record.AdaptiveAtr), d("4"))
+		})
+	}
+}
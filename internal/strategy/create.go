@@ -20,7 +20,7 @@ const (
 	MaxGridNumLimit = 50
 )
 
-func InitGridStrategy(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy, prices []decimal.Decimal) error {
+func InitGridStrategy(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy, prices, quantities []decimal.Decimal) error {
 	// 初始交易账户
 	adapter, err := helper.NewExchangeAdapterFromStrategy(svcCtx, record)
 	if err != nil {
@@ -52,7 +52,7 @@ func InitGridStrategy(ctx context.Context, svcCtx *svc.ServiceContext, record *e
 			Account:    record.Account,
 			Level:      level,
 			Price:      price,
-			Quantity:   record.InitialOrderSize,
+			Quantity:   quantities[level],
 		}
 		gridLevels = append(gridLevels, item)
 	}
@@ -63,8 +63,28 @@ func InitGridStrategy(ctx context.Context, svcCtx *svc.ServiceContext, record *e
 		return err
 	}
 
+	// 上一次运行没有正常结束时（例如停止过程中程序退出），先统计上一次运行的收益
+	now := time.Now()
+	staleRun, err := svcCtx.StrategyRunModel.FindRunning(ctx, record.GUID)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+	var staleArgs ent.StrategyRun
+	if staleRun != nil {
+		staleArgs = helper.RunStatistics(ctx, svcCtx, record)
+		staleArgs.EndTime = &now
+	}
+
 	// 更新策略状态
-	return util.Tx(ctx, svcCtx.DbClient, func(tx *ent.Tx) error {
+	err = util.Tx(ctx, svcCtx.DbClient, func(tx *ent.Tx) error {
+		// 结束上一次运行记录并归档，避免与本次运行的网格和收益混在一起
+		if staleRun != nil {
+			err := helper.CloseStrategyRun(ctx, tx, record.GUID, staleRun.ID, staleArgs)
+			if err != nil {
+				return err
+			}
+		}
+
 		m := model.NewGridModel(tx.Grid)
 		err := m.DeleteByStrategyId(ctx, record.GUID)
 		if err != nil {
@@ -75,13 +95,31 @@ func InitGridStrategy(ctx context.Context, svcCtx *svc.ServiceContext, record *e
 			return err
 		}
 
-		err = model.NewStrategyModel(tx.Strategy).UpdateStartTime(ctx, record.ID, time.Now())
+		// 每次启动开启新的运行记录
+		_, err = model.NewStrategyRunModel(tx.StrategyRun).Create(ctx, helper.NewStrategyRun(record, now))
+		if err != nil {
+			return err
+		}
+
+		err = model.NewStrategyModel(tx.Strategy).UpdateStartTime(ctx, record.ID, now)
+		if err != nil {
+			return err
+		}
+
+		// 移动止损止盈从本次启动开始记录极值价格
+		err = model.NewStrategyModel(tx.Strategy).ClearTrailingExtremePrice(ctx, record.ID)
 		if err != nil {
 			return err
 		}
 
 		return model.NewStrategyModel(tx.Strategy).UpdateStatus(ctx, record.ID, strategy.StatusActive)
 	})
+	if err != nil {
+		return err
+	}
+
+	record.TrailingExtremePrice = nil
+	return nil
 }
 
 func getLevelPrice(record *ent.Strategy, level *ent.Grid) decimal.Decimal {
@@ -177,6 +215,24 @@ func InitGridPosition(ctx context.Context, svcCtx *svc.ServiceContext, adapter *
 			}
 
 		}
+	case strategy.ModeNeutral:
+		// 距离当前价格最近的档位留空，下方挂买单，上方挂卖单
+		gap := nearestLevelIndex(gridLevels, lastPrice)
+		for idx := range gridLevels {
+			if idx == gap {
+				continue
+			}
+
+			lvl := &gridLevels[idx]
+			limitOrderIndexMap[len(limitOrders)] = idx
+			limitOrders = append(limitOrders, helper.CreateLimitOrderParams{
+				Symbol:     record.Symbol,
+				IsAsk:      idx > gap,
+				ReduceOnly: false,
+				Price:      lvl.Price,
+				Size:       lvl.Quantity,
+			})
+		}
 	default:
 		return errors.New("invalid grid mode")
 	}
@@ -189,34 +245,46 @@ func InitGridPosition(ctx context.Context, svcCtx *svc.ServiceContext, adapter *
 
 	// 更新订单CLientID
 	for idx := range limitOrderIds {
-		switch record.Mode {
-		case strategy.ModeLong:
-			gridLevels[limitOrderIndexMap[idx]].BuyClientOrderId = &limitOrderIds[idx]
-			gridLevels[limitOrderIndexMap[idx]].BuyClientOrderTime = &ts
-		case strategy.ModeShort:
+		if limitOrders[idx].IsAsk {
 			gridLevels[limitOrderIndexMap[idx]].SellClientOrderId = &limitOrderIds[idx]
 			gridLevels[limitOrderIndexMap[idx]].SellClientOrderTime = &ts
+		} else {
+			gridLevels[limitOrderIndexMap[idx]].BuyClientOrderId = &limitOrderIds[idx]
+			gridLevels[limitOrderIndexMap[idx]].BuyClientOrderTime = &ts
 		}
 	}
 	for idx := range marketOrderIds {
-		switch record.Mode {
-		case strategy.ModeLong:
-			gridLevels[marketOrderIndexMap[idx]].BuyClientOrderId = &marketOrderIds[idx]
-			gridLevels[marketOrderIndexMap[idx]].BuyClientOrderTime = &ts
-		case strategy.ModeShort:
+		if marketOrders[idx].IsAsk {
 			gridLevels[marketOrderIndexMap[idx]].SellClientOrderId = &marketOrderIds[idx]
 			gridLevels[marketOrderIndexMap[idx]].SellClientOrderTime = &ts
+		} else {
+			gridLevels[marketOrderIndexMap[idx]].BuyClientOrderId = &marketOrderIds[idx]
+			gridLevels[marketOrderIndexMap[idx]].BuyClientOrderTime = &ts
 		}
 	}
 
 	return nil
 }
 
+// nearestLevelIndex 查找距离价格最近的网格档位
+// 价格位于两个档位正中间时取较低的档位
+func nearestLevelIndex(gridLevels []ent.Grid, price decimal.Decimal) int {
+	nearest := 0
+	for idx := 1; idx < len(gridLevels); idx++ {
+		distance := gridLevels[idx].Price.Sub(price).Abs()
+		if distance.LessThan(gridLevels[nearest].Price.Sub(price).Abs()) {
+			nearest = idx
+		}
+	}
+	return nearest
+}
+
 func GenerateGridPrices(record *ent.Strategy, supportedPriceDecimals uint8) (prices []decimal.Decimal, err error) {
 	switch record.QuantityMode {
 	case strategy.QuantityModeGeometric:
 		return GenerateGeometricGrid(record.PriceLower, record.PriceUpper, record.GridNum, int32(supportedPriceDecimals))
-	case strategy.QuantityModeArithmetic:
+	case strategy.QuantityModeArithmetic, strategy.QuantityModeAdaptive:
+		// 自适应网格按等差间距生成，网格数量由近期波动率决定
 		return GenerateArithmeticGrid(record.PriceLower, record.PriceUpper, record.GridNum, int32(supportedPriceDecimals))
 	default:
 		return nil, errors.New("invalid quantity mode")
@@ -0,0 +1,150 @@
+package strategy
+
+import (
+	"testing"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/grid"
+	"github.com/fachebot/omni-grid-bot/internal/ent/matchedtrade"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategyrun"
+	"github.com/fachebot/omni-grid-bot/internal/helper"
+	"github.com/samber/lo"
+)
+
+// runs 查询策略的运行记录，按启动时间倒序
+func (h *testHarness) runs() []*ent.StrategyRun {
+	h.t.Helper()
+	runs, _, err := h.svcCtx.StrategyRunModel.FindAllByStrategyId(h.ctx, h.record.GUID, 0, 100)
+	if err != nil {
+		h.t.Fatalf("查询运行记录失败: %v", err)
+	}
+	return runs
+}
+
+// archived 统计归档到运行记录的网格和匹配交易数量
+func (h *testHarness) archived(runId int) (grids, trades int) {
+	h.t.Helper()
+	grids, err := h.svcCtx.DbClient.Grid.Query().Where(grid.RunIdEQ(runId)).Count(h.ctx)
+	if err != nil {
+		h.t.Fatalf("查询归档网格失败: %v", err)
+	}
+	trades, err = h.svcCtx.DbClient.MatchedTrade.Query().Where(matchedtrade.RunIdEQ(runId)).Count(h.ctx)
+	if err != nil {
+		h.t.Fatalf("查询归档匹配交易失败: %v", err)
+	}
+	return grids, trades
+}
+
+func TestDeactivateStrategy(t *testing.T) {
+	tests := []struct {
+		name   string
+		reason strategyrun.StopReason
+	}{
+		{name: "手动停止", reason: strategyrun.StopReasonManual},
+		{name: "停止并平仓", reason: strategyrun.StopReasonClosePosition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newTestHarness(t, testStrategy(strategy.ModeLong), d("100.5"))
+			h.price("99.5")
+			h.price("102")
+			h.price("99")
+
+			profit, err := helper.QueryStrategyProfit(h.ctx, h.svcCtx, h.record, d("99"))
+			if err != nil {
+				t.Fatalf("计算策略利润失败: %v", err)
+			}
+			if !profit.RealizedProfit.IsPositive() || profit.UnrealizedProfit.IsZero() {
+				t.Fatalf("测试数据应同时包含已实现利润和浮动盈亏, profit: %+v", profit)
+			}
+			trades := h.matchedTrades()
+			matched := len(completedTrades(trades))
+			levels := len(h.grids())
+
+			if err = helper.DeactivateStrategy(h.ctx, h.svcCtx, h.record, tt.reason); err != nil {
+				t.Fatalf("停止策略失败: %v", err)
+			}
+
+			runs := h.runs()
+			if len(runs) != 1 {
+				t.Fatalf("运行记录数量 = %d, want 1", len(runs))
+			}
+			run := runs[0]
+			if run.Status != strategyrun.StatusStopped || run.EndTime == nil || lo.FromPtr(run.StopReason) != tt.reason {
+				t.Fatalf("运行记录状态 = %s, 停止原因 = %v", run.Status, run.StopReason)
+			}
+			if run.MatchedTrades != matched {
+				t.Fatalf("匹配交易数量 = %d, want %d", run.MatchedTrades, matched)
+			}
+			requireDecimal(t, "已实现利润", lo.FromPtr(run.RealizedProfit), profit.RealizedProfit)
+			requireDecimal(t, "未实现利润", lo.FromPtr(run.UnrealizedProfit), profit.UnrealizedProfit)
+			requireDecimal(t, "总利润", lo.FromPtr(run.TotalProfit), profit.Total())
+
+			// 网格和匹配交易归档到运行记录，不再属于本次运行
+			archivedGrids, archivedTrades := h.archived(run.ID)
+			if archivedGrids != levels || archivedTrades != len(trades) {
+				t.Fatalf("归档网格 = %d, 匹配交易 = %d, want %d, %d", archivedGrids, archivedTrades, levels, len(trades))
+			}
+			if len(h.grids()) != 0 || len(h.matchedTrades()) != 0 {
+				t.Fatal("停止后不应存在未归档的网格和匹配交易")
+			}
+
+			record, err := h.svcCtx.StrategyModel.FindOneByGUID(h.ctx, h.record.GUID)
+			if err != nil {
+				t.Fatalf("查询策略失败: %v", err)
+			}
+			if record.Status != strategy.StatusInactive {
+				t.Fatalf("策略状态 = %s, want inactive", record.Status)
+			}
+		})
+	}
+}
+
+func TestInitGridStrategyClosesStaleRun(t *testing.T) {
+	h := newTestHarness(t, testStrategy(strategy.ModeLong), d("100.5"))
+	h.price("99.5")
+	h.price("102")
+	trades := len(h.matchedTrades())
+	levels := len(h.grids())
+
+	// 停止过程中程序退出，运行记录仍为 running
+	if err := h.svcCtx.StrategyModel.UpdateStatus(h.ctx, h.record.ID, strategy.StatusInactive); err != nil {
+		t.Fatalf("更新策略状态失败: %v", err)
+	}
+	stale := h.runs()[0]
+
+	prices, err := GenerateGridPrices(h.record, 2)
+	if err != nil {
+		t.Fatalf("生成网格价格失败: %v", err)
+	}
+	quantities, err := GenerateGridQuantities(h.record, prices, 4)
+	if err != nil {
+		t.Fatalf("生成网格数量失败: %v", err)
+	}
+	if err = InitGridStrategy(h.ctx, h.svcCtx, h.record, prices, quantities); err != nil {
+		t.Fatalf("初始化网格策略失败: %v", err)
+	}
+
+	runs := h.runs()
+	if len(runs) != 2 {
+		t.Fatalf("运行记录数量 = %d, want 2", len(runs))
+	}
+	running := lo.Filter(runs, func(item *ent.StrategyRun, _ int) bool { return item.Status == strategyrun.StatusRunning })
+	if len(running) != 1 || running[0].ID == stale.ID {
+		t.Fatalf("应只有本次启动的运行记录为 running, runs: %v", runs)
+	}
+
+	closed, ok := lo.Find(runs, func(item *ent.StrategyRun) bool { return item.ID == stale.ID })
+	if !ok || closed.Status != strategyrun.StatusStopped || closed.EndTime == nil || closed.StopReason != nil {
+		t.Fatalf("上一次运行记录应按未知原因结束, run: %v", closed)
+	}
+	archivedGrids, archivedTrades := h.archived(stale.ID)
+	if archivedGrids != levels || archivedTrades != trades {
+		t.Fatalf("归档网格 = %d, 匹配交易 = %d, want %d, %d", archivedGrids, archivedTrades, levels, trades)
+	}
+	if len(h.matchedTrades()) != 0 || len(h.grids()) != len(prices) {
+		t.Fatalf("本次运行的匹配交易 = %d, 网格 = %d, want 0, %d", len(h.matchedTrades()), len(h.grids()), len(prices))
+	}
+}
@@ -0,0 +1,111 @@
+package strategy
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/helper"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/fachebot/omni-grid-bot/internal/model"
+	"github.com/fachebot/omni-grid-bot/internal/util"
+)
+
+// CancelFarthestOpenOrders 撤销距离当前价格最远的开仓挂单，避免持仓继续增加
+// 多头持仓撤销价格最低的买单，空头持仓撤销价格最高的卖单，撤销后清空档位，等待相邻档位成交后重新挂单
+// 返回值: 撤销的订单数量，错误信息
+func (s *GridStrategy) CancelFarthestOpenOrders(ctx context.Context, side helper.Side, n int) (int, error) {
+	if n <= 0 {
+		return 0, nil
+	}
+
+	state, err := LoadGridStrategyState(ctx, s.svcCtx, s.strategy)
+	if err != nil {
+		return 0, err
+	}
+	return state.cancelFarthestOpenOrders(side, n)
+}
+
+// cancelFarthestOpenOrders 从远端档位开始撤销未成交的开仓挂单
+// 平仓挂单和部分成交的挂单保持不变，否则撤单后会遗留没有平仓挂单的持仓
+func (state *GridStrategyState) cancelFarthestOpenOrders(side helper.Side, n int) (int, error) {
+	isBuy := side == helper.LONG
+	levels := slices.Clone(state.sortedGrids)
+	if !isBuy {
+		slices.Reverse(levels)
+	}
+
+	targets := make([]*ent.Grid, 0, n)
+	cancelOrderIds := make([]string, 0, n)
+	for _, lvl := range levels {
+		if len(targets) >= n {
+			break
+		}
+
+		clientOrderId := lvl.SellClientOrderId
+		if isBuy {
+			clientOrderId = lvl.BuyClientOrderId
+		}
+		if !state.isActiveOrder(clientOrderId) {
+			continue
+		}
+
+		ord, ok := state.orders[*clientOrderId]
+		if !ok || ord.FilledBaseAmount.IsPositive() {
+			continue
+		}
+
+		closing, err := state.isClosingOrder(ord)
+		if err != nil {
+			return 0, err
+		}
+		if closing {
+			continue
+		}
+
+		targets = append(targets, lvl)
+		cancelOrderIds = append(cancelOrderIds, ord.OrderId)
+	}
+	if len(targets) == 0 {
+		return 0, nil
+	}
+
+	if err := state.adapter.CancelOrders(state.ctx, state.strategy.Symbol, cancelOrderIds); err != nil {
+		return 0, err
+	}
+
+	// 更新数据状态
+	now := time.Now()
+	err := util.Tx(state.ctx, state.svcCtx.DbClient, func(tx *ent.Tx) error {
+		m := model.NewGridModel(tx.Grid)
+		for _, lvl := range targets {
+			var err error
+			if isBuy {
+				err = m.UpdateBuyClientOrderId(state.ctx, lvl.ID, nil, now)
+			} else {
+				err = m.UpdateSellClientOrderId(state.ctx, lvl.ID, nil, now)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Errorf("[GridStrategyState] 更新降低风险状态失败, strategy: %s, %v", state.strategy.GUID, err)
+		return 0, err
+	}
+
+	for _, lvl := range targets {
+		if isBuy {
+			lvl.BuyClientOrderId = nil
+		} else {
+			lvl.SellClientOrderId = nil
+		}
+		logger.Infof("[%s %s] #%d 降低风险撤销开仓挂单, 价格: %s, 数量: %s",
+			state.strategy.Symbol, state.strategy.Mode, lvl.Level, lvl.Price, lvl.Quantity)
+	}
+
+	return len(targets), nil
+}
@@ -3,5 +3,14 @@ package strategy
 import "errors"
 
 var (
-	ErrOrderCanceled = errors.New("order canceled")
+	ErrOrderCanceled   = errors.New("order canceled")
+	ErrTrailingBlocked = errors.New("trailing blocked by far side orders")
+
+	ErrRegridBlocked       = errors.New("regrid blocked by pending orders")
+	ErrInsufficientCandles = errors.New("insufficient candles")
+
+	ErrInvalidSizingFactor  = errors.New("invalid sizing factor")
+	ErrInvalidSizingWeights = errors.New("invalid sizing weights")
+	ErrOrderSizeTooSmall    = errors.New("order size too small")
+	ErrOrderValueTooSmall   = errors.New("order value too small")
 )
@@ -2,6 +2,7 @@ package strategy
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -15,6 +16,8 @@ import (
 	"github.com/fachebot/omni-grid-bot/internal/svc"
 	"github.com/fachebot/omni-grid-bot/internal/util"
 	"github.com/fachebot/omni-grid-bot/internal/util/format"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
 )
 
 type GridStrategyState struct {
@@ -24,6 +27,8 @@ type GridStrategyState struct {
 	adapter     *helper.ExchangeAdapter
 	sortedGrids []*ent.Grid
 	orders      map[string]*ent.Order
+	riskChecked bool  // 本次再平衡是否已检查风险限额
+	riskErr     error // 风险限额的检查结果
 }
 
 func strategyName(record *ent.Strategy) string {
@@ -112,24 +117,24 @@ func LoadGridStrategyState(ctx context.Context, svcCtx *svc.ServiceContext, s *e
 }
 
 func (state *GridStrategyState) Rebalance() error {
-	// 检查订单状态
+	// 处理意外取消的订单
 	for idx := range state.sortedGrids {
 		lvl := state.sortedGrids[idx]
 		if lvl.BuyClientOrderId != nil {
 			ord, ok := state.orders[*lvl.BuyClientOrderId]
 			if ok && ord.Status == order.StatusCanceled {
-				logger.Errorf("[GridStrategyState] 订单意外取消, strategy: %s, symbol: %s, clientOrderId: %s",
-					state.strategy.GUID, state.strategy.Symbol, *lvl.BuyClientOrderId)
-				return ErrOrderCanceled
+				if err := state.handleCanceledOrder(lvl, ord); err != nil {
+					return err
+				}
 			}
 		}
 
 		if lvl.SellClientOrderId != nil {
 			ord, ok := state.orders[*lvl.SellClientOrderId]
 			if ok && ord.Status == order.StatusCanceled {
-				logger.Errorf("[GridStrategyState] 订单意外取消, strategy: %s, symbol: %s, clientOrderId: %s",
-					state.strategy.GUID, state.strategy.Symbol, *lvl.SellClientOrderId)
-				return ErrOrderCanceled
+				if err := state.handleCanceledOrder(lvl, ord); err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -207,20 +212,27 @@ func (state *GridStrategyState) sendGridMatchedNotification(completedPair *ent.M
 		state.strategy.Symbol, strings.ToUpper(string(state.strategy.Mode)), link)
 	text += fmt.Sprintf("🏦 交易平台: %s\n", state.strategy.Exchange)
 
-	switch state.strategy.Mode {
-	case strategy.ModeLong:
+	// 手续费在成交明细同步后结算，此时只展示毛利，成交明细已同步时附带估算的手续费和净利润
+	grossProfit := completedPair.SellQuoteAmount.Sub(*completedPair.BuyQuoteAmount)
+	profitText := fmt.Sprintf("💰 毛利: %s USD\n", grossProfit)
+	if fee, ok := state.estimateMatchedTradeFee(completedPair); ok {
+		profitText += fmt.Sprintf("💸 手续费(估算): %s USD\n", fee)
+		profitText += fmt.Sprintf("💰 净利润(估算): %s USD\n", grossProfit.Sub(fee))
+	}
+
+	if helper.IsLongTrade(state.strategy, completedPair) {
 		text += fmt.Sprintf("🔢 做多数量: %s %s\n", completedPair.BuyBaseAmount.String(), state.strategy.Symbol)
 		text += fmt.Sprintf("💥 做多价格: %s USD\n", format.Price(completedPair.BuyQuoteAmount.Div(*completedPair.BuyBaseAmount), 5))
 		text += fmt.Sprintf("🔢 平多数量: %s %s\n", completedPair.SellBaseAmount.String(), state.strategy.Symbol)
 		text += fmt.Sprintf("💥 平多价格: *%s* USD\n", format.Price(completedPair.SellQuoteAmount.Div(*completedPair.SellBaseAmount), 5))
-		text += fmt.Sprintf("💰 实现利润: %s USD\n", completedPair.SellQuoteAmount.Sub(*completedPair.BuyQuoteAmount))
+		text += profitText
 		text += fmt.Sprintf("⏰ 配对时间: `%s`\n", util.FormaTime(time.UnixMilli(*completedPair.SellOrderTimestamp)))
-	case strategy.ModeShort:
+	} else {
 		text += fmt.Sprintf("🔢 做空数量: %s %s\n", completedPair.SellBaseAmount.String(), state.strategy.Symbol)
 		text += fmt.Sprintf("💥 做空价格: %s USD\n", format.Price(completedPair.SellQuoteAmount.Div(*completedPair.SellBaseAmount), 5))
 		text += fmt.Sprintf("🔢 平空数量: %s %s\n", completedPair.BuyBaseAmount.String(), state.strategy.Symbol)
 		text += fmt.Sprintf("💥 平空价格: *%s* USD\n", format.Price(completedPair.BuyQuoteAmount.Div(*completedPair.BuyBaseAmount), 5))
-		text += fmt.Sprintf("💰 实现利润: %s USD\n", completedPair.SellQuoteAmount.Sub(*completedPair.BuyQuoteAmount))
+		text += profitText
 		text += fmt.Sprintf("⏰ 配对时间: `%s`\n", util.FormaTime(time.UnixMilli(*completedPair.BuyOrderTimestamp)))
 	}
 
@@ -231,6 +243,46 @@ func (state *GridStrategyState) sendGridMatchedNotification(completedPair *ent.M
 	}
 }
 
+// estimateMatchedTradeFee 按已同步的成交明细估算匹配交易的手续费
+// 买卖订单的成交明细都已完整同步时返回手续费合计，否则 ok 为 false；准确的手续费以 SettleFees 结算结果为准
+func (state *GridStrategyState) estimateMatchedTradeFee(completedPair *ent.MatchedTrade) (fee decimal.Decimal, ok bool) {
+	if completedPair.BuyClientOrderId == nil || completedPair.SellClientOrderId == nil {
+		return decimal.Zero, false
+	}
+
+	clientOrderIds := []string{*completedPair.BuyClientOrderId, *completedPair.SellClientOrderId}
+	orders, err := state.svcCtx.OrderModel.FindAllByAccountClientOrderIds(
+		state.ctx, state.strategy.Exchange, state.strategy.Account, clientOrderIds)
+	if err != nil {
+		logger.Debugf("[GridStrategyState] 查询匹配交易订单失败, id: %d, %v", completedPair.ID, err)
+		return decimal.Zero, false
+	}
+	for _, id := range clientOrderIds {
+		if !lo.ContainsBy(orders, func(item *ent.Order) bool { return item.ClientOrderId == id }) {
+			return decimal.Zero, false
+		}
+	}
+
+	orderIds := lo.Map(orders, func(item *ent.Order, _ int) string { return item.OrderId })
+	fills, err := state.svcCtx.FillModel.FindAllByOrderIds(state.ctx, state.strategy.Exchange, state.strategy.Account, orderIds)
+	if err != nil {
+		logger.Debugf("[GridStrategyState] 查询匹配交易成交明细失败, id: %d, %v", completedPair.ID, err)
+		return decimal.Zero, false
+	}
+
+	filledByOrder := make(map[string]decimal.Decimal)
+	for _, item := range fills {
+		filledByOrder[item.OrderId] = filledByOrder[item.OrderId].Add(item.BaseAmount)
+		fee = fee.Add(item.Fee)
+	}
+	for _, ord := range orders {
+		if filledByOrder[ord.OrderId].LessThan(ord.FilledBaseAmount) {
+			return decimal.Zero, false
+		}
+	}
+	return fee, true
+}
+
 func (state *GridStrategyState) handleEventNotification(isFirstRecord bool, ord *ent.Order, completedPair *ent.MatchedTrade) {
 	// 更新交易利润
 	if completedPair != nil && completedPair.Profit == nil {
@@ -252,6 +304,19 @@ func (state *GridStrategyState) handleEventNotification(isFirstRecord bool, ord
 	}()
 }
 
+// opensPosition 判断成交订单是否为开仓订单，开仓订单需要关联随后挂出的平仓订单
+// 中性网格未完成配对的成交即为开仓，已完成配对的成交是平掉反向仓位
+func (state *GridStrategyState) opensPosition(mode strategy.Mode, completedPair *ent.MatchedTrade) bool {
+	switch state.strategy.Mode {
+	case mode:
+		return true
+	case strategy.ModeNeutral:
+		return completedPair == nil
+	default:
+		return false
+	}
+}
+
 func (state *GridStrategyState) handleBuyOrder(level *ent.Grid, buyOrder *ent.Order) error {
 	logger.Infof("[%s %s] #%d 买单成交, ID: %s, 价格: %s, 数量: %s",
 		state.strategy.Symbol, state.strategy.Mode, level.Level, buyOrder.ClientOrderId, buyOrder.Price, buyOrder.FilledBaseAmount)
@@ -268,9 +333,26 @@ func (state *GridStrategyState) handleBuyOrder(level *ent.Grid, buyOrder *ent.Or
 	upperLevel := getUpperLevel(state.sortedGrids, level.Level)
 	if upperLevel != nil {
 		if upperLevel.SellClientOrderId == nil && !state.isActiveOrder(upperLevel.BuyClientOrderId) {
-			quantity := buyOrder.FilledBaseAmount
-			if state.strategy.Mode == strategy.ModeShort {
-				quantity = upperLevel.Quantity
+			// 平仓订单按成交数量挂单，开仓订单按档位数量挂单
+			quantity := upperLevel.Quantity
+			if state.opensPosition(strategy.ModeLong, completedPair) {
+				buyOrder, err = state.mergePartialAmount(buyOrder)
+				if err != nil {
+					return err
+				}
+				quantity = buyOrder.FilledBaseAmount
+			}
+
+			// 检查风险限额，平仓订单不受限制
+			if !state.opensPosition(strategy.ModeLong, completedPair) {
+				var limitErr *RiskLimitError
+				err = state.checkOrderRiskLimits()
+				if errors.As(err, &limitErr) {
+					return state.skipOpeningOrder(level, upperLevel, buyOrder, quantity, limitErr.Reason)
+				}
+				if err != nil {
+					return err
+				}
 			}
 
 			sellOrderId, err := state.adapter.CreateLimitOrder(state.ctx, state.strategy.Symbol, true, false, upperLevel.Price, quantity)
@@ -296,7 +378,7 @@ func (state *GridStrategyState) handleBuyOrder(level *ent.Grid, buyOrder *ent.Or
 					return err
 				}
 
-				if state.strategy.Mode == strategy.ModeLong {
+				if state.opensPosition(strategy.ModeLong, completedPair) {
 					err = model.NewMatchedTradeModel(tx.MatchedTrade).UpdateByBuyOrder(
 						state.ctx, state.strategy.GUID, buyOrder, sellOrderId, &quantity, nil, nil)
 					if err != nil {
@@ -338,9 +420,26 @@ func (state *GridStrategyState) handleSellOrder(level *ent.Grid, sellOrder *ent.
 	lowerLevel := getLowerLevel(state.sortedGrids, level.Level)
 	if lowerLevel != nil {
 		if lowerLevel.BuyClientOrderId == nil && !state.isActiveOrder(lowerLevel.SellClientOrderId) {
-			quantity := sellOrder.FilledBaseAmount
-			if state.strategy.Mode == strategy.ModeLong {
-				quantity = lowerLevel.Quantity
+			// 平仓订单按成交数量挂单，开仓订单按档位数量挂单
+			quantity := lowerLevel.Quantity
+			if state.opensPosition(strategy.ModeShort, completedPair) {
+				sellOrder, err = state.mergePartialAmount(sellOrder)
+				if err != nil {
+					return err
+				}
+				quantity = sellOrder.FilledBaseAmount
+			}
+
+			// 检查风险限额，平仓订单不受限制
+			if !state.opensPosition(strategy.ModeShort, completedPair) {
+				var limitErr *RiskLimitError
+				err = state.checkOrderRiskLimits()
+				if errors.As(err, &limitErr) {
+					return state.skipOpeningOrder(level, lowerLevel, sellOrder, quantity, limitErr.Reason)
+				}
+				if err != nil {
+					return err
+				}
 			}
 
 			buyOrderId, err := state.adapter.CreateLimitOrder(state.ctx, state.strategy.Symbol, false, false, lowerLevel.Price, quantity)
@@ -366,7 +465,7 @@ func (state *GridStrategyState) handleSellOrder(level *ent.Grid, sellOrder *ent.
 					return err
 				}
 
-				if state.strategy.Mode == strategy.ModeShort {
+				if state.opensPosition(strategy.ModeShort, completedPair) {
 					err = model.NewMatchedTradeModel(tx.MatchedTrade).UpdateBySellOrder(
 						state.ctx, state.strategy.GUID, sellOrder, buyOrderId, &quantity, nil, nil)
 					if err != nil {
@@ -412,15 +511,23 @@ func (state *GridStrategyState) checkAndRebalanceLevel(idx int) error {
 		}
 	}
 
+	// 撤销部分成交达到阈值的订单
+	if buyOrder != nil {
+		state.cancelPartialFilledOrder(level, buyOrder)
+	}
+	if sellOrder != nil {
+		state.cancelPartialFilledOrder(level, sellOrder)
+	}
+
 	// 处理买入订单
-	if buyOrder != nil && buyOrder.Status == order.StatusFilled {
+	if buyOrder != nil && isFilledOrder(buyOrder) {
 		if err := state.handleBuyOrder(level, buyOrder); err != nil {
 			return err
 		}
 	}
 
 	// 处理卖出订单
-	if sellOrder != nil && sellOrder.Status == order.StatusFilled {
+	if sellOrder != nil && isFilledOrder(sellOrder) {
 		if err := state.handleSellOrder(level, sellOrder); err != nil {
 			return err
 		}
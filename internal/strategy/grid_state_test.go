@@ -0,0 +1,207 @@
+package strategy
+
+import (
+	"testing"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/fill"
+	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+func requireDecimal(t *testing.T, name string, got, want decimal.Decimal) {
+	t.Helper()
+	if !got.Equal(want) {
+		t.Fatalf("%s = %s, want %s", name, got, want)
+	}
+}
+
+// completedTrades 过滤已完成配对的匹配交易
+func completedTrades(trades []*ent.MatchedTrade) []*ent.MatchedTrade {
+	return lo.Filter(trades, func(item *ent.MatchedTrade, _ int) bool {
+		return item.BuyOrderTimestamp != nil && item.SellOrderTimestamp != nil
+	})
+}
+
+func TestNeutralGridPairsLongFirst(t *testing.T) {
+	h := newTestHarness(t, testStrategy(strategy.ModeNeutral), d("100.5"))
+
+	// 价格下跌，98 买单开多，在 100 挂出平仓卖单
+	h.price("98")
+	closing := h.levelOrder("100", order.SideSell)
+	requireDecimal(t, "平仓卖单数量", closing.BaseAmount, d("1"))
+
+	trades := h.matchedTrades()
+	if len(trades) != 1 || trades[0].SellClientOrderId == nil || *trades[0].SellClientOrderId != closing.ClientOrderId {
+		t.Fatalf("开多后应关联平仓卖单, trades: %v", trades)
+	}
+
+	// 价格回升，平仓卖单成交，完成配对后在 98 重新挂出开仓买单
+	h.price("100")
+	completed := completedTrades(h.matchedTrades())
+	if len(completed) != 1 {
+		t.Fatalf("completed trades = %d, want 1", len(completed))
+	}
+	requireDecimal(t, "买入数量", *completed[0].BuyBaseAmount, d("1"))
+	requireDecimal(t, "卖出数量", *completed[0].SellBaseAmount, d("1"))
+	requireDecimal(t, "利润", decimal.NewFromFloat(lo.FromPtr(completed[0].Profit)), d("2"))
+
+	reopened := h.levelOrder("98", order.SideBuy)
+	requireDecimal(t, "重新开仓买单数量", reopened.BaseAmount, d("1"))
+	if len(h.matchedTrades()) != 1 {
+		t.Fatal("平仓卖单成交后不应创建新的匹配交易")
+	}
+}
+
+func TestNeutralGridPairsShortFirst(t *testing.T) {
+	h := newTestHarness(t, testStrategy(strategy.ModeNeutral), d("100.5"))
+
+	// 价格上涨，102 卖单开空，在 100 挂出平仓买单
+	h.price("102")
+	closing := h.levelOrder("100", order.SideBuy)
+	requireDecimal(t, "平仓买单数量", closing.BaseAmount, d("1"))
+
+	trades := h.matchedTrades()
+	if len(trades) != 1 || trades[0].BuyClientOrderId == nil || *trades[0].BuyClientOrderId != closing.ClientOrderId {
+		t.Fatalf("开空后应关联平仓买单, trades: %v", trades)
+	}
+
+	// 价格回落，平仓买单成交，完成配对后在 102 重新挂出开仓卖单
+	h.price("100")
+	completed := completedTrades(h.matchedTrades())
+	if len(completed) != 1 {
+		t.Fatalf("completed trades = %d, want 1", len(completed))
+	}
+	requireDecimal(t, "买入数量", *completed[0].BuyBaseAmount, d("1"))
+	requireDecimal(t, "卖出数量", *completed[0].SellBaseAmount, d("1"))
+	requireDecimal(t, "利润", decimal.NewFromFloat(lo.FromPtr(completed[0].Profit)), d("2"))
+
+	reopened := h.levelOrder("102", order.SideSell)
+	requireDecimal(t, "重新开仓卖单数量", reopened.BaseAmount, d("1"))
+}
+
+func TestNeutralGridReopensWithLevelQuantityAfterPartialFill(t *testing.T) {
+	tests := []struct {
+		name        string
+		nearPrice   string
+		openPrice   string
+		openSide    order.Side
+		closeSide   order.Side
+		closePrice  string
+		reopenPrice string
+	}{
+		{name: "买入开多", nearPrice: "98.5", openPrice: "98", openSide: order.SideBuy, closeSide: order.SideSell, closePrice: "100", reopenPrice: "98"},
+		{name: "卖出开空", nearPrice: "101.5", openPrice: "102", openSide: order.SideSell, closeSide: order.SideBuy, closePrice: "100", reopenPrice: "102"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := testStrategy(strategy.ModeNeutral)
+			args.PartialFillThreshold = lo.ToPtr(d("0.5"))
+			h := newTestHarness(t, args, d("100.5"))
+
+			// 价格接近开仓档位时开仓订单成交 60%，撤销剩余数量后按成交数量挂出平仓订单
+			h.price(tt.nearPrice)
+			opening := h.levelOrder(tt.openPrice, tt.openSide)
+			h.partialFill(opening.ClientOrderId, "0.6")
+			closing := h.levelOrder(tt.closePrice, tt.closeSide)
+			requireDecimal(t, "平仓订单数量", closing.BaseAmount, d("0.6"))
+
+			// 平仓订单成交后，开仓订单恢复为档位数量
+			h.price(tt.closePrice)
+			reopened := h.levelOrder(tt.reopenPrice, tt.openSide)
+			requireDecimal(t, "重新开仓订单数量", reopened.BaseAmount, d("1"))
+
+			completed := completedTrades(h.matchedTrades())
+			if len(completed) != 1 {
+				t.Fatalf("completed trades = %d, want 1", len(completed))
+			}
+			requireDecimal(t, "买入数量", *completed[0].BuyBaseAmount, d("0.6"))
+			requireDecimal(t, "卖出数量", *completed[0].SellBaseAmount, d("0.6"))
+		})
+	}
+}
+
+func TestDirectionalGridMatchedTrades(t *testing.T) {
+	t.Run("做多", func(t *testing.T) {
+		h := newTestHarness(t, testStrategy(strategy.ModeLong), d("100.5"))
+
+		// 做多网格在价格上方的档位按市价开仓后挂出平仓卖单
+		h.price("98")
+		h.price("100")
+		completed := completedTrades(h.matchedTrades())
+		if len(completed) == 0 {
+			t.Fatal("做多网格应完成买入后卖出的配对")
+		}
+		for _, trade := range completed {
+			if *trade.BuyOrderTimestamp > *trade.SellOrderTimestamp {
+				t.Fatalf("做多网格应先买入后卖出, trade: %v", trade)
+			}
+		}
+		requireDecimal(t, "重新开仓买单数量", h.levelOrder("98", order.SideBuy).BaseAmount, d("1"))
+	})
+
+	t.Run("做空", func(t *testing.T) {
+		h := newTestHarness(t, testStrategy(strategy.ModeShort), d("100.5"))
+
+		h.price("102")
+		h.price("100")
+		completed := completedTrades(h.matchedTrades())
+		if len(completed) == 0 {
+			t.Fatal("做空网格应完成卖出后买入的配对")
+		}
+		for _, trade := range completed {
+			if *trade.SellOrderTimestamp > *trade.BuyOrderTimestamp {
+				t.Fatalf("做空网格应先卖出后买入, trade: %v", trade)
+			}
+		}
+		requireDecimal(t, "重新开仓卖单数量", h.levelOrder("102", order.SideSell).BaseAmount, d("1"))
+	})
+}
+
+func TestEstimateMatchedTradeFee(t *testing.T) {
+	h := newTestHarness(t, testStrategy(strategy.ModeNeutral), d("100.5"))
+	h.price("98")
+	h.price("100")
+	completed := completedTrades(h.matchedTrades())
+	if len(completed) != 1 {
+		t.Fatalf("completed trades = %d, want 1", len(completed))
+	}
+
+	state, err := LoadGridStrategyState(h.ctx, h.svcCtx, h.record)
+	if err != nil {
+		t.Fatalf("加载网格状态失败: %v", err)
+	}
+	saveFill := func(fillId string, ord *ent.Order, size, fee string) {
+		err := h.svcCtx.FillModel.Upsert(h.ctx, ent.Fill{
+			Exchange: h.record.Exchange, Account: h.record.Account, Symbol: ord.Symbol, FillId: fillId,
+			OrderId: ord.OrderId, Side: fill.Side(ord.Side), Price: ord.Price, BaseAmount: d(size),
+			QuoteAmount: ord.Price.Mul(d(size)), Fee: d(fee), FeeAsset: "USDC", Timestamp: ord.Timestamp,
+		})
+		if err != nil {
+			t.Fatalf("保存成交记录失败: %v", err)
+		}
+	}
+	buyOrder := h.order(*completed[0].BuyClientOrderId)
+	sellOrder := h.order(*completed[0].SellClientOrderId)
+
+	// 成交明细尚未同步或不完整时不估算手续费
+	if _, ok := state.estimateMatchedTradeFee(completed[0]); ok {
+		t.Fatal("没有成交明细时不应估算手续费")
+	}
+	saveFill("f1", buyOrder, "1", "0.02")
+	saveFill("f2", sellOrder, "0.4", "0.01")
+	if _, ok := state.estimateMatchedTradeFee(completed[0]); ok {
+		t.Fatal("卖单成交明细不完整时不应估算手续费")
+	}
+
+	// 买卖订单的成交明细都已同步后返回手续费合计
+	saveFill("f3", sellOrder, "0.6", "0.015")
+	fee, ok := state.estimateMatchedTradeFee(completed[0])
+	if !ok {
+		t.Fatal("成交明细完整时应估算手续费")
+	}
+	requireDecimal(t, "手续费", fee, d("0.045"))
+}
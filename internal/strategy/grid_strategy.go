@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/fachebot/omni-grid-bot/internal/ent"
 	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategyrun"
 	"github.com/fachebot/omni-grid-bot/internal/helper"
 	"github.com/fachebot/omni-grid-bot/internal/logger"
 	"github.com/fachebot/omni-grid-bot/internal/svc"
@@ -23,6 +26,15 @@ type GridStrategy struct {
 	engine   StrategyEngine
 	svcCtx   *svc.ServiceContext
 	strategy *ent.Strategy
+
+	trailingRetryAt time.Time
+	adaptiveCheckAt time.Time
+	pnlCheckAt      time.Time
+
+	trailingMutex         sync.Mutex // 保护移动止损极值价格，Update 在其他协程调用
+	trailingExtremeSaveAt time.Time
+	trailingExtremeDirty  bool // 极值价格尚未写入数据库
+	trailingExtremeFlush  bool // 下一次行情更新时立即保存极值价格
 }
 
 func NewGridStrategy(svcCtx *svc.ServiceContext, engine StrategyEngine, s *ent.Strategy) *GridStrategy {
@@ -34,9 +46,22 @@ func (s *GridStrategy) Get() *ent.Strategy {
 }
 
 func (s *GridStrategy) Update(entStrategy *ent.Strategy) {
+	// 保留尚未写入数据库的移动止损极值价格，由下一次行情更新或引擎停止时保存，避免持有引擎锁时写入数据库
+	s.trailingMutex.Lock()
+	defer s.trailingMutex.Unlock()
+
+	if s.trailingExtremeDirty {
+		entStrategy.TrailingExtremePrice = s.strategy.TrailingExtremePrice
+		s.trailingExtremeFlush = true
+	}
 	s.strategy = entStrategy
 }
 
+// Flush 保存尚未写入数据库的运行状态，引擎停止时调用
+func (s *GridStrategy) Flush(ctx context.Context) {
+	s.flushTrailingExtremePrice(ctx)
+}
+
 func (s *GridStrategy) OnTicker(ctx context.Context, price decimal.Decimal) {
 	logger.Tracef("[GridStrategy] 收到行情更新, id: %s, symbol: %s, account: %s, price: %s",
 		s.strategy.GUID, s.strategy.Symbol, s.strategy.Account, price.String())
@@ -70,7 +95,75 @@ func (s *GridStrategy) OnTicker(ctx context.Context, price decimal.Decimal) {
 			s.handleTriggerTakeProfitPrice(ctx, price, *s.strategy.TriggerTakeProfitPrice)
 			return
 		}
+	case strategy.ModeNeutral:
+		if s.strategy.TriggerStopLossPrice != nil &&
+			s.strategy.TriggerStopLossPrice.GreaterThan(decimal.Zero) &&
+			NeutralTriggerReached(s.strategy, *s.strategy.TriggerStopLossPrice, price) {
+			s.handleTriggerStopLossPrice(ctx, price, *s.strategy.TriggerStopLossPrice)
+			return
+		}
+
+		if s.strategy.TriggerTakeProfitPrice != nil &&
+			s.strategy.TriggerTakeProfitPrice.GreaterThan(decimal.Zero) &&
+			NeutralTriggerReached(s.strategy, *s.strategy.TriggerTakeProfitPrice, price) {
+			s.handleTriggerTakeProfitPrice(ctx, price, *s.strategy.TriggerTakeProfitPrice)
+			return
+		}
+	}
+
+	// 移动止损和移动止盈
+	if s.checkTrailingStop(ctx, price) {
+		return
+	}
+
+	// 最大亏损和目标利润
+	if s.checkPnlStop(ctx, price) {
+		return
+	}
+
+	// 移动网格
+	if !time.Now().Before(s.trailingRetryAt) {
+		if _, err := s.Trail(ctx, price); err != nil {
+			s.trailingRetryAt = time.Now().Add(trailingRetryInterval)
+			if err == ErrTrailingBlocked {
+				logger.Infof("[GridStrategy] 远端档位存在平仓挂单, 暂不移动网格, id: %s, symbol: %s, account: %s, price: %s",
+					s.strategy.GUID, s.strategy.Symbol, s.strategy.Account, price.String())
+			} else {
+				logger.Errorf("[GridStrategy] 移动网格失败, id: %s, symbol: %s, account: %s, %v",
+					s.strategy.GUID, s.strategy.Symbol, s.strategy.Account, err)
+			}
+		}
+	}
+
+	// 自适应网格
+	if s.strategy.QuantityMode == strategy.QuantityModeAdaptive && !time.Now().Before(s.adaptiveCheckAt) {
+		s.adaptiveCheckAt = time.Now().Add(adaptiveCheckInterval)
+		if _, err := s.Adapt(ctx, price, time.Now()); err != nil {
+			if err == ErrRegridBlocked {
+				logger.Infof("[GridStrategy] 存在未处理的订单, 暂不重新生成自适应网格, id: %s, symbol: %s, account: %s, price: %s",
+					s.strategy.GUID, s.strategy.Symbol, s.strategy.Account, price.String())
+			} else if err == ErrInsufficientCandles {
+				logger.Debugf("[GridStrategy] 近期K线数量不足, 暂不计算自适应网格, id: %s, symbol: %s, account: %s",
+					s.strategy.GUID, s.strategy.Symbol, s.strategy.Account)
+			} else {
+				logger.Errorf("[GridStrategy] 重新生成自适应网格失败, id: %s, symbol: %s, account: %s, %v",
+					s.strategy.GUID, s.strategy.Symbol, s.strategy.Account, err)
+			}
+		}
+	}
+
+	// 区间提醒
+	s.checkRangeThreshold(ctx, price)
+}
+
+// NeutralTriggerReached 判断中性网格的止损止盈价格是否触发
+// 中性网格没有固定方向，触发价格低于网格中间价时向下穿越触发，高于网格中间价时向上穿越触发
+func NeutralTriggerReached(record *ent.Strategy, triggerPrice, price decimal.Decimal) bool {
+	middle := record.PriceLower.Add(record.PriceUpper).Div(decimal.NewFromInt(2))
+	if triggerPrice.LessThan(middle) {
+		return price.LessThanOrEqual(triggerPrice)
 	}
+	return price.GreaterThanOrEqual(triggerPrice)
 }
 
 func (s *GridStrategy) OnOrdersChanged(ctx context.Context) error {
@@ -94,7 +187,7 @@ func (s *GridStrategy) handleTriggerStopLossPrice(ctx context.Context, price, st
 	logger.Infof("[GridStrategy] 触发止损价格, 停止策略, id: %s, symbol: %s, account: %s, price: %s",
 		s.strategy.GUID, s.strategy.Symbol, s.strategy.Account, price.String())
 
-	err := helper.StopStrategyAndClosePosition(ctx, s.svcCtx, s.engine, s.strategy)
+	err := helper.StopStrategyAndClosePosition(ctx, s.svcCtx, s.engine, s.strategy, strategyrun.StopReasonStopLoss)
 	if err != nil {
 		logger.Errorf("[GridStrategy] 关闭仓位失败, id: %s, symbol: %s, account: %s, %v",
 			s.strategy.GUID, s.strategy.Symbol, s.strategy.Account, err)
@@ -121,7 +214,7 @@ func (s *GridStrategy) handleTriggerTakeProfitPrice(ctx context.Context, price,
 	logger.Infof("[GridStrategy] 触发止盈价格, 停止策略, id: %s, symbol: %s, account: %s, price: %s",
 		s.strategy.GUID, s.strategy.Symbol, s.strategy.Account, price.String())
 
-	err := helper.StopStrategyAndClosePosition(ctx, s.svcCtx, s.engine, s.strategy)
+	err := helper.StopStrategyAndClosePosition(ctx, s.svcCtx, s.engine, s.strategy, strategyrun.StopReasonTakeProfit)
 	if err != nil {
 		logger.Errorf("[GridStrategy] 关闭仓位失败, id: %s, symbol: %s, account: %s, %v",
 			s.strategy.GUID, s.strategy.Symbol, s.strategy.Account, err)
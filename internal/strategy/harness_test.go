@@ -0,0 +1,299 @@
+package strategy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/config"
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/exchange/paper"
+	"github.com/fachebot/omni-grid-bot/internal/helper"
+	"github.com/fachebot/omni-grid-bot/internal/svc"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	testExchange = "strategy-test"
+	testAccount  = "test"
+	testSymbol   = "BTC"
+)
+
+var testDatabaseSequence atomic.Int64
+
+func d(s string) decimal.Decimal {
+	return decimal.RequireFromString(s)
+}
+
+// testDriver 订单在撮合模拟器中成交的测试交易所驱动
+type testDriver struct {
+	svcCtx         *svc.ServiceContext
+	simulator      *paper.Simulator
+	metadata       exchange.MarketMetadata
+	candles        []exchange.Candle // 返回的历史K线
+	createOrderErr error             // 不为空时下单返回该错误
+}
+
+// testOrderHelper 可以模拟下单失败的订单操作客户端
+type testOrderHelper struct {
+	exchange.OrderHelper
+	driver *testDriver
+}
+
+func (h *testOrderHelper) CreateOrderBatch(ctx context.Context, limitOrders []exchange.CreateLimitOrderParams, marketOrders []exchange.CreateMarketOrderParams) ([]string, []string, error) {
+	if h.driver.createOrderErr != nil {
+		return nil, nil, h.driver.createOrderErr
+	}
+	return h.OrderHelper.CreateOrderBatch(ctx, limitOrders, marketOrders)
+}
+
+func (h *testOrderHelper) CreateLimitOrder(ctx context.Context, symbol string, isAsk, reduceOnly bool, price, size decimal.Decimal) (string, error) {
+	if h.driver.createOrderErr != nil {
+		return "", h.driver.createOrderErr
+	}
+	return h.OrderHelper.CreateLimitOrder(ctx, symbol, isAsk, reduceOnly, price, size)
+}
+
+func (d *testDriver) Name() string { return testExchange }
+
+func (d *testDriver) NewOrderHelper(record *ent.Strategy) (exchange.OrderHelper, error) {
+	orderHelper := helper.NewPaperOrderHelper(d.svcCtx, testExchange, d.simulator, nil, record.ExchangeApiKey)
+	return &testOrderHelper{OrderHelper: orderHelper, driver: d}, nil
+}
+
+func (d *testDriver) Subscriber() exchange.Subscriber { return nil }
+
+func (d *testDriver) GetMarketMetadata(ctx context.Context, symbol string) (exchange.MarketMetadata, error) {
+	return d.metadata, nil
+}
+
+func (d *testDriver) GetCandles(ctx context.Context, symbol string, interval time.Duration, start, end time.Time) ([]exchange.Candle, error) {
+	return d.candles, nil
+}
+
+func (d *testDriver) GetLastTradePrice(ctx context.Context, symbol string) (decimal.Decimal, error) {
+	price, ok := d.simulator.LastPrice(symbol)
+	if !ok {
+		return decimal.Zero, paper.ErrNoMarketPrice
+	}
+	return price, nil
+}
+
+func (d *testDriver) GetAccountInfo(ctx context.Context, record *ent.Strategy) (*exchange.Account, error) {
+	account := d.simulator.Account(record.ExchangeApiKey)
+	return &account, nil
+}
+
+func (d *testDriver) TestConnectivity(ctx context.Context, record *ent.Strategy) error { return nil }
+
+func (d *testDriver) MarketURL(symbol string) string { return "" }
+
+// testHarness 使用内存数据库和撮合模拟器运行生产环境的网格逻辑
+type testHarness struct {
+	t         *testing.T
+	ctx       context.Context
+	svcCtx    *svc.ServiceContext
+	driver    *testDriver
+	simulator *paper.Simulator
+	record    *ent.Strategy
+	grid      *GridStrategy
+	changed   bool
+	orderErr  error
+}
+
+// newTestSvcCtx 创建使用内存数据库和撮合模拟器的服务上下文
+func newTestSvcCtx(t *testing.T, c *config.Config) (*svc.ServiceContext, *testDriver) {
+	t.Helper()
+
+	ctx := context.Background()
+	dsn := fmt.Sprintf("file:strategy-%d?mode=memory&cache=shared&_fk=1", testDatabaseSequence.Add(1))
+	client, err := ent.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatalf("打开数据库失败: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+	if err = client.Schema.Create(ctx); err != nil {
+		t.Fatalf("创建数据表失败: %v", err)
+	}
+
+	simulator := paper.NewSimulator(paper.FeeModel{}, d("100000"))
+	driver := &testDriver{simulator: simulator, metadata: exchange.MarketMetadata{SupportedPriceDecimals: 2, SupportedSizeDecimals: 4}}
+	if c == nil {
+		c = &config.Config{}
+	}
+	svcCtx := svc.NewIsolatedServiceContext(c, client, driver)
+	driver.svcCtx = svcCtx
+	return svcCtx, driver
+}
+
+// testStrategy 生成测试策略记录，价格区间 90~110，10 格，每格 1 BTC
+func testStrategy(mode strategy.Mode) ent.Strategy {
+	return ent.Strategy{
+		GUID:               uuid.NewString(),
+		Owner:              1,
+		Exchange:           testExchange,
+		Symbol:             testSymbol,
+		Account:            testAccount,
+		Mode:               mode,
+		MarginMode:         strategy.MarginModeCross,
+		QuantityMode:       strategy.QuantityModeArithmetic,
+		PriceUpper:         d("110"),
+		PriceLower:         d("90"),
+		GridNum:            10,
+		Leverage:           1,
+		InitialOrderSize:   d("1"),
+		SizingMode:         strategy.SizingModeFixed,
+		CancelRepairPolicy: strategy.CancelRepairPolicyRepair,
+		ReconcilePolicy:    strategy.ReconcilePolicyAlert,
+		Status:             strategy.StatusInactive,
+		ExchangeApiKey:     testAccount,
+	}
+}
+
+// newTestHarness 按 price 启动策略，调用生产环境的网格初始化和再平衡逻辑
+func newTestHarness(t *testing.T, args ent.Strategy, price decimal.Decimal) *testHarness {
+	t.Helper()
+
+	svcCtx, driver := newTestSvcCtx(t, nil)
+	h := &testHarness{t: t, ctx: context.Background(), svcCtx: svcCtx, driver: driver, simulator: driver.simulator}
+	h.simulator.AddOrderHandler(h.onOrders)
+	h.simulator.OnPrice(args.Symbol, price)
+
+	record, err := svcCtx.StrategyModel.Save(h.ctx, args)
+	if err != nil {
+		t.Fatalf("保存策略失败: %v", err)
+	}
+	prices, err := GenerateGridPrices(record, 2)
+	if err != nil {
+		t.Fatalf("生成网格价格失败: %v", err)
+	}
+	quantities, err := GenerateGridQuantities(record, prices, 4)
+	if err != nil {
+		t.Fatalf("生成网格数量失败: %v", err)
+	}
+	if err = InitGridStrategy(h.ctx, svcCtx, record, prices, quantities); err != nil {
+		t.Fatalf("初始化网格策略失败: %v", err)
+	}
+
+	h.record, err = svcCtx.StrategyModel.FindOneByGUID(h.ctx, record.GUID)
+	if err != nil {
+		t.Fatalf("查询策略失败: %v", err)
+	}
+	h.grid = NewGridStrategy(svcCtx, nil, h.record)
+	h.rebalance()
+	return h
+}
+
+// onOrders 与实盘订阅器一致，先将订单写入数据库，再由再平衡逻辑读取
+func (h *testHarness) onOrders(account string, orders []*exchange.Order) {
+	for _, item := range orders {
+		err := h.svcCtx.OrderModel.Upsert(h.ctx, helper.ToPaperEntOrder(testExchange, account, item))
+		if err != nil && h.orderErr == nil {
+			h.orderErr = err
+		}
+		h.changed = true
+	}
+}
+
+// rebalance 存在订单变化时执行网格再平衡，直到没有新的成交
+func (h *testHarness) rebalance() {
+	h.t.Helper()
+	for round := 0; h.changed; round++ {
+		if h.orderErr != nil {
+			h.t.Fatalf("保存订单失败: %v", h.orderErr)
+		}
+		if round >= 100 {
+			h.t.Fatal("再平衡没有结束")
+		}
+		h.changed = false
+		if err := h.grid.OnOrdersChanged(h.ctx); err != nil && !errors.Is(err, ErrOrderCanceled) {
+			h.t.Fatalf("网格再平衡失败: %v", err)
+		}
+	}
+}
+
+// price 推送行情价格并再平衡
+func (h *testHarness) price(value string) {
+	h.t.Helper()
+	h.simulator.OnPrice(h.record.Symbol, d(value))
+	h.rebalance()
+}
+
+// partialFill 部分成交档位上的挂单并再平衡
+func (h *testHarness) partialFill(clientOrderId string, size string) {
+	h.t.Helper()
+	ord := h.order(clientOrderId)
+	if _, err := h.simulator.PartialFill(testAccount, ord.OrderId, d(size)); err != nil {
+		h.t.Fatalf("部分成交失败: %v", err)
+	}
+	h.rebalance()
+}
+
+// cancel 撤销挂单并再平衡
+func (h *testHarness) cancel(clientOrderId string) {
+	h.t.Helper()
+	ord := h.order(clientOrderId)
+	h.simulator.CancelOrders(testAccount, h.record.Symbol, []string{ord.OrderId})
+	h.rebalance()
+}
+
+// grids 查询按价格升序排列的网格
+func (h *testHarness) grids() []*ent.Grid {
+	h.t.Helper()
+	grids, err := h.svcCtx.GridModel.FindAllByStrategyIdOrderAsc(h.ctx, h.record.GUID)
+	if err != nil {
+		h.t.Fatalf("查询网格失败: %v", err)
+	}
+	return grids
+}
+
+// level 查询指定价格的网格档位
+func (h *testHarness) level(price string) *ent.Grid {
+	h.t.Helper()
+	lvl, ok := lo.Find(h.grids(), func(item *ent.Grid) bool { return item.Price.Equal(d(price)) })
+	if !ok {
+		h.t.Fatalf("网格档位 %s 不存在", price)
+	}
+	return lvl
+}
+
+// order 查询订单记录
+func (h *testHarness) order(clientOrderId string) *ent.Order {
+	h.t.Helper()
+	orders, err := h.svcCtx.OrderModel.FindAllByAccountClientOrderIds(h.ctx, testExchange, testAccount, []string{clientOrderId})
+	if err != nil || len(orders) == 0 {
+		h.t.Fatalf("查询订单 %s 失败: %v", clientOrderId, err)
+	}
+	return orders[0]
+}
+
+// levelOrder 查询档位上指定方向的挂单
+func (h *testHarness) levelOrder(price string, side order.Side) *ent.Order {
+	h.t.Helper()
+	lvl := h.level(price)
+	clientOrderId := lo.If(side == order.SideBuy, lvl.BuyClientOrderId).Else(lvl.SellClientOrderId)
+	if clientOrderId == nil {
+		h.t.Fatalf("网格档位 %s 没有%s挂单", price, side)
+	}
+	return h.order(*clientOrderId)
+}
+
+// matchedTrades 查询策略本次运行的匹配交易
+func (h *testHarness) matchedTrades() []*ent.MatchedTrade {
+	h.t.Helper()
+	trades, err := h.svcCtx.DbClient.MatchedTrade.Query().All(h.ctx)
+	if err != nil {
+		h.t.Fatalf("查询匹配交易失败: %v", err)
+	}
+	return lo.Filter(trades, func(item *ent.MatchedTrade, _ int) bool {
+		return item.StrategyId == h.record.GUID && item.RunId == nil
+	})
+}
@@ -0,0 +1,218 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/config"
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/fachebot/omni-grid-bot/internal/svc"
+	"github.com/fachebot/omni-grid-bot/internal/util"
+	"github.com/fachebot/omni-grid-bot/internal/util/format"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// RiskLimitError 超出账户或用户的风险限额，Reason 为展示给用户的原因
+type RiskLimitError struct {
+	Reason string
+}
+
+func (e *RiskLimitError) Error() string {
+	return "risk limit exceeded: " + e.Reason
+}
+
+// gridExposure 网格全部成交后的最大持仓价值，开启策略和挂单时的风险限额都按此计算
+func gridExposure(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy) (decimal.Decimal, error) {
+	grids, err := svcCtx.GridModel.FindAllByStrategyIdOrderAsc(ctx, record.GUID)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return gridsExposure(grids), nil
+}
+
+// gridsExposure 按档位价格和数量计算网格全部成交后的最大持仓价值
+func gridsExposure(grids []*ent.Grid) decimal.Decimal {
+	total := decimal.Zero
+	for _, item := range grids {
+		total = total.Add(item.Price.Mul(item.Quantity))
+	}
+	return total
+}
+
+// CheckStartRiskLimits 检查开启策略后是否超出账户和用户的风险限额
+// exposure 为新策略网格全部成交后的最大持仓价值，运行中的策略同样按网格全部成交计算
+func CheckStartRiskLimits(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy, exposure decimal.Decimal) error {
+	return checkRiskLimits(ctx, svcCtx, record, exposure, true)
+}
+
+// CheckOrderRiskLimits 检查挂出开仓订单时是否超出账户和用户的风险限额
+// 与开启策略时一致按网格全部成交后的最大持仓价值计算，开仓订单所在的档位已经计入 exposure，
+// 限额调低或移动网格抬高持仓价值后超出限额时暂停开仓；不检查运行中的策略数量
+func CheckOrderRiskLimits(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy, exposure decimal.Decimal) error {
+	return checkRiskLimits(ctx, svcCtx, record, exposure, false)
+}
+
+// checkOrderRiskLimits 检查当前网格挂出开仓订单时是否超出风险限额
+// 检查结果与订单无关，同一次再平衡只查询一次，结果缓存在状态中
+func (state *GridStrategyState) checkOrderRiskLimits() error {
+	if !state.riskChecked {
+		state.riskErr = CheckOrderRiskLimits(state.ctx, state.svcCtx, state.strategy, gridsExposure(state.sortedGrids))
+		state.riskChecked = true
+	}
+	return state.riskErr
+}
+
+// riskLimited 是否设置了任意一项限额
+func riskLimited(rule config.RiskLimitRule) bool {
+	return rule.MaxNotional > 0 || rule.MaxSymbolPosition > 0 || rule.MaxActiveStrategies > 0
+}
+
+func checkRiskLimits(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy, exposure decimal.Decimal, starting bool) error {
+	c := svcCtx.Config.RiskLimit
+	accountLimited, ownerLimited := riskLimited(c.Account), riskLimited(c.Owner)
+	if !accountLimited && !ownerLimited {
+		return nil
+	}
+
+	cache := make(map[string]decimal.Decimal)
+	exposureOf := func(item *ent.Strategy) (decimal.Decimal, error) {
+		if item.GUID == record.GUID {
+			return exposure, nil
+		}
+		if value, ok := cache[item.GUID]; ok {
+			return value, nil
+		}
+		value, err := gridExposure(ctx, svcCtx, item)
+		if err != nil {
+			return decimal.Zero, err
+		}
+		cache[item.GUID] = value
+		return value, nil
+	}
+
+	if accountLimited {
+		strategies, err := svcCtx.StrategyModel.FindAllActiveByExchangeAndAccount(ctx, record.Exchange, record.Account)
+		if err != nil {
+			return err
+		}
+		if err = checkRiskLimitRule("交易账户", c.Account, record, strategies, starting, exposureOf); err != nil {
+			return err
+		}
+	}
+
+	if ownerLimited {
+		strategies, err := svcCtx.StrategyModel.FindAllActiveByOwner(ctx, record.Owner)
+		if err != nil {
+			return err
+		}
+		if err = checkRiskLimitRule("用户", c.Owner, record, strategies, starting, exposureOf); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkRiskLimitRule 检查单个范围内的策略数量、持仓总价值和交易对持仓价值
+// 开启策略时 strategies 不包含正在开启的策略，挂单时包含当前策略
+func checkRiskLimitRule(
+	scope string,
+	rule config.RiskLimitRule,
+	record *ent.Strategy,
+	strategies []*ent.Strategy,
+	starting bool,
+	exposureOf func(item *ent.Strategy) (decimal.Decimal, error),
+) error {
+	items := make([]*ent.Strategy, 0, len(strategies)+1)
+	items = append(items, record)
+	for _, item := range strategies {
+		if item.GUID != record.GUID {
+			items = append(items, item)
+		}
+	}
+
+	if starting && rule.MaxActiveStrategies > 0 && len(items) > rule.MaxActiveStrategies {
+		return &RiskLimitError{Reason: fmt.Sprintf("%s运行中的策略数量不能超过 %d 个", scope, rule.MaxActiveStrategies)}
+	}
+
+	total, symbolTotal := decimal.Zero, decimal.Zero
+	for _, item := range items {
+		value, err := exposureOf(item)
+		if err != nil {
+			return err
+		}
+		total = total.Add(value)
+		if item.Symbol == record.Symbol {
+			symbolTotal = symbolTotal.Add(value)
+		}
+	}
+
+	if rule.MaxNotional > 0 && total.GreaterThan(decimal.NewFromFloat(rule.MaxNotional)) {
+		return &RiskLimitError{Reason: fmt.Sprintf("%s持仓总价值 %s USD 超过限额 %s USD",
+			scope, total.StringFixed(2), decimal.NewFromFloat(rule.MaxNotional))}
+	}
+	if rule.MaxSymbolPosition > 0 && symbolTotal.GreaterThan(decimal.NewFromFloat(rule.MaxSymbolPosition)) {
+		return &RiskLimitError{Reason: fmt.Sprintf("%s %s 持仓价值 %s USD 超过限额 %s USD",
+			scope, record.Symbol, symbolTotal.StringFixed(2), decimal.NewFromFloat(rule.MaxSymbolPosition))}
+	}
+
+	return nil
+}
+
+// skipOpeningOrder 开仓订单超出风险限额时不挂单，清空已成交订单所在的档位
+// 目标档位保持空闲，网格减少一个开仓档位，不影响其他档位的平仓订单
+func (state *GridStrategyState) skipOpeningOrder(level, target *ent.Grid, filled *ent.Order, quantity decimal.Decimal, reason string) error {
+	var err error
+	now := time.Now()
+	if filled.Side == order.SideBuy {
+		err = state.svcCtx.GridModel.UpdateBuyClientOrderId(state.ctx, level.ID, nil, now)
+	} else {
+		err = state.svcCtx.GridModel.UpdateSellClientOrderId(state.ctx, level.ID, nil, now)
+	}
+	if err != nil {
+		logger.Errorf("[GridStrategyState] 更新网格状态失败, level: %d, clientOrderId: %s, %v", level.ID, filled.ClientOrderId, err)
+		return err
+	}
+
+	if filled.Side == order.SideBuy {
+		level.BuyClientOrderId = nil
+	} else {
+		level.SellClientOrderId = nil
+	}
+
+	side := lo.If(filled.Side == order.SideBuy, "卖单").Else("买单")
+	logger.Warnf("[%s %s] #%d 超出风险限额, 取消下单%s, 价格: %s, 数量: %s, %s",
+		state.strategy.Symbol, state.strategy.Mode, target.Level, side, target.Price, quantity, reason)
+
+	go state.sendRiskLimitNotification(target, side, quantity, reason)
+
+	return nil
+}
+
+func (state *GridStrategyState) sendRiskLimitNotification(target *ent.Grid, side string, quantity decimal.Decimal, reason string) {
+	if !state.strategy.EnablePushNotification {
+		return
+	}
+
+	link := fmt.Sprintf("[%s](https://t.me/%s?start=%s)",
+		strategyName(state.strategy), state.svcCtx.Bot.Me.Username, state.strategy.GUID)
+	text := fmt.Sprintf("🚧 %s %s 超出风险限额，暂停开仓 %s\n\n",
+		state.strategy.Symbol, strings.ToUpper(string(state.strategy.Mode)), link)
+	text += fmt.Sprintf("🏦 交易平台: %s\n", state.strategy.Exchange)
+	text += fmt.Sprintf("🪜 网格档位: #%d %s\n", target.Level, side)
+	text += fmt.Sprintf("💥 挂单价格: *%s* USD\n", format.Price(target.Price, 5))
+	text += fmt.Sprintf("🔢 挂单数量: %s %s\n", quantity, state.strategy.Symbol)
+	text += fmt.Sprintf("❗ 拒绝原因: `%s`\n", reason)
+	text += "\n**注意**：`被跳过的档位暂不开仓，释放额度后可以重启策略恢复完整网格。`"
+
+	chatId := util.ChatId(state.strategy.Owner)
+	_, err := util.SendMarkdownMessage(state.svcCtx.Bot, chatId, text, nil)
+	if err != nil {
+		logger.Debugf("[GridStrategyState] 发送风险限额通知失败, chat: %d, %v", chatId, err)
+	}
+}
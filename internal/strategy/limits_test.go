@@ -0,0 +1,154 @@
+package strategy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/fachebot/omni-grid-bot/internal/config"
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/svc"
+)
+
+// saveRiskTestStrategy 保存运行中的策略和网格，网格全部成交后的持仓价值为 exposure
+func saveRiskTestStrategy(t *testing.T, svcCtx *svc.ServiceContext, account, symbol, exposure string) *ent.Strategy {
+	t.Helper()
+
+	ctx := context.Background()
+	args := testStrategy(strategy.ModeLong)
+	args.Account = account
+	args.Symbol = symbol
+	args.Status = strategy.StatusActive
+	record, err := svcCtx.StrategyModel.Save(ctx, args)
+	if err != nil {
+		t.Fatalf("保存策略失败: %v", err)
+	}
+
+	grids := []ent.Grid{
+		{StrategyId: record.GUID, Exchange: record.Exchange, Symbol: symbol, Account: account, Level: 0, Price: d(exposure).Div(d("2")), Quantity: d("1")},
+		{StrategyId: record.GUID, Exchange: record.Exchange, Symbol: symbol, Account: account, Level: 1, Price: d(exposure).Div(d("2")), Quantity: d("1")},
+	}
+	if err = svcCtx.GridModel.CreateBulk(ctx, grids); err != nil {
+		t.Fatalf("保存网格失败: %v", err)
+	}
+	return record
+}
+
+func TestCheckStartRiskLimits(t *testing.T) {
+	tests := []struct {
+		name     string
+		limit    config.RiskLimit
+		symbol   string
+		exposure string
+		exceeded bool
+	}{
+		{name: "未设置限额", symbol: "BTC", exposure: "100000", exceeded: false},
+		{name: "账户持仓总价值未超出", limit: config.RiskLimit{Account: config.RiskLimitRule{MaxNotional: 1500}}, symbol: "ETH", exposure: "500", exceeded: false},
+		{name: "账户持仓总价值超出", limit: config.RiskLimit{Account: config.RiskLimitRule{MaxNotional: 1500}}, symbol: "ETH", exposure: "600", exceeded: true},
+		{name: "其他账户的策略不计入账户限额", limit: config.RiskLimit{Account: config.RiskLimitRule{MaxNotional: 1100}}, symbol: "ETH", exposure: "100", exceeded: false},
+		{name: "其他账户的策略计入用户限额", limit: config.RiskLimit{Owner: config.RiskLimitRule{MaxNotional: 1500}}, symbol: "ETH", exposure: "100", exceeded: true},
+		{name: "交易对持仓价值超出", limit: config.RiskLimit{Account: config.RiskLimitRule{MaxSymbolPosition: 1200}}, symbol: "BTC", exposure: "300", exceeded: true},
+		{name: "其他交易对不计入交易对限额", limit: config.RiskLimit{Account: config.RiskLimitRule{MaxSymbolPosition: 1200}}, symbol: "ETH", exposure: "300", exceeded: false},
+		{name: "运行中的策略数量超出", limit: config.RiskLimit{Account: config.RiskLimitRule{MaxActiveStrategies: 1}}, symbol: "ETH", exposure: "1", exceeded: true},
+		{name: "运行中的策略数量未超出", limit: config.RiskLimit{Owner: config.RiskLimitRule{MaxActiveStrategies: 3}}, symbol: "ETH", exposure: "1", exceeded: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svcCtx, _ := newTestSvcCtx(t, &config.Config{RiskLimit: tt.limit})
+			saveRiskTestStrategy(t, svcCtx, testAccount, "BTC", "1000")
+			saveRiskTestStrategy(t, svcCtx, "other", "BTC", "500")
+
+			args := testStrategy(strategy.ModeLong)
+			args.Symbol = tt.symbol
+			record, err := svcCtx.StrategyModel.Save(context.Background(), args)
+			if err != nil {
+				t.Fatalf("保存策略失败: %v", err)
+			}
+
+			var limitErr *RiskLimitError
+			err = CheckStartRiskLimits(context.Background(), svcCtx, record, d(tt.exposure))
+			if err != nil && !errors.As(err, &limitErr) {
+				t.Fatalf("检查风险限额失败: %v", err)
+			}
+			if (err != nil) != tt.exceeded {
+				t.Fatalf("exceeded = %v, want %v", err != nil, tt.exceeded)
+			}
+		})
+	}
+}
+
+func TestCheckOrderRiskLimits(t *testing.T) {
+	tests := []struct {
+		name     string
+		limit    config.RiskLimit
+		exceeded bool
+	}{
+		{name: "当前策略按网格计算不重复计入", limit: config.RiskLimit{Account: config.RiskLimitRule{MaxNotional: 1500}}, exceeded: false},
+		{name: "持仓总价值超出", limit: config.RiskLimit{Account: config.RiskLimitRule{MaxNotional: 1400}}, exceeded: true},
+		{name: "交易对持仓价值超出", limit: config.RiskLimit{Owner: config.RiskLimitRule{MaxSymbolPosition: 1200}}, exceeded: true},
+		{name: "挂单时不检查运行中的策略数量", limit: config.RiskLimit{Account: config.RiskLimitRule{MaxActiveStrategies: 1}}, exceeded: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svcCtx, _ := newTestSvcCtx(t, &config.Config{RiskLimit: tt.limit})
+			saveRiskTestStrategy(t, svcCtx, testAccount, "BTC", "1000")
+			record := saveRiskTestStrategy(t, svcCtx, testAccount, "BTC", "500")
+
+			var limitErr *RiskLimitError
+			err := CheckOrderRiskLimits(context.Background(), svcCtx, record, d("500"))
+			if err != nil && !errors.As(err, &limitErr) {
+				t.Fatalf("检查风险限额失败: %v", err)
+			}
+			if (err != nil) != tt.exceeded {
+				t.Fatalf("exceeded = %v, want %v", err != nil, tt.exceeded)
+			}
+		})
+	}
+}
+
+func TestGridStrategyStateCachesRiskLimits(t *testing.T) {
+	svcCtx, _ := newTestSvcCtx(t, &config.Config{RiskLimit: config.RiskLimit{Account: config.RiskLimitRule{MaxNotional: 100}}})
+	record := saveRiskTestStrategy(t, svcCtx, testAccount, "BTC", "500")
+	grids, err := svcCtx.GridModel.FindAllByStrategyIdOrderAsc(context.Background(), record.GUID)
+	if err != nil {
+		t.Fatalf("查询网格失败: %v", err)
+	}
+
+	state := &GridStrategyState{ctx: context.Background(), svcCtx: svcCtx, strategy: record, sortedGrids: grids}
+	if err = state.checkOrderRiskLimits(); err == nil {
+		t.Fatal("超出限额时应返回错误")
+	}
+
+	// 同一次再平衡内复用检查结果，不再查询数据库
+	svcCtx.Config.RiskLimit = config.RiskLimit{}
+	if err = state.checkOrderRiskLimits(); err == nil {
+		t.Fatal("同一次再平衡应复用缓存的检查结果")
+	}
+}
+
+func TestRiskLimitSkipsOpeningOrders(t *testing.T) {
+	h := newTestHarness(t, testStrategy(strategy.ModeLong), d("100.5"))
+
+	// 价格下跌，100、98 买单开多，平仓卖单不受限额限制
+	h.price("98")
+	h.levelOrder("100", order.SideSell)
+
+	// 限额调低到网格持仓价值以下，平仓后不再挂出开仓买单
+	exposure := gridsExposure(h.grids())
+	h.svcCtx.Config.RiskLimit.Account.MaxNotional = exposure.Sub(d("1")).InexactFloat64()
+	h.price("102")
+	for _, price := range []string{"98", "100"} {
+		if lvl := h.level(price); lvl.BuyClientOrderId != nil || lvl.SellClientOrderId != nil {
+			t.Fatalf("网格档位 %s 超出限额后不应挂出开仓订单", price)
+		}
+	}
+
+	// 限额恢复后下一次开仓正常挂单
+	h.svcCtx.Config.RiskLimit.Account.MaxNotional = exposure.InexactFloat64()
+	h.price("104")
+	h.levelOrder("102", order.SideBuy)
+}
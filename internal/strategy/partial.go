@@ -0,0 +1,181 @@
+package strategy
+
+import (
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/helper"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/fachebot/omni-grid-bot/internal/model"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// DefaultPartialFillThreshold 默认部分成交处理阈值，开仓挂单成交比例达到 80% 后撤销剩余数量
+var DefaultPartialFillThreshold = decimal.NewFromFloat(0.8)
+
+// PartialFillThreshold 获取策略的部分成交处理阈值，未设置时使用默认值，设置为零时不处理挂单中的部分成交
+func PartialFillThreshold(record *ent.Strategy) decimal.Decimal {
+	return lo.FromPtrOr(record.PartialFillThreshold, DefaultPartialFillThreshold)
+}
+
+// isFilledOrder 判断订单是否已成交，部分成交后被取消的订单按已成交数量处理
+func isFilledOrder(ord *ent.Order) bool {
+	return ord.Status == order.StatusFilled || (ord.Status == order.StatusCanceled && ord.FilledBaseAmount.IsPositive())
+}
+
+// meetsMinOrderSize 判断订单数量是否满足交易所的最小下单数量和金额
+func (state *GridStrategyState) meetsMinOrderSize(price, quantity decimal.Decimal) (bool, error) {
+	mm, err := helper.GetMarketMetadata(state.ctx, state.svcCtx, state.strategy.Exchange, state.strategy.Symbol)
+	if err != nil {
+		return false, err
+	}
+	return quantity.GreaterThanOrEqual(mm.MinBaseAmount) && quantity.Mul(price).GreaterThanOrEqual(mm.MinQuoteAmount), nil
+}
+
+// handleCanceledOrder 处理被取消的网格订单
+// 没有成交的订单按修复方式处理；部分成交的开仓订单按已成交数量处理，由再平衡挂出数量对应的平仓订单；
+// 部分成交的平仓订单和成交数量低于最小下单限制的开仓订单，已成交部分先计入匹配交易，剩余数量按修复方式处理
+func (state *GridStrategyState) handleCanceledOrder(level *ent.Grid, ord *ent.Order) error {
+	if !ord.FilledBaseAmount.IsPositive() {
+		return state.repairCanceledOrder(level, ord)
+	}
+
+	closing, err := state.isClosingOrder(ord)
+	if err != nil {
+		return err
+	}
+	if closing {
+		return state.repairCanceledOrder(level, ord)
+	}
+
+	ok, err := state.meetsMinOrderSize(ord.Price, ord.FilledBaseAmount)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		logger.Warnf("[%s %s] #%d 部分成交数量低于最小下单限制, 剩余数量按取消处理, ID: %s, 成交数量: %s/%s",
+			state.strategy.Symbol, state.strategy.Mode, level.Level, ord.ClientOrderId, ord.FilledBaseAmount, ord.BaseAmount)
+		return state.repairCanceledOrder(level, ord)
+	}
+
+	logger.Infof("[%s %s] #%d 订单部分成交后取消, 按成交数量再平衡, ID: %s, 成交数量: %s/%s",
+		state.strategy.Symbol, state.strategy.Mode, level.Level, ord.ClientOrderId, ord.FilledBaseAmount, ord.BaseAmount)
+	return nil
+}
+
+// cancelPartialFilledOrder 撤销成交比例达到阈值的开仓订单的剩余数量
+// 撤单后订单变为部分成交后取消，由下一次再平衡按已成交数量挂出平仓订单；平仓订单等待完全成交
+func (state *GridStrategyState) cancelPartialFilledOrder(level *ent.Grid, ord *ent.Order) {
+	threshold := PartialFillThreshold(state.strategy)
+	if !threshold.IsPositive() || ord.Status != order.StatusOpen || !ord.BaseAmount.IsPositive() ||
+		!ord.FilledBaseAmount.IsPositive() || ord.FilledBaseAmount.GreaterThanOrEqual(ord.BaseAmount) {
+		return
+	}
+	if ord.FilledBaseAmount.Div(ord.BaseAmount).LessThan(threshold) {
+		return
+	}
+
+	closing, err := state.isClosingOrder(ord)
+	if err != nil {
+		logger.Errorf("[GridStrategyState] 查询匹配交易失败, strategy: %s, clientOrderId: %s, %v", state.strategy.GUID, ord.ClientOrderId, err)
+		return
+	}
+	if closing {
+		return
+	}
+
+	ok, err := state.meetsMinOrderSize(ord.Price, ord.FilledBaseAmount)
+	if err != nil {
+		logger.Errorf("[GridStrategyState] 获取市场元数据失败, strategy: %s, symbol: %s, %v", state.strategy.GUID, state.strategy.Symbol, err)
+		return
+	}
+	if !ok {
+		return
+	}
+
+	logger.Infof("[%s %s] #%d 订单部分成交达到阈值, 撤销剩余数量, ID: %s, 成交数量: %s/%s",
+		state.strategy.Symbol, state.strategy.Mode, level.Level, ord.ClientOrderId, ord.FilledBaseAmount, ord.BaseAmount)
+
+	if err = state.adapter.CancelOrders(state.ctx, state.strategy.Symbol, []string{ord.OrderId}); err != nil {
+		logger.Errorf("[%s %s] #%d 撤销部分成交订单错误, ID: %s, %v",
+			state.strategy.Symbol, state.strategy.Mode, level.Level, ord.ClientOrderId, err)
+	}
+}
+
+// recordPartialAmount 将被取消订单已部分成交的数量和金额计入匹配交易
+// 平仓订单累加到关联的匹配交易，重新挂出后成交时合并计入；开仓订单没有关联的匹配交易，
+// 重新挂单时创建只记录部分成交数量的匹配交易，随订单ID替换到新订单，成交时合并计入开仓数量；
+// 不再重新挂单时按已成交的开仓订单记录，计入策略持仓
+func (state *GridStrategyState) recordPartialAmount(m *model.MatchedTradeModel, ord *ent.Order, replaced bool) error {
+	if !ord.FilledBaseAmount.IsPositive() {
+		return nil
+	}
+
+	var err error
+	if ord.Side == order.SideBuy {
+		_, err = m.FindByBuyClientOrderId(state.ctx, state.strategy.GUID, ord.ClientOrderId)
+	} else {
+		_, err = m.FindBySellClientOrderId(state.ctx, state.strategy.GUID, ord.ClientOrderId)
+	}
+	switch {
+	case err == nil && ord.Side == order.SideBuy:
+		return m.AddBuyPartialAmount(state.ctx, state.strategy.GUID, ord.ClientOrderId, ord.FilledBaseAmount, ord.FilledQuoteAmount)
+	case err == nil:
+		return m.AddSellPartialAmount(state.ctx, state.strategy.GUID, ord.ClientOrderId, ord.FilledBaseAmount, ord.FilledQuoteAmount)
+	case !ent.IsNotFound(err):
+		return err
+	}
+
+	args := ent.MatchedTrade{
+		StrategyId: state.strategy.GUID,
+		Account:    state.strategy.Account,
+		Symbol:     ord.Symbol,
+	}
+	switch {
+	case ord.Side == order.SideBuy && replaced:
+		args.BuyClientOrderId = &ord.ClientOrderId
+		args.BuyPartialBaseAmount = &ord.FilledBaseAmount
+		args.BuyPartialQuoteAmount = &ord.FilledQuoteAmount
+	case ord.Side == order.SideBuy:
+		args.BuyClientOrderId = &ord.ClientOrderId
+		args.BuyBaseAmount = &ord.FilledBaseAmount
+		args.BuyQuoteAmount = &ord.FilledQuoteAmount
+		args.BuyOrderTimestamp = &ord.Timestamp
+	case replaced:
+		args.SellClientOrderId = &ord.ClientOrderId
+		args.SellPartialBaseAmount = &ord.FilledBaseAmount
+		args.SellPartialQuoteAmount = &ord.FilledQuoteAmount
+	default:
+		args.SellClientOrderId = &ord.ClientOrderId
+		args.SellBaseAmount = &ord.FilledBaseAmount
+		args.SellQuoteAmount = &ord.FilledQuoteAmount
+		args.SellOrderTimestamp = &ord.Timestamp
+	}
+	return m.Create(state.ctx, args)
+}
+
+// mergePartialAmount 合并开仓订单此前被取消时已部分成交的数量和金额
+// 匹配交易在成交时已合并计入部分成交数量，返回按合并后的数量和金额成交的订单副本，用于挂出数量对应的平仓订单
+func (state *GridStrategyState) mergePartialAmount(ord *ent.Order) (*ent.Order, error) {
+	var record *ent.MatchedTrade
+	var err error
+	if ord.Side == order.SideBuy {
+		record, err = state.svcCtx.MatchedTradeModel.FindByBuyClientOrderId(state.ctx, state.strategy.GUID, ord.ClientOrderId)
+	} else {
+		record, err = state.svcCtx.MatchedTradeModel.FindBySellClientOrderId(state.ctx, state.strategy.GUID, ord.ClientOrderId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	merged := *ord
+	if ord.Side == order.SideBuy && lo.FromPtr(record.BuyPartialBaseAmount).IsPositive() {
+		merged.FilledBaseAmount = lo.FromPtr(record.BuyBaseAmount)
+		merged.FilledQuoteAmount = lo.FromPtr(record.BuyQuoteAmount)
+	}
+	if ord.Side == order.SideSell && lo.FromPtr(record.SellPartialBaseAmount).IsPositive() {
+		merged.FilledBaseAmount = lo.FromPtr(record.SellBaseAmount)
+		merged.FilledQuoteAmount = lo.FromPtr(record.SellQuoteAmount)
+	}
+	return &merged, nil
+}
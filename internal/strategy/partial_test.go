@@ -0,0 +1,146 @@
+package strategy
+
+import (
+	"testing"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/samber/lo"
+)
+
+func TestPartialFillThreshold(t *testing.T) {
+	tests := []struct {
+		name      string
+		threshold *string
+		want      string
+	}{
+		{name: "未设置时使用默认值", threshold: nil, want: "0.8"},
+		{name: "设置为零时关闭", threshold: lo.ToPtr("0"), want: "0"},
+		{name: "使用设置的阈值", threshold: lo.ToPtr("0.5"), want: "0.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := &ent.Strategy{}
+			if tt.threshold != nil {
+				record.PartialFillThreshold = lo.ToPtr(d(*tt.threshold))
+			}
+			requireDecimal(t, "阈值", PartialFillThreshold(record), d(tt.want))
+		})
+	}
+}
+
+func TestDefaultPartialFillThreshold(t *testing.T) {
+	tests := []struct {
+		name     string
+		filled   string
+		canceled bool
+	}{
+		{name: "成交比例达到默认阈值", filled: "0.9", canceled: true},
+		{name: "成交比例低于默认阈值", filled: "0.7", canceled: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newTestHarness(t, testStrategy(strategy.ModeLong), d("100.5"))
+			h.price("98.5")
+
+			opening := h.levelOrder("98", order.SideBuy)
+			h.partialFill(opening.ClientOrderId, tt.filled)
+
+			ord := h.order(opening.ClientOrderId)
+			if canceled := ord.Status == order.StatusCanceled; canceled != tt.canceled {
+				t.Fatalf("订单状态 = %s, 撤销剩余数量 = %v", ord.Status, tt.canceled)
+			}
+			if !tt.canceled {
+				if h.level("100").SellClientOrderId != nil {
+					t.Fatal("未达到阈值时不应挂出平仓卖单")
+				}
+				return
+			}
+			requireDecimal(t, "平仓卖单数量", h.levelOrder("100", order.SideSell).BaseAmount, d(tt.filled))
+		})
+	}
+}
+
+func TestCanceledPartialFillBelowMinOrderSize(t *testing.T) {
+	tests := []struct {
+		name      string
+		mode      strategy.Mode
+		nearPrice string
+		openPrice string
+		side      order.Side
+		policy    strategy.CancelRepairPolicy
+	}{
+		{name: "做多重新挂单", mode: strategy.ModeLong, nearPrice: "98.5", openPrice: "98", side: order.SideBuy, policy: strategy.CancelRepairPolicyRepair},
+		{name: "做空重新挂单", mode: strategy.ModeShort, nearPrice: "101.5", openPrice: "102", side: order.SideSell, policy: strategy.CancelRepairPolicyRepair},
+		{name: "做多跳过档位", mode: strategy.ModeLong, nearPrice: "98.5", openPrice: "98", side: order.SideBuy, policy: strategy.CancelRepairPolicySkip},
+		{name: "做空跳过档位", mode: strategy.ModeShort, nearPrice: "101.5", openPrice: "102", side: order.SideSell, policy: strategy.CancelRepairPolicySkip},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := testStrategy(tt.mode)
+			args.CancelRepairPolicy = tt.policy
+			h := newTestHarness(t, args, d("100.5"))
+			h.driver.metadata.MinBaseAmount = d("0.5")
+			h.price(tt.nearPrice)
+
+			// 开仓订单成交 0.3 后被意外取消，成交数量低于最小下单数量
+			opening := h.levelOrder(tt.openPrice, tt.side)
+			h.partialFill(opening.ClientOrderId, "0.3")
+			h.cancel(opening.ClientOrderId)
+
+			// 重新挂单时记录在部分成交数量中，跳过档位时按已成交的开仓订单记录
+			trade, ok := lo.Find(h.matchedTrades(), func(item *ent.MatchedTrade) bool {
+				if tt.side == order.SideBuy {
+					return item.BuyPartialBaseAmount != nil || lo.FromPtr(item.BuyClientOrderId) == opening.ClientOrderId
+				}
+				return item.SellPartialBaseAmount != nil || lo.FromPtr(item.SellClientOrderId) == opening.ClientOrderId
+			})
+			if !ok {
+				t.Fatal("已成交部分应计入匹配交易")
+			}
+
+			if tt.policy == strategy.CancelRepairPolicySkip {
+				level := h.level(tt.openPrice)
+				if level.BuyClientOrderId != nil || level.SellClientOrderId != nil {
+					t.Fatal("跳过档位后档位应为空闲")
+				}
+				amount := lo.If(tt.side == order.SideBuy, trade.BuyBaseAmount).Else(trade.SellBaseAmount)
+				timestamp := lo.If(tt.side == order.SideBuy, trade.BuyOrderTimestamp).Else(trade.SellOrderTimestamp)
+				if amount == nil || timestamp == nil {
+					t.Fatalf("跳过档位时应按已成交的开仓订单记录, trade: %v", trade)
+				}
+				requireDecimal(t, "开仓数量", *amount, d("0.3"))
+				return
+			}
+
+			// 重新挂出剩余数量，匹配交易随订单ID替换到新订单
+			replaced := h.levelOrder(tt.openPrice, tt.side)
+			requireDecimal(t, "重新挂单数量", replaced.BaseAmount, d("0.7"))
+			clientOrderId := lo.If(tt.side == order.SideBuy, trade.BuyClientOrderId).Else(trade.SellClientOrderId)
+			if lo.FromPtr(clientOrderId) != replaced.ClientOrderId {
+				t.Fatalf("匹配交易订单ID = %s, want %s", lo.FromPtr(clientOrderId), replaced.ClientOrderId)
+			}
+
+			// 新订单成交后按合并后的数量挂出平仓订单，并按全部数量计算利润
+			h.price(tt.openPrice)
+			closeSide := lo.If(tt.side == order.SideBuy, order.SideSell).Else(order.SideBuy)
+			closing := h.levelOrder("100", closeSide)
+			requireDecimal(t, "平仓订单数量", closing.BaseAmount, d("1"))
+
+			h.price("100")
+			completed, ok := lo.Find(completedTrades(h.matchedTrades()), func(item *ent.MatchedTrade) bool { return item.ID == trade.ID })
+			if !ok {
+				t.Fatal("平仓订单成交后应完成配对")
+			}
+			requireDecimal(t, "买入数量", *completed.BuyBaseAmount, d("1"))
+			requireDecimal(t, "卖出数量", *completed.SellBaseAmount, d("1"))
+			if lo.FromPtr(completed.Profit) != 2 {
+				t.Fatalf("利润 = %v, want 2", lo.FromPtr(completed.Profit))
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategyrun"
+	"github.com/fachebot/omni-grid-bot/internal/helper"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/fachebot/omni-grid-bot/internal/util"
+	"github.com/fachebot/omni-grid-bot/internal/util/format"
+	"github.com/shopspring/decimal"
+)
+
+// pnlCheckInterval 按策略盈亏止损止盈的检查间隔，避免每次行情更新都查询数据库
+const pnlCheckInterval = 10 * time.Second
+
+// pnlStop 触发的盈亏止损止盈条件
+type pnlStop struct {
+	reason    strategyrun.StopReason // 停止原因
+	condition string                 // 展示给用户的触发条件
+}
+
+// pnlStopEnabled 是否设置了任意一项盈亏止损止盈条件
+func pnlStopEnabled(record *ent.Strategy) bool {
+	return isPositive(record.MaxLossAmount) || isPositive(record.MaxLossPercent) || isPositive(record.ProfitTargetAmount)
+}
+
+func isPositive(value *decimal.Decimal) bool {
+	return value != nil && value.IsPositive()
+}
+
+// pnlStopReached 判断策略总利润是否触发最大亏损或目标利润
+// margin 为策略分配的保证金，即网格全部成交后的持仓价值除以杠杆倍数，亏损比例按该保证金计算
+func pnlStopReached(record *ent.Strategy, pnl, margin decimal.Decimal) (pnlStop, bool) {
+	loss := pnl.Neg()
+	if isPositive(record.MaxLossAmount) && loss.GreaterThanOrEqual(*record.MaxLossAmount) {
+		condition := fmt.Sprintf("亏损达到 %s USD", record.MaxLossAmount)
+		return pnlStop{reason: strategyrun.StopReasonMaxLoss, condition: condition}, true
+	}
+
+	if isPositive(record.MaxLossPercent) && margin.IsPositive() {
+		threshold := margin.Mul(*record.MaxLossPercent).Div(decimal.NewFromInt(100))
+		if loss.GreaterThanOrEqual(threshold) {
+			condition := fmt.Sprintf("亏损达到保证金的 %s%% (%s USD)", record.MaxLossPercent, threshold.StringFixed(2))
+			return pnlStop{reason: strategyrun.StopReasonMaxLoss, condition: condition}, true
+		}
+	}
+
+	if isPositive(record.ProfitTargetAmount) && pnl.GreaterThanOrEqual(*record.ProfitTargetAmount) {
+		condition := fmt.Sprintf("利润达到 %s USD", record.ProfitTargetAmount)
+		return pnlStop{reason: strategyrun.StopReasonProfitTarget, condition: condition}, true
+	}
+
+	return pnlStop{}, false
+}
+
+// checkPnlStop 按策略总利润检查最大亏损和目标利润，触发后停止策略并平仓
+// 返回值: 是否已停止策略
+func (s *GridStrategy) checkPnlStop(ctx context.Context, price decimal.Decimal) bool {
+	if !pnlStopEnabled(s.strategy) || time.Now().Before(s.pnlCheckAt) {
+		return false
+	}
+	s.pnlCheckAt = time.Now().Add(pnlCheckInterval)
+
+	profit, err := helper.QueryStrategyProfit(ctx, s.svcCtx, s.strategy, price)
+	if err != nil {
+		logger.Errorf("[GridStrategy] 计算策略利润失败, id: %s, symbol: %s, account: %s, %v",
+			s.strategy.GUID, s.strategy.Symbol, s.strategy.Account, err)
+		return false
+	}
+	pnl := profit.Total()
+
+	margin := decimal.Zero
+	if isPositive(s.strategy.MaxLossPercent) {
+		exposure, err := gridExposure(ctx, s.svcCtx, s.strategy)
+		if err != nil {
+			logger.Errorf("[GridStrategy] 计算策略保证金失败, id: %s, symbol: %s, account: %s, %v",
+				s.strategy.GUID, s.strategy.Symbol, s.strategy.Account, err)
+			return false
+		}
+		margin = exposure.Div(decimal.NewFromInt(int64(max(s.strategy.Leverage, 1))))
+	}
+
+	stop, ok := pnlStopReached(s.strategy, pnl, margin)
+	if !ok {
+		return false
+	}
+
+	s.handleTriggerPnlStop(ctx, price, pnl, stop)
+	return true
+}
+
+func (s *GridStrategy) handleTriggerPnlStop(ctx context.Context, price, pnl decimal.Decimal, stop pnlStop) {
+	logger.Infof("[GridStrategy] 触发盈亏止损止盈, 停止策略, id: %s, symbol: %s, account: %s, price: %s, pnl: %s, reason: %s",
+		s.strategy.GUID, s.strategy.Symbol, s.strategy.Account, price.String(), pnl.String(), stop.reason)
+
+	err := helper.StopStrategyAndClosePosition(ctx, s.svcCtx, s.engine, s.strategy, stop.reason)
+	if err != nil {
+		logger.Errorf("[GridStrategy] 关闭仓位失败, id: %s, symbol: %s, account: %s, %v",
+			s.strategy.GUID, s.strategy.Symbol, s.strategy.Account, err)
+		return
+	}
+
+	// 发送通知消息
+	title := "📉 **%s %s** 触发最大亏损 %s\n\n"
+	if stop.reason == strategyrun.StopReasonProfitTarget {
+		title = "📈 **%s %s** 达到目标利润 %s\n\n"
+	}
+
+	chatId := util.ChatId(s.strategy.Owner)
+	name := util.StrategyName(s.strategy)
+	link := fmt.Sprintf("[%s](https://t.me/%s?start=%s)",
+		name, s.svcCtx.Bot.Me.Username, s.strategy.GUID)
+	text := fmt.Sprintf(title, s.strategy.Symbol, strings.ToUpper(string(s.strategy.Mode)), link)
+	text += fmt.Sprintf("💵 当前价格: %s\n", format.Price(price, 5))
+	text += fmt.Sprintf("💰 策略利润: %s USD\n", pnl.StringFixed(2))
+	text += fmt.Sprintf("🔔 触发条件: %s\n", stop.condition)
+	text += "\n策略已自动停止并平仓。由于市价滑点问题，可能存在平仓失败的情况，请注意检查仓位是否正常关闭。"
+	_, err = util.SendMarkdownMessage(s.svcCtx.Bot, chatId, text, nil)
+	if err != nil {
+		logger.Debugf("[GridStrategy] 发送盈亏止损止盈通知失败, chat: %d, %v", chatId, err)
+	}
+}
@@ -0,0 +1,140 @@
+package strategy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategyrun"
+	"github.com/fachebot/omni-grid-bot/internal/helper"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+func TestPnlStopReached(t *testing.T) {
+	tests := []struct {
+		name          string
+		maxLossAmount string
+		maxLossPct    string
+		profitTarget  string
+		pnl           string
+		margin        string
+		reached       bool
+		reason        strategyrun.StopReason
+	}{
+		{name: "未设置条件", pnl: "-1000", margin: "100", reached: false},
+		{name: "亏损未达到金额", maxLossAmount: "10", pnl: "-9.99", reached: false},
+		{name: "亏损达到金额", maxLossAmount: "10", pnl: "-10", reached: true, reason: strategyrun.StopReasonMaxLoss},
+		{name: "盈利时不触发最大亏损", maxLossAmount: "10", pnl: "10", reached: false},
+		{name: "亏损未达到保证金比例", maxLossPct: "20", pnl: "-19", margin: "100", reached: false},
+		{name: "亏损达到保证金比例", maxLossPct: "20", pnl: "-20", margin: "100", reached: true, reason: strategyrun.StopReasonMaxLoss},
+		{name: "保证金为零时不按比例检查", maxLossPct: "20", pnl: "-20", margin: "0", reached: false},
+		{name: "利润未达到目标", profitTarget: "50", pnl: "49.9", reached: false},
+		{name: "利润达到目标", profitTarget: "50", pnl: "50", reached: true, reason: strategyrun.StopReasonProfitTarget},
+		{name: "亏损时不触发目标利润", profitTarget: "50", pnl: "-50", reached: false},
+		{name: "同时设置时按亏损触发", maxLossAmount: "10", profitTarget: "50", pnl: "-15", reached: true, reason: strategyrun.StopReasonMaxLoss},
+		{name: "同时设置时按利润触发", maxLossAmount: "10", maxLossPct: "20", profitTarget: "50", pnl: "60", margin: "100", reached: true, reason: strategyrun.StopReasonProfitTarget},
+	}
+
+	optional := func(s string) *decimal.Decimal {
+		if s == "" {
+			return nil
+		}
+		return lo.ToPtr(d(s))
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := &ent.Strategy{
+				MaxLossAmount:      optional(tt.maxLossAmount),
+				MaxLossPercent:     optional(tt.maxLossPct),
+				ProfitTargetAmount: optional(tt.profitTarget),
+			}
+			margin := decimal.Zero
+			if tt.margin != "" {
+				margin = d(tt.margin)
+			}
+
+			stop, reached := pnlStopReached(record, d(tt.pnl), margin)
+			if reached != tt.reached || stop.reason != tt.reason {
+				t.Fatalf("pnlStopReached = %v, %q, want %v, %q", reached, stop.reason, tt.reached, tt.reason)
+			}
+		})
+	}
+}
+
+func TestStrategyProfitStops(t *testing.T) {
+	tests := []struct {
+		name    string
+		mode    strategy.Mode
+		price   string
+		fee     float64
+		pnl     string
+		reached bool
+		reason  strategyrun.StopReason
+	}{
+		{name: "做多下跌亏损", mode: strategy.ModeLong, price: "95", pnl: "-3", reached: true, reason: strategyrun.StopReasonMaxLoss},
+		{name: "做多亏损未达到", mode: strategy.ModeLong, price: "95.5", pnl: "-2.5", reached: false},
+		{name: "做多计入手续费后亏损达到", mode: strategy.ModeLong, price: "95.5", fee: 0.5, pnl: "-3", reached: true, reason: strategyrun.StopReasonMaxLoss},
+		{name: "做多上涨盈利", mode: strategy.ModeLong, price: "110", pnl: "12", reached: true, reason: strategyrun.StopReasonProfitTarget},
+		{name: "做空下跌盈利", mode: strategy.ModeShort, price: "95", pnl: "11", reached: true, reason: strategyrun.StopReasonProfitTarget},
+		{name: "做空计入手续费后利润未达到", mode: strategy.ModeShort, price: "96", fee: 0.5, pnl: "9.5", reached: false},
+		{name: "做空上涨亏损", mode: strategy.ModeShort, price: "110", pnl: "-4", reached: true, reason: strategyrun.StopReasonMaxLoss},
+		{name: "中性多空浮动盈亏抵消", mode: strategy.ModeNeutral, price: "95", pnl: "6", reached: false},
+		{name: "中性上涨", mode: strategy.ModeNeutral, price: "110", pnl: "6", reached: false},
+		{name: "中性计入手续费", mode: strategy.ModeNeutral, price: "102", fee: 0.5, pnl: "5.5", reached: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			svcCtx, _ := newTestSvcCtx(t, nil)
+			args := testStrategy(tt.mode)
+			args.MaxLossAmount = lo.ToPtr(d("3"))
+			args.ProfitTargetAmount = lo.ToPtr(d("10"))
+			record, err := svcCtx.StrategyModel.Save(ctx, args)
+			if err != nil {
+				t.Fatalf("保存策略失败: %v", err)
+			}
+
+			// 已配对交易 98 买入 100 卖出，利润 2；未平仓多单 1@100，未平仓空单 1@104
+			trades := []ent.MatchedTrade{
+				{
+					BuyBaseAmount: lo.ToPtr(d("1")), BuyQuoteAmount: lo.ToPtr(d("98")), BuyOrderTimestamp: lo.ToPtr(int64(1)),
+					SellBaseAmount: lo.ToPtr(d("1")), SellQuoteAmount: lo.ToPtr(d("100")), SellOrderTimestamp: lo.ToPtr(int64(2)),
+					Profit: lo.ToPtr(2.0),
+				},
+				{BuyBaseAmount: lo.ToPtr(d("1")), BuyQuoteAmount: lo.ToPtr(d("100")), BuyOrderTimestamp: lo.ToPtr(int64(3))},
+				{SellBaseAmount: lo.ToPtr(d("1")), SellQuoteAmount: lo.ToPtr(d("104")), SellOrderTimestamp: lo.ToPtr(int64(4))},
+			}
+			for _, item := range trades {
+				item.StrategyId, item.Account, item.Symbol = record.GUID, record.Account, record.Symbol
+				if err = svcCtx.MatchedTradeModel.Create(ctx, item); err != nil {
+					t.Fatalf("保存匹配交易失败: %v", err)
+				}
+			}
+			if tt.fee > 0 {
+				completed, err := svcCtx.DbClient.MatchedTrade.Query().All(ctx)
+				if err != nil {
+					t.Fatalf("查询匹配交易失败: %v", err)
+				}
+				item := completedTrades(completed)[0]
+				if err = svcCtx.MatchedTradeModel.UpdateFee(ctx, item.ID, tt.fee, *item.Profit-tt.fee); err != nil {
+					t.Fatalf("更新手续费失败: %v", err)
+				}
+			}
+
+			profit, err := helper.QueryStrategyProfit(ctx, svcCtx, record, d(tt.price))
+			if err != nil {
+				t.Fatalf("计算策略利润失败: %v", err)
+			}
+			requireDecimal(t, "策略利润", profit.Total(), d(tt.pnl))
+
+			stop, reached := pnlStopReached(record, profit.Total(), decimal.Zero)
+			if reached != tt.reached || stop.reason != tt.reason {
+				t.Fatalf("pnlStopReached = %v, %q, want %v, %q", reached, stop.reason, tt.reached, tt.reason)
+			}
+		})
+	}
+}
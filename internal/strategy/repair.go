@@ -0,0 +1,239 @@
+package strategy
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/ent/orderrepair"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/fachebot/omni-grid-bot/internal/model"
+	"github.com/fachebot/omni-grid-bot/internal/util"
+	"github.com/fachebot/omni-grid-bot/internal/util/format"
+	"github.com/samber/lo"
+)
+
+// DefaultCancelRepairMaxAttempts 默认同一档位订单被意外取消后的最大修复次数
+const DefaultCancelRepairMaxAttempts = 3
+
+// CancelRepairMaxAttempts 获取策略的最大修复次数，未设置时使用默认值
+func CancelRepairMaxAttempts(record *ent.Strategy) int {
+	return lo.FromPtrOr(record.CancelRepairMaxAttempts, DefaultCancelRepairMaxAttempts)
+}
+
+// repairCanceledOrder 按策略的修复方式处理意外取消的网格订单
+// 重新挂单: 在同一档位按剩余数量重新挂单，修复链超过最大修复次数后停止策略
+// 跳过档位: 清空档位的挂单，等待相邻档位成交后重新挂单
+// 停止策略: 返回 ErrOrderCanceled，由策略引擎停止策略
+// 订单已部分成交的数量先计入匹配交易，每次处理都会写入修复记录，修复和跳过会发送通知
+func (state *GridStrategyState) repairCanceledOrder(level *ent.Grid, ord *ent.Order) error {
+	logger.Warnf("[GridStrategyState] 订单意外取消, strategy: %s, symbol: %s, level: %d, clientOrderId: %s, policy: %s",
+		state.strategy.GUID, state.strategy.Symbol, level.Level, ord.ClientOrderId, state.strategy.CancelRepairPolicy)
+
+	lastAttempt, err := state.svcCtx.OrderRepairModel.QueryLastAttempt(state.ctx, state.strategy.GUID, ord.ClientOrderId)
+	if err != nil {
+		return err
+	}
+
+	quantity := ord.BaseAmount.Sub(ord.FilledBaseAmount)
+	if !quantity.IsPositive() {
+		quantity = ord.BaseAmount
+	}
+	args := ent.OrderRepair{
+		StrategyId:            state.strategy.GUID,
+		Exchange:              state.strategy.Exchange,
+		Symbol:                state.strategy.Symbol,
+		Account:               state.strategy.Account,
+		Level:                 level.Level,
+		Side:                  orderrepair.Side(ord.Side),
+		Price:                 level.Price,
+		Quantity:              quantity,
+		CanceledClientOrderId: ord.ClientOrderId,
+		Attempt:               lastAttempt,
+	}
+
+	maxAttempts := CancelRepairMaxAttempts(state.strategy)
+	switch {
+	case state.strategy.CancelRepairPolicy == strategy.CancelRepairPolicySkip:
+		args.Action = orderrepair.ActionSkip
+		return state.skipCanceledOrder(level, ord, args)
+	case state.strategy.CancelRepairPolicy == strategy.CancelRepairPolicyRepair && lastAttempt < maxAttempts:
+		args.Action = orderrepair.ActionRepair
+		args.Attempt = lastAttempt + 1
+		return state.replaceCanceledOrder(level, ord, args, maxAttempts)
+	}
+
+	args.Action = orderrepair.ActionStop
+	if state.strategy.CancelRepairPolicy == strategy.CancelRepairPolicyRepair {
+		args.Reason = lo.ToPtr(fmt.Sprintf("超过最大修复次数 %d", maxAttempts))
+	}
+	err = util.Tx(state.ctx, state.svcCtx.DbClient, func(tx *ent.Tx) error {
+		if err := state.recordPartialAmount(model.NewMatchedTradeModel(tx.MatchedTrade), ord, false); err != nil {
+			return err
+		}
+		_, err := model.NewOrderRepairModel(tx.OrderRepair).Create(state.ctx, args)
+		return err
+	})
+	if err != nil {
+		logger.Errorf("[GridStrategyState] 保存修复记录失败, strategy: %s, clientOrderId: %s, %v",
+			state.strategy.GUID, ord.ClientOrderId, err)
+	}
+	return ErrOrderCanceled
+}
+
+// skipCanceledOrder 清空被取消订单所在档位的挂单
+func (state *GridStrategyState) skipCanceledOrder(level *ent.Grid, ord *ent.Order, args ent.OrderRepair) error {
+	now := time.Now()
+	var record *ent.OrderRepair
+	err := util.Tx(state.ctx, state.svcCtx.DbClient, func(tx *ent.Tx) error {
+		var err error
+		m := model.NewGridModel(tx.Grid)
+		if ord.Side == order.SideBuy {
+			err = m.UpdateBuyClientOrderId(state.ctx, level.ID, nil, now)
+		} else {
+			err = m.UpdateSellClientOrderId(state.ctx, level.ID, nil, now)
+		}
+		if err != nil {
+			return err
+		}
+		if err = state.recordPartialAmount(model.NewMatchedTradeModel(tx.MatchedTrade), ord, false); err != nil {
+			return err
+		}
+
+		record, err = model.NewOrderRepairModel(tx.OrderRepair).Create(state.ctx, args)
+		return err
+	})
+	if err != nil {
+		logger.Errorf("[GridStrategyState] 跳过档位失败, strategy: %s, level: %d, clientOrderId: %s, %v",
+			state.strategy.GUID, level.Level, ord.ClientOrderId, err)
+		return err
+	}
+
+	logger.Infof("[%s %s] #%d 跳过被取消的订单, ID: %s", state.strategy.Symbol, state.strategy.Mode, level.Level, ord.ClientOrderId)
+
+	if ord.Side == order.SideBuy {
+		level.BuyClientOrderId = nil
+	} else {
+		level.SellClientOrderId = nil
+	}
+	go state.sendOrderRepairNotification(record, 0)
+
+	return nil
+}
+
+// replaceCanceledOrder 在被取消订单所在档位重新挂单
+// 同步替换匹配交易记录关联的订单ID并计入已部分成交的数量，挂单失败同样记为一次修复尝试
+func (state *GridStrategyState) replaceCanceledOrder(level *ent.Grid, ord *ent.Order, args ent.OrderRepair, maxAttempts int) error {
+	isAsk := ord.Side == order.SideSell
+	clientOrderId, err := state.adapter.CreateLimitOrder(state.ctx, state.strategy.Symbol, isAsk, false, args.Price, args.Quantity)
+	if err != nil {
+		logger.Errorf("[%s %s] #%d 重新挂单错误, 价格: %s, 数量: %s, %v",
+			state.strategy.Symbol, state.strategy.Mode, level.Level, args.Price, args.Quantity, err)
+
+		args.Reason = lo.ToPtr(err.Error())
+		record, createErr := state.svcCtx.OrderRepairModel.Create(state.ctx, args)
+		if createErr != nil {
+			logger.Errorf("[GridStrategyState] 保存修复记录失败, strategy: %s, clientOrderId: %s, %v",
+				state.strategy.GUID, ord.ClientOrderId, createErr)
+		} else {
+			go state.sendOrderRepairNotification(record, maxAttempts)
+		}
+		return err
+	}
+
+	logger.Infof("[%s %s] #%d 重新挂单, clientOrderId: %s, 价格: %s, 数量: %s, 第 %d/%d 次",
+		state.strategy.Symbol, state.strategy.Mode, level.Level, clientOrderId, args.Price, args.Quantity, args.Attempt, maxAttempts)
+
+	// 更新数据状态
+	now := time.Now()
+	args.NewClientOrderId = &clientOrderId
+	var record *ent.OrderRepair
+	err = util.Tx(state.ctx, state.svcCtx.DbClient, func(tx *ent.Tx) error {
+		m := model.NewGridModel(tx.Grid)
+		matchedTradeModel := model.NewMatchedTradeModel(tx.MatchedTrade)
+		if err := state.recordPartialAmount(matchedTradeModel, ord, true); err != nil {
+			return err
+		}
+		if isAsk {
+			if err := m.UpdateSellClientOrderId(state.ctx, level.ID, &clientOrderId, now); err != nil {
+				return err
+			}
+			err = matchedTradeModel.ReplaceSellClientOrderId(state.ctx, state.strategy.GUID, ord.ClientOrderId, clientOrderId)
+		} else {
+			if err := m.UpdateBuyClientOrderId(state.ctx, level.ID, &clientOrderId, now); err != nil {
+				return err
+			}
+			err = matchedTradeModel.ReplaceBuyClientOrderId(state.ctx, state.strategy.GUID, ord.ClientOrderId, clientOrderId)
+		}
+		if err != nil {
+			return err
+		}
+
+		record, err = model.NewOrderRepairModel(tx.OrderRepair).Create(state.ctx, args)
+		return err
+	})
+	if err != nil {
+		logger.Errorf("[GridStrategyState] 更新网格状态失败, level: %d, canceledClientOrderId: %s, clientOrderId: %s, %v",
+			level.ID, ord.ClientOrderId, clientOrderId, err)
+		return err
+	}
+
+	if isAsk {
+		level.SellClientOrderId = &clientOrderId
+	} else {
+		level.BuyClientOrderId = &clientOrderId
+	}
+	state.svcCtx.PendingOrdersCache.Add(state.strategy.Exchange, state.strategy.Account, clientOrderId)
+	go state.sendOrderRepairNotification(record, maxAttempts)
+
+	return nil
+}
+
+func (state *GridStrategyState) sendOrderRepairNotification(record *ent.OrderRepair, maxAttempts int) {
+	if !state.strategy.EnablePushNotification {
+		return
+	}
+
+	link := fmt.Sprintf("[%s](https://t.me/%s?start=%s)",
+		strategyName(state.strategy), state.svcCtx.Bot.Me.Username, state.strategy.GUID)
+
+	side := lo.If(record.Side == orderrepair.SideBuy, "买单").Else("卖单")
+	var text string
+	switch {
+	case record.Action == orderrepair.ActionSkip:
+		text = fmt.Sprintf("⏭️ %s %s 订单被取消，已跳过档位 %s\n\n",
+			state.strategy.Symbol, strings.ToUpper(string(state.strategy.Mode)), link)
+	case record.NewClientOrderId == nil:
+		text = fmt.Sprintf("⚠️ %s %s 订单被取消，重新挂单失败 %s\n\n",
+			state.strategy.Symbol, strings.ToUpper(string(state.strategy.Mode)), link)
+	default:
+		text = fmt.Sprintf("🔧 %s %s 订单被取消，已重新挂单 %s\n\n",
+			state.strategy.Symbol, strings.ToUpper(string(state.strategy.Mode)), link)
+	}
+	text += fmt.Sprintf("🏦 交易平台: %s\n", state.strategy.Exchange)
+	text += fmt.Sprintf("🪜 网格档位: #%d %s\n", record.Level, side)
+	text += fmt.Sprintf("💥 挂单价格: *%s* USD\n", format.Price(record.Price, 5))
+	text += fmt.Sprintf("🔢 挂单数量: %s %s\n", record.Quantity, state.strategy.Symbol)
+	text += fmt.Sprintf("🆔 取消订单: `%s`\n", record.CanceledClientOrderId)
+	if record.NewClientOrderId != nil {
+		text += fmt.Sprintf("🆕 新订单: `%s`\n", *record.NewClientOrderId)
+	}
+	if record.Action == orderrepair.ActionRepair {
+		text += fmt.Sprintf("🔁 修复次数: %d/%d\n", record.Attempt, maxAttempts)
+	}
+	if record.Reason != nil {
+		text += fmt.Sprintf("❗ 失败原因: `%s`\n", *record.Reason)
+	}
+	if record.Action == orderrepair.ActionSkip {
+		text += "\n**注意**：`被跳过的订单如为平仓订单，对应仓位需要手动处理。`"
+	}
+
+	chatId := util.ChatId(state.strategy.Owner)
+	_, err := util.SendMarkdownMessage(state.svcCtx.Bot, chatId, text, nil)
+	if err != nil {
+		logger.Debugf("[GridStrategyState] 发送订单修复通知失败, chat: %d, %v", chatId, err)
+	}
+}
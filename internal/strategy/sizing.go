@@ -0,0 +1,155 @@
+package strategy
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// DefaultPyramidFactor 默认金字塔加仓系数，每远离锚定价格一个档位增加的单笔数量比例
+	DefaultPyramidFactor = decimal.NewFromFloat(0.1)
+
+	// DefaultGeometricSizingFactor 默认等比加仓倍数，每远离锚定价格一个档位单笔数量乘以该倍数
+	DefaultGeometricSizingFactor = decimal.NewFromFloat(1.2)
+)
+
+// ParseSizingWeights 解析自定义权重列表，权重以逗号或空格分隔，必须大于0
+func ParseSizingWeights(text string) ([]decimal.Decimal, error) {
+	fields := strings.FieldsFunc(text, func(r rune) bool {
+		return r == ',' || r == '，' || r == ' '
+	})
+	if len(fields) == 0 {
+		return nil, ErrInvalidSizingWeights
+	}
+
+	weights := make([]decimal.Decimal, 0, len(fields))
+	for _, item := range fields {
+		w, err := decimal.NewFromString(item)
+		if err != nil || !w.IsPositive() {
+			return nil, ErrInvalidSizingWeights
+		}
+		weights = append(weights, w)
+	}
+	return weights, nil
+}
+
+// sizingAnchor 仓位曲线的锚定价格
+// 做多以入场价格或最高的开仓档位为锚点，做空以入场价格或最低的开仓档位为锚点，中性以区间中间价为锚点
+func sizingAnchor(record *ent.Strategy, prices []decimal.Decimal) decimal.Decimal {
+	entryPrice := lo.FromPtrOr(record.EntryPrice, decimal.Zero)
+	switch record.Mode {
+	case strategy.ModeLong:
+		if entryPrice.IsPositive() {
+			return entryPrice
+		}
+		return prices[max(len(prices)-2, 0)]
+	case strategy.ModeShort:
+		if entryPrice.IsPositive() {
+			return entryPrice
+		}
+		return prices[min(1, len(prices)-1)]
+	default:
+		return prices[0].Add(prices[len(prices)-1]).Div(decimal.NewFromInt(2))
+	}
+}
+
+// GenerateGridQuantities 按仓位曲线生成每个网格档位的下单数量
+// prices 按价格升序排列，档位按距离锚定价格由近到远排序，排序越靠后的档位按仓位曲线分配越大的数量:
+// 金字塔 = 单笔数量 * (1 + 系数 * 排序)，等比 = 单笔数量 * 倍数^排序，自定义 = 单笔数量 * 权重(超出权重列表时使用最后一个权重)，
+// 等额 = 单笔数量 * 锚定价格 / 档位价格，即每个档位投入相同的USD金额；数量按交易所精度向下截断
+func GenerateGridQuantities(record *ent.Strategy, prices []decimal.Decimal, sizeDecimals uint8) ([]decimal.Decimal, error) {
+	if len(prices) == 0 {
+		return nil, nil
+	}
+
+	var weights []decimal.Decimal
+	if record.SizingMode == strategy.SizingModeCustom {
+		var err error
+		weights, err = ParseSizingWeights(lo.FromPtr(record.SizingWeights))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	factor := lo.FromPtr(record.SizingFactor)
+	switch record.SizingMode {
+	case strategy.SizingModePyramid:
+		if record.SizingFactor == nil {
+			factor = DefaultPyramidFactor
+		}
+		if factor.IsNegative() {
+			return nil, ErrInvalidSizingFactor
+		}
+	case strategy.SizingModeGeometric:
+		if record.SizingFactor == nil {
+			factor = DefaultGeometricSizingFactor
+		}
+		if !factor.IsPositive() {
+			return nil, ErrInvalidSizingFactor
+		}
+	}
+
+	// 按距离锚定价格由近到远排序，距离相同的档位排序相同
+	anchor := sizingAnchor(record, prices)
+	distances := lo.Map(prices, func(item decimal.Decimal, _ int) decimal.Decimal { return item.Sub(anchor).Abs() })
+	order := lo.Range(len(prices))
+	slices.SortStableFunc(order, func(a, b int) int { return distances[a].Cmp(distances[b]) })
+	ranks := make([]int, len(prices))
+	for i := 1; i < len(order); i++ {
+		ranks[order[i]] = ranks[order[i-1]]
+		if !distances[order[i]].Equal(distances[order[i-1]]) {
+			ranks[order[i]]++
+		}
+	}
+
+	quantities := make([]decimal.Decimal, 0, len(prices))
+	for idx, price := range prices {
+		rank := decimal.NewFromInt(int64(ranks[idx]))
+
+		weight := decimal.NewFromInt(1)
+		switch record.SizingMode {
+		case strategy.SizingModePyramid:
+			weight = weight.Add(factor.Mul(rank))
+		case strategy.SizingModeGeometric:
+			weight = factor.Pow(rank)
+		case strategy.SizingModeEqualNotional:
+			if price.IsPositive() {
+				weight = anchor.Div(price)
+			}
+		case strategy.SizingModeCustom:
+			weight = weights[min(ranks[idx], len(weights)-1)]
+		}
+
+		quantities = append(quantities, record.InitialOrderSize.Mul(weight).RoundDown(int32(sizeDecimals)))
+	}
+	return quantities, nil
+}
+
+// ValidateGridQuantities 检查每个档位的下单数量和下单金额是否满足交易所的最小限制
+func ValidateGridQuantities(prices, quantities []decimal.Decimal, mm exchange.MarketMetadata) error {
+	for idx, quantity := range quantities {
+		if !quantity.IsPositive() || quantity.LessThan(mm.MinBaseAmount) {
+			return fmt.Errorf("%w: level %d, size %s", ErrOrderSizeTooSmall, idx, quantity)
+		}
+		if quantity.Mul(prices[idx]).LessThan(mm.MinQuoteAmount) {
+			return fmt.Errorf("%w: level %d, value %s", ErrOrderValueTooSmall, idx, quantity.Mul(prices[idx]))
+		}
+	}
+	return nil
+}
+
+// GridInvestment 计算全部网格档位的下单总金额
+func GridInvestment(prices, quantities []decimal.Decimal) decimal.Decimal {
+	total := decimal.Zero
+	for idx, price := range prices {
+		total = total.Add(price.Mul(quantities[idx]))
+	}
+	return total
+}
@@ -0,0 +1,113 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/fachebot/omni-grid-bot/internal/util"
+	"github.com/fachebot/omni-grid-bot/internal/util/format"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	tele "gopkg.in/telebot.v4"
+)
+
+// rangeThresholdReached 判断价格是否接近或穿越网格区间边界
+// 价格距离边界小于 buffer(%) 时触发，buffer 为零时只在价格穿越边界时触发，缓冲区重叠时按价格在区间中间价的哪一侧判断边界
+// 返回值: 是否触发提醒，是否为上边界
+func rangeThresholdReached(record *ent.Strategy, price, buffer decimal.Decimal) (bool, bool) {
+	if !record.PriceLower.IsPositive() || !record.PriceUpper.IsPositive() {
+		return false, false
+	}
+
+	ratio := buffer.Div(decimal.NewFromInt(100))
+	middle := record.PriceLower.Add(record.PriceUpper).Div(decimal.NewFromInt(2))
+	if price.GreaterThanOrEqual(middle) {
+		threshold := record.PriceUpper.Mul(decimal.NewFromInt(1).Sub(ratio))
+		return price.GreaterThanOrEqual(threshold), true
+	}
+
+	threshold := record.PriceLower.Mul(decimal.NewFromInt(1).Add(ratio))
+	return price.LessThanOrEqual(threshold), false
+}
+
+// checkRangeThreshold 价格接近或穿越网格区间边界时发送提醒
+// 同一边界按 lastLowerThresholdAlertTime、lastUpperThresholdAlertTime 限制重复提醒的间隔
+func (s *GridStrategy) checkRangeThreshold(ctx context.Context, price decimal.Decimal) {
+	c := s.svcCtx.Config.RangeAlert
+	if c.Buffer == nil || *c.Buffer < 0 || !s.strategy.EnablePushNotification {
+		return
+	}
+
+	reached, upper := rangeThresholdReached(s.strategy, price, decimal.NewFromFloat(*c.Buffer))
+	if !reached {
+		return
+	}
+
+	now := time.Now()
+	lastAlertTime := lo.If(upper, s.strategy.LastUpperThresholdAlertTime).Else(s.strategy.LastLowerThresholdAlertTime)
+	if lastAlertTime != nil && now.Sub(*lastAlertTime) < time.Duration(c.Interval)*time.Second {
+		return
+	}
+
+	var err error
+	if upper {
+		err = s.svcCtx.StrategyModel.UpdateLastUpperThresholdAlertTime(ctx, s.strategy.ID, now)
+	} else {
+		err = s.svcCtx.StrategyModel.UpdateLastLowerThresholdAlertTime(ctx, s.strategy.ID, now)
+	}
+	if err != nil {
+		logger.Errorf("[GridStrategy] 更新区间提醒时间失败, id: %s, symbol: %s, account: %s, %v",
+			s.strategy.GUID, s.strategy.Symbol, s.strategy.Account, err)
+		return
+	}
+
+	if upper {
+		s.strategy.LastUpperThresholdAlertTime = &now
+	} else {
+		s.strategy.LastLowerThresholdAlertTime = &now
+	}
+
+	logger.Infof("[GridStrategy] 价格接近网格区间边界, id: %s, symbol: %s, price: %s, range: %s~%s",
+		s.strategy.GUID, s.strategy.Symbol, price, s.strategy.PriceLower, s.strategy.PriceUpper)
+
+	go s.sendRangeAlert(price, upper)
+}
+
+func (s *GridStrategy) sendRangeAlert(price decimal.Decimal, upper bool) {
+	boundary := lo.If(upper, s.strategy.PriceUpper).Else(s.strategy.PriceLower)
+	crossed := lo.If(upper, price.GreaterThanOrEqual(boundary)).Else(price.LessThanOrEqual(boundary))
+
+	var title string
+	switch {
+	case upper && crossed:
+		title = "🚨 **%s %s** 价格突破网格上限 %s\n\n"
+	case upper:
+		title = "⚠️ **%s %s** 价格接近网格上限 %s\n\n"
+	case crossed:
+		title = "🚨 **%s %s** 价格跌破网格下限 %s\n\n"
+	default:
+		title = "⚠️ **%s %s** 价格接近网格下限 %s\n\n"
+	}
+
+	chatId := util.ChatId(s.strategy.Owner)
+	link := fmt.Sprintf("[%s](https://t.me/%s?start=%s)",
+		util.StrategyName(s.strategy), s.svcCtx.Bot.Me.Username, s.strategy.GUID)
+	text := fmt.Sprintf(title, s.strategy.Symbol, strings.ToUpper(string(s.strategy.Mode)), link)
+	text += fmt.Sprintf("💵 当前价格: %s\n", format.Price(price, 5))
+	text += fmt.Sprintf("📏 网格区间: %s ~ %s\n", format.Price(s.strategy.PriceLower, 5), format.Price(s.strategy.PriceUpper, 5))
+	text += fmt.Sprintf("📐 距离边界: %s%%\n", price.Sub(boundary).Abs().Div(boundary).Mul(decimal.NewFromInt(100)).StringFixed(2))
+	text += "\n价格离开网格区间后网格不再成交，可以设置移动网格扩展区间，或者停止策略。"
+
+	var replyMarkup *tele.ReplyMarkup
+	if s.svcCtx.ReplyMarkups != nil {
+		replyMarkup = s.svcCtx.ReplyMarkups.RangeAlertReplyMarkup(s.strategy)
+	}
+	_, err := util.SendMarkdownMessage(s.svcCtx.Bot, chatId, text, replyMarkup)
+	if err != nil {
+		logger.Debugf("[GridStrategy] 发送区间提醒失败, chat: %d, %v", chatId, err)
+	}
+}
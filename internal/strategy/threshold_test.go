@@ -0,0 +1,44 @@
+package strategy
+
+import (
+	"testing"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+)
+
+func TestRangeThresholdReached(t *testing.T) {
+	tests := []struct {
+		name    string
+		lower   string
+		upper   string
+		price   string
+		buffer  string
+		reached bool
+		isUpper bool
+	}{
+		{name: "区间中间不提醒", lower: "90", upper: "110", price: "100", buffer: "1", reached: false, isUpper: true},
+		{name: "接近上限", lower: "90", upper: "110", price: "109", buffer: "1", reached: true, isUpper: true},
+		{name: "未进入上限缓冲区", lower: "90", upper: "110", price: "108.8", buffer: "1", reached: false, isUpper: true},
+		{name: "突破上限", lower: "90", upper: "110", price: "115", buffer: "1", reached: true, isUpper: true},
+		{name: "接近下限", lower: "90", upper: "110", price: "90.9", buffer: "1", reached: true, isUpper: false},
+		{name: "跌破下限", lower: "90", upper: "110", price: "85", buffer: "1", reached: true, isUpper: false},
+		{name: "缓冲区为零时接近上限不提醒", lower: "90", upper: "110", price: "109.9", buffer: "0", reached: false, isUpper: true},
+		{name: "缓冲区为零时触及上限提醒", lower: "90", upper: "110", price: "110", buffer: "0", reached: true, isUpper: true},
+		{name: "缓冲区为零时接近下限不提醒", lower: "90", upper: "110", price: "90.1", buffer: "0", reached: false, isUpper: false},
+		{name: "缓冲区为零时跌破下限提醒", lower: "90", upper: "110", price: "89", buffer: "0", reached: true, isUpper: false},
+		{name: "缓冲区重叠时按中间价判断上边界", lower: "99", upper: "101", price: "100.5", buffer: "5", reached: true, isUpper: true},
+		{name: "缓冲区重叠时按中间价判断下边界", lower: "99", upper: "101", price: "99.5", buffer: "5", reached: true, isUpper: false},
+		{name: "区间未设置", lower: "0", upper: "0", price: "100", buffer: "1", reached: false, isUpper: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := &ent.Strategy{Mode: strategy.ModeLong, PriceLower: d(tt.lower), PriceUpper: d(tt.upper)}
+			reached, isUpper := rangeThresholdReached(record, d(tt.price), d(tt.buffer))
+			if reached != tt.reached || isUpper != tt.isUpper {
+				t.Fatalf("rangeThresholdReached = %v, %v, want %v, %v", reached, isUpper, tt.reached, tt.isUpper)
+			}
+		})
+	}
+}
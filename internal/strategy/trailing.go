@@ -0,0 +1,359 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/helper"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/fachebot/omni-grid-bot/internal/model"
+	"github.com/fachebot/omni-grid-bot/internal/util"
+	"github.com/fachebot/omni-grid-bot/internal/util/format"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+// trailingRetryInterval 移动网格失败后的重试间隔
+const trailingRetryInterval = time.Minute
+
+// trailingPlan 移动网格计划
+type trailingPlan struct {
+	up         bool              // 是否向上平移
+	prices     []decimal.Decimal // 新增档位价格，按距离原区间由近到远排列
+	quantities []decimal.Decimal // 新增档位下单数量，与 prices 一一对应
+	priceLower decimal.Decimal   // 平移后的价格下限
+	priceUpper decimal.Decimal   // 平移后的价格上限
+}
+
+// offsetGridPrice 按网格间距计算 base 平移 n 个档位后的价格，n 为负数时向下平移
+func offsetGridPrice(record *ent.Strategy, base decimal.Decimal, n int) decimal.Decimal {
+	if record.QuantityMode == strategy.QuantityModeGeometric {
+		ratio := calculateGeometricRatio(record.PriceLower, record.PriceUpper, record.GridNum)
+		return base.Mul(ratio.Pow(decimal.NewFromInt(int64(n))))
+	}
+
+	step := record.PriceUpper.Sub(record.PriceLower).Div(decimal.NewFromInt(int64(record.GridNum)))
+	return base.Add(step.Mul(decimal.NewFromInt(int64(n))))
+}
+
+// trailingTriggered 判断价格是否离开网格区间达到移动网格的触发档位数
+func trailingTriggered(record *ent.Strategy, price decimal.Decimal) bool {
+	if record.TrailingLevels == nil || *record.TrailingLevels <= 0 || record.GridNum <= 0 {
+		return false
+	}
+
+	levels := *record.TrailingLevels
+	switch {
+	case price.GreaterThan(record.PriceUpper):
+		return price.GreaterThanOrEqual(offsetGridPrice(record, record.PriceUpper, levels))
+	case price.LessThan(record.PriceLower):
+		return price.LessThanOrEqual(offsetGridPrice(record, record.PriceLower, -levels))
+	default:
+		return false
+	}
+}
+
+// planTrailing 生成移动网格计划
+// 按原有间距向价格方向逐档新增，直到新增档位覆盖当前价格，最多平移 maxLevels 个档位，
+// 新增档位超出移动上限或下限时停止，无法平移时返回 nil
+func planTrailing(record *ent.Strategy, price decimal.Decimal, priceDecimals int32, maxLevels int) *trailingPlan {
+	if !trailingTriggered(record, price) {
+		return nil
+	}
+
+	plan := trailingPlan{up: price.GreaterThan(record.PriceUpper)}
+	for n := 1; n <= maxLevels; n++ {
+		var levelPrice decimal.Decimal
+		if plan.up {
+			levelPrice = offsetGridPrice(record, record.PriceUpper, n).Truncate(priceDecimals)
+			limit := record.TrailingPriceUpperLimit
+			if limit != nil && limit.IsPositive() && levelPrice.GreaterThan(*limit) {
+				break
+			}
+		} else {
+			levelPrice = offsetGridPrice(record, record.PriceLower, -n).Truncate(priceDecimals)
+			limit := record.TrailingPriceLowerLimit
+			if !levelPrice.IsPositive() || (limit != nil && limit.IsPositive() && levelPrice.LessThan(*limit)) {
+				break
+			}
+		}
+
+		plan.prices = append(plan.prices, levelPrice)
+		if (plan.up && levelPrice.GreaterThanOrEqual(price)) || (!plan.up && levelPrice.LessThanOrEqual(price)) {
+			break
+		}
+	}
+	if len(plan.prices) == 0 {
+		return nil
+	}
+
+	n := lo.If(plan.up, len(plan.prices)).Else(-len(plan.prices))
+	plan.priceLower = offsetGridPrice(record, record.PriceLower, n).Truncate(priceDecimals)
+	plan.priceUpper = offsetGridPrice(record, record.PriceUpper, n).Truncate(priceDecimals)
+	return &plan
+}
+
+// trailingQuantities 按平移后的网格价格计算新增档位的下单数量
+// 保留档位沿用原有数量，新增档位按仓位曲线在平移后的网格中的位置分配数量
+func trailingQuantities(record *ent.Strategy, sortedGrids []*ent.Grid, plan *trailingPlan, sizeDecimals uint8) ([]decimal.Decimal, error) {
+	k := len(plan.prices)
+	kept := sortedGrids[k:]
+	if !plan.up {
+		kept = sortedGrids[:len(sortedGrids)-k]
+	}
+
+	prices := lo.Map(kept, func(item *ent.Grid, _ int) decimal.Decimal { return item.Price })
+	prices = append(prices, plan.prices...)
+	slices.SortFunc(prices, func(a, b decimal.Decimal) int { return a.Cmp(b) })
+
+	quantities, err := GenerateGridQuantities(record, prices, sizeDecimals)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]decimal.Decimal, 0, k)
+	for _, price := range plan.prices {
+		idx := slices.IndexFunc(prices, func(item decimal.Decimal) bool { return item.Equal(price) })
+		result = append(result, quantities[idx])
+	}
+	return result, nil
+}
+
+// Trail 价格离开网格区间时平移网格
+// 撤销远端档位的挂单并删除档位，在价格一侧按相同间距新增档位并挂出开仓订单，匹配交易记录保持不变
+// 返回值: 是否完成平移，错误信息；远端档位存在平仓挂单时返回 ErrTrailingBlocked
+func (s *GridStrategy) Trail(ctx context.Context, price decimal.Decimal) (bool, error) {
+	if !trailingTriggered(s.strategy, price) {
+		return false, nil
+	}
+
+	mm, err := helper.GetMarketMetadata(ctx, s.svcCtx, s.strategy.Exchange, s.strategy.Symbol)
+	if err != nil {
+		return false, err
+	}
+
+	state, err := LoadGridStrategyState(ctx, s.svcCtx, s.strategy)
+	if err != nil {
+		return false, err
+	}
+
+	plan := planTrailing(s.strategy, price, int32(mm.SupportedPriceDecimals), len(state.sortedGrids)-1)
+	if plan == nil {
+		return false, nil
+	}
+
+	plan.quantities, err = trailingQuantities(s.strategy, state.sortedGrids, plan, mm.SupportedSizeDecimals)
+	if err != nil {
+		return false, err
+	}
+
+	priceLower, priceUpper := s.strategy.PriceLower, s.strategy.PriceUpper
+	if err = state.trail(plan); err != nil {
+		return false, err
+	}
+
+	logger.Infof("[GridStrategy] 移动网格, id: %s, symbol: %s, price: %s, range: %s~%s -> %s~%s",
+		s.strategy.GUID, s.strategy.Symbol, price, priceLower, priceUpper, plan.priceLower, plan.priceUpper)
+
+	go s.sendTrailingNotification(price, priceLower, priceUpper)
+
+	return true, nil
+}
+
+func (s *GridStrategy) sendTrailingNotification(price, priceLower, priceUpper decimal.Decimal) {
+	if !s.strategy.EnablePushNotification {
+		return
+	}
+
+	chatId := util.ChatId(s.strategy.Owner)
+	link := fmt.Sprintf("[%s](https://t.me/%s?start=%s)",
+		util.StrategyName(s.strategy), s.svcCtx.Bot.Me.Username, s.strategy.GUID)
+	text := fmt.Sprintf("🧲 **%s %s** 移动网格 %s\n\n",
+		s.strategy.Symbol, strings.ToUpper(string(s.strategy.Mode)), link)
+	text += fmt.Sprintf("💵 当前价格: %s\n", format.Price(price, 5))
+	text += fmt.Sprintf("⬅️ 原价格区间: %s ~ %s\n", format.Price(priceLower, 5), format.Price(priceUpper, 5))
+	text += fmt.Sprintf("➡️ 新价格区间: %s ~ %s\n", format.Price(s.strategy.PriceLower, 5), format.Price(s.strategy.PriceUpper, 5))
+	_, err := util.SendMarkdownMessage(s.svcCtx.Bot, chatId, text, nil)
+	if err != nil {
+		logger.Debugf("[GridStrategy] 发送移动网格通知失败, chat: %d, %v", chatId, err)
+	}
+}
+
+// isClosingOrder 判断订单是否为平仓订单，即关联的匹配记录中反向订单已经成交
+func (state *GridStrategyState) isClosingOrder(ord *ent.Order) (bool, error) {
+	var record *ent.MatchedTrade
+	var err error
+	if ord.Side == order.SideBuy {
+		record, err = state.svcCtx.MatchedTradeModel.FindByBuyClientOrderId(state.ctx, state.strategy.GUID, ord.ClientOrderId)
+	} else {
+		record, err = state.svcCtx.MatchedTradeModel.FindBySellClientOrderId(state.ctx, state.strategy.GUID, ord.ClientOrderId)
+	}
+	if ent.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if ord.Side == order.SideBuy {
+		return record.SellOrderTimestamp != nil, nil
+	}
+	return record.BuyOrderTimestamp != nil, nil
+}
+
+// trail 执行移动网格计划
+// 远端档位只能存在未成交的开仓挂单，否则撤单后会遗留没有平仓挂单的持仓
+func (state *GridStrategyState) trail(plan *trailingPlan) error {
+	k := len(plan.prices)
+	n := len(state.sortedGrids)
+	removed, kept := state.sortedGrids[:k], state.sortedGrids[k:]
+	if !plan.up {
+		removed, kept = state.sortedGrids[n-k:], state.sortedGrids[:n-k]
+	}
+
+	// 检查远端挂单
+	removedIds := make([]int, 0, len(removed))
+	cancelOrderIds := make([]string, 0, len(removed))
+	for _, lvl := range removed {
+		removedIds = append(removedIds, lvl.ID)
+		for _, clientOrderId := range []*string{lvl.BuyClientOrderId, lvl.SellClientOrderId} {
+			if clientOrderId == nil {
+				continue
+			}
+
+			ord, ok := state.orders[*clientOrderId]
+			if !ok || !state.isActiveOrder(clientOrderId) || ord.FilledBaseAmount.IsPositive() {
+				return ErrTrailingBlocked
+			}
+
+			closing, err := state.isClosingOrder(ord)
+			if err != nil {
+				return err
+			}
+			if closing {
+				return ErrTrailingBlocked
+			}
+			cancelOrderIds = append(cancelOrderIds, ord.OrderId)
+		}
+	}
+
+	// 生成新增档位
+	newLevels := make([]ent.Grid, 0, k)
+	for idx, price := range plan.prices {
+		level := lo.If(plan.up, state.sortedGrids[n-1].Level+idx+1).Else(state.sortedGrids[0].Level - idx - 1)
+		newLevels = append(newLevels, ent.Grid{
+			StrategyId: state.strategy.GUID,
+			Exchange:   state.strategy.Exchange,
+			Symbol:     state.strategy.Symbol,
+			Account:    state.strategy.Account,
+			Level:      level,
+			Price:      price,
+			Quantity:   plan.quantities[idx],
+		})
+	}
+
+	// 最外侧的新增档位留空，其余空闲档位挂出开仓订单
+	targets := make([]*ent.Grid, 0, k)
+	for _, lvl := range kept {
+		if lvl.BuyClientOrderId == nil && lvl.SellClientOrderId == nil {
+			targets = append(targets, lvl)
+		}
+	}
+	for idx := 0; idx < k-1; idx++ {
+		targets = append(targets, &newLevels[idx])
+	}
+
+	limitOrders := make([]helper.CreateLimitOrderParams, 0, len(targets))
+	for _, lvl := range targets {
+		limitOrders = append(limitOrders, helper.CreateLimitOrderParams{
+			Symbol:     state.strategy.Symbol,
+			IsAsk:      !plan.up,
+			ReduceOnly: false,
+			Price:      lvl.Price,
+			Size:       lvl.Quantity,
+		})
+	}
+
+	// 先撤销远端挂单并删除远端档位，再挂出新订单，避免挂单失败时已撤销的订单按意外取消重新挂出
+	if err := state.adapter.CancelOrders(state.ctx, state.strategy.Symbol, cancelOrderIds); err != nil {
+		return err
+	}
+
+	err := util.Tx(state.ctx, state.svcCtx.DbClient, func(tx *ent.Tx) error {
+		m := model.NewGridModel(tx.Grid)
+		if err := m.DeleteByIds(state.ctx, removedIds); err != nil {
+			return err
+		}
+
+		if err := m.CreateBulk(state.ctx, newLevels); err != nil {
+			return err
+		}
+
+		return model.NewStrategyModel(tx.Strategy).UpdatePriceRange(state.ctx, state.strategy.ID, plan.priceLower, plan.priceUpper)
+	})
+	if err != nil {
+		logger.Errorf("[GridStrategyState] 更新移动网格状态失败, strategy: %s, range: %s~%s, %v",
+			state.strategy.GUID, plan.priceLower, plan.priceUpper, err)
+		return err
+	}
+	state.strategy.PriceLower = plan.priceLower
+	state.strategy.PriceUpper = plan.priceUpper
+
+	if len(limitOrders) == 0 {
+		return nil
+	}
+
+	// 挂出开仓订单，失败时对应档位保持空闲
+	now := time.Now()
+	clientOrderIds, _, err := state.adapter.CreateOrderBatch(state.ctx, limitOrders, nil)
+	if err != nil {
+		logger.Errorf("[GridStrategyState] 移动网格挂单失败, strategy: %s, range: %s~%s, %v",
+			state.strategy.GUID, plan.priceLower, plan.priceUpper, err)
+		return err
+	}
+
+	// 新增档位在创建后才有ID，按档位编号查询
+	grids, err := state.svcCtx.GridModel.FindAllByStrategyIdOrderAsc(state.ctx, state.strategy.GUID)
+	if err != nil {
+		return err
+	}
+	levels := lo.KeyBy(grids, func(item *ent.Grid) int { return item.Level })
+
+	err = util.Tx(state.ctx, state.svcCtx.DbClient, func(tx *ent.Tx) error {
+		m := model.NewGridModel(tx.Grid)
+		for idx, clientOrderId := range clientOrderIds {
+			lvl, ok := levels[targets[idx].Level]
+			if !ok {
+				continue
+			}
+
+			var err error
+			if plan.up {
+				err = m.UpdateBuyClientOrderId(state.ctx, lvl.ID, &clientOrderId, now)
+			} else {
+				err = m.UpdateSellClientOrderId(state.ctx, lvl.ID, &clientOrderId, now)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Errorf("[GridStrategyState] 更新移动网格挂单失败, strategy: %s, range: %s~%s, %v",
+			state.strategy.GUID, plan.priceLower, plan.priceUpper, err)
+		return err
+	}
+
+	for _, clientOrderId := range clientOrderIds {
+		state.svcCtx.PendingOrdersCache.Add(state.strategy.Exchange, state.strategy.Account, clientOrderId)
+	}
+
+	return nil
+}
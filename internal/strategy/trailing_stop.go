@@ -0,0 +1,224 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategyrun"
+	"github.com/fachebot/omni-grid-bot/internal/helper"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/fachebot/omni-grid-bot/internal/util"
+	"github.com/fachebot/omni-grid-bot/internal/util/format"
+	"github.com/shopspring/decimal"
+)
+
+// trailingExtremeSaveInterval 移动止损止盈极值价格的保存间隔，避免趋势行情中每次行情更新都写入数据库
+const trailingExtremeSaveInterval = 10 * time.Second
+
+// TrailingStopStatus 移动止损止盈的当前状态
+type TrailingStopStatus struct {
+	ExtremePrice     *decimal.Decimal // 策略启动后的最高价格(做多)或最低价格(做空)，尚未记录时为空
+	StopLossPrice    *decimal.Decimal // 移动止损触发价格，未设置时为空
+	TakeProfitPrice  *decimal.Decimal // 移动止盈触发价格，未设置或未激活时为空
+	TakeProfitActive bool             // 极值价格是否已经达到移动止盈激活价格
+}
+
+// TrailingStopEnabled 是否设置了移动止损或移动止盈，中性网格没有固定方向，不支持移动止损止盈
+func TrailingStopEnabled(record *ent.Strategy) bool {
+	if record.Mode == strategy.ModeNeutral {
+		return false
+	}
+	return trailingStopLossEnabled(record) || trailingTakeProfitEnabled(record)
+}
+
+func trailingStopLossEnabled(record *ent.Strategy) bool {
+	return isPositive(record.TrailingStopLossAmount) || isPositive(record.TrailingStopLossPercent)
+}
+
+func trailingTakeProfitEnabled(record *ent.Strategy) bool {
+	return isPositive(record.TrailingTakeProfitActivationPrice) &&
+		(isPositive(record.TrailingTakeProfitAmount) || isPositive(record.TrailingTakeProfitPercent))
+}
+
+// GetTrailingStopStatus 按策略记录的极值价格计算移动止损止盈的触发价格
+func GetTrailingStopStatus(record *ent.Strategy) TrailingStopStatus {
+	status := TrailingStopStatus{ExtremePrice: record.TrailingExtremePrice}
+	if record.TrailingExtremePrice == nil || !TrailingStopEnabled(record) {
+		return status
+	}
+
+	extreme := *record.TrailingExtremePrice
+	if trailingStopLossEnabled(record) {
+		price := retracePrice(record.Mode, extreme, record.TrailingStopLossAmount, record.TrailingStopLossPercent)
+		status.StopLossPrice = &price
+	}
+
+	if trailingTakeProfitEnabled(record) {
+		activation := *record.TrailingTakeProfitActivationPrice
+		if record.Mode == strategy.ModeLong {
+			status.TakeProfitActive = extreme.GreaterThanOrEqual(activation)
+		} else {
+			status.TakeProfitActive = extreme.LessThanOrEqual(activation)
+		}
+		if status.TakeProfitActive {
+			price := retracePrice(record.Mode, extreme, record.TrailingTakeProfitAmount, record.TrailingTakeProfitPercent)
+			status.TakeProfitPrice = &price
+		}
+	}
+
+	return status
+}
+
+// nextExtremePrice 按最新价格更新极值价格，做多记录最高价格，做空记录最低价格
+// 返回值: 新的极值价格，是否发生变化
+func nextExtremePrice(mode strategy.Mode, extreme *decimal.Decimal, price decimal.Decimal) (decimal.Decimal, bool) {
+	if extreme == nil {
+		return price, true
+	}
+	if mode == strategy.ModeLong && price.GreaterThan(*extreme) {
+		return price, true
+	}
+	if mode == strategy.ModeShort && price.LessThan(*extreme) {
+		return price, true
+	}
+	return *extreme, false
+}
+
+// retracePrice 计算从极值价格回撤指定距离后的价格
+// 回撤距离按 amount(价格) 或 percent(%) 设置，同时设置时取距离极值价格较近的一项
+func retracePrice(mode strategy.Mode, extreme decimal.Decimal, amount, percent *decimal.Decimal) decimal.Decimal {
+	distance := decimal.Zero
+	if isPositive(amount) {
+		distance = *amount
+	}
+	if isPositive(percent) {
+		d := extreme.Mul(*percent).Div(decimal.NewFromInt(100))
+		if distance.IsZero() || d.LessThan(distance) {
+			distance = d
+		}
+	}
+
+	if mode == strategy.ModeShort {
+		return extreme.Add(distance)
+	}
+	return extreme.Sub(distance)
+}
+
+// trailingStopReached 判断价格是否从极值价格回撤到移动止盈或移动止损的触发价格
+// 移动止盈激活后优先按移动止盈处理
+// 返回值: 停止原因，触发价格，是否触发
+func trailingStopReached(record *ent.Strategy, price decimal.Decimal) (strategyrun.StopReason, decimal.Decimal, bool) {
+	reached := func(trigger decimal.Decimal) bool {
+		if record.Mode == strategy.ModeShort {
+			return price.GreaterThanOrEqual(trigger)
+		}
+		return price.LessThanOrEqual(trigger)
+	}
+
+	status := GetTrailingStopStatus(record)
+	if status.TakeProfitPrice != nil && reached(*status.TakeProfitPrice) {
+		return strategyrun.StopReasonTrailingTakeProfit, *status.TakeProfitPrice, true
+	}
+	if status.StopLossPrice != nil && reached(*status.StopLossPrice) {
+		return strategyrun.StopReasonTrailingStopLoss, *status.StopLossPrice, true
+	}
+	return "", decimal.Zero, false
+}
+
+// checkTrailingStop 更新极值价格并检查移动止损止盈，触发后停止策略并平仓
+// 极值价格先更新到内存，按 trailingExtremeSaveInterval 间隔保存，更新策略后的下一次行情和停止引擎时立即保存，重启后从数据库恢复
+// 返回值: 是否已停止策略
+func (s *GridStrategy) checkTrailingStop(ctx context.Context, price decimal.Decimal) bool {
+	s.trailingMutex.Lock()
+	if !TrailingStopEnabled(s.strategy) {
+		s.trailingMutex.Unlock()
+		return false
+	}
+
+	extreme, changed := nextExtremePrice(s.strategy.Mode, s.strategy.TrailingExtremePrice, price)
+	if changed {
+		s.strategy.TrailingExtremePrice = &extreme
+		s.trailingExtremeDirty = true
+	}
+
+	flush := s.trailingExtremeDirty && (s.trailingExtremeFlush || !time.Now().Before(s.trailingExtremeSaveAt))
+	if flush {
+		s.trailingExtremeSaveAt = time.Now().Add(trailingExtremeSaveInterval)
+	}
+	reason, trigger, ok := trailingStopReached(s.strategy, price)
+	s.trailingMutex.Unlock()
+
+	if flush {
+		s.flushTrailingExtremePrice(ctx)
+	}
+	if !ok {
+		return false
+	}
+
+	s.handleTriggerTrailingStop(ctx, price, extreme, trigger, reason)
+	return true
+}
+
+// flushTrailingExtremePrice 保存内存中尚未写入数据库的极值价格，写入数据库时不持有锁
+func (s *GridStrategy) flushTrailingExtremePrice(ctx context.Context) {
+	s.trailingMutex.Lock()
+	record := s.strategy
+	if !s.trailingExtremeDirty || record.TrailingExtremePrice == nil {
+		s.trailingMutex.Unlock()
+		return
+	}
+	extreme := *record.TrailingExtremePrice
+	s.trailingExtremeDirty = false
+	s.trailingExtremeFlush = false
+	s.trailingMutex.Unlock()
+
+	err := s.svcCtx.StrategyModel.UpdateTrailingExtremePrice(ctx, record.ID, extreme)
+	if err != nil {
+		logger.Errorf("[GridStrategy] 更新移动止损极值价格失败, id: %s, symbol: %s, account: %s, %v",
+			record.GUID, record.Symbol, record.Account, err)
+
+		s.trailingMutex.Lock()
+		s.trailingExtremeDirty = true
+		s.trailingMutex.Unlock()
+	}
+}
+
+func (s *GridStrategy) handleTriggerTrailingStop(ctx context.Context, price, extreme, trigger decimal.Decimal, reason strategyrun.StopReason) {
+	logger.Infof("[GridStrategy] 触发移动止损止盈, 停止策略, id: %s, symbol: %s, account: %s, price: %s, extreme: %s, reason: %s",
+		s.strategy.GUID, s.strategy.Symbol, s.strategy.Account, price.String(), extreme.String(), reason)
+
+	err := helper.StopStrategyAndClosePosition(ctx, s.svcCtx, s.engine, s.strategy, reason)
+	if err != nil {
+		logger.Errorf("[GridStrategy] 关闭仓位失败, id: %s, symbol: %s, account: %s, %v",
+			s.strategy.GUID, s.strategy.Symbol, s.strategy.Account, err)
+		return
+	}
+
+	// 发送通知消息
+	title := "📉 **%s %s** 触发移动止损 %s\n\n"
+	if reason == strategyrun.StopReasonTrailingTakeProfit {
+		title = "📈 **%s %s** 触发移动止盈 %s\n\n"
+	}
+
+	chatId := util.ChatId(s.strategy.Owner)
+	name := util.StrategyName(s.strategy)
+	link := fmt.Sprintf("[%s](https://t.me/%s?start=%s)",
+		name, s.svcCtx.Bot.Me.Username, s.strategy.GUID)
+	text := fmt.Sprintf(title, s.strategy.Symbol, strings.ToUpper(string(s.strategy.Mode)), link)
+	text += fmt.Sprintf("💵 当前价格: %s\n", format.Price(price, 5))
+	if s.strategy.Mode == strategy.ModeShort {
+		text += fmt.Sprintf("🏔 最低价格: %s\n", format.Price(extreme, 5))
+	} else {
+		text += fmt.Sprintf("🏔 最高价格: %s\n", format.Price(extreme, 5))
+	}
+	text += fmt.Sprintf("🔔 触发价格: %s\n", format.Price(trigger, 5))
+	text += "\n策略已自动停止并平仓。由于市价滑点问题，可能存在平仓失败的情况，请注意检查仓位是否正常关闭。"
+	_, err = util.SendMarkdownMessage(s.svcCtx.Bot, chatId, text, nil)
+	if err != nil {
+		logger.Debugf("[GridStrategy] 发送移动止损止盈通知失败, chat: %d, %v", chatId, err)
+	}
+}
@@ -0,0 +1,199 @@
+package strategy
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategyrun"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+func optionalDecimal(s string) *decimal.Decimal {
+	if s == "" {
+		return nil
+	}
+	return lo.ToPtr(d(s))
+}
+
+func TestRetracePrice(t *testing.T) {
+	tests := []struct {
+		name    string
+		mode    strategy.Mode
+		extreme string
+		amount  string
+		percent string
+		want    string
+	}{
+		{name: "做多按价格回撤", mode: strategy.ModeLong, extreme: "200", amount: "10", want: "190"},
+		{name: "做多按比例回撤", mode: strategy.ModeLong, extreme: "200", percent: "5", want: "190"},
+		{name: "做空按价格回撤", mode: strategy.ModeShort, extreme: "200", amount: "10", want: "210"},
+		{name: "做空按比例回撤", mode: strategy.ModeShort, extreme: "200", percent: "2.5", want: "205"},
+		{name: "同时设置时取比例更近", mode: strategy.ModeLong, extreme: "200", amount: "20", percent: "5", want: "190"},
+		{name: "同时设置时取价格更近", mode: strategy.ModeShort, extreme: "200", amount: "5", percent: "5", want: "205"},
+		{name: "未设置时不回撤", mode: strategy.ModeLong, extreme: "200", want: "200"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := retracePrice(tt.mode, d(tt.extreme), optionalDecimal(tt.amount), optionalDecimal(tt.percent))
+			requireDecimal(t, "回撤价格", got, d(tt.want))
+		})
+	}
+}
+
+func TestNextExtremePrice(t *testing.T) {
+	tests := []struct {
+		name    string
+		mode    strategy.Mode
+		extreme string
+		price   string
+		want    string
+		changed bool
+	}{
+		{name: "尚未记录时使用当前价格", mode: strategy.ModeLong, price: "100", want: "100", changed: true},
+		{name: "做多记录更高价格", mode: strategy.ModeLong, extreme: "100", price: "101", want: "101", changed: true},
+		{name: "做多忽略更低价格", mode: strategy.ModeLong, extreme: "100", price: "99", want: "100", changed: false},
+		{name: "做空记录更低价格", mode: strategy.ModeShort, extreme: "100", price: "99", want: "99", changed: true},
+		{name: "做空忽略更高价格", mode: strategy.ModeShort, extreme: "100", price: "101", want: "100", changed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, changed := nextExtremePrice(tt.mode, optionalDecimal(tt.extreme), d(tt.price))
+			requireDecimal(t, "极值价格", got, d(tt.want))
+			if changed != tt.changed {
+				t.Fatalf("changed = %v, want %v", changed, tt.changed)
+			}
+		})
+	}
+}
+
+func TestTrailingStopReached(t *testing.T) {
+	tests := []struct {
+		name       string
+		mode       strategy.Mode
+		extreme    string
+		price      string
+		stopLoss   string
+		activation string
+		takeProfit string
+		reached    bool
+		reason     strategyrun.StopReason
+		trigger    string
+	}{
+		{name: "做多未回撤到止损价格", mode: strategy.ModeLong, extreme: "120", price: "110.1", stopLoss: "10", reached: false},
+		{name: "做多回撤到止损价格", mode: strategy.ModeLong, extreme: "120", price: "110", stopLoss: "10", reached: true, reason: strategyrun.StopReasonTrailingStopLoss, trigger: "110"},
+		{name: "做多止盈未激活时按止损处理", mode: strategy.ModeLong, extreme: "120", price: "100", stopLoss: "10", activation: "130", takeProfit: "5", reached: true, reason: strategyrun.StopReasonTrailingStopLoss, trigger: "110"},
+		{name: "做多止盈未激活", mode: strategy.ModeLong, extreme: "120", price: "100", activation: "130", takeProfit: "5", reached: false},
+		{name: "做多止盈激活后优先止盈", mode: strategy.ModeLong, extreme: "130", price: "100", stopLoss: "10", activation: "130", takeProfit: "5", reached: true, reason: strategyrun.StopReasonTrailingTakeProfit, trigger: "125"},
+		{name: "做空回撤到止损价格", mode: strategy.ModeShort, extreme: "80", price: "88", stopLoss: "10%", reached: true, reason: strategyrun.StopReasonTrailingStopLoss, trigger: "88"},
+		{name: "做空未回撤到止损价格", mode: strategy.ModeShort, extreme: "80", price: "87.9", stopLoss: "10%", reached: false},
+		{name: "做空止盈激活后回撤", mode: strategy.ModeShort, extreme: "70", price: "73.5", activation: "75", takeProfit: "5%", reached: true, reason: strategyrun.StopReasonTrailingTakeProfit, trigger: "73.5"},
+		{name: "中性网格不支持", mode: strategy.ModeNeutral, extreme: "120", price: "100", stopLoss: "10", reached: false},
+		{name: "尚未记录极值价格", mode: strategy.ModeLong, price: "100", stopLoss: "10", reached: false},
+	}
+
+	// distance 按 10 或 10% 的格式解析回撤距离
+	distance := func(s string) (*decimal.Decimal, *decimal.Decimal) {
+		if s == "" {
+			return nil, nil
+		}
+		if s[len(s)-1] == '%' {
+			return nil, optionalDecimal(s[:len(s)-1])
+		}
+		return optionalDecimal(s), nil
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := &ent.Strategy{Mode: tt.mode, TrailingExtremePrice: optionalDecimal(tt.extreme)}
+			record.TrailingStopLossAmount, record.TrailingStopLossPercent = distance(tt.stopLoss)
+			record.TrailingTakeProfitAmount, record.TrailingTakeProfitPercent = distance(tt.takeProfit)
+			record.TrailingTakeProfitActivationPrice = optionalDecimal(tt.activation)
+
+			reason, trigger, reached := trailingStopReached(record, d(tt.price))
+			if reached != tt.reached || reason != tt.reason {
+				t.Fatalf("trailingStopReached = %v, %q, want %v, %q", reached, reason, tt.reached, tt.reason)
+			}
+			if tt.reached {
+				requireDecimal(t, "触发价格", trigger, d(tt.trigger))
+			}
+		})
+	}
+}
+
+func TestTrailingExtremePricePersistence(t *testing.T) {
+	ctx := context.Background()
+	svcCtx, _ := newTestSvcCtx(t, nil)
+	args := testStrategy(strategy.ModeLong)
+	args.TrailingStopLossAmount = lo.ToPtr(d("50"))
+	record, err := svcCtx.StrategyModel.Save(ctx, args)
+	if err != nil {
+		t.Fatalf("保存策略失败: %v", err)
+	}
+
+	saved := func() decimal.Decimal {
+		t.Helper()
+		item, err := svcCtx.StrategyModel.FindOneByGUID(ctx, record.GUID)
+		if err != nil {
+			t.Fatalf("查询策略失败: %v", err)
+		}
+		if item.TrailingExtremePrice == nil {
+			t.Fatal("极值价格没有保存")
+		}
+		return *item.TrailingExtremePrice
+	}
+
+	// 第一次记录极值价格时立即保存，保存间隔内只更新内存
+	s := NewGridStrategy(svcCtx, nil, record)
+	s.checkTrailingStop(ctx, d("100"))
+	requireDecimal(t, "首次保存的极值价格", saved(), d("100"))
+	s.checkTrailingStop(ctx, d("105"))
+	s.checkTrailingStop(ctx, d("103"))
+	requireDecimal(t, "内存中的极值价格", *s.Get().TrailingExtremePrice, d("105"))
+	requireDecimal(t, "保存间隔内的极值价格", saved(), d("100"))
+
+	// 引擎停止时保存尚未写入数据库的极值价格
+	s.Flush(ctx)
+	requireDecimal(t, "停止时保存的极值价格", saved(), d("105"))
+
+	// 更新策略时保留内存中的极值价格，不写入数据库，下一次行情更新时立即保存
+	s.checkTrailingStop(ctx, d("108"))
+	item, err := svcCtx.StrategyModel.FindOneByGUID(ctx, record.GUID)
+	if err != nil {
+		t.Fatalf("查询策略失败: %v", err)
+	}
+	s.Update(item)
+	requireDecimal(t, "更新后的极值价格", *s.Get().TrailingExtremePrice, d("108"))
+	requireDecimal(t, "更新时的极值价格", saved(), d("105"))
+	s.checkTrailingStop(ctx, d("107"))
+	requireDecimal(t, "更新后下一次行情保存的极值价格", saved(), d("108"))
+
+	// 行情更新和策略更新在不同的协程执行
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for range 100 {
+			s.Update(item)
+		}
+	}()
+	for idx := range 100 {
+		s.checkTrailingStop(ctx, d("108").Add(decimal.NewFromInt(int64(idx))))
+	}
+	wg.Wait()
+	s.Flush(ctx)
+	requireDecimal(t, "并发更新后保存的极值价格", saved(), d("207"))
+
+	// 重启后从数据库恢复极值价格
+	item, err = svcCtx.StrategyModel.FindOneByGUID(ctx, record.GUID)
+	if err != nil {
+		t.Fatalf("查询策略失败: %v", err)
+	}
+	status := GetTrailingStopStatus(NewGridStrategy(svcCtx, nil, item).Get())
+	requireDecimal(t, "重启后的止损价格", *status.StopLossPrice, d("157"))
+}
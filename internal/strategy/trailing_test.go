@@ -0,0 +1,262 @@
+package strategy
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/order"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+func requireDecimals(t *testing.T, name string, got []decimal.Decimal, want ...string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%s = %v, want %v", name, got, want)
+	}
+	for idx := range want {
+		if !got[idx].Equal(d(want[idx])) {
+			t.Fatalf("%s = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestPlanTrailing(t *testing.T) {
+	arithmetic := func(lower, upper string, gridNum int) *ent.Strategy {
+		return &ent.Strategy{
+			Mode:           strategy.ModeLong,
+			QuantityMode:   strategy.QuantityModeArithmetic,
+			PriceLower:     d(lower),
+			PriceUpper:     d(upper),
+			GridNum:        gridNum,
+			TrailingLevels: lo.ToPtr(1),
+		}
+	}
+
+	tests := []struct {
+		name       string
+		record     *ent.Strategy
+		price      string
+		maxLevels  int
+		prices     []string // nil 表示不平移
+		priceLower string
+		priceUpper string
+	}{
+		{
+			name:   "未达到触发档位",
+			record: arithmetic("90", "110", 10),
+			price:  "111.9", maxLevels: 10,
+		},
+		{
+			name:   "向上平移到覆盖当前价格",
+			record: arithmetic("90", "110", 10),
+			price:  "113", maxLevels: 10,
+			prices: []string{"112", "114"}, priceLower: "94", priceUpper: "114",
+		},
+		{
+			name:   "向下平移到覆盖当前价格",
+			record: arithmetic("90", "110", 10),
+			price:  "87", maxLevels: 10,
+			prices: []string{"88", "86"}, priceLower: "86", priceUpper: "106",
+		},
+		{
+			name:   "最多平移 maxLevels 个档位",
+			record: arithmetic("90", "110", 10),
+			price:  "200", maxLevels: 3,
+			prices: []string{"112", "114", "116"}, priceLower: "96", priceUpper: "116",
+		},
+		{
+			name: "新增档位超出移动上限",
+			record: func() *ent.Strategy {
+				record := arithmetic("90", "110", 10)
+				record.TrailingPriceUpperLimit = lo.ToPtr(d("113"))
+				return record
+			}(),
+			price: "115", maxLevels: 10,
+			prices: []string{"112"}, priceLower: "92", priceUpper: "112",
+		},
+		{
+			name: "移动上限低于第一个新增档位",
+			record: func() *ent.Strategy {
+				record := arithmetic("90", "110", 10)
+				record.TrailingPriceUpperLimit = lo.ToPtr(d("111"))
+				return record
+			}(),
+			price: "115", maxLevels: 10,
+		},
+		{
+			name: "新增档位超出移动下限",
+			record: func() *ent.Strategy {
+				record := arithmetic("90", "110", 10)
+				record.TrailingPriceLowerLimit = lo.ToPtr(d("87"))
+				return record
+			}(),
+			price: "85", maxLevels: 10,
+			prices: []string{"88"}, priceLower: "88", priceUpper: "108",
+		},
+		{
+			name:   "新增档位价格不为正数",
+			record: arithmetic("3", "23", 10),
+			price:  "0.5", maxLevels: 10,
+			prices: []string{"1"}, priceLower: "1", priceUpper: "21",
+		},
+		{
+			name:   "第一个新增档位价格不为正数",
+			record: arithmetic("2", "22", 10),
+			price:  "0", maxLevels: 10,
+		},
+		{
+			name: "等比网格按相同比例平移",
+			record: &ent.Strategy{
+				Mode:           strategy.ModeLong,
+				QuantityMode:   strategy.QuantityModeGeometric,
+				PriceLower:     d("100"),
+				PriceUpper:     d("121"),
+				GridNum:        2,
+				TrailingLevels: lo.ToPtr(1),
+			},
+			price: "140", maxLevels: 10,
+			prices: []string{"133.1", "146.41"}, priceLower: "121", priceUpper: "146.41",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := planTrailing(tt.record, d(tt.price), 2, tt.maxLevels)
+			if tt.prices == nil {
+				if plan != nil {
+					t.Fatalf("plan = %+v, want nil", plan)
+				}
+				return
+			}
+			if plan == nil {
+				t.Fatal("plan = nil")
+			}
+			requireDecimals(t, "新增档位价格", plan.prices, tt.prices...)
+			requireDecimal(t, "价格下限", plan.priceLower, d(tt.priceLower))
+			requireDecimal(t, "价格上限", plan.priceUpper, d(tt.priceUpper))
+		})
+	}
+}
+
+func TestTrailingQuantities(t *testing.T) {
+	// 做多金字塔仓位，档位数量 = 1 + 排序，锚点为平移后次高的档位
+	record := &ent.Strategy{
+		Mode:             strategy.ModeLong,
+		QuantityMode:     strategy.QuantityModeArithmetic,
+		PriceLower:       d("90"),
+		PriceUpper:       d("100"),
+		GridNum:          5,
+		InitialOrderSize: d("1"),
+		SizingMode:       strategy.SizingModePyramid,
+		SizingFactor:     lo.ToPtr(d("1")),
+	}
+	sortedGrids := lo.Map([]string{"90", "92", "94", "96", "98", "100"}, func(price string, idx int) *ent.Grid {
+		return &ent.Grid{Level: idx, Price: d(price), Quantity: d("1")}
+	})
+
+	tests := []struct {
+		name       string
+		plan       *trailingPlan
+		quantities []string
+	}{
+		{name: "向上平移", plan: &trailingPlan{up: true, prices: []decimal.Decimal{d("102"), d("104")}}, quantities: []string{"1", "2"}},
+		{name: "向下平移", plan: &trailingPlan{up: false, prices: []decimal.Decimal{d("88"), d("86")}}, quantities: []string{"4", "5"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quantities, err := trailingQuantities(record, sortedGrids, tt.plan, 4)
+			if err != nil {
+				t.Fatalf("trailingQuantities: %v", err)
+			}
+			requireDecimals(t, "新增档位数量", quantities, tt.quantities...)
+		})
+	}
+}
+
+func TestTrailKeepsRemovedLevelsWhenOrderPlacementFails(t *testing.T) {
+	args := testStrategy(strategy.ModeLong)
+	args.TrailingLevels = lo.ToPtr(1)
+	h := newTestHarness(t, args, d("100.5"))
+	h.price("113")
+
+	removed := []*ent.Grid{h.level("90"), h.level("92")}
+
+	// 撤销远端挂单后下单失败
+	h.driver.createOrderErr = errors.New("create order failed")
+	if _, err := h.grid.Trail(context.Background(), d("113")); err == nil {
+		t.Fatal("Trail 应返回下单错误")
+	}
+	h.driver.createOrderErr = nil
+	h.rebalance()
+
+	// 远端档位已删除，撤销的订单不会按意外取消重新挂出
+	for _, lvl := range removed {
+		if lo.ContainsBy(h.grids(), func(item *ent.Grid) bool { return item.ID == lvl.ID }) {
+			t.Fatalf("网格档位 %s 应已删除", lvl.Price)
+		}
+	}
+	for _, ord := range h.simulator.OpenOrders(testAccount, testSymbol) {
+		if ord.Price.LessThan(d("94")) {
+			t.Fatalf("远端档位的订单被重新挂出, price: %s", ord.Price)
+		}
+	}
+
+	record, err := h.svcCtx.StrategyModel.FindOneByGUID(h.ctx, h.record.GUID)
+	if err != nil {
+		t.Fatalf("查询策略失败: %v", err)
+	}
+	requireDecimal(t, "价格下限", record.PriceLower, d("94"))
+	requireDecimal(t, "价格上限", record.PriceUpper, d("114"))
+	if len(h.grids()) != 11 {
+		t.Fatalf("grids = %d, want 11", len(h.grids()))
+	}
+}
+
+func TestAdaptKeepsNewLevelsWhenOrderPlacementFails(t *testing.T) {
+	args := testStrategy(strategy.ModeLong)
+	args.QuantityMode = strategy.QuantityModeAdaptive
+	h := newTestHarness(t, args, d("100.5"))
+	h.price("98")
+
+	// 真实波幅为 1，网格数量由 10 调整为 20
+	h.driver.candles = testCandles(lo.RepeatBy(DefaultAtrPeriod+1, func(_ int) [3]string { return [3]string{"100.5", "99.5", "100"} })...)
+
+	// 撤销现有挂单后下单失败
+	h.driver.createOrderErr = errors.New("create order failed")
+	if _, err := h.grid.Adapt(context.Background(), d("98"), time.Now()); err == nil {
+		t.Fatal("Adapt 应返回下单错误")
+	}
+	h.driver.createOrderErr = nil
+	h.rebalance()
+
+	// 新网格已保存，平仓订单在新档位按意外取消重新挂出，开仓档位保持空闲
+	if len(h.grids()) != 21 {
+		t.Fatalf("grids = %d, want 21", len(h.grids()))
+	}
+	open := h.simulator.OpenOrders(testAccount, testSymbol)
+	prices := lo.Map(open, func(item *exchange.Order, _ int) string { return item.Price.String() })
+	if !lo.ElementsMatch(prices, levelPrices(99, 104)) || lo.ContainsBy(open, func(item *exchange.Order) bool { return item.Side != order.SideSell }) {
+		t.Fatalf("open orders = %v, want sells at %v", prices, levelPrices(99, 104))
+	}
+	for _, price := range levelPrices(99, 104) {
+		ord := h.levelOrder(price, order.SideSell)
+		if !lo.ContainsBy(h.matchedTrades(), func(item *ent.MatchedTrade) bool { return lo.FromPtr(item.SellClientOrderId) == ord.ClientOrderId }) {
+			t.Fatalf("网格档位 %s 的平仓订单没有关联匹配交易", price)
+		}
+	}
+
+	record, err := h.svcCtx.StrategyModel.FindOneByGUID(h.ctx, h.record.GUID)
+	if err != nil {
+		t.Fatalf("查询策略失败: %v", err)
+	}
+	if record.GridNum != 20 {
+		t.Fatalf("GridNum = %d, want 20", record.GridNum)
+	}
+}
@@ -11,6 +11,10 @@ import (
 	"github.com/fachebot/omni-grid-bot/internal/cache"
 	"github.com/fachebot/omni-grid-bot/internal/config"
 	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/migrate"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/exchange/dydx"
+	"github.com/fachebot/omni-grid-bot/internal/exchange/hyperliquid"
 	"github.com/fachebot/omni-grid-bot/internal/exchange/lighter"
 	"github.com/fachebot/omni-grid-bot/internal/exchange/paradex"
 	"github.com/fachebot/omni-grid-bot/internal/exchange/variational"
@@ -23,6 +27,22 @@ import (
 	tele "gopkg.in/telebot.v4"
 )
 
+// DataSourceName 数据库连接地址
+const DataSourceName = "file:data/sqlite.db?mode=rwc&_journal_mode=WAL&_fk=1"
+
+// 行情推送聚合K线的周期和保留数量
+const (
+	CandleCacheInterval = 15 * time.Minute
+	CandleCacheLimit    = 200
+)
+
+// ReplyMarkupProvider 生成策略通知消息的操作按钮
+// 由 Telegram 处理器实现，避免策略包依赖界面路由
+type ReplyMarkupProvider interface {
+	// RangeAlertReplyMarkup 网格区间提醒消息的操作按钮
+	RangeAlertReplyMarkup(record *ent.Strategy) *tele.ReplyMarkup
+}
+
 type ServiceContext struct {
 	Config             *config.Config
 	Bot                *tele.Bot
@@ -32,30 +52,46 @@ type ServiceContext struct {
 	LighterCache       *cache.LighterCache
 	ParadexCache       *cache.ParadexCache
 	PendingOrdersCache *cache.PendingOrdersCache
+	CandleCache        *cache.CandleCache
 
 	ParadexClient          *paradex.Client
 	LighterClient          *lighter.Client
 	VariationalClient      *variational.Client
 	VariationalRateLimiter *variational.RateLimiter
+	HyperliquidClient      *hyperliquid.Client
+	DydxClient             *dydx.Client
 
-	GridModel         *model.GridModel
-	OrderModel        *model.OrderModel
-	StrategyModel     *model.StrategyModel
-	SyncProgressModel *model.SyncProgressModel
-	MatchedTradeModel *model.MatchedTradeModel
+	GridModel            *model.GridModel
+	OrderModel           *model.OrderModel
+	StrategyModel        *model.StrategyModel
+	SyncProgressModel    *model.SyncProgressModel
+	MatchedTradeModel    *model.MatchedTradeModel
+	OrderRepairModel     *model.OrderRepairModel
+	FillModel            *model.FillModel
+	FundingPaymentModel  *model.FundingPaymentModel
+	StrategyRunModel     *model.StrategyRunModel
+	ExchangeAccountModel *model.ExchangeAccountModel
 
 	MatchedTradeService *service.MatchedTradeService
 
+	// ReplyMarkups 生成策略通知消息的操作按钮，由 Telegram 处理器注册，为空时通知消息不带按钮
+	ReplyMarkups ReplyMarkupProvider
+
+	// Driver 绑定的交易所驱动
+	// 不为空时同名交易所优先使用此驱动而不是全局注册的驱动，用于回测等隔离运行环境
+	Driver exchange.Driver
+
 	userLocks  map[int64]*sync.Mutex
 	locksMutex sync.RWMutex
 }
 
 func NewServiceContext(c *config.Config) *ServiceContext {
-	client, err := ent.Open("sqlite3", "file:data/sqlite.db?mode=rwc&_journal_mode=WAL&_fk=1")
+	client, err := ent.Open("sqlite3", DataSourceName)
 	if err != nil {
 		logger.Fatalf("打开数据库失败, %v", err)
 	}
-	if err := client.Schema.Create(context.Background()); err != nil {
+	// 网格的唯一索引改为只约束运行中的档位，需要删除旧索引
+	if err := client.Schema.Create(context.Background(), migrate.WithDropIndex(true), migrate.WithDropColumn(true)); err != nil {
 		logger.Fatalf("创建数据库Schema失败, %v", err)
 	}
 
@@ -86,6 +122,18 @@ func NewServiceContext(c *config.Config) *ServiceContext {
 	lighterRateLimiter := lighter.NewRateLimiter(c.LighterRateLimit.RequestsPerMinute)
 	lighterClient := lighter.NewClient(lighClient, lighterRateLimiter)
 
+	hypeClient := new(http.Client)
+	if transportProxy != nil {
+		hypeClient.Transport = transportProxy
+	}
+	hyperliquidClient := hyperliquid.NewClient(hypeClient)
+
+	dyClient := new(http.Client)
+	if transportProxy != nil {
+		dyClient.Transport = transportProxy
+	}
+	dydxClient := dydx.NewClient(dyClient, c.Dydx.ValidatorURL)
+
 	botHttpClient := &http.Client{
 		Timeout: 30 * time.Second,
 	}
@@ -114,25 +162,75 @@ func NewServiceContext(c *config.Config) *ServiceContext {
 		LighterCache:       cache.NewLighterCache(lighterClient),
 		ParadexCache:       cache.NewParadexCache(paradexClient),
 		PendingOrdersCache: cache.NewPendingOrdersCache(),
+		CandleCache:        cache.NewCandleCache(CandleCacheInterval, CandleCacheLimit),
 
 		ParadexClient:          paradexClient,
 		LighterClient:          lighterClient,
 		VariationalClient:      variational.NewClient(c.Sock5Proxy),
 		VariationalRateLimiter: variational.NewRateLimiter(c.VariationalRateLimit.RequestsPerSecond, c.VariationalRateLimit.Burst),
+		HyperliquidClient:      hyperliquidClient,
+		DydxClient:             dydxClient,
 
-		GridModel:         model.NewGridModel(client.Grid),
-		OrderModel:        model.NewOrderModel(client.Order),
-		StrategyModel:     model.NewStrategyModel(client.Strategy),
-		SyncProgressModel: model.NewSyncProgressModel(client.SyncProgress),
-		MatchedTradeModel: model.NewMatchedTradeModel(client.MatchedTrade),
+		GridModel:            model.NewGridModel(client.Grid),
+		OrderModel:           model.NewOrderModel(client.Order),
+		StrategyModel:        model.NewStrategyModel(client.Strategy),
+		SyncProgressModel:    model.NewSyncProgressModel(client.SyncProgress),
+		MatchedTradeModel:    model.NewMatchedTradeModel(client.MatchedTrade),
+		OrderRepairModel:     model.NewOrderRepairModel(client.OrderRepair),
+		FillModel:            model.NewFillModel(client.Fill),
+		FundingPaymentModel:  model.NewFundingPaymentModel(client.FundingPayment),
+		StrategyRunModel:     model.NewStrategyRunModel(client.StrategyRun),
+		ExchangeAccountModel: model.NewExchangeAccountModel(client.ExchangeAccount),
 
-		MatchedTradeService: service.NewMatchedTradeService(model.NewMatchedTradeModel(client.MatchedTrade)),
+		MatchedTradeService: service.NewMatchedTradeService(
+			model.NewMatchedTradeModel(client.MatchedTrade), model.NewOrderModel(client.Order), model.NewFillModel(client.Fill),
+			model.NewOrderRepairModel(client.OrderRepair), model.NewStrategyRunModel(client.StrategyRun)),
 
 		userLocks: make(map[int64]*sync.Mutex),
 	}
 	return svcCtx
 }
 
+// NewIsolatedServiceContext 创建隔离运行的服务上下文
+// 使用独立的数据库和绑定的交易所驱动，不连接交易所和Telegram，用于回测
+func NewIsolatedServiceContext(c *config.Config, client *ent.Client, driver exchange.Driver) *ServiceContext {
+	return &ServiceContext{
+		Config:             c,
+		DbClient:           client,
+		MessageCache:       cache.NewMessageCache(),
+		PendingOrdersCache: cache.NewPendingOrdersCache(),
+		CandleCache:        cache.NewCandleCache(CandleCacheInterval, CandleCacheLimit),
+
+		GridModel:            model.NewGridModel(client.Grid),
+		OrderModel:           model.NewOrderModel(client.Order),
+		StrategyModel:        model.NewStrategyModel(client.Strategy),
+		SyncProgressModel:    model.NewSyncProgressModel(client.SyncProgress),
+		MatchedTradeModel:    model.NewMatchedTradeModel(client.MatchedTrade),
+		OrderRepairModel:     model.NewOrderRepairModel(client.OrderRepair),
+		FillModel:            model.NewFillModel(client.Fill),
+		FundingPaymentModel:  model.NewFundingPaymentModel(client.FundingPayment),
+		StrategyRunModel:     model.NewStrategyRunModel(client.StrategyRun),
+		ExchangeAccountModel: model.NewExchangeAccountModel(client.ExchangeAccount),
+
+		MatchedTradeService: service.NewMatchedTradeService(
+			model.NewMatchedTradeModel(client.MatchedTrade), model.NewOrderModel(client.Order), model.NewFillModel(client.Fill),
+			model.NewOrderRepairModel(client.OrderRepair), model.NewStrategyRunModel(client.StrategyRun)),
+
+		Driver: driver,
+
+		userLocks: make(map[int64]*sync.Mutex),
+	}
+}
+
+// GetDriver 获取交易所驱动
+// 优先使用服务上下文绑定的驱动
+func (svcCtx *ServiceContext) GetDriver(name string) (exchange.Driver, error) {
+	if svcCtx != nil && svcCtx.Driver != nil && svcCtx.Driver.Name() == name {
+		return svcCtx.Driver, nil
+	}
+	return exchange.GetDriver(name)
+}
+
 func (svcCtx *ServiceContext) GetUserLock(userId int64) *sync.Mutex {
 	svcCtx.locksMutex.RLock()
 	if lock, exists := svcCtx.userLocks[userId]; exists {
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/fachebot/omni-grid-bot/internal/svc"
+	"github.com/fachebot/omni-grid-bot/internal/telebot/pathrouter"
+	"github.com/fachebot/omni-grid-bot/internal/util"
+	tele "gopkg.in/telebot.v4"
+)
+
+type CreateExchangeAccountHandler struct {
+	svcCtx *svc.ServiceContext
+}
+
+func NewCreateExchangeAccountHandler(svcCtx *svc.ServiceContext) *CreateExchangeAccountHandler {
+	return &CreateExchangeAccountHandler{svcCtx: svcCtx}
+}
+
+func (h CreateExchangeAccountHandler) FormatPath(ex ...string) string {
+	if len(ex) == 0 {
+		return "/account/create"
+	}
+	return fmt.Sprintf("/account/create/%s", ex[0])
+}
+
+func (h *CreateExchangeAccountHandler) AddRouter(router *pathrouter.Router) {
+	router.HandleFunc("/account/create", h.handle)
+	router.HandleFunc("/account/create/{exchange}", h.handle)
+}
+
+func (h *CreateExchangeAccountHandler) handle(ctx context.Context, vars map[string]string, userId int64, update tele.Update) error {
+	// 返回交易所列表
+	value, ok := vars["exchange"]
+	if !ok {
+		var inlineKeyboard [][]tele.InlineButton
+		for _, driver := range exchange.Drivers() {
+			if _, ok := accountFields[driver.Name()]; !ok {
+				continue
+			}
+			inlineKeyboard = append(inlineKeyboard, []tele.InlineButton{
+				{Text: driver.Name(), Data: h.FormatPath(driver.Name())},
+			})
+		}
+		inlineKeyboard = append(inlineKeyboard, []tele.InlineButton{
+			{Text: "◀️ 返回上级", Data: ExchangeAccountListHandler{}.FormatPath(1)},
+			{Text: "⏪ 返回主页", Data: "/home"},
+		})
+		replyMarkup := &tele.ReplyMarkup{InlineKeyboard: inlineKeyboard}
+
+		text := fmt.Sprintf("*%s* | 添加账户\n\n请选择交易所账户的交易平台:", h.svcCtx.Config.AppName)
+		_, err := util.ReplyMessage(h.svcCtx.Bot, update, text, replyMarkup)
+		if err != nil {
+			logger.Debugf("[CreateExchangeAccountHandler] 生成交易所列表UI失败, %v", err)
+		}
+		return nil
+	}
+
+	if _, ok = accountFields[value]; !ok || !IsValidExchange(value) {
+		return nil
+	}
+
+	// 创建交易所账户
+	args := ent.ExchangeAccount{
+		Owner:    userId,
+		Exchange: value,
+		Label:    value,
+	}
+	record, err := h.svcCtx.ExchangeAccountModel.Save(ctx, args)
+	if err != nil {
+		logger.Errorf("[CreateExchangeAccountHandler] 创建交易所账户失败, %v", err)
+
+		chat, ok := util.GetChat(update)
+		if ok {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, chat, "❌ 服务器内部错误, 请稍后重试", 1)
+		}
+		return nil
+	}
+
+	return DisplayExchangeAccountDetails(ctx, h.svcCtx, userId, update, record)
+}
@@ -49,6 +49,9 @@ func (h *CreateStrategyHandler) handle(ctx context.Context, vars map[string]stri
 		MarginMode:                    strategy.MarginModeCross,
 		Leverage:                      2,
 		QuantityMode:                  strategy.QuantityModeArithmetic,
+		SizingMode:                    strategy.SizingModeFixed,
+		CancelRepairPolicy:            strategy.CancelRepairPolicyRepair,
+		ReconcilePolicy:               strategy.ReconcilePolicyAlert,
 		GridNum:                       50,
 		Status:                        strategy.StatusInactive,
 		EnablePushNotification:        true,
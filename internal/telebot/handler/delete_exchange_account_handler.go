@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/fachebot/omni-grid-bot/internal/svc"
+	"github.com/fachebot/omni-grid-bot/internal/telebot/pathrouter"
+	"github.com/fachebot/omni-grid-bot/internal/util"
+	tele "gopkg.in/telebot.v4"
+)
+
+type DeleteExchangeAccountHandler struct {
+	svcCtx *svc.ServiceContext
+}
+
+func NewDeleteExchangeAccountHandler(svcCtx *svc.ServiceContext) *DeleteExchangeAccountHandler {
+	return &DeleteExchangeAccountHandler{svcCtx: svcCtx}
+}
+
+func (h DeleteExchangeAccountHandler) FormatPath(id int) string {
+	return fmt.Sprintf("/account/delete/%d", id)
+}
+
+func (h *DeleteExchangeAccountHandler) AddRouter(router *pathrouter.Router) {
+	router.HandleFunc("/account/delete/{id:[0-9]+}", h.handle)
+	router.HandleFunc("/account/delete/{id:[0-9]+}/{confirm}", h.handle)
+}
+
+func (h *DeleteExchangeAccountHandler) handle(ctx context.Context, vars map[string]string, userId int64, update tele.Update) error {
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		return nil
+	}
+
+	// 查询账户信息
+	record, err := h.svcCtx.ExchangeAccountModel.FindOne(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return DisplayExchangeAccountList(ctx, h.svcCtx, userId, update, 1)
+		}
+		logger.Errorf("[DeleteExchangeAccountHandler] 查询交易所账户失败, id: %d, %v", id, err)
+		return nil
+	}
+
+	chat, ok := util.GetChat(update)
+	if !ok || record.Owner != userId {
+		return DisplayExchangeAccountList(ctx, h.svcCtx, userId, update, 1)
+	}
+
+	// 检查关联策略
+	strategies, err := h.svcCtx.StrategyModel.FindAllByAccountId(ctx, record.ID)
+	if err != nil {
+		logger.Errorf("[DeleteExchangeAccountHandler] 查询关联策略失败, id: %d, %v", record.ID, err)
+		return nil
+	}
+	if len(strategies) > 0 {
+		text := fmt.Sprintf("❌ 账户已被 %d 个策略使用, 请先解除关联", len(strategies))
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, chat, text, 3)
+		return nil
+	}
+
+	// 显示删除菜单
+	_, confirm := vars["confirm"]
+	if !confirm {
+		detailsPath := ExchangeAccountDetailsHandler{}.FormatPath(record.ID, nil)
+		inlineKeyboard := [][]tele.InlineButton{
+			{
+				{Text: "🔴 删除账户", Data: h.FormatPath(record.ID) + "/confirm"},
+			},
+			{
+				{Text: "◀️ 返回上级", Data: detailsPath},
+			},
+			{
+				{Text: "🟣 我点错了", Data: detailsPath},
+			},
+			{
+				{Text: "🟢 取消删除", Data: detailsPath},
+			},
+		}
+
+		rand.Shuffle(len(inlineKeyboard), func(i, j int) {
+			inlineKeyboard[i], inlineKeyboard[j] = inlineKeyboard[j], inlineKeyboard[i]
+		})
+
+		replyMarkup := &tele.ReplyMarkup{
+			InlineKeyboard: inlineKeyboard,
+		}
+		text := fmt.Sprintf("*%s* | 删除账户 `%s`\n\n删除后账户凭证无法恢复, 确认删除此交易所账户吗?", h.svcCtx.Config.AppName, record.Label)
+		_, err := util.ReplyMessage(h.svcCtx.Bot, update, text, replyMarkup)
+		return err
+	}
+
+	// 执行删除账户
+	text := fmt.Sprintf("✅ *%s* 账户删除成功", record.Label)
+	err = h.svcCtx.ExchangeAccountModel.Delete(ctx, record.ID)
+	if err != nil {
+		text = fmt.Sprintf("❌ *%s* 账户删除失败, 请稍后再试", record.Label)
+		logger.Errorf("[DeleteExchangeAccountHandler] 删除交易所账户失败, id: %d, %v", record.ID, err)
+	} else {
+		err = DisplayExchangeAccountList(ctx, h.svcCtx, userId, update, 1)
+		if err != nil {
+			logger.Warnf("[DeleteExchangeAccountHandler] 处理UI失败, %v", err)
+		}
+	}
+
+	util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, chat, text, 1)
+
+	return nil
+}
@@ -0,0 +1,353 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/fachebot/omni-grid-bot/internal/cache"
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/fachebot/omni-grid-bot/internal/model"
+	"github.com/fachebot/omni-grid-bot/internal/svc"
+	"github.com/fachebot/omni-grid-bot/internal/telebot/pathrouter"
+	"github.com/fachebot/omni-grid-bot/internal/util"
+
+	"github.com/samber/lo"
+	tele "gopkg.in/telebot.v4"
+)
+
+type ExchangeAccountOption int
+
+var (
+	ExchangeAccountOptionRename ExchangeAccountOption = 1
+)
+
+// maxAccountLabelLength 交易所账户名称的最大长度
+const maxAccountLabelLength = 32
+
+type ExchangeAccountDetailsHandler struct {
+	svcCtx *svc.ServiceContext
+}
+
+func NewExchangeAccountDetailsHandler(svcCtx *svc.ServiceContext) *ExchangeAccountDetailsHandler {
+	return &ExchangeAccountDetailsHandler{svcCtx: svcCtx}
+}
+
+func (h ExchangeAccountDetailsHandler) FormatPath(id int, option *ExchangeAccountOption) string {
+	if option == nil {
+		return fmt.Sprintf("/account/details/%d", id)
+	}
+	return fmt.Sprintf("/account/details/%d/%d", id, *option)
+}
+
+func (h ExchangeAccountDetailsHandler) FormatFieldPath(id int, field int) string {
+	return fmt.Sprintf("/account/details/%d/field/%d", id, field)
+}
+
+func (h *ExchangeAccountDetailsHandler) AddRouter(router *pathrouter.Router) {
+	router.HandleFunc("/account/details/{id:[0-9]+}", h.handle)
+	router.HandleFunc("/account/details/{id:[0-9]+}/{option:[0-9]+}", h.handle)
+	router.HandleFunc("/account/details/{id:[0-9]+}/field/{field:[0-9]+}", h.handle)
+}
+
+func (h *ExchangeAccountDetailsHandler) handle(ctx context.Context, vars map[string]string, userId int64, update tele.Update) error {
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		return nil
+	}
+
+	// 查询账户信息
+	record, err := h.svcCtx.ExchangeAccountModel.FindOne(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return DisplayExchangeAccountList(ctx, h.svcCtx, userId, update, 1)
+		}
+		logger.Errorf("[ExchangeAccountDetailsHandler] 查询交易所账户失败, id: %d, %v", id, err)
+		return nil
+	}
+
+	if record.Owner != userId {
+		return nil
+	}
+
+	// 修改账户凭证
+	if value, ok := vars["field"]; ok {
+		field, err := strconv.Atoi(value)
+		fields := accountFields[record.Exchange]
+		if err != nil || field < 0 || field >= len(fields) {
+			return DisplayExchangeAccountDetails(ctx, h.svcCtx, userId, update, record)
+		}
+		return h.handleField(ctx, userId, update, record, field, fields[field])
+	}
+
+	// 显示账户详情
+	option, ok := vars["option"]
+	if !ok {
+		return DisplayExchangeAccountDetails(ctx, h.svcCtx, userId, update, record)
+	}
+
+	optionValue, err := strconv.Atoi(option)
+	if err != nil {
+		return DisplayExchangeAccountDetails(ctx, h.svcCtx, userId, update, record)
+	}
+	switch ExchangeAccountOption(optionValue) {
+	case ExchangeAccountOptionRename:
+		return h.handleRename(ctx, userId, update, record)
+	}
+
+	return nil
+}
+
+// exchangeAccountStrategy 使用交易所账户生成临时策略，用于测试账户连通性
+func exchangeAccountStrategy(account *ent.ExchangeAccount) *ent.Strategy {
+	record := &ent.Strategy{Owner: account.Owner}
+	record.Edges.ExchangeAccount = account
+	return model.BindExchangeAccount(record)
+}
+
+func DisplayExchangeAccountDetails(ctx context.Context, svcCtx *svc.ServiceContext, userId int64, update tele.Update, record *ent.ExchangeAccount) error {
+	// 测试连通性
+	connectStatus := "🔴"
+	if testExchangeConnectivity(ctx, svcCtx, exchangeAccountStrategy(record)) == nil {
+		connectStatus = "🟢"
+	}
+
+	strategies, err := svcCtx.StrategyModel.FindAllByAccountId(ctx, record.ID)
+	if err != nil {
+		logger.Errorf("[DisplayExchangeAccountDetails] 查询关联策略失败, id: %d, %v", record.ID, err)
+	}
+
+	statusText := func(s string) string {
+		return lo.If(s != "", "✅").Else("⬜")
+	}
+
+	text := fmt.Sprintf("*%s* | 交易所账户 `%s`\n\n", svcCtx.Config.AppName, record.Label)
+	text += fmt.Sprintf("┣ 交易平台: *%s*\n", record.Exchange)
+	text += fmt.Sprintf("┣ 账户标识: `%s`\n", lo.If(record.Account != "", record.Account).Else("未设置"))
+	text += fmt.Sprintf("┗ 关联策略: %d 个\n\n", len(strategies))
+	text += "▸ *关联的策略运行中不允许修改账户凭证, 账户名称可以随时修改*"
+
+	h := ExchangeAccountDetailsHandler{}
+	inlineKeyboard := [][]tele.InlineButton{
+		{
+			{Text: fmt.Sprintf("%s %s", connectStatus, record.Exchange), Data: h.FormatPath(record.ID, nil)},
+		},
+	}
+
+	fields := accountFields[record.Exchange]
+	buttons := make([]tele.InlineButton, 0, len(fields))
+	for idx, field := range fields {
+		buttons = append(buttons, tele.InlineButton{
+			Text: fmt.Sprintf("%s %s", statusText(field.Value(record)), field.Name),
+			Data: h.FormatFieldPath(record.ID, idx),
+		})
+	}
+	inlineKeyboard = append(inlineKeyboard, lo.Chunk(buttons, 2)...)
+
+	inlineKeyboard = append(inlineKeyboard,
+		[]tele.InlineButton{
+			{Text: "✏️ 修改名称", Data: h.FormatPath(record.ID, &ExchangeAccountOptionRename)},
+			{Text: "🗑 删除账户", Data: DeleteExchangeAccountHandler{}.FormatPath(record.ID)},
+		},
+		[]tele.InlineButton{
+			{Text: "◀️ 返回上级", Data: ExchangeAccountListHandler{}.FormatPath(1)},
+			{Text: "⏪ 返回主页", Data: "/home"},
+		},
+	)
+
+	replyMarkup := &tele.ReplyMarkup{InlineKeyboard: inlineKeyboard}
+	_, err = util.ReplyMessage(svcCtx.Bot, update, text, replyMarkup)
+	if err != nil {
+		logger.Debugf("[DisplayExchangeAccountDetails] 生成交易所账户详情界面失败, %v", err)
+	}
+	return nil
+}
+
+func (h *ExchangeAccountDetailsHandler) refreshDetailsMessage(ctx context.Context, userId int64, update tele.Update, record *ent.ExchangeAccount) error {
+	chatId := update.Message.Chat.ID
+	if update.Message.ReplyTo == nil {
+		return DisplayExchangeAccountDetails(ctx, h.svcCtx, userId, update, record)
+	} else {
+		route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyTo.ID)
+		if ok && route.Context != nil {
+			return DisplayExchangeAccountDetails(ctx, h.svcCtx, userId, tele.Update{Message: route.Context}, record)
+		}
+		return DisplayExchangeAccountDetails(ctx, h.svcCtx, userId, update, record)
+	}
+}
+
+// checkActiveStrategies 检查关联的策略是否运行中，运行中不允许修改账户凭证
+func (h *ExchangeAccountDetailsHandler) checkActiveStrategies(ctx context.Context, chat tele.Recipient, record *ent.ExchangeAccount) bool {
+	strategies, err := h.svcCtx.StrategyModel.FindAllByAccountId(ctx, record.ID)
+	if err != nil {
+		logger.Errorf("[ExchangeAccountDetailsHandler] 查询关联策略失败, id: %d, %v", record.ID, err)
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, chat, "❌ 服务器内部错误, 请稍后重试", 1)
+		return false
+	}
+
+	active := lo.ContainsBy(strategies, func(item *ent.Strategy) bool { return item.Status == strategy.StatusActive })
+	if active {
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, chat, "❌ 关联的策略运行中, 请先停止策略", 3)
+		return false
+	}
+	return true
+}
+
+// checkAccountConflict 检查新的账户标识是否已被其他网格策略使用
+func (h *ExchangeAccountDetailsHandler) checkAccountConflict(ctx context.Context, record *ent.ExchangeAccount, account string) (bool, error) {
+	strategies, err := h.svcCtx.StrategyModel.FindAllByAccountId(ctx, record.ID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, item := range strategies {
+		result, err := h.svcCtx.StrategyModel.FindAllByExchangeAndAccountAndSymbol(ctx, record.Exchange, account, item.Symbol)
+		if err != nil {
+			return false, err
+		}
+
+		conflict := lo.ContainsBy(result, func(other *ent.Strategy) bool {
+			return other.AccountId == nil || *other.AccountId != record.ID
+		})
+		if conflict {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (h *ExchangeAccountDetailsHandler) handleField(ctx context.Context, userId int64, update tele.Update, record *ent.ExchangeAccount, idx int, field accountField) error {
+	// 步骤1
+	if update.Callback != nil {
+		chatId := update.Callback.Message.Chat.ID
+		if !h.checkActiveStrategies(ctx, util.ChatId(chatId), record) {
+			return nil
+		}
+
+		msg, err := h.svcCtx.Bot.Send(util.ChatId(chatId), field.Prompt, defaultSendOptions())
+		if err != nil {
+			logger.Debugf("[ExchangeAccountDetailsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatFieldPath(record.ID, idx), Context: update.Callback.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.ID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		deleteMessageAndReply(h.svcCtx.Bot, update.Message)
+
+		chatId := util.ChatId(update.Message.Chat.ID)
+		if !h.checkActiveStrategies(ctx, chatId, record) {
+			return nil
+		}
+
+		// 检查输入
+		value, err := field.Parse(userId, record, strings.TrimSpace(update.Message.Text))
+		if err != nil {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, chatId, err.Error(), 3)
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		switch field.Column {
+		case accountColumnAccount:
+			if value == record.Account {
+				break
+			}
+
+			conflict, err := h.checkAccountConflict(ctx, record, value)
+			if err != nil || conflict {
+				util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, chatId, "❌ 此账户已被其他网格策略使用", 1)
+				return nil
+			}
+
+			err = util.Tx(ctx, h.svcCtx.DbClient, func(tx *ent.Tx) error {
+				err := model.NewExchangeAccountModel(tx.ExchangeAccount).UpdateAccount(ctx, record.ID, value)
+				if err != nil {
+					return err
+				}
+				return model.NewStrategyModel(tx.Strategy).UpdateAccountByAccountId(ctx, record.ID, value)
+			})
+			if err == nil {
+				record.Account, record.SecretKey, record.Passphrase = value, "", ""
+			}
+		case accountColumnSecretKey:
+			err = h.svcCtx.ExchangeAccountModel.UpdateSecretKey(ctx, record.ID, value)
+			if err == nil {
+				record.SecretKey = value
+			}
+		case accountColumnPassphrase:
+			err = h.svcCtx.ExchangeAccountModel.UpdatePassphrase(ctx, record.ID, value)
+			if err == nil {
+				record.Passphrase = value
+			}
+		}
+		if err != nil {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[ExchangeAccountDetailsHandler] 更新交易所账户[%s]失败, id: %d, %v", field.Name, record.ID, err)
+		}
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, chatId, text, 1)
+
+		// 刷新用户界面
+		return h.refreshDetailsMessage(ctx, userId, update, record)
+	}
+
+	return nil
+}
+
+func (h *ExchangeAccountDetailsHandler) handleRename(ctx context.Context, userId int64, update tele.Update, record *ent.ExchangeAccount) error {
+	// 步骤1
+	if update.Callback != nil {
+		chatId := update.Callback.Message.Chat.ID
+		text := fmt.Sprintf("🌳 填写账户名称, 最多%d个字符。", maxAccountLabelLength)
+		msg, err := h.svcCtx.Bot.Send(util.ChatId(chatId), text, defaultSendOptions())
+		if err != nil {
+			logger.Debugf("[ExchangeAccountDetailsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.ID, &ExchangeAccountOptionRename), Context: update.Callback.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.ID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		deleteMessageAndReply(h.svcCtx.Bot, update.Message)
+
+		// 检查输入
+		chatId := util.ChatId(update.Message.Chat.ID)
+		label := strings.TrimSpace(update.Message.Text)
+		if label == "" || utf8.RuneCountInString(label) > maxAccountLabelLength || strings.ContainsAny(label, "`\n") {
+			text := fmt.Sprintf("❌ 请输入有效账户名称, 最多%d个字符", maxAccountLabelLength)
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, chatId, text, 3)
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err := h.svcCtx.ExchangeAccountModel.UpdateLabel(ctx, record.ID, label)
+		if err == nil {
+			record.Label = label
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[ExchangeAccountDetailsHandler] 更新交易所账户[Label]失败, id: %d, %v", record.ID, err)
+		}
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, chatId, text, 1)
+
+		// 刷新用户界面
+		return h.refreshDetailsMessage(ctx, userId, update, record)
+	}
+
+	return nil
+}
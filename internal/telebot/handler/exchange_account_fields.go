@@ -0,0 +1,206 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dontpanicdao/caigo/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/exchange/dydx"
+	"github.com/fachebot/omni-grid-bot/internal/util/ethutil"
+)
+
+// accountColumn 交易所账户凭证字段保存的数据列
+type accountColumn int
+
+const (
+	// accountColumnAccount 账户标识，关联策略时作为策略的 exchangeApiKey，修改后清空全部凭证
+	accountColumnAccount accountColumn = iota
+	accountColumnSecretKey
+	accountColumnPassphrase
+)
+
+// accountField 交易所账户的凭证字段
+type accountField struct {
+	Name   string
+	Prompt string
+	Column accountColumn
+
+	// Parse 校验用户输入并返回保存的值，校验失败时返回的错误作为提示发送给用户
+	Parse func(userId int64, account *ent.ExchangeAccount, input string) (string, error)
+}
+
+// Value 读取凭证字段的当前值
+func (f accountField) Value(account *ent.ExchangeAccount) string {
+	switch f.Column {
+	case accountColumnSecretKey:
+		return account.SecretKey
+	case accountColumnPassphrase:
+		return account.Passphrase
+	default:
+		return account.Account
+	}
+}
+
+// accountFields 各个交易所账户需要填写的凭证字段，校验规则和策略的交易所配置一致
+var accountFields = map[string][]accountField{
+	exchange.Lighter: {
+		{
+			Name:   "AccountIndex",
+			Prompt: "🌳 填写Lighter AccountIndex，值为大于0的整数。",
+			Column: accountColumnAccount,
+			Parse: func(userId int64, account *ent.ExchangeAccount, input string) (string, error) {
+				d, err := strconv.Atoi(input)
+				if err != nil || d <= 0 {
+					return "", errors.New("❌ 请输入有效Lighter AccountIndex，值为大于0的整数")
+				}
+				return input, nil
+			},
+		},
+		{
+			Name:   "ApiKeyIndex",
+			Prompt: "🌳 填写Lighter ApiKeyIndex，值为大于等于0的整数。",
+			Column: accountColumnSecretKey,
+			Parse: func(userId int64, account *ent.ExchangeAccount, input string) (string, error) {
+				d, err := strconv.Atoi(input)
+				if err != nil || d < 0 {
+					return "", errors.New("❌ 请输入有效Lighter ApiKeyIndex，值为大于等于0的整数")
+				}
+				return input, nil
+			},
+		},
+		{
+			Name:   "ApiKeyPrivateKey",
+			Prompt: "🌳 填写Lighter ApiKeyPrivateKey，值为长度80的字符串。",
+			Column: accountColumnPassphrase,
+			Parse: func(userId int64, account *ent.ExchangeAccount, input string) (string, error) {
+				if len(input) != 80 {
+					return "", errors.New("❌ 请输入有效Lighter ApiKeyPrivateKey，值为长度80的字符串")
+				}
+				return input, nil
+			},
+		},
+	},
+	exchange.Paradex: {
+		{
+			Name:   "Paradex地址",
+			Prompt: "🌳 填写Paradex账户地址(Paradex L2 地址)。",
+			Column: accountColumnAccount,
+			Parse: func(userId int64, account *ent.ExchangeAccount, input string) (string, error) {
+				if types.HexToBN(input) == nil {
+					return "", errors.New("❌ 请输入有效Paradex账户地址")
+				}
+				return input, nil
+			},
+		},
+		{
+			Name:   "Paradex私钥",
+			Prompt: "🌳 填写Paradex账户私钥，安全起见请在密钥管理页面创建一个新的交易密钥。",
+			Column: accountColumnSecretKey,
+			Parse: func(userId int64, account *ent.ExchangeAccount, input string) (string, error) {
+				if types.HexToBN(input) == nil {
+					return "", errors.New("❌ 请输入有效Paradex账户私钥")
+				}
+				return input, nil
+			},
+		},
+	},
+	exchange.Variational: {
+		{
+			Name:   "账户地址",
+			Prompt: "🌳 填写Variational账户地址。",
+			Column: accountColumnAccount,
+			Parse: func(userId int64, account *ent.ExchangeAccount, input string) (string, error) {
+				if !common.IsHexAddress(input) {
+					return "", errors.New("❌ 请输入有效Variational账户地址")
+				}
+				return common.HexToAddress(input).Hex(), nil
+			},
+		},
+		{
+			Name:   "账户私钥",
+			Prompt: "🌳 填写Variational账户私钥。",
+			Column: accountColumnSecretKey,
+			Parse: func(userId int64, account *ent.ExchangeAccount, input string) (string, error) {
+				_, address, err := ethutil.ParsePrivateKey(input)
+				if err != nil {
+					return "", errors.New("❌ 请输入有效Variational账户私钥")
+				}
+				if account.Account != "" && !strings.EqualFold(account.Account, address.Hex()) {
+					return "", errors.New("❌ Variational账户地址和私钥不匹配")
+				}
+				return input, nil
+			},
+		},
+	},
+	exchange.Hyperliquid: {
+		{
+			Name:   "账户地址",
+			Prompt: "🌳 填写Hyperliquid账户地址。",
+			Column: accountColumnAccount,
+			Parse: func(userId int64, account *ent.ExchangeAccount, input string) (string, error) {
+				if !common.IsHexAddress(input) {
+					return "", errors.New("❌ 请输入有效Hyperliquid账户地址")
+				}
+				return common.HexToAddress(input).Hex(), nil
+			},
+		},
+		{
+			Name:   "API私钥",
+			Prompt: "🌳 填写Hyperliquid API钱包私钥。\n\n请在 app.hyperliquid.xyz/API 页面创建并授权API钱包。",
+			Column: accountColumnSecretKey,
+			Parse: func(userId int64, account *ent.ExchangeAccount, input string) (string, error) {
+				if _, _, err := ethutil.ParsePrivateKey(input); err != nil {
+					return "", errors.New("❌ 请输入有效Hyperliquid API钱包私钥")
+				}
+				return input, nil
+			},
+		},
+	},
+	exchange.Dydx: {
+		{
+			Name:   "账户地址",
+			Prompt: "🌳 填写dYdX账户地址。",
+			Column: accountColumnAccount,
+			Parse: func(userId int64, account *ent.ExchangeAccount, input string) (string, error) {
+				if !dydx.IsValidAddress(input) {
+					return "", errors.New("❌ 请输入有效dYdX账户地址")
+				}
+				return input, nil
+			},
+		},
+		{
+			Name:   "助记词",
+			Prompt: "🌳 填写dYdX账户助记词。\n\n助记词仅用于本地签名交易, 必须与账户地址对应。",
+			Column: accountColumnSecretKey,
+			Parse: func(userId int64, account *ent.ExchangeAccount, input string) (string, error) {
+				mnemonic := strings.Join(strings.Fields(input), " ")
+				wallet, err := dydx.NewWallet(mnemonic)
+				if err != nil {
+					return "", errors.New("❌ 请输入有效dYdX账户助记词")
+				}
+				if account.Account != "" && wallet.Address() != account.Account {
+					return "", errors.New("❌ 助记词与账户地址不匹配")
+				}
+				return mnemonic, nil
+			},
+		},
+	},
+	exchange.Paper: {
+		{
+			Name:   "模拟账户",
+			Prompt: "🌳 填写模拟账户名称。\n\n名称由字母、数字、下划线或短横线组成, 相同名称的策略共享模拟账户余额。",
+			Column: accountColumnAccount,
+			Parse: func(userId int64, account *ent.ExchangeAccount, input string) (string, error) {
+				if !paperAccountRegexp.MatchString(input) {
+					return "", errors.New("❌ 请输入有效模拟账户名称")
+				}
+				return fmt.Sprintf("%d:%s", userId, input), nil
+			},
+		},
+	},
+}
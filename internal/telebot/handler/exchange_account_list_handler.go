@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/fachebot/omni-grid-bot/internal/svc"
+	"github.com/fachebot/omni-grid-bot/internal/telebot/pathrouter"
+	"github.com/fachebot/omni-grid-bot/internal/util"
+	tele "gopkg.in/telebot.v4"
+)
+
+type ExchangeAccountListHandler struct {
+	svcCtx *svc.ServiceContext
+}
+
+func NewExchangeAccountListHandler(svcCtx *svc.ServiceContext) *ExchangeAccountListHandler {
+	return &ExchangeAccountListHandler{svcCtx: svcCtx}
+}
+
+func (h ExchangeAccountListHandler) FormatPath(page int) string {
+	return fmt.Sprintf("/account/list/%d", page)
+}
+
+func (h *ExchangeAccountListHandler) AddRouter(router *pathrouter.Router) {
+	router.HandleFunc("/account/list", h.handle)
+	router.HandleFunc("/account/list/{page:[0-9]+}", h.handle)
+}
+
+func (h *ExchangeAccountListHandler) handle(ctx context.Context, vars map[string]string, userId int64, update tele.Update) error {
+	page := 1
+	if val, ok := vars["page"]; ok {
+		if n, err := strconv.Atoi(val); err == nil {
+			page = n
+		}
+	}
+
+	err := DisplayExchangeAccountList(ctx, h.svcCtx, userId, update, page)
+	if err != nil {
+		logger.Debugf("[ExchangeAccountListHandler] 处理UI失败, %v", err)
+	}
+
+	return nil
+}
+
+func DisplayExchangeAccountList(ctx context.Context, svcCtx *svc.ServiceContext, userId int64, update tele.Update, page int) error {
+	if page < 1 {
+		return nil
+	}
+
+	// 查询账户列表
+	const limit = 10
+	offset := (page - 1) * limit
+	accounts, total, err := svcCtx.ExchangeAccountModel.FindAllByOwner(ctx, userId, offset, limit)
+	if err != nil {
+		return err
+	}
+
+	totalPage := total / limit
+	if total%limit != 0 {
+		totalPage += 1
+	}
+
+	if page > totalPage && totalPage > 0 {
+		page = totalPage
+		offset := (page - 1) * limit
+		accounts, total, err = svcCtx.ExchangeAccountModel.FindAllByOwner(ctx, userId, offset, limit)
+		if err != nil {
+			return err
+		}
+	}
+
+	// 生成账户列表
+	var inlineKeyboard [][]tele.InlineButton
+	for _, item := range accounts {
+		inlineKeyboard = append(inlineKeyboard, []tele.InlineButton{
+			{Text: fmt.Sprintf("🔑 %s | %s", item.Label, item.Exchange), Data: ExchangeAccountDetailsHandler{}.FormatPath(item.ID, nil)},
+		})
+	}
+
+	// 多页翻页功能
+	var pageButtons []tele.InlineButton
+	if total > limit {
+		nextPage := page + 1
+		previousPage := page - 1
+		if previousPage < 1 {
+			page = 1
+			previousPage = 0
+		}
+		if nextPage > totalPage {
+			page = totalPage
+			nextPage = 0
+		}
+		pageButtons = []tele.InlineButton{
+			{Text: "⬅️ 上一页", Data: ExchangeAccountListHandler{}.FormatPath(previousPage)},
+			{Text: fmt.Sprintf("%d/%d", page, totalPage), Data: ExchangeAccountListHandler{}.FormatPath(0)},
+			{Text: "➡️ 下一页", Data: ExchangeAccountListHandler{}.FormatPath(nextPage)},
+		}
+	}
+
+	inlineKeyboard = append(inlineKeyboard, pageButtons)
+	inlineKeyboard = append(inlineKeyboard, []tele.InlineButton{
+		{Text: "➕ 添加账户", Data: CreateExchangeAccountHandler{}.FormatPath()},
+		{Text: "⏪ 返回主页", Data: "/home"},
+	})
+
+	replyMarkup := &tele.ReplyMarkup{
+		InlineKeyboard: inlineKeyboard,
+	}
+	text := fmt.Sprintf("*%s* | 账户管理\n\n", svcCtx.Config.AppName)
+	text += "保存的交易所账户可以在多个策略中使用, 修改账户凭证后所有关联的策略同时生效。"
+
+	_, err = util.ReplyMessage(svcCtx.Bot, update, text, replyMarkup)
+	if err != nil {
+		logger.Debugf("[DisplayExchangeAccountList] 生成UI失败, %v", err)
+	}
+	return nil
+}
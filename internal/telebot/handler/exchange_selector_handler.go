@@ -3,7 +3,7 @@ package handler
 import (
 	"context"
 	"fmt"
-	"slices"
+	"strconv"
 
 	"github.com/fachebot/omni-grid-bot/internal/ent"
 	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
@@ -14,25 +14,18 @@ import (
 	"github.com/fachebot/omni-grid-bot/internal/telebot/pathrouter"
 	"github.com/fachebot/omni-grid-bot/internal/util"
 
+	"github.com/samber/lo"
 	tele "gopkg.in/telebot.v4"
 )
 
-type ExchangeType string
-
-const (
-	ExchangeTypeLighter     ExchangeType = "1"
-	ExchangeTypeParadex     ExchangeType = "2"
-	ExchangeTypeVariational ExchangeType = "3"
-)
-
-var (
-	validExchanges = []ExchangeType{ExchangeTypeLighter, ExchangeTypeParadex, ExchangeTypeVariational}
-)
-
 func IsValidExchange(ex string) bool {
-	return slices.Contains(validExchanges, ExchangeType(ex))
+	_, err := exchange.GetDriver(ex)
+	return err == nil
 }
 
+// maxAccountOptions 选择交易平台时最多显示的已保存交易所账户数量
+const maxAccountOptions = 20
+
 type ExchangeSelectorHandler struct {
 	svcCtx *svc.ServiceContext
 }
@@ -48,9 +41,15 @@ func (h ExchangeSelectorHandler) FormatPath(guid string, ex ...string) string {
 	return fmt.Sprintf("/exchange/%s/select/%s", guid, ex[0])
 }
 
+// FormatLinkPath 关联交易所账户的路径，accountId 为0时解除关联
+func (h ExchangeSelectorHandler) FormatLinkPath(guid string, accountId int) string {
+	return fmt.Sprintf("/exchange/%s/link/%d", guid, accountId)
+}
+
 func (h *ExchangeSelectorHandler) AddRouter(router *pathrouter.Router) {
 	router.HandleFunc("/exchange/{uuid}/select", h.handle)
 	router.HandleFunc("/exchange/{uuid}/select/{exchange}", h.handle)
+	router.HandleFunc("/exchange/{uuid}/link/{account:[0-9]+}", h.handle)
 }
 
 func (h *ExchangeSelectorHandler) handle(ctx context.Context, vars map[string]string, userId int64, update tele.Update) error {
@@ -81,6 +80,18 @@ func (h *ExchangeSelectorHandler) handle(ctx context.Context, vars map[string]st
 		return nil
 	}
 
+	// 关联或解除关联交易所账户
+	if value, ok := vars["account"]; ok {
+		accountId, err := strconv.Atoi(value)
+		if err != nil {
+			return nil
+		}
+		if accountId == 0 {
+			return h.handleUnlink(ctx, userId, update, record)
+		}
+		return h.handleLink(ctx, userId, update, record, accountId)
+	}
+
 	name := util.StrategyName(record)
 	text := fmt.Sprintf("*%s* | 交易平台 `%s`", h.svcCtx.Config.AppName, name)
 	text += "\n\n请选择运行网格策略的交易平台:"
@@ -88,20 +99,34 @@ func (h *ExchangeSelectorHandler) handle(ctx context.Context, vars map[string]st
 	// 返回交易所列表
 	value, ok := vars["exchange"]
 	if !ok {
-		replyMarkup := &tele.ReplyMarkup{
-			InlineKeyboard: [][]tele.InlineButton{
-				{
-					{Text: exchange.Lighter, Data: h.FormatPath(guid, string(ExchangeTypeLighter))},
-				},
-				{
-					{Text: exchange.Paradex, Data: h.FormatPath(guid, string(ExchangeTypeParadex))},
-				},
-				{
-					{Text: exchange.Variational, Data: h.FormatPath(guid, string(ExchangeTypeVariational))},
-				},
-			},
+		var inlineKeyboard [][]tele.InlineButton
+		for _, driver := range exchange.Drivers() {
+			inlineKeyboard = append(inlineKeyboard, []tele.InlineButton{
+				{Text: driver.Name(), Data: h.FormatPath(guid, driver.Name())},
+			})
 		}
 
+		// 已保存的交易所账户
+		accounts, _, err := h.svcCtx.ExchangeAccountModel.FindAllByOwner(ctx, userId, 0, maxAccountOptions)
+		if err != nil {
+			logger.Errorf("[ExchangeSelectorHandler] 查询交易所账户列表失败, userId: %d, %v", userId, err)
+		}
+		if len(accounts) > 0 {
+			text += "\n\n或者使用已保存的交易所账户:"
+			for _, account := range accounts {
+				inlineKeyboard = append(inlineKeyboard, []tele.InlineButton{
+					{Text: fmt.Sprintf("🔑 %s | %s", account.Label, account.Exchange), Data: h.FormatLinkPath(guid, account.ID)},
+				})
+			}
+		}
+		if account := record.Edges.ExchangeAccount; account != nil {
+			text += fmt.Sprintf("\n\n当前关联账户: `%s`", account.Label)
+			inlineKeyboard = append(inlineKeyboard, []tele.InlineButton{
+				{Text: "🔓 解除关联账户", Data: h.FormatLinkPath(guid, 0)},
+			})
+		}
+		replyMarkup := &tele.ReplyMarkup{InlineKeyboard: inlineKeyboard}
+
 		_, err = util.ReplyMessage(h.svcCtx.Bot, update, text, replyMarkup)
 		if err != nil {
 			logger.Debugf("[ExchangeSelectorHandler] 生成交易所列表UI失败, %v", err)
@@ -134,7 +159,7 @@ func (h *ExchangeSelectorHandler) handle(ctx context.Context, vars map[string]st
 				return err
 			}
 
-			return nil
+			return m.ClearAccountId(ctx, record.ID)
 		})
 
 		if err != nil {
@@ -148,14 +173,66 @@ func (h *ExchangeSelectorHandler) handle(ctx context.Context, vars map[string]st
 	}
 
 	// 返回交易所账户配置
-	switch ExchangeType(value) {
-	case ExchangeTypeLighter:
-		return NewExchangeSettingsLighterHandler(h.svcCtx).handle(ctx, vars, userId, update)
-	case ExchangeTypeParadex:
-		return NewExchangeSettingsParadexHandler(h.svcCtx).handle(ctx, vars, userId, update)
-	case ExchangeTypeVariational:
-		return NewExchangeSettingsVariationalHandler(h.svcCtx).handle(ctx, vars, userId, update)
+	return displayExchangeSettings(ctx, h.svcCtx, value, vars, userId, update)
+}
+
+func (h *ExchangeSelectorHandler) handleLink(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy, accountId int) error {
+	chatId := util.ChatId(update.Callback.Message.Chat.ID)
+
+	account, err := h.svcCtx.ExchangeAccountModel.FindOne(ctx, accountId)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			logger.Errorf("[ExchangeSelectorHandler] 查询交易所账户失败, id: %d, %v", accountId, err)
+		}
+		return nil
 	}
 
-	return nil
+	if account.Owner != userId {
+		return nil
+	}
+
+	if account.Account == "" {
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, chatId, "❌ 交易所账户尚未填写凭证", 3)
+		return nil
+	}
+
+	// 检查账户是否已被其他网格策略使用
+	if record.Symbol != "" {
+		result, err := h.svcCtx.StrategyModel.FindAllByExchangeAndAccountAndSymbol(ctx, account.Exchange, account.Account, record.Symbol)
+		if err != nil || lo.ContainsBy(result, func(item *ent.Strategy) bool { return item.ID != record.ID }) {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, chatId, "❌ 此账户已被其他网格策略使用", 3)
+			return nil
+		}
+	}
+
+	err = h.svcCtx.StrategyModel.LinkExchangeAccount(ctx, record.ID, account)
+	if err != nil {
+		logger.Errorf("[ExchangeSelectorHandler] 关联交易所账户失败, id: %s, account: %d, %v", record.GUID, account.ID, err)
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, chatId, "❌ 服务器内部错误, 请稍后重试", 1)
+		return nil
+	}
+
+	// 返回交易所账户配置
+	vars := map[string]string{"uuid": record.GUID}
+	return displayExchangeSettings(ctx, h.svcCtx, account.Exchange, vars, userId, update)
+}
+
+func (h *ExchangeSelectorHandler) handleUnlink(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
+	account := record.Edges.ExchangeAccount
+	if account == nil {
+		return nil
+	}
+
+	err := h.svcCtx.StrategyModel.UnlinkExchangeAccount(ctx, record.ID, account)
+	if err != nil {
+		logger.Errorf("[ExchangeSelectorHandler] 解除关联交易所账户失败, id: %s, account: %d, %v", record.GUID, account.ID, err)
+
+		chatId := util.ChatId(update.Callback.Message.Chat.ID)
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, chatId, "❌ 服务器内部错误, 请稍后重试", 1)
+		return nil
+	}
+
+	// 返回交易所账户配置
+	vars := map[string]string{"uuid": record.GUID}
+	return displayExchangeSettings(ctx, h.svcCtx, account.Exchange, vars, userId, update)
 }
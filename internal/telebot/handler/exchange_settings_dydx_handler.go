@@ -0,0 +1,301 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/fachebot/omni-grid-bot/internal/cache"
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/exchange/dydx"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/fachebot/omni-grid-bot/internal/model"
+	"github.com/fachebot/omni-grid-bot/internal/svc"
+	"github.com/fachebot/omni-grid-bot/internal/telebot/pathrouter"
+	"github.com/fachebot/omni-grid-bot/internal/util"
+
+	"github.com/samber/lo"
+	tele "gopkg.in/telebot.v4"
+)
+
+type DydxSettingsOption int
+
+var (
+	DydxSettingsOptionAccount  DydxSettingsOption = 1
+	DydxSettingsOptionMnemonic DydxSettingsOption = 2
+)
+
+func init() {
+	RegisterExchangeSettings(exchange.Dydx, func(svcCtx *svc.ServiceContext) pathrouter.HandlerFunc {
+		return NewExchangeSettingsDydxHandler(svcCtx).handle
+	})
+}
+
+type ExchangeSettingsDydxHandler struct {
+	svcCtx *svc.ServiceContext
+}
+
+func NewExchangeSettingsDydxHandler(svcCtx *svc.ServiceContext) *ExchangeSettingsDydxHandler {
+	return &ExchangeSettingsDydxHandler{svcCtx: svcCtx}
+}
+
+func (h ExchangeSettingsDydxHandler) FormatPath(guid string, option *DydxSettingsOption) string {
+	if option == nil {
+		return fmt.Sprintf("/dydx/%s/settings", guid)
+	}
+	return fmt.Sprintf("/dydx/%s/settings/%d", guid, *option)
+}
+
+func (h *ExchangeSettingsDydxHandler) AddRouter(router *pathrouter.Router) {
+	router.HandleFunc("/dydx/{uuid}/settings", h.handle)
+	router.HandleFunc("/dydx/{uuid}/settings/{option}", h.handle)
+}
+
+func (h *ExchangeSettingsDydxHandler) handle(ctx context.Context, vars map[string]string, userId int64, update tele.Update) error {
+	guid, ok := vars["uuid"]
+	if !ok {
+		return nil
+	}
+
+	record, err := h.svcCtx.StrategyModel.FindOneByGUID(ctx, guid)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return DisplayStrategyList(ctx, h.svcCtx, userId, update, 1)
+		}
+		logger.Errorf("[ExchangeSettingsDydxHandler] 查询策略信息失败, id: %s, %v", guid, err)
+		return nil
+	}
+
+	if record.Owner != userId {
+		return nil
+	}
+
+	if record.Status != strategy.StatusInactive {
+		chat, ok := util.GetChat(update)
+		if ok {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, chat, "❌ 策略运行中不允许修改此参数", 3)
+		}
+		return nil
+	}
+
+	// 更新交易所
+	defaultExchange := exchange.Dydx
+	err = h.svcCtx.StrategyModel.UpdateExchange(ctx, record.ID, defaultExchange)
+	if err != nil {
+		logger.Errorf("[ExchangeSettingsHandler] 更新配置[Exchange]失败, %v", err)
+
+		text := "❌ 服务器内部错误, 请稍后重试"
+		chatId := util.ChatId(update.Callback.Message.Chat.ID)
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, chatId, text, 1)
+		return nil
+	}
+
+	record.Exchange = defaultExchange
+
+	// 显示设置界面
+	option, ok := vars["option"]
+	if !ok {
+		return DisplayExchangeSettingsDydxSettings(ctx, h.svcCtx, userId, update, record)
+	}
+
+	// 关联交易所账户的策略在账户管理中修改凭证
+	if record.AccountId != nil {
+		chat, ok := util.GetChat(update)
+		if ok {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, chat, "❌ 策略已关联交易所账户, 请在账户管理中修改凭证", 3)
+		}
+		return nil
+	}
+
+	// 更新交易所设置
+	optionValue, err := strconv.Atoi(option)
+	if err != nil {
+		return DisplayExchangeSettingsDydxSettings(ctx, h.svcCtx, userId, update, record)
+	}
+	switch DydxSettingsOption(optionValue) {
+	case DydxSettingsOptionAccount:
+		return h.handleAccount(ctx, userId, update, record)
+	case DydxSettingsOptionMnemonic:
+		return h.handleMnemonic(ctx, userId, update, record)
+	}
+
+	return nil
+}
+
+func DisplayExchangeSettingsDydxSettings(ctx context.Context, svcCtx *svc.ServiceContext, userId int64, update tele.Update, record *ent.Strategy) error {
+	// 测试连通性
+	connectStatus := "🔴"
+	if testExchangeConnectivity(ctx, svcCtx, record) == nil {
+		connectStatus = "🟢"
+	}
+
+	statusText := func(s string) string {
+		return lo.If(s != "", "✅").Else("⬜")
+	}
+
+	name := util.StrategyName(record)
+	text := fmt.Sprintf("*%s* | 交易所配置 `%s`", svcCtx.Config.AppName, name)
+	text += "\n\n「调整设置, 优化您的跟单体验」"
+
+	account := record.ExchangeApiKey
+	mnemonic := record.ExchangeSecretKey
+	h := ExchangeSettingsDydxHandler{}
+	replyMarkup := &tele.ReplyMarkup{
+		InlineKeyboard: [][]tele.InlineButton{
+			{
+				{Text: fmt.Sprintf("%s dydx", connectStatus), Data: ExchangeSelectorHandler{}.FormatPath(record.GUID)},
+			},
+			{
+				{Text: fmt.Sprintf("%s 账户地址", statusText(account)), Data: h.FormatPath(record.GUID, &DydxSettingsOptionAccount)},
+				{Text: fmt.Sprintf("%s 助记词", statusText(mnemonic)), Data: h.FormatPath(record.GUID, &DydxSettingsOptionMnemonic)},
+			},
+
+			{
+				{Text: "◀️ 返回上级", Data: StrategySettingsHandler{}.FormatPath(record.GUID)},
+				{Text: "⏪ 返回主页", Data: "/home"},
+			},
+		},
+	}
+
+	_, err := util.ReplyMessage(svcCtx.Bot, update, text, replyMarkup)
+	if err != nil {
+		logger.Debugf("[DisplayExchangeSettingsDydxSettings] 生成dYdX设置界面失败, %v", err)
+	}
+	return nil
+}
+
+func (h *ExchangeSettingsDydxHandler) refreshSettingsMessage(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
+	chatId := update.Message.Chat.ID
+	if update.Message.ReplyTo == nil {
+		return DisplayExchangeSettingsDydxSettings(ctx, h.svcCtx, userId, update, record)
+	} else {
+		route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyTo.ID)
+		if ok && route.Context != nil {
+			return DisplayExchangeSettingsDydxSettings(ctx, h.svcCtx, userId, tele.Update{Message: route.Context}, record)
+		}
+		return DisplayExchangeSettingsDydxSettings(ctx, h.svcCtx, userId, update, record)
+	}
+}
+
+func (h *ExchangeSettingsDydxHandler) handleAccount(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.Callback != nil {
+		chatId := update.Callback.Message.Chat.ID
+		text := "🌳 填写dYdX账户地址。"
+		msg, err := h.svcCtx.Bot.Send(util.ChatId(chatId), text, defaultSendOptions())
+		if err != nil {
+			logger.Debugf("[ExchangeSettingsDydxHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &DydxSettingsOptionAccount), Context: update.Callback.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.ID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		deleteMessageAndReply(h.svcCtx.Bot, update.Message)
+
+		// 检查输入
+		chatId := update.Message.Chat.ID
+		input := strings.TrimSpace(update.Message.Text)
+		if !dydx.IsValidAddress(input) {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), "❌ 请输入有效dYdX账户地址", 3)
+			return nil
+		}
+		account := input
+
+		if record.Symbol != "" {
+			result, err := h.svcCtx.StrategyModel.FindAllByExchangeAndAccountAndSymbol(ctx, exchange.Dydx, account, record.Symbol)
+			if err != nil || len(result) > 0 {
+				text := "❌ 此dYdX账户地址已被其他网格策略使用"
+				util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), text, 1)
+				return nil
+			}
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err := util.Tx(ctx, h.svcCtx.DbClient, func(tx *ent.Tx) error {
+			m := model.NewStrategyModel(tx.Strategy)
+			if err := m.UpdateAccount(ctx, record.ID, account); err != nil {
+				return err
+			}
+
+			if err := m.UpdateExchangeSecretKey(ctx, record.ID, ""); err != nil {
+				return err
+			}
+
+			return m.UpdateExchangeAPIKey(ctx, record.ID, account)
+		})
+		if err == nil {
+			record.ExchangeApiKey = account
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[ExchangeSettingsDydxHandler] 更新配置[ExchangeAPIKey]失败, %v", err)
+		}
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), text, 1)
+
+		// 刷新用户界面
+		return h.refreshSettingsMessage(ctx, userId, update, record)
+	}
+
+	return nil
+}
+
+func (h *ExchangeSettingsDydxHandler) handleMnemonic(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.Callback != nil {
+		chatId := update.Callback.Message.Chat.ID
+		text := "🌳 填写dYdX账户助记词。\n\n助记词仅用于本地签名交易, 必须与账户地址对应。"
+		msg, err := h.svcCtx.Bot.Send(util.ChatId(chatId), text, defaultSendOptions())
+		if err != nil {
+			logger.Debugf("[ExchangeSettingsDydxHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &DydxSettingsOptionMnemonic), Context: update.Callback.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.ID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		deleteMessageAndReply(h.svcCtx.Bot, update.Message)
+
+		// 检查输入
+		chatId := update.Message.Chat.ID
+		mnemonic := strings.Join(strings.Fields(update.Message.Text), " ")
+		wallet, err := dydx.NewWallet(mnemonic)
+		if err != nil {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), "❌ 请输入有效dYdX账户助记词", 3)
+			return nil
+		}
+		if record.ExchangeApiKey != "" && wallet.Address() != record.ExchangeApiKey {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), "❌ 助记词与账户地址不匹配", 3)
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateExchangeSecretKey(ctx, record.ID, mnemonic)
+		if err == nil {
+			record.ExchangeSecretKey = mnemonic
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[ExchangeSettingsDydxHandler] 更新配置[ExchangeSecretKey]失败, %v", err)
+		}
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), text, 1)
+
+		// 刷新用户界面
+		return h.refreshSettingsMessage(ctx, userId, update, record)
+	}
+
+	return nil
+}
@@ -15,6 +15,27 @@ import (
 	tele "gopkg.in/telebot.v4"
 )
 
+// ExchangeSettingsFactory 交易所账户配置界面构造函数
+type ExchangeSettingsFactory func(svcCtx *svc.ServiceContext) pathrouter.HandlerFunc
+
+var (
+	exchangeSettingsFactories = make(map[string]ExchangeSettingsFactory)
+)
+
+// RegisterExchangeSettings 注册交易所账户配置界面
+// name 需与 exchange.Driver 的名称一致
+func RegisterExchangeSettings(name string, factory ExchangeSettingsFactory) {
+	exchangeSettingsFactories[name] = factory
+}
+
+func displayExchangeSettings(ctx context.Context, svcCtx *svc.ServiceContext, name string, vars map[string]string, userId int64, update tele.Update) error {
+	factory, ok := exchangeSettingsFactories[name]
+	if !ok {
+		return nil
+	}
+	return factory(svcCtx)(ctx, vars, userId, update)
+}
+
 type ExchangeSettingsHandler struct {
 	svcCtx *svc.ServiceContext
 }
@@ -60,7 +81,13 @@ func (h *ExchangeSettingsHandler) handle(ctx context.Context, vars map[string]st
 
 	// 设置默认交易所
 	if record.Exchange == "" {
-		err = h.svcCtx.StrategyModel.UpdateExchange(ctx, record.ID, exchange.Lighter)
+		drivers := exchange.Drivers()
+		if len(drivers) == 0 {
+			return nil
+		}
+
+		defaultExchange := drivers[0].Name()
+		err = h.svcCtx.StrategyModel.UpdateExchange(ctx, record.ID, defaultExchange)
 		if err != nil {
 			logger.Errorf("[ExchangeSettingsHandler] 更新配置[Exchange]失败, %v", err)
 
@@ -70,18 +97,9 @@ func (h *ExchangeSettingsHandler) handle(ctx context.Context, vars map[string]st
 			return nil
 		}
 
-		record.Exchange = exchange.Lighter
+		record.Exchange = defaultExchange
 	}
 
 	// 返回交易所配置
-	switch record.Exchange {
-	case exchange.Lighter:
-		return NewExchangeSettingsLighterHandler(h.svcCtx).handle(ctx, vars, userId, update)
-	case exchange.Paradex:
-		return NewExchangeSettingsParadexHandler(h.svcCtx).handle(ctx, vars, userId, update)
-	case exchange.Variational:
-		return NewExchangeSettingsVariationalHandler(h.svcCtx).handle(ctx, vars, userId, update)
-	}
-
-	return nil
+	return displayExchangeSettings(ctx, h.svcCtx, record.Exchange, vars, userId, update)
 }
@@ -27,6 +27,12 @@ var (
 	LighterSettingsOptionApiKeyIndex      LighterSettingsOption = 3
 )
 
+func init() {
+	RegisterExchangeSettings(exchange.Lighter, func(svcCtx *svc.ServiceContext) pathrouter.HandlerFunc {
+		return NewExchangeSettingsLighterHandler(svcCtx).handle
+	})
+}
+
 type ExchangeSettingsLighterHandler struct {
 	svcCtx *svc.ServiceContext
 }
@@ -94,6 +100,15 @@ func (h *ExchangeSettingsLighterHandler) handle(ctx context.Context, vars map[st
 		return DisplayExchangeSettingsLighterSettings(ctx, h.svcCtx, userId, update, record)
 	}
 
+	// 关联交易所账户的策略在账户管理中修改凭证
+	if record.AccountId != nil {
+		chat, ok := util.GetChat(update)
+		if ok {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, chat, "❌ 策略已关联交易所账户, 请在账户管理中修改凭证", 3)
+		}
+		return nil
+	}
+
 	// 更新交易所设置
 	optionValue, err := strconv.Atoi(option)
 	if err != nil {
@@ -126,7 +141,7 @@ func DisplayExchangeSettingsLighterSettings(ctx context.Context, svcCtx *svc.Ser
 	}
 
 	connectStatus := "🔴"
-	if testLighterConnectivity(ctx, svcCtx, record) == nil {
+	if testExchangeConnectivity(ctx, svcCtx, record) == nil {
 		connectStatus = "🟢"
 	}
 
@@ -0,0 +1,246 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/fachebot/omni-grid-bot/internal/cache"
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/fachebot/omni-grid-bot/internal/model"
+	"github.com/fachebot/omni-grid-bot/internal/svc"
+	"github.com/fachebot/omni-grid-bot/internal/telebot/pathrouter"
+	"github.com/fachebot/omni-grid-bot/internal/util"
+
+	"github.com/samber/lo"
+	tele "gopkg.in/telebot.v4"
+)
+
+type PaperSettingsOption int
+
+var paperAccountRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]{1,32}$`)
+
+var (
+	PaperSettingsOptionAccount PaperSettingsOption = 1
+)
+
+func init() {
+	RegisterExchangeSettings(exchange.Paper, func(svcCtx *svc.ServiceContext) pathrouter.HandlerFunc {
+		return NewExchangeSettingsPaperHandler(svcCtx).handle
+	})
+}
+
+type ExchangeSettingsPaperHandler struct {
+	svcCtx *svc.ServiceContext
+}
+
+func NewExchangeSettingsPaperHandler(svcCtx *svc.ServiceContext) *ExchangeSettingsPaperHandler {
+	return &ExchangeSettingsPaperHandler{svcCtx: svcCtx}
+}
+
+func (h ExchangeSettingsPaperHandler) FormatPath(guid string, option *PaperSettingsOption) string {
+	if option == nil {
+		return fmt.Sprintf("/paper/%s/settings", guid)
+	}
+	return fmt.Sprintf("/paper/%s/settings/%d", guid, *option)
+}
+
+func (h *ExchangeSettingsPaperHandler) AddRouter(router *pathrouter.Router) {
+	router.HandleFunc("/paper/{uuid}/settings", h.handle)
+	router.HandleFunc("/paper/{uuid}/settings/{option}", h.handle)
+}
+
+func (h *ExchangeSettingsPaperHandler) handle(ctx context.Context, vars map[string]string, userId int64, update tele.Update) error {
+	guid, ok := vars["uuid"]
+	if !ok {
+		return nil
+	}
+
+	record, err := h.svcCtx.StrategyModel.FindOneByGUID(ctx, guid)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return DisplayStrategyList(ctx, h.svcCtx, userId, update, 1)
+		}
+		logger.Errorf("[ExchangeSettingsPaperHandler] 查询策略信息失败, id: %s, %v", guid, err)
+		return nil
+	}
+
+	if record.Owner != userId {
+		return nil
+	}
+
+	if record.Status != strategy.StatusInactive {
+		chat, ok := util.GetChat(update)
+		if ok {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, chat, "❌ 策略运行中不允许修改此参数", 3)
+		}
+		return nil
+	}
+
+	// 更新交易所
+	defaultExchange := exchange.Paper
+	err = h.svcCtx.StrategyModel.UpdateExchange(ctx, record.ID, defaultExchange)
+	if err != nil {
+		logger.Errorf("[ExchangeSettingsHandler] 更新配置[Exchange]失败, %v", err)
+
+		text := "❌ 服务器内部错误, 请稍后重试"
+		chatId := util.ChatId(update.Callback.Message.Chat.ID)
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, chatId, text, 1)
+		return nil
+	}
+
+	record.Exchange = defaultExchange
+
+	// 显示设置界面
+	option, ok := vars["option"]
+	if !ok {
+		return DisplayExchangeSettingsPaperSettings(ctx, h.svcCtx, userId, update, record)
+	}
+
+	// 关联交易所账户的策略在账户管理中修改凭证
+	if record.AccountId != nil {
+		chat, ok := util.GetChat(update)
+		if ok {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, chat, "❌ 策略已关联交易所账户, 请在账户管理中修改凭证", 3)
+		}
+		return nil
+	}
+
+	// 更新交易所设置
+	optionValue, err := strconv.Atoi(option)
+	if err != nil {
+		return DisplayExchangeSettingsPaperSettings(ctx, h.svcCtx, userId, update, record)
+	}
+	switch PaperSettingsOption(optionValue) {
+	case PaperSettingsOptionAccount:
+		return h.handleAccount(ctx, userId, update, record)
+	}
+
+	return nil
+}
+
+func DisplayExchangeSettingsPaperSettings(ctx context.Context, svcCtx *svc.ServiceContext, userId int64, update tele.Update, record *ent.Strategy) error {
+	// 测试连通性
+	connectStatus := "🔴"
+	if testExchangeConnectivity(ctx, svcCtx, record) == nil {
+		connectStatus = "🟢"
+	}
+
+	statusText := func(s string) string {
+		return lo.If(s != "", "✅").Else("⬜")
+	}
+
+	name := util.StrategyName(record)
+	text := fmt.Sprintf("*%s* | 交易所配置 `%s`", svcCtx.Config.AppName, name)
+	text += "\n\n「模拟交易使用真实行情在本地撮合, 不会动用真实资金」"
+
+	account := record.ExchangeApiKey
+	h := ExchangeSettingsPaperHandler{}
+	replyMarkup := &tele.ReplyMarkup{
+		InlineKeyboard: [][]tele.InlineButton{
+			{
+				{Text: fmt.Sprintf("%s paper", connectStatus), Data: ExchangeSelectorHandler{}.FormatPath(record.GUID)},
+			},
+			{
+				{Text: fmt.Sprintf("%s 模拟账户", statusText(account)), Data: h.FormatPath(record.GUID, &PaperSettingsOptionAccount)},
+			},
+
+			{
+				{Text: "◀️ 返回上级", Data: StrategySettingsHandler{}.FormatPath(record.GUID)},
+				{Text: "⏪ 返回主页", Data: "/home"},
+			},
+		},
+	}
+
+	_, err := util.ReplyMessage(svcCtx.Bot, update, text, replyMarkup)
+	if err != nil {
+		logger.Debugf("[DisplayExchangeSettingsPaperSettings] 生成模拟交易设置界面失败, %v", err)
+	}
+	return nil
+}
+
+func (h *ExchangeSettingsPaperHandler) refreshSettingsMessage(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
+	chatId := update.Message.Chat.ID
+	if update.Message.ReplyTo == nil {
+		return DisplayExchangeSettingsPaperSettings(ctx, h.svcCtx, userId, update, record)
+	} else {
+		route, ok := h.svcCtx.MessageCache.GetRoute(chatId, update.Message.ReplyTo.ID)
+		if ok && route.Context != nil {
+			return DisplayExchangeSettingsPaperSettings(ctx, h.svcCtx, userId, tele.Update{Message: route.Context}, record)
+		}
+		return DisplayExchangeSettingsPaperSettings(ctx, h.svcCtx, userId, update, record)
+	}
+}
+
+func (h *ExchangeSettingsPaperHandler) handleAccount(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.Callback != nil {
+		chatId := update.Callback.Message.Chat.ID
+		text := "🌳 填写模拟账户名称。\n\n名称由字母、数字、下划线或短横线组成, 相同名称的策略共享模拟账户余额。"
+		msg, err := h.svcCtx.Bot.Send(util.ChatId(chatId), text, defaultSendOptions())
+		if err != nil {
+			logger.Debugf("[ExchangeSettingsPaperHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, &PaperSettingsOptionAccount), Context: update.Callback.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.ID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		deleteMessageAndReply(h.svcCtx.Bot, update.Message)
+
+		// 检查输入
+		chatId := update.Message.Chat.ID
+		input := strings.TrimSpace(update.Message.Text)
+		if !paperAccountRegexp.MatchString(input) {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), "❌ 请输入有效模拟账户名称", 3)
+			return nil
+		}
+		account := fmt.Sprintf("%d:%s", userId, input)
+
+		if record.Symbol != "" {
+			result, err := h.svcCtx.StrategyModel.FindAllByExchangeAndAccountAndSymbol(ctx, exchange.Paper, account, record.Symbol)
+			if err != nil || len(result) > 0 {
+				text := "❌ 此模拟账户已被其他网格策略使用"
+				util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), text, 1)
+				return nil
+			}
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err := util.Tx(ctx, h.svcCtx.DbClient, func(tx *ent.Tx) error {
+			m := model.NewStrategyModel(tx.Strategy)
+			if err := m.UpdateAccount(ctx, record.ID, account); err != nil {
+				return err
+			}
+
+			if err := m.UpdateExchangeSecretKey(ctx, record.ID, ""); err != nil {
+				return err
+			}
+
+			return m.UpdateExchangeAPIKey(ctx, record.ID, account)
+		})
+		if err == nil {
+			record.ExchangeApiKey = account
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[ExchangeSettingsPaperHandler] 更新配置[ExchangeAPIKey]失败, %v", err)
+		}
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), text, 1)
+
+		// 刷新用户界面
+		return h.refreshSettingsMessage(ctx, userId, update, record)
+	}
+
+	return nil
+}
@@ -27,6 +27,12 @@ var (
 	ParadexSettingsOptionDexPrivateKey ParadexSettingsOption = 2
 )
 
+func init() {
+	RegisterExchangeSettings(exchange.Paradex, func(svcCtx *svc.ServiceContext) pathrouter.HandlerFunc {
+		return NewExchangeSettingsParadexHandler(svcCtx).handle
+	})
+}
+
 type ExchangeSettingsParadexHandler struct {
 	svcCtx *svc.ServiceContext
 }
@@ -94,6 +100,15 @@ func (h *ExchangeSettingsParadexHandler) handle(ctx context.Context, vars map[st
 		return DisplayExchangeSettingsParadexSettings(ctx, h.svcCtx, userId, update, record)
 	}
 
+	// 关联交易所账户的策略在账户管理中修改凭证
+	if record.AccountId != nil {
+		chat, ok := util.GetChat(update)
+		if ok {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, chat, "❌ 策略已关联交易所账户, 请在账户管理中修改凭证", 3)
+		}
+		return nil
+	}
+
 	// 更新交易所设置
 	optionValue, err := strconv.Atoi(option)
 	if err != nil {
@@ -112,7 +127,7 @@ func (h *ExchangeSettingsParadexHandler) handle(ctx context.Context, vars map[st
 func DisplayExchangeSettingsParadexSettings(ctx context.Context, svcCtx *svc.ServiceContext, userId int64, update tele.Update, record *ent.Strategy) error {
 	// 测试连通性
 	connectStatus := "🔴"
-	if testParadexConnectivity(ctx, svcCtx, record) == nil {
+	if testExchangeConnectivity(ctx, svcCtx, record) == nil {
 		connectStatus = "🟢"
 	}
 
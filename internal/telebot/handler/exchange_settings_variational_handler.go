@@ -29,6 +29,12 @@ var (
 	VariationalSettingsOptionDexPrivateKey VariationalSettingsOption = 2
 )
 
+func init() {
+	RegisterExchangeSettings(exchange.Variational, func(svcCtx *svc.ServiceContext) pathrouter.HandlerFunc {
+		return NewExchangeSettingsVariationalHandler(svcCtx).handle
+	})
+}
+
 type ExchangeSettingsVariationalHandler struct {
 	svcCtx *svc.ServiceContext
 }
@@ -96,6 +102,15 @@ func (h *ExchangeSettingsVariationalHandler) handle(ctx context.Context, vars ma
 		return DisplayExchangeSettingsVariationalSettings(ctx, h.svcCtx, userId, update, record)
 	}
 
+	// 关联交易所账户的策略在账户管理中修改凭证
+	if record.AccountId != nil {
+		chat, ok := util.GetChat(update)
+		if ok {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, chat, "❌ 策略已关联交易所账户, 请在账户管理中修改凭证", 3)
+		}
+		return nil
+	}
+
 	// 更新交易所设置
 	optionValue, err := strconv.Atoi(option)
 	if err != nil {
@@ -114,7 +129,7 @@ func (h *ExchangeSettingsVariationalHandler) handle(ctx context.Context, vars ma
 func DisplayExchangeSettingsVariationalSettings(ctx context.Context, svcCtx *svc.ServiceContext, userId int64, update tele.Update, record *ent.Strategy) error {
 	// 测试连通性
 	connectStatus := "🔴"
-	if testVariationalConnectivity(ctx, svcCtx, record) == nil {
+	if testExchangeConnectivity(ctx, svcCtx, record) == nil {
 		connectStatus = "🟢"
 	}
 
@@ -8,7 +8,7 @@ import (
 	"time"
 
 	"github.com/fachebot/omni-grid-bot/internal/ent"
-	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/helper"
 	"github.com/fachebot/omni-grid-bot/internal/logger"
 	"github.com/fachebot/omni-grid-bot/internal/svc"
 	"github.com/fachebot/omni-grid-bot/internal/telebot/pathrouter"
@@ -101,15 +101,18 @@ func (h *MatchedTradesHandler) handle(ctx context.Context, vars map[string]strin
 
 		buyPrice := format.Price(trade.BuyQuoteAmount.Div(*trade.BuyBaseAmount), 5)
 		sellPrice := format.Price(trade.SellQuoteAmount.Div(*trade.SellBaseAmount), 5)
+		profit := fmt.Sprintf("利润 %v USD", *trade.Profit)
+		if trade.Fee != nil && trade.NetProfit != nil {
+			profit = fmt.Sprintf("利润 %v USD, 手续费 %v, 净利润 %v USD", *trade.Profit, *trade.Fee, *trade.NetProfit)
+		}
 
-		switch record.Mode {
-		case strategy.ModeLong:
+		if helper.IsLongTrade(record, trade) {
 			date := util.FormaDate(time.UnixMilli(*trade.SellOrderTimestamp))
-			s := fmt.Sprintf("*%s* 🟢 开多 %s %s, 价格 %s, 平多价格 %s, 利润 %v USD", date, trade.BuyBaseAmount, trade.Symbol, buyPrice, sellPrice, *trade.Profit)
+			s := fmt.Sprintf("*%s* 🟢 开多 %s %s, 价格 %s, 平多价格 %s, %s", date, trade.BuyBaseAmount, trade.Symbol, buyPrice, sellPrice, profit)
 			items = append(items, s)
-		case strategy.ModeShort:
+		} else {
 			date := util.FormaDate(time.UnixMilli(*trade.BuyOrderTimestamp))
-			s := fmt.Sprintf("*%s* 🔴 开空 %s %s, 价格 %s, 平空价格 %s, 利润 %v USD", date, trade.SellBaseAmount, trade.Symbol, sellPrice, buyPrice, *trade.Profit)
+			s := fmt.Sprintf("*%s* 🔴 开空 %s %s, 价格 %s, 平空价格 %s, %s", date, trade.SellBaseAmount, trade.Symbol, sellPrice, buyPrice, profit)
 			items = append(items, s)
 		}
 	}
@@ -5,10 +5,14 @@ import (
 	"github.com/fachebot/omni-grid-bot/internal/telebot/pathrouter"
 )
 
+// InitRoutes 注册处理器路由，并向服务上下文注册策略通知消息的操作按钮
 func InitRoutes(svcCtx *svc.ServiceContext, router *pathrouter.Router) {
+	svcCtx.ReplyMarkups = replyMarkups{}
+
 	NewDeleteMessageHandler(svcCtx).AddRouter(router)
 	NewClosePositionHandler(svcCtx).AddRouter(router)
 	NewMatchedTradesHandler(svcCtx).AddRouter(router)
+	NewStrategyRunsHandler(svcCtx).AddRouter(router)
 	NewCreateStrategyHandler(svcCtx).AddRouter(router)
 	NewDeleteStrategyHandler(svcCtx).AddRouter(router)
 	NewStrategyDetailsHandler(svcCtx).AddRouter(router)
@@ -20,4 +24,11 @@ func InitRoutes(svcCtx *svc.ServiceContext, router *pathrouter.Router) {
 	NewExchangeSettingsLighterHandler(svcCtx).AddRouter(router)
 	NewExchangeSettingsParadexHandler(svcCtx).AddRouter(router)
 	NewExchangeSettingsVariationalHandler(svcCtx).AddRouter(router)
+	NewExchangeSettingsHyperliquidHandler(svcCtx).AddRouter(router)
+	NewExchangeSettingsDydxHandler(svcCtx).AddRouter(router)
+	NewExchangeSettingsPaperHandler(svcCtx).AddRouter(router)
+	NewExchangeAccountListHandler(svcCtx).AddRouter(router)
+	NewCreateExchangeAccountHandler(svcCtx).AddRouter(router)
+	NewExchangeAccountDetailsHandler(svcCtx).AddRouter(router)
+	NewDeleteExchangeAccountHandler(svcCtx).AddRouter(router)
 }
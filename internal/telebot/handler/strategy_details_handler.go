@@ -10,9 +10,9 @@ import (
 	"github.com/fachebot/omni-grid-bot/internal/ent"
 	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
 	"github.com/fachebot/omni-grid-bot/internal/exchange"
-	"github.com/fachebot/omni-grid-bot/internal/exchange/paradex"
 	"github.com/fachebot/omni-grid-bot/internal/helper"
 	"github.com/fachebot/omni-grid-bot/internal/logger"
+	gridstrategy "github.com/fachebot/omni-grid-bot/internal/strategy"
 	"github.com/fachebot/omni-grid-bot/internal/svc"
 	"github.com/fachebot/omni-grid-bot/internal/telebot/pathrouter"
 	"github.com/fachebot/omni-grid-bot/internal/util"
@@ -129,16 +129,41 @@ func marketSymbol(record *ent.Strategy) string {
 		return "未设置"
 	}
 
-	switch record.Exchange {
-	case exchange.Lighter:
-		return fmt.Sprintf("[%s](https://app.lighter.xyz/trade/%s)", record.Symbol, record.Symbol)
-	case exchange.Paradex:
-		return fmt.Sprintf("[%s](https://app.paradex.trade/trade/%s)", record.Symbol, paradex.FormatUsdPerpMarket(record.Symbol))
-	case exchange.Variational:
-		return fmt.Sprintf("[%s](https://omni.variational.io/perpetual/%s)", record.Symbol, record.Symbol)
-	default:
+	driver, err := exchange.GetDriver(record.Exchange)
+	if err != nil {
 		return "未设置"
 	}
+	return fmt.Sprintf("[%s](%s)", record.Symbol, driver.MarketURL(record.Symbol))
+}
+
+// trailingStopText 移动止损止盈的极值价格和触发价格
+func trailingStopText(record *ent.Strategy) string {
+	status := gridstrategy.GetTrailingStopStatus(record)
+
+	extremePrice := "未记录"
+	if status.ExtremePrice != nil {
+		extremePrice = "$" + format.Price(*status.ExtremePrice, 5)
+	}
+
+	stopLoss := trailingDistanceText(record.TrailingStopLossAmount, record.TrailingStopLossPercent)
+	if status.StopLossPrice != nil {
+		stopLoss = fmt.Sprintf("回撤 %s | 触发价格 *$%s*", stopLoss, format.Price(*status.StopLossPrice, 5))
+	}
+
+	takeProfit := trailingDistanceText(record.TrailingTakeProfitAmount, record.TrailingTakeProfitPercent)
+	if record.TrailingTakeProfitActivationPrice == nil || !record.TrailingTakeProfitActivationPrice.IsPositive() {
+		takeProfit = "关闭"
+	} else if status.TakeProfitPrice != nil {
+		takeProfit = fmt.Sprintf("回撤 %s | 触发价格 *$%s*", takeProfit, format.Price(*status.TakeProfitPrice, 5))
+	} else if takeProfit != "关闭" {
+		takeProfit = fmt.Sprintf("未激活(激活价格 $%s)", format.Price(*record.TrailingTakeProfitActivationPrice, 5))
+	}
+
+	text := "🪂 移动止损止盈\n"
+	text += fmt.Sprintf("┣ %s: %s\n", lo.If(record.Mode == strategy.ModeShort, "最低价格").Else("最高价格"), extremePrice)
+	text += fmt.Sprintf("┣ 移动止损: %s\n", stopLoss)
+	text += fmt.Sprintf("┗ 移动止盈: %s\n\n", takeProfit)
+	return text
 }
 
 func StrategyDetailsText(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy) string {
@@ -170,6 +195,9 @@ func StrategyDetailsText(ctx context.Context, svcCtx *svc.ServiceContext, record
 		}
 	}
 	text += fmt.Sprintf("┣ 交易平台: *%s*\n", exchangeAccount)
+	if account := record.Edges.ExchangeAccount; account != nil {
+		text += fmt.Sprintf("┣ 关联账户: `%s`\n", account.Label)
+	}
 
 	var position *exchange.Position
 	var availableBalance decimal.Decimal
@@ -179,7 +207,7 @@ func StrategyDetailsText(ctx context.Context, svcCtx *svc.ServiceContext, record
 			availableBalance = account.AvailableBalance
 			positionSide := lo.If(record.Mode == strategy.ModeLong, exchange.PositionSideLong).Else(exchange.PositionSideShort)
 			position, _ = lo.Find(account.Positions, func(item *exchange.Position) bool {
-				return record.Symbol == item.Symbol && item.Side == positionSide
+				return record.Symbol == item.Symbol && (record.Mode == strategy.ModeNeutral || item.Side == positionSide)
 			})
 		}
 	}
@@ -191,13 +219,21 @@ func StrategyDetailsText(ctx context.Context, svcCtx *svc.ServiceContext, record
 	} else {
 		text += fmt.Sprintf("📌 策略(%s)\n", time.Since(*record.StartTime))
 	}
-	positionSide := lo.If(record.Mode == strategy.ModeLong, "🟢做多").Else("🔴做空")
+	icon, modeName := gridModeText(record.Mode)
+	positionSide := icon + modeName
 	marginMode := lo.If(record.MarginMode == strategy.MarginModeCross, "全仓").Else("逐仓")
 	text += fmt.Sprintf("┣ 方向: %s | 杠杆: **%dX** | %s\n", positionSide, record.Leverage, marginMode)
 	text += fmt.Sprintf("┣ 交易标的: %s\n", marketSymbol(record))
 	text += fmt.Sprintf("┣ 价格区间: %s\n", lo.If(record.PriceLower.IsZero() || record.PriceUpper.IsZero(), "未设置").
 		Else(fmt.Sprintf("$%s ~ $%s", record.PriceLower, record.PriceUpper)))
-	text += fmt.Sprintf("┗ 单格投入: %s\n\n", lo.If(record.Symbol != "" && !record.InitialOrderSize.IsZero(), fmt.Sprintf("%s %s", record.InitialOrderSize, record.Symbol)).Else("未设置"))
+	orderSize := "未设置"
+	if record.Symbol != "" && !record.InitialOrderSize.IsZero() {
+		orderSize = fmt.Sprintf("%s %s", record.InitialOrderSize, record.Symbol)
+		if record.SizingMode != strategy.SizingModeFixed {
+			orderSize += fmt.Sprintf(" (%s)", sizingModeText(record.SizingMode))
+		}
+	}
+	text += fmt.Sprintf("┗ 单格投入: %s\n\n", orderSize)
 
 	// 查询最新价格
 	lastPrice := decimal.Zero
@@ -217,23 +253,15 @@ func StrategyDetailsText(ctx context.Context, svcCtx *svc.ServiceContext, record
 		text += fmt.Sprintf("┗ 平均持仓成本: $%s\n\n", format.Price(position.AvgEntryPrice, 5))
 	}
 
+	// 移动止损止盈
+	if gridstrategy.TrailingStopEnabled(record) {
+		text += trailingStopText(record)
+	}
+
 	// 计算未实现收益
-	unrealizedPnl := decimal.Zero
-	switch record.Mode {
-	case strategy.ModeLong:
-		size, cost, err := svcCtx.MatchedTradeModel.QueryOpeLongPositionAndCost(ctx, record.GUID)
-		if err == nil {
-			unrealizedPnl = size.Mul(lastPrice).Sub(cost)
-		} else {
-			logger.Errorf("[StrategyDetailsText] 查询未平多仓和成本失败, id: %s, %v", record.GUID, err)
-		}
-	case strategy.ModeShort:
-		size, cost, err := svcCtx.MatchedTradeModel.QueryOpenShortPositionAndCost(ctx, record.GUID)
-		if err == nil {
-			unrealizedPnl = cost.Sub(size.Mul(lastPrice))
-		} else {
-			logger.Errorf("[StrategyDetailsText] 查询未平多仓和成本失败, id: %s, %v", record.GUID, err)
-		}
+	unrealizedPnl, err := helper.UnrealizedProfit(ctx, svcCtx, record, lastPrice)
+	if err != nil {
+		logger.Errorf("[StrategyDetailsText] 查询未平仓位和成本失败, id: %s, %v", record.GUID, err)
 	}
 
 	// 收益信息
@@ -241,10 +269,19 @@ func StrategyDetailsText(ctx context.Context, svcCtx *svc.ServiceContext, record
 	if err != nil {
 		logger.Warnf("[StrategyDetailsText] 查询已实现利润失败, id: %s, %v", record.GUID, err)
 	}
-	pnl := realizedPnl.Add(unrealizedPnl)
-	if position != nil {
-		pnl = pnl.Sub(position.TotalFundingPaidOut)
+	fee, err := svcCtx.MatchedTradeModel.QueryTotalFee(ctx, record.GUID)
+	if err != nil {
+		logger.Warnf("[StrategyDetailsText] 查询已结算手续费失败, id: %s, %v", record.GUID, err)
+	}
+	// 优先使用同步到本地的资金费用，没有资金费用记录时使用交易所持仓的累计资金费用
+	funding, ok, err := svcCtx.FundingPaymentModel.QueryTotalAmount(ctx, record.GUID)
+	if err != nil {
+		logger.Warnf("[StrategyDetailsText] 查询资金费用失败, id: %s, %v", record.GUID, err)
 	}
+	if !ok && position != nil {
+		funding = position.TotalFundingPaidOut
+	}
+	pnl := helper.StrategyProfit{RealizedProfit: realizedPnl, Fee: fee, Funding: funding, UnrealizedProfit: unrealizedPnl}.Total()
 
 	text += "💰 收益\n"
 	if record.Status == strategy.StatusActive && record.StartTime != nil && totalInvestment.GreaterThan(decimal.Zero) {
@@ -255,6 +292,8 @@ func StrategyDetailsText(ctx context.Context, svcCtx *svc.ServiceContext, record
 		text += fmt.Sprintf("┣ 总利润: %s\n", pnl.Truncate(5))
 	}
 	text += fmt.Sprintf("┣ 已实现利润: %s\n", realizedPnl.Truncate(5))
+	text += fmt.Sprintf("┣ 已结算手续费: %s\n", fee.Truncate(5))
+	text += fmt.Sprintf("┣ 资金费用: %s\n", funding.Truncate(5))
 	text += fmt.Sprintf("┗ 未实现利润: %s\n\n", unrealizedPnl.Truncate(5))
 
 	// 显示网格挂单
@@ -262,7 +301,7 @@ func StrategyDetailsText(ctx context.Context, svcCtx *svc.ServiceContext, record
 		text += fmt.Sprintf("➖[💵] *当前价格*: $*%s*\n\n", lastPrice)
 	} else {
 		gridList := formatGridListWithCurrentPrice(lastPrice, grids)
-		if record.Mode == strategy.ModeLong {
+		if record.Mode != strategy.ModeShort {
 			slices.Reverse(gridList)
 		}
 		text += "🟢 买入订单 | 🔴 卖出订单\n\n" + strings.Join(gridList, "\n")
@@ -270,7 +309,7 @@ func StrategyDetailsText(ctx context.Context, svcCtx *svc.ServiceContext, record
 		text += fmt.Sprintf("初始保证金: $%v\n\n", totalInvestment.Div(decimal.NewFromInt(int64(record.Leverage))).Truncate(2))
 	}
 
-	text += fmt.Sprintf("🕒 更新时间: [%s]\n\n⚠️ 重要提示:\n▸ *停止策略会清空之前的网格记录!*", util.FormaTime(time.Now()))
+	text += fmt.Sprintf("🕒 更新时间: [%s]\n\n⚠️ 重要提示:\n▸ *停止策略会归档本次运行的网格和匹配记录，可在运行记录中查看!*", util.FormaTime(time.Now()))
 	return text
 }
 
@@ -293,7 +332,10 @@ func DisplayStrategyDetails(ctx context.Context, svcCtx *svc.ServiceContext, use
 				{Text: "🗒 匹配记录", Data: MatchedTradesHandler{}.FormatPath(record.GUID, 1)},
 			},
 			{
+				{Text: "📜 运行记录", Data: StrategyRunsHandler{}.FormatPath(record.GUID, 1)},
 				{Text: "⚙️ 编辑策略", Data: StrategySettingsHandler{}.FormatPath(record.GUID)},
+			},
+			{
 				{Text: "🗑 删除策略", Data: DeleteStrategyHandler{}.FormatPath(record.GUID)},
 			},
 			{
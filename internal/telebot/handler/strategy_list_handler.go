@@ -123,6 +123,9 @@ func DisplayStrategyList(ctx context.Context, svcCtx *svc.ServiceContext, userId
 		{Text: "🔄 刷新界面", Data: StrategyListHandler{}.FormatPath(1)},
 		{Text: "➕ 创建策略", Data: CreateStrategyHandler{}.FormatPath()},
 	})
+	inlineKeyboard = append(inlineKeyboard, []tele.InlineButton{
+		{Text: "🔑 账户管理", Data: ExchangeAccountListHandler{}.FormatPath(1)},
+	})
 
 	replyMarkup := &tele.ReplyMarkup{
 		InlineKeyboard: inlineKeyboard,
@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategyrun"
+	"github.com/fachebot/omni-grid-bot/internal/logger"
+	"github.com/fachebot/omni-grid-bot/internal/svc"
+	"github.com/fachebot/omni-grid-bot/internal/telebot/pathrouter"
+	"github.com/fachebot/omni-grid-bot/internal/util"
+	"github.com/shopspring/decimal"
+	tele "gopkg.in/telebot.v4"
+)
+
+type StrategyRunsHandler struct {
+	svcCtx *svc.ServiceContext
+}
+
+func NewStrategyRunsHandler(svcCtx *svc.ServiceContext) *StrategyRunsHandler {
+	return &StrategyRunsHandler{svcCtx: svcCtx}
+}
+
+func (h StrategyRunsHandler) FormatPath(guid string, page int) string {
+	return fmt.Sprintf("/strategy/runs/%s/%d", guid, page)
+}
+
+func (h *StrategyRunsHandler) AddRouter(router *pathrouter.Router) {
+	router.HandleFunc("/strategy/runs/{uuid}/{page:[0-9]+}", h.handle)
+}
+
+func stopReasonText(reason *strategyrun.StopReason) string {
+	if reason == nil {
+		return "未知"
+	}
+
+	switch *reason {
+	case strategyrun.StopReasonManual:
+		return "手动停止"
+	case strategyrun.StopReasonClosePosition:
+		return "停止并平仓"
+	case strategyrun.StopReasonStopLoss:
+		return "触发止损"
+	case strategyrun.StopReasonTakeProfit:
+		return "触发止盈"
+	case strategyrun.StopReasonOrderCanceled:
+		return "订单被取消"
+	case strategyrun.StopReasonLiquidationRisk:
+		return "强平风险"
+	case strategyrun.StopReasonMaxLoss:
+		return "最大亏损"
+	case strategyrun.StopReasonProfitTarget:
+		return "目标利润"
+	case strategyrun.StopReasonTrailingStopLoss:
+		return "移动止损"
+	case strategyrun.StopReasonTrailingTakeProfit:
+		return "移动止盈"
+	default:
+		return string(*reason)
+	}
+}
+
+func formatRunProfit(value *decimal.Decimal) string {
+	if value == nil {
+		return "未知"
+	}
+	return value.Truncate(5).String()
+}
+
+func formatStrategyRun(run *ent.StrategyRun) string {
+	icon, modeName := gridModeText(strategy.Mode(run.Mode))
+	text := fmt.Sprintf("*%s* %s%s %s | *%dX*\n", util.FormaTime(run.StartTime), icon, modeName, run.Symbol, run.Leverage)
+	text += fmt.Sprintf("┣ 价格区间: $%s ~ $%s | %d格\n", run.PriceLower, run.PriceUpper, run.GridNum)
+	if run.Status == strategyrun.StatusRunning || run.EndTime == nil {
+		text += fmt.Sprintf("┗ 运行中(%s)", time.Since(run.StartTime).Truncate(time.Second))
+		return text
+	}
+
+	text += fmt.Sprintf("┣ 停止: %s(%s)\n", util.FormaTime(*run.EndTime), stopReasonText(run.StopReason))
+	text += fmt.Sprintf("┣ 运行时长: %s | 匹配次数: %d\n", run.EndTime.Sub(run.StartTime).Truncate(time.Second), run.MatchedTrades)
+	text += fmt.Sprintf("┣ 已实现利润: %s | 手续费: %s | 资金费用: %s\n",
+		formatRunProfit(run.RealizedProfit), formatRunProfit(run.Fee), formatRunProfit(run.Funding))
+	text += fmt.Sprintf("┗ 未实现利润: %s | *总利润: %s*", formatRunProfit(run.UnrealizedProfit), formatRunProfit(run.TotalProfit))
+	return text
+}
+
+func (h *StrategyRunsHandler) handle(ctx context.Context, vars map[string]string, userId int64, update tele.Update) error {
+	guid, ok := vars["uuid"]
+	if !ok {
+		return nil
+	}
+
+	var page int
+	val, ok := vars["page"]
+	if !ok {
+		page = 1
+	} else {
+		n, err := strconv.Atoi((val))
+		if err != nil {
+			page = 1
+		} else {
+			page = n
+		}
+	}
+
+	// 查询策略信息
+	record, err := h.svcCtx.StrategyModel.FindOneByGUID(ctx, guid)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return DisplayStrategyList(ctx, h.svcCtx, userId, update, 1)
+		}
+		logger.Errorf("[StrategyRunsHandler] 查询策略信息失败, id: %s, %v", guid, err)
+		return nil
+	}
+
+	if record.Owner != userId {
+		return DisplayStrategyList(ctx, h.svcCtx, userId, update, 1)
+	}
+
+	// 查询运行记录
+	const limit = 5
+	offset := (page - 1) * limit
+	runs, total, err := h.svcCtx.StrategyRunModel.FindAllByStrategyId(ctx, guid, offset, limit)
+	if err != nil {
+		logger.Errorf("[StrategyRunsHandler] 查询策略运行记录失败, userId: %d, strategy: %s, %v", userId, guid, err)
+		return nil
+	}
+
+	totalPage := total / limit
+	if total%limit != 0 {
+		totalPage += 1
+	}
+
+	if page > totalPage {
+		page = totalPage
+		offset := (page - 1) * limit
+		runs, total, err = h.svcCtx.StrategyRunModel.FindAllByStrategyId(ctx, guid, offset, limit)
+		if err != nil {
+			logger.Errorf("[StrategyRunsHandler] 查询策略运行记录失败, userId: %d, strategy: %s, %v", userId, guid, err)
+			return nil
+		}
+	}
+
+	// 多页翻页功能
+	var pageButtons []tele.InlineButton
+	if total > limit {
+		nextPage := page + 1
+		previousPage := page - 1
+		if previousPage < 1 {
+			page = 1
+			previousPage = 0
+		}
+		if nextPage > totalPage {
+			page = totalPage
+			nextPage = 0
+		}
+		pageButtons = []tele.InlineButton{
+			{Text: "⬅️ 上一页", Data: h.FormatPath(record.GUID, previousPage)},
+			{Text: fmt.Sprintf("%d/%d", page, totalPage), Data: h.FormatPath(record.GUID, 0)},
+			{Text: "➡️ 下一页", Data: h.FormatPath(record.GUID, nextPage)},
+		}
+	}
+
+	replyMarkup := &tele.ReplyMarkup{
+		InlineKeyboard: [][]tele.InlineButton{
+			pageButtons,
+			{
+				{Text: "◀️ 返回上级", Data: StrategyDetailsHandler{}.FormatPath(record.GUID)},
+				{Text: "⏪ 返回主页", Data: StrategyListHandler{}.FormatPath(1)},
+			},
+		},
+	}
+
+	name := util.StrategyName(record)
+	text := fmt.Sprintf("*%s* | 运行记录 `%s`\n\n", h.svcCtx.Config.AppName, name)
+	if len(runs) == 0 {
+		text += "暂无策略运行记录"
+	} else {
+		items := make([]string, 0, len(runs))
+		for _, run := range runs {
+			items = append(items, formatStrategyRun(run))
+		}
+		text += fmt.Sprintf("运行次数: *%d*\n\n", total) + strings.Join(items, "\n\n")
+	}
+
+	_, err = util.ReplyMessage(h.svcCtx.Bot, update, text, replyMarkup)
+	if err != nil {
+		logger.Debugf("[StrategyRunsHandler] 生成UI失败, %v", err)
+	}
+	return nil
+}
@@ -2,14 +2,19 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/fachebot/omni-grid-bot/internal/backtest"
 	"github.com/fachebot/omni-grid-bot/internal/cache"
 	"github.com/fachebot/omni-grid-bot/internal/ent"
 	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/exchange/paper"
 	"github.com/fachebot/omni-grid-bot/internal/helper"
 	"github.com/fachebot/omni-grid-bot/internal/logger"
 	gridstrategy "github.com/fachebot/omni-grid-bot/internal/strategy"
@@ -41,12 +46,42 @@ var (
 	SettingsOptionEntryPrice                    SettingsOption = 14
 	SettingsOptionTriggerStopLossPrice          SettingsOption = 15
 	SettingsOptionTriggerTakeProfitPrice        SettingsOption = 16
+	SettingsOptionSuggestRange                  SettingsOption = 17
+	SettingsOptionTrailingLevels                SettingsOption = 18
+	SettingsOptionTrailingPriceUpperLimit       SettingsOption = 19
+	SettingsOptionTrailingPriceLowerLimit       SettingsOption = 20
+	SettingsOptionAtrPeriod                     SettingsOption = 21
+	SettingsOptionAtrMultiplier                 SettingsOption = 22
+	SettingsOptionAdaptiveThreshold             SettingsOption = 23
+	SettingsOptionAdaptiveIntervalHours         SettingsOption = 24
+	SettingsOptionSizingMode                    SettingsOption = 25
+	SettingsOptionSizingFactor                  SettingsOption = 26
+	SettingsOptionSizingWeights                 SettingsOption = 27
+	SettingsOptionCancelRepairPolicy            SettingsOption = 28
+	SettingsOptionCancelRepairMaxAttempts       SettingsOption = 29
+	SettingsOptionReconcilePolicy               SettingsOption = 30
+	SettingsOptionPartialFillThreshold          SettingsOption = 31
+	SettingsOptionMaxLossAmount                 SettingsOption = 32
+	SettingsOptionMaxLossPercent                SettingsOption = 33
+	SettingsOptionProfitTargetAmount            SettingsOption = 34
+	SettingsOptionTrailingStopLoss              SettingsOption = 35
+	SettingsOptionTrailingTakeProfitActivation  SettingsOption = 36
+	SettingsOptionTrailingTakeProfit            SettingsOption = 37
 )
 
 const (
 	MaxShowGridNum = 10
 )
 
+const (
+	suggestHistoryDays   = 7         // 推荐网格参数使用的历史行情天数
+	suggestCandleSize    = time.Hour // 推荐网格参数使用的K线周期
+	suggestSweepTimeout  = 2 * time.Minute
+	suggestDisplayResult = 3 // 推荐网格参数展示数量
+)
+
+var suggestGridNums = []int{10, 20, 30, 50}
+
 type StrategySettingsHandler struct {
 	svcCtx *svc.ServiceContext
 }
@@ -103,6 +138,23 @@ func (h *StrategySettingsHandler) handle(ctx context.Context, vars map[string]st
 			SettingsOptionEnablePushMatchedNotification,
 			SettingsOptionTriggerStopLossPrice,
 			SettingsOptionTriggerTakeProfitPrice,
+			SettingsOptionMaxLossAmount,
+			SettingsOptionMaxLossPercent,
+			SettingsOptionProfitTargetAmount,
+			SettingsOptionTrailingStopLoss,
+			SettingsOptionTrailingTakeProfitActivation,
+			SettingsOptionTrailingTakeProfit,
+			SettingsOptionTrailingLevels,
+			SettingsOptionTrailingPriceUpperLimit,
+			SettingsOptionTrailingPriceLowerLimit,
+			SettingsOptionAtrPeriod,
+			SettingsOptionAtrMultiplier,
+			SettingsOptionAdaptiveThreshold,
+			SettingsOptionAdaptiveIntervalHours,
+			SettingsOptionCancelRepairPolicy,
+			SettingsOptionCancelRepairMaxAttempts,
+			SettingsOptionReconcilePolicy,
+			SettingsOptionPartialFillThreshold,
 		}
 		if lo.IndexOf(allowList, SettingsOption(optionValue)) == -1 {
 			chatId := util.ChatId(update.Callback.Message.Chat.ID)
@@ -111,6 +163,19 @@ func (h *StrategySettingsHandler) handle(ctx context.Context, vars map[string]st
 		}
 	}
 
+	// 中性网格没有固定方向，不支持移动止损止盈
+	if record.Mode == strategy.ModeNeutral && isTrailingStopOption(SettingsOption(optionValue)) {
+		var chatId int64
+		if update.Callback != nil {
+			chatId = update.Callback.Message.Chat.ID
+		} else if update.Message != nil {
+			chatId = update.Message.Chat.ID
+			deleteMessageAndReply(h.svcCtx.Bot, update.Message)
+		}
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), "❌ 中性网格不支持移动止损止盈", 3)
+		return nil
+	}
+
 	switch SettingsOption(optionValue) {
 	case SettingsOptionGridMode:
 		return h.handleGridMode(ctx, userId, update, record)
@@ -142,6 +207,48 @@ func (h *StrategySettingsHandler) handle(ctx context.Context, vars map[string]st
 		return h.handleTriggerStopLossPrice(ctx, userId, update, record)
 	case SettingsOptionTriggerTakeProfitPrice:
 		return h.handleTriggerTakeProfitPrice(ctx, userId, update, record)
+	case SettingsOptionMaxLossAmount:
+		return h.handleMaxLossAmount(ctx, userId, update, record)
+	case SettingsOptionMaxLossPercent:
+		return h.handleMaxLossPercent(ctx, userId, update, record)
+	case SettingsOptionProfitTargetAmount:
+		return h.handleProfitTargetAmount(ctx, userId, update, record)
+	case SettingsOptionTrailingStopLoss:
+		return h.handleTrailingStopLoss(ctx, userId, update, record)
+	case SettingsOptionTrailingTakeProfitActivation:
+		return h.handleTrailingTakeProfitActivation(ctx, userId, update, record)
+	case SettingsOptionTrailingTakeProfit:
+		return h.handleTrailingTakeProfit(ctx, userId, update, record)
+	case SettingsOptionSuggestRange:
+		return h.handleSuggestRange(ctx, userId, update, record)
+	case SettingsOptionTrailingLevels:
+		return h.handleTrailingLevels(ctx, userId, update, record)
+	case SettingsOptionTrailingPriceUpperLimit:
+		return h.handleTrailingPriceUpperLimit(ctx, userId, update, record)
+	case SettingsOptionTrailingPriceLowerLimit:
+		return h.handleTrailingPriceLowerLimit(ctx, userId, update, record)
+	case SettingsOptionAtrPeriod:
+		return h.handleAtrPeriod(ctx, userId, update, record)
+	case SettingsOptionAtrMultiplier:
+		return h.handleAtrMultiplier(ctx, userId, update, record)
+	case SettingsOptionAdaptiveThreshold:
+		return h.handleAdaptiveThreshold(ctx, userId, update, record)
+	case SettingsOptionAdaptiveIntervalHours:
+		return h.handleAdaptiveIntervalHours(ctx, userId, update, record)
+	case SettingsOptionSizingMode:
+		return h.handleSizingMode(ctx, userId, update, record)
+	case SettingsOptionSizingFactor:
+		return h.handleSizingFactor(ctx, userId, update, record)
+	case SettingsOptionSizingWeights:
+		return h.handleSizingWeights(ctx, userId, update, record)
+	case SettingsOptionCancelRepairPolicy:
+		return h.handleCancelRepairPolicy(ctx, userId, update, record)
+	case SettingsOptionCancelRepairMaxAttempts:
+		return h.handleCancelRepairMaxAttempts(ctx, userId, update, record)
+	case SettingsOptionReconcilePolicy:
+		return h.handleReconcilePolicy(ctx, userId, update, record)
+	case SettingsOptionPartialFillThreshold:
+		return h.handlePartialFillThreshold(ctx, userId, update, record)
 	}
 
 	return nil
@@ -169,9 +276,13 @@ func (h *StrategySettingsHandler) handleGridMode(ctx context.Context, userId int
 		return nil
 	}
 
+	// 按 做多 -> 做空 -> 中性 循环切换
 	mode := strategy.ModeLong
-	if record.Mode == strategy.ModeLong {
+	switch record.Mode {
+	case strategy.ModeLong:
 		mode = strategy.ModeShort
+	case strategy.ModeShort:
+		mode = strategy.ModeNeutral
 	}
 
 	text := "✅ 配置修改成功"
@@ -219,9 +330,13 @@ func (h *StrategySettingsHandler) handleQuantityMode(ctx context.Context, userId
 		return nil
 	}
 
+	// 按 等差 -> 等比 -> 自适应 循环切换
 	mode := strategy.QuantityModeArithmetic
-	if record.QuantityMode == strategy.QuantityModeArithmetic {
+	switch record.QuantityMode {
+	case strategy.QuantityModeArithmetic:
 		mode = strategy.QuantityModeGeometric
+	case strategy.QuantityModeGeometric:
+		mode = strategy.QuantityModeAdaptive
 	}
 
 	text := "✅ 配置修改成功"
@@ -239,6 +354,138 @@ func (h *StrategySettingsHandler) handleQuantityMode(ctx context.Context, userId
 	return h.refreshSettingsMessage(ctx, userId, update, record)
 }
 
+func (h *StrategySettingsHandler) handleSizingMode(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
+	if update.Callback == nil {
+		return nil
+	}
+
+	// 按 固定 -> 金字塔 -> 等比 -> 等额 -> 自定义 循环切换
+	mode := strategy.SizingModeFixed
+	switch record.SizingMode {
+	case strategy.SizingModeFixed:
+		mode = strategy.SizingModePyramid
+	case strategy.SizingModePyramid:
+		mode = strategy.SizingModeGeometric
+	case strategy.SizingModeGeometric:
+		mode = strategy.SizingModeEqualNotional
+	case strategy.SizingModeEqualNotional:
+		mode = strategy.SizingModeCustom
+	}
+
+	text := "✅ 配置修改成功"
+	err := h.svcCtx.StrategyModel.UpdateSizingMode(ctx, record.ID, mode)
+	if err == nil {
+		record.SizingMode = mode
+	} else {
+		text = "❌ 配置修改失败, 请稍后重试"
+		logger.Errorf("[StrategySettingsHandler] 更新配置[SizingMode]失败, %v", err)
+	}
+
+	chatId := util.ChatId(update.Callback.Message.Chat.ID)
+	util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, chatId, text, 1)
+
+	return h.refreshSettingsMessage(ctx, userId, update, record)
+}
+
+func (h *StrategySettingsHandler) handleSizingFactor(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.Callback != nil {
+		chatId := update.Callback.Message.Chat.ID
+		text := fmt.Sprintf("🌳 填写加仓系数，档位离入场价格越远下单数量越大。\n\n"+
+			"📈 金字塔: 每远离一格增加单笔数量的比例，默认%s\n"+
+			"✖️ 等比: 每远离一格单笔数量乘以的倍数，默认%s",
+			gridstrategy.DefaultPyramidFactor, gridstrategy.DefaultGeometricSizingFactor)
+		msg, err := h.svcCtx.Bot.Send(util.ChatId(chatId), text, defaultSendOptions())
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, SettingsOptionSizingFactor), Context: update.Callback.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.ID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		deleteMessageAndReply(h.svcCtx.Bot, update.Message)
+
+		// 检查输入系数
+		chatId := update.Message.Chat.ID
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || !d.IsPositive() {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), "❌ 请输入有效系数，并且必须大于0", 3)
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateSizingFactor(ctx, record.ID, d)
+		if err == nil {
+			record.SizingFactor = &d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[SizingFactor]失败, %v", err)
+		}
+
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), text, 1)
+
+		return h.refreshSettingsMessage(ctx, userId, update, record)
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleSizingWeights(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.Callback != nil {
+		chatId := update.Callback.Message.Chat.ID
+		text := "🌳 填写自定义权重列表，以逗号分隔，从距离入场价格最近的档位开始依次对应，每格数量 = 单笔数量 × 权重，档位多于权重数量时使用最后一个权重。\n\n例如: 1,1,1.5,2,3"
+		msg, err := h.svcCtx.Bot.Send(util.ChatId(chatId), text, defaultSendOptions())
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, SettingsOptionSizingWeights), Context: update.Callback.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.ID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		deleteMessageAndReply(h.svcCtx.Bot, update.Message)
+
+		// 检查输入权重
+		chatId := update.Message.Chat.ID
+		weights, err := gridstrategy.ParseSizingWeights(update.Message.Text)
+		if err != nil || len(weights) > gridstrategy.MaxGridNumLimit+1 {
+			text := fmt.Sprintf("❌ 请输入有效权重列表，权重必须大于0，最多%d个", gridstrategy.MaxGridNumLimit+1)
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), text, 3)
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		value := strings.Join(lo.Map(weights, func(item decimal.Decimal, _ int) string { return item.String() }), ",")
+		err = h.svcCtx.StrategyModel.UpdateSizingWeights(ctx, record.ID, value)
+		if err == nil {
+			record.SizingWeights = &value
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[SizingWeights]失败, %v", err)
+		}
+
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), text, 1)
+
+		return h.refreshSettingsMessage(ctx, userId, update, record)
+	}
+
+	return nil
+}
+
 func (h *StrategySettingsHandler) handleLeverage(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
 	// 步骤1
 	if update.Callback != nil {
@@ -824,18 +1071,18 @@ func (h *StrategySettingsHandler) handleTriggerStopLossPrice(ctx context.Context
 	return nil
 }
 
-func (h *StrategySettingsHandler) handleTriggerTakeProfitPrice(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
+func (h *StrategySettingsHandler) handleMaxLossAmount(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
 	// 步骤1
 	if update.Callback != nil {
 		chatId := update.Callback.Message.Chat.ID
-		text := "🌳 填写触发止盈价格，标记价格触及顶部止盈价格或底部止盈价格后，停止网格策略"
+		text := "🌳 填写最大亏损金额(USD)，策略总利润(含未实现利润、手续费和资金费用)亏损达到该金额后，停止网格策略并平仓\n\n🔢 填写0关闭"
 		msg, err := h.svcCtx.Bot.Send(util.ChatId(chatId), text, defaultSendOptions())
 		if err != nil {
 			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
 			return err
 		}
 
-		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, SettingsOptionTriggerTakeProfitPrice), Context: update.Callback.Message}
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, SettingsOptionMaxLossAmount), Context: update.Callback.Message}
 		h.svcCtx.MessageCache.SetRoute(chatId, msg.ID, route)
 
 		return nil
@@ -845,22 +1092,22 @@ func (h *StrategySettingsHandler) handleTriggerTakeProfitPrice(ctx context.Conte
 	if update.Message != nil {
 		deleteMessageAndReply(h.svcCtx.Bot, update.Message)
 
-		// 检查输入数量
+		// 检查输入数值
 		chatId := update.Message.Chat.ID
 		d, err := decimal.NewFromString(update.Message.Text)
 		if err != nil || d.LessThan(decimal.Zero) {
-			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), "❌ 请输入有效价格数值，并且不能小于0", 3)
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), "❌ 请输入有效金额数值，并且不能小于0", 3)
 			return nil
 		}
 
 		// 发送成功提示
 		text := "✅ 配置修改成功"
-		err = h.svcCtx.StrategyModel.UpdateTriggerTakeProfitPrice(ctx, record.ID, d)
+		err = h.svcCtx.StrategyModel.UpdateMaxLossAmount(ctx, record.ID, d)
 		if err == nil {
-			record.TriggerTakeProfitPrice = &d
+			record.MaxLossAmount = &d
 		} else {
 			text = "❌ 配置修改失败, 请稍后重试"
-			logger.Errorf("[StrategySettingsHandler] 更新配置[TriggerTakeProfitPrice]失败, %v", err)
+			logger.Errorf("[StrategySettingsHandler] 更新配置[MaxLossAmount]失败, %v", err)
 		}
 
 		// 更新缓存数据
@@ -877,107 +1124,1140 @@ func (h *StrategySettingsHandler) handleTriggerTakeProfitPrice(ctx context.Conte
 	return nil
 }
 
-func GenerateGridList(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy) []decimal.Decimal {
-	mm, err := helper.GetMarketMetadata(ctx, svcCtx, record.Exchange, record.Symbol)
-	if err != nil {
-		return nil
-	}
+func (h *StrategySettingsHandler) handleMaxLossPercent(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.Callback != nil {
+		chatId := update.Callback.Message.Chat.ID
+		text := "🌳 填写最大亏损比例(%)，策略总利润亏损达到初始保证金的该比例后，停止网格策略并平仓\n\n🔢 填写0关闭"
+		msg, err := h.svcCtx.Bot.Send(util.ChatId(chatId), text, defaultSendOptions())
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, SettingsOptionMaxLossPercent), Context: update.Callback.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.ID, route)
 
-	var prices []decimal.Decimal
-	switch record.QuantityMode {
-	case strategy.QuantityModeGeometric:
-		prices, err = gridstrategy.GenerateGeometricGrid(record.PriceLower, record.PriceUpper, record.GridNum, int32(mm.SupportedPriceDecimals))
-	case strategy.QuantityModeArithmetic:
-		prices, err = gridstrategy.GenerateArithmeticGrid(record.PriceLower, record.PriceUpper, record.GridNum, int32(mm.SupportedPriceDecimals))
-	}
-	if err != nil {
 		return nil
 	}
 
-	if record.Mode == strategy.ModeShort {
-		slices.Reverse(prices)
-	}
+	// 步骤2
+	if update.Message != nil {
+		deleteMessageAndReply(h.svcCtx.Bot, update.Message)
 
-	return prices
-}
+		// 检查输入数值
+		chatId := update.Message.Chat.ID
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || d.LessThan(decimal.Zero) {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), "❌ 请输入有效比例数值，并且不能小于0", 3)
+			return nil
+		}
 
-func CalculateProfitMargin(record *ent.Strategy, prices []decimal.Decimal) (decimal.Decimal, decimal.Decimal) {
-	if len(prices) < 2 {
-		return decimal.Zero, decimal.Zero
-	}
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateMaxLossPercent(ctx, record.ID, d)
+		if err == nil {
+			record.MaxLossPercent = &d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[MaxLossPercent]失败, %v", err)
+		}
 
-	profitMargin1, profitMargin2 := decimal.Zero, decimal.Zero
-	p1, p2, p3, p4 := prices[0], prices[1], prices[len(prices)-2], prices[len(prices)-1]
-	switch record.Mode {
-	case strategy.ModeLong:
-		profitMargin1 = p2.Sub(p1).Div(p1)
-		profitMargin2 = p4.Sub(p3).Div(p3)
-	case strategy.ModeShort:
-		profitMargin1 = p1.Sub(p2).Div(p1)
-		profitMargin2 = p3.Sub(p4).Div(p3)
-	}
+		// 更新缓存数据
+		strategyEngine, ok := GetStrategyEngine(ctx)
+		if ok {
+			strategyEngine.UpdateStrategy(record)
+		}
 
-	if profitMargin1.LessThan(profitMargin2) {
-		return profitMargin1, profitMargin2
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), text, 1)
+
+		return h.refreshSettingsMessage(ctx, userId, update, record)
 	}
-	return profitMargin2, profitMargin1
+
+	return nil
 }
 
-func DisplayStrategSettings(ctx context.Context, svcCtx *svc.ServiceContext, userId int64, update tele.Update, record *ent.Strategy, newMessage bool) error {
-	name := util.StrategyName(record)
-	text := fmt.Sprintf("*%s* | 编辑策略 `%s`\n\n", svcCtx.Config.AppName, name)
+func (h *StrategySettingsHandler) handleProfitTargetAmount(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.Callback != nil {
+		chatId := update.Callback.Message.Chat.ID
+		text := "🌳 填写目标利润金额(USD)，策略总利润(含未实现利润、手续费和资金费用)达到该金额后，停止网格策略并平仓\n\n🔢 填写0关闭"
+		msg, err := h.svcCtx.Bot.Send(util.ChatId(chatId), text, defaultSendOptions())
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
 
-	// 生成网格列表
-	if record.Exchange != "" &&
-		record.Symbol != "" &&
-		record.GridNum > 0 &&
-		record.PriceUpper.GreaterThan(decimal.Zero) &&
-		record.PriceLower.GreaterThan(decimal.Zero) {
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, SettingsOptionProfitTargetAmount), Context: update.Callback.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.ID, route)
 
-		var gridLabels []string
-		totalInvestment := decimal.Zero
-		prices := GenerateGridList(ctx, svcCtx, record)
-		for idx, price := range prices {
-			item := fmt.Sprintf("➖\\[ *%d* ] %s", idx, price)
-			gridLabels = append(gridLabels, item)
-			totalInvestment = totalInvestment.Add(record.InitialOrderSize.Mul(price))
-		}
+		return nil
+	}
 
-		// 截断网格列表
-		if len(gridLabels) > MaxShowGridNum {
-			n := MaxShowGridNum / 2
-			part1 := lo.Slice(gridLabels, 0, n)
-			part2 := lo.Slice(gridLabels, len(gridLabels)-n, len(gridLabels))
-			gridLabels = make([]string, 0, len(gridLabels)+1)
-			gridLabels = append(gridLabels, part1...)
-			gridLabels = append(gridLabels, "➖   ... (省略中间网格)")
-			gridLabels = append(gridLabels, part2...)
+	// 步骤2
+	if update.Message != nil {
+		deleteMessageAndReply(h.svcCtx.Bot, update.Message)
+
+		// 检查输入数值
+		chatId := update.Message.Chat.ID
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || d.LessThan(decimal.Zero) {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), "❌ 请输入有效金额数值，并且不能小于0", 3)
+			return nil
 		}
 
-		if len(gridLabels) > 0 {
-			text += "网格列表:\n" + strings.Join(gridLabels, "\n")
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateProfitTargetAmount(ctx, record.ID, d)
+		if err == nil {
+			record.ProfitTargetAmount = &d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[ProfitTargetAmount]失败, %v", err)
 		}
 
-		if len(prices) > 2 {
-			minProfitMargin, maxProfitMargin := CalculateProfitMargin(record, prices)
-			minProfitMargin = minProfitMargin.Mul(decimal.NewFromInt(100)).Truncate(2)
-			maxProfitMargin = maxProfitMargin.Mul(decimal.NewFromInt(100)).Truncate(2)
-			if minProfitMargin.Equal(maxProfitMargin) {
-				text += fmt.Sprintf("\n\n每格利润: *%v%%*", minProfitMargin)
-			} else {
-				text += fmt.Sprintf("\n\n每格利润: *%v%%* - *%v%%*", minProfitMargin, maxProfitMargin)
-			}
-			text += fmt.Sprintf("\n总投资额: %v USD", totalInvestment)
-			text += fmt.Sprintf("\n初始保证金: %v USD", totalInvestment.Div(decimal.NewFromInt(int64(record.Leverage))).Truncate(2))
+		// 更新缓存数据
+		strategyEngine, ok := GetStrategyEngine(ctx)
+		if ok {
+			strategyEngine.UpdateStrategy(record)
 		}
-	}
 
-	connectStatus := "🔴"
-	if testExchangeConnectivity(ctx, svcCtx, record) == nil {
-		connectStatus = "🟢"
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), text, 1)
+
+		return h.refreshSettingsMessage(ctx, userId, update, record)
 	}
 
-	symbol := "未设置"
+	return nil
+}
+
+// isTrailingStopOption 是否为移动止损止盈的配置项
+func isTrailingStopOption(option SettingsOption) bool {
+	return option == SettingsOptionTrailingStopLoss ||
+		option == SettingsOptionTrailingTakeProfitActivation ||
+		option == SettingsOptionTrailingTakeProfit
+}
+
+// parseTrailingDistance 解析移动止损止盈的回撤距离，以%结尾时为回撤比例，否则为回撤价格，填写0时关闭
+// 返回值: 回撤价格，回撤比例，是否有效
+func parseTrailingDistance(text string) (*decimal.Decimal, *decimal.Decimal, bool) {
+	text = strings.TrimSpace(text)
+	isPercent := strings.HasSuffix(text, "%")
+	d, err := decimal.NewFromString(strings.TrimSpace(strings.TrimSuffix(text, "%")))
+	if err != nil || d.LessThan(decimal.Zero) {
+		return nil, nil, false
+	}
+
+	if d.IsZero() {
+		return nil, nil, true
+	}
+	if isPercent {
+		if d.GreaterThanOrEqual(decimal.NewFromInt(100)) {
+			return nil, nil, false
+		}
+		return nil, &d, true
+	}
+	return &d, nil, true
+}
+
+// trailingDistanceText 移动止损止盈回撤距离的展示文本
+func trailingDistanceText(amount, percent *decimal.Decimal) string {
+	if percent != nil && percent.GreaterThan(decimal.Zero) {
+		return fmt.Sprintf("%s%%", percent)
+	}
+	if amount != nil && amount.GreaterThan(decimal.Zero) {
+		return amount.String()
+	}
+	return "关闭"
+}
+
+func (h *StrategySettingsHandler) handleTrailingStopLoss(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.Callback != nil {
+		chatId := update.Callback.Message.Chat.ID
+		text := "🌳 填写移动止损回撤距离，价格从策略启动后的最高价格(做多)或最低价格(做空)回撤该距离后，停止网格策略并平仓\n\n🔢 填写价格差值(如 100)或百分比(如 5%)，填写0关闭，中性网格不支持移动止损"
+		msg, err := h.svcCtx.Bot.Send(util.ChatId(chatId), text, defaultSendOptions())
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, SettingsOptionTrailingStopLoss), Context: update.Callback.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.ID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		deleteMessageAndReply(h.svcCtx.Bot, update.Message)
+
+		// 检查输入数值
+		chatId := update.Message.Chat.ID
+		amount, percent, ok := parseTrailingDistance(update.Message.Text)
+		if !ok {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), "❌ 请输入有效价格差值或百分比(0~100%)", 3)
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err := h.svcCtx.StrategyModel.UpdateTrailingStopLoss(ctx, record.ID, amount, percent)
+		if err == nil {
+			record.TrailingStopLossAmount = amount
+			record.TrailingStopLossPercent = percent
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[TrailingStopLoss]失败, %v", err)
+		}
+
+		// 更新缓存数据
+		strategyEngine, ok := GetStrategyEngine(ctx)
+		if ok {
+			strategyEngine.UpdateStrategy(record)
+		}
+
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), text, 1)
+
+		return h.refreshSettingsMessage(ctx, userId, update, record)
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleTrailingTakeProfitActivation(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.Callback != nil {
+		chatId := update.Callback.Message.Chat.ID
+		text := "🌳 填写移动止盈激活价格，价格上涨(做多)或下跌(做空)到激活价格后开始移动止盈\n\n🔢 填写0关闭移动止盈"
+		msg, err := h.svcCtx.Bot.Send(util.ChatId(chatId), text, defaultSendOptions())
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, SettingsOptionTrailingTakeProfitActivation), Context: update.Callback.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.ID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		deleteMessageAndReply(h.svcCtx.Bot, update.Message)
+
+		// 检查输入数值
+		chatId := update.Message.Chat.ID
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || d.LessThan(decimal.Zero) {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), "❌ 请输入有效价格数值，并且不能小于0", 3)
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateTrailingTakeProfitActivationPrice(ctx, record.ID, d)
+		if err == nil {
+			record.TrailingTakeProfitActivationPrice = &d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[TrailingTakeProfitActivationPrice]失败, %v", err)
+		}
+
+		// 更新缓存数据
+		strategyEngine, ok := GetStrategyEngine(ctx)
+		if ok {
+			strategyEngine.UpdateStrategy(record)
+		}
+
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), text, 1)
+
+		return h.refreshSettingsMessage(ctx, userId, update, record)
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleTrailingTakeProfit(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.Callback != nil {
+		chatId := update.Callback.Message.Chat.ID
+		text := "🌳 填写移动止盈回撤距离，移动止盈激活后，价格从最高价格(做多)或最低价格(做空)回撤该距离后，停止网格策略并平仓\n\n🔢 填写价格差值(如 100)或百分比(如 5%)，填写0关闭，中性网格不支持移动止盈"
+		msg, err := h.svcCtx.Bot.Send(util.ChatId(chatId), text, defaultSendOptions())
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, SettingsOptionTrailingTakeProfit), Context: update.Callback.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.ID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		deleteMessageAndReply(h.svcCtx.Bot, update.Message)
+
+		// 检查输入数值
+		chatId := update.Message.Chat.ID
+		amount, percent, ok := parseTrailingDistance(update.Message.Text)
+		if !ok {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), "❌ 请输入有效价格差值或百分比(0~100%)", 3)
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err := h.svcCtx.StrategyModel.UpdateTrailingTakeProfit(ctx, record.ID, amount, percent)
+		if err == nil {
+			record.TrailingTakeProfitAmount = amount
+			record.TrailingTakeProfitPercent = percent
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[TrailingTakeProfit]失败, %v", err)
+		}
+
+		// 更新缓存数据
+		strategyEngine, ok := GetStrategyEngine(ctx)
+		if ok {
+			strategyEngine.UpdateStrategy(record)
+		}
+
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), text, 1)
+
+		return h.refreshSettingsMessage(ctx, userId, update, record)
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleTrailingLevels(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.Callback != nil {
+		chatId := update.Callback.Message.Chat.ID
+		text := "🌳 填写移动网格触发档位数，价格离开网格区间超过该档位数后，撤销远端挂单并向价格方向平移网格。\n\n🔢 填写0关闭移动网格"
+		msg, err := h.svcCtx.Bot.Send(util.ChatId(chatId), text, defaultSendOptions())
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, SettingsOptionTrailingLevels), Context: update.Callback.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.ID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		deleteMessageAndReply(h.svcCtx.Bot, update.Message)
+
+		// 检查输入数量
+		chatId := update.Message.Chat.ID
+		d, err := strconv.Atoi(update.Message.Text)
+		if err != nil || d < 0 || d > gridstrategy.MaxGridNumLimit {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), "❌ 请输入有效档位数量(0~50)", 3)
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateTrailingLevels(ctx, record.ID, d)
+		if err == nil {
+			record.TrailingLevels = &d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[TrailingLevels]失败, %v", err)
+		}
+
+		// 更新缓存数据
+		strategyEngine, ok := GetStrategyEngine(ctx)
+		if ok {
+			strategyEngine.UpdateStrategy(record)
+		}
+
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), text, 1)
+
+		return h.refreshSettingsMessage(ctx, userId, update, record)
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleTrailingPriceUpperLimit(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.Callback != nil {
+		chatId := update.Callback.Message.Chat.ID
+		text := "🌳 填写移动网格的价格上限，网格向上平移后价格上限不会超过该价格。\n\n🔢 填写0不限制"
+		msg, err := h.svcCtx.Bot.Send(util.ChatId(chatId), text, defaultSendOptions())
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, SettingsOptionTrailingPriceUpperLimit), Context: update.Callback.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.ID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		deleteMessageAndReply(h.svcCtx.Bot, update.Message)
+
+		// 检查输入价格
+		chatId := update.Message.Chat.ID
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || d.LessThan(decimal.Zero) {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), "❌ 请输入有效价格数值，并且不能小于0", 3)
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateTrailingPriceUpperLimit(ctx, record.ID, d)
+		if err == nil {
+			record.TrailingPriceUpperLimit = &d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[TrailingPriceUpperLimit]失败, %v", err)
+		}
+
+		// 更新缓存数据
+		strategyEngine, ok := GetStrategyEngine(ctx)
+		if ok {
+			strategyEngine.UpdateStrategy(record)
+		}
+
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), text, 1)
+
+		return h.refreshSettingsMessage(ctx, userId, update, record)
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleTrailingPriceLowerLimit(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.Callback != nil {
+		chatId := update.Callback.Message.Chat.ID
+		text := "🌳 填写移动网格的价格下限，网格向下平移后价格下限不会低于该价格。\n\n🔢 填写0不限制"
+		msg, err := h.svcCtx.Bot.Send(util.ChatId(chatId), text, defaultSendOptions())
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, SettingsOptionTrailingPriceLowerLimit), Context: update.Callback.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.ID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		deleteMessageAndReply(h.svcCtx.Bot, update.Message)
+
+		// 检查输入价格
+		chatId := update.Message.Chat.ID
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || d.LessThan(decimal.Zero) {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), "❌ 请输入有效价格数值，并且不能小于0", 3)
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateTrailingPriceLowerLimit(ctx, record.ID, d)
+		if err == nil {
+			record.TrailingPriceLowerLimit = &d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[TrailingPriceLowerLimit]失败, %v", err)
+		}
+
+		// 更新缓存数据
+		strategyEngine, ok := GetStrategyEngine(ctx)
+		if ok {
+			strategyEngine.UpdateStrategy(record)
+		}
+
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), text, 1)
+
+		return h.refreshSettingsMessage(ctx, userId, update, record)
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleAtrPeriod(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.Callback != nil {
+		chatId := update.Callback.Message.Chat.ID
+		text := fmt.Sprintf("🌳 填写自适应网格的ATR计算周期(K线数量)，默认%d。", gridstrategy.DefaultAtrPeriod)
+		msg, err := h.svcCtx.Bot.Send(util.ChatId(chatId), text, defaultSendOptions())
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, SettingsOptionAtrPeriod), Context: update.Callback.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.ID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		deleteMessageAndReply(h.svcCtx.Bot, update.Message)
+
+		// 检查输入数量
+		chatId := update.Message.Chat.ID
+		d, err := strconv.Atoi(update.Message.Text)
+		if err != nil || d < 1 || d > svc.CandleCacheLimit-2 {
+			text := fmt.Sprintf("❌ 请输入有效周期数量(1~%d)", svc.CandleCacheLimit-2)
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), text, 3)
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateAtrPeriod(ctx, record.ID, d)
+		if err == nil {
+			record.AtrPeriod = &d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[AtrPeriod]失败, %v", err)
+		}
+
+		// 更新缓存数据
+		strategyEngine, ok := GetStrategyEngine(ctx)
+		if ok {
+			strategyEngine.UpdateStrategy(record)
+		}
+
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), text, 1)
+
+		return h.refreshSettingsMessage(ctx, userId, update, record)
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleAtrMultiplier(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.Callback != nil {
+		chatId := update.Callback.Message.Chat.ID
+		text := fmt.Sprintf("🌳 填写自适应网格间距与ATR的倍数，网格间距 = ATR × 倍数，默认%s。", gridstrategy.DefaultAtrMultiplier)
+		msg, err := h.svcCtx.Bot.Send(util.ChatId(chatId), text, defaultSendOptions())
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, SettingsOptionAtrMultiplier), Context: update.Callback.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.ID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		deleteMessageAndReply(h.svcCtx.Bot, update.Message)
+
+		// 检查输入倍数
+		chatId := update.Message.Chat.ID
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || !d.IsPositive() {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), "❌ 请输入有效倍数，并且必须大于0", 3)
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateAtrMultiplier(ctx, record.ID, d)
+		if err == nil {
+			record.AtrMultiplier = &d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[AtrMultiplier]失败, %v", err)
+		}
+
+		// 更新缓存数据
+		strategyEngine, ok := GetStrategyEngine(ctx)
+		if ok {
+			strategyEngine.UpdateStrategy(record)
+		}
+
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), text, 1)
+
+		return h.refreshSettingsMessage(ctx, userId, update, record)
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleAdaptiveThreshold(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.Callback != nil {
+		chatId := update.Callback.Message.Chat.ID
+		text := fmt.Sprintf("🌳 填写自适应网格的波动率变化阈值(%%)，ATR相对上次生成网格时变化超过该比例后重新生成网格，默认%s%%。",
+			gridstrategy.DefaultAdaptiveThreshold.Mul(decimal.NewFromInt(100)))
+		msg, err := h.svcCtx.Bot.Send(util.ChatId(chatId), text, defaultSendOptions())
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, SettingsOptionAdaptiveThreshold), Context: update.Callback.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.ID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		deleteMessageAndReply(h.svcCtx.Bot, update.Message)
+
+		// 检查输入比例
+		chatId := update.Message.Chat.ID
+		d, err := decimal.NewFromString(strings.TrimSuffix(update.Message.Text, "%"))
+		if err != nil || !d.IsPositive() {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), "❌ 请输入有效比例，并且必须大于0", 3)
+			return nil
+		}
+		d = d.Div(decimal.NewFromInt(100))
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateAdaptiveThreshold(ctx, record.ID, d)
+		if err == nil {
+			record.AdaptiveThreshold = &d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[AdaptiveThreshold]失败, %v", err)
+		}
+
+		// 更新缓存数据
+		strategyEngine, ok := GetStrategyEngine(ctx)
+		if ok {
+			strategyEngine.UpdateStrategy(record)
+		}
+
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), text, 1)
+
+		return h.refreshSettingsMessage(ctx, userId, update, record)
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleAdaptiveIntervalHours(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.Callback != nil {
+		chatId := update.Callback.Message.Chat.ID
+		text := "🌳 填写自适应网格的定时重新生成周期(小时)，到期后按最新波动率重新生成网格。\n\n🔢 填写0只在波动率变化超过阈值时重新生成"
+		msg, err := h.svcCtx.Bot.Send(util.ChatId(chatId), text, defaultSendOptions())
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, SettingsOptionAdaptiveIntervalHours), Context: update.Callback.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.ID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		deleteMessageAndReply(h.svcCtx.Bot, update.Message)
+
+		// 检查输入小时数
+		chatId := update.Message.Chat.ID
+		d, err := strconv.Atoi(update.Message.Text)
+		if err != nil || d < 0 {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), "❌ 请输入有效小时数，并且不能小于0", 3)
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateAdaptiveIntervalHours(ctx, record.ID, d)
+		if err == nil {
+			record.AdaptiveIntervalHours = &d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[AdaptiveIntervalHours]失败, %v", err)
+		}
+
+		// 更新缓存数据
+		strategyEngine, ok := GetStrategyEngine(ctx)
+		if ok {
+			strategyEngine.UpdateStrategy(record)
+		}
+
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), text, 1)
+
+		return h.refreshSettingsMessage(ctx, userId, update, record)
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleCancelRepairPolicy(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
+	if update.Callback == nil {
+		return nil
+	}
+
+	// 按 重新挂单 -> 跳过档位 -> 停止策略 循环切换
+	policy := strategy.CancelRepairPolicyRepair
+	switch record.CancelRepairPolicy {
+	case strategy.CancelRepairPolicyRepair:
+		policy = strategy.CancelRepairPolicySkip
+	case strategy.CancelRepairPolicySkip:
+		policy = strategy.CancelRepairPolicyStop
+	}
+
+	text := "✅ 配置修改成功"
+	err := h.svcCtx.StrategyModel.UpdateCancelRepairPolicy(ctx, record.ID, policy)
+	if err == nil {
+		record.CancelRepairPolicy = policy
+	} else {
+		text = "❌ 配置修改失败, 请稍后重试"
+		logger.Errorf("[StrategySettingsHandler] 更新配置[CancelRepairPolicy]失败, %v", err)
+	}
+
+	// 更新缓存数据
+	strategyEngine, ok := GetStrategyEngine(ctx)
+	if ok {
+		strategyEngine.UpdateStrategy(record)
+	}
+
+	chatId := update.Callback.Message.Chat.ID
+	util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), text, 1)
+
+	return h.refreshSettingsMessage(ctx, userId, update, record)
+}
+
+func (h *StrategySettingsHandler) handleCancelRepairMaxAttempts(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.Callback != nil {
+		chatId := update.Callback.Message.Chat.ID
+		text := fmt.Sprintf("🌳 填写订单被意外取消后同一档位的最大重新挂单次数，超过后停止策略，默认%d。", gridstrategy.DefaultCancelRepairMaxAttempts)
+		msg, err := h.svcCtx.Bot.Send(util.ChatId(chatId), text, defaultSendOptions())
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, SettingsOptionCancelRepairMaxAttempts), Context: update.Callback.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.ID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		deleteMessageAndReply(h.svcCtx.Bot, update.Message)
+
+		// 检查输入次数
+		chatId := update.Message.Chat.ID
+		d, err := strconv.Atoi(update.Message.Text)
+		if err != nil || d < 0 || d > 100 {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), "❌ 请输入有效次数(0~100)", 3)
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateCancelRepairMaxAttempts(ctx, record.ID, d)
+		if err == nil {
+			record.CancelRepairMaxAttempts = &d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[CancelRepairMaxAttempts]失败, %v", err)
+		}
+
+		// 更新缓存数据
+		strategyEngine, ok := GetStrategyEngine(ctx)
+		if ok {
+			strategyEngine.UpdateStrategy(record)
+		}
+
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), text, 1)
+
+		return h.refreshSettingsMessage(ctx, userId, update, record)
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleReconcilePolicy(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
+	if update.Callback == nil {
+		return nil
+	}
+
+	policy := lo.If(record.ReconcilePolicy == strategy.ReconcilePolicyFix, strategy.ReconcilePolicyAlert).Else(strategy.ReconcilePolicyFix)
+
+	text := "✅ 配置修改成功"
+	if policy == strategy.ReconcilePolicyFix {
+		text += "，将撤销孤立挂单并修复缺失挂单，持仓偏差不会自动修复，只推送告警"
+	}
+	err := h.svcCtx.StrategyModel.UpdateReconcilePolicy(ctx, record.ID, policy)
+	if err == nil {
+		record.ReconcilePolicy = policy
+	} else {
+		text = "❌ 配置修改失败, 请稍后重试"
+		logger.Errorf("[StrategySettingsHandler] 更新配置[ReconcilePolicy]失败, %v", err)
+	}
+
+	// 更新缓存数据
+	strategyEngine, ok := GetStrategyEngine(ctx)
+	if ok {
+		strategyEngine.UpdateStrategy(record)
+	}
+
+	chatId := update.Callback.Message.Chat.ID
+	util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), text, 1)
+
+	return h.refreshSettingsMessage(ctx, userId, update, record)
+}
+
+func (h *StrategySettingsHandler) handlePartialFillThreshold(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.Callback != nil {
+		chatId := update.Callback.Message.Chat.ID
+		text := "🌳 填写部分成交处理阈值(%)，开仓挂单成交比例达到该阈值后撤销剩余数量，并按已成交数量挂出平仓订单，默认80%，填写0关闭。"
+		msg, err := h.svcCtx.Bot.Send(util.ChatId(chatId), text, defaultSendOptions())
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, SettingsOptionPartialFillThreshold), Context: update.Callback.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.ID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		deleteMessageAndReply(h.svcCtx.Bot, update.Message)
+
+		// 检查输入比例
+		chatId := update.Message.Chat.ID
+		d, err := decimal.NewFromString(strings.TrimSuffix(update.Message.Text, "%"))
+		if err != nil || d.IsNegative() || d.GreaterThan(decimal.NewFromInt(100)) {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), "❌ 请输入有效比例(0~100)", 3)
+			return nil
+		}
+		d = d.Div(decimal.NewFromInt(100))
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdatePartialFillThreshold(ctx, record.ID, d)
+		if err == nil {
+			record.PartialFillThreshold = &d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[PartialFillThreshold]失败, %v", err)
+		}
+
+		// 更新缓存数据
+		strategyEngine, ok := GetStrategyEngine(ctx)
+		if ok {
+			strategyEngine.UpdateStrategy(record)
+		}
+
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), text, 1)
+
+		return h.refreshSettingsMessage(ctx, userId, update, record)
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleTriggerTakeProfitPrice(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
+	// 步骤1
+	if update.Callback != nil {
+		chatId := update.Callback.Message.Chat.ID
+		text := "🌳 填写触发止盈价格，标记价格触及顶部止盈价格或底部止盈价格后，停止网格策略"
+		msg, err := h.svcCtx.Bot.Send(util.ChatId(chatId), text, defaultSendOptions())
+		if err != nil {
+			logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+			return err
+		}
+
+		route := cache.RouteInfo{Path: h.FormatPath(record.GUID, SettingsOptionTriggerTakeProfitPrice), Context: update.Callback.Message}
+		h.svcCtx.MessageCache.SetRoute(chatId, msg.ID, route)
+
+		return nil
+	}
+
+	// 步骤2
+	if update.Message != nil {
+		deleteMessageAndReply(h.svcCtx.Bot, update.Message)
+
+		// 检查输入数量
+		chatId := update.Message.Chat.ID
+		d, err := decimal.NewFromString(update.Message.Text)
+		if err != nil || d.LessThan(decimal.Zero) {
+			util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), "❌ 请输入有效价格数值，并且不能小于0", 3)
+			return nil
+		}
+
+		// 发送成功提示
+		text := "✅ 配置修改成功"
+		err = h.svcCtx.StrategyModel.UpdateTriggerTakeProfitPrice(ctx, record.ID, d)
+		if err == nil {
+			record.TriggerTakeProfitPrice = &d
+		} else {
+			text = "❌ 配置修改失败, 请稍后重试"
+			logger.Errorf("[StrategySettingsHandler] 更新配置[TriggerTakeProfitPrice]失败, %v", err)
+		}
+
+		// 更新缓存数据
+		strategyEngine, ok := GetStrategyEngine(ctx)
+		if ok {
+			strategyEngine.UpdateStrategy(record)
+		}
+
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, util.ChatId(chatId), text, 1)
+
+		return h.refreshSettingsMessage(ctx, userId, update, record)
+	}
+
+	return nil
+}
+
+func (h *StrategySettingsHandler) handleSuggestRange(ctx context.Context, userId int64, update tele.Update, record *ent.Strategy) error {
+	if update.Callback == nil {
+		return nil
+	}
+
+	chatId := util.ChatId(update.Callback.Message.Chat.ID)
+	if record.Exchange == "" || record.Symbol == "" || !record.InitialOrderSize.IsPositive() {
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, chatId, "❌ 请先设置交易所、交易币种和单笔数量", 3)
+		return nil
+	}
+
+	msg, err := util.SendMarkdownMessage(h.svcCtx.Bot, chatId, fmt.Sprintf("⏳ 正在回测 %s 最近 %d 天行情, 请稍候...", record.Symbol, suggestHistoryDays), nil)
+	if err != nil {
+		logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+		return err
+	}
+	defer h.svcCtx.Bot.Delete(msg)
+
+	results, err := SuggestGridRange(ctx, h.svcCtx, record)
+	if err != nil {
+		text := "❌ 回测推荐失败, 请稍后重试"
+		if errors.Is(err, exchange.ErrExchangeUnsupported) {
+			text = "❌ 当前交易所暂不支持查询历史行情"
+		}
+		logger.Warnf("[StrategySettingsHandler] 回测推荐网格参数失败, id: %s, exchange: %s, symbol: %s, %v",
+			record.GUID, record.Exchange, record.Symbol, err)
+		util.SendMarkdownMessageAndDelayDeletion(h.svcCtx.Bot, chatId, text, 3)
+		return nil
+	}
+
+	medals := []string{"🥇", "🥈", "🥉"}
+	hundred := decimal.NewFromInt(100)
+	text := fmt.Sprintf("🔍 *%s %s* 最近 %d 天回测推荐\n\n", record.Symbol, strings.ToUpper(string(record.Mode)), suggestHistoryDays)
+	for idx, item := range lo.Slice(results, 0, suggestDisplayResult) {
+		text += fmt.Sprintf("%s 价格区间: *%s* ~ *%s*\n", medals[idx],
+			format.Price(item.Config.PriceLower, 5), format.Price(item.Config.PriceUpper, 5))
+		text += fmt.Sprintf("网格数量: %d | 数量模式: %s\n",
+			item.Config.GridNum, quantityModeText(item.Config.QuantityMode))
+		text += fmt.Sprintf("净利润: %s USD | 配对次数: %d\n", item.NetProfit.StringFixed(2), len(item.Result.MatchedTrades))
+		text += fmt.Sprintf("初始保证金: %s USD | 保证金收益率: %s%%\n\n",
+			item.Margin.StringFixed(2), decimal.NewFromFloat(item.Score).Mul(hundred).StringFixed(2))
+	}
+	text += fmt.Sprintf("_使用 %dX 杠杆和当前单笔数量回测，历史表现不代表未来收益_", record.Leverage)
+
+	_, err = util.SendMarkdownMessage(h.svcCtx.Bot, chatId, text, nil)
+	if err != nil {
+		logger.Debugf("[StrategySettingsHandler] 发送消息失败, %v", err)
+	}
+	return nil
+}
+
+// SuggestGridRange 回测推荐网格参数
+// 使用最近的历史K线扫描价格区间、网格数量和数量模式，按单位保证金净利润降序返回
+func SuggestGridRange(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy) ([]*backtest.SweepResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, suggestSweepTimeout)
+	defer cancel()
+
+	mm, err := helper.GetMarketMetadata(ctx, svcCtx, record.Exchange, record.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	end := time.Now()
+	start := end.Add(-suggestHistoryDays * 24 * time.Hour)
+	candles, err := helper.GetCandles(ctx, svcCtx, record.Exchange, record.Symbol, suggestCandleSize, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	lowers, uppers := backtest.SuggestPriceRanges(candles, mm.SupportedPriceDecimals)
+	if len(lowers) == 0 || len(uppers) == 0 {
+		return nil, backtest.ErrEmptyData
+	}
+
+	// 初始资金足够挂出所有网格订单，收益按保证金计算，与初始资金无关
+	maxGridNum := slices.Max(suggestGridNums)
+	balance := slices.MaxFunc(uppers, func(a, b decimal.Decimal) int { return a.Cmp(b) }).
+		Mul(record.InitialOrderSize).Mul(decimal.NewFromInt(int64(maxGridNum + 1))).Mul(decimal.NewFromInt(2))
+
+	base := backtest.Config{
+		Symbol:           record.Symbol,
+		Mode:             record.Mode,
+		QuantityMode:     record.QuantityMode,
+		InitialOrderSize: record.InitialOrderSize,
+		Leverage:         max(record.Leverage, 1),
+		SlippageBps:      lo.FromPtrOr(record.SlippageBps, helper.DefaultSlippageBps),
+		InitialBalance:   balance,
+		Fee: paper.FeeModel{
+			MakerFeeRate: decimal.NewFromFloat(svcCtx.Config.Paper.MakerFeeRate),
+			TakerFeeRate: decimal.NewFromFloat(svcCtx.Config.Paper.TakerFeeRate),
+			SlippageBps:  svcCtx.Config.Paper.SlippageBps,
+		},
+		Metadata: mm,
+	}
+	space := backtest.SweepSpace{
+		PriceLowers:   lowers,
+		PriceUppers:   uppers,
+		GridNums:      suggestGridNums,
+		QuantityModes: []strategy.QuantityMode{strategy.QuantityModeArithmetic, strategy.QuantityModeGeometric},
+	}
+	return backtest.Sweep(ctx, base, space, candles, 0, backtest.ObjectiveProfitPerMargin)
+}
+
+// GenerateGridList 生成用于展示的网格价格和每格数量，做空网格按价格降序排列
+func GenerateGridList(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy) ([]decimal.Decimal, []decimal.Decimal, exchange.MarketMetadata) {
+	mm, err := helper.GetMarketMetadata(ctx, svcCtx, record.Exchange, record.Symbol)
+	if err != nil {
+		return nil, nil, mm
+	}
+
+	var prices []decimal.Decimal
+	switch record.QuantityMode {
+	case strategy.QuantityModeGeometric:
+		prices, err = gridstrategy.GenerateGeometricGrid(record.PriceLower, record.PriceUpper, record.GridNum, int32(mm.SupportedPriceDecimals))
+	case strategy.QuantityModeArithmetic, strategy.QuantityModeAdaptive:
+		prices, err = gridstrategy.GenerateArithmeticGrid(record.PriceLower, record.PriceUpper, record.GridNum, int32(mm.SupportedPriceDecimals))
+	}
+	if err != nil {
+		return nil, nil, mm
+	}
+
+	quantities, err := gridstrategy.GenerateGridQuantities(record, prices, mm.SupportedSizeDecimals)
+	if err != nil {
+		return prices, nil, mm
+	}
+
+	if record.Mode == strategy.ModeShort {
+		slices.Reverse(prices)
+		slices.Reverse(quantities)
+	}
+
+	return prices, quantities, mm
+}
+
+func CalculateProfitMargin(record *ent.Strategy, prices []decimal.Decimal) (decimal.Decimal, decimal.Decimal) {
+	if len(prices) < 2 {
+		return decimal.Zero, decimal.Zero
+	}
+
+	profitMargin1, profitMargin2 := decimal.Zero, decimal.Zero
+	p1, p2, p3, p4 := prices[0], prices[1], prices[len(prices)-2], prices[len(prices)-1]
+	switch record.Mode {
+	case strategy.ModeLong, strategy.ModeNeutral:
+		profitMargin1 = p2.Sub(p1).Div(p1)
+		profitMargin2 = p4.Sub(p3).Div(p3)
+	case strategy.ModeShort:
+		profitMargin1 = p1.Sub(p2).Div(p1)
+		profitMargin2 = p3.Sub(p4).Div(p3)
+	}
+
+	if profitMargin1.LessThan(profitMargin2) {
+		return profitMargin1, profitMargin2
+	}
+	return profitMargin2, profitMargin1
+}
+
+func DisplayStrategSettings(ctx context.Context, svcCtx *svc.ServiceContext, userId int64, update tele.Update, record *ent.Strategy, newMessage bool) error {
+	name := util.StrategyName(record)
+	text := fmt.Sprintf("*%s* | 编辑策略 `%s`\n\n", svcCtx.Config.AppName, name)
+
+	// 生成网格列表
+	if record.Exchange != "" &&
+		record.Symbol != "" &&
+		record.GridNum > 0 &&
+		record.PriceUpper.GreaterThan(decimal.Zero) &&
+		record.PriceLower.GreaterThan(decimal.Zero) {
+
+		var gridLabels []string
+		prices, quantities, mm := GenerateGridList(ctx, svcCtx, record)
+		for idx, price := range prices {
+			item := fmt.Sprintf("➖\\[ *%d* ] %s", idx, price)
+			if record.SizingMode != strategy.SizingModeFixed && idx < len(quantities) {
+				item += fmt.Sprintf(" × %s", quantities[idx])
+			}
+			gridLabels = append(gridLabels, item)
+		}
+		totalInvestment := decimal.Zero
+		if len(quantities) == len(prices) {
+			totalInvestment = gridstrategy.GridInvestment(prices, quantities)
+		}
+
+		// 截断网格列表
+		if len(gridLabels) > MaxShowGridNum {
+			n := MaxShowGridNum / 2
+			part1 := lo.Slice(gridLabels, 0, n)
+			part2 := lo.Slice(gridLabels, len(gridLabels)-n, len(gridLabels))
+			gridLabels = make([]string, 0, len(gridLabels)+1)
+			gridLabels = append(gridLabels, part1...)
+			gridLabels = append(gridLabels, "➖   ... (省略中间网格)")
+			gridLabels = append(gridLabels, part2...)
+		}
+
+		if len(gridLabels) > 0 {
+			text += "网格列表:\n" + strings.Join(gridLabels, "\n")
+		}
+
+		if len(prices) > 2 {
+			minProfitMargin, maxProfitMargin := CalculateProfitMargin(record, prices)
+			minProfitMargin = minProfitMargin.Mul(decimal.NewFromInt(100)).Truncate(2)
+			maxProfitMargin = maxProfitMargin.Mul(decimal.NewFromInt(100)).Truncate(2)
+			if minProfitMargin.Equal(maxProfitMargin) {
+				text += fmt.Sprintf("\n\n每格利润: *%v%%*", minProfitMargin)
+			} else {
+				text += fmt.Sprintf("\n\n每格利润: *%v%%* - *%v%%*", minProfitMargin, maxProfitMargin)
+			}
+			text += fmt.Sprintf("\n总投资额: %v USD", totalInvestment)
+			text += fmt.Sprintf("\n初始保证金: %v USD", totalInvestment.Div(decimal.NewFromInt(int64(record.Leverage))).Truncate(2))
+		}
+
+		// 检查每格数量
+		if len(prices) > 0 && record.InitialOrderSize.IsPositive() {
+			if len(quantities) != len(prices) {
+				text += "\n\n⚠️ 仓位曲线参数无效，请检查加仓系数或自定义权重"
+			} else if err := gridstrategy.ValidateGridQuantities(prices, quantities, mm); errors.Is(err, gridstrategy.ErrOrderValueTooSmall) {
+				text += fmt.Sprintf("\n\n⚠️ 部分网格交易金额小于 %s USD，请调整单笔数量或仓位曲线", mm.MinQuoteAmount)
+			} else if err != nil {
+				text += fmt.Sprintf("\n\n⚠️ 部分网格代币数量小于 %s，请调整单笔数量或仓位曲线", mm.MinBaseAmount)
+			}
+		}
+	}
+
+	connectStatus := "🔴"
+	if testExchangeConnectivity(ctx, svcCtx, record) == nil {
+		connectStatus = "🟢"
+	}
+
+	symbol := "未设置"
 	if record.Symbol != "" {
 		symbol = record.Symbol
 	}
@@ -1017,6 +2297,67 @@ func DisplayStrategSettings(ctx context.Context, svcCtx *svc.ServiceContext, use
 		triggerTakeProfitPrice = record.TriggerTakeProfitPrice.String()
 	}
 
+	maxLossAmount := "关闭"
+	if record.MaxLossAmount != nil && record.MaxLossAmount.GreaterThan(decimal.Zero) {
+		maxLossAmount = fmt.Sprintf("%s USD", record.MaxLossAmount)
+	}
+
+	maxLossPercent := "关闭"
+	if record.MaxLossPercent != nil && record.MaxLossPercent.GreaterThan(decimal.Zero) {
+		maxLossPercent = fmt.Sprintf("%s%%", record.MaxLossPercent)
+	}
+
+	profitTargetAmount := "关闭"
+	if record.ProfitTargetAmount != nil && record.ProfitTargetAmount.GreaterThan(decimal.Zero) {
+		profitTargetAmount = fmt.Sprintf("%s USD", record.ProfitTargetAmount)
+	}
+
+	trailingTakeProfitActivation := "未设置"
+	if record.TrailingTakeProfitActivationPrice != nil && record.TrailingTakeProfitActivationPrice.GreaterThan(decimal.Zero) {
+		trailingTakeProfitActivation = format.Price(*record.TrailingTakeProfitActivationPrice, 5)
+	}
+
+	modeIcon, modeName := gridModeText(record.Mode)
+
+	trailingLevels := "关闭"
+	if record.TrailingLevels != nil && *record.TrailingLevels > 0 {
+		trailingLevels = fmt.Sprintf("%d档", *record.TrailingLevels)
+	}
+
+	trailingPriceUpperLimit := "不限制"
+	if record.TrailingPriceUpperLimit != nil && record.TrailingPriceUpperLimit.GreaterThan(decimal.Zero) {
+		trailingPriceUpperLimit = format.Price(*record.TrailingPriceUpperLimit, 5)
+	}
+
+	trailingPriceLowerLimit := "不限制"
+	if record.TrailingPriceLowerLimit != nil && record.TrailingPriceLowerLimit.GreaterThan(decimal.Zero) {
+		trailingPriceLowerLimit = format.Price(*record.TrailingPriceLowerLimit, 5)
+	}
+
+	atrPeriod := lo.FromPtrOr(record.AtrPeriod, gridstrategy.DefaultAtrPeriod)
+	atrMultiplier := lo.FromPtrOr(record.AtrMultiplier, gridstrategy.DefaultAtrMultiplier)
+	adaptiveThreshold := lo.FromPtrOr(record.AdaptiveThreshold, gridstrategy.DefaultAdaptiveThreshold)
+
+	adaptiveIntervalHours := "关闭"
+	if record.AdaptiveIntervalHours != nil && *record.AdaptiveIntervalHours > 0 {
+		adaptiveIntervalHours = fmt.Sprintf("%d小时", *record.AdaptiveIntervalHours)
+	}
+
+	partialFillThreshold := "关闭"
+	if threshold := gridstrategy.PartialFillThreshold(record); threshold.IsPositive() {
+		partialFillThreshold = fmt.Sprintf("%s%%", threshold.Mul(decimal.NewFromInt(100)))
+	}
+
+	sizingFactor := "默认"
+	if record.SizingFactor != nil {
+		sizingFactor = record.SizingFactor.String()
+	}
+
+	sizingWeights := "未设置"
+	if record.SizingWeights != nil && *record.SizingWeights != "" {
+		sizingWeights = *record.SizingWeights
+	}
+
 	h := StrategySettingsHandler{}
 	replyMarkup := &tele.ReplyMarkup{
 		InlineKeyboard: [][]tele.InlineButton{
@@ -1029,21 +2370,39 @@ func DisplayStrategSettings(ctx context.Context, svcCtx *svc.ServiceContext, use
 			},
 			{
 				{Text: fmt.Sprintf("交易币种: %s", symbol), Data: h.FormatPath(record.GUID, SettingsOptionMarketSymbol)},
-				{Text: fmt.Sprintf("%s 网格模式: %s", lo.If(record.Mode == strategy.ModeLong, "🟢").Else("🔴"), lo.If(record.Mode == strategy.ModeLong, "做多").Else("做空")), Data: h.FormatPath(record.GUID, SettingsOptionGridMode)},
+				{Text: fmt.Sprintf("%s 网格模式: %s", modeIcon, modeName), Data: h.FormatPath(record.GUID, SettingsOptionGridMode)},
 			},
 			{
 				{Text: fmt.Sprintf("网格数量: %d", record.GridNum), Data: h.FormatPath(record.GUID, SettingsOptionGridNum)},
-				{Text: fmt.Sprintf("🔄 数量模式: %s", lo.If(record.QuantityMode == strategy.QuantityModeArithmetic, "等差").Else("等比")), Data: h.FormatPath(record.GUID, SettingsOptionQuantityMode)},
+				{Text: fmt.Sprintf("🔄 数量模式: %s", quantityModeText(record.QuantityMode)), Data: h.FormatPath(record.GUID, SettingsOptionQuantityMode)},
+			},
+			{
+				{Text: fmt.Sprintf("📊 ATR周期: %d", atrPeriod), Data: h.FormatPath(record.GUID, SettingsOptionAtrPeriod)},
+				{Text: fmt.Sprintf("📐 ATR倍数: %s", atrMultiplier), Data: h.FormatPath(record.GUID, SettingsOptionAtrMultiplier)},
+			},
+			{
+				{Text: fmt.Sprintf("🌊 波动阈值: %s%%", adaptiveThreshold.Mul(decimal.NewFromInt(100))), Data: h.FormatPath(record.GUID, SettingsOptionAdaptiveThreshold)},
+				{Text: fmt.Sprintf("⏰ 定时调整: %s", adaptiveIntervalHours), Data: h.FormatPath(record.GUID, SettingsOptionAdaptiveIntervalHours)},
 			},
 			{
 				{Text: fmt.Sprintf("🟰 单笔数量: %s", orderSize), Data: h.FormatPath(record.GUID, SettingsOptionOrderSize)},
 			},
+			{
+				{Text: fmt.Sprintf("📶 仓位曲线: %s", sizingModeText(record.SizingMode)), Data: h.FormatPath(record.GUID, SettingsOptionSizingMode)},
+				{Text: fmt.Sprintf("➕ 加仓系数: %s", sizingFactor), Data: h.FormatPath(record.GUID, SettingsOptionSizingFactor)},
+			},
+			{
+				{Text: fmt.Sprintf("🎚️ 自定义权重: %s", sizingWeights), Data: h.FormatPath(record.GUID, SettingsOptionSizingWeights)},
+			},
 			{
 				{Text: fmt.Sprintf("⬆️ 价格上限: %s", priceUpper), Data: h.FormatPath(record.GUID, SettingsOptionPriceUpper)},
 			},
 			{
 				{Text: fmt.Sprintf("⬇️ 价格下限: %s", priceLower), Data: h.FormatPath(record.GUID, SettingsOptionPriceLower)},
 			},
+			{
+				{Text: "🔍 回测推荐网格区间", Data: h.FormatPath(record.GUID, SettingsOptionSuggestRange)},
+			},
 			{
 				{Text: fmt.Sprintf("💲 策略入场价格: %s", entryPrice), Data: h.FormatPath(record.GUID, SettingsOptionEntryPrice)},
 			},
@@ -1053,9 +2412,40 @@ func DisplayStrategSettings(ctx context.Context, svcCtx *svc.ServiceContext, use
 			{
 				{Text: fmt.Sprintf("🏃‍♂️ 触发止盈价格: %s", triggerTakeProfitPrice), Data: h.FormatPath(record.GUID, SettingsOptionTriggerTakeProfitPrice)},
 			},
+			{
+				{Text: fmt.Sprintf("🛑 最大亏损: %s", maxLossAmount), Data: h.FormatPath(record.GUID, SettingsOptionMaxLossAmount)},
+				{Text: fmt.Sprintf("🛑 亏损比例: %s", maxLossPercent), Data: h.FormatPath(record.GUID, SettingsOptionMaxLossPercent)},
+			},
+			{
+				{Text: fmt.Sprintf("🎯 目标利润: %s", profitTargetAmount), Data: h.FormatPath(record.GUID, SettingsOptionProfitTargetAmount)},
+			},
+			{
+				{Text: fmt.Sprintf("🪂 移动止损: %s", trailingDistanceText(record.TrailingStopLossAmount, record.TrailingStopLossPercent)),
+					Data: h.FormatPath(record.GUID, SettingsOptionTrailingStopLoss)},
+			},
+			{
+				{Text: fmt.Sprintf("🚀 止盈激活: %s", trailingTakeProfitActivation), Data: h.FormatPath(record.GUID, SettingsOptionTrailingTakeProfitActivation)},
+				{Text: fmt.Sprintf("🪂 移动止盈: %s", trailingDistanceText(record.TrailingTakeProfitAmount, record.TrailingTakeProfitPercent)),
+					Data: h.FormatPath(record.GUID, SettingsOptionTrailingTakeProfit)},
+			},
+			{
+				{Text: fmt.Sprintf("🧲 移动网格: %s", trailingLevels), Data: h.FormatPath(record.GUID, SettingsOptionTrailingLevels)},
+			},
+			{
+				{Text: fmt.Sprintf("⤴️ 移动上限: %s", trailingPriceUpperLimit), Data: h.FormatPath(record.GUID, SettingsOptionTrailingPriceUpperLimit)},
+				{Text: fmt.Sprintf("⤵️ 移动下限: %s", trailingPriceLowerLimit), Data: h.FormatPath(record.GUID, SettingsOptionTrailingPriceLowerLimit)},
+			},
 			{
 				{Text: fmt.Sprintf("⚖️ 市价交易滑点: %v%%", float64(slippageBps)/10000*100.0), Data: h.FormatPath(record.GUID, SettingsOptionSlippage)},
 			},
+			{
+				{Text: fmt.Sprintf("🔧 撤单处理: %s", cancelRepairPolicyText(record.CancelRepairPolicy)), Data: h.FormatPath(record.GUID, SettingsOptionCancelRepairPolicy)},
+				{Text: fmt.Sprintf("🔁 修复次数: %d", gridstrategy.CancelRepairMaxAttempts(record)), Data: h.FormatPath(record.GUID, SettingsOptionCancelRepairMaxAttempts)},
+			},
+			{
+				{Text: fmt.Sprintf("🧾 对账差异: %s", reconcilePolicyText(record.ReconcilePolicy)), Data: h.FormatPath(record.GUID, SettingsOptionReconcilePolicy)},
+				{Text: fmt.Sprintf("🧩 部分成交: %s", partialFillThreshold), Data: h.FormatPath(record.GUID, SettingsOptionPartialFillThreshold)},
+			},
 			{
 				{Text: lo.If(record.EnablePushNotification, "🟢 开启成交通知").Else("🔴 关闭成交通知"), Data: h.FormatPath(record.GUID, SettingsOptionEnablePushNotification)},
 				{Text: lo.If(record.EnablePushMatchedNotification != nil && *record.EnablePushMatchedNotification, "🟢 开启匹配通知").Else("🔴 关闭匹配通知"),
@@ -2,15 +2,17 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/fachebot/omni-grid-bot/internal/engine"
 	"github.com/fachebot/omni-grid-bot/internal/ent"
 	"github.com/fachebot/omni-grid-bot/internal/ent/strategy"
+	"github.com/fachebot/omni-grid-bot/internal/ent/strategyrun"
 	"github.com/fachebot/omni-grid-bot/internal/exchange"
 	"github.com/fachebot/omni-grid-bot/internal/helper"
 	"github.com/fachebot/omni-grid-bot/internal/logger"
-	"github.com/fachebot/omni-grid-bot/internal/model"
 	gridstrategy "github.com/fachebot/omni-grid-bot/internal/strategy"
 	"github.com/fachebot/omni-grid-bot/internal/svc"
 	"github.com/fachebot/omni-grid-bot/internal/telebot/pathrouter"
@@ -24,8 +26,29 @@ type StopType string
 var (
 	StopTypeStop  StopType = "stop"
 	StopTypeClose StopType = "close"
+	StopTypeMenu  StopType = "menu"
 )
 
+// replyMarkups 策略通知消息的操作按钮，注册到 ServiceContext 供策略发送通知时使用
+type replyMarkups struct{}
+
+// RangeAlertReplyMarkup 网格区间提醒消息的操作按钮
+// 运行中的策略不能修改价格区间，按钮打开移动网格档位数设置，由移动网格跟随价格扩展区间；
+// 停止策略先显示关闭菜单，策略已经停止时不会重新开启
+func (replyMarkups) RangeAlertReplyMarkup(record *ent.Strategy) *tele.ReplyMarkup {
+	return &tele.ReplyMarkup{
+		InlineKeyboard: [][]tele.InlineButton{
+			{
+				{Text: "🧲 设置移动网格", Data: StrategySettingsHandler{}.FormatPath(record.GUID, SettingsOptionTrailingLevels)},
+				{Text: "⏹ 停止策略", Data: StrategySwitchHandler{}.FormatStopPath(record.GUID, StopTypeMenu)},
+			},
+			{
+				{Text: "📊 查看策略", Data: StrategyDetailsHandler{}.FormatPath(record.GUID)},
+			},
+		},
+	}
+}
+
 type StrategySwitchHandler struct {
 	svcCtx *svc.ServiceContext
 }
@@ -87,7 +110,7 @@ func (h *StrategySwitchHandler) handle(ctx context.Context, vars map[string]stri
 
 	// 选择开关操作
 	stopType, ok := vars["stop"]
-	if !ok {
+	if !ok || StopType(stopType) == StopTypeMenu {
 		if record.Status == strategy.StatusActive {
 			text := StrategyDetailsText(ctx, h.svcCtx, record)
 			inlineKeyboard := [][]tele.InlineButton{
@@ -109,6 +132,10 @@ func (h *StrategySwitchHandler) handle(ctx context.Context, vars map[string]stri
 			return err
 		}
 
+		if ok {
+			return DisplayStrategyDetails(ctx, h.svcCtx, userId, update, record)
+		}
+
 		if record.Status == strategy.StatusInactive {
 			return h.handleStartStrategy(ctx, userId, update, record, strategyEngine)
 		}
@@ -168,25 +195,12 @@ func (h *StrategySwitchHandler) handleStartStrategy(
 	}
 
 	// 检查单笔数量
-	if record.InitialOrderSize.LessThan(mm.MinBaseAmount) {
-		text := fmt.Sprintf("❌ 代币数量不能小于%s", mm.MinBaseAmount)
-		_, err = util.ReplyMessage(h.svcCtx.Bot, tele.Update{Message: msg}, text, nil)
-		return err
-	}
-
 	if uint8(-record.InitialOrderSize.Exponent()) > mm.SupportedSizeDecimals {
 		text := fmt.Sprintf("❌ 代币数量小数位长度不能大于%d", mm.SupportedSizeDecimals)
 		_, err = util.ReplyMessage(h.svcCtx.Bot, tele.Update{Message: msg}, text, nil)
 		return err
 	}
 
-	// 检查交易金额
-	if record.InitialOrderSize.Mul(record.PriceLower).LessThan(mm.MinQuoteAmount) {
-		text := fmt.Sprintf("❌ 单笔交易金额不能小于 %s USD，请调整单笔数量和价格下限", mm.MinQuoteAmount)
-		_, err = util.ReplyMessage(h.svcCtx.Bot, tele.Update{Message: msg}, text, nil)
-		return err
-	}
-
 	// 检查网格策略
 	result, err := h.svcCtx.StrategyModel.FindAllByExchangeAndAccountAndSymbol(ctx, record.Exchange, record.Account, record.Symbol)
 	if err != nil || len(result) > 1 {
@@ -195,6 +209,18 @@ func (h *StrategySwitchHandler) handleStartStrategy(
 		return err
 	}
 
+	// 根据近期波动率计算自适应网格数量，K线不足时使用配置的网格数量，运行后再自动调整
+	if record.QuantityMode == strategy.QuantityModeAdaptive {
+		err = gridstrategy.PrepareAdaptiveGrid(ctx, h.svcCtx, record, time.Now())
+		if err != nil && !errors.Is(err, gridstrategy.ErrInsufficientCandles) {
+			logger.Warnf("[StrategySwitchHandler] 计算自适应网格失败, id: %s, symbol: %s, %v", record.GUID, record.Symbol, err)
+
+			text := "❌ 获取近期行情失败，请稍后重试"
+			_, err = util.ReplyMessage(h.svcCtx.Bot, tele.Update{Message: msg}, text, nil)
+			return err
+		}
+	}
+
 	// 生成网格价格
 	prices, err := gridstrategy.GenerateGridPrices(record, mm.SupportedPriceDecimals)
 	if err != nil || len(prices) == 0 {
@@ -203,18 +229,47 @@ func (h *StrategySwitchHandler) handleStartStrategy(
 		return err
 	}
 
+	// 生成每格数量
+	quantities, err := gridstrategy.GenerateGridQuantities(record, prices, mm.SupportedSizeDecimals)
+	if err != nil {
+		text := "❌ 仓位曲线参数无效，请检查加仓系数或自定义权重后重试"
+		_, err = util.ReplyMessage(h.svcCtx.Bot, tele.Update{Message: msg}, text, nil)
+		return err
+	}
+
+	// 检查每格数量和交易金额
+	if err = gridstrategy.ValidateGridQuantities(prices, quantities, mm); err != nil {
+		text := fmt.Sprintf("❌ 代币数量不能小于%s，请调整单笔数量或仓位曲线", mm.MinBaseAmount)
+		if errors.Is(err, gridstrategy.ErrOrderValueTooSmall) {
+			text = fmt.Sprintf("❌ 单笔交易金额不能小于 %s USD，请调整单笔数量、仓位曲线和价格下限", mm.MinQuoteAmount)
+		}
+		_, err = util.ReplyMessage(h.svcCtx.Bot, tele.Update{Message: msg}, text, nil)
+		return err
+	}
+
 	// 校验保证金数量
-	positionValue := decimal.Zero
+	positionValue := gridstrategy.GridInvestment(prices, quantities)
 	maxPositionValue := account.AvailableBalance.Mul(decimal.NewFromInt(int64(record.Leverage)))
-	for _, price := range prices {
-		positionValue = positionValue.Add(price.Mul(record.InitialOrderSize))
-	}
 	if positionValue.GreaterThanOrEqual(maxPositionValue) {
 		text := fmt.Sprintf("❌ 账户保证金余额不足，必须大于 %s USD，请充值后重试", positionValue.Div(decimal.NewFromInt(int64(record.Leverage))).Truncate(2))
 		_, err = util.ReplyMessage(h.svcCtx.Bot, tele.Update{Message: msg}, text, nil)
 		return err
 	}
 
+	// 检查风险限额
+	err = gridstrategy.CheckStartRiskLimits(ctx, h.svcCtx, record, positionValue)
+	if err != nil {
+		text := "❌ 检查风险限额失败，请稍后重试"
+		var limitErr *gridstrategy.RiskLimitError
+		if errors.As(err, &limitErr) {
+			text = fmt.Sprintf("❌ 超出风险限额，%s，请调整网格或停止其他策略后重试", limitErr.Reason)
+		} else {
+			logger.Warnf("[StrategySwitchHandler] 检查风险限额失败, id: %s, symbol: %s, %v", record.GUID, record.Symbol, err)
+		}
+		_, err = util.ReplyMessage(h.svcCtx.Bot, tele.Update{Message: msg}, text, nil)
+		return err
+	}
+
 	// 检查入场价格
 	if record.EntryPrice != nil && record.EntryPrice.GreaterThan(decimal.Zero) {
 		if record.EntryPrice.LessThan(record.PriceLower) || record.EntryPrice.GreaterThan(record.PriceUpper) {
@@ -234,7 +289,7 @@ func (h *StrategySwitchHandler) handleStartStrategy(
 	}
 
 	// 初始化网格策略
-	err = gridstrategy.InitGridStrategy(ctx, h.svcCtx, record, prices)
+	err = gridstrategy.InitGridStrategy(ctx, h.svcCtx, record, prices, quantities)
 	if err != nil {
 		logger.Warnf("[StrategySwitchHandler] 初始化网格策略失败, id: %s, symbol: %s, %v", record.GUID, record.Symbol, err)
 
@@ -303,19 +358,7 @@ func (h *StrategySwitchHandler) handleStopStrategy(
 	}
 
 	// 更新策略状态
-	err = util.Tx(ctx, h.svcCtx.DbClient, func(tx *ent.Tx) error {
-		err = model.NewGridModel(tx.Grid).DeleteByStrategyId(ctx, record.GUID)
-		if err != nil {
-			return err
-		}
-
-		err = model.NewMatchedTradeModel(tx.MatchedTrade).DeleteByStrategyId(ctx, record.GUID)
-		if err != nil {
-			return err
-		}
-
-		return model.NewStrategyModel(tx.Strategy).UpdateStatus(ctx, record.ID, strategy.StatusInactive)
-	})
+	err = helper.DeactivateStrategy(ctx, h.svcCtx, record, strategyrun.StopReasonManual)
 	if err != nil {
 		logger.Errorf("[StrategySwitchHandler] 更新策略状态失败, guid: %s, %v", record.GUID, err)
 
@@ -384,19 +427,7 @@ func (h *StrategySwitchHandler) handleStopStrategyAndClose(
 	}
 
 	// 更新策略状态
-	err = util.Tx(ctx, h.svcCtx.DbClient, func(tx *ent.Tx) error {
-		err = model.NewGridModel(tx.Grid).DeleteByStrategyId(ctx, record.GUID)
-		if err != nil {
-			return err
-		}
-
-		err = model.NewMatchedTradeModel(tx.MatchedTrade).DeleteByStrategyId(ctx, record.GUID)
-		if err != nil {
-			return err
-		}
-
-		return model.NewStrategyModel(tx.Strategy).UpdateStatus(ctx, record.ID, strategy.StatusInactive)
-	})
+	err = helper.DeactivateStrategy(ctx, h.svcCtx, record, strategyrun.StopReasonClosePosition)
 	if err != nil {
 		logger.Errorf("[StrategySwitchHandler] 更新策略状态失败, guid: %s, %v", record.GUID, err)
 
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/fachebot/omni-grid-bot/internal/config"
+	"github.com/fachebot/omni-grid-bot/internal/ent"
+	"github.com/fachebot/omni-grid-bot/internal/svc"
+	"github.com/fachebot/omni-grid-bot/internal/telebot/pathrouter"
+)
+
+func TestRangeAlertReplyMarkup(t *testing.T) {
+	svcCtx := svc.NewIsolatedServiceContext(&config.Config{}, &ent.Client{}, nil)
+	router := pathrouter.NewRouter()
+	InitRoutes(svcCtx, router)
+	if svcCtx.ReplyMarkups == nil {
+		t.Fatal("注册路由后应提供通知消息的操作按钮")
+	}
+
+	record := &ent.Strategy{GUID: "guid"}
+	markup := svcCtx.ReplyMarkups.RangeAlertReplyMarkup(record)
+	want := map[string]string{
+		"🧲 设置移动网格": StrategySettingsHandler{}.FormatPath(record.GUID, SettingsOptionTrailingLevels),
+		"⏹ 停止策略":   StrategySwitchHandler{}.FormatStopPath(record.GUID, StopTypeMenu),
+		"📊 查看策略":   StrategyDetailsHandler{}.FormatPath(record.GUID),
+	}
+
+	count := 0
+	for _, row := range markup.InlineKeyboard {
+		for _, button := range row {
+			count++
+			if data, ok := want[button.Text]; !ok || button.Data != data {
+				t.Errorf("按钮 %s = %s, want %s", button.Text, button.Data, data)
+			}
+			if handler, _ := router.Match(button.Data); handler == nil {
+				t.Errorf("按钮 %s 的路径 %s 没有对应的处理器", button.Text, button.Data)
+			}
+		}
+	}
+	if count != len(want) {
+		t.Fatalf("按钮数量 = %d, want %d", count, len(want))
+	}
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/fachebot/omni-grid-bot/internal/helper"
 	"github.com/fachebot/omni-grid-bot/internal/svc"
 	"github.com/fachebot/omni-grid-bot/internal/util"
-	"github.com/samber/lo"
 	tele "gopkg.in/telebot.v4"
 )
 
@@ -32,8 +31,69 @@ func ClosePosition(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.
 		slippageBps = *record.SlippageBps
 	}
 
-	side := lo.If(record.Mode == strategy.ModeLong, helper.LONG).Else(helper.SHORT)
-	return adapter.ClosePosition(ctx, record.Symbol, side, slippageBps)
+	for _, side := range helper.PositionSides(record) {
+		if err = adapter.ClosePosition(ctx, record.Symbol, side, slippageBps); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// gridModeText 网格模式的图标和名称
+func gridModeText(mode strategy.Mode) (string, string) {
+	switch mode {
+	case strategy.ModeLong:
+		return "🟢", "做多"
+	case strategy.ModeShort:
+		return "🔴", "做空"
+	default:
+		return "⚪️", "中性"
+	}
+}
+
+func quantityModeText(mode strategy.QuantityMode) string {
+	switch mode {
+	case strategy.QuantityModeGeometric:
+		return "等比"
+	case strategy.QuantityModeAdaptive:
+		return "自适应"
+	default:
+		return "等差"
+	}
+}
+
+func sizingModeText(mode strategy.SizingMode) string {
+	switch mode {
+	case strategy.SizingModePyramid:
+		return "金字塔"
+	case strategy.SizingModeGeometric:
+		return "等比加仓"
+	case strategy.SizingModeEqualNotional:
+		return "等额"
+	case strategy.SizingModeCustom:
+		return "自定义"
+	default:
+		return "固定"
+	}
+}
+
+func cancelRepairPolicyText(policy strategy.CancelRepairPolicy) string {
+	switch policy {
+	case strategy.CancelRepairPolicySkip:
+		return "跳过档位"
+	case strategy.CancelRepairPolicyStop:
+		return "停止策略"
+	default:
+		return "重新挂单"
+	}
+}
+
+// reconcilePolicyText 对账方式的展示文本，修复模式只修复挂单，持仓偏差始终只告警
+func reconcilePolicyText(policy strategy.ReconcilePolicy) string {
+	if policy == strategy.ReconcilePolicyFix {
+		return "修复挂单"
+	}
+	return "仅告警"
 }
 
 func CancelAllOrders(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy) error {
@@ -75,49 +135,14 @@ func deleteMessageAndReply(bot *tele.Bot, message *tele.Message) {
 	util.DeleteMessages(bot, deleteMessages, 0)
 }
 
-func testLighterConnectivity(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy) error {
-	lighterClient, err := helper.GetLighterClient(svcCtx, record)
-	if err != nil {
-		return err
-	}
-
-	_, err = lighterClient.GetAccountInactiveOrders(ctx, "", 1)
-	return err
-}
-
-func testParadexConnectivity(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy) error {
-	userClient, err := helper.GetParadexClient(svcCtx, record)
-	if err != nil {
-		return err
-	}
-
-	_, err = userClient.GetAccount(ctx)
-	return err
-}
-
-func testVariationalConnectivity(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy) error {
-	userClient, err := helper.GetVariationalClient(svcCtx, record)
-	if err != nil {
-		return err
-	}
-
-	_, err = userClient.GetPortfolio(ctx, true)
-	return err
-}
-
 func testExchangeConnectivity(ctx context.Context, svcCtx *svc.ServiceContext, record *ent.Strategy) error {
 	if record.Exchange == "" {
 		return errors.New("exchange not configured")
 	}
 
-	switch record.Exchange {
-	case exchange.Lighter:
-		return testLighterConnectivity(ctx, svcCtx, record)
-	case exchange.Paradex:
-		return testParadexConnectivity(ctx, svcCtx, record)
-	case exchange.Variational:
-		return testVariationalConnectivity(ctx, svcCtx, record)
-	default:
-		return errors.New("exchange unsupported")
+	driver, err := exchange.GetDriver(record.Exchange)
+	if err != nil {
+		return err
 	}
+	return driver.TestConnectivity(ctx, record)
 }
@@ -0,0 +1,250 @@
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+const (
+	// KeySize 主密钥长度，使用 AES-256-GCM
+	KeySize = 32
+
+	// KeyEnv 未配置密钥文件时读取主密钥的环境变量
+	KeyEnv = "OMNI_GRID_MASTER_KEY"
+
+	// prefix 加密后的字段前缀，格式为 enc:v1:<密钥ID>:<加密的数据密钥>:<加密的数据>
+	prefix = "enc:v1:"
+)
+
+var (
+	ErrKeyNotConfigured = errors.New("secret: master key not configured")
+	ErrUnknownKey       = errors.New("secret: unknown master key")
+	ErrInvalidKey       = errors.New("secret: invalid master key")
+	ErrInvalidCipher    = errors.New("secret: invalid ciphertext")
+)
+
+// Keyring 主密钥集合
+// 使用信封加密，每个值使用随机生成的数据密钥加密，数据密钥再使用主密钥加密后一起保存；
+// 加密只使用当前主密钥，解密按密文记录的密钥ID查找主密钥，用于轮换主密钥时同时读取新旧密文
+type Keyring struct {
+	primary string
+	keys    map[string]cipher.AEAD
+}
+
+// NewKeyring 创建主密钥集合，第一个密钥为加密使用的当前主密钥，其余密钥只用于解密
+func NewKeyring(primary []byte, others ...[]byte) (*Keyring, error) {
+	k := &Keyring{keys: make(map[string]cipher.AEAD)}
+	for idx, key := range append([][]byte{primary}, others...) {
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, ErrInvalidKey
+		}
+
+		id := KeyID(key)
+		if idx == 0 {
+			k.primary = id
+		}
+		k.keys[id] = aead
+	}
+	return k, nil
+}
+
+// KeyID 计算主密钥的ID，写入密文用于解密时查找主密钥
+func KeyID(key []byte) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:4])
+}
+
+// GenerateKey 生成随机主密钥
+func GenerateKey() ([]byte, error) {
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// ParseKey 解析十六进制或 base64 编码的主密钥
+func ParseKey(text string) ([]byte, error) {
+	text = strings.TrimSpace(text)
+	if key, err := hex.DecodeString(text); err == nil && len(key) == KeySize {
+		return key, nil
+	}
+	if key, err := base64.StdEncoding.DecodeString(text); err == nil && len(key) == KeySize {
+		return key, nil
+	}
+	return nil, ErrInvalidKey
+}
+
+// LoadKey 加载主密钥
+// 优先读取密钥文件，没有配置密钥文件时读取环境变量 OMNI_GRID_MASTER_KEY，都没有配置时返回 nil
+func LoadKey(keyFile string) ([]byte, error) {
+	if keyFile != "" {
+		data, err := os.ReadFile(keyFile)
+		if err != nil {
+			return nil, err
+		}
+		return ParseKey(string(data))
+	}
+
+	if text, ok := os.LookupEnv(KeyEnv); ok && text != "" {
+		return ParseKey(text)
+	}
+	return nil, nil
+}
+
+// IsEncrypted 判断字段值是否已经加密
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
+
+// Encrypt 使用当前主密钥加密字段值，空字符串不加密
+func (k *Keyring) Encrypt(plaintext string) (string, error) {
+	if plaintext == "" {
+		return plaintext, nil
+	}
+
+	dataKey, err := GenerateKey()
+	if err != nil {
+		return "", err
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	wrappedKey, err := seal(k.keys[k.primary], dataKey)
+	if err != nil {
+		return "", err
+	}
+	ciphertext, err := seal(dataAEAD, []byte(plaintext))
+	if err != nil {
+		return "", err
+	}
+
+	return prefix + strings.Join([]string{
+		k.primary,
+		base64.StdEncoding.EncodeToString(wrappedKey),
+		base64.StdEncoding.EncodeToString(ciphertext),
+	}, ":"), nil
+}
+
+// Decrypt 解密字段值，没有加密的字段值原样返回
+func (k *Keyring) Decrypt(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+
+	parts := strings.Split(strings.TrimPrefix(value, prefix), ":")
+	if len(parts) != 3 {
+		return "", ErrInvalidCipher
+	}
+
+	masterAEAD, ok := k.keys[parts[0]]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnknownKey, parts[0])
+	}
+
+	wrappedKey, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", ErrInvalidCipher
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", ErrInvalidCipher
+	}
+
+	dataKey, err := open(masterAEAD, wrappedKey)
+	if err != nil {
+		return "", err
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return "", ErrInvalidCipher
+	}
+
+	plaintext, err := open(dataAEAD, ciphertext)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, ErrInvalidKey
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func seal(aead cipher.AEAD, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func open(aead cipher.AEAD, data []byte) ([]byte, error) {
+	if len(data) < aead.NonceSize() {
+		return nil, ErrInvalidCipher
+	}
+	plaintext, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, ErrInvalidCipher
+	}
+	return plaintext, nil
+}
+
+var (
+	mutex          sync.RWMutex
+	defaultKeyring *Keyring
+)
+
+// SetDefault 设置数据库字段加解密使用的主密钥集合，为 nil 时不加密写入的字段
+func SetDefault(k *Keyring) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	defaultKeyring = k
+}
+
+// Default 获取数据库字段加解密使用的主密钥集合
+func Default() *Keyring {
+	mutex.RLock()
+	defer mutex.RUnlock()
+	return defaultKeyring
+}
+
+// EncryptValue 使用默认主密钥集合加密写入数据库的字段值，没有配置主密钥时原样写入
+func EncryptValue(plaintext string) (string, error) {
+	k := Default()
+	if k == nil {
+		return plaintext, nil
+	}
+	return k.Encrypt(plaintext)
+}
+
+// DecryptValue 使用默认主密钥集合解密从数据库读取的字段值，没有配置主密钥时无法读取已加密的字段
+func DecryptValue(value string) (string, error) {
+	k := Default()
+	if k == nil {
+		if IsEncrypted(value) {
+			return "", ErrKeyNotConfigured
+		}
+		return value, nil
+	}
+	return k.Decrypt(value)
+}
@@ -0,0 +1,118 @@
+package secret
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func mustKeyring(t *testing.T, keys ...[]byte) *Keyring {
+	t.Helper()
+	k, err := NewKeyring(keys[0], keys[1:]...)
+	if err != nil {
+		t.Fatalf("创建主密钥集合失败: %v", err)
+	}
+	return k
+}
+
+func TestKeyringEncryptDecrypt(t *testing.T) {
+	key, _ := GenerateKey()
+	k := mustKeyring(t, key)
+
+	plaintext := "0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
+	first, err := k.Encrypt(plaintext)
+	if err != nil {
+		t.Fatalf("加密失败: %v", err)
+	}
+	second, _ := k.Encrypt(plaintext)
+	if !IsEncrypted(first) || strings.Contains(first, plaintext) {
+		t.Errorf("密文格式错误: %s", first)
+	}
+	if first == second {
+		t.Errorf("相同明文的密文不应相同")
+	}
+
+	for _, value := range []string{first, second} {
+		got, err := k.Decrypt(value)
+		if err != nil || got != plaintext {
+			t.Errorf("解密结果 = %q, %v, expected %q", got, err, plaintext)
+		}
+	}
+
+	// 空字符串和升级前保存的明文原样返回
+	if value, _ := k.Encrypt(""); value != "" {
+		t.Errorf("空字符串不应加密: %q", value)
+	}
+	if got, err := k.Decrypt("plain"); err != nil || got != "plain" {
+		t.Errorf("明文解密结果 = %q, %v", got, err)
+	}
+
+	// 篡改密文后解密失败
+	tampered := first[:len(first)-4] + "AAAA"
+	if _, err = k.Decrypt(tampered); !errors.Is(err, ErrInvalidCipher) {
+		t.Errorf("篡改密文的解密错误 = %v, expected %v", err, ErrInvalidCipher)
+	}
+}
+
+func TestKeyringRotate(t *testing.T) {
+	oldKey, _ := GenerateKey()
+	newKey, _ := GenerateKey()
+
+	value, _ := mustKeyring(t, oldKey).Encrypt("secret")
+
+	// 轮换时新密钥加密，旧密钥仍可解密旧密文
+	rotating := mustKeyring(t, newKey, oldKey)
+	got, err := rotating.Decrypt(value)
+	if err != nil || got != "secret" {
+		t.Fatalf("旧密文解密结果 = %q, %v", got, err)
+	}
+	rotated, _ := rotating.Encrypt(got)
+	if !strings.HasPrefix(rotated, prefix+KeyID(newKey)+":") {
+		t.Errorf("轮换后应使用新密钥加密: %s", rotated)
+	}
+
+	// 轮换完成后只保留新密钥，旧密文无法解密
+	if _, err = mustKeyring(t, newKey).Decrypt(value); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("旧密文解密错误 = %v, expected %v", err, ErrUnknownKey)
+	}
+	if got, _ = mustKeyring(t, newKey).Decrypt(rotated); got != "secret" {
+		t.Errorf("新密文解密结果 = %q", got)
+	}
+}
+
+func TestParseKey(t *testing.T) {
+	key, _ := GenerateKey()
+	for _, text := range []string{hex.EncodeToString(key), base64.StdEncoding.EncodeToString(key) + "\n"} {
+		got, err := ParseKey(text)
+		if err != nil || string(got) != string(key) {
+			t.Errorf("解析主密钥 %q 失败: %v", text, err)
+		}
+	}
+	if _, err := ParseKey("short"); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("无效主密钥的错误 = %v, expected %v", err, ErrInvalidKey)
+	}
+}
+
+func TestDefaultKeyring(t *testing.T) {
+	defer SetDefault(nil)
+
+	// 没有配置主密钥时原样写入，无法读取已加密的字段
+	SetDefault(nil)
+	if value, _ := EncryptValue("secret"); value != "secret" {
+		t.Errorf("未配置主密钥时不应加密: %s", value)
+	}
+
+	key, _ := GenerateKey()
+	SetDefault(mustKeyring(t, key))
+	value, err := EncryptValue("secret")
+	if err != nil || !IsEncrypted(value) {
+		t.Fatalf("加密结果 = %q, %v", value, err)
+	}
+
+	SetDefault(nil)
+	if _, err = DecryptValue(value); !errors.Is(err, ErrKeyNotConfigured) {
+		t.Errorf("未配置主密钥的解密错误 = %v, expected %v", err, ErrKeyNotConfigured)
+	}
+}
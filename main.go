@@ -6,17 +6,26 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
+	"time"
 
 	"github.com/fachebot/omni-grid-bot/internal/config"
 	"github.com/fachebot/omni-grid-bot/internal/engine"
+	"github.com/fachebot/omni-grid-bot/internal/exchange"
+	"github.com/fachebot/omni-grid-bot/internal/exchange/dydx"
+	"github.com/fachebot/omni-grid-bot/internal/exchange/hyperliquid"
 	"github.com/fachebot/omni-grid-bot/internal/exchange/lighter"
+	"github.com/fachebot/omni-grid-bot/internal/exchange/paper"
 	"github.com/fachebot/omni-grid-bot/internal/exchange/paradex"
 	"github.com/fachebot/omni-grid-bot/internal/exchange/variational"
+	"github.com/fachebot/omni-grid-bot/internal/helper"
 	"github.com/fachebot/omni-grid-bot/internal/logger"
 	"github.com/fachebot/omni-grid-bot/internal/strategy"
 	"github.com/fachebot/omni-grid-bot/internal/svc"
 	"github.com/fachebot/omni-grid-bot/internal/telebot"
+	"github.com/fachebot/omni-grid-bot/internal/util/secret"
+	"github.com/shopspring/decimal"
 	"github.com/sirupsen/logrus"
 )
 
@@ -24,6 +33,10 @@ var (
 	version     = "dev"
 	showVersion = flag.Bool("version", false, "显示版本信息")
 	configFile  = flag.String("f", "etc/config.yaml", "the config file")
+
+	genKeyFile         = flag.String("gen-key", "", "生成交易所凭证主密钥文件并退出")
+	encryptCredentials = flag.Bool("encrypt-credentials", false, "使用主密钥加密数据库中的明文交易所凭证并退出")
+	rotateKeyFile      = flag.String("rotate-key", "", "使用新的主密钥文件重新加密交易所凭证并退出")
 )
 
 func startAllStrategy(svcCtx *svc.ServiceContext, strategyEngine *engine.StrategyEngine) {
@@ -53,6 +66,21 @@ func startAllStrategy(svcCtx *svc.ServiceContext, strategyEngine *engine.Strateg
 	}
 }
 
+// newRecorder 创建交易所WebSocket消息录制器，未开启录制时返回 nil
+func newRecorder(c *config.Config, name string) *exchange.Recorder {
+	if !c.WsRecorder.Enable {
+		return nil
+	}
+
+	path := filepath.Join(c.WsRecorder.Dir, fmt.Sprintf("%s-%s.jsonl", name, time.Now().Format("20060102-150405")))
+	recorder, err := exchange.NewRecorder(path)
+	if err != nil {
+		logger.Fatalf("创建消息录制器失败, exchange: %s, %s", name, err)
+	}
+	logger.Infof("开始录制WebSocket消息, exchange: %s, path: %s", name, path)
+	return recorder
+}
+
 func main() {
 	flag.Parse()
 
@@ -61,6 +89,15 @@ func main() {
 		return
 	}
 
+	// 生成主密钥文件
+	if *genKeyFile != "" {
+		if err := generateKeyFile(*genKeyFile); err != nil {
+			logger.Fatalf("生成主密钥文件失败, %s", err)
+		}
+		logger.Infof("已生成主密钥文件, path: %s", *genKeyFile)
+		return
+	}
+
 	// 读取配置文件
 	c, err := config.LoadFromFile(*configFile)
 	if err != nil {
@@ -78,25 +115,98 @@ func main() {
 		}
 	}
 
+	// 加载交易所凭证主密钥
+	masterKey, err := secret.LoadKey(c.Credentials.KeyFile)
+	if err != nil {
+		logger.Fatalf("加载交易所凭证主密钥失败, %s", err)
+	}
+
+	// 加密或轮换交易所凭证
+	if *encryptCredentials || *rotateKeyFile != "" {
+		count, err := reencryptCredentials(masterKey, *rotateKeyFile)
+		if err != nil {
+			logger.Fatalf("加密交易所凭证失败, %s", err)
+		}
+		logger.Infof("已重新加密交易所凭证, 记录数量: %d", count)
+		if *rotateKeyFile != "" {
+			logger.Infof("请将配置中的主密钥替换为新主密钥, path: %s", *rotateKeyFile)
+		}
+		return
+	}
+
+	if masterKey == nil {
+		logger.Warnf("未配置交易所凭证主密钥, 交易所凭证将以明文保存")
+	} else {
+		keyring, err := secret.NewKeyring(masterKey)
+		if err != nil {
+			logger.Fatalf("加载交易所凭证主密钥失败, %s", err)
+		}
+		secret.SetDefault(keyring)
+	}
+
 	// 创建服务上下文
 	svcCtx := svc.NewServiceContext(c)
 
+	// 创建消息录制器
+	lighterRecorder := newRecorder(c, exchange.Lighter)
+	paradexRecorder := newRecorder(c, exchange.Paradex)
+	variationalRecorder := newRecorder(c, exchange.Variational)
+
 	// 启动Lighter订阅器
 	lighterSubscriber := lighter.NewLighterSubscriber(svcCtx.LighterCache, c.Sock5Proxy)
+	lighterSubscriber.SetRecorder(lighterRecorder)
 	lighterSubscriber.Start()
 	lighterSubscriber.WaitUntilConnected()
 
 	// 启动Paradex订阅器
 	paradexSubscriber := paradex.NewParadexSubscriber(c.Sock5Proxy)
+	paradexSubscriber.SetRecorder(paradexRecorder)
 	paradexSubscriber.Start()
 
 	// 启动Variational订阅器
 	variationalSubscriber := variational.NewVariationalSubscriber(c.Sock5Proxy)
+	variationalSubscriber.SetRecorder(variationalRecorder)
 	variationalSubscriber.Start()
 
+	// 启动Hyperliquid订阅器
+	hyperliquidSubscriber := hyperliquid.NewHyperliquidSubscriber(hyperliquid.MainnetWsURL, c.Sock5Proxy)
+	hyperliquidSubscriber.Start()
+
+	// 启动dYdX订阅器
+	dydxSubscriber := dydx.NewDydxSubscriber(dydx.MainnetIndexerWsURL, c.Sock5Proxy)
+	dydxSubscriber.Start()
+
+	// 启动模拟交易所订阅器
+	paperSimulator := paper.NewSimulator(paper.FeeModel{
+		MakerFeeRate: decimal.NewFromFloat(c.Paper.MakerFeeRate),
+		TakerFeeRate: decimal.NewFromFloat(c.Paper.TakerFeeRate),
+		SlippageBps:  c.Paper.SlippageBps,
+	}, decimal.NewFromFloat(c.Paper.InitialBalance))
+	paperSubscriber := paper.NewPaperSubscriber(
+		paperSimulator, helper.NewPaperPriceFunc(c.Paper.PriceSource), time.Duration(c.Paper.PollInterval)*time.Second)
+	paperSubscriber.Start()
+
+	// 注册交易所驱动
+	exchange.Register(helper.NewLighterDriver(svcCtx, lighterSubscriber))
+	exchange.Register(helper.NewParadexDriver(svcCtx, paradexSubscriber))
+	exchange.Register(helper.NewVariationalDriver(svcCtx, variationalSubscriber))
+	exchange.Register(helper.NewHyperliquidDriver(svcCtx, hyperliquidSubscriber))
+	exchange.Register(helper.NewDydxDriver(svcCtx, dydxSubscriber))
+	if _, err = exchange.GetDriver(c.Paper.PriceSource); err != nil {
+		logger.Fatalf("模拟交易所行情来源不支持, %s", c.Paper.PriceSource)
+	}
+	exchange.Register(helper.NewPaperDriver(svcCtx, c.Paper.PriceSource, paperSimulator, paperSubscriber))
+
 	// 启动网格策略引擎
-	strategyEngine := engine.NewStrategyEngine(
-		svcCtx, lighterSubscriber, paradexSubscriber, variationalSubscriber)
+	subscribers := make([]exchange.Subscriber, 0)
+	for _, driver := range exchange.Drivers() {
+		subscribers = append(subscribers, driver.Subscriber())
+	}
+	strategyEngine := engine.NewStrategyEngine(svcCtx, subscribers...)
+	strategyEngine.SetReconcileInterval(time.Duration(c.Reconcile.Interval) * time.Second)
+	strategyEngine.SetFillSyncInterval(time.Duration(c.FillSync.Interval) * time.Second)
+	strategyEngine.SetFundingSyncInterval(time.Duration(c.FundingSync.Interval) * time.Second)
+	strategyEngine.SetRiskMonitorInterval(time.Duration(c.RiskMonitor.Interval) * time.Second)
 	strategyEngine.Start()
 
 	// 启动所有网络
@@ -115,11 +225,17 @@ func main() {
 	<-ch
 
 	strategyEngine.Stop()
-	lighterSubscriber.Stop()
-	paradexSubscriber.Stop()
-	variationalSubscriber.Stop()
+	for _, subscriber := range subscribers {
+		subscriber.Stop()
+	}
 	botService.Stop()
 
+	for _, recorder := range []*exchange.Recorder{lighterRecorder, paradexRecorder, variationalRecorder} {
+		if err = recorder.Close(); err != nil {
+			logger.Errorf("关闭消息录制器失败, %s", err)
+		}
+	}
+
 	svcCtx.Close()
 	logger.Infof("服务已停止")
 }